@@ -12,6 +12,7 @@ import (
 
 	"github.com/robfig/cron"
 	"github.com/sevenNt/ares/application"
+	"github.com/sevenNt/ares/client/chiwoo"
 	"github.com/sevenNt/ares/flag"
 	"github.com/sevenNt/ares/registry"
 	"github.com/sevenNt/ares/server"
@@ -57,6 +58,8 @@ func NewAPP(opts ...Option) *App {
 
 	app.loadOptions(opts...)
 	app.initLogger()
+	// pooled grpc connections are closed after all user defers
+	app.Defer(chiwoo.CloseAll)
 	return app
 }
 
@@ -0,0 +1,127 @@
+package chiwoo
+
+import (
+	"context"
+	"hash/crc32"
+	"math/rand"
+	"sort"
+	"strconv"
+	"sync"
+
+	"google.golang.org/grpc/balancer"
+	"google.golang.org/grpc/balancer/base"
+	"google.golang.org/grpc/balancer/roundrobin"
+	"google.golang.org/grpc/resolver"
+)
+
+// Balancer names, used with grpc.WithBalancerName or "loadBalancingPolicy"
+// of service config.
+const (
+	BalancerRoundRobin     = roundrobin.Name
+	BalancerWeighted       = "ares_weighted"
+	BalancerConsistentHash = "ares_consistent_hash"
+)
+
+func init() {
+	balancer.Register(base.NewBalancerBuilder(BalancerWeighted, &weightedPickerBuilder{}))
+	balancer.Register(base.NewBalancerBuilder(BalancerConsistentHash, &hashPickerBuilder{}))
+}
+
+type hashKey struct{}
+
+// WithHashKey returns context carrying the key of consistent hash balancer.
+func WithHashKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, hashKey{}, key)
+}
+
+func addrWeight(addr resolver.Address) int {
+	if md, ok := addr.Metadata.(AddrMetadata); ok && md.Weight > 0 {
+		return md.Weight
+	}
+	return 1
+}
+
+type weightedPickerBuilder struct{}
+
+func (*weightedPickerBuilder) Build(readySCs map[resolver.Address]balancer.SubConn) balancer.Picker {
+	if len(readySCs) == 0 {
+		return base.NewErrPicker(balancer.ErrNoSubConnAvailable)
+	}
+	p := &weightedPicker{}
+	for addr, sc := range readySCs {
+		p.conns = append(p.conns, &weightedConn{sc: sc, weight: addrWeight(addr)})
+	}
+	return p
+}
+
+type weightedConn struct {
+	sc      balancer.SubConn
+	weight  int
+	current int
+}
+
+// weightedPicker picks by smooth weighted round-robin.
+type weightedPicker struct {
+	mu    sync.Mutex
+	conns []*weightedConn
+}
+
+func (p *weightedPicker) Pick(ctx context.Context, opts balancer.PickOptions) (balancer.SubConn, func(balancer.DoneInfo), error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var best *weightedConn
+	total := 0
+	for _, c := range p.conns {
+		c.current += c.weight
+		total += c.weight
+		if best == nil || c.current > best.current {
+			best = c
+		}
+	}
+	best.current -= total
+	return best.sc, nil, nil
+}
+
+type hashPickerBuilder struct{}
+
+func (*hashPickerBuilder) Build(readySCs map[resolver.Address]balancer.SubConn) balancer.Picker {
+	if len(readySCs) == 0 {
+		return base.NewErrPicker(balancer.ErrNoSubConnAvailable)
+	}
+	p := &hashPicker{
+		nodes: make(map[uint32]balancer.SubConn),
+	}
+	for addr, sc := range readySCs {
+		p.conns = append(p.conns, sc)
+		for i := 0; i < 100*addrWeight(addr); i++ {
+			h := crc32.ChecksumIEEE([]byte(strconv.Itoa(i) + addr.Addr))
+			p.ring = append(p.ring, h)
+			p.nodes[h] = sc
+		}
+	}
+	sort.Slice(p.ring, func(i, j int) bool { return p.ring[i] < p.ring[j] })
+	return p
+}
+
+// hashPicker picks by consistent hash of the key set by WithHashKey,
+// calls without key are picked randomly.
+type hashPicker struct {
+	conns []balancer.SubConn
+	ring  []uint32
+	nodes map[uint32]balancer.SubConn
+}
+
+func (p *hashPicker) Pick(ctx context.Context, opts balancer.PickOptions) (balancer.SubConn, func(balancer.DoneInfo), error) {
+	key, ok := ctx.Value(hashKey{}).(string)
+	if !ok {
+		return p.conns[rand.Intn(len(p.conns))], nil, nil
+	}
+
+	h := crc32.ChecksumIEEE([]byte(key))
+	i := sort.Search(len(p.ring), func(i int) bool { return p.ring[i] >= h })
+	if i == len(p.ring) {
+		i = 0
+	}
+	return p.nodes[p.ring[i]], nil, nil
+}
@@ -0,0 +1,52 @@
+package chiwoo
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/balancer"
+	"google.golang.org/grpc/resolver"
+)
+
+type testSubConn struct {
+	balancer.SubConn
+	name string
+}
+
+func TestWeightedPicker(t *testing.T) {
+	a, b := &testSubConn{name: "a"}, &testSubConn{name: "b"}
+	p := (&weightedPickerBuilder{}).Build(map[resolver.Address]balancer.SubConn{
+		{Addr: "a", Metadata: AddrMetadata{Weight: 3}}: a,
+		{Addr: "b"}: b,
+	})
+
+	picks := make(map[string]int)
+	for i := 0; i < 8; i++ {
+		sc, _, err := p.Pick(context.Background(), balancer.PickOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		picks[sc.(*testSubConn).name]++
+	}
+	if picks["a"] != 6 || picks["b"] != 2 {
+		t.Fatalf("picks = %v", picks)
+	}
+}
+
+func TestHashPicker(t *testing.T) {
+	readySCs := map[resolver.Address]balancer.SubConn{
+		{Addr: "a"}: &testSubConn{name: "a"},
+		{Addr: "b"}: &testSubConn{name: "b"},
+		{Addr: "c"}: &testSubConn{name: "c"},
+	}
+	p := (&hashPickerBuilder{}).Build(readySCs)
+	ctx := WithHashKey(context.Background(), "user-1")
+	first, _, _ := p.Pick(ctx, balancer.PickOptions{})
+	for i := 0; i < 10; i++ {
+		// pickers rebuilt from the same addresses pick the same conn
+		sc, _, _ := (&hashPickerBuilder{}).Build(readySCs).Pick(ctx, balancer.PickOptions{})
+		if sc != first {
+			t.Fatalf("pick = %v, want %v", sc, first)
+		}
+	}
+}
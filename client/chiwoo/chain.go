@@ -14,8 +14,12 @@ type SteamClientInterceptorFunc interface {
 	StreamClientIntercept() grpc.StreamClientInterceptor
 }
 
-// UnaryClientInterceptorChain returns stream interceptors chain.
+// UnaryClientInterceptorChain returns unary interceptors chain.
 func UnaryClientInterceptorChain(interceptors ...UnaryClientInterceptorFunc) grpc.UnaryClientInterceptor {
+	intes := make([]grpc.UnaryClientInterceptor, len(interceptors))
+	for i, in := range interceptors {
+		intes[i] = in.UnaryClientIntercept()
+	}
 	build := func(c grpc.UnaryClientInterceptor, invoker grpc.UnaryInvoker) grpc.UnaryInvoker {
 		return func(ctx context.Context, method string, req, rep interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
 			return c(ctx, method, req, rep, cc, invoker, opts...)
@@ -24,8 +28,8 @@ func UnaryClientInterceptorChain(interceptors ...UnaryClientInterceptorFunc) grp
 	//type UnaryInvoker func(ctx context.Context, method string, req, reply interface{}, cc *ClientConn, opts ...CallOption) error
 	return func(ctx context.Context, method string, req, rep interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
 		chain := invoker
-		for i := len(interceptors) - 1; i >= 0; i-- {
-			chain = build(interceptors[i].UnaryClientIntercept(), chain)
+		for i := len(intes) - 1; i >= 0; i-- {
+			chain = build(intes[i], chain)
 		}
 		return chain(ctx, method, req, rep, cc, opts...)
 	}
@@ -33,6 +37,10 @@ func UnaryClientInterceptorChain(interceptors ...UnaryClientInterceptorFunc) grp
 
 // StreamClientInterceptorChain returns stream interceptors chain.
 func StreamClientInterceptorChain(interceptors ...SteamClientInterceptorFunc) grpc.StreamClientInterceptor {
+	intes := make([]grpc.StreamClientInterceptor, len(interceptors))
+	for i, in := range interceptors {
+		intes[i] = in.StreamClientIntercept()
+	}
 	build := func(c grpc.StreamClientInterceptor, streamer grpc.Streamer) grpc.Streamer {
 		return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
 			return c(ctx, desc, cc, method, streamer, opts...)
@@ -40,8 +48,8 @@ func StreamClientInterceptorChain(interceptors ...SteamClientInterceptorFunc) gr
 	}
 	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
 		chain := streamer
-		for i := len(interceptors) - 1; i >= 0; i-- {
-			chain = build(interceptors[i].StreamClientIntercept(), chain)
+		for i := len(intes) - 1; i >= 0; i-- {
+			chain = build(intes[i], chain)
 		}
 		return chain(ctx, desc, cc, method, opts...)
 	}
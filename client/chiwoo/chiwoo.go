@@ -2,9 +2,14 @@ package chiwoo
 
 import (
 	"context"
+	"strings"
+	"sync/atomic"
 	"time"
 
+	"github.com/sevenNt/ares/plugin/backoff"
+	"github.com/sevenNt/ares/plugin/tracer"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
 )
 
 type (
@@ -19,20 +24,41 @@ type Chiwoo struct {
 	target             string
 	dialOptions        []grpc.DialOption
 	dialTimeout        time.Duration
-	unaryInterceptors  []grpc.UnaryClientInterceptor
-	streamInterceptors []grpc.StreamClientInterceptor
+	dialBackOff        func() backoff.BackOff
+	unaryInterceptors  []UnaryClientInterceptorFunc
+	streamInterceptors []SteamClientInterceptorFunc
+	pool               *Pool
+	poolName           string
+	id                 uint64
 }
 
-// New 返回一个grpc client wrappter Chiwoo
+// clients counts clients, which identifies connections of clients not
+// sharing them.
+var clients uint64
+
+// New 返回一个grpc client wrappter Chiwoo, trace keys and caller identity of
+// context are not sent unless propagation is enabled, see SetPropagation.
 func New(target string, opts ...grpc.DialOption) *Chiwoo {
 	return &Chiwoo{
-		dialOptions:        make([]grpc.DialOption, 0),
+		target:             target,
+		dialOptions:        append(make([]grpc.DialOption, 0, len(opts)), opts...),
 		dialTimeout:        time.Second * 2,
-		unaryInterceptors:  make([]grpc.UnaryClientInterceptor, 0),
-		streamInterceptors: make([]grpc.StreamClientInterceptor, 0),
+		unaryInterceptors:  make([]UnaryClientInterceptorFunc, 0),
+		streamInterceptors: make([]SteamClientInterceptorFunc, 0),
+		pool:               defaultPool,
+		id:                 atomic.AddUint64(&clients, 1),
 	}
 }
 
+// SetPropagation sends trace keys, deadline and caller identity of context
+// by tracer.Default, only enable it for services trusted with them.
+func (c *Chiwoo) SetPropagation() *Chiwoo {
+	t := tracer.Default()
+	c.unaryInterceptors = append(c.unaryInterceptors, t)
+	c.streamInterceptors = append(c.streamInterceptors, t)
+	return c
+}
+
 func (c *Chiwoo) SetTarget(target string) *Chiwoo {
 	c.target = target
 	return c
@@ -45,18 +71,43 @@ func (c *Chiwoo) SetDialOptions(opts ...grpc.DialOption) *Chiwoo {
 
 func (c *Chiwoo) SetUnaryClientInterceptor(intes ...ClientInterceptor) *Chiwoo {
 	for _, in := range intes {
-		c.unaryInterceptors = append(c.unaryInterceptors, in.UnaryClientIntercept())
+		c.unaryInterceptors = append(c.unaryInterceptors, in)
 	}
 	return c
 }
 
 func (c *Chiwoo) SetStreamClientInterceptor(intes ...ClientInterceptor) *Chiwoo {
 	for _, in := range intes {
-		c.streamInterceptors = append(c.streamInterceptors, in.StreamClientIntercept())
+		c.streamInterceptors = append(c.streamInterceptors, in)
 	}
 	return c
 }
 
+// SetRegistryTarget sets target to app label discovered through registry,
+// see RegistryTarget.
+func (c *Chiwoo) SetRegistryTarget(label string) *Chiwoo {
+	c.target = RegistryTarget(label)
+	return c
+}
+
+// SetBalancerName sets balancer of resolved addresses, e.g. BalancerWeighted.
+func (c *Chiwoo) SetBalancerName(name string) *Chiwoo {
+	c.dialOptions = append(c.dialOptions, grpc.WithBalancerName(name))
+	return c
+}
+
+// SetServiceConfig sets json service config of registry target, it must be
+// called after SetRegistryTarget.
+func (c *Chiwoo) SetServiceConfig(config string) *Chiwoo {
+	if strings.HasPrefix(c.target, Scheme+":///") {
+		RegisterServiceConfig(strings.TrimPrefix(c.target, Scheme+":///"), config)
+	}
+	return c
+}
+
+// SetETCDBalancerEndpoints sets round-robin balancer resolving target from etcd.
+//
+// Deprecated: use SetRegistryTarget instead.
 func (c *Chiwoo) SetETCDBalancerEndpoints(endpoints ...string) *Chiwoo {
 	c.dialOptions = append(c.dialOptions, grpc.WithBalancer(NewETCDBalancer(endpoints)))
 	return c
@@ -72,6 +123,24 @@ func (c *Chiwoo) SetDialTimeout(timeout time.Duration) *Chiwoo {
 	return c
 }
 
+// SetDialBackOff sets the backoff policy retrying failed dials, each dial
+// gets a new policy from newBackOff. It only takes effect on blocking dials,
+// see grpc.WithBlock.
+func (c *Chiwoo) SetDialBackOff(newBackOff func() backoff.BackOff) *Chiwoo {
+	c.dialBackOff = newBackOff
+	return c
+}
+
+// SetPool sets the pool of connection, the default pool is closed on
+// application shutting down. Clients of the same target, pool and name share
+// the connection dialed by the first of them, so only give the same name to
+// clients created with the same options, e.g. credentials and interceptors.
+// Clients of empty name, including ones not set, never share connections.
+func (c *Chiwoo) SetPool(pool *Pool, name string) *Chiwoo {
+	c.pool, c.poolName = pool, name
+	return c
+}
+
 func (c *Chiwoo) MustDial() *grpc.ClientConn {
 	conn, err := c.Dial()
 	if err != nil {
@@ -81,21 +150,67 @@ func (c *Chiwoo) MustDial() *grpc.ClientConn {
 	return conn
 }
 
+// Dial dials a new connection, which is not pooled.
 func (c *Chiwoo) Dial() (cc *grpc.ClientConn, err error) {
+	if c.dialBackOff == nil {
+		return c.dial()
+	}
+
+	err = backoff.Retry(func() error {
+		cc, err = c.dial()
+		return err
+	}, c.dialBackOff())
+	return cc, err
+}
+
+func (c *Chiwoo) dial() (*grpc.ClientConn, error) {
 	var ctx = context.Background()
 	if c.dialTimeout > 0 {
 		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(context.Background(), 2*time.Second)
+		ctx, cancel = context.WithTimeout(context.Background(), c.dialTimeout)
 		defer cancel()
 	}
 
+	opts := append(make([]grpc.DialOption, 0, len(c.dialOptions)+2), c.dialOptions...)
 	if len(c.unaryInterceptors) > 0 {
-		c.dialOptions = append(c.dialOptions, grpc.WithUnaryInterceptor(UnaryClientInterceptorChain(c.unaryInterceptors...)))
+		opts = append(opts, grpc.WithUnaryInterceptor(UnaryClientInterceptorChain(c.unaryInterceptors...)))
 	}
 
 	if len(c.streamInterceptors) > 0 {
-		c.dialOptions = append(c.dialOptions, grpc.WithStreamInterceptor(StreamClientInterceptorChain(c.streamInterceptors...)))
+		opts = append(opts, grpc.WithStreamInterceptor(StreamClientInterceptorChain(c.streamInterceptors...)))
+	}
+
+	return grpc.DialContext(ctx, c.target, opts...)
+}
+
+// key returns key of connection in pool, see SetPool.
+func (c *Chiwoo) key() poolKey {
+	if c.poolName != "" {
+		return poolKey{target: c.target, name: c.poolName}
 	}
+	return poolKey{target: c.target, client: c.id}
+}
+
+// Conn returns the pooled connection of target, it's dialed on first use.
+func (c *Chiwoo) Conn() (*grpc.ClientConn, error) {
+	return c.pool.get(c.key(), c.Dial)
+}
+
+// Do calls fn with the pooled connection of target.
+func (c *Chiwoo) Do(fn func(*grpc.ClientConn) error) error {
+	cc, err := c.Conn()
+	if err != nil {
+		return err
+	}
+	return fn(cc)
+}
+
+// State returns connectivity state of the pooled connection.
+func (c *Chiwoo) State() connectivity.State {
+	return c.pool.state(c.key())
+}
 
-	return grpc.DialContext(ctx, c.target, c.dialOptions...)
+// Close closes the pooled connection of target.
+func (c *Chiwoo) Close() error {
+	return c.pool.close(c.key())
 }
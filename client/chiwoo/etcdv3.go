@@ -4,12 +4,17 @@ import (
 	"github.com/coreos/etcd/clientv3"
 	etcdnaming "github.com/coreos/etcd/clientv3/naming"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/naming"
 )
 
 type ETCDBalancer struct {
 	endpoints []string
 }
 
+// NewETCDBalancer returns a round-robin balancer resolving target from etcd,
+// dialing fails with the error if etcd client can't be created.
+//
+// Deprecated: use the "ares" resolver scheme instead, see RegistryTarget.
 func NewETCDBalancer(endpoints []string) grpc.Balancer {
 	cc, err := clientv3.New(clientv3.Config{
 		Endpoints:        endpoints,
@@ -17,9 +22,17 @@ func NewETCDBalancer(endpoints []string) grpc.Balancer {
 	})
 
 	if err != nil {
-		panic("client v3 connect etcd error")
+		return grpc.RoundRobin(errResolver{err: err})
 	}
 
 	rr := &etcdnaming.GRPCResolver{Client: cc}
 	return grpc.RoundRobin(rr)
 }
+
+type errResolver struct {
+	err error
+}
+
+func (r errResolver) Resolve(target string) (naming.Watcher, error) {
+	return nil, r.err
+}
@@ -0,0 +1,233 @@
+package chiwoo
+
+import (
+	"context"
+	"strconv"
+	"sync"
+
+	"github.com/sevenNt/wzap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+)
+
+var defaultPool = NewPool()
+
+// CloseAll closes all connections of the default pool.
+func CloseAll() {
+	defaultPool.CloseAll()
+}
+
+// Pool shares client connections by target and name, connections are dialed
+// lazily by the first Get of them.
+type Pool struct {
+	mu            sync.Mutex
+	conns         map[poolKey]*pooledConn
+	onStateChange func(target string, state connectivity.State)
+}
+
+// poolKey identifies connection of target by name, or by client if it's not
+// shared, see Chiwoo.SetPool.
+type poolKey struct {
+	target string
+	name   string
+	client uint64
+}
+
+func (k poolKey) String() string {
+	switch {
+	case k.name != "":
+		return k.target + "#" + k.name
+	case k.client != 0:
+		return k.target + "@" + strconv.FormatUint(k.client, 10)
+	}
+	return k.target
+}
+
+type pooledConn struct {
+	mu      sync.Mutex
+	cc      *grpc.ClientConn
+	state   connectivity.State
+	cancel  context.CancelFunc
+	dialing chan struct{} // closed once dial returns
+	closed  bool
+}
+
+// NewPool constructs a new connection pool.
+func NewPool() *Pool {
+	return &Pool{
+		conns: make(map[poolKey]*pooledConn),
+	}
+}
+
+// OnStateChange sets callback on connectivity state change of connections.
+func (p *Pool) OnStateChange(fn func(target string, state connectivity.State)) {
+	p.mu.Lock()
+	p.onStateChange = fn
+	p.mu.Unlock()
+}
+
+// Get returns connection of target by name, dial is called if it's not
+// dialed yet. Callers of the same target and name share the connection, so
+// they must dial with the same options.
+func (p *Pool) Get(target, name string, dial func() (*grpc.ClientConn, error)) (*grpc.ClientConn, error) {
+	return p.get(poolKey{target: target, name: name}, dial)
+}
+
+func (p *Pool) get(key poolKey, dial func() (*grpc.ClientConn, error)) (*grpc.ClientConn, error) {
+	p.mu.Lock()
+	pc, ok := p.conns[key]
+	if !ok {
+		pc = &pooledConn{}
+		p.conns[key] = pc
+	}
+	p.mu.Unlock()
+
+	// dial is called without holding pc.mu, which blocks State
+	for {
+		pc.mu.Lock()
+		if pc.cc != nil || pc.closed {
+			cc, closed := pc.cc, pc.closed
+			pc.mu.Unlock()
+			if closed {
+				return nil, grpc.ErrClientConnClosing
+			}
+			return cc, nil
+		}
+		if pc.dialing == nil {
+			break
+		}
+		dialing := pc.dialing
+		pc.mu.Unlock()
+		<-dialing
+	}
+	dialing := make(chan struct{})
+	pc.dialing = dialing
+	pc.mu.Unlock()
+
+	cc, err := dial()
+
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+	pc.dialing = nil
+	close(dialing)
+	if err != nil {
+		return nil, err
+	}
+	if pc.closed {
+		// closed while dialing
+		cc.Close()
+		return nil, grpc.ErrClientConnClosing
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	pc.cc, pc.cancel = cc, cancel
+	go p.watch(ctx, key.target, pc, cc)
+	return cc, nil
+}
+
+// State returns connectivity state of connection of target by name,
+// connections not dialed are idle.
+func (p *Pool) State(target, name string) connectivity.State {
+	return p.state(poolKey{target: target, name: name})
+}
+
+func (p *Pool) state(key poolKey) connectivity.State {
+	p.mu.Lock()
+	pc, ok := p.conns[key]
+	p.mu.Unlock()
+	if !ok {
+		return connectivity.Idle
+	}
+
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+	return pc.state
+}
+
+// States returns connectivity states of connections by target, targets are
+// suffixed by #name of shared connections, or @id of client otherwise.
+func (p *Pool) States() map[string]connectivity.State {
+	p.mu.Lock()
+	keys := make([]poolKey, 0, len(p.conns))
+	for key := range p.conns {
+		keys = append(keys, key)
+	}
+	p.mu.Unlock()
+
+	states := make(map[string]connectivity.State, len(keys))
+	for _, key := range keys {
+		states[key.String()] = p.state(key)
+	}
+	return states
+}
+
+// Close closes connection of target by name.
+func (p *Pool) Close(target, name string) error {
+	return p.close(poolKey{target: target, name: name})
+}
+
+func (p *Pool) close(key poolKey) error {
+	p.mu.Lock()
+	pc, ok := p.conns[key]
+	delete(p.conns, key)
+	p.mu.Unlock()
+	if !ok {
+		return nil
+	}
+	return pc.close()
+}
+
+// CloseAll closes all connections.
+func (p *Pool) CloseAll() {
+	p.mu.Lock()
+	conns := p.conns
+	p.conns = make(map[poolKey]*pooledConn)
+	p.mu.Unlock()
+
+	for key, pc := range conns {
+		if err := pc.close(); err != nil {
+			wzap.Warnf("[chiwoo] close %s failed: %v", key.target, err)
+		}
+	}
+}
+
+// watch reports state changes of connection until it's closed.
+func (p *Pool) watch(ctx context.Context, target string, pc *pooledConn, cc *grpc.ClientConn) {
+	state := cc.GetState()
+	for {
+		pc.mu.Lock()
+		pc.state = state
+		pc.mu.Unlock()
+
+		switch state {
+		case connectivity.TransientFailure:
+			wzap.Warnf("[chiwoo] connection to %s failed, reconnecting", target)
+		case connectivity.Ready:
+			wzap.Infof("[chiwoo] connection to %s ready", target)
+		}
+		p.mu.Lock()
+		fn := p.onStateChange
+		p.mu.Unlock()
+		if fn != nil {
+			fn(target, state)
+		}
+
+		if state == connectivity.Shutdown || !cc.WaitForStateChange(ctx, state) {
+			return
+		}
+		state = cc.GetState()
+	}
+}
+
+func (pc *pooledConn) close() error {
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+	pc.closed = true
+	if pc.cc == nil {
+		return nil
+	}
+	pc.cancel()
+	err := pc.cc.Close()
+	pc.cc = nil
+	pc.state = connectivity.Shutdown
+	return err
+}
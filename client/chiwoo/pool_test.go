@@ -0,0 +1,137 @@
+package chiwoo
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+)
+
+// token is per-RPC credentials of a user.
+type token string
+
+func (t token) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": string(t)}, nil
+}
+
+func (token) RequireTransportSecurity() bool { return false }
+
+func TestPoolSharing(t *testing.T) {
+	p := NewPool()
+	defer p.CloseAll()
+	conn := func(c *Chiwoo) *grpc.ClientConn {
+		cc, err := c.Conn()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return cc
+	}
+	newClient := func(user string) *Chiwoo {
+		return New("127.0.0.1:1", grpc.WithInsecure(), grpc.WithPerRPCCredentials(token(user)))
+	}
+
+	// clients of different credentials never share connections
+	alice, bob := newClient("alice").SetPool(p, ""), newClient("bob").SetPool(p, "")
+	if conn(alice) == conn(bob) {
+		t.Error("clients of different credentials share connection")
+	}
+	if conn(alice) != conn(alice) {
+		t.Error("connection of client is not reused")
+	}
+
+	// neither do clients of the default pool, unless they are named
+	a, b := newClient("alice"), newClient("alice")
+	defer a.Close()
+	defer b.Close()
+	if conn(a) == conn(b) {
+		t.Error("clients not named share connection")
+	}
+	if conn(newClient("alice").SetPool(p, "alice")) != conn(newClient("alice").SetPool(p, "alice")) {
+		t.Error("clients of the same name don't share connection")
+	}
+	if conn(newClient("bob").SetPool(p, "bob")) == conn(newClient("alice").SetPool(p, "alice")) {
+		t.Error("clients of different names share connection")
+	}
+}
+
+func TestPoolGet(t *testing.T) {
+	p := NewPool()
+	defer p.CloseAll()
+
+	var (
+		dials   int
+		release = make(chan struct{})
+	)
+	dial := func() (*grpc.ClientConn, error) {
+		dials++
+		<-release
+		return grpc.Dial("127.0.0.1:1", grpc.WithInsecure())
+	}
+
+	var wg sync.WaitGroup
+	conns := make([]*grpc.ClientConn, 2)
+	for i := range conns {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			cc, err := p.Get("127.0.0.1:1", "a", dial)
+			if err != nil {
+				t.Error(err)
+			}
+			conns[i] = cc
+		}(i)
+	}
+
+	// state is not blocked by dialing
+	done := make(chan connectivity.State)
+	go func() { done <- p.State("127.0.0.1:1", "a") }()
+	select {
+	case state := <-done:
+		if state != connectivity.Idle {
+			t.Errorf("state while dialing = %v", state)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("state is blocked by dialing")
+	}
+
+	close(release)
+	wg.Wait()
+	if dials != 1 || conns[0] == nil || conns[0] != conns[1] {
+		t.Errorf("dials = %d, conns = %v", dials, conns)
+	}
+
+	// connections of other names are not shared
+	cc, err := p.Get("127.0.0.1:1", "b", func() (*grpc.ClientConn, error) {
+		return grpc.Dial("127.0.0.1:1", grpc.WithInsecure())
+	})
+	if err != nil || cc == conns[0] {
+		t.Errorf("conn of other name = %v, %v", cc, err)
+	}
+	if states := p.States(); len(states) != 2 {
+		t.Errorf("states = %v", states)
+	}
+}
+
+func TestPoolCloseWhileDialing(t *testing.T) {
+	p := NewPool()
+	dialing, release := make(chan struct{}), make(chan struct{})
+	errs := make(chan error)
+	go func() {
+		_, err := p.Get("127.0.0.1:1", "", func() (*grpc.ClientConn, error) {
+			close(dialing)
+			<-release
+			return grpc.Dial("127.0.0.1:1", grpc.WithInsecure())
+		})
+		errs <- err
+	}()
+
+	<-dialing
+	p.Close("127.0.0.1:1", "")
+	close(release)
+	if err := <-errs; err != grpc.ErrClientConnClosing {
+		t.Errorf("err = %v", err)
+	}
+}
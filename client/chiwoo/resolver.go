@@ -0,0 +1,150 @@
+package chiwoo
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/sevenNt/ares/registry"
+	"github.com/sevenNt/wzap"
+	"google.golang.org/grpc/resolver"
+)
+
+// Scheme is the target scheme of services discovered through registry,
+// e.g. "ares:///name:v1:mode" dials gRPC service registered by app "name:v1:mode".
+const Scheme = "ares"
+
+var serviceConfigs sync.Map
+
+func init() {
+	resolver.Register(&registryBuilder{})
+}
+
+// RegistryTarget returns the registry target of app label.
+func RegistryTarget(label string) string {
+	return Scheme + ":///" + label
+}
+
+// RegisterServiceConfig sets json service config of app label, which
+// is pushed to connections along with addresses, see
+// https://github.com/grpc/grpc/blob/master/doc/service_config.md
+func RegisterServiceConfig(label string, config string) {
+	serviceConfigs.Store(label, config)
+}
+
+// AddrMetadata is the metadata of resolved addresses.
+type AddrMetadata struct {
+	Weight int
+}
+
+type registryBuilder struct{}
+
+// Build implements resolver.Builder interface.
+func (b *registryBuilder) Build(target resolver.Target, cc resolver.ClientConn, opts resolver.BuildOption) (resolver.Resolver, error) {
+	r := &registryResolver{
+		service: "grpc:" + target.Endpoint,
+		label:   target.Endpoint,
+		cc:      cc,
+		stop:    make(chan struct{}),
+	}
+	go r.watch()
+	return r, nil
+}
+
+// Scheme implements resolver.Builder interface.
+func (b *registryBuilder) Scheme() string {
+	return Scheme
+}
+
+type registryResolver struct {
+	service string
+	label   string
+	cc      resolver.ClientConn
+
+	mu      sync.Mutex
+	watcher registry.Watcher
+	stop    chan struct{}
+}
+
+// ResolveNow implements resolver.Resolver interface, addresses are pushed
+// by registry watcher.
+func (r *registryResolver) ResolveNow(opts resolver.ResolveNowOption) {}
+
+// Close implements resolver.Resolver interface.
+func (r *registryResolver) Close() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	close(r.stop)
+	if r.watcher != nil {
+		r.watcher.Stop()
+	}
+}
+
+func (r *registryResolver) watch() {
+	for {
+		r.mu.Lock()
+		select {
+		case <-r.stop:
+			r.mu.Unlock()
+			return
+		default:
+		}
+		w, err := registry.Watch(registry.WatchService(r.service))
+		if err == nil {
+			r.watcher = w
+		}
+		r.mu.Unlock()
+
+		if err == nil {
+			err = r.consume(w)
+		}
+		if err == registry.ErrWatcherStopped {
+			return
+		}
+		wzap.Warnf("[chiwoo] watch service %s failed: %v", r.service, err)
+
+		select {
+		case <-r.stop:
+			return
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+func (r *registryResolver) consume(w registry.Watcher) error {
+	addrs := make(map[string]resolver.Address)
+	for {
+		res, err := w.Next()
+		if err != nil {
+			return err
+		}
+		for _, node := range res.Service.Nodes {
+			if res.Action == "delete" {
+				delete(addrs, node.ID)
+				continue
+			}
+			addrs[node.ID] = resolver.Address{
+				Addr:     node.Address,
+				Metadata: nodeMetadata(node),
+			}
+		}
+
+		list := make([]resolver.Address, 0, len(addrs))
+		for _, addr := range addrs {
+			list = append(list, addr)
+		}
+		r.cc.NewAddress(list)
+		if config, ok := serviceConfigs.Load(r.label); ok {
+			r.cc.NewServiceConfig(config.(string))
+		}
+	}
+}
+
+// nodeMetadata parses weight of node, which defaults to 1.
+func nodeMetadata(node *registry.Node) AddrMetadata {
+	md := AddrMetadata{Weight: 1}
+	if w, err := strconv.Atoi(node.Metadata["weight"]); err == nil && w > 0 {
+		md.Weight = w
+	}
+	return md
+}
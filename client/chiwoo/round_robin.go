@@ -12,6 +12,8 @@ import (
 
 // RoundRobin returns a Balancer that selects addresses round-robin. It uses r to watch
 // the name resolution updates and updates the addresses available correspondingly.
+//
+// Deprecated: use the "ares" resolver scheme with BalancerRoundRobin instead.
 func RoundRobin(r naming.Resolver) grpc.Balancer {
 	return &roundRobin{r: r}
 }
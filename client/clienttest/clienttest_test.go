@@ -0,0 +1,158 @@
+package clienttest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/sevenNt/ares/client/chiwoo"
+	"github.com/sevenNt/ares/client/resty"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type recorder struct {
+	errors []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, format)
+}
+
+func TestTransport(t *testing.T) {
+	mock := NewTransport()
+	mock.On("GET", "/users/1").WithQuery("fields", "name").ReplyJSON(http.StatusOK, map[string]string{"name": "ares"}).Once()
+	mock.On("POST", "/users").WithJSONBody(map[string]string{"name": "new"}).Reply(http.StatusCreated, "")
+	mock.On("GET", "/slow").Delay(time.Second)
+	mock.On("GET", "/down").ReplyError(errors.New("connection refused"))
+
+	c := resty.New().SetHostURL("http://user-api").SetRoundTripper(mock)
+
+	var user map[string]string
+	resp, err := c.R().SetQueryParam("fields", "name").SetResult(&user).Get("/users/1")
+	if err != nil || resp.StatusCode() != http.StatusOK || user["name"] != "ares" {
+		t.Fatalf("resp = %v, err = %v, user = %v", resp, err, user)
+	}
+	if _, err := c.R().Get("/users/1"); err == nil {
+		t.Error("expectation called more than once")
+	}
+
+	resp, err = c.R().SetBody(map[string]string{"name": "new"}).Post("/users")
+	if err != nil || resp.StatusCode() != http.StatusCreated {
+		t.Fatalf("resp = %v, err = %v", resp, err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := c.R().SetContext(ctx).Get("/slow"); err == nil {
+		t.Error("delay not canceled by context")
+	}
+	if _, err := c.R().Get("/down"); err == nil {
+		t.Error("error not replied")
+	}
+
+	if !mock.AssertExpectations(t) {
+		t.Error("expectations not met")
+	}
+	if n := len(mock.Requests()); n != 5 {
+		t.Errorf("requests = %d, want 5", n)
+	}
+
+	r := &recorder{}
+	unmet := NewTransport()
+	unmet.On("GET", "/never")
+	if unmet.AssertExpectations(r) || len(r.errors) != 1 {
+		t.Error("unmet expectation not reported")
+	}
+}
+
+var echoDesc = grpc.ServiceDesc{
+	ServiceName: "test.Echo",
+	Methods: []grpc.MethodDesc{
+		{MethodName: "Say"},
+		{MethodName: "Shout"},
+	},
+	Streams: []grpc.StreamDesc{
+		{StreamName: "Chat", ServerStreams: true, ClientStreams: true},
+		{StreamName: "Listen", ServerStreams: true},
+	},
+}
+
+func TestServer(t *testing.T) {
+	srv := NewServer().Fake(&echoDesc, map[string]interface{}{
+		"Say": func(ctx context.Context, in *wrappers.StringValue) (*wrappers.StringValue, error) {
+			return &wrappers.StringValue{Value: "echo " + in.Value}, nil
+		},
+		"Chat": func(stream grpc.ServerStream) error {
+			for {
+				var in wrappers.StringValue
+				if err := stream.RecvMsg(&in); err != nil {
+					return nil
+				}
+				if err := stream.SendMsg(&wrappers.StringValue{Value: "echo " + in.Value}); err != nil {
+					return err
+				}
+			}
+		},
+	}).Start()
+	defer srv.Stop()
+
+	cc, err := chiwoo.New(Target, srv.DialOptions()...).Dial()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cc.Close()
+
+	var out wrappers.StringValue
+	if err := grpc.Invoke(context.Background(), "/test.Echo/Say", &wrappers.StringValue{Value: "hi"}, &out, cc); err != nil {
+		t.Fatal(err)
+	}
+	if out.Value != "echo hi" {
+		t.Errorf("reply = %q", out.Value)
+	}
+	err = grpc.Invoke(context.Background(), "/test.Echo/Shout", &wrappers.StringValue{}, &out, cc)
+	if status.Code(err) != codes.Unimplemented {
+		t.Errorf("err = %v, want unimplemented", err)
+	}
+	if n := srv.Calls("/test.Echo/Say"); n != 1 {
+		t.Errorf("calls = %d, want 1", n)
+	}
+
+	// stream methods
+	stream, err := grpc.NewClientStream(context.Background(), &echoDesc.Streams[0], cc, "/test.Echo/Chat")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := stream.SendMsg(&wrappers.StringValue{Value: "hi"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := stream.RecvMsg(&out); err != nil || out.Value != "echo hi" {
+		t.Errorf("stream reply = %q, %v", out.Value, err)
+	}
+	stream.CloseSend()
+	if n := srv.Calls("/test.Echo/Chat"); n != 1 {
+		t.Errorf("stream calls = %d, want 1", n)
+	}
+	stream, err = grpc.NewClientStream(context.Background(), &echoDesc.Streams[1], cc, "/test.Echo/Listen")
+	if err == nil {
+		stream.SendMsg(&wrappers.StringValue{})
+		stream.CloseSend()
+		err = stream.RecvMsg(&out)
+	}
+	if status.Code(err) != codes.Unimplemented {
+		t.Errorf("stream err = %v, want unimplemented", err)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("fake of invalid stream method is accepted")
+		}
+	}()
+	NewServer().Fake(&echoDesc, map[string]interface{}{"Chat": func() {}})
+}
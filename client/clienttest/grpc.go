@@ -0,0 +1,209 @@
+package clienttest
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"reflect"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// Target is the dial target of mock servers, which is ignored by the
+// in-memory dialer.
+const Target = "clienttest"
+
+// Server is an in-memory gRPC server for mocking services, e.g.
+//
+//	srv := clienttest.NewServer()
+//	srv.Fake(&pb.Greeter_serviceDesc, map[string]interface{}{
+//		"SayHello": func(ctx context.Context, in *pb.HelloRequest) (*pb.HelloReply, error) {
+//			return &pb.HelloReply{Message: "hi"}, nil
+//		},
+//	}).Start()
+//	defer srv.Stop()
+//	cc := chiwoo.New(clienttest.Target, srv.DialOptions()...).MustDial()
+type Server struct {
+	*grpc.Server
+	lis *bufconn.Listener
+
+	mu    sync.Mutex
+	calls map[string]int
+}
+
+// NewServer constructs a new mock server.
+func NewServer(opts ...grpc.ServerOption) *Server {
+	s := &Server{
+		lis:   bufconn.Listen(1 << 20),
+		calls: make(map[string]int),
+	}
+	opts = append(opts, grpc.UnaryInterceptor(s.count), grpc.StreamInterceptor(s.countStream))
+	s.Server = grpc.NewServer(opts...)
+	return s
+}
+
+// Register registers impl as the implementation of service desc.
+func (s *Server) Register(desc *grpc.ServiceDesc, impl interface{}) *Server {
+	s.RegisterService(desc, impl)
+	return s
+}
+
+// Fake registers fake methods of service desc by method name, whose values
+// are functions of form
+//
+//	func(context.Context, *Request) (*Response, error)
+//
+// for unary methods, or of form
+//
+//	func(grpc.ServerStream) error
+//
+// for stream methods, which receive and send messages by RecvMsg and SendMsg
+// of stream. Methods not faked return Unimplemented.
+func (s *Server) Fake(desc *grpc.ServiceDesc, methods map[string]interface{}) *Server {
+	fake := &grpc.ServiceDesc{
+		ServiceName: desc.ServiceName,
+		// any implementation is accepted
+		HandlerType: (*interface{})(nil),
+		Metadata:    desc.Metadata,
+	}
+	for _, m := range desc.Methods {
+		fake.Methods = append(fake.Methods, grpc.MethodDesc{
+			MethodName: m.MethodName,
+			Handler:    fakeHandler(desc.ServiceName, m.MethodName, methods[m.MethodName]),
+		})
+	}
+	for _, m := range desc.Streams {
+		fake.Streams = append(fake.Streams, grpc.StreamDesc{
+			StreamName:    m.StreamName,
+			Handler:       fakeStreamHandler(desc.ServiceName, m.StreamName, methods[m.StreamName]),
+			ServerStreams: m.ServerStreams,
+			ClientStreams: m.ClientStreams,
+		})
+	}
+	for name := range methods {
+		if !hasMethod(desc, name) {
+			panic(fmt.Sprintf("clienttest: %s has no method %s", desc.ServiceName, name))
+		}
+	}
+	s.RegisterService(fake, struct{}{})
+	return s
+}
+
+// Start starts serving in background.
+func (s *Server) Start() *Server {
+	go s.Serve(s.lis)
+	return s
+}
+
+// Stop stops server and closes listener.
+func (s *Server) Stop() {
+	s.Server.Stop()
+	s.lis.Close()
+}
+
+// DialOptions returns options dialing server in memory.
+func (s *Server) DialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithInsecure(),
+		grpc.WithDialer(func(string, time.Duration) (net.Conn, error) {
+			return s.lis.Dial()
+		}),
+	}
+}
+
+// Calls returns number of calls of full method, e.g. /pkg.Svc/Method.
+func (s *Server) Calls(method string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls[method]
+}
+
+func (s *Server) count(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	s.mu.Lock()
+	s.calls[info.FullMethod]++
+	s.mu.Unlock()
+	return handler(ctx, req)
+}
+
+func (s *Server) countStream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	s.mu.Lock()
+	s.calls[info.FullMethod]++
+	s.mu.Unlock()
+	return handler(srv, ss)
+}
+
+var (
+	contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+	errorType   = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+func fakeHandler(service, method string, fn interface{}) func(interface{}, context.Context, func(interface{}) error, grpc.UnaryServerInterceptor) (interface{}, error) {
+	fullMethod := "/" + service + "/" + method
+	if fn == nil {
+		return func(_ interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+			handler := func(context.Context, interface{}) (interface{}, error) {
+				return nil, status.Errorf(codes.Unimplemented, "clienttest: %s is not faked", fullMethod)
+			}
+			if interceptor == nil {
+				return handler(ctx, nil)
+			}
+			return interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: fullMethod}, handler)
+		}
+	}
+
+	fv := reflect.ValueOf(fn)
+	ft := fv.Type()
+	if ft.Kind() != reflect.Func || ft.NumIn() != 2 || ft.NumOut() != 2 ||
+		ft.In(0) != contextType || ft.In(1).Kind() != reflect.Ptr || ft.Out(1) != errorType {
+		panic(fmt.Sprintf("clienttest: fake of %s must be func(context.Context, *Request) (*Response, error)", fullMethod))
+	}
+	return func(_ interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+		in := reflect.New(ft.In(1).Elem()).Interface()
+		if err := dec(in); err != nil {
+			return nil, err
+		}
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			out := fv.Call([]reflect.Value{reflect.ValueOf(ctx), reflect.ValueOf(req)})
+			err, _ := out[1].Interface().(error)
+			return out[0].Interface(), err
+		}
+		if interceptor == nil {
+			return handler(ctx, in)
+		}
+		return interceptor(ctx, in, &grpc.UnaryServerInfo{FullMethod: fullMethod}, handler)
+	}
+}
+
+func fakeStreamHandler(service, method string, fn interface{}) grpc.StreamHandler {
+	if fn == nil {
+		return func(interface{}, grpc.ServerStream) error {
+			return status.Errorf(codes.Unimplemented, "clienttest: /%s/%s is not faked", service, method)
+		}
+	}
+	handler, ok := fn.(func(grpc.ServerStream) error)
+	if !ok {
+		panic(fmt.Sprintf("clienttest: fake of /%s/%s must be func(grpc.ServerStream) error", service, method))
+	}
+	return func(_ interface{}, stream grpc.ServerStream) error {
+		return handler(stream)
+	}
+}
+
+func hasMethod(desc *grpc.ServiceDesc, name string) bool {
+	for _, m := range desc.Methods {
+		if m.MethodName == name {
+			return true
+		}
+	}
+	for _, m := range desc.Streams {
+		if m.StreamName == name {
+			return true
+		}
+	}
+	return false
+}
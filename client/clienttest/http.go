@@ -0,0 +1,261 @@
+// Package clienttest provides mocks of HTTP and gRPC downstream services for
+// testing code calling them through resty and chiwoo.
+package clienttest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TB is the subset of testing.TB used by assertions.
+type TB interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+}
+
+// Transport is a mock http.RoundTripper serving canned responses of
+// expectations, e.g.
+//
+//	mock := clienttest.NewTransport()
+//	mock.On("GET", "/users/1").ReplyJSON(200, user)
+//	client := resty.New().SetRoundTripper(mock)
+//	...
+//	mock.AssertExpectations(t)
+type Transport struct {
+	mu           sync.Mutex
+	expectations []*Expectation
+	requests     []*http.Request
+}
+
+// NewTransport constructs a new mock transport.
+func NewTransport() *Transport {
+	return &Transport{}
+}
+
+// On adds an expectation of requests with method and path, expectations are
+// matched in order of adding.
+func (m *Transport) On(method, path string) *Expectation {
+	e := &Expectation{
+		method: strings.ToUpper(method),
+		path:   path,
+		query:  make(url.Values),
+		status: http.StatusOK,
+		header: make(http.Header),
+	}
+	m.mu.Lock()
+	m.expectations = append(m.expectations, e)
+	m.mu.Unlock()
+	return e
+}
+
+// RoundTrip implements http.RoundTripper interface, requests matching no
+// expectation fail with error.
+func (m *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = ioutil.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+	}
+
+	m.mu.Lock()
+	m.requests = append(m.requests, req)
+	var matched *Expectation
+	for _, e := range m.expectations {
+		if e.match(req, body) {
+			matched = e
+			e.calls++
+			break
+		}
+	}
+	m.mu.Unlock()
+
+	if matched == nil {
+		return nil, fmt.Errorf("clienttest: no expectation of %s %s", req.Method, req.URL)
+	}
+	if matched.delay > 0 {
+		t := time.NewTimer(matched.delay)
+		select {
+		case <-req.Context().Done():
+			t.Stop()
+			return nil, req.Context().Err()
+		case <-t.C:
+		}
+	}
+	if matched.err != nil {
+		return nil, matched.err
+	}
+	return matched.response(req), nil
+}
+
+// Requests returns requests received in order.
+func (m *Transport) Requests() []*http.Request {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*http.Request(nil), m.requests...)
+}
+
+// AssertExpectations asserts that all expectations are called as expected.
+func (m *Transport) AssertExpectations(t TB) bool {
+	t.Helper()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ok := true
+	for _, e := range m.expectations {
+		if e.times > 0 && e.calls != e.times || e.times == 0 && e.calls == 0 {
+			t.Errorf("clienttest: %s %s called %d times, expected %s", e.method, e.path, e.calls, e.expectedTimes())
+			ok = false
+		}
+	}
+	return ok
+}
+
+// Expectation is an expected request and its canned response.
+type Expectation struct {
+	method string
+	path   string
+	query  url.Values
+	body   []byte
+	json   interface{}
+
+	status int
+	header http.Header
+	reply  []byte
+	err    error
+	delay  time.Duration
+
+	times int
+	calls int
+}
+
+// WithQuery expects query parameter key of value.
+func (e *Expectation) WithQuery(key, value string) *Expectation {
+	e.query.Add(key, value)
+	return e
+}
+
+// WithBody expects request body.
+func (e *Expectation) WithBody(body string) *Expectation {
+	e.body = []byte(body)
+	return e
+}
+
+// WithJSONBody expects request body equal to v in JSON.
+func (e *Expectation) WithJSONBody(v interface{}) *Expectation {
+	e.json = v
+	return e
+}
+
+// Reply sets canned response of status and body.
+func (e *Expectation) Reply(status int, body string) *Expectation {
+	e.status = status
+	e.reply = []byte(body)
+	return e
+}
+
+// ReplyJSON sets canned response of status and v encoded in JSON.
+func (e *Expectation) ReplyJSON(status int, v interface{}) *Expectation {
+	body, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	e.header.Set("Content-Type", "application/json; charset=utf-8")
+	return e.Reply(status, string(body))
+}
+
+// ReplyHeader sets header of canned response.
+func (e *Expectation) ReplyHeader(key, value string) *Expectation {
+	e.header.Set(key, value)
+	return e
+}
+
+// ReplyError fails round trip with err, e.g. to mock network errors.
+func (e *Expectation) ReplyError(err error) *Expectation {
+	e.err = err
+	return e
+}
+
+// Delay delays response by d, or until the request is canceled.
+func (e *Expectation) Delay(d time.Duration) *Expectation {
+	e.delay = d
+	return e
+}
+
+// Times limits expectation to n calls, and AssertExpectations asserts
+// exactly n calls. Unlimited expectations are asserted to be called at least
+// once.
+func (e *Expectation) Times(n int) *Expectation {
+	e.times = n
+	return e
+}
+
+// Once is short for Times(1).
+func (e *Expectation) Once() *Expectation {
+	return e.Times(1)
+}
+
+func (e *Expectation) match(req *http.Request, body []byte) bool {
+	if e.times > 0 && e.calls >= e.times {
+		return false
+	}
+	if e.method != req.Method || e.path != req.URL.Path {
+		return false
+	}
+	query := req.URL.Query()
+	for k, vs := range e.query {
+		if !reflect.DeepEqual(query[k], vs) {
+			return false
+		}
+	}
+	if e.body != nil && !bytes.Equal(e.body, body) {
+		return false
+	}
+	if e.json != nil {
+		want, err := json.Marshal(e.json)
+		if err != nil {
+			return false
+		}
+		var got, expected interface{}
+		if json.Unmarshal(body, &got) != nil || json.Unmarshal(want, &expected) != nil {
+			return false
+		}
+		return reflect.DeepEqual(got, expected)
+	}
+	return true
+}
+
+func (e *Expectation) response(req *http.Request) *http.Response {
+	header := make(http.Header, len(e.header))
+	for k, v := range e.header {
+		header[k] = v
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", e.status, http.StatusText(e.status)),
+		StatusCode:    e.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(e.reply)),
+		ContentLength: int64(len(e.reply)),
+		Request:       req,
+	}
+}
+
+func (e *Expectation) expectedTimes() string {
+	if e.times == 0 {
+		return "at least once"
+	}
+	return fmt.Sprintf("%d times", e.times)
+}
@@ -0,0 +1,154 @@
+package resty
+
+import (
+	"hash/crc32"
+	"math/rand"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+)
+
+// Balancer picks an address of resolved service for request, done is
+// called after the request completes if it is not nil.
+type Balancer interface {
+	Pick(res Resolved, r *Request) (addr string, done func())
+}
+
+// NewRoundRobinBalancer returns a balancer picking addresses in turn.
+func NewRoundRobinBalancer() Balancer {
+	return &roundRobinBalancer{}
+}
+
+type roundRobinBalancer struct {
+	next uint32
+}
+
+func (b *roundRobinBalancer) Pick(res Resolved, r *Request) (string, func()) {
+	addrs := res.Addrs
+	n := atomic.AddUint32(&b.next, 1)
+	return addrs[int(n-1)%len(addrs)], nil
+}
+
+// NewRandomBalancer returns a balancer picking addresses randomly.
+func NewRandomBalancer() Balancer {
+	return randomBalancer{}
+}
+
+type randomBalancer struct{}
+
+func (randomBalancer) Pick(res Resolved, r *Request) (string, func()) {
+	return res.Addrs[rand.Intn(len(res.Addrs))], nil
+}
+
+// NewLeastPendingBalancer returns a balancer picking the address with
+// fewest pending requests.
+func NewLeastPendingBalancer() Balancer {
+	return &leastPendingBalancer{
+		pending: make(map[string]int),
+	}
+}
+
+type leastPendingBalancer struct {
+	mu      sync.Mutex
+	pending map[string]int
+}
+
+func (b *leastPendingBalancer) Pick(res Resolved, r *Request) (string, func()) {
+	addrs := res.Addrs
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	// start from a random address, so that ties are spread
+	offset := rand.Intn(len(addrs))
+	addr := addrs[offset]
+	for i := 1; i < len(addrs); i++ {
+		a := addrs[(offset+i)%len(addrs)]
+		if b.pending[a] < b.pending[addr] {
+			addr = a
+		}
+	}
+	b.pending[addr]++
+
+	return addr, func() {
+		b.mu.Lock()
+		if b.pending[addr]--; b.pending[addr] <= 0 {
+			delete(b.pending, addr)
+		}
+		b.mu.Unlock()
+	}
+}
+
+// NewConsistentHashBalancer returns a balancer picking addresses by the hash
+// key of request, see Request.SetHashKey. Requests without hash key are
+// picked randomly.
+func NewConsistentHashBalancer(replicas int) Balancer {
+	if replicas <= 0 {
+		replicas = 100
+	}
+	return &consistentHashBalancer{
+		replicas: replicas,
+		rings:    make(map[string]*hashRing),
+	}
+}
+
+type consistentHashBalancer struct {
+	replicas int
+
+	mu    sync.RWMutex
+	rings map[string]*hashRing // by label
+}
+
+// hashRing is built from addresses of a version.
+type hashRing struct {
+	version uint64
+	hashes  []uint32
+	nodes   map[uint32]string
+}
+
+func (b *consistentHashBalancer) Pick(res Resolved, r *Request) (string, func()) {
+	if r.hashKey == "" {
+		return res.Addrs[rand.Intn(len(res.Addrs))], nil
+	}
+
+	ring := b.ring(res)
+	h := crc32.ChecksumIEEE([]byte(r.hashKey))
+	i := sort.Search(len(ring.hashes), func(i int) bool { return ring.hashes[i] >= h })
+	if i == len(ring.hashes) {
+		i = 0
+	}
+	return ring.nodes[ring.hashes[i]], nil
+}
+
+// ring returns ring of resolved addresses, which is built once per version.
+func (b *consistentHashBalancer) ring(res Resolved) *hashRing {
+	if res.Version != 0 {
+		b.mu.RLock()
+		ring, ok := b.rings[res.Label]
+		b.mu.RUnlock()
+		if ok && ring.version == res.Version {
+			return ring
+		}
+	}
+
+	ring := &hashRing{
+		version: res.Version,
+		hashes:  make([]uint32, 0, len(res.Addrs)*b.replicas),
+		nodes:   make(map[uint32]string, len(res.Addrs)*b.replicas),
+	}
+	for _, addr := range res.Addrs {
+		for i := 0; i < b.replicas; i++ {
+			h := crc32.ChecksumIEEE([]byte(strconv.Itoa(i) + addr))
+			ring.hashes = append(ring.hashes, h)
+			ring.nodes[h] = addr
+		}
+	}
+	sort.Slice(ring.hashes, func(i, j int) bool { return ring.hashes[i] < ring.hashes[j] })
+
+	if res.Version != 0 {
+		b.mu.Lock()
+		b.rings[res.Label] = ring
+		b.mu.Unlock()
+	}
+	return ring
+}
@@ -21,6 +21,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/sevenNt/ares/plugin/backoff"
 	"github.com/sevenNt/wzap"
 )
 
@@ -83,6 +84,7 @@ type Client struct {
 	RetryWaitTime         time.Duration
 	RetryMaxWaitTime      time.Duration
 	RetryConditions       []RetryConditionFunc
+	RetryBackOff          func() backoff.BackOff
 	JSONMarshal           func(v interface{}) ([]byte, error)
 	JSONUnmarshal         func(data []byte, v interface{}) error
 
@@ -98,6 +100,11 @@ type Client struct {
 	udBeforeRequest  []func(*Client, *Request) error
 	preReqHook       func(*Client, *Request) error
 	afterResponse    []func(*Client, *Response) error
+	resolver         Resolver
+	balancer         Balancer
+	ejector          *ejector
+	middlewares      []Middleware
+	baseTransport    http.RoundTripper
 }
 
 // User type is to hold an username and password information
@@ -347,7 +354,7 @@ func (c *Client) OnAfterResponse(m func(*Client, *Response) error) *Client {
 // Note: Only one pre-request hook can be registered. Use `resty.OnBeforeRequest` for mutilple.
 func (c *Client) SetPreRequestHook(h func(*Client, *Request) error) *Client {
 	if c.preReqHook != nil {
-		log.Infof("Overwriting an existing pre-request hook: %s", functionName(h))
+		wzap.Infof("Overwriting an existing pre-request hook: %s", functionName(h))
 	}
 	c.preReqHook = h
 	return c
@@ -423,7 +430,7 @@ func (c *Client) SetError(err interface{}) *Client {
 func (c *Client) SetRedirectPolicy(policies ...interface{}) *Client {
 	for _, p := range policies {
 		if _, ok := p.(RedirectPolicy); !ok {
-			log.Warnf("ERORR: %v does not implement resty.RedirectPolicy (missing Apply method)",
+			wzap.Warnf("ERORR: %v does not implement resty.RedirectPolicy (missing Apply method)",
 				functionName(p))
 		}
 	}
@@ -463,6 +470,20 @@ func (c *Client) SetRetryMaxWaitTime(maxWaitTime time.Duration) *Client {
 	return c
 }
 
+// SetRetryBackOff method sets the backoff policy of retries, newBackOff is
+// called for each request and the request is retried until the policy stops,
+// e.g.
+//
+//		client.SetRetryBackOff(func() backoff.BackOff {
+//			return backoff.WithMaxRetries(backoff.NewExponentialBackOff(), 3)
+//		})
+//
+// It takes the place of retry count and wait times.
+func (c *Client) SetRetryBackOff(newBackOff func() backoff.BackOff) *Client {
+	c.RetryBackOff = newBackOff
+	return c
+}
+
 // AddRetryCondition method adds a retry condition function to array of functions
 // that are checked to determine if the request is retried. The request will
 // retry if any of the functions return true and error is nil.
@@ -536,7 +557,7 @@ func (c *Client) Mode() string {
 //
 func (c *Client) SetTLSClientConfig(config *tls.Config) *Client {
 	c.transport.TLSClientConfig = config
-	c.httpClient.Transport = c.transport
+	c.httpClient.Transport = c.roundTripper()
 	return c
 }
 
@@ -550,9 +571,9 @@ func (c *Client) SetProxy(proxyURL string) *Client {
 	if pURL, err := url.Parse(proxyURL); err == nil {
 		c.proxyURL = pURL
 		c.transport.Proxy = http.ProxyURL(c.proxyURL)
-		c.httpClient.Transport = c.transport
+		c.httpClient.Transport = c.roundTripper()
 	} else {
-		log.Errorf("ERROR [%v]", err)
+		wzap.Errorf("ERROR [%v]", err)
 		c.RemoveProxy()
 	}
 
@@ -565,7 +586,7 @@ func (c *Client) SetProxy(proxyURL string) *Client {
 func (c *Client) RemoveProxy() *Client {
 	c.proxyURL = nil
 	c.transport.Proxy = nil
-	c.httpClient.Transport = c.transport
+	c.httpClient.Transport = c.roundTripper()
 
 	return c
 }
@@ -584,7 +605,7 @@ func (c *Client) SetCertificates(certs ...tls.Certificate) *Client {
 func (c *Client) SetRootCertificate(pemFilePath string) *Client {
 	rootPemData, err := ioutil.ReadFile(pemFilePath)
 	if err != nil {
-		log.Errorf("ERROR [%v]", err)
+		wzap.Errorf("ERROR [%v]", err)
 		return c
 	}
 
@@ -606,7 +627,7 @@ func (c *Client) SetRootCertificate(pemFilePath string) *Client {
 func (c *Client) SetOutputDirectory(dirPath string) *Client {
 	err := createDirectory(dirPath)
 	if err != nil {
-		log.Errorf("ERROR [%v]", err)
+		wzap.Errorf("ERROR [%v]", err)
 	}
 
 	c.outputDirectory = dirPath
@@ -629,7 +650,8 @@ func (c *Client) SetOutputDirectory(dirPath string) *Client {
 func (c *Client) SetTransport(transport *http.Transport) *Client {
 	if transport != nil {
 		c.transport = transport
-		c.httpClient.Transport = c.transport
+		c.baseTransport = nil
+		c.httpClient.Transport = c.roundTripper()
 	}
 
 	return c
@@ -725,7 +747,7 @@ func (c *Client) execute(req *Request) (*Response, error) {
 func (c *Client) getTLSConfig() *tls.Config {
 	if c.transport.TLSClientConfig == nil {
 		c.transport.TLSClientConfig = &tls.Config{}
-		c.httpClient.Transport = c.transport
+		c.httpClient.Transport = c.roundTripper()
 	}
 	return c.transport.TLSClientConfig
 }
@@ -0,0 +1,132 @@
+package resty
+
+import (
+	"crypto/tls"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sevenNt/ares/plugin/tracer"
+	"github.com/sevenNt/hera"
+)
+
+// Balancer names of config.
+const (
+	BalancerRoundRobin     = "round_robin"
+	BalancerRandom         = "random"
+	BalancerLeastPending   = "least_pending"
+	BalancerConsistentHash = "consistent_hash"
+)
+
+// Config is the typed config of a client, which is loaded from
+// app.client.http.<name>, e.g.
+//
+//	[app.client.http.user]
+//	  host_url = "ares://user-api"
+//	  timeout = "1s"
+//	  retry_count = 2
+//	  balancer = "least_pending"
+//	  propagate = true
+//	  [app.client.http.user.headers]
+//	    X-Caller = "order"
+type Config struct {
+	HostURL            string
+	Timeout            time.Duration
+	Debug              bool
+	RetryCount         int
+	RetryWaitTime      time.Duration
+	RetryMaxWaitTime   time.Duration
+	Headers            map[string]string
+	Balancer           string
+	InsecureSkipVerify bool
+	// Propagate sends trace keys, deadline and caller identity of request
+	// context, only enable it for services trusted with them.
+	Propagate bool
+}
+
+// LoadConfig loads config of client name from hera.
+func LoadConfig(name string) Config {
+	key := "app.client.http." + name
+	config := Config{
+		HostURL:            hera.GetString(key + ".host_url"),
+		Timeout:            hera.GetDuration(key + ".timeout"),
+		Debug:              hera.GetBool(key + ".debug"),
+		RetryCount:         hera.GetInt(key + ".retry_count"),
+		RetryWaitTime:      hera.GetDuration(key + ".retry_wait_time"),
+		RetryMaxWaitTime:   hera.GetDuration(key + ".retry_max_wait_time"),
+		Headers:            make(map[string]string),
+		Balancer:           hera.GetString(key + ".balancer"),
+		InsecureSkipVerify: hera.GetBool(key + ".insecure_skip_verify"),
+		Propagate:          hera.GetBool(key + ".propagate"),
+	}
+	for k, v := range hera.GetStringMap(key + ".headers") {
+		config.Headers[k] = fmt.Sprint(v)
+	}
+	return config
+}
+
+// Build builds a new client of config, plugins are applied in order.
+func (config Config) Build(plugins ...Plugin) *Client {
+	c := New().
+		SetHostURL(config.HostURL).
+		SetDebug(config.Debug).
+		SetRetryCount(config.RetryCount).
+		SetHeaders(config.Headers)
+	if config.Timeout > 0 {
+		c.SetTimeout(config.Timeout)
+	}
+	if config.RetryWaitTime > 0 {
+		c.SetRetryWaitTime(config.RetryWaitTime)
+	}
+	if config.RetryMaxWaitTime > 0 {
+		c.SetRetryMaxWaitTime(config.RetryMaxWaitTime)
+	}
+	if config.InsecureSkipVerify {
+		c.SetTLSClientConfig(&tls.Config{InsecureSkipVerify: true})
+	}
+
+	switch config.Balancer {
+	case BalancerRandom:
+		c.SetBalancer(NewRandomBalancer())
+	case BalancerLeastPending:
+		c.SetBalancer(NewLeastPendingBalancer())
+	case BalancerConsistentHash:
+		c.SetBalancer(NewConsistentHashBalancer(0))
+	}
+	if config.Propagate {
+		c.UsePlugin(tracer.Default())
+	}
+	return c.UsePlugin(plugins...)
+}
+
+var named = struct {
+	sync.RWMutex
+	clients map[string]*Client
+}{clients: make(map[string]*Client)}
+
+// Named returns the client named name, which is built from config
+// app.client.http.<name> on first use.
+func Named(name string) *Client {
+	named.RLock()
+	c, ok := named.clients[name]
+	named.RUnlock()
+	if ok {
+		return c
+	}
+
+	named.Lock()
+	defer named.Unlock()
+	if c, ok = named.clients[name]; !ok {
+		c = LoadConfig(name).Build()
+		named.clients[name] = c
+	}
+	return c
+}
+
+// SetNamed registers c as the client named name, replacing the one built
+// from config.
+func SetNamed(name string, c *Client) {
+	named.Lock()
+	named.clients[name] = c
+	named.Unlock()
+}
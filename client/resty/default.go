@@ -8,13 +8,17 @@ import (
 	"net/url"
 	"time"
 
+	"github.com/sevenNt/ares/plugin/backoff"
 	"golang.org/x/net/publicsuffix"
 )
 
 // DefaultClient of resty
 var DefaultClient *Client
 
-// New method creates a new go-resty client
+// New method creates a new go-resty client. Trace keys, deadline and caller
+// identity of request context are not sent unless propagation is enabled,
+// e.g. c.UsePlugin(tracer.Default()) for clients of trusted services, see
+// `Request.SetContext`.
 func New() *Client {
 	cookieJar, _ := cookiejar.New(&cookiejar.Options{PublicSuffixList: publicsuffix.List})
 
@@ -34,9 +38,11 @@ func New() *Client {
 		JSONUnmarshal:    json.Unmarshal,
 		httpClient:       &http.Client{Jar: cookieJar},
 		transport:        &http.Transport{},
+		balancer:         NewRoundRobinBalancer(),
+		middlewares:      make([]Middleware, 0),
 	}
 
-	c.httpClient.Transport = c.transport
+	c.httpClient.Transport = c.roundTripper()
 
 	// Default redirect policy
 	c.SetRedirectPolicy(NoRedirectPolicy())
@@ -165,6 +171,11 @@ func SetRetryMaxWaitTime(maxWaitTime time.Duration) *Client {
 	return DefaultClient.SetRetryMaxWaitTime(maxWaitTime)
 }
 
+// SetRetryBackOff method sets the retry backoff policy. See `Client.SetRetryBackOff` for more information.
+func SetRetryBackOff(newBackOff func() backoff.BackOff) *Client {
+	return DefaultClient.SetRetryBackOff(newBackOff)
+}
+
 // AddRetryCondition method appends check function for retry. See `Client.AddRetryCondition` for more information.
 func AddRetryCondition(condition RetryConditionFunc) *Client {
 	return DefaultClient.AddRetryCondition(condition)
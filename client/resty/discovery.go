@@ -0,0 +1,310 @@
+package resty
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sevenNt/ares/registry"
+	"github.com/sevenNt/wzap"
+)
+
+// SchemeAres is the URL scheme of services discovered through registry,
+// e.g. "ares://user-svc:v1:prod/api/users" requests "/api/users" of
+// service "user-svc:v1:prod".
+const SchemeAres = "ares://"
+
+var (
+	// ErrNoAddress is returned when service has no available address.
+	ErrNoAddress = errors.New("resty: no available address")
+
+	defaultResolver     Resolver
+	defaultResolverOnce sync.Once
+)
+
+// Resolved are addresses of service resolved by Resolver.
+type Resolved struct {
+	Label string
+	// Version is changed once addresses of label change, so that balancers
+	// may reuse states built from the same addresses, e.g. hash rings.
+	// Addresses of zero version are never reused.
+	Version uint64
+	Addrs   []string
+}
+
+// Resolver resolves service label to addresses.
+type Resolver interface {
+	Resolve(label string) (Resolved, error)
+}
+
+// NewRegistryResolver returns a resolver watching HTTP services registered
+// by registry.RegisterApp, timeout is the max duration to wait for the
+// initial addresses of a service.
+func NewRegistryResolver(timeout time.Duration) *RegistryResolver {
+	return &RegistryResolver{
+		timeout:  timeout,
+		services: make(map[string]*serviceAddrs),
+	}
+}
+
+// RegistryResolver resolves services through registry Watcher.
+type RegistryResolver struct {
+	timeout time.Duration
+
+	mu       sync.Mutex
+	services map[string]*serviceAddrs
+}
+
+type serviceAddrs struct {
+	mu      sync.RWMutex
+	addrs   []string
+	version uint64
+	ready   chan struct{}
+	watcher registry.Watcher
+	stopped bool
+}
+
+// Resolve implements Resolver interface.
+func (r *RegistryResolver) Resolve(label string) (Resolved, error) {
+	r.mu.Lock()
+	s, ok := r.services[label]
+	if !ok {
+		s = &serviceAddrs{ready: make(chan struct{})}
+		r.services[label] = s
+		go s.watch("http:" + label)
+	}
+	r.mu.Unlock()
+
+	select {
+	case <-s.ready:
+	case <-time.After(r.timeout):
+		return Resolved{}, ErrNoAddress
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if len(s.addrs) == 0 {
+		return Resolved{}, ErrNoAddress
+	}
+	return Resolved{Label: label, Version: s.version, Addrs: s.addrs}, nil
+}
+
+// Close stops watching all services.
+func (r *RegistryResolver) Close() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for label, s := range r.services {
+		s.stop()
+		delete(r.services, label)
+	}
+}
+
+// watch keeps addresses of service up to date, and re-watches on errors.
+func (s *serviceAddrs) watch(service string) {
+	for {
+		s.mu.Lock()
+		if s.stopped {
+			s.mu.Unlock()
+			return
+		}
+		w, err := registry.Watch(registry.WatchService(service))
+		if err == nil {
+			s.watcher = w
+		}
+		s.mu.Unlock()
+
+		if err == nil {
+			err = s.consume(w)
+		}
+		if err == registry.ErrWatcherStopped {
+			return
+		}
+		wzap.Warnf("[resty] watch service %s failed: %v", service, err)
+		time.Sleep(time.Second)
+	}
+}
+
+func (s *serviceAddrs) consume(w registry.Watcher) error {
+	nodes := make(map[string]string)
+	for {
+		res, err := w.Next()
+		if err != nil {
+			return err
+		}
+		for _, node := range res.Service.Nodes {
+			if res.Action == "delete" {
+				delete(nodes, node.ID)
+			} else {
+				nodes[node.ID] = node.Address
+			}
+		}
+
+		addrs := make([]string, 0, len(nodes))
+		for _, addr := range nodes {
+			addrs = append(addrs, addr)
+		}
+		s.mu.Lock()
+		s.addrs = addrs
+		s.version++
+		s.mu.Unlock()
+
+		select {
+		case <-s.ready:
+		default:
+			close(s.ready)
+		}
+	}
+}
+
+func (s *serviceAddrs) stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stopped = true
+	if s.watcher != nil {
+		s.watcher.Stop()
+	}
+}
+
+// ejector ejects addresses after consecutive failures for a while.
+type ejector struct {
+	threshold int
+	duration  time.Duration
+
+	mu       sync.Mutex
+	failures map[string]int
+	ejected  map[string]time.Time
+}
+
+func newEjector(threshold int, duration time.Duration) *ejector {
+	return &ejector{
+		threshold: threshold,
+		duration:  duration,
+		failures:  make(map[string]int),
+		ejected:   make(map[string]time.Time),
+	}
+}
+
+// filter drops ejected addresses, all addresses are kept if all are ejected.
+func (e *ejector) filter(addrs []string) []string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if len(e.ejected) == 0 {
+		return addrs
+	}
+
+	now := time.Now()
+	available := make([]string, 0, len(addrs))
+	for _, addr := range addrs {
+		if until, ok := e.ejected[addr]; ok {
+			if now.Before(until) {
+				continue
+			}
+			delete(e.ejected, addr)
+		}
+		available = append(available, addr)
+	}
+	if len(available) == 0 {
+		return addrs
+	}
+	return available
+}
+
+func (e *ejector) report(addr string, failed bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if !failed {
+		delete(e.failures, addr)
+		return
+	}
+	if e.failures[addr]++; e.failures[addr] >= e.threshold {
+		delete(e.failures, addr)
+		e.ejected[addr] = time.Now().Add(e.duration)
+		wzap.Warnf("[resty] eject %s for %v", addr, e.duration)
+	}
+}
+
+// SetResolver method sets the resolver of "ares://" URLs, registry resolver
+// by default.
+func (c *Client) SetResolver(resolver Resolver) *Client {
+	c.resolver = resolver
+	return c
+}
+
+// SetBalancer method sets the balancer of "ares://" URLs, round-robin by default.
+func (c *Client) SetBalancer(balancer Balancer) *Client {
+	if balancer != nil {
+		c.balancer = balancer
+	}
+	return c
+}
+
+// SetEjection method ejects an address of "ares://" URLs for duration after
+// threshold consecutive failures, failures are transport errors and 5xx.
+func (c *Client) SetEjection(threshold int, duration time.Duration) *Client {
+	c.ejector = newEjector(threshold, duration)
+	return c
+}
+
+// SetHashKey method sets the key of consistent hash balancer.
+func (r *Request) SetHashKey(key string) *Request {
+	r.hashKey = key
+	return r
+}
+
+// resolve replaces "ares://<label>" of request URL, or of client host URL
+// for relative request URL, with an address picked by balancer.
+func (r *Request) resolve() (func(*Response, error), error) {
+	c := r.client
+	rawURL := r.URL
+	if !strings.HasPrefix(rawURL, SchemeAres) {
+		if strings.Contains(rawURL, "://") || !strings.HasPrefix(c.HostURL, SchemeAres) {
+			return nil, nil
+		}
+		rawURL = c.HostURL + "/" + strings.TrimLeft(rawURL, "/")
+	}
+
+	label := strings.TrimPrefix(rawURL, SchemeAres)
+	path := ""
+	if i := strings.IndexAny(label, "/?"); i >= 0 {
+		label, path = label[:i], label[i:]
+	}
+
+	resolver := c.resolver
+	if resolver == nil {
+		defaultResolverOnce.Do(func() {
+			defaultResolver = NewRegistryResolver(3 * time.Second)
+		})
+		resolver = defaultResolver
+	}
+	res, err := resolver.Resolve(label)
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Addrs) == 0 {
+		return nil, ErrNoAddress
+	}
+	if c.ejector != nil {
+		// addresses filtered by ejections are not versioned
+		if addrs := c.ejector.filter(res.Addrs); len(addrs) != len(res.Addrs) {
+			res.Addrs, res.Version = addrs, 0
+		}
+	}
+
+	addr, done := c.balancer.Pick(res, r)
+
+	scheme := c.scheme
+	if scheme == "" {
+		scheme = "http"
+	}
+	r.URL = scheme + "://" + addr + path
+
+	return func(resp *Response, err error) {
+		if done != nil {
+			done()
+		}
+		if c.ejector != nil {
+			c.ejector.report(addr, err != nil || (resp != nil && resp.RawResponse != nil && resp.StatusCode() >= 500))
+		}
+	}, nil
+}
@@ -0,0 +1,97 @@
+package resty
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+type staticResolver map[string][]string
+
+func (r staticResolver) Resolve(label string) (Resolved, error) {
+	addrs, ok := r[label]
+	if !ok {
+		return Resolved{}, ErrNoAddress
+	}
+	return Resolved{Label: label, Version: 1, Addrs: addrs}, nil
+}
+
+func TestResolveAresURL(t *testing.T) {
+	hits := make(map[string]int)
+	handler := func(name string, code int) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			hits[name+" "+r.URL.Path]++
+			w.WriteHeader(code)
+		})
+	}
+	ok := httptest.NewServer(handler("ok", http.StatusOK))
+	defer ok.Close()
+	bad := httptest.NewServer(handler("bad", http.StatusInternalServerError))
+	defer bad.Close()
+
+	c := New().
+		SetResolver(staticResolver{
+			"user-svc:v1:prod": {strings.TrimPrefix(ok.URL, "http://"), strings.TrimPrefix(bad.URL, "http://")},
+		}).
+		SetEjection(1, time.Minute)
+	for i := 0; i < 4; i++ {
+		if _, err := c.R().Get("ares://user-svc:v1:prod/api/users"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// bad address is ejected after its first failure
+	if hits["bad /api/users"] != 1 || hits["ok /api/users"] != 3 {
+		t.Fatalf("hits = %v", hits)
+	}
+
+	c.SetHostURL("ares://user-svc:v1:prod")
+	if _, err := c.R().Get("/api/groups"); err != nil {
+		t.Fatal(err)
+	}
+	if hits["ok /api/groups"] != 1 {
+		t.Fatalf("hits = %v", hits)
+	}
+
+	if _, err := c.R().Get("ares://unknown/api"); err != ErrNoAddress {
+		t.Fatalf("err = %v, want no address", err)
+	}
+}
+
+func TestConsistentHashBalancer(t *testing.T) {
+	b := NewConsistentHashBalancer(0)
+	r := New().R().SetHashKey("user-1")
+	addr, _ := b.Pick(Resolved{Addrs: []string{"a:80", "b:80", "c:80"}}, r)
+	for i := 0; i < 10; i++ {
+		if got, _ := b.Pick(Resolved{Addrs: []string{"c:80", "a:80", "b:80"}}, r); got != addr {
+			t.Fatalf("pick = %s, want %s", got, addr)
+		}
+	}
+
+	// rings are rebuilt only once version of label changes
+	b.Pick(Resolved{Label: "svc", Version: 1, Addrs: []string{"a:80"}}, r)
+	if got, _ := b.Pick(Resolved{Label: "svc", Version: 1, Addrs: []string{"b:80"}}, r); got != "a:80" {
+		t.Fatalf("pick of the same version = %s, want a:80", got)
+	}
+	if got, _ := b.Pick(Resolved{Label: "other", Version: 1, Addrs: []string{"c:80"}}, r); got != "c:80" {
+		t.Fatalf("pick of other label = %s, want c:80", got)
+	}
+	if got, _ := b.Pick(Resolved{Label: "svc", Version: 2, Addrs: []string{"b:80"}}, r); got != "b:80" {
+		t.Fatalf("pick of new version = %s, want b:80", got)
+	}
+}
+
+func TestLeastPendingBalancer(t *testing.T) {
+	b := NewLeastPendingBalancer()
+	addrs := Resolved{Addrs: []string{"a:80", "b:80"}}
+	first, done := b.Pick(addrs, nil)
+	second, _ := b.Pick(addrs, nil)
+	if first == second {
+		t.Fatalf("picked %s twice", first)
+	}
+	done()
+	if third, _ := b.Pick(addrs, nil); third != first {
+		t.Fatalf("pick = %s, want %s", third, first)
+	}
+}
@@ -169,7 +169,7 @@ func addCredentials(c *Client, r *Request) error {
 
 	if !c.DisableWarn {
 		if isBasicAuth && !strings.HasPrefix(r.URL, "https") {
-			log.Warn("WARNING - Using Basic Auth in HTTP mode is not secure.")
+			wzap.Warn("WARNING - Using Basic Auth in HTTP mode is not secure.")
 		}
 	}
 
@@ -186,15 +186,15 @@ func addCredentials(c *Client, r *Request) error {
 func requestLogger(c *Client, r *Request) error {
 	if c.Debug {
 		rr := r.RawRequest
-		log.Debug("---------------------- REQUEST LOG -----------------------")
-		log.Debugf("%s  %s  %s\n", r.Method, rr.URL.RequestURI(), rr.Proto)
-		log.Debugf("HOST   : %s", rr.URL.Host)
-		log.Debug("HEADERS:")
+		wzap.Debug("---------------------- REQUEST LOG -----------------------")
+		wzap.Debugf("%s  %s  %s\n", r.Method, rr.URL.RequestURI(), rr.Proto)
+		wzap.Debugf("HOST   : %s", rr.URL.Host)
+		wzap.Debug("HEADERS:")
 		for h, v := range rr.Header {
-			log.Debugf("%10s: %v", h, strings.Join(v, ", "))
+			wzap.Debugf("%10s: %v", h, strings.Join(v, ", "))
 		}
-		log.Debugf("BODY   :\n%v", r.fmtBodyString())
-		log.Debug("----------------------------------------------------------")
+		wzap.Debugf("BODY   :\n%v", r.fmtBodyString())
+		wzap.Debug("----------------------------------------------------------")
 	}
 
 	return nil
@@ -206,20 +206,20 @@ func requestLogger(c *Client, r *Request) error {
 
 func responseLogger(c *Client, res *Response) error {
 	if c.Debug {
-		log.Debug("---------------------- RESPONSE LOG -----------------------")
-		log.Debugf("STATUS: %s", res.Status())
-		log.Debugf("RECEAT: %v", res.ReceivedAt())
-		log.Debugf("RESPAT: %v", res.Time())
-		log.Debug("HEADERS:")
+		wzap.Debug("---------------------- RESPONSE LOG -----------------------")
+		wzap.Debugf("STATUS: %s", res.Status())
+		wzap.Debugf("RECEAT: %v", res.ReceivedAt())
+		wzap.Debugf("RESPAT: %v", res.Time())
+		wzap.Debug("HEADERS:")
 		for h, v := range res.Header() {
-			log.Debugf("%10s: %v", h, strings.Join(v, ", "))
+			wzap.Debugf("%10s: %v", h, strings.Join(v, ", "))
 		}
 		if res.Request.isSaveResponse {
-			log.Debugf("BODY   :\n***** RESPONSE WRITTEN INTO FILE *****")
+			wzap.Debugf("BODY   :\n***** RESPONSE WRITTEN INTO FILE *****")
 		} else {
-			log.Debugf("BODY   :\n%v", res.fmtBodyString())
+			wzap.Debugf("BODY   :\n%v", res.fmtBodyString())
 		}
-		log.Debug("----------------------------------------------------------")
+		wzap.Debug("----------------------------------------------------------")
 	}
 
 	return nil
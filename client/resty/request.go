@@ -47,6 +47,7 @@ type Request struct {
 	outputFile       string
 	multipartFiles   []*File
 	ctx              context.Context
+	hashKey          string
 }
 
 // SetContext method sets the context.Context for current Request. It allows
@@ -173,7 +174,7 @@ func (r *Request) SetQueryString(query string) *Request {
 			r.QueryParam.Add(k, values.Get(k))
 		}
 	} else {
-		log.Errorf("ERROR [%v]", err)
+		wzap.Errorf("ERROR [%v]", err)
 	}
 	return r
 }
@@ -461,8 +462,18 @@ func (r *Request) Execute(method, url string) (*Response, error) {
 	r.Method = method
 	r.URL = r.selectAddr(addrs, url, 0)
 
-	if r.client.RetryCount == 0 {
-		return r.client.execute(r)
+	if r.client.RetryCount == 0 && r.client.RetryBackOff == nil {
+		return r.execute()
+	}
+
+	retryOptions := []Option{
+		Retries(r.client.RetryCount),
+		WaitTime(r.client.RetryWaitTime),
+		MaxWaitTime(r.client.RetryMaxWaitTime),
+		RetryConditions(r.client.RetryConditions),
+	}
+	if r.client.RetryBackOff != nil {
+		retryOptions = append(retryOptions, BackOffPolicy(r.client.RetryBackOff()))
 	}
 
 	var resp *Response
@@ -473,9 +484,9 @@ func (r *Request) Execute(method, url string) (*Response, error) {
 
 			r.URL = r.selectAddr(addrs, url, attempt)
 
-			resp, err = r.client.execute(r)
+			resp, err = r.execute()
 			if err != nil {
-				log.Errorf("ERROR [%v] Attempt [%v]", err, attempt)
+				wzap.Errorf("ERROR [%v] Attempt [%v]", err, attempt)
 				if r.isContextCancelledIfAvailable() {
 					// stop Backoff from retrying request if request has been
 					// canceled by context
@@ -485,15 +496,25 @@ func (r *Request) Execute(method, url string) (*Response, error) {
 
 			return resp, err
 		},
-		Retries(r.client.RetryCount),
-		WaitTime(r.client.RetryWaitTime),
-		MaxWaitTime(r.client.RetryMaxWaitTime),
-		RetryConditions(r.client.RetryConditions),
+		retryOptions...,
 	)
 
 	return resp, err
 }
 
+// execute resolves service address of request if needed, and executes it.
+func (r *Request) execute() (*Response, error) {
+	done, err := r.resolve()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := r.client.execute(r)
+	if done != nil {
+		done(resp, err)
+	}
+	return resp, err
+}
+
 func (r *Request) fmtBodyString() (body string) {
 	body = "***** NO CONTENT *****"
 	if isPayloadSupported(r.Method, r.client.AllowGetMethodPayload) {
@@ -4,6 +4,8 @@ import (
 	"math"
 	"math/rand"
 	"time"
+
+	"github.com/sevenNt/ares/plugin/backoff"
 )
 
 const (
@@ -25,6 +27,7 @@ type (
 		waitTime        time.Duration
 		maxWaitTime     time.Duration
 		retryConditions []RetryConditionFunc
+		backOff         backoff.BackOff
 	}
 )
 
@@ -56,6 +59,14 @@ func RetryConditions(conditions []RetryConditionFunc) Option {
 	}
 }
 
+// BackOffPolicy sets the backoff policy between requests, requests are
+// retried until the policy stops regardless of max retries and wait times.
+func BackOffPolicy(b backoff.BackOff) Option {
+	return func(o *Options) {
+		o.backOff = b
+	}
+}
+
 // Backoff retries with increasing timeout duration up until X amount of retries
 // (Default is 3 attempts, Override with option Retries(n))
 func Backoff(operation func() (*Response, error), options ...Option) error {
@@ -77,22 +88,22 @@ func Backoff(operation func() (*Response, error), options ...Option) error {
 	)
 	base := float64(opts.waitTime)        // Time to wait between each attempt
 	capLevel := float64(opts.maxWaitTime) // Maximum amount of wait time for the retry
-	for attempt := 0; attempt < opts.maxRetries; attempt++ {
+	if opts.backOff != nil {
+		opts.backOff.Reset()
+	}
+	for attempt := 0; opts.backOff != nil || attempt < opts.maxRetries; attempt++ {
 		resp, err = operation()
-
-		var needsRetry bool
-		var conditionErr error
-		for _, condition := range opts.retryConditions {
-			needsRetry, conditionErr = condition(resp)
-			if needsRetry || conditionErr != nil {
-				break
-			}
+		if !needsRetry(resp, err, opts.retryConditions) {
+			return nil
 		}
 
-		// If the operation returned no error, there was no condition satisfied and
-		// there was no error caused by the conditional functions.
-		if err == nil && !needsRetry && conditionErr == nil {
-			return nil
+		if opts.backOff != nil {
+			next := opts.backOff.Next()
+			if next == backoff.Stop {
+				return err
+			}
+			time.Sleep(next)
+			continue
 		}
 		// Adding capped exponential backup with jitter
 		// See the following article...
@@ -108,3 +119,20 @@ func Backoff(operation func() (*Response, error), options ...Option) error {
 
 	return err
 }
+
+// needsRetry reports whether the operation failed or any condition asks for
+// retry.
+func needsRetry(resp *Response, err error, conditions []RetryConditionFunc) bool {
+	var needsRetry bool
+	var conditionErr error
+	for _, condition := range conditions {
+		needsRetry, conditionErr = condition(resp)
+		if needsRetry || conditionErr != nil {
+			break
+		}
+	}
+
+	// If the operation returned no error, there was no condition satisfied and
+	// there was no error caused by the conditional functions.
+	return err != nil || needsRetry || conditionErr != nil
+}
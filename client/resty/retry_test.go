@@ -0,0 +1,22 @@
+package resty
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/sevenNt/ares/plugin/backoff"
+)
+
+func TestBackoffPolicy(t *testing.T) {
+	attempts := 0
+	err := Backoff(func() (*Response, error) {
+		attempts++
+		return nil, errors.New("failed")
+	}, BackOffPolicy(backoff.WithMaxRetries(&backoff.ZeroBackOff{}, 4)))
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if attempts != 5 {
+		t.Fatalf("attempts = %d, want 5", attempts)
+	}
+}
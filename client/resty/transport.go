@@ -0,0 +1,94 @@
+package resty
+
+import (
+	"net/http"
+
+	"github.com/sevenNt/ares/plugin"
+)
+
+type (
+	// Middleware wraps the round tripper of client, e.g. for tracing,
+	// metrics, circuit breaking, retries and authentication.
+	Middleware func(next http.RoundTripper) http.RoundTripper
+
+	// Plugin is a client plugin intercepting round trips.
+	Plugin interface {
+		RoundTripper(next http.RoundTripper) http.RoundTripper
+		Label() string
+	}
+
+	// RoundTripperFunc is an adapter to allow the use of ordinary functions
+	// as http.RoundTripper, see plugin.RoundTripperFunc.
+	RoundTripperFunc = plugin.RoundTripperFunc
+)
+
+// Use method appends middlewares of client transport, the first one is the
+// outermost. It should be called before sending requests.
+//
+//	client.Use(breaker.Default().RoundTripper)
+func (c *Client) Use(middlewares ...Middleware) *Client {
+	c.middlewares = append(c.middlewares, middlewares...)
+	c.httpClient.Transport = c.roundTripper()
+	return c
+}
+
+// UsePlugin method appends plugins of client transport, see `Client.Use`.
+func (c *Client) UsePlugin(plugins ...Plugin) *Client {
+	for _, p := range plugins {
+		c.Use(p.RoundTripper)
+	}
+	return c
+}
+
+// SetRoundTripper method replaces the underlying transport of client with
+// rt, e.g. mocks of clienttest, middlewares are kept around it. Transport
+// settings like TLS and proxy no longer take effect.
+func (c *Client) SetRoundTripper(rt http.RoundTripper) *Client {
+	c.baseTransport = rt
+	c.httpClient.Transport = c.roundTripper()
+	return c
+}
+
+// roundTripper chains middlewares around transport.
+func (c *Client) roundTripper() http.RoundTripper {
+	var rt http.RoundTripper = c.transport
+	if c.baseTransport != nil {
+		rt = c.baseTransport
+	}
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		rt = c.middlewares[i](rt)
+	}
+	return rt
+}
+
+// BearerAuth returns middleware setting bearer token of requests from
+// source, e.g. tokens refreshed by OAuth2, requests with Authorization
+// header are left untouched.
+func BearerAuth(source func() (string, error)) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			if req.Header.Get(hdrAuthorizationKey) != "" {
+				return next.RoundTrip(req)
+			}
+			token, err := source()
+			if err != nil {
+				return nil, err
+			}
+			req = cloneRequest(req)
+			req.Header.Set(hdrAuthorizationKey, "Bearer "+token)
+			return next.RoundTrip(req)
+		})
+	}
+}
+
+// cloneRequest returns a shallow copy of req with a copy of header, since
+// round trippers must not modify requests.
+func cloneRequest(req *http.Request) *http.Request {
+	r := new(http.Request)
+	*r = *req
+	r.Header = make(http.Header, len(req.Header))
+	for k, v := range req.Header {
+		r.Header[k] = append([]string(nil), v...)
+	}
+	return r
+}
@@ -0,0 +1,53 @@
+package resty
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestMiddlewareChain(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Order", r.Header.Get("X-Order"))
+		w.Header().Set("X-Auth", r.Header.Get("Authorization"))
+	}))
+	defer srv.Close()
+
+	order := func(name string) Middleware {
+		return func(next http.RoundTripper) http.RoundTripper {
+			return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				req = cloneRequest(req)
+				req.Header.Set("X-Order", req.Header.Get("X-Order")+name)
+				return next.RoundTrip(req)
+			})
+		}
+	}
+	c := New().Use(order("a"), order("b"), BearerAuth(func() (string, error) { return "token", nil }))
+
+	resp, err := c.R().Get(srv.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if order := resp.Header().Get("X-Order"); order != "ab" {
+		t.Errorf("order = %q, want ab", order)
+	}
+	if auth := resp.Header().Get("X-Auth"); auth != "Bearer token" {
+		t.Errorf("auth = %q", auth)
+	}
+}
+
+func TestConfigBuild(t *testing.T) {
+	c := Config{
+		HostURL:  "http://127.0.0.1:8080",
+		Headers:  map[string]string{"X-Caller": "test"},
+		Balancer: BalancerLeastPending,
+	}.Build()
+	if c.HostURL != "http://127.0.0.1:8080" || c.Header.Get("X-Caller") != "test" {
+		t.Fatalf("config not applied: %s %v", c.HostURL, c.Header)
+	}
+
+	SetNamed("test", c)
+	if Named("test") != c {
+		t.Fatal("named client not registered")
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"io"
 	"io/ioutil"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/ugorji/go/codec"
 )
 
@@ -132,15 +133,33 @@ func (c *MsgpackCodec) Decode(r io.Reader, v interface{}) error {
 type ProtobufCodec struct {
 }
 
-func NewPBCodec() *XMLCodec {
-	return &XMLCodec{}
+func NewPBCodec() *ProtobufCodec {
+	return &ProtobufCodec{}
 }
 
 func (c *ProtobufCodec) Encode(w io.Writer, v interface{}) error {
-	return nil
+	m, ok := v.(proto.Message)
+	if !ok {
+		return fmt.Errorf("codec: %T is not proto.Message", v)
+	}
+	bs, err := proto.Marshal(m)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(bs)
+	return err
 }
+
 func (c *ProtobufCodec) Decode(r io.Reader, v interface{}) error {
-	return nil
+	m, ok := v.(proto.Message)
+	if !ok {
+		return fmt.Errorf("codec: %T is not proto.Message", v)
+	}
+	bs, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	return proto.Unmarshal(bs, m)
 }
 
 type HTMLEncoder struct {
@@ -0,0 +1,62 @@
+package codec
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/any"
+)
+
+func TestProtobufCodec(t *testing.T) {
+	c, ok := Get("application/x-protobuf; charset=utf-8")
+	if !ok {
+		t.Fatal("protobuf codec is not registered")
+	}
+
+	in := &any.Any{TypeUrl: "type.googleapis.com/test", Value: []byte("v")}
+	var buf bytes.Buffer
+	if err := c.Encode(&buf, in); err != nil {
+		t.Fatal(err)
+	}
+	out := new(any.Any)
+	if err := c.Decode(&buf, out); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(in, out) {
+		t.Errorf("decoded %v, want %v", out, in)
+	}
+
+	if err := c.Encode(&buf, struct{}{}); err == nil {
+		t.Error("encoding non proto message should fail")
+	}
+}
+
+func TestRegister(t *testing.T) {
+	Register("Application/CBOR", NewJSONCodec())
+	if _, ok := Get("application/cbor"); !ok {
+		t.Error("codec is not registered")
+	}
+	mimes := MIMEs()
+	if mimes[0] != "application/json" || mimes[len(mimes)-1] != "application/cbor" {
+		t.Errorf("mimes = %v", mimes)
+	}
+}
+
+func TestRegisterEncoder(t *testing.T) {
+	if _, ok := Get("text/plain"); ok {
+		t.Error("text/plain is registered for decoding")
+	}
+	if _, ok := GetEncoder("text/plain"); !ok {
+		t.Error("text/plain is not registered for negotiation")
+	}
+
+	Register("application/vnd.test", NewJSONCodec())
+	RegisterEncoder("application/vnd.test", NewStringCodec())
+	if _, ok := Get("application/vnd.test"); ok {
+		t.Error("codec is not replaced by encoder")
+	}
+	if _, ok := GetEncoder("application/vnd.test"); !ok {
+		t.Error("encoder is not registered")
+	}
+}
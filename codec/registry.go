@@ -0,0 +1,91 @@
+package codec
+
+import (
+	"strings"
+	"sync"
+)
+
+var registry = struct {
+	sync.RWMutex
+	codecs   map[string]Codec
+	encoders map[string]Encoder
+	mimes    []string
+}{
+	codecs:   make(map[string]Codec),
+	encoders: make(map[string]Encoder),
+}
+
+func init() {
+	Register("application/json", NewJSONCodec())
+	Register("application/xml", NewXMLCodec())
+	Register("text/xml", NewXMLCodec())
+	Register("application/msgpack", NewMsgpackCodec())
+	Register("application/x-msgpack", NewMsgpackCodec())
+	Register("application/protobuf", NewPBCodec())
+	Register("application/x-protobuf", NewPBCodec())
+	// StringCodec does not decode, text/plain bodies are bound as forms
+	RegisterEncoder("text/plain", NewStringCodec())
+}
+
+// Register registers codec c of MIME type, e.g. application/cbor, which
+// replaces codec registered before. Registered codecs are used by HTTP
+// servers for both request binding and content negotiation.
+func Register(mime string, c Codec) {
+	mime = normalize(mime)
+	registry.Lock()
+	defer registry.Unlock()
+	register(mime, c)
+	registry.codecs[mime] = c
+}
+
+// RegisterEncoder registers encoder e of MIME type for content negotiation
+// only, which replaces codec or encoder registered before.
+func RegisterEncoder(mime string, e Encoder) {
+	mime = normalize(mime)
+	registry.Lock()
+	defer registry.Unlock()
+	register(mime, e)
+	delete(registry.codecs, mime)
+}
+
+func register(mime string, e Encoder) {
+	if _, ok := registry.encoders[mime]; !ok {
+		registry.mimes = append(registry.mimes, mime)
+	}
+	registry.encoders[mime] = e
+}
+
+// Get returns codec of MIME type, parameters like charset are ignored.
+// Encoders registered by RegisterEncoder are not returned.
+func Get(mime string) (Codec, bool) {
+	mime = normalize(mime)
+	registry.RLock()
+	defer registry.RUnlock()
+	c, ok := registry.codecs[mime]
+	return c, ok
+}
+
+// GetEncoder returns encoder of MIME type registered by Register or
+// RegisterEncoder, parameters like charset are ignored.
+func GetEncoder(mime string) (Encoder, bool) {
+	mime = normalize(mime)
+	registry.RLock()
+	defer registry.RUnlock()
+	e, ok := registry.encoders[mime]
+	return e, ok
+}
+
+// MIMEs returns MIME types of registered codecs and encoders in order of
+// registration.
+func MIMEs() []string {
+	registry.RLock()
+	defer registry.RUnlock()
+	return append([]string(nil), registry.mimes...)
+}
+
+func normalize(mime string) string {
+	if i := strings.IndexByte(mime, ';'); i >= 0 {
+		mime = mime[:i]
+	}
+	return strings.ToLower(strings.TrimSpace(mime))
+}
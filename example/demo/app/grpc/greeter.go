@@ -3,7 +3,7 @@ package grpc
 import (
 	"strings"
 
-	"github.com/sevenNt/ares/server/echo/client"
+	"github.com/sevenNt/ares/client/resty"
 	"github.com/sevenNt/ares/server/yell"
 	"github.com/sevenNt/wzap"
 	"golang.org/x/net/context"
@@ -17,7 +17,7 @@ type Greeter struct {
 }
 
 var (
-	c = client.SetDebug(true).SetHostURL("http://127.0.0.1:18090")
+	c = resty.SetDebug(true).SetHostURL("http://127.0.0.1:18090")
 )
 
 // SayHello implements helloworld.GreeterServer
@@ -34,5 +34,10 @@ type ConstantBackOff struct {
 	Interval time.Duration
 }
 
+// NewConstantBackOff creates a constant backoff of interval d.
+func NewConstantBackOff(d time.Duration) *ConstantBackOff {
+	return &ConstantBackOff{Interval: d}
+}
+
 func (b *ConstantBackOff) Reset()              {}
 func (b *ConstantBackOff) Next() time.Duration { return b.Interval }
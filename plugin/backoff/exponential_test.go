@@ -0,0 +1,85 @@
+package backoff
+
+import (
+	"testing"
+	"time"
+)
+
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time { return c.now }
+
+func TestExponentialBackOff(t *testing.T) {
+	clock := &testClock{now: time.Unix(0, 0)}
+	b := &ExponentialBackOff{
+		InitialInterval:     500 * time.Millisecond,
+		RandomizationFactor: 0.1,
+		Multiplier:          2,
+		MaxInterval:         5 * time.Second,
+		MaxElapsedTime:      time.Minute,
+		Clock:               clock,
+	}
+	b.Reset()
+
+	expected := []time.Duration{500, 1000, 2000, 4000, 5000, 5000}
+	for _, e := range expected {
+		e *= time.Millisecond
+		min, max := e-e/10, e+e/10
+		if next := b.Next(); next < min || next > max {
+			t.Errorf("next = %v, want between %v and %v", next, min, max)
+		}
+	}
+
+	clock.now = clock.now.Add(time.Minute + time.Second)
+	if next := b.Next(); next != Stop {
+		t.Errorf("next = %v, want stop after max elapsed time", next)
+	}
+
+	b.Reset()
+	if next := b.Next(); next == Stop {
+		t.Error("next = stop after reset")
+	}
+}
+
+func TestJitterBackOff(t *testing.T) {
+	base, max := 10*time.Millisecond, 100*time.Millisecond
+	full := NewFullJitterBackOff(base, max)
+	decorrelated := NewDecorrelatedJitterBackOff(base, max)
+	for i := 0; i < 100; i++ {
+		if next := full.Next(); next < 0 || next > max {
+			t.Fatalf("full jitter next = %v", next)
+		}
+		if next := decorrelated.Next(); next < base || next > max {
+			t.Fatalf("decorrelated jitter next = %v", next)
+		}
+	}
+}
+
+func TestWithMaxRetries(t *testing.T) {
+	b := WithMaxRetries(&ZeroBackOff{}, 2)
+	for i := 0; i < 2; i++ {
+		if next := b.Next(); next != 0 {
+			t.Fatalf("next = %v, want 0", next)
+		}
+	}
+	if next := b.Next(); next != Stop {
+		t.Fatalf("next = %v, want stop", next)
+	}
+	b.Reset()
+	if next := b.Next(); next != 0 {
+		t.Fatalf("next = %v after reset, want 0", next)
+	}
+}
+
+func TestTicker(t *testing.T) {
+	ticker := NewTicker(WithMaxRetries(NewConstantBackOff(time.Millisecond), 2))
+	ticks := 0
+	for range ticker.C {
+		ticks++
+	}
+	if ticks != 3 {
+		t.Fatalf("ticks = %d, want 3", ticks)
+	}
+}
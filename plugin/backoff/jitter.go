@@ -0,0 +1,71 @@
+package backoff
+
+import (
+	"math/rand"
+	"time"
+)
+
+// FullJitterBackOff sleeps a random duration between zero and the capped
+// exponential interval, i.e. random(0, min(Max, Base * 2^attempt)).
+// See https://www.awsarchitectureblog.com/2015/03/backoff.html.
+type FullJitterBackOff struct {
+	Base time.Duration
+	Max  time.Duration
+
+	attempt uint
+}
+
+// NewFullJitterBackOff creates a full jitter backoff between base and max.
+func NewFullJitterBackOff(base, max time.Duration) *FullJitterBackOff {
+	return &FullJitterBackOff{Base: base, Max: max}
+}
+
+func (b *FullJitterBackOff) Reset() { b.attempt = 0 }
+
+func (b *FullJitterBackOff) Next() time.Duration {
+	interval := b.Max
+	// guards against overflow of large attempts
+	if b.attempt < 32 {
+		if d := b.Base << b.attempt; d > 0 && d < b.Max {
+			interval = d
+		}
+	}
+	b.attempt++
+	if interval <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(interval) + 1))
+}
+
+// DecorrelatedJitterBackOff grows the interval based on the previous one,
+// i.e. min(Max, random(Base, previous * 3)).
+// See https://www.awsarchitectureblog.com/2015/03/backoff.html.
+type DecorrelatedJitterBackOff struct {
+	Base time.Duration
+	Max  time.Duration
+
+	previous time.Duration
+}
+
+// NewDecorrelatedJitterBackOff creates a decorrelated jitter backoff between base and max.
+func NewDecorrelatedJitterBackOff(base, max time.Duration) *DecorrelatedJitterBackOff {
+	return &DecorrelatedJitterBackOff{Base: base, Max: max}
+}
+
+func (b *DecorrelatedJitterBackOff) Reset() { b.previous = 0 }
+
+func (b *DecorrelatedJitterBackOff) Next() time.Duration {
+	if b.previous < b.Base {
+		b.previous = b.Base
+	}
+	upper := b.previous * 3
+	if upper > b.Max || upper <= 0 {
+		upper = b.Max
+	}
+	next := b.Base
+	if upper > b.Base {
+		next += time.Duration(rand.Int63n(int64(upper - b.Base)))
+	}
+	b.previous = next
+	return next
+}
@@ -2,79 +2,210 @@ package breaker
 
 import (
 	"context"
+	"net/http"
+	"sync"
+	"time"
 
-	"github.com/afex/hystrix-go/hystrix"
+	"github.com/sevenNt/ares/plugin"
+	"github.com/sevenNt/ares/plugin/backoff"
 	"github.com/sevenNt/ares/server/echo"
 	"github.com/sevenNt/ares/server/yell"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
-type TripFunc func(*Breaker) bool
+// Breaker keeps a circuit per target, e.g. gRPC method or HTTP host.
 type Breaker struct {
 	Options
+
+	mu       sync.RWMutex
+	circuits map[string]*Circuit
 }
 
 func New(opts ...Option) *Breaker {
-	var options Options
+	options := Options{
+		newBackOff: func() backoff.BackOff {
+			return &backoff.ConstantBackOff{Interval: 5 * time.Second}
+		},
+		shouldTrip:    RateTripFunc(0.5, 20),
+		windowTime:    10 * time.Second,
+		windowBuckets: 10,
+		httpTarget: func(c *echo.Context) string {
+			return c.Request().Host
+		},
+		isFailure: isFailure,
+	}
 	for _, opt := range opts {
 		opt(&options)
 	}
 	return &Breaker{
-		Options: options,
+		Options:  options,
+		circuits: make(map[string]*Circuit),
 	}
 }
 
+// Circuit returns the circuit of target.
+func (b *Breaker) Circuit(target string) *Circuit {
+	b.mu.RLock()
+	c, ok := b.circuits[target]
+	b.mu.RUnlock()
+	if ok {
+		return c
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if c, ok = b.circuits[target]; !ok {
+		c = newCircuit(target, &b.Options)
+		b.circuits[target] = c
+	}
+	return c
+}
+
+// Stats returns metrics by target.
+func (b *Breaker) Stats() map[string]Stats {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	stats := make(map[string]Stats, len(b.circuits))
+	for target, c := range b.circuits {
+		stats[target] = c.Stats()
+	}
+	return stats
+}
+
+// Do calls fn through the circuit of target, errors of fn count as failures.
+func (b *Breaker) Do(target string, fn func() error) error {
+	c := b.Circuit(target)
+	if !c.Allow() {
+		return ErrOpen
+	}
+	err := fn()
+	if err != nil {
+		c.Failure()
+	} else {
+		c.Success()
+	}
+	return err
+}
+
 // Func implements Middleware interface.
 func (b *Breaker) Func() echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c *echo.Context) (err error) {
-			hystrix.Do(b.label, func() (err error) {
-				return next(c)
-			}, func(err error) error {
-				return c.Status(echo.StatusTooManyRequests)
-			})
-			return nil
+			circuit := b.Circuit(b.httpTarget(c))
+			if !circuit.Allow() {
+				return c.String(echo.StatusServiceUnavailable, "circuit break")
+			}
+
+			err = next(c)
+			if err != nil || c.Response().Status() >= echo.StatusInternalServerError {
+				circuit.Failure()
+			} else {
+				circuit.Success()
+			}
+			return err
 		}
 	}
 }
 
+// RoundTripper wraps HTTP client transport with circuits by host.
+func (b *Breaker) RoundTripper(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return plugin.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		circuit := b.Circuit(req.URL.Host)
+		if !circuit.Allow() {
+			return nil, ErrOpen
+		}
+
+		resp, err := next.RoundTrip(req)
+		if err != nil || resp.StatusCode >= http.StatusInternalServerError {
+			circuit.Failure()
+		} else {
+			circuit.Success()
+		}
+		return resp, err
+	})
+}
+
 func (b *Breaker) UnaryClientIntercept() grpc.UnaryClientInterceptor {
 	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
-		return hystrix.Do(b.label, func() (err error) {
-			return invoker(ctx, method, req, reply, cc, opts...)
-		}, func(err error) error {
-			return status.Errorf(yell.CodeCircuitBreak, "circuit break, %s", err)
-		})
+		circuit := b.Circuit(method)
+		if !circuit.Allow() {
+			return status.Errorf(yell.CodeCircuitBreak, "circuit break, %s", ErrOpen)
+		}
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		b.record(circuit, err)
+		return err
 	}
 }
 
 func (b *Breaker) StreamClientIntercept() grpc.StreamClientInterceptor {
 	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (cs grpc.ClientStream, err error) {
-		err = hystrix.Do(b.label, func() (err error) {
-			cs, err = streamer(ctx, desc, cc, method, opts...)
-			return
-		}, func(err error) error {
-			return status.Errorf(yell.CodeCircuitBreak, "circuit break, %s", err)
-		})
+		circuit := b.Circuit(method)
+		if !circuit.Allow() {
+			return nil, status.Errorf(yell.CodeCircuitBreak, "circuit break, %s", ErrOpen)
+		}
+
+		cs, err = streamer(ctx, desc, cc, method, opts...)
+		b.record(circuit, err)
 		return
 	}
 }
 
 // UnaryServerIntercept implements gRPC unary server interceptor interface
-//func (r *Breaker) UnaryServerIntercept() grpc.UnaryServerInterceptor {
-//	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
-//		return handler(ctx, req)
-//	}
-//}
+func (b *Breaker) UnaryServerIntercept() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		circuit := b.Circuit(info.FullMethod)
+		if !circuit.Allow() {
+			return nil, status.Errorf(yell.CodeCircuitBreak, "circuit break, %s", ErrOpen)
+		}
+
+		resp, err = handler(ctx, req)
+		b.record(circuit, err)
+		return
+	}
+}
 
 // StreamServerIntercept implements gRPC stream server interceptor interface
-//func (r *Breaker) StreamServerIntercept() grpc.StreamServerInterceptor {
-//	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
-//		return handler(srv, ss)
-//	}
-//}
+func (b *Breaker) StreamServerIntercept() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		circuit := b.Circuit(info.FullMethod)
+		if !circuit.Allow() {
+			return status.Errorf(yell.CodeCircuitBreak, "circuit break, %s", ErrOpen)
+		}
+
+		err := handler(srv, ss)
+		b.record(circuit, err)
+		return err
+	}
+}
 
 func (b *Breaker) Label() string {
+	if b.label != "" {
+		return b.label
+	}
 	return "breaker"
 }
+
+func (b *Breaker) record(circuit *Circuit, err error) {
+	if err != nil && b.isFailure(err) {
+		circuit.Failure()
+	} else {
+		circuit.Success()
+	}
+}
+
+// isFailure reports whether err is caused by server rather than request.
+func isFailure(err error) bool {
+	s, _ := status.FromError(err)
+	switch s.Code() {
+	case codes.Unknown, codes.DeadlineExceeded, codes.ResourceExhausted,
+		codes.Internal, codes.Unavailable, codes.DataLoss, yell.CodeTooManyRequest:
+		return true
+	}
+	return false
+}
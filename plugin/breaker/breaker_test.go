@@ -0,0 +1,111 @@
+package breaker
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sevenNt/ares/plugin/backoff"
+	"github.com/sevenNt/ares/server/echo"
+)
+
+var errTest = errors.New("test")
+
+func TestCircuit(t *testing.T) {
+	var changes []State
+	b := New(
+		ShouldTrip(ThresholdTripFunc(2)),
+		BackOff(func() backoff.BackOff {
+			return &backoff.ConstantBackOff{Interval: 20 * time.Millisecond}
+		}),
+		OnStateChange(func(target string, from, to State) {
+			changes = append(changes, to)
+		}),
+	)
+	fail := func() error { return errTest }
+	succ := func() error { return nil }
+
+	for i := 0; i < 2; i++ {
+		if err := b.Do("a", fail); err != errTest {
+			t.Fatalf("call %d err = %v", i, err)
+		}
+	}
+	if err := b.Do("a", succ); err != ErrOpen {
+		t.Fatalf("err = %v, want circuit open", err)
+	}
+	if err := b.Do("b", succ); err != nil {
+		t.Fatalf("other target err = %v", err)
+	}
+
+	// failed probe opens the circuit again
+	time.Sleep(25 * time.Millisecond)
+	if err := b.Do("a", fail); err != errTest {
+		t.Fatalf("probe err = %v", err)
+	}
+	if b.Circuit("a").State() != StateOpen {
+		t.Fatalf("state = %v, want open", b.Circuit("a").State())
+	}
+
+	time.Sleep(25 * time.Millisecond)
+	if err := b.Do("a", succ); err != nil {
+		t.Fatalf("probe err = %v", err)
+	}
+	if b.Circuit("a").State() != StateClosed {
+		t.Fatalf("state = %v, want closed", b.Circuit("a").State())
+	}
+
+	want := []State{StateOpen, StateHalfOpen, StateOpen, StateHalfOpen, StateClosed}
+	if len(changes) != len(want) {
+		t.Fatalf("changes = %v, want %v", changes, want)
+	}
+	for i := range want {
+		if changes[i] != want[i] {
+			t.Fatalf("changes = %v, want %v", changes, want)
+		}
+	}
+
+	stats := b.Stats()["a"]
+	if stats.Trips != 2 || stats.Rejects != 1 || stats.Failures != 3 || stats.Successes != 1 {
+		t.Fatalf("stats = %+v", stats)
+	}
+}
+
+func TestRateTripFunc(t *testing.T) {
+	trip := RateTripFunc(0.5, 4)
+	if trip(Counts{Successes: 1, Failures: 2}) {
+		t.Fatal("tripped before min samples")
+	}
+	if !trip(Counts{Successes: 2, Failures: 2}) {
+		t.Fatal("not tripped at error rate")
+	}
+	if trip(Counts{Successes: 3, Failures: 2}) {
+		t.Fatal("tripped below error rate")
+	}
+}
+
+func TestBreaker_Func(t *testing.T) {
+	b := New(ShouldTrip(ThresholdTripFunc(1)))
+	s := echo.NewServer(nil)
+	s.GET("/", func(c *echo.Context) error {
+		return errTest
+	}, b)
+
+	var handled error
+	s.SetErrHandler(func(c *echo.Context) error {
+		handled = errTest
+		return c.String(http.StatusInternalServerError, "error")
+	})
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest("GET", "/", nil))
+	if handled != errTest {
+		t.Fatal("handler error swallowed")
+	}
+
+	rec = httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest("GET", "/", nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("code = %d, want 503", rec.Code)
+	}
+}
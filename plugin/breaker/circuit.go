@@ -0,0 +1,221 @@
+package breaker
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/sevenNt/ares/plugin/backoff"
+)
+
+// ErrOpen is returned when the circuit rejects a call.
+var ErrOpen = errors.New("[breaker] circuit open")
+
+// State is circuit state.
+type State int
+
+const (
+	// StateClosed lets calls pass and counts their results.
+	StateClosed State = iota
+	// StateOpen rejects calls until the backoff elapses.
+	StateOpen
+	// StateHalfOpen lets a probe call pass to decide whether to close.
+	StateHalfOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case StateClosed:
+		return "closed"
+	case StateOpen:
+		return "open"
+	case StateHalfOpen:
+		return "half-open"
+	}
+	return "unknown"
+}
+
+// Counts are the results counted in the sliding window.
+type Counts struct {
+	Successes           int64
+	Failures            int64
+	ConsecutiveFailures int64
+}
+
+// ErrorRate returns the failure rate of the window.
+func (c Counts) ErrorRate() float64 {
+	total := c.Successes + c.Failures
+	if total == 0 {
+		return 0
+	}
+	return float64(c.Failures) / float64(total)
+}
+
+// Stats are the metrics of a circuit.
+type Stats struct {
+	State     State
+	Successes uint64 // total successful calls
+	Failures  uint64 // total failed calls
+	Rejects   uint64 // total rejected calls
+	Trips     uint64 // total times the circuit opened
+}
+
+// Circuit is the breaker of a single target.
+type Circuit struct {
+	target string
+	opts   *Options
+
+	mu       sync.Mutex
+	state    State
+	window   *window
+	consec   int64
+	backoff  backoff.BackOff
+	openedAt time.Time
+	openFor  time.Duration
+	probeAt  time.Time
+	probing  bool
+	stats    Stats
+}
+
+func newCircuit(target string, opts *Options) *Circuit {
+	return &Circuit{
+		target:  target,
+		opts:    opts,
+		window:  newWindow(opts.windowTime, opts.windowBuckets, time.Now()),
+		backoff: opts.newBackOff(),
+	}
+}
+
+// Target returns circuit target.
+func (c *Circuit) Target() string {
+	return c.target
+}
+
+// State returns current circuit state.
+func (c *Circuit) State() State {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.state
+}
+
+// Stats returns circuit metrics.
+func (c *Circuit) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	stats := c.stats
+	stats.State = c.state
+	return stats
+}
+
+// Allow reports whether a call may pass, every allowed call must be
+// followed by Success or Failure.
+func (c *Circuit) Allow() bool {
+	c.mu.Lock()
+	now := time.Now()
+	from := c.state
+	if c.state == StateOpen && c.openFor >= 0 && now.Sub(c.openedAt) >= c.openFor {
+		c.state = StateHalfOpen
+		c.probing = false
+	}
+
+	allowed := true
+	switch c.state {
+	case StateOpen:
+		allowed = false
+	case StateHalfOpen:
+		// only one probe at a time, unless the probe gets lost
+		if c.probing && now.Sub(c.probeAt) < c.opts.windowTime {
+			allowed = false
+		} else {
+			c.probing = true
+			c.probeAt = now
+		}
+	}
+	if !allowed {
+		c.stats.Rejects++
+	}
+	to := c.state
+	c.mu.Unlock()
+
+	c.notify(from, to)
+	return allowed
+}
+
+// Success records a successful call.
+func (c *Circuit) Success() {
+	c.mu.Lock()
+	now := time.Now()
+	from := c.state
+	c.stats.Successes++
+	c.consec = 0
+	if c.state == StateHalfOpen {
+		c.close(now)
+	} else {
+		c.window.success(now)
+	}
+	to := c.state
+	c.mu.Unlock()
+
+	c.notify(from, to)
+}
+
+// Failure records a failed call.
+func (c *Circuit) Failure() {
+	c.mu.Lock()
+	now := time.Now()
+	from := c.state
+	c.stats.Failures++
+	c.consec++
+	switch c.state {
+	case StateHalfOpen:
+		c.open(now)
+	case StateClosed:
+		c.window.failure(now)
+		successes, failures := c.window.counts(now)
+		if c.opts.shouldTrip(Counts{
+			Successes:           successes,
+			Failures:            failures,
+			ConsecutiveFailures: c.consec,
+		}) {
+			c.open(now)
+		}
+	}
+	to := c.state
+	c.mu.Unlock()
+
+	c.notify(from, to)
+}
+
+// Reset closes the circuit.
+func (c *Circuit) Reset() {
+	c.mu.Lock()
+	from := c.state
+	c.close(time.Now())
+	c.mu.Unlock()
+
+	c.notify(from, StateClosed)
+}
+
+// open opens the circuit for the next backoff, backoff.Stop keeps it
+// open until Reset.
+func (c *Circuit) open(now time.Time) {
+	c.state = StateOpen
+	c.openedAt = now
+	c.openFor = c.backoff.Next()
+	c.probing = false
+	c.stats.Trips++
+}
+
+func (c *Circuit) close(now time.Time) {
+	c.state = StateClosed
+	c.consec = 0
+	c.probing = false
+	c.backoff.Reset()
+	c.window.reset(now)
+}
+
+func (c *Circuit) notify(from, to State) {
+	if from != to && c.opts.onStateChange != nil {
+		c.opts.onStateChange(c.target, from, to)
+	}
+}
@@ -1,34 +1,88 @@
 package breaker
 
+import (
+	"time"
+
+	"github.com/sevenNt/ares/plugin/backoff"
+	"github.com/sevenNt/ares/server/echo"
+)
+
+// TripFunc decides whether to open the circuit after a failure.
+type TripFunc func(Counts) bool
+
+// ThresholdTripFunc trips after threshold consecutive failures.
+func ThresholdTripFunc(threshold int64) TripFunc {
+	return func(counts Counts) bool {
+		return counts.ConsecutiveFailures >= threshold
+	}
+}
+
+// RateTripFunc trips once the error rate of the window reaches rate,
+// after at least minSamples calls.
+func RateTripFunc(rate float64, minSamples int64) TripFunc {
+	return func(counts Counts) bool {
+		return counts.Successes+counts.Failures >= minSamples && counts.ErrorRate() >= rate
+	}
+}
+
 type Options struct {
-	//backoff       backoff.BackOff
-	//clock         clock.Clock
-	//shouldTrip    TripFunc
-	//windowTime    time.Duration
-	//windowBuckets int
-	label string
+	newBackOff    func() backoff.BackOff
+	shouldTrip    TripFunc
+	windowTime    time.Duration
+	windowBuckets int
+	label         string
+	onStateChange func(target string, from, to State)
+	httpTarget    func(*echo.Context) string
+	isFailure     func(error) bool
 }
 
 type Option func(*Options)
 
-//func BackOff(backoff backoff.BackOff) *Option {
-//return func(opts *Options) {
-//opts.backoff = backoff
-//}
-//}
-
-//func ShouldTrip(shouldTrip TripFunc) *Option {
-//return func(opts *Options) {
-//opts.shouldTrip = shouldTrip
-//}
-//}
-
-//func Window(dura time.Duration, buckets int) *Option {
-//return func(opts *Options) {
-//opts.windowTime = dura
-//opts.windowBuckets = buckets
-//}
-//}
+// BackOff sets the backoff of open circuits before probing, a new backoff
+// is made for each circuit. Defaults to constant 5s.
+func BackOff(newBackOff func() backoff.BackOff) Option {
+	return func(opts *Options) {
+		opts.newBackOff = newBackOff
+	}
+}
+
+// ShouldTrip sets trip policy, defaults to 50% error rate of at least 20 calls.
+func ShouldTrip(shouldTrip TripFunc) Option {
+	return func(opts *Options) {
+		opts.shouldTrip = shouldTrip
+	}
+}
+
+// Window sets the sliding window, defaults to 10s of 10 buckets.
+func Window(dura time.Duration, buckets int) Option {
+	return func(opts *Options) {
+		opts.windowTime = dura
+		opts.windowBuckets = buckets
+	}
+}
+
+// OnStateChange sets callback on circuit state change.
+func OnStateChange(fn func(target string, from, to State)) Option {
+	return func(opts *Options) {
+		opts.onStateChange = fn
+	}
+}
+
+// HTTPTarget sets the circuit target of HTTP requests, defaults to host.
+func HTTPTarget(fn func(*echo.Context) string) Option {
+	return func(opts *Options) {
+		opts.httpTarget = fn
+	}
+}
+
+// IsFailure sets whether a gRPC error counts as failure, defaults to
+// errors with server-side codes, e.g. Unavailable or DeadlineExceeded.
+func IsFailure(fn func(error) bool) Option {
+	return func(opts *Options) {
+		opts.isFailure = fn
+	}
+}
+
 func Label(label string) Option {
 	return func(opts *Options) {
 		opts.label = label
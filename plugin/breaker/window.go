@@ -0,0 +1,70 @@
+package breaker
+
+import "time"
+
+type bucket struct {
+	successes int64
+	failures  int64
+}
+
+// window counts results in a sliding window of buckets.
+type window struct {
+	buckets    []bucket
+	bucketTime time.Duration
+	idx        int
+	last       time.Time // start time of current bucket
+}
+
+func newWindow(d time.Duration, buckets int, now time.Time) *window {
+	if buckets <= 0 {
+		buckets = 1
+	}
+	return &window{
+		buckets:    make([]bucket, buckets),
+		bucketTime: d / time.Duration(buckets),
+		last:       now,
+	}
+}
+
+// current rotates stale buckets out and returns the current bucket.
+func (w *window) current(now time.Time) *bucket {
+	if w.bucketTime <= 0 {
+		return &w.buckets[w.idx]
+	}
+	n := int(now.Sub(w.last) / w.bucketTime)
+	if n > len(w.buckets) {
+		n = len(w.buckets)
+	}
+	for i := 0; i < n; i++ {
+		w.idx = (w.idx + 1) % len(w.buckets)
+		w.buckets[w.idx] = bucket{}
+	}
+	if n > 0 {
+		w.last = now.Add(-now.Sub(w.last) % w.bucketTime)
+	}
+	return &w.buckets[w.idx]
+}
+
+func (w *window) success(now time.Time) {
+	w.current(now).successes++
+}
+
+func (w *window) failure(now time.Time) {
+	w.current(now).failures++
+}
+
+func (w *window) counts(now time.Time) (successes, failures int64) {
+	w.current(now)
+	for _, b := range w.buckets {
+		successes += b.successes
+		failures += b.failures
+	}
+	return
+}
+
+func (w *window) reset(now time.Time) {
+	for i := range w.buckets {
+		w.buckets[i] = bucket{}
+	}
+	w.last = now
+}
@@ -0,0 +1,195 @@
+package concurrency
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sevenNt/ares/server/echo"
+	"github.com/sevenNt/ares/server/yell"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Priority classes.
+const (
+	PriorityCritical  = "critical"
+	PriorityNormal    = "normal"
+	PrioritySheddable = "sheddable"
+)
+
+const (
+	// HeaderPriority is the default header/metadata key of the priority class.
+	HeaderPriority = "X-Priority"
+	// MetadataRetryPushback is the gRPC trailer key of the retry hint.
+	MetadataRetryPushback = "grpc-retry-pushback-ms"
+)
+
+// Limiter sheds load once inflight requests exceed an adaptive concurrency limit.
+type Limiter struct {
+	Options
+
+	mu       sync.Mutex
+	inflight int
+}
+
+// New constructs a new concurrency limiter.
+func New(opts ...Option) *Limiter {
+	options := Options{
+		priorityKey:     HeaderPriority,
+		defaultPriority: PriorityNormal,
+		priorities: map[string]float64{
+			PriorityCritical:  1.0,
+			PriorityNormal:    0.9,
+			PrioritySheddable: 0.5,
+		},
+		retryAfter: time.Second,
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if options.limit == nil {
+		options.limit = NewAIMDLimit(20)
+	}
+	return &Limiter{
+		Options: options,
+	}
+}
+
+// Default gets default concurrency limiter.
+func Default() *Limiter {
+	return New()
+}
+
+// Token is an admitted request slot, it must be released exactly once.
+type Token struct {
+	limiter  *Limiter
+	beg      time.Time
+	inflight int
+}
+
+// Release releases the slot and samples the request into the limit.
+func (t *Token) Release(dropped bool) {
+	t.limiter.release()
+	t.limiter.limit.OnSample(time.Since(t.beg), t.inflight, dropped)
+}
+
+// Ignore releases the slot without sampling, e.g. for long-lived streams.
+func (t *Token) Ignore() {
+	t.limiter.release()
+}
+
+// Acquire tries to admit a request of the priority class.
+func (l *Limiter) Acquire(class string) (*Token, bool) {
+	share, ok := l.priorities[class]
+	if !ok {
+		share = l.priorities[l.defaultPriority]
+	}
+
+	max := int(math.Ceil(share * float64(l.limit.Limit())))
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.inflight >= max {
+		return nil, false
+	}
+	l.inflight++
+	return &Token{limiter: l, beg: time.Now(), inflight: l.inflight}, true
+}
+
+// Inflight returns the number of admitted requests.
+func (l *Limiter) Inflight() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.inflight
+}
+
+func (l *Limiter) release() {
+	l.mu.Lock()
+	l.inflight--
+	l.mu.Unlock()
+}
+
+// Func implements HTTP Middleware interface.
+func (l *Limiter) Func() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c *echo.Context) (err error) {
+			token, ok := l.Acquire(c.Request().Header().Get(l.priorityKey))
+			if !ok {
+				c.Response().Header().Set(echo.HeaderRetryAfter, strconv.Itoa(int(math.Ceil(l.retryAfter.Seconds()))))
+				return c.String(echo.StatusTooManyRequests, "too many requests")
+			}
+
+			// panics recovered by outer plugins are samples of drops
+			dropped := true
+			defer func() { token.Release(dropped) }()
+			err = next(c)
+			dropped = err != nil || c.Response().Status() >= echo.StatusInternalServerError
+			return
+		}
+	}
+}
+
+// UnaryServerIntercept implements gRPC unary server interceptor interface
+func (l *Limiter) UnaryServerIntercept() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		token, ok := l.Acquire(l.grpcPriority(ctx))
+		if !ok {
+			grpc.SetTrailer(ctx, l.pushback())
+			return nil, status.Errorf(yell.CodeTooManyRequest, "too many request")
+		}
+
+		// panics recovered by outer plugins are samples of drops
+		dropped := true
+		defer func() { token.Release(dropped) }()
+		resp, err = handler(ctx, req)
+		dropped = isDropped(err)
+		return
+	}
+}
+
+// StreamServerIntercept implements gRPC stream server interceptor interface
+func (l *Limiter) StreamServerIntercept() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		token, ok := l.Acquire(l.grpcPriority(ss.Context()))
+		if !ok {
+			ss.SetTrailer(l.pushback())
+			return status.Errorf(yell.CodeTooManyRequest, "too many request")
+		}
+		defer token.Ignore()
+		return handler(srv, ss)
+	}
+}
+
+// Label implements plugin interface.
+func (l *Limiter) Label() string {
+	if l.label != "" {
+		return l.label
+	}
+	return "concurrency"
+}
+
+func (l *Limiter) grpcPriority(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if vs := md[strings.ToLower(l.priorityKey)]; len(vs) > 0 {
+			return vs[0]
+		}
+	}
+	return ""
+}
+
+func (l *Limiter) pushback() metadata.MD {
+	return metadata.Pairs(MetadataRetryPushback, strconv.FormatInt(int64(l.retryAfter/time.Millisecond), 10))
+}
+
+func isDropped(err error) bool {
+	switch status.Code(err) {
+	case codes.DeadlineExceeded, codes.Unavailable, codes.ResourceExhausted, yell.CodeTooManyRequest:
+		return true
+	}
+	return false
+}
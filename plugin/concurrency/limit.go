@@ -0,0 +1,131 @@
+package concurrency
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// Limit is an adaptive concurrency limit algorithm.
+type Limit interface {
+	// Limit returns the current concurrency limit.
+	Limit() int
+	// OnSample updates the limit with a finished request's round trip time,
+	// the inflight number when it started and whether it was dropped.
+	OnSample(rtt time.Duration, inflight int, dropped bool)
+}
+
+// AIMDLimit increases the limit additively while requests succeed and
+// decreases it multiplicatively once a request is dropped or times out.
+type AIMDLimit struct {
+	MinLimit     int
+	MaxLimit     int
+	BackoffRatio float64       // multiplicative decrease ratio, in (0, 1)
+	Timeout      time.Duration // rtt above timeout counts as a drop, 0 disables
+
+	mu    sync.Mutex
+	limit float64
+}
+
+// NewAIMDLimit constructs an AIMD limit starting from initial.
+func NewAIMDLimit(initial int) *AIMDLimit {
+	return &AIMDLimit{
+		MinLimit:     1,
+		MaxLimit:     1000,
+		BackoffRatio: 0.9,
+		limit:        float64(initial),
+	}
+}
+
+// Limit implements Limit interface.
+func (l *AIMDLimit) Limit() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return int(l.limit)
+}
+
+// OnSample implements Limit interface.
+func (l *AIMDLimit) OnSample(rtt time.Duration, inflight int, dropped bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if dropped || (l.Timeout > 0 && rtt > l.Timeout) {
+		l.limit *= l.BackoffRatio
+	} else if float64(inflight)*2 >= l.limit {
+		// only grow while the limit is actually being used
+		l.limit++
+	}
+	l.limit = clamp(l.limit, l.MinLimit, l.MaxLimit)
+}
+
+// GradientLimit adjusts the limit by the gradient between the minimum
+// observed rtt and the current rtt, so the limit shrinks once latency
+// starts to grow because of queueing.
+type GradientLimit struct {
+	MinLimit      int
+	MaxLimit      int
+	Smoothing     float64 // weight of the new estimate, in (0, 1]
+	Tolerance     float64 // rtt growth tolerated before the limit shrinks
+	ProbeInterval int     // samples between resetting the minimum rtt
+
+	mu      sync.Mutex
+	limit   float64
+	minRTT  time.Duration
+	samples int
+}
+
+// NewGradientLimit constructs a gradient limit starting from initial.
+func NewGradientLimit(initial int) *GradientLimit {
+	return &GradientLimit{
+		MinLimit:      1,
+		MaxLimit:      1000,
+		Smoothing:     0.2,
+		Tolerance:     1.5,
+		ProbeInterval: 1000,
+		limit:         float64(initial),
+	}
+}
+
+// Limit implements Limit interface.
+func (l *GradientLimit) Limit() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return int(l.limit)
+}
+
+// OnSample implements Limit interface.
+func (l *GradientLimit) OnSample(rtt time.Duration, inflight int, dropped bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.samples++
+	if l.minRTT == 0 || rtt < l.minRTT || (l.ProbeInterval > 0 && l.samples%l.ProbeInterval == 0) {
+		l.minRTT = rtt
+	}
+
+	if dropped {
+		l.limit = clamp(l.limit/2, l.MinLimit, l.MaxLimit)
+		return
+	}
+
+	gradient := 1.0
+	if rtt > 0 {
+		gradient = math.Max(0.5, math.Min(1.0, l.Tolerance*float64(l.minRTT)/float64(rtt)))
+	}
+	estimate := l.limit*gradient + math.Sqrt(l.limit)
+	if estimate > l.limit && float64(inflight) < l.limit/2 {
+		// app-limited, the sample tells nothing about a higher limit
+		return
+	}
+	l.limit = clamp((1-l.Smoothing)*l.limit+l.Smoothing*estimate, l.MinLimit, l.MaxLimit)
+}
+
+func clamp(limit float64, min, max int) float64 {
+	if max > 0 && limit > float64(max) {
+		return float64(max)
+	}
+	if limit < float64(min) {
+		return float64(min)
+	}
+	return limit
+}
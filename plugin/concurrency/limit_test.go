@@ -0,0 +1,78 @@
+package concurrency
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+func TestAIMDLimit(t *testing.T) {
+	l := NewAIMDLimit(10)
+	l.OnSample(time.Millisecond, 6, false)
+	if l.Limit() != 11 {
+		t.Fatalf("limit = %d, want 11", l.Limit())
+	}
+	l.OnSample(time.Millisecond, 1, false)
+	if l.Limit() != 11 {
+		t.Fatalf("app-limited sample grew limit to %d", l.Limit())
+	}
+	l.OnSample(time.Millisecond, 6, true)
+	if l.Limit() != 9 {
+		t.Fatalf("limit = %d, want 9", l.Limit())
+	}
+}
+
+func TestLimiterPriority(t *testing.T) {
+	l := New(Algorithm(NewAIMDLimit(10)))
+
+	var tokens []*Token
+	for i := 0; i < 5; i++ {
+		token, ok := l.Acquire(PrioritySheddable)
+		if !ok {
+			t.Fatalf("sheddable request %d rejected", i)
+		}
+		tokens = append(tokens, token)
+	}
+	if _, ok := l.Acquire(PrioritySheddable); ok {
+		t.Fatal("sheddable request admitted over its share")
+	}
+	for i := 0; i < 4; i++ {
+		token, ok := l.Acquire("")
+		if !ok {
+			t.Fatalf("normal request %d rejected", i)
+		}
+		tokens = append(tokens, token)
+	}
+	if _, ok := l.Acquire(PriorityNormal); ok {
+		t.Fatal("normal request admitted over its share")
+	}
+	token, ok := l.Acquire(PriorityCritical)
+	if !ok {
+		t.Fatal("critical request rejected")
+	}
+	tokens = append(tokens, token)
+
+	for _, token := range tokens {
+		token.Ignore()
+	}
+	if l.Inflight() != 0 {
+		t.Fatalf("inflight = %d, want 0", l.Inflight())
+	}
+}
+
+func TestLimiterPanic(t *testing.T) {
+	l := New(Algorithm(NewAIMDLimit(10)))
+	intercept := l.UnaryServerIntercept()
+	func() {
+		// recovered like by outer recovery plugins
+		defer func() { recover() }()
+		intercept(context.Background(), nil, &grpc.UnaryServerInfo{}, func(context.Context, interface{}) (interface{}, error) {
+			panic("boom")
+		})
+	}()
+	if l.Inflight() != 0 {
+		t.Fatalf("inflight = %d after panic, want 0", l.Inflight())
+	}
+}
@@ -0,0 +1,59 @@
+package concurrency
+
+import "time"
+
+// Option concurrency limiter option func.
+type Option func(*Options)
+
+// Options concurrency limiter options.
+type Options struct {
+	label           string
+	limit           Limit
+	priorityKey     string             // header/metadata key carrying the priority class
+	defaultPriority string             // class used when the request carries none
+	priorities      map[string]float64 // class -> share of the limit it may use
+	retryAfter      time.Duration
+}
+
+// Label sets limiter label.
+func Label(label string) Option {
+	return func(opts *Options) {
+		opts.label = label
+	}
+}
+
+// Algorithm sets the adaptive limit algorithm, AIMD by default.
+func Algorithm(limit Limit) Option {
+	return func(opts *Options) {
+		opts.limit = limit
+	}
+}
+
+// PriorityKey sets the HTTP header or gRPC metadata key of the priority class.
+func PriorityKey(key string) Option {
+	return func(opts *Options) {
+		opts.priorityKey = key
+	}
+}
+
+// DefaultPriority sets the class of requests without priority.
+func DefaultPriority(class string) Option {
+	return func(opts *Options) {
+		opts.defaultPriority = class
+	}
+}
+
+// Priority sets the share of the limit which requests of class may use.
+// Lower classes get shed first once the server is saturated.
+func Priority(class string, share float64) Option {
+	return func(opts *Options) {
+		opts.priorities[class] = share
+	}
+}
+
+// RetryAfter sets the retry hint returned with rejected requests.
+func RetryAfter(d time.Duration) Option {
+	return func(opts *Options) {
+		opts.retryAfter = d
+	}
+}
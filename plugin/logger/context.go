@@ -0,0 +1,33 @@
+package logger
+
+import (
+	"context"
+)
+
+// MetadataRequestID is default gRPC metadata key carrying request id.
+const MetadataRequestID = "x-request-id"
+
+type requestIDKey struct{}
+
+// WithRequestID returns context carrying request id.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestID returns request id of context, which is set by logger plugin.
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// KVs prepends request id of context to key-values, so that business logs
+// carry the same id as access log, e.g.
+//
+//	wzap.Info("order created", logger.KVs(ctx, "order", id)...)
+func KVs(ctx context.Context, kvs ...interface{}) []interface{} {
+	id := RequestID(ctx)
+	if id == "" {
+		return kvs
+	}
+	return append([]interface{}{"request_id", id}, kvs...)
+}
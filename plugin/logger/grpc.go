@@ -5,13 +5,18 @@ import (
 )
 
 type GRPCAccessMessage struct {
-	Beg       int64           `json:"ts"`
-	Cost      float64         `json:"cost"`
-	Method    string          `json:"method"`
-	Ext       json.RawMessage `json:"ext,omitempty"`
-	Client    string          `json:"client"`
-	BegFormat string          `json:"ts"`
-	Error     error           `json:"err"`
+	Beg       int64               `json:"ts"`
+	Cost      float64             `json:"cost"`
+	RequestID string              `json:"request_id"`
+	Method    string              `json:"method"`
+	Code      string              `json:"code,omitempty"`
+	Peer      string              `json:"peer,omitempty"`
+	UserAgent string              `json:"ua,omitempty"`
+	Headers   map[string][]string `json:"headers,omitempty"`
+	Ext       json.RawMessage     `json:"ext,omitempty"`
+	Client    string              `json:"client"`
+	BegFormat string              `json:"-"`
+	Error     string              `json:"err,omitempty"`
 }
 
 //func (m *GRPCAccessMessage) MarshalJSON() ([]byte, error) {
@@ -5,13 +5,21 @@ import (
 )
 
 type HTTPAccessMessage struct {
-	Beg    int64           `json:"ts"`
-	Cost   float64         `json:"cost"`
-	Method string          `json:"method"`
-	Status int             `json:"status"`
-	Path   string          `json:"path"`
-	Ext    json.RawMessage `json:"ext,omitempty"`
-	Client string          `json:"client"`
+	Beg          int64               `json:"ts"`
+	Cost         float64             `json:"cost"`
+	RequestID    string              `json:"request_id"`
+	Method       string              `json:"method"`
+	Status       int                 `json:"status"`
+	Path         string              `json:"path"`
+	Route        string              `json:"route,omitempty"`
+	RequestSize  int64               `json:"req_size,omitempty"`
+	ResponseSize int64               `json:"resp_size,omitempty"`
+	Peer         string              `json:"peer,omitempty"`
+	UserAgent    string              `json:"ua,omitempty"`
+	Headers      map[string][]string `json:"headers,omitempty"`
+	Error        string              `json:"err,omitempty"`
+	Ext          json.RawMessage     `json:"ext,omitempty"`
+	Client       string              `json:"client"`
 }
 
 //func (m *HTTPAccessMessage) MarshalJSON() ([]byte, error) {
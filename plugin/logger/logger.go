@@ -3,20 +3,34 @@ package logger
 import (
 	"context"
 	"fmt"
+	"math/rand"
+	"net/http"
 	"strings"
 	"sync"
 	"time"
 
-	"github.com/sevenNt/wzap"
 	"github.com/sevenNt/ares/application"
 	"github.com/sevenNt/ares/server/echo"
+	"github.com/sevenNt/ares/util"
+	"github.com/sevenNt/wzap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 )
 
 const (
 	defaultAccessLogName = "access.json"
 )
 
+type level int
+
+const (
+	levelInfo level = iota
+	levelWarn
+	levelError
+)
+
 // Logger logger plugin.
 type Logger struct {
 	Options
@@ -32,7 +46,29 @@ func Default() *Logger {
 
 // NewAccessLog returns new access log instance.
 func NewAccessLog(opts ...Option) *Logger {
-	var options Options
+	return New(opts...)
+}
+
+// New returns new logger instance
+func New(opts ...Option) *Logger {
+	options := Options{
+		fields: map[string]bool{
+			FieldRequestSize:  true,
+			FieldResponseSize: true,
+			FieldRoute:        true,
+			FieldPeer:         true,
+			FieldUserAgent:    true,
+			FieldCode:         true,
+		},
+		requestIDHeader: echo.HeaderXRequestID,
+		redact: map[string]bool{
+			"Authorization": true,
+			"Cookie":        true,
+			"Set-Cookie":    true,
+			"X-Api-Key":     true,
+		},
+		sampleRatio: 1,
+	}
 	for _, opt := range opts {
 		opt(&options)
 	}
@@ -41,10 +77,7 @@ func NewAccessLog(opts ...Option) *Logger {
 	path := options.path
 	if path == "" {
 		if options.directory != "" {
-			if strings.HasSuffix(options.directory, "/") {
-				options.directory = options.directory[:len(options.directory)-1]
-			}
-			path = fmt.Sprintf("%s/%s", options.directory, defaultAccessLogName)
+			path = fmt.Sprintf("%s/%s", strings.TrimSuffix(options.directory, "/"), defaultAccessLogName)
 		} else {
 			path = defaultAccessLogName
 		}
@@ -61,23 +94,9 @@ func NewAccessLog(opts ...Option) *Logger {
 		))
 	}
 
-	return &Logger{
-		logger: wzap.New(logOpts...),
-	}
-}
-
-// New returns new logger instance
-func New(opts ...Option) *Logger {
-	var options Options
-	for _, opt := range opts {
-		opt(&options)
-	}
 	return &Logger{
 		Options: options,
-		logger: wzap.New(
-			wzap.WithPath(options.path),
-			wzap.WithLevelString("INFO"),
-		),
+		logger:  wzap.New(logOpts...),
 	}
 }
 
@@ -91,18 +110,53 @@ func (r *Logger) Func() echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c *echo.Context) (err error) {
 			beg := time.Now().Round(time.Microsecond)
-			err = next(c)
 			req := c.Request()
 			rep := c.Response()
 
+			id := req.Header().Get(r.requestIDHeader)
+			if id == "" {
+				id = util.GenerateID()
+			}
+			rep.Header().Set(r.requestIDHeader, id)
+			c.SetContext(r.withRequestID(c.Context, id))
+
+			err = next(c)
+			cost := time.Since(beg)
+			lv := r.level(cost, err != nil || rep.Status() >= http.StatusInternalServerError)
+			if !r.sampled(lv) {
+				return
+			}
+
 			msg := pool.Get().(*HTTPAccessMessage)
-			msg.Beg = beg.Unix()
-			msg.Cost = float64(time.Since(beg).Round(time.Microsecond)) / float64(time.Millisecond)
-			msg.Method = req.Method
-			msg.Client = req.RemoteAddr
-			msg.Status = rep.Status()
-			msg.Path = req.URL.Path()
-			r.logger.Info("http", "access", msg)
+			*msg = HTTPAccessMessage{
+				Beg:       beg.Unix(),
+				Cost:      float64(cost.Round(time.Microsecond)) / float64(time.Millisecond),
+				RequestID: id,
+				Method:    req.Method,
+				Client:    req.RemoteAddr,
+				Status:    rep.Status(),
+				Path:      req.URL.Path(),
+				Headers:   r.headerValues(req.Header()),
+			}
+			if err != nil {
+				msg.Error = err.Error()
+			}
+			if r.fields[FieldRoute] {
+				msg.Route = c.PatternPath()
+			}
+			if r.fields[FieldRequestSize] {
+				msg.RequestSize = req.ContentLength
+			}
+			if r.fields[FieldResponseSize] {
+				msg.ResponseSize = rep.Size()
+			}
+			if r.fields[FieldPeer] {
+				msg.Peer = c.ClientIP()
+			}
+			if r.fields[FieldUserAgent] {
+				msg.UserAgent = req.Header().Get(echo.HeaderUserAgent)
+			}
+			r.log(lv, "http", msg)
 			pool.Put(msg)
 			return
 		}
@@ -118,15 +172,9 @@ func (r *Logger) UnaryServerIntercept() grpc.UnaryServerInterceptor {
 	}
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
 		beg := time.Now()
+		ctx, id := r.grpcRequestID(ctx)
 		resp, err = handler(ctx, req)
-
-		msg := pool.Get().(*GRPCAccessMessage)
-		msg.Beg = beg.Unix()
-		msg.Cost = float64(time.Since(beg).Round(time.Microsecond)) / float64(time.Millisecond)
-		msg.Method = info.FullMethod
-		msg.Error = err
-		r.logger.Info("unary", "access", msg)
-		pool.Put(msg)
+		r.logGRPC(&pool, "unary", ctx, id, info.FullMethod, beg, err)
 		return
 	}
 }
@@ -140,15 +188,9 @@ func (r *Logger) StreamServerIntercept() grpc.StreamServerInterceptor {
 	}
 	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
 		beg := time.Now()
-		err = handler(srv, ss)
-
-		msg := pool.Get().(*GRPCAccessMessage)
-		msg.Beg = beg.Unix()
-		msg.Cost = float64(time.Since(beg).Round(time.Microsecond)) / float64(time.Millisecond)
-		msg.Method = info.FullMethod
-		msg.Error = err
-		r.logger.Info("stream", "access", msg)
-		pool.Put(msg)
+		ctx, id := r.grpcRequestID(ss.Context())
+		err = handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+		r.logGRPC(&pool, "stream", ctx, id, info.FullMethod, beg, err)
 		return
 	}
 }
@@ -157,3 +199,124 @@ func (r *Logger) StreamServerIntercept() grpc.StreamServerInterceptor {
 func (r *Logger) Label() string {
 	return "logger"
 }
+
+func (r *Logger) logGRPC(pool *sync.Pool, kind string, ctx context.Context, id, method string, beg time.Time, err error) {
+	cost := time.Since(beg)
+	lv := r.level(cost, err != nil)
+	if !r.sampled(lv) {
+		return
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	msg := pool.Get().(*GRPCAccessMessage)
+	*msg = GRPCAccessMessage{
+		Beg:       beg.Unix(),
+		Cost:      float64(cost.Round(time.Microsecond)) / float64(time.Millisecond),
+		RequestID: id,
+		Method:    method,
+		Headers:   r.headerValues(metadataHeader(md)),
+	}
+	if err != nil {
+		msg.Error = err.Error()
+	}
+	if r.fields[FieldCode] {
+		msg.Code = status.Code(err).String()
+	}
+	if p, ok := peer.FromContext(ctx); ok && r.fields[FieldPeer] {
+		msg.Peer = p.Addr.String()
+	}
+	if vs := md["user-agent"]; len(vs) > 0 && r.fields[FieldUserAgent] {
+		msg.UserAgent = vs[0]
+	}
+	r.log(lv, kind, msg)
+	pool.Put(msg)
+}
+
+// grpcRequestID takes request id from incoming metadata or generates one.
+func (r *Logger) grpcRequestID(ctx context.Context) (context.Context, string) {
+	var id string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if vs := md[strings.ToLower(r.requestIDHeader)]; len(vs) > 0 {
+			id = vs[0]
+		}
+	}
+	if id == "" {
+		id = util.GenerateID()
+	}
+	return r.withRequestID(ctx, id), id
+}
+
+// withRequestID injects request id into context and outgoing metadata.
+func (r *Logger) withRequestID(ctx context.Context, id string) context.Context {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	md[strings.ToLower(r.requestIDHeader)] = []string{id}
+	return WithRequestID(metadata.NewOutgoingContext(ctx, md), id)
+}
+
+func (r *Logger) level(cost time.Duration, failed bool) level {
+	switch {
+	case failed, r.slowError > 0 && cost >= r.slowError:
+		return levelError
+	case r.slowWarn > 0 && cost >= r.slowWarn:
+		return levelWarn
+	default:
+		return levelInfo
+	}
+}
+
+func (r *Logger) sampled(lv level) bool {
+	return lv > levelInfo || r.sampleRatio >= 1 || rand.Float64() < r.sampleRatio
+}
+
+func (r *Logger) log(lv level, kind string, msg interface{}) {
+	switch lv {
+	case levelError:
+		r.logger.Error(kind, "access", msg)
+	case levelWarn:
+		r.logger.Warn(kind, "access", msg)
+	default:
+		r.logger.Info(kind, "access", msg)
+	}
+}
+
+// headerValues returns configured headers with sensitive values redacted.
+func (r *Logger) headerValues(header http.Header) map[string][]string {
+	if len(r.headers) == 0 {
+		return nil
+	}
+	values := make(map[string][]string, len(r.headers))
+	for _, h := range r.headers {
+		key := canonical(h)
+		vs := header[key]
+		if len(vs) == 0 {
+			continue
+		}
+		if r.redact[key] {
+			vs = []string{"[REDACTED]"}
+		}
+		values[key] = vs
+	}
+	return values
+}
+
+func canonical(header string) string {
+	return http.CanonicalHeaderKey(header)
+}
+
+func metadataHeader(md metadata.MD) http.Header {
+	header := make(http.Header, len(md))
+	for k, vs := range md {
+		header[canonical(k)] = vs
+	}
+	return header
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
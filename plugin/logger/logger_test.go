@@ -0,0 +1,77 @@
+package logger
+
+import (
+	"context"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sevenNt/ares/server/echo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestHTTPRequestID(t *testing.T) {
+	l := New(Path(filepath.Join(t.TempDir(), "access.json")))
+	s := echo.NewServer(nil)
+
+	var id string
+	s.GET("/", func(c *echo.Context) error {
+		id = RequestID(c)
+		md, _ := metadata.FromOutgoingContext(c)
+		if len(md[MetadataRequestID]) == 0 || md[MetadataRequestID][0] != id {
+			t.Errorf("request id not in outgoing metadata: %v", md)
+		}
+		return nil
+	}, l)
+
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest("GET", "/", nil))
+	if id == "" || rec.Header().Get(echo.HeaderXRequestID) != id {
+		t.Fatalf("request id = %q, header = %q", id, rec.Header().Get(echo.HeaderXRequestID))
+	}
+
+	req := httptest.NewRequest("GET", "/", nil)
+	req.Header.Set(echo.HeaderXRequestID, "incoming")
+	s.ServeHTTP(httptest.NewRecorder(), req)
+	if id != "incoming" {
+		t.Fatalf("request id = %q, want incoming", id)
+	}
+}
+
+func TestGRPCRequestID(t *testing.T) {
+	l := New(Path(filepath.Join(t.TempDir(), "access.json")))
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(MetadataRequestID, "incoming"))
+	l.UnaryServerIntercept()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/svc/M"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		if id := RequestID(ctx); id != "incoming" {
+			t.Errorf("request id = %q", id)
+		}
+		if kvs := KVs(ctx, "k", "v"); len(kvs) != 4 || kvs[1] != "incoming" {
+			t.Errorf("kvs = %v", kvs)
+		}
+		return nil, nil
+	})
+}
+
+func TestLevelAndRedaction(t *testing.T) {
+	l := New(
+		Path(filepath.Join(t.TempDir(), "access.json")),
+		SlowThreshold(time.Second, 2*time.Second),
+		Headers("authorization", "X-Caller"),
+	)
+	if lv := l.level(1500*time.Millisecond, false); lv != levelWarn {
+		t.Errorf("level = %v, want warn", lv)
+	}
+	if lv := l.level(time.Millisecond, true); lv != levelError {
+		t.Errorf("level = %v, want error", lv)
+	}
+
+	req := httptest.NewRequest("GET", "/", nil)
+	req.Header.Set("Authorization", "secret")
+	req.Header.Set("X-Caller", "order")
+	values := l.headerValues(req.Header)
+	if values["Authorization"][0] != "[REDACTED]" || values["X-Caller"][0] != "order" {
+		t.Errorf("headers = %v", values)
+	}
+}
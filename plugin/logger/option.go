@@ -1,5 +1,17 @@
 package logger
 
+import "time"
+
+// Optional fields of access log.
+const (
+	FieldRequestSize  = "req_size"
+	FieldResponseSize = "resp_size"
+	FieldRoute        = "route"
+	FieldPeer         = "peer"
+	FieldUserAgent    = "ua"
+	FieldCode         = "code"
+)
+
 // Option logger Option func
 type Option func(*Options)
 
@@ -8,6 +20,14 @@ type Options struct {
 	directory     string // path为空，则使用directory + #{appname} + access.json 规则生成一个path
 	path          string // 优先使用path
 	consoleOutput bool
+
+	fields          map[string]bool
+	requestIDHeader string
+	headers         []string
+	redact          map[string]bool
+	slowWarn        time.Duration
+	slowError       time.Duration
+	sampleRatio     float64
 }
 
 // Directory 相对于application.LogDir()之后的路径。
@@ -17,9 +37,70 @@ func Directory(directory string) Option {
 	}
 }
 
+// Path 日志文件路径，优先于Directory。
+func Path(path string) Option {
+	return func(opts *Options) {
+		opts.path = path
+	}
+}
+
 // ConsoleOutput 是否启用终端输出。
 func ConsoleOutput(output bool) Option {
 	return func(opts *Options) {
 		opts.consoleOutput = output
 	}
 }
+
+// Fields sets optional fields of access log, defaults to all.
+func Fields(fields ...string) Option {
+	return func(opts *Options) {
+		opts.fields = make(map[string]bool, len(fields))
+		for _, f := range fields {
+			opts.fields[f] = true
+		}
+	}
+}
+
+// RequestIDHeader sets header carrying request id, defaults to X-Request-ID.
+// Request id is taken from the header or generated, and written back to
+// response header.
+func RequestIDHeader(header string) Option {
+	return func(opts *Options) {
+		opts.requestIDHeader = header
+	}
+}
+
+// Headers sets request headers or metadata logged in field headers.
+func Headers(headers ...string) Option {
+	return func(opts *Options) {
+		opts.headers = headers
+	}
+}
+
+// Redact sets headers whose values are redacted, defaults to Authorization,
+// Cookie, Set-Cookie and X-Api-Key.
+func Redact(headers ...string) Option {
+	return func(opts *Options) {
+		opts.redact = make(map[string]bool, len(headers))
+		for _, h := range headers {
+			opts.redact[canonical(h)] = true
+		}
+	}
+}
+
+// SlowThreshold escalates access log of requests costing more than warn to
+// warn level, and more than error to error level, zero disables.
+func SlowThreshold(warn, error time.Duration) Option {
+	return func(opts *Options) {
+		opts.slowWarn = warn
+		opts.slowError = error
+	}
+}
+
+// SampleRatio sets ratio of info level access logs to write, slow or failed
+// requests are always logged. Defaults to 1.
+func SampleRatio(ratio float64) Option {
+	return func(opts *Options) {
+		opts.sampleRatio = ratio
+	}
+}
@@ -3,10 +3,12 @@ package metric
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/sevenNt/ares/plugin"
 	"github.com/sevenNt/wzap"
 	"github.com/sevenNt/ares/server/echo"
 
@@ -84,6 +86,21 @@ func (m *Metric) StreamServerIntercept() grpc.StreamServerInterceptor {
 	}
 }
 
+// RoundTripper wraps HTTP client transport with metrics by method and host.
+func (m *Metric) RoundTripper(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return plugin.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		beg := time.Now()
+		resp, err := next.RoundTrip(req)
+		cost := time.Since(beg)
+		m.update(nameFn("client_"+req.Method+"_"+req.URL.Host), cost, map[string]string{"if_type": "http_client"})
+		m.update("http_client", cost, map[string]string{"if_type": "http_client"})
+		return resp, err
+	})
+}
+
 func (m *Metric) update(key string, duration time.Duration, labels map[string]string) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -1,6 +1,8 @@
 package plugin
 
 import (
+	"net/http"
+
 	"github.com/sevenNt/ares/server/echo"
 	"google.golang.org/grpc"
 )
@@ -14,6 +16,15 @@ type Plugin interface {
 	Label() string
 }
 
+// RoundTripperFunc is an adapter to allow the use of ordinary functions as
+// http.RoundTripper, e.g. of plugins wrapping HTTP client transports.
+type RoundTripperFunc func(*http.Request) (*http.Response, error)
+
+// RoundTrip implements http.RoundTripper interface.
+func (f RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
 //type Deprecated struct{}
 //func (Deprecated) HookRoute(method string, path string) {}
 //func (Deprecated) Clone() (echo.Middleware, bool)       {}
@@ -0,0 +1,106 @@
+package ratelimit
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/juju/ratelimit"
+)
+
+// Limit is a token bucket limit.
+type Limit struct {
+	Rate  float64 // tokens filled per second
+	Burst int64   // bucket capacity, defaults to ceil(Rate)
+}
+
+func (l Limit) burst() int64 {
+	if l.Burst > 0 {
+		return l.Burst
+	}
+	return int64(math.Max(1, math.Ceil(l.Rate)))
+}
+
+// Result is the outcome of taking a token.
+type Result struct {
+	Allowed    bool
+	Remaining  int64         // tokens left in the bucket
+	Wait       time.Duration // time to wait before proceeding when allowed
+	RetryAfter time.Duration // time until a token is available when rejected
+}
+
+// Backend stores token buckets.
+type Backend interface {
+	// Take takes a token from the bucket of key, it's allowed when a token
+	// is available within maxWait.
+	Take(key string, limit Limit, maxWait time.Duration) (Result, error)
+}
+
+// minSweep is the bucket number which triggers the first sweep of a LocalBackend.
+const minSweep = 1024
+
+// LocalBackend is a process-local backend.
+type LocalBackend struct {
+	mu        sync.Mutex
+	buckets   map[string]*localBucket
+	nextSweep int
+}
+
+type localBucket struct {
+	*ratelimit.Bucket
+	limit Limit
+}
+
+// NewLocalBackend constructs a new process-local backend.
+func NewLocalBackend() *LocalBackend {
+	return &LocalBackend{
+		buckets:   make(map[string]*localBucket),
+		nextSweep: minSweep,
+	}
+}
+
+// Take implements Backend interface.
+func (b *LocalBackend) Take(key string, limit Limit, maxWait time.Duration) (Result, error) {
+	bucket := b.bucket(key, limit)
+	wait, ok := bucket.TakeMaxDuration(1, maxWait)
+	if !ok {
+		need := float64(1 - bucket.Available())
+		return Result{
+			RetryAfter: time.Duration(need / bucket.Rate() * float64(time.Second)),
+		}, nil
+	}
+
+	remaining := bucket.Available()
+	if remaining < 0 {
+		remaining = 0
+	}
+	return Result{Allowed: true, Remaining: remaining, Wait: wait}, nil
+}
+
+func (b *LocalBackend) bucket(key string, limit Limit) *ratelimit.Bucket {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if bucket, ok := b.buckets[key]; ok && bucket.limit == limit {
+		return bucket.Bucket
+	}
+	if len(b.buckets) >= b.nextSweep {
+		b.sweep()
+	}
+	bucket := ratelimit.NewBucketWithRate(limit.Rate, limit.burst())
+	b.buckets[key] = &localBucket{Bucket: bucket, limit: limit}
+	return bucket
+}
+
+// sweep drops full buckets, which behave the same as fresh ones.
+func (b *LocalBackend) sweep() {
+	for key, bucket := range b.buckets {
+		if bucket.Available() >= bucket.Capacity() {
+			delete(b.buckets, key)
+		}
+	}
+	b.nextSweep = 2 * len(b.buckets)
+	if b.nextSweep < minSweep {
+		b.nextSweep = minSweep
+	}
+}
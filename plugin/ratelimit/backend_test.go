@@ -0,0 +1,97 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis"
+	"github.com/garyburd/redigo/redis"
+)
+
+func testBackend(t *testing.T, backend Backend) {
+	limit := Limit{Rate: 1, Burst: 3}
+	for i := int64(0); i < 3; i++ {
+		res, err := backend.Take("a", limit, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !res.Allowed {
+			t.Fatalf("take %d rejected", i)
+		}
+		if res.Remaining != 2-i {
+			t.Fatalf("take %d remaining = %d, want %d", i, res.Remaining, 2-i)
+		}
+	}
+
+	res, err := backend.Take("a", limit, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Allowed {
+		t.Fatal("take over burst allowed")
+	}
+	if res.RetryAfter <= 0 || res.RetryAfter > time.Second {
+		t.Fatalf("retry after = %v", res.RetryAfter)
+	}
+
+	res, err = backend.Take("a", limit, 2*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Allowed || res.Wait <= 0 {
+		t.Fatalf("take with wait = %+v", res)
+	}
+
+	// other keys own their buckets
+	res, err = backend.Take("b", limit, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Allowed {
+		t.Fatal("take of another key rejected")
+	}
+}
+
+func TestLocalBackend(t *testing.T) {
+	testBackend(t, NewLocalBackend())
+}
+
+func TestRedisBackend(t *testing.T) {
+	s, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	addr := s.Addr()
+	pool := &redis.Pool{
+		Dial: func() (redis.Conn, error) {
+			return redis.Dial("tcp", addr)
+		},
+	}
+	testBackend(t, NewRedisBackend(pool, "test:"))
+	if !s.Exists("test:a") {
+		t.Fatal("bucket not stored in redis")
+	}
+
+	// time is taken from redis, not clock of replicas
+	s.SetTime(time.Unix(1000, 0))
+	if _, err := NewRedisBackend(pool, "test:").Take("c", Limit{Rate: 1, Burst: 1}, 0); err != nil {
+		t.Fatal(err)
+	}
+	if tat, _ := s.Get("test:c"); tat != "1001000000" {
+		t.Fatalf("tat = %s, want 1001000000", tat)
+	}
+
+	// fall back to local limiter while redis is down
+	s.Close()
+	backend := NewRedisBackend(pool, "test:")
+	res, err := backend.Take("a", Limit{Rate: 1, Burst: 1}, 0)
+	if err != nil || !res.Allowed {
+		t.Fatalf("fallback take = %+v, %v", res, err)
+	}
+	res, err = backend.Take("a", Limit{Rate: 1, Burst: 1}, 0)
+	if err != nil || res.Allowed {
+		t.Fatalf("fallback take over burst = %+v, %v", res, err)
+	}
+}
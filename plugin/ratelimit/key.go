@@ -0,0 +1,103 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/sevenNt/ares/server/echo"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// HTTPKeyFunc extracts the bucket key of a HTTP request.
+type HTTPKeyFunc func(c *echo.Context) string
+
+// GRPCKeyFunc extracts the bucket key of a gRPC call.
+type GRPCKeyFunc func(ctx context.Context, fullMethod string) string
+
+// KeyByIP keys HTTP requests by client IP.
+func KeyByIP() HTTPKeyFunc {
+	return func(c *echo.Context) string {
+		return c.ClientIP()
+	}
+}
+
+// KeyByRoute keys HTTP requests by route pattern.
+func KeyByRoute() HTTPKeyFunc {
+	return func(c *echo.Context) string {
+		return c.Request().Method + " " + c.PatternPath()
+	}
+}
+
+// KeyByHeader keys HTTP requests by header value.
+func KeyByHeader(header string) HTTPKeyFunc {
+	return func(c *echo.Context) string {
+		return c.Request().Header().Get(header)
+	}
+}
+
+// KeyBySubject keys HTTP requests by the auth subject stored in context
+// with contextKey, e.g. a jwt token set by jwt middleware.
+func KeyBySubject(contextKey string) HTTPKeyFunc {
+	return func(c *echo.Context) string {
+		return subject(c.Get(contextKey))
+	}
+}
+
+// KeyByPeer keys gRPC calls by peer IP.
+func KeyByPeer() GRPCKeyFunc {
+	return func(ctx context.Context, fullMethod string) string {
+		p, ok := peer.FromContext(ctx)
+		if !ok || p.Addr == nil {
+			return ""
+		}
+		if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+			return host
+		}
+		return p.Addr.String()
+	}
+}
+
+// KeyByMethod keys gRPC calls by full method name.
+func KeyByMethod() GRPCKeyFunc {
+	return func(ctx context.Context, fullMethod string) string {
+		return fullMethod
+	}
+}
+
+// KeyByMetadata keys gRPC calls by incoming metadata value.
+func KeyByMetadata(key string) GRPCKeyFunc {
+	return func(ctx context.Context, fullMethod string) string {
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			return ""
+		}
+		if vs := md[strings.ToLower(key)]; len(vs) > 0 {
+			return vs[0]
+		}
+		return ""
+	}
+}
+
+func subject(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case *jwt.Token:
+		if claims, ok := v.Claims.(jwt.MapClaims); ok {
+			sub, _ := claims["sub"].(string)
+			return sub
+		}
+		if claims, ok := v.Claims.(*jwt.StandardClaims); ok {
+			return claims.Subject
+		}
+		return ""
+	default:
+		return fmt.Sprint(v)
+	}
+}
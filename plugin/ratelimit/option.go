@@ -1,6 +1,12 @@
 package ratelimit
 
-import "time"
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/sevenNt/hera"
+)
 
 type Option func(*Options)
 type Options struct {
@@ -10,6 +16,11 @@ type Options struct {
 	capacity        int64
 	waitMaxDuration time.Duration
 	trigger         bool
+	backend         Backend
+	httpKey         HTTPKeyFunc
+	grpcKey         GRPCKeyFunc
+	keyLimits       map[string]Limit
+	policies        map[string]Policy
 }
 
 func Rate(rate float64) Option {
@@ -47,3 +58,121 @@ func Trigger(trig bool) Option {
 		opts.trigger = trig
 	}
 }
+
+// WithBackend sets the bucket backend, process-local by default.
+func WithBackend(backend Backend) Option {
+	return func(opts *Options) {
+		opts.backend = backend
+	}
+}
+
+// HTTPKey sets the bucket key extractor of HTTP requests.
+func HTTPKey(fn HTTPKeyFunc) Option {
+	return func(opts *Options) {
+		opts.httpKey = fn
+	}
+}
+
+// GRPCKey sets the bucket key extractor of gRPC calls.
+func GRPCKey(fn GRPCKeyFunc) Option {
+	return func(opts *Options) {
+		opts.grpcKey = fn
+	}
+}
+
+// KeyLimit sets the limit of a specific bucket key.
+func KeyLimit(key string, limit Limit) Option {
+	return func(opts *Options) {
+		opts.keyLimits[key] = limit
+	}
+}
+
+// KeyLimitsFromConfig loads per-key limits from config, e.g.
+//
+//	[app.plugin.ratelimit.keys]
+//	  "10.0.0.1" = { rate = 100.0, burst = 200 }
+func KeyLimitsFromConfig(key string) Option {
+	return func(opts *Options) {
+		for k, v := range hera.GetStringMap(key) {
+			limit, err := parseLimit(v)
+			if err != nil {
+				panic(fmt.Sprintf("ratelimit: invalid limit of %s.%s: %v", key, k, err))
+			}
+			opts.keyLimits[k] = limit
+		}
+	}
+}
+
+// RoutePolicy sets the policy of a route, which is a HTTP route pattern
+// optionally prefixed by method, e.g. "GET /users/:id", or a gRPC full
+// method, e.g. "/pkg.Service/Method", or "/pkg.Service/*" for all methods.
+func RoutePolicy(route string, policy Policy) Option {
+	return func(opts *Options) {
+		opts.policies[route] = policy
+	}
+}
+
+// PoliciesFromConfig loads route policies from config, e.g.
+//
+//	[app.plugin.ratelimit.policies]
+//	  "GET /users/:id" = { rate = 100.0, burst = 200 }
+//	  "/pkg.Service/Method" = { rate = 10.0, wait = "100ms" }
+//	  "/pkg.Service/*" = { rate = 50.0, trigger = true }
+func PoliciesFromConfig(key string) Option {
+	return func(opts *Options) {
+		for route, v := range hera.GetStringMap(key) {
+			policy, err := parsePolicy(v)
+			if err != nil {
+				panic(fmt.Sprintf("ratelimit: invalid policy of %s.%s: %v", key, route, err))
+			}
+			opts.policies[route] = policy
+		}
+	}
+}
+
+func toMap(v interface{}) (map[string]interface{}, error) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		return v, nil
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, val := range v {
+			m[fmt.Sprint(k)] = val
+		}
+		return m, nil
+	}
+	return nil, fmt.Errorf("unexpected type %T", v)
+}
+
+func parseLimit(v interface{}) (limit Limit, err error) {
+	m, err := toMap(v)
+	if err != nil {
+		return
+	}
+
+	if limit.Rate, err = toFloat64(m["rate"]); err != nil {
+		return
+	}
+	if limit.Rate <= 0 {
+		return limit, fmt.Errorf("rate must be positive")
+	}
+	burst, err := toFloat64(m["burst"])
+	limit.Burst = int64(burst)
+	return
+}
+
+func toFloat64(v interface{}) (float64, error) {
+	switch v := v.(type) {
+	case nil:
+		return 0, nil
+	case int:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case float64:
+		return v, nil
+	case string:
+		return strconv.ParseFloat(v, 64)
+	}
+	return 0, fmt.Errorf("unexpected number %v", v)
+}
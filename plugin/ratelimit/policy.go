@@ -0,0 +1,125 @@
+package ratelimit
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Policy is the rate limit policy of a route or method.
+type Policy struct {
+	Limit
+	// WaitMaxDuration is the max duration to wait for a token, requests
+	// are rejected at once when it's zero.
+	WaitMaxDuration time.Duration
+	// Trigger only logs and counts limited requests instead of rejecting them.
+	Trigger bool
+}
+
+// Stats counts requests of a policy.
+type Stats struct {
+	Passed  uint64
+	Limited uint64
+}
+
+type counter struct {
+	passed  uint64
+	limited uint64
+}
+
+type policyStats struct {
+	mu       sync.RWMutex
+	counters map[string]*counter
+}
+
+func (s *policyStats) counter(route string) *counter {
+	s.mu.RLock()
+	cnt, ok := s.counters[route]
+	s.mu.RUnlock()
+	if ok {
+		return cnt
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if cnt, ok = s.counters[route]; !ok {
+		cnt = &counter{}
+		s.counters[route] = cnt
+	}
+	return cnt
+}
+
+func (s *policyStats) snapshot() map[string]Stats {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	stats := make(map[string]Stats, len(s.counters))
+	for route, cnt := range s.counters {
+		stats[route] = Stats{
+			Passed:  atomic.LoadUint64(&cnt.passed),
+			Limited: atomic.LoadUint64(&cnt.limited),
+		}
+	}
+	return stats
+}
+
+// httpPolicy looks up policy by "METHOD pattern" and then by pattern.
+func (r *RateLimit) httpPolicy(method, pattern string) (string, Policy) {
+	if route := method + " " + pattern; r.hasPolicy(route) {
+		return route, r.policies[route]
+	}
+	if r.hasPolicy(pattern) {
+		return pattern, r.policies[pattern]
+	}
+	return "", r.defaultPolicy
+}
+
+// grpcPolicy looks up policy by full method and then by "/package.service/*".
+func (r *RateLimit) grpcPolicy(fullMethod string) (string, Policy) {
+	if r.hasPolicy(fullMethod) {
+		return fullMethod, r.policies[fullMethod]
+	}
+	if i := strings.LastIndex(fullMethod, "/"); i > 0 {
+		if route := fullMethod[:i+1] + "*"; r.hasPolicy(route) {
+			return route, r.policies[route]
+		}
+	}
+	return "", r.defaultPolicy
+}
+
+func (r *RateLimit) hasPolicy(route string) bool {
+	_, ok := r.policies[route]
+	return ok
+}
+
+func parsePolicy(v interface{}) (policy Policy, err error) {
+	m, err := toMap(v)
+	if err != nil {
+		return
+	}
+	if policy.Limit, err = parseLimit(m); err != nil {
+		return
+	}
+
+	switch wait := m["wait"].(type) {
+	case nil:
+	case string:
+		if policy.WaitMaxDuration, err = time.ParseDuration(wait); err != nil {
+			return
+		}
+	case time.Duration:
+		policy.WaitMaxDuration = wait
+	default:
+		return policy, fmt.Errorf("unexpected wait %v", wait)
+	}
+
+	switch trigger := m["trigger"].(type) {
+	case nil:
+	case bool:
+		policy.Trigger = trigger
+	default:
+		return policy, fmt.Errorf("unexpected trigger %v", trigger)
+	}
+	return
+}
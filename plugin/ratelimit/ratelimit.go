@@ -3,11 +3,17 @@ package ratelimit
 import (
 	"context"
 	"errors"
+	"math"
+	"strconv"
+	"sync/atomic"
+	"time"
 
-	"github.com/juju/ratelimit"
 	"github.com/sevenNt/ares/server/echo"
 	"github.com/sevenNt/ares/server/yell"
+	"github.com/sevenNt/wzap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
@@ -16,30 +22,70 @@ var (
 	ErrRateLimitTooMany = errors.New("[ratelimit] too many request")
 )
 
+// MetadataRetryPushback is the gRPC trailer key of the retry hint.
+const MetadataRetryPushback = "grpc-retry-pushback-ms"
+
 // RateLimit rate limit
 type RateLimit struct {
 	Options
+	defaultPolicy Policy
+	stats         policyStats
 }
 
 // New constructs a new rateLimit interceptor.
 func New(opts ...Option) *RateLimit {
-	var options Options
+	options := Options{
+		keyLimits: make(map[string]Limit),
+		policies:  make(map[string]Policy),
+	}
 	for _, opt := range opts {
 		opt(&options)
 	}
+	if options.backend == nil {
+		options.backend = NewLocalBackend()
+	}
+
+	// rate takes precedence over fill interval, routes without policy
+	// are not limited if neither is set.
+	rate := options.rate
+	if rate <= 0 && options.interval > 0 {
+		rate = float64(time.Second) / float64(options.interval)
+	}
 	return &RateLimit{
 		Options: options,
+		defaultPolicy: Policy{
+			Limit:           Limit{Rate: rate, Burst: options.capacity},
+			WaitMaxDuration: options.waitMaxDuration,
+			Trigger:         options.trigger,
+		},
+		stats: policyStats{counters: make(map[string]*counter)},
 	}
 }
 
 // Func implements HTTP Middleware interface.
 func (r *RateLimit) Func() echo.MiddlewareFunc {
-	rt := ratelimit.NewBucket(r.interval, r.capacity)
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c *echo.Context) (err error) {
-			if _, wait := rt.TakeMaxDuration(1, r.waitMaxDuration); !wait {
+			var key string
+			if r.httpKey != nil {
+				key = r.httpKey(c)
+			}
+			route, policy := r.httpPolicy(c.Request().Method, c.PatternPath())
+			limit, res, err := r.take(c, route, policy, key)
+			if limit.Rate <= 0 {
+				return next(c)
+			}
+
+			header := c.Response().Header()
+			header.Set(echo.HeaderXRateLimitLimit, strconv.FormatInt(limit.burst(), 10))
+			header.Set(echo.HeaderXRateLimitRemaining, strconv.FormatInt(res.Remaining, 10))
+			if err == ErrRateLimitTooMany {
+				header.Set(echo.HeaderRetryAfter, strconv.Itoa(int(math.Ceil(res.RetryAfter.Seconds()))))
 				return c.String(echo.StatusTooManyRequests, "too many requests")
 			}
+			if err != nil {
+				return err
+			}
 
 			return next(c)
 		}
@@ -48,10 +94,18 @@ func (r *RateLimit) Func() echo.MiddlewareFunc {
 
 // UnaryServerIntercept implements gRPC unary server interceptor interface
 func (r *RateLimit) UnaryServerIntercept() grpc.UnaryServerInterceptor {
-	rt := ratelimit.NewBucket(r.interval, r.capacity)
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
-		if _, wait := rt.TakeMaxDuration(1, r.waitMaxDuration); !wait {
-			return nil, status.Errorf(yell.CodeTooManyRequest, "too many request")
+		var key string
+		if r.grpcKey != nil {
+			key = r.grpcKey(ctx, info.FullMethod)
+		}
+		route, policy := r.grpcPolicy(info.FullMethod)
+		if _, res, err := r.take(ctx, route, policy, key); err != nil {
+			if err == ErrRateLimitTooMany {
+				grpc.SetTrailer(ctx, pushback(res.RetryAfter))
+				return nil, status.Errorf(yell.CodeTooManyRequest, "too many request")
+			}
+			return nil, contextError(err)
 		}
 		return handler(ctx, req)
 	}
@@ -59,11 +113,18 @@ func (r *RateLimit) UnaryServerIntercept() grpc.UnaryServerInterceptor {
 
 // StreamServerIntercept implements gRPC stream server interceptor interface
 func (r *RateLimit) StreamServerIntercept() grpc.StreamServerInterceptor {
-	rt := ratelimit.NewBucket(r.interval, r.capacity)
 	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
-		if _, wait := rt.TakeMaxDuration(1, r.waitMaxDuration); !wait {
-			return status.Errorf(yell.CodeTooManyRequest, "too many request")
-
+		var key string
+		if r.grpcKey != nil {
+			key = r.grpcKey(ss.Context(), info.FullMethod)
+		}
+		route, policy := r.grpcPolicy(info.FullMethod)
+		if _, res, err := r.take(ss.Context(), route, policy, key); err != nil {
+			if err == ErrRateLimitTooMany {
+				ss.SetTrailer(pushback(res.RetryAfter))
+				return status.Errorf(yell.CodeTooManyRequest, "too many request")
+			}
+			return contextError(err)
 		}
 		return handler(srv, ss)
 	}
@@ -71,5 +132,68 @@ func (r *RateLimit) StreamServerIntercept() grpc.StreamServerInterceptor {
 
 // Label implements HTTP Middleware interface.
 func (r *RateLimit) Label() string {
+	if r.label != "" {
+		return r.label
+	}
 	return "ratelimit"
 }
+
+// Stats returns request counts by route, requests of routes without
+// policy are counted under the empty route.
+func (r *RateLimit) Stats() map[string]Stats {
+	return r.stats.snapshot()
+}
+
+// take takes a token of key from the bucket of route, and waits for it if needed.
+func (r *RateLimit) take(ctx context.Context, route string, policy Policy, key string) (Limit, Result, error) {
+	limit := policy.Limit
+	if l, ok := r.keyLimits[key]; ok {
+		limit = l
+	}
+	if limit.Rate <= 0 {
+		return limit, Result{Allowed: true}, nil
+	}
+
+	maxWait := policy.WaitMaxDuration
+	if policy.Trigger {
+		maxWait = 0
+	}
+	res, err := r.backend.Take(r.Label()+":"+route+":"+key, limit, maxWait)
+	if err != nil {
+		// let requests pass rather than failing them for a broken backend
+		return limit, Result{Allowed: true}, nil
+	}
+
+	cnt := r.stats.counter(route)
+	if !res.Allowed {
+		atomic.AddUint64(&cnt.limited, 1)
+		if policy.Trigger {
+			wzap.Warn("[ratelimit] triggered", "route", route, "key", key, "rate", limit.Rate, "burst", limit.burst())
+			return limit, Result{Allowed: true}, nil
+		}
+		return limit, res, ErrRateLimitTooMany
+	}
+	atomic.AddUint64(&cnt.passed, 1)
+
+	if res.Wait > 0 {
+		timer := time.NewTimer(res.Wait)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return limit, res, ctx.Err()
+		}
+	}
+	return limit, res, nil
+}
+
+func contextError(err error) error {
+	if err == context.DeadlineExceeded {
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+	return status.Error(codes.Canceled, err.Error())
+}
+
+func pushback(d time.Duration) metadata.MD {
+	return metadata.Pairs(MetadataRetryPushback, strconv.FormatInt(int64(d/time.Millisecond), 10))
+}
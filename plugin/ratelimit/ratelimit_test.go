@@ -1,13 +1,100 @@
 package ratelimit
 
-import "testing"
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sevenNt/ares/server/echo"
+	"github.com/sevenNt/ares/server/yell"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+func serve(s *echo.Server, method, path string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(method, path, nil))
+	return rec
+}
 
 func TestRateLimit_Func(t *testing.T) {
+	rl := New(
+		RoutePolicy("GET /users/:id", Policy{Limit: Limit{Rate: 1, Burst: 2}}),
+		RoutePolicy("/trigger", Policy{Limit: Limit{Rate: 1, Burst: 1}, Trigger: true}),
+	)
+	s := echo.NewServer(nil)
+	ok := func(c *echo.Context) error {
+		return c.String(http.StatusOK, "ok")
+	}
+	s.GET("/users/:id", ok, rl)
+	s.GET("/trigger", ok, rl)
+	s.GET("/free", ok, rl)
+
+	for i := 0; i < 2; i++ {
+		if rec := serve(s, "GET", "/users/1"); rec.Code != http.StatusOK {
+			t.Fatalf("request %d code = %d", i, rec.Code)
+		}
+	}
+	// routes share bucket by pattern
+	rec := serve(s, "GET", "/users/2")
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("code = %d, want 429", rec.Code)
+	}
+	if rec.Header().Get(echo.HeaderRetryAfter) != "1" {
+		t.Fatalf("retry after = %q", rec.Header().Get(echo.HeaderRetryAfter))
+	}
+	if rec.Header().Get(echo.HeaderXRateLimitLimit) != "2" || rec.Header().Get(echo.HeaderXRateLimitRemaining) != "0" {
+		t.Fatalf("rate limit headers = %v", rec.Header())
+	}
+
+	for i := 0; i < 3; i++ {
+		if rec := serve(s, "GET", "/trigger"); rec.Code != http.StatusOK {
+			t.Fatalf("trigger request %d code = %d", i, rec.Code)
+		}
+		if rec := serve(s, "GET", "/free"); rec.Code != http.StatusOK {
+			t.Fatalf("free request %d code = %d", i, rec.Code)
+		}
+	}
 
+	stats := rl.Stats()
+	if stats["GET /users/:id"] != (Stats{Passed: 2, Limited: 1}) {
+		t.Fatalf("users stats = %+v", stats["GET /users/:id"])
+	}
+	if stats["/trigger"] != (Stats{Passed: 1, Limited: 2}) {
+		t.Fatalf("trigger stats = %+v", stats["/trigger"])
+	}
 }
 
 func TestRateLimit_UnaryServerIntercept(t *testing.T) {
+	rl := New(
+		RoutePolicy("/pkg.Service/*", Policy{Limit: Limit{Rate: 1, Burst: 1}}),
+		RoutePolicy("/pkg.Service/Wait", Policy{Limit: Limit{Rate: 100, Burst: 1}, WaitMaxDuration: time.Second}),
+	)
+	intercept := rl.UnaryServerIntercept()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	call := func(method string) error {
+		_, err := intercept(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
 
+	if err := call("/pkg.Service/A"); err != nil {
+		t.Fatal(err)
+	}
+	if err := call("/pkg.Service/B"); status.Code(err) != yell.CodeTooManyRequest {
+		t.Fatalf("err = %v, want too many request", err)
+	}
+	for i := 0; i < 3; i++ {
+		if err := call("/pkg.Service/Wait"); err != nil {
+			t.Fatalf("wait call %d: %v", i, err)
+		}
+	}
+	if err := call("/other.Service/A"); err != nil {
+		t.Fatal(err)
+	}
 }
 
 func TestRateLimit_StreamServerIntercept(t *testing.T) {
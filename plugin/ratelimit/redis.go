@@ -0,0 +1,105 @@
+package ratelimit
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+	"github.com/sevenNt/wzap"
+)
+
+// gcraScript implements GCRA, the bucket is stored as its theoretical
+// arrival time in microseconds. Time is taken from redis so that replicas
+// of skewed clocks share the same limit.
+//
+// KEYS[1] bucket key
+// ARGV[1] emission interval, ARGV[2] burst, ARGV[3] max wait
+// returns {allowed, remaining, wait, retry after}
+var gcraScript = redis.NewScript(1, `
+-- replicate SET instead of the script, which calls non-deterministic TIME
+redis.replicate_commands()
+
+local emission = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local max_wait = tonumber(ARGV[3])
+local time = redis.call("TIME")
+local now = tonumber(time[1]) * 1000000 + tonumber(time[2])
+local tolerance = emission * burst
+
+local tat = tonumber(redis.call("GET", KEYS[1]) or now)
+if tat < now then
+	tat = now
+end
+
+local new_tat = tat + emission
+local wait = new_tat - tolerance - now
+if wait > max_wait then
+	return {0, 0, 0, wait}
+end
+if wait < 0 then
+	wait = 0
+end
+
+local ttl = math.ceil((new_tat - now) / 1000)
+if ttl < 1 then
+	ttl = 1
+end
+-- format explicitly, numbers are converted with 14 significant digits
+redis.call("SET", KEYS[1], string.format("%d", new_tat), "PX", ttl)
+
+local remaining = math.floor((tolerance - (new_tat - now)) / emission)
+if remaining < 0 then
+	remaining = 0
+end
+return {1, remaining, wait, 0}
+`)
+
+// RedisBackend is a backend shared by replicas through redis, it falls
+// back to a process-local backend while redis is unreachable.
+type RedisBackend struct {
+	pool     *redis.Pool
+	prefix   string
+	fallback Backend
+	broken   int32
+}
+
+// NewRedisBackend constructs a new redis backend, keys are stored with prefix.
+func NewRedisBackend(pool *redis.Pool, prefix string) *RedisBackend {
+	return &RedisBackend{
+		pool:     pool,
+		prefix:   prefix,
+		fallback: NewLocalBackend(),
+	}
+}
+
+// Take implements Backend interface.
+func (b *RedisBackend) Take(key string, limit Limit, maxWait time.Duration) (Result, error) {
+	res, err := b.take(key, limit, maxWait)
+	if err != nil {
+		if atomic.CompareAndSwapInt32(&b.broken, 0, 1) {
+			wzap.Warn("[ratelimit] redis unreachable, fallback to local limiter", "error", err)
+		}
+		return b.fallback.Take(key, limit, maxWait)
+	}
+	if atomic.CompareAndSwapInt32(&b.broken, 1, 0) {
+		wzap.Info("[ratelimit] redis recovered")
+	}
+	return res, nil
+}
+
+func (b *RedisBackend) take(key string, limit Limit, maxWait time.Duration) (Result, error) {
+	conn := b.pool.Get()
+	defer conn.Close()
+
+	emission := int64(float64(time.Second/time.Microsecond) / limit.Rate)
+	vals, err := redis.Int64s(gcraScript.Do(conn, b.prefix+key, emission, limit.burst(), int64(maxWait/time.Microsecond)))
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{
+		Allowed:    vals[0] == 1,
+		Remaining:  vals[1],
+		Wait:       time.Duration(vals[2]) * time.Microsecond,
+		RetryAfter: time.Duration(vals[3]) * time.Microsecond,
+	}, nil
+}
@@ -0,0 +1,63 @@
+package retry
+
+import (
+	"sync"
+	"time"
+)
+
+// budget is a token bucket of retries, each call deposits ratio tokens
+// and each retry withdraws one, besides min tokens refilled per second.
+type budget struct {
+	ratio float64
+	min   float64
+	max   float64
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+func newBudget(ratio, min float64) *budget {
+	// allows bursting retries of about 10 seconds
+	max := 10 * min
+	if max < 10 {
+		max = 10
+	}
+	return &budget{
+		ratio:  ratio,
+		min:    min,
+		max:    max,
+		tokens: max,
+		last:   time.Now(),
+	}
+}
+
+func (b *budget) deposit() {
+	b.mu.Lock()
+	b.refill()
+	b.tokens += b.ratio
+	if b.tokens > b.max {
+		b.tokens = b.max
+	}
+	b.mu.Unlock()
+}
+
+func (b *budget) withdraw() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refill()
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+func (b *budget) refill() {
+	now := time.Now()
+	b.tokens += now.Sub(b.last).Seconds() * b.min
+	if b.tokens > b.max {
+		b.tokens = b.max
+	}
+	b.last = now
+}
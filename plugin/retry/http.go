@@ -0,0 +1,101 @@
+package retry
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/sevenNt/ares/plugin"
+	"github.com/sevenNt/ares/plugin/backoff"
+)
+
+// RoundTripper wraps HTTP client transport with retries on transport errors
+// and configured statuses, Retry-After of response is honored. Only requests
+// of configured methods are retried, see HTTPMethods, and requests with body
+// are only retried if the body can be got again, see http.Request.GetBody.
+func (r *Retry) RoundTripper(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return plugin.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if !r.httpMethods[req.Method] {
+			return next.RoundTrip(req)
+		}
+		r.budget.deposit()
+		b := r.newBackOff()
+		for attempt := 1; ; attempt++ {
+			resp, err := next.RoundTrip(req)
+			if attempt >= r.maxAttempts || !r.retryableResponse(resp, err) {
+				return resp, err
+			}
+			if req.Body != nil && req.GetBody == nil {
+				return resp, err
+			}
+
+			wait, ok := retryAfter(resp)
+			if !ok {
+				wait = b.Next()
+			}
+			if wait < 0 || !r.budget.withdraw() {
+				return resp, err
+			}
+
+			body := req.Body
+			if req.GetBody != nil {
+				var bodyErr error
+				if body, bodyErr = req.GetBody(); bodyErr != nil {
+					return resp, err
+				}
+			}
+
+			t := time.NewTimer(wait)
+			select {
+			case <-req.Context().Done():
+				t.Stop()
+				return resp, err
+			case <-t.C:
+			}
+
+			if resp != nil {
+				// drains body so that connection is reused
+				io.Copy(ioutil.Discard, resp.Body)
+				resp.Body.Close()
+			}
+			// round trippers must not modify request
+			clone := new(http.Request)
+			*clone = *req
+			clone.Body = body
+			req = clone
+		}
+	})
+}
+
+func (r *Retry) retryableResponse(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	return r.statuses[resp.StatusCode]
+}
+
+// retryAfter parses Retry-After header in seconds or HTTP date.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if wait := time.Until(t); wait > 0 {
+			return wait, true
+		}
+		return 0, true
+	}
+	return backoff.Stop, true
+}
@@ -0,0 +1,130 @@
+package retry
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sevenNt/ares/plugin/backoff"
+	"github.com/sevenNt/hera"
+	"google.golang.org/grpc/codes"
+)
+
+type Option func(*Options)
+type Options struct {
+	label          string
+	maxAttempts    int
+	codes          map[codes.Code]bool
+	statuses       map[int]bool
+	httpMethods    map[string]bool
+	newBackOff     func() backoff.BackOff
+	budgetRatio    float64
+	budgetMin      float64
+	hedgeDelay     time.Duration
+	hedgeMethods   map[string]bool
+	timeouts       map[string]time.Duration
+	defaultTimeout time.Duration
+}
+
+func Label(label string) Option {
+	return func(opts *Options) {
+		opts.label = label
+	}
+}
+
+// MaxAttempts sets max attempts of a call including the first one, defaults to 3.
+func MaxAttempts(n int) Option {
+	return func(opts *Options) {
+		opts.maxAttempts = n
+	}
+}
+
+// Codes sets status codes to retry on, defaults to Unavailable.
+func Codes(cs ...codes.Code) Option {
+	return func(opts *Options) {
+		opts.codes = make(map[codes.Code]bool, len(cs))
+		for _, c := range cs {
+			opts.codes[c] = true
+		}
+	}
+}
+
+// Statuses sets HTTP status codes to retry on, defaults to 502, 503 and 504.
+func Statuses(statuses ...int) Option {
+	return func(opts *Options) {
+		opts.statuses = make(map[int]bool, len(statuses))
+		for _, s := range statuses {
+			opts.statuses[s] = true
+		}
+	}
+}
+
+// HTTPMethods sets HTTP methods to retry, defaults to idempotent GET, HEAD,
+// OPTIONS, PUT and DELETE. Retrying other methods, e.g. POST, may submit
+// writes twice.
+func HTTPMethods(methods ...string) Option {
+	return func(opts *Options) {
+		opts.httpMethods = make(map[string]bool, len(methods))
+		for _, m := range methods {
+			opts.httpMethods[m] = true
+		}
+	}
+}
+
+// BackOff sets backoff between attempts, a new backoff is made for each
+// call. Defaults to decorrelated jitter between 50ms and 1s.
+func BackOff(newBackOff func() backoff.BackOff) Option {
+	return func(opts *Options) {
+		opts.newBackOff = newBackOff
+	}
+}
+
+// Budget limits retries to ratio of calls, plus minPerSecond retries per
+// second, so that retries don't overload a failing server. Defaults to
+// 20% and 10 per second.
+func Budget(ratio float64, minPerSecond float64) Option {
+	return func(opts *Options) {
+		opts.budgetRatio = ratio
+		opts.budgetMin = minPerSecond
+	}
+}
+
+// Hedge sends another attempt of idempotent methods if no response arrives
+// within delay, the first response wins.
+func Hedge(delay time.Duration, methods ...string) Option {
+	return func(opts *Options) {
+		opts.hedgeDelay = delay
+		for _, method := range methods {
+			opts.hedgeMethods[method] = true
+		}
+	}
+}
+
+// Timeout sets deadline of calls of full method without deadline.
+func Timeout(method string, timeout time.Duration) Option {
+	return func(opts *Options) {
+		opts.timeouts[method] = timeout
+	}
+}
+
+// DefaultTimeout sets deadline of calls without deadline or method timeout.
+func DefaultTimeout(timeout time.Duration) Option {
+	return func(opts *Options) {
+		opts.defaultTimeout = timeout
+	}
+}
+
+// TimeoutsFromConfig loads method timeouts from config, e.g.
+//
+//	[app.client.grpc.timeout]
+//	  "/pkg.Service/Method" = "500ms"
+func TimeoutsFromConfig(key string) Option {
+	return func(opts *Options) {
+		for method, v := range hera.GetStringMap(key) {
+			timeout, err := time.ParseDuration(fmt.Sprint(v))
+			if err != nil {
+				panic(fmt.Sprintf("retry: invalid timeout of %s.%s: %v", key, method, err))
+			}
+			opts.timeouts[method] = timeout
+		}
+	}
+}
@@ -0,0 +1,243 @@
+package retry
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/sevenNt/ares/plugin/backoff"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// MetadataRetryPushback is the trailer key of server retry hint, a negative
+// or invalid value means not to retry.
+const MetadataRetryPushback = "grpc-retry-pushback-ms"
+
+// Retry is a gRPC client interceptor retrying and hedging calls.
+type Retry struct {
+	Options
+	budget *budget
+}
+
+// New constructs a new retry interceptor.
+func New(opts ...Option) *Retry {
+	options := Options{
+		maxAttempts: 3,
+		codes:       map[codes.Code]bool{codes.Unavailable: true},
+		statuses: map[int]bool{
+			http.StatusBadGateway:         true,
+			http.StatusServiceUnavailable: true,
+			http.StatusGatewayTimeout:     true,
+		},
+		httpMethods: map[string]bool{
+			http.MethodGet:     true,
+			http.MethodHead:    true,
+			http.MethodOptions: true,
+			http.MethodPut:     true,
+			http.MethodDelete:  true,
+		},
+		newBackOff: func() backoff.BackOff {
+			return backoff.NewDecorrelatedJitterBackOff(50*time.Millisecond, time.Second)
+		},
+		budgetRatio:  0.2,
+		budgetMin:    10,
+		hedgeMethods: make(map[string]bool),
+		timeouts:     make(map[string]time.Duration),
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return &Retry{
+		Options: options,
+		budget:  newBudget(options.budgetRatio, options.budgetMin),
+	}
+}
+
+// UnaryClientIntercept implements gRPC unary client interceptor interface.
+func (r *Retry) UnaryClientIntercept() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx, cancel := r.withTimeout(ctx, method)
+		defer cancel()
+
+		r.budget.deposit()
+		if r.hedgeDelay > 0 && r.hedgeMethods[method] {
+			return r.hedge(ctx, method, req, reply, cc, invoker, opts...)
+		}
+		return r.retry(ctx, method, req, reply, cc, invoker, opts...)
+	}
+}
+
+// StreamClientIntercept implements gRPC stream client interceptor interface,
+// streams are not retried but get method timeouts.
+func (r *Retry) StreamClientIntercept() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		timeout := r.timeout(method)
+		if _, ok := ctx.Deadline(); ok || timeout <= 0 {
+			return streamer(ctx, desc, cc, method, opts...)
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		cs, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		return &timeoutStream{ClientStream: cs, cancel: cancel}, nil
+	}
+}
+
+// timeoutStream releases stream timeout once the stream finishes.
+type timeoutStream struct {
+	grpc.ClientStream
+	cancel context.CancelFunc
+}
+
+func (s *timeoutStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err != nil {
+		s.cancel()
+	}
+	return err
+}
+
+// Label returns the plugin label.
+func (r *Retry) Label() string {
+	if r.label != "" {
+		return r.label
+	}
+	return "retry"
+}
+
+func (r *Retry) timeout(method string) time.Duration {
+	if timeout, ok := r.timeouts[method]; ok {
+		return timeout
+	}
+	return r.defaultTimeout
+}
+
+func (r *Retry) withTimeout(ctx context.Context, method string) (context.Context, context.CancelFunc) {
+	timeout := r.timeout(method)
+	if _, ok := ctx.Deadline(); ok || timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+func (r *Retry) retry(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	b := r.newBackOff()
+	for attempt := 1; ; attempt++ {
+		var trailer metadata.MD
+		err := invoker(ctx, method, req, reply, cc, append(opts, grpc.Trailer(&trailer))...)
+		if err == nil || attempt >= r.maxAttempts || !r.retryable(err) {
+			return err
+		}
+
+		wait, ok := pushback(trailer)
+		if !ok {
+			wait = b.Next()
+		}
+		if wait < 0 || !r.budget.withdraw() {
+			return err
+		}
+
+		t := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return err
+		case <-t.C:
+		}
+	}
+}
+
+type attemptResult struct {
+	reply interface{}
+	err   error
+}
+
+// hedge sends attempts every hedge delay until one succeeds, retryable
+// failures start the next attempt at once.
+func (r *Retry) hedge(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make(chan attemptResult, r.maxAttempts)
+	send := func() {
+		// each attempt decodes into its own reply, the winner is copied back
+		rep := reflect.New(reflect.TypeOf(reply).Elem()).Interface()
+		go func() {
+			err := invoker(ctx, method, req, rep, cc, opts...)
+			results <- attemptResult{reply: rep, err: err}
+		}()
+	}
+
+	sent, pending := 1, 1
+	send()
+	timer := time.NewTimer(r.hedgeDelay)
+	defer timer.Stop()
+
+	var err error
+	for {
+		select {
+		case <-timer.C:
+			if sent < r.maxAttempts && r.budget.withdraw() {
+				sent++
+				pending++
+				send()
+				timer.Reset(r.hedgeDelay)
+			}
+		case res := <-results:
+			pending--
+			if res.err == nil {
+				reflect.ValueOf(reply).Elem().Set(reflect.ValueOf(res.reply).Elem())
+				return nil
+			}
+			err = res.err
+			if !r.retryable(err) {
+				return err
+			}
+			if sent < r.maxAttempts && r.budget.withdraw() {
+				sent++
+				pending++
+				send()
+			} else if pending == 0 {
+				return err
+			}
+		case <-ctx.Done():
+			if err == nil {
+				err = contextError(ctx.Err())
+			}
+			return err
+		}
+	}
+}
+
+func (r *Retry) retryable(err error) bool {
+	s, _ := status.FromError(err)
+	return r.codes[s.Code()]
+}
+
+// pushback parses server retry hint from trailer.
+func pushback(md metadata.MD) (time.Duration, bool) {
+	vs := md[MetadataRetryPushback]
+	if len(vs) == 0 {
+		return 0, false
+	}
+	ms, err := strconv.Atoi(vs[0])
+	if err != nil || ms < 0 {
+		return backoff.Stop, true
+	}
+	return time.Duration(ms) * time.Millisecond, true
+}
+
+func contextError(err error) error {
+	if err == context.DeadlineExceeded {
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+	return status.Error(codes.Canceled, err.Error())
+}
@@ -0,0 +1,141 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sevenNt/ares/plugin"
+	"github.com/sevenNt/ares/plugin/backoff"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type reply struct{ Msg string }
+
+func TestRetryUnavailable(t *testing.T) {
+	r := New(BackOff(func() backoff.BackOff { return &backoff.ZeroBackOff{} }), MaxAttempts(3))
+	calls := 0
+	invoker := func(ctx context.Context, method string, req, rep interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		calls++
+		if calls < 3 {
+			return status.Error(codes.Unavailable, "unavailable")
+		}
+		rep.(*reply).Msg = "ok"
+		return nil
+	}
+
+	var rep reply
+	if err := r.UnaryClientIntercept()(context.Background(), "/svc/M", nil, &rep, nil, invoker); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls != 3 || rep.Msg != "ok" {
+		t.Fatalf("calls = %d, msg = %q", calls, rep.Msg)
+	}
+}
+
+func TestRetryNotRetryable(t *testing.T) {
+	r := New()
+	calls := 0
+	invoker := func(ctx context.Context, method string, req, rep interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		calls++
+		return status.Error(codes.InvalidArgument, "invalid")
+	}
+	err := r.UnaryClientIntercept()(context.Background(), "/svc/M", nil, &reply{}, nil, invoker)
+	if status.Code(err) != codes.InvalidArgument || calls != 1 {
+		t.Fatalf("err = %v, calls = %d", err, calls)
+	}
+}
+
+func TestPushback(t *testing.T) {
+	if _, ok := pushback(metadata.MD{}); ok {
+		t.Fatal("pushback without trailer")
+	}
+	if d, ok := pushback(metadata.Pairs(MetadataRetryPushback, "20")); !ok || d != 20*time.Millisecond {
+		t.Fatalf("pushback = %v, %v", d, ok)
+	}
+	if d, _ := pushback(metadata.Pairs(MetadataRetryPushback, "-1")); d != backoff.Stop {
+		t.Fatalf("pushback = %v, want stop", d)
+	}
+}
+
+func TestTimeout(t *testing.T) {
+	r := New(Timeout("/svc/M", 10*time.Millisecond))
+	invoker := func(ctx context.Context, method string, req, rep interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		deadline, ok := ctx.Deadline()
+		if !ok || time.Until(deadline) > 10*time.Millisecond {
+			t.Fatalf("deadline not applied")
+		}
+		return nil
+	}
+	r.UnaryClientIntercept()(context.Background(), "/svc/M", nil, &reply{}, nil, invoker)
+}
+
+func TestHedge(t *testing.T) {
+	r := New(Hedge(5*time.Millisecond, "/svc/M"))
+	var calls int32
+	invoker := func(ctx context.Context, method string, req, rep interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			// first attempt hangs until cancelled
+			<-ctx.Done()
+			return ctx.Err()
+		}
+		rep.(*reply).Msg = "hedged"
+		return nil
+	}
+
+	var rep reply
+	if err := r.UnaryClientIntercept()(context.Background(), "/svc/M", nil, &rep, nil, invoker); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rep.Msg != "hedged" {
+		t.Fatalf("msg = %q", rep.Msg)
+	}
+}
+
+func TestRoundTripper(t *testing.T) {
+	r := New(BackOff(func() backoff.BackOff { return &backoff.ZeroBackOff{} }))
+	calls := 0
+	rt := r.RoundTripper(plugin.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		calls++
+		status := http.StatusServiceUnavailable
+		if calls == 2 {
+			status = http.StatusOK
+		}
+		return &http.Response{StatusCode: status, Body: ioutil.NopCloser(strings.NewReader("")), Header: http.Header{}}, nil
+	}))
+
+	req, _ := http.NewRequest("GET", "http://example.com", nil)
+	resp, err := rt.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusOK || calls != 2 {
+		t.Fatalf("resp = %v, err = %v, calls = %d", resp, err, calls)
+	}
+}
+
+func TestRoundTripperMethods(t *testing.T) {
+	calls := 0
+	next := plugin.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		calls++
+		return nil, errors.New("connection reset")
+	})
+
+	req, _ := http.NewRequest("POST", "http://example.com", strings.NewReader("order"))
+	New(BackOff(func() backoff.BackOff { return &backoff.ZeroBackOff{} })).RoundTripper(next).RoundTrip(req)
+	if calls != 1 {
+		t.Fatalf("POST calls = %d", calls)
+	}
+
+	calls = 0
+	req, _ = http.NewRequest("POST", "http://example.com", strings.NewReader("order"))
+	New(BackOff(func() backoff.BackOff { return &backoff.ZeroBackOff{} }), HTTPMethods("POST")).RoundTripper(next).RoundTrip(req)
+	if calls != 3 {
+		t.Fatalf("POST calls with HTTPMethods = %d", calls)
+	}
+}
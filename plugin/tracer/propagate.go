@@ -0,0 +1,82 @@
+package tracer
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sevenNt/ares/application"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// HeaderTimeout carries remaining deadline of caller in milliseconds.
+	HeaderTimeout = "X-Ares-Timeout"
+	// HeaderClientID carries app id of caller.
+	HeaderClientID = "DY-Client-ID"
+	// HeaderClientUID carries app uuid of caller.
+	HeaderClientUID = "DY-Client-UID"
+)
+
+// TraceKeys are trace keys propagated from incoming requests to outgoing
+// ones, which are written by mw.Trace, interceptor.TraceWrite and request
+// id of logger plugin.
+var TraceKeys = []string{"t", "s", "p", "tstt", "a", "x-request-id"}
+
+// Outgoing returns context with outgoing metadata appended with trace keys
+// of incoming metadata and caller identity, existing outgoing metadata is
+// kept.
+func Outgoing(ctx context.Context) context.Context {
+	out, _ := metadata.FromOutgoingContext(ctx)
+	out = out.Copy()
+	if in, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, key := range TraceKeys {
+			if len(out[key]) == 0 && len(in[key]) > 0 {
+				out[key] = in[key]
+			}
+		}
+	}
+	out[strings.ToLower(HeaderClientID)] = []string{application.ID()}
+	out[strings.ToLower(HeaderClientUID)] = []string{application.UUID()}
+	return metadata.NewOutgoingContext(ctx, out)
+}
+
+// InjectHeader sets trace keys, remaining deadline and caller identity of
+// ctx into HTTP header.
+func InjectHeader(ctx context.Context, header http.Header) {
+	md, _ := metadata.FromOutgoingContext(Outgoing(ctx))
+	for _, key := range TraceKeys {
+		if vs := md[key]; len(vs) > 0 && header.Get(key) == "" {
+			header.Set(key, vs[0])
+		}
+	}
+	header.Set(HeaderClientID, application.ID())
+	header.Set(HeaderClientUID, application.UUID())
+	if deadline, ok := ctx.Deadline(); ok {
+		if timeout := time.Until(deadline); timeout > 0 {
+			header.Set(HeaderTimeout, strconv.FormatInt(int64(timeout/time.Millisecond), 10))
+		}
+	}
+}
+
+// ExtractHeader returns context with trace keys of HTTP header as outgoing
+// metadata, and remaining deadline of caller if any. The returned cancel
+// must be called.
+func ExtractHeader(ctx context.Context, header http.Header) (context.Context, context.CancelFunc) {
+	out, _ := metadata.FromOutgoingContext(ctx)
+	out = out.Copy()
+	for _, key := range TraceKeys {
+		if v := header.Get(key); v != "" && len(out[key]) == 0 {
+			out[key] = []string{v}
+		}
+	}
+	ctx = metadata.NewOutgoingContext(ctx, out)
+
+	ms, err := strconv.ParseInt(header.Get(HeaderTimeout), 10, 64)
+	if err != nil || ms <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, time.Duration(ms)*time.Millisecond)
+}
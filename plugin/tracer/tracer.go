@@ -2,11 +2,11 @@ package tracer
 
 import (
 	"context"
+	"net/http"
 
-	"github.com/sevenNt/ares/application"
+	"github.com/sevenNt/ares/plugin"
 	"github.com/sevenNt/ares/server/echo"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/metadata"
 )
 
 type Tracer struct {
@@ -29,35 +29,51 @@ func Default() *Tracer {
 	return New()
 }
 
-// Func implements Middleware interface.
+// Func implements Middleware interface, trace keys and deadline of request
+// are carried by context of echo.Context to outgoing calls.
 func (t *Tracer) Func() echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c *echo.Context) (err error) {
+			ctx, cancel := ExtractHeader(c.Context, c.Request().Header())
+			defer cancel()
+			c.SetContext(ctx)
 			return next(c)
 		}
 	}
 }
 
+// UnaryClientIntercept appends trace keys and caller identity to outgoing metadata.
 func (t *Tracer) UnaryClientIntercept() grpc.UnaryClientInterceptor {
-	return func(parentCtx context.Context, method string, req, rep interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
-		ctx := metadata.NewOutgoingContext(parentCtx, metadata.Pairs(
-			"DY-Client-ID", application.ID(),
-			"DY-Client-UID", application.UUID(),
-		))
-		return invoker(ctx, method, req, rep, cc, opts...)
+	return func(ctx context.Context, method string, req, rep interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(Outgoing(ctx), method, req, rep, cc, opts...)
 	}
 }
 
+// StreamClientIntercept appends trace keys and caller identity to outgoing metadata.
 func (t *Tracer) StreamClientIntercept() grpc.StreamClientInterceptor {
-	return func(parentCtx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (cs grpc.ClientStream, err error) {
-		ctx := metadata.NewOutgoingContext(parentCtx, metadata.Pairs(
-			"DY-Client-ID", application.ID(),
-			"DY-Client-UID", application.UUID(),
-		))
-		return streamer(ctx, desc, cc, method, opts...)
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (cs grpc.ClientStream, err error) {
+		return streamer(Outgoing(ctx), desc, cc, method, opts...)
 	}
 }
 
+// RoundTripper wraps HTTP client transport with trace keys, remaining
+// deadline and caller identity of request context.
+func (t *Tracer) RoundTripper(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return plugin.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		r := new(http.Request)
+		*r = *req
+		r.Header = make(http.Header, len(req.Header)+len(TraceKeys)+3)
+		for k, v := range req.Header {
+			r.Header[k] = v
+		}
+		InjectHeader(req.Context(), r.Header)
+		return next.RoundTrip(r)
+	})
+}
+
 func (t *Tracer) Label() string {
 	return "tracer"
 }
@@ -0,0 +1,61 @@
+package tracer
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/sevenNt/ares/plugin"
+	"github.com/sevenNt/ares/server/echo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestUnaryClientInterceptAppends(t *testing.T) {
+	ctx := metadata.NewOutgoingContext(context.Background(), metadata.Pairs("x-user", "1"))
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("t", "trace", "tstt", "1"))
+
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		if md["x-user"][0] != "1" || md["t"][0] != "trace" || md["tstt"][0] != "1" {
+			t.Errorf("metadata = %v", md)
+		}
+		if len(md["dy-client-id"]) != 1 {
+			t.Errorf("caller id missing: %v", md)
+		}
+		return nil
+	}
+	Default().UnaryClientIntercept()(ctx, "/svc/M", nil, nil, nil, invoker)
+}
+
+func TestHTTPPropagation(t *testing.T) {
+	var header http.Header
+	rt := Default().RoundTripper(plugin.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		header = req.Header
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	}))
+
+	s := echo.NewServer(nil)
+	s.GET("/", func(c *echo.Context) error {
+		if _, ok := c.Deadline(); !ok {
+			t.Error("deadline of caller not applied")
+		}
+		req, _ := http.NewRequest("GET", "http://upstream/", nil)
+		_, err := rt.RoundTrip(req.WithContext(c))
+		return err
+	}, Default())
+
+	req := httptest.NewRequest("GET", "/", nil)
+	req.Header.Set("t", "trace")
+	req.Header.Set(HeaderTimeout, "1000")
+	s.ServeHTTP(httptest.NewRecorder(), req)
+
+	if header.Get("t") != "trace" {
+		t.Errorf("trace key not propagated: %v", header)
+	}
+	if ms, err := strconv.Atoi(header.Get(HeaderTimeout)); err != nil || ms <= 0 || ms > 1000 {
+		t.Errorf("timeout = %q", header.Get(HeaderTimeout))
+	}
+}
@@ -0,0 +1,268 @@
+package tracing
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/sevenNt/ares/application"
+)
+
+// Exporter exports finished spans in batches.
+type Exporter interface {
+	Export(spans []*SpanData) error
+	Shutdown() error
+}
+
+// OTLPExporter exports spans to OTLP/HTTP collector in JSON encoding, e.g.
+// http://127.0.0.1:4318/v1/traces.
+type OTLPExporter struct {
+	Endpoint    string
+	ServiceName string
+	Client      *http.Client
+}
+
+// NewOTLPExporter constructs a new OTLP/HTTP exporter, service name
+// defaults to application name if it's empty.
+func NewOTLPExporter(endpoint, serviceName string) *OTLPExporter {
+	return &OTLPExporter{
+		Endpoint:    endpoint,
+		ServiceName: serviceNameOr(serviceName),
+		Client:      &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+func serviceNameOr(name string) string {
+	if name == "" {
+		return application.Name()
+	}
+	return name
+}
+
+type (
+	otlpValue struct {
+		StringValue string `json:"stringValue"`
+	}
+	otlpAttribute struct {
+		Key   string    `json:"key"`
+		Value otlpValue `json:"value"`
+	}
+	otlpStatus struct {
+		Code    int    `json:"code,omitempty"`
+		Message string `json:"message,omitempty"`
+	}
+	otlpSpan struct {
+		TraceID           string          `json:"traceId"`
+		SpanID            string          `json:"spanId"`
+		ParentSpanID      string          `json:"parentSpanId,omitempty"`
+		Name              string          `json:"name"`
+		Kind              SpanKind        `json:"kind"`
+		StartTimeUnixNano string          `json:"startTimeUnixNano"`
+		EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+		Attributes        []otlpAttribute `json:"attributes,omitempty"`
+		Status            otlpStatus      `json:"status"`
+	}
+	otlpRequest struct {
+		ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+	}
+	otlpResourceSpans struct {
+		Resource struct {
+			Attributes []otlpAttribute `json:"attributes"`
+		} `json:"resource"`
+		ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+	}
+	otlpScopeSpans struct {
+		Scope struct {
+			Name string `json:"name"`
+		} `json:"scope"`
+		Spans []otlpSpan `json:"spans"`
+	}
+)
+
+// Export implements Exporter interface.
+func (e *OTLPExporter) Export(spans []*SpanData) error {
+	ss := otlpScopeSpans{Spans: make([]otlpSpan, 0, len(spans))}
+	ss.Scope.Name = "github.com/sevenNt/ares/plugin/tracing"
+	for _, span := range spans {
+		s := otlpSpan{
+			TraceID:           span.Context.TraceID.String(),
+			SpanID:            span.Context.SpanID.String(),
+			Name:              span.Name,
+			Kind:              span.Kind,
+			StartTimeUnixNano: strconv.FormatInt(span.Start.UnixNano(), 10),
+			EndTimeUnixNano:   strconv.FormatInt(span.End.UnixNano(), 10),
+		}
+		if span.ParentID.IsValid() {
+			s.ParentSpanID = span.ParentID.String()
+		}
+		for k, v := range span.Attributes {
+			s.Attributes = append(s.Attributes, otlpAttribute{Key: k, Value: otlpValue{StringValue: v}})
+		}
+		if span.Error {
+			// STATUS_CODE_ERROR
+			s.Status = otlpStatus{Code: 2, Message: span.Message}
+		}
+		ss.Spans = append(ss.Spans, s)
+	}
+
+	rs := otlpResourceSpans{ScopeSpans: []otlpScopeSpans{ss}}
+	rs.Resource.Attributes = []otlpAttribute{{Key: "service.name", Value: otlpValue{StringValue: e.ServiceName}}}
+	return postJSON(e.Client, e.Endpoint, otlpRequest{ResourceSpans: []otlpResourceSpans{rs}})
+}
+
+// Shutdown implements Exporter interface.
+func (e *OTLPExporter) Shutdown() error { return nil }
+
+// ZipkinExporter exports spans to Zipkin v2 JSON API, e.g.
+// http://127.0.0.1:9411/api/v2/spans.
+type ZipkinExporter struct {
+	Endpoint    string
+	ServiceName string
+	Client      *http.Client
+}
+
+// NewZipkinExporter constructs a new Zipkin exporter, service name defaults
+// to application name if it's empty.
+func NewZipkinExporter(endpoint, serviceName string) *ZipkinExporter {
+	return &ZipkinExporter{
+		Endpoint:    endpoint,
+		ServiceName: serviceNameOr(serviceName),
+		Client:      &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+type zipkinSpan struct {
+	TraceID       string            `json:"traceId"`
+	ID            string            `json:"id"`
+	ParentID      string            `json:"parentId,omitempty"`
+	Name          string            `json:"name"`
+	Kind          string            `json:"kind,omitempty"`
+	Timestamp     int64             `json:"timestamp"`
+	Duration      int64             `json:"duration"`
+	LocalEndpoint map[string]string `json:"localEndpoint"`
+	Tags          map[string]string `json:"tags,omitempty"`
+}
+
+// Export implements Exporter interface.
+func (e *ZipkinExporter) Export(spans []*SpanData) error {
+	zs := make([]zipkinSpan, 0, len(spans))
+	for _, span := range spans {
+		s := zipkinSpan{
+			TraceID:       span.Context.TraceID.String(),
+			ID:            span.Context.SpanID.String(),
+			Name:          span.Name,
+			Timestamp:     span.Start.UnixNano() / int64(time.Microsecond),
+			Duration:      int64(span.End.Sub(span.Start) / time.Microsecond),
+			LocalEndpoint: map[string]string{"serviceName": e.ServiceName},
+			Tags:          make(map[string]string, len(span.Attributes)+1),
+		}
+		if span.ParentID.IsValid() {
+			s.ParentID = span.ParentID.String()
+		}
+		switch span.Kind {
+		case SpanKindServer:
+			s.Kind = "SERVER"
+		case SpanKindClient:
+			s.Kind = "CLIENT"
+		}
+		for k, v := range span.Attributes {
+			s.Tags[k] = v
+		}
+		if span.Error {
+			s.Tags["error"] = span.Message
+		}
+		zs = append(zs, s)
+	}
+	return postJSON(e.Client, e.Endpoint, zs)
+}
+
+// Shutdown implements Exporter interface.
+func (e *ZipkinExporter) Shutdown() error { return nil }
+
+func postJSON(client *http.Client, endpoint string, v interface{}) error {
+	body, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Post(endpoint, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("export spans to %s: %s", endpoint, resp.Status)
+	}
+	return nil
+}
+
+// FileExporter writes spans as JSON lines to file, which is opened once.
+type FileExporter struct {
+	mu   sync.Mutex
+	file *os.File
+	enc  *json.Encoder
+}
+
+// NewFileExporter constructs a new file exporter appending to path.
+func NewFileExporter(path string) (*FileExporter, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &FileExporter{file: file, enc: json.NewEncoder(file)}, nil
+}
+
+type fileSpan struct {
+	TraceID    string            `json:"trace_id"`
+	SpanID     string            `json:"span_id"`
+	ParentID   string            `json:"parent_id,omitempty"`
+	Name       string            `json:"name"`
+	Kind       SpanKind          `json:"kind"`
+	Start      time.Time         `json:"start"`
+	Duration   time.Duration     `json:"duration"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+	Error      string            `json:"error,omitempty"`
+}
+
+// Export implements Exporter interface.
+func (e *FileExporter) Export(spans []*SpanData) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, span := range spans {
+		s := fileSpan{
+			TraceID:    span.Context.TraceID.String(),
+			SpanID:     span.Context.SpanID.String(),
+			Name:       span.Name,
+			Kind:       span.Kind,
+			Start:      span.Start,
+			Duration:   span.End.Sub(span.Start),
+			Attributes: span.Attributes,
+			Error:      span.Message,
+		}
+		if span.ParentID.IsValid() {
+			s.ParentID = span.ParentID.String()
+		}
+		if err := e.enc.Encode(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Shutdown implements Exporter interface.
+func (e *FileExporter) Shutdown() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.file.Close()
+}
@@ -0,0 +1,59 @@
+package tracing
+
+import "time"
+
+type Option func(*Options)
+type Options struct {
+	label         string
+	exporter      Exporter
+	sampler       Sampler
+	sampleErrors  bool
+	batchSize     int
+	queueSize     int
+	flushInterval time.Duration
+}
+
+func Label(label string) Option {
+	return func(opts *Options) {
+		opts.label = label
+	}
+}
+
+// WithExporter sets exporter of spans, spans are dropped without exporter.
+func WithExporter(exporter Exporter) Option {
+	return func(opts *Options) {
+		opts.exporter = exporter
+	}
+}
+
+// WithSampler sets sampler of new traces, defaults to sampling 10% of traces.
+func WithSampler(sampler Sampler) Option {
+	return func(opts *Options) {
+		opts.sampler = sampler
+	}
+}
+
+// SampleErrors sets whether failed spans are exported even if their traces
+// are not sampled, defaults to true.
+func SampleErrors(sample bool) Option {
+	return func(opts *Options) {
+		opts.sampleErrors = sample
+	}
+}
+
+// Batch sets max spans of an export and interval flushing spans, defaults
+// to 512 and 5 seconds.
+func Batch(size int, interval time.Duration) Option {
+	return func(opts *Options) {
+		opts.batchSize = size
+		opts.flushInterval = interval
+	}
+}
+
+// QueueSize sets max spans waiting to export, spans are dropped once queue
+// is full, defaults to 2048.
+func QueueSize(size int) Option {
+	return func(opts *Options) {
+		opts.queueSize = size
+	}
+}
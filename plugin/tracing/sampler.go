@@ -0,0 +1,43 @@
+package tracing
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// Sampler decides whether to sample a new trace, spans with sampled parent
+// follow the parent.
+type Sampler interface {
+	Sample(id TraceID) bool
+}
+
+// SamplerFunc is an adapter to allow the use of ordinary functions as Sampler.
+type SamplerFunc func(id TraceID) bool
+
+// Sample implements Sampler interface.
+func (f SamplerFunc) Sample(id TraceID) bool { return f(id) }
+
+// AlwaysSample samples every trace.
+func AlwaysSample() Sampler {
+	return SamplerFunc(func(TraceID) bool { return true })
+}
+
+// NeverSample samples no trace, failed and forced traces are still exported.
+func NeverSample() Sampler {
+	return SamplerFunc(func(TraceID) bool { return false })
+}
+
+// RatioSampler samples ratio of traces by trace id, so that services
+// sampling the same ratio agree on the same traces.
+func RatioSampler(ratio float64) Sampler {
+	if ratio >= 1 {
+		return AlwaysSample()
+	}
+	if ratio <= 0 {
+		return NeverSample()
+	}
+	bound := uint64(ratio * math.MaxUint64)
+	return SamplerFunc(func(id TraceID) bool {
+		return binary.BigEndian.Uint64(id[8:]) < bound
+	})
+}
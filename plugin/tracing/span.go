@@ -0,0 +1,136 @@
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+)
+
+type (
+	// TraceID is a W3C trace id.
+	TraceID [16]byte
+	// SpanID is a W3C span id.
+	SpanID [8]byte
+)
+
+// String returns hex encoding of trace id.
+func (id TraceID) String() string { return hex.EncodeToString(id[:]) }
+
+// IsValid reports whether trace id is not all zeros.
+func (id TraceID) IsValid() bool { return id != TraceID{} }
+
+// String returns hex encoding of span id.
+func (id SpanID) String() string { return hex.EncodeToString(id[:]) }
+
+// IsValid reports whether span id is not all zeros.
+func (id SpanID) IsValid() bool { return id != SpanID{} }
+
+func newTraceID() (id TraceID) {
+	rand.Read(id[:])
+	return
+}
+
+func newSpanID() (id SpanID) {
+	rand.Read(id[:])
+	return
+}
+
+// SpanContext is the propagated part of span.
+type SpanContext struct {
+	TraceID TraceID
+	SpanID  SpanID
+	Sampled bool
+}
+
+// IsValid reports whether both trace id and span id are valid.
+func (sc SpanContext) IsValid() bool {
+	return sc.TraceID.IsValid() && sc.SpanID.IsValid()
+}
+
+// SpanKind is the kind of span.
+type SpanKind int
+
+// Span kinds, valued as OTLP.
+const (
+	SpanKindInternal SpanKind = 1
+	SpanKindServer   SpanKind = 2
+	SpanKindClient   SpanKind = 3
+)
+
+// SpanData is a finished span handed to exporters.
+type SpanData struct {
+	Name       string
+	Kind       SpanKind
+	Context    SpanContext
+	ParentID   SpanID
+	Start      time.Time
+	End        time.Time
+	Attributes map[string]string
+	Error      bool
+	Message    string
+}
+
+// Span is an operation being traced.
+type Span struct {
+	tracing *Tracing
+	forced  bool
+
+	mu   sync.Mutex
+	data SpanData
+	done bool
+}
+
+// SpanContext returns span context of span.
+func (s *Span) SpanContext() SpanContext {
+	return s.data.Context
+}
+
+// SetAttribute sets an attribute of span.
+func (s *Span) SetAttribute(key, value string) {
+	s.mu.Lock()
+	s.data.Attributes[key] = value
+	s.mu.Unlock()
+}
+
+// SetError marks span as failed with err, nil err is ignored.
+func (s *Span) SetError(err error) {
+	if err == nil {
+		return
+	}
+	s.mu.Lock()
+	s.data.Error = true
+	s.data.Message = err.Error()
+	s.mu.Unlock()
+}
+
+// End finishes span, which is exported if it's sampled, failed or forced.
+func (s *Span) End() {
+	s.mu.Lock()
+	if s.done {
+		s.mu.Unlock()
+		return
+	}
+	s.done = true
+	s.data.End = time.Now()
+	data := s.data
+	s.mu.Unlock()
+
+	if data.Context.Sampled || s.forced || (data.Error && s.tracing.sampleErrors) {
+		s.tracing.export(&data)
+	}
+}
+
+type spanKey struct{}
+
+// ContextWithSpan returns context carrying span.
+func ContextWithSpan(ctx context.Context, span *Span) context.Context {
+	return context.WithValue(ctx, spanKey{}, span)
+}
+
+// SpanFromContext returns span of context, or nil.
+func SpanFromContext(ctx context.Context) *Span {
+	span, _ := ctx.Value(spanKey{}).(*Span)
+	return span
+}
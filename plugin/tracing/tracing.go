@@ -0,0 +1,327 @@
+package tracing
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/sevenNt/ares/plugin"
+	"github.com/sevenNt/ares/server/echo"
+	"github.com/sevenNt/wzap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Tracing creates spans of HTTP and gRPC servers and clients, which are
+// propagated by W3C traceparent.
+type Tracing struct {
+	Options
+	queue     chan *SpanData
+	flush     chan chan struct{}
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+// New constructs a new tracing plugin.
+func New(opts ...Option) *Tracing {
+	options := Options{
+		sampler:       RatioSampler(0.1),
+		sampleErrors:  true,
+		batchSize:     512,
+		queueSize:     2048,
+		flushInterval: 5 * time.Second,
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	t := &Tracing{
+		Options: options,
+		queue:   make(chan *SpanData, options.queueSize),
+		flush:   make(chan chan struct{}),
+		closed:  make(chan struct{}),
+	}
+	go t.run()
+	return t
+}
+
+// Start starts a span as child of span in ctx, or a new trace.
+func (t *Tracing) Start(ctx context.Context, name string, kind SpanKind) (context.Context, *Span) {
+	var parent SpanContext
+	var force bool
+	if p := SpanFromContext(ctx); p != nil {
+		parent, force = p.SpanContext(), p.forced
+	}
+	span := t.startSpan(parent, force, name, kind)
+	return ContextWithSpan(ctx, span), span
+}
+
+func (t *Tracing) startSpan(parent SpanContext, force bool, name string, kind SpanKind) *Span {
+	sc := SpanContext{SpanID: newSpanID()}
+	if parent.IsValid() {
+		sc.TraceID, sc.Sampled = parent.TraceID, parent.Sampled
+	} else {
+		sc.TraceID = newTraceID()
+		sc.Sampled = t.sampler.Sample(sc.TraceID)
+	}
+	return &Span{
+		tracing: t,
+		forced:  force,
+		data: SpanData{
+			Name:       name,
+			Kind:       kind,
+			Context:    sc,
+			ParentID:   parent.SpanID,
+			Start:      time.Now(),
+			Attributes: make(map[string]string),
+		},
+	}
+}
+
+// Func implements Middleware interface.
+func (t *Tracing) Func() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c *echo.Context) (err error) {
+			header := c.Request().Header()
+			parent, _ := ParseTraceparent(header.Get(HeaderTraceparent))
+			span := t.startSpan(parent, forced(header.Get(HeaderTraceStatus)), c.Request().Method, SpanKindServer)
+			c.SetContext(ContextWithSpan(c.Context, span))
+
+			err = next(c)
+
+			// pattern path is known after routing
+			span.data.Name = c.Request().Method + " " + c.PatternPath()
+			span.SetAttribute("http.method", c.Request().Method)
+			span.SetAttribute("http.route", c.PatternPath())
+			span.SetAttribute("http.status_code", strconv.Itoa(c.Response().Status()))
+			span.SetError(err)
+			if err == nil && c.Response().Status() >= http.StatusInternalServerError {
+				span.SetError(errStatus(c.Response().Status()))
+			}
+			span.End()
+			return err
+		}
+	}
+}
+
+// UnaryServerIntercept implements UnaryIntercept function of Interceptor.
+func (t *Tracing) UnaryServerIntercept() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, span := t.startServerSpan(ctx, info.FullMethod)
+		resp, err := handler(ctx, req)
+		endRPCSpan(span, err)
+		return resp, err
+	}
+}
+
+// StreamServerIntercept implements StreamIntercept function of Interceptor.
+func (t *Tracing) StreamServerIntercept() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, span := t.startServerSpan(ss.Context(), info.FullMethod)
+		err := handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+		endRPCSpan(span, err)
+		return err
+	}
+}
+
+func (t *Tracing) startServerSpan(ctx context.Context, method string) (context.Context, *Span) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	parent, _ := ParseTraceparent(mdValue(md, HeaderTraceparent))
+	span := t.startSpan(parent, forced(mdValue(md, HeaderTraceStatus)), method, SpanKindServer)
+	span.SetAttribute("rpc.method", method)
+	return ContextWithSpan(ctx, span), span
+}
+
+// UnaryClientIntercept implements gRPC unary client interceptor interface.
+func (t *Tracing) UnaryClientIntercept() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx, span := t.startClientSpan(ctx, method)
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		endRPCSpan(span, err)
+		return err
+	}
+}
+
+// StreamClientIntercept implements gRPC stream client interceptor interface.
+func (t *Tracing) StreamClientIntercept() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		ctx, span := t.startClientSpan(ctx, method)
+		cs, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			endRPCSpan(span, err)
+			return nil, err
+		}
+		return &clientStream{ClientStream: cs, span: span}, nil
+	}
+}
+
+func (t *Tracing) startClientSpan(ctx context.Context, method string) (context.Context, *Span) {
+	ctx, span := t.Start(ctx, method, SpanKindClient)
+	span.SetAttribute("rpc.method", method)
+
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	md[HeaderTraceparent] = []string{span.SpanContext().Traceparent()}
+	if span.forced && len(md[HeaderTraceStatus]) == 0 {
+		md[HeaderTraceStatus] = []string{"2"}
+	}
+	return metadata.NewOutgoingContext(ctx, md), span
+}
+
+// RoundTripper wraps HTTP client transport with client spans.
+func (t *Tracing) RoundTripper(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return plugin.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		_, span := t.Start(req.Context(), "HTTP "+req.Method, SpanKindClient)
+		span.SetAttribute("http.method", req.Method)
+		span.SetAttribute("http.url", req.URL.String())
+
+		r := new(http.Request)
+		*r = *req
+		r.Header = make(http.Header, len(req.Header)+2)
+		for k, v := range req.Header {
+			r.Header[k] = v
+		}
+		r.Header.Set(HeaderTraceparent, span.SpanContext().Traceparent())
+		if span.forced && r.Header.Get(HeaderTraceStatus) == "" {
+			r.Header.Set(HeaderTraceStatus, "2")
+		}
+
+		resp, err := next.RoundTrip(r)
+		span.SetError(err)
+		if resp != nil {
+			span.SetAttribute("http.status_code", strconv.Itoa(resp.StatusCode))
+			if resp.StatusCode >= http.StatusInternalServerError {
+				span.SetError(errStatus(resp.StatusCode))
+			}
+		}
+		span.End()
+		return resp, err
+	})
+}
+
+func (t *Tracing) Label() string {
+	if t.label != "" {
+		return t.label
+	}
+	return "tracing"
+}
+
+// Close flushes pending spans and shuts exporter down.
+func (t *Tracing) Close() error {
+	var err error
+	t.closeOnce.Do(func() {
+		done := make(chan struct{})
+		t.flush <- done
+		<-done
+		close(t.closed)
+		if t.exporter != nil {
+			err = t.exporter.Shutdown()
+		}
+	})
+	return err
+}
+
+// Flush exports pending spans.
+func (t *Tracing) Flush() {
+	done := make(chan struct{})
+	select {
+	case t.flush <- done:
+		<-done
+	case <-t.closed:
+	}
+}
+
+func (t *Tracing) export(span *SpanData) {
+	select {
+	case t.queue <- span:
+	default:
+		wzap.Warnf("[tracing] queue is full, span %s dropped", span.Name)
+	}
+}
+
+func (t *Tracing) run() {
+	ticker := time.NewTicker(t.flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*SpanData, 0, t.batchSize)
+	send := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if t.exporter != nil {
+			if err := t.exporter.Export(batch); err != nil {
+				wzap.Warnf("[tracing] export %d spans: %v", len(batch), err)
+			}
+		}
+		batch = make([]*SpanData, 0, t.batchSize)
+	}
+	for {
+		select {
+		case span := <-t.queue:
+			if batch = append(batch, span); len(batch) >= t.batchSize {
+				send()
+			}
+		case <-ticker.C:
+			send()
+		case done := <-t.flush:
+			for n := len(t.queue); n > 0; n-- {
+				batch = append(batch, <-t.queue)
+			}
+			send()
+			close(done)
+		case <-t.closed:
+			return
+		}
+	}
+}
+
+func endRPCSpan(span *Span, err error) {
+	if err != nil {
+		span.SetAttribute("rpc.code", status.Code(err).String())
+		span.SetError(err)
+	}
+	span.End()
+}
+
+func mdValue(md metadata.MD, key string) string {
+	if vs := md[key]; len(vs) > 0 {
+		return vs[0]
+	}
+	return ""
+}
+
+type errStatus int
+
+func (e errStatus) Error() string {
+	return "http status " + strconv.Itoa(int(e))
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+type clientStream struct {
+	grpc.ClientStream
+	span *Span
+}
+
+func (s *clientStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err == io.EOF {
+		s.span.End()
+	} else if err != nil {
+		endRPCSpan(s.span, err)
+	}
+	return err
+}
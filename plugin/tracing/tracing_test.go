@@ -0,0 +1,139 @@
+package tracing
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/sevenNt/ares/server/echo"
+)
+
+// collector is a stand-in of OTLP/HTTP collector.
+type collector struct {
+	mu    sync.Mutex
+	spans []otlpSpan
+}
+
+func (c *collector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req otlpRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, rs := range req.ResourceSpans {
+		for _, ss := range rs.ScopeSpans {
+			c.spans = append(c.spans, ss.Spans...)
+		}
+	}
+}
+
+func TestParseTraceparent(t *testing.T) {
+	sc, ok := ParseTraceparent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	if !ok || !sc.Sampled || sc.TraceID.String() != "4bf92f3577b34da6a3ce929d0e0e4736" || sc.SpanID.String() != "00f067aa0ba902b7" {
+		t.Fatalf("parse = %v, %v", sc, ok)
+	}
+	if sc.Traceparent() != "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01" {
+		t.Fatalf("traceparent = %s", sc.Traceparent())
+	}
+	for _, s := range []string{
+		"",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra",
+	} {
+		if _, ok := ParseTraceparent(s); ok {
+			t.Errorf("parse %q should fail", s)
+		}
+	}
+}
+
+func TestPropagation(t *testing.T) {
+	coll := &collector{}
+	collSrv := httptest.NewServer(coll)
+	defer collSrv.Close()
+
+	tr := New(WithExporter(NewOTLPExporter(collSrv.URL, "test")), WithSampler(AlwaysSample()))
+	defer tr.Close()
+
+	var upstream string
+	upstreamSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		upstream = r.Header.Get(HeaderTraceparent)
+	}))
+	defer upstreamSrv.Close()
+
+	client := &http.Client{Transport: tr.RoundTripper(nil)}
+	s := echo.NewServer(nil)
+	s.GET("/users/:id", func(c *echo.Context) error {
+		req, _ := http.NewRequest("GET", upstreamSrv.URL, nil)
+		resp, err := client.Do(req.WithContext(c))
+		if err != nil {
+			return err
+		}
+		return resp.Body.Close()
+	}, tr)
+
+	req := httptest.NewRequest("GET", "/users/1", nil)
+	req.Header.Set(HeaderTraceparent, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	s.ServeHTTP(httptest.NewRecorder(), req)
+	tr.Flush()
+
+	up, ok := ParseTraceparent(upstream)
+	if !ok || up.TraceID.String() != "4bf92f3577b34da6a3ce929d0e0e4736" {
+		t.Fatalf("upstream traceparent = %q", upstream)
+	}
+	coll.mu.Lock()
+	defer coll.mu.Unlock()
+	if len(coll.spans) != 2 {
+		t.Fatalf("spans = %d, want 2", len(coll.spans))
+	}
+	clientSpan, serverSpan := coll.spans[0], coll.spans[1]
+	if serverSpan.Name != "GET /users/:id" || serverSpan.ParentSpanID != "00f067aa0ba902b7" {
+		t.Errorf("server span = %+v", serverSpan)
+	}
+	if clientSpan.ParentSpanID != serverSpan.SpanID || clientSpan.SpanID != up.SpanID.String() {
+		t.Errorf("client span = %+v", clientSpan)
+	}
+}
+
+type memExporter struct {
+	spans []*SpanData
+}
+
+func (e *memExporter) Export(spans []*SpanData) error {
+	e.spans = append(e.spans, spans...)
+	return nil
+}
+
+func (e *memExporter) Shutdown() error { return nil }
+
+func TestSampling(t *testing.T) {
+	exp := &memExporter{}
+	tr := New(WithExporter(exp), WithSampler(NeverSample()))
+
+	tr.startSpan(SpanContext{}, false, "ok", SpanKindInternal).End()
+	failed := tr.startSpan(SpanContext{}, false, "failed", SpanKindInternal)
+	failed.SetError(errors.New("failed"))
+	failed.End()
+	tr.startSpan(SpanContext{}, true, "forced", SpanKindInternal).End()
+	tr.Close()
+
+	if len(exp.spans) != 2 || exp.spans[0].Name != "failed" || exp.spans[1].Name != "forced" {
+		t.Fatalf("exported = %d spans", len(exp.spans))
+	}
+
+	sampler := RatioSampler(0.5)
+	sampled := 0
+	for i := 0; i < 1000; i++ {
+		if sampler.Sample(newTraceID()) {
+			sampled++
+		}
+	}
+	if sampled < 400 || sampled > 600 {
+		t.Errorf("sampled = %d of 1000 with ratio 0.5", sampled)
+	}
+}
@@ -1,6 +1,7 @@
 package registry
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"sync"
@@ -79,15 +80,23 @@ func (r *etcdRegistry) UnregisterApp() error {
 }
 
 func (r *etcdRegistry) Register(key string, val string) error {
+	return r.RegisterWithMetadata(key, val, nil)
+}
+
+func (r *etcdRegistry) RegisterWithMetadata(key string, val string, md map[string]string) error {
 	// Register registers service from provided service name and address.
 	if r.client == nil || r.resolver == nil {
 		return ErrUninitialRegistry
 	}
 
-	err := r.resolver.Update(r.client.Ctx(), key, naming.Update{
+	update := naming.Update{
 		Op:   naming.Add,
 		Addr: val,
-	})
+	}
+	if md != nil {
+		update.Metadata = md
+	}
+	err := r.resolver.Update(r.client.Ctx(), key, update)
 
 	if err != nil {
 		return err
@@ -124,8 +133,19 @@ func (r *etcdRegistry) UnregisterAll() {
 	}
 }
 
-func (r *etcdRegistry) Watch() (Watcher, error) {
-	return nil, nil
+func (r *etcdRegistry) Watch(opts ...WatchOption) (Watcher, error) {
+	if r.client == nil {
+		return nil, ErrUninitialRegistry
+	}
+
+	var options WatchOptions
+	for _, o := range opts {
+		o(&options)
+	}
+	if options.Service == "" {
+		return nil, errors.New("watch service required")
+	}
+	return newETCDWatcher(r.client, options.Service)
 }
 
 func (r *etcdRegistry) String() string {
@@ -67,6 +67,14 @@ func Register(key string, addr string) error {
 	return defaultRegistry.Register(key, addr)
 }
 
+// RegisterWithMetadata registers addr with node metadata, e.g. weight.
+func RegisterWithMetadata(key string, addr string, md map[string]string) error {
+	if defaultRegistry == nil {
+		return ErrUninitialRegistry
+	}
+	return defaultRegistry.RegisterWithMetadata(key, addr, md)
+}
+
 func Unregister(key string, addr string) error {
 	if defaultRegistry == nil {
 		return ErrUninitialRegistry
@@ -79,3 +87,11 @@ func UnregisterAll() {
 		defaultRegistry.UnregisterAll()
 	}
 }
+
+// Watch watches services from default registry.
+func Watch(opts ...WatchOption) (Watcher, error) {
+	if defaultRegistry == nil {
+		return nil, ErrUninitialRegistry
+	}
+	return defaultRegistry.Watch(opts...)
+}
@@ -31,9 +31,10 @@ func (info AppInfo) Desc() string {
 // Registry provides an interface for service discovery
 type Registry interface {
 	Register(string, string) error
+	RegisterWithMetadata(string, string, map[string]string) error
 	Unregister(string, string) error
 	UnregisterAll()
-	Watch() (Watcher, error)
+	Watch(...WatchOption) (Watcher, error)
 	String() string
 
 	RegisterApp(AppInfo) error
@@ -1,10 +1,136 @@
 package registry
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
 	"github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/mvcc/mvccpb"
+	"google.golang.org/grpc/naming"
 )
 
+// ErrWatcherStopped is returned by Next after the watcher stopped.
+var ErrWatcherStopped = errors.New("watcher stopped")
+
+// WatchOption is used to set options for the watcher.
+type WatchOption func(*WatchOptions)
+
+// WatchOptions wraps watcher options.
+type WatchOptions struct {
+	// Service is the registry key of service to watch, e.g. "http:name:v1:mode".
+	Service string
+}
+
+// WatchService injects the service to watch.
+func WatchService(service string) WatchOption {
+	return func(o *WatchOptions) {
+		o.Service = service
+	}
+}
+
 type etcdWatcher struct {
-	clientv3.WatchChan
-	client *clientv3.Client
+	service string
+	prefix  string
+	cancel  context.CancelFunc
+	wch     clientv3.WatchChan
+	pending []*Result
+}
+
+// newETCDWatcher lists current nodes of service, then watches changes after them.
+func newETCDWatcher(client *clientv3.Client, service string) (*etcdWatcher, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	w := &etcdWatcher{
+		service: service,
+		prefix:  service + "/",
+		cancel:  cancel,
+	}
+
+	resp, err := client.Get(ctx, w.prefix, clientv3.WithPrefix())
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	// current nodes are returned at once by the first Next, even if there
+	// is none, so that callers know when the initial list is complete.
+	initial := &Result{
+		Action:  "create",
+		Service: &Service{Name: service, Nodes: []*Node{}},
+	}
+	for _, kv := range resp.Kvs {
+		if res := w.result("create", kv); res != nil {
+			initial.Service.Nodes = append(initial.Service.Nodes, res.Service.Nodes...)
+		}
+	}
+	w.pending = []*Result{initial}
+	w.wch = client.Watch(ctx, w.prefix, clientv3.WithPrefix(), clientv3.WithRev(resp.Header.Revision+1))
+	return w, nil
+}
+
+// Next implements Watcher interface.
+func (w *etcdWatcher) Next() (*Result, error) {
+	for len(w.pending) == 0 {
+		wresp, ok := <-w.wch
+		if !ok {
+			return nil, ErrWatcherStopped
+		}
+		if err := wresp.Err(); err != nil {
+			return nil, err
+		}
+		for _, ev := range wresp.Events {
+			action := "create"
+			switch {
+			case ev.Type == mvccpb.DELETE:
+				action = "delete"
+			case ev.IsModify():
+				action = "update"
+			}
+			if res := w.result(action, ev.Kv); res != nil {
+				w.pending = append(w.pending, res)
+			}
+		}
+	}
+
+	res := w.pending[0]
+	w.pending = w.pending[1:]
+	return res, nil
+}
+
+// Stop implements Watcher interface.
+func (w *etcdWatcher) Stop() {
+	w.cancel()
+}
+
+// result decodes a node stored by naming.GRPCResolver, whose key is
+// "<service>/<addr>" and value is json encoded naming.Update.
+func (w *etcdWatcher) result(action string, kv *mvccpb.KeyValue) *Result {
+	addr := strings.TrimPrefix(string(kv.Key), w.prefix)
+	node := &Node{
+		ID:      addr,
+		Address: addr,
+	}
+
+	if action != "delete" {
+		var upd naming.Update
+		if err := json.Unmarshal(kv.Value, &upd); err != nil {
+			return nil
+		}
+		node.Address = upd.Addr
+		if md, ok := upd.Metadata.(map[string]interface{}); ok {
+			node.Metadata = make(map[string]string, len(md))
+			for k, v := range md {
+				node.Metadata[k] = fmt.Sprint(v)
+			}
+		}
+	}
+
+	return &Result{
+		Action: action,
+		Service: &Service{
+			Name:  w.service,
+			Nodes: []*Node{node},
+		},
+	}
 }
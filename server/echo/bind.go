@@ -0,0 +1,214 @@
+package echo
+
+import (
+	"encoding"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+// bindSources are request parts bound by struct tags besides body.
+var bindSources = []string{"path", "query", "header", "cookie"}
+
+// bindField is a struct field bound from request parts or with default.
+type bindField struct {
+	index   []int
+	field   reflect.StructField
+	sources map[string]string
+	def     string
+	hasDef  bool
+}
+
+var bindFields sync.Map // reflect.Type -> []bindField
+
+func bindFieldsOf(t reflect.Type) []bindField {
+	if fs, ok := bindFields.Load(t); ok {
+		return fs.([]bindField)
+	}
+	fs := appendBindFields(nil, t, nil)
+	bindFields.Store(t, fs)
+	return fs
+}
+
+func appendBindFields(fs []bindField, t reflect.Type, index []int) []bindField {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		idx := append(append([]int(nil), index...), i)
+		if f.Anonymous && f.Type.Kind() == reflect.Struct {
+			fs = appendBindFields(fs, f.Type, idx)
+			continue
+		}
+		if f.PkgPath != "" {
+			continue
+		}
+		bf := bindField{index: idx, field: f, sources: make(map[string]string)}
+		for _, source := range bindSources {
+			if name := strings.Split(f.Tag.Get(source), ",")[0]; name != "" && name != "-" {
+				bf.sources[source] = name
+			}
+		}
+		bf.def, bf.hasDef = f.Tag.Lookup("default")
+		if len(bf.sources) > 0 || bf.hasDef {
+			fs = append(fs, bf)
+		}
+	}
+	return fs
+}
+
+// bind sets defaults of fields, and binds fields tagged by path, query,
+// header and cookie, e.g.
+//
+//	type ListOrders struct {
+//		UserID int       `path:"id"`
+//		Token  string    `header:"X-Token"`
+//		Session string   `cookie:"sid"`
+//		Page   int       `query:"page" default:"1"`
+//		States []string  `query:"state"`
+//		Since  time.Time `query:"since" time_format:"2006-01-02"`
+//	}
+//
+// Slices are bound from repeated values, values of name[] and comma separated
+// values of queries. The first source present wins if a field has several.
+func (c *Context) bind(obj interface{}) error {
+	v := reflect.ValueOf(obj)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return nil
+	}
+	v = v.Elem()
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	for _, bf := range bindFieldsOf(v.Type()) {
+		field := v.FieldByIndex(bf.index)
+		values, source := c.bindValues(bf)
+		if values == nil && bf.hasDef {
+			values, source = []string{bf.def}, "default"
+		}
+		if values == nil {
+			continue
+		}
+		// comma separated values of queries only, commas of other sources
+		// are part of values, e.g. form bodies
+		if err := setField(field, bf.field, values, source == "query"); err != nil {
+			return fmt.Errorf("bind %s of %s: %v", source, bf.field.Name, err)
+		}
+	}
+	return nil
+}
+
+func (c *Context) bindValues(bf bindField) ([]string, string) {
+	for _, source := range bindSources {
+		name, ok := bf.sources[source]
+		if !ok {
+			continue
+		}
+		switch source {
+		case "path":
+			for _, pname := range c.pnames {
+				if pname == name {
+					return []string{c.Param(name)}, source
+				}
+			}
+		case "query":
+			// comma separated values are split for slices only
+			query := c.request.URL.QueryAll()
+			if values := query[name]; len(values) > 0 {
+				return values, source
+			}
+			if values := query[name+"[]"]; len(values) > 0 {
+				return values, source
+			}
+		case "header":
+			if values := c.request.Header()[http.CanonicalHeaderKey(name)]; len(values) > 0 {
+				return values, source
+			}
+		case "cookie":
+			if cookie, err := c.request.Cookie(name); err == nil {
+				return []string{cookie.Value}, source
+			}
+		}
+	}
+	return nil, ""
+}
+
+var (
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	timeType            = reflect.TypeOf(time.Time{})
+	durationType        = reflect.TypeOf(time.Duration(0))
+)
+
+// setField sets field of struct field sf by values, supporting pointers,
+// slices, encoding.TextUnmarshaler, time.Time and time.Duration besides basic
+// kinds. Comma separated values of slices are split if split is true.
+func setField(field reflect.Value, sf reflect.StructField, values []string, split bool) error {
+	if field.Kind() == reflect.Ptr && !field.Type().Implements(textUnmarshalerType) {
+		if field.IsNil() {
+			field.Set(reflect.New(field.Type().Elem()))
+		}
+		return setField(field.Elem(), sf, values, split)
+	}
+	if field.Kind() == reflect.Slice && !implementsTextUnmarshaler(field) {
+		if split {
+			values = splitValues(values)
+		}
+		slice := reflect.MakeSlice(field.Type(), len(values), len(values))
+		for i, value := range values {
+			if err := setValue(slice.Index(i), sf, value); err != nil {
+				return err
+			}
+		}
+		field.Set(slice)
+		return nil
+	}
+	return setValue(field, sf, values[0])
+}
+
+func setValue(field reflect.Value, sf reflect.StructField, value string) error {
+	if field.Kind() == reflect.Ptr {
+		if field.IsNil() {
+			field.Set(reflect.New(field.Type().Elem()))
+		}
+		if u, ok := field.Interface().(encoding.TextUnmarshaler); ok {
+			return u.UnmarshalText([]byte(value))
+		}
+		return setValue(field.Elem(), sf, value)
+	}
+	if implementsTextUnmarshaler(field) && field.Type() != timeType {
+		return field.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(value))
+	}
+	switch field.Type() {
+	case timeType:
+		return setTimeField(value, sf, field)
+	case durationType:
+		d, err := time.ParseDuration(value)
+		if err == nil {
+			field.SetInt(int64(d))
+		}
+		return err
+	}
+	return setWithProperType(field.Kind(), value, field)
+}
+
+func implementsTextUnmarshaler(field reflect.Value) bool {
+	return field.CanAddr() && reflect.PtrTo(field.Type()).Implements(textUnmarshalerType)
+}
+
+// splitValues splits comma separated values.
+func splitValues(values []string) []string {
+	n := 0
+	for _, value := range values {
+		n += strings.Count(value, ",") + 1
+	}
+	if n == len(values) {
+		return values
+	}
+	split := make([]string, 0, n)
+	for _, value := range values {
+		split = append(split, strings.Split(value, ",")...)
+	}
+	return split
+}
@@ -0,0 +1,143 @@
+package echo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// level is a custom TextUnmarshaler type.
+type level int
+
+func (l *level) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "low":
+		*l = 1
+	case "high":
+		*l = 2
+	default:
+		*l = 0
+	}
+	return nil
+}
+
+type listOrders struct {
+	UserID  int           `path:"id"`
+	Token   string        `header:"X-Token"`
+	Session string        `cookie:"sid"`
+	Page    int           `query:"page" default:"1"`
+	Size    *int          `query:"size" default:"20"`
+	Q       string        `query:"q"`
+	States  []string      `query:"state"`
+	IDs     []int64       `query:"ids"`
+	Since   time.Time     `query:"since" time_format:"2006-01-02" time_utc:"true"`
+	Until   time.Time     `query:"until" time_format:"unix"`
+	Timeout time.Duration `query:"timeout"`
+	Level   level         `query:"level"`
+	Levels  []level       `query:"levels"`
+}
+
+func TestBindSources(t *testing.T) {
+	s := NewServer(nil)
+	var got listOrders
+	s.GET("/users/:id/orders", func(c *Context) error {
+		return c.Bind(&got)
+	})
+
+	req := httptest.NewRequest(GET, "/users/7/orders?q=a,b&state=new&state=paid,done&ids[]=1&ids[]=2"+
+		"&since=2018-05-01&until=1525132800&timeout=1.5s&level=high&levels=low,high", nil)
+	req.Header.Set("X-Token", "token")
+	req.AddCookie(&http.Cookie{Name: "sid", Value: "session"})
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, req)
+	if rec.Code != 200 {
+		t.Fatalf("status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+
+	size := 20
+	want := listOrders{
+		UserID:  7,
+		Token:   "token",
+		Session: "session",
+		Page:    1,
+		Size:    &size,
+		Q:       "a,b",
+		States:  []string{"new", "paid", "done"},
+		IDs:     []int64{1, 2},
+		Since:   time.Date(2018, 5, 1, 0, 0, 0, 0, time.UTC),
+		Until:   time.Unix(1525132800, 0),
+		Timeout: 1500 * time.Millisecond,
+		Level:   2,
+		Levels:  []level{1, 2},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("bound\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func TestBindPathAndBody(t *testing.T) {
+	s := NewServer(nil)
+	type updateUser struct {
+		ID   int    `path:"id" json:"-"`
+		Name string `json:"name" validate:"required"`
+	}
+	var got updateUser
+	s.PUT("/users/:id", func(c *Context) error {
+		got = updateUser{}
+		if err := c.Bind(&got); err != nil {
+			return c.Problem(ProblemOf(err))
+		}
+		return nil
+	})
+
+	req := httptest.NewRequest(PUT, "/users/3", strings.NewReader(`{"name":"bob"}`))
+	req.Header.Set(HeaderContentType, MIMEApplicationJSON)
+	s.ServeHTTP(httptest.NewRecorder(), req)
+	if got.ID != 3 || got.Name != "bob" {
+		t.Errorf("bound %+v", got)
+	}
+
+	// requests without body are validated
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(PUT, "/users/3", nil))
+	if rec.Code != 422 {
+		t.Errorf("status = %d, want 422", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(PUT, "/users/x", strings.NewReader(`{}`)))
+	if rec.Code != 400 {
+		t.Errorf("status = %d, want 400", rec.Code)
+	}
+}
+
+func TestBindFormCommas(t *testing.T) {
+	type note struct {
+		Lines []string `form:"lines"`
+	}
+	s := NewServer(nil)
+	var got note
+	s.POST("/notes", func(c *Context) error {
+		return c.Bind(&got)
+	})
+
+	// commas of form bodies are part of values
+	req := httptest.NewRequest(POST, "/notes", strings.NewReader("lines=a,b&lines=c"))
+	req.Header.Set(HeaderContentType, MIMEApplicationForm)
+	s.ServeHTTP(httptest.NewRecorder(), req)
+	if !reflect.DeepEqual(got.Lines, []string{"a,b", "c"}) {
+		t.Errorf("lines = %q", got.Lines)
+	}
+}
+
+func TestQueryS(t *testing.T) {
+	req := newRequest(httptest.NewRequest(GET, "/?a=1&a=2&b[]=1&b[]=2&c=1,2", nil))
+	for _, name := range []string{"a", "b", "c"} {
+		if got := req.QueryS(name); !reflect.DeepEqual(got, []string{"1", "2"}) {
+			t.Errorf("QueryS(%s) = %v", name, got)
+		}
+	}
+}
@@ -8,10 +8,11 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
-	"github.com/asaskevich/govalidator"
 	"github.com/golang/protobuf/proto"
+	"github.com/sevenNt/ares/codec"
 )
 
 // Binder is the interface that wraps the Bind method.
@@ -37,27 +38,70 @@ var (
 	ProtoBufBinder = protobufBinder{}
 )
 
+var binders = struct {
+	sync.RWMutex
+	m map[string]Binder
+}{
+	m: map[string]Binder{
+		MIMEApplicationJSON:     JSONBinder,
+		MIMEApplicationXML:      XMLBinder,
+		MIMEApplicationProtobuf: ProtoBufBinder,
+		MIMEMultipartForm:       FormMultipartBinder,
+		MIMEApplicationForm:     FormBinder,
+	},
+}
+
+// RegisterBinder registers binder of request content type, e.g.
+// application/cbor. Content types without binder are bound by codecs
+// registered in codec package, so a codec registered once serves both binding
+// and content negotiation.
+func RegisterBinder(contentType string, b Binder) {
+	binders.Lock()
+	binders.m[mediaType(contentType)] = b
+	binders.Unlock()
+}
+
 func defaultBinder(method, contentType string) Binder {
 	if strings.ToUpper(method) == "GET" {
 		return QueryBinder
 	}
 
+	contentType = mediaType(contentType)
+
+	binders.RLock()
+	b, ok := binders.m[contentType]
+	binders.RUnlock()
+	if ok {
+		return b
+	}
+	if c, ok := codec.Get(contentType); ok {
+		return codecBinder{mime: contentType, codec: c}
+	}
+	//case MIMEPOSTForm, MIMEMultipartPOSTForm:
+	return FormBinder
+}
+
+// mediaType returns lower cased media type without parameters.
+func mediaType(contentType string) string {
 	contentType = strings.SplitN(contentType, ";", 2)[0]
+	return strings.ToLower(strings.TrimSpace(contentType))
+}
+
+// codecBinder binds request body decoded by codec.
+type codecBinder struct {
+	mime  string
+	codec codec.Codec
+}
 
-	switch contentType {
-	case MIMEApplicationJSON, MIMEApplicationJSONCharsetUTF8:
-		return JSONBinder
-	case MIMEApplicationXML, MIMEApplicationXMLCharsetUTF8:
-		return XMLBinder
-	case MIMEApplicationProtobuf:
-		return ProtoBufBinder
-	case MIMEMultipartForm:
-		return FormMultipartBinder
-	case MIMEApplicationForm:
-		return FormBinder
-	default: //case MIMEPOSTForm, MIMEMultipartPOSTForm:
-		return FormBinder
+func (b codecBinder) MIME() string {
+	return b.mime
+}
+
+func (b codecBinder) Bind(req *Request, obj interface{}) error {
+	if err := b.codec.Decode(req.Body, obj); err != nil {
+		return err
 	}
+	return validate(req, obj, jsonNames)
 }
 
 type queryBinder struct{}
@@ -68,11 +112,11 @@ func (b queryBinder) MIME() string {
 
 func (b queryBinder) Bind(req *Request, obj interface{}) error {
 	values := req.URL.QueryAll()
-	if err := mapForm(obj, values); err != nil {
+	if err := mapForm(obj, values, true); err != nil {
 		return err
 	}
 
-	return validate(obj)
+	return validate(req, obj, formNames)
 }
 
 type jsonBinder struct {
@@ -92,7 +136,7 @@ func (b jsonBinder) Bind(req *Request, obj interface{}) error {
 	if err := decoder.Decode(obj); err != nil {
 		return err
 	}
-	return validate(obj)
+	return validate(req, obj, jsonNames)
 }
 
 type xmlBinder struct{}
@@ -106,7 +150,7 @@ func (b xmlBinder) Bind(req *Request, obj interface{}) error {
 	if err := decoder.Decode(obj); err != nil {
 		return err
 	}
-	return validate(obj)
+	return validate(req, obj, jsonNames)
 }
 
 type formBinder struct{}
@@ -123,10 +167,10 @@ func (formBinder) Bind(req *Request, obj interface{}) error {
 	}
 
 	req.ParseMultipartForm(32 << 10) // 32 MB
-	if err := mapForm(obj, req.Form); err != nil {
+	if err := mapForm(obj, req.Form, false); err != nil {
 		return err
 	}
-	return validate(obj)
+	return validate(req, obj, formNames)
 }
 
 func (formPostBinder) MIME() string {
@@ -137,10 +181,10 @@ func (formPostBinder) Bind(req *Request, obj interface{}) error {
 	if err := req.ParseForm(); err != nil {
 		return err
 	}
-	if err := mapForm(obj, req.PostForm); err != nil {
+	if err := mapForm(obj, req.PostForm, false); err != nil {
 		return err
 	}
-	return validate(obj)
+	return validate(req, obj, formNames)
 }
 
 func (formMultipartBinder) MIME() string {
@@ -151,10 +195,10 @@ func (formMultipartBinder) Bind(req *Request, obj interface{}) error {
 	if err := req.ParseMultipartForm(32 << 10); err != nil {
 		return err
 	}
-	if err := mapForm(obj, req.MultipartForm.Value); err != nil {
+	if err := mapForm(obj, req.MultipartForm.Value, false); err != nil {
 		return err
 	}
-	return validate(obj)
+	return validate(req, obj, formNames)
 }
 
 type protobufBinder struct{}
@@ -172,15 +216,12 @@ func (protobufBinder) Bind(req *Request, obj interface{}) error {
 	if err = proto.Unmarshal(buf, obj.(proto.Message)); err != nil {
 		return err
 	}
-	return validate(obj)
+	return validate(req, obj, jsonNames)
 }
 
-func validate(obj interface{}) error {
-	_, err := govalidator.ValidateStruct(obj)
-	return err
-}
-
-func mapForm(ptr interface{}, form map[string][]string) error {
+// mapForm maps form to fields of ptr, comma separated values of slices are
+// split if split is true, e.g. of queries.
+func mapForm(ptr interface{}, form map[string][]string, split bool) error {
 	typ := reflect.TypeOf(ptr).Elem()
 	val := reflect.ValueOf(ptr).Elem()
 	for i := 0; i < typ.NumField(); i++ {
@@ -198,8 +239,8 @@ func mapForm(ptr interface{}, form map[string][]string) error {
 			// if "form" tag is nil, we inspect if the field is a struct.
 			// this would not make sense for JSON parsing but it does for a form
 			// since data is flatten
-			if structFieldKind == reflect.Struct {
-				err := mapForm(structField.Addr().Interface(), form)
+			if structFieldKind == reflect.Struct && !implementsTextUnmarshaler(structField) {
+				err := mapForm(structField.Addr().Interface(), form, split)
 				if err != nil {
 					return err
 				}
@@ -207,30 +248,12 @@ func mapForm(ptr interface{}, form map[string][]string) error {
 			}
 		}
 		inputValue, exists := form[inputFieldName]
-		if !exists {
+		if !exists || len(inputValue) == 0 {
 			continue
 		}
 
-		numElems := len(inputValue)
-		if structFieldKind == reflect.Slice && numElems > 0 {
-			sliceOf := structField.Type().Elem().Kind()
-			slice := reflect.MakeSlice(structField.Type(), numElems, numElems)
-			for i := 0; i < numElems; i++ {
-				if err := setWithProperType(sliceOf, inputValue[i], slice.Index(i)); err != nil {
-					return err
-				}
-			}
-			val.Field(i).Set(slice)
-		} else {
-			if _, isTime := structField.Interface().(time.Time); isTime {
-				if err := setTimeField(inputValue[0], typeField, structField); err != nil {
-					return err
-				}
-				continue
-			}
-			if err := setWithProperType(typeField.Type.Kind(), inputValue[0], structField); err != nil {
-				return err
-			}
+		if err := setField(structField, typeField, inputValue, split); err != nil {
+			return err
 		}
 	}
 	return nil
@@ -316,10 +339,12 @@ func setFloatField(val string, bitSize int, field reflect.Value) error {
 	return err
 }
 
+// setTimeField parses val by layout of time_format tag, which defaults to
+// RFC3339, or is one of unix, unixmilli and unixnano for timestamps.
 func setTimeField(val string, structField reflect.StructField, value reflect.Value) error {
 	timeFormat := structField.Tag.Get("time_format")
 	if timeFormat == "" {
-		return errors.New("Blank time format")
+		timeFormat = time.RFC3339
 	}
 
 	if val == "" {
@@ -327,6 +352,25 @@ func setTimeField(val string, structField reflect.StructField, value reflect.Val
 		return nil
 	}
 
+	switch timeFormat {
+	case "unix", "unixmilli", "unixnano":
+		n, err := strconv.ParseInt(val, 10, 64)
+		if err != nil {
+			return err
+		}
+		var t time.Time
+		switch timeFormat {
+		case "unix":
+			t = time.Unix(n, 0)
+		case "unixmilli":
+			t = time.Unix(0, n*int64(time.Millisecond))
+		default:
+			t = time.Unix(0, n)
+		}
+		value.Set(reflect.ValueOf(t))
+		return nil
+	}
+
 	l := time.Local
 	if isUTC, _ := strconv.ParseBool(structField.Tag.Get("time_utc")); isUTC {
 		l = time.UTC
@@ -45,6 +45,7 @@ const (
 	StatusRequestedRangeNotSatisfiable  = 416
 	StatusExpectationFailed             = 417
 	StatusTeapot                        = 418
+	StatusUnprocessableEntity           = 422
 	StatusPreconditionRequired          = 428
 	StatusTooManyRequests               = 429
 	StatusRequestHeaderFieldsTooLarge   = 431
@@ -100,6 +101,7 @@ var statusText = map[int]string{
 	StatusRequestedRangeNotSatisfiable: "Requested Range Not Satisfiable",
 	StatusExpectationFailed:            "Expectation Failed",
 	StatusTeapot:                       "I'm a teapot",
+	StatusUnprocessableEntity:          "Unprocessable Entity",
 	StatusPreconditionRequired:         "Precondition Required",
 	StatusTooManyRequests:              "Too Many Requests",
 	StatusRequestHeaderFieldsTooLarge:  "Request Header Fields Too Large",
@@ -131,6 +133,10 @@ var (
 	ErrRendererNotRegistered = errors.New("renderer not registered")
 	ErrInvalidRedirectCode   = errors.New("invalid redirect status code")
 	ErrCookieNotFound        = errors.New("cookie not found")
+	ErrStreamClosed          = errors.New("stream closed")
+	ErrClientGone            = errors.New("client gone")
+	ErrRequestEntityTooLarge = errors.New("request entity too large")
+	ErrInvalidEvent          = errors.New("invalid event: id or event contains line break")
 )
 
 // HTTP methods
@@ -165,6 +171,9 @@ const (
 	MIMETextPlainCharsetUTF8             = MIMETextPlain + "; " + charsetUTF8
 	MIMEMultipartForm                    = "multipart/form-data"
 	MIMEOctetStream                      = "application/octet-stream"
+	MIMETextEventStream                  = "text/event-stream"
+	MIMEApplicationNDJSON                = "application/x-ndjson"
+	MIMEApplicationProblemJSON           = "application/problem+json"
 )
 
 const (
@@ -175,6 +184,7 @@ const (
 const (
 	HeaderUserAgent                     = "User-Agent"
 	HeaderAccept                        = "Accept"
+	HeaderAcceptLanguage                = "Accept-Language"
 	HeaderAcceptEncoding                = "Accept-Encoding"
 	HeaderAllow                         = "Allow"
 	HeaderAuthorization                 = "Authorization"
@@ -185,8 +195,12 @@ const (
 	HeaderCookie                        = "Cookie"
 	HeaderSetCookie                     = "Set-Cookie"
 	HeaderIfModifiedSince               = "If-Modified-Since"
+	HeaderIfNoneMatch                   = "If-None-Match"
+	HeaderETag                          = "ETag"
+	HeaderRange                         = "Range"
 	HeaderLastModified                  = "Last-Modified"
 	HeaderLocation                      = "Location"
+	HeaderRetryAfter                    = "Retry-After"
 	HeaderUpgrade                       = "Upgrade"
 	HeaderVary                          = "Vary"
 	HeaderWWWAuthenticate               = "WWW-Authenticate"
@@ -194,6 +208,12 @@ const (
 	HeaderXHTTPMethodOverride           = "X-HTTP-Method-Override"
 	HeaderXForwardedFor                 = "X-Forwarded-For"
 	HeaderXRealIP                       = "X-Real-IP"
+	HeaderXRateLimitLimit               = "X-RateLimit-Limit"
+	HeaderXRateLimitRemaining           = "X-RateLimit-Remaining"
+	HeaderXRequestID                    = "X-Request-ID"
+	HeaderXAccelBuffering               = "X-Accel-Buffering"
+	HeaderCacheControl                  = "Cache-Control"
+	HeaderConnection                    = "Connection"
 	HeaderServer                        = "Server"
 	HeaderOrigin                        = "Origin"
 	HeaderAccessControlRequestMethod    = "Access-Control-Request-Method"
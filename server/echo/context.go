@@ -33,12 +33,14 @@ type Context struct {
 	request  *Request
 	response *Response
 	handler  HandlerFunc
-	params   map[string]string
+	pnames   []string
+	pvalues  []string
 	render   Render
 	reqPool  sync.Pool
 	repPool  sync.Pool
 	path     string // request path: /v1/user/123
 	ppath    string // pattern path: /v1/user/:id
+	body     limitedBody
 }
 
 func newContext() *Context {
@@ -46,10 +48,22 @@ func newContext() *Context {
 }
 
 func (c *Context) reset(req *http.Request, res http.ResponseWriter, s *Server) {
-	c.Context = context.Background()
-	c.request = newRequest(req)
-	c.response = newResponse(res)
-	c.params = make(map[string]string)
+	c.Context = req.Context()
+	if c.request == nil {
+		c.request = newRequest(req)
+		c.response = newResponse(res)
+	} else {
+		c.request.reset(req)
+		c.response.reset(res)
+	}
+	if cap(c.pvalues) < s.router.maxParams {
+		c.pvalues = make([]string, s.router.maxParams)
+	}
+	c.pvalues = c.pvalues[:cap(c.pvalues)]
+	c.pnames = nil
+	c.handler = nil
+	c.ppath = ""
+	c.body.reset(nil, 0)
 	// TODO server\writer\render\handler\id
 }
 
@@ -58,9 +72,19 @@ func (c *Context) ID() string {
 	return c.id
 }
 
-// Bind binds the request body into provided type `i`. The default binder
-// does it based on Content-Type header.
+// Bind binds the request into provided type `i`. Fields tagged by path,
+// query, header and cookie are bound from request parts, fields tagged by
+// default get default values, and then the request body is bound by the
+// default binder based on Content-Type header.
 func (c *Context) Bind(i interface{}) error {
+	if err := c.bind(i); err != nil {
+		return err
+	}
+	// requests without body are only validated
+	if c.request.Method != GET && c.request.ContentLength == 0 &&
+		(c.request.Body == nil || c.request.Body == http.NoBody) {
+		return validate(c.request, i, jsonNames)
+	}
 	b := defaultBinder(c.request.Method, c.request.Header().Get(HeaderContentType))
 	return c.BindWith(i, b)
 }
@@ -168,7 +192,12 @@ func (c *Context) SetHandler(h HandlerFunc) {
 
 // Param returns path parameter by name.
 func (c *Context) Param(name string) (value string) {
-	return c.params[name]
+	for i, pname := range c.pnames {
+		if pname == name {
+			return c.pvalues[i]
+		}
+	}
+	return ""
 }
 
 // ParamInt returns path int parameter by name.
@@ -343,22 +372,41 @@ func (c *Context) Redirect(code int, url string) error {
 	return nil
 }
 
-// ServeContent replies to the request using the content in the provided ReadSeeker.
+// ServeContent replies to the request using the content in the provided
+// ReadSeeker, handling conditional, range and HEAD requests.
 func (c *Context) ServeContent(content io.ReadSeeker, name string, modtime time.Time) error {
-	req := c.request
-	res := c.response
+	c.response.Header().Set(HeaderContentType, ContentTypeByExtension(name))
+	c.serveContent(content, name, modtime)
+	return nil
+}
+
+// serveContent serves content by http.ServeContent, content is written
+// through writer of response if it encodes body, e.g. by gzip middleware, so
+// that ranges and strong ETag of content don't apply.
+func (c *Context) serveContent(content io.ReadSeeker, name string, modtime time.Time) {
+	res, req := c.response, c.request.Request
+	if !res.wrapped || res.Header().Get(HeaderContentEncoding) == "" {
+		http.ServeContent(res, req, name, modtime, content)
+		return
+	}
 
-	if t, err := time.Parse(http.TimeFormat, req.Header().Get(HeaderIfModifiedSince)); err == nil && modtime.Before(t.Add(1*time.Second)) {
-		res.Header().Del(HeaderContentType)
-		res.Header().Del(HeaderContentLength)
-		return c.NoContent(http.StatusNotModified)
+	if etag := res.Header().Get(HeaderETag); etag != "" && !strings.HasPrefix(etag, "W/") {
+		res.Header().Set(HeaderETag, "W/"+etag)
+	}
+	if req.Header.Get(HeaderRange) != "" {
+		req = req.WithContext(req.Context())
+		req.Header = cloneHeader(req.Header)
+		req.Header.Del(HeaderRange)
 	}
+	http.ServeContent(encodedWriter{res}, req, name, modtime, content)
+}
 
-	res.Header().Set(HeaderContentType, ContentTypeByExtension(name))
-	res.Header().Set(HeaderLastModified, modtime.UTC().Format(http.TimeFormat))
-	res.WriteHeader(http.StatusOK)
-	_, err := io.Copy(res, content)
-	return err
+func cloneHeader(h http.Header) http.Header {
+	clone := make(http.Header, len(h))
+	for k, v := range h {
+		clone[k] = append([]string(nil), v...)
+	}
+	return clone
 }
 
 // ContentTypeByExtension returns the MIME type associated with the file based on
@@ -21,81 +21,72 @@ func (g *Group) Use(ms ...Middleware) {
 }
 
 // GET implements `Echo#GET()` for sub-routes within the Group.
-func (g *Group) GET(path string, handler HandlerFunc, ms ...Middleware) *Group {
+func (g *Group) GET(path string, handler HandlerFunc, ms ...Middleware) *Route {
 	if strings.HasSuffix(path, "/") {
 		path = path[:len(path)-1]
 	}
-	g.server.add(GET, g.path+path, handler, append(g.ms, ms...)...)
-	return g
+	return g.server.add(GET, g.path+path, handler, append(g.ms, ms...)...)
 }
 
 // PUT implements `Echo#PUT()` for sub-routes within the Group.
-func (g *Group) PUT(path string, handler HandlerFunc, ms ...Middleware) *Group {
+func (g *Group) PUT(path string, handler HandlerFunc, ms ...Middleware) *Route {
 	if strings.HasSuffix(path, "/") {
 		path = path[:len(path)-1]
 	}
-	g.server.add(PUT, g.path+path, handler, append(g.ms, ms...)...)
-	return g
+	return g.server.add(PUT, g.path+path, handler, append(g.ms, ms...)...)
 }
 
 // POST implements `Echo#POST()` for sub-routes within the Group.
-func (g *Group) POST(path string, handler HandlerFunc, ms ...Middleware) *Group {
+func (g *Group) POST(path string, handler HandlerFunc, ms ...Middleware) *Route {
 	if strings.HasSuffix(path, "/") {
 		path = path[:len(path)-1]
 	}
-	g.server.add(POST, g.path+path, handler, append(g.ms, ms...)...)
-	return g
+	return g.server.add(POST, g.path+path, handler, append(g.ms, ms...)...)
 }
 
 // DELETE implements `Echo#DELETE()` for sub-routes within the Group.
-func (g *Group) DELETE(path string, handler HandlerFunc, ms ...Middleware) *Group {
+func (g *Group) DELETE(path string, handler HandlerFunc, ms ...Middleware) *Route {
 	if strings.HasSuffix(path, "/") {
 		path = path[:len(path)-1]
 	}
-	g.server.add(DELETE, g.path+path, handler, append(g.ms, ms...)...)
-	return g
+	return g.server.add(DELETE, g.path+path, handler, append(g.ms, ms...)...)
 }
 
 // PATCH implements `Echo#PATCH()` for sub-routes within the Group.
-func (g *Group) PATCH(path string, handler HandlerFunc, ms ...Middleware) *Group {
+func (g *Group) PATCH(path string, handler HandlerFunc, ms ...Middleware) *Route {
 	if strings.HasSuffix(path, "/") {
 		path = path[:len(path)-1]
 	}
-	g.server.add(PATCH, g.path+path, handler, append(g.ms, ms...)...)
-	return g
+	return g.server.add(PATCH, g.path+path, handler, append(g.ms, ms...)...)
 }
 
 // OPTIONS implements `Echo#OPTIONS()` for sub-routes within the Group.
-func (g *Group) OPTIONS(path string, handler HandlerFunc, ms ...Middleware) *Group {
+func (g *Group) OPTIONS(path string, handler HandlerFunc, ms ...Middleware) *Route {
 	if strings.HasSuffix(path, "/") {
 		path = path[:len(path)-1]
 	}
-	g.server.add(OPTIONS, g.path+path, handler, append(g.ms, ms...)...)
-	return g
+	return g.server.add(OPTIONS, g.path+path, handler, append(g.ms, ms...)...)
 }
 
 // HEAD implements `Echo#HEAD()` for sub-routes within the Group.
-func (g *Group) HEAD(path string, handler HandlerFunc, ms ...Middleware) *Group {
+func (g *Group) HEAD(path string, handler HandlerFunc, ms ...Middleware) *Route {
 	if strings.HasSuffix(path, "/") {
 		path = path[:len(path)-1]
 	}
-	g.server.add(HEAD, g.path+path, handler, append(g.ms, ms...)...)
-	return g
+	return g.server.add(HEAD, g.path+path, handler, append(g.ms, ms...)...)
 }
 
 // WS implements `Echo#WS()` for sub-routes within the Group.
-func (g *Group) WS(path string, handler websocket.Handler, ms ...Middleware) *Group {
+func (g *Group) WS(path string, handler websocket.Handler, ms ...Middleware) *Route {
 	if strings.HasSuffix(path, "/") {
 		path = path[:len(path)-1]
 	}
-	g.server.add(GET, g.path+path, g.server.wsWrapper(handler), append(g.ms, ms...)...)
-	return g
+	return g.server.add(GET, g.path+path, g.server.wsWrapper(handler), append(g.ms, ms...)...).setHandler(handler)
 }
 
-func (g *Group) GRPCProxy(path string, handler interface{}, ms ...Middleware) *Group {
+func (g *Group) GRPCProxy(path string, handler interface{}, ms ...Middleware) *Route {
 	if strings.HasSuffix(path, "/") {
 		path = path[:len(path)-1]
 	}
-	g.server.add(POST, g.path+path, g.server.grpcProxyWrapper(handler), append(g.ms, ms...)...)
-	return g
+	return g.server.add(POST, g.path+path, g.server.grpcProxyWrapper(handler), append(g.ms, ms...)...).setHandler(handler)
 }
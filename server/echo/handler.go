@@ -63,6 +63,14 @@ func notFoundHandler(c *Context) error {
 	return c.String(StatusNotFound, http.StatusNotFound)
 }
 
+func methodNotAllowedHandler(c *Context) error {
+	return c.String(StatusMethodNotAllowed, http.StatusMethodNotAllowed)
+}
+
+func optionsHandler(c *Context) error {
+	return c.NoContent(StatusNoContent)
+}
+
 func errHandler(c *Context) error {
 	return c.String(StatusInternalServerError, http.StatusInternalServerError)
 }
@@ -0,0 +1,79 @@
+package echo
+
+import (
+	"crypto/tls"
+	"io"
+	"net"
+	"net/http"
+
+	"github.com/sevenNt/ares/server"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+)
+
+// newHTTPServer returns HTTP server of s by HTTP config.
+func newHTTPServer(s *Server, config server.HTTPConfig) *http.Server {
+	srv := &http.Server{
+		Handler:           s,
+		ReadTimeout:       config.ReadTimeout,
+		ReadHeaderTimeout: config.ReadHeaderTimeout,
+		WriteTimeout:      config.WriteTimeout,
+		IdleTimeout:       config.IdleTimeout,
+		MaxHeaderBytes:    config.MaxHeaderBytes,
+	}
+	if config.H2C {
+		srv.Handler = h2c.NewHandler(s, &http2.Server{IdleTimeout: config.IdleTimeout})
+	}
+	return srv
+}
+
+// listen returns listener of HTTP server, which is TLS listener if TLS is
+// enabled.
+func (s *Server) listen() (net.Listener, error) {
+	tlsConfig, err := newTLSConfig(s.config)
+	if err != nil || tlsConfig == nil {
+		return s.listener, err
+	}
+
+	s.httpServer.TLSConfig = tlsConfig
+	if s.config.DisableHTTP2 {
+		// non-nil map disables HTTP/2
+		s.httpServer.TLSNextProto = make(map[string]func(*http.Server, *tls.Conn, http.Handler))
+	} else if err := http2.ConfigureServer(s.httpServer, &http2.Server{IdleTimeout: s.config.IdleTimeout}); err != nil {
+		return nil, err
+	}
+	return tls.NewListener(s.listener, s.httpServer.TLSConfig), nil
+}
+
+// limitedBody limits bytes of request body, requests over limit are replied
+// with 413 whatever handlers return.
+type limitedBody struct {
+	io.ReadCloser
+	n        int64
+	exceeded bool
+}
+
+func (b *limitedBody) reset(body io.ReadCloser, limit int64) {
+	b.ReadCloser, b.n, b.exceeded = body, limit, false
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	if int64(len(p)) > b.n+1 {
+		// one more byte tells bodies over limit from bodies of limit bytes
+		p = p[:b.n+1]
+	}
+	n, err := b.ReadCloser.Read(p)
+	if int64(n) > b.n {
+		n, b.n, b.exceeded = int(b.n), 0, true
+		return n, ErrRequestEntityTooLarge
+	}
+	b.n -= int64(n)
+	return n, err
+}
+
+func requestEntityTooLargeHandler(c *Context) error {
+	// rest of body is not read
+	c.response.Header().Set(HeaderConnection, "close")
+	c.response.Header().Set(HeaderContentType, MIMETextPlainCharsetUTF8)
+	return c.String(StatusRequestEntityTooLarge, http.StatusRequestEntityTooLarge)
+}
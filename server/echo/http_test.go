@@ -0,0 +1,220 @@
+package echo
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sevenNt/ares/server"
+	"golang.org/x/net/http2"
+)
+
+func TestServerBodyLimit(t *testing.T) {
+	s := NewServer(nil, server.BodyLimit(4))
+	called := false
+	s.POST("/upload", func(c *Context) error {
+		called = true
+		body, err := ioutil.ReadAll(c.Request().Body)
+		if err != nil {
+			return err
+		}
+		return c.String(StatusOK, string(body))
+	})
+
+	// declared length over limit is replied before handler
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(POST, "/upload", strings.NewReader("12345")))
+	if rec.Code != StatusRequestEntityTooLarge || called {
+		t.Errorf("declared = %d, called = %v", rec.Code, called)
+	}
+
+	// unknown length over limit is replied once read
+	rec = httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(POST, "/upload", io.MultiReader(strings.NewReader("123456789"))))
+	if rec.Code != StatusRequestEntityTooLarge || !called {
+		t.Errorf("chunked = %d, called = %v", rec.Code, called)
+	}
+
+	rec = httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(POST, "/upload", io.MultiReader(strings.NewReader("1234"))))
+	if rec.Code != StatusOK || rec.Body.String() != "1234" {
+		t.Errorf("limit = %d %q", rec.Code, rec.Body.String())
+	}
+
+	// problems of binding errors are replied with 413
+	s.POST("/bind", func(c *Context) error {
+		var v map[string]string
+		if err := c.Bind(&v); err != nil {
+			return c.Problem(ProblemOf(err))
+		}
+		return c.String(StatusOK, "bound")
+	})
+	req := httptest.NewRequest(POST, "/bind", io.MultiReader(strings.NewReader(`{"a":"123456789"}`)))
+	req.Header.Set(HeaderContentType, MIMEApplicationJSON)
+	rec = httptest.NewRecorder()
+	s.ServeHTTP(rec, req)
+	if rec.Code != StatusRequestEntityTooLarge || rec.Header().Get(HeaderContentType) != MIMETextPlainCharsetUTF8 {
+		t.Errorf("bind = %d %v", rec.Code, rec.Header())
+	}
+	if p := ProblemOf(ErrRequestEntityTooLarge); p.Status != StatusRequestEntityTooLarge {
+		t.Errorf("problem = %+v", p)
+	}
+
+	// pooled contexts don't carry exceeded limit to later requests
+	s.GET("/json", func(c *Context) error {
+		return c.JSON(StatusOK, map[string]int{"a": 1})
+	})
+	s.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(POST, "/upload", io.MultiReader(strings.NewReader("123456789"))))
+	rec = httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(GET, "/json", nil))
+	if rec.Code != StatusOK {
+		t.Errorf("after exceeded = %d", rec.Code)
+	}
+}
+
+// testCert writes certificate and key signed by parent, or self-signed CA if
+// parent is nil.
+func testCert(t *testing.T, dir, name string, serial int64, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	if parent == nil {
+		tmpl.IsCA = true
+		tmpl.KeyUsage = x509.KeyUsageCertSign
+		tmpl.BasicConstraintsValid = true
+		parent, parentKey = tmpl, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ioutil.WriteFile(filepath.Join(dir, name+".crt"), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600)
+	ioutil.WriteFile(filepath.Join(dir, name+".key"), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600)
+	cert, _ := x509.ParseCertificate(der)
+	return cert, key
+}
+
+func serveTest(t *testing.T, opts ...server.Option) (*Server, string) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := NewServer(lis, opts...)
+	s.GET("/proto", func(c *Context) error {
+		return c.String(StatusOK, c.Request().Proto)
+	})
+	go s.Serve()
+	return s, lis.Addr().String()
+}
+
+func TestServerTLS(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tls")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	ca, caKey := testCert(t, dir, "ca", 1, nil, nil)
+	testCert(t, dir, "server", 2, ca, caKey)
+	testCert(t, dir, "client", 3, ca, caKey)
+
+	s, addr := serveTest(t,
+		server.TLS(filepath.Join(dir, "server.crt"), filepath.Join(dir, "server.key")),
+		server.ClientCA(filepath.Join(dir, "ca.crt"), false),
+	)
+	defer s.Stop()
+	if s.Scheme() != "https" {
+		t.Errorf("scheme = %s", s.Scheme())
+	}
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca)
+	clientCert, err := tls.LoadX509KeyPair(filepath.Join(dir, "client.crt"), filepath.Join(dir, "client.key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	get := func(certs ...tls.Certificate) (*http.Response, error) {
+		client := &http.Client{Transport: &http.Transport{
+			TLSClientConfig:   &tls.Config{RootCAs: roots, Certificates: certs},
+			ForceAttemptHTTP2: true,
+		}}
+		return client.Get("https://" + addr + "/proto")
+	}
+
+	res, err := get(clientCert)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if string(body) != "HTTP/2.0" || res.TLS.PeerCertificates[0].SerialNumber.Int64() != 2 {
+		t.Errorf("proto = %s, serial = %v", body, res.TLS.PeerCertificates[0].SerialNumber)
+	}
+
+	// mTLS requires client certificate
+	if res, err = get(); err == nil {
+		res.Body.Close()
+		t.Error("request without client certificate succeeded")
+	}
+
+	// renewed certificate is served by new connections
+	certCheckInterval = 0
+	defer func() { certCheckInterval = time.Second }()
+	testCert(t, dir, "server", 4, ca, caKey)
+	later := time.Now().Add(time.Minute)
+	os.Chtimes(filepath.Join(dir, "server.crt"), later, later)
+	if res, err = get(clientCert); err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if serial := res.TLS.PeerCertificates[0].SerialNumber.Int64(); serial != 4 {
+		t.Errorf("serial after reload = %d", serial)
+	}
+}
+
+func TestServerH2C(t *testing.T) {
+	s, addr := serveTest(t, server.H2C(), server.ReadHeaderTimeout(time.Second))
+	defer s.Stop()
+
+	client := &http.Client{Transport: &http2.Transport{
+		AllowHTTP: true,
+		DialTLS: func(network, addr string, _ *tls.Config) (net.Conn, error) {
+			return net.Dial(network, addr)
+		},
+	}}
+	res, err := client.Get("http://" + addr + "/proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	if body, _ := ioutil.ReadAll(res.Body); string(body) != "HTTP/2.0" {
+		t.Errorf("proto = %s", body)
+	}
+}
@@ -11,6 +11,9 @@ import (
 type Listener struct {
 	net.Listener
 	wg *sync.WaitGroup
+	// keepAlive is TCP keepalive period, defaults to 20s, and negative
+	// disables keepalive.
+	keepAlive time.Duration
 }
 
 func wrapListener(l net.Listener) *Listener {
@@ -29,12 +32,18 @@ func (l *Listener) Accept() (c net.Conn, err error) {
 		return nil, err
 	}
 
-	if err = tc.SetKeepAlive(true); err != nil {
-		return nil, err
-	}
-
-	if err = tc.SetKeepAlivePeriod(20 * time.Second); err != nil {
-		return nil, err
+	if l.keepAlive >= 0 {
+		if err = tc.SetKeepAlive(true); err != nil {
+			return nil, err
+		}
+
+		period := l.keepAlive
+		if period == 0 {
+			period = 20 * time.Second
+		}
+		if err = tc.SetKeepAlivePeriod(period); err != nil {
+			return nil, err
+		}
 	}
 
 	l.wg.Add(1)
@@ -58,3 +58,8 @@ func (w *gzipResponseWriter) Write(bs []byte) (n int, e error) {
 
 	return
 }
+
+// Close writes gzip footer, it's called after response is encoded.
+func (w *gzipResponseWriter) Close() error {
+	return w.w.Close()
+}
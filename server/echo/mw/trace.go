@@ -66,6 +66,8 @@ type msgStruct struct {
 }
 
 // NewTrace constructs a new Trace instance.
+//
+// Deprecated: use plugin/tracing, which exports W3C compatible spans.
 func NewTrace(localAddr string, logPath string) *Trace {
 	t := &Trace{
 		stopCh:    make(chan struct{}),
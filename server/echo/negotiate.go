@@ -0,0 +1,149 @@
+package echo
+
+import (
+	"bytes"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/sevenNt/ares/codec"
+)
+
+// Negotiate sends data with status code, encoded by the registered codec
+// most preferred by Accept header of request, e.g. JSON for
+// "application/json;q=0.9, application/xml;q=0.5". JSON is sent if Accept
+// header is absent. Codecs failing to encode data are skipped, e.g. protobuf
+// for values which are not proto.Message, and 406 is sent if no codec is
+// acceptable.
+func (c *Context) Negotiate(code int, data interface{}) error {
+	var buf bytes.Buffer
+	for _, mime := range negotiate(c.request.Header().Get(HeaderAccept), codec.MIMEs()) {
+		enc, ok := codec.GetEncoder(mime)
+		if !ok {
+			continue
+		}
+		buf.Reset()
+		if err := enc.Encode(&buf, data); err != nil {
+			continue
+		}
+
+		if mime == MIMEApplicationJSON || mime == MIMEApplicationXML ||
+			strings.HasPrefix(mime, "text/") {
+			mime += "; " + charsetUTF8
+		}
+		c.response.Header().Set(HeaderContentType, mime)
+		c.response.Header().Add(HeaderVary, HeaderAccept)
+		c.response.SetStatus(code)
+		c.response.SetData(buf.Bytes())
+		c.response.SetEncoder(blobEncoder{})
+		return nil
+	}
+
+	c.response.Header().Set(HeaderContentType, MIMETextPlainCharsetUTF8)
+	return c.String(StatusNotAcceptable, StatusText(StatusNotAcceptable))
+}
+
+// blobEncoder writes data encoded up front by Negotiate.
+type blobEncoder struct{}
+
+func (blobEncoder) Encode(w io.Writer, v interface{}) error {
+	_, err := w.Write(v.([]byte))
+	return err
+}
+
+// acceptRange is a media range of Accept header.
+type acceptRange struct {
+	typ, sub string
+	q        float64
+	i        int
+}
+
+func parseAccept(accept string) []acceptRange {
+	parts := strings.Split(accept, ",")
+	ranges := make([]acceptRange, 0, len(parts))
+	for i, part := range parts {
+		params := strings.Split(part, ";")
+		mime := strings.ToLower(strings.TrimSpace(params[0]))
+		slash := strings.IndexByte(mime, '/')
+		if slash <= 0 {
+			continue
+		}
+		r := acceptRange{typ: mime[:slash], sub: mime[slash+1:], q: 1, i: i}
+		for _, param := range params[1:] {
+			kv := strings.SplitN(strings.TrimSpace(param), "=", 2)
+			if len(kv) == 2 && strings.ToLower(kv[0]) == "q" {
+				if q, err := strconv.ParseFloat(strings.TrimSpace(kv[1]), 64); err == nil {
+					r.q = q
+				}
+			}
+		}
+		ranges = append(ranges, r)
+	}
+	return ranges
+}
+
+// specificity of media range, more specific ranges override q of others.
+func (r acceptRange) specificity() int {
+	switch {
+	case r.typ == "*":
+		return 0
+	case r.sub == "*":
+		return 1
+	}
+	return 2
+}
+
+func (r acceptRange) match(mime string) bool {
+	slash := strings.IndexByte(mime, '/')
+	if slash < 0 {
+		return false
+	}
+	return (r.typ == "*" || r.typ == mime[:slash]) && (r.sub == "*" || r.sub == mime[slash+1:])
+}
+
+// negotiate returns the offered MIME types acceptable by Accept header in
+// order of preference, offers are in order of server preference.
+func negotiate(accept string, offers []string) []string {
+	if strings.TrimSpace(accept) == "" {
+		accept = "*/*"
+	}
+	ranges := parseAccept(accept)
+	// JSON is preferred by server
+	sort.SliceStable(offers, func(i, j int) bool {
+		return offers[i] == MIMEApplicationJSON && offers[j] != MIMEApplicationJSON
+	})
+
+	type acceptable struct {
+		mime    string
+		q       float64
+		i, spec int
+	}
+	accepted := make([]acceptable, 0, len(offers))
+	for _, offer := range offers {
+		// q of the most specific range matching offer
+		q, i, spec := 0.0, 0, -1
+		for _, r := range ranges {
+			if r.match(offer) && r.specificity() > spec {
+				q, i, spec = r.q, r.i, r.specificity()
+			}
+		}
+		if spec < 0 || q <= 0 {
+			continue
+		}
+		accepted = append(accepted, acceptable{offer, q, i, spec})
+	}
+	sort.SliceStable(accepted, func(i, j int) bool {
+		a, b := accepted[i], accepted[j]
+		if a.q != b.q {
+			return a.q > b.q
+		}
+		return a.spec > b.spec || a.spec == 2 && b.spec == 2 && a.i < b.i
+	})
+
+	mimes := make([]string, len(accepted))
+	for i, a := range accepted {
+		mimes[i] = a.mime
+	}
+	return mimes
+}
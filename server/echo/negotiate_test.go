@@ -0,0 +1,119 @@
+package echo
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/sevenNt/ares/codec"
+)
+
+func TestNegotiate(t *testing.T) {
+	offers := []string{MIMEApplicationXML, MIMEApplicationJSON, MIMEApplicationMsgpack, MIMETextPlain}
+	for accept, want := range map[string]string{
+		"":                                     MIMEApplicationJSON,
+		"*/*":                                  MIMEApplicationJSON,
+		"application/xml":                      MIMEApplicationXML,
+		"application/xml, application/json":    MIMEApplicationXML,
+		"application/json;q=0.5, text/*;q=0.8": MIMETextPlain,
+		"*/*;q=0.1, application/msgpack":       MIMEApplicationMsgpack,
+		"application/*, application/json;q=0":  MIMEApplicationXML,
+		"text/html, image/*":                   "",
+		"application/xml;q=0.9, */*;q=0.9":     MIMEApplicationXML,
+	} {
+		got := ""
+		if mimes := negotiate(accept, append([]string(nil), offers...)); len(mimes) > 0 {
+			got = mimes[0]
+		}
+		if got != want {
+			t.Errorf("negotiate(%q) = %q, want %q", accept, got, want)
+		}
+	}
+}
+
+type point struct {
+	X int `json:"x" xml:"x"`
+}
+
+func TestContextNegotiate(t *testing.T) {
+	s := NewServer(nil)
+	s.GET("/point", func(c *Context) error {
+		return c.Negotiate(200, point{X: 1})
+	})
+
+	for accept, want := range map[string]string{
+		"application/json":    `{"x":1}`,
+		"application/xml":     `<point><x>1</x></point>`,
+		"text/plain":          "{1}",
+		"text/html":           "Not Acceptable",
+		"application/*;q=0.5": `{"x":1}`,
+	} {
+		req := httptest.NewRequest(GET, "/point", nil)
+		req.Header.Set(HeaderAccept, accept)
+		rec := httptest.NewRecorder()
+		s.ServeHTTP(rec, req)
+		if body := strings.TrimSpace(rec.Body.String()); body != want {
+			t.Errorf("Accept %q: body = %q, want %q", accept, body, want)
+		}
+	}
+}
+
+func TestContextNegotiateUnencodable(t *testing.T) {
+	s := NewServer(nil)
+	s.GET("/point", func(c *Context) error {
+		// point is not proto.Message
+		return c.Negotiate(200, point{X: 1})
+	})
+
+	for accept, want := range map[string]string{
+		"application/x-protobuf":                        "Not Acceptable",
+		"application/x-protobuf, application/xml;q=0.5": `<point><x>1</x></point>`,
+	} {
+		req := httptest.NewRequest(GET, "/point", nil)
+		req.Header.Set(HeaderAccept, accept)
+		rec := httptest.NewRecorder()
+		s.ServeHTTP(rec, req)
+		if body := strings.TrimSpace(rec.Body.String()); body != want {
+			t.Errorf("Accept %q: body = %q, want %q", accept, body, want)
+		}
+	}
+}
+
+func TestBindRegisteredCodec(t *testing.T) {
+	// codec registered once serves binding too
+	codec.Register("application/vnd.point+json", codec.NewJSONCodec())
+	s := NewServer(nil)
+	var p point
+	s.POST("/point", func(c *Context) error {
+		return c.Bind(&p)
+	})
+
+	req := httptest.NewRequest(POST, "/point", strings.NewReader(`{"x":2}`))
+	req.Header.Set(HeaderContentType, "application/vnd.point+json")
+	s.ServeHTTP(httptest.NewRecorder(), req)
+	if p.X != 2 {
+		t.Errorf("bound %+v", p)
+	}
+
+	// text/plain is registered for negotiation only
+	if b := defaultBinder(POST, MIMETextPlainCharsetUTF8); b != FormBinder {
+		t.Errorf("text/plain binder = %s", b.MIME())
+	}
+
+	var called bool
+	RegisterBinder("application/vnd.custom", binderFunc(func(*Request, interface{}) error {
+		called = true
+		return nil
+	}))
+	req = httptest.NewRequest(POST, "/point", strings.NewReader(`x`))
+	req.Header.Set(HeaderContentType, "application/vnd.custom; v=1")
+	s.ServeHTTP(httptest.NewRecorder(), req)
+	if !called {
+		t.Error("registered binder is not used")
+	}
+}
+
+type binderFunc func(*Request, interface{}) error
+
+func (binderFunc) MIME() string                               { return "custom" }
+func (f binderFunc) Bind(req *Request, obj interface{}) error { return f(req, obj) }
@@ -0,0 +1,12 @@
+// Code generated by go run gen.go; DO NOT EDIT.
+
+package openapi
+
+// swaggerUIFiles are gzipped files of swagger-ui/, keyed by name.
+var swaggerUIFiles = map[string]string{
+	"favicon-16x16.png":    "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x00\x99\x02f\xfd\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00\x10\x00\x00\x00\x10\b\x03\x00\x00\x00(-\x0fS\x00\x00\x015PLTEb\xb14a\xb14^\xab5[\xa55W\xa07U\x9d7R\x978Q\x968/^@.]@-ZA+WA3f>4f?9o=%NC$MC$OC&MB$KB#LB!IC$KB$LB$MB%MB$NC%NC\x1cAD\x1c?E\x1fCD\x1dCD\x1fDD EC\"IC\"IC#JB'SA$LC&PA\"GB\"HC)VA+Y?$MA%MB\x146F\x154D\x152G\x113D\x125F\x101B\f1I\x15+@\x00$I\x003M\x0033\x00\x00\x00\x00\x00\x00\x85\xea-\x84\xe9,\x83\xe8,\x82\xe6-\x81\xe5,\x7f\xe2.\x80\xe1.}\xdd.|\xdd.v\xd20t\xd00r\xca1q\xc91p\xc81o\xc62m\xc51m\xc41l\xc32k\xc02j\xbf2i\xbe3h\xbb3h\xba3g\xb83K\x8d9J\x8a:J\x89:D\x7f;C\x7f<@y=>w=9n>8m>8n?6j?5h?3e?\x1b=E\x1b>E\x1c?E\x1c=E\x1eCE\x1fDD FD`%\x11/\x00\x00\x00;tRNS\xf4\xf4\xf5\xf5\xf6\xf5\xf7\xf6\xee\xee\xef\xf0\xea\xea\xe7\xe1\xe1\xe0\xe0\xe3\xe3\xdf\xdc\xdb\xdb\xda\xd9\xd8\xd8\xdb\u03ff\xbc\xba\xac\xab\xa9\xa9\xa1\x99\x96\x94\x8e\x89\x85\x84L1$\x1e\x1d\x1f\x15\f\a\n\x05\x01\x00\a\a\xae\xc9\x00\x00\x00\xd8IDATx\xda=\xcf\xd9.CQ\x18\x86\xe1\xcfn\x8a\x8dRi\xa9\"\x86\xb61\xcfs\u05bb[\xb3\x84\x12\x1bA\x8c5\x94;u\xe0\x86\xa4\x12\xc1Z\xcdN\x9f\xa3\xff\xff\xce^\x19k.\x97Iv\x0fL-\xb9[\u01ac\x0fw\x94KP:N\x8c\xae\xbaac0N\xa4ih\xcd\x0e\x85\x96\xe8\xdd\xdb$\x967\x9a\xf7\xe1\xf2\x01\xeb\xf1\x1e\xda\x16T\b\xe1}\vk\xe7\r\xc2I\xf5\x04\xf0\x1a\xe0\xbc@\u0427\x14\\\xdd\xec\x9f\x1f\x9c\x1e\x9eT. \xed\xfdI\xbfq\xff\u02ef\xf9\xb5\xef\x98\xf4\xa3l\x00OE\x9c\xe7\"A\xaf\xc6C\xa8\xeebmW\xe1lB\u02edp[\xc1\xba\xbb\x86\xf6E\x991\x8f\x86\xe6\x9c\xf1\x94\xca\x7f(\xf2\x99IK6p\xba\xf3\xc8\u0155\x13#\xf58ke6\x9b\xec\ua7e9\xe7\xff\x03\xcdJ9\x84\xc0\xe4\xbb\xd1\x00\x00\x00\x00IEND\xaeB`\x82\x03\x00\\\xa1\xa9S\x99\x02\x00\x00",
+	"favicon-32x32.png":    "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x00t\x02\x8b\xfd\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00 \x00\x00\x00 \b\x03\x00\x00\x00D\xa4\x8a\xc6\x00\x00\x00\x90PLTE\x00\x00\x00\x103D\x165F\x166F\x176F\x00.:\x165F\x188E\x177F\x1a<E\x0f1@\x143D\x155F\x166F\x165F\x165E\x165F\x154F\x166F\x165F\x163G\x85\xea-\x176G!GC\x81\xe5,3f?p\xc91/^@7k>Z\xa56~\xe0.C\x80;w\xd4/_\xae59o>n\xc52?x<s\xce0&OB,YA\x1eBEe\xb74z\xd9.\x83\xe8,H\x87:J\x8a:I\x88:N\x909xo\x8d\xe5\x00\x00\x00\x15tRNS\x00\x15\xcd\xf4\xe1\a\x99\xfe\xf8\xfe\x10 w\u0129F\x8aS\u05fd-\x8ak\xf8t\x00\x00\x01~IDATx\u0685S\xd9v\x820\x10\x1d%\x10\"\xee\x96\tkd\a\u0176\xff\xffw-I \x14=\xf6\xbeLr\xe6\xce>\x03\x06\xf6i\xbf&\xaeK\xd6\xfb\x93\r\xcfX9\x16\xb2\xb0\xfa|T!C\xd7Y-\xf5[\v\x93<\xf0%\x82<Dk\xfb\xc7\xcbf\x87I\xe4\xcf\x10%\xb8\xdb\x18\xbdG\xd8\xcd_ g\u011b\xec\t7\xe6\xc6\t':\x11{MK\xff\x05J\xba\xb6U~\x98\x0e\xff\xbe\\\xbaI\xf1(\x03X\xc9\xf0\xab9\u01a3\xa6\xa5q6\xc8\xc4\x1d\x82\x1c\xa9\xfcTX\xa4\x93i\x8cWiD\x9d_\x82%\xdf~\x8c\x99q^c+\xe5\u0575\u102a\xc2\x06\u0164\xef\x056\xf2q\xc3\x038L\xf5\x8f\xa3\x94\x1a\x94K\x110\a.\xb1zb\xe7\xcf\xd0PE\x8f/@\n\xd58L\xe6\x84\x02S%\u03e0\xf2\r\x91\xd7}\xdbeA\u00c5\xe0M_s\fe\x96\x16\xb8#!\v8\xbf\v\u0383\xack\xfb\xa8\x1b\t:\x84\xf86!\x94\xc1\x97\xd0!v:I\xca\xe6\x046&\xb9\x03\x87\xf5\xbaL\xe1\x1b`7\x95y\u009b&\u070d^ \x9f\x1ae\xbbW\xdd\xc9\xda\x10\xee\xb3V\xc3~\x1cVl\x86U`5\xc8L\x0e\u02e3\xa14*\xd8 \xf5\xe0x)k\x91\u31a3\xee\x9aAT\xf6\xb3\x85\x01\xfb\xfc\xcf\u0281g\xbd_Z\x80\x83\xc5\xf2\xa5>\xa7\u0103\t\x1f\xe4\xfd\xe1\x00\xac.\xf8\xf6\xf4\x860g\x1c\x8e\xf7\xf1|\xbc&\xce\xf6\xd5\xf9\xff\x00\u018cF{\xbe\xb8\x05g\x00\x00\x00\x00IEND\xaeB`\x82\x03\x00\xa0\u04dfCt\x02\x00\x00",
+	"oauth2-redirect.html": "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xccV\xcdn\xf3\xb6\x12\xdd\xfb)&\\D2\xac+\xe3v\xa9X\t>\xa4Y\xa4h\x9a\xe2\v\xd2M\x10\x04\f9\x96\xd9\u02242\xa4l\xb8\xb6\u07fd\xa0$\u06d2#\xb7.\xd0E\xb9\x88#\xea\xcc\xe1\xfc\x9c\x19qr!\x8dp\xab\x02a\xe6\xe6\xf9\xf5`\xe2\x7f \xe7:K\x19\xea\xff=?1\xbf\x87\\^\x0f\x00\x00&N\xb9\x1c\xaf\x9f\x96<\u02d0\xe0\xf9>\x81\xc7o\xa5\x9b\xfd\x00\xdfQ*B\xe1&\xe3\x1a2\x98\x8ck\xb3\u027b\x91\xab\xeb\xc1\xc4\nR\x85\xabi\x82\xd2\"XGJ\xb8\xe0\xaa\u0699\x96Z8e4P\xa9!\x1c\u00ba\xda\xf5k\xc1\t\f\xaf\xceHa\xa9\xb44\xcb\xd8\x14\xa8\x91b[\xbb\xf1|\xbf;\xfc\xb1\xc2]ul-j\xf7\xe4\xb8CH\x1b\x9e\xd8\xfa\xc7.\x8a\x1a\x86g\xca\x0f\xb8\xd6f\x17\xad\xeco<W2\x82\xcf\"\x02Nt5\u063fVS\b\xc7\xc2H\xdc8\xf3\x81z\x83D\x86\u01b1C\xeb\xc2\xc6\xfb\xdc\b\xeec\x8dg\xdc\u0386\xedX\xfd\xfa,\x0eqv\x90\xb1-\xdf}\xcet\x16\xfe\x7fx\xf0g\v\x98[\xec#\xd9[[\xe4$N\xda\x1f|\xe7D\x90\xc2g\x11\xdb\"W.d\x97\xac\x85\xe3D\xf1\xd4\xd0\x1d\x17\xb3p_\xadp\x11\xa9\xe8\x8d\x13\ra\r\xfe\xf7E\xbdB\n\x01\v`\x04\x8b\x98\xb0\u0239\xc00H\x83\b\x02\x96\xb0`\b#\xff\xf6j\xdbb\xae\x9c\xfd,\xe0\x06~zz\xfc%.8Y\f\x83\xb5\xa7\xf0\x87\xfen\x94\x0e+\xbbm\x10u\xa2\xec\b'\xfc\xc0U\x04\v\x9e\x97x\x9c\xd2\xdd\"t%i\xf8\xc0\x15\xa4i\n\x8c\xc1Mm\x00\tH\xf4E{\xfe~\x7fk\xe6\x85\u0468]Xs]}\xa1\xda\xeew\x86\x90\xc0z\u06ee~-\x8c&\x8b\xb5\xea\xd2\xf4\xa0\xc1#\xa1\x84\x83\x03k\xa39\xff7\xb6b\x86s\x1eg\xe8B6\xcd\u0352\rk\x87\xb9\x10h\xed\xad\x91\xc8`\xb3\xf9\xa7\u01a5\x9b\x19R\x7fT\x9a\xf878\xde|\xc6X+\x19\x97\x97p\xd1f\xf1\xef\x8fk\xe1\u00feh\xd2\xd4W\xa7\xc6\x1e\x89n\xdf\xc3\xfe2z\u013dL:\x0ek>\u01e8\x17mMI\x02\x93\xdaw\u058f\xc9q\x81y\x02l\xc9I+\x9d\x9d@\xcd\xd1Z\x9ey\xaao\xed4\xc0\x9c\xaf\xe0\x1d\xa1\u0516O1\x82\x82[\x8b\x12\xea\xea/\xb9\x051\xe3:C\tJ\x83EZ \xc1\xafG\x10\x1d\xb8F\x9c(aJf^\xc5\u0620\xd9W\x01\x1e\x89r;\xe8<\xfa\x14\x7f\x16\xbd\xd9\xf7Kb\x8e\x0eO\xcc\xc2>1x\xa2Z\xd1\xfe\xbf\x93X\xc1\xf3\xfc\x9d\x8b\x8fp\xed\x9f;\xe5\x89\xda\xf35i?|\t\xa5o\x9a\xd5%r5\xe3\x9d\x1f\xa8\x0f6\xfb\xeaG\x13w5qO\x8d\x80\x0e\x05\xa4\xc0^\xd8hg3b\xaf\t0\x18\xf5\x1a\xfa\xb5g\x7f\x93X\x7f\xce|\xf9o\xa0o{\x04,\x06\x06\t0m\xe0\u0437@(P-vev3l\xaa\x1c\x03\x1b\x9eutI\nn\x80=\x18BPzj\x12`\xa3\xce\xcb\x04\x18\xeb\x9dZ\xff\xad^\xab\x1c\xfe\xbbN\xeb\x96k\xb3\x01\xf6\xd2\xed\xbd)W9\xca\xd7\x04\xce\xcb\xf29\xbd\xf4\x97R<G\xeb\u0547?\xa9\xee\x06\u0360K\x0e7\x86s\x1a\xe1\xe0Cs\x0f\x10\xb9\xb1\x186\x88\xa6\x90^\xed\u0488r\x8e\xda\u0144\\\xae\xea[\xceE\x9aB\x90\x1b.\x95\u0382v\x1bP\xa9\xf7\x14\u01e1\ud278\x94w\v\xd4\xeege\x1dj\xa40\xf8\xf1\xf1\xe1\xd6h\xe7\xf7\f\x97(\x83\xa8\xf5\xcd=n\xb3\xd6\x19\xed\xe4n\a\x93\xf1\xee\xfe7\x197\x17\xc2q}\xe3\xfcs\x00d\x85\xe2z\x82\n\x00\x00",
+	"swagger-ui-bundle.js": "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xec\xfdi\x97$\xc7u \x88~\x7f\xbf\"\xd2\xc9N\xba3-#c\xdf\x12\x8eT\xe4F\x94\x04\xa0J\xb5\x00\"\xb3\x92I\vw\x8b\b\xaf\xf4p\x8f27\xcf\u0328\xb4xG\x04H\x89\xa2\xa4\xe9\xe9\xe9VkZbOK3h\x82b\x8b$ j\xe1\"Q\xe7\xcc\xfb>\a|\xe7\u0319\xaay_j\x06\x04(\xbe\x99\xf7\x1f\u07b9\xd7|\x8d\xf0\xc8\xca*P\u02fc\xf3pP\x9ea\xbb\u0675k\xd7\xeeff[\x9f]+\x1d\xfa\xbc\xe4:\x16\xf3\x02Vr\xbc\xa1\xcf'T8\xbeW\x9a\xba\x8c\x06\xac\x140V\n\xce\xe9h\xc4\xf8f\xe8l\x0eB\xcfvY\xf9AP~\xf5\xc6\xde\xc1\xebw\x0e\xca\xe2B\x94>\xbb\xf5\x7f[\x1b\x86\x9e\x05\x05uF\x84q\xa9\xf9\x83\a\xcc\x12\x9ai\x8a\u0654\xf9\xc3\x12\xbb\x98\xfa\\\x04\xeb\xebK)\x13\xdf\x0e]\xb6\xa3\xfe\x94\xa3|\xa6\u040d\x9e\x16\u05d9f\xb6\xd9\xd0\xf1\xd8\xfa\xba\xfa[\xa6\x13{G\xfd\u050f\x8e\x890z\xab\xda\u0749\xfe\x96\ufa31\u073b\xb1\x8b#\xc1vXQ\xec\\\x17c' z2.\xe3\x923\x11rO\xd7\r\xf3\xe5\xcb3\xcaK\u033cl\xb5\x1a\xd5n\x0f\xc6L<\x88N\a\xe0\xe9\xb5F\xa7\xd11\xe6\xa4QmV\xab+\xf2t\xea\xf5V\u0758\x93j\xb5Z\xeb\xac\xc8\xd3l\xb7;\rcN\x9a\x8dj\xa5\xbe\xaa\xadN\xb5\xdb2\xe6\xa4\xddn\xb7Z\xab\u06aa\xb4\x9a\x90\xa5V]\xd9\xe5f\xbb\x81]\xaeV*\xad\u06aa\xa6\xba\x8d&\xd4\xd3h4\xba\x8d\x15yZ\xddv\x03\x86U\xabT\xab\xab\xbaS\xadv\xb1\x9eV\xad\xb1\xb2\xadn\xab\u0482\xa1w\x1b\x8d\xf6\xaa\xa1\xb7\xaa\xcdv\x1b\xc6\xd5\xe9VW\xf5\xa7\u046a\xb5\xbb\x98\xa7\u0669\xac\xc8S\xaf\xb7\xdb0\xf6N\xb5\xd5X\xd5V\xb5[o\u00f8Z\xddze\u0554\xb6\xeb\x9d*\xb4U\xabW\x9a\x8d\x95c\xaf\xd4j\x90\xa7\u06ed\xae\x1cV\xbb\v\u0769\xd7\xea+g\xb4Yk\xd6`\xe8\xf5nme=\xf5V\xa7\x89yZ\x8dU\xb3\u07a9UpT\xedvue\x9eF\xb3\xd6\x01\x04k\xb4\xeb\x95U\xb3\u056au\x9a\x90\xa7[k\xaf\u019e:\"X\xad\xdbY\x89\xef\xf5f\x15'\xa2\xd6\ucb1c\x88v\xab\u07aa\xc2du\x1b\x95U\x13\u06aev\xaa\x80`\xcdn\xbd\xb1*O\xa7[\xaf#\x00\xeb\xddU=\xae6;-\xe8N\xb3\xdam\xac\x1cy\xbd\xdeA\xbc\xa8w\u06eb\x86\u0568v\xab\x15\x18V\xab\xd6m\xae\xeaN\xabV\x01\xdc\u9d3a\x95\xd5\x10\xac4\x11\xbf*\x8d\xe6\xaaz\x1a\xedv\x17\x86\u07ad7\u06ab\x90\xa7\xd6n\xb4\xa0?\xf5z\xa5\xbe\xb2\xadv\xb7\xd3U\xeb\xaf\xde\\\x89\xef\xddZ\va\xd8m\xad\xa6+\xad\x06LW\xb3\u05a8\xadZ\x13\xedZ\x05\xe1\u04ec7\xbb\xab\xfbS\xef \r\xeb\xd4[\xabP\xa3Sm\xe2\u06aav\xbb\xddUc\xaf\xd7\x14\x9c\x9b\xd5Fc\u0578\xaa\x8d*\xd6S\xaf\xb4\xba+WE\xa3\xd1\xc0u\xdc\xe9\xd4\x1b+\xc7^mB\x9en\xb3\xd5YI\u00c0\xc0a\x9eZ\xbd\xb3r\\\x8d.\xe0Xw%.\xb7Zm\x1cx\xad\xd2YUI\xabRk\xc1\xc0\xab\x9d\xee\u0295U\xe96\x90\ued1b\xab\xa6\xa1]i\"\xc1h4\xea\x8d\xd5d\xb0\x85S\xd5\xec\xd4\xdb\xed\x95\xd3\xd9\xc4\xe5W\xadWV\x0e\xbb\u07ad \xfdj\xd5k\xab\xa7\xbc\xd1VK\xab\xd1Y=\xaeF\xbdRG\x8aQo\xaeB\x8bn\xbd\xdd\xc5m\xa2\xdb\\\xb9\u051bM\x85\x16\xadn\xbb\xdbY\xb9E6\xebU\x1cWs\u5ca9V\x14\xb1\xec\xd4;+\xc7U\xaf6k\x8d\bM[\xabg\xbd\x82c\xafw:+\xb7\xd16R\x8cz\xa3\u05a8\xf7X.1\u03f6\xe9^\u8ea6)\xa4\x14/\xb3\xb2\u02fc\x91\x18\x1b\xeb\xeb\xba0\x93\xd0\xf6\xd0\xe7:p?\x9eY!\xdc\xf4\xd8y\xa9\xcf9\x9d\xe9\xc2\xd8\xf6^\x12\xdb\xde\u0186\xc1\x8f\xbcc\x93\x1dy\xc7\u06cae*\xf19I\x9a,\x9f\x9c\xb0\xe05\xe4\xf6\u0335J&\xdefC\x1a\xba\xc2Lb\xe6\xa4\xd9n\u05f2\x03\x87v9L&\xac\x7fc\xbbh\x18\u01a53\u0539\xce\f#j\x9b\xbd`\u06cdV\xa7\xd5Y\xd96\xf1a~\x00\xa0\xd7\uc1af3\xe3E\xa1\u04295VN\x1b6t\xe6;v\xa9b\x9a&3\u0118\xfb\xe7%\x98\x96\xdbl\xc88\xf3,v\xc0\xb9\xcfu\r\xf8\xd9\u0498\x06\xdegDi\xc0\x98Wr<G8\xd4u\x02f\x976KA8e\\7r9,\xea\xba\xcc\u058c\xedO\b\xcbf\xb5\u06aa\x16\xc0\x12\x17\x91\xb1\x1d\x8f\xa6\xe4\xab,\xc4'\x948$0.\x05\x9fa\xe6\xd0dG\u03b1\x1e\x18\xc45\xc3\xf2\x19uC6\xb7\xa8\xb0\xc6\x00\x80\xa8s\b\x04\x80\xc8<,\u06fe\xc7v\x84\xee\x1a=^\xe6,\xf0\xdd3\xa6\xbbFY\x8c\x99\xa7\xfb\x84\x1a\xf3bPF5ex~h\\\x98(\nx&\xe5\xa3p\xc2<\x11\xc4\xf0\x000s=\x95\x118\xa1\xaa\x88c\xb22\x9dN\u0759\x0e#N\a\x18@+\xbe\xee\x10N(\tHH4\x8f]\b\x8d0c\x9e\xe4\t\x97\xf2\xe0\x9cb\xa6 \x9ajcn\x18\xf3\x17\x9c\x8cZ\xab\xdem\\I\x06\x9c\xa1\xbe\xa6\x838\x18\b\xeaY F\t#\x83Xwg\xd3\x18\xa7\xf6\xa8\xe7\xf9\x02\xf1\xa4DK\x96K\x83\xa0D\x83\x12M@\xa8\xbd(\u04b7\x1b\x95\x9c\xb4\x13\xa3\f\xd2T\xb5\xfcp\xf76\b\x85\x9f\xadj\xdd \x0e\xd2\xc3F;\ap\xc0\xa70\x99ZG7v\xf4t\u0701\xc9_\xa4{F/WG\x16z\xc43\xa2\xbe\x1e\x011=\xde\xe6\xe5i\x18\x8c#l\xe0D\x18\xdb\nA<v\xae\xfb\xfaaT\u050820\u008dd\xb9y\xeb\xebTw\x88W\x9er_\xf8 \xd1\x1a\xc4y!x\x1a$\x88\x1a\x80N\x91\x04\x91\xb3\v!x\xb1\x89ju\xab\xdd\":\x89\xcc\xc1\xe2\xda6.3\xdb\a\xec\x17\u0476\x82\xdb\x06\x16\xf5M\x01\x1b\x87_f^8a\x9c\x0e\\ff\x03R\xaeU\x89_\xb6|o\xe8\x8cB\x95\xbeV!\x1a\xd2\x04\xcd\xf1J\xfe\xfa\xba\xee\x97\u03f9#\xa24\x83p\x9d\x11\xbf|\xcaf\xc47\xe6\xf3b\xac\x87y\x8b\xc0.\xd6\xd7}\x9d\xa5@'\xc2 \x1e\xc6\x11OU\xa6%i\x1a\xb9\x8c\x9b\xea\xadU\xe7\x06yQ\x12\xd9i5\xaaE`\u013d_\xe1;r\xb4\n\u07e3M\b\x10\xbe\u076du\xbb\u015b\x10\xc0[\xc1ZQ\x8d5\xd3\xe48\x0eCJv\xa4\xfd\u02af8\x82q*|\xae\x1do\u00da\xf7p\xe9SL\xd7=\u04c1\xedKJ\xb1\xbe\xce\xd6\xd75/\x9c\f\x18\xcfhVb\x8e\xe0\xd2[_\u05d9\xe9)\xc4\x0e\xcc\n\t\xcd\f\x05\x9dG\xd8|\x19\xf4B\xe2\xf5\x96\xf4)\xa5\xe0\u513b\u0639\x04\xc2\xdd[\xab\xcc{\u046f*\xc1\x89\xed\xb1\xa3`c\xe3\x18H^/K\xb0\x15Qbs2\xec\x85\xf3y!\x89\xba\xe1\x9dQ\u05f1KT\b6\x99\x8a\x92\xf0Kj\u062c\xe4\xf9\xde&\xfe\x1e\xb8)\xb5+\xdf\xf7nx%\x9f\u06ccC\xde\x01+\xc5Y\b\x16\xa0\xc0\ud514\x9e)(M\xc2@\x94\xc6\xf4\x8c\x95h\xe9\xe8\xcel2\xf0\xddr\f\xd5c\xdd(M\x98\x18\xfbvY3\xe6\x00\x1c\x97X\x80\bSs\xad\x9aB%\x03\x11\xcf\xf4\xca@K\x91W\xc8\xc1J\u96fc2l\x17zB ,\x93\xe1V\x87X\x97\x03\xcc\x14\xdaqM\x84L\xa6\x1a\xd8O-)\x15\x9b\xe7\x95U5R\u01bftc>t<\xea\xba3@\x84iD\xf3\xdd\xf9\xfcE\xf7\x9aj\xa5\xd2\xed\\M\xc5[\xadz\xa7\xa2\xb0\x1ai7\"u\xad\xdaX\xc5W\xa9\x1d\x99\xa6@X\x80\x92G\xa8\t(\x0e\x18\x1d\xe1\x7f`\xfa\xa8\xcd3\x80l\x04\x82\x87\x96\xf0\xf9\xb6gr\x9d\xa6\xa4\x90\x001t\x03V\xf2L\x1a\xef\u0770\xed'\x19\x92\x06\x1d\x95\xe0\xbd\xf0\x0e\xdci\xb7\u06b5\xd5$\xf3\xd9\x04\xaa\xe4x%\xb6\xc31\xfaR-\x10\x8f\xa44\xb2\xb7V!Y\x02\t\xe1\x94JU\xe6F\x8f\x1d\x89c\xd3{ab\xd5\xec\x14\xf6\x1f%\xcf\x1c\xc9O\xba\x9c\x8e\xc97\xb9\x94\xd9\t\x8dw\x04aV\xb7\xc5K\t\xb8\xe3\x9dA\xc4;C\x86\xff:\x12\u01c9\x1c\xc2\x01\x18\x9eq\x13\x17dJ\xb4\xcbc\x1a\xdc<\xf7nq\x7f\u02b8\x98\xa9e\xe5\x11\x0e\xe2\f;\xe2\u01e6w\u010f\x8d\xf9'bl\x89_\x8c(\x99-\xc6\x7f1\x00\u05eb\x8dv\xa3\x00\xc2(-F\xdc\x0f\b\xd6j\xddT[\xadF7\x03w'\x81\xbb\x16zJ?nkk1\xe1\xbe\u0346.\xb3\xc4\xfa:\xcfrB\xce/\x81\x13rVpB\x14\x82\xb8 y\xcc\x1e\xfb\x8a\x15\x8aWTy\xc4\xc4\x0e~\xd5D-b\xc1K\xf5\x1d\xd6\xf3\x8c\x9e\x13\xf1\xfe/\xc8\x049\u03dc/\xe7\x05\x99  bE\xc2\"h\x12\xd4|\xa1r\"3I\x14\xa8\xd3\xd2\xea\xa0&\xdf\xf1{\x052\t+\x9f\x9c r\x9f\x9cH\xf9\xc2\xc2$\x81V3\u00e5/*\xcf\xd5\xdb\xdd\"\x02\xd0\xe9\xaa\xc1*J\x96\xb2\xe6\xdbW\x88\x1a\xa9\x99'AQ\xb1\xbe\x0e[\u051ai\x8ab\xb9\xe3\x0e\b\xab`\xdc\xe1,\b\x00\x9a\xb8\x0f3G\x8c\x19\x87-\x1bJ\x97|\x9e\x13D\xb63,\x9d\xc9u\xb1\xbe\x9e!\x17\xe42\xb35\xf4\"\x9a\u02b2Ts\x91\xa2\xce\xe7\x06\xf1\xaf\xe6\x05\x05\xf0\xef0\xcc\x17$\xb3\xf5N\xa5]\x00e\xd4$^\xb9=F\xebj\xb3\n\x1c\x9f.\xccX\xca(\v\xff\x8e\xe0\x8e7\x8a\xb9\fC\xfd\x10D;\xf2\xa8p\xceX\xc9\xf2mv\xfc\xc2R\x1b\xec\xe0+\xb7\xfb\xa2\x1e+\fH\xa9\x94\xb9@\xa5\xa4\\\xe3\x91\ne\xad\x8a\x14\xa4\x1c\x8c\xe9$\x17U`'\xbc\xc5\xfd\x8bY\x9c\xa9\xb2\rl\x8f\n\x94v}\xdfe4#R)\x8ars\xa8 \xc1\xf5(\x039:\xce\x19\xff\xe6\x86a\x90\xb5\u02a2\xd6a\xad\xfa\xc2L@3o\xba\xb8\x9a\xe1G\xad\xf8\x15\xaa\xa6,\x83\xaf\x96\x8eb\xf3\xd5\xef\x05f?\xd6I\xd1\x17\xd7I\xb5\x9a\x95f\xeb:\xbd\x7f\x86`\xa28Q\xb6\x03\x7f{\u05d2R\u0508\xa2\xbd\x05\xd5D\xe6\xd11\t\x15\xbf\xbbV\u0179\x06\xde \xc3Ko\xaf\u9869\u04c4}6\x90i\x06N @\xe1\\\xa7\n\t\f\xb2&\xa4\x8c\xf7\x1c\xa4>\xdbP\xb1\xb1\x9dN\xbb\v\r9&K\x18eh/\xbc.K\xedF\xe4\u0319\u01dcG\xf0\xa2\xf8\xd3\xee\xb6\xeb\xadUj\x1c\u3c90l\x16\xc8B6ST/\xe4\xff\xc4\xf2\xd0\v\x8e\xba\xd5jW>\xf9\xa8\x83)g\xd4\xfe\xbf\u0080\xbb\xcdn\xceD\x95p\x82`\u01c8$(0\x8d(2\x81\xc6*%BE\xfc\xa1\xf2\x0e\xe8\xd4\x1b\x06\t\x91\x12W\xea\x1d\u0422\x82\x11\xac\u076c\x19\xc4J\xb7\xeb)\xd4\v\xb2I\x86M\x19fW+\x8f\xe4*?\x95\xab\x98\xb1-`!\x99T\x0f\xa2\xdd$\xeb5!2\x9a7\xa8(\xa3\xc9A\x8a\xeae\xb5c\x1e\xc8`Qvo\x85\x8a\xf6\xda\xd2\x02\xe1\x00\x1c$\x16Y\xb9a'\x1b\xe8]\u03b7\u017f\xaa\xed\x04\xbag\x0eu%B\xe8>\xd0\xf9xg\xf4\xf2c\x99\xe2D\xf8G\xe2\x184\xb0\xbdp\xc7\xd5\x19\tu\xdf0z\x81\u03b3u\xc4\x15\xf0|\x05\x16V\xe0\xe8>\x11\x06T1\xff\x84\x9a\xf5N\xa5Z+\x12\xc5\"\x9c\xf0\x13v\x01\u0463\xd1hv+W2c\x11=\x8ev\x88\xcb\xf9\xb6\x82%P\xd9%.>T\xf8\x003\u2615m\xe7\xa50\x9e\x05gc\xc3\xf0\xcc\xf0\xc89&\xbe.\x12&\xc33^6+R.\x89j\xd3HH\xbb\x11\x1c$\u0621\xca0\xe2!\x99\x8e\rJFJ6_\u042a\x03\x10\xb8z9]\xc1^]\r$J\x1c\xf3rN\x82\x14,\u052cl\u04d7\x12\xe4\xa4\b\x96\xe0\x88\x16\x82Ew\x92Q&r\u044b\xaa\xf8\xabE\xc2c\xad\xden5\x8d8\x7f$D\xd6\x1aW\x0e\x15\xd6v\xeaT\x05\u0324!e\x01\xc3%\x12C\x97\xd8\u03b2#+\xf8\xf7}\u019d3f\x972LwP\x9a\x00Y\xf5\xdcY)\xaaI5\vl|\xca\x1f&\xa0\xf9$\xf6\xb4V\xb5\xbeRV\xcbj/\xb2:\x97\x95\n\f\"\x8ad4S\x90\x17\xd5)`\xb3\x9f\\\x85P\xed\xb4\xdbE\xbc8\xdaT\xa3\x8d\x03\x98\xb8H\xa1\u072d\xc5\x1b\a2\x16\xab\x91\"\x1a+G\xfeL)\xf5\xa5\xa4\xd1_G\x7f\xd1IA\x1dF\x11!C\xf5\xe0\xca\xde\xc0*\xdb^\xbb\x96\xf2\a\xf2'r\xa5\xcep\x95\x1a\u06df\u0636\u066eVZ\x8d\xab\u132c\xfe\xf3\xc0y\x19\xca\n\u019f\b\xc2\xcdn\xa5^\u0132\xa3\xa1\xfb\u028e\x02\xcf\xf5O\xd8Q\xa4SW\xcb\x16\xe8\xedp\reJ\x01\xa5\xe2\xeb\xebZ\x80|Z\x1a\xe7\xef\x14\f)Jc\xf3Bu\xcc\xfazq\xdd,\xabc6Q\x94a \u0464\u0239\x137\xdfKZ\xf8gT\xe5\xe0<\x17*\x19j\xf5\b+\x94\xecI\xe8\xd5\x1e\x0f\t\x97\b\xf4_\t\xa5Z\x80\u0286\x14>,#y\xa6\x96P\x90\u0556V\xf0\nM\x85G:d\xb3\x1a/Z\xedf\xb239\v\xa0__\xd7\x1d3\x17S\xf6\xe8\x84\x19D{\x8dN\xb1\x80\x94\xda\x1d\xa6\xca\xee\x00\xe2\xf6\xb4~\xcc\x1aF\xe9[_\xd4wz\xf7\x1cy\xc3\xf0\x84\xbe\xd3\xeb\xc8jK\xd6k\x86\xbe\xd3\xdbs\xe9d\xcalc\a}_>\xbdU\x16,\x10\xbac\uca01E\x92\xec\v\x8bv\x8dN\xa1\xed\xbb[\x89$\xebNWMGd;q\x12\v8z\ru*m\xc5\xec\xa3\r=\xb3J\\=\xe1\u42d0w\a\xdd\x19\xa2\xbeo/\xad&wQ\xe7\xa0\xd8 \x13L\xb2A\xd6\xe1f\xbbX\xa9\xc7~\x89\xaa\xbc,\x8b\xa1\xac\x96\x1e\xd0\xfcL/<P&\x03\x1f\xe6\x95\x03&\x94\x16.\x01\x84H\r\x13\xa1\xce26 \xbal'JX\xce\fv\x9ay\xfbp\x91\xeeP\xe4\xec1\xd5gi\x12\x1d]\x90\x17\xd5\xe9\xbayB\u0fa0F\xa7^\xadtW\xba\xe4\xb6Z\xe0\xacVmw[\x8a\xa7\x80\x1cZ\b\a\x04\x04w,\xa1m\x8b\xf2\ub455Yq\xc3\xe6\xd6\x17\xf5\xa3/\xde??\xfe\xac\xa1?\xa0g4\xb0\xb83\x15\u04a6\x82\u02b3\x81\n\x19[\u0384psk\xfdS\xfa\xfd\xf3\rC\xff\xe2\xfds\xb9m\xecl\x8d\x88on\x1d\xdd\x0f+\x95Je\x13\xfeT\x0f\xe1\xdb>\xc4@\xf7\xf0~XS)\xb5Je\xff~xxpxx\xbc5r&\x84\xaaV{\xc7\x1bFok4!\x8ey\xa4\x955\xa2mi\xc7\xd8\xc1%E)\tL]\x00\x06k\x1a\x11e\u03a6.\xb5XNf\xcb\xf2<\x11E\x1ar\x7f\xb27\xa6|\u03f7\x19\x80\x1ed\xbd\xb8\xa8O4\xcd(\v\xeeLt\xc4\u04b5 BH\x8d\x0e\xfcP\xf4\x06.\xf5N5H)\xd8T\x9c\xb2\xe3\xd9\xec\xe2\xe6PgG\x95c\xe3\xe5\xcd\xea\\\x0f\x12\x8c\x0e\xb6\x95\xd0\x15\x94'\xa8\x8c\xa2\xaa\x810\x9f\xee\x9a\xe1Q%q\xbc\xf3\x14ar\x8d\x9d\\\xfb=\xd0:5\xeb\xed\x9c#rn:\xbd\xb2\xad\vr\xf9\x85\x1e\x9cvxenl\xc74\bL\x7fH\x85\u029e\xce\x15\x15B\x87\"\xa4BeO\xa7\x8a\b\xa1W\bR\xa1\xb2\xa7\u0736\"a\x03\xf4\reOw\x95\xb6\x01\r\t\x06\x19b\xdc\xd4 c\xf4\x8c\xac@Q\x1b\xe3\xc6\x06\x99$Z\tr\x86q\x13\x83\x8c\x80\xba\xc1Y\a\x83\xcc0nd\x90\x01\xe4\x03\xe7}\x83\x9cc\xdc\xc0 \a\xe8\x8d\u066d\x1a\xe4\x02\xa3\x0e\fr\x02\xbdk\u05e0\xc7w\x80\xfb\xa9\u05fbY\x1ay\x9a\x99\x8f\xd3\"2\xa9t<\x05L\u0102\xf2\xe7\x97\xc4R\xc4\xcd-\xf2\x15I\xfcZ\xfc\xfbj\x0e\x03\u0195\x12\xc0\xfe?\x86\xa3WR\xfb\xde\xf5\\}\xb8r\xf5\xe1YW\x1f\xbe\xe8\xea\u00efp\xf5\x81M\x9e\xe7]}\">B\x89\x8b1\xff\xaf3\xc2\xd1\xfd\x87\x1b\xf3\xb4\x977\x8b\xad\xea\xabj\xf8\xa4\x96\xf6\xa4\xdd\aY\x8dZ\xd4\xda)\x9b\x05\x91n-\x8a\x191\x91\x91`\xd4\f\a1\u072e\xca\x13k\xe4\xb8\xc9\xcbC\xc7\x15,\ub818\u04b2\xc2*\xf6\x99\"\xcc>\xbf\x8e\xb2\x8eg\x94u\xc9\xe8n<\x9fI\xff\x9a\n\xba\a\xba\aJ\xb9\xf2\xd0\xe7\a\xd4\x1a\xe7G\x14Md\xac\x94{\xc6\u0202\xc2\x19vX\xa03\U000ac8bag\x18\xbd\a\xba\xb7\xaa#+Q\xe7Y\xd0\xf6\x96\u0501\t@\xeffH\xc4\xddx\xe6\x03&n\u016b\xfd\xe6p'\xad>\x13\xfbL+ra)T\xab\xdc\xcd\x11\x8b\xd7s\x9b\xe0\xeb\xc5}\xb8\xaeJ\xe4\xf5\x05V\xecV6;\x98_bmS\xd6\x18\\d\"\xde\xf9g\xf3\x81\x06YA\xa0?\xd7m\xf3r\x9en\x1d\xaf-\x10\x94\x02\xf8G\x9aC\xa8b\xc7\xeb\xad\u0230f\x9a,{P\xd2\x04\xda]\xb890\x98\xbc\x15)c\x1a`3\xf8K\x17\xf0\x038ba\xf4\xbc\xde*\xf5\xc8\x0eP\xab^f5\xef\xc7C\x8aW47+\xdb\x1c\xf4z\xf1Z6\x9c\xa1\xae3\x13\xc7~\xc476\x8e\xc9m\xc30M\xf3v\u0088o/\xe3\xf4\xab\x19G\xae\x05\xe2\xf0ru}=a\xedSRP\xcd\u0485*\xd0\x05\xe2-\x97\xad\x15\x96\xade\xcb\u05b0,7wQ\xcdF|`\xfe\xb2D\xf8\x86\x0eU\x93\xcb9\xac\u0238\xeb~\x99\x9d1>\xd3y\x06qwsx\x9eL\xa5W,\xf9z\x118\xf4\n\xb9Sv\x02C\x87\xad\x8f(e\xaf3\xd4Q\x8c,;\x01\xfe\x05*\xb3\x90{_\xf5v\x1f\x15\xe2\xc6\xf6\x95f\xaeS6\xeb\x81<\xc5,\xc1lu8\x03\xe4'\u0563^I\xdb\x00\xc75\x00\xfea\u07b0R \x1c\xf5\x95\xa7\x8c0\xc8-\xf5\xeb.\xa8\xad\v}h\x8cybuYr\xcc\xfbg\xf5\xf4\x88&\xd7\xe2\x8c\n\xf6K\xf1\xfa\x10\xeb\xeb\x11\x15C\xa1\x8dx\xa6 \xdc<\xba\x04\xc0k\xc1\xd8\x0f]{\u03dfL}\x8fy\xe2\xde\u0526\x82i\x91\x17k\x81\x1f\u32e2\x7f\x04\xe4\xb5Wq\"\xca!\xb6s\x13\xd7t@0j\x8a?\x19\xd1ri\x9a!\xe5b\xa1;\x82\n\x16\x95\n\xe07\x11D\xcb'j\xc6|~L\xf8\xfa\xfa\x9e\x9e\xf1\x9d \xdc >\xc6\x11\xdf b\xae\x9f\x94\x93\x91\x1b\xdb\b\xdb\xd2#\xf3\x10\x85\x87{\xa8\xb6\xeb\xd6+\x06y\x88\xbc\xf8=\x83\xbc\x81'K[\u0776A\xde\u01387\f\xf2J\x1e+uC\xe7\x84)\xf9C\x986\x043\xdc:\x8f]c\x89\xb7\xed\xe8\x86BJ\x9e\x1eR\xf2\x97\x00Lh\xe6\u0312\x0f\xc2Je;x\xc9\xdf\x0e66\fz\x14\x1cg\x80\x1e\xa4b\x94\xa9\xfc\u04923\x1d3\xdd\u0419y\x04\xcd\x1d\x1b1\xfd\xa4\x86A\xcetC\xb7tC\xf7\f\xa2\x8d\x98x\u0377\x99\xfb:\x9d0\x8d\x14\b|\xe8\x9fs\x0eU%uh\x9f\xdaB\xf6\xc1\x81\x9c\xc1\x96\x06d;\x96-\xb7\xbeX\xfe\xec\xa7\xeeg\xd3\xefom\x81\xbc\xd9+\xae\u020a\xa7\"\xd8\n\xac1\x9b\xd0\xe2\xfa\xd2l\xf7\xe3|q\xbd\x91\x1aky\\\xb7\xd9\xf0\x0ef-\x1cW\xc9S\xc8W\x0e\xa6\u033a\xc3\xc0\x8f\xc7\xe7Ay\xe8x\xf6~\xd2y`1\x80\xa7\x9c'\x8a\x18\x98\xe9h\x15q\xe6\u064c/-\x9bh\xb2\xcd\f~\v\x13\xf7\xb5\x04\xed\b\x8f#`\xdd\x06\x84\x9a,\xdf\r\x02jA5N\x12\x98\x00\x8d\x87\xa1\u00d9MB\x93\xa1\x8a\x10\u0726\xcbNp\x9b\r\x89\x15\x15\xbeE\u0158L\xc1\xd9\xda\t\xa6.\x9d\xc1\x8c\x92!d\xf3,7\xb4\xd9mF\ud6de;#\xe34\xeeM\xee\xc0\xf2qg\xc46\x85\x1ei*\x11\x1f4\x90i\x85\xae!\x0e\xc61g\x10s\x8b;\x13\a\u0730\xe2\u0611\x19\xb3\x01d\x06*Nt\x91\u0535O\x7f\x9a\xb3\xa1\x16\xc9\xff\xeb\xeb\xb3\xf5u=T@\xc9\xe2\x9c>\x03\x97%G\xa5;Iz2wz\x88\xe9\xf1F}\x12\xd1\xc8\x03\x97\x01\xfe\xebZ0\xa5\x9eF.Q\u0603\xfaz\xda\x04\xaa.\xe1wS8\xc2e\u069c\\\xb7\x98*pr\"\xe0\xc0\u04dcL\xa5\f\x8d\xe5\xc2\xced\xa4\x91\u02c0[=O\xaf5\xabm\x83\x8c\x993\x1a\x8b\x9eV\xabL/4r\xee\xd8b\x1c\x05\xe6\x86\"\v\x03\x93\x96\x9d\xe0f\xffN]7\x12\x10\xd9l\u0299E\x05\xb2n\xc1\xb9\x03\x1a\x137=\a\xe1\uee3d\xb5\xb5\x19\x19e\xa0\x8a>|\x86\x94#\xe3\u04a2\x01\x8b!\xdf[\x01\x1f\x9b\xf8\xba\xa1g\xc7\x19\x15\x98g\xe9\xefe\x8c?=\x8b\xa4h\xd9\xe3D\xa1`\xcf!\x80s\xbd\x90\xa4\x1d\xee\r\b\xa2_\xcf%\v\xf8\xd5\x1b\x92E\xec\xea\x8d\x01\f\xd8_tLY\xd9\xdd\xc9RwU\xfe|o\xaf\xdf\xc5x\xdd\xf4\x82k\xf72\xd2:\xae\xea\xe1\x99\xeaaA\x87\xa2\xb5\xdd\x13\xe4E\xfa\x87g\xe5\xe6\xc7\x06\xe1s\xfd\x91\xb1\r\xa4\xec\x15t\u06dc\x02\x9f\x11\x00\xbe\xa9\x8a.tC\x7f\b\xeea\x00~U\x9a\xe4\xda\x7fS7\xca@\x8c\x962\xa8\x1e\x15%\xe7h\x0f\xe6PX\x92\u0343\u0747$\u0163\x91\f\x95\xc9F+\xa4\x80\x88\x81\xef\xbb\xe9\x00\x93\x18v1\xa5@b\xa7b\x8c\x91\xea\xc8\x0e\xb1\x17#\x12\x94|\xa8\x1be\xd7\tr\x9dY\x9c\u0324\xf6\xa59\x8dS\xe6pD\xb9U\xab_K\x97\xd8\xcf\xe8\x12\x95C\u00bf\be\"\u07b8\xb0\xa0L\x8c\x14\x8c9eb\xa49\xbc\x80\"\x8df\xab\x81\xfa\u0132\xa7_(mb\xb7R\x03\x7f\xefSu\xb8\xbd\xd20H\x7f\x89\x9d\xf1\xf2\xecL\xf6x\xa8\x87\xe7\xff\xd49\xb8\x94\x9fA1%r\x8c\xc7\b\u0214\xec\u023e\u0691\u04cd\xf5\x1ew\xb5\x9c\xeb\xaa\xda3\xfd\xa2=9s\x90U?\xd1\rCg\u543b\xbaAN\xcb_(\xbb\xbeE\xd5\xd9\xc4\xd8\x18\xa7\x1b1\u0365\xa6\x0e\xf6\x84\x14\xf9\r\xdd(\xa3\xfcA\x85\xcf\xefq7\x15\x9d\x90\xa34/C\xee\xf6\xfc\xf2bOu\x83dK\xf5\x12)\x9e\xeehc!\xa6Aok+\xc9P\x8en\"*;~\x1a\xa9\xf5\xe8\x9c\xf8Y.\u008b\xb9\x88{\xaf\xdf\xe9\x1f\x1e\x9c$\f\u039b\x8e\xeb\xdef\x16s\u0398\xe2\x85W\xb2\xe5\xcf\x1a\x9cb\x95\x99@\xe6X\u01f1\xc5\xdb\xeb\xf5\x86'\xae?<1\x87\x83F\xd7\xe1\x8b`\xf5A\xd7S\xfa\xb90\x06\x98{\xc2M\x14+_\x9f(\xf4R\xf3\x93\x1b^b^MU\x10>b\xea\xfa\xfa\f\xff\xc6\xc7\xf5\xd0k7SI\xc8\xdd\xf5u\xac}\xfc\xe6\xea\u068b\xb3\x84\xdc5v\x0e\x9e\xcdL\f]\x9f\x8aM\x0eL\x816'K\x05\xa8F.\x05\xe5#&z\u0689\xb2v\x10\xce\u071e\xe6\xf9\xfe\x94y\x8c\x97<\x9f\xb3!\xe3\x1c\xe08\xe6l\xd8C\xc6\xd9\xd44\xd04[T\xe8\x9ch[6\x1b\x84\xa3\x9d\x90\xbb\xa6f$<5\xf3\xc0;\xfe\xde\xed\x1b\u024e\xb08\x00c\xb9G{\x8a\x9d\x81FD\xbe\x91|\xf5\xe2:\xd5\x13\ua29ev\xd3s\x1d\x8f\x95\x12\xa0\x96\x06\xd4\x1e1\xe0\x87\x8c\x1eL\tnv\xde\\?\xc8Hcd\xef9\tQ\xa4kN\x0f\xbd\xa5\xd4H\xe7yrd\x18\xf1\"w}j3\x1b\uc74cs\x9f\xc31\b\xc2\v\xd7f\xb2(\xf7\x1d\xfb5?\xf4\u0115\xcc>\x11(\xb5\u0758\xd0\x11\xdb\x16e\u07c3\x86\xb2\x8e\xbf,\xb3\x1a\xe3NT`c\x82\xcc\u0617U\xb9\xa3\x8eF\x99\x03ne\xa4\v\b&k\xef\u0169\tT\x88^\x11P\u07da\xb9\xd0@\xa2\nO\x06\xe8\x15\fP\xac\x1a\xa0W4@\xb1b\x80\x1e\x0e\x90\xa9q=\x93\xa8D\xf3\x96AB\xack\u7818kG\xdcD\xb5\x8d67\xb2dA\xf5wU1X\x1dy\x88 \x96g\xa2\xa8+\xe6\xab1\x1bN\x9dT\xaa\xddkq\x1f\x13F\x02\xfcq\xc62|H\xb4\xa9\xa3\aT\xb7[k\xe7\x1c\x8bb\xe5\x95R\u0465\x0e*\x91\xa96\xf1z\x88#t\x91\xaa\x1f\xd4\x1d),\xb1|\xbd\xc4\xd1\xfa\x05\xa8\x00\xbe\x9e\xa0\xf4Z\u0412nV\xe7\x99c|\vf\xb4\xb8\xa2\xcd\xea\xb6\xf72\x18\xd567\x8d5\xdcM\xb0>\x03\xac\x84\xc1\x14n\xf3\xd3=R\xcd\xe8*\x83\xf4\xac4`Y\xa4Z\xbb\xe7\x8d)\\wg\x97@|(\r}^R(P\xfa\x8c\xb6\xc16\xb4\xcfD\xa7\x96s\u01f9\x93:\x19\xe2w\xa2t\xc73\xe0\x97\xa0\x1d\x83y\x13t\x04\xd4\xda\xd44\x15\xa6B\xf0\xc0\x8cY{\xc7\xf3\x18\x7f\xe5\xeek\xaf&\xe9\xe7cG\xb0`J-v\x9b\x8d\u0605\xb9u?\xd8\xd8\xcaW\xc5\xe2_\xcaX\x9f\xd6\xca\xd4_)\x97\xabg\xd1o1q\xa5di\x02T\x91\x98Z2s\x1a0q7j\xae\xc8z\x9b\xeb\x0e\xb65'\xd9\u28c2\u2165\xb1}\xb2\xd0r_\b^\xeck\x19\xb3\x16\x90#\u040d#vl\x8a\xe2\xe6\x17\uae22\x86\xc2\xe6\v]\xfb\"\x05*\r\x02g\xe4\xe9\v5\x81\xfeseO\x82U`\x88&L\xcf\u11b1\u0525=\xd8\xf5W\x0e'\uacee!s\xa0\x11\xb6P\x01\xb5\xed\xe5\n\x12\xcb$\xf1\x12\x85\b\xe6\xd2\r\xc2\xcd\"\\\x04\xb2\xb0\xe3\xe1\xba\x12:7zG\xc7J\x83\x14\x85\xb7\x85\u9503\xb13\x84c\xf9\xc6f\x15XW\xf4\xee__\xf7\xd5\xe1\xa2\xf4\x98\xeb\x02\xec\xca\xd8q\xd3/?\xf0\x1dO\xd7JZ\x11(9\x9b\xf8g\xec\x9fb ~D]\xf0\xbe\xa7dL\xc6v|\xd6C\rk\x1bu\x8d\x01\x8c.\"5\x01\x90\x9aO0\xc2\xd1\xd2D\x1b\x97WU\xb7\xbcx\xc64X\x85*\xd8[hyc\x01H\x1b\u041b\x84fC\x06\x86QKHx#\xa1&\xab\x101Co\n\x86\x97T!&\xeeU\xb8\x9c\xb4\xa3/\"\xf2\xa8\xb0\x13+\xfa\xb0\f\x9dQa\a\x96`\x9c\xb6\xbf\u043c\xf0\xfb\x9e5\xf6\xb9\x12\x01\xcdbelJ\xfat\x83(\x8e\xa7<\b\x1d\xd7\u01a9\xbb#xr_\u00d1\xf6\x92F\x18\xe4\xd9\xc1i\xe9i\x1a\xd1^\xd6H\xbe\x1fb\x02\xb2\x93\xf6\xd2\x16\xe4\xd5^\u058e#\x14Z\x9c\xa0\\\x1b\v\xc7J\xd7R\xf2\x12;ai\xdb\xf9>\xc7TL\x98G\xe9\x8d\x02\x1e:\x82\x18\x8b^\xe4(\xff\b\xb5\xa6\xbd\x8d\u03d8\xdag6`\xd7\xdd\xf8\x8c\xf6\x99t\x85\xa7\x98>'l\xae\x1b\x91O\xd6so\xa0\x1e;\x7f\xd3\xf1l\xff\xdc\\\xab\xaa\x18\xc1C\x10\x1cX\xb2\x8b&2Q\xb2\x8b\xa6\x85X\xfa\x1b\xbdh\xf25\xb0\u49d4\u02f5\xb1\xf4\xf7\xaa}\x12\xa1^\x84\xcc\xc0]\x84\xfae\xb4\xcd\xf5@\x1aC\xf8+nN\xb1\x7f\n\xe6\xcc \xc9\u079c\xf0z\x16\v\x02\x85lw\u1826\xdaH\xd20\xb8H\xe4\xa7?Sc\xc1%!\x97(\xdeejy\x85\xb3!\xa0wD2U\xe9\xbd@Q\x0fp\x9cI/^\xd2ED\xbc\xbc\xc5\x19\xc14%b\x9a\x89\x88)\u029c\xb9f\xa1\x90i\u487f\xbe\x9e\v&D\xb7(\xf6\xa5%\bdht\x19\x15\xea\xe6\xd2\xf0\xc0\x10W\x00\xa4x\x98f\xb1|\x92NztqJ\xcav\x1e\x89\xe3\u012a\x12Ur'\x1c\x0e\x9d\v\x06K\xc77+\x84\x9a<\xe6q\xfd\x97\xe8\xb6\x0f\u7722\xcdoC\xdb\xd46\xf8\x91\x9f\x1ej\xf22k$^\x96\xf9\x0e/aB\x11\xaaE\x8b\xd8\xf6\xefF0[\xa2\x9bi\u04aa1\xc7\xe0FC\n\x9c5\x8f\xdd\x1aR_f\x05\x828\x1e\xb6\xd6D/\x16kI\x82\t\xe5\xeat\xd4N\xe1\xfd\x17\xc4\u07ceN\a\xef\u87a9\xad\x8f\x99\xeb:\xd3m\x8d\xf8f\x9dp\xb3c\xf4t\xdf\xf4\xd2\x16\u27f1\xa6m\xb9\U000da5bag\xa3\xee\x9a\x01\xeb?\xf6\x03p\xfc\x12\x1b\xb1\x81\x8bmh\xbd\xad-\xcd \xf94\xf8\rqS\xaa\x10i\xc3\u0536\xb4\r\x15\x86\xf8\x87!\xe3\xb3(aG\u06c8\" e\xc8\xe9\bD\xb7(\xf1S\xdaF\x1a\x87h\xe7\x14\x1c\b\xe0\xa6\u062a\x11\xdf|\x8d\x8aq\xd9b\x8e\xabT\xbd\x9b\xd5\xcfb\xcc\xd0\xf5}\x0eQNfX\xf4\xa5J\xe4\xc0\x1f\x84\x83@p\x1dl\xabI\xa0B|c\xc3\xdbp\xe6J\xa8\x8e\u058a)\xf2\xb3\x16\x98b\xd3'a\x01\xf8\xd0y\x84!\xa4\x95+-x\r\xd3\xcdG\xe06|\x7f\xeb\xfe\u0596\x93 +\u01d5\xa6\xa0i\xf2\xa3\xea1\xf1L/\xee\a?\xaa\x1c\xc7s\x85Z\x91L}\xe5\xcf\xee\x18\xfa\x8e\xa9\xdf\u07d1\x9f\x92\xf7\xb7\xe4\xa7\rc\xcb1\xf02M\x9c\x80\xe7\xa9\xec\xfeV\xbe\xbal]0i\xcfU\xd7NRW\xbe\"\x9c\xe4\xe7\xa9\xe9SX\u0467\x93\nbD\xc0:\xd0\x1b@94\x86\x11\xfe\\F\xee\xc9*X\b\xa9\xfb\x9f2\x8c\xa4\xd6m\xd0%F\xb9\x93R\t\x06\xb8G\u0564c\x84\x99h\u031c\xafB\a\xecE\xb4\x04\xd4\x0fS\xfd\xc9\xd8\xc2\xcf\xcf\xcf\xef\x97\xd1\xec\x1dWGV\xa2\x96\x05\xa8\x9a\xad\xd4\u0688*4\x88\x15\x15z\u064c\xb9\x8eR\u0516\x8a\a\xddoT0\x1d\x8d\xd8\x04\x8c6\u0488`\x83\x1b=G\xb7H\xb0\x10\x89\xedO\x93\xf6\xa35<Uk8\x8c\xd6p\x98\xac\xe1\xa9Z\xc3\xf1\x1c\x90)rF\xba\xb515\x96\xfa\x99\x8d\xc5^b8\xed\xa3\xd1\u04ed\rG\x9f\x92`\xd3Jpb\xa1w\u05869\x9dc\xcf\x12\xba\x80\xf3>Dj\x91\xc6n\xab^\f\v{1\\\xe8\u0170\xa0\x17\xc3g\xf4b\xa8z\x11S\xc7hv\xb0/c3\xcc\xd1\u01e8/\xe3\xb8\u0557\x92\xae\x8c7\xac\\\xd3P\xa7\xb5\x8c\x14\x16N\x8a\x9d\xa1_\xc1\xcb@\xbfl\u04caK\xe7\xa9]\xb0U\x03\x7fZ\xddJ+O\xa9#&nx\x1b\xf6\u00a8\xe6\xe8\xfc\xd5\xd3&\x8em\xbb\xacx\xbf\xb9\x8a\x1e\xae\u0787\xb8\u0641\xbd\xc8\xe8\xe9\xe9\xe6C\xfc\xa5}\b\xc8i\x8eH\xbf\xbc@\xa4\xf3\x83\xa4\xd1 Y\xd1 i4H'3H\x9a\x0er\xc5\xc5g\x85\xdbk\xba\x15F\xa8\x04\vr\xd5@\xebF/3F\x96\xf4\x1d\x8c[\xb0\f9,C\xe0\xdcU\v\xaa;\x8a\x95'\xd6j6\x1e\xb9\x89\x93\x93\a\x81\x1dZ\xa7\xfb\xe1d2\xdb\xf7-\x10\x1c\xd0\xc1Y1\x80H\xf0\x98\x8d\xfcL\u0331\xfb\xc3a\xc0\x84YIT]\xbb\xc0Y3n\xb2L`\xb98\u02c6r5\xb1\xe8G\x11\xd7\x0e\xbe!\x99JV\x88\x82\x99\x9a\x97\x04\u065b\xaa\x91\xfc\r\x8c\x99\u0197\x04\xcf\xc5\x02\xf9\xa6\xa2\xae.i\x03\x16\x98\xcc\xe5\xe2G\x89\xcc9\x9b2\xdd8.\x90\t\xef\xd2\xd1\x15J\xb8\b\xb2*\xab:\xf3\x15M\xf2\xb4X\x057]\xe5\xf5|\x99\xf87\xf7\x8e\x8e\u7653\x14\xa8/^_\xcf\u05d6s\x87\x9e/\xfaL\xe7\x04Pa\x88\"\x01Ti\x8e\x05\u0785@\xf0:\nc\xbet[\xc70\xb5\xe3D3\x94=<\xc2\xe6\xaa\\\x16h\xd1E<\xa6\xd8Y\xf0\x974zY\x87?3\xeb<\t\u009e\xa7T\xc4\xe3\x02X\x8f\u035c\xe6p\u0165\x7f\xc43\xab\x84/\xb9\xe9%\xba\xf28\xa3\x8f0I\xf3\x01\x00\xaeu\xec[\x10_\x81\xcd\a\xb0e\xe4\x106'\xe3\x15\xf7\xc1)zN&\xab\x1cd\x93\xe3\x1b,c\x06\x83\xf3\xef\xf0#\x95s\u0604:.\xac\xf5\xf8\xa7P\x7fS\xa7\xb7\xa1\xf2 \x15\vJd\x80\xf4\x12@5,\xab\xcd\x17s\x1f`\xd5+p\x1d\xcb,\x15I\xc5\u0142f\xa0\x84\xf0{\xda\xc63+\xb8\x8a\x8c\xc4\xe5\xe6\xba\x05\xae\x16\x9f\f\x90\x01\xe3g\x8e\xc5be\a\x9e\xf5\x1c\v:R\x81l\xaa\u02062\x19E\xfc\xeb\x05a\x1f\x95^\x86\xfe\x9dL\xe3\xabLgi\x96\xa5\xe2\xafD\xfd[Q4j\xf6\x9a\x13\x98\xd1me\xa1 \xccl]\xb13\x1c\x8b\xbc\xdc\u0139#\x04\xe3\xb1\xdfX\xe2\x96\x10\u0157-\x7f\xb2\x15\x15\xdd\xd26\x84r5\x1bR\x8b\r|\xfft\xa9\xd4\xf9\xf9y9N,.\x8a$r\xc4\xe9d\xa9l\x92\x82\x05\xd9\xc5\xd4\xf59\xdb\x12t\x14`\xe9\xd8{\xac\xc0\x8eu\xea\xf9\xe7^)\x1as\t\u0729J\xc2/M}\xc7\x13\xa5\xa8\x03%\xe1\x83\xef;3\xe6+\x80Y\x8c\xcc\xc0\xb5.\xcc\x04b\xf4\u85c9\xd1\xf1\x14\x10\xaf\f\x04\xc8\xf1=S\xcb\x06D\xfc\xebjd\x7f!\u010ej^F\xec\u05e2\xc6W\xb1\t*\xf9\x97\xbd\x1e\x8a\x11;B\xd9\xc5\xd2\u03c1\xc1\xdaF\xb6\xd7\u03c7\x89Ee\x03?\xf4l\xcb\xf5C{\xa9p\x9aTT\xfa\x05\xb18*\x1ea\xf1\x12 \x9e\x13\xa9\x7f%\u07e9\xa5\xc2Wq`J\x85\xf2\f\x96-\xc5}#c\x9f\xca\xe0\x86\x9e\u0575\xc6JuT!\xa9\xf55\xfb\x84\xebKy:\xaae4u\xc3\xe0\x8e3\xf2@\x95\x9e\xa4\x88\xe8G6]$?_p1M\u01fe\u01d6\x97\xd2-\x88~]\xb5\xbbbM\xa8\xce,\x95\xbc\xbaP\xbej\xddx\xbe}^\x80W\u0506ZT\xf1\xb8w\xb4\r\xad\xa7i\xc6\xc6U\x9dz\xf6\u67d3!\u0504\x0e>\u1122\xaf\x14\xccf\xc8]\x94bp\n4%\xc7k\xf1\x1b\t\x96\xef\u078b\xd2\xd5l\u01f1\xb7\x99\x8b\xf7\x9bf\x92@\xea\x9b\xde\xe2l\xe8\\\x98\xca\x03X\x1d\xdf=?\ag\x998\xc3]N\x1d\xd7\xf1Fw\\\x1a\x8c\xe1\xf8\xb1W\xb6\x19\xf8i\xddb\xdcb\x9e8\x00\xa7-\xb0\x8ba\x92\xaaFU\x1ay2|QG\u00b0\x83\x9aEcg\xcb!^\xf9\xfc\xfc\xfc\xaa<:\ua8a2\xbc\x8b#\x88\x8b\xa0\xa22\x93~\x8b\xb3)\xf3lf\xab\xd1\xe5\xc0$rA\x95\xaa\"\x8b\x00'\x96\xa2V\x02R\x14\xc7/@WdC\u0140\x15\x05\x91+A-\x8a\xe3_p\u0446\xbc\x80\xa9\xbe\x97\x05\xdf\n,\xcf\u00b4\xa8\x82\x15\xacY\x98:\xc7\xc6v\xafe\bJ\x99K\x8b\xbb\xb3\x10\x9d\u0339\x94zZ\xbb\x89[\x11h\xb66\x18)\u038fg\xe5\xd9s\u044b\x84\xe4\xa0[k\xaa8]\xa7\x93\xe9\xf6\u0588h\xeb\x9aqMR\x91\xb7\xc1ft\x12\xba\xf1l\u0220\x9d\x14\x93#\xa4\xca\xe7QH\xa63\xc3 \xd9L\x10\x99(\x03s\x15\xdc\xc9,\xd9\xe2b\xe7\xe7\xe7\ve\xde<?/,\x90C\u07f4\x8c\xf2\xe1\xc8%\xa6\x05\vQy\xb1\xecB2\x96^\x98\xbe\xa5\xc1\x14\x1a\u0352yS\x9d^$WD\u04cc\x82j\x93\xf1^\xa3\xce<m#\u06a7\xab\x85U\x16\xcf\xdb5\xea/\xa4\x87\xcb\xfd.\x80yA\xe5\xda\x16\xe8QY\xd9\x1aS\xde\x17z\xea`\x87*\x03\xd0\x0e\xa2\x8bK\x05\xee\x9cZ\x82x\xe1\xd4\x14\x98\x9b2'\xee\xfeU\xad\xb65r\x88\xb6\xfe0\xf4\u0176fdSZ*\x05\x96S>\xa1\xad\x12>U\xef\xe6\x13\xea{*\xc1]\xa8\xa9~\xa0\xe2G\x10\x9f\xbd\xfb\xdaf\xcb\x0e\xc6\xc6\xd2;Zb\x1e\xef\xd9\xe7\u02da\xbeg\xe88Wh0\xe7\xe4\xc0\xdc:\xeao~\x01\x8cl[\xe4\x02n\xf7\xb1\x8f\xb7\xc8\t\xfc\xd8?\xde\"w\xc0\xe3o\x8b\x9c\x9a[G\x9f\u044e\xb7H\x1f\x12.\xe0\x8e\x9f\x8b\xea\xe1\xfd\x8b\xf6\xe1\xf1\x16\xd93\xb7T\r\xf7/\xfa\xfd\xfb\x17\xbb\xcd\xfb\x17\xbb\xfd\xfb\x17{\x90i\xbfu\xffb\xbf\xb3y\xff\xe2\xb0u\xff\xe2\xb0\x03\xf7\x04\xd5\xf6\xaa\xf8ma`\x1f\x03\ax\x9dP\xed\xa0\x81\xdf=\xfc\x1e\xdc\x0f+\xf56&\xd4\xdb\r\xfc\xb6\xf0\xdb\xc6o_%\xec\xe3\x17\xae!\xaaw0\xb9\x83\x8d\xd4;}\xfc\xee\xe1\xf7\x00\xa3\xfaU\xfc\xd61p\xd8\xc4o\x1b\x02\x8dN\x15\xbfXe\xb3\x06\x955\xebU\f4[\xf8\xed\u00b7\xa5\xa2:\xd0~s\x1f;\xd6<\x80f\x9a\x87*pX\xbb\x1fVZ5\f\xb4\x1a\x90\xd2j\x1d\xe0\x17\xaal\xb5\xb1|k\xbf\x8e_h\xbfu\xa0\xbe-\xfcb\xd6\x03\xccz\x88]i\x1d\xee\xe1\x17\xa2\xda\xd5\n~k\x90\xd0\xc6>\xb6\x1b\xfb\x18\xe8C%\xed]\x18C{\x0f\v\xb6\xb1[\xed\xc3\x06~1\xf9\x10b:\xea\u06a6N\xb5\x89_\x8c\xaa5\xf0\u06c1oC%71\xd0W\x81]L\xdfm\xa9\x00\x80\xbb[i@\xa0[\xef\xe2\x17\xa3\x9a\x15\xfc\"\xec\xbb-\xe8JW\r\xb7\xdb\xc1\x94NS\x05\xf6\xf0\v\xbd\xefv1\xa1\x8b\xd3\xd1\xedw\xf0\x8b\xbd\xef\xeeb\xcan\r\xbf-\x15\x85m\xa9\xe6\xf7\x00P\xdd}\xacj\x1fc\xf6\xf1\x02\xaa\xee\x01\xb6{\x88\xa5\x0f\xe1w\xbf\x82\xcd\xf6+}\xfcB\xb3}\x84c\xbf\x8a\xcd\xf6q\xd8\xfd\x1a6\u06efcJ\xbd\x86\xdf:~\x9b\xf8m\xe1\x17\xb3\xe2\x98\xfb\xcd.\x16h\xee\xe1\x17\xba\xd3o\xe3\xc4\xf4\x11Q\xfbj\xb4\xfd\xce>~\xb1s\xfd.vH\r\xb7\x8f\xc3\xed\xab\xe1\xf6q\xb8}\x1cn\x7f\x17\xdb\xddU\xe5q\xd0}\x1ct\x7f\x1f3\x1d\xa8/Vu\b\xa9\xbbj\x84\xbb\x95=\xfc\xc2\bwq\x84\xbbj\x84\xbb8\xc2]5\xc2]\x1c\xe1.\x8ep\x17G\xb8[W\xc5qX\xbb8\x95\xbb8\xaa\u0766\xfa\x8d}\xdf\xc5\t\xddm\u3dc3\xe5\xd4\bwq\x95\xed\xaa\xf5\xb5\x8b\x13\xba\u06ed\xa9@\x13\xbfXo\x17su\xb1\xde\xee\x01~\xb1\xa3}\xac\xaa\xdf\xc0/\xa2\xcen\x1f\xb3\xf6U\x858\xfe]\x1c\xf9\x9e\x1a\xe7\x1e\x8es\xaf\x82\xe9{8\xd0=\xb5$\xf6p\xa0{j\xa0{8\x9e=\x1c\u03deB\u02bd&\u053c\xd7\xc2\"8\x9e=\xc4\xcc=5\x92=\xc4\xcc=5\x92=\x1c\u025e\x1a\xc9\x1e\xce\u055e\x9a\xab=\x9c\x9f=5?{\u063f=\x9c\x9f\xbd}\x18\xd7\x1e\xce\xcf\x1e\xce\xcf\u07a1\xfa\x02\xb0\xf7U\xef\xf7\xb1\xf7\xfb\xaa\xf7\xfb\xd8\xfb}\xd5\xfb\xfdz\x1f\xbfP\xd5~\x03\xaa\xdao\xe2\"\xdbG\x02\xb4\xaf\xe6a\x1f\xfb\xbd\xafH\xdf>\x12\xbd}\xd5\xfd\xfd.f\xeb\xaa\x14$\x05\xfb\xbbu\x15\xd8\xc5/\u05bc\x87Kz\x7f\x0f2\x1fTp}\x1e N\x1c N\x1c N\x1c(*p\xd0\xc0\\H\x1a\x0f:\x98\xdci\u0dcd\xdf\x0e~\xfb\xf8\x85\xca\x0f\xba\xd8\xe1\x83.&w\xbb*\x00}<\u8ad6p\xba\x0f\x90Z\x1d\xf41\x17\xce\xf6A\x7f\x17\xbfH\xcc\x0ep1\x1c\xe0b8@`\x1f`\xd7\x0fT\xd7\x0f\xf6\xb0\vj\x00\xfb{\x18\xb5\x0fm\x1cV\xa0\u0721\xea\xfaa\xa3\x8d_\xec\xc3a\v\x80~\xa8\xb6\x85C\x98\u6aba\xa3\xaeZ\xa9\xf5\xe1[?\x84oSE5\x9b\xf8\xed\xab\xc0>|\x01\xe8\xd5J\v\x13Z-\xfc\x1e`r\xbb\x82\xdf&\x06:\x98\xabs\x00\u07fe\xaak\x0f\x8b\xec\xb5\xf1\x8bU\xed\xab\x84Cl\xf7\x10\xba_\xad5:\xf8\xed\xab\x00d\xab\xa9\xbe\xd4`\uaaf5&\xa6\xab\x1e\u0570G\xb5\x96J\xef`JG\xa5t0\xa5\xabR\x00\x88\xd5\xdanM\x05\x9a\xf8\xed\xa8\x00t\xb0\xb6\x87\xe9{*\x1d\xbbY\xdbS\xe9\xfb\xd8\xe6>\x06\ua021\xd5:bh\xb5\x0e{F\xb5^U)\xb0\x98\xaa\xf5\x0e\xb6V\ar^\xad\xabA\xe3\xbeZ\xad\x1f\xaal\x87\u0429\x06\"Z\xb5\x05\xf3Pm\xb5\x0e1\x00\xc8[muT\n\u0407jKU\xd0:\xc0\xc0\xa1J9\x84!\xb6\xd5t\xb5a\xf5T\u06f8z\xaa\xed*\xc0\xbb]S)u\f4T\xa0\x89\x01\x05\xa366\xdaV\x13\xd6\xc6\tk\xab^\xb7\x01\xb9\xaa\xed\xfd6~!WGU\xd6\x01\xfe\xa2\xdaQ\xb9:\x80\xf2\xd5N\xa7\x8d\x01\xa0\x04\xd5\x0e m\xb5\xb3\xab\xd2q\xb8]\xd5\xc1n\x15\x80\xdbU\xb3\xd7m\xc1\u063bm\x15\x80m\xa1\xdaUuv\x01\u07eb]UAw\xaf{?\xac\xf6U\x05\xfd*@\xbf\xaf\xfa\xd1oB\x99>,\x94\xaa\xa2\xf2U\xa4\xd9\xd5\u0746\n4\xa0\x9a\xddN\x1d\x03\xfd\n~\x0f\xf0\v\xb0\xdd\xddE\xbc\xd8\x05\x0e\xa3\xba\xa7\xea\u07ebA\xf9=d\x1b\xaa{\r\u0236\xa7\x10k\x0f\xf8\xa8\xea\x9e\xea\xe0\x1e\"\xd6\xdeA\x17\x03\xc0\x8dU\xf7\x0e\x10\x82H\u04ea{8\xe8\xbdC\xe8\ucfaay\x7f\x17*;P\x81CD\x95C\x85*\x87U\xa8\xf9P\x8d\u9c01)\r\x95\x82\xc8~\xa8\xc0u\xd8l\u3dcb\xdf]\xfc\xaad\u0117C\xec\u07e1\xea\xdf!\xb0$\xd5C\xe4\f\xaa\x87\xbb{\xf8\x85\x91\x1f*\x8c>\xdc\xc3\xf4=\x95\xbe\x87\xe9j\xe5\x1d\x02\xefU=\xdcW)\xfb\xd8\u0301J\xc1a\x1e\x1e\xaa\n\x0e\xb1\x82C\x95\r\x98\xb0Z\x056\xbfZ\xa5\x8dW\\v\xd5\x15\x97\xb0\xa1\u056a\x95\x1a~\xdb\xf8\x05`\u05aa\xd5:~\x9b\xf8\xed\xaa\xa8}\xf8\x02\xafU\xab\xd6Z\xf8\xed\xe0W\x95\xa8\xa9\xe4C\f\xc0\xe6U\xab\xd6\xf7T\x00Z\xac\xe2\x94\u05ea\rL\x81\xfd\xa1V\xed`#\x80\x9f55\xbd\xb5\xbd\x1a$\xec\xd5U\xa0\x89\x81\x96\n\x1c`\xb6\x83]\x15\xc0\x14\u0618j{\x87P\x8b\x9a\xc4\xda~\xad\x89\xdf6~\xa1G\xfb\xaa\xae\xfd\x16F\x01\x17[\xdb\xef\xa8(\xd8pj\xfb}\x15\xe8\xab@G\x05\xa0\xfa\xfd]\x95\xb2\x8b)\xbb*e\x17S\xf6T\xca\x1e\xa6\uca54=L\xd9W)\xfb\x98\xb2\xafR`_\xad\x1d\x00\xbf[\xafT\x9a\xf8m\xc1\x17\xf9\xf3z\xa5\x8eQ\xf5]\xfc\xee\xc1\xb7\xa1\x12\xba\x98\xab\xbb\xaf\x02X\xbc\xafR\x80\xfa\xd6\x15\xf5\xadW\x80\xb7\xaeWqy\xd5q\x16\xeaUUs\x15Hy\xbd\x8a\x03\xacWw\xa1L\xf5P\x05\xb0L\x03a\xd6\xd8\a\xea\xdaP\x88\xdf=\x04\xa6\xbe\xafv\x97~\x03\xb6\x9a~c_\x05\x80\x12\xf6\x9b*\xa5\x05\x84\xac\u07ea\xaa\x00\\\xa1\xdao\xc1V\xd4o\xd5v\xe1\xdbP\t :\xf4[x\xb7j\xbf\u0545\xf2\x8aD\xf6Qj\u8deb@\x94\xfam,\u07ee\xd50\x00+\xb7\xdf\xee\xecb\x00&\xa2\xdf\u0189\xe8\xb7w\xdb\xf0Ey\xa7\u07e9T\xf1[W\x81&~\xa3\x94>~\xf70P\xab\xc1Wu\xa7\u04ee\u00f7\x83\xedtv1p\xa8\x02\x87m\xfc\xee\xe2\x17\xfa\xd9\xc5u\xd0\xef\x02>\xf5\xbb\x88C\xfd.\xb0\x13\xfdnK\x05\xda\x00\x81n\xa7\x81\x01\xd8\xec\xfb\xdd=\x18G\xf7@\xa5\x03\xbe\xf6\xbb\a-\x15\xc0\x94CU\xe7!\fJ\xd1\xcb>\xb2\xef\xfd\xbe\xea`\xbfQ\xc3oC\x05\xa07}\xd5Z\x1f\xe4\xc6~\xbf\xdd\xc7\xef\x01F\x01\x8d\xec\xf7\x81Q\xea\xf7a\n\xfb\xfd]\u0334\xdb\xc5d\xe09\xfa}\xd8&\xfb\xfd=\xacw\x1fa\xda\xdf\xc7\x04\xd5\xcb\xfe\x01\u05a8\xa0\xd0\a\x9a\xd1\xdf\u017d\xae\xbf\v8\xda\u07edtU\x00\xba\xbc[U)ULAr\xd8\u07ed\xa9@G\x050\x9b\x82\xd6.l\xb0\xfd\xdd&\xce\xc3.\xf0\x1e\xfd\u0776J9\x80\xee\xa8\x15\xbf\xdf\x06\x96j_M\xf1~\x1bV\xd4~{o\x17\x030\x1d\x87jc:\xec\xc3^t\xd8o\xab\xc0~\xf7~x\xb8\xabR\xb0\xa3\x87JV8\u072d\xb6\xf1\xbb\x8f\xdfC\x8c\x02\b\x1f*\xf1\xe1p\xb7\x8e\x99\xeb\x1d\x15\xd8\xc3\xef\x01|\x1b\x15\xfcV\xf1[\xc7o\x03\xbf-\u033a\x8b\t\xfb\xd8\b\xf2\xb8\x87\xfbH\xf5\x0f\xf7\x81u8\xdcGF\xfbp\x1f8\xa5\xc3\xfdC\x95\x82\xfd?P]>\x80\xed\xf3\U00020375\x1d\x00=><\xac\x018\x0f\x0f\x81o><l\xa8@\x13\x03-\u0306;\u00a1\xda\x11\x0e\x0f\xb1\xeaC\x14\x8e\x0f\x0f\x01\xd3\x0e\x0f\xf7U\xca>\xa6\ucad4\xfd\xbd\xadr\xe0\x87\xdcb\u49b9\xb7\xb1u?\xac)\xe6\xa3\xd6\x1e\f\uf1f6\u0360n\xdb\x1eB@\t\xd3\xf6\x00\x03\x96\n\f!0d\x15\x86\xdf!\xa8\x18*J\x83\xd1\xc2\x04\x9c\xf7!\x83}\xb8V\xb1\xd562\xac@eu\v\x8b\x0f\x92Zj\x95\x8a}?\xac\xd7@\x84\xaa\u05c0\xab\xaeW\xea6~+\xf7\xc3Z\u00ea%\xc5l\x04\x93m\xc3\x1ee\xdbm\xa6\x02\xd8\xe1\x0e\x83o\xb7\x8aQ]\xba8\x06\x86\xf8j3\xd8\xc4lV\x85tV\u00c4z\r\x13\xea\x18\x85\xb3e\xb3&\xee\x81\xd0d\xad\x82[Q\x93\xd2M\xfc3\x80\xef\x00\xe8v\u04c2\xde5q \xb5\xe6\x90\xc1\xc5\u0434\x8b_\x86{\\-\xde\xe2l\xab\x02\xbbRK\x01\x18\xb5\x1c\xb5\x01\x10\xa3\xda\x00\xb0\xb26\xc0=uP\x1d\xe0\x17\x1a\x1d\x80\x96\xa16\x00\x1e\xbdV\x87\xce\xd6\xea\x98Z\u01fd\xb4n\x01\xd4\xea\f7\xdc\xfa\xb0\x8e\u07ce\n\xc0(,L\xef\u008eQ\xeb\xd6q'V\x1byu8\x8c\xa7}c+;e\x87\xa8\x15\xaa+\x15\x11\xaa\x80\xbaJ\x05\x84\"I\x13x\x9eJ\x13\xb5YM \x12\x95&J'M`\xa8+M@\xb9\x8a\"\xf2\x95\x16\xaaZZ\x8d]\f4\x956\b\x04\x96\x16\xb2\"\x95\x16*3ZJ\x8d\xd1B-X\xeb\x00\xcb\x1ft\xf0\xab\xd4A\a\xfb\xa8\x02BE\x0f\u0489J\x1b\xb5L\xed>\xd6\xd2F\u0669\x8d\xbb|\xa5\r;{\xa5Sm)\x85O\x17\xbf\x98\u04a9aJ\xad\xa9\x02m\xfcvU\x00\x1a\xe8(\xedF\a\xb8\xb0Jg\x1f\xa5\xba\x0e\x8a\xb4\x9d\x03\xa5\xac\xa9@\x05\u077aR\xd6\xd4Q\x0fSG\x99\xb6\xdb@\xcdNSi~\x80\xa5\xabt[\xa8\xc6ia\x91\x8eJ@\x15Bw\x17\v\uea82\b\xb9.\u00ac\xbb\x87\xba \xa4c\x95\xee\x9eR\xf0`\xc2\x01Vu\x80*\x12%\xbd\xf6\xb1+}\xecC_\xf5\x01\xf7\x81J\x1fE\xc0~\x03\xf5,\n\xee\xfd\x06*R\x9a\xa8<A\xe8\xf7\xdb\xea7jyT\xdf\xfa\x1d\xa5\x80\xc1\nU\xdf\xfa8\x9f\xfd\xbd\xb6\n\xa0NFu\xae\x8f\x9d\xebc\xb7\xfa\xd8-E\xff+\xbb\xd8-$\x91\x95]\xd5-\xa4\x88\x95]\xec\xd6.vkWuk\xb7\xa14--\xfcb2\x82l\xb7\xa5\x14-\xf8[\xf5d\x17\xb1lWiNw\x11L\xbbJ\u1dcb=\xd9E0\xa9\xbd\xa1\xb2\x87}\xd8S\xad\xefa\xeb{H\x8f+{\xd8\xfc^Ci4\x1aJI\xd2\xc4/tb\x0f\x9b\xdf\xc3\xe6\xf7\x14T\xf6\x10*{\b\x95=\u0557=\x9c\xb1=\u0557=\xec\u02de\xea\xcb\u079eR\x88`\x8d\xfbX#Bh\x0f!\xb4\xaf \xb4\x8f\xbd\xdbW\xbd\xdb\xc7\xde\xed\xab\xde\xedc\xef\xf6U\xef\xf6\xb1w\xfb\b\x96}\xec\xd7>\xf6k\x1f\xc1\xb2\x8f\xdd\xda\xdfC\xdd\u025eR\x91\xeccU\u062cb!+\xfb\xa8 \xd8W\x8a\x18\\\x15\auTj\u0515\xc2\x02U/\a\r\x9c\xde\x03\u053d\x1c\xa0\x02\xe5`W\xa5\xa3\x96\xe7\x00\xd5(\a\b\x81\x03dZ+\a8\xce\xc3j\a\xbf\x90\xe9\x10\xf5\x85\x87u\xd4>\xd4U\xcc\x01~QE\xa1\x14\xa2\x87\xa8E9De\xf5!\xeaR\x0e;\xa8\xfa8D\xcd\u0261\u049c\xa0XS9\xdcC}Cm\x17\xd5\x06uT.4Q*\xa9\xa0\xa4Ti*MD\v5\x11\xad\x9a\n4\xf0\xdbV\x01\xd4;`\xcb\xd5\nJ\xa1\x95\x8e\u0286\n\x83\n\xca\ue56e\xd2w\x00\x93Y\xad7\xf7\x95\x88\x7f\x88bvMI\xdbP\xb2]W\x81:\x06\x9a5\xfc\xa2\x14\xdd\xc6\xdfm\xfc\x8dP\xab\xb6\xf7\x95x\r5v*\xd8\xffN\x05\x03\x9d&~a`\x9d>\f\xa2\xabd\xc3n\r\xc5\xe2\xba\n\x00G_\xed#\x8b[\xedW1\xd0D\xb1\x17\u056dU\xc5\xd9U\xfb\xc0GV\xfb\xa8L\xe8+\x81\xba\x8f\x92\xa0bo\xaa\xbb\xb0\xc3Twq\xa2\xab\xb8\x02\xab\xbb-\xec\xcc.vvW\t\x94\xb8\u022a\xbb(\x1fTw\xfb\xd0\xcd]\xe4=\xab\xbb\x87(1\u05f0\x82=\x98\xd8\xea\x9e\x12%\xf7\xf6k\xf8U)\aJbF!Z\u0250{(C\xee\xa1\x02c\x0fT\xb5U%\xf8T\xf7Qz\xde?\xdcU\x01\x14(\x95\xe0S\x01\xcdum\xef\x00\xa5@\x14\xb5k\xa8\xe4\xab\xed\x1f(\xc1\b\x05\x8f\n2c\xf5\x8a\x12\x89\x90;\xa8tQzh)Q\x01&\xa3\xdfj7T\x00\xe5\x86.\x8a\x12]\x944\xa0\x91~\xebPI\x00\xc8\xe0#\xb3\u06a9 \xff^S2A\rYzP\x90\xf6;\x1d\u030a\xd3\xda\xef\uc870\xa0\xf8\xdf\x0eV\u04ad).\xbd\x86\x9c?.\xa3~\x17\u0422\xaf\xf4\x1d}$\xf7\xfd.\xaa#\xfb]\u4a7b(\xc1\xf4k\x8a\u066e#\xe7\r|c\xbf\xdf\xd8\xc3/r\xd9m\xc5r\xe3\x10PI\xde\xef\xef*^\x1b\x14\x00\xfd>\x8a4\xfd]\x94\x01vQ,\xd8E\xae~\x0f\xb9\xfa\x03U\x1a\x85\x87\xfe!\xb6w\x88\xec6nb\xfd]d\xdewA\xec\xef\xef\x1e(\x96\x178F%\xca\x1d\x1e\x80*\xfd\xf0\xa0\xa6\x02\xb5\u00c4/|`nU6\xbbh\xd4Q[{\v\x03\xca\x02\xd4B\x9d|[\xe9*\u06f8Ut[\u0280\x81\u0304\x92h*(\xd1T\xfa*\xa5\x8f)}\x95\x82\u076d\uca94]LQ\x98X\xd9\u0154=\x95\xb2\x87){*e\x0fS\xf6U\n\n\xe9\x95}\x95\xb2\x8f)\aM\xa5:E#\u0581\xb2[\x1d\xec#uR\xa6\xaa\xc3\x1a\xd2\x12\xa5>C\u01ae\xaaT\x1cUD\xafj[\xa9H\xda\a\x10\xe8 GSE\x8e\xa2\xdaE\x92]\xc5m\xbf\xdaU\v\xa3\vUW\xfbje\xf5;\x18P\xb5\xf5\xb1\xb6]\xa5\xf0\xd9E\x02\xb6\xab\x16-j\xfa\xab{\xaa\a{\u0603=\x95m\x0f\xb2\xf5\x95M\xad\u07c2\x8e\xf6;J\xa4\xee@;}%\xe1\xf4\xbb\x15\f\xa8\x94\xaeJ9T\x01\x98\x92~\x1fk\xeb\xf7\xb1\xb6]\x95\xb2\v)\x87\x878\x9e\xc3\xc3j7\x99\xe2\x1b\xe6\u034d\a\xe4.~_75}\xa7w\xa4m<\xd8\u040e/\xab\xa4>\xbf\x7f\xbfl\\\xd6\xe7\xd9(\x8d\xdc2\xb5#m\xe3\xee\x86v\xacr\xdf\xdd\xd0\xee\xdf\xdf<\xbe\xac\x90Vu\x1e\xa5\x18;\x1a\xb9]d\xea\x863v\xda\u01ad\r\xcd0\xee\xdf\xd76\u061c\xbcV\x9c\xad\xa7m\xdc\u0599\xb1\x01?\xef\xdf/ch\xa3jlh\xc6e\x85Tk\xad\xb9\xd46^\xdf\xd0\fmN\xf6M]]\xb3>:\xb8\x98\xeaQ\xe7\xca\u0427\u03e6\xdd\xd3\f\xb2\x9c\xe9X3\f\U000aae65\xef\xf4.\xbc\xcd\xcd3\xc6'#\xe6\x05\x03\u01a9\b\xbd\xd1\xe6\xf4| \x97\x12\x18\u07f4\x84\x8a\xb7\\klU\x18\xad\fj\xa3\x1a\xed\x8e,\x1b\xa3\xcf\x1b\xbc\xd3dng8\x0e\x9b\xb6\u01e9\xf4|.\xc6\xe7,\x10\x8c{\x93P\x84\u0515\x82\xd33\xe62\x1e8^\x10r\xeaYLB+\xff\x8f\xbf\xcev\x00k\xab\xfb\x0f\xab\x9d3\xb73\xf5\xea-\x8a1\xcd \xac7\x1et\xeb\xad\xc1(Py\x06\xd6iu\u0425M\x9b\xb3\x86\x851\x93\u0440:]\xfah\xf4p\xdaz\x10\xc70>m\u0426\u0760\x1cc.N\xad\x9a\xed\xd6i\x93\xb1\xcax\xb1y\xa6\xf2\f\x1f\u0342\x8e\xdd\xea\x86g\xa3IRq\u06ea\f\x06^\x85&\xb5P\xb7>\x9e\xb1\x1a\x95t\u00b8cQ/\xba+[\x9e2\xceg\xd3\xe4y\x03\x19P\xcf>sN-\x9f\xfb\x13\xea\t\xac\xc0\xa9\x0eZ\x83*m\xd1\x1a\xc3\xf0\xa9\xc5/\xdam\xbbz\xd1P\r\xb8\xa3\xc1\x80\x8a*\xb5;\xc9@h\x9d6\x86\xd5\x16M\xc2\xf4\xd4j\xdbg\xc38lUh\x97>\xb2\x14p\xbc\x87g\xedaP\xa9\xb0\t\x95tH'\x8e;\x83\xfb\xb3\xa87K\xba\xabb\xe5\x80z\u0523\x9cM\u00c1\xebX\u0482i\xe1\x9c\x05\x8crk,-\xdf?u\xbc\x915\xa6\x9e\xc7\\54\xd7\x1f9\x81p\xac@\x9e3*\u018c\u01e98O\x8dA{(h\xc5RS\xd2z\xf8\xb0\xdbi\r\xea\x17*\xb5S\xa1\x0f\x99e\xf3\xaa\x1a\u0160q\u07aa4\x87\x8c+\x1c\x1a:\x0fk\xb5\x8e\xd5\x1c\a\x18\x1c\xd7\x06\x9c\x8d\xea\xecL\x81\xe8\x81\xfb\xb0U\r\xbb\xe7\xedA\x06\"u\xf6@$A:i\xd3\xce8\t\xce\xc6\xed\xd14\x01\u05e0;\x1cL\xfd\xa4\xec`\\\xa5\xed*K\x80G\xdb\xf6#\u06cf\x83N\x83Y\xecb\x1a\a/\x1a\x96]\xa1\xaa,?\xb3\xaa\xacB'u&\x1d\x0f\x90\x1b\x8f\xa4SW\xba\u0390\xa5\x88\xad\x1e:\x1e0!\x1co\x14a~\f\xa7s\xdf\x15\x8c\a\xa7nx\x1ea\x1c\xb3N\xcflau#8L-\xfe\xa0k\u0563\xd0#\xabfuY\xcdJ\x80\xf2\xa0kuTH8\x0f\x1b\u074b\x873\x85$\xb3!\xf7\x1bN\xab\xad\x861\x1b\r\x9c\x1a\x9dL.dr\x06\xc9\xf1=\xe9N\u0761\xe3Q\xcfr\xa8+\x03k\uced4\acg\x1a\xc0b\b\x1ck\xccx\xb2\nYe\u042e\xb4\x15\x90\x1a\xcd\x01oZ\xb3d\x1e\xed\x8b\xf1i\x10\a\x026\xb6\x15x:\xb3\n\xad\xb4\xea\n\xec#\xeb\x94\u05c7\x95\x04E\xbb\xf4|\x90\x04\xe8\xa06\xb0\xe3\xc0(\xec\u0512\xa9\x9a\xba\xb5a2\x8d\xa2n\x8f\xa3l\xa7\xd5A\u0608\u05ba7\x1aXM\xfa\u020e\x03\xac\u02e2\xb5\xe9\x8f\x06\xd3ag\xa8:\xfa\xd0:\xa5\xd5\xe9\u0112\u0532\xfc\xd0\x13\xd4\x13\x81\x1cPn\xb9tfQn\u02c1K\xad\xd3!wl:\x93\x03\u05f7N\a!\x90,9\xe0\x8e=b\x81\xf0=&-\xea\x9e9\u07a9\xcb\x1c\x0f`)8\xc5;<\xa5\u0159\xed\x88\xd0\x03\xb02o\xe4x\x8c\xc1yF\xc9\x00/\xa6\xdc\tX \x87\xbeo{L\x9c\xfb\xfcT:\xde\x19\v\x04\x1euR+i\xec\v\xe6\x06\u04a5\x93\x81\xcfGc\xc7s\xe4\xc4\x17>\xb7f\x96\xcb\x02\xe9\xbbtF\xbd\x11\xf7\u00e9\x9c\x8e}\xe1\x8f8\x9d\x8eg\x12\xee\xa8\r\x04\u275cr\xdfV3\x1b\xc0\xef\x11\xde\xd6\x7f\xc6$gv8\x19p\xe6\xbaT\u274dT\x8c\xfd\t\x93\xe7\x8e\xeb:t2v\\\x05\xa0ju\u04081\xadj\x9d\xd6XU\xcdc\xf5\xe1\xc3\xf3Z4\x8d\xb5\x00\xf1Q\xe1\xc4 \x10\x95\x8a\"\x8bu;h4\xea\x11\xae\x8c\xd3<\x8d\x9aU\xb3\xbb4A\x9b8\xbe\xd9|x\u07a8\xa9\xfc\xad!\x7f\u0509~w*\u052fE$\xa1{\xcaE%\x9aI\xcb:\xad\r\xea\u0476\U000c8dfa\r\xf5\u06eeR\xebA\x14\u03c6g\xb3N\xb4\xe8Y \xce\xdaMU\xe7\xd0\x12\x8dZ\xf7T\xfd~\xf0\xb0]\x8b\xea\x1c\xba\xe7\xf5f\xb4\xeeG\xb5\x8b\x8bF\xb4\x92F,\xed\xff\xe8\xb4NE\x95e\u05db\xfa}!:\u0546\x8aw&\xbcY\xad{\x8a2\xb5\u03ab\xdd\b\x0e\x0f\xcex\xb5\xd3U\xf09\x9d\xf2\xf3j\x04\xdb\xd3)\x9f5\xdb\xf1\xef\xb0]m\r\xb3\xa4(\xfe-.j\x11\x89r.:\u076a\xca\xe3\xcd\x1e\xcej\x11\xe1\xf7E\xd8\xee\xb6T=\u04c1\xe8\xb6\u06eao\xd3\xd1 \xa8\xd8\n\x0e\x0f\xbb\x0ff\x83h\x9d\xf0\xf1\u00f3nK\xf5\x8d\xfbga\xa7\xa3\xea\x0f\xba\xe9\xb8\x02\x164\x9b\r\x95G\xb4*\x83f\xd4\xd6Y\xf8\xb0\u04ca\xf0\xe1\xbc\xc1\x83FE\xe1\xcc\xc5\xf8a\xb3\x16\xc5?\x1a\xf2j\xab1\x90O\xbf\xfbGO\xbf\xfb\xf7O\xbf\xfb\x1f\x9e~\xef\xf7\x9f~\xf7\x0f\x9e~\xf7\xdbO\xbf\xf7\xfbO\xbf\xfb\xed\xa7\xdf{\xeb\xe9w\xdf{\xfa\xbd\xdf\u03ec@I\xa7\x94G\xeb\x80\x06\x81o9T0X\x96\xc1)\x13\x03\xea\xbar\xe0M\xa7\x94;\x03\x1a\u0201\xcf\u01b0\xaa\x18\x97\x16\x9d:\x82\xba\xb8$}/\b]\xa4\xadj\x15\xe2b\xb6B'p<\xc4y\x16r\xff\xcc\tpi^\bN\xf1:.9\x84#\"j\xe1\x8c\x19u\xc5\u0622\x9cIg2\xf1\a\x8e\xeb0O:\x9e\x1d\x06\x82\u00f6=\xa1\x1e\x1d\u1149r\xe2\x88 \x1c8\xc1\u0611\x8a\u071f;6\x93\x1e;\x1f\xfb\xaeK=[z\xecB\xd8\x0eg\x96\x90\xbe7\xf3C\x1e@\x86\f\x0f0\xe5\xbe`V\xb4bC\x9by\x02\xc8/g\xd4e\xb0\x92\x99\x8c7`\xeaI\x0eQ\xb0\x8d\b\xa0\u03d4\r\x87.\xe328w\x86\xc2\xf2\xcf\x18\x97\x82\n\x8at\"\x90\x82Yc\xcfw\xfd\xd1L\n\u6ca1\xef9\x16\x95\xa1\xe7 1\x173y\xe6\x04\x82N\xb9\xe3\ty\xe6R\xea\u064c3O\x9e\xf9\xee)\\z\xcb\x14\x02\xd7+\x9c\xb7g\xea\xe7\xf4\"\xec\x9cFK\xf7a\xb5\x1a\xad\xe8\u0440;\x93x\x11_4\x15\xfe5\u0163I3Y\xc2\xc1\xb9\xfa\u066d\xd0:U\u017a\xf6\xe0aD\u05bbL4k!\xfe\xb4j\x03\xdeVy\xadQcp\xea\xc4\v<\xa8\x88\xf8gXKX\x82V\xb4\xea\x87\xce\u00e0\x13\xc4?\x1fu\xd5O\u01ef\u0436\x13/+QW?'\x17\xe2aU\xf5\u05ef[\xe7\r\xb5.\xa6A0\x8b\xfa\x10z\u1d21F|>\x1a\x8c\xa3a\x9e\x8f\x06n\x84\xfb\xb3.\xad\u04c7\x80\xb4\xcc\x13!g\x92\xbaC\xca\xfd\t\xf3%u\xd5\x0e\xfaH\xd2\t\xec\x156\x9dH\xeaQw\x86<\x11}\x18R \xbb\f\xb7\x19\xe6\xfa\x1e\x85\x8d\u0147\x13\x1d#i\x8d\xb9\x13\x88\t\r\xa4\xe5O&\xa1\aS\xa4\x10\xc7\xe73\xc9\xecP\xddp#\xe1R\xf0)\xe2\u0790:\xfc\xdc\xf1\xec@\xa6\xdb\xf6\xd0\xe1\xd1\xd64\xe4,`\x9e\x13\x06r\xc8}O\u063e\xcf\xe50|\xe0\\0\xee_\xc8a\xc8=\a{?\xf2]\x1b\x0f4\u0271\x13P\xc4g\t\xfb\x81\u0366\xbe\xc0_#\u07f7\x03\xfc\x150/`r\xec{lv\xce\\W:^ \x1c\x11\n&S\xf6\xe64\xf4O\xd5\xc6\xe4R{\xc0\xfdS\x06\u06d8gQ\xdc<aMpDU`\x8a\x021s\x99\x9cP~\xcap\xc1N\x80\u182e\x1b\xc8\ts\a~\xc8=&'\x8e\xc5\xfd\xc0\x1f\n9\xa5\x1e\r\x00\x8d\xe5\x94\x06\x01\xa0(\xac\x9e\x88i\x95\xdc\x01\x8fa\xdbud`=\xf0\xc7^\xe0{2\x18\xc3&\xe9\xb8T\x06\xbe\x1b\xaa\xad\x10\x17\u0540z\xa7\xea\u05d0\xf2\x89\f\x84o\x9d\x8e}w\x92\n\"\xf2\x8c*\x80\a1\xea:\xea\x97U\xa5g\xa3x\xb3q\x15\x19fU\x1a\x11\xd5\xe1x\xc0\x14\xa2=\xa8R6\x8c\x7fM\x14\x9a\xb9Uj%L\n\xbfH\xf9q\xfc5\xadRK\xfd\x12\xd6\xe9\xb9\xdaL\xce\xc6\x0f\xc339\xf3m\x7f@\x81\xc8\xd0Ah\x8f\xe9\xc0\x91\xd4\xe1C\x9f[\x80}\xae\xa2\x13T\b\x9f{l\x1631\xc8\u0370\xa1\xef\v\xf81\xa2\x8e\a1\x01Sd\xd4\x0f\x85\xf30dr\xc0\xa9\xcd\x02\u02d7\x03\xeeS\xfb\x1cx\x1d\x1e\x06\x010\x1f\x83\u0426S\x16\b9P.\xc3\x10\x03D4\b\x80\xde2\xe1\x9f{\u04a2Bq6\x16p\x11(&\x8c\xf9,\x00\xb2d9S\xeeP\u03d1\x96#f\x8c\x8a@Z.\xa3\x1efv\x1d\x0f[\xb7\\_\x8c1\u019fLpR@\x16\t\x01Sl\xe6\x02\xa9\x9a\xc1\x0f\xdf\x11\x82I\x9bM|\x8bS!m\x87N|\xc0{\xdb\tp\xe7P?\x00\xabl\xff\x1c\xef\xe9M\xb8.\t\xd8\x11\x002\xb0\x18C\x99p\x02\xeaR!\xd9\x19\xe3\xd8(\xbb\x00\x06|\xc4\xe4\x901{@\xadS9t\xa0}1\x83\x055\xb1\xa9\v\x1b\x84\xafv \\N\x0e\xe3\x12\xd6\u014cQ.G\x9c\xaam\b\xb90X\ua8d0r\u06e1\x9e\x1c\xdbC\v\x9b\x1837p\xbcSG\x8e}\x17\x9c\xd2aA\x05\xb8iI\xc7S\u05d3Kg\xeap\ua368\x04\xca\xec\rBW>\x98N|>\xa2\x9et\x81Q\x03@\xb9\xa1g\x0f\x98u\nk\x85;\xbe\x10rB\x03\u01a9p\xe4\xc4:u\xbc\x80\xcd\xe4\x84M|\x0e\xd4`\u00b8u:\tl9\xf1\xb9\x18\xd1\x11\x93\x13\x1f\xc9>\x97\xfe\x00\x0e+2.a\xc3\xf5`r\xa7c\xca'\u051a\u0269c\x01e\b\xe4\xd4\r'\x03h4\u06aef\xc0;*\xea\u0099\xeb(i\x86R\x8e\x1b]@'\x81\xe5\x86\x03\x190+\xe4\x00\xba\xe84d \x83\xb1?\x9dB=\xc1\xd8?\x17\u0384IX\xcfj\r\xfaCq\x0e;m ,E5\x82p:uQ8\x9e\x81L\xcc,X\x93\x0e\xa2Mh\xf9\x9e\a;\xe9\x19\xf5\x10\xc2\xf2L\x91`\x94P\x1c\xbc\xd2\xe0\x1c\x88\x95c\xab\xd5\u04edP\xf5\xc3\xf3\xa3\x98i\x95\xaa\xe5\xf9\xf0\x82N\xe4\xcc?\xf5\xc7tB\xe5\xe3w\x9f|\xe5\xf1\xf7\x1f\xff\xf0\xc9\xd7\x1e\x7f\xef\xc9\xd7\x1f\xff\x99\xa4\x03\x7fDm_R\x8b\xdal2\x93tDO\xc7\u0513\xd4u\x06t@%\x921\u01d6T\x8c]&\xa8\xa4\x91\fEC\u06c1w2i\x18L\xfd@Hz\x06`\xa2(KO\u0605\x1c\xd0pL\xc3@\x0e\x98'\\X\xab,\x10\x83p&\aJ\x9a\x86U\br\xb3\x1c\x84#*\x84\x1336\u04a2\x9c\x9eQXo\x9c\xc1dY\x94#\x0e\u0182#\xd0>\x80\xb95v<Aq\xcdQ\x1b\xe2\xddp0a\xb6\xb4|\xd7e#\xe0\x8e\\\x7f\x84\\\xd2\x04(\xb2\x8cE\x7f\xfc\xcb\x19\n1\xd4\x12\xb1t/-\x1f$?*q\x99q\xc8\x17N\x81&Z\xc0\xc90\x10t\x1c\xeb\x94\ti\xf1\x10\x85\x1a\xe4_\x02X\x90#\xec\xb7\xedO\x90\U000302e9\x1f0[\u019a\x10 \xbb0\x8e!P5\u07d3C\xc69\xe5\x0e\xfee\u070fv4&\x87\xc0Zy#9t\x04\x12\x9e!.\x04\xf8\xeb\xc3v\t\x7f\u03f1\x1e\x9f\a\xb8J\xb9?\xa2^\x80;\x1d\xecd#x*\x90\xcf\xe4\b\xfa\xe5\x8d\xe4\u0237\xa9m\xcf\xe4\x88\xfb\x16\u0187\x8e\xa0<\x90c:\x19\x84|$\x81\f\xf8!\uc142Zc\\\xad(\x00\x8e\xfd\x00\u02e3\\\xc6`\xf1\n\xb8MA\x8eg\xc06:\xd2\t\xf0\xfd\x06\xe9\x04\x10\xed\xc8\a\uc739|&\x1f\x84\x9e3e\\\x9e\xc2ii\xe6\xc9S\x7fB\xa1_.\xb5\xa8sAqg\x04\xe1\u02e5\xde\x05\x8c\u03e5\x01\xf4X\xbaTp\x7f\xc0\xa4\xcb,\x97qK\xba\x0eu\x80\x88\xb9\xf0\x1a\x11\xb3\xa5\xebx\x96\xefz\xd1\xde\t\xfb\xa5\x80\rUN|\x0f\xf7Y\x8f\xa9\xf5\xe511t\x9d\v\x19\v\x9a\x1e\v\xd5\xf2?u<zN\xa5\xef\xda\x1e=\x9bI\xa42\x8e%}\xee\x8c`\xbe\xa6c\xc7\x05\xc9\x7f\xea\xf8HE\xa7\xbe\xeb\b\x87!_*|.9\xb3\x9c)\v$g\x9e\xa0.\xfc=s\xd89\xfc\xbd\x00\fFj\x00\u0282H\xc3\x05\xec\xea\u0131\x91m=\xa7\xfc\x91\f,\x87!\xf9\x18;\xa7\xc1\x98\xca`\xcc\x1dN'2p\xbc\x11\xc0\x17X\xd3\xe8/\x1f\x03Q\x11>\a\xfa\x05\xd4\xc1\xe7B\x06!\x1f\xc1\xfc\x05\xb3@\xb0\tp\xbb@\x06O\xa5\x183T\xd6\xe1_\u03a4@\xf4\f\xa4p\x86C\xc0s\xe1\acg@\x81\xa6\x00\x15\x96\xe7\u051dP.\xe49\xf5F#?\x94\xe7x\xf2-\xd1_\xc9s6\b\x1c\xc1\xe49\xb3U\xfe\xb1\x1f\x9c\x8f}y\x8e\xf7N\x06\xf0\x17\x89\xe7\x05\x92\xf0\x99\x9c\xd1\t\xbd\b=9\xf3C\x11\x0e\x98|\x14\xc2\xfe3\x96\x1f\xfc\xe8\x83\xf7~\xfa\xd6\a?\xf9\xe0\xc7\x1f\xfc\xe0\x83\x1f\xc9\xc7\xef>\xfe\xf6\xe3\xbfz\xfc\ue4ef<~\xf7\xf1\xb7\xe5\xe3w\x9f|\xe5\xf1\x9f?\xfe\x8b\xc7\xef>\xfe\xe6\xe3\xf7U\xf0\x87O\xbe\xf2\xe4\xeb\x8f\xdf}\xf2[\xf2\xc9O\x1e\xbf\xfb\xbf\xfe\xd9\xe3\xef?\xfe\xb6\n\xbe\xfd\xf8\xdd\xc7\xff\xe5\xc9\u05de|\xe5\xc9\u05df\xbc-\x9f|\xf5\xc9\xd7\x1e\x7f\xeb\xf1\xbbO\xbe\xfe\xe4+O\xbe.\x9f~\xf7\xb7\x9f~\xf7[O\xbf\xf7\xfbO\xbf\xfb\xce\xd3\xef\xfe\xfd\xd3\xef~\xef\xe9w\x7f\"\xe9\x80r1\x96t0\x80\x1d\x83\x0e\x06g\x0e\x93\u0502\xc3Z\x92\x0e\x91\x7f\x02~\u029a\x01_1\b\x03\xf8#\x98\v\xe4nJg\x92\xba\x01EV#\x10>p\xb4\x0fC \x85\x9cN,_\xd2P\x8c}.\at\u01b8'\a\x8c\x86\x02\xc8\x1aw\x1dO\x0e\xc6@\xa6@\x93\xe2\x01\x87\x01k\xe8\x14\xff\xf8\x9eD\xd6\x10$\xb7\t\xe34\xa2k\x12\x0e\xf2;c\xf8\xe3x\xbe\xb4P]\x824\x0eI\x1c0\xd9\xd2\x1a\x87\xa8~t\xb8\xe5\x02\x03A\x9dI\xa0\x18\nKZ\xfep\u0210\xb8\x05\fB\x9e\xed\a\x11\u024a\x84\xc1\x88P\xc1\xa3\xe70\x9d6,FO\xda m\x01\xcb1\xe2\x8c!\r\x03\xd2\xc5pC\x89\x847\xdb\aF\\\u06a1\xe7\xfaSiC\x95B\xda!\xf0\x0f\x92\xe1>+\x99\xc7\xf8h&#\xb1\x8d\x9d\xa1\x14\xcb.`\u7511Ju\xe8:\xd6)\xf0\xe1b\xe0\xbbH\x9c\u00a9\x1cQn3O\x8e\x98\xcfGL\x8e\x9c3$T\xae?\xa0.\xf0\x18#\x97\x01c!\x9c \x92L\xe5\x98\xf1\t\xd0 g:\xf6\xa7r\xec[\xa7l&#\x8d\xd18\x1c\x01\xfe:\x13:\xa1B\xb1\u5a0e\f\x1d!\x1fP\xd85\xe5\x03\x1fI\u0743\x90\x8d\xfc@\x9e\xd2p\b\x84\xc9\x01\x01\x10\xff\xb8L\x9e\xfa\xc1Xq\xeb\x96\x03DJ\xc0t\xb8\xf4|\x06\x91l\xc8\xe9\xa9tU?A1\x06\x91\x00kO\xba\xe1E\xc8grBm\xee\xd8r\xa2h\u0584\x9e\xb2p\x1a\x91*9\xa1\x020\v\xe5j\xa6\xfe\u0300l\xd1`\fl\xca\x04\n\xf8\x81\xe5\x9f\xcbI\x18\xb0p\"#\x13\x81GG\xfe\x8c\x82\x94\x1d\x82\r\xc1\t\x02\xea\xa9?3\xb4(\xf8\x9e\xf4\xfcs\x1e>\x92\xfep\xe8X,\u0491I\x1f_\xe6\x90>\xa7\x80/>G>\xcf\x17AxJ\xe5t\xe8<b\\i\u0400\xe6\xcd\x02\u01d7S\x87\x8e\x98@.\x88\t\xf90d\x03fIN-\x18-\x92?`\x83\x9c\x80\x818>\xa5\x0e\xd0B$I\x8a\x04J\ue0e2Tr\x7f\x04t\x81\xcf\xc2\xd3Y(\x03:d\xc0\x11\xd1S\xe8|@=\x7f\u880a\xd5GMk\xe82\x19\xa9\u0551{b2\xdaN\x82\xb1\x1f>pd\xe0[\x16\b\xfa\xbeR\xcd\n\u0380T\x8a\xd0v|\xc5/\xcdd\x10>\nO\x1d\x19 \x01\x93\xc1\xcc\x06I@Pg\xca\x1c)\xa8?\xa0\xbeT\x17\u408e@\xf8\xbe\x14\xcc\xf3\x1c \x8c\u0333\xa9\x14\x0f&\xf4\xe2B\x8aS\xf5\u01df\xf9\x82F\xc2\x10\xe8\f,\x7f\"\xcf\x1c\xfa\x008-\a\x19\x833\xc7u)\x84\xf8\xc8\xf1d\xa4O9\xf3qU\x9d\xf93\xa0\xd5g!s\xfd\x00H,\xac\xe2s\xe0.= \xa8\x16\x9d\xc8\vg\f4dF-\xd8\xcdg\xa0}\xb8\x90\x8f\xe8t\xea\a\xf2\x83\xbf\xfd\xe0'?\xfd\xf2\a?\xfa\xe0/>xO~\xf0\x93\x0f\xfe\xee\x83\x1f\x7f\xf0\xde\a?\xfc\xe0\xef\xe4\xe3w\x1f\x7f\xeb\xc9\xd7\x1e\xff\xe0\xf1\xb7\x9e|\x1d\x02\xef?~\xf7\xc9W\x9f\xbc\xfd\xe4k\xf2\xf1\xbbO\xbe\xf2\xf8\xdd\xc7\xef?\xfe\u0793\xdf\xc2\xc0\x93\xaf>\xfe\xd1\xe3\xf7\x1f\x7f\v\x02_}\xfc\xee\xe3\xf7\x1f\xbf\xfb\xf8\xdb\xf2\u0257\x9f|\xe5\xf1\xf7\x1f\xff\u0353\xaf?\xf9-\xf9\xe4\xabO\xbe\xf2\xe4\ub3ff\xff\xe4\xeb\x8f\u07d5O\xdf\xf9\xce\xd3w~\xf2\xf4\x9d\xf7\x9e\xbe\xf3\xce\xd3w\xbe\xfb\xf4?\xff\xbe|\xfa\xdd\xdf~\xfa\u077fx\xfa\xdd\xff\xf0\xf4{\xbf\xff\xf4\xbb\x7f\xf0\xf4{_\x93\x1f\xbe\xfd\a\x1f\xbe\xf5\xa7\x1f\xbe\xfd\xf6\x87o\xfd\u0347o\xbf\xfb\xe1\xdb\u07d7\xa8\xf1\x95\xd4\x0e]!)\x13\x1eX}\x86 \xf7O\x90\xaaN\xa7\xc0\rr\xe0%(\xce\x17\r\x01\xd7\xe8#\x98\xe5\x01u\xec\x10h&\xa8\x9e\x91o\x04v\xdbW\xaag9\xf01\xde\x0f\xac\xb1\x12\xc1\xc0\x04\xe3#\x0fh\a@\x15\x03\xa0\x86\x8cN\xa5\x05\xe2\x0f\xf0|@\x03\x81\xc4H\xbc\xecDZ>EK\x8d\x8d\x9c\x1a\xcak\xb3\t\x0f\xa5M\a!\x976rX@\xfb\x80os\x05\x95\xb6o\x8f\x98\xb49l\vv8\xa0\x8ed\xb8o0\x9b\x9dR\x89\xf7GI\x86|-\x9b\xc2\xca\x1eRG\x8c\xe5\x90\xc1\xe4\x01\xc7\xe6\x02\x1f\x06\xbf}\x1eN\x90\xbc\xf9rD\x81V\x8d\x9c\xa1\x80\xef\x19\xfcv\xa9\xcd\xe0\x1b\x04H\xe6|9\u009a\x81\xf8zr\u011d)\x93J\x16\x18\x85\x96\xe5\x00\x8ff3\xa5\x7f\x00\u0743M\xe5\xd8\u7a07\b\xe1;\xa3BH\xe7\x94z>\x9a]\\\xe9p'\x18K\xe7\x8cY\xbe|\xc0\xc4#!O}\xe6z\xf2t\xe6\v\xa0b\x13$b\x00=\x97\x8d\xc0<\xc3.\xc2@\xba\x8e\xeb\u0380\xaf\xb2\x99\x04\xd6\a~_8\xaet}\xe0']\u07c2<>\u0221\xf0\xf5\xa5\x1bN\x1d\xa0m\xd6\f\x15\x82#_N\x98\xedP9q\xe8\x04\x94\xf5\xb0\xfe&\xfe\x94r\x94\xb9\x98\xf4(@\xc9\u00f6<\xe7\x14\u0215\xe3=\x00k\xe8\xa9\x03\xdfsq&A\xd9B\xa5\x1fP\xa0M\x94;\x01|\x85\xfa\xce$^e\"\x91X\u0269\xf3\xe8\x11\x95x\xbcXNq#\x9drz\xe1H\xc5]O9\bYS\xeeO| `\x81\x90\xc0\xee\xf8\x92\xb31\x1d(\xea\x05\xfa\x12\x7f\f\xd4\xea4\x90\u0737\x99/y8\x1a\x00\x8drAs\xc2< 8\xb0\x89\x81\x16\x85Oe\x00W6\x02A\x02\xae\xect6e2pQ\xa6C\xfe)\x98\x005\x0f\xc0d$\x95B5P|\x9a\xa06E\xee\x8d!\xc1\x9aI\xa5\xf3\tBh78w\x82\x00\x95\x96\\\n\a\xe48\xe1p\u07d5b\x02\x82\xb6\xf0\x81\xf3\x16\xfe\xe9\u0317\xc2\xf7\xdd@\n\x9fc\fl\xcf\x02$\x10\xe4\xe3\x98\x14<\f\x84\x14\xa1\a5\x84\xc18\x94!r\xbfgl\x844\nFw\xe6\u06e7\x14\u051bg>\x90$\xe0\xf1\x90T\x9e\xb3\x01\xf2y\xce\xc0\x97\xc0\"\a\xf0um\xa9\xf4d3:\xf6}\xf9\u0219N}\xf9\xf8\xdd\xff\xd7\xef=~\xff\xf1\xbbO~K>\xfe\xd6\xe3w\x1f\xff\xc5\xe3w\x1f\xbf/\x1f\x7f\xeb\x7f\xfd\xc9\xe3w\x1f\xbf\xff\xf8\xdb\xf2\xf1\xf7\x9f|\xed\xf1\xf7T\x86\xef?\xf9\xda\xe3\xf7\x9f|\xfd\xf1\x9f\xc9'\xbf\xfd\u4acf\xdf\x7f\xfc\xee\x93\xdf\xce\x10\x99\xff\xfc\xbbO\xdfyG>}\xe7\aO\xdfy\xeb\xe9;\x7f\xf8\xf4\x9d?y\xfa\u03b7\xe4\xd3o\xfe\xf9\xd3o\xfe\xe4\xe97\xdfz\xfa\u037fx\xfa\u035f\u0227\xef}\xe7\xe9{?y\xfa\xde{O\xdf{\xe7\xe9\xfb\xbf/\x9f\xfe\xe5w\x9e\xfe\xe5O\x9e\xfe\xe5{O\xff\xf2\x9d\xa7\x7f\xf9\x96\xfc\xe8\xbf\xfd\x9d_\xfc\xf6\xef}\xf4\u03bb\xbf\xf8\xea\x7f\xf3\u044f\xfePR\x98(\x8a\xf2\xa7M-IA\x92\xa2\xce\u0217\xf4\xd4\x06\xd9\xd5E\x1b\xef\x05\xb0n\x03I9\u0235|J%\xe5\xa0>\nl*i\xe0P\xa4SH\xa6\xe4\x80\x0e\xd0\flK\x04\xe7`pF\xe5\x00\xf8\xb4\x01\xaa\x85\x9cSE\xb0@\x8b\t\x1fP'\xf9C*\a>\x94\xf0\xfdS9\b\x1f=\x92\x16\x1d\xa2\xa5\f\xa4\xd8\xc9\x14Y=\xf8\x80\x10\x1b\x00\xe7\ad\fv\x7fk\x00\t\xc0EZc*\x90\x9c\xc1g\x86B,H\xa4.|\xa6\u049a\xf9@\u0080^\x01\xa3e[\xee)R1 b\x8am\x93\xb6\xc3P)4\x06\xbe-\x90\xb6?\xa6\x12\xce\xc8\x03\xff\x16H\xfb\x8c\x8e$\xe3\x9e#\x87\xb0O\r\x81\xf8\fQj\x04e\xe0\x10\xe4\xe2\xa1C\xe1c\x83$\xeaNP\xad\x8a\xb2(pp\x1cMw@\xe4l\u04322\xb8E\xd1F:'G\x03\xe7\x11\u029ar4b\f\xa9\x9e\x1cM\x06c\u0532\xc2g\x88\x1c\x9d\x1c\x85\xc0\xe9\x85<\x94c\xe0 P%\x00\xaa\"P\x13M\x81\xbfcr<\x12g(v\xcaq0\xb0\xa4c\xc1\x871\xb0I\xd8\x034L\x80\xe2\u0217\x8e\xa0\xa1|@\u03e8|\xc0\xd8\x14\x98\xbb@>\x98\xf2@\x9e\u06b6#O\x9dsG\x9eN'#y\xcaAl\x84\xb9t\xd9\u0217\xeeh \xa4\xeb\xd8\xca:-]\x98K\u05d9\xf8@\x01\x91\xb9cH\xf9\xa4\v\x9aW\u05c7\xa0\xb0)0x\xa0\xb0u\x86r\u0080\x99c\x13&'\xcc\v\xe5\x04M\x94\xa0F\x06\x96NN|\x9b\xa2\xc5R\x82\xdeC\xa2\x8c\u9040\b\xf6\x10\t\f\x05\x10A`\xd2\\_\xfaS\xe6\xc9)L\xc5\x14\xd0bjY\xe7\xc0~\x81\xf4\t\xca'\xe8\x10\x186A\x1f\x15\u0229?v\xe5\xd4\xe7\x9e\u010d\b\xac\x9c\xf0\x19\u0287\xc0\xe5s\xea\xd8\xc0\xa5\xc1\xc7\x11(\x9e\xa2\xd5\x04\xd5\u00d2O \xce\xf7'\x92\agS\xc9\xc31G\xd6L\xa2\xee \xa0\u0715\x01=\x83_\x17\xbe\f,\x9f\xc3G\x00k\x06\x1fv*\x03v1\x03zx\x0e\x82+E\xc5\x16|\xcee\u0e27 \xbcR\x89\xe2bp\xeax2\xf0\xac\xa1\f\xfcq(\x03\x1f\b\xa5\xef\u0340*\"Q\xe4 \xc1\x0e\xa5\xa0\xee\xa9\x14@\xb8\x05\xb0t`\xb3\x91\x82\x9dQ)\x1c\n\x9f)\u043cs\x0f\x182\xa0l\x03\x06\xaa/\n\xec\x16|\xce\xf0\x03\xa4M0\xf8\xf8(\xc6\x02-\xe3\xf2\x1clV\xe7\u03a9\x03\"*C\xca&/\x06@\xd1\xfc\x11\x95\x8f(\xa7\xf2\x11\x90\x87G\xb0\xa7|\xf0\x97\x1f\xfc\xd5O\xdf\xfa\xe0\a\xf2\xa7_\xfe\xe0\xbd\x0f~\xf8\u04f7\xe4\xe3o=~\xf7\xf1\xfb\x8f\xbf-\x1f\x7f\xeb\xc9\xd7\x1f\x7f\xfb\xc9\xdb\xf2\x7f\xfd\xcd8\xe6\xdbO\xbe\xf6\xe4\xb7\x1e\x7f_>\xfe\xcb\xc7\xdfz\xf2\xf6\xe3?\x93\x8f\x7f\xf8\xf8\xfd\xc7\xef>yK>\xfe\u14ef>~\xf7\xc9o\xc9'_}\xf2\xb5'o=\xfea\x8e\xad\x92O\xbf\xf9\x9d\xa7\xdf\xfc\xc9\xd3o\xbe\xf7\xf4\x9bI\xe0]\x15\xf8\xd6w\x9e~\xeb'O\xbf\xf5\xde\xd3o\xbd#\x9f~\xfb;O\xbf\xfd\x93\xa7\xdf~\xef\xe9\xb7\u07d1O\xff\xfc;O\xff\xfc'O\xff\xfc\xbd\xa7\x7f\xfe\x8e|\xfa\x17\xdfy\xfa\x17?y\xfa\x17\xef=\xfd\x8bw\xe4\u04ff\xfe\xbb\xa7\x7f\xfd\xd6\u04ff\xfe\xa3\xa7\x7f\xf3\xaf\xe5\x87o\xbd\xf7\xe1\xdb\x7f\xfb\xe1[\xef}\xf8\xf6\x7f\x91\x1f\xbe\xf5\xbd\x0f\xdf\xfe\xb3\x0f\xdf\xfa\xe6\x87o\xff\x8e\xfc\xf0\xed\xff\xf8\xe1[\xef|\xf8\xf6\xf7?|\xfbk\xf2\xa3w\xde\xfd\xd9\xdf\xfe\xe1\xc7\xef\xff\xdd?\xfc\xdd7\xe5\xcf\x7f\xfc\x95\x9f\xff\xf8\xb7?\xfe\u018f>\xfe\xef\xbe\"\x7f\xf1\xc7?\xfe\x87o}\xfd\xe7\x7f\xfc\xb5\x9f\xbf\xfbo\xe4/\xbe\xf9\x1f>\xfe\x93\xbf\xfd\xc5o\xff\xde\u01ff\xfb;\x92R\n\x12\xb3\xa4\x03\xf0\x15\xf0%\xb5\x03I\xd9H\u04a1\v\xc4U\xa2\xb9\xc7w\x81\xd5\x03J*\xe9y \xe9\x05\xa8\xfc\xb8\x1c\f,9\x18\b9\xb0Fr`\x81x\fd\u04d6\x03\u01d7@*\x06\x93@\x0e&\xe7r\xe0\x81J~\x02DS\x0e@a\xef_HT\v>\x02ax\x00\xd4\x13\x88'\x90M\u0434Kk@\xa55\xf0\xa45\b\xa4\xc5\x06\xd2b\xbe\xb4\x86TZC`\xfa&\xd2\x02\xea\x1aX\u04a6\xb6\x84M\xd4\x06e9;\x93\xf6\u0615\xb63\x93\xb67\x95\xb6?\x92\xb6/\xa4-\u03a4}\xc6% 9\xb0L\xcc\x0e%\v\x1eJ\x06\xa6#\xea\x81\xeaM\x0eAv\xf5}9\x04\xd3\x11w\xe5P\x80\x10\xeb\xc9\xe1\xcc\x016O\x8e\xe8T\x8el\x90a\xa9Dyu\x02L\xdd\x05\x10;9\xf2\xa7r\xe4\v9\xf2\xcf\xe4x\xe0\u02f1s&\u01e7`Z\x82\x7f\xe7\xd2\x19L$\xc8k\x8e\x15Jg8\x91\x8egIX\xf7\xb0\uc05e\x80\x9a\xcf\x11g\xf2\x815\x90\x0f\xac\xa9|\xe0\xf8\xf2\x81\xeb\xca\a\x93\xa9|\xe0=\x90\x0f|!\x1f\xf83y:\x1cK`\xa5N\x9d\x89<\x9dz\xf2\x94\x03\xbd\x13 \xb6J\xd7\x0e\xa4\xebZ\xd2\xf5]ps\x01Z\x06L\x9b\x9c\u0429\x9c\f(\xb0n@\xc6\xe4\xc4q\xc1\xa4+'\xee@N\xc0\f5\x01*\x06b*\x90\xb4\t\xb0r\x12\xf5\xe9\u0093\x13\xc1\xa5G\a\xd2\x1bP\xe91\vTl@\xe2\xa47t%\xb0\x83\xde\xf8\x14\xd8:\x89.o\xfc\\zBHofI \x8f\xfep(a\xe1\x01]\xf0=W\xfa\xbe\x0f*8\t\xda\x19\xffl,A\xe92\x05!tl\u02e9\x03\xff<9\xf5, t`%\x96\xd3p \xa7\xe7\x96|xf\x81N\x1e\xe8\x9c\xe4\x8e+90y\x0eP6O\xf2s dS\x19\xd0@\x06\x03G\x06\x83@\x06\x16\x95\x81\x05:\xfa@\x06\xec\x1c\xe8\x99\f\x86\x1c\xe8\x150u2\xf0g2\x00*\x88\xac\x9b%\x05\x1d\x00y\x92\xc2r\xa4\xb0O\xc1\x92,\xc5\u0616\xe2\x01\b\x89S)\xf8\x99\x14\xa1#\xc5Y \xc3A C\u03d7\xa1\xef\xca\x10\x9d\x86\x84<s@R\x04\xf9p\n*4\xa0E\xf2\x1c|M\xfcsy.,y.\x86\xf2\xc2\xf1\xe4\xc5\u0145\xbc\x98=\x02\xb5\x99\x9c\x85\x1e0\\\xf2\x83\xf7?\xf8\xab\x0f~,?\xf8\xd1\a?\xf9\xe0o\xe5\xff\xf3\x8f?x\uf0ff\x91\x1f\xfc\xed\a?\xfa\xe0/\xe1\xcfO>\xf8;\xf9\xc1O~\xfa\x9b\x1f|_\xfe\xf47\x7f\xfa\xf6O\xbf,\x7f\xfa\xe5\x9f\xfe\xe6\a\xef\u02df\xbe\xfd\xc1\x8f~\xfa\x9b\xf2\x7f~\xef\x7f\xfe\xd3\xff\xf9\xaf\xe4\xff\xf2\xee\xff\xf2\a\xff\xcb\x7f\x94\x8f\x7f\xf8\xf8\xfd\xc7\u07d2O\xdez\xfc7\x8f\u07d7O\xde~\xf2\xb5'_\x95O\xbe\xfa\xf8\xaf\x1e\xbf/\x9f\xbe\xf3\aO\xff\xf3\xef<}\xe7\xbb\xf2\xe9;\xdfz\xfa\x9f\x7f\xfb\xe9;\xffI>\xfd\xc1W\x9e\xfe\xe0;O\x7f\xf0\xa7\xf2\xe9\x0f\xbf\xf2\xf4\a\x7f\xf8\xf4\a\xff\xbd\xfc\xf0\xad\x1f~\xf8\xf6\xd7>|\v~\xfd\xf8\u00f7\xff\xf6\u00f7\xff\x8b\xfc\xf0\xcb\x7f\xff\xe1[\xff\xf6\xc3/\x7f[\xfe\xec\a\xdf\xf9\xf8\xdf\xff\xf6\xcf\xff\xee\xdf\u020f\xde\xf9\xb3\x9f\xfd\xe0\xc7\x1f\xff\xc1\x7f\x90\x1f\x7f\xed\xdf\xfc\xfck\xef\xff\xec\xef\xfeD~\xfc\xef\xdf\xfb\xe8\xeb\x7f\xf2\xd1\x7f\xfcS\xf9\xf1\xdf\xfc\xe9/\xfe\xec\xcf\x7f\xf1\xef\xfeT\xfe\xc3\xf7~\xf4\xd1\x7f\xfa\xd1\xcf~\xf4G\xf2\x17\xff\xc3\x7f\xf7\xd1\xd7\xfe\xec\xe3\xbf\xfa\xa6\x04\xae\u0356\x14\xb4r\x92\x8e$u$u%H\x93\xbe\x04\x9b3\x974\x90TH\x1aJz.\u9164\x8f\xe4\x80\xca\xc1@\x0el9`r0\x94\x83\x91\x1c\x8c\xe5\xc0\x91\x83\ar0\x91\x03O\x0e\xc0\xb8'\a\x81\x04Bt&\a\xe7\x12\xb8:\xe0\u02e4eI\u02d6\xd6PZ`\x80\x96\x96#Qv\x94\xd6DZ\xe0\xd1$-.\xadPZg\xd2:\x97\u0585\xb4f\xd2z$m&\xed\a\xd2>\x95\xf6D\u06be\xb4\x1fIfI\xc6$\x03\xaeJ\xb2@2!Y(\x87\x8e\x1c>\x90\xc3S9\x9c\u0221/\x87\\\x8e\xa8\x1c\r\xe4\u0216\xc0\xfd\f\xe5h$Gc9r\xe4\u0215\xa3\x89\x1cyr4\x95\xa3\x87r\xc4\xe5(\x90#!G\xa1\x1c\x9d\xcb\xd1L\x8eO\xe5x\"\u01de\x1cs9\x16r\x1cJ\u01d6\x0e\x93\x8e+\x1d\xa0\x18\xd2\xf1\xa5\xf3P:\\:\x81\x04M\x17\x93\x0f&\xf2\x81/\x1fL\xe5)\x93\xa7#y\n\xc4A\x9eN\xe4\xa9'O\xa7\xf2\x94\xcb\xd3sy:\x93\xa7\x8f\xa4K\xa5;\x90@\x1d\x1c\xe9\x9eJ\x97K\xf0\xbc\x12\xd2\r\xa5{&A;E\xe5\u0112\x13 \x11r2\x92\x93\xb1\x9c\x9c\u0289+'\x139\x01m\x95\x9cL\xe5\u485cp9\t\xe4D\xc8I('grr.'\x17r2\x93\x93G\u04a3\xd2\x03\n!\xbd\xa1\xf4F\xd2s\xa4\xe7J\u03d7\xdeTz\\z\xa1\xf4\x1eI\x7f\"\xa7TN\x99\x9c\x0e\xe5t$\xa7c9\x05\xbeGN'r\n\xbe!\x12\xd4\xf1BN\xcf\xe5t&\x1fR\u0259\xe4\xbe\x04\x1dS(\xf9\xb9\f\xa8\f\x062\xb0d`\u02c0\xc9\x00\xacm2pd\xf0@\x06\xa72pe0\x91\x81'\x03_\x06\\\x06\xa0A\x97\xc1\x99\f.d0\x93\xc1#\t\x8b\u0756b(\xc5H\x8a\xb1\x14\x0f\xa48\x95\x02D1)\x80\xff\x90\x82K!\xa48\x93\xe2\\\x8aG2\xa42\x1c\xc9\xf0T\x86\x81\fg2|$\x81\x1b\xb1\xe4\x19\x93g\xb0\xe6\xe5\x99'\xcfBy>\x94\u705c19\x13\xf2\x11\x95\x8f&\xf2\u0479\xfc\x9f\xfe\xea\x7f\xfa\xb1\xfc\xe0\xfd\x0f\xbe/?\xf8\xab\x9f\xfeW\xf2\xa7\xbf\xf9\u04ef\xc8\xff\xed\xed\xff\xe6\x7f{\xfb\xdf\xc9\xff\xe3w\xff\xe6\xff\xf8\xca\xdf\xc0\x9f\xff\xf3\xc7\x7f)\xff\u03f7\xfe\xf6\xff\xfc\xca\xfb\xf2\xff\xf3\a\xdf\xf8\x7f\xff\xd5w\xe4\x87o}\xff\u00f7\xffD\xfe\xec\a\xff\xfe\xe7\x7f\xf0{\xf2g?\xf8\xce\xcf\xfe\xfeO\xe1\xcfG\x7f\xfcw\xf8\xe7\x1b\xbf+\x7f\xf6\xb7_\xfe\xd9\x0f\xfeH\xfe\xec\xef\xfe\xf8\xa3\xf7\u07d7?\xfb\xfb?\xfd\xf8\xcb\u07d3\x1f}\xf9?\x7f\xf4\xa3\xbf\x91\x1f}\xf5\xbf|\xf4\xfb\u07d4\x1f}\xf5\xcf?\xfa\xd7?\x80??\xff\xe3\xaf\u02cf\xfe\xf5{\x1f\xff\xf0'\xf0\xe7\xe7_\xfe\x1f\xe4G\x7f\xf0[\x1f\xfd\xa7\xff\n\xff\xfc\xe8\x0f\xe1\xcf\xc7\x7f\xf2\xdb\xf2\xa3\xff\xf6w~\xf1\u06ff'?\xfa\u01b7~\xfe\xa3\xbf\x97\x1f\xbd\xf3\xee\u01ff\xfb\xf7\xf2\xa3o\xbd\xff\xb3\x1f\xfe\xd7\xf2\xa3\xef\xfe\xf5/\xfe\xf8\xc7\xf2\xa3\xbf\xf9\xcf\xff\xf0\xa7\xbf'?\xfa\xe1\xdf\xff\xec\a\u07d0\x1f\xfd\xe4\xbb\x1f\xfd\xfe\x1f\u024f\xbf\xfa\xb5\x8f\xfe\xfdW\xe4\u01ff\xf3\xbb\x1f\x7f\xe3G\xf0\xe7\x1f\xfe\xf4[\xf2\xe3\xdf\xfd\xe3\x7f\xf8\xf2\x7f+?\xfew\x7f\xff\xd1\xd7\xff\x14\xff\xfc\xe8\x1b\xf2\xe3\x7f\xff\xde/\xfe\xf0\xc7\xf2\xe3?\xfc\xeb\x8f\xde\xfb#\xf9\xf1\x1f\xff\xe0\xe7\xef\xff\xbe\xfc\xf8\x1b?\xfa\xf8\xbf\xfb\x8a\xfc\xf8\a?\xf8\xf8k\xffZ~\xfc\x93\xef\xff\xe2\xdf\xff\xa6\xfc\xf9[?\xfc\xf97~W\xfe\xfc_\xff\xc9G\xdf\xfd\x8f\xf2\xe7\xef\xfe\xf8\xa3\xaf\x7fK\xfe\xfc\xef\xfe\xcdG\xff\xf17\xf1\u03cf\xfe\x10\xfe\xfc\xfc\xbf\xfc\a\xfc\xf3\xe3o\xc8\x7f\xf8\xf2\xbf\xfb\xc5o\xfe\x91\xfc\x87\xf7\xfe\xe6\xe3\xef\xfc\x9e\xfc\x87\xbf\xfc\xce\xcf\x7f\xe7\xcf\xe4/~\xf3\x8f\xfe\xe1/\xfeL\xfe\xe2\x8f\x7f\xeb\xa3?\xfe\xef\xe5/\xfe\x87\xff\xf4\u047f\xfd\xb2\xfc\xc5;\xff\xf5G\xff\xd5W\xe5/\xbe\xf9\x1f>\xfe\xc1\xf7\x8c-\xb2k.{\x88\xaf}\xea\xd3\xffj\xfd3\x9f\xdd\xd82w\xbex\xf2\xa5K9\xff\xbf\xa3+\xf9a.\xeb\x17\xb5\x8dW\xe3C\x95\u06a75\x83<Zu\xcfh|\x1b\x1b\\V\xb6\x86\ufb71\xe2\xdb\xdf\xf37\x8f\xaa\xe7\x9e\xdc[\x94\x8b\xbd1\xe5\xea\xf6\xcd]\xa2\ueaf4\xc4]\xd7VQ\x87D\\q\xfb$\x88\x1a\xea\xfaB\xb6\xe2\x19Gs\xe1\xfe\xb4\xf8\xda\xdc\xe5\xd6\xe3\xe7\x1d\xf3\x1d \xbeytLh\xf2F,q\x10N\xf7Hh^N\xd4Cl=\xcd\u0448\xd3\xd3\\\x8d\xb8=MhD\xf44_#~O\xebis\xe2\x9a\x15b\x99\x1525\x9dm\xf7%\xba\x1d\xbf\u06d2\\\x8e\xe7\x1a\xf1\xd5\u15bax\xb9T\xe9\xd9\xfa\xd0\xd8\x1epFO\xf1Z\xe4R\xb5w\xa6\xa7\x056\xab\x06\u0267\xd7z\xa3\x85\x12\xf5\xdel!\xa6\xd1\x1b,\xc44{\xe7\v1\xad\xde\xc1BL\xbbw\x91\xc6\u0117,\a\xbae\xcc\u074d\x8dxfNu\x83\xf8\xe9k\t6\u03006\xc1+\x00wN\xf4\xaa\xd1\xf3\u0282\x05Bg\xc6\xfa\xfa\x89\x9ey@\xf7L\xbd\xab\xa0\xf5T\xde(\x9b0vt\u02ec\x91\xa9\x02\xb5>\xd6\xc7\xfa\xe5\x9cL\rr9\xa6\xc1kx\x8f\xbe\xba\xd7\x10\x9f_6\x8c\xde\x1d\xdd\xe8\x85\xf0~\xabi\n)\xf5\xb4\x1e\u02ec\xf5\xb42\xd4.v,\xb3\xde\xd3~%\xfe\u0740B\x99\xae\x8c\xb0\xd3e\u05514+\u00ecI\xff\xa5\xbc\x93\xed\xff,SH\u02b8\x04\xf4&)\x81]\xc8\x15\x1a@\xa1\xfdLz3\x9f~\x9e\xefI\xbb\xa7m\u01bf[\xbd\xfd\xb4'\xa7\xd9B\aXh3\xeeIT\xfaT7z\xf9\x96r\x85.\xf2\xdd\xdf,*\xa4[fs\xc5L\xec\xa3_\xc0\xbe/\xe2i\xc8U~R\xf0\x0ec\r^{aIu\x97\x8e}\xd1s\xe7\x99Bwt\xe32Z-i\xe4\xa9zwrZ\u03b6\x99^o\xac\ue49c\x96\x1d\xfb\x82\xb8\xc6\xf6\xd6\xd1f\xf9\xf8\xd3[j\x00\xf8\xb4\a\xbe\xbb\x95\xb9p2zwgZ^\xc0\xa6\x9d8[\xf2VC\xb4\u835e\xb7\xad/_@\xa9\u01ef\xbcje\xcd(O\xfd\xa9n\xc0\u02ceFY\xf8\xaf\x82G\xc3\x1e\r2\x17r\xf3\x18)\xe7\x86N\xd3gl\x01\x12\x13\xfd2\xa5P=A2\xf7<\xf7<\xa2\x9es\xe9\xa9\x01\xa2\xfe\xbeG\xe7\x861\a\xa4\xc1+%\xcf\rr/\x7f?\xe6\x8a\xd7/\x1d\xfb\xc2Ti@\xa9!\xb8\x83\xdf\xdef\xf4\x94\xe5\x02@\u03355\xb6\x18\x97dLf\"\u0395D\xcc\xc9\xc3\x15O\xfa\x18\x97\xe9\x03:N\xf0\x06<\xb4_\xf8.\u031a.\xd6\xd7\xd5;\xa3Q\xb6{\xdcQ\x97\xb0\xea\xf1\x1d\xd6\xc9=\xc1\xfb>\v^\xf7\xc5+\x14\x1f\x8c\xc7KLo\xf2}_`\x85\xab\xf3\xf6\u016b\x8c\x06\xe2\xa6\xc7\xde\xf4\xb9\r\xdb\x00\x16Xh\xf7\u01b4o\u06dc\xe13\x96Q]\xe8^\xe3x\xc1k\xe0\xa1<u\u067e/ \x15_I\\,Upmif\x9b\x8dAy\x18\xbanzq\xeb\x88]\xc4[\xaf\xea\xc94\x1b\x97\xe0S\xbc\xe1F/\xbe\tl\xbf\xa8o\x05}`\xdb\v\xafa,\xf7 !\u063aH^3V\xcf\x1e\xaag\xe8\xe2(\x88\xa8\x1c\xa7/\xfe\x96\u02da\xf1\xf2f5\x03\x8cd\xea\x8a:\x12\xf7^MR\x94\x11{\x807\u072f\xaf\v\xa8\xbdhAi\xa0/\r,\xeeLEO[3Mo}];\x1bd#\xa0\x0f\xcfB\x92\x15\xe8\xb7\u01a4\x14\xeb\xeb\xcf\x00\x0f\xa0\x17\xbe\x84\xcc2\xe3\xd7\fcE\xc3\xcb\x18wE\xebk\x80\x8a\xfa\xda3{\x10\xe3\xeb\x98\x06q\xad\xfd\xa1`\xbcp6\xb1gE\xb5\x99[_\x8c\xef\x91=\xda,o\xa8\x9f\x95\xcd\xee\xf1g\xd5\u02cd\x84-\xcc\xce\x15\x05\xb6\b\xbb\xba79N\xb3w\xf4\xc5\xfb\xf7\x83\xe3\xcf\xee\x1ci\x1b{x~\x91\xb0\x18\xe5\u036d#\xa8\x12?;\xea{\xbf\xfc\x82Qz\x0f\xff~\xd6\u0639\xbf\xb5\xf3\xe9-\xf5*\xd5\x1b\xa6n\xe7Y\u4b5dO\xe5\x0e\x81n\xac\xff\u02a7\xb6\xfe\x95\xf9\x7f?\xd1\r\xf9\x99O\x7f\xf6\xfe\xfd\xa3\xfb\xf7\x8f/\xe7;k=R\xde\xfe\xe2\xff\xfe\x8d\x7f{\xfc\xd9g\xe5\xfd\u07ff\xf1o\xe1\xf4h\xee\xb4\xe6\x11\x9e\a%\x9a\xae\x118\xaaY\fJ8\x7fZ\x9f\xf7\xf4\x9d\xb5+\xd3a\x82\f}g\xed\xbe\xbdq\x7f\v\xde|T1;Fr$\xf65\xbdf\x10\xcd\u0408&U\x9b\x9a\xbe\xb5e\uca36\u0565\xf8\x99\xbc\xad\u2f2f\xe9\u054a\x01\xe3,k$\x96\r\x88\x06\x9d\xdb\xd46n\xc0\tYM\x95\x83\x7f\xfaNO\xc1{\xc3\xd8QAm\xc3N\x04\nc'\xf3\xf04\xd1F\x0e\x1cZ}\xd3,<\xcdJ^\xf9\xa4O\xc2\xfc#\xbcG\xa06\xe8Hjy\x03^\x1e\x88\x90]u\xdf|\xf3_\xe0M\xfd\u03d6\x95b\xd9(;\xba\xacT\x14\xf7\xdf7W\\\xcdN\xa8\xb9\xfa\xeeu\xe2,\xc9b\x01\xc8V\xe1\xe2\x15\xf6\x9e\t4\x9f\x84\xa6\x80GS-S\x1c5\x8e\xc9\xd4\x14G\xcdc24\xc5Q\xf7\x98\x00\xb8\x90\xe2\x12\u06dcJ9$\x93T\x9e\x82[\xc6\xf1\xe1\xe1\x87\xf1\x06\xa4{$4\xe2\xa7\xcaa\x87t\xbc\x90\xe1\xf3\x94cxjQ\xb1\xed\x93\xd5\x19\xec\xf5uwa\x86\x15A\x9d\x14\u05fau\x7fg\x99\xff\x8c_c\xf4\u04bb\xd0\r\xe2*X\xbfB\x83{\u0780\x82\xa7\x16\xb3\xf7\\\x1f\x1c(oQ\xce<\xdd3\x8cU\xa5\xb7\x99\x1b0\x04\u0619\x99\xd6s\xc3;\x83!'\x84\xf7\xae\xab\x86\xbf}\xf6\xf2fu\xb1/g\x86zfnd\x1e%o\x1b\x90\xe4\xc1\x1d\xed\xb8<t<[\u05d7O\xb3\xaf\xad\x85\xeb\xeb\xb8\xf3\x85\xc9\xce\u01cc\xb9\x81\x80\x1f\xa9i\x9c\x99^\x9262\xb63\r\xcf\f\x12\x9aa&4\xde0g\u0611s3\u0709\xdf\x1e\xe9Y;\xda\xf9\xf9\xb9\xd6\u04c4kk\xe4\xc0\\[\v\xb7\x83\x94i\x1e\xe4\x98f\xa7\x98i\x1e\x93\xeck\x12\xbds\b\xf6<\xb2\xf8\xfeC\xef\x80\x14>\x8a\xd0[[\xb3I\x06\xf5{\x9c,c}\xcf'\x85\b\xaf\xb8t\xe2\"\xdaoG\xec\x9a.L\xaf\xcc.\x98\x05\xbb\xf1\xb6\x11\xa6\xf2A\x90\xbf\x05\xffj\xc4Xf\xa5\x88Wx\xdf>L\x88f\x00\x82{\x8605]C\xb4)A\xecq\x12{\xa4bA\xc8\xd2\xe6\xc8:Ex\xbdV\xdd\x16\xa6v\xa9\xcdc\x1a\xc1\xcdJ\xf42y\xdaF\xf269v\xc4I\xbb\xe1\x1b\xdb\x0eJ\xdb|c\xa3\a\xbf\xe0\xfdw\xae\x1e\xac\x9e\xd0\v\x9doVI\u0148_+G\x81\x85\x17\x03\xa1\x00\xab\x17X'X\xf0,\xea\xf5f5z^\xbc\xb2\x8do\x96f\u0673\x1e\xbeC\x1c\v\x8d^$\f\xf2\xbc\xf2K\xd7\xcb;\xb0\xe9\x1dm\x96\xd56\xf4\u0663M\xf5\xe3\xfe\xfdr\xfcs\xc3\u040cx&\xb3<9> \xbbY\xed\xe9\xde\x06>\u065c{\x14\x15[\xcd\xc4\x1ad\xeb\x8bG_\xdc,'\x9bz\xef>p [\x89\xf8\xed\xf56\xabF,\xe5}:\xd7\xcdO\x1d\x9d\xa8\x9e\\VI\xb5\u079d\xc3V\x1c\xc5\xc0\x16<\xd2\f\xf2\xab\xf9=\xf5\x8b\u0266\xfak\xffX\xef\xace7\xc6O\x13\xaf\xec\xf9\u079b)\xe5d\x17\u6bfe\xf0ck\x9fl\x03[\xecH\xb2\x8beZ\xa7K;\x94c\x1e\x1d\xafX\xba\x97\xd1c\xe8\xd1F\x14\xa6x\x1fD\xeb\x0e\x10:\x902\x12\xfcC#~\xaa\x1b\xb77\v\xffD\x18\x01\xf9S\xcav\x96\xa3l4G\xd9\u0718\xb2\x05$\xd3\xf3\x9eO\xa2\x87\xf4z\x16\x10\x9d\x18\x8aN\x8c9\x9f\xcb\"\x02p}\xd1\xff\xf77\x8c\x9d\xfb6^\x83r\xb4y\xbf\u04a8\x94\x8fw\x8c\x9d\xfb:D\xd6\xe7\xf7\x8d\x9d$\x16#\xb2\xa1\xc6\u0710Q\x15\xfaN\xaf{\xd4m\xb7\x8e\xef\u06f2s\xd4\xed\xb4\x9b\xf5\n\xfcn\xc1o\xf8\xd1<\xeabD\xa3v\u07d6\xf5\xa3N\xbb\t\xa1\xdaQ\xb7\xd3j6\xea\xb5*\x84\xbaG\x1d\xfc]9\x96\x9d\xa3V\xa3V=\x96\xad\xa3V\x1c\xd5<\uad23\xbc\xb2q\xd4U\x01H\xa8\x1fu[Q\x9e\xdaQ\xbbr,\u06f2j$\xfd\x04f\xd8NGv\xd9\"\xd5\xda\xfc\xbe\xbda\x18\xfa\x11\xd9>\xdeP<\xea\xa7v\x8c\xcf&w\bjR\xdb\xd8\xd2+\xfaQ\x15X\xec\xea|s\a\x7f\xe1\xf8\xa5\x8a\xac\xcd7w\xf2a\x80\xa1\x8a\xac\xe5\"\xa3\x9c\u0579\xb1\xa9`&+G\xedN\xf7\xb8\x12\x05\x93\xd8\xe6BL\u0290\xe3B\xfe\xc2/]\xfd\x9e[\xa9\x9f#\xe2\x1fm\xe1eV\x93\xff\x8c\xd5D\xd5\xd2pL\x9a>\xbbr\xf4\xc5\xcaf\x97l\x7f\xea\x18\xdeB\xd2\f\x12\x98k\xfa\x1ap\x86 \xf8\x1e\u054e\x81\x97\xa8\x98\xf12\xdc\u0474^\xf20v\x14\xb7Y%U\x83\xb8\xe6b\xfc\x06\x8dIs\x15\u0512ka\xfcv\xfe}{\v\xc4j7\x1b\xdeVca\x81zWI\x17G\xf5cc}}!\x16\xf4zVN\xb57\u02ede\x9e[\xcb4^\xcb1\rQ\x0f\xc3\xf5\x1c\x12\xbf\x1b\xd7\v\xe6\xe9\xfe\xe8\xe7\xf7\u01a4\u0562\xc7}N\xe2\r$&\x00\x9f\xcf\xed\x04\xbf\x92\xd9:\x9a\x95\xa2\x9d\xe3\xd7\x17\xf3\x97\x93\x02\xf5\xc2\x02\xbf\xb1P`\xf3\xa4\xbc\xd8\xc4\xe6B\x11\xc6VmO\x82\xfd\x13\xecO,0/\xa3\xa4\xde\xe7I\xf26e\xef\xd7I\xfa\xd4d\xef7\xe6E\x9b\x18c\xbf\xfc],\xb7\x90\xb2\xb5\xf1\xe5\xb5\u0142\xa3\xc5l\xc7\U00046db4\u046d\xd8\u0540c\xe2\xf1S\xf6\xce6\xac\xe5\xcc\xda\xe4/\xb2\xd3\xf9E;]\xba\x94\xef\x977>\x1d\xadb\xcbt\v\xb7\xbe\xd1\xf3o}\x1e\x89\x9e\xdb,\xde\xfaR\xb3\x94\xc7\x16\x9e\x03\a\u0216}\xef\xe6\x94yw\xe9\b\xe0W\xf6=\xe0\xb2\x19\x04)\x06\xa1e\xe2\xa8\x14\x7f\x02-\x81`Z\xf6\xbd}\u07c2\xf9$.\xe20g\x91\x91/\xb1\x13\x0e\xcd\n\xb1\xcd\n\x99\x98\xee\xb6\xf5\xd24\x82\xe2Y\n;+\xb1\xfb\r\x13\xbb\xdfH?[\xb0\xfb\xcd\x16bj\xbd\xf3\x85\x98zo\xb0\x10\xd3\xe8\x9d,\xc44{{\v1\xad\xde\u0345\x98v\xef\xc1BL\xa7wc!\xa6\u06fb\xbb\xd8\xc3J\xef\xf5\u0168j\xef\xd6bT\xadw{1\xaa\xde{m1\xaa\xd1\xdb_\x8cj\xf6^]\x8cj\xf5v\x17\xa3\u06bd\xc3\u0168N\xef\xd1bT\xb7wo\x11\x9a\x95\xdeC\xfdl\u067c94\xe6\xd6\xc6\u01a2q\xf0%M\x99T\xde\\6\x01\xaea\xd2\xce\u042c\xd6{\xd1\xe3g;\xfa\u042c\x91I\x84\x1e\xb1\xe9lb\x90K'\x88D\xb9\xd8n\x16\u057c\xf3\xa6n\xf4\x0eR\xcb\xdb\u042c\xaf(\x0f(\x9b)\xaf\x0f\x15\xa2-\x1a\x19\xef\xa4u\x15\xd5\x03^\xbd\xbdO\xeb \xb7\x93\xa1\xd9\xc8\xf7#\x19\u0173KVkFO{\xf9\x9a\xd9_\u044d\xcc \xa5\xbcH\x7fFf`)\xdfX6\x87F\u057f\x91\x83\xd0\u042c\xf7\xdeX\xb29\xdeIk\xd4\xe3Q@'\x93>\xbe\xa2/\f\u054c\xda=MK\xf6\x93F\xa0\u0261\xd9\u0334\xb2\x97\x87\xed\xd0l\xf5\xf2\xed\x98q\xa0\xbd\xb2\xcd\xd3\xd4\xc0\x92\x1b\xc1\u036bG\xf0<5\x17\xf4\xfc\xc1b\xed\x9f\xd1>\x13U\xd8\xe9i\x9f\x89+\xef\xf6\xb6\x8e^6\xbft\xbc\x95\xab*\xdb\xc8\u042cV2\xf5\u0780z\xa3\xba\xd6\xd7\xf5\xa1Y\xadfR\xef\xe2\f~F+N}}\x11\x9a\x8d\xa2\xa1-\xac\xba[\x05\x85\xae3\xd5\xfa\xd0l\x10ks3S\xd5\xed,~\xe9\xd7X\xaf\n\x85\x87f#\xad\xe35\xe0K\xb4Me@\x8f\xd9L\x8b\xd4\xc0|\xbe\xb1\x82\n\xc0\xee\xd1\xd3,\xb5\xa9h\xd1J\x82E\xb8\x7fs\xef\xee\xe7o\x1d,\xd4\xd56\xca\u00bf7\x9d\xc6V0\xac\xb9}E\u0376\u06a0\xa2\x9ak\x15#\xbfT\xf63\x0e\x03\x00\xb3f/\xbb\u0186f\xb5\x95\xe6}u!o\u72bc\xbb\x99\xbcj\xb2\u06d9V\x0f!uh\xc6uU;\xbdl\xd9G\u0669\xc0\xa9K\xe9j\xb7\x97x7@\xa5\xadL\xa5\xf7\x16\xba\x97_\x1b\xf9\xee=\\j\xa2\x10\xbf\xde\x00\xbb\xb5\"\xaai\xe4\x9b\x18YMa\x8e\xfe\vV\xd6\x7f\xe1\x95\xccK\u078a\xbf\xb1\xc9\x04L\xec\x06\xa8\xa2\xa8.\x88m\x90d\xd2A\xed\\\x86I\xdaqt\x95+\x9d\xb64-\x8c\xd3\xe0oL\xfa\xd7\u05f9>)\x03a\x8d\xea\x87?1\x9e\xae\xaf\xfb\xf9D\x83\xbc\xa1\x1b\xc46\xad\x8dj\xda\xd7O'}\xc5L\x1bz\xa6\x86\x9dZ\xaf\x9ah\xb5\xe2\xa1\bb-\xf86\xcc\ud5ecH\xc3\x16\x8f\xd62\b\xd5=bG\xcd)\x053g\xbf\x1c\xff\x04\x80\x87\xc9\xf0\x8f\x94\x9a\xa0#M\xc5\xc3PM\x86\x7f\xc0\x03#\xaa-\x86\x15:($\xa181\x1ai\x94\x18\x85\xe6\xdbx\x9bb\xc9g+<\x18D\xa6\xf7\x02\x8d\xe3I\xef\xf1N.\x1f`\x11\xfd\"\xb1\xebA`^\xceU\x00\xdd7\xc0\x86\x84!<\f\x98\x84\"\r\x0e\xbc3\x9d}E>\t{\xec\xfcM\xbc\xb1 )\xf1\f\xd3\xd6\n+MR|\xa5\x81\vS\x05\x0f=\x8b\nf^*\x8e\xb6W!\xe04\x87\x9c\xb6\xc6<[\x8b\x1a\xb0\xe0\f\xa8\x12\xb4\"\xc0G\f\xff\xa1\x97y\xc1\xd4\xf2=\xc1.D4\xbdQ\u05e8\xe7\b\xe7\x11{EL\xdct\xd4J\xcc\t\x8a_?M#\x11\xac\xf8\xcb\xf3\xf9\x84\xba\xce#v\x8f\xbb\xc1\xdep\xa4\xe3\v\u0701\x91\x85\xb86\xf0}\x97QO3M@\x1e\x7fX\x12*e'\xfa\xdbK3g\u79a8\x1c\xa6\xecD\x7f{i\xe6\xfc,\x8a\xf8W\xe45\x12\x85\xf2S+\xe2_Q\xa6(\xb48\xdfE\xbdHRw2\xbf{\xf9\x82\xcbX\x92\a\u061d4E\xc1-\x93u\xe5\xfb\u02c5\xbdY\u03b6S\x14\xd9[Q\xe7U\xf8X\xd4\\a\u039d\x15\xf1\xbd\u0555\x17\xe0\xa1\xc8\x05\xa5\\\x8b\x8d\t\xd9\tB\xe1\xb9\x1a9\x9d\xc4\xea\x85(\x98(\x12\xe2\x88T\x9b\x801\x86\x18s\xff\xbc\x04[\xc8\x01\xe7>\xd75GY7J_\x8aj\xffR\xc9\x1a\x8eJ\x9b\xa5\x80\xb1\x12\x1c}\xd4b#E\x16\x91\x92.\xf0|\x17 8\xa4\x16\x83\xa3\x9bq8\xd7%~E\a\xa2\xba\v:\x90'\ty4\xba\x1bE+\x1c\x8a3\x19\x8b\xe4A\xa4\xbfcO\xaa8\xbcH7D\xfa;\u029a\x84\xf3\xe4D\u013fT\xb6\u053b\fN@\xa6$\\\x10/Q\x8d\xc1\xb8\x99\xee\x19\x98%r\x9bB\xa5\xcc3\xb2c\x9e8\x7f\xa2\xceY$>E\xca8e\x15R{\x1d<\x10\xbf\x8c\xd1l'\"\u07d1b\xa8\u01c0\x88\xa7\x01\xe1\xdaI`\xde[\u021bT\xb7\x96TW\xce\xe5\x90r!\"[yQ\xe94Y\xcal(\u06cf\xa2ri\xb2\x94\xd9\xd0|\x05\xcc\xf2\xf4\xe7\x13\x82N\xc1,\x03\x9f+\x00\x93B\x04\u02ec\x80A4\xf8U\xbd\u03e0}A\xd75\xa5\xcc\xcdu4\xdaH\xd9\xe2F\xda\xcb\xdb2\x13\x05U\xc9\xf1J\xc2\xc0\x9d\xe4\xe6\xb9w+\xba\xc0\r\x9d\nR\x16\xea\xc8;\x06\xf0\x1cy\u01e68\xf2\x8eS\xaem\xae3)/\xe7$n\xf6u\xecP\xf9\xd6\xcd;7\xee\xdex\xe3\xe0\xe4\xc6\xeb\x877^\xbfq\xf7\xf3\x8b\xbdY\xc0\xf0\x85\xb5\x91\xb8\xee\xa1\xe6\xd53\x8f\xc0\a^\xc3\v\x01\xe0/\xba\xdfi\xc7D\x19\x8a\x8f\x8e\xb7\x93e\xa43r\x99(\xdbz\xd9\xfa\xbc\x8c\x0f\xc1\xcbfe}\x9dol\u0309\u04bdeA\u3859\x17\x06\xcdc{\xc1\xb2\x11X\x94\u056d;\xcbT\xeeK\u8c48:\xd1/\x95&a Jcz\xc6Jb\xccJ\x9f\x19}\xa64t\xe9\xa8\x140\xa1\x19\xdbY\r\xe1\x11(V+\xdb@\x93bc\xaf\xc1\x95\xc22\x96\xce\x1co\xa4\xfb$\x1a\x85a\x90(\xd9;\xaa\x1c\x1b\u010f\xdd 6 \x1ci\aS\x9f\xe0\u52a0\x82\xb9\xce\u0216\xbe\xee\r\x82\xe9\xb6\\\xffT\xb5U\u0656\xf0\x1e\xbd\\\xff\x14\xfe\x1c\xa9\x9f\xb5m\xa9^\xbc\x97\ub7ea7\xf0\xdb\xdd6\xb6F\x8eA\x1c\xd3\u06e6\xe5\xa1\xcf\x0f\xa85\xd6\xf5%(z\xff\xaaf\x9a\x15\x05Fn\n5\xcb\x00p\x9d\x11\xc7\xd8V\x16\x8c\u0212\xe4\x13n\u031d\x8dD\xb7\x89jV\x92\xaaJ\xaf\x9e\xcc\"\x8b?I\xb4\xa9\xb9\xb2s\x92\xe8U\xf3\xf1s#\xd6n\xe3\x1dw\x96\x88\b\x8b\xee'\t\x9cM\xfc3v\xcf;\xa7\x9e`v\x9a\x9eG\xe6|\xf1\x1cV\xb3r\xe0s\xa1\xebE.\x99%V\x1e1q\x13\xd5\u03fa\xb1)\xb2\xa1\xb9\x91\"\x8c\x00\x97\x83\x972\xae\x11\"v\x8b\xf0Lv$`Yx\u0672\x88\x1d#&^K\xd5\u073a\x11\x95&\xd4\xe4\x1b>l\xf3b\xa3\x9a\u0509\x93\u01ce\xc4F\xf58[Q\xba&\x1c3M-\xaa\xf6e\x7fG\xf4\xc4Fu[9\xf5ZLwH\xd5\u060e\x1d\x98\xe6\xcbu\xbfD\xd7\u05d3\xccb\x03\xaci\x89\x96\x9d\xe5\xc1[8\tEt=\xcb\xc9H\xe9\xe8\x8c\x14x\x19iQ\x06\xa5\t\x191\x01><J\x8b\x98r\xeb\xabKc\xf2\xaa\xb2\xea\x1e\x96\x95e1yU\u0644g_U:\u02b0\xaa<H'\x8b;\xf4\xaa\xaaB\xbe8\x04\xe0(\xb1l\x12\x7f/\xe3\xe2\xb4\xd8Lv+\x7f\xae6\xc0\xf5\xea:\rd\xf7\xfc\xe7j\x00\xbc\xbaV7\xc0\n6!\xc0\xe3\x05\x8a\xbf \x8bW\f\"\xe0\x04P%%\xe1\x8a:$\x8b\x81\a\xba\x91PuB\xcd\u0621.ub\x8aK:\xa6w\xe4\x1f\xe7\xecu:\x03\xfbs\x85\x84\xa6\x13\x17\v^\n\xb7\x83\x8d\r\xc39\n\x8e\xcbA\xb2h\xc4\x06FdV\x91\xb1\u03734\x95\x13'1\xec\xf2\xfcX\xf3\xac+\xcb\xfb6i\xda\xf6\x92\u0302\xdc\x11K\xedl/\x81\x91\r\xb6\v\xcdH#_\xc6\xc8\x11Df\xe9\x95Z\f\xc8\xda2\x03\xb6\xf3x\ubba0\x01l\x118\n0\xe2\xc8?\xde\xf6\x96\xf7.N\x9c\u0710\r\x12eR\xa4\x9b3*\x14(o\xe3X\u07a0\xae\xee\xc0vg\xe6\x8am8\xabh\xd7<\xb1\xef.7\x8d\x8d\xc5>\xc5\vD\xbf\xa0\xe1e\x86&w$\"\x117\x10\xad\xf2Q\xa9\xe19\x16>\b3\f\xa2\xa9\x9ed\xc4\xd4\x1d\xd1[\xab\xa2\x03\x1c[\x1aQO\x94P*\xf3,\xc8\x1a\ue232\xf0\xfb\x9e5\xf6\xf9\x1d5 \xa3\xc7\xcaxa\xd8]:\u048d\xa5\xd4\xfc\b3\xe8\x9d\xd5[9C\xd5\xcdX\xa7bd\x87\x18G\xa2l\u0252er7Q\xb6\u8c18\x8e\x80\x81\xfa\xb5\x9cU\x96\xe5\u032fYJ>W>\xee\x8f\xf2\u0663\xd8/\x14\xc6\n\xf6\xac\xba#b\x1a\xe5\x7fe1{\xc69s\xc9Q\xf99\x14\x10\u03eb9\x98\x1b\xc7\xdbE\xd04\xc5\xd2\u0324\x10]\xf4tf\x8b\x96\xf9\xb8JPr/%\xa2\xd6\xd9\xd5/Wk{\"\x81\xa4\x97\x13\xd6I\"e\xf7\xf2BwBfc\u0165V/W\x1b\xe5\xbaFXY![\xa6\xe7.ae\xa05w\xe9\xc8\f\t\x8b\xc7k^\x1e\xa0\xf6\xec\x11y%\xf2?\xfb5\x12ab\uf73c\x16\x19\xe6\x05#\xb7PY\xf6\x05r\x8f\xbb\xbdW\xe6q\x05q\xf1IR\xfcL\x15\xefYI\xe1QTv\x86e\as<\xb9\xa1\x8e\xf01s\v\u0134_\x91\xf7\xd5)\x95\xd4\xd6\xef\xb0t\xfb\xd9\xfa\xe2K\xf7\xb7\xe8\xfd\xe0\xb3/o9\x89[L\x923`\xc9d\x1c\x1d\x13u<\xcbg\xfae\x16\xb1\u05aa\xe87\xbcV\x89\x8e\u07edUHB\rRFU\x18\x97\x91A_\xa4\x1b\x9d2\xed\xe3\x0e\xd8c\x8al]\x02\xc9\xe8\xc5\x1eXH\x0f\xb0z\x11\ud130\x01f\x8c\xd4\x11\x1f\xf3\xac\xd2\u0259\xc5\r\xac\a\xe1\xaag\xe8\xff\x17\xa3\xf4-\a\x1c0\xe6\xf1\xe6\xb3V\x9d\xcfc\x91\xf1\x12v\x1eP7\xd0P\xf8\xf0\x9b\U0005e627\xa0\nY\xc6O\x85p\xe2\x13J\x1c\x12\x90\x90\xb8\xc4\"S2$cb\xa3+\xbe\xf0O\x99\x17\x903\xd4\xd7\xe2n\xe3\x99\x15\xc2\xcdI\xbc\xa1x/\xf1m\x0f\xb6\xcd!h\x9f\\G1[\x93#\xef\x18\x95\xfb\x06\x14\x99\x9a\x15\"L\xddW\xd1\xd6\xd8qm\xce<#\xc3\\\xbf\f\xec\xf6\xe6&\xd6\x02\xfd=\xb1@\"\x01%\x97NM\xffH\x1c\x1b\xaa6tl\x1e\x8b\x89\x1b1\x93\x14\xa3\xd7\xd7u\u06e4\x8a\x86{\x82l}\xf1%z\xf4\xf2\xfd\xe08\xc6\x12\xdbX_\x9f\x82\xff\xffts\x938LO\xb2B\xfc\u0186A\xd6\xf4\xe9\xcb\x15de\u0605\xc8\xd6K\x95wV\xa6\x04v\xe1\fMW\xfa\x99\t8\xa7\xf4O\x01\x19\x9bg\xe5\x14\xde\x06:\xbf\xc5]\x1aFc5+d\x8c\xd9u\xc7 kq\xac\x11\xf3\xec\b`<Ka\x99\xb4\xec\xb23\xe6\x82S\xdcv\xf8R\x9cu;\xdc\xd80XYA\xba\x8c\xca>*\u062bP\xe3\xf0(<\x06N\x0e\x0e*\xe8\xae\xe9$\xe2\x1b&\xc0\xc8\fc}=\x88\x91\x0fM\x8b8^\x12\xf5\xb2\xe7$\x87k]\x83`\xeb`\x13#\xf9\"8=\xfe\x94y\x1a\x19s6\xec\xc5\xcd\x12\xe1\b\x97\xf54-.\xb9\xb1\xb1T6\xdf\\\u04af\xab\xdbR\xa8\x10\xe5\xd9\u0704\\\x8e\x19w\xd5\xddHj\x89\x81\tnH\xea\xe73F\x9bi6\x87\x98&\xa8;`\xea,*t?\x81\x890H@\xe2\xa0\u0628\x82\xec\x8c.\xf80ibss\x1b\xf0TM\u069a\x19O\xdf\xfaz\x06`k&\xe22\xe2\u05b6!67\xd3\x15\xe92%\xb9Z>ge\x1e\xba,R(`ig8\xd3H\u0214\x05\xceb\xa6\xa7\xd7\u069df\xcb SfzeO\xb7\x98A\x86\x10\xddmT;\r\x83\x8cU\xf4\x90e|\xa6l\x96=\xab\x19y\xa4\xa2\xf3]\xa2\xba\xa5f\u0084{\xe0~\xe9\x11G1\xf5{\xbe7tF\x01\x9e@\x88x\xa3D\xbf&\x8c\x8c\x82o[\xf9\x97!\xe5-\x9f\u85f0R\x81\u018a\xd9T\xbd\x89\xc58\x04\x91$\x06\xf0\vFw\x17/\xc2\xedi'p1\xf5\xa967\xcaa\xc0t\x97\x19\xdbA\x16\x1c\xb6\x13\xc0]\x8a\xfa\x91\x16QB\xf4\x86\x05M\u0544r\x01\xca\x13\x16h\xc7j?\tMG\xc7j\xeey\x01\x1d\xb2\xd7(?\x85\xa7(\x88k\x02\xf7\xe7\x01K$\fb\x99gLw\xc9\xe5R\xbe^\x98P\u0492X_w\xd7\u05ed\x1d\x1e1\x9f\a.6\xack\xb6s\xa6\x91\xcbtK\x1e3\xdd\xd0)\xd1&Q%\x9aAl\xea\x8d\x18\xf7\xc3\xc0\x9d\xdda\xe2\x86\xe71\xfe\xca\xdd\xd7^\xed]\x9e\x9c d\xac\xf9\xdc\xe8\x01\xdc\xe6\xb0\xc1\x94\xa9m\xbf\xe2\xfb\xa7\xeb\xeb\u0650\xae\r\xd8\xd0\xe7\xecN$&D\xed\aZ\x91|VbeX\x93(\xe53\xd1\x17\x82;\x83P0]\xe3\xcc\u0548\xe6\xf9\x80\x86\x8c\x97<\x9f3u\xb7\xbf\x06\x9c\x83a\x10\x9b\x95#\xa7)PN\x06\xe6e:\xed\xbd\f\xaf\x13m/\xcb\x10\x83\xdd'6\xb9N\x98i\xb3\x14\xf3\xce2\x98\x97x1\xc7:\x8cj\xac\x00]3\xd3\u0123\xea\xf1N6\u043b\x9c\x13\xcf\x14\x05\x13\xcaS+\xb3\xb7\xbe\xee\x11\xdf\xe4\xa0m\xd99:\xee\x1d%\xaaL\x9c#-\xe1\xf2\xf8\xfa\xfa\u0699:\xd8J\xb9\xc7\xec>\xbc\xbe\xb2\u03e6\x9c)\xe5\xe9\xfa\xba\x0e\xe3\xf0]V>\xa7\xdc\u04f5\xa5fK\xb6\x13\xc0\x15\x88%\v!\x14r,W\x9a\xc2\x1d\xecL0^r\x82\x92\x1dU\xc8\xecR\xe0x\x16+\xbd\\/\xd7Z\xe5J\x89zv\t\x1ew+\rXIiYl\xd0\x0e\x9f5\u0295r\xa5\xac\x19\u4abe\xa1\xea\x1c\xd1#\x16\x1bA\x19\xdb\xdf\xdf?\xe9\u07fd{\xbbw\xa4\xa9[\xa5\xb5crx\xf3\xf6\xee\x8d\xfd\x93\xbb\xfd\xcf\xdd\u0240b\xe8\xf3\x89vL\xfa\xaf\xbez\xf3\u0353\xfd\xfe\u077e*\xe7\xc7\xd91D\xe7\xc6\xfc\xeaNT\xe7\xa4\u046cW:=\x9d\x11A<\xc3|\xf9\x12\xa0TR7\xaeh\xdb^\x19\x17\x98W\xb6uA.co<\xdd0_\x1e\xce#\x13\x18(\xcc\xf4v\xa7[m\x18(\xbc{\xba\x8f\xbaN\xbd\u056dW\xaa \x9dC\x9cc\x90\x10\xe8Z\xa5\u05a8\x19\xc4E\xca\u05ea\xa1\x97\xb7\xa7w\x9b\xd5J\xcd S\xf32\u01bc\xa19\u0766\xba\xa1s3\xd0\r\xdd2\x94\x80g\x19\xd1\x0f\x9e_3@\xce\xca[\xb8K\x96\x1f\x04@\x9d\x13<E\xed\xf0\xf4H\xaf\x90\xb0\xfc\x05\xd7\u0419q\x8cG5q ;\xc9/`\xaa\f\x83L\xcbw\xe8\x90\xddF\xc2b\xbaq\xe2\x9c4\x9b\x9dj\xedz0\xba\xf3\xca\xcd7O\xfa\xf7\xee\xberr\xeb\xe6\xad{\xb7\x10Vc\x02\x117o\xdf\xf8\xc2\x01\x86m\xf2\xea\xcd\xcf\u077cw\x17\x03\x13r\xeb\xf6\xc1I\x92\xe1\xe4&\xfc\xaca\xd2\x19)\x8c\x1e\x917\xfa\xaf\xde\xd8\xef\xdfU\xb5\xcd\xc8\xde\xcd\xd7\x0fo|\xee^T\rF\x0e\xc8\xed\x83;wofj\xee\u07fdq\xf3uL;'p\xb7\xe6>S\xcf\xe3\xf8^\x80\xb1\aD=9\xe0<b\x18\xbeH\xc3o:b|\v$\x92@\u071cB\t\xccpB\\\x1f\x1e\xf5\xc0\xc0\x9d(P\x9c\xf5\x94L9\xeb\xc7\xd5\u0758\x80\xe2\xd2Q\x05\xfbi37\xe1\x97\x1a\xe2\xdeblq\xbd7\xd3l\xb7h\x10\xc0\x81P\x8c\x7f\x90\xc6\xf7\xe1\u065b\u0218\x02I72I\x96\u0142`\u03f7q\x80\x87>\x9f\u0702\x85\xaf\xc0qwU\xbe]\x1a8\x16\x8c\x85y\"[\xf1\xebi\x81\xdb\fo\x87\xc6\xe8[$\xa6-\b\x00\x8c\xbbM\xf05\xb1\x14$i-\xaf\x91\xa9\x1ae.\xe9\xc6\xf0u\xc6l\xa6\x06\xb7\x8f\r\xdd\xf2\xa7\xe1\x14\u00ef\u0393\x13sz\xad\xden5\x95\x0e\xdb\xd39.H\xbd\u066d7*\xb8$\u02deNqA\xea\xcdj\x17\xd6a\x88q\x81Z\x90\x9dF\xb3\xd5\xc0\x05Y\xf6t\x17\x96#,\xd2f\xa5\x01\xcej\xc9\xd2\x1d\x9b\x1a`\xcf\xc9\x14\xda\u05c8mj\u02705215\x85\x06\x1a93\xb5)g'I\u224f\U000e8451\xa9-G\xceL-f\x81520\xb5\x04fX\x81F\xceM-\x02\xd9\t\xcd\x02F\xdb\xce_\xb7\x13mk\xc8%\x8e\u0254\xce\xe0\u046a\x1e\x9b/\\\xb0\x93\xcdfg\xb3\x01\x1cO\x8a\xb4\xe3Y\x912Rb\x82\x8c0\xeecl\xb0\ud553A\xa1\u05ae|\xd54\xea\xc6|\xbe\x9d\xb9`g\xa1G\x93\xc5\x1e\x9d\xbeX\x8f\xd4T\\\xaf;\xa4\xff\x02m\xa0\xb7>\xe3<\x0e\xfa&\xc3dBc\x81\x93\x04fr\xe9\b\tM_\xe9\xd8)qM_9\xc3Yf\b\x8c\xa9\xae\xc1\x8bC\x9a\xb1m3\x97\tV\x9a\x96\xbfP\x86\xe7\x12G\x8c\u07fbq\x9b\xa9\xc7B\x14% \x1aM\xd6#Hx\x96\x94x\xa2\xcfc\xe70\xba\x03\xce\xf5K\xc8x\xc3\xee\xb9D\xb1\xc7=Ma\x91\x12\x134`\x06\x80\xf5%\x13\x86\xef\xdd\xf5\xb4\x1cXJ\x13:\x83==DV\x81\x94\xe0U<\xd8\xfc\x05\x15\xactN\x83\x92z\xd4\f7{\xf5\xd8W\xe9\xd6B\x16\xef3\xa2\xa4@\xc8\xec\u0490\xfb\x93\x12t \u02ad\xcd\rB\x01l>\xdfy\x9enc\x89\xb4\u04cen\xe8\xd4\x00\xbe\xb3\xfc,Z\xa9j\xee\xf9\x04\xe7\x04\u0642\xf9v\xdew:\x8b\x7f\xa3E\xfc\xbb\xf9\tW\x84\xea\xd65\x11\xf1\xc1\x8b!\"\x8bQ\u02cf\xbc\x0e\x11\t\u00c0q\f\x80\x004\x8d\xb6\b\x12d\x02w\u0549\x11\x10\x9f\x1c\xe6\x89\x1b6\xb1\x92\xc0\x1dfq&\x80/\x19q\xea\x89\x13%|\xc6%5\x12X\xfe\x94\xc1\xe16\xf8\x1bD\xaa\xee\x92f\x90\xb8\xd9\x1e%q\xee\x9e3'c`p\"\x85T\x10\xa9\xa1\xb8\xda+6\a\xbe=\xd3zkY[\n\u0605\xc5\xfaz\xa8\x1b\xc0\x1b\xaa.\x9d8vO\u030dmo1>\xc0\xbe\xf6\xbc\xb91\u05e7\xa0\xfd\xc9)\xac\x06\xb0ii\xbdq9\awS\xc3\u036c\xa4m\xe8\x152,\U000fa87b\x1bZO\u06f0\x16\xcf_\xe4\xf9\xe87\xd5\x02\xea\x95b/\xa5,0KZ,q\aD\xc3\xf5\xa2\x16\x10)y>h\xd8-7\x04mmI\xf5\xbb\xe4\xd8\xc8H\xab\xeek\xe9\xd1:\xaf\xbc\xb8\x9d\xea\x97\x00\xa3\x1ev\xf5s_0\xf4\xa9\x81Z6\xae\xe8\a\xa2\xf6=\xeej\x06\xf1\xa2\u3dcc\u068c\a\xbd1y\x182>\x03\xd9\x03\x97\x01C\xf4\a,\xba\xf1K@55\xf7\x88l\t\xa2%\xa8\x14,\xa2Rh^\xe6\xf7\xfc\xe5\x19pp\x06\x02\xbc\xa6 \x87w\xd1D[\x9cE\xaf\xd9\x061\x06\xfa)\xea\u0377\xaf\r?7\x82\x14]\x01F\x05\xac\x04\x8c!\x80\x8d\xdc-\xbc1\n\xb2\xa2\x0e\x82G\xa4\xfa\x1ew\xb7\x17\xe1\xc9b\xd7\x06\x96\x83\xa7o\x8a\x18\x9e`\u078a\x80(\xb2@\x14\x8b@\x14e\xd0\xff\xbf\xc1\xb83t\x18_\x04T\x8eC8\x81\x9c\xa0+\xb2YO\x15#\xe9JrH~\xf5\x04$\x1e\xc0I\u021d\x9e\x87\xc5N\u03a2vz\xe1<\xf5\x12y>\xe8\xfa\xc5\xd0\x15\b\xd3\xd7\xff\x85\xc2\xf4\xfa\x98j\xbd\xf8\x04\xfc\xd2\x00n]\v\xe0\t:\xbb\b\xfa[\u05e2\x00\xc8\xe7\f=\x84e\xaaNA f\xa1\xee\u6e61\xa9)\xca>\r\xeaw\xf0\xcd)\x9f\ad\b\xd32eV\x1a3\x8e\xaaHcl\x93\x95a`\xa8\xb4G\xc2E\xceRu\xded\xe7r\u079b\x90\x91\xc9\xca\xd1@\xc8,M\x1dA\xea\x88\fbZtn\xe2\xa5g\xe4 F\xa7\vS\x1fgF\x00\x97#^\xceQC\x85\x82 u\x7f\x1d\x1aT\x06M%\v\x81\xaap\b\x9e\xfa\xfd;u=:DzbN\u02ca\x959\x18\x0e\x19\xbev\xf7\x06uC\xa6C4\x8c\x83\xd9w0\x19L\xec\x9ei\xe9\x86~NN\xc8Z\xc5Pj\xd68j\b\x9d\xd3\rH\xd8\xd6\xfc\xc1\x03f\xa1\xca\xde\xd7\r\xfd\x02/\xe9Q\xe37a\xa3\x03\u0151\n\x92\x8b\u8190;\xa6W\x16~l}%\xa7*\x1f\bkp\xdb\x1cM\u5fad\a\x81\xef\x91\x12(\x8d\xb7\xa6.u<R\xfa\xec\xd6g5\xa2\xed)\x05\xf2&l[Z\xbe\xc8\xc5\xe6\xf9\xf9\xf9&hY6C\xee2012[#\xdaolF\xe8\xc7\xecM\u0cf4\x9e\xf6\x1b\xaf\xbd\xfa\x8a\x10\xd3(^\x9b\x93\x198\x16\f\x19\xec\U0009700bw\u0204\x89\xb1o\xf7\xb4\xa9\x1f\b-A\xc1\xd3hc:#\x88\xc96\x89x\x81\x1b\x9e`\x1cF\xe1\xf3\x1eE\x83\xd1b4\u020fS\xdf\v\xd8r\u05a5\xf8\xb9Q\x06YU/\xb8-\xf3W\xef\xdc|=\xf64(\xdbT\xd0\xe8\x82?](\xf6\x14\xaf\xce$<\x8a\u008c\aI\xfc6+\xfb\xa7;\x9e\x94|\xc7-\xe2b\a\v|k\x9e\xa7\xcdr\xb1\x02\xb8\xd8\xe0\xba\\\xecA\xc4\u014a\xb9\xd1\xfb\x04\r\xb32p\xeba\x00\u05ba\xb91G\u0352\xb0\xc6E`J\x1d\x16\x99Q\x8e\xca\u00fa`\t\xb8\xd7\xd7\xd3\xdf\n\x90\u0251\xf8\\\xf4\xb6\u0cc8`/\xf9&x;\x99\xe9\xf0\xe0~S\xaef\x01\x80\xbfaj\xa4\x84\xa1^\xca_E\xe9\xe8\u0788\xbfNl\xa6\xbc=\x95\xf2S\x95\xca\xc4-\x97\u03560\x8c\xb9\x02\x01\xba\xf7}\x02\xd0*xfE\x8c\u06cb\"\u01a0P6\x7fm1\xdb\xf9\xa2$\xb2o.\xe9\xb0K\xab6\u03c4\xa6\xc2\\\xe9\x15\x92\xb5\x80\x18\xbaQ(\x89\xe4Y>\x88\x06\xa9d\x1b\xaf\xa6\xbe\xa3\xdeJ-\aL\xdc\x10l\xa2\xa7j\f[#\x80\xc7@\x8e~\xf5\x8en\xc0\xd8\xe7\xe4\xd5\u009b$K\x99\xfe_!\u4682@*h\xf9u\x86\xd5u\xeb\xedJ\xf3\xf9\u0574c\u043c%\x1d\xc5-<RAf\xe3\xfbS\xe7\xd7\xd8\f\x13&\x19mR\xa7\xddj\xd7\x16\xb5I\x8dj\xa5\xbe\xa0Mj\xb7\u06ed\u05826\xa9Q\xef\xb6jJ\xbd\x8b\xdaS\xa5OjU*\xf5\xa6\xd2'5:\xf5J-c\xd7\x1a\xa7V\t:\x14\x8c\xbf\n\u04de\x9d[\xe5\xf2\xe3\xfb\xe2\x86\a[E`.F\xa0k\xf5bd\xd9\xf1\x1cq\x13&9\xcf#\x95sj\xb9\xe5b\xcb\xe01a\x8e'\x91\x06zB\xf0\xdc\x133\xae.\x89\x00\u01c2vT\u040e\v\xce\t\xaa\vn\xb9!\xbc\f\xdcS\xc4\xed\x923;\xb4\x90?\x89\xb5\u03c4\xaa\x0e\xf7,\x12\xc4\x18\u075b\xce\t0\x12\xbd\xcbsN\xa7\u0448z\xc3\xf9<\xb3\x98l\x85+$v\xccF\xc9$\v\x80\xa4\x93(\xa6\xe4\xf9\x12\xb0\xafA\u032f\xc2\xcei\x99z\x85\x04\x11\x13`\xe8\xc6\xce\x11\x1c\x97\x9c\xfa^l\xb7\x8b^&Ww\x91\x06\x1a\x9al\xa2\xb8\x8c\x96Y\x83+\x03]\u0740ex\xc3\xd3a\xb7\xa6\xe6\xd1q\x04\x17J,\x02\xee\x01\x89\xbdn\xba\xe3\xe8\xbe\xda\xf9\x05\xb9<\x03\x06\xa3w\x99\b\xd6^*X\xf39Q\xbcno\x1a-\xc0\xb9\x11Y\xe3\x12`LbyZ\x99,\xe8JP8K\xa0\bL'\x05\x85\v\xa0p~\x19\xa0\xb0\xcc \a\n\x9e\x82\x82\x137\x0f\nk\x87.\x81\u008b\am-\x0ezNp\xed=?\xad\x98\xe4L:\xd1\xe2\u03d9t\xaa\x9dv\xbb\xbd`\u0489L?.\u0185\u044aW\xea\xe5)\xc6Y\xd1z\xaf\u05fbu\xd0\x1f'\xaad;\xa1\r\xb1\xbd\xd1\xd49\x9c\xcbD\xcb\x0f\xb1\xcb\v\xb6\x14\xb2\xe0\b\x1e\x93\xeah\x8f\u021c\x8d\x05\xd6\x7f\xc1\u01a1\x11\x0f@\x94\u051dXV\nk%<\xad\x97\xf8&\n\x19\xa0\xc7\xfb\xd5;\x86\"\x84L\xc9\x17\x99-\x00\xce\xeeC\xb6\xd7\xe8\xd4H\xaf\x10tuC\xf7L\xbf\xcc<\xc1gw\xd8C=6ay\x99v\x93\xb1\x04\xba\xa1\vR\x03\x9e\v\x8e&\xc0<\x1cU\u057d0:8\x9c\xbc\xe9\x1a\xba\xaf\x90\xc60\xf2\xc3\xcdnFT\u0363cFy\xf5#\xe5\x9f\f\xc7B\xf0$\U000f1e84\x90\"]\x03\x9e\u06d1\x12/\x99\xc4\xdf\x065)V\u0289o\xa4\x97\x13*\x1d\x12f\xb8T\xb6\xf9\xb4z\xc4I\x8dh\xf1\xfa\u050e\r\xe2\x16\xa4'\xba\xb3cc\x9b\x9az\xd4\x10d\xe1$\xcatL\xd2U\x1eF\xbcrN\xf8\x1c\xa3\xf0\x19\xa2\xf0\xe9\"\xe7\x96V\x11\r\xf3\x98D\xf2_\x14\x86\x1d\xd90H!\xa8\n\x91\"2\xb7]\a7x\xac\xf2\xe6J\xe5\xbd\xed\xab\xbf\xe6T\xadXd\xaas\b\xe4\xc7\xd3s]$B/\x19&tO\xe5G\x00\x1b\xc4+\x1c\x90\x93\x1b\x90\xb23>c\xe1\xa0Jk\xa9'\xe5sG\x8c_\v\x05\xf2EA\x9e/F\xac.FdVV*|\xe4\xea\xe1\xff\xed\xd5x\xcas}\xcd[1\x9fo\xb1\xab\xcd|i\x91\x17\xda@\x9f\xaf\xe6l\x7fs\xb3\x98Q\xb0\xd98P\x18\x0eA\x06\xe7z\xa4\x17\b\x94\xb7h\x85='v\x1as\xd7\xef\xe7L\xb2\ad\xc4D\xa6\xc4\xee\f\x1cV\x82\xc8\\\x9b)x\xe8s\x10G\x1d\xae\\L\"sm\xda\xdf\xc8d\xeb\x04\xfd|\xd4)\xc9x\x8b@D?\xc3\rF\xc4?\xc7\rF\xc4?\xc7\r\xd6*\xd5\xea\"7\xd8nW\x1b\xdd\x05\xdbb\xa7\xdaj\xd4qo({\xfaT\xed\f\xb5n\xb7\x8a\x1bC\xd9\xd3\xc7\x06\\\xeb\xa0wZ]p\x0e8\u00f8\x89AF\xd8D\xb3]7\xc8,\xddV\x06E\x8a\x1c6'\xe7\xb0\xf4F\xe5[UC\x1f\xacp\xb4\x19\x15\xed\x168\xa1\a\u0145WK\x1e\xa8\xaf\u02f1\x0f\xe5\x82\xdd_W\xab|\xa6V9\\V\xc0M\f\xbf\xea\x04\"\xbb\xecuC]\u0277\xb8w\bR \xa5\xfah\x0e\xa8\xa1\b\x0f{\aU\x975;f\u0694\xb1\x9dP\x12 K\u070c|\xd3\x14\xcd\xe0H#/\x9e!\xb6\xb0\x94\n.\fu{\xc1H\u043f\xfd\xfa\x8d\xd7?\xd7+\x15\xe2\xec\x82g\xcd\n_\x1a8\b\b/\x9b\x96&\xf4\x81\xcfK\x91WtY\x8b\xdf\v\xe1\xc5\xe0%\xc1Jx\xc2B\xc7}f\xd5^\x9c\x99\xca\x1c\xe0p.\xd8\xf5\xe6\x82p\x12\xa4\xf3\x11\x9a\x01\u0307k\x06\xea\xf2l\x8a\x18\x17\x82\xceKY\xbf\xc1\x88\x19\xa9+as6\xe0n\xeb\xc0y\xc4\xf0\xa0\x83\x15od`f\xd0\f\x144a\x03:e\xb9n,x\u0138\u0273\x13\xeaR!a\x8a\x98.3E\xac,\u04ca\b\x9c\xeb\xfa\xe7\u033e\xa3\x1a \u0088.\x86\x86>\x12\v\xf3\x06f|\u0273\n\xce\xe7\xe4\xa4@g\xfd\x82>aES\xb5\x84n\x8b2}\xb9\x98L&Kk\xa1\xbep\u0146\x95\xd2\x01\v&\xb8`N\xd3%\x80\xba_\x9d%\x90/\x0f\x1d\x1e\b\u0748\xf6\xb9\xf9\x9c\u0739&\xb1Y\xda\xf0c4\x03\xe8\x9e>\xcf\x02\xcc\xc3$\xa7\xb3\x88\xca)P\x94\x9d\xe0UG\xbd\xbf\xb4\xb6\x16F\xeb \xd2V\\\xb5\x06\xb6\xe3\xeb\x9bM\xd3\x1c\xe2\x12\xb0\xb1\xf0\x19 \xb7q\x15@\xd7\u0594E\t\xef O\xe0\xbaV\x85`\x84\x1f\xb1\xb8\u04bf&\xd8\xe2M\x1e\xa1MP\x7fp\xbd\x9d\x16N\t\x87B\xed\xa14\xb3\xa3u\x9b\xddF\xde[\xa6\x983\xc8k\xe8\xf9\xa2\x16\x7f{\x85\u0740\"S\x81\x0f\x94\x89\xb2R\x01\xa3\xcd\xc0\x9f2\xe5h\x88V\x17v!8\x05\x89\xfb2\xb3?{\xb9\xb9\\_\u03c7\xa3\x99\xcb\xee\xf8\xbd\x15\xa4p}}EB\\\x87\x1a\x89\u0290\xa9$_2\u025e\xd8c\x98\x12Haxp'\xa4\xd2o;$\x19\x1a\xde\b\x89\x85\x1d\x06\xc6\x16\x12*\xadX\xbb\u04ae6\xae7k\xf7n\x81\x8b\u06c9b\v\xef\xa8\xc9#wo~\xees\xaf\xe6#\x1d\x12Nm\x1aMo@\x84?\x1a\xb9*\x10\x12`\xe8\"\xde\u01bdR\xb1\x15#\u05c9\xaaK#N\x1a\xa5j\u0338\x1a\x05\u0655\xa94\x954\xd1TF\x82:\xe4\xc8\x1e\xd4XTm:\x8b\xaaM\xf7\x9a\xaa\u034c\x01\xca\u02eb1\xf0`\xf2\x95\x8aMn\xe64\x99\xa3\x02M\xa6\xb1\xcd\x01\u074a\x1c\xd4\xf4,\x86f\x94\xd5\xc0\xc6\xcfQGY\xab5[\u05db\\,\xf9y:q\xd5Xp\x86\xfc,\xb3YS\x13\x94_\x90\xa0>O\f\x82_\xb8U\x06\xf8\xe9,\xa3\xb7N}\xbd\xb3\xa68Pe\u07c5\xbb\a<Pfg\xd5\xf9\x06\xb9\u011eW[\xad\xea\xf3+L\xacl\x87\x9b\xd5V\x1dQJG0D\xfcq\xa5\x1d\xf1\xc7z\xad\xd6\xeav\x14\x87\xdc\xeaV\xaa\x1d\xa5=\xa9\xb7\xdb\xc0\x04\xbb\xe8\xa7\xfd*LN\x04\x90%d\xd0+\xc4//\x80\xcd\xd0yN\xcfn%\xb9/\xf3\xcaE\xa5,\x8c5\x89NF\x93\xe8\xce#\a\xc5 \xa3|\f\x97\x94\x8f4S$\x00\x90\xcd\tv\xfd\xf9\x81\x16>S\xcb\x14\xb1\xf4A\x02\xbfH=\x14\xe6\xd4CA9O \u022ak\x02&\x8c\x8f\x98\x8ez\xbbXvK\x84=##.\x06\xe5<q\xb9\xae\xd4\xec-\x88\xb8\x1eY\xe3\xa9<\bS}= \x8d\x98\x02Pv\x87jT\x9b\xd5\xea\x15;Tv\x83\xbc\xe1!M\x16\u018e\xe8\x81\xfap>'\x88s\u05dc\"\xff\u0703ae\xd7c*\r\xee\xce\xe0\xa4\xdf\xd2\xfe\xa9\x10\xdd\x7f\x96\x11=A`\xb0\xa2+\u02e8\x81f\x8dU\xe3I\"#\xba\x05\xe3\a\x14\xce\x10\xba\xf8\xf4u\xc9/\u7eee\xb3\xc8\xe0IA\x0f\x96,\r\nuy\xd9\x13\xbe\x91)\u04cb\f\x81/\x9b\x8dJeG\xf7\xcbj\a\x00\xf3\x03\x1cE\xc54]\x1bR\xc7eQ\v\x9aA\x9e/\x17\x9c \xc4+\x96#\x01\t\xadg\xba\x97\xb1@nh%m\x03\x8d\xf5\x06\x11:\xf0E\x86\xd1\x13\x80\xb4|y\xcd{\xd1\x190\xb5\n\xab\xddv\xe5\x9a\n\a&\xe0L'N#O\xa6\xb1\x88\xdf\xda\x19;@\xfeg\xc8\xf5\xc3\xe0\x18\xf6I\x99.\xb4O%VDf\x18\xbds<\xe9Z\x8eo\xac\xc1\x83\u01e6\xa6A\xd7\x1a\xddN\xe5\xf9\t\x84\x93\xc7\xffnWQ\xd5V\xa5\xd3n+\xaaZo4;\x8d\xcc\xdc:\t\xd9;\xe2\t\xe5Z\xa0\x80\t\x91\xcbZL.#\xfe\xe0Y\x96\xba\xf8\x02\xff\u8abc\xf4\n\xff\xe8\"2u\x1e\xf9\xde\xed\x1b{\xb11@/\x02\x8b\xb1-\xca.\x9d\xf9\xa1\x887&\x9c\xdb}\u01a6p\xf0\xef\x15\xaaD,\xf8\x8f@7\x93\u0682\xdee\xcaY\xf9\xc9\x10o\xc6qp\xe3\v\x8d\xa3\xe7\xf3c\xb0\x01\x00\u4baf\x88\x8a|\xf1\x03\x8b\xfb\xae{\u05cf\x9c\xf0\x97\xba\x17y\xddsF\xed\xd9]\xff\x0e\xe6\x8e<\xee\xe3\xa2\u0479\xa6\xc8\xd9\xder\x19\xe5w\xb2\xb5\xde%\xd9\xc9~\xfd\x85l\x0e\x11Y\xcc\xd9\x1c\"#d\xce\xe6Po\x81\xd6i\x8cQCepP\x1a\xa6\tF\u0668V\x8a\x15Q#\x8c;Sj%\\V\xa0V\xf2\xf4F\xb3\n\x9d:\xc7\xe4\x01h\x85\x12\v\xc6E\xba[\x9d`\xf2\x85A\ue61a\x9a\xe3\x13\x05\x91\x13\xe1k\xe44\x89D\x80DIZ\xce\xeb:\xbb\xc7d\x98<\x107T\xd9\xd5\x02G\xf6u\xe5E)\x1c\x00\xcb\xceK}\xce\xe9\f&\x9d\x9a\x95m\xfa\x92\u0626\x1b\x1b\x86\x7fD\x8f3\x929=Vn\r9d\xf7\r\xe2\xe9F\u064e\xb0\xc0\xf1FF\xec\xc5\xe0\x98\xbez\x16\xccG+\x85\xe9\xea\x86\xee\x18;N\xef\xc89\xc6Y\xb5L\x0e4\r0\a\xdb?\xe4\xfe\xe4Fp\a\x14\x9f\xbf\xc6f\xba\xa3^\x05\xb3\xa2\x9eF\x14\x1dK\x0e\xc9X\x1d\xfd!h(\x1aC;\x13s\x1c[CB#\xd9Sf\u522e\x19\xf8\xf2%$\xd7P\xa3\x12U\x9a\u03e2W\xc8A\xd9\xffUC\x9f\xea\x86>4\xb5\xad\x94\x96yKk\x18.\x86\xdd\xd2b\xe9uH\n\xb2L\f\u00c8l$\xd5L\xab\xeb\xeb+\x9a}V\x83F\xd6\v#\xbf_(?\x80\xbd\x02\x82\xad$\x8a;\x89D\xe1\xa2t\xbe\xc3zG\fH\xc1\xb5\u0777s\xb4\xa9\x10\xf1\x94'\x85X\xf0\xa4\xc8\xe0\xc6\xfaz$\xaa\xf8\x84\x9ac\uc1c2\x1e\x83+\x8d\xd4\xe5\xc1\xf4\xa8\x02\xf7\xa3\xa9t\x9a\xd8\x7f\xe1\xf55m\xeb\xea\f\x91\xe1d\xa2\x1b\xba\x0f\xb6\xa3\xe4\xc1\xd3(\x93_\xac?\x00\x87%\xe0\xc8B\x93\x97\x9d\x04\xff\x00\x1b\xefe\xb0\x13\xa8\x8b\x8bX\x17\x92:>\x88\x00X75]\xd0\xdb\rS7\xbb)\x9c\xa9\x9d\x12\xdbt\x8fj\xc7Y\xef<\x1b\x12l4\xad%\xe4:\x80\x01Y\xf1\xfbkW6\x7f4<6\xb6G\x80\x97\t\xc6i'\xf8t\xeb\xd2I\xc6\xc4\x03\x9b\x05\x16\x9d\x82;5\xccA\tN\xe1\x96\xce\u01ce`\xc1\x94Z\xac\x04&\x9c\u0497N\xbe\x94\xe8VC/\b\xa7S\x9f\x8b\xf4\xac\")\xc1\xce\xf0\xa5\x7fU\xab|\t\xb9#Fm8\xbc\xe8\x95a[\xd0\x01\u04b3\xa8;\xb3\x15\u0299\xe4B\x83\x13\xb8\u0426\xa4t\xe7k\x15#\xa9d\x06\xa1\xb9^84)!\xfa,\x8a>\x8b\xa3\x8d\x7f\xde!\x87Q\x7f\xc2\x17\x1dr\b!\bD[\x9f\x1e\x1a\xf3\x85\xc3\rW\xf0\xbb\xdc\xf4\x16\x97\x1e,\xb9x\x1f\x05\xdail\x9f\xe8F\xd9\tt\x0ef\xa9\x8bD\xb4a\x86r\x9d\xcc\xf3\x19\v\x9b\xb3\xda\xfc\xf3Yr;\xb5\x8e\xfa\xcc\x1b\xcf\xee-l\x05x\xbf\x93\a\xac}\xd2G|\x11\x0f\x8f\x1e\x9d\xebFt\xe0Z\xa5\xe0\x89\xed\xb2\xf0sb{\x11\xa1\xbb\xbb\xac\x0fQt\xee49\x9f\xfc\xbay9\xf4z\x97\v\xad\xf6\x8a\xb6T\u06f7p\x93+\xc7?\"H\x10n\"-\x9bLCp[\x85\u04f5\xd0k\xdf\xd4\xe8 \xf0\xddP\xe0\x19#^\x9e\xfa\x01j\xce\xc0\xac\xb2\xb3\xa5\xd3P\xf8R\x01U\x8e\x1d\xdbf\x9e\xb1\xd5\xcbE\x1b[H\a\x86\xce\x05\xb3\xf3U$\xe7\xec\xb7\xd3{\xad\x18Zf\xa6\x94\xa7=\xdb6\x9c\xa1^\xd0;\xc7 \xfa\x9a/\xa5\x06\xfc\xadcik\xb9\xba\xd7\u05e9\xba\u8097\xfd3\xc6\xe1T\xd5F\xfa\xf3\xf3\x99\u07ffa\xa4/\xcdD?\xbc\xf9\xa2S\x92B\x91Tsp\xb9\xc8\xe5\xddHY\u01bd\x05f\xef\xee\x02\x9b\xf8\xa0\x80\x9d\xbc9\xcf\xe8\x15.\xf3(\u07bb\xc2Z\x97\u609b\xa5V\xd3\xd4B\\X\xe1e\x115\xe1\xef\x1ce\xa97\xe1\xc4?\xee\xf1\\\xe4&^s\u038f{G\xc7s\xb2\x9a\xbf\xb9\xa2\xf1\xfaB\xe3\xc0\xf2\x1e\xd5\xe2>\xe4w\x0f\xbes\xe4\x13z\xdc[\xec\x80J\xc1N\xccI\xa2\xba\u026aHV\xebD\x82E(\x12\xa0&\x8a\x86\x14kGNWP\xc2\u022a\xb40%\xa8\xfd 9Q\v\xa5\x8c~\xa4:\x02\x01\xee\xf9%\xc3Y\xf6\xa4k\xab\xdem,X\xa3[\xddj\xb7\xb3`\x8dnvj\x8d\x05ct\xb3Zo/\x1a\xa3\xab\x95J\xb7\xb3`\x8c\x8e\xa4\x91\x9c5:\x922r\xd6\xe8V\xbb\xd6M\x94T\xb3g\xd3L\v\xef\x97\xf0\x12\x01\x1c\x829M\x85\xc2\x15F\xbcmT\xe8\xe0\xabqF\x86Z,\xb1\xf8A\x86\xc5G&\xa6\xb2\xed\xbe\xe4l\xbb\x1b\x1bFp\xe4fY|7\xc1s\xcf\xe4\xb8\xc7%\xb7\u07e1M\xc7\xc4W\xac\x8e\x13\xa6-0\fb\u00c6\x88\x064\xa2\xf9\x1e\xa8<\xb4ec\x117\x97W\x88W\x9er\x7f\x8a\x17f\x1d/\x89\xbe9\xe2\xa0s\x82\x86\xa2\xf4\x9d.<\xdeH\x8e.O\u066c\xa7\xa9\xab>4\xa2\\\u07d6\xf5\xe4\xa3\xc5;=\x82)\xf54r\xc9\xd9P\u0770\xa5\xba='\x8b\x19\xa3k\xae\xb1\x9b\xb0\xe5\x1d\x1b\x84\xce\xf5Q9a\xc9a\x1bD\xcd\xc3\xff\xafa+\x9c\x15\xef\xd6+\xff\x7f\xb4\x8d\x914\xb5\xca\xf9\xe8\xcau\x13\x0f\xa4\xe80W>`0\x01w\xba$\x13\x9e\xa1\xcaf\xcb0\xf6\xdb\x01\xbc\xbc\x96\xdfE(q\x8e\xc9\xd5K \xf8\x17\xbc\x04\x1a\x9dJ\xf5\x05\f$g\x99%\x10\xf9\x84\xe6\x96@\xbd\xdbmu\xaf\xe1L\x1eihrk \xba\xd6 \xef>\xa4\xfc\x82\xecT-3I.1\xc8\xdcc\x935\xa7\rc\x1b\xf7\x95\x16\x93D=\u07bbJ\x94\xcep\xef\x99s`4R^g]\x8as\xeal\x18bV\xe3c\x99B)\u0255\xbft\xda\xf1\xa1\xaen\xd4\x16\x05jl\x91Uc\u01dc]p\x95\x9aZ3\b\xcf[\xc6`\u0666\xd61]\u0107]\xd2\xdaAWm(e\xb5A.\xe3\xe3\x1f\xd8Y\x14\xb0A\x14\xd6\xd7\xe2\xdc\xeb\xeb\xcb=]_\xcf\xe0n\xacH\x12\xc8+\u07fb\xfd\xaa\xe6x\xa5I\xf9\v;x\xe8Fwt\xc3\u0419\xd1\xd3E\u02bf/`9\x05\xd1\x7f\xcc\xd9\xd0d\xf13\xe9\xc0\x11G\xcf;Z>\xdc\xdb\x15E\xe3\xf3=_H5\xb2q\x8e\x983\u02cf^\xbb\xe5\a\x813pYi\x02,\xfcft\xd9X\xc9\t\x82\x90\xed\x94\xee\x8eYiJG\xeaB\x00\xa5E.\x01g]\x8a\x9fj/\rBQ\xa2\u905b\xb4GD\xdb\xda*\u077b\xfd*\x16\x054\x80\xb3\x8ev\xb9\xb47f\xd6iI\x8c\xa9(\xcd\xfc\xb0D9\xc3\x13\xd3T\b6\x99\n\x10x\x85\x8fM\xa9\x1e\x95\xa2\x1e\x955\xa3`*br\x89\xba\x89\x85i\xf6\x8c9 Q\xd9\xe7\xce\xc8\xf1\xd6\x16\xc0\xa2b\xe3C\x03\xc1\x02X\x94\xd7~\n\x1c\x8b\xfbA\xb0\xa9\u0294\xf4\xbd\x9b\xb7\xef\x18Y\x10\xc10\xe3\xc4\f(T\x14\u044c\x92\xed\xb3\x00\x87\x89\x97'\x96D\fV=Q\xe9PR\xd0=\xa2\x19)\xc0X|\r\x83\x1as\x80Q\x96\xcf9\xb3D\xe93\xea\xf6\x96M8\xeb\xc7}w\xb3\x0f\xeeN\x9b\x9f\xfdL):\x85\xb7\n|y\x90\x05\xc6|\xd1\xc8<\x9f\ub1b1\xbdb\x85\x05!\xb6\xaa\x19$\xcepg\xca,](\xcb\r\xa1\xea\xe4\xa3z\x1d8\xc8X\x89\x981g\xf0\x9eV\x94\x81\xac\xa8\xdeUA\\\xc1(t\xe9K# \x96:|\xc8\xd0\a\x02ZWwd.\x9d0\x9c\x16\x9c/\x94\xb2\u061b\xb2\xe8\xd8\xe1\xb4\xe8\xd0\xe1\xaa\n2\x87\xd7{Z@',B\x9d\xf4P\xe4\xea\x03\x9cpfs\x1e\x9ff\x1c\x92!\xecK\x05\xd0\xe9\x15\xb9\xf2\x1d)\xcbU\f7\x12\x93@\x92\xcc\x14\xc9\x1b\uf3b7\xd1\xe7\xc9\xca\xf9\x8d\xc1\u04c0yUE\x88>Q\xda\xc1\xc2\xc9<F\xb4\x92\xa3\xf0\xda\xf7X\xc9\x1f\x96\xb4\xd4\aj\x88u&\x8e\xd1\u0455\x86@\b\"\u74d3\xa8\x9b'\x8a\x8ajYO\x91\x8c\xa4w\xb9\xbaH\xa1Ak\xf96\xe4X\xce\u06c9\x04B7\vG\x8d$\xe9\x06\xb4\x9c\x15\xd4s\x19{\xe8\x93\x0f\xde[+t\xafz\x85\u0609\x83\xdbU\x0e^\xf9\xf6\r)\xd1AL\x19:\x95=\xb1uM\xf7\x92\xd7\x0f\xde<\xb9\xfb\xca\xed\x9bo\xbe~rp\xfbvd\xcd\xceG\x9e\xec\xf6\xef\xee\xbd\x12\xb9\x15A\u049d[\a{In'\x17\x95\xc9\x1b`\x02\x1eJ\x89\xf3\x86d\xef\u0543\xbe\xfa\xed\xaa\xdf'\xbb\x9f\u01e0E\xb2d\x04\xa3\xa6\xb9\xa8]\xbcZ\x17\xe2\x87\x10\x0f\xeb4\xce8\xceD\xa4\xd9l\x92\x9e\u02cc\xee\x0fC\n\x10]\x18\x86\xbfw\xd5Q\xbeQ\x86\xfbj\xb7\xab\x15Tk1\xceO<v~\x82oqx'\x8cs\x8d\u0422\xe8\x93\x01\xb4\x89\x8ePq\"\"\x1c\x96\b\x96#\xe3\xfca\x9a\x04\xeeB*\xbf\xab\"\xb1w\x1a\xb12\xa1\x93\xc1,\xe3Y5]4j\xf8\t\xf2\xa3-<`\xdc\xc1\x97^p\xc5)'\x82\f{\xc4V9eeO\x99\x8e\xaft\xc5\u029e\x9f\xcbg\v\n\xb3M\x16\xb3\x85\x85\xd9\u0392+\x8d\x97\xbcd+\x85^\xb2\x95\xac\x97l\x05nN\xcc\xd1\v\xb7\xb0\x95\xd1'meI\xaeX\xab\xe4\u06f5\xf2\u0128\xd9\xeavj\x9f\xccq*:.\x90\x93\v\x94\x916/\x197*\xadjN,8B\x11\x16\x1c\xde\xf5Z\xb5So\x1a\xc7YG\xa8\f\xf1\xbf|\x10\xe0\xfew\t\xd4\x13o\tp\x17\x15c\xe9\xb9lQ\x16\x9cz\x01\x9c\xfc\u01d7Y\x12\xb5\xa0n\xe8\xbc\xd8\xd9:vxe\xf3\xc4x\x97J\xd0\xf9\xfdB\xbb\x1bW\xcext\x9e[#\xc2 \f\xdeSa\v\x9e\xff\xd7l\xb2\xd8\x11=\xca\x11SV\xc7C\xc7\xf2(\bn\x9b\u0445\xa1\xe0%T\xef^s\x0e\x13\xd0(J\x96\x9dE\x9c\xb1\xdc$FB[n\x16\x95A>'\xdb\xd5k\xf5V+\x95\xed\niA\xc9\xcfY\x12\v\xbd\xf5\xb5x\xc3\x1d\x96h\tpU\x0f\f\x8dp3\xf6\xdc\u01c1G\x02Mf7\xf6\xd0b\xcc\xc1\xe4\x14\xdb-I\x80H\xe2\xaf*\xe4\x13\xbe\x11?Ra\xc46H\xa2-\x9et\x8a\x8b\x11\xc53\xaf\xa8\x8c\x92\n\xe1\xc6\xc6\n\x8f\xf4\xc21\xc7\xc7z\x13\x8d\bX\x00\xe2+\xb8\xd7\xd7\xe3\xdf/Ww\u0606\xe6\xf3\x92\xb6!z\xfc\xc8\u06e8\x1eg\x12k;lClh\xa4\xa4Ei*\\\xd2zlC\xcc\r\x02'bB\xd7\x06\v\x1a\u054c\xb9\x1e\xa4W3\xc5h\x03\x8b\xeeE\xf0\x06|\x8fb\x8cI\xd0\x04\xc4\xf4z\vc\x94\xe8\x9e\"\x02\x8f\xd6hY\xad\xe3\x04\xcc\xe0N\xdcnw\xeb\x9f\xcc\u0168[o\xd6\u0531&\x1d\x19\x8c\by\u06edV;\xe7b\xc4V\xb8V2\xce3\x9e\x93\xb8KEM\xc1\xf4%\x0e\x94~\x86\x7f\xa2\x8a\x9f\xc1\x96\x9f\xbf\xf3\x83g\x9d\xd0W\x8a\x96\xfc\x99,\x04\xf6\u0091\xacv\xab\xb5\xa0S\x89hqN\xa7R\xadTZ\x8bz\xc5\bPg\xa9\x9e\x054\x8b\xb8\x19\x80\xfb\xcc%\x90\x9b^e\u1c88\u4dbd{\u07a9\a\x97\xef\xaa\xf8\x8c\xfb\xea \u07be\x92)\x06[\x86:H3)\xe7\u0677g{g\xc2\u009f\x11/~|Yq6\x19\u0458E\xb2\x95\xae:X|\x11\xb3\xae\xf8\u05f3\xf8@\x89\xa1\x0e\xc2`Tz \x18\x0f5\\\xa768\xe7\x90\xc1\x0eurd\xc5\b\x15\xd3y\xcd\u04ca\x9e\xf9\xcc#.\xb9>+\xe0\xb0%\xe0\x18\xea\x9c\xec\x95c\u027d\xcdb)\xf2\xba\b\xa5xK\u02b6\xe9\xfd2\xe1\x14\xb3\xe6\xcf\u0183\x85>\xc4\x1d\xe7xz,>tEP\x00\u04de9\xf6\xe7\xc2\n|\xbelw\xa6_%\xf2\xe0\xc6<\xff\u01c0\xcc?%\xfe \xf0\xfe/\x84=\xb1\xfc\xf6\x9c\xd8\xe3D7Q\x19+\xb1\bo\xc2\xf9\xe5b\xd1/g\xd4(\x96>\xf3<;:\xd7q)c\xbe1j/\xbdl`[\xb9c\xa3\x0e\xc3\\\u0334\xfa$Y\f.;b\xc6\x16N/\x8a\xa2\xcb\x10T\xed\x02v7~$b\x03\x10\xb8>\x80\xce\xd2_<]\xae\xdc\xfc/Ugz\xfe|y\xf4'\xbb\x9f\xbf6\x00\xb48_\xfa@\x02\xff\x04@\x88Jr\x9d]\xa3\xd3\xc0\xd8 \xffq=\u0780\xba\xee\x81*\xaft\x19.\r\x04F,{S\xab\xbd\xdaO-%\xd4\xc4c%+\x957W\xebk\x12l\x04\xa6'Fb\x18\x81\x93VKWT\x00\xbd\u0523\x93|\xddn\xfb\x05n\xa0\xf7s#\xabt3\u071a\x9f\xba\f\x81\x83\x90?\r\x0e\x1dW0\xde\u3a534\u0793R\xe9>\x7f\xbb\xc13\x84\xd7\x05\xb9g\u0171\xb1\xd5\"M\xfa\xd2\xf1&<&\xed\xe4\u8c88\x8e\x9a\u059a\x8d\xf6\xf3\x9d\xa3{\xb5\xff\xf9\xf8\xcay\x9fDq\x877^\xbd{p;\u009b(\ued5b\xfb\a\x91\n\f\xee_QC\x8e\x15\xad\xca\x1dH)\xbdTT\x04X\xa5\xfbJ<\xc9-\xa2.I~\rn}\\\x94\x15#\xe7i?\xf1\x89\x8eu\x98\x18BuT>a\x88m\xa0**\x9f0\xc1\xab%\x83$\x1a\xda\u05f6W\x1f\xba\xf3\v\xb5&\xee\xf5\xf4F\xa9N\xc1\\\u04ef}\xd6\u06502\x1bL9[\\4\xfdC\x14\x12\x16\x15Lp\u05d57\xea1\x04\xa8\a\x8f\x13\xcc\xf3b\xf1':p\xadiW\xf5\xc2Y\xee\x05\x80\xb9\xa7\x96L\xad\u0569U?\u065ai\xc6\"\x8b\x8eH\xac\x16M\x03\xac\x96\xea\x9c\\\xa7\xdb\xe9\xe6V\xcd\n\xc1+\xf6MKd/\xbetj-/te\xae\xccJl\xb1=G\xc9b\u0429O\xa0\xc7Zyz!\x92\xb2\xf2\xa7\x17\x14\x19v\x13\bD>\x18V\xee|\x9b[\xce-\u072b]\xb9\u2953\xaa\xecS\xcf-\xb7\x9c[\xedWW\x14/\xb5\u2280 <\x83eB\xf7WO1N\xe1\xf2\x81\xbb2\xe2T\x81\f\x86\xc54\x92+u\xb9r\xfbQ\xb7\x13y1\x93\xb30P a\xcf\xdc\xe9UO\x88\x9f\x89\x01<\xcf)qnxz\x80\x9b\xfc\x91\x06i\xf0,QL\x88\xb9At\x1f/\xc2\xdc\u0434\xe4\xb8\x1f\xa0\xf1\xf5\xd0\xc8\n9\x8f\x8f\xc9X$\ne\x88\xe94\xf6j\x8c\x8c\x03\xe7c*\x12j:F\xc2p'\x9cL(W\xba~;\xbb\xc0\xba\x95\xfa\xe2\xa6\x14\xe1\xe1\xc2E-\xc8\x03,\xbc\xcb\x12a\xa7e^!\xb4(l3\xe6dzE\xae\b\x95\x8c9\x19\x9a\x8b\u05daG9\x85\xc2\x12\xa0?\xc2 \x99\xabX\x14&\xb8YL\xc0\xcb\xd2\xf4\\,@\u04c0k\u054b\xa7z\x89<\xd6\n\xc9c-K\x1ekY\xf2X\u043d\x1b\x9e\xee\xac\xc0\bu\xf8R\u106d\xae\xcc{N\xbejm\b\x96Cf;\xc2\xe7\xd1-\aH\r\xaf\xa9d\x83\x9b$\xed\xe4LX\xb0Dx#\x1c\xc8{\xe0\xa0B6\x83\x16\xcft\x03\x8b\xfd\xb98\xa1W{t\u0457\xab;t\xb3\u06ab\x00\x8aU\xb7\u00d7(\xbey\x17\x1c\x85\x9b\u056coW\xa8\xce\n\xb9\xe6\xe2\xc1#u+\x9e\x97^\x8b\x17\xe0\xbd%x\xfa\xe4\xce,\x10l\xa2\x83\xda\xca*\x0f=2\x84\xa37y\x8fy2V\x97\xa7\xc4n5\xb69\xd6A}e\u00fb\xe7\xfb\xea\xe1'\x06o\xb9\u00ab\x88\xc3rn\t\xa6\xf7g\x9c\xad\xaf\xaf\x01\xbe\x9c\xc1\xa3\x82<dZ\xf4{H\xdd \x0e\xe8\xae9-'L\xa6\xee\x923\xc3 \x93\xf5\xf55'x\x9d\xbe\xaeO\x8c\xf5\xf5\x89zx\xddEv\u038dF\xe4\x92\n\x99\x18\x06qQ\x1bXm\xbe\xc0a\xc9\xecq\xdc\xe8j\xcex~\xb3\xaey\x19\x87'+\xb9<\xe0\xd2f\x83p\u052b\x10\xc7\x1b\xfa\xbd*\xbc\xe0\u04eb\x118m\u046b\x13e\x9ch\x80\u0164\x00\x81\xbd#v,\xe5fu\xae\x9e$\xf6G\xaf\xe2\x13\x87\x8e\xc9\x179\xde\x14a\x04\xf1\xae>\x9c\xe6\xbd\\\xdd\xf1\x14\xc2P\xb3\xbaM_\xf2\xe2Cjy\x84\xa1\xc7\xdb\x1c\x9f\xb2w\xd4\r9\xca\xc2b\x1c\xb1\xe3\b\x81\x04\xf1\x13eu\x80\xc7G\u0416\x12D`\x0f\xa2s\xac\x90\x80\x1e\x1a8\xd6\xc2,\x98\x82y\x00F\x85Y \x01s 4\v\xb3`\x8af\x90\xcb\xcc5\xa1`D\x1f\xa9\xa3\xf4\xa4\u056e\\\xf7*\x93h\x87\xbbs\xf0\xea\xc1\xde\u0743\xfd\x93;\a\xb7\u07c88x\x1es\xf0\xb7\x0f~\xfd\xde\xc1\x9d\xbb'\xbb7\xf7?\x7f\xf2F\xff\xd5{\ay\xae\x7f9\xfd\xe4\xf6\xc1\xdd\xfe\x8d\xd7O\x0e_\xed\x7f./\r\xe4\xf2\xdex}\xef\xd5{w\xe2g\xa4\x9c8O\x7f\xef\xee\x8d7\x0eN\x0e~\xa3\xff\u06adW\x0f\ue73cv\xf0\xdan\u0527`\xb1\x9e\xbd\x9b\xaf\xdf=x\xfd\xee\xc9\xdd\xcf\xdf:\x88\xe4\x88$\u01dd[7_\xbfs\xb0\x9c\xc5%\u0268a\xb0'o\xf4o\xdf\xe8\xef\xbez\x90\x19\x9bE\xee\x1c\xdc]\x1a\x18\xbe\x91\x05j\x9e\x9b\xb7\xa3=U\xe9\x01\x9e\x95o\xb8\"_\xd4\u0618\x04L\xdc\xc9\xddt\x1e\x99\xe6\x03&\xa2k\xc0w}{\x86\x97\xa2G6zL\x11\xd4\xf1\x16\xd3\x0f]:\x8al\xf7\xf9\xd27<\xcb\r\x83\xf8]\xa8\x11\xa4\xf6\xf1\xae\xf5\x83\v:\x99\xba,x\x8dM\x06Q\u02f3L\xd9\xe8Rs\xb8\xd3<z\t\f\u04d4\x8b\xceb\xe2\xb9\x1a\n\f\xe1\r\xca\x1dx\x971\xed\xf5\xc1\xf2x\xf0\x85\xa6T\xb9p\xa1\xfc\r\xae\xccsB\x1c\u03f9\xba\x9a;E\xd5D\x9d8M\b\x9c\x06\x97\xe9\x9f\x04L\x9c(w\xaf@#~&2\xf2b:\x81\xbb\xd4O\xa2\xfb&\xe9\xaat\x8esq2t\xc1M\xddY\x95\u02c9\xa7\x00e\xcc$\x0f\xc5i8a\xd1<\x9cLp\"\xd0\xf3a\xa9\x9e\xc8I\xefD\xa9\a\xdd\\\x0e5%\vY\xac\xa5q\x9e\x9cE3\x13\x8fjz\u0168\x11\xb2'\x91\xc5e\x18eT\x8e\x16Wf\x1d_\x995\xcc\xde~c/\xdf~\xc3S\x991\xff\x02\xc0=\xf4@\xf3\xe8D\x9d\xce\xcbK\xb2\x93\ucd84\xad\xe0\x8d6`\x9c~\r/\x14\xda.\x96\u0763\x1bp\x05Is\xf6\xbc\xb9\xbaE\xe7\xac\xf0-\x8dgVN\x9fY\xf9v\xc6\xc9\xe2\x195\xe3\xcd 0\xe6\xedb/\x93\xc2&\x10H=\x9e\x85\xcf,\xdb\x10$\x17\xb5\x13\xbdw\x0f+\x9a\xf8i\xf8\xf5\xc5\xe63\xca\x05li\xa1\xf5L5=N2\x95\xf4\xfcl\x97\x06\xcf\x05\xd5\xf0\x99PM*>_]1\x0es\xb2\\\xbb[\\{\u03cb\xaf\xa3\xca\x01\xf3 \u06c0ZX\xd8B\x82\x9b\xd8\xcc)\x9b!\x14\u03e8\xbb\xc2\xed\xe5R\x95\xed\x89\fV{\x04\\\xf49\xf8\xe6#\xb8\xa0\x99\x8bBD\xc4\xf1x\xc9x\xb0\xcdh):\xbe\xa7\u0538\xb9\x86\xa7i\xc388\x11\x0f\xce#\x8b\xe5p\xbc\xe4\xe4:\xed\xe6\x9a\x18\xaelb\x8ew\u06ad\xaa\xef\xb5kT\x85\xc7\xf6Ump\x1b%Tx\xfa<\x15\x8e\xf3\x15F\x98\xa3TQ\xedz\xbbv}\xdb\xff\xaa;U\x9feM\x8f\xae\x9fx\xe6\x11\x85\xe8&\xd4g\x9a\xd3#w\x97\x9c9=\x12\xc4\x17\xcc\xe9\xe0\u06e0\xfa6#\x03S\x9f\x81H9y\x01U\xfd5\xee#\u0357\xcd\xca\xd0Y\xfbT\"\x16\xef\xe8Vt\x85\xe0\x95\xd7Zg\x9cs\x9c\xf82L\xaaN=\x06\xea\xd4ch\x06\u064b/\xd5\xc9\xf9\xe4R\xccp}]\xd9\a\x83\xf4\x89\xc0@3\xaey\x1f\xaa\xb3p\x1fj\xa8\xeeCu\xf3\x16\xbdK\xa8\xb4\xe7\x91\u0713E\xb0k\x85\xf9\xab\x1a\xe3x\xcd \xf1\x9bBq\x96\xcc\x1bC\xea\x96\xce8!\xb9\xb3S\x11\xe0\xecHI\xf6U\x8e(%\x13\x05\xc2\xffvr=+HHg\xe0@\x1b_\x02O\xc90'E\x16\xcd{\xa2\xe8@\x171\xa5&SW|\xaf!d\xe3\u02ff!$\xa5~Uc\x01\x94'p\x9c\u027b\x01\xfe\xd1^\xf4PO\xb8\xbe\x1eu=\x97\xb4O\x05\u0562\xa7\x82\\su\x0e8\u0762\x12\u04f7\xa3\x82\x93\xe4\xb0>\xf8\xff\x1e\x15>%\xa59\xd1\x03\xb2\xda\xf16 \xc84\x02\u0134\x18\xf7\xdc\xecld\xeb__W\xe6\u0215\x19\x8a\xd0kIe*\b\xea\xffH\x02\xb8\xc8\xfc\xb5\x88cl\x19\xc7\xdc\x02\x1c;a\x9e=\xf5\x1dOd1\xcd\xcd`Z6C\x84o\x9e\u00b0x\xe9\x90\x1c\xb0\xa1|\xd1$ \xc6^\x03\u035c\b\n\xceu\xd1l\xae\x94\xa1=\x0e\x7f\x9fu\xb3R\xac\x1c\xf0\xf2j\x9dE\xa2\x15\xbd\xbf\xa0\x1b\x05j\xbd\x9c\x0e\x81\xc7\x17\xdc\xf0\xd5\x17\u0720\xa5<~\xc2\xc1\x8b0\xd5!A\xd4\x05\xbc\xee\xcaHo\xce\a\xd7\x06\xa2q\x16\xf8\xee\x19\xb3\xef\x84\x03\xc1\x19\v4\xf2\x8c\x97\x1f27\xb0\xe6\xd4Z!\xc0\xd4<\x12$9\xae\xe8\x10?\xe3\\\x97\xcf\xed\x038\xe7\xa4^o\xbc\x88\xabX\xd6\xea\xd6\xec\\ko+\xf0\x14S'F\x89e\xea\xf1\xa1\xd1\xc4I/6]L\xcd\u2ef0a|\x03j\x9d\x06\xf1my\u0269B|C\x01`{\x8b\xa2\u0790\xebZ\xa2\xba\xdcSw\x173\xae\xe1\xdb``\x92OnMp\x8b\xcf9*E\xcb\xeb~)i1\xba$zj\x86\u05f9F;\xb3\xe59\xf1\xf5\x00S\x93\xc3\xc614y\xc6p\xb7\u053e\xed\x9ci\x04\xcfhN\xe7d)u\\\x8b\xfa65\x88\xbawwx\xbd\xb7 \xb2\x1d\x19\xaa\x8e\x8c\xb3\x1d\xd1>\xfdi\u0386@\x86\x87;\xd0@\uf28e\r\xe7Q\xdb\xe3\ubd5d\xb6\x1c]R\x10&\xefP\xa4\xcd&\xee\x10\xd0:\x82z\fd\xcfJ\xc9^zeY\x98z\xdd-v3 \u0245\xa9\x97\xfe\xb47\x8eXiAG=MK8y\xc5\xd9\x0fI\x8c0=\xaah\u0594\f\t 3\x1c\xea\xba\xcbg7\xc4\xcdP\xf4\u05aa\xca,\x14\xff\xbbz\xea\xa2\xd9\x01\x9f\x8bV\xbb\xfd\x02O,]\xfc\xf3\x9f\xf3\x8e\\>\x17\xee\xc8G\xe6s\x86q#u\x95\x99Z\u0739\x9b\u0322\u015d\x17W\x90\xbd#L\x8dK(G\x97\x8cZ\xd7\xd3Y\xf2\xacPz\xd6\xdb3P\x19\xac\x96\x16D@\xa6\xe4\x146\xc5S\xd8{\xe8\x15P\xe4\x11f\xd2\xf8\x1cv\x94\t\u91e0|\xc4\x04\xa0a$\x14\"d\xd5s\x96g\xd1.\x85\x87M#\xa1\xd1\xd8\xf6w\x9c#\xff\xd8\xe4=\xc7\xe4\x04_\x17Q\x04=\x92\x11\x9dy\xd1E\xfeB\x8fj\x89\u07ec\x88\x0e\xac\xab.\xe1\x13@\u0628k:\xf1\xbb\x9a\xd1=\xee\xea\xe1\xc3\x04\u0162jL%c\a\xf1\x1b=ntl\u06da\x13\x9a=\xdd\xed\u0167\xbb\xe3\x9a\n\xcew\xab\xf3\x1c\xe9\x19m\x92\xd3\x06\xa47\xf2\xc6]\xf0\xe0\xfa\xdeh\xf3\x12\u0263*\x80\xdf\xcf8H\x8e\r\x01\xeeg\x1b\x03\xc8'\xaf\xddz\x8b\x14\x1c\xcf9\xa7\x06\x17'\x9e\x9c\xc0\xf4u\xed\x867\r\x85\x06X\xee\xeb\xdamx\u075b\xb8\xf0s\xcf\aV\u0642\x9fT\x9dcB\x15\xfb\x14\"^\xa3\xfc\xd4F\xf3\xdf\x1a\x9e\xed\xf6u\xedW\xc3\xc9\xf4\xae\x0f\x8b^E\x8eM\x9dg\x1ewa\xc0'j\x9aQ\x16\xfe\xab\xfe9\xe3{4`:,\x0f\x1cD:Cdb\xce\xf0\xa6\x00ZN\x1c\xa6t\xa3\xc8\xff\xa6\xe8\xb6\xf6\x1b\xb6f\x98\xa6\xe9\x00n\xe4\xde\xc2\x19+\xb8\x9d\x91\x91i\xef\u062a@\xf2\b\x8ez\x90)\x9e\x98\x83\xd5\xe4g)i\xdcX\x95\xa2X`Ls\xe0\xd9\xf3\u030b0\x06\xd1\xfe\xc7?)\xe9\xc0\xe4\x93\x12>\x98chK\x15\f\x89\x12\u034b\u07e5\"\xce1pm\xa3\xf5\xf5\xe5.\xb5\xe2]\xb6\x9f\xb9-z\xa9\xfe\xb0\xb8\xdf\xd3\xe4\x8c-/\x94v\xae]\x91\xe6\xd2\x01s\xe3\xae\u070b@\xdd\xd3\f2\u06b9\nXZI#\uaaae\xdeb6\xb7\xb8\xa5 q\x8ef\x17B\u00e3\xb3\x0egvO\x8b\x7fiJg\x97\xce7\xd1@S\xbb\xa9z\xa8^E\xdcDL\xd9L\xb3\xc4\xe4-\xbe\x94A\x85@6\xf0\x0f}+\fzk\x15\u0636^\f\x1c\xb7\xe27\u046e\x01\x8e\xcf\xe2\x7f\xcf\a\x0f\xe8%\xac\x7f\x97\t\xd6\xd3<v\xbe\x99>\x86\xae`\x91\x86\x17\x1fK_\t\x9b4K1l\x10\x1c\u7ea1\x9f\x99\x93\xe5\xb7;\xceV\bg\x8b\xbd\xb7\x88\xf2\xb2\xec1\xe40\xd4\x1b\x951\u05ed\r\x18\xe5\x8c\xe3\x82\xde\xf9'^\xa7\xd8\xf2\x8b.T\xfb\xff*\vU\x19t@\xf1\U0004f016\xd9eZ\x84f\b\xe2\xcd\xc8zr\xdd\x05x\x8ebC\x01\u0189O\x84q\xbd\xe7A/6Y\x952\x88\x12\x1c\x83h\xa5W\xee\u07bdU\x82\xa12ODG\xe6{\xb9{\x0f\xd5NY\u0488\xf6\x99\xe4\x8c\xfa\x18\x02Ft\xe3\x8b7\xd7\x0f\xb27\xbe\x90v\xab\xd1\xfa\x84\x02'\u02ac\xca\u046fQk4;\x91\xcc\xd9l7\x95\u03297[\xad\xaa\xba\xd9W\xefvk\u0445\xf8\x8df\xbd\xd6V\x1c1\xf2\xe3\xear\xdfn\xab\xddm\xa5\xc2\xe6\xe5^,\xe5e\x9c\xfe\xe0\x11f\xc0\xf8\xcc\u04d9\x19\x8bb\xe6\xdade\x9a\nz\xcebL\"w\xf6\x82\xa2*\x0e\xd01's9~\"\xb0\xc65ZIR\"\xfb\xc0Uw\u067b\x99\t\x02\xe0\xf9!;z\x11)C\x89\x1491#\x12)\x9e}\x95\xce\xf2K\\\x91\xa8p\x96\xd1\x03d\xb9\xa60/4XKBC\xce\xf59\x16\x19D\xec\xb3\x01\xe1\xf4b\xedB.\xf9j\xd6u\x91G\x86\x9bA\x13\x13\x8fRe\xe7U\x10\xc6\"\xbf\xe9\x9b\"Q\x94\xc5\xd7<\x01{\xb9\x18{\x9b\r5#~\r\x1eN\xd3r:a\x82\xf1\x00t\x8a\x05\x91\xf1[+\x8e)\nhj\xcc\x1fN\x8a\x05x\u02e5A\x80\x96\xb8\xb4\x03\x9b06mN\x9e]$\xdbRA\xfe\x98\x8cL\xae\xa0\u025ea\x10gg1\aO\xf6\tg\xae\xf8\\c\xb9\x96)O\b{\xb2VJ_\u0488O\xb4/i\x05\xbd\xe1\x1aYQS\x9a\xa4\xddJ [\xd2H\x81\xd5\x02\x19\xf4\u8b8b\xe4\xf0\x86\x88\xb4\x15\xa9s\x9f\x1d\x990\xa2\xf3\xbb\xf7\xbd\u00b3\x1bYoE\xef\xe5\u028e\xd22\xb2\x8d\xaaQ~\xe0;\x9e\x0e\f\xe5\x86\x00\xe2\x1eG@Esx)P]\x97\x06\x83\xaf\x19 \xa3\\\u03af\x1arJ\x88'\x19B\x1c\x93\xbc\x91y6'H\x04\x9f\x9fr\x9c_\xf9\xe2QBMr\x94#\xa2&y\x05\x05\xea,\xf2\x84C\x11\x98\xc5\xf3\xa2\xdd\xce\x02\xe5\x88t\x169\xfdDdD\xcb\xe9'\"\ns\xbe\x82\xc2\f\xf3\x14\xc6.\xa00J\x88\xe5\xea\xbd6Eb\x92\x1b\xe8\x9e\xe5\xaf\b\xe3\xadd\xfd\x14\x17\xbc\x14\x93#_\"{\x03\x9d \xb3U7\u041d\xe9\x06Z\u02b8A\xb4%\x97!\xad\xd8Z\xc1#\n\x06S\x84V[\x90\xb2sV\xdb\x12/\x0f}n\xb1{\xcas\xda Q\x91\xf2R\x13:\u00fe\tSKv}\x9fh\xbd\x8ca\x83*\xc3\xd0RG\x97\x1c\x82\x9e\xd1[\x1a\xf7\xd6y\xbe\xde.\xb5\x83\xb6\x87X#\b\xaf\xf3\x90\xcb\xd4\u03be8\x18\x9a\x1f\x8cc\u0317\x873\xba\n\xee\x89\xd2#\x19\x88\x17\xbf\xd9\xe5\x9bbi \xaa\xe3K5\xea\n\x01\xd2~y\xd9~1\xe2\xaf\xe8Uv\xf0Z\xb1\x01\xd6\xcf@\u060f!\xec_\u0571l\xa5\xfa\x02\xec\xbcU\xb0\xf3\x88c\x90\u0231\x81\u0349(\xea\xef\xc1p\xc8\xd0\xf1)n\xe3\xd9Xq%\x0e'}.\xaaX\x8f\xdd,\x18\xc9\x0f\xe1J\\\x9eG\xefXf\x1f\xfd{1\xde\xc1_\xe0\xec\x12g\x948\xbch\xce@#\x85X_O\r\x15\x89Z\xdc7\xb9\xaeE\xe5\x14\xdb \xa5\a\xbc\xc0N\xba\xa1k=u'G\f\x9e\xc15x\x81\x81\xeb[\xa7\x9b\x01\x8b\x8e\xbc%\xbcA\xec\x1d7'\xcf]\u02e6\xba\x85\xebZE\x05\x1d\\\x91}\xdc\xc8\xe7\x8e\x1b\x12\x8ep\x996')@\f\xe39\xfa\x99ag6\xe1\x1c\xcf\xf4z\xad\u01d7n\u0309vw\xcc\x02\x90\x84\x1c\xa2m\xe2\xfd\x04%\x1f\xab\v\xf0\xfa>\xee\xd8\f\xaf\x8f\x1b\xb9\xfe\x80\xba\xf1\xc5rQ\x96\xb2\xb6\xdcW\x9fD\xa8\x1a\xf4h|\x8cC\x8d\xad\x17k \x17\xa8\x85Q\xe0>\x8aY\x97\xa2W9g\xa6\xb9\x97\xd2\xc8\x12\x11\xc8t#\x1bMV\xac\xbc${Q\xe2<\u00e6\f\xb2l\u029c\xa0\xdc\xf6\u0258\x93\x7f&\xe3I\"\xd6xz\xb7Q\xed4\x16^\x82\x89\x8e\xc6\f\xcc\u00c8f\xa8\x8b\x1c\x81\xaf$\xe7/l5)\x90\x84\x9ea<Anc_\x81n%\xf1Uw\a\xa6[Y\xa2\xde\xe0\xf8\x00lZ8c\xb0X\xb0\x90\xf0\xb9A<]\xbd`v\r\xe3\u0362\xd9F\u01c7On=2\xe2\x87f\xf3u\xec\xfb\x93+l@\xb1\xc1G)]\xb6\x97;'\n\xcd74m]$G\xc2bK\x8c*\x98\xf6J\xd5mH\xc9r\x00\x99\x13\x96\xd6\x12gZa\xad\xb9\xf7\xfa\x9d\xfe\xe1\xc1Ib\xff\x7f\xd3q\xdd\xdb\xccb\xce\x19\xbb\x05\xc0X\xdae\x98q\x99n0\xaanp\x99P\xbf\xd6\u05e3\x1fpo)\xae\xec\u051e\xb5\xbe\x9e\xac\xf5,\x18\x96F37\xc8Z\xa6\xba\xec\xc0\xe2*\x96*]B(\xf5$\u06cbl\x979\xb3\x10l\x95\xead8\xe1*c\xa6m\\\xdd\xf0\xb8\xf1\xcb\x15@x]\x83\x1be\xfb\x9c\xd1\u7443\xc1-y\x13\x05\xec\x02\x99\x96f\xb3\x8etC\xcfd?9QJ\xc2K\xc7C\xefI8qOpK\xea\xa9^\xedx\x91\x14G@\x03\xa9\xc5\xe3\xe7KZ\xc3\x04\x8f\xe7Y\xc1\xedV\xc8YJ\x15\xb7\x01\xf5\u03c9\x16\xcdF\x84\x1a\x97IU\x03\x12\x06\x8c\xbfB\x03P,1\x1b\xb5T`\xbb\x9e\x13\u0517]\xfb\xd5\xc0h\x0e\v\xdd\xe7\ad\x15\xb5-z\u03bd\xe0\u07b5\xe84\xfb\x82\xafc\xb3SY\xa0\xb6\x918\x97\xa3\xb6\xd13]\xcfR\"%\xce$d\x94\xd2\xdb\x19\x9e\x8e\x85g2\aK\xa7\a\xd5\x10X\xe2\xa2\x13y\xd0kD\x1c\x1b\xc82\xa7\xe6A\x9aj_\xa8\x998+E9b\x0f~\r\u019c\x8f\xd3`\xf4t\x87'm$y\x89\x97\x1aU{\x0e\t\xd5\xe3\xc5\x17\x8e\xa1\xfbD\x00fYnh\xb37\xb9#\xd8M\u03dd\x81:\x99\x041rc^X\xb8\xa1\x11?br^\xe8\x0f\xbb\x84\x19\xb8\xacx:\u0144\xe7\xc3j\xf7\xa7\xf9\xc8\xe4\f\xc9\x1d&\x04\x9cv\r\xf2\xe9\xca\fJ\xdc\xc5%<\u037f3;^|\ua75c\xe3\xcd\xd9\xe4 v8WGJ\u0205\xc9\xcaNp\xa0\x9e[&'Y\x0f\x9f;fJ_\xc9i&pC\x01\xec`2\x153\xd2\a\x93v\xee\x94\u02ef\xb1\x19\xd93\xe3s\xc2\xfd\xa5\xb4\x9b&+_y\xb8\x86<(\x000\x129<^\x1f\xba6\x9c\x05\x84[\xfbnx\x0e8\x8f\x90,\xf5\xec\xa5\x17 j\x9e_R\x13o\x9a\xd1#\ue324\x91\xf0\xec\x8e(/\xd7h\x82fQ\xc0c:\ue8be\xf1.D\xc11R\xf7 \xc1\xba\xd7!nI\xe9\xac\x19\xe4\x16$\x8c\x9d\xd1\xd8uFc\xb1\a\xfa9\x83\u0706\xc8\x18\x1cjz\xb0\xdb\n\x1c\f\u02bd\x96\u0262\x19d\x1f\x82\x89\n-\v|\xcd \xaf\x9aS\xdd\xc0\x13\xdc{\xfed\xe2{\a\x17\x82y\x01\xdeQ\xbek\u018e\x05\v\xeaKuK+9\u0324\xc7\xeb\x11\xd2\u0429\xf0&\xb7\x19g\xf6kt\xba}`\x1eHy\xb8\xf4\x88:\xd8\xf0\x914=2\x0f\xb1\x92\x03u\xfbOZ\xd2\xd0\r\x83\xdc3\x1f\xe5\x16wa\xa6\x87q\xa6\u031aE\xd5\xe1\x1b&\xfc5\u0347;\x8a\x12\xf4`W\x7f\x18\t\x90\x0f\x8b$p<\u060feL\x9d\x9b\xc05$\x8f}\U00078488pD\xe6%l)a\xef\xd6\xd7ufFG\xeb\xa3\f\x03\xdd#\aD\x18\xc47\xd4\r\x8c :\x06\xe6Y\xee\x9d\xf6\xc0\xd8\xc1\xbb\xe4\x12\xdfk\xb2\xf6\b7\xa8%\xe9\xf2MS\xf3\xf1\t\x02\xc0\xcaG\xa9\xd7b\x04\x1f\xe5o|l\x90WLm\xe0x\x94\xcfV\xe4\x83\xdb\xf9\xa8\x80\x9c\x9f6\xc1\xbd\x81\xb5\x1a\xcf\xca\t\x1d\xcf]\xd3\xec[\x82\x89\xcd@pF'P\xf8@J\x80T\xa8\x1b\xfaA\x04\xe5\x03\xf0\u07a5#\xb6\xa5\x19\x85\x894\xb4\x1d\x7fU\xe2\x99c3\x95\xf8\x8a\x94\x9fV3\xf4\xab\xa6\x9b\xb8\x9a\xc4`\xbfX\xd2V\xffjl$\x1c:n\xd6\x06\x98\xa5\fw\xf4\x84\xfd\x84\\p\x1f*8_\xf6\x16\xeb\u049cX\xa7\x1d\xad*E\x00\x82\xf4\xc6\xf63\xea\xb8 c\x95\x86>/iW*\xd7\x0f\xc0~7a\xb6\xa3\xee\xac\x04\x11\x13.g\x7fs}=\x0f\u068b\xcd\xf3\xf3\xf3M\x00\xfdf\xc8]\xf5t\xa0\xbd\x1a\u0193\xd0\x15\u0394r\x01O\u3b6f\u07cbl\x12\x1c\xb4\x04\xc2aA\xe1\u0089\xf82\x05\xd7_#\x9f\x03\u0202\xd7-\xba\xb4\xd2C\x9fO4\x83|!G?\x0e.\x84f\x90\u03db\xd7k \u0560\xa2\x9fq\xd9\t\xc0\u06d8\x1b;\xbc\xb7\x9c\xfb\x1a\xc6\r\x01@\u019b\xf9\x15\xb1\x9b\x93\xdd\xf5\xf5\xc5b7\x12C\xc5\uef20R\xa1\xb4o\xc5V\x10\x01\xacc\x94\x98\xed\xf2\xe7\x8d\xf5u\xa0\x1d\xbff~~\u0663\xf2\xd7\n\x95b\xe8\x06\x15\x9dH\x98\x9a\xa1\xf2\xe7\f\xe3\xf7,\xc7\n~\x9cQ\x1b\x98\x86\xd8}~f\xbe\xba\x83,\xc3\xd47\xf4\xb12\xb3\x90\x81\xa9NE\u070b\xcb\xc4\xde(Yz\x91\xaa\u0166\xe0\xe77\xce\x1d>8\x89\xc3\xd1R6H\xdf\x1c\x17\x90v\xb2g&\f\xd04\xe5z\xc8\xcd\\tt\v\x14\xdct\x13\x90\xbb\xd1\xce85\xa4\\\xab\x92\xd7\xcd1\xbc|\xab\xc7<0\xac\xdd(&a\xb4\xa2\x18$2\x8e`\x93@#I\xe2qaj\\\xd91\xec\x8aqu^8\xc1=\x18\xaem\x8f\x06\xa3\xe2\x10\xad\xa5\x1c\xc0n\xf9\xba\x94\xb7\xc8k\xa6\xa6mk\x14\x94\xfep\xfc\xe1@\xca\xdbR\uabd9G\xc7\x06\xd13\xd4\x14\x12\xa0\xc6\xd7R\x16oL\u05aa\xc5<\x9ea\x90%\xa3\xd3k\xeb\xeb\xd9\xea\u04aa\x80\x03|-S\xc2\u0316P\x1dK\nd\x1e\xda\x7f-\xf2L\xdc5\u04c2\x98+C\xdaO\xa4\u0310\xef\x93\xd5R\x95\xe0\xb1\xdb2\xc9,\xa9\x8c\u0652h6\x15t3Z\u04f3Mt^\x01'\xe7\xe5\xaa\xec\xfc\xbaL+\u0674|\xf7\x04\v^\xc3X9\xd8IK\x9e`\xa9R\x82\u073d\xc5$mN\xa6d\xb03\xb9\xca\x1d\xfc\x7f\xfc\x93\xcfj+m\x93K\xd4$\xd3\x00\xae\x9399 '\xeb\xeb+Z\u0215\xe4\xfet3ZKs\xa2\xe9\x9f\xd6\xc8\t\xd1\f`\xa8\xd6\xd7gJ\xb0T\xb7\x86\u032e}b*!\x16\x91\v6\xcd=R\xb7\u0629/D\x8e\xde\v\x1arN\xb4\u035c\x86\x9c\\\xfc\x1a\xbaW_\xbcA\xdd\x1eE}\xf9\x8b\xc0\xc7fS\xce,*\x98\xad\xcdIB9\x928c'\x1bR\r\x18\u03cd6W\x9b\xaeS\xb2\u079f\x1b\xe4b\xa7\xb8\xff\x85d\xfds\x04n\x85;'v\x86Kw\xa8\x1b\xf1\xfb\xbb\xb1\xd3\xee8wHkJ\xb2\x12R\xe2\u045b\xb0\x83{;\xaf\xf5\xf6R'\xc1\x01\x89\xee\xf4\xbb\xb9\x92\xd9 GSd0\xc8@9\xf0/vs\x9f\\\x16\x16\x8dP\xe0T\x9f\x12\x86\x8f\x1fFL\xbc\u077b\x9b\t\u071c\xaaK`\x1e\x800\xee\x04\xfbN\x00\x1b\x9d\u0773uC\xdf3v@\n\u078b\xac\xa0\xbd5$J7k\x90\x92`\x841\x8fN\xf4\x00F\xfe\xba\xa9\xd8\u05beA~\xc3\xcc8\xb5\xa2\"\ua9a1\xff:\xd0\xc9\xdf05x\xa8C[\x85L8\x19\xcf\u0721\xdfX\x9a\xca\xdb\xe4rYQ\"H\xcc\xdd\xf7\u0788\xf5\xee\x80\xdb\xfd8\x00\xae\xa1\xc8\x17\xbe\x91\xa8p\x94\x80\x94\x03\xe6\x1e(\xbd\xa2\xab\xecT\xc6;\xb1\xfcw\xd7?\x04\xd9$b\xf2\xe0\xf5\x94\x05\f\xb8\xfaN\x87\x9b\x91[\xc4\xf3\xe1\xe6\xeb$V\xc3\xc6\b\x14\xe4\xe5\xd1_O\xf1\xe9\xceB\x87`\xea\x16\xeb\xbbK.\xf3\x99H*\xd7\xf7\xa6$'\xd5\xf7\u0184]L\xa9g\ufce9\x18\xf7\xaa$\x11\xe5{\x17\xf1\xa2\u020b_Fz \xe3D\x1d\xc8Hu1\aF<CK\x9d\xba\xb5B\x9bw\x12\u07e9\xa0\xe5{\xe9Ro\x14\xc2\xd5\u047fA\x12\xfd\xa7\xdaD\xb9!\xe5\xaf\xcf\rR\xb8#\x17\xa0\xd2k\xe42\xee\xd4\x1b8\x92\xbeA\xae\x80O4\x85\xa0\x16\x05\x0f\xb5\xe77o\f\xff\x11\xbd\xb6\"M\xda\xd0\xfc\x97\uc115W\x1by\xa0\xf7\xa1A=\x8d\xe1QL\xfc\x14\x9a\xbf|LLD\xc7\xf5\x03\xf4\xa0\xf23\xb6\xd4\u0108\x01\xaf\xde\xe2.;\xbd\xe2\x88\u03f3\xf7\xf1\xa8\x9d\x02#\xe5Rig\xb5\xd9\xcf+\a\xcf6\xf8\xf1\xeb[\xfb\xf8\xf5M}^9\xc8E$\xf9\n-}q\xee$1\xb6\xf1!\xd2\xc7Z\xedi\xce/\x14}6\x9f\x7f\x1d\x9c>K\xf1\xfc/\xc7\a\xa9\xdbh\xb4\xeb\v\x87\xa4\x94^\xfc\x02\xa3\x0e@~JV\u07dd\xf4\xcc\xfe\xe9?\x8e\u05d2\xbf\xec\xb5D3^K>\x00\xab\xb2\x1d\xbc\xe4o\a\x1b\x1b\x06=\n\xb2^K\xc1sz-\xd1\x05\xbf\x8f\xd8\x01\xa2\xd0f\x97AL=o\xb93\xe6+\xea\xc9!\xe6\x15\x86\xc0\xac;\xce\n\xec\xf7a@\u0645\x87\xca\xef\xa8\x17#&\xfaBpg\x10\n\xa6+9&\xbeE\a\x95\xfd\v\x86\xc6\xf4\xb6\xe7D\x12\x03mf\xe2\x85\xe2\xa3\a7\xc5\v>\x9c\xf9j\x97\xa9\x85\xb1\xe0\xe5\xaa+|\xb3\xa2+\xbaWx\xab$\xa6\xc5}\xc7~\xcd\x0fa?]u^+Kq\xbd\x94Xn/@G\u02bc\u05c0\x1e\xab5\x99\xe9\xc5J\u062cz\x93\xc5\xea\xcd\xe8\x14T\xc8]\xcdH\xed\x83/b\x02US\xab\xf0;\xb9\x84Em\x01+\xe7\x98-{.\x80\xc2$\x1ds~\x94hU-\x1aw<\v\xd8$\xe6\x8a~\xc7\x0f\x1d:\xe6\xf9\x8a\v\xf0S\x0f\x7f\x91\x01\x85\xb9\xd4\x12\xccf\x80\xb5Ps\xb9\xcd\u4e57+N\x9a\xc5\x15\x8b\xa2\xa1\xcdaz\xf4\n\xb9\x93W\x93\xa1\xfa\u0209\xd5\u008bs\x1c\xcflv\x0e\x91\x0e%7o\xc4\xcb\"\xd0\n\xab'\xae\xa9\u00d0\u00a8\xff\xe1U\xfdO\x94<+\xfaj,d#\x96\xe9\\\xaf\x17S\xd5\v+\xea\x85\xf5\xcb\xec\xc5\xf6\u014a\x9a\xd1\xd6\xe8\xeb\v\xd3L\xe0\x91}X9S)y\ua9f6\x90\t\x16\t^\xd1\xd3\x13\v\u0341\xa5c\xae\xeeIx\xce#\x99\xb19=v\b\xe4\xc9\x12\x02\xebe\xbe\xfd@\xa9\xe8\xf2+\x87\x84*\xb6\x88\x1b\x88'\x9a>\x0f\xa2:W\x83\xf8Y\xb3j\x99 \x86\xba\u023a\xc5\xfb\xd4\xc93\xf5\x10\xa9\x7f\xdb\u024a#G\x97c1q\x0f}~e^\u0164e\xed\xf1\x91i?\xbb\xe3\x91\xe4\xd4\xf0Etxt\xe98P\xf1\xd9\u0465\xe6\x94\v\x99\x16\x8bwY@\"\xb2d#\xe6$\x97\xcc\nT\xb3\xeb\xebZi3\xf3\xe0dA\x16\xf5r\x84\xf0\x15\x9f`\x18\x06\xb1vNV\xf3\xc7\xcf\x06<\x10\xfbP0\x1b,\x10\xc0\x1d\xefEax\u5d67-W\x901s\x84\xba\x03\x1d8Yy~.b\xb5K\x19\x94!'W\xe9\xeaO\xae\xd2\xd5_D\xf7\xba\\\xeb\xa6 \xc2Se\x1bU7-\x84\xb9+\x1fNV\xaaNi\x01^\t;\xea\x045\xaeH\f\xb3\x1a\xea\x9d\xd5\u0239\xc0\xbb\xf4h*\xfe{\xe5+\xb8\xaay\x04\x83\\;W\xbd\x14w\x05\xc6\xc6\xe2L\x8f\x99\xa6\x19\xe8\x0e\x8cL\xd9\xcf#\\\x9e\xab\x97\u02cd\xdeR\x1d\x0e\x1a\xe6\xf2'\xf4T!U\x0f\x10\xc3k\x8e\x88,\x81\"\xbe\xe2\xc1H\xa4t%\xb1\x9c\xe4=\x13\xe1\x1e\xad\xebI,\xeaN\x98\xe8ZR'\xb8s\x0e7*\xf3\x1aF\xb8\x04\u0492\x8a\xdf\xe4tzHA\x86\x9d-\xbdhXp\xd3J\xad\u06e9-\xca\xf9\xd1C\xfc\xf9\xd7\nb\xff\x8f\xe4\xbe\x1e:u\x12Aw\xf9\x05\xd7\xf5u\xbc\x8eZ$3\xab\xd5\u02d5\xb2:\x86\x14]z\xdd0\x16^:\xc85\x11\xa81^\xd9\xc4B\v\xb5rE3\x16\x9eEX\xbc\xd8'{l&\x89\xcc<Y\a\xae\x029\xd5\xc0RDr\xe7\xcfN\xa8\xafJ\xd2\rc'Xz\xce>:=\xc0\x89G.or\a\xcf\xe6\xf4\x16\xb3\t\u008d\x9e\x1e?\xce\b7\x01\xeb\x1aLp)\xf2*\xee\x95,p\xe4\xf0>#J#&J\xd1\xe3P\x11\x9a\x81:\xa8\xddhV?\xd9\xf9\xc8n\xad\xd2P\xfa \x1d\xaf\xb2S\x98\u04ad\xb6\x1b\xd5\xe8\xed\x008\x83\x19\xbd\x9b\xd8nG\xaf_\xeaxYp$\rWZ\xd1\xf9\xc8V\xad\x9a{\x00e\x9a>\x9a\x90\xdeR\x949\xf0\b\xa3\xdcK\x13\xd2\xe3\x8eE\x8f\xe0\xe7\x1fL\xe0\xf97\x15\xe0\xd8\xe9b\x16\x7fN@\xa7\x93>\x9f\x1f\xa6\xaf\x16\xa7G$\xd3j\\\xf5\x06\x03\x0e\xe2\x13\xb8\x10\xaf~\x84\xa1Zi5\x16\x1ea\x88\xb4\x10.\u0185\x11\x14\xbb\xf5J\x15\xb5\neO\xb7P\xa7\x10]\x90>\u01a8!\xaa\x14\xe2\xab\xfe&\x18g+\xcf\xe1\xe8Z\xbf\x9c\xe7p\xa4\x17\x18$\x93\x96x|e\xdfy\x18\x94\x8b\xeft~\xf6\xd3T\x8b\x8a\xa6{\xdcM\xeeGQWZR\xd3\xdf9\xf2\x89\x96\u03e7\x1d\xf7\x8e\x96\xa2\x16\xdf=\xa0\x84g\x9eVH:\xb9|%\xf2u\xfa\x99\xba~fn/\xa5\xa6\xab\x1b\xbaO\xf0\xadB\xaa\xb6]\x1a\x1b\xc4g9\a\x01c\xa1sGZ\xe4\u01067\xc9\x11\x87\x84\x04\xd5\xc8\xca/\xfa\x98p\x85\x11\x16\x19f\xbc\x04\xaf,\xa2\x18\xd4Y\xf4L\xf5v\xb6\xf9!\xa4\r\xcdL\xb2\xaa\xdd6\xa7\xd9\aZ\x01\x0f\x02\xdd\x00\x84\x18\x99c\xdd\xd0\u03e2\x943RI\xad\xb0\xba\xa1\x8f\xa2\xf8Q\xb1\xe2#\xea.;6\xb6\x87h\u0686W\u01f3\xfd\x11\xd0\x1f\xcb\x1c\u01b0`\x89e\x9e\x88\xf8\x05\xb6\xeb\x81\u027a\xde\fgo\a\xff%\xcev\xa0f\xfbYS\x1b\x10M\xdd\u05bc\x9b\x9b\xe0g\xf5<\xb9\xab\xfc\x13\xf48\xb9j(\xe9z`:\nQ\x9dgw=\x88\xc1}#\xbdF\x9a\xae\xe8z\xf1\x15\xea\xd7\xe9:\xf6\xb0\xa0\xe7\xd9{\x81\x9d4\xfc\xba\xba\x9e'\x19Ph\x82\x9f\x13\xb1\u0320p@\xa9\a]H,B\x89C\xb4\x9c{\u6ce6\"{\x97\xfb?\x13\xea,^\x8a\xbe\xba\xcb\x05\xf7\xcf?\x7f\x9f\v\u03ba\xad\xe8\x9fO(\xd1\xe2[\xc0\xaf\xd1\xc1\xc2\xdb\ufbf7K\xa0J`qk\xf0\xf0\xb6cG\x8d\x80\x04\xc9V\xb1\xc4\x7f\a\x1a\u112aMcU\xe2\xe2X\x03\xe2\xe4\xc6\xf0\x8c\xeb\xf9\xaf3\x8c\xe8Xf\f]\x1c\xc1\xe2\x1d\xc8\xc41\x8fp\x03q\"s\xe4\xed\xc8 _\x1a:\u0335K\xd1\x1b\xd1S\xee\x83/\xa1\xad\x19\x84\x96'N\x108\xde(!/\xcf\xd8l8\xf1S\x9f'\x82\xbbB\xf2<\xa4r\xe6L\xaa\x8c\x1b\xff56\v\xd6\xd7\v\xa3\x93\xe7\xd9\u0558\x03\xb30\xd7\xe2sI+z\x95\xa5\xed\xb9\x8e\xadzHi\x94{\xac\xe2\x8a[N\xa3\xcb\xc4V\x0e\x1b_/\x9f\xa7\xb7X\xe6\x19\xeb\xd0c\x17S\xe46J\x9c\x05\xc0\xb7\x95\x9e\x81\x0f\xd1\xe3\xe4\t\xfa<\xf3\u0746\x17C\xa0\x15\xcc\xc1\"0\x8d%\x96\x84\x1a/\x8e%G\xc7\xc9\x05s\u04ec\xc2\r\x891^\xb8J\\s\x9c\u04f9\xa6\f\x84\xbb\xf3\x8f\x81\x03\x85W\t?\x17\x0e\x1c\x1d\xa7H\u043b\xc6\xd4=\x93r\xe5\xef\xb9w\xd18RSo\x81\xa27\x14(h\x88\xb3j\x06\x91\xb6\xe6f0\x1a\x89\xb3\x93\x9dF\xc7\u0639\x8a8/\xc12b\xffz\xd7-\xa4i\x1108\x98N+\xadk\xde.\x19,\xbf-r@x\xd1\xf1\x9e\x8b\xe8\bC\xb1\x1fJ\xf4\xfe\u0198\x06\xe0\f\x93q\x9eQ\x8fn\\qPD\x19m\xc9\xd2Q\x11\x8c\xef\x13\xba\xea!\x92=\u008b_!\xb9I\xf8\x8a'H\x1e\x90`\xc5\xfb#7\x16RT\xebwI\x91\xd5\x1a\x93^\x8f\xef\xc4g\xbbl\xe8s\x16{\xaf@\u06ad$\xed\xce\x18\xaf\v\x8d\xe9+\xa6\xde~\x91\xe7\xd3\n\xdeT/\xb8\x04\xbe\xd3\xeaVj\x8b\xc7P\u0561\xaa\x9c}:\x92\x16\xcf\xe2\x9b\xdf\xd1@\x1d]\x9c\x94\x9eC\xdd\xce?\u0170\xfaB\xe5\xe5\xd7!\x89\x971\x1b\v\x83p\xb3\xb2\xcd_\x12\xdb|c\xc3\xf0\x8ex\xd6l\u030f\xb7\x17k\x16\xf1\xb1\xab\xeb\xdd\u04fc\x9dy3:\xbej\x99\xc3j\xcb\xddn\xec\u016e\x06\xe8dvN\x0e\u0301^L\x16v\x80\xf4<\x97\x18\xab\xe8\x82\x17\xd9[\xc0z\xbfPwFE\xb5\x82\x8a\b\xe2-\n\x89\xd8]\xd0d\xbf`mK\xa2\f\xfaIC\x8dwV=\x92\xb7($F'\xdf\xe2)\xc0\x03oy\xa7\x1a4\re'\x06\xf8\xe6\xec\xe4\xa8K\xb0\u04d9q\x8c\x98\xfdX\xab\x92\xd0\xf4\xcb\u02eb\x18Uz\xc4M\u04d21`\nT9\xc9PV\xd7\xc0\xd7\u0392\x83\xaanyB\xa7\a\x9e\xe0\x0e\v\n\xdf/\xc8\x16f\xf0n\xea\xce\x11\x03J\x0f\xbf\xb3\al\x8cc\xa4\xa8\u0461>t+\xcd\x1e\x9cYj\xd70H\xa8\x06g)\xdf\xeb\xd5\a&\r]\x01\xe9v\xfe\xaao\xfd\b\xeff\b\x92\x19LJ\x81\x03zH\xfcr\x119\u05172\xe7C\x86\xb1\x1d\x98kk\xee\xfa\xba\xbbf\x9aV\xf2\\\xd9<s\xc0\a_\xcbxq\xacM\xe5\xceH\xbd1\x8960@\xb7\xfe\vV\x9c\xf1\xf6\x8f\xd7\xc2\xderU\x84\x13\xbf\xa0\xbaT\x9c\x8c\xf2,\x89\x93\x99jo\xbe\xf0\x12[\x16\xf92\xd5>x\xe1j\v$\xb5L\xbd7\x8a\xea\xc5\xf5\xba\xe2B\xaaK\xe5\xdd\x13{l\xa0\xa7[\"\x9fms\x93\xee\x1c\xd1U\xf2\x98O\xbc\xd5\xf2\x18$\u0399\x1b\xb0Kn\xae\xca# \xcf\u00a8yf4wWP\xe2\xab\xc6\xc2\u04f1\xf8\xb9\xb1xW\u02d6\xabG\u00a3qx\xab\xc7q</\xa0\xf8\x88\xeb9[3\x8c\xe9\xf5\x15c\"/:C>\xf1L\xba\x93 \r]=B\xa3\x97\xa2\xd6\xca<8V\x80\"\xf1\xcckd\xdf\xf6L\xafh\xa8\x91l\xc1\xb7\xb3\x8eEE\x87\r\x00\x00\x8e\xe9\x949\x9b\xba\xd4b\xf8\x00\xc4m6:\xb8\x98\xea\xdaebZ\x16D\x9bk\x06\xd1FZ\xc4\xde\x13\xb0\xfa\x93[\xa6~\xfe\x8c\xc7B3X\x13%\t\xb8\xfb\xe6\u862c\xad->\xa6\x9a]o\x8bO\xaa\x92\xa3\xdc&l\u0331\xe69)\xe0z\xd82\xd7#2\\\x0f3\x88gV\xb6\xbd\x97\u0636\xb7\xb1a\x88#/\xcb\xf5x\xc7\xdb+\xb6\\\x8fpB\xf3\xdb\xee\xea\x17*\x023\x1aT:\x0ea\x1cU\x8fa\xe4Q\x03k43|n\xc6\x1bL\\\x80\x13\xf5\x8a$9*\xd8@\xf08\xcc1\xf8]\x9c\xe7\x99)\x81\x87\tn\x9b\xab\xde=\x06\x1e!fz3[_\x86\x9e\x11?\x93-OB\t\xcd'\xa5G\xcec\x8d\xcb\u06a4P>v\xb6\x15c\x91\x8c\xbd\xa4\xde\x0e\x1a\xc2\xc8\v\xf8\x8c\x15\xc7b\x9c\xa1\x0e\xd6g?\u0553/\x17=b\xea=\x18Ql\xe7\xb6s\xca\x0ef\xbcT\x81\xf7kPM\u0112wK\xacU\x1a\x11\xe32\x9e\xb3\x8c\xc2\u0750\xd2\xc9\xd4@\x1c|\u01f5\u0768^W\xceS\x1e\xc4\xea\x05\xcb\x05\x83s\xd6T\x18=\x10\xe5\xa7b\x02\xcd=\x10\xe5\xe4\x1e\xeeM\xb73$\x0f~\xbc\ubafb\n8>!\x15\\\xe5\u0783\xa78H\xa6(\x0e\u03bdf\xe9\xf8\xa5\x94\xe5\x1a,Sw\xd2J\n\xac\x1f\xae\xce\xd2W\xa6\u0296\x1fzB7^\xaa\xe23\xaf\xa1\xce\f\x83\x88\x95\xcf\\\xe5\xe9e\x10\xf3<~\x86\xe7Y\xbe9\xa6\xe8\xea\x85k\x897\x8a\x83\xa6\t\a-\x8c\xec[6KD\x88\x17?s\xeb\x9b\xd5m\xff%o\xdb\xdf\xd80\xf8\x91\x9f\x7f\xe6\xd6OV\x8c\x93_\xe8\xdc\xc83\x89\x06\x99.,\xfae\xb10r\xce\x7fN\u064d\x96S\xa4D\x87U\xc0o0[?\xf7\xb3k\xd1\u00e9c\x1a\xbc\xe2\a\"y.u\xe9bkL\x99\x90q\x9c\xe9\x8c\xc0aF<a\xa2\xe33\xa8\xa0Y\f'\x91.`F\xa6\xdc\a\xc3r\xf0\xff\xe5\xee\xcf\xf7\xdb\u0195\x85\x01\xf0Ud\u07be\n\u0446\x15\u027b\xa90\xba\x89\xe3,\xdd\xd9\xdaN\u048b\xa2\xe3CK\x90\u0144\x02\x15\x12\xf2\u0496\xees\xcc\x7f\xf3\f\xf3f\xf3\b\xf3\xab\xc2\xcaE\xb6\xd3\xdd\xe7\x9bo\xe6\xfcN\xc7\"v\x14\n\x85B\xa1\x16\x1d\xeaTF\xdf1\xb1M\xed\xfe:\xa2\x8ev\xc7Uy\xb3\x9d\xd6o\xb6\xad\xad\xfd\xfd\x8e\xdclj\xdf\xc5f\xdf\x15\x83\x1c\xdfK\x01B\xb3I\xfc;\x97!\xbe\xe5\nm\xf0\x85\x04bU\x8e\xba_\xcfW\u04c8\xc8\u0408$\xcc\xfd\x9a-Jn\\\x94#r3\xebb\xf3{\x11\x93\xc8%\x05\xb3{\xd6.\x10\x93B\v\xe3\x1a\xb7\x1e\xb3[\xe8\xc7P\xd1\x0f:qg8\xaewCe=\u02f8!\x9e\xd0\xe8\xc9\r\xed\x14\x15\x9ee{\"pA\t@\x1a\x85\xf5\xb7\u0771\u03c81k\xd6\x14\x8b\x82\xc3\x01\xb8\u047a\x03L\rF\xfc\x1a\x8bI\xe9j\x9a\xdfA\v\xef\x88Pe\xd8}\x10;%\xf4<L\xe8u\b\x06\xe6\t\xbd\f\x13zt\v\xa0\xee\"\xbb\x05(\\\xfd\x87\u0213\xdd\x17\xeeB0\xd2cA\x01[\x8aQ1\xff\xe9\x11X\x02y\xc7(\x96t\xaf\xbd\xb5\xf3\x17<\xf8\xce\xdd\x18\x95\xed\xcefY\xbbL\u0662\x18\xdaD\xf3\xb0\xef\xbd\xcbbo\xa04N\xf0\xe0\x8f[\xab4\xd8j\xcfb\xd6z\x97\xc5\xc6\x109'n(\x98\xb8\x1c\nf^\x0e\x05\x93\x84\u0709M\x83\x12\u0454\x83wv\xa5\xcc7\xd3O\xd7\xe30\xf6=\xed\xba\u076a\xa1a\xa4\xc2\xd0xj\x92\x8e\vzQ5F\x82\xb4`\x97\xe3\n2\x19\x85bF\u0771\x04sjG\xa2\r\xfc\xd4\xc0\x83\xd8j\x1f\x0e\x97$\x88*jb\x1cYC\x8a\nX\u07ffr\x89{\xaeln\ufd95\xbe\x17\xe0\x81\xb6\x00\xdc\xde\xd7\xfe\xf0\xdb\xed\x9d})[\xde\xd9\xda>\x906\x80 \xf7\xdd\xdf\u04eaCIx\xa3]\b9\x9e\xef\x01t\xaf\x04\x9b:>\xee\xad\u07ceSy\xafu\xdc\xd6\x7fb\x19\x8a\xfaE4\x9dY\xd7\xf4\x14\x1d\x119\xaa`)Ob\xceT\xb4\xee4{\x1a\x8d\u0399U!\x03N\x00F\xf6\xff\xc7\u062c\xf1Xc\b\x1d\x86Y\xb3\x89\x18\xd9\u02ca\x0e4\xa4\xa5\ufb12/\xf1V\xe6\"\xa6\x97<\xd5\u031aM\u01d5\u00ec\xd9\x1c\x16\xbd9\f\v\xde\x1c\x86\xb5;\xc0ulc\xb5\xb5\xe7\x8a\xf5\xecy\xca\u05dd\x17x\x9e\xf2ug\xf2\u6077\xc2\x19NR\xe7\f\a,\xe6\xa5\x15\xb9hY\x93\xf2\u06f6\r\xe2\xfc\xfdP\xc4\u0d4c\xc0\xefb\xcc\xc4\xddE;\xfb\xdb[\xab1Fy\xf0,\xa8\xfa\xed\x1f\x1c\xe8\x90\x12\x12\xa1\x86\xf8,\xd3\xee\x1c\xe0\v\r\xbbl\xcc[\xa7\xa0\xae\x0e.\xaf\xa6Q\xf6\xd5#\xddY\v\x1d\u03b6\xb2y\u00b2\x16\xe30S\xbf\xaf4\xd0\a\x84\xce\u0427\xd3\r\xf8\xe1\xff\x80`\n\xbc\u04f3$\x02\xaf\xfcr\xac\xe3Z\xb7n\xd2\xce]\x86F\xd4KE\xb3\xd0X]\xf1\x9e\xe7\x05\\\xbfk*;\xe4[\x1d\xdaKOP`\x12%{\x99\xd3$\x8c}\u049a\xe7\xec#\u03e31\xd3p\xa5\xe3p\u0592\x96&\x00u\x15\xac/\x87\x93\xfd\xa6R\x18\x8c\xc9Wi\x04O\x9aM_:\xb3\x99\xa8\x8b\xf2\x04\xfdm\u059a\x0f\x8c\x00\xb3\xb2t\x9e'\xd7'\xc0)p\x96\xbd\xfc\xf0\xe6upsz\nF\x1a\xc1|\xe9\xe0l\x8eO\xc6\xca\x1c\x86\x8d\xf4`\xc0\x92\xa7p\xe3\xe9\x8e[\xaeO\xc5\xf0\xc6\xc2*\xa8p\xac5\x93[\xeb@@wIL\x10\x0e\xc9-\xc4d\fO\xb7@\x8e\xff^\u030e:\xa5\xf0\xfb\x1a\xbd\xde\u04f9\xad\xda\x06#\x83\xe7\x18\x1apk\xef`\a\xf8\xbb\xef\xf2Tk8\xa4\xf8\xfeV\xe2\x7f\xc3\u0776]?\n\xc2@<\x896\xce\xd2+P\x85r\xddM\xac\xb5\xd1\xecN\xd2\xed\xaa\u06d1f\xd3\xe7J\xef\xc7M\xa6Y8\xb9\xdb\xf8[vj\xebm\x80\xee\n \xfe\x92z\xcfLj\xe0\x11B'w\x99\xcap\x1b\x1cbI\xb3J\xf9i\xeb\x0f\xad\xa5\xee\x00\xc2\xc5bAG\xca\a\x83\xeb\x91\xc1\x16n9\u024bE\xdb\xf5\xcb<q\xac\x1fL\xf4\b\xbfMG\xb7\xe0\xf8\x05\xaa'\xb4w\xfe\x82\x87\x83\xc8\xc1q\x89u)\x86\xf6\xd9\xdc\u04bdG\U000aadfa\xf7\xb4\xf5\aYRd\x84\xbe\xbf\xff\xd4\xe9_YT\xc8nS\xe8V\x8d\xe9/\xf0\n\xd6Q\xfdj\aCY\xe5\xd8c\x84V+L\xa3$)\xe2\u0685\xe4\xc06r`\xc1\xbc%\xcdj\x03\xa7\xd4\xd4\x00d\x84\xb9(\xeb\xae%\xdd\xdc\xdf\xd9m\x7f?\xd4\xe2B\x9c\xa6\xf6V\x914\xadu,-\x88-!]e\x1b\xa0|7\x047R1\xe9\x042\xea\xee\xbcU\xbf\xcd\xe1\x1a\xc4\xe4Y)\xb5P~Y\x90\x97-6\xba\xfa\x0e\xa7\x02I\xab\x8d\xe4\x13\xe0\xdeT\xc4:\xc7]D\xbd\xc17\x06\x9e\xe0\xb4-a@n\x1b\x9a\xfc\x1f=\xd8\xecl\xddS\xa9G\u026bOx<\x9b1\xf1\x82q\x96\x01[}:\x9cg\xc9\xe9\fBW\xe6\x13\x96$JY\xe5\xd6\xd2gQ.\xc5a\u01f7\x97\x1bN\xa5v\u02db;\xb4[\xea\xe2@\xef\xefnw\xca\a\x91R[)\x1cD\x8a\x1f+\x1cDJ[\xa6\x18\xa2V\u0198*\x86\xa8E\r\x9a\x82\xf7\x85\xad\x83\xcdJ\x88\u06bd\xcdN\xe7\xa0\xe4~A\xb9 >\xc1\xb4Sp\xbe\xc0\xfd\u037d\x9d\xf66\xbcl\x1bi\xddamXj\xef\xf4\xc7\x1f\xfb\x03\xcf}\x8f`\u0198\x93\x93G\xed\x1eS\x9e\xb4\x98\x8a\x0e\xc4\t\xf0\xbf\xb2\b\xbc<\xbd\xab\x91\xa4y\x1b\xa3\x060\xe9l\xb1x\xf8\xaf\xfe\xe9\xe7\x87\x1b\x83\x87\xe7-\xc1r!e\x01\xde\x03o\x9d\x99\xb7\xb6\x87\x0f\x1e\x9eS\xef\xc1\xe7\xcf\x0f\x1exd\xdd{\xe0-\xe9\x97[\x1aE/\xa2\xa6\xee\xe7\x7fA\xe5\x7f\xfd\xcb#N\xdag\xef\xe19}\xf0\xf9\xf3\xe7\xcf\xde\x03'\x1dS\xbdB\xd2g\x8e\xd51\xe2Q\xcfivc\u0500\x8c\x8dQ\x03\xf3\x82\xdai<\xf0\x1e\xac\xb3\xf5\a\xde\x03\xf0h{\v\x14z,\x80\x8eL\xcd\a\xff\xe3}\xe6\x0f\xd6Yid\xa5\xd1\xfe\x1b\x06\xf0\xef\x7f\x17&\xf6\xc3C\xea\xfd\xfb\a\x8f\xac?\xf8\u033d\xffy\x10\xdc\a\xba5\xb3\x96\x00\xd7\u0436\x04\ue0cb\x1a}`8\xe6\x80\x10\x92\xbb@\x9bR\xe2\u0691vEv\x8d\x92\xfe\xac\x95\xfb\xa4\xbb\xe6\x8b0kq\x9f\x90\xd6(\xe5\xac+\x9b\x02E\xc0\\2\xe2\xbe\xf21\x01\u028b\xc30\x01\x95\x96Y\x98\x80\xf2\xe28<\xf4\x87\xf8\x920k\xc4<\x17\x11\x1f\x02\x1d\xfa\xda\xfa\xa3\xf5<N\x18Q\xac\xcc\xc8'~\x8a^C\xa2\xf0A\xa3\xe1=\xd0\x0f\xb3c\xfa\xc0\v\x1a7\x9fy\xa3\xd1h\xc8\u0412A\xc3{@\x8c\x01\xf7LJX\x1e\u0634\x94\xceZ@\xeez\x0f\xa8\xaa\x06_^\xe0\xb4*\v`%\xb8\x1czPn\t\xda*\xf88\xed\x8c)\xaf\x1b\x8d\xe9)G\a\x193\x1d\xff\xca.\x83\xff9\xfb\xcc\x17\x9f\xb3\xc5gN\x1e\x9e\xcb\xf6e\x18\xc2!\xf8bCLj\xa1+\xfaq\u0323$\xb9\xbe\xc9Zc\xdf\x04\xea\xbc\xf9\xccm\x98\x1d\xed\xab\x1d\xb0\x15ZZz\xd2[\xd9\xdb\x15\x1e\xc3\xcb\xcf2\x8f\xb7\x9aM\xe3\x13\xdcd\xf6\xb7\x06=\xf7\x03\xc0\x00\xe7\x01\xcdC\u03e3Ix\xbfgg^|v\x96\xcav\xecve\xbb|=\xf4\x1a\xde\xfau\xe1\xc1^\x10\x97\x99\x1c\xfeg\xbb\xbf\xad\xebYX=\xc1\xe5\x88\u0342\xc0\xa3|X\u38a4\x02\xf8v-\xe0\xdb.\xe0\u06c3\xa0\xe3\x8c\xecR\xc6X\x02d\u0464\x1a(\xf1D\x1b\xb2\xcaP4\xb9'\xdd)\xaf\x87\x1e\x9c\x80\xdezF\x99\xf4\x0f\n\x9f\xca'\x1e\\\x14\x92\xe2\u045e\xda-_(H\bM|o\xe37O\x1b\xe1K\xd5q\xb8\x04\xcc|B\xc7>\xbc\x18{e3\xfc9\xfa\xd9 t\xd2lN\xa4\xd7f\x84\u0114\x9e\xd33\xa4.`\x9b=\xad\x8c\\Ml\xea\x10\x993Ed\xce\u00f3\n\x919\xa2\xa7\xe1\xb9r`\xa3\x06\x83\xa7\xfc;$;\xa7@p\xbe\x84\xef\x80\xe0\xbc\n\u07c1\xb9\xcc\xd0\xf76^z\x18\x90\xe3\xc8q\x12\xf9\x85\x02\x01 \xc6y\xf0+\xb8\u0347\x11\x1cc\u0299\xdb\x06\x90\x84\x1f\x1e\u0192\xde~!\xcd\xe6\xc3\x7f\x19\x0f\u00df\x1f\xa2Wb0\xce6E^\xb9\xdb\xf9\xac\xb8\x9d\xcfp;\xcb}J\u07c7\x05B\vk\xf7\x9e\x80\tE\xb3y\xe2\x13\xffm\xd8\xf7\u07bf;\xf9\xe0Q\xef\xfdG\xfc\xf7\u0247\u00d7FO\xe2meU$d\x8e\xe9\x1b\x04\xf3\xfbZ\xc2\xfdF\xc1\xf48|S\x81\xe93\xfa\x9a>\xa5\xcf\x11\x82\u01d6p\xff\x19>\a8~\f\x9f\x03\xe1\xfe\x16\x1e\xfa\x7fJ\xc2mq`\xe3\xb9G\xe8\xc7ZB\x9e \xbd|\x864\xfc\xb5\x03\xf8o\xd4\v\xff\xc7\x00\xfe5\xfd\x88\xf4Z\x7f?\xa3\x1f%\xad\xf6\xba\xf0'4\xd5d2PgM\x95e\aOKM\x9b\x96\x9f\u048f\x05\xfa\xfa\xa6\xb8 o\xe4\x82`K\x00\xff\xda9\xb83\u0175\u0790B\xca\u0183\xff1\x9d\xbe\x97\xe7\x8d\xf7@\x0f\xec\u01ad5jx\x12??\x85\xef\xbbO\x9c\x97\x92O\xa47\xf4\xe1\x14&AU\xcc\xf5\xa9\xd9\xf4?a\xa8\xffO\xa8\x9d\xf1\x89(5\xa9\xf7\x8b\x85D\rt\xe6S\xc0\x82\xc5\xc2/v\xfc\x00Gd4,\xe9\xfb\xba\x87\u0437>\xa3\xaf\xa8\xf7\xef\xcf\u0723^\x8b]1\xa0y\u01ebJ\xbe\xa3\xde\xe7\xcf\x18\ua47eYU\xe6\x8bb\xb2\x96K\xba\xbf\xbb\xb3\xb7\xf3\xf7\xaeix\xd5P: \xbb\xbb\a\x92s\xdf\xdf\xdel\x9b\xb8\xb8q\x95@\xbb\xd6\xdf7\u01c5\xbbB\xeeD\xa5\xa5c\x0ev\u0745\xfb]V*}\x93;\xf6\xdd\xf0?\x8a\x9d\x7f\xff\xa4\xae\xee\xf2\x05\xd7\xeet\u02ba\xf6\xbb\xbb\u06dd\x83{(\xdb\xcb\x1b\xc5*\xa9\xd8\xe6\xde\xd6n\xa7d\xb8\xbd\xb9\xb5\xb3\xdb.\x19n\xefm\xef\xef\xec\xa8\xfb\xc8\xee\xce>\xdeGn\x86\xf3,\a\x972\xb34\xe6\x02D[I\xcc\xd9K\x06\xc1\x18\x82\x0e\xfa\x92M\xa2\xeb\xc0\x8b\xf1\xd5dc\x9c\xb0+\x8fB\x88\xe2\xf3,\x9d\xf3\xd1a\x9a@\xe5\xec\xfc\xcc\xdf\xdci\u04c6\xfe\x87xt\x16\x8dFh\x7f&D:\r\xbc\xb6I\xf9\x90\xce\xf0\xf3,\xcdF,\v\xbc\xce\uca91\xa7I<j@3;\x1d\u0690\xff\x11]\xe48\x1a\xc5\xf3<\xf0\xb6gW\r\xf8\xaf\xdd\xc0\xdaW'\x93h\x94^\x06\x1eO9\xd3euw\x98\xb6\xa4G\xff\xe0\x04K#\xab\xed\xfe\xd6\xd9\xdc\x13\"\xb5\u04ddF\xd9y\u0331\xe0\xc6\xce\xecJ'\x1c\xe34\nI\xaf\xd9\u0624\xfc\xf9\x8a\x8f\xd8U\xe0\x1d\x1c\x1c\x1c\xd4CH\xed\xb1\xab\xba\x1b\xa5\x1b\x8b\xc5\r\xc1\xa2\xf7\x8e}\x16\u034b\xc2\xce$\x94\x16x\xbd\xdc7OHk\x9d\xb50\x9c\xfa\xc4O\xa8\x97_s\x11]\xbd\xd4A?\x80q\xa9\u03d1*\xe8(\xee\xc4\xc8\"#\x10~M\xe0\x11\xe0\x98\x8d\x89/]<_\x98D\f&E\x8c\v8!\x9d\xb6\x97\x85\by\xd1#\x9c\xd0\x1e\xe1D%z\a\\\xceS\x9f\xf8\x17pX\x9e\x86WpX\x9e\x84WpX~\xad\xef4\u048dE\x8eB\xfe\x11\n5\xd9\xc8G\xfdth\xf0+4x\x18>\x81\x06\u07c5O\x80\x8b\xd1\xcdI\xfb\x9f\xa2\x8e\u0292Pt\xe2~K\x11\u0352\xc6\xc8O\xce}\u23f4\xeb\xac\xd6p\x12'\xa3\xb7\xe9\x88\xe5+\x9c;\xe1zkY\xf4\x1ak\xf1t\xc4@\xf3\xb0\xd9T\xd3\nQh\x80r;t\xc4_\x0f>\x15\xb1@q\xa5\x1b\xf0\xf0\x14q`_\x96\x84\xb8R\tq\xab\xc3\x1e\u058aF\xa3\xa3\v\xc6\x05t\x05\x8b\x06\xc1d\xe69\xbb\x9c0\x96x\xf4\x98\xde\u0322<\x8f/\x98\x8c*UT\x80\xbbO\xfb\x19\x9b\xa6\x17\xec\xb6.\x94\xde$\xed\x83>2@\xe7\xcbJT\xa2\xaf\xc2/\x90\x05\u049a\x0f\xe1+\xf5~\xca=t\xa1\xf8\xd6=\xba\xde\xf9k\x87d\xc5)\xcd\xc0\xa9}\xef(\xb8,\x9f\xcd\xc5\x00r\xf8\xc26b\x89\x88~G\xdd\xd7|\x98\xa5I\"\x89\x99\xd4r\x1d\x8fs&TBdJ|Hg\xdd\xec1\x84m\x81%\x8b\x9aM\xfe\xa8\xbdX\xa4\xeb\xd1cx\xda\xe5\x8f\xdb\x04\u00ab\xcd2\x060QX\xeb#\x0f0\ub565\xfcg\xad\xd1\x16\xbd1\xbe\x8b\u054c\xe5\xc6\x05\xdfw\x8ec\xady\x964\xa61\x8c\x00\xb79\xcd\xc5u\x82\xda#g\xad\xc3M\xe2\xaf\xd8\xf3\x02\xf4x\x00k\xe8\a\x12L*\xae\xa6\u0615\x882\x16y\xf4F\x87\x95\x00\x1f\u05a5n\xf5\v\xcd\a\xf3\xde\u05f8\xf3uC+%o\u42bey4c\xe3`\xb4\\\xf50\"\xa7ss\x19\x8f\xc4$\xf0:\xed\xf6\x7f{\xf6<\x91\a\u0257y.\xe2\xb1VF\x96\xa9 \x1e\u03c4G\xa3$>\xe7\xa0\u07d0\a\u0790\u0273I\x92pM\xa2;@\u01175\xfdc \u0414\x1f&\xf1\xf0k\xf5i\xb0\xf1\x16\xd6N\x8d\xae|\xfc-\xc1\xff\xaf\x9e`\u035b\xcf\xd9\\\x88\x94\u07fby}\xec\xa9C\u041c\x9b\xe6\x04\x96O\xf3\x87=\xef0M\x92h\x96\xb3\x86\x1b\x1dV\x92F'\xa9f\xb2\xf9\xc5yq\x9d\xa2,K/=j\xe0\xee\u0449<\xce\xe1wM\x03\xf3\x1c^\x1d&\xb0\x98\x87=\xef\xbf\x12\xd8J\x1b\xd8\xc8\x06\xbe\xc3\x06\x854\x8f^\xc1\xf3\xf7\xcb\xfb\x16GW]\xf4\xb0\u066c\xf4[\xf54\xe7\x92\u017b\xd0J\xf1\x06\xf28GL\xd0\xec\u00b1\x9e\xec\xec\u0281B\x15\xfb\x96K\x8c\xd6\xce\xc3/\xf7\v\xcf`\xfd\xc5\xf1P\xc0\u0454\x85\xc2\xf1Gq\xebp\xdf\xfb\xbc\xb0\xf7\xcf\x04\xf7\x94\xb7\xc8\u0548\xe4\x8e\xe0\x14.<p=fd\xe9s\xb2\x12\xebew\x1c\xe9\xe5\xcdPrg\x97\x93X0o\x19\xdc,\xe1\xcdIj\xa8\xa0\xafk\xe5I\x8d\xdeci\xd2\xd9\xf5\x86H7\x86I<;K\xa3\xacn}\xae[\x87\xe9\xec\xfaCz\xa8\xcb\xd0\x1b\xa0F\xc1\x87\xe5\xeam\xa4\x82\xe0\xaf\x18\x01\xe4\xd27(&\xa4p\xfb\xb9wlD{\x02)\xbd\x92\xba\xc3I)'\x83\xd3`\xb4F{\xadi\xb6TS\xae2(\x981-\xe8\f\u3765h3,\x9f(\xee\xb2\x19V\xea\xc6C\xfbj1[\xad\xa7;4z\xbac\xa9\xb7\x00\xaa\x99\xb3\x95:\xac\xbe\xa7\x8f\x1f a\\'\x9e\x9b\x99{\xd4iT\x9f\x00kb\xb1\x10\x10\xc7\x0f\xa2\xc2\xf9\xa4\u01c3t\xa5\t\x11/j\n\x18\x86\x82\xeb8\xac\x93\xf0\xb6\xa7@\xe1\xda\u05c8\u0598\xbb\x81q9\xb2gY8\xf6\x8dP$\xab\xf7\x1aP\xd3Edlg*ocV\\G\x06K_\xe8i\xdb7A#}\xe022\x87\n\xe06\x064\xc5}RK\x1a\xf4B!KDG+\u05e7\xa8\xe0\x1c\x150\xceS\n\xc0\xf7\xab;*b\xa5\xa7T\x15w;\a\xf7\x945\xa0Q\xffS8\x86\"\xe5\xc7\xf0\x82\xaeR`\xf9\xa7\xc3\x17\xd4\x05\fu\xee\xea;\xbb\x9d}\xa5\u00b2y\xb0\xdd\xde*\xab\xb0\xdc#\u0401\xf6\xaa\x9e\xd6xU\x8f\xaa\"\xf3\xd8\x11\x99G\u06abz\x84^\xd5\xe3\x15^\xd5\xfd\x8aW\xf5q\x9dW\xf5\x18PF\x85#\x9eD9B\x1d\x02M\xa2\x8e\xa1\xb4\x80\xb7~\xc0\xe3U~\xc0\x0fA~W\xf5\xab\r\xbb\xc0Q\f\x19\xf3V\xa5\x12\x16\xfa\xa7B\xfbJ\x06[)\xae1yg\xca\x187^\xb9q\x9e-=McC\xe9{\u03e3$\x01\xbe\xc7[\xc9i\xa6\xf4\x06\xf5w\xb9\xd5\xf7\u0296\u02c1\x06\x06\xd2\xe1,\x06\xf5w\xe8\xe3y\x96N\xb1\x8f:_\u3cba\x03\xed\xb6\x826[\xd6*\xca\\\x944\xca\xec,\x03\x0f\xa6\xd50@\xf5\x8a\n\xc4\xd5\xc3zZ\x10\xab\xddTV#\x18UWhI5\x1c%:\x18\u015d\x8b%E\xf4\xffG\x15c\xeaN\v\x00\xfcJ\x9d\x97\n\x170\xd6k\xb9\xa4\xde\xff\xfb\xff\xf9\xff\xf8\x7f5<\x9a\xad\f\x93x\b\x1eJ\xd0\xef\x92D\xbd\x06\x84\xa5\xf4\xf0J\\\x86m \xa8G\x1b9\x93A\xf8\xb5\x17!\xe9x\x1e)\xc2\xfd\xe0P\x85\xb9<\xc9/c1\xa9\u04bc\xe9\xff\x97\xd4\xf4\xf4\xf4\x101\xe9\xf4\x9e\ae\xc80\x96qV\u0719y\x98\xb5\u019c&a\xe4{\x85\x19B\xec\x12\xe9\xeb\xfd\x99\xe4ya\x01\x01T\xa3\x8aF`\xa6\u0269\xb2\xcet\xc8iV\xa3\x11\x98\x01\xb8\xef\xd4\b\xcc\xee\xd4\b\\A\f\x12\xean\xc2R\xf8\xad\b\xb6V^e'E\x8d^\x1d\xfeT\xee\ufd1e\\V\xda\xfej\f\xa3\xd6\xc8\x02)\xf4~-\xa3\x8boX\x87\x99\x8c\xef\xe6\xf3P\x10\xe8G\xa4\x02\xe5@\xdc~\xb4\xe2\xfc\x98EC;\xeaf\xd3\x1f9\xd9\xd3h\x86t\xecC*i\x8eX\x9dG\xe8\b\x04\xee\x9b{\xbb\x9b\x7f\xc1\ba\xf4\x17\x9c\xdbloo\x1fl\x97\x10|\x0f\f\x11\x8a\xf8\x8d\xbc\x06\xe2\xb7>\xa5'\xe6\xecV\xe4fTy\x10\xfe\xab\xcf\xc1\xb0\xac\x18pZ\xc1\x13dR\x94\x87\x8e\x84\xad?\b\x04\x9eJ\xe3y\x92\xbc\xbb`Y\x16\x8fX!\xf0w\xb3\x99\xad2\xf2\x164\xd3\xf2Yi\x01\x04\xbb\xa9\xc71H\xb0\xb0\xd6\u0702\xf6\xbd'\xb3\x99G\xbd\xa7Q\xce^G\xd7\xe9\\x\xd4SF\x15\xef\xb3\xe8|\x1a=\x8f\x13\x94Rx\xaf\xf88=4\xf1IM@\xa1B\x924\xc7r\x93\x9ehs\x95\xa7\x82\xbb\xe9\xb2Y7\u5756\a\xe4\xee\x87[\xa2\x10\xd0Q\xfb\xb0(\x94>\xd1\xe6g\xde\x1bP\x815?~\x95\x9e\x90\xbd\x01\x95\xec?\x92,\x94DI\xb6\\rJ\x89Z\u00d5\x1c\xba1\xc7\x1b\xf3V\x85\xfeJ\xbbi\xbd\xffn\xea\xcf\xcbI\xf5\xbc\xac\xa3\xe4 \xf4\xad$\x13?\a\xad\t\xe7\x15\xacXkf\xcc]4{\x12\x8c\xed\xf9]r\x93<\xc2g\xaf\x9d\xbd\xf6\xce=U.s\xf4j\x02\x8c\n\xaer\x84w\x90x\x88\xbb\xf2#\x8d\xf9\x98e2\x03S\xbeQI\xcc~{\xf3Z\a\x9d\x83\xe4O\xb4\xdc\f&\xffJ\xa7l\x9a\x82M\xd3a]\xad\x1fL\xf6I]\xed\x9f\\\xce~kow\xe7\x1e4A\xa9\xff\xdd\xf9\b\xa7\xc2\xfe\x17U\x02\xe5e\xb8\xa0\x12\xb8\xb7\xd7\xd9>(\xa9\x04Jw\xcb\x7f% \x93R0,h\x04\xeelm\u00d1\xfc\x04\u04fe\xc2K\x02\xf7;\xdb\xf8\x94\xf8\x0e\xd3\x0eAG\x02F\xbc\xdb>\x00\t5\xa4}\x01\xf9\xb4\xf6\xb7E\xdf\u0089\xdd\u0782I\xbe\x0fo\x94-U\xed\x95l\x16\t\xc12\xdes3A\xe7@\x15\xe0\xec\xd2\x7f\xe7\x13\xd45<g\xdc'\x8e\xc2S\xc1\xd0b\xb9\xf4Mc$0\xa9T\xfe8e\xd3(N\xaa\xe7'`a\xf6?\xca\xc1\x0el\x18`\xcdT\x95Q$\u0606\x88AA\xacR\x0f\xbd~<\x8b\x04#-\x91\xbe:yw\x82U\xa4\xe0\xd2T\xbe\x7f\xb5V>?\x93\x15\xfd6\xed\xb4m3\xf3y<\xaa\x19\xf5\xd68\xda\xdf\x19\xefno\xec\xecu\xf66\xb6wv77\u03b6\xc6\u00cd\xcd\xe1\xc1\xee\xd6xw7\x1aG\xbbv\xee`\x16\x8e7\x84jC\u0159\xab\xf2\xf1\xecb\xbb\xa6l\xe7`\xbf\xb5\xd3iu\xda\xed\xd6\xf6f\xa1\xf4nM\xe9\xcdv\xbb\x13\xb4Gg\xfb\xc1\xce\xd9\xc1n\xd0n\xb7\xdb\xf2\x9f\xed\xcd\xddq\xb0\xcf:{\xc1\xee\xf6f\xe4-)\x9f\xa3#\xbc*o\xd3\xd6y\xa7\xe3$\x8dD}\x89\x98\vv\xbe\xaa\xfaY\x9a&,\xe25\x98\xe7\xa9,+\xb5`\x9a\x80-\x16\xcc1\xd7;\xaeU\x86e k\xf8\u041a\xfeI\xa4\x9e\x18\x97\xfa\x7f@\f\xa4q\x1b\x8d\xc2\xf7\xfd\xb8\x1aX\xf6\u0509'\x91\x92\xc1b\xf1\u07aa\x92a\x93\xbf&\u010fH/\xf2AW\xe5#\xff\xca\xd3K\xde@\xb7\x7f\r\xd0\u0544n\xea\x95'\xb0\xf6o\x9f\x88\u03e8\xf7\xc3\x0f\x19\x1b{uB\x90\x8a]\x12k6\xe7\x05U^\xef\xbf<\xf2x\xa3\x83B\x91g`V\x12]\xbdw\x02\x94{\u04d8;\xdf\x03\xfa\x1a\xca\xc4\xf2\xa5\x01\xb2\xa3+\xf9s@\x9f\u029cx:\x9f\xca\f\xf5\x8b]\xa1\x87\xad\v\xf6&\xe6\x95$Uj@\x9f\xcb\u06af\xf1\x9c\x94\xf5\xd5\xef\x01\xfd\xd3\u033f!\x1d\xa9\xdd(W\xf6\xa0AZe\x946k\x19\xa5M\x97Q\xdaDF)).\xb6\xb1/\ubcc1\u04c4\xc0O\x1fR\xf17YveP3|\x1eMC\xe3\xb1\xcb\t\xbaMe0\x11\xea]M\x13\x8fJ\x05\u0481\xd1/U\nT\xb5\u0700\xc1\xe7D\xb9K\xb1\xe3hi\xcf6\xcd&j^\x9bo\x88^\xef\x9br\xdc)g\x7f+\xe0,\x16\xbeM\xc3`\xdeC\x19\x8b\xcb6V\x1b\xdaH>\xea\x8e\x10\xc3y\xb9\xac\xa0\f\xcc\xe4m_\xd6\xdb\vj\x80\xc9P]\x12\x85\xc8\rw\xbep8\xf63\xbcQ'\xee\xccn\xb8Be#\x9a\x9a4b\u0798\xc9qM\xa1\x8fw\x18B\u0739\x1dL\xa2\xfc\u0765\xc6\xddk9\xd0\x19\x9d\x10\x02nxf\xfd\xc9`\xb1\xc0?-k\xcdT\xc9\xd2o\x82\x8bE\xdeR\xd1S\x8fUR\xa5\xec\xa5\x0e\xab\xea\x146\xa1V\xb1\xb4;\xd3\xfed@J\xdf!4C\xd7nY\xe6fs\x03\xf4\x1e\xe6\xa8\x18YY\xb1)\x9dH\xe3.\x9d\xde+\xaf\b\x04\xc7w\x16\x7f\x02\x91\x95\r\xe7\x89q\xe3\xb1>\xfeZ,\xf4/X\x14\xaa\x7f3_\x95\xd4)4' (\xa2\x1f\v{T\u02dc+{\xb3S\xbb7;\xee\xde\xec\xe0\xde\xcc\xfe\xea\xbe\xd6v(\xf7Wdn6\u076f.\\A5i\x16\xe8B\x91\xa0o\f\xa1\xdd)*gM\xa6-\b\x9f\xe74-Z\x8a\x1e\x94\x935\x13\x9d\x84ky\xb3) \xfcL\xca\u067b\xb1\xf9anztf\x8bD\xfc\xfa\xdd\xd8\xfc0E\u041b\x14\xacW\xb2X\u0314J\xe7\xc4\ue6a4\xa7\u0684;\xa1\xaa\v\xe6\x19P\xedO\x7f\x82l9\xe0\xda\xd54\x81\xb7F\xfc\xe3\xe3g\x88_\x84V\xe7\xe3Lf\xa2\xd3H\x1e\xae\xb5\xbbZ-rR\xd8\u88b8\xd1E\xddF\x9f\xda!Oj7\xfa9l\xf4\xa9\x9c\xdf\u067d7\xfa\x94\x9e\u02cd>\xed\x9f\xc3\x0e\x85?\xe5\x8d\xeef\u064d\xcek7\xba[\xd6\xd9\xe8\xbc~\xa3\xbb3\xed\x9f\x0fH\xe9;\x84f\xe8\u06a4\xb4\xd1'\xb5\x1b\xfd,\x9c\x947\xfa\x19=G\x8c\xb4\x1b]\x946\xfa9\t\x84\xb3\xd1\xcf\a\u06bb\r\xa8C\xdf,\xe9\t\xb8\x98\xbb\x01\u01d9'\xb0\xd8\xf4Ix\"9\x9a\xc3\xf0D\xaf,}\x17\x9e8\xe3\xa6_\xc2\x13\u0425A\x0fDQby\x02\xfa6<Q\xa4\xe0}X\x81\x1e}\x1eV\x81D?\x86_\u00ef8\x82o\xa1T\u895f\u008f\xadY\xc6\xc6\xf1\x15\xfd5\xfch=\b\u0497\xe1\u0372+\xb5\x9d\xfd\xa3\xd0\xff\xd4\xfb\xb4\xee\x05`\xa7O\xd6\xfdo\xe1\xb7\xc5\xc2\u3a48\u03a1\x82G\xe8\xafj+\xfc\x10~\xea\xc1\xe1\xcb\xf3\xc0[\xff\x14\u021f^\xf7\xb4\xff\xc3 \xfcu\tm\xbd\xec\x1f\r\xe0\x00D4\xfc\xa9N\xaexQ`\x94\xea\xce\xe8{\xe1\xa2P^\u07c1\xb0\xac=i6\xfdw\x8b\u0157\xc5\xe2'\xff\x19\xe9=\t\xbd\x14\xdb\xf0\x82\xb7\x90\xf4\x1a\x93\"\x10\x1ax\xc1O\xfeS\xd2\U000df11ed\x8b=*ZR\xc9Y}\x93 \x877H\xe04\x16\v(\xa7\x98=SN}+\x8a\xf53}A\xff\b\xeb\xbc\xf6\x01\x1f\x15wA\x0e\xbd\x16\x86FI\x8b\x87\x05\xd5,\xaeU\xb3xK3}\u0121\b\xa6ZV\xac\x96\xe9j\x99[\xcdg\xe1u\x01\xbam\xaa\xeb\xc7\xc5\xfa\xb1\xae\x1f\xdb\xfaH\xc5\xca\xc3M\x8b\xf5R]/miv\xb5v\xb8Q\xb1\x9a\xa3rg\xaa\x11M\x8b\u0d4b)\n\xfc\u02340/\xb60\xd7-\xccm\vJq~\xdee\x8a5\xea\xe7\xeb\xeb\xff\xad\x9b\x1a\x18Y0[\xd2\xdf-A|G\xe8/a\x9b\xfeVcR\"\x9aM5\x7f\xd1*p\xec\x85\x03\xa7\x94\xf3\xcb\xe3r\u06922\u6d8e\xc4k\xb1px\x10\xed@\xa1\u0755\x0f\x85\x1c\xadd\xa2\x1e\xda\x03Vy\x90\xfam\x92\xad[\xf9\xe3\xcb>\x1b\xf4\xda\x01\\8\x02\xe9\xe4\xb0\xd2\b_\xadOXh\xcaQ'\x84\xcd\\\xabGx\xb9B\x83\xcf\xca\xdc\n|>p\xacjt\xd6EV\x89\x85\xde\u0216T\xb0\xeaFR\x15\xd6|<\xb6-m\xaf4\x00\u07acG\xab\xa6\xce\u0212rVC\x8e`a\u0504K\xab\xe8\u03bb\x9c\xb3\xf6\x9b\x0f\xdbmM0\x1fx\xf4R\xfe\xc6/\x1b\x8c\xf9\xe4q\x9b \x89\xfd9\x8czn \xba\xfa\xc8\xda\xf7\xe4\xe1d\x11hV4\x9b\xbf\x83\xee#\xa0\x17\xfc@.C\xff\xc0S@\x7f\xb4\"\x1d\x8fZ\xbb\u0686\x83\x11s\x81\u0291\x9e\xf9\xd9o\xeb\x1e\xe8\\\xb6\xa5\x8f-\u0574b\xb6\xba\xce*\xfb\xa7}\xd3\x11\x1c\x17\x8bE6\xb0\\\u073c7\x0f\xccG\xd2K\xecG\xde\u02c3c\x1c\x05!\xcbB\va\xb9=iv\x01\xa9\xe0\xb1<\f\xc3/\x8b\x05\xd6\fo\xae\xa6I _n\xb3\xe5R\x87\u079a\x85L\x83G\xaf\"\xe5@\x8fI\x973?\x83\xa5\xfbe}\x1d\x1f\xccg\xa4\x87GV\xec\x13\x7f(\x11^G\u3751\x00\xbe%m\x99\x11\xb2\xb4\x12\x10A3r\u00d9/\b\x1eyb\x102\xff\xf7\xbe\x18P\xd4&!\xf4\x97\xf5u\x10?\xa9\x1b5\x86\x8d\xceX\xf8\xc6\xde&\xb3^f!q\xd8;\f\f\x1fK\xe8Z\x84k\xaa\xcf##d\xc8X\xc1c\xcf\x13ED\x1c\xc9\b#\xb5\xfeZ2\xb6X\xd4T\x84\x819B\u009fN\u07bdm\u0362,g\xd0PYB\xd8\xc8\xd8r\tX\x87g\"@.c\xa4\xa7\xcf\xd4T~\x13\xaa\x12\xb0\x17\xa4{\xaa\xa4\x9cQYl\x921g(\x19\v\xfb\x19\x1b C\x95\xb2P\xf4\u0515H\xa3|\n\xaa\xce)C4\x97\x7f\x16\v\xc9\xed\xa4\xcc K\xe8\xfc^,\xbe\xe2_\x89\x15\x11\v\xaf\xe4X\xe4\xfaf\xac\x96n1?\x05r,h\xe4jDG,\xfc\u00cf\x18\xa1_[2H\xe3\xa8'9\x9d\x88\xd1W`\xf1F\x16\v\x8b+7\xa7\xb0\xe1\x82S \xc6/\xa1\xc8K\x80\x9cfI\fln\a\x87>\x1dc\x06\xacz\xc6\u023d\xb8\xa2\x8c\u0458!\x1b\v\xe8\x1f\xb3\x81\xfacX\xf1fs\xed=\u07a0\n\xb9\x86\xf9n6\u05de\u00e5\xa2\x94\x8f\x17\x19\xf3\xd3\u0493\xdei\u07e6J\x98\xc7l\x10f\f\x12\x83\x9f\xfd\x98Q\xf9\xdb>\xb44n\x83\x17}\xa9\xcfl\x84\xae,\xda\xcbX\xd07eh\xc6\x06u\x005\xdc\x04\xc2\xeb\xf7\xfb\x81\xebw\x9a#\xb4~\xef\xe7r\xb29s/4\x8bE!\xa3\b\xc3BV\t\x80?\xfb9S\x01\x99\x9a\xcd\xd3f\xb3v\xae\xbf\xf9\xc6S\xf1K\xbcw\xc2Q\xf3\x85D=\xa7t\xf1j\x10xO\xf8\xb5\x98\xc4\xfc\xbc1\x8cx\xe3\x8c5&,c\u0792\x04/K\x97\x88\x0e\xdcG~Y_7w\xc8/\x8aWrd\xae_\bMX\xc8\xfc9 \xbc\xbe\xdf\xebA\u03d9\\\xf4\xb9\xddO\u0366s'\x00\xd2n\xb3\x88\x1dr\u0094e\xa0^\x90!\v-S\xe5\x8a=\x8bLU1\xe7\x97G\xa5\xa4^\xe9{\xe3\x97`\x8b\xceX\xd8\xe9\xce\u0623p\u023a3\xb6\xbe\x8e\u06ea\f\xd6H\xce|\xcc\xe0\xc63f}\xaf\b)o}\xc6\x06a\xc2Zfn\xd4Nf\u0314\xc9\xdf\xcbU\u057a\xbf\xac\xaf\x1bq\x8fD\xcb2\t|\xab\x86\x83dh\xc2\xe8\x88\xd1)\x93#{\x8b\xd4\xec\xad$f\x86\xf1\x1a\xb1\"\xef;b\x9a\xef\x1a!\xc8\tR\xbd\xb7\x96\u8f6d\xd0<h\x1eH\xef[)\xa3 d\"\xc9\x1f\x16\x96I\xeaJ\xbf\x8a\f\xfe\u9fd5\xe2\f\a7\x96\xda\xea\u04f4\x8e\xc2\x10u9\xbc`]\xd5\xcb\x05\vu\x96\xec\xe5\xe2;{A\xa0#\xf0\xfc\xb5h\xb1\x88\x9aMCx\xcd\xe22\xffm\x01o',\xec\x97\u048c\x83\xfe\t\xd0\xef\t#\xd4mI\x91\xf0\xc9\xed$\x9c\xbe$\xc1\x84-%\xc3%%\x87\xc84\x91\x17r'=yn\x92\xfa\xedA\xd7\f\\;I\xeb\xd6\x1c\xe5\xfe\x8b\xf0\xd8\x17\xdad\xf8\x9cI\xfc\a\x91\xbd\xba.\x9e3\x94D\x94e\xfc\xcd\xe69[_\xa7/\xc2su\xa8]3\u025b:U\xaf\xcbUen\xb3y\xcd66\xe8\x8b\xf0\x9a\x91e\xdd\xe1\xf3B\x19\x12\xe9+\x8e|&(_|t\xaa\xff\x02o\x9a/\xd4\xfa\xbe\xa0m\xea\xe4\x13\x19\xaeXo\ucea6t\xaa\xbd\x06\x9e\xb1\xb0\xdd}\xa1\xef\x81N\x91.y\xb1\x1e\xbe\u87f1\xf5\xf5\xff\xd6\xf9\x03\x9c\x03:\x1e\\s8\x9aH\xad\xa9:8^\xb8\xe7\u018b\x01,\xe5\v\x10\x8e\u053e\xa1J\xcf^x\x89\xd6\x1f\x842GV\x03YJ\x04\xa0N\x1eB\u0652~Z\xe1\xf1\xe1\xa3\xfc\x04\x9b8\xe4\xff\u020a\xb7#`\x05\x9f\xa0\xb6\xd2\u0348\r\x93HjF\x80\xd6`\xccGh\x90\xf2YxK\xb2\xa4\xbf\xae\x8a\xd2cz\xea\x90%}\xb9\xa2T\x9f\xd1\x13\x19\xd2\xe0\x04U*\x06K\xfa\x03`\xf0\xdb\xd6\x1f\xc4\xffD_\x12\xfa\x93\xf9\xfc\x95\xbe$K\xba\xbf\xbf\xff7u\xffP]\xc1Q\xe5J\xadq0X\xfd\xa2FCgs\U000debbft,\xca\xf7G\x87\xd8\x13cT%}<~\x8d)\u00a4\x003\x8bI\xdc$\xbd\x7fr\xfc\xe4\r\xa6e&\xed\xe8\xcd\xfb\x0f\xbf\xcb\x1c\x1b\xb5UN\x83Q\x13\xe5\x0f\xf3O09bT\x86\x0f\x94\x01;1-\xd6i\x18f\x0e\x93r\x99\xf4\xe6\xe3\x87'\x10U\xd9\u035a3\xfa\xfa\u074bBR\u00a8\x8eT\xe74;\xb4\xa9\xb6\xe8L'\xd6\rnl\xe6\xf5\xee\xfd\xd1\xf1\x93\x0f\xaf\u07bd=}s\xf4\xe1\x89\fz\x87E&\xa6\xc8\xf1\xd1\u027b\u05df\x8e\x9ea\xf2\xa8\x92|z\xf2\xf1\xe9\x87\xe3#Yk*\xa7sr\xf8\xf2\xe8\x8dL\xb9`\xd4\xf1\xb8\x05)\xe7:E\xfb\x89\xc6\xd4k\x9d\xfa1\x93\xee\xa5\xceX\u0673\x16\xa4^2\x8a7\x8f\x0f)$c\xd2\x11\xa3\xca\x01\xb7)u\u02b4\u03e9\x92/j\xcc}\xc2\xe8\x10\x9d\x89\xbe\a\xb5#L:,$=\xbd~\x05\xfb)\x16R\xf5\xf2]y\xc0nc_\x18U\xbeK\xab\x05\x0e\xa3\xe1D\x96z\xc5Ld7\xecAE\x89\xd3\r\xa3\x11\x03f\x98pHX\xe0-\xa3\u00c4E\u0667j\xdd\xf7z\u0107\xca\u05fc\x8d0wl&\xa3\xfc\xce\u06ec7\x8c\xe6L\x1c+\r+Lz\xa6\x92\x10\\\x98\xf2\x1aS\xde\xcc\x05p\xb6n\xc6SF\x93\xf4\xdcMy\xce(\x93\xf1\xeb\xdc\xd4?M*~~T\xb3(t\xfb\xcd$\xdaz\x9f\xb0c\xa9k&\u0547\x98\xeb[`\xe7`\xbb\xac\x01\xa4\\\xf3\x96t\xfb;\xbb\x15\xfb\x15\xa9=T\xd0\x00\xda\u0742p\xf4E\r \xa5\x15T\xd0\x00\x92j<\x05\x05\xa0\u076d\x83\xbd\xfd\xb2S0\x19\\\xbd\xa0\x01\xd4i\xb7w7K*@\a[\xdb{\xbbe\xa7`\xd22\xa7\xa0\x02\xb4\xd9\xde\xde\xd9)\xa9\x00)\u5982\n\x90Rnz\x8bi\x1f@\x01\xc8(F\x1dc\xda{B\xdf\xd8\x10\x7f\xcf0\xed\r\xa1\xaf\x11T\a\xa0>\xf4\x14\xd3^\x13x,\xf0;\x9d\xce\xe6>x\x13\x81\xb4\xe7\x84~\x84\xb4MP8\xfef\xad\x7f>\xa1[\x87\x1d\bX\xff+\x16\xfcD\xe8K\xa9-\xd5&\xf4\a(\xb8\xd7\xde\u0683\xa3\x02r\x7f \xf4g\\\x85M\xd0tz\x81i?\x13\xfa\a\xeaR\x1d\xec\xee\x13\xfa;\xa6\xfd\x01\x12W\xa3\xd5\xf4\x9b\x8a9\xe3Q\xed5c@\x19\x88\xd5glx*7\xce)\xfc\xf6\xa8(\xa5\xa2\xcd(/%J\x1f\xffY)\x15U\x0f=\x9a\x96\x92\x19\xecH\x99y\x1a;\xa1\xb1u1\xbd\x9bu\xfdXg\xe4L\x9cj\rF\x8f\xe6\xc5dDu\x8f\xce\xdd\u0529\xdcg67\u0479IznS\x87:\x15w\x8d\xd3\u016c\x9c\xa1*\x8c\x8b\xe9\xe5\x01OJ\x136F\x9b\xa7S&\xa2S\x19;\x86\x8eJ\xa5l\xa4\x83\u929c\xd3\\\xd2>\x8f^@\t&Ne\xb8\v\xcf\x1e\xee\xe7\xcc\xd5\xed\xf1E\xc8\xe8O\xc8p\xf4\x04<+9^\xd5\x048\xdf\x02oJ\xdd:FT\vA\xa4si\u01a8\x8a\xca\x1a\xf0\xe5\xd2tv\xcd\x1cK\v,8\xb2\x05\x99S\xf0\xacRP\xd4\x17\xbc\xac\x14\u4142\xf8\xce\xc7\xee\xa5(\x1f\nt\u062f|SJ#\xf0BH\xc44\x14-\x96e\xba\x80\x89\x99(2\x1aK\x9f\xb3 \x97c\xe0\xcf/\xf2\tM[\xb8\xd8\xfe\x8dt\xe1\x1cxxVf\xde\x12H\xe4\xc7\xd6\x1f\xef[0H\xbfFhWP\xf3\xf7\x194\xc5\xd9%\x1c\xa8GY\xb5=\x9a\xb0\v\x96\x042\xce\x1e\xe0B\x9e\x83%\"ke,\xcaS\x8eN9\x02P\x10\u03fe6\x9b\xf2o\v\xd2z\xce\xef\xf5\x8e\x92\xd7Y;\x9a\xb8\xd9tD5C\f3\xdc\xe3\xad\"\x1b\xe0\xc7\x04LC\x97\U0010a07f\xb3SvG\xd4\x04n\x03\x9a\xb8\xb0NC^\x82u$\x1d\xaa\xeb\x02p\xa6\x8c9\xcd\u00f85f\xa0\xcd;\xc7(d\x88\xe54\t\xe3\u0593\x93\x0fth_C\x92\xde\xcd2H0f\x80\xe3\x15\xfb\nL\"\x8b\x81\xa6\x9dQ\xb4\x1c\xc6\x05\xc2~[\x81R#\x8f\xf9\x905.\xb6Z\x9dv\xab\xdd\x00\xb3\xea\xcb8I@\x92#\x1d\x1e\x8c@xu\xb1\xddj\xb7\xda\xdd\x06\xb0\xc2\xf5\f\x0f\xfaIb\xd1h\xcd#\b\xb0\xb6v\xfc=\xf3\xd1\ucc45\x1e\x85\xb5\xac\xebM4\xccR0\xf2h\x19\x8dl\x99t\x1e\x8e\xb5\xbf\x90W\\\xb0l\xc8f\"\xcd\xe8\x19&KJ\xe4\xa4w\rP\x18\u0792R',\x05\xa1\xf6\xb1\x04\xd5:\xd2\xd6<K\xb4V\xc7e8\x04\u8f4e9{\x8b7\xe1\xe7i\x06quz\xf5\u026e\x1a\"\xb8\x87I\xf5\xee0\xe1/\x1as\xff\x06\x97/\xc8)\xe4\x05\fc\xf5<K\x87\x81\xa0\u0579\a\x13Z\x9cy0\xa5\xd5y\a\xe7\xb4f\xd6\xc1\u0652\xb4\u0104\xf1zw\xbd\xd0;\u06a6\xe1\xa6\xc9e\fv\xb5]\xa5w|1\xc9\xc0\"}I\xd0\xfb\x19'\xa0>V\x8c\xbc\x9e\x86\x17\xab\x8c\\W\xefd\x86\xdbM\xd9\x1b 0/\xfd#j?\x95S\x17T\u7778\xc5\xecO\xe5a\xaf\u5672H\x00BM\x00\xf4mW\u0340j\x1f\xf2\xa1\x0e\x8d\x93y\x14\u07db\xa9\xa7h\x85Go@\x12\xc22\xf0U\x0fWXiq\xc3Z*\x7f)c\xabw# C\x1f\xb0\u0663,{\x8aT+\xb5\x86w\xad\"c\xee\v\xe9\ub0de0\xf0\x92\xf9\x95\x85/|\xe2\xcf}\xe2\x8f}\x82\xa4\u01eeM\x83\xf9\x8eo\x1c\xf9\x06Os\x9a\xd0!\x9d\xd1\tE?x\xf4\x88\x9e\u04af\xf4\xd0Xc\xf8\x04eB\xc5\x15\x06\xb9D\xb7K\xf2\xcb\x18i*z\xde\bY\x8b\x83)\xcf\xcd0\xca\x19\xbc\x9e\x8f}\x11\x9e\xb0V\x8eF\x1b\xe4Ff\x87\xdb\u0773\x8cE_\x97\xb5k\xe7\x8d\u0619\x9a\x99\xda\xcdy\xd0\x18\xa5\xfc\x81hL\xa2\v\u0588\x1a\xb2\xb5\x86H\x95\xcf\x05\xd6H9mDgi&b~\xde\x02\xb1C+:\xcb\xe63\fR\x04]x\xa4\x8b#\u0686\x11\xf1\xe2\xe9\x92\xc9\xcf\xe2\xe93\xe64\xc2x\xba\xee8h\x1c\xa6\x8a*\xe6a\x8a\x940\xb1\x940\aJ\x98\xd3a\xe54\x9b\x95\u03bb\xc8\xc0a\xffV8H\xeb\u0206\x8aa\xb3q\x98\u010c\x8b\xc6(\x96\xf6z\xb3,\xbd\x88G\x00\x8e\x7f\x17G\xf9\uf18a\xa1\xdf\x18\xa5 \t\u7a58\xdc\x01\x96\xfd@\xcb\x01\u00e4\x9e\x10%\xf7 D\xd3ph\t\x11=/9{\xa6g\xe1y\x1d\xd9=\n\xcf\xcbd\x17|'\u0590\u076f\x98\\!@T\xa1^\xa7C\x15X;\xdb\x14\x1e\xe0O\xf4S\xd6\t\xa3\x15;\xa7\x95\xbbCP\x15\xc8\x106\u014c\x9e\xd3KzH\xbf\xd0W\xf4\xc3\xdf\xdf\x0eF\x96\x86\xc3\u0724B\x82\x7fSg\xe4@0\x19\x174\t\xf1\x90\x9c'\xe2M4C#A\x00,\u063c\x1dJ\x7fHzg\xe8)\xef\xd1\u021f\u0454\xdeh2?\x94\xe7K\x1d\xb1?+\x13\xfb\xa3:b\x7fZK\xec\xbf.\x15\xca\xec\xc1N:\xd7\xe3\xbd\f\xcfuT\x96\xc3\xf0\\\x12\xfd\xac\x15%\t\xe2p\xee\x13t\xb5\t\x94\x1dI\xff\xd3k\x7f\xa5\xae\x85\xa6\xa6\xd6w\xa0\f\u0532XX\xaaj\xf3$\xc1\x85\u0735+\xe5\x16\x1a\xd35\tw\x14\xe1\xebm\x9f0re_\f\\\x1d\x9e\xbe\x18(\x0f\tp\"]\x92f\xf3\u04b1\x86\xf3\xbf\xe0qt\xa9\x1a\xbe\\\xe1\x05\xa0|\xfaL\xfc\xe9\xff\x8f\x9c>\x94\u05dc>_\b\xa1k\x87\x8b\xc5\xdaP\x05\xf6\xf2aE\x9c fk\x00\xb8\xf6`\xb1\xa8\x043\u00dc\xce\xc0P\xbd\xceN\x91\xec\x99d\x90\xbc\xb6\xf09\xc4'\xfe\xab\x10\x84\xbe\x1f\xc2w>\xf1\x0f\xf5*~\xa8\xb56Hg\x8c\xbf\x1a\x1d\xa6\x9c+\x1eZY0\x18o\x10\xaf\xbeg\xff\xdb\xc8\xc3\xff\xd4~\xe7\xe1\xcd<K\x02\xd1*\f\xf4c\x96|\u03fe3dNo\xf9m\x1a\xfb\u071ck~\xa6\xf6\"q\\\x84\xe2\xee[,24\xfc\x9f\xe7\x8f\xc3\xedv\xbbW<et\xde\av%\xd6\xc1\xc31\a\xc2A\u0283\x85\u0fe1\xab\x01\xd1B\x93]Cq;rI\xf5q\xa2\u01ba\x0fH\xda\x06\xbbP\u0121\x0e\xa1\xc5\xce!W\u0360\xd3\t\xe0\xaf\xc7\xf8\u0233t2\x17\xe9\xccG/fL\x1a\xac\xf7\xfb\x1d\xba?\x18\xb8\x96\x89\x8d\xba\x87\xb3\x15\xc6\xcfK\x1f\xea\xc9\x0ewt7\xbf\xa3\xe1dJ\x0f\t\xfd\x1d\x1dc\xe3\xcf\xcaYIo\fE\x0e\x12\xba\x82\x1e\a3M ;\xfbwLh\xc5\x1c(\xbf\xcf,p\xab\xa8}\xee;\x03\xf3\x87\xf5a\xf2U\xe8\xbfo\u069d\x8cR*_9\x8d\xa1swQe\x97\x06r\xdb\xc1\xa1&\xfc#\x960\xc1\x1a\x86\xc5S,\xa8>\xe16]\xb4\xe8\x1ch\xbc\xe8\x1c\x94\x10\xe36\xcc\xd8\xdc\ff\xadZI\xb4\xdf\x1f\xd0C{N\xea\xf2[\xf7\u0164\x0e\xed\x1c(\\\xa2\xe0\xbf\xf5\xc9\xfd\xc4\x17\xddW\xa8EwQb1\xea\x8f\x01I\xc4\xff\xe7\x7f\xbc\x82s\x1a7\x1d\xec\xa0\x16\v\xffD+i\x12j\xa0\x19\n\xfa\x95a|B+F:d\xd50\xfe\xf2&\x95Yy\xcc\r\x1c+\x01S\x04>\x93\a>\x1a\xeb\v\xf9\xfb\x15\x0f8\x8d\xf3\u07e6\tz\x8c5\xad\xbf3\xad\xdf\xd96\xb6\x13\b\u0547n-S*\xdf_\xea\x05\x14\xb2\xb5\u9a91\n\x90p\xbcb5\xcer\xeb\xeb\xf5\a\xaa\xa2\x83\xdb\xd0\u0107\xdbz\x8fJ\xad\xbcAv9`:\n-\x0e\xe2-+\xbd\x13\x96!\x92\xaeje\x05\xac\xa52:>y\u0207\x89l\xe9.\xeb\xfb\x8axm\xbc\xa2\x03w\xb9\x8eYuz\x93\x95\xc0\xa5\xcao\x8d|9Q\xc2N\xb7\xb97\x7f\xa19\x1d\xe5\xd76\x87\u03ba\u064ag\u059brc\xf2\xb2\x12\b8\xf6\x02\xbe\xa4\xd8i\u0316K\xfa\xfa\xaf\xb4\xf1\u0374\x91C\x1bO\xff^\x1bsh\xe3y\x1dY0\xf5\x99,\x99@\xc9?\xd9}=j\x99;f\xf1j\x18W.\x8fy\xe1\x1aE\x13\x15\xea\xdd\x16\x18\x86\x92\x89\x04\x8c\x03\xc5\u0396\xba\xfd\x01Wi\xa4\xdb\x18\xb7\x16\xeed\u04fa\x8b\xd55&W/V`\x04\"i\x7f\x17\xdd\xe5#g\xed8- \xcd\xe6[$\x85\xf8<\x1f\xd6\x14\xb8\xd5\u05105\x9b*h\x18\u058b\x92$\xbd\xb4\xfb\xc3[\xe1\xcdK\xa0\xd2Pl\xef\x8c\xe6\xed\xf0\x84\t\xb8\xff?O3\xbf?\xa4\xb3\x01\x15\xb2ae\xa3\xa1\xbeb\x8e\xbe\xe8\x99m \x0f\xdd\x0f\xd0\x1dR\x9eV\xfc6\xfd\xa55\x04c@\xea\x96 ]\x7f\x8d/\x16\xbc\u0644\xd1s\x19E\x00\x95\x1fl\x99~\xa1\xefA\u806bq\xa9\xf1\u01b4\u00d3\x8fY\x12\xfe\xea\x13?V\xa2\xc0\x96H\xb5\xa96=k6\xcf\xec\xf2\xbd\x1a\xf5\x98\xfb\x15\x16\xf2\x823\x8cM9\xc3!\xb8\xa5\xd2V:{5\xf2\xcf@\xb6C\x88\t\xc9\xeb+\xbd\x9aKz\x14\x1e\xc3M\xc61\x1e\x1eR/0\xcbvIg\xa4\v\a}v\xc1\xb20iI/\xe3l$\xbdR\xf8Gd\xb1\xa8$J\x81\xe6\x15\x96\x86\x84OQ\x16\xc3u#\xf7UH\xfd@7H\x8d\xb1Mp\xb44\f\xc9\xe9=j\x9a\xd2]V.\x1b>\xf3\x89\x7fEt\x10\u036b\xe0\x94\x1a\x97\xcf\u0281)\x989\x87IM\"\xaa4Sf\xb6B\xb9|%\x15+,\x16\u078f\x0f\x7f\xf4p\xda'\xf4\xabm\xf9i:\x92\x98,\xdb=,\u6531\x16\v\x01z|\x05\r,\x98\x1eany\xdcc'\xc8t|U\xcb\xf3\xb5vW}+\x05:\x96x(\x893\t\x9cK\xd1I\xfd\x8d\x18\xe3\x150\xd2k\xe3%[\xc21X\u00ed\xf0\x0e\x03\u0353\xc5\xe2\x10\x1bE\xa9\xa3Y\tP{+\x8e\xf8\xebR\x86\xbcx*\xdd\xfe0\xd2}\x17\xa6\xad\xb3y\x9c\xe8\xe7u\xb05\x89Z\xf6\x1d\xdewH\x99!d\xef\xb4\xf7\xe2\xd2\x1dN\xac\xba\xc3\t\u07daj\xdfr\x87\x13\xe5;\x9c\x90\u0329\xa8\xbd\xc3\t-\xb39WL\xf9\x9cc\b}6\xa2}> %A\x0e>i1.h\xaag\x9f\xa9\x99\x16\xf5\vj'\x9cb8\xc7\xd2\xe5#S]\xecV\x99[\xe10\xb7\xa2\xfeV\xc1\x1c_/+\xef\x14]Vs8\x84_\x9c\x1d\xe1\xa6_\u3abc\n\xff\xf4\x89}vH[J\x03\xc2g\x95G\x01AnDk4\x97\xd4IV\xdbx\xa5\x97_\xb6_\v\x0e\x89f\xf2\xa2Ph\xcd{\x1e\xc5\t\x1b\x81\x1c\x18\x85\xb2\x9e\xb4D\x91\xb2\fT\xf2C\xe5O\b\xad\xabS\xc3\a?\xfeX\xaa\xd5\xfa\xf1\xc7F\xe33\xff\xf1\xc7\xf7i\x9e\xc7g\tk\x1c\xe3k^\x1e\xfc\xf8c\x03\" m4\x0e\xdf\x1d\x9f\xa8\x9fo\x99\xb8L\xb3\xaf\rhd\x9e1\x95\xfa\xf1\xf8uC\xbe\xf76\xa6\xf3\\\xc0s\x95\x8c\x92\xddH3\xf9+\xf7@\xdfW\xb6\xa4\x80\xdcz@\xee1\xfb\x1b\xe6\xf8\xc8\x036\xf7%\x90\xbc8J\xe2?\x99\xf4\xe2\x87\xfbP\xca\xff?\xb2\x7f\u06a1\x13\f\b\x9fo\xd4p\xa4\xf7\x01F\x7f#\xab\\5E \xcd\xe2JL\x1e\xab\x17\xa0\xa2\x97}\x97\xe3\x81\xe7\xc5\u06e2\xe8\x9b\xeb'>>\x9a\xd3M\u0296\xf0\xb6L\x87a\xdc\x1aZ\xaa\x8c47\xf7\xfb\x82\xf2\x81\xa9<\v\x875\x14\x9f\x8e1\xb9B\xd8\xe9$|x5Mt\u021c\x19\xa8\xc68\x1c\x87\xdb\x03\x9d\x18\x12\xa8\x85\xb8\xad\xa2n\x10F.L\r\x15\xb8YR\xf54\x17\u0267\xb99\u056b\x0eos\x92\v\xe5VD\x9b\a#Z\x1d\xb9\x8e\xab\u0382\x84\xd6L \x18/\x8b\xb7\xc6o5\x9c\xfd\xb0\xca\u066b\xee\x85{)\xf8TSuv\xbf\xaa\xbf\xb2\x12\u04cd\x95/\x9c\xcaj\x12\fa\xe0\xcc\x1f\xbd9m\xed\xb5\xb7\xfeB\xd8\u04fc\xe0\xfcW\a\xd0\xf4Q\x93R\xeaOmm\xed\xefwdP\uaf7d\x9d\xf6\xbe\xa3\x82\x99\xdf?\xa6fL3\x06W\x9f,w\xe2\xbdG*3\xa56&K$c\xb2\xe0X\xbe\x7f:Gz:8\x8b\xfd\xbd]PJ\x8aPq(U\xc1\xe9\xa5\xe3\xb8B`m\xa5\v\x95`\xda\\\xfa0V\xbaZ\x18\xdf\xdf\x1fj\xd7p\xa0\xe65\xc1\xa4\xb1r\xef*\u0563\n\xa1X\x94\x02\u0539US\xba\xb6Q\x1d\xcf,H/\r\xa0\x95\x8b\xb9\xa3\xd0\xcf\xc0\xd6\"B\r\xde\u02d6\xa3\xa4Z\xcf\x7fT\xf4]DKaKO\xf9\xfaU\xcaO:Y25\xe5\x0e>\x1e\xbf^%\U00057b60\xb2\x94id\xdd\xf3HM+ \xf1\xbc\xbd\x19\xa9^\xe5\xb7\xe9y+}A|\xd3 \xa9kN+\x8f\xde\xd6\xe4+\xee\xf7=\xa3p4\xf8\u07a6\xb5^j\xad3f|\xebT\xad\xd04\xccT\x14\xad(\u0310\xd0wK\xe3H\xf0rgG\xa3i\xb2\xf1)\xc3i\xee\x13?\"\u010e2\xad\x1d\x1d*\xe1\xae\x18\x12M\x9dA\xc1\xa3+\x9e91\xfeP\x92\x14:\xd7_\xaf8\x1d\xea\xdft\x16\xa6j\x06\xe30m\xa1\xfc\x89N\xc2a\x0f\a\xf3\xe9\x80\xf8C\x12\xc89\xd8\v\u039c\u008b\x8a\x19\x7f\x8c\xce6{\x92?>\x05\xb79\x81\xfc\xed\xd5\x01#\x03oALD\xe8\xbbOL, 2\x05\b\xda/8\xf5\x9b\xd0\x11\u0123\xaf\x83H\xadf\xf5\xf7@H\x8a\x83\xee\x80SE\xea\x84vT\xf1b\xb16'\xa5Gg\xa9\xa5\xf3\xab\f\xac\x1d4n\x1bfch\xbc\xc6*w\xe5\xf0\f\x8d\xfd\xfe\u032e\xf1\xa1Y\xb9\u04f9\x13\xfa\x7f\x1b\xcaVM1\xf7\xe8\f\x1c\v\x15\xa0]R\x03\xff\x8b\x00\x96\xf7f:\x0f1\xec\xc7m\xec\x8ar!\xe7\xee\x9e\xe2\x1c\u0316\x01\x92\x8c\u0355\xd8\n\xe23\x1a\x95y\n\xa6\x04\xe0\x7f\r\x0fe\u061d\x8b\xd68K\xa7?\x9d\x00#B\x8a\x02\x15\u05d0\xbc1\xc3q\xcf+\xc2\x1c\xd9\x06\x86\xce\xf1\xeb%3\u05b6\x03w HN8\x1d\xc2\xe9[\x9ch\x8d\xc4\x06gMyA`\xc3\x1d\x81\r\xcde\x9b\xaf\xbeB\x9b\x19\xbd9\xbb\x86\x00:\xc7\u028e\xfdp\u0086_\x83TKp\xe3\xa5cG\xafh\xaa!\n\x9cP\xf9\x84\x9a{\x83\"\\r\xa0]\xf2\xbe\xe1\xe2P\xadA\xc1\x9d\xf4\xd5\xc1\xa1\x15\xab\xc8W\xad\xa2\u0111\xec\x8eU\xc4hJ57\xbc\u025d\xfeC\xd4\u0465\x80PiU\xcd\xdf\x05\x81k\xd3q\xbf\x93%c\xb99Wh\x1cf\xea\x02\xd1\xf5y\x98\xca7\x9e\xde\x14xbs\xbdA\x1bu\u0312\x9eY\xb4v\xa5LR_T>V\x1e\xa6#\x9da\x13\x96T\xa6hv\x98\x04)i\xa9\x00\x9e!\u05ff\x8cxO\xeaZ\x98\xe3V\xfb^\x8dh\uc739\xdc\u07a5\xaf[\x7f\xb4\x9e&\xe9Y\xb3\x99\xb6F\x91\x88\xdcP\x8c6\xcf\xcf\xc3|E\xb3\x14\xc3\xfe\x80\xcbV\xac\x0f8]\x051Z\xb2\xd4B\u0601o&}\xa1\xa12'\xc2\x17\xb8\xc1i\t\xd5\xec \xf0\xee\x90{\xa0\xeb\xe5L-\xab\xaep\xc9\x1c\xe7\x1f\x1d\u01b4 \xf7\xb8k4\xb7\x98\xe9\xac$\xe1\b\xe39\x1d:#\x84[\x1f\x8e\f\xeey\x92S\x98\x84C\b\x91FG\xe1m\xf4y\x86\x91\x1e\xeeAjg\u0121\u0456\xee\x83{>\xe4\f\xad\xf7=(=\" =s\vF\xa1\xcb\xec\x19'x\xf5\x85\xe3\xdbx\xb1\xd8T\uae47\xe9\xdczG\x9ec\x89)\xa1\xe0\x82\xad\xb8\xeb\u01e4\xc4E\x17\xed\xad\xee\x83\t\b\xe7\xb4\x06\x03\xe4\x13qyCd4\x1d\x90\xba\x1e\xef\x8dz\xf7\xebP\xa3[\xa5?k\xb0u\x9f\xce\xe4=\xf56TO\x9b\u0368g\xf1]VPh\x9e\x91 ],\x8c\x93\x9fj)\xefT\xdd\xf8\xa4\\\u00c3*0\xd8\fb\x86\xc0\xcd\xea~\xd7\xc6$\u0285\xd4\x1aD\x83(:WFeo\xa8\xd2\xcb\u00efg\xf2K\xea\xb6C\xc2k\xaaY\x1a\xfc|J]\x15\x02LzNk\xb4\n\xa4\xd1\x13\xbd\x8d\x1dR\xbe\x93sm\xa1\xf6I\x9f\xd0\xd2Kr\xcc\xc7)\xfe|I\u0655`\x19\x8f\x92g\xe90WN\x92/\xa4\xdbn\xfc\xfa\x89\xe6l\no\x00\xf0\xf13J\vd\xb1\x17\xd4\u0204d\xc2\x1fT?p\xe2\xe7\xefT?P\xe2\xe7/Tk'\xe1\xe7o\xe6\xf3\x19\bsc\xdb\fct\x1c\xf3\x91M\xd6\x06\x94\xa3RA.U\xabQ\x1dR\x19O\x82+\\m$)W8\xd7\u0191v\xb0\b\xae4\x15\xaf\xf8\x84e\xb1P\x80\x8e\x19\x15\xd1y\xae\x8d$Et\xfe\x8c\x89(Nrm\x1bYl\xe0\x83.\x9b`\xd9s6zW\x84\u0190\x19\xf1P\xae\r$\xf5\xa6\xd06\x91%\u04ac\xed u\xbd\xe7\n\x9dF\xa6\xa6N\x99\x96\xeb\xea\x8c\vF\xf15\xefCv\xfdJ\xbc\x9b\x9b\xf4sf\xe5Z0\xfc7LD%\xab\xc3kFo\xe3\x13\xb5\x8dd\xa5\x19m&i\xe0S\xc88b\xf4\x9c\x89\xf7\xba\x12\xa6]1:\x89\xf2\x97z\xadN\x19-q\xe2\x98|\xe2$\xe7\xaf\xe4u\xea\x95D\x86\xaf5Y(|\xd3\u0596e\x99\xa4\xb1\xb9\x94\xca6\xdaNQO\xeb\x1d3\xb8\xaa\x82\x9c\xeb\x9c/\xcc u)\xe7\x953c\u01c2\xf0\x03\xa3\u00c8\x1fI9\xa4\x93\xfe\xd6\x1ad>e\xe34cG\x8e\xc1\xe2{\x04\x12lO\xcdV\x1f\xdb\xd7\x1cW\xb8\xa8\xac-\xe3\xfc\r\x1b\xc5\x11$a\x17\x91\xf5>q\xf4m\x1e)\xd2\xc3\xee\b)\xac\x84W\x05[F%\xbc*\xd82J\xef\xe3\xc50E\xd2\x06\xb0`\u02a8l\x05\xef2eT\xa6\x87w:3W\"\xb3\x82)\xe3\xf6\xdeV{\xb3d\u02a8\xfc\xa5\x17L\x19\x95Ue\xc1\x94QE\x14{e\xe5h\x1f\xact\rB\xaa\x9f3\x01\xac\x06\xc6-\x98\xa5\xb9@\xef\xbep\x9ey\xd4Kg:\x9c\x000\xb3\x92#\x81\xb0C\x9e\u0222!\x1c\x1a\xefWG'\xfb`\xa2\x93\x1d\xc3M\xea\vF\xb0z\x7f[\x04+s\x98H\xc9\u061b{V\xc3\x00\xfbP\xe1\xd9=+\xa08\x0f\x9eQ=\xa8\xf5\xfa;j\x9d\x18\xe5_\x8f\xa7b\x83\x8db\x91f\xd8\xca\xd3{\xb6b\xa4w\x1a<8\xf2\xe7\xf7\xacm\xcd\x02\xcb-\xfc\x19\u05b0\x14eFq~\xb7xM\x06n\xd3\xc6C\xa4\xec\xef\xd6\xd1\x15\xfc\xe0<\xfb\x82\xd7-\xf7\x9b\x839\x19\x8eX\xba\uc1b0q8\xe2w\u0648el$\a\u079a\xb2\xec\x9c\x01\xd9T\xbc\x06h\xc1|\xbb\x03\x14\xb76\xf3\x91\xba`&\xb4\x0463_\t\xb3Ou\xc8\xfb\xd4g\xe8\xd9\xc1\x8c\xe2\x13\xf5+\xcaa\x10B\x17\fqk\v\u0676\x9e1\x9f5\x9bj\x10\xc0z\xa8\x8e\x7f\xf8\xae\x8a.\xa3\xa2\x1a\xf8\xc96\xf0r\x95\xa6\x8b\xaa\xadx\x1a\xb9C~\xb6\xf5~\xba\xc5\xf9 \x86\x03\x0e\x1f^\xf4\xfc~{\xe3`\xf0#\xf9\u072a\xfez\x18\u3cd3\r\xc7'h\a;ya;\xf9v\x1b2\xcb\xdb\rV\xf9\xc3VyQ\xac\x02\xd2C\x06\x97\x12\xd0yy\xd4!\x0e\x12(\xc1\x90\x8a\xf9VH\xb2P\x18K\xdd\x02\x7f\xbcJFA\xb9\xe9dM\x16R}\x80o\xa2\x95\x95\xb4\xd3@\xf4\x14\xfeV\x15yK3\x02\x11\x82\u04472\xaaV\u280c\x10\f_\x9c\xb8~q\xca\xec9\n\x9a\x80\xa3`\x8e\u05f8\x82[\xfd\r#\xbaLU\xb0A%&\x11$p'\fI\xbf\u07c1TX\xfe\x84\t\xe2+\xf8\xeb\x03^\xe1\xd4/\xdf[_\xb3\x0e\xaa\xfeow!\xb5\xaa\xa6\xf9_\x8f\xba3\xc0&\x18\xfb\xce6\x1c\x1eZ\"\x92`ut0\xd4\x04\xb0\x86\xf4\xa1/{\xd3\b\x15\x03\x19|\x94fN%I\xb4\xeb\vj\\\xe3\x8bE&\x1dW\xa2_\xcbU\xf3(\xc4\xe3t\x1b4\r\x15\xe9jO\x04\xeeYJ\b\xcd\xee\v$}I\x90\x90I\xef[\r\xee\x12\xb2Jt\xef\xe5Pv\x17\x95S)vZ\xf8\x83\xfeN\x7f)\xde|\x9d\xd3\xe4\xe2\x1e2D)\xcb\xf4=\xb3o\xbc\n\xad\x90\xff\xae}(\xa8$i\xbfO\xa6!\b\f\x84j1\x00y\xff\x96\x89\xd9-\xb2X\xd8\xfeM\xea\x1d\xdbD\x9dLRm\x89Vv\x8d\u06e4I\xbdg\x93\\6\t\xd4\xc0)c\x90D\xfe\x9f\xe6\xf7\xc4C\xb8\x00\x96\xb6\xa3\xc5FHi\xc5\x18\xc1\x1e\xf0\xf1\xfa\xceP\xf1E\xe0\x83\xcb\xc52\xa9\x9a\xd7\xeeR\x9a\x85\xb9\xf4\xdeZG\xcb/\x91w\xb9F1\xb5\x96\x879=\xdd\x11\x86\xd5\x15\xf3\x87a(\x96\xa4\x88\xab4q@\x153\x9a\xb3\x15/\xa6W+\x8f\x04Cl\xecr\tCA\x1c\x94\x95\xd0\x1eX\x10\xf3\xd60\x9ds\xe1\x93G\x9d\x9e\xc5\b\x13a\xc3\x05\u018a\xc9\xe1Y\x83X\x16\\\xdd#8\xaf\xe9\x84\x7f_\xe3\u0286\x84^\xadB\x81\xcacuQ\x1f\xb6D\xefi\x95\x95\x93h;\xbc\xafR3\x80\xdaUV\x86I\xc0S\x00@\xf8$\xcd\x04\xcb\xf0E\xc0J0db\u05e19<LPu,O3Q4\x12t&#\n\xcfW\xceR\xdb(\xc1Fy \xed\xa5\xc1\xab\xd6\xe5\xc4\x19D?5\xd1\xc0yO\u0597\x81=\xefz\u052ai>\xeaE\xb2\xf9\xf2\xa4\xfa\xd1\x00\xe6\xde;),NF\x02\xd1-S\x88\x1bG\xc83g\x88\x1a\xaeL+\xd5\xca]3v\xffk\x89\x96\xb2\x96O\x80\xf1\xfd\xdb\u0402\xd3r\x13\x93\xfb6Q\x91\xf8\x97[\x1a\xad\xd2\xcdo\u0318}F\x95ZVx\xb8/\xe9te\x95\xf1\xaa*\x17+\xabLVU9\xaf1@Yk/\xe95\xabwe\aB\xde\xf0\x9bml\xae\xde\r\xca\x0f\x06\xf26W\xf7\xaaW\xdex:\u0621j,]\xf5\b\x91~O\x9b\tj\x0fG\xb7D\u0691\xcf(2$\xa9?/\u0155r\x9ec\xddW|Q|\xb5\x056\xc3i \v\xcb|\xf4\x8af\xd2b3\xd4\x03\x7f\xb4\x13\xcbqg\x94c\n\xbc\xf4\xf9V\xc5u\xd5\xfd\x13\x9f\x93c\xd7E1\x9cX\x89j,\xb9\road\xa8\xd7o\xbe\xcc\xed\u035cX\x85\xb1\xd6\xd3\xce%=\xab3!\xc2{\n\x06\x90\xaa\xb9`\x14\xc0A\xea\xe4\x05\xd1*L\x88j0\xa1\xa0\x1b\x91\x0f\x88t\x80xy\u02f0\xcaX\x9c\x86\xfd\xbf\x83qyx\xb9\x1a\xe3j\xa1\x9e\xd5\xc0\xba\x1e\xbe\xe6]V\xea\x18\xe6dI\x8fV\xeeOw^zF\x14\xb7|\u0770#\xe7\xb2Q\x00\xf6\xea*1\xee\xad,Lk\xd5&>\x94\xd5&\\\x83\x18cW&\xa7\")Q\xe6\xa0\xeeJ\x1cOiDZy\xb9\xbf\x988z\x9fW\xac\xb4\xc6]\x01AX\xfa\x83\xae\xd6\xd5\xfd\xdbT\xc6\xd5\x1cp7\xdb\xed\xcb^dKkV\xbd\xd9,a\u0192\x90\xa2$\x13fpzO\x9eZ^\xa3\xba\xabT\f!\\\x82U\x8f\xf6\x1ez\x18\x98\xa1\rn\x00,,OX\x01\xab\n\x90L\xc3#m\xad\xab\xe3\x03\xc8C\x80\x95\xc9?!w\xa0H\xd7\xe1mS\xb3\x025\fO\x06@\xf2\xce\xd2\u0475\xd4|7\xd0\xea\t\xc7\xe8\x03\x95\xdaH\xe0&yE\xb5+\x90\x8b\xbcB\xd5\x18Ao\xf0\xdd\xe6e\x94OX\x1e\x80\\\r1\xb1\xb8\xd07\xc0\x8f\x18\xa8|\xb5n\xcd\xfej\xcc\x06\xcf\x03\xb5\xb4\xe2\xdd\xc6\xdc\x13\x1bO\uef0b\xae\xc0%\x858\xa20\xdc'\xff\xd7\x0eW\xba\xb9\xa8\f\xf8\x90\x15.e%\xac+\xd1\xeb:}\x06-C\xae\xac!-n\xff[4\x1dV7\x91\x87\xd2b\x18\xb8\x8b*\xed\x83\xf0|\xecR\xddZ\xe90\x8c\x8a\xf7xe2Jz+\u0483'\xccO\xa8\xf4bLz\xdet\x9e\x88x\x16e\xe2!D\xbf\xdc\x00%\x1aO\x17I\xb3)\xb8(\x80b\xb0\v\xe3!r\xcf\x0f\xaf6.//7\xb0\xf8<K\x18\x1f\xa6#6\xf2t\xc4\x04\x87\xbc\x9aI\u0568\xda\x0fk5\xec\xf3%)\x9bM\xff\x03\x8b$\x85X6\xcePj\x8d*\xbec\xa5h\u07c80\x14,\a\xaait\\d\xceA#\xe6h\x97\xc4g\xd1b\x91/\x16\x05P\xa2\xd4\xcd\xd1\xed\xff\xe2N\xb90\xe1\f'\xac\xe3\xe0\xff\x85IG:\f\x8b\x00\xac\xf2\x89\x9f\xd3\x0e8\x0f\xb7\x18dG.g53}\x99\xd3=\xa1\xb6\x94\x9a\x1d\x1d\xbb\xc5J\x99z\xea\xc3\xc5b\xb6X\x8c\x9d\x99\xbe\xfa\xff\xe6L\xadt\ubd99\x9aRu3-gVf\nC\xf8\xb0\x82w\n\xddg\xbd\xd6\x14\xad\xb0\x1e\xfe\xcb\xefG\x1b\x7f\x0e\xe0\x9f\xf6\xc6\xc1\xfa\xe7\x8d\xd6\xe0G\x12<\x84\xeb\xfe;\x14\t\xf52 \x94\xd0[\x99\x89\xb5:/h\x9f\xb3XT3*\xca0d\xb1H\xf1}\x90\xbe]1J\xdd\xcbH\xed\x0e4\xbe\xa2\xf8\xc7\xdd\x1d\x1f\xf4\t\x8e\xb1g\xe9\xfbZ\xc1Wi\x85\u02efu+46\xef\xcb!\xd1\x14\"\x17Z\xabDG~\xb6\x9a{Q\x9a\x9a\xa4\x8b\x8c\x8a\x92OA\xb4\x97pM>\xf2\xa4Kz\xbcB\x8aG\xd3\xf0\u01b1\xca\f\xd6:u\xe6D7\xcb%\x8d*s\xad\x11\u03ef\x9c\xb7\xd3\u01ed\xaca\xa4\u078e\x16\v?r\xde\x01\xa4\u00817\xc0i\x15\xccHkK\x11\fN\x949\x99J\xdb\x02\x86\u01b8\u022eO\xd87\x9f\xdc\n[\bG\x00B\xea~gP\xc4\xc1\u0223\x85\xbe\xf4\xb3\xc5]\x05\xb5\xd2vZcj\x061\x90\xf8\x12]\x8d\xa4K\xfaf\xe5\x1d\fF\xe4\xc3\xeb\x05\xf8A\x04\xb6\x97h\xf9\xc3\n\x8e\xfd\xce5J\xeb\u05c8:\x10\xabY-\xf4\u04c8+\xb5\xb9X\x80\xb9\xfb\x9a\x19IG\xe9\xcf\x1a\xd8sj\xc11s\xa36W\xb1?\xb1\xb5\xb2{\xd7\xd2!\xce\xd6\xf2\x16\x03\u0152\xdcO\xdc;\xce3\xb6\x92\xb9\xea\xb1@2 o\xa2\xd9rI\xd1\xd8\xeb~\xaat%\xef\xedI\x9dW\xf6a\x9d\x17\xf0Y\x9d\xcb\xf3\xb1\xa3\x04\xa3<R\x17C\xfaKM\x96\x82\x12\xcc\xe6\xdeV\u0261w\xbd\x9a\xa2cMY\xb1\xc9$7\xa5[\x89\xb5\xf8\xa5\xbc\u5e14W\xa5x\xc1$\x98\x0e\xffR\x97:`E\x95\xc1\xa6Y\b\xcb\xf1$\u02e2k\x80u\x04A\xfd\x1e\x89n\xb4\xbeN@dj+\xf4\xa3A\xb74\xf4\f\x86|\xbb\xd3y_\a\xc9\xcd\xe0\fMP\u0112S{\x1cc\\\x98!\x1e\xb4p\f\xfb\xc4\x1f\x9ahf\x05\n\x01\x15\x13\x8a\x977\u0418\xd0\x11\xad\xab\x8ef\xed)\u0324z\xe9-\x05\x1dgi\xb4\xe2$m\x80\"\u07bf\x00o+\x17\xe7-\xeb\xa6\x1e\xa0\x8bO]K:\xbe\xa5Qc\x97{K\xb3\xa8gb]G\xa0a\xe6\xf6\xfe\xce=-\x19A\x83V\xe96ff\x13\xdc\xee\xba\xf8\x16\xa4\xed\xea\xc1\xbb\x9e%\xf1\x01\xf10c\xa8F\x18%y\xd7\xc6$G+pm\x8d\\.\x17V\xe4\x00\xbc\xe7\x89l\xce\xe0:\u0343\xb55\x8e\x93\xdd\xde\xde\xda?\xf8~\xb3\xcdo\xdcL\xf7f\x89\xe52Y.\xa37\xb0\xe3\xde\x03+e\x95u\x9f3z\x1ae\f\x15\xe7\x94f+\xa3\b\x05\xa3\u0469b\x03\xc8T\xac.ugU\u02b14F\x95\u02b3\x8c\x8e\x18\x9b\x1d&)g:6\xc19\x13\xa8\x86\xf8\xf4\xfa}\x1as\xad\x05\xf9\xc9j\x04J\xddZ\xf3\xad\x06\xf6\x93V\xe0K\xf5<R9\x8f\x94\xde\x00FG\x99\xac\x98\t\xaa\x8d\xc1\xf0[\b\x9a\x9e\xa1w\x0e\xfcd\x82\u03b9\x9b\xf0\x9bn7\xd2\xedF\xb2\xdd\b\xdaM\xbf\u01b2\xd8!\xa7\xd2r\x03\xbf\x9epi(\f\xbfO9\xfd6g\x99T\x1b=\xd1\u040e\xad\xfa`\xc1\x1eV\x85G(\xd8\u00eaP\b\x05{\xd8\xfd\xdd\xed\xce~\xc9 V\x85Q(\x18\xc4*U\xc6sL\xbb\x90\xe6\xb0{\xfb;\xfbm\xd4%lq\xffZ\x1a\xc3\xeemv:\a\xa8K\xd8\xe2\xfe%j\x12\xfa\xbb\xbb\u06d0v\x8aiW\xa8I\xe8\xef\x1clm\xb7Q\x97\xb0\xc5\xfd\x13\xd4$Tz\x8b\x87\x98\xf4\x04\x15\t\xf5\xe1\xf1\x05\xd3\xdeIE\xc2\xcd\xdd\u0343\x1dT$lq\xff\x15\xc6D\xd0\xfa\x8d\xef1\xed-\xc4D\xf0\xb9\xbf\xd59h\xef\x10\xca\xfd\x83\xcd\xed\x83\x1d\xd4\xe9\x83\xecc\f\x8f \xa3\x1d(\xab\xdd\u05e1g\xfc[\xd88\x89\xe7Iz\x16%\x1f&q\u07b3?\x83\xba\x929K\xc6=\xf8'\xb8\x8c\xf9(\xbd\xa47\xcf\xd5\r=xJ\xc1R&xN\x9f\xc7\t\v\xfe\\\x86\xafq\xdd>ZU\xd0o8\xaa\x8f2\u0702T\xfbt\xa2-\xd4\xf8\x7f\f\x1e\xf6\xfe\xab?\xf8\x9f\xb5\x1f\x9a\x0f|\xf2\xe3:\xed\x86^+\xe6#v\xf5n\xec3\xc9\xea\xffPS\xef\xe1\xbf\xd4\x05\xe6\xf3F\xeb\xf4\x7f\a\xeb?h{\x7f\xe6r\x17?\xb9^\xfb\xffN`y\xdeb\xf90\x9a1Z\x13\xdbt\xb3.\x98\xbc\x96V\x96\xe3\\2\x15\x83\u027a\v\"\xb4B\xcaP\xe5,Q\x81\xa5\xb2^\xdas\x9c72\x12\x1c\xe2\xe3\xce7\xa9aU\xab>\xa0\xa6\f\x8c\xe0\x0f\x8ex\v-H_\xa2\xdc\u029b\xf3<\x1a#\xb5\xccl\xb6$\x15\xc8u\xb1+q\x84\"\x17s?\x93\xdd\x1e\xe2\xf5\xe2\xd4'~\u06922\x19\xe8\xe0\x1eax\x7f\xc5\xea^\u06fc\x998@\xe8\xec\x02\xff\xfdq6c\xd9a\x943+k\x17tc\xd3\xf1%U\xab%\xe1\xfd\xb7m\x12\xca*\xf7|^\xe1w\xc0\xacL\xe0g\xf7\x8e\x86\"\x16=FdnZq\xae\x99\x9c^\u076d\x0e,\x90\xb8\xae\x89\xcaM\xb9\xb8N\x005X\x8b]\u0352t\xc4\xf0:\xa60&\xae{\x81\xff\xc9g\xf4F\x16\b\xa2\xa5\f\xa5\xeb\xe51D\xa3-,\xc9\u177e\xd3\xe3\u00a4\xa9\x8a;\x91Dg,q[\xf2Z\x06H\xdf\xdbf\u02d3W\no\x1a\x89,\xbe\xfa\x8b\xe3\x93\xee\x16\xfc\x92\x1a\x85|\xe2\x81P\xe2\xea\x9a\xc0\x16\x8b\xb4\x87v\xb0a\x8eVS\xf6\x8d\r\x02\xbdz\xd4\xeb\x9aw\xb6\x98\n\xea\x85\xe63\x02\xb5\xd7\x1co\x96N%\xeaQS\"C\xcd?\xea) \x81hQ\xce\x06\xc71\x0f\u04de\xd745e\xdb\x01\xf4\xf8\xd7\x16c\xaeb\xd1\u0362!{\u0192x\x1a\v6r\xfaK\xa0\xbfJw\x7f\xb57\xafa\x9aJ\x88\xecy\x16\xcfj;\x1e\xfe\xb3\x1d/LSC\xa3C\xb5\x04:\xe5\x04\u0198\xca`)\xff\xa7\xb6\x13\x9d\x87_p\x16\xab\xf6\u057c\x0e\x1f\x85\xc5\xc79x\xac\xf1y_\x80Q:\x80+\xf4\x00\x13\xe8,d\xbd2r9\xa0\x93\xd8g\xd1w\xee`\xa2\u046b\x9aSaQvhq3qq\xb3\xb2\x81\xff\u0690[5C\x06\xa7\xd4^\xeb?0f\x87>4\x9b\xe9\xfd\xc6\r\xef\xd6z\xd4\xf3\xf2H\xbb0\xd2n\xcdH\u0767\xf7\xb9}v/\x90\x83\x8cF+\x06w\xeb\u021cg\xfe9\x8e,\x1b\x00\xe7Y\xb7\xec\xdd\xd2\x1er\u01d9*\x88z\xee\xae\xd4\x00\xcc\\\xa2\x94\xd2y=Q\xba\xff\xba\u04e1\x81!\xacv\x85\x06Z\x90 \x85\v\x14\xd6\u04b1F\x13Z\x80\xb1\xc6\x03\xf87\xa9\u00c6\xc4b\u00dc\x8e]\xc4P\x13q\x88@\r[\xa26}f6}j6\xfd\xdf<7\r\xd2\xc5~V\xdaGi\xf5 \x84Be\xe40-\xe4\x92]\xb1k\xcc]\xe4\x12\xd4V\xd6+V\xed\x1c\xaeq\xef\f\rt\xf3\xc1\x93\x14\x04\xb6\xb1\x80ZJ\xe6\xfdE\xe9~-Z\xf0F\x1c2i\xf7\xfeGxc\x1c\x88\x1d\xb3<\x10`j\x9f\x9d\xb3W\xfc\x17\xb8M\xbd\x83\xd8\x0e\xd3`\xcc\x1c\x16\xf8w\az\xbf\xac\xf0\x1fg\n\xff\xe2\u06f2hA=\xbb\xdd\u02fa\x0eAb\x99b\xc7\x11\xc5_\x0f;\xc2\xc3Z\x1e\x1dys\u0353W\x0e\x17\x8c\xcc\xe3\xf3P\x10t\x82Ny\xbd\xff\x00\xfaG\xab\n2\x9f;\xe5\x9bM<;\xf4'i\x8d\xd3\xec(*8\xb03\u01d7)\xd5g\x83n\x9dj\x82\xef\x96\b\x85\x13(\x8c\xafc\xa40\xa5\xf2\xbf\xc6k\xfc\xf7Y\xaf\xfb\x9b\xb5^\xf7\xf7i]-\xe3X~?@\x8f\xa5\xe8\xab\x1b\x1d\x1a\xc2orSr\xfb\xbe\xc4\x12\xdcxr\x87\xed)\xda\xea\xd3q\x8ah'\xa2\x85\xcf\x1b0Mo\xb0X8YJj\xbe\xf1\x01\xb3\xe8C\xf3\xee\xfb\xd9>\xfc\xea\xab\x1a\x8a\xc9\x11\xbf]\x7f\fO\x9bM_y&_\xd9%\xad\x16(vL\x8c\xc7\xfdm\xe3\xe6~\x8f\xfa\xbc5\xcfY\xf6\x1c\x04J\x8b\x05\u0295`\xa1\xe7YB5\xcc:{z\u01a9\x85\x9a\xf4\x1e\u0666\x7f\xb4\xdc\u0747\a\x8e\xae\xb7\x89\x88k\xea\xe0rV\xfc.\x1a\xd8o\xee\u05ee\xe7\xe66\xad\xad\u79fa\x97m\xb5\xa4\x1dgI;\xb6\xd9=\xbb\xa4\x9d0Uu\xf6\x02\x18\x96\xe8\xa8\xcf\xfd@\x95\xde:p\x1d\xbco\xb5e\xd3\b\xb5\xad64\xbci}\xbco\x13\x9a\x9an\xb6t\b \x8c^\u0440\x92\xaa\x8d\xed\x00\x93\xfc\b\xef\x90( \xf3S'H\x81\x8c\x03\x82\xd3k\xc8\xd4F\x9c[\xc6U\x17%\x84\xa8_\xa1N\xa2\x91\xe3-\xc4M\xd5\xedag0\xcfM\x1a\xa9\xd1\x1c\xe0\x92\xb4\u04af6v\u044e;r?\xfe\xe7\x87\x19\xd7\x0e36\xc3\fS\x1a\xab\xb0\x0f;\xd6\xc1}\xd9a\xa8^\xef\xed\xdd\xfb\xba\xc3\u07e6[m\xed\x0e\x7f\x15\x99\a\x92\xf5\xdb?\xe6d\xd2p\xbd\x0f}P/X@\x98\xf7\xebh\x9a,0\xb8\xc4\u7cc75\xf2\x18\u018a\xca\xe0\u0366\x8fQ\u054c\u0127\xaa\xb5@\x16\v'{]\xa6=n\x93\x92L\xe4\x99\x1b)\xd0\xf4'\xdc\xd7\xff\x1a\xc9M\xedD7\aE\x81\x8e\x12\x00A\xe3\xf0zD\xd3\xd0T\u029a\u034cF\xe1M\xfa5`\xad\xf4+\xfal`@O\x16\v\xa1\xdc\u0740\xc3\b\xfcA-~\x994\xf8P\"\xd2<\u0218\xcf\u0321\xb3\xa4q\x18\xad$\x7fy\x98.\x16\xbfI\xf1h\xdec\x18\xcf#`\xad\xb3$=\x83G\xf9\xb3\xf9xl\xe56s\xa5\xcfT\x17\xe9\r_\n\xa1v\xc8h\x84\xaemBFs\x02\x11\x1a\xd5\xfd\f \x18CP3\xe4C\x04\x8dZ\xe9\u0657P\xd8h\x8c\x91\\\x04\xb5\xff4E\x8b\nzP\xdc}\xe5;+(Yy\xb4\xe1\xa1\u05d3Y\x12\v\x1f\xbf\\YM\xc6\xfe\xbe3T-\x8b\u04cdZQ\xe7\x914i\xbcY\x06 \xd0:r\aV/\xb20\xb8t\xee\x13_\xd0M\x02\xa8\x01\xefUi\xc8\xfb\x1dc\x9b\xc0\xfa\xd9 \xe4\xccO\u0470\x88\u07b8\xeaFii\x0f,\x16\x8e\x10\u05befD\x17\xf1y$ \x02\x8c/B\xf3E\xa8h6\xbdc\x16\r\xc5\xdbH\xc4\x17Lj\x0fJ}\x18\xd1[C\xe3K\xcd\x1a\xadI\xd6\b\u03004kbf\x0eX\x1a\x93\xc0\xc0\xef\xcff\x93\xb9\xa7\U0001f2c5o2\x9f\x972\x9f/\x16\xfe\xda\x1a\n\u025e\"\xaa\xb5\xe2\xfcS\xcc.\xb1+\xa5\x1d\xc3\n\x811\xe58\x9a\xcd\x1a3\f\xd6\x029\x85\x8b-Q\x11BE\xa1\x9cT\xba\xcb\xd3)\xab\xb56S\xd0\x05\xec\xc3\x17\x05\x16\x02\xd39\xc5\xcb{Q\x12\x13x\xff\xbd\xd9\xf6hAH\x12x\voIs\x16\xde\f\xf3\vY'\xbfP\x05\x05\xfej\x1f\xc8*9\x16\xb5\x94m\xfe\xd7(M\xb3\xe9~\xa1#\xaea\x9a\xc0\xbb^\x9cr\xe0L#\x81\xfe\xedJ\xfe\xf5e\xec\x01\u014b \xb1\x94\xae%0\x06\x01Jec~N\xf3\xb0\x84\t\x82,\x16\x15\x11\xa7\b\x84\xba\x86\xcdC\u07abU\xf2\xb6\xc2\xeaePW\x00:d\x1f\x8f_\x1d\xeaGR4\xfc\x86'\\\x86\x17!\x1bf\xcf\xca\x05\xfa\xfd\x84z\xde\x00\xb58R\xe6\xe7d\xb1\x88\xe0\x8f\x93\x9d\xcb\xdcH%5\x12\x80qN9\x8d\xb0\xd5\x18\xc9W\x7f\x8a^\xf8\xf1\xfaH\xa8\xfcPb#\xfd\x89\xc0;f\xf8|5\"\x83\x95\xc8Sx\f\t\xd1\u06fa\x92\x97\xa9\xf6\xe9,4\x8d\xd3qXj\xb9[\x1e\xe6\rV\n\x86TU\tf\xb4P\x01\x1c\xfd\x82\xa0\xae\xe0\x01\x19'U=\x06\xc30,\x15\x83\x91M\xaa\u03e0y/\x0f\xbe\xfa\xc4\xcfI\xd7Br\xeeO\xc8`\xb0t\x13rgUm^\tar\xd2S\xa5\xc9`\x10\x14Q''\xcdf\xdeb\x17,\xbb\xae\x05f\x99\b-\x89l\xec\x10\u06d5T6\xa7sGr=\x18\x04\xb279\xfe\xbf8*\xa5k\n\x10\xcbj;T\xdd\u050f#g\xfd\fb\xa3\xe5\x17\xde@\x97\x044\xb5\xd4)aU\x83\x90\x98\xce\xc3L\xe2\xc8b!\x05\x02nt\xc9L#M\xcf\b\v\xe6\x81I\xa4\xc3p\r\x1e\xbb\x81\x99(bTO\xbf\xd2\x04^\xa6\x92<RP4\xa8\x15\x8c\fA\x02:^\xb1\x99\x97\xc1=jwk\xe1.\x00\xeec\x9f\x11:\xf3E\x15\xf4\x82\xf4\x12]\xe0\xb0`:7\x93\x90\xac\u0351`\x8fY\x7f\x8e\xf0.\xc9L\xe6\xbdC\x14H}\xc1Z5F\t%%\xc2\xfe\u062fy\x01\xe8\xbb/\x00\xd4\x1bx\x04\xa7\x00\xbe\xff\xc1J H\xb0\x97\xb8\xd8K\\'\xfa6\xd3\xef3U\u05d9VZl \xad>\x86i\xc3\xe9~^\xf2\xad\x8am8BA\xae\xfb\xc0N\xdc-b\x11qXP\x9d\x92\xbc\xcb-,\v\xcd,\u04d2J%\x9b(\x04UO\x1a\x87\x13\x9f\xf8s\xb4I\x01\xa9\x14\xc1\b\xdd \xab\x89[\xb9O\xbak>\x0f\xe3\x16\xf7\ti\x8dR\u03baZ\xef\x15\x9a\xe3\xea\xec\xd8DVTj\ue008\x06\bt\x11=\x12MM\xe9\f\xfbKl73\xd5\xcd0\x9cU\xba1\xde\xffL\x8bE\x96c\xac\x9a\x1d\xe1]\xee\xb9\xdf\x1f\x0f\x88\xd4>b|\xe4\xcf\xe9\x88,Ux\v\x934&K\u02fe\xceZ\f\xaf\f1\x8f\x92\xe4\xfaf\xd6\x1a\xc3\xd5\n\xab\xd4w\xa8\xe71\xd5\x1d&\xc5\x0e\xa7\xd5\x0e\x13\xb7\u00f8\xd8a\x8c\x1d\x9a\xddI(\xb4\xfa\xd4\xe1\x86f\x8e\xd1\u00d7\x15\xab\\0\x97R\x8b\xc9)\x03\x04\xb7PN\x15\x94\xb30\xad@9\xc2\xc5\xcc\xecb\xc6a\x04\x8b\x99\x87\x11,\xa6\xe8\u01c30w&\x91\x16'\x91\xba\x93\x10\x92\xe3\xd5\xc7\xe1\x1b\x1fn\xc9p\xd0\xc4\xe3k_\xd0\x1b\xc9.\x80.k\xccG\xf1P\u066a\xa0\x8e\xb0\x99\xf4\x98\xfdC1\x10P\xb6c)\xb1\xe8y^ P\xf4\x8c\xfa'(z\x06\x9aL\xa3\xb0F\v\xae\xda;\x15\x8e\x16\x1c#\x94\x87\xed.\x7f\u013a|}\x9d\x00AqF\u0135\x16\xf2\xfb;\xed\xfc\x99\xdd\xe4M\xfb\x8e\x90\xf5\xbc\x9e\xb50\x04\x89=\u02bb\x95Y\x81$W)\xa9ag$\xb2\xa4}6(>o\xa7\fY\xc0H\xfbQ\xc8C\xb6Z\xa8\xc7V\xc9\u0590\xf7Z,n\x15\xed\xe5D\xbf\xa9\x02\x95B\b\x93\xee\v\x7fN\x99\xd9\x1dx\x9f\x9c1\x90m)i\x9e,\a\x1c\x91y!\xe5\xfa^\xd8\xf3\xe0U\xeb\x1cU\xfb61$\x04\xa0\xe78\x1c\x02\x01\x9b\x84\u04acg\xacI\x01\xe0\x9c\x14\r\x8cA\xf5\a\x00\x95\x91\xee\xb4^\x92\xab\xd5\xcf\xe5 F}6@S\u9c08\xb8#\x8b\xb8\x10\xfe\x1f\xfa\xb9\b#\x7fBg\f_\x02\x10\xd5\xc2\xd9\xfa\x055\xb3A\f\xb3[\x1b\x196\x06\x96\xd3\xdc?\xd89\xd8\x06\xad$&\u0552\x18\x01Sg\xd0\x06\xda:\xd8&\xf4\\&_0\x02f\xcb\xdc\xdf=\xe8\x1c\xec\x13z&\x93\xaf\x19\x01;P\xe3\xeb\xffH&_2\f\x97o\\\xa1\x9d\xca\xe4+\x8cI\b\xc9[\xdb{\xbb\x84~\x95\xc9'\fC&\x82:\xd1\xde\xee&\xa1\x872\xf9\t#\xf4\x1d\v\xfdI}\f\xbe\u0184\x85\xf2pn\xe5\f|\xe0\x89\x14\xd0\xe5\xddx\xb1\xb89=\x9d\xc1\xf7\xe9i\xd0\x1f,\x9d\xcb\"n\x95f\xb3\xd8\x1ck\x99\xe2\xa1X.\x16\xc5\\\xbd\xfdx#\xe6\rA\x04\x18\x0f\xbf\xbb\xe4:\x122\u0199\xf7\x81\xba\x85x\x86/\xe9D\xdd\xfah\xa9\x1d\xf5\u0540=\r\u04b0\x16>\xb2d\xf3\xa1@Y\x85\xaa\x86\xb2b5\x95\x10\xae\xafH(\xd4<\x87\x19\x8b\x04l\x98\xc0\xe7N1a\x7f#\xc5F\u0142/\x06:&\xb34\xf4n\xc50E\x01\xf6\v\xd3\x02\x11\xe1\xd0\xfe\x0fL\ti\xcaw`WQ\u05e1G\x8aHi\xb2\xa4\xed\"-u\xf2\xbcu\xe3\xbcE\xc0NS\xe3\xfd\u02aes}\xcdr\x92\xe0\xfe&\xc2\xfe\xa0\xab{\xcb`E\x18\xc1\xc1\x83\xf8^9g\u0288m\xd4\f\xfe-\x0e^\xc7gR\x97}\xf9\u0323\x19K-\xd3tdy\xf8\xd3n;\xa6\x02\x97:w3]\t-T\xb4\u05a6\xd9e\xcbRTF\x03'\x80\t\x12~\r\x93GYW\xba\xb8\xf11\xf4\xf4p\x12e \xb5}\"|\xb01\t\xb7\xf7\x9bM\xf1(\xdc\xd9#Vw\x9e\xaf\xaf/\x8dK\x81B G\xbd\x90\x1b\x1d\f\x8ag\x04\x96\x0f\xc1\x02\xbd\x9c\xf8\xbf \xf1\n\x98}\f\xfa_x\n\xfa\u07f6G\x9c\xf7\xa1\x87\x98\xd6\xf1H1\u0123s-\xb7\xd5;P\xf6\xa1[\xfd\x7f\xdbX\u076d\xfdL#\x939\r\x99\xb5O@\x87?w Z\x9br\v>\xf1\x88w\xc5\xfa:\u0501\x86\xe1\xf1\u0674f\xd8'+\x01\xb2\xeb/\xcfD\xc4l\xf40\xa2\u0683nD\xd8\xee\x8aGi\xa1\u076c/\x06n\xd3z-\xec\xbc^\x17D/\xf0\x10W\xc0V\x95\x93\x86\x95\xc1\x88~6\xe8\x95\xf0\r\u04a4P}\x93\x04\xf0a\x15\x92Sx\xad\x92\xe8\xbe\xee\x05\ro=5,\x0fW\x87\xf7g\xee\u0263\xe1i\xd1\xef\xca\u06067S\xd1_i\xa4O\xf12]\xc2SY\xf2\n \xbf\x7f\u0340gB\a\xeb\x9c\"\x06\x15\u071d\xa5\x14\xbd\xf9Fh`\r\x15l$#\xa8\x13r\x9aK\xbc\v3\x9a[O'aJ\xf3\x16\xd4\f#ZK\xd0}x\xb40T\x8c\xd0\xdcD\xe1\xba\xf7\x80h\xae\xc1\xf3\x0e\xaa0B\xc5\u0497a\xaf ,\xe7S\x06!7\xdf2\b|u\x13\x8dF\xc1\nO\x1fh}\x83@\x92\xcc\xe9\rg\x97\xcf\xd2!2Y\x10k\x88fl\x9a^\xb0`\x85\x05\\_\x18\xa9\xac>\x98\xfbbPjE\xb51\x82\xb8\xaeT\xed\xa1{4x\xfb\xd0\n\x8d\xde2\xc4O\xcc\xe7\x14[\x01\xf5h\xd2\xcd@\x16\x10\xbe\x95l\x85D\xdd_\xa1\xc8M:\x039\x00\xb4\u4a50KP\t\x8cm\x96\xa4%3\x8c\x10\xca\u0588F#\xb78\xfcRA`\xd3%\xb9m\xc8\xc3tv}\u7421w\xf2=\x9d\xe2\xbc*\x1d/\x97T\x80\x11\u038ax\xaf\xc5Ab\xc9\x17\x92\xe8P\v~\xb0\x978=g\xab\x1ai\u0612a\r\n\x80\xb5\xc0\xb7\xdb1\xf1=\xf3\x85~\xaa\x18\x02J\xb7\xdd\u0783;\xb0An\x15\xb1\x1aak\xe7\xaa\x17\xc4\xe9\x15\x8dL\xff\xa3x\xfa\x91\xb5\xe0\xaf\u0100\x8f\xac\x05\x7f%\xd4?2\xe4\xef%\x9c?\xb2\x16\xfc]v+\xe1\u0180\xf4\x03\x9d-j\x16\xf3\x10q\xe3\x14\xdd\xe4J\xe4X:\xa8\x83\u0393\x94<\xa1\x1a\x86L\x13M\xf7\xfc\x02\xa9\x19\a3Jj\x92\xe4\xe6Yk;I\xa94\xb6t\x93\xa2f\u04cf\xc26\xa1\xd0B\u035b\x04\xf8\x96\x82\xe5e\x18dJLH\xf0\x13\xae7!\xd4S/.\x90\xaa(x\x01\x9cL\xea$\xc1\x06\xc0\x82\xe9L\x03!n9\xe5B-t\x8f\xb1\xb8Z\xcb{V\u045b=\xd4\xd5\xe1K\xd7\x05\xe9e:\xbb\xbe\xbd)\xb9Pr\xf7\xd2B\xf5f\xd3w\xda'\xaa\x03Xt\u04e2\x8c\xe1\vI\xe1\v\u064e\xdc\x01t\xad\x83bj\xc8!R7\x00E$\xcc\xf7>\xb0\\4\xcc!\xd1\x18c\x18G\x8fz\x1f\x8eN>8\x91\"\x9e?y\xf5\xfa\xe8\x99G#\n\xe7E\xb7v\xe8\x8c\xc6K\t2w\xd8v\x9a\x0el\n\xf5\xf0P\x97\xb3A\f,U\x14\x9a4\xc82\xbc4\x01c\xc8\xd3\xf8w:\xfbwC\xd9<^\x83^\x00OE#\xe5\xac\x01\x1e\xcbt\xa9\xbc\xa1\xb8U\xe0@\x8e\x9f\x1fn\x80\xe9\x87G=;\xd5w\xefO_\xbd\u01784\x95\xe9.\xe1\xd1\x0f\x8eF\xe4~\xa5\xac\u0357(\aR\x12\xa2\xef\xc4\x0f=\u0519\xa4I\u0621C\xa32EgJ\xdcA\xc7\xfa\xc7D\xfd@\xc6h\x12\xd6#|\xf0\x13\xebJ\x86x\x1c\xe6\xfdd@\xd3f\xd33\xb74/\f\xc7\x0eH@\x12 \xf5&<`\xa06\xa4ESc\x9a\x8e\xe2\xf1u\xcc\xcf\x1b\xff65%\xb0\x00Pg\x11\a\x88\x8c\u04ec\xa1-\xe8\x1a\x99\x8c\xd7I\x1b\xf1\x18\tt\xe32\xca\x1b \xf5\x9ag\xb34g\xb41KX\x94\xb3F\xceD\xe3\xdfg\x117,\xca\x1b\xe8J=\x91\xe4\xffn\x8c\x93\xe8\xbc1\x8e\x80\xef\x8c\xf8\xa8\x01\xf1\x85\x1a\xb1h\x88T\xb6\xaag\xdcj\xbcI3\u0588\xf98\x85\x95\x19G\xb9\u0600\xf7\x95\r\xf4\xc6\xdd8>z\xf2\xec\u0351\xd4\xc4\xe4Z\xcc\x14\x86!\xec\n\xf31\xef\x8f\a\xbdY\x98\xb7r$\xc8m\x9ah\xd9\xcdC\x8f\x04I\x18\x0e7:\u0366?\xd3T\x82\x1a\x0er\xd6lN\x14]\x99\x11B\x93\xf5uZ\xe4\xb7\xe7D\xd2\xce\r\xc0\xce1\x19\x87s\xcd\x1e\x03G}#G\xb5\xf6\x9e\xf9cR\xc2\u03e3\xab\x19FrnD\xbc1\xe7y|\x0e\x90\x06\xb7\xa5\x1b\x9dv#\u609d\xb3\xac!\xc9Gc\x1a}\x85%\x12\x13\x86\xd536f\x19\xe3C6\x92\x050#\x82\xf14X\xc2`\xef4\xc0\xf8\x0e\x93\xffdY\xba\x01\xcd\x02b\x8f\xd8U\x01\xa5\xdf?\xf9\xf0\xf2\xf4\xd5\xeb\xd7G/\x9e\xbc>}r|\xfc\xe4\xf7\xd3Wo\x9f\x1d\xfdf\x10\x1c\a\xdel\xfa\xe3\xf0\x7f\xffw\x8cO_\xc9\xe3pH\u053c\\\x9a\xd9l\x8e\x1f\ube57I\u02445\xc0\xfc1\x1e\xc7z\x14\x8d7\x1fO>4\u07be\xfb\x00\xc1[\xcf\xf1\u009e5\xc4$\xe2r\x8eh\x82\x03\xdbSM'\xc7k\x81\x9eda\n\x18\xa5\xe6\xf4\xdd\xc7\x0f\xa7\uf79f>}\xf7\xf1\xed\xb3\x133z\xb8*\x02\x81\xf3\xe3\xf0\x1b\xeb\xc3(\aZ\xa67\xa7c\xca\b\xf9\xe7)\x9f\x95D\x8f\xe1\x1a\xb9\x16\x8e\v\xb7H\x84\xe5\x1bD\aj@\xa9\a\xf9\xf1\xff\xd8 \xe7\xb8'\x9c\xab\xf7K\xe7\xd0.\x1e\u0677\x9e\u03c0\xdc\xe57\xa5\xd2H\x91\xd04rPz\xe4C\x1b\xb17\xe2f1O \n\xcd\xdb\u00e3\u04f7\xef>\x9c>y+\x11\x11\x84\xaa.Q\xd57\xc4\u0235i6\u04938lc\xec~\xb5\xf7\xe2Gy7^_'\x11H\xc1%?\u048f\a\x94\u04f56MiL(\v!\xcb=m\xac\u00c4\xe2\xd1\x15Y\x18\xfdP\xf4H\xa5\x1a\xb6h\x96\xfd\x03\v\xc5\v\v\x95\xb9\x83\xb1\x03\xf9\xc9y\xd0t\xee\xecVUD\xeat\x80\xbc\xa0\xac>\xc0\xc8\xcacR\x1d\x8c\x11o\xa8\xf6\xdcm\xa6\x96\xe6\xdd\u04df\x8e\x0e?x0H*m5\xd6>\xb2>\x03\xa4\xfd?y\xfc\xda\xeek\xd4d\x90\x84\xaf\x1c\f\xe4V\x87\x135T;U\xeaX\xedS\xc6j\x87\x86JR#\x95\xa8_=\x8a\x83x\xb0z\x10\xb8'r\x11e\x8an{\x0f\xbd\a\xf7\x19\x88e#Y\x91\x8d\x84O\xd0\u0769\x02\a\xf9\u01d5\xc0\x81\xdc*pf\x19\xcb\xe1L\xf1\x95\x8e\x03D\xed\x8ey\xe3\xdf\xd0\xf9\xbf\xf1\xf0\xfe7t\xfcog5I\x01\x90\u03cf\u07fd\xc1XS\xaf\x8e\x8fJ\x13P'\x88\x1a\xbf\xc3T\xab\x14\xcd\u01aa\x19YA\x98\xe2`WN\x05\xb3\xef?\x97h4\xfa7m\xfc[\r@\xcd\n:_=+y\xf2\xfc#\xd3\x02\xb9\x99\x9a\xd0\xff\xf7gt\xf8\xe4-\xec\xf6\xc3wo?<y\xf5\xf6\xf4\xe3\xdbgG\xcf_\xbd-\u0390\x13{e\xc29(\x89\x9d\xda\x01\x96\xdd\xd5,n\x14f\xd5Th)\x857\xc1\xf5N\xb3\x89?J\xb3?\x8c8N\x91e\xf0\xce\u0508\u0534\x1cz\x1a\t\xe4\vF,\x8f3\x06\\\xa4\x98T\xf7\xb0\x9a\u0453gf\x0eV\xdeY]\x1b{MQ\t\xfa\xf2\xc1\xf4UJ\xce\x12\xb4Io\x1f/\x8c\xac0\xd4\b\a\b\x8c\x8eh\x8cR&\x17\x90]\u0179\xa8\x0e\xfa\xe3[\x19d\xf8\xc9\xd3\xd7G\xd5a\u07f6\xf1\xd5%\xf7g\xe6\xf7\xcb\xd7wI\x00\x94`Gn\xa6\xe5@\xadi\xdcl\xde:\x06\xbc)\x82\x14\xf1{&\r\xdd\xddw\xdaH&j\xa7\xedX\x02\x9b3\x18\x1e\xc9\xe1\xfc\xb9\xe3|\xfb\xeb\xecG<\xf6\x05y\xc9\xfc\xb7\xcc\x17\x84\"\x1bB\xc13O\x9bH\u079e\x87|\xb1\xf8\x89Y\xd9u\xd8\xeef\x8f\x8c\xb8=[_'\x1c\xc4\xe1\x03\x9aQab\xca8j\x05c\xbf\xa8\xa3\u026c\xf0E\xce\xc1}\x1bya\x055,\xb4\x82\x1a\xf5\x10\xd0l\nW{\xd3Z\xa5W\u048a\xa6@a\x19z4\x0e\xcb\xfc\x1ct\x105\x9bRy\u03f7\xef1d\xcd0\\\xf6\xbd\x05\x80\xc1\u00ec\xdb^\v\xf9\xc6F\x17\xc8\xc4\x1a\n\xfe\xf8\x80\xe2\u04df-j\x1e\b\xa0\xfd\xb50.;<*\xbdj\xc9\xces\xdby\xa1\x80 \xf7\x1aJ\xe5a2/\x8ei\xe5\xf0\xd3\x10J\xc2\x1c\xd2\xda9\xe8\x95[\x035Z\xb1\x16\n|T\xf8\x83!\xb7\xfa+\x8b\xbe\xbe\x89f\xf4\xf7\xe2\x1b\x03\xca\xf7\x94\x93\x8e,\u01d2PJ%\x7f\t\u0652\xfeR~\xd5\xc5L\xb8\x1e\x9cE\u00ef!\xa3\x856BW\xb2\xf7\x9bB\x18\xd12\x9e@|\xe7m\x89\x89\xa2\xa6Q\xcd\xfb\xfa\x1f\xd2\x1f\x1a#K\x05\x7f\xad?\x17\xae\xf0zo\xe6\x82\xf5\x04Y\xfa\x19\xf0\xc7\x1c.\f\xa9\u0255DL\xfa&\xfa\x1dw\xd5\x1f\xd21*\xa3\x99:\\T\x8b\xe8\x0e\x81C<{\xa5\xe7\"/\x03\xd0'\xb7@\x10\x94K[\x11|^\x94w\x04G3D\b\x9f\xa3r\xbe\x936LX\x94}\x88\xa7,\x9d\v_\xd6&\xba\x95\x9c\t\x9d\x13\x91e\xb7\xce\u07c6\xf4\xb2\xd1l\xfa\xf2G+\x1a\x8d\x8e.\x18\x17\xe0w\x93q\x96\x01y\x9e\xe7l>\xf3\u0bb1\xb2\xd0Wv}W\x11lg\x94^\xf2;[\xba\xbb\xd0p\x12\xf1s\x06e\x9cW0\x94\xa2\xb0<\xec\x0f(oIZ\x112\xca-\xceT@Ik\x81WV\x16\xb0\xa8 \xdfp|a\x16\f\u0442\x13z\x1f\xc8\u02b3\xf8n\xe0\u0596\xab\xc0wuke\xe8\xadj\xef^\xe5\\@\xd3\xcc\x01\x05 \xb9@\xe5\x83_d81B\xb9\u0751B\xefH\xa3\xfa\x84\x06\x92k\x1d\xed\x87I\xefG\xb5N\xa4\u02c5\xd1\xe6\u04c9\x94\xe9%\xa5\x9e\xa74%\xe4\xb7qO\xf2\x929\xb5T&1\xee\x17U\x82\xbd\x81Z\x1d.\x9f\xb9\xe8\xc2\u03026\x9b\xf6\xb7\x9f\x11B3;).Jr\x05\x01\x97'rS#\xd1\x14\xe8\xd0\xef\xdd[\x9c\xb5\xfe\xf0\vW\xff\x0fx\x14\xc7\xea-:\x0f\xd7:`\U000ad1b8\xd1\xe9\xce\x1f\x87\xed\xee|cC+#\xb1\xfe,\x8c\xfbs\xa9\x95\xbe\xf6\x8a\xa1\xfe\xeaba!\u071f\r\x1c\u0774\xa4\xd9\u010b|E\xa2Q>%k\xca\xf4|\xfb\u068c\\\x17\xb2\xf8\x92\xeb\xca\u05bd\x87\xde\xfa1\xf3g\u013e\xa9\xa1!\xbe[\xa1\xf0P\xe8TY\xe2L\xdb$\xb8\xad\a\xd5.\xab\xb4)\xb9\xdbb)\xb1$\u05301R_\x1e \u046d0\vI\xb3)\x8dC\x92\x1aNb\xa83\x87=.\xfc\x84\x0ea\x95\x9d\x99\xa6$\x00\xb3\x92a\xb3\xe9\x83\xd8\xe5oC\xa70\x93\xba:C\x19\xba#\x1e\xfb\xf9b\x11)\xacX3\xf8A\x00\x8f\xe6\x80 \x8ftfw\xbe\xbe.qe\xd6E\r\x99Y\x18\x81Zs\tE\x16\vw$\xf6\xa5\xb4v\x14P\x81,\xc1\x97\x99\xd9\x04\x990\x82\xa3\xcaC\x98\xdax}\x1b%E\x16\xce`\xffr\xd8K\x8a\u0349r\x90\x11\xfbp\x18\u04b4\xe2\xd4\xec\xa9\xeb\x82\xec-\xa3R5\x1c\"`\x19\x13\x8f\xe0\x98\xd19\xaf\xcb0QCS\x01\xfae\a\a\xbb\x84F\x02\xd5\xcbR\xa1\x9dT\u0162\xfa\xd4j\x9e@\x8bpa\x06\xcf\xec\x9b\xe7\\T_Q\v\xd5\v\xe8\xcf\xe0a\x93e\u7b3e;\xc0\xc0@\x86<\xc1\xb0BP\x1f\x8b\xd7\f\x00\u04df16\xfb\u03a6\xa0JMs\xa8ty%nkL\x15\xa9\xa9\x8c\xbe8k\xab6\xc4\nMp\x95mh\x94h6Y\x0f\x9e\x87q\xaf3\xb2t\x1d\u0515\x1f\x96\x91}\xe2hD\xaf\xe0\x03\x82f\x11N\x99O|\xfc\a\x94nA\xf9\x97\xca\x00}\xf2\x01\xa4\xd9\u0305z\xbe\x82-e\xee\x94Yx\x84\xc8)s\xbaG\f\x83{\x9b\xc7D\x94!\xf7\xe7\xba*\xcd\xc8\xc0\xbd\xb1\x1a\xa0\x9aWI\xe5\xca\xdfi\x93Fa$|\u29e6\xd5.\v\xcb\xedFd@\n\x82Q\xd3G\xe1=\xc2y\xf7m6\xa7\xc2\xd7-\xba\u02b8:m\x85\xb3\xa3b\x1c\xa8\x12\x96{\x0f\x8d\xaao\x8e\x1ahz\xa1e\x9b}>XJc\xc2\xfe\x80t_J\xab\xccZ\xb9Ce\xb8\xc6M\xb5l\xa9\u02e5\x91\t\xc4W~/\x0f`\xd0\xe3\x9b2\x115\x9bgBzR(\x9dGj^\x8b\x85;\xf1f\xd3\u03cbk\x9f\x13*\x1b\"\x84\xb207\u00d3 \x17\x03B\xff\xa9\xbe\xb5vqa\xb5\x93\xe2h\xe6f4\xdd\xf2\x9a'\x80KFe\x90\xce\"\f\xe9\x1c\x89\u025b\n\xe2\x93\x1a\xb1\x80(\xdd\xf1$C\xd5F\xf3=G\xbb\x90\xaf\x87\x1d\xb8\xf3\xc1M\x15\xf8\x14\xb8\x1cV\xefzt\x9cDB0\x1eL\x04\x1d\u03d3\xe4\xfa-\x98\xfd\x81\x8f\x01\xec\xac\xce\\g$\xfc\x89\xf0\xc7\xe8\u0645,)/U\x104\xce\xdfg\xe94\xce\xeb\xc8cc*\u0408\xf2\x02=\xb0M\x18\\g\x94^\xf4[vY\x9a;\x88ET\xb5\x04@=\x13\xa0\x9fj\x84\x0eV\xeb\xd2i\xe2}\x06\u0194\xf1\x05\xbb\xa3\xad\xe1\u02b6\xe2\u071cG\xc19\xcc\xe6P\xd2\xc02Y2\x95.\xe5\x94\f\xadD\xd9\x15\x90OhK\u05ba\x84vt\xa4\xc2\xe0\x1a\xbe\x9e\x8cF8N\x93z\x06\xa9/\xa4#\xcc4\xab\xe9\xc8\xebK\xb6\xa5a\n=We\x06\xd0gE\xf9W\x9b\xd8i\x9bb\x18\x8e\xae\x11\\@o\xb2J0\x85\xdf\xf2\ucb59^A\xb0\x83\xa5\x96\xce\xd5<\x17N\xd12o\xd9\xd3\xf2\x95G\x1d0}p\xa8\xcc\xe1]\xb1.|\xb6\xee\x15TJoUR%\xce#x\xc1\x82{.J\nNU\xfe\xcb\x1cj\x14\xb6+\xe8b\x99\u0689\xa8\xf7\x15\x8f;\xe0\x10M\xbd\xde\x17&r&V8\x0eW5\x85\x96\x8dSN\x999\x94\xc0g\xbck\x82<,\r\xba\x81\a_\x7f@+\xf0=\xbc-\xb0\xad\xaa\xacZ\xcb\v\x8e\xd52t\xfc\x12L\x85n%S\xe6=\xb7\x86\x19\x1a\n)\x04\\\xd1\x1at\xc4\xfb\xdc\xdcaP\x1a\x8b\x90\xb3s\x9b\x15A\n\xcc\"\xfa-\x973$\xf6\xc1I\x9d\xa8\xb5m\xa6\xf8\x8e\x9b\x17|\xe0\xa7\xd2\xef\\U\x85X^\xb7\xee\x03\xa9\xd9jHu\xa3\x9a.#b\x0f\xc1\xa90\x9d\x81=\xa65\xe9cu&}\xc5>W\x83\xb4;\xaf\xe9vn\x85\x1eY8\x11\xa0\xd1hM\x97n\u07ce\x01\u0617\x98\xc2\x13Q\xf1\x93)\x1dO\xc1B(/\x1e\x14<}\u07b9[\xab]a\xdb\x01\x9a1;\xc3\x1b\xb9\xc3{\x7fg\xab\x86Sd\x05\x87\x0eSQ\x8c\x90]1\xf5\xb7E/\xcaE\xabwu\x87Z\x9c\v%\u033e\xb4\xab)\xf0!B\u06e4j\xae\xac\xf0\xb6\"\x8a\xefb\xa2FM\xfc\xda\x1d\av\xb3X\xe8s\xc30\xec\xf6\xe00\xf5\xce\xdcz\u05f2\xc2\xddOr\x86?v\xbf5\xbf\ni\x0e\x84.\xef\x0f\xcc#Q9K\x95\xcee\xe5 \x85{V\x9a\xb0\x16C5-\x06l\xb9\x8c\x16\x02\xb2M\x7fgs{s\x9b\xd0Sy/\x03\x99\u06c9\x90\xb6C[;\x84~\x95\xc9'\x82\xd0'\x90\xbc\xbf\xb3\xb7\xe3\x04\xd3:\x145\xf65x.\xb5\x86\xd1L\xcc3v\"\xa2\xe1\xd7\x0fY4d\xbd\x15\xe9R\xbb\xbd\xac\xfcN\xa4\xbep\x0e\xc5B\xdfx\xe7!2\xa5{\x8b]\x1e/\xda\xe5\xa9\xe7\x13\xf9n\x02\xde8L\x05\xd0\xe9\xc7N\xb4B;\xfa\xef\xc0\xe0\x1c\x8e\xdb\x1c\xeeJ5\xadq\x90\x1e\x10uR\xa5\xa2=+$9S\n9\xe5\b\xf7w\x00\xc9\xce\xd6\xee\x01\xf8\x80\x96\x00~'\b}%\xc2~1\x86\u0087J\xca[H)\xc4\xcc.\x84 s\xa3\x86\xd6\x06\xf4\xa6\x8e\xff\xfa\x872pC)\xd1m\xc1I.\xf4\xe2\xb6Q\xf6\x80O\xdf\xc3\x10e\xd3\x0f\xd9U\x84\x1e\xf9\xa8\x17\v6\xcd\xcd\xf7\xc0\xa2\u0431p\x83y0\xf7pq\xbf\xd1'\x06\xb3\x9c\x85&\x92\xaf\xacO \x01N\xa2\x95\x8d\xcd\a\x9b\xcc\xc9b\xf1\xd6~fPj\xb1x/V\xbb\x0e\xc9J~\xa7]z\xf7\xa6\xf4\xbea\xfc\xd5\xfc\x97\aV,h<\vv\x89Q\x98\x026\xc5a\nv\x89\xf3\xf0\x89h\xa9\x18\x1f~\x84\xeek\x81P\xd9i\u013d\xb2!\xf8\x9cB\x936B\v\t\xe6\x88<\xcfDXq\nA\x1bn\n\xf8^\xf2\xe8k\x11\xea\b?\xeb\xc1\u71df\x1f.\xe0\x1f\xf20\xa6OEx(\xa4\xba\xe61\x1b#\x16{\xd5\x18\xe2\xf2\x05(\x8b\xcfc\x1e%X(\xe4\x14\xaf\xe6r\xbf\xc2\xed\x1fmG\x9f\vxI\xf9\x13\xcd\xcf\xfcS\xe1\x13B?\x8a\xb0_\xc33\xcb\x18\v@\xf6\xfa\xedA\xb3\xe9 +\xa6mA\x9a\xc2\x10\x95\xb43X\u04bf\u0610\x8e\x8b\xa2\u06b1M\u02d4\xbd\xff@\u02f9n\xba\xd9T!\xfa\xf0\xfb\xe0\xbe]\u0670.+:\u06eeLc\xf7?\xd2v\xae\x1b/Nd\xff~\x9d9\xa4\x02\x137+\xa3\xde\xfek\rmU\x1a\xda\xf9\xfb#\u029d\xe9;s\xdd\xfd\xfbC\xcc\x1d\fqZ\xde\xfb\x1bc\xfeg\xb0\xe1\xfb\x9a\xfeg\x91\xe1>H\xbd\xf7O5}\xbf\xcd9\xa0\xdfDx\xf3\x95]\a\x1e\x84\x89\xf1\xe8,\x99\x9f\xc7<X\x11\xdbI\xc7d\x93\xf7p\x1fH\xfd\xaf\x85\xebB\x9bnt\xa4\x0e\xe31\xbc\xf56\x9bk5g\xcc\xc7\xca\xf9#\u071b)>9\xf8\x91\x11*f*V\n\b5\xa0+\u043c~\x96\x0e\xebU\x15\xcd;78\x90\x10>\xce*\xb0**J'\xb1\x81\x16\xb6\x1b\xc7lL<z\x83e\x18U\xed\x061J\xa0@\x10\x16\xe8\x98\x1c\xe8^\x98\xce\u009f`xt\x1c\xce\xe0|\x9b\x84\xb3~g\x00G\x19z\x97\x98\x87\xf1b1\xee\xbd\x14\xfe\x18N,\f\xc8.\xd9Q;\xbb\x1f\x04\x98\r\xceT\xf4\x92\tuz\xc6\xfe\u616e\xe1\x1e\xb9:\xfer\x1c\xfe)C\xa2\xc2\v\xffb\xe1\xc7\xf2(\xc2\x17\xd1\f\x1eJ\xe5\xc8\u00d2\xa7;\xa9\xe9WP\xec0N\fW\nF~\x13\xae`c\tO\xd6I\xc5\a5\x9c\xea\x8f\xf2\x19\x1bN\xa3\x19\xaa\xc7?\xf6\xdcs<\xa5\x8c\xd0a8\xb7\x82\x93(I\u078d??\xfc<Z\xff\xfc\xb0\a2\x14OF\aT\x02\xac\x0f\x19\x93\xaf\xb3p\rt\x16]H[\x04\xc6\xfd!e\xa4\x18]l\x1cz\x1e\x86>Y\xe1\x0f\xc2D\t\n\xf3Rp\xf01\xda\x0e\x1b\a\x15\xbf\xe1\u034b\xc6\xfd\xf1\xa0\u0644\x7fW\xb3L\f\x16'\x81@\x80\xdcO\xa8D_\xe9\x15yb\a\xe7\xdc$\xfd\xb8?\x1c(\xcf\xd8\xf0S\n\x1c\x94S\x1fp[\xefO\xe8\x9cFhZ\xbe\x96\xb6\xe69;\x8c\xb3\xe1<\x89\xb2\x13\xe4\xa6\xe7\x19\u02cd\x8f\a\xe13:7\xac\x14\v\xc3p\xd4\x03\xd4\vb\xebf\x97\x83\xff\x17\x15\xb2\x11\xbc\t\xf9\xc3\xf0w\xe1O\x1c\xeds?\x91\xbb\xcc\x1f\xa2D;\t\xf5\xf69L\xe7\xc9\bu\xcb\x14\ag\r%\x82\x86\xe3[{\x05RW\xb7\x138\x1a\n\xe5\x1b\xa7\x9f\x84?\x03\xaf7!\xa4u*\xa3|\xf6\x12\xfd+H\x94\xd7\xd5\x02\x91\x90:\\\xb7\xec\xa2j\x87\xc0\xa6%\x15\u0461v\xb0\x86P\x82\xfb3\xa2\xb4\xf4U2\xd5P\xa5\x17\xa1\x03\u0148&\xf4\xe6\a\xeck\xba\xc4\xe5\x9d7\x9b\xf3\xb5\xd0hY\xf5/h,4\xf2B\x10 =\xa0\xf9\x92\f\xbaZ\xb7\xae\u038f\"\x98k\x9b7+\xd4\xf6\xbc\xf5\xe1J\xbd\x9c\xa2\xb99\x85\x7f\xf1\u054af\xe6e\xc0\xde02\aOc\xd1\u04a2Vy\x7f\xe7.\xaf\x1f\xb6\x17\v\xe5Yg%q\xced\x9f\xb0\x8a\u02e5\x9f\xa1\xffNF/ \xba\xe5\n4\xd5h_\xb9\x98#y\x04s\xd3O\"D\xce\xfa\x1bD\x9fJ\x87\x18\x05-x.ht\x96\xa7\xc9\\\xb0x|\x1d\xbc\x14RCFf\x166\xb5\x91\xcc\xf4\x94a\xf3s\xf0\x18\x15\xc0L\x9e\x8b\x15^\xab\u0752\xb0Y\xa9{\x1d\b\x9e\n\n>\xbb\xe5\xc7\x0f\x02\x1e\x18a\x11_\b\x8a\xb7\x9d\xe0'A\u0655\u0222\xa1x\x9e\xa5S\xc8\xfaYPt\xa3\f\xcd\xd4I\xae1\xd3g\xf4F;9\xbdy2\x1c\xb2\x99\b\x9e\x89%\xd5\x1eU\u0463J\x8dsR\xeb\x7f\x10\xb0\nE\xcc+\v\x15\xfc\xbf\xc2\xc4\xd4@\x83?\x04\x85\xbb\x92\n\x9b\xf5!\x95o$\xbf\v\xf3x\xfd\x93\x9b\xfb\x95\xf1\xe0\x17\xb1\u052f\u053f\x8a\xf0\x93\xb0(\xf5R\xd8\x17\xa1\xd7B\xfb\xb7\u056e\xbd \u0575\xd7x*|u\xbb\xfb\x90\x81\xa9\x92H\r%\x89\x1a\x19K\xd0\x7fg\xe3\xe3\xf1k\x8az\xfa\xe9\\4&\xd1\x05\x1c\xd1QC\x1f\x8b-\xd4u\r\x1a\x0f\\wf\x0fL6d\xd8\u02e2\xa0\x1e|\x9a`6\xf6\xee)\x806\x9b\a\x16k\x82R\xbc\xd5v1\ue43e\xfb\xb8\xbf\xd1YO\x8f\x87\x15\xdfj\xc5\x12\xada:b\u04a7\xb9\x96\x94\x96K(\xa1\vA7\xe3\xea\xa3[b\\\xeeGy9A\xe3\x16\u01ceET\xde.\xec%\xdd\xd1\xf9u'\x1d\xe2NP\xe6~kH&\xd4\xcb\x19<\xc1j7\xb8Y\xf8\a\xaaZ\x19\xd0\xe2\xb6\xfd\xca|b \x9c\x11z\xa3\xe9w\xb6\xacJ\xe3TaE\x81\xf4Z\xbc\x10\xf5ny\xb5\u02a4\x90+\xe7J\"^8\x82\x139xUX\xf4\n\xc3G\u03de\x85!\n\x12\xf8X#\xfc$Zf\xd7\xd6\f\xc0R=Y\\P!o\xfc\xe0\x90\u038e\xe4\x8f\x02 \x7f\x17J\xad\x93\x9b\a'cZ\xab\xb4Rb\xa1\u0087\n\xa5#n\xad\u008a\u06e6\x06\x03\xf4\x91\xd7p7BI\xfd\x1b\f\x15F\xea\x15\xdfs\xceef\x9d\x919\xcf \xbf;\xa2\xf7z~\xba\xd6\xdc\xd5\x1aZ*\x86\x9a6\xce\xd1\xe4\xc7\fL\xcal\xcd>\xf4\x1e\xda\xcb\v\x86\xfe\xca\xe7g\xb9\xc8\xfc\x8e6&g\xbd\xfe@\xc5\xf4b\x8eU\x83\xe1\xc7~q=\x02\xfd\xe2 yu\xc82d\xaa\xff\x15\xc41\xbe\x17\x16\xf6\xe9=|\xc5\x10\"C\x16\xbb\xdb\xe57\xe1F\uec2d\xf7\xfb\x9eG\xef\xeb\xc2f0 \xc50`\xdc\xf5>\xaa'\xdaq\xbae\xce\xe3;\x06uX,$ \xf9b\xe1\xfd\x17\xfe(\xb2\xbfR*7\x9cD\xd9\x13\xe1\x98\xf2\xa5\xe1\xaf\x05\xefkpGS#i\x17\x9c\xf2\xa0\xea\xc1Z\xa6\xbb\xc9t7\xc0\x8az\xff\x05.0S\x15\xa2CpumD&~\xe5\xbdQi(\xae\xa5\xa8\x83\xb0X\xa8\x1f-\xe4\xa3\xf4\x9b\u062dWHRo\x91\xa0\xee\x86%\xbc\xc4\xc1T\r\x12\x1c#z\xcd\x1a\x86\x9c\xc6KyAZ\xeb`\xe8\x7f\xe3_1\xaa\xe7\x1a\x12d\x8c\x93\xbe\n\x93\xaa\xf4,n\x964!\x148\x90/\xe8\xceQ\xc3\\3\x19I\v\xc7\xd4UN\x1f\xe9X\xbd4\x8e\x95\x13\x1d\x87\xbd\xbc\x81fY]\xe7\x1a\a2\x97\x81\u00e49q\xfd@\xcd\u00f5\xb6\x1b\xbe\xce\u0670\x8e\xd1o\x11H\xf2\x99%\xb70J]\x18\xa9a\xa6di\x06l\x1eq\xfc\x88j\xd3\xfc\xb86B\xeb\xdfs5\xafn\x92\x18f\xf8\x9c\x89\xa7Q\xce>f\xc9\xf34{\x9b\x8e\xf0\u0727\x91ui\x98\xf6Vy\x19m\x14\xc4\a\x92\x17\xe9\xdb\xe8\xdf\xdfpcP\x15G\xd0\xdc3\x97A\x8a\xb1\x8cE\x94\x9d3\xf13\xbb\xce\xe9\xdc\xf6\x16\xf7\xfaJ\\\xe2!\"{\x83 \xa6\x89\xa3p\xf8E`{5k\x89\xab\x06\xce\xdf\xe76\xa8\x14H\x9b\xbf\xb2k\u01fa\xd0*\xf0B\x1eOGL;\x82\xb4\x9ed$#b7\xb5\u0241\xfd\xfeF\xf8\xa6*\x8d\xd0\xda7)c\x1c\u01f7j\xc4\xe5\xa5\xcfh\xcd.\xa47\xb5\x80\xaf\x843JW\xc2:\xb5\xb0N\x11\u021c\x80\x0eT\x15\xdeT-7\xf0\r]\x89k\xea%j\x8c\x85c\x19!&i)\x91\x16q6\x10\xde\u07e0\xb7\xa1\xedmH#j$kD^\xc7kQ8\x81\xb83\x89\"Hu\x8d\xcel\xa33hT\xae\xb8=\xda\x1ac\xb8\xc7,)\xd7\x14\xd1}.Z)N\xabQ\x11\xb0\xf1.\x8d\x1a\x1fP\x18\xd0\v_!h\xa3\xb1!B\x86\xad\xc8p\xe3\xd0\u020eo%I\x01\xce.\xed\x8bAK\xf9\x8f\v\xb3\x96\x19\xfb\x9bh\x98\xa5~L\x99\xc3\xc5Y[\xf1#\xf5\x9cj-\xbc\x1d\x9a\x11!N\x15\xe4\x0f\xd6IX!\x19\xbd\x15e\x06n\xf6\xb1\xef.1\xa4\x9e5s4\u0665S>9\xa7\u021d\xd34\x1d\xb1D['\xc9yA\x89\xd2\xc4\xe2\u0689\x95\x0e\x8c\xbaI\xa4d)\xe3R\xa5\xbc\xe0=\xb5\x10*\xea\xdc<\x1e1\"wj\x96\xa6\"\x8c\xb8\xaf\x1e\x93T\xcbgP\x8e\u047e\x84H\u0384\xa7\xb4\x82\xea\xe8+\xb6s\xce\xc4{\xd4\xdc\xf3\x19*\xa0\xa3\xb1\x8fq\x7f\xe4\xbe)\x02!\x1dN\xe2d\x941\xdeM\xc1\xed[\xce\xfdT*}p\x12\xa4\xf8@,\xf9R\xa9N\x92s\xdf\f\x15\xca\xcc\x13\xf0\xe1K\xe5\xd8\xce\xeb\xc6&\xed\xd9\x18\xd8\xc8[%\x1a\x87\a\u05ad\xa9\xc3\xd6qKH\xb3\xd0\xf6\x95\x86\xedn\xfa\xc8F\x7f\xf5\xe194\x1dP\x1f\x96SO\x81\x80&a7\x05\xf5\xc2\f]`\x1a\"\x04.\xc4\xf1\x15\x1a\xc3\x04\xb8#\x96\x90\xaa\x87\xa9\x8d\xbd\xe9h\x8b\xa1(\xcd|o\xf6\xcc\x18\x83_\v\x02R\u070e+4^\xe1\xe4fE'P\x92KSK\xa1:\xce\xfa|\x806\x87~\x86.t9\xca\xeb\xe0\x88\xa5\x99T|5\x9d\x93\xe5\x12$?K\u07d1\xf4DE\x19Q\xce\xfd\x1b\xddCp\xb3\\\u04a2O\u037c\xe2`N.I\x88\xe8H\x99\x03\xbf\x90\xf7\n\x1a\xa5\xdc\xc9#T\xd5#\x81\xfaAQ\x86d\x17\xa9\x86\xf6\x18\xfc\xb5\xc5\xc0MW\xe1+\u0339\u03e9\xb1\xa9A\xda/\x9d\xc7\xce\v\xbblI\x93[v\x9d\xb1\xcaD\xdc\xea\x166\xa1}\u03bd\x01\x82\t\x91\x00G\xecl~\xfe\x9a]\xb0$\xf0b>N5\x19\u0283\xfe@\xfd|\x19\xe7\"\u036e\x81GA\x95\x12\xcc\u048a3\xb2\x9c\xbcz\x8e\x94f/$\xa1\x98\f\xaa\xccl\x9a\xd6y\xbfYRG\xdc\r\x17\x97F\xcai>I/\x9f\xc1h\xc0At\x84\x04HW\x94\xe3\x00r\x16x0\xf07\xd1\u0323I|\xf6\x86\x89I:\xca\x03\x9cW\xc9m\xeb$\u0381 \xd3\x1b\xe7\xf1&p\xa0\xa69?\x88\xa6TVM\x06\xbf\xd4T(\xe4;gBnS\xb0\x7fn\x9d\xc5|\xa4\x1a\u05f9\xea\ucdc5TB\xa5\xec$\u028f\u7284\x9d\u028fJ\x19\x10\xc3\xcd\xd8\xe8\xbd\\\x04Tq\xe4\xa1T\x12\xc4|\xb5:F\xccb*\xc9\x1aN{\x8e.\x81hY\xedSb\x98&\x98\xa9<\xfbc\x01v~p\xeab\x1e\x80\x98\xac(\xa7E\xbd\xba\xac\xfa\xd6\xc5\xe7\xb3Q$\x98\x02\xa3\ufdb0\x82\xdc#\xfe\xad \xaaX\xdd\"(\xfa--\xb8u\xad\xe8\x02e\x8e.\x10\x7f\xdc\xe9\xf1\x8dN\xd0\x06\u07b0\xd3M\x1f\xf1n\xba\xbeN\xb2~\xba\xd1q\xb5\x82\xd2A\xd7\x17\xa1R\x9a\"\xad$=7\xfaw\x19Y\x1aJz\xc1\xb2\xb34g+\x86j\xb2\xc30,\r\xbb0d\x9aU\a\x9d:\x83\xce\x1ewz\x99\x1ct\x14v\xba\u0463\xac\x1b\xad\xaf\x93\xb4\x1f\x15\a\x1d\xad\x1e\xb4b\xf7\xbd\xbe+0\x194\x1a\r\x8f\xd8+@J\x9c\xa9Y\xf4\xb9\xe5\xe4U\x86\u0786\xe3~\x16\xe7C\xd0\xf7\xe6\x91H3\xb87\xc0e\u033a\x1d\xb4\xb8\n\xdbv\xd0\xf3\u3421\x86O9\x87\x04\x05\xf4\x04\xfdE\x1e\xb2\xa0\"\xb8\xcfBF\xeb-\x86+\x17e8\xcd*\xe1I\\\xba\x8f\xf6X\\\xca\xc1yX\x1f\xeaR\xab\xc9\xc62\xce*\x1d\xd3\xd1\xdf\x0fq9*L\x80&:0\u0098N\xe8\x94^\xd0szM\xcf\xe8%=\xa2W\xf4\x94\x9e\xdc\xd2a\\\xee0\x96\x1d\u0195\x98\x9aEH\x92\x1bY\"\xdcU\x01\xf1\xc0\x1e\x1bnb`\x13\xd4\x17\x96u2\x05\xb7\x8b\x11\vu*\xcd\xd4*\xfa\x8cb\x03NL\xc4\xed@\x95\xdaj\xbb\x91\x06w\x83ah{\xa0\xe3P\xf4\x87\xf0\xa4\xec\x84ys\xf8a\xf0\xdf\xee~\x83c\xb4fs\x18\x86\xe1\x84^\x84u\xf6$\xf3>\x93w\x9a\x01=\x0f\xdb\xf4Z\xe9\x00\x9b\x88\x96(R:\x7ft\xado\x1c\xe4\xa64L\x00\xc6Yx\xdd?\x1f\xd0\u02d0\xf5\xcf\x06\xf4\xa8t\xe1<#\xf4*t!zI\xe8i\xa8\xba\xa5\x17\xa6\xc5\xcdM\xdb\xe2\xdaU5\u067cjUgq\xdal\xfa\xf3\xfe\xe9\x00\x1dc\xc5h\xf7|\x1e\t\xf6{\u0312\x91?\xf2\x019\x12B=\xd1\xf6\xe8\xe6\xa6\x0e\x0f\x89\x93\x9b.\x16g\xe0M\x04/\xd67q)4d<\xf6O\xc2\u050f\xe0`\x8b\x9a\u0375\x93j\x91\xe2\x12o\xee\xd95\xbe\xa4g\xf4\x88r\x9a\x10\x13[\xf2|}\x9d\xc6\xc5(\xa5&\xa0d%va\xec\xc4.\x8c\u044c\xde\xec\xba\x11\xa1sx\xc6O0~E1d\x02B\xbal\xadAL\x8c\xcfm\x9a`h\v\x85]\xc0\x87\x0f\xc3\xc4\u01b8\xa8\x86<5O\xe1Cc\xba\\\x06\xf0X\u5325\xc8a\xac`}\xa0\xba9\bXa\x03\x99\U00029eabb\xfaV\xa0\xe3\x91nQ\x15\xbeS^\xc1\xb6\xf1b\x8f!=5\x86\xee\xdaH\x8f\xb2\xca.M \xbe\x06\b\xc8b\x1e\xe7\x13\xbf\xb3\xab\xcb\x1e\xdc3>\xe46\xedl\xd1\xce.\xed\x1c\xe8 \x91\xc8,)F!\x06a9\xae\xf0\xdbh\xca\x02\xa9\u047aX\x14\xadap\x11\u0327\xcf\xd1*U\x1d\x1d0\xe5\x15G\x87\x92\xdeP\xc9\x18iRv\n\xbd+\x91N\x91\u05f9U\x1b\x1do\xfa\x1a\x01\xf2\xe7i\xf6^\xd1\x1ek\xb5\xb0$\xa5a\xb9L\xe9\xaa\xd1u5\x9bQbaM\xab\xa4\xf0\x8c\x03\xb7\xdfC;\x81R\xa5[g\xa0\xee\nK\xe2\f\x13ng.\x8b]\xc7\\\xc8\a\x9f\xb2\xbat\xcbT\x85\x85s.\xef\xce\xf1\xaa\x1a\xed\v\u0536\xa8vz<\xe7\x87\xe9\x9c\xd7^i\xdd\xd6\u02a3\xb4@_9\x91\x0f\xf1\uc3b9\xd44\u06b5\xc1A\v'\x11\xdc\u03aa=idx%\xbdQ~Wg\x1f\xe2\x19Lb\xea6\xa1z\xf7\xa4\xd3H\xfb\xb4\"z\x1b\x9d@\x98\xfe\x15\x83{\xfb\xaa\x95\xc4\x15\xb7\xae[\u0342\xf1AX\x9b\n\xcbHks${.Hy\x98\x12/o\x87N7\x16\xad\xa2!a\xbd\x14\xb7\xc6\u0550T+\x11\xd2\xd2@\xdd\x11\x98\xf4\a\xd0\u043a\x1f%\xf6\u00f5.\x11\x92K\xf6\u02e3\xf5^\xe0\xbb\x1aO\xf9\x86\xb2\xba\x9b\xc9=,\xdf\x19E\xcb\\\x15Qw\xdf\\\x15\xf5\x00h\xe1=\xd4xD\xb3\xda&\xa5\xbd\xae\xab\xa9A\x15\xb2?L\x18W\xef\x9b\u062ak\x90X\x9dOn%\xc1\xd2\xc4\xcc\xc8\nL\x03\x1ao\xab\x95%\x14t~\xee\xbbb\u020aMG\x19\xe8K\x97\xfc\x95Z\xaa[\xff\xb2\xa9\x89\x1af\xc5\x0f\xa7\xcd@\u012b\xb2+\xba\x84\x95Ojxw%\x8e\xc5\u02bcB\x82L[y\bF\x19\xbd\xa9\\\xbck;Y\x92.\xfa0\x81<#w0\xabf[\f\x9d\r \x85\u0677\x9f\x01\xce\xf3f\xed\x19`5\x85\xba\xfcQ\xbbg\xefu\xbe\xab\xd8\x01\xfd\x80k2\xb7\xb2\xf4Q\x16\xe7\xfc\x01\xfa\x95\xcb\u061aG\x82C\x86/\xa0\xb7\x9c\x1cpA\xec\xd89T\x10\xf1\x8e\x8d\\\x12k\xa9\xbf\xe5G~#)-H\xb8\x98\xb4\u0797\xed\x83\n\xa8h\u0540Pb^\U000527e1~NYq\x8d\x93\x9b{\xb7\xc0\xa5\xa8K\x1d\x90\x0e\xa5\xbf\x05\x83\r\x895\xb3\x06\x01l\x9bV\xe9w\xb3\xa9\x81nqG?\x12\xe3\xfbB)\xd3\u0711\xa1\x0fw4\x87\xf3,c\xfc\x96AU\x8e\xcd\x1a\x86\xc5\b\x8ddc*\xb5\xc8\x13T\xab\xdd\xf7|+\x1c\x8ae\xae\xc0m\xd9\x17\ub77a\u026d\xe4\xe5\u0706\x86nY\xb7\x91\xd7\xf1\xd9\x1dU\xad\xd0\xce\xd4;]!U\xb7\xef\x0eJw\u0121\x1c\xa4P\xfbP;\u02b8\x9d\x8f)\xab\xd8:\xad\xe4\xb74B\xc5\xeafr\xe5\xfe\xcc\x0eG\x8a\xf5\xee\xcdRi\x1el%^<\xf6\x01\xadm\a\xa38\x9f\xdd\xc6\xd0*v[\x914\xfd\xc2`\xd9t_\xaa\x19\x98W%\x9b]\u0625D\u019b3\xcf\xe3%\x1abg\xd3\xd2\x03\xf2k\xc9\xc0\xaa\x82\u02bf\x9a\x14C\xbb++\x05\u0318\"\x7f/\v8\xec\x9c\xfc~d\xdcH\xe9SCSSZP\x7f\x8a\xa4\"\xb0b\x9a\x10\xdca\xe1\v\xc5\xff\x85\x14x\x16\xf2\xcb)\xb0\x0e\xeb\x9dJ\xc1\u01ddv\u95a0\xfa\x95\x93\xe3\u0159\xe5x{\xe0jr\xe6\xc6`\xdf\xe1\xbc_\xd9\x03T|\x8b\x86\x13T6\x9aD\u0668\x81a\xaa\xc1\u04f1\x11\xeau\xdam\xea5\xe4P\x1a\u065c\xe7\xf0<+ut\xb3\xb50\xacnTu\x82\xd7_t\xca\xd6\u04f7\x1cR\xf1\x1d\u05db\xae\v\v\xa8pXx\xf9\xaf\xaf\x8c\xf85\xe7t\x8eg\x01\xb9/\xbe\x99\xfb\xbc-\u020b\xb3\x0e\xb3\xaeT\"\xe0\xad\x1a\x12\xee\x13\n\xb8P>\x186:Zu9s\xaf\xbe\xc6\x0e\x01%\x15\x19,[KR=\x9f8\xe16#\x15n3\r\xa3B\xb8\xcd\xc4OM\xbc\x1a'\x88~!\x9efd\xa3\x90\xde$\x95.\x96\xb70\x84\x89\u07efy\x06a\xe6\x82\x0fJ\x06\x03\xdb\x11o\xd5\xdcd\xfc\x8c\xde\x14.E\xe0\xe2\xcb\u02aa\x1c\u043b\xafp\t\x8c\x87\xa1\x8e[,Z5\x9eL`\x14\xbct\xe63\xb4\xb2_.\a\xfa!\xa0p1\xba\x85~\xb2\x96\x15X\x14\x9f9\xb1\xe1wc\x8cUy\x1b\x15/\u06be\xbb\x0f\x8b(\x8c\xe5\xe1M\xc6\xc6y\U0002b8285\x14\bN\xadzC\xc09\xb5B\xc9 \xe3K:\xe3`(}\xb0\xdd>p\x802v\xce\xe8\x8aNP\xa7V'\xa8\xe3\xea\x04uP'\x88\x87\xa2\xa5l\xf9^q\xc1\xb2!\x9b\x894Cw3Z\xbb\xd6MOC\xd6\x02\x8d\xe2\u00cc\x8d\x18\x17q\x94\xe4=/\xe6\xc3d>\x82P\xd2y4e\x1b\xd2\xc4\xd3\xeb\x96w\x8e\x85\x0f\xf3o\xe6Y\x12\bWM\x9bVG\x81\xf1\x83*c\b2Z\xa7\xf1=\xb4#\x82\xe8S\xb7\xe9|\x83\xb60^f\f,'\x0e,\x99T`\u0140t\xa8\xf4\x91\xa9X\xaf0\xf7)j\xfe\x84\xacr\x83\xa0\xb1Ud\x8a\x16\x8b\bC\xadU_1\xe6!\xab\u045c\xa0C,\xec*\x89\xd0q\xc8\xea\x16f\x12\xb2\u0685\x19\xc1h\xbf\xc63\xbd/p\x87\xd1i\xc8\xeaM\b(<\x1cL\x84\x98\xd1\xf3\x90\x193 \xed\xc1 <_,2z\x01\xcf\xd6\x17\x8b\xc5\xef\x94\xf7 0f0\xe6\xfe\x05\xbd\xa9Y\xa7q\xed:M\x96\xc4?W\vq\xed .\xec\xd6\x1b8^\x87\xbc\x05\xfb\xa0\xa5\r\x14\xfa\u70d0\x11\xaa\x93\x8d\x1eq\xf8\xfd\x1dw\xf5\xb5\xab\xafZ\xd3z\f5.\"\x86\xc6\xe1\u07d0\xdbU\xc8\t\xad);/\x945\xbb\x94P\xd41\x93\xf1\xccS\xb7\fnpB}\xa18\x11f\u07e8\xb5!\xcb\xf9\u04bc\x88\xf3\xeaCqL+X\x14\u4d08,\xc1\x90V\x91*\x98\xd3\u06a5\x0f\xa6Kd\x06\x12\u0611\x0e\xc5U+5\xea\x95\x19\xbe0\x01}\xad\xda',r\xb3\xfa\xfd\xe8\x9e\x0fVfWFg\xd9|&|O\xad\x13\xf8\u3542\xe8;\xe4\xd0x\x9c\xdfFl\\\xdf\v\x86\xfc\x81\xa9\r\tf\xbc\xf53\xdcR`\x9e#$\xb2\xed\xce\xe6&\xa1S.\x83\xe7rB/ ysok\xb7C\xe8\xb9L\xbe\xe0\x84^C\xf2V\xbb\xdd\xdeu0\xfb\xac\u039f\x93<.\xef\xe9\xc4\xc94u\xe9\n\x0f\xb42Kg-\f\xb1\x0f7f\x90\x8c0\xea\xf8\xb9X,tA\xee\u0192\x05\xc9\v\xa4\xf3\xb2gi\x0f%Y::#X\"bk\x8d#\x1e\xde\x00\xa9\xac\xb9\x13\"iB\n)U\x8e\x84\f\xb9\u0317\x8a4\xdfZ\xc5\xe0.\u035c\x06d\xc5<4\xbf\x90g6\x87\x18\x04w1Y}\x8e\xcf\b\x830#K\x8a\xa1\x90\xef\x1e#\xcd\u072e\xbbB\x86P\x0e\xd5_\xec\f]\xaaB\xb4\xa0\xb34MX\xc4=\x19*\xe5z\xc6PY\xca\xc3\x18P\x1e\xe9*\xb7\x98}-\t\xa0\x9e\x8a\x82\xe4\r\xacS\b\xac'\xfdG@\u0776\x8a\xfcDT\x87\xfdLM\xe1f\x98&\t\u00f1?\a\xda-\x82\xacUN\xa2Zh\xd2\xd5\x0e\x892y\x00\x1dMg\xe2\x1au\x86\x9c\xf3\x9e[\xb3b\xe8BO\xb5\x9f\x0eB\xfb\x13'l?\xcb\u0345k\xed\xe5Rz\xd0\xfa~\xc8\u03b3$\xc4\x7f\xb5\xc9\xc1\x8d\r5\x8eC\xa2\xde\xd2\xd3N\xbdd\xe4\xeb\x8f\u01ef\x8c\x97O\x9f\x13\xe97n\xfa,\x12\xd1w\xf7\xef\xf3\u0162\x02\x1e\x82\xe8\x03m\x86\xf2\x8f\x9a?\xfc\xb4K\xa1\xc0LK\x95\x83Js\xf4\x1e\x8b\x06oX\x964\\\x159\u0133\xc2#d\xc5\xfd\x86Gz\x15\xa5e\xb0\xc0\xf8\x8a\x8ce\xc0\x1c\x13\aK2N\xef\v\"\xaaQC\x06\xbb\x10\xd7\t\xa3q\x98\xb5\xd8\xd5,\x01\xfe&\xd7\xd6\xc2\x1c\xcdWr\xed\x11\x11\x1e\xbbs\x88\x10y\xfb\x1a\xa7\xee\xf2\xfe\xe4_q\x9f\x83\xd9\u7374\x89C\x8b<\xa9\n\xa9|\x1f\xff\xec#\x8b\xad\x86\xa4\xd7\x00\x87\x15\x80g\x93<\x96\x1e_\xd4\xf0\x82\x18\b\x1cuZ\xfb\x8e\xe1$.\xd7wro\x9c\xc27\x81*\xc10`\xd2;\x0e d\x13%\xa4\x8a\xdb\x1d\xa1\x91Z\xcfb\x9a\xe68\xf4\x85Z\xb7\xbb@6\xb4\x9a\xc8\xea\xb5B\xe4<f\xe8\xbc{T\xe9\xd1`u\u03b2\u0630\x86\xeff\xf0op\xa3\xc0l\x81K\v\xad\x05\xf9\xd2\xc6\t\xbb\a\u0459\x97\x89\xce\xdc\x12\x9dy\x91\xe8\xcc\xeb\x89\x0e\xb4\xf2\x15\xb4\x8b\"\xe4\xed=\xeaEs1I35n\xf4\x15\x84\xe0\u0740}\xe1\xfa\xfdyr\xef\xd3&\x1e\u06f3\xa4t\xe0\xac\xf9_\xad\x01\xaf<fZ\"}\x9d^\xb2\xec0\xca\x19\xc8\xcb6:\x84\xa0\xb8\xa7f\xf1yi\xf1\xcb\a\xd6\x15Ggv\x12\xa4w\x1cnj_pw_dj_p\x89\n5\xbb\u00ee\x88\u0191f\xd3\xfc4\xbb\xa6\xe8\x82\xe8\xf0\xef\x9e\xd3\xca<\x06\xdeyTd\x84\"p\"\x1a\xdf\x06\x9e\xd6a\x9a~\x8d\x99\xb2\u07b76\xa0j\xea^hL\xd6\"\x8a\x10\x8c\x1d\xdf|\x05\xef\xfca\xaa\xf1\xd0y~J+oN\x19q\x80\x82\xce+\xeb\xfa\xac\x0ep\xbe^\xbf(\x06\xac\x95\xe5\x81\x03\u678b\xb3T\x8c\xe8;`-w\xda{\xed]B\xbfH\x8e\xf3\x1dw\xb8\xccW\x85\xf7_f#o\xe3:9\x8ey\xf0\u01aa\xdci\xc5,\xc7{+\xdehc\xb8\xc0\n\x11\r'\x87r9@\xa2\xf1<\xcdp+\xbe\x8f\xae\xe1ZN\xe7\xb61\xa7TW\x84+\x0ed(Hy\xb1\xc7\xcc^\x8ay\xeff\x19p\x9a\x16\x06\\\xb05\xba\x91\xe6Aj\x8c\xf3\xd0q\xfb\x9c\x00\x95Sd\x80\x8d\xe8\xd0VK\xa0Z\x02\xee\xd0u\xbf\u05cbE\xec\xfc\xee\x0f\xe8\x18\\\xbb\xaf\xad\x01\x02\x0eM\x9c\xa7Ix\xceA\x10I\xfb\x8e\xf31\xc7\u01d9q?F\x10\xc1\xc1*\xcc\xe0\xfe\xbc@2\xe6\xead\x98\xbb\xac\xe4\x17\xdc\n\xaa\xb3\xc5bm\xbcX\xac\xcd\xca1\xee\uc6019\xb7_EG\"\u05bf\u01ac\xfe\xd1\xfc\xcb*\xb3(\xb3@\xc3>\x03\xefg\x13e\x90,\xf4\x03\x9d2\x19\x00}\x9c4\xe4\u0232\xca|\xd8W\xd1,\xfe\x99\xa1w\xa7\x94x85\xf8\r\xd4\x11\xf4\xc7\x14\x15\xb7\x9c8\xf5$P\n\x85\xaa\f;\xd0o\xd8K\x85b2\xc9-f8]\x0f\xa4\x14r\x10 .}\xf8\xaf\xb3(\x8f\x87?<\x94\xb1\x80}\xdeB\xc7p\xc6\x15(\x1e\x8e\xf3\x9ceR\xcf\xc8\xf3\x10w f\xebe\x9a\x8d0a\x16~\x81\x95/\x13\x9b\x98z\x81Cg\x12\xf0\xcfi\xf6\xff\x13\xf7\f\n\xbd\xa70\x06+)\x9f\x91\xe5\xc3\x7f\x9d\xb1(cYu`.\x1c*\xed`\x1d\xdbP\xe6\u04b5\x140~\x13\xa7\xbeXx\xe9\x8c\xf1W\xa3\u00d4sM\xd5\xe4\x8c\xc7t\x841(\xbe2\xe9>}\x1a\x8e\xfa\xbc\xef]m`\x12\x8a:\xc1\x9b\x0e\x9c\xa6y~\x8a\x89\u0780^\x84#Y\xe7\x14\xd7\xfc\x02.=8\x16\xa0\xa1\x17\xc5\x13o\xb1\xf0/\xf4P!,\xf0\x8a\xc9\x00<\xc7\x0e</\\k\xfd1\x9d\x82,V\x9a\x9b\u0357\xbe\x16\xe6\x04\x82Zr\x11\xa4N\xb0\x7f\x8e\xb6k\x10\xec\xbf+\xd9D\xee\x926\x9c\xeaPZ\x87\xea3\x05\xd2\xc0\xd5\u037c\xd9\x1c\x9a#|\x0e\xe75>Y,\x1620\u053c\u065c\x11{\xdaz\x8a\xb8m\xa0Tw\x10\xce\r\xe6\xad\xcd\x15\x84\xc3!\x9cV\xe3\xc2!]\xae6\xa6\xf3p\xac\xe4\xea %\x9a\xddZz.\xdf\u022c(\u03e1\xb0p\"\xe8d\xc5zO\xe8\b\xf5\xf4'\xe1\a<Dm\xb6\x02\xef\x84\xd6l\xfas\u0502\xdf$\x94\x87\x02<+\x81=\x0f\x1a,\u0362\f\x05f>\xa7\x9d\xb6\xb5P}\x1cn\xb6\xdb\xcdf\xfah\v\xfe\\r\x87\x93]\x92Z3\x1cs\bAW\x82n\x92~g\xe0\xfa\x9a\xb52\xf0\xe2\xe1O\x94\xaf\xf9\x91\x1b\xb3\xc5\x1e\xb6\x92\xf3\vG\xca9\x12\x054B-b Ks\\\xc4\xd2\x02+ze/QW\x1b\x97\x97\x97\x1bp\xf8n\u0333D\xde1\u046b\xea\x1c\x1c\xa6\xce\x13\x11\u03e2L<\xc4\x02\xa3HD\x98E\x9c\x10\xa5Jk%St\xe5\"\xb4x\xa6\x18Y\xd2\xc2fc~\x0e_]u\xbb\xbcYRE\xfak\u0271\xbaqZ\xb6<\x03\u02ac\x1b\n.\xfaL)\xa3iB\xa0\x9a\u037a\xea\v\xe5,\u0672\xf0\xa5A\xee\x04^\xfdPV\xac\xd7\xc7jT8\x7f\xe3\xe2q]\x7f\xe4\xd3$\xacET:\xbc\a\x1b!]K\xfd\xdf\xc92H\xa3\xce\x13u\u8698\xe6\xe0\b\v\xfc&\xcc\xe8\xa4\xc0U\x00\xa5\xada$\xa6a\u072aq\x8c\xfa\xb79\x86\xd1b\xb16\xf9\xbb\x1c\xc3\xe4\xefp\f\x0e\xab\xc0\xf5\x19CKL\x03\xe02\x8d\xc2\x14y\atNz\xfb\xd1C\x13\xb8\xdar\br<\xc3_\xee1T\xc3zD\x9a\x06[\x0e$m\u017c\xa7>\x03\xc5t\xe4^\x17\xe2Dc\xb0h\t\xe2\xfex\xd0O5;a\x0fU\xe4\x1ed\xc3x\xa3\x95\u0549=\xd4\n\xb7\xcdP\x17\xb0\x11\x88\x80\x16\x978\x8c\x8b\x12\x87\xd1\xcd\xf0Eew\xdb2\x1a\x13\xe7`\x1c\xb9\x8c\u0184^\x10BWu_f4t\u00f5\\B\xd4l*s\x8f\xf3\xee,\x9c\x15\x8e\xf4Y\xf1H\xef\xcd\x02}\xa0\xaf\xec\x1b\x86}\xee\f{\xe6\x9e\xe7\xe74\xb9\xc7y\x1e;\xe7y$\xcf\xf3\x14,.\x91n-\x16Z\x127\x94t\xfd>gry7\x80\xa4z>\x85\x83\x10'>\u00d3\xc8&\xd3\x0e\xe9\xaen\x16\xfc\r.\xeb[N\xcb-\x8f\xb1\xe5\xf4\x9e-\x8fM\u02d1\xf3\xa2\xd4lJc;7\xcd\xe8\x82\xd5<Uz\xe3X9\xb0\x94~\u00c9\xa6\x0e\xbd\xd5=\xd7\x1enAu\x14Y\xdd(\xb2\xfaQ(I\xac\xa7\x9c\x9a\xd8q\xdc\xca\xe3\xdc\xe3<\xb6\\\xfe\xdc0:t\x1aVG;\xa9\x1b\xed\xa4v\xb4g\xda#ma\xa4\x8f\xdb\xf4\xa2\xa6\xddQ]\xbb\xa3\xef\x84\xc2\xe3v\x17\fc.\xc8\x1d,\x9fv\x1c\x9f4\x9be4\x9ee)0W9i6\xed\u01ed\u0311S\xcc\u14a8\x8c\xe6\xf9\x16\xe4gxo\xa2\x1e\xbe\xa2\u00bdv\u0186\x1e\xf5\u0306|5BO\xdfb\"\xb5!\xbc)*\u0355\xbd\x7f\xdb\xcd\f~\xb8yx\x9f\xe0\x00,\xe8\x0f\x96\xf4\x98\xa3Oh\x13\x16\xfam*\x9e\xa7s>\xfa\xdb\u07a1\xdf\xc0\xb3\xd4<NF\u01ca\xe4\xbc\u639c\xfdY\x81\xc7\xc0\x17nn\x9e\xf43\xcd)\x14X\x8aW#\xe4\x8a4,\x90\x9f\x90\xd0P\x8f\xf8\x1a\x1e\xc8\x1f9\xacJ\x12N9\xf2\xd8o9\xa1Cx-\xe7\x8b\xc5\xef\x10L\"\x06\x1f\x93\u0366\x9f\x86~\x9b\xcex\x8b\x0f\x89\x1f\x19\uf873\xf0\ro\xb9SP:\xb2H 3\xea\f,H]\u0150\u0725\xads\n\x93\v\x86K\xbc\xa9\x1a\x01\x01\xa0?D\xa5\xe4\xbe\xfcM\xca\x1c\x84J\x06d\x95?CxT\u0429t\xe8\xbb\xe1\x03^\xbb\xaf\x90\xab\xc1\x17\xaf`\x10\x11^x\vV<dE\x9da\xb8B\x9da&\xdf4\xe1\xa1\xfcc\x96\xa02\x04\x1c\xb9\xcfq\x19'\xb8\f\xd9\x05Sj\x0f\xf8\xf3S\x94\xc5\x10R<G\xa5\a\\xxk\x86PtQB\xaf\x8b\vy\xe6~>\x85\x95\x80\xd4K\xb5Z\x7f\xee\xa2\x18\xf3l\xb1\xf0\xcf\xc2\xcb^\x14\x1c\xa9H'G!\xea\xadx^A\xdbd\xdalN\xef\xab\x14cUW\x96T\t=\xc0\x8f\x00\\\xc0\xfd#5\xd8\xf0\x82\xd0\x04\xbf\xab\x00\v\x13B\x87*\xaf\x02\xb5pH\xe8\x183\r\xac\u00b1\x1c\xf9\x95\x9a\xda\x0f\x19A\x01tWZ\rZ7d\xc7\xdc\r\xe1\xee>\x9e\xa0\x13\xb41l]O\xe1\xef)=\t\xaf\x1c\x96\xfc\xabe\x9cO\x80\xb7>\xa1O\xc2+\xbd\x85\u0785WfkA\xafG\xf0J\xb3\x1e\xfa\xa7\xa1\xc6w\x89!\xc1\x9c\xda\x05\x0ffT\xaej0\xa1\xa5\xe5\rFT7\x17\xbc\xa3\xb2\x93\xe0\u0252\u06a5;\x95\xe6\u5f7aK\a\xe4(\xe1\xb6\xda\xed\x99\xdd\xed\xa9\u016b\xa8\x88\x7fq\r\x9a\x15<\x17\xdd,\xd1W\x11\x8a\x14\x05\xed+\xcf\u0494S\x90;\x80\xbb\xc0\x02\xfbE=\u0658\x94.V+\xd5g\xdbD:\fQ\x8e\x85\x16\u0460F\xdbl&\xcdfR\u0434\x1c\xa3\x9ee\xa2\u03b4d\x85V\xe4<K@\xa1rl\xae\u04e9z\xb2\x1e\x86)\x9d\x85I\xdf\xcd\x19\xe0-|mX\xeam\x18&\xfd\xf6\x00\x9aB\xec\x98 \xa7\x94\x00\x834\v'\xc0\b\xa9\xde\xec\x9d\u077b\xf1d7ee\x11\xa3\xb5\x03\u048a\x877~\xff_\xcb\xc1:Y><\xef\n|\xf3bCP9'J\xbd\x06\xc4\x19\x86\xf4\xf1\xa5?\\q\xa3\x89\xc7\xfe\xacu\xa1\x17\xae\xd9t>\xc0\xef\x98B\x8eb*\xe5\xe1\x1c/\xe3B\xfb\xbcQ\xa6\xff\xc7\xec\xfc\xe8j\xe6>0\xca\xe7l\xea\x9d{\xa4;\f\x87\u0189M\x86\x01\xe1\x89\x13r\xd8hP\xd7x\x16\x80c\xbeFe\xaf\xed\xaa\xec\xb5\a@{\xb2\xbf\xaa\xee\xe7y \xe5m6\xb3\xde\x13\xd1B!\x90\xef8\xf2\x84\xe1\x92\xc0\xe4p\x02A\xd7\xf5WFh\x1c>\xe5~*\xf5I\x86)xf~\xca\xfd\xc8\xfd\xf6<:\x0f\xd3\xd6$\xcd\x05\x845\x85\xbf\xe8\x03\x0e\xf0Z_\x96\n\x9e\v}\x16\xc6\xcd\xe6\xbcW\xf6\x1a\r\xa2\u0607\xae\x9f\xc2\xf9zB\x82\x84\xb8\x8ewz5\xaeb\x02\xb6\xf4\x874\"K\xff\x94\xd4\x19\xe1\x14O0u2U\xb6\xba\xd5\u30d7\xa1\x88\xce-\x18b\x90+\x94\x9e\x92T;9\u965f\xb0Ph\x1d;\x04\xd1m\x82\x90\x9a\x17 %\x993\x10AHh\xcd\xd5_\u03e3\xe3P\xde\xf2\xc0=R\x15b\x02D\x10\xb3Jl\x8fa\x11b\x9c\xce\xd6\xc7$\x18\x93\xbe(A\xcc*\x1dH\x88\t\x80\x15\xa1kF\x13_y\xd8;\xd22yz\xd4U$\xfb\x1d=R\x94\xd2\xe9\xf8\t\x90\xb6\x8f\xb3\x99!m\xd7\xe1\xb5y\x11|\x85\x17c1\xc9\xfb\xef\xf0V\u078d\xf1t*\xb1\xb2\xb1<U>\xd4H\x89n\x96]\xb6B\x90\xd6g\xad\x18\f\x16}\xf5+\x04\xe1\xaf\xfa\xddg\xea\xe2\u03f4!\x00w\xdd\xc8I0\xac\x14\x8c\xa0/\u049a\xec\x8c\xdc\x18\xc2\xc3\x06\xfdl\xa0|\x92S\xbe\xf4%\x06W\xfc\xe0\xbd\xe7\xfeW\xf7\x82a1\xfa=\xf7_\x15s\xba\x1fn\x91\xc7P\x10\x83\x9d\xc9\u0661KQ\xf7\x9a\xd3l*d\x8e\xec/G9\x11\u0353\xae]\xbf\xe4\"D\x17\xbc\x00\xa3f\xf3ZC\x8b\x90Rz\x19\u0260/\xea\xb5\x1c,\x93e\xc9\xc0\xdc\xe3V\xaaC[\xbc\xab\x8dO\x80\xed\x84*\x14\x98/\x9b\xa5\x1f\xcc\r\xab\xa3^w\xbaZ)\xfd2\u02b8~\xc1y\xaf\xa1\xe8\xba2\x96-x\x0f\x1aq\u0788\xa6g\xf1\xf9<\x9d\xe7\x8d36\x8c\xe69k\x88\tk\xa8G\xe3\x06\u0403\xc6$\xca\x1b\xd34\x83\x9c\x887R\xce\x1afm\u04032\u0580&]\xb7\xc8\x11\xb5\xddD|\x84e@\x0e\xc4F\x1b1w\x1a@qY\xde\x18\xc5\xd2\xef\xac\xec\x18\xfch>\x88\xf9\x03\x99\xdbR\"n\xe9\xc3=\x94\x9e)\rIg\xfa\f\xd2d\xde\xc4\\79\xce\xf2\n\xe9^\xf9\xdb<\xce\u0628\xd9\\c\x15e(\xcb\xf6)\x8b\x90cU\xda\x19t\x05\x94\x00I\x18\xfe,K/b\xbc\xbd#\x17y\xe9b\x9f#=\u05c9J\x7f\xae\xea\x83\x11\x9d\u0289\x10\x14|\x15uu#\x8dU\x86h\xfd\xf6b\u11b1\xdb-@YG\xa2\x88\xf2\x064\xec\x91e\xdal\xa6(\x9e\n\x8e\xec]\xca\t\vi%T_\xa5\x84\n\\,k\xb2\xf1\xb6\u0584\u0469\"\xcda\xfc\xa3\xf0\xb2\xf7\x8a\xfbo\xe9\x11\t>\u023fD\x13\xd0f\x13\x88\x8a\xa1\xa7\xa4\xc0\xa5\x1d\x87\xa5\xcc\xdb^YL1p\xec\xa5Y\xb8u\x9f\xf5\xbc&h0\x90\xf5k\xde\xd2J>\u04bf\xcc\x12\xfc\x00\x93\xeeQY\x8f\xe1X\xf3dGv\x94\x15\xc2?f\xfe\x11\xa1G(QxZ{\xf5g=\xc7K\xef\xe7_e\xb0\t\x19\xa3\xc3\xde\u021f\x17\x89\xc1\x9f|\x85>\xae\xa9\xf0\xa7c\x8d\xf3'\xbfE\xfb\xd8\u0119\xa4\x18\xb9\x83\xe6\u0191\x0e\u0291@\u28db\xef\xfeSz\xcaYxQ\xe7w\xf5\x02\xfc\xad^(?\xabi\x98\xb5d\xf1#.\u2301!\x1f\x8d\u0531\xfe,\x1d\xe2\x1bu\xcde8\x0f\xb3\xbak\x1d\x9dW\\H\xe2\xebF\xad\x1dA\xb6B\xdf\x7f\x1c\xdeT\xf5\u0239\x89\xee\x10\u0559_\u0135\xda\xf5\x15\xe5\xf3y\x9d\xf2y\xb2B\xf9|\xb8\xa4\x13u\x17\xfd\xb9\xa3e\x1ebI\xe8(\x9cH\xd6L;?\xa1\x13\xee\x17v\xdfX\xee>\xac1\xaa\xf1\xa8\u05a6\x15\x03\b\xa9l\xa8\xbd\xb7\xa8%\x9c\xe2e\x8e\v\n\"\x9a\"3\xc7Q/\xc8\b7\xa78\"y\xab\x93\xbf)\xc4~\x03\x04'\xb4\xaa\xb1>5\x0e\\\ue871NWn\x04@\u93e8^\xbe\xbd\xbf\xb3\xe9(\xfe|+\xf2\xb2\xc8\xc4\x0eS>\x8e\u03e5n\x8f4T\x85O\x85\xee7c\x1e\u0720\xe8+\xf0E\xf8;\xe5\xc0\x8cg\xec\xb9\x12\x86\xa5\xadY\x9a\v\xfd\x95-\x16u[\x1cL\x84\xf8\xaa\xacjj\x85\xceK\xb3-\x94\x9b\xb0%\xa1\x7fH7\xb1\xaf\xf8/\xf0~\xf3.\x03]Y\x9f\x11\xcaB\xee\xcbh\x1b>\xc3\xf7\u1488\x8f\xc2\xe5o.X\xf0\x8cSuc\t&\xe6\xe7\xc9\xfcLd\x8cU\xc2\xca\xcb\xc0\xbe\xae;x\xa3\x1c\x1d\xf9\xa4\xcb\u00db\x1a\xd4M\xebvV\t\xe7\xd3\xf2\x86\xac\xd9>i\xdd\x1e\xaf\xdbQi\u076e_.\xf5\x858\xae:t\x9b;\x0e\xdd\xe2\xc7[\xbdxc\v\x1c\xba%\xe1V7y\x14w\x93\xf5u2\xef'\x1b[\xaeC\xb7\u011c\x19\xcf5\xe6I\xa8P\x19z\xa1\x8f0\x1b\x14\x02\xd1\x1a\x9dQv\xcc\xf2@0\x9a\xce^\x8d\xc0j\xe2\xfc\u05e5t\xc0\xa8\\\xeb\x047\n\x11\x83\x1b \xafO\x86\xd2s\xe3\r<\x01\xb3Q\xf0\x91\xb7\xe4/t\uaee4\a\xfb;\x9b;\x81Z\xa5\xf0\xf1\x8d\aL\x99\xb2\xa0\xe9\xf2V\xe6\vByk\xe4C\xf4\x12\xc9\xdf\x04>\t\x1f\xa7J\x19$\x83p\xa2\xed\xcdmws\xa4\xe6\xd0@\xac\xcf'\x92\xe9\xf96\x8f\x12p4\x1dd\xad3\x86}o\xefom\xef\u076fo\bR\x12\xe7\xb3$\xbaFq\x13\f!3C\xa8\x95\xa7\x8cl\xf1\xc5BrN\x8b\x85gT\u06fd\xe5\x92\xeemmo\xb6\xbf\x7f\xee\x893\xf7\x9d\x83\xadmt:\xc8[p-\xa1\x91\x81\a\x8d!{goo\x97\xd0<\xe4>N\x96\x00\xbe\xf8\xbb\xed\xad\u03b6\x8e>\x92\x84u>*\x14\tQ\x83\xcd\xf1\xba|\xce\u0109H3)\xc1\x85\x8f\xeb\\\xb0)\x1d\xc2-\xc2o\u04f8P\x05\xe4>\x19\xe5\x04\xa4qQ\xeb\xe5{R\xe0\xf7\xc9\xcd-AVE1\xc8*\x0f\xdb]\xfe\x88u\xf9\xfa:\x11\xe8\xb9\xd5 \xb2\xf5I\x9b\xe2\xc5\x02\xa9\xe9\xacf=\xfc6\x9d\xb7\xfe >\xfbO\x8eA\xe0\x8d\x05!\x01\x17\x857\xe8p\n\x84\xe0Q\xccY&\x0124\x12\xfa\x9b,M\xc5+\x8e\xce\xe1\x83\x1b\x17t`\xc3\x15}e\xa5\xfa\xc1\x8cf\x8c\x83E\r\xf6 \x7f\xcbF\x8b\x90\af\x8f\x02\xe2\x97p6o\x15\x13p\v \x82\xdc{\v\xd8!\x02\x1eN\x98\x19\x11\t\x1f\x8f\x19\xad\x995f\u0358\x83\xb1\x9b[{\xbb;e\x8c\xdd9\xd8\xdeA\x8cmq?\x92\xf8\xba\xb3\xbf\xb7\x89\xe8\xda\xe2~\x0e\x04\x8d\xfb\x9b\xbb[\a\xdb \xf2\x84\xb4\x04V\x9a\xfb\xbb\a\x9d\x83}\b\x19\ai3B'P\xb5\xb3\xb5w\x00|\x04\xa4M\xc0\xb7\x1f\a\x13v(w\x81iSB\xcf\xc1\xc4k\xf7\xa0\xbd\tr\nH;'\xf4\f\xda\xdb\u06c4>.C\xee\xefm\x1d@\x98\xe2#\x18\xe0\xde\x1e\xb4x\x15\x9e)cg\x13.\x0f\x18\x00)\n/\xa0\x1d\xf3\u0252\x9e\x84U\xa3\xf2\xd3%\xfd\x1a\xde\xf0T@\xdc%'[\xf9\xb8~\xb2\xc2\u066e\xf4'.}\x17\xa4\x19\v\x99\xf6\x98\x9a1.N\xe6g\xa1\xf2O?\xe7\x10\xf8c\x98\xc5g\fe\xc225\x89s\xc18\xcb\xf2\xf0\xab\xf6\x0e\xcbG\t;\x9cD\xfc\x9c\xfd\x8a\x9e\u0472pU\x86\xe3\xe6u\xa9\x85\xe7\u0194\xcc\xe0>8u}\xcbr\xc1F0\x9aU\xbe(Dv}\xa2\xc7\xe7\x93\xd2\xe0Zf\xe8`\xffFEKB\u0274\x9a\xbb\xb0)U\x95E}\xacV7\xb9r\u0354\x9f\xc0\x99%\v5\x9b\xd54\xd9T\x9c\x9b\xe1\x8ej\x16\xf3\xa9\xb4\x0e\xf3\u02e0\u01fa\xee\\+\xfd;\x85ApU^\xba\xe2\xe2\xf6\x8a\x9f\x05P\xfb\xab\x96\xcd\x04\xb9N3\xe6@ve\xf12\xa6Tl?O\xa4\x03\x03@*\xeez`\xbd\xc1\xd0a..\x17J-i\x15\u0659_O\x86E\x97u\tC\xb6\xe3,\x1a~\xf5\x81\x19\x94\x97\x00t\x9fz\xceDP[\xaf\x0fBz\xd1\xe5P\x19\xc5r\x1cH6\u01da]\u02e3\x1a(T$\xc2Y\xb8\xd6\x06\x92\x14\xde\xe8\xbe\x03F\xa1\xb6\x14\xde\xc2-\x10l\ue30b\x12H\xe8\xc9?\xd8I\x98\x06\"L]\xf1~\xd6lj\t\x0e:\x94]\xeb\xd0\x14\x8b\xf6\xe4\x1f\xac+\x99\xf0\x8b@q\xe3\x17\xb4\xaee\xfc\x13\xe8\x1f\xa0k\xb3\xf4\x89\u01b0\x8f\x0e\xd2\u0702c\u06a3\x95\x93\xa4\xb7\xdf\x1d\x14\xa3\x85\xab[\u066b\xe1W\xb2D?\x06\xf40,\x98](^\xff2\xe6\xa3\xf4\u04b9\x16\u02c4\x96\x8e\x9c\xb4:G\x11X\x15P\xa5w\x06\xb7\xd8\xd7\xd1u:\x17G\xe31\x1b\x8a\x00S\xe4o\xc5\u00bc\xab\xd5*D\u0327\xdc\xca\xf4\xa9\xeb\x15\x9e\xe2\x83<\xb6\xf5\x86MSR\xc0H\x89U\xc8\x01\xf8\u0089\xf3P\xa0\x10\x80\x99%\x02Eo\xb0\xd3@hT\x93\xa6U\f\xeez\x18L0\xba\xa5S\xd7\xfb$v\xe3\x13U\xad{X3\xba\xb4\xe5\xf6a\xc7X\"\xb1\x11`\xa0\xd3d\xb3Y\x1d\xb6_x\x98b%\xbc\x82]X\x9a\xb9\f.Hh?\xa5\xd1@G\xa8\u12c5vl\xd88+\xae\xa2\x1f\xb7\xdeKYa\xa6\xdd~\xa5K\xf0x\x8dU\xbf\xc0Q\xbc\xb7\xbd\xbbI\xe8+8\x81\xb7\xb6vw\t\xfd\x80\a4\x9c\xbao\xf1|\xfe@\xe8{8\x947\x0f\xf6\xb6\b=\x86=\xff&\xecKB3O\x12\xc7\xfa\xebY\xc1(\xc6*\"\xf7\xe1\xedFZ\xb4\xf0\xf5\xce\xc0\xd1m\u0417\xc4\u00fa\x05a\xc5+\x12p\x99\xc0b\x99\xdaOm,&\x90=\x01\x00\x95W\x980\xa3\xc2\xfcN\xa9\xf1\x16\x03\xc4 \xd2\x1f\xe8\\Hg\xe0tb\u07f5\xc8z^l^\x8a\xb6\xa4{D9\xc7!4'_\x80\xe98,\x06\xc2Y\x1b\xea\x87FN\xc7\x05<@\x0f2,\xcc\xfc1Mu\xf7\x8e\u0415\x87\f4/\x96|\xb1\xf0e\xdb@\x8b\xc3\xd0\f\xb5\x17\xeb_\x8b\xc5\xdc'\x813\tFs\xe7wlg\u0766\x89\x7f\x03\x04\"\xf0N>\xbc;>:\xfd\xf8\xfe\u0653\x0fG\xcf<\xaa\xd6%\xb8A\x173\x01\x97\x1e\x06\xbb\xbc\x84wc\xca\xcb\xf8=\xf6\xab\x16\xff8\xf5!\xf4\u01eb\xb8\xcckp\x99\u0394 |\xb6\x94\x86W\x7fV\x0f{\x89j\xed\x81#\xcf\xfc\xa8\x10\xad(\x88\xbfY\xea\x87&|(.q\xdd4\xb5\xaf\x8aY\xafFj\xa2\xec:\x9e\x8c.\">d#\xdf[g\xeb\x1e\xf1\x96\x01\xa8\"p\xf5\uc9b4\x93\xdc\xf7\xc9a\xb1\x1e<W\xe6h)\x01\x1c:\xfa\x8f\x021\x86\xab\xab\x90\xf7\xe4\xcf\x00\xf4\x97x+\x9f\x80\\\xfd%\xb2\x06\x0e\x80\xf2\x82\xc2\xf4b\x01\xea\xd2\\\x12\u05dfYIU\xda\xc3d\x0f\xf5\xa5}\x8e\xb7\xa0c\x06\x8b6N\xb3\xcb(\x1bA<h:\n\r\xe9\x9f4\x9b\xa05\xcd\r\x85\xbe\xb0\xadM{W\xc1\x94\x9e\x03\xc1|\x05W7N\xfb^\x11\x94FUM}\x94\xa6\xeaQo\u0554<*G\n\x9a\xc5\xd4S\xc3\xf4\xa8g\x87\xa9\x8dK\xaf\x04(Y\\\x87\x17un%\xd4*\x17\xaf\xfa\xa2z\xd5\a\x01\x9b|E\xf7\xdb\xf4\vL\xe6fI\xcf+B\x85\x94\xda\xe9\xa0\u01350\x9d`NWM'\x18R=\x9d`L\x9d\xd1\x04\x19U~\x8f\xcd`0\x99\xd3_K\xc9(x\xcd\xc3\xf3\xd6l\x9e1ef\x93\xf7\xccIU\xeb\x1c\xc9'\xce^\x98Y_\x8fw\x9e\xab\xad\x8cECq\xccF\xf3\xab\xe7\x12\xe2\f`NEa\xb1\xeb\vyF\u03e3o\u0446Q\x8c\xd1\v\x01k@\xe4\x0e&.q\x98\x81\x89K\x1ef\xfd\xcd\x01\x9d\xdf}\xee\xc2\xebP\xebP\xea\xf4\x82\x15n\x9b\xbe\xb7.Xu:\xf1\xcb'\x9b\xad#\xe3\xf7\x90^\x1a\\\u02e3\xf1z\x00WT\u0771j\t\u0713\xd1q\xa8o\x0ej'\x91f\xb3\x94bHuM\x96\xf6\xd4B\xba:g\u651a\xa9&\x95\n\u03b8\xa7\xaa\x05*\x83\x8e\xee\x06F\xad\xa7&\xeb\x0f\r\x15+&\u021eL\x06p\xbf^\u0760<\x84T\x1bo\xba.g5\xa1c\x19\xffgV`e\xe0\x96Q\xe5Q\xe4-\xd4x\x99\xec\xeb%\x9f\xd01\x9d\r\xe0b?\x85e?\x0f\xa7\xb0\xec\x97\xf7\x98bo\x168\xdbqFo\n<\xdb\xc5\x12\xa77\xa63z1\x00!\x80n\xf0\x18\x9f\xe52\xe2?\xa3\xc7\xf4O\x90\t\x1c\xf5\xdb\x03\xe8\xfb4<\x02f#\x1e\xfbW\xcd\xe6\x95\xf4\x96\xa6\xce\x15\xf5\x85\xd3?q\x9a\x1a\x13\x9f\u042f\u0144\x1cx\xeaR\x91w\u0144\xb5\x0e\xf0GI\u0774\x0e-_\x91\x87a\xf8\u055c\xd7&#\x18\xf9\x13\x87\x11\xa0\xb9\\GzE\xf3\x01\xe9\xbe\xf6\x9f\xd2\xfeWzB\xdf\u045c~\xa0\x87\xf4|@\xe8k\xff9\xed\x0f\xe9\x84^\xd0\x11\x95\x99\x87\xf4\x9c\x9e\x0e\xa0\xde\x05\x1d\r\xf4\x1b\xe9\x9dP/o\x1fA\x9d5\xf8@\xc1\x1bZ\x10/\xa5YXL\x05\xfd`\xb6\xfb\x9dM\x0f{\xe5\xc6\xe7\x15\xae\xf3rI\u07d2\xe0-\xb4>\xa7o\xe9\xe5@\x8a4&H\xeb\xa6l\x9a\xfa3\x12\xcc`\t'\xad2\x89\f\x05\x9d\xb8\xa4>\x9c\x15\xbe2:\x92\x04n\x1a\x9e9\xe7\u074a\x10\xe3\xe5\xa1N\\80zSO\xfc\x02\xa1b\xdf\xeb\xf7\xab\xd2\b\xa6u\x83~\vOV\xc0\xb6\xaaJ\xf0=\x81o\xcbc~+\x8c\rC\x96\xf4P\xdb`\xb1\x80?b\xb1\xe8<da\xd8y(\x02\xb6\x06\x9a!b-tL\xcc>\x99\b\x9a\xb2!\x1b3\xd51\xa3\xb3\xf1c\xe5\xb3Y\x88\xcdWr\x85\xc9\x15\xba\x99\x8eb\xa7\x94\u03e4\xaf\xec:\xc7\a\xa2B\x82(\x84\x05\xc6\xc8\x14\x05C\xa8\xb5\x8e\x89\x86&\xa3\x9f\xe9\xa2\x18\xa8\a\xa8T\xc5%S\xd19\x92\xf1\x9a\xdcOA\x17s\xed\x9b\xcf\xfa\xf0{@\x85\xfcKlOz\xfe\x16D\xbf\xba\xe1\xca\rq5\x0fS\xa1|\u00ee{\xc3hdz\xe1\xd2\u0588\xcd\x18\x1f\xe5\xef\xb8\x1aW\x8eb\x05g!_\x96\"\xc0+\x8d\x91R-\xe7\xf2]\x93\xdb\xd3\aIM\x1e\t:XI\x82\u0389\xf1]@\xa0\xe2\x04\xb9\x8b\xa4\x95\x17\x13\xa7ZV3\x96\xac5\x8df\x1fR\xfc\xc0\xc2A1\x85,\xbb\xabk\xc3\r\xc0-\x1e\x16\aV\xc8b\xb4\xae\x01\x80\xa7\xf2h\x91\xb9\xb1\xb9k\xfc\xc1\x81\x92\x7f\xa1\xc5tE\x8b)\xa1\xba5B\xe1\x1a\xbcT^\xb6~\n\xfbu\xd6&\x95\x9eX\xef\a\x9f\x11\xe5A\xa2\xee\xe5\xb5\xc14g\xffk\x9d\xdf\xc3\x1b}\x8c\xa3`\x82\u0537\xe0j\xf0\x98~\x7f\xad\x8d\xdf]\xa7\xa0\x821f]}9\tD\xd6\xcf\x06\xdd\x15\xc0\xe3\xe0\b\xb7F\x1e\xe2\xa7\xc5\v\xb8}\x1f\x87\xe0 vW\xcb\xf8\x94\x99\x9f\xba\x8a\xc0\f\xef\xeb\x06X\x03%1\xfa\xf9?\vj\x03i\x84\xaf#\x9axQ\x8c\n\xe8\xd0|\xe5\x02\\\x0e\xef\x8f{\x0f\xaffDw\xee>|{\x01>\x1f/\x94Q\xc6\xde\xc0;\xfc\b\xf1\x13_Ii\x1c\x1a\"Vh\x8e\x1a\aPL}\xeaRq/m6#\x7fN\xc1\u971f\x85s\x12\xf81\xee\xbfpN\x00\u0157\xf7\x85c\x15\x03^,]\x10\xfenc\x95\x96G\x98\xd2\xc8$r\x9f\xe17\x15~\x06\x7f\"\xf7\xbc\xfb\xc5\r\x9f\xad\x9cEh\x9d\xa10\x05\x90 s\xa4\xa01\x93Iz\v\xcb\u0131S\xceI\x9e\x00\xe4L?#?\xa5J\xbdKj!\xad\xcd\xfc\t\x8d\xe1\xf5im\x88\xc3\xd3\xf0\x8b\u0094\xc6\x10l\xaa\xd9<\xef\xf9y\xc8\xd0H\x88\x8a:\xe2\xed\xcfC!=\xdf\xe0\xab\x18\x8c;\x06\x14\xef\xf9\xb5\xb4\u07b4\xf6}\u035d\xf7\xfc\x91\x1e\xc64\\\x1b\xfb#\x9a\xc3\x15qD\xa7\x18\xa8\u06d4\xa4\xa0\x90\xbd\xac\xae\xc5\u042c\u01647\xc2\xef@\x0e\x05\xa7:$T\xf7\xebt\v\xf0k\u04c2k\xae\xdf\\\xb2\"Z1\x8f\u015bh\x86pW\x94\x96f6\xfd\x99\"m:+\xd5Y\x80\xe22)2\xb7L0\xa5\xa8i\u03e3:\xb5\u051a\xce0my\x03\xf9\xcc\xce(>Zf\xf2\xc7<L}f\xd7\x164\xe3\xe7\x19\xeb\xfd\x12\xfcN|\x89f\x90kg\u0218\xa6\n\x9a\x96eN\xe8\xb2n\xf68lw\xb3\x8d\rMC\xc1\x0f\xb8\x8a\x12b\x9d\xa6/\xab\xbb5\xabQ\x9d|\xc5/\xa2$\x1e)\rI`\xb7\xaeg\xac\xe1\xadkz\xb2\xee5\xc6i\xd6\xf0\xd6\xf9\xba\xd7\u0404\xb6q9a\xbc\xa1\xe4K\xa0Ri\xbc\xe44\xbc\xf5\xacU'aX\xf7Z\x9e\xbb\x88\x82U9L\x9b\u02d9\x95\xdb\x1b\xf9\x0f\x03K\x1a\x86^\x89U\xd7/\xdf\x1d\x16}\x02|D\x97\x00\xa25-\xae\xdf\xf3h(\xd2L\xba9r<\x04\xfc\x8c\x0e\x02\xb0ti]m\x85\u0735\xe4\xf9)\x00\x1f\xe5B\xaa)\x95\n&\xb6\xe0\xbc\xf7\aX,\x85\xa2\x95\xb3\x84A\x01Y\xec\x9a\xcel\xa1a\xef\xb7`\u062d90)G\x1ad\x86\x89\xa6\x82Z\x84\x18\x87)\x9d\x87\xb1$\u058e\x18n\xbeX\xcc\xe98\x8c\u02d4jb\x8b\x8c{\x82\x810H\x16*\u04ae\xa9-6\xea}\nF\xf4\xc2i\xca)wn\xcb]\xf4>\x05\x17\xf4Z\x96\xab\x9c\x17g\xb6\xe0u\xefSpM/\xcd\x1e\x03\xafJ0|\x8fz\xc5\xc1\u0284\xc2\xc0\x9c2\xe5\xc4r\x97\x1e\xde\xc9q\xe7D\xd4+!\x80\a\xb7r\x06GTN\xbd\xear{\x18F\x8f\xf9\x9c&\u0533\x8bk#\xf4g\xfe\xcc^\xc7\x1c\x99\x9c\x96\xb1z\xb4$\xb9[-\xc65\xe2\xdb\xd5\xc2;\xc3n\x13ZC\x8c\x82#ZO\x8c\x82+Z$F\xc1)\x05@\aCZ\x04t0\xa1e@\aSZ\x05tpNk\x00\x1d\x9c-\xe9%z\x83G\xce$c!g\xca{y\u02ba)\v/\xe1\x05Q\x80\xb1\xd4\xe9\x19\f\x8f\x8d>\xa2\xf3\xf5\x9c\x9e\x86\xa9\xe48\"\x06J\x19{;;{\x84\xc6L*y0BsH\xde\xc5\u0539L\xcd\x19\xa1\t\v\u02fa{\x86Y:j};$>\xef\xd5^\x1f\xaaAg\x98OZc^\x88\xe4InF>\xf1S\u02b4B\xca\x05|\xd6_\xbc\x86\xda\xe28%4[\xa1\xa1\xa9\xfb\xc6F\xfb:\x1c\x10\bnW\a')\xdf\xfd\u07d9g\xc3%-\xe7q:W~LT\x90\x84Y^\x8a\x85+]\u02a7K\xff\xace\x95\xaf\xecK\xb5+ \xf0~\x05Iw\x9a\n\xdfq#QDe\x10S{\u0103\x06\x97\x18\xc74\x98\x83\x8c(s_\xecm\x9cx\x1a\x85\xb1O\xfcX\x0e1#\x94\xf9\x04\x0eB_]\xe1\xfd\xb4\xe0vx\xb1p\u0231\xfc\t*\x96E\x84'u\x1a\xa672\xc43[.\xadS!\x0e\x87\xa8kS\xc7j\u0157\xea\x19P\xa2\x82s'\xe1\x1a\x15\xf8\xffE\xa8 \xf4r\x9b\xe7\xf9\xba%\xbfm\u0173\xea\x8aK==\xdf\xf1kXZs\xa1\xd6<C.\x1d\x15\xe9\u8c3c\rif\xf9\x13\xbck\t\xed\x8cT@X\xe3\x9a\xdbI\x047\x02\x10\x8d\xd0\f\xfea2\xf2\xd0l\xd5\xfe.\xf1.\xea\xc2(\xd7-\n!\xd5\xcb\xd2Tx\xc0r\xb9\v,WQ\xe8G\xb7\xea*\n\xaa\\\xc7\xc4\u075a\xe5\x8cC\xaeD;\x90\x00e\xe9\x10\x8e\x95\x8c\n\n\x86dq\xed\xc2~|{\xf2\xe4\xf9\u0469\xe1\x84~\x8d\x93\xe4\x98\rY|\xa1\x0e\x93\xf2\x92\x83\xf9\x80n\xd6.\xac\x1bCl\x05\xa6H\x89y\xac\xdd\x1f(\x84\xc8z\xd7\xe8$(\xe8[\xbb\xd722E\x94\u074a*q\x15UJ\xda{\x16g\xd22\xceD\ng\xe2\u548e\xebp\xa5r!\xd0\xe8\"\xcbd\xc4\xf7\x9e\xccf\x9e^\xd4\xee\xa5z\xaa\xf4\xab\u04d0\xcf\xcf)\x00kr7\xf6df\xb9\xab\u05b1\x9b\xb5\u05b1\x9b\xaeu,\x1an\xa0\xe0R\xe9\xaf\x1b\xd1d\xe6XK\x81S\x03\xc5S\xbfe\x18:G\x96\xa6\r\x916P\u03fe\x11Y>\xb9\xd5\xf85\xca\x1b\xe7\xf1\x05\u3368\u1b67\xb8v\x12c\xf3\x10\xe4#\x86\xac\xf5\xa2\x9e\x84\b\xbe\xea&\x8029\x15>!\x81\xfcM\x82<\xf0\xe3\xd68\x8a\x93\x938a\\$\u05cb\x05\xf3eXn\xb4\x85s\xec\xa3\xc61\x1f\xd9Q\x04\x1e\x90h\x04&\xa8~n\xed\xee\xecwV\xab~J\xb5\xe3-T\xe0|K\x0f7\xf1\xc7\x1f\x8e\"\xa7\u049e,(r\xee\xed\xef\xec\xb7K\x8a\x9cR\x9f\xc3n\u0239<\xb5\xd5\x19!\x16\v\xf1X\v\x14\x89\xb2dS_F\xb6\xc3\xc3v!\u073a\x80 y\x02\xb5q3\xd0\xc6e\x8e\x16n\xb6,\x06\x8b\xa9\xf0\xdc(\xa8.\xbb*\xd1\x17\xa99\x88\x141\xacw\xa9B\x9d\x92\xd3\xc9\xf5\xf4,M\xb4\xa2\x17\xeb\xcb\xefV,d\b\x9f\xc1b\xa1s\xbc\xff\xf9\x1f\x9d\xea\rH\xc1]\xca8K\xa75\x9d*\x81\xba\xea\xbcbJ\xe1\x8c\u05d2\xbe;\x83\n\xb4\xf2$\x1e2\x7f\x1f\xec}\x15\xbc\xbcw\xc6N\x0f\xadG\x9d\xf0\x01\xe8w\xba\x90\"m<\xa9\xf7&B?\x9c|\xb1\xf0N\x98\xac\xdb+\xcc&\xf0\x9e\xe8\r\xa5\n>\xfc\x97\xdf\v>\u018bW\x84\v\xbf\x17\xec/:\xbb\x8b\xadM\xe2\xf7\x82\xc3$\x9a\xce\u0608\xc8\x16~x\xa8\x9cf\x92\x9e\x9c\x9b\x16\x1a\x95\x00Dnj7\xa3\xbe\xe0FB\xb0\xe9L\xc0n\xccg\x19\x8bF\x18\xe1\x13\xd7\xe0,\xb1qE[\x9f\xf9+\xdeH\xb3\x11\u02e0\xe8\x19k\xe8\"\x14+D0$e`\x987\xa6\xf3\\4&\x11\x86`\xac,\xb6O\x1a\xf2\xaa\x007__\xbe+\rA\xef\xfe\xa0`\x9c0+88\b;]\xf1\xa8L\xa5\xbab}]\xf3-\x12\x81,u\x12\x83\x9e\\0\xdfM#\x01\xca[\xdd\xf7\x10N\xea\x8edU\xe2\x9c\t\xe7eC\xce$G5\xc5L\x13\xfc\xdbJ\xfa\x9c\xb4\xc6q\"XV\x1bV\xa7\xb6\xea3&\xdfV\xd3\xcc\u7511\x16\xe3\xf3\xa9\x844(\xed\x03WU5y\x06*\u0466C\xa9\xb8\x0f//b\xe0\x06\xe2bK\xe9\xdd\xc2(mO\x8c\"\x19n\x88\x11\x18\x8d\x1b\xc0O\xd5^B\u0642\xf1\xad\xd7q\xbe\xf4\x9eb]\xd7I\x80\xf4\xa9\u0532\xb7\xc3\x11\x86g\xf3\xe1O\b\x8d\xf9\x1c\u0368\x8d5\xa7l\x94\xf7D\xb0\x89\x7f\xfb\xe8r\x13\x1dnZ\x03jL\x83VmBg@\n\x05:\xe5\x02\xed\x01\x19\x04[\xe56\x05\xe87|W\u00d5\x02\x9b\xf7\xe8\xf9\xf6\x02\xe5\x166\xefja\xf3\xbb\ayg\x9f5\xd3\xfa~\b\x7f/d\xbe\x7f\xa2w.\xcf\xe6\x9d3GD\xe0\x8f\xc3\xed\n\x1e\x88\xfe\xd6?\x8d\f\x95\x02[\xff8\xb6t\xee\xea\xe2\xef\xa3\xd3\xe6]]l\xdd\xd5\xc5\xd6]]l}7$;\x7f\xbf\xc6\xd6\xdf\xde\x04w\xd7\u063a\x1b#\xbe\xb7\x8f\xad\xbf@\x82\xfev\x8d\xad\xbf\xbd\x99;\xdf\r\xab\u039d3o\x7fw\x8d\xcd\x7f\x9e\u0134\xbf{\av\xfe\u0096\xfc\xde>\xb6\xbe\xbb\x8f\xef\u07e4\xed\xbf_c\xf3nJ\xf1\xfd\xb4\xe5{\xfb\xd8\xfc\xee>\xfe\x91#\xf8{I\xc5\xf7c\xf7}(\xda\xdf&\x8a\xff\x00\x05\xeb\xfc\x85\x83\xf4?\xc1\xb0|\xffi\xfd\xb7i\xf3\xe6\x7f\x80\xf4\xfe\x03t\xb2\xfd\x17\xf8\x8e\xff\x04{\xf7\xfd\xcc\xcd\xf7b\xe7}\xa8\xf3\xdf&\xf0\xff\x005n\xff\x056\xedo\xd3\xd2\xf6\xdf'\xae\xdf\xcfUm}\xff\x8al\xfe\x85C\xe1o\xd3\xfc\xf6_\xe0j\xefu=\x91R\x1bB\xe1\x9al%r\x17\xffL\xa0\xe2\xaa\\\xb7(\xc0\x95\xed\xe0\xf5}\xb5\x9c\u0093n\xe8\u05e4\xab1p`\xef\xc8a\xd3\x15\ue5cc\xf3 T\xd3U\x12\tP\xdc2S</x\x81\x90\u0483\x86\xe7\x14\xb8v\x8d\x14y:\x926\x8a\x18%*\x9f0&\xcd\x14\xf1\xb3h+t#M\x80\xd0\xfd\xed+\x9e\u011c\x9d`\x1dtI\xfa\x95]\xd3\\>\xb6)_o\xa8\xba d\xd0\x06\xd1\x12\xd19\xda\f\r\xb5\xcf~\x94p\xa3}\v\u01bbP\x03\x1eBrb4\x96\xc2:\u057evE\x8d\xc1J\xfb\xf9z\u0611*\x97\x0e\xe0P\xcbJ\x15\xb8\xf6o`\xca:z\x19N8`\xb44\xa5@P|\x14\x19\xa6#\xb6\x91\xb3sX\xd8\r\xfb~E\xbd\r\x8bo)Ag\xd0\xcb%>\b\xc2\xf8\x95\xf8\xff\xac$\x0f\xbb}E\x85\x0e\x17\b\xa8\\+\x8ab-\xe5\xc87\xf7\x05Y,\x94\xa51\xaa\x17R&\u00d8\xd0\xcb\x10\ff\xa3<\x7f\x8b\x8e\xf3\x9c\x0f[Y\xe1\x1d\xe9\xf5\u056fA\xd0\x1f\u0423b\xcdK==\xb7\x89\u0578\xbcvf\xdbWapG!\"iNoL\x03\xc1\xb9\x7fd\x1ea\xe5\x02\x04\x17n\x0fTA,\xca\xc1\xff0V\u05d8\x886\x8f*\xd8@}\xd3N;d\xa9}\rO}aLn\xad\xa1^\xe9\x85'\x01m\x8b\tj[\xc0\xb2\xc7K:\"\xf4J\xc5@;\v\x1f~\xe6\x0f\u03ed \xef\xd2\xdd?\x80!R\xc6M\xb9\xf9\x84\x01\xa3\xad\xaf~\xc7:\xa9\xd9M\xe3$\x8dD\xe0%l,\xc0\xfep\x04QW\x8e\xe3\xf3\x89\b\xbcN{v\xe5\xe9\xdd&#\xbb\x9e\xa8p\x8b\x8e\xc5\xdd\xcd2\x90\xb1\xbds\x11e\xa0\x17\xf5:\xe6\xec-\x96^5QDh\x8f\xaaP\x8b\x15`c8HZg\xcb\f[#\xa7\xbc\xb63K/\xac3\x86\xe2\x064\x9b7\r\xc5:_9\xb8|\x16qO=GB\x87v\xc7\tB\xedJK\u00ec\x8d\xfc\x9a\x8b\xe8jc\x12\x9fO\x12\x00\x1b\xcb6\xb0\x92\x8e\x84+\xe7X#\x83\xcez\x99\x9f\x92 [:\xe7LJ\xbd\xcf\xdcC\u06c6\xa5\x7f\x83\xb3\r\x84\xe3P\x8e\xff\xf0\x90z\x1e\xd1{\x14\u02aa\x0ebZ\x85H\x90\x17b\x1a\x1e\xb9\x1b]\u065f29i\x8f*\xc2\x18\xa8\xd9[\xfa\x198\x80\x90s\u06b0\x00a.@0l\x9cl\r\x8bk\b\xdc\x0fP\x035\x11\xb1\xa4z\xa3\x04}5J\xa4\xcf\xeaI\x98-\a\x8eB\xddU\u046f\x95{,\u0768W\xdd\xc0\x8b\x91\xa4n\x9c%\xe9\xf0\xabG\xa71\xff5\x1e\x89I\xe0g!w`\x9f9!d\xb5\xfb\x1b\xaf\xb5\xb9\u00e6\x1e!\xe5\x9d\xc1\xa6\x1e\x85Q=I\xe2s\x1ex\x19${@\xbe\xb3\x13T~\v<\x9er\xe6-i\xad\xb62\xf3\x05\t\xdc\xd8\u05a7\x85\xbd\xac\xa6\x8fx\x9e\x14\xf1\xdb~\xea\xbd\xccZI\x94\x9d\xb3\\8;\x01\xf6k>I/\xe5Yb3\xe4\x19\tiR\x054\xaf8\xec\x9eC\xff\x86\f\x16\xb4\xfb\xfa\x03T\xef\x93-\xbbm\x8eC\x86\u028f\xafS\x89|9\x9d\x845\xd3\xce{p\xfa\x04\xb9\xb4*2\x9d\x84\t\xe5\u0366:\xabF\u155f\xe1\xfe\x87\b\xb6<\x9f\xc4c\xe1\x1f\xf9\x9c\x8e\xecs\u01f8\t\xee\xde'r\xabK\n\xac>\x9c%\x1f'\xec\u0283\x13\xe9\xfex>\xb1x'\u0710\xb8\u0147\xaa\xbf\u022e\xa1g\x8e\xbf\xf8\f\x8f\xae\xbf\xdb\xdd\xec\x91~\xa0\xe9f\xeb\xeb\x05K\x02\x97\x87\x91q\x87\xb4C\xf0S\xff\xc6\xee\xa6t\xe0\xec\xd6\u0107'\xc3\x13\x06T\rm\xa9\xb4\xc3\xda\xd4\x1eTZ\xb1\xc5j?Xx9\xa7\\\x97\x87\\\x179q\xea\xd3\b\xf8\x11c\x84`@\xfa\xb5j\xca/\xbb\x02\xb5\xf3\x13_EV\x05\xa3Q\fU\xb9\u0461\x93\xb0\xedj\x93\x1bB\x1e\xfdu\x98jZ\xe1B\x88Q\xbb\xbf\x02AK\x9b\r\xec\xc3\xcb[\r\xe8n\xddF\vRj\xb6Y\xc0\x1d\xb0G\xb4\xb4}\x94m\xb2\xd9<\xc1|\xe9\x10\x86\xa9y\x01\u03daM\xd1l\xa6\x9a\xfb\xbc\xf2s*hL\xba\xcc\xd9'hKc\x9f\x05\xdd\xeb\x865\xb1\xfa\xdb\x10\x13\x10M\\\xd5m\xf7`58\xcdH0\xc5N\x8c\x8f\xbe\xf3\xaa\xe7\x9ca\x7f2\xa0.\x85\x03'\xf9N\xfc\xe1)z]8#V\xcb\xdd9\u7ebc\x8e\x1d\u0546\v1D\f\x9e\xa9Q\xad\x03?Rst8\xf79y\u03a2~K;tb\xe4^\xf8C\xa5\x1c0^\xef\u0409\xe1\xae\v\xfb(w\xf7\x11\xab\xdd\x13@zb\u049d\xc9]8\xb7\u0469R\f\xf2\xa9\x95\xdaqe\x87\xfd\xc9zg\xd0l\u02bf\x068\x95\x04\xb8P\xcam\x12\x16\x86s\xfbL\xc9\xf2>\xa3\xed\x0e\x11\xd6C\xe6O\xd6;\x14M\x0fL|\xafQx\xe1\u00dcc\xcaV\xec\x7f5\xcd\x11Y\xdaZ\xd3\xfb\u059a\xaa\x90\x00\xe1d9Y__v'\x8f\x86\x9a\u0391s\x1fo2\xe3\xb50\x1c:@\x83\xf6\xafCw\xa5\x86FW&\x1e\xfb\xd7\xcd\xe6u\xc1\xa7\xf0ex\xe1_\xd3\x02\x86\x98\xee/-\x89\x12`\n\x9dHo\xe5\b=\xa5\xe1\b~1\xecfzR\b\u0657^\xe6u\xf7\xd6\xd2E\xeeV\x8e\xb4t%d\ue550W\xae\x84Y\xf1J(\x8f5g\xb5I1\x8eu\u0456\u0371w4|\u0613\xb9He\x94e\xfa\x05\x1d\xcf\x1c\xecn\xef\xb5\xc1\xb0\xda\x7f\x17\xbe\xa2_\xc0\x86\xad\x96\x17\x8f\xf8\xf9<:W\xae\x854\xc9_qi\xff\xa2n\x11\xc3y.\xd2\xe9\xaa[\xc4\\]]>D\xe7\x92A)0 \xce-K\xf4<\u077d\u02dd\a\x85+\x1d\xb2\x06i\xff\x01\xb4\xd8\u01fa?\x86N\xb5\xc1\x83\xc1\xea\xdc\a\x96R<\xf0\x06\x0f \u0407\xe2~\xcaR\a\xc7\xd6`\xb8X\fe\xfc\x9d\x12\x874\xb5\xae>F\xcd\xe6H\x86\xe1\xa9\xe5\xcf\\S\x83\xc5\xe2\x02\xa3\xf4\xd4\\u\n\x86\x06\x9d\xe0\x9a\x1e)\xbeN\xe6\x1f\x16/|W5\x8c\xe3\xa9m\xe1\n\x80\x7fEO4\x1b\x87,\u072b\nS\xf7\xc1N\xe2U\xb3\xf9\x8a\xbe-\xb0\x92\xefm{o\xa1\xbd\xb7\xf48dJ\x95\x91e\xf4M\xc8Z\xef3XX\xfa\u0316|\xd3\xf3f\x19\xf3\x827\xf4u\xc8Z\x87r\xe5\xe9S[\xe0uO^\x15\x83\xd7\xf4\xb9\xc2\r\xfa\xa7\xcd~\xde+;+\xeeq\x8c4\x11<\xa7\x1fC\u058ar\xf1\x82q\xa9\x98D\xbf\x85\x15\xad2\xa9z\xa7\x95\xc8T\x88\x06\xe9G\xd8o\xd3\\)\xdc M\xb9M\x0fH\xf3\"\xb7\x95\x01K%8\x1a%'\x17\xb9\x9c\x1c\x0f\xa3~6\xa0\xc2\x06\xd9'\x8f\xc3\xf6bQQcSd\xf4\xfaU~d\xb4\x86t\xb4\x0f\xf0\xd3\xec\xa7Z\t\xd00\x01)h\xaf\xf7\r^{\xd4\xd3;\x15}\xbf\\'\x98d7%|9\x1b\u0423^\t\u07e57\x19\x17\xbbUJ\x05\x97\xb1\x832\u6aaba\x1d\x9a\x16\xb2t\x8aAH\xfd[\xa3\xa3*\xadG\xa9\x11\u0363\x9eD3\x8fz\n\x9f\u0514<\xea\xb9\xe8\x00\xfeS>\x86\x1f\x17\x8bw\xb8\xe8\x9f\xc2i\xaf,\b\xb8\xa47E\xc9IpD\x8d`%Ht@{\x10X\xd81\a\xa7u\x17\xf13j\x054\xc1\x9fK\xe9\x11\x9e\xfe\x1a\xa6\xadI\xf2%_,\xd2\xfe\x83Y\xb6\x82H-\x167\xe0\x88\xef<K\xe7|t\x98&i\x16x\xff5\x1e\x8f\xbd%}\x19\x1e\xfa\x1fI\u03c3F\xbc\xc0\x9beq>\xfd\x92{\xf4\x87p\u04abHU\xbe\xad\x94\xb7\xfcJc\xb2$A]\rKr\xbf\u0663\xbbg\u064b\x97\x18\x02S\x7f9eH\xf0\x92\xae\xe8\x0e:\xc3h[\x1f\xc9\n\x11\xcc3\xfa\x03\xfdD\u02e9OiB\xff$\xa4k]W\x9f4\x9b\u01cb\xc5\a@\xfd\x93p\xad\r\xee\u040e\x17\x8b'\xb8\xa2?\x85u\x8c\u045f\xcb\x01\xfd\xb9\xd6M^\x81Vp\xf7|\xcb4\xe5Imp\t\xc0\xadO\x9as=\x84+\x94\xa2\x01uG\xfb\x86r\x16\x10\xe7\xe2\xb5j4\xf7\x89\xd9\xee\x82,\v6\xf5\xfa&\xc7{\xdaG\x1c\xd5cQ\x93Y\x06\x11\x04\xbc\xd4'8Z\xa2\x04\xa2x\xa4\xfb\x19Y\x82o3}\xfdj6e\xdd5\xa7a\xb7\r\x8c\xcd\x14\xe8\x1e\x97\xd6\x05\x9a\"\x8e&c\xe9\u07f8\xb0\n>\xda\xd1\tD\xf3\xe0OZ\x86R\xf0\u04d2t\x951\xcc\xcf\x06\xb2\u0366\xff\xb3\xe4\xff\u00df\xa4\xa0\xf4E\xf8\xd5\xff\x99\x9e\xd0\xf7tJ\xcf\xe9\x19U\u065ak;\xa3\xa7\xf4\x83\x11\x9f&\xae\b@}\xd0\x0f\xbd\x9b\xcbI,\xd8\xc9,\x1a2\xd8\x12l\x03\u81b7\f\xca\xe9\u0792\xd0:\u012bC\xbb\xb5\xf3f\xf3\x13=\xf6o\x80\xdf\v^\xb8\x1cZZ\xe1\xd0& w^B\xf4\x9a\x8c\x9d\u01f9`\x99^\xf5\xf0U%I\x19\xe2\xbf\r? \x00\xde#\xff\xb5\xb5m\xdcR\x1f\xa3\n\xf9{\xa2l\xcd\u0780j\xe7f{sW\xe7?\xc3\xfc7:\xff5h\xdanvv\xf6t\xfeS\xcc\x7f\xad\U000df0d6hg\xa7s\xa0\xf3\xff\xc4\xfc\xe7:\xff#X\xa8m\xef\xec\x9b\xfa\xdf0\xff\xa3\xce\xff\x04N\xf8\xdb{\xfb\xa6\xff_1\xff\x93\xce\x7f\t\ud0e6\xbb\xce\xff\x01\xf3_\x12\x9f\u041f\xc2\x1b T\x81\x95\xd2(\x89\\z\xc1\xb2q\x92^\xfe\x16x\xd1\\\xa4F\f\x1dx\xed\x16\x88\u07e8\xa5\x7f\x81\xf7_[[[\x1e\x1dJ:\x88K\nR6hx\x03\xb4\xb2\xbd\xe0f\x9cr\xf1+\x93\x82\xba\xb34\x19\x99\xfc\\d)?\xbf\xad\x84d60_Rs/\x16Q\x12\x0fM\x87\xff\xb5\xbf\xbfoJ\xb3\xe9l\x12\xe5q^[C\x17\x12\x11\xf4\xa8\xab\xefn\x0e\xf7\xc7[&S\xc7WsJD#\xb63v\xaa\xb3\xe9,\x89\x04\xbbGQmv\xbb\x11\x8f\xeeQ\b)\xf5-\u5926\xbd\x93\xbf9\x1eF\x9b&\xffl\x9e$L8\xf9\xa3\xad\u076d];3 \xbdN\xeex\x1c\u066cX$\xab\xf2r&\xa5\x86\xf5\xb9\x91\x10Y|6\x17\xabj\x7f\x9b\xa7+\xf3 z\x828\x8d\xf9m\xd91\xd78TWD\t\xaaWN9GNou\xfeWv\r\xf1\xb0\xdd\u0587\xc3\xcd\xfd\x9a\xb5)\xe2L\xa9T\x82\xaa\xf6\xc9\xea\x02Z\n\x1f\xdc\xd4!-\x06\xb5)\x82\x18wT\r\x8f1<8;83\x153v\u03aefN\xb5\xe1\xee\xd9\xf6\xb8\ud30b\x7f]\x9d;e\"*\f\xb9\xd06\xecw5\xa8\xea8\x14\xe6Qw\xb8\xcbe\xf7m\x85\x96\xfa\u0797<\xe5\x1e}Fh}\xa6G\x8f\ubcee\xa6\x89G\x9f\xd6\xe7]G\x90\xf9\xad>SF\xad\xfb\xb5>\xf3,\xca'\x1e\xfd\xb3>s\x06\xc1/\xf3\tK\x12\x8f\xfe\xb0b\xc0\xd1E$\xad\x05`\xe0H_\x7f\x0eo\xa2\xf3H\xb0\xe0'\x1ae\"\n\xfe\x11\x8a\xba\xb9\xe9@7\x8a\x9c\xdd8?\xcb]<\x8a\xa2\xdbw\xea\u0623\xb7\x90\xd62Vnoo\xaf\u0739n^\tu\u072c\n\x95\x1a\x8f\x87\u00ed[\xb6d)\xbfB\xc5J\xf9\x15\x9c/\xe5WHB\xbb=\x1c\xee\xee\xd6\xe1\xf5\x8a\x125dik3\x8a\x18\xbb\x8b2\x95JU\xa9B\xa9@\x89 \x97so9fJE\xeb\x88pe0\xfc\xeb\xea\xdc*\x1d\xdc\xdd\xdd\u078e\xa2\xbb\xe8`\xa9T\t\x1e\xab\u0688\xef\xd3Q\xf90,\x95+\x9fXgg\x9d\x8e\xb3\x8650+\x95\xa8!\xba\xa5\x12f\x01\x8as.\xb7\x93\x0ee\xfe\xdf\xe0qn\xe7Z\x96t\x9a\xf2\xf4k\x14\xffC\xa4eos\u07e5.\xa3\xd1h\x05g4>\xd8\xdc\xdd\u06fc\xed\xb0\x94\x05VS\x98U\xc7\xe7]\xf5j\x0e\xd4;\xbb\xd2P^1\xfa2\x17Q\xccU\\\xa6E\xb6\xd1\x01\x1b\xdb\\\xc0\xc5F\xab\x0e\xf1\x8a\xecn\xddF<\x1b\xef\x1d\x8c\xceV\u04ffR~\x85\xbe\x95\xf2K\x1b\xb9\xdcz\x85G\xdce\x9b\x9bl5u-\xe5W\x8e\x97bvy\u05e9\xec\xdb\x10\xa0|$\xddY\xc5\xd9\f\xabFQ\xa4\x9bw\xb6XC\xd0+`\xa9%\xe8e\xe0hl\x86e\xbeG\xb1Y\xce\xe6\xa3tu\xc1\x9a\xa3\xa8T\xa2\xee\x86Q\x82E=\x91ZU\xea\xee\x06\xabL\xc1\xde\xce^g\x87\xad\xe4\vJ\xd95t\xb5T\xa2\xc4<\x94\xeb\xdfMO\v\xe7H\xb5\u0612\xf24\x1b\xfdC\xc4\xf2hk{\xbbm\x89\xe5\xb3\xfdgGG\a+\xb7K9\xbb\x9e\xfc\xedw\x9et\x0e;w\x9c\x8b\xfb\u03df\x1e>}z\x1f\xdaZ>)U\u037b\xb0vU\xb1\n\xd6\xee\xef\x1f\xb6\x9f\xb5\xefu1\xc8\xce\xcf\"\xbf\xb3\xbbE\x1b\x9d\x836mt\xb6\u06f4\xd1n\xed\x90:\xecXQ\xf9\xa0C\x1b\a{\xb4\xd1i\xef\x96\xea\xd6l\xe3\xd2\x1cJ\x84\xa1\x94{\a\x9c\x8cz\xcc\u0299\xeb\x12\x8d\xc7\xf5gA\xb9|\xf5\xc0,\xad|\xf5\x98+\xa3F\xf9\xb0(\xe5W\x98\u0767\xdb\xfbGO\x9e\xad>L\x8e\x9e\x1e>\xdd\x7f\xba\xfa\xb8x\xb2\xf5\xf4h\xffp%\xbd/\x81\f\xc3\v\xe6\xab\xf1\xbfr\u0694\x86_:uK\xad\xdfK\x963N\xb3\xe9<\x89n\xc1\xeb;\xb9/8Q\x83\t\xd0\x05/\xb8\x01\x81\xec36LU\xec\\\xf4\xad\x90%1_M\xff\xb6\x0fwvw\x9f\xdcv\xa9*\x160\x04n\u0140K\xe4q\xe7h\xbf\xf3\u4c10[\x83Xu\xa5\xeeZ\xdc\xdb\xe9A\x1d+SY\xde\xdaS\xb3\x8c\xa3\xb7\xe6V\x19\x84\xd2&\xba\x95t\xd6\x1cg\xa51\xdev\xf2\xad*Z\xec\u04826:\xe3\xe3Fk%t\xec\xc0\x9d\x82\x95\x1dlw`4\x8b\x86\x13v\xbf\x06\u07625 3P\x89\xb2\xd1<\xe6i\xa3e\x17\xa8D1m\x9b\xf9\x8c\r\u0157F\xcb`\x8c\v\x9a\xf6\xfe\xde^\xa1\xd8\xdd4\xef\x0e\xe8\x18\xf4\x1a&\xe9\x97y\xa6gSF\x0f\xd3\\\xb1\xd8-p\x1c\xa6\xdf\xee3\xdf\xe1l\xd6h\u0772;\x9c\x01\xe6\xf9\xbd\x1a4\u016a\xfb\xd1\xc2o\x14\x8f\xc7\xf50\xb6\x1d\xb2\xfb\x82\xee<\u0253\xfb\f\xed<K\u04cbk\xa7\u06c0\xa7\xc2\x0f\xc6q\x96\x8b\r|n&\xb5\x83\x9dDW\xec.\x84\x00\x11\xd8]e\x92Q|\xbf\t%q>\xbb\v\x11\x92yt\x9f9O\u04d4K\xd1\xdc}J\xf3\xf3\x98_\xddk\xef\xb9%\xab[\xcfR\x87\xd9\xf8>\xfd\u03b2t\xc8\xf2\x1c|\x95\u07a3t~\x1b\x8e\xd9]\x0fo_\xf3{\x15\xbc\x8c\xc7\xe2\xae\u017b\x88\xa7w\x8e\x8d\u059e\xc8 \x97\xbd\xbd\xf5%M\xcf\xf2x\x14G\xfc\x1f\xe2\xcf\xf77\xcf\xe0\xee\xa7\xfbam\xb6\u0276o\xe1\xc0\x0e\xb6\x86{\xbb[\xdf/\xb2\xb8\xb3^\x95\x97\xbb\x7fWq\xcd\bW\xb2x\xe3\xf1p\xb4\xb9y\xeb9\xbd\xbb\xbb\x7fv\xd6^\xc1f\x15%\x16\xf7\x16mTP_f\xaf\x9e]\x85\xf7\x1cm\x1d\xecm\xef\xac\x12d\x94r\xcb\\\xd0\xce^g\x7fs\x15S0<;\x8bF+\x19\x0e\x99{\x9bx\xa0\u0325\x16\xdb+_\xf2J\xd95\xf2\x8aR\x89\xf2\xb5\xe4\xee\x01U\xb7]iH\xab\xeet+\x8aU\xeft\u01425\x92\x88R\x89\x1a\xf6\xa9<\xcbz\xf6iU\xa9\xbb\x1b\xac\x9c\xd0l\xb8\xb7\xdbn\xaf\xbe\x1c\x95\xf2k\x1e\xdd:\xfb\xec`w%'_\u02ae\x91d\x94J\xac\xbc\x82?i\xefo>}\xf6\x1d2\r\xbd\xf5\xfe\x8a\x10yI=\x91N\xd3,K/78dx\xc1\xcd*\b\x1c\xec\x1e\xec\xdf\x02\x81Rv\xcd\x1a\xc1KI\x9d\x8c\xfc\x1eE\xa3\xf3\u0559\xa5=[\u02ad'\x94\xab\n\x95\x17\xa3T\xae\xfahZ\u032fY\xf6\xf2`+\xaf\xce\xec`kg|\xdb\xfe\xad+Q\xbd9\x95JU\x8f\x94R\x81\x12a)\xe5Vn\xe7\xa5\xfc\x12\x89\xadt\u03bf\xae\u03ad}\xf6o\x0fw\xf7ny\b<\xdb9\x1b\xed\xee\xdf\"\b/\xe6W\xc8r)\xbf\x86f\x95JT\xae*\x9dh\xf3\x8c\xddv\xf5,\x16\xa8r\x0fg\x9b\a\xdbggw\xf1\n\xa6\xd4=9\x9c\x02G\xd3\x19u\u019b\x1d\xcb\xd1\fw\x86\xfb\xc3\xdd\n\x1b\xf4]B\x92\u06c8\u01d2\xbe\bS\x9f\xf8?\x13\xfaG]p\xec\xd8'\xfe\v\"uZ_PFz?\xf7\xd9 \xf0\x87)\xcf\u04c4)\xbf\x9e\xc7\xec\u06dc\u5881JX\x8d\a\xd6\x18\x91z\x0f\x1aq\x8e\x1e?\xa3\x8b(N\xa4\xffB\xd94\xf0\xc0J+\r\xbd\x1e\xb2h\xe4\x11B\x7f\x02g\xaa\a\xed\xcd\xed\xcd;<\x80N\xffD\u01dfg\x8c\xa6/\xf0\xd7%\xa3O\x9e\xe3\xaf#F_\xff\x82\xbf\xae\x18\xfd\x99\xe3\xafSF\x7fM\xf0\xd7\t\xa3_\x7f\xc2__\x19}\xf9\x1e\x7f=a\xf4\xc95\xfe:d\xf4\x17\xe9R\xf4\x1d\xa3\xa7;\xf8\xeb\v\xa3\xb1l\xef\x15\xa3\xe73\xfc\xf5\x81\xd1g\xc7\xf8\xeb-\xa3\x7f\u0216\xdf3\xfa\xea+\xfez\xc3\xe8U\x8c\xbf>2\xfaQ\x8e\xef\x1b\xa3\x99\xf4Z\xfa\x89\xd1\xcb\t\xfe\xfa\x95\xd1\x17\x7f\u0bd7\x8c\x9e1\xfc\xf5\x03\xa3o\xa7\xf8\xeb'F'\xbf\u2bdf\x19\xfdE\xb6\xf2\x82\xd1T\x8e\xfe\x0fF\x7f\xda\xc5_\xbf3\xca\x7f\xc3_\xbf0:K\xf1\xd7o\x8c\xfe\xf6\t\x7f1A\xdfKX\tA\x9f\xfd\x80\xbf\xb8\xa0\x9f\x0e\xf0W&\xe8P\u6982~\xfc\x82\xbf\"A\x7f;\xc3_\xb1\xa0\xef$4\xe6\xc2\xf1\xb0\xbas\xd0\xde\xda-xX\xf5\xb9\xdf\xd9\xdf\xdb\xdb#\x94\xfb\x9b[{\xbb;\xa4\xe4lu\x7fw\xbb\xb3O\xe8\x1c\xd3T\xd8\xfc\xed\xceN\xa7S\n\x9b\xbfyp\xd0)E\xcd\xdf\xdb\xect\xcaQ\xf3\xf7\xf6\xf6vwKQ\xf3\xf7\xf6\x0f:\u06e5\xa8\xf9\x9b\xedNg\x17\xa3\u6df8\x7f&c\xe6+\xff\xb0W\x98v\x04>\xf7}\u0431\ubd37`\xf4[\x9b\xe0\x15\x96\xd0\x13\xcc>\x85(x\x10\xb8\x1f\x9ce\xd2'\x98\xf6\x15\xe2\xe0q\x7f{o\v\x9ay\x87i\x87\x84B\xb4\xe0\xad\xdd\xed\x03\f\x16\xdc\xe2\xfe\x17\x19*xo\xaf\xb3]\x8c\x15\xec\x1bw\xb4\x00\u026d\xed6\x01=Y\xa9UHQ\x9f\xb0\xb3\xbb\xbdE\xa8\xd6$\xa4\xa0Cx\xb0\xbd\r\u1175\xf6 }\x8e\xb0\xda\xdf\xdc'Tk\fR\xd0\x15\xdc\xdc\u0647\xbaZK\x90\x82~\xe0\xf6\xd6\xd6\xc1\x16\xa1Z3\x90\x82N`g\xef`w\x8f\xd0\x1f@=p\xff`\xf3\x00\xb4\x01!\xfb\aB\x7f\x86\xec\xce^\xbbM\xe8\vLC\xe2\xc0\xfd\xfd\xfd\xad\xf6.\xa1\xbfc\xda\x1f\x84\xfe\x02\u5db6`\t\x7f\u00f4_\be\xe8Q\xff`\xaf\xbdM\xa8\x90\xbe\xf3\x19#\x943\x84\xc4~g\x8b\xd0L&sFh\n\u025b[;\xbbmB#\x99\x9c2\xe9\x8a\xdf\xdf\xd9k\ufd15/\xfe\u037d\x9d\xf6\xb6t\xc6\xef\xefo\xee\x1e\xa03~(=g\x84\x0e!\xb5s\u0406\xe4\x19\xfc>\xd8\xdc\xdc\xdf$t,\x8b\xcc\x18\xa1\x13\xacx\xd0\xde\xdb$t$\x93'\x8c\xd0)\xd6\u0704\xd4\v\xf8\xb9\xbd\xbf\xb7\xbbMZO\xe7\xe31\xcb\xe89\v=E\xa0<z\xcd\xea\xa8\xe3\xaf>i\xbdR~Y[q\xae\x7fb\xf4/]\xbcq\u019c\x1a\xa7\xf0\u047b\xc6\x7fYK\xa4?\x9d\xf8$`\xc1\x8dc\xdfzi\x83\x8eP\x8e\xd6S\xcc\xf1\x03\x8c\xfa\xcd\xd6O,\x98\xf1\xb2\xd6\x1f\xad\xe7q\xc2\nE\xd6N+\xb5\x86>q\x92\xc6>\xf1y\b38a\xdf ]\x12yN/\x19i\x89\xf4u\x9c\vi\xeb\x151\x9f\xf8#YW\x8e++E.\x80\xee\n\xa5\xca\xfeJe\xd4-\xef\xf4\xc7\x1f\xfb\x03\x8fB\x18\x11\x1a\x85sSA\xf6\u0308\x8c\xfd<N3?j\xe5>\xe9\xae\xf9\"\x8cZ\xdc'\xa45J9\xebj\xcbB\xc7S\b\xef\xc7\xfd\xf6`\x00\n\xfb\xf8\xa3\xd9T?\xb4\x8f\x85\xfc\xcd<\x11\xf1,Q \xcd!\x92\x12\xc5@\x8ak\xaa$Q\x7f\u00dbr\x95\x00B\xfe\xa0\xa2s\xd0YR\u07bf\xf0\x89?D?\xee\xb3\xd0j\xdcCe\r\xbd\x19\xcd\xf4\xcf!\xd5#\x91M\x90A\xa8\x06K\x91\xc7d\r\xf5\xd9-\x96\x03G%\xb2\xab\x1c\xbbJVt\x95\u062e\xf2jWq\xbf3\x90&\x8e\xaa\x17\x99b\x95\u0223\x16\xa2\xe98\xe6Q\x92\\\xdfD\xad\xb1O\x96Nx2\xeb\x13\xc3'~\x86x\xf2.\x1b\xb1\x8c\x8d\xdeD3?\xd5]g\x80.\xae#zQ.j0K`Q\xeb\xfc\xc0\xdd\x16\x125{,\xe83\xc7)\xcf\x15\xbb=\u0218c\xae\xef\x94\\[+\u0108\xc3@\x10n\xc7'\xf7n\xf5ku\x88h\x97\xf7\x84\x85\xbf\xfb\x8e\xeb\xe4C\u6e21\xd1p;\xc1\xfdu\xe5\x13\v\x01NK\xb1*4\xbc\xc1c\xaf\xf0\xc1r\x1c\x1d\xa1/\t8\xf8\xb7\xe3x\xf7W\xdbW\xae5t\xcb]\x1bQ\xb8\x04\x9f\x944\x9bO\xa0A\x9a\xd6\xf4\xff\x85\xad\ba\xe1\x04\u07b5!\u073b\xabC\xf5\xba1.V.\x00\xefq\x9f\xf9\x84\x04\xc2\xe7\xe0\x0f\u078e\xe3\x95K\x19\xa5o!\xa0]f^\xdclz\xff\x9c\x91\xde9\v\xde!F^\xc2\xccj`d\xd1`\x13\x00\xe1\xb3u\xcf#\xfd\xf6`I\f\u0392\x16J\xcd}\a\x1a\x1f\x981W[[}\x00\x10\xe7\x88P\xb4T\x05\xfc\x00@\xbd\xe2H\x8d\x05\xe9\x89\x00\\5uK\xa5[q\x8e\x95\xc0~.\xb0M\xd8A\xbc-\x80\xa2\xffp\x1c'\f\xae\x93\x9f\x7f\f\xfb\xffz0X\x7f\xf0\xf9\xf2\xc7\a\x9e\xdf\xff\x977X'^\xb7\xf70\xa6\xf5e\xfc\xfe\xbf\xba\x83uR,\x11z\x98\xfc#\xe9\xf6\xbcB\xbaI~\x18\xa3\x93\x83\xb7+ \xcb\xcb\xc1>}\x11\xf2\x16\xbbbC\x00\rX\x17\xabt\xd1l\n\u3e018\xe61#\x06r\u02cf\u01efLx\b\xe9\x03\xccR0}\a\xc1\x00\u01be\xa5B\u0432\x05\xd4{\x17w\xd1@\xd8\xf8ni\xf5\xff\xd5z8\xf8Qzp\xa1/|\xe2\xff\x84k\"W\xca\t\x03v\u030c\x7f\x84H\xae\xbb\x0e\x01\xdb\x1f\xa8P\t\xfd\x01\xba\xb5:g\xc2\xf7`\x00(\f!4\xd1i\x19\xfb6\x8f36\xf2\x80\xcbUi\xd3\xe8*\x9e\u03a7\x1ep\xb9:)\xe6*i\xa4\x93\xf0\x96\x0fL\xaf\xfa\x86'\xefHx\xc0\xf2\xdav^#\x04=\xe0ymK&\xf1H'\xcey\xfcm\xce^\t6\x95\xa1\xa8l\x03:\xed\u0529\xaf\xd3Nt\xda,\x12\x82e\xdcC\x9ex\xb1X\xc3\xc0\xfb\xc0\x14\xe3Z\xe2y<\x97A\ad\f\u0775\xd1b\xb1\xe6\x7f],\x9e4\x9b\x1ez\xac\x87m\x86\x89k_\x9b\u0375'\x84\x14\xe1x\x18\u0680\x02`\x98\xcb\xe8\xbb\u0429\xd8lJ\"\f\xe1\x00$\xce\xd0/\xc5\xfc\xf2\xfe\x91e\x87\xe9\x9c\v\x1f\xf8\xf2\xfe!\x05\xa3\xeaB\x9dj\f\x03\xe8\xd8\x03\x9c\xd7\u00e8e\xb7\xa8w&\x03d\xa9R>\xcc\x18\u0776\x13\xea)!\x91\xcdi\xab\x8c\x98\vv^\x9bc)\xf2\xa8\xe6\xfc\xd2\xc1\x1cBV.Y7\xfc\x01\xfd\x10\xc2\xde|\xa5\xf6\xe6\xab:\xaa\xb7\xb6\xc6\xc0\xcdH<\xf6a1>4\x9bk\x99\xa6Z\xb94\xc5\xf7\x8e\x15\xda6\xc61KF\xfa.?\x93\xc1\xab\x01\x99s7\x92\xb2\x9a\x93B\x80h\xb1\xf0\xc0\\?\x1e\xa22\xc5C4\x05\x80t\xc5E\xbe\a\x83\xe4n}\x10\t \x04\xc7\xe1O'\xef\u07b6fQ\x96#\xabR\xb2\x7f3c|\x0f\x82.&X\xa6\"\x9d\xa8\x18\x82\x18\x13\xe1\x8c5d\xd8\x05h\n\x86\xbb\x8c\xc7>\u049cI\x94\xbb\xbb\xb2\xd9<a~\xa2\u04064\x9b\xfa\xa7O\x9a\xcdk\xe0\xc2\f\xd7U\x00\xa41|<\xee\xb3A\xb3\xa9\x86t\x03v\xc1?\xb3\xeb\x80Q$N\x81\x85\xa3\xb6\x18F8\x8eA\xe8\u3843\x00j\ae}3x\x84@\xdf\xef\xcd\xfess\xe4x\xdeS\xbf\xce\x15\xde1\xf3\x8f\xfbb@\x19]\xeb \xd5\xea\u02a1)\xff\t9M}\xe2\x8f\xef:\x16\xcd<\x84\x9a\a\x86\xe5\x95\u03b0\xe2\xb1\x7f\"{{S5\xe3^\x03O6\xc7\xec\xfc\xe8j\x06G(\xc6\xcd0G\xa2\xf7\u026e\xcf8M\x92\xf4\xb2\xa1\b\v\xc4{\x04\xf3\xe8\x13\xd2}c\x80\xf9\x06\xfb:-P\x10\xd9\xf1\xb3:/\x80\xc8\u0673fS<\x0e;\x8b\x05\xb3\xa4\u2466\u064d\v\xe4\x9c<\xb4P\x97\xc3PlC#\x12\x8d\x84E\xb9h\xb8~I\x1a\xb1@\x8fS\x1aP\x1d\x8cB\xeey\x81\x97C@\rFOI\xf7\x99\x19\xee3\x1c\xeeU\xcdp_\xaf\x1a\xae3\xc8\u01f7\f\x92\xa7v\xa0\xd34c\r1a\xfc\xfbFzE\xba\xaf-\x96r\x06\xb1\u06e7\xe9\x05^t\xe4\n\xbf^\xe2\xf8\x8fj\xc6\xff\xb4\xba\xce`0\xea\x89l\x8e\x84R\xa8\x13A\x18w1~\x9b~\xc2\xc0+?\x9d\x10\x19\x18\x9d\x83K/\xa6.\x96f\xd2Y+\x8f\xffd\x9a]\xc5Z'L\x10Y\xeaz\x15\x96\xc2\x18Vqv\x96\xe5<a\xbeh1\b\x18\x98\x93\x9e\xfe\xe53\x12\x88P\xfa\xd7\u013e\xc1i\x94\x9f\x86i+\x1a\x8d\x94\xfb%\n~\x15R927\u0416\xea-\xad\xdd0hAl\xb7\xfd\u06f41\x9aK\n\xc8\xf2F\x04\xb8\xceF-\x0fvQK\xa4\xb8\xb6>\xc1\x10\xc4\xf4\x88t\x9f6\x9b5\xbb\xf4\xa9\xdcl\xe7\xf2\xa08\x97Pz\xbe\x12\x97V!\u04a7\x02Q\xe4i#I\xf99\xcb\x1ab\x12\x95\x90h8\x89\xb2h(X\xe6b\xd2\x1ab\x12\u0634KL:'\xdd\xe7\x06\x93\x9e\xe3\b\xcf\xe40\xfe\xbckh\x8fn\x1fZ\xfd\x1e\xbc\u05e0\xceH\xf7O3\xa8?qP3\t\xb6\x99\x1c\xc7\xc7\x1a\x146\x90*\x8d#ay^\x86\x0e\xf62#\u074f\xa6\x97\x8f\xd8\xcbD\xf6\xa2\x9c\xdb\xd4\xf8\xb5`\x8fV\xf4r\x8e\x96\xcdYmG\x13\xd2\xfdf:\xfaF\x96\x85\xa3R\xef\u0297H\xf0\xfc\x97\xa17\xc2\xd7\xd7x\x8a\x9bq\xda+\th\xe2\xfcm\xf4\xd6\x7f\x16\tfNTR?\xa4\xa8\x01\xa5>@C\x18\x1b\u06db\xcf\xe3Qm\x9b,d\xae\x8b>\x91\xbe\x06\x13\xba\xc3(g>\xa1k\x0f\xff\u057f\xf1\a\xbd~{\xe3 \xda\x18\x0fn\xf6\x97\x1b\xe6\xf7\xf6=~w6\x97}\xb2\x1c\xf4~xx\xcb\x01\x82\xe3}\x01\x03\u0131\x96\ah\u0663\xb5r\x84\xaaJ+\xaa\xe0\u0495`\xe5\xea\xdc\xf4_Z\x87Q\x05\xb6O.\xc2\x0feq\x98$\x8a\xc0\xad5\x9b\xde8Jr\xfd\xb1\xd6V\x7f\x01yW\x0eE\xf7\xb0T\xe1\x94\xd7~\xa8\f\xe8\ag@\x0e\xb3)\xc7\xf3SE<\xf7\xf0_\x1b\xbd\u03e3u\xffs\v\xfe\x90{\x00U5j\x86\xf0Se\b?9Cp\xd9Z9\x86\x9fW\x8d\xe1\u03aeyC\xb7f:\xff\xb9\xd2\xf9\xcfN\xe7\xeeI\x05\xa5\xdf5\x9bk_l\r&Y8#g,\x1c\"\\\v\x15\xe5%O2Z\xb1\xba\xf9\xdc\xc1<\u016a\xc5\xf8\x96\xb3\x80WY'3ns\u0150cx\x11V\xd1wm\x95\xacw\u0572a\x9b\x06n/*p{a\xee\xc99\u02b2\u07b0Z_\xca\x7f9@!\x1e\xf2q\xfe\xee\xc9\u0256q8\xb5\x16\x823\xb8\f\xa3\xfa\x9f]\u03e2<\xd7\xfc\xf0\xe1\x84\r\xbf\x1a\xbfS\xd0t\xb3)}\u05d6o\xcds\xe0\v\x86Lz\x00\xba\x91\x1d\x04\xe9\x12\xae\xd8\xf3V>\x9c\xb0iD\x87\xe1\xbc5\u04f7\x01\xf0e\u00f8x\xc3Fq\x04q\u0634\x88\xe5\x98\xf9\x82&4\xa71\x1d\x92%}V\x8d\u06e8\x18\x9b5\u05ba\x9a&\x8b\x85\xfc+#\xcc\xc9L\xf8\x0eU\xee\u0352\xae\xb1\xd6\x0f?dlL\x8csm 5\xe0\x88\x19\xf1\b~X\xce\x1d\xbe\xf4\xdbu\x94\xbc7\xe9\xbd\a\x8fzW\u04e4q\xc1\xb2<Ny\xe8uZm\xaf\xc1\xf80\x85w\xdf\xd0\xfb\xf8\xe1\xf9\u01be\xd7{\xfc\x99\x7f\xbe\xda\x1a\xaeml4~{\xf3\xba\xc1\xae\xa2\xe9,a\x8da\xc4y*\x0f\x14\xe9\x06\x84\x8d\xba\x8d,MEC\xf9\xd9l\xc0\xf0\xe1\xfef\xa2\x05666>_m\xb1\a\xe8\xf9F\xbd\uca49(\xbf\x7f\x0f?\x9f\xfc\xf8\xf9\xa1\xff\xf9d\x9d\xfc\xf0\x90t-\x18\xc2\fd\xc9&\xf8q\xccZS6M\xe3?\xd9\xe8\x10\x8f\xb3\xdf\u07bc>\x92\x03S\xf1\xd1\u0212\xbefa\xff\x06\xe2\xb5\ax\x11|\xa8\xc2N\xcb\xc3#\x1e_\xc3\x12\xe5A_\x93\xeb\xc1r@\x9f\xb2\xb0\xaf\xaf\x96\x03\xfa\xbc.\x96\xa7t\xf1R\x1c\xc3\t\xf6\xfc<K\xa7'\x88\x16r\f\x19<C\xc6>\xf11\x0e>\bO_k\x82\xf0\x9a\xd5]\xa22\xeb\"\x0e\x86m\x82\xff]\xa0<\xbc?P\x953\xa4\x06\x8c\xe0\x1f\u046a\x9b\x15!\x01[\x12\xfa\xd4\xca\xd4\x05\xf3\x89?\xaf\xa3\x1a\x18\x81>_\x12\xd2;\x86\xb1\xa2\x98\x8cn\x92 Z\xd2?W\x01 \xa5Q\xf8\u0708\xa8\xe4#\nxS\x94q\x8e\xa7\xac\xf5\xc7\xfb\xd6h>\x9d\xf9\xf2g\x92F#\x80\x02z3\x96\x8ew7:KB\xfa\xa9q\xe87@n\xf8U\x81\xddmS\x9bO\xaa\xb7\xf1\xb24\x8ez\xf8+h\fM\xd0O\x8d\x9a\rT\x14U\xa8\xeb-\x1d\xc7\xf6Z2'\x1e\x9eS\xaf\x01\xce\xe9\xe9GV\xe3\x1c\xe8/\xfa|\xf5<\xfa\xf7([\xa5\xeeVm\xdd-\xb7\xee\x96\xf6\xfa\u07f5^\xff\xe0\t\x02\x1f\xffH\xb3\xa9\xb8(x\b$\xe0\x11\xf1\x84\xf9\x99\xc9\xcb\xc2\xcc\xe4=\xbc\x9a&\xea\xe8\x14\xa4\xf7\x8ci7\x9f\x0f}\x80\xe7\xe2z\x9a\x10\x9b\xfd\xa7\xcc\x06\xbc\x0f\x9e\xdb\xdfK\xfa\x8dU\u0740B\x14\xef\x10\x8f\x96$\x95\u009aV\u03a2l8\u9eb2N\x15|\x168)\xc7\x1f(hGf~\xc7x\xc0nz6:j\u0588y\x83\x93\x8a\u06f4I\x94;\xfe\xd84;\x9f\xc9\t\xc3c\x88n,\xf4\be\xfd\x1aQp\x86\xb1\x1c\x90\x0e5\x9b\xb5\xf9\x9d\x01Y,\xe0^`\x9c\xaf\xd2Ouo\xb8\xc5\xf3\xf5\x82\xf5Xp\xc1T\xb4P\x87\xb9\xa5\xde\\\x8c7\xf6=Bl\"\xb8\x93`\xbb\u06c0\xa5\xbf\xb2\xf0\x06f\x83\xc0\xcbO\xd2L\xb0,\xb8\x89\x92\xd9$\nj}K2#Q\x9dx\x04\xc1\x9e0\x18~\x941_\xb8ydIe\xf4\xce[\u06d1EV\xb5\xa4sA\xb5FD\xe7\xf7\x1b_\xa9%\x8c\xcc\xfb\xb2n3\xf6\af\xc1\x81sk0\x13\xe5\x1e\x82\u07fa\x1c\x80\xe7\u027fB\xb2\"}N\xbd\u0423\x8cW\x97\x8fXb\xf0\u07dbm\xa0\x06\xeb\x1e\x19\xa8\xa0\x15\x9eu\xa9+TR\x13\x16\xe1\x87U!\x98\xd7\xd6~\u00f7\xad\xba\xf7\x89\x8c\xf9\u0107\x91R\x81n\xfa\x88\xfbt\xff\x93\xfbTX\xb9H\x00~\x81\x10\x01$,~\x9b\xbel\xbd->4\xfe\xec>J\xfakl\xb1xV`C=\x04\xf1$\u0345'\xfd\v\x96\xb2;\x9b{\xadv\xab\xdd\xea\xa8l\xe9\xc1\x1czt:y\xc14{]|w\x05\x1e\xac\xf0\b\ubf0eH\x19YA\xb4a\x98\x00\x11>]\xc9+[\xf4\xf8\x13\x1fJ\xf4\xbb\xae\xb7\t\x02T|\x8a\x94\b7\x94\xbc\x97G\x80?\"\xc651\xbe\xfbh\xdeN\xabV\x90\u01628r\x9f\xd0,\xf4y\xa5\xa5r)b\xde\xff\x14\x89T\xfd\xf4\xb8di\x1c\xe7\xc8\xc8\xe9\xfeQ\xb7\xfd\xab\xb2\xe7\u0162@\x11\xe4^\xef}s\x81\xe2`\xe7\xe7\x1c\x90\xf3\xbf7\xdb\x1e\t<oI\x7f\xaf\ub911\x00\x92\xfd\xc1|V\xc5\xebS\xdc\xe2\xbf\xd4V\xbb\xbc{%\x1e\xfe\xebj\xc3P}@^\xfa\xdb_oJ\x89a\x17\xe6Aia^\x91\x16\xea\xb1j\xa1^\xa8\n}\xda\r\u00c4s\x89\xa0\x7f\u0675\xb5sB\xa9\xed\xd3^\xba\x0f\rk\xfaUd\xb1\x18\xaa\xbf\u03ab\x93(\ua7e4!\xbclCX\x1c\xc3\x7f]\x9b\xb7\xf3\x94\xd4\v\xc0\x81\r\x13\xcdf\xe6\xa7}6\x00\xbdG\xa5\xb3\x01\x9f\x01\xfc\x132!\xf3\xf0X%\x10\xcb\xde@A\x88;\xc3c3#\xf8\x05\xdc-q\x01\xb7<\xfe\xb8O\xa4\xc0!2\xcd!*\x86\xcc\x11|b\u05e5wQE\xad\u0713\xcd\x0e\x9b\vg_\x18\x99\x82\x8d\x91\xe0T\n\x1c-\x89L\xfc3\xb1\x93DKv\xfd$Ihfo\x84\xbc\xd9\xe44\rE\v\u0167/\xa3|\xc2r\x1aY\x97\xb3\xe9b\x91v5\xfd\x93\x84OQ<\x1b\x87[\xc5\xe0\u01bb\xe1\x87\xf4\u0548q\x11\x8fc\x96\x05\x8d\x8c\rY|\x81q\xf2!\xac\xf6\xab)4\xd10\x97\xc8F\x947b>\x9b\vO>\n\xa3k|\x9ah\x1a\x86\n\u0704\x0e\xf5w\xcc=\xe9$\xbf\x8bK;\x89\xf2\tx\\lB\x80\x84\xa4\u064c\xc0\xdf5\x1e}p\x89\x88Q\xbd'w\xd4{\x86\x18\xaf\xca(\xf5$\xd4\xc3&6LZLm\xa3>!\xa4+\xdbu\x1a\x9d\xafjnN\x13b\xf0_UH\b\xcdz\xb3`\xd6o\x0f\xe0,\xb3K\x9a\x96\xb61,1\xbd1\xcb\x13\xac\xb5\x97\xa4\xebP\x16\x1e\xa2\x96\x90\xb9\x12\xd5]lD\x9f9\x8a\x15\xb5\xefM\r\xb3\xe8 \x98\aE\f3\xa2H\x18Z\xd0\u0205?f>\xf1\xb76\xeb\x981\a\xa1c\a\xa1\xa1\x16\x00\xcd\xf7\xf2I\xb4\xb9\xb3\xeb\x91\xd6|\x06\"S \x8b\xa3\xf8\x1c\xa8Y]\x1b\xb9(\x84\u0372\x84\x7f\xfd\xe1\xb9\f\xf7d\x93\x1e*\x82n\x93BH\xf1\xa4\xd6\xd0\\\xd4\x10f\xe0\n\xd6\xfc5T\xf1CQC\x9c\x1fMg\xe2\xdaGfms\xa7\xb3\xbfJ\r\xda\xee\xbf:\xe5\x1cFn,\xa5X[\xbb\xfd%\x155&@f\u0513\xfe\xda\xf0\xfc\\*=\xebwRMxI\x96\x14\xd50\xefP\xcb\xfeC\x17W\xee&\xb3\x9a\ub1bee\xc0\xbe\x9f\u0139H\xb3k\xf8\x99\xce\x18wI\xff\x92\x0e\x934g\xc5$\x10~\x15R\xe4\xc1`\xc4\x1a\x96b]\xc6|\x94^Z\x82\v\xf0`\xa1L\xed\xda\xf8!m\u041f\xf1\xa0Y\x8fzO\x93\xf4\u0323\xde\xf34\x9b>\x8bD\xe4\r\xba\xe2\x11\xafD\xbf\x87K\x8a\x18t\xf1z#\xdb\x03\x02\x0eJ\\\xf2\xab\x9f\r\xc8\xf2nE\x15\x06\xd1\xf8)j\xae\xde\v\xa8CG\x03[\xe95;\x1a\xd8F\xa9\xb8\xa0v\xadT\x7f\x1d\xb5\xeb9j|\x8aV:V\xba%T\xab\x94P%\xf7\xa4V\xed\xd5\xe8\xa8P\x8f]\r\x93y\x1e_\xb07&Ik\xab\xb8\x99&\xc9j\xa5PG\x19\x85\x1a%\x12j\xd5N\xa8\xd56\xa1\x05\x1d\x15\xea1.\x1bS\x1a\x99\xef\u019e\xa3w7\xfc\xa7\x0e\x1e)N\xc4Sd^>E\xd4\xe5W\x8a\x18\x03\x95\xed\x13\xbaR\xd0(w\x0f6\xc6Um\xef,\x1d\xa1\x80\xda9'z\xbaI\x95&\xbf<jz\xb8\xb3\x8b@\xb7\x90\u0783c\x8f\vJ\f28\xdc=\xa6Pf\xe8\xcd\u076e\x98nF}\xb3t\xf8r\x1a\x85\x99\u059f\xeb\x16\u1a35\xe0\xfa\xb6\x89\x88j\x18\f\xee\a\x84h\xb9\xaci\xf4\xaf@r\xb9\xa4\xbb\xed\xad\xce\xfdh\xdb3w\x1b\xeen\x1dl\x97\xb6\xe1\xeeA\xe7`\xbf\xb4\r\xb7:\xdb{\xdb%\xeb\x87\xdd\u076d\xfdv\xc9\xfaa\xa7\xb3\xb5wP2\x7f\xe8\xb4\xdb\a\xfbe\xf3\x87\xed\xfd\xf6V\xc9\xfcAYS\x14\xcc\x1f:\xed\xf6\xeef\xc9\xfcaww\xbbsP0\x7f\x801o\xb5;%\x9b\aeqP\xb0y\xd8\xdd\xdc\u07ad\xd8<\x1cl\x1dl\x96\x8c\x1e\x94\xa9\x80c\xf4Ps\xda\u05fc\xd6_+v\xf7\x1a\xef\x97V/\x02\xb8q\xf5\xb3\u067c\xbc\xed1\xc7\x11\xa3\u0085\x1e\x030\xd2c\xf7\x10\u04a4\x9fU\x88\x06\x15!p\x8b\xf2]\x9e\xc1]\xb5\xdd\xe5\x8fX\x97\xaf\xaf\x13h\xcc!$\xdc\x06\xdfY\xd27\x85\u0661Z\r\\6$e\x1a\xc1\xa7C\xb4\uc966\x81\"\xe3I\x9cSN\xa8\x0em\x82\u07e6\x1f\xb2t\xb6/\xa7}\x19\xfaM\xdeE\xb4\a\xf5\xaaD\x06.6'\xaam}I\x87\x9fp\xb1~R\xb8\xb9\xbfG\xad{\xd5\xc7\xdc'\xa8\x95\u02ad\xa8\x8eP\xd3Y\xa91\xb8\xf5,UL\xbes&\xfe\x0f\r\x06{\xbae$\x93(\xff\v#\xe9:^\xe1\xdfU\x87\xb4\\\x0e@)\x1a\xf9\xebW\x92\xedV\x9e\xbe\xff1\xc9\xf41\u582f\xd7:\x04G/]\xf3+|\xa3(\xcd[_\x05\xbeP\u0431%8\u0356K\xbaw\xb0\xa7\f\xe6*\xb4K\xb4\u03ae\x05\x93\xc7o\u0340\xe7\x12\xd11\xe0n\x16\x82\x8a\xad\xeec\xebG\x9f\xafg\xe4\xe1\xf6F\xb6\xa4\xa2%\u04a7\u05c2\xe1\u07a86C9\x1at0 {\x114\x95\x87\x11\xc4\xdfMpC\xa5~\xbd\\\x0e\xba\x10\xeb\x1c\xba\xe0Kx\u0121\x80\x16\u00f0Mga\xfe\xb8\u074b7\xb6\x83\x18Y5\xb9\x13g]\xbe\x1en\x13\x11f}\bp\x13ep\x0fz\"|N\x06\x8f\x1eu\xf6\x17\xe5\xe4\xf5\x0eflV36!c\xb7\x9a\xbeE\x064\xe9\x0f\xd7\xd7\a\xa1x\xfc\xb8\xb3\xdb\xdc\xdc\xd9q\x12\xf6\xdd\xef\u035d\x9d\xa6\xe8n\xc2{M\xb3\xe9\xd7\x0fj\xb3vL\x8f\x1fo\x17\x1a!\xdd\u03ad\xadt\xda+\xa6\xb6];\xb3\u01cf7o\x1d\xb3\xf5\xd7\x0f\xeb\n\xa2\xee\xfa\x955<\xb2\ft(Id\x1af\xff\xbdE#Px\x8e\xc3\xce\xee\xd6\xfe\x16\xcd\xc36\x9d\x87\xd9F\xda\xcd\x1f\u037b\xf9z\x18\x93H]0}Fs\x9a\xaf\u01cf\xe7\xbdy\x90\xaf\xc7DN5\xed\xf9\"d\xfdl\xa33\xa0\xaa(\xef\x8b\u01cf7\a\xeb\xbc/\x1e=\xdan\xeen\r\u05bd0\xf4\b\t\x00\xc2)\xc2\x06x\xec\x8d\xcd\xc1\xa3G\xfbd\xbd\xa6v\xa7\x8d\xd5\x1f?\x96\u0571\xa5M\u0552g)Kd\xc4\xc8K+\xb8\x0e1\xa6_\x7f@\xd3\u0439K\x18Y\xef\u01d8\x8b}\x84P\xcf\xfe\f\xf0_\x1a\x85\u0793\xa7\x87\u03ce\x9e\xbfx\xf9\ua9df_\xbfy\xfb\xee\xfd/\xc7'\x1f>~\xfa\xf5\xb7\xdf\xff\x88\u0386#6>\x9f\xc4_\xbe&S\x9e\u03bee\xb9\x98_\\^]\xff\xd9\xeelnm\xef\xec\xee\xed\x1f\xac?\xf4h\x1c\xb6a\xcf\xe8\xabF\xfc(\ufbaf\u01c4\xf7\xe3A\x18\xf5\xe3\x01\xcd\xfa\x91\xbb\xd01\xd8\xf8\xd8Ce^\x88\x03\xa5\xda\x18\xfb\u2ff7\x1f\xb7\xabr\x90W\\*\xc5J\xe9T\xab!\x89\x83\xa3D\xa0\xd9\xedF:nl{\xd6rA\a\xc8\x00x\x1a\xa2\x19\xa2\x8c\xc6\xe7\xa1 \xb4\xcf)G\xa5\xc3v\xb0\xbd\xc1\xff{\u06f9\xc1'\xea\x19\u05a0\x15\x86\x1a\x04\x90\xe7\xa1\xe8\xe6\x8f2\xc0\x9c-\x92\xc2*\xe7\x80\xf4\xbb\xcd\xce\xee^\xa7\xb3\xbb\xdf&\ub436\u0781\x95o\xee\xeelb\n\xa02\xa4Bt\xf2Xc\x81\x1f\x85)y\xfc\xb8\xb3\xaf0 z\xfc\xb8\xb3i\x7f\ufa9f\xbb[\xcd\xc8\x1a]\xc4\x16!\xb2\xbe\xb7\xe1\xb9pn\x93A\xb8\xbbI\xb3\xbewZM\xdfZ\u049d\xf6^{\xd7a\x1b5cX0%\xec\xae9\xecG\x818\xb3\x16\xbb\x9a\xa5\x99\xc8\xef|\x99\xcaz,\xc8\xea\u07a5\xceb\x1ee\xd7+\x1e\xa6\xf0\x86\x89cY\xc5\xda\xea[:\xf7\xf1\fA^\xd6\xdfo\xefn\xef\x007[c\x9e#\xc3'5\x9b+\xb3Z\xe34\xeb\u065f\xbe\xc7\xd3\x11\xfb\x92\xb7\xe6\"NZ1G\x87b*\xec\x98'\xe3\xeet\x85\x02T8\xa7\xa2u\x92\xa4\x97\xea\xd3\x05\xca:[\x03\xc5)\x9f\x85m\xcb$\xa0Pp\xe8\xaf3\x02\xa4\xec\xd5\u06d3\xf7G\x87\x1fN\xdf<\xf9\xed\xf4\xe9\xef\x1f\x8eN\u009d\xb6\x9a`\x1cn\x02\v\xbe\xbf\xb5\xbb\xbdg\xb7M\xae\x15m\x1e\xc7\xce.9\x8e\xf89\x93[\xe5\xc1\x87\tS\xfa\xe4\u0783u\xb6\xfe\xc0\x03\x1d\x8aX\xed\x9fq\x9a5\xd2\x19\xb6\xe4\xc1c\x8a\xf7@\xb3\x06\x92\x91\xb4\xa4\u00b1\xa7S\xaf\xa19\x13\xef5c\x03!g\xe8\xdcet\x1c\u34f9\xa3\x96R\x95\xca\u058b\x99\x853\x1b\xb8\xe58\x93\xd1e\x1b\x9a\xff0\xdb\x1e\xea]\u03d8\xa1\n\xc7Z4\x8a\xa9\xb2\xe7\aV\x84\xe8\b2\x12\xad\xe5Q\xda\xec\xfc\x0e!x\xf1\xa2Z\x9d\x85z1\x14\x8b\x85/Bx\x80\xdd\xf7\xa4Z\xd3\x1c\xc4dJ5\xc6\x17\xa4v\xb6\xdeG\xfe\x95\xa7\x97\xdc\xe8\xd0\x04\xa0^\xae\x17\x88\x87\xed\x85\f\xe5\xd9M\x18\xe0\x7f\xees\x9d\x97\x86Y\xeb2\x8b\x85\xb4\xf3\xea\xa6R\b\x8do\xf12\xbea\x9b\xa6\xf68\u0256&$\x19.\xb5D\xddV\x9c\x7f\x8a\u0665ko[\xd4\xedz\xe13j\U0004341b;\x11g\xe2\x8b\xd6\x196N%O\xf7n<\u0399\xa0.\x83\xe7\x98b2\xa2\xb5\xc0tP\xb5Z(\x01N\xe0\x15\xbd\x06!x\x19)hC\u038e6\x9c\xa9\xaa\x0f\xdaH3\xf9k#\x89\xbf2\x8d\xe7%4\xf2\xd6\r\x12t\x15\x14\x9c\xa6\bj\xec\xbf\U000197a8\x9bG\f\x1c$n\x95\xe4~\xe6\xac>\x99D\x19\x1b95\x9bM\uc9d2^\xe9\xadZ\xa2\xbe\xd3\xea.\\\xbd\u0750x\x94w\x9b\xd2\xd5\xd2\xc0\x95\xed\xad\xdcq\x9aD\xab0\xbc\xef\xc6\u0366\xf9\xe9\x9b\x15^C\x1d;G\xab\xb41\x97\xc7E&\a\xaeq\xbb\x84\x87\xb0\x95\xe4d}\xe6`a{12\n\xdbp?\xc8}\xcb6\xb6\x9d\x88\xa9\xa0\xce6Lg\xd7>\xa7m\u06a6\x02.J\xf1\xd8w\xc2Z\xaa6\x8a\xcfJf\xb1\x98i\xe7\x0f\xdb_/\xf7\xdb$\x18+\xf4\xf5\xe4\xf0\xa4\xf8\f\xaa5\x9b\xc5 \x83\xac5\x8aDd7\x9aN\xd0\xf8\x9f\xea\x9ct%\u02a83M\xc2Q~\xb5D\xfa>\x8b\xa7\xb1\x88/X\xedi\xc7\xfa\u0542\x83\x12\xeck\xcb\x18\x8aH\xe4\xca\xfc_\xb6/\x97E\xf9\xaa\x83\xf0kw \xbc<\x04W\x9f,\x06\xa3Q#\xbe\xfd\x0f\x1d\xb9v\xbc\xb3\x82\xb96#4\x87nz\xed\x00\xb1\xd9}\xd5\x19\xfb\xcc\xe2\xba1L0E\u02c8\x0fl\x8c<& V\xa4\xe8f\xeba\x87\xa0\u0576\xe4B\xb3A\xb7\x1aT{\u2701\xe2Q\x1bv\x8a%\u050f\u010a\xd9{)Ru\x9ct:\x17y<B\xf8I\n\xd58\x03\x8b\xb1\\A\xb0\xd0\u073a\xcf\x17\x8b6Y\u056a\x9c\xd0]\xad\xe2\x1c\xcd\xc1f\xdfX\x85\x16b`\x10\xbc\xca\t\x15\u0712I\x05\t\xaai\x94\x13Z\xcf\beEF([\xbaQ\xc6\x15\xb3\x16\xae\xe0\u05bc'\x02<\x92\x89\x86H\xd1\xd8f\x18\t\xa6vF\x03\x03\x85+;\v\xf5\xa8\xd1\x00\x04\n\x1a\xed+o=\xb6\xccsg\x97\xac{\r\x00m\xeeY\x92\xb7`u!\xc0\x8b\xc4\u04eaN\x99\vX-G\xb0X\x94\x8f=[\xd5.\xa9k\x19\xb9v\x9f\x93f5cw\v\xbd\u0434A\x8d\xb1D\x15\x1e8\xa7\xb5\xe6\x9b\xf4\xf4Vhw\xa0\t\x98\xab\u0301\f[\xd6lB\xba~\x0fi\xb4\x11\xd5\xd2p\xad\x83{\xab\xdb%\xf9e\xac\xf4\x17\x86Q\u03bc(\x1f\u01b1\x17\xe0\xef$\x121\xef\xa8\x0fu\xdd\t\xf4~\xebb*\xf2\x86\x81\xfe\xb9\xb1o\xf2\x7f\xf0\x99\xd6\vR%\x87\xf9\xa6.9\xcc76m\xad\xcen\xc2\xec\u05c6\xfcT\xcdl\xfe\xa8:\x9a\xb0+\xdb\xf7\xe3\u01cf;2Y]\xb8t\xceON\xaf\xea--p\x0f\xa2\xac\xb7\xd1\t\u0721\x89\xd0\xf7\x80;-\xd9\u0424\xe1Z{Y\x8c\x1a\x8f\x14E\u04a2\xb5\x0e\xc0\xd6\x06\xdf\x14\x8b\x85x\xd4&(*i\x13*\x1e\x83t\xb3t\xfez\xc5*|\xb1\xe0\x85b\xf2\"\xef$P\xfe(l\x17k\u00fc\xc36y\x14\xfaB\xfe\xb2\u0670\x98l\xb1\xf0\x99a\xd7\xcd\xca2rS\x81\xe0+)\xc0\x95\xfc\u026d\xeb\xf8\xa4\\R!\x88\xbeT\x95\xb3o\u00d9/\xe5\u00a5\xd5\xfbZ\x19\xd5_\u0099\x0fN3\x0e\x12d\xf7\xbf\xad0\xd2e\xca+E\t1\xb2\"b\x9ck\xc40\xac\"\xbcO\xc3?\xa8\x84I\xe1\x9f\xd0!\xa5\xd7\u019fA\x8at\xac\x1dV8\xb5\x8dN\xadi6\xef\xf9Y\xc8\xe1e\x84\x04\xfc\xb1\xbdS\xf7\xb8s\xc1\x0e\xf8\xff\x87\xba?\xdbn\x1c\xc7\x1aF\xc1W\xb1XY\xfa\x80\x14,\x93\xd4L\x19\xd6\x17\x83\xa32\xaa22\xa2\u009159UQ\xb4\x04\xd9\u0320H\x15IyHK\xb5\ua71e\x87\xd5\u05fd\xfa\xa6\xcfm_\xf5\ua2fe\xec\xd5k\xfdOp\xfeG8\xd5\x0f\u042f\xd0ko\x00$HQ\xb6#2\xbf\xfe\xfe\x93\x83\xc5\x01\x046\x80\x8d\x8d\x8d=\x1e\x1f\xe6wCD)\u37b2\xbf\x90\x88\xb7\"\x89k\xf1\xc4\xf6DaX\u03a2c\x1b\x9f\xebg\xad\x88\xb2\xe8$\xbf\xa5\xf7\xc6::t\xc6E\xc1C'w\xac\x89\x8em,\xd7(\x15\xb4\xb7u\x1d\xcap\xb9(v-c\t\xa5\u0320\xeaYN\x9amC\x05\x05\xab\xf7\xc2\x18\xc2\xdaCAn\x85\x965\x81K`5\x1cd\xb1'\x1a\xc6\xd1J\xc66\x89'\x0f\xbd\xd6\xda/\x98u\xaf\xb6`\xe8\xa7\xd9\xeb\x9a\xc2\x008\xb8\x86+\xd0k\x91\xf1\xda\x0f\xf3\xddC\xef\x18\xb2\x7f\xb0a(~\xdc`\xa7\xcc\xc1@\xe2\x04\x92=\a\xfdx\xd4f\xb1\xce\aol\x1e\x0f\xe0l&\x97\x17\xe7\x9c$\\\xed\xc1I\x05\xdf\xc1\x94[\xae<\xcey\xb2\xd9\xe4K\xaf\xb8=\xcc\ufd53\x8eb\xea\xdc\xcd&\u02ef\r\xe4\xe6.K\x8f\xb8\xcb\xd6\xf0':\xe2nEz\x91s\x92 \xe0\f&\xb0\x98<\xd1N\x84?\xff\xfeu\x949\xfd\xe7\xa7$\xfb:@\xaf\xccXS\xe4C\xb9\x9d\xf9<\x1a\xfb\xc7\xe9\xd8o\xb5h\xb0@)\xb8O!V\x06\xc9\x18\xcaK\x93\x89\xed\xf9\x87\nR\xf9\x04\x05\f>e\xfea\u0482\a\xeb|\xaf\xf8:@\xc4F\xed\x14\x14\xf3\x0f9|\u02e09|\x03L1\x89Z\xeb\x93\x14WNz\xb8FE\xf4\xd8?\xe1\xf6\xd8?<\x94\xd0E\xbcaW\x18\xd9\xf581 l%\xb4!a\x04\xc0\"\xd8^.\x12\xe1\x7f\x82\x1e\xe6\x1b\xb7\xbf\xd5#h\x04,+\xdcQ\".\xf3k\x93\x88n6v~\xe6\xcc\xd7h4N\x80\x80\xa8B\t\xa5'1v<\xa6^\xc2cU\xde/p\x05`\x95\x1a\xa0\xe4\xc4?r\xe5 \x1d\xb9\xa0f\xb2\xc7\xc1q\x82B\xf2\x9c\ua841\xd3\xeb(#\xb9_\x82\xfbu\xc0\\\u029c>\xae\u047f\x90\x82\xd5\n\xc6\xe2<j\x05@\x16\xf5\x13#6U\xd1#\xf5\xf2w\xe4+\x92\xb1\xa2\x1f\x94I\xef\x8b\xe2\x9b\u06dao\u0333\xb6>m(sy9%\xa8_\u059dm\xb5\"\xaaL\xe3\xf1XQ\xd6\x03\x15\xceC[\x92\xed6\xff\xb1\xa6\xf9\xdf\xd6\x15<{\x10N\xc0{\x04\r\xd6r>\x1f\x06\xccr\xe0E\xae\x8co\x10\x92\x1dr\x97\x1e\xdb\x14'#\xe2%\xb8\x03\xc0\xd3\xe8\xe4d\br\xe5_\xbb\xbd\xbe\xd6\xd8\xc4T_\x15q\xa9\xfc\xed#c\xfc\xa9\xa2?Tg\x93\xc8\u063e&IY\xa3\x05\u01d3\x9dGJ\x8e\aU\x19\xb5?\u04f5G\xfc\x8d\x9f]\xb5\x97A\x94\x93\x91B\x82\x02Z\"\u017df\x92y\x8d\x8f\xa3q1\xbf\xe2<\x96\xef}\x8c\x8b\xc3\x02\x9e\x9d\xb8\x9d\u0464\xebe'\xae\u06d9t\xbc\xec\xc4\x199\x13\xd7\xc3\x1d6n\x05\xc7<*\x06=e\xeb\xb1\xe2\x98\x02\xc91\x1d8^v\uce30\x8f\xfa<\xa3rQ\"cr\xe0z\x11\xb4\xd7r\xa6\xccq\x87\x9c\x13g\xe46qc]s\xd2q\x9a\x19\x05-g\xbf\u04cc\xd8\xfa\xc4q\aX\u015a\x96\xea\xe8\x14u$x\xe1V++\xee\x12U\xb5\xd3\u00ea\x1dwC\xa0r\xddJ\xc2\xd6'\xae\u0745f\xd6\u01fd\x9e;\xeao6\xeb\x93\u07a0\xd3\xed\u043a\xa6\xbb5M\xa7x\xd1y\x18\x86\xe2.\xad@4\xcc!R\xc0%\x1a\xb8\x94\xadO\xfa\xbd^\xa7\xd7l\xae\x8f\x1d\xc7\xe9:\x8e\xab\x81\xdanu\u031a\t\xf19\x94\xea\xc0\x06F=_\x7f\x02T\x17.\xfb,\x91X\xeb\x03\xf3o7\x1d\xdb\xedl\xb0\xab@s{\xfd\x8eko\xe0Y\u04e7yI\xca\xe2\x16\x0f\xb65r\xe0\xaa\x04\x02uv\xc7\xfc\x05-\xd9p#\xf6\xbePkJ\x99\x89\xc87L\xc83{\xc4-\x8b\x01MG|\x04\t\x05\x8dZ\xfc\xf1\xaf\xd5:HX\xd2\xe2/\n\n\x13m1W}\xfb\xd3\x1bm\xc3\xc7\x03\xb6n\x7f\xf8\xf3\xbb\u04d7\x1f\x9f\xbd\x7f\xff\xec\xcf\x1f\u03fe\x7f\xf7\xee\xed\xfb\x0f\xa6]\r\xd8W\xca\x0e\x89\xaa\x10\u06e1,\xe3\xf7\x8b8\u07b5\xec?\xe8\xba\xdb\xedc\xba\x91:\x06g\x9f\x00\x01H\x18\xeb\xbaH\x12\x16qLv\xcc]\x1b\x0e\xa8\xc5\xea\xfb\xb3\xd9\x18\xe2\xc1\x9cOS\x06\x9c\x9bM\xc1\xc35*\xef\xa4q\xe7fS\xba\x05)^\x90\x1e\\@\x8ez\x14A\xcc>\xa5x\xb0\x9e\x1f\xa0\xcb\xfa\x01):F\x0f\xd2\xf5\nT\x81\a7W\xc1\xec\n\x045\xda\xfb\xf9\xe0\xe2\xee\xe0oRT\xf3\xb7\x83\xeb^\xfb\xb6}\xf0}*\x8cG\xdd\xf6\xedA\xb08\xb8\x8b\xd7\xfa\x9b\x838\x9c\xe7\xed\xaa\x8a\xdbV>d\xb2\x87\xda\x01\x8f\x18\x12\x17\xb0\xcfL\xd0\x1c\xef\x1eL/e8;\b\x0fs)2s\xf2\xca\xc2\x0fiF\xa3\xf7'8=J\u0636\xdb'5\xa9D^?\xb7\xc9\\%\x02\u036e\u06eb8\x0e\u03c2\x9f\x04\x1f:#\x97I\x0e\x7f\x8f\x83X\xa1\xc1\u0683T&\xb8\x9f\x83\x8ck\xa34\xc0\x84R\xa9}@\xecy\x8c\x92Lq\xcc\xedIZ\xc8\xdaT\u84dd\xd3\x19\x14i/\x024^\x8a\xa8g\xdc\u029bm\xdeQ\x05\xcc\xf7Q\xea/j]\x86P\xc3W.\x06\xaa\u0647\x8a\xea\u0669+\xa3\x82\xc4)\t\x91h\x7f\u0505\x9bM\xd1\xe0\xdc\x18a\xa8i&\xfd\x0ew#\xa9T\xf4f\xa8\x10\xd6\xc2v&\xda\x12\x95\x98)H\xa3\x10\xdb/\xab|d\x1c\xf9\xb2v\\\xa3H\xa3\xacQ\x92\xeem6\x8d\xf2\xb9p\xbf \xeeb\xbdp,\x86\xbfn!\x8eKk\xe5q\xb2>8U\x15\xf0)\xf1.\x86R*\xc9\xcbJ\u00b7\x9cG\u059cY\xccm\xe6\x17\x9c\v\xba\x82\xc7\xc7\xfe\xb8\u054a\x81\xc7\a\xbe\x04\xb0\xe6<\x9e\x02\x87\x03\xb7P\vp+\x92\xcd\xd7\xf3t\x8c\u04a9\xe48\x9a8\x9e\xbde\xa6\xae\xb54\xaf\x8aK\xc9\xfd\x8c*G6C\u06b3G\xb4\xf34A_IB\xf3\xe5\u2606\x9d\va\x8am\x80\xad\x95\xafLM\b\xb3\x8a\x8ei\u03c4C\n\xab\xacF\xe6\xeaGR\xae\n\x13-\xe7XI\xedkD-\xb9\x19\x83\xadv\xf3\u220c8\x00\x13,\xfd\x14v\x8f\v-\x14\xf0\xe8G\x9aC--Z\xa2t\u0731\xe2\x0fj\xaaA\xee3\u00ea\xa4V\xb6\xb4\\h\xdc\u028aP]\xf2bR\xa2\xc5t\xb31V\x14\xa5,\x93J\xc1\x84\xc5t\x8fx\"\x15\x99\xf6\x16\xca@\x0e\x01\a\xd9{\xad\xdb\x7fd\x95}\u07a0\x1b\xb0l\xe3V\xben\xb6\xb9\xfe\x9e\xadM\x13\u0265\xa9\x80(\xa8\x14o\u0625\x17\u937fr\xfa&\xff\xa3y\x1fCz\x8a\xeb\xf8\xd7n\x83\xef\xd1u)\xd5&*\"\xf6\x98_9\xfd\u00cb K\xadB\t\x95q{\x9c\x1d\x8bq\xd6\xe2.\xbd\xd4B\u01ac\xe5\x14'\u012b \xdd\xee@\xdbq\x9f\x04m\xf7\xe7@\xdbq\x1f\x80\xb6kB\u06e1L\u07f5\x1c\x96\xb5\xdcG\xa0\xefw\x9f\x04\xfd\xf0\xe7@\xdf\xef>\x00\xfd\u0404~P\x81\xbeo\u073b,k\xf5\x8c\xfb\x0e\xcbZ\xdd\az\xa75O\x8f\xf5\xcf8\xf3\xa2\x13gI\u0462\x97\xa5\x92\x93\xdb\f8\x85=&\xe5\x95\u05bfEG\x7f\x05C\x1d`\xa5\xf22\x80\xdeNl\xa7\xb2&\xacV\xa8\xf8lg\xb9\xe6\x92Dsp\xa4\x13/t.\xe7\x02d\x0fD\x05reJf\x0e\x1bL\x99\xe0\x96\x95+\xabjl\xc2\u01b9\x87\x00\x0ena4\a[\x15\xb3Yd\xb8\xef\x91\xf6\xbd\xbb\xa5\xe0\xc3\xf7\x95s\x00B\xf8$X\x12\u028cY9\x01\xcb \xd1\xe2\xd6A\xbb\xdd>\xb0(\xb3\x8e\x15\x9eY-\u0472N\xac-\xf3\xe1\x84Z\xc0}\xeeOyM7h\xa9ou\xec\x8f!\xaf\xff\"&\xe8\ts$\xf9\x97\fT\xa5\xd9#\x8a\xc4:\xc6\xe5!\xf5aiK\xd3*\xaa\xfc\x894 \x05\x1fc\x15o\xdf|)e\xa3\xe6\x93\x18\xe3\u04d4\xd4T\xa8a\x8fN\n{\x92\x04\x1e\xc4%\xe5V=]\x88\xd7\x19\xf4*\x81G\a(^\x97Vf\xc9\t\xb4\x93\x9d\x98ZK\xf5\xb8\xa4#1K\xc8\a\n\x8b\xcb\u031b\xcfI,\xd5e\x87$\x91\x17,\xe0Z\x99v\xa8ui\nu\u04c2\x91\xf3Y\x80\x81\xa4\xa1#\xfa\xbc\x1eK\xa7\xe3B\x8c\x95S,\xc1\xed\xb1@\xeba\x81\x92\xdds\x01\\\xdf\xec\\L\xe9\xbd\xcf\xe1\x96\x05x[\xe6\xfa\xfc\xe3\x00\xb8\xbe\xe0\xd8\xd7\\\x9f\x89\xa1\xb3p=\x17\xe9\x9es\v\n\xa6\x11:m\x1e\\\x1c1v\xf4\x16{\xea8PT\n\x1f\xb2\x86]\xf9\xd8\xd0e<\xad\x02\xa7R\x01\x9a\xf3\u0544O*3Z\x89\xd2Z\xb2\x92\n\x94\xc1&\xa0UK&\xc6\u0598]&<\xdb\xf31R\xc9 }\x15DA&\u029cMi\x7f\x92\xa0\x12\xadu\xd1\xea@v \x17\xf59;\x90\x15O\xa9\x8c\u01ac\xe3x\xaaC>\xc4J\x1bKTbyk\x11\x9d(<\xab.*\xd9_J=\xa5\u0553\xaf\xe9V\v\ue35e\x1cf5\xaa\xe3X)\xf1h.\xb6=A\xf5\u0771-u\u045bM\xf6\x84\x15h\x98m`\xefs\x13\x95\x92}\nl\x13\x9bM\x01\xb4ZU;&\x04I\x8d\xa2\xf9\xc6\xc0\x8e\xc7T\u0367\xbbe\x9fj\x8dp\xbb\xfbiE\xb7\xfc\xb1\x06\x90/\xd2.\x9f\x95*2\xf4\xcb\xfe\xd3\xf5\xcb\t\x1d'hxP\u05771\x1f\xf5\xcb\x15F\x01\x9c\xc8\xf9\x8e\x00\xef\x1e\xdez\xdaH\x8f\x81\u5777\xc3\xec\x03\x9d*<\xae\xda\x1f\xfd$\xd9l$\xa7B\xb7[E\xf3^\xf0\xae=\xea\x17\xd6\xd9o\xcb\xe6\x0e\x965\xde#\x98/N\xc0\x19\n\xe3\xe1\u011b\xd4\xca?!\xdbO\x13\x8e\xbe\x86\xf9n\x91\xe6\xe2?\xa0\xbd}m\xbd\xde\xd1\xd8k6\x8f4*v\x1c\xa4\x01KMno\x89\\o\x89>\xd1)\x93\v\tp6N\x10\x98\x84\xc6-\xfe\xe7s0K\xcb\xed\u048c\xe8$\x1fv\x9b6\xf6\x91\x9dz\u5192\xe4Z\xf6\xb1\x80sG\\\xdf\xdd\xe4\\L[n\xaf\xffur.Z\u0394\xd64\xff\x9d\x19f\xf1\xd7N\x03\"\x1b\xed5\x06T\xa2A\x1dx~\x1dD\x99\u071cE+;\x89\xf6|\xf3!\xb9\x830\xf0Y|\xe0\xcff\"M\x0f.\xc4]\x1c\xcd55\tUZ\x04#GD\xc1^\xe9\x04\x0fO\xe0\x95,Y_\xdd9TsG\xda\x7fC\x1e\xfd\xb3\x93\x18f\xd6\xdfg\xa3W5\x15\x96\xb6zh\xa7g\x98\xfdE\xad$\xe7r\xf6\f\x00n\x95\a&_c\xf6\xf6\xbd\xc9L\xfe\x9dd\xd8m\xc1\"6\xd0$U\xa9g\xb3\xe6\xf3\xe0\x12\u0529]w\xd4\x1d\xf5\a\xee\xa8G)\xaaM[S\xee3\xff\xe4\x84\x0f\xd9\xd3n\xc7Ru\xa8+>9Q5w\\Z\u06c8\xe6\xd1\xe5\xe7\x01\v\xcc\xda\x1e\xbb5l0\xdf\xfc\xfc\xbe\x0e\u02bd\xe9\x97o{\xe5\xdb\xee\xcf\xebk\xa7\xdc\x1b\xb7|\xeb\x94n\xe1&j\r\x8b\xbe\xbe\xdc\xc1\xe2/D\x95\xb12\xa1\xf9\x12\xe4\xfa\xd6\u0314\x92g]ieL\xf1\x1f>D9\x13p\xccb\xe07_\xf8Y\xe07n\a\x9eF\xadnQ\xe1\xf3\xa7W8\u072d\xb0\xe7\xc2S\x18\xa6uu;\xaa\x88\xfc\xf2\x13\xa3q\xe2&\x82\xff\xe3\x1f\x82\x1e\xdb\x138\xe2E\xf4\xd8V\xfeG\x9e\x90\xe7>\x1eQF2\xc3Nv\x12y\xff\xf8G\x86_d\xf9\x17@\u017d\f\xbf\xc8\xe0\x8b\xecX\xe0\xa5(T\xca\xd8j\xba\xbe\xf0s\xa3\u0647Ub;V\xb2\xe6\xbd4R\t\xa2\xec\xdbS\xbe\xf3\xf85>\xae2\xd1B\x0e\xa6b\x1c\xa3\xcdFRi\x93uS~2\xf0\b\x0e\x111w\x98\xaf5\x8e\xad\x96\x7f\f\x87\xba\xf8k\xee\xf6\xfat\f\xbb\xa1,\xd8\xf2\xa7_\xc7cS\u0536\v\xe6\xf3z0\x9f\xff|0[\x87\x87\x19\x82*\xc1\xccN\xecz \xa1\u0623`\x0ek\xa1\x1c\xf2\xfa\xf8\x89\nR\t\xa2\xc3\xcagU9\x86\xf5\xcd8\xfd=\u04c6/\x9e\u0418[\xdb\xd8Fu\x15\x9d(\xf7\xb5\xfc|_\xcb\xcf\x7fF\xcb\xc7\xc7\u00e2\xf1\xfa\x96;\xee\x9e>\xe3\x8b'\xb4\xdc-\xb7L\xea:\xado\\t.\xa5-\xa7?\x18\f\\\xa7\xff\xb5z\xde\xd9\v\xdc\xf3}\xc0=\xff\x12\xe0*\xedN[\u0100\xd2\xe9\x9b`\xe60w\xa6t\x17\xb8\xe7\xc1%\x80\xd1\xef~{\xca\x7fOJ\xc6F\x7f \n\x04\xad[-\x1c\x13\xf5\x02\x8e4\xf2\x0f\x8c \x9d\x99\x11\xad \x82\x90\x8b\u0084\xfdphP,d\xf3\xb0\x8aVKL[h\x1ca\xdc\xe7W_\xbb_\x7f\xedvY\xcc\xf3'\x8f|\x19\xc9/\xf4jT[fB[D]\xc6\xf4\xf8\xb8\xd8S1\x92\xcd\u07a1y\xfe\x9f14\xb2\x03;\x1d+w;\xbf2\x87\xe6I_\xea\x14\x80$\x1f\x9a\xd2x\xb4\xf2a\xaa\x1b\x99\xffL\xfa\x1f\x7f\xcd\x1dw\u0224`\x8d$\x87\xf2t\xb5\x8ao\x88\u02c6_g\xb4v#\xfb\xd9;\x81\x025'\xf5\x894f\x1b'\xe5\xdd\xc0o\x99Ev\x80\xf6%\xd0~\r\xd0~-\xd0_\xb21\x80\x95\x97\x1a\xe0\u0261\xf35\x98\x01\x1ej*\xe1Po\xef\xbeQ\xbf;<D\x9c\xc7&\xbfS\xa5\x94y\x04\x10w\xd0\x1f6\xa3\t\xb2\xab\xb63\xe8\u06db\u020b\xf64\xff\xfcg4\xdfr4\x00_\xd8\xfc\x17\xee\x13\x8fo\x13\x05\x01>>v\xbb{\xda~\xfe3\u0686j7O\xd9\x00j\xe9\xff\x7f\x1e\xf9\x97\x1fw\r\xda$Z\xbd\x121\x17\xad\xfe\xb4E\"\xe8 }\x1a\xc5\xfa\xcc=e\x1f\xe5\xffO#\xfc$\xc3\xce>\x95\xf0?qP>oo\xa8\x1d\x94Wa\xecg_\xb4Bb\xfc^\xcb\x1a\x1b\xb6<\xa2m\xeb[x\xfe\v\xb4\xe0\xeck\xe1e\xbc\xbe\b\xc5\xd3:1|\xa4\x13x,\xdc\xd7\xc4\xf3_\xa0\t\xa7\xae\t<\x9a\u059c\xcb\xe4\xf3\xba\x8dY\xa9(\x04o\x89|\xab\x93?\x8d\x84\u07bf3U\x1e\xa5])\xa2\x87\x0eHV\xa50\xaf\u0628\x11O2\u5d4a\xbbvdn\x81\xf2u\u02dfrq\x14C\u0620\\/\u06ca\xf6t\xe5\xf9\x9e\xae<\xff\x8f\xe9Jt\b\x9dq\x8c\u03b4b\u075d\xc3\xc3\xf8\x84\u00d6\xeeW\xfa\x13C\x7f\xfc\xa7\xf5gX\u07dd\xe1>\xb5S\xa9?\x9b\x8d\xd1\x0f\x87\x81\u05cfMYi\xd0\xc1^`O\xd3;\a\xbf\xbc\xf5\x9d\xbd\xfdI\x00\xb8\fm\x97k@\x90\xc3\xe2L9\xa0\xf4\x10L\x16\xf6\x82\xf4|/H\xcf\x7f\x19\x90\x14\b9Hz\x94\xf6\x81\xb4sT\xccA\xda\xe1\x01\x9e\x04R\x97\x15\u00b8\x02\xaeVGB\xe6v\xf5\x03W>p\xfa\xbb\xc3W\x99\xdf\xee^\u021f\xef\x85\xfc\xf9/\x06y\x15n\xa7\n\xb7[\x19\xf3\xce\u00d0\xef=d2\x10k\x15\xa1\xc8s\xb0\u0536eS}e\u0677\x8b\xca?\xd6\uea6d\xdc\xd8\xf3\x87\x1a{\xf3\xcb4\xf6D\x82\v\xe4Io\xf0e\xcat\xe8\xd0q\x89p%\x87\x0e;L\u8db0\xc6t\xd0Q\xa9\x8e\xf4\xc6\u01d1I\xaa\x04H\nm\xcey\xd0l\xdaJ\x9f\x0e\xa4K\xe6\xe2\x01_\x04VP3\"\x8e\xfc\x93\x13\x9b\x1e\x06\x8f\x13\xb5'\xd2\xe2/\ue962\u0215~>D\x95\xf7u\xb5\xf5\vt\xf5\x8b\xe8\xb4\xe3\x0e\u0621\xe3\x0e\xc1\xcaZJx\xdd^\xaf%Z\xce\xd3h\xf7\xcf \xd0p\xc2\x19\xb0C\xf8\x19~\x19\x95\xfe\x19\xa4\xb8\xbe\xf1\u03e1\xc7?\x83\xe8\x16\xce\u00ac\xe4\x1a\xfc\xc8\x18\xec\xa1\xc5\x06\xb5nu\xf7\x01\xfa\xfc\x17\x05T\xa3JA\x83\xcb\x18\xf3\x8bS\xe1\xcf\"\u0087\x059\x1c\xda\xe5\x7f,\x93V\x0e\x9eN\x98?\x8b.\xff\xd2\xed\xd7\x1d`\x8c\t\xfc\xb6h\xb9a\xef\x98\x1e\x155<\x7fR\rN}\r\xb5\a\x10\xa3\x8a\xe7\x8f\x03Q{\xc0\xd8S\xc5.\x14`\xc6\\O\u025fb~\x99\xab\x95e\x8a\xba\x8a\x01&\xa8\xfc6\x1b\x12q\x9b\xb2D\x9a_Jc\x9b\xb2\u0322p\u0117\x1a\xac\xe2\rZ\x80\xc3\xc7 UK\x8ee\xc0\xb4\x88\xb2\xa4\x1c\xf6\xa3b\x01/[\u068dS!Kf{\x95\x90\xd2D\xf1,\U000d3b2c#\u03f5\x97`-\xc1\x1f7=\u06a7\xffL\xf66\x9d\xc6\xebd&N\xa3\xf9N\u00c9i\xea\x84\xfd/\r^\xee\u061a\x1d'\x87r|\x8aG-3\xfa\xdcaT5\x88\xad\r@UkW\x0fH\xf2\xc7 \xbb\n\xa2\t\xb6^\xdc\x13\x89/\x8f\x98\xe3\vV\xd6E\xc2',\xa3,.\xe3\"\xf8\x14\xd5\xe3bM\xb8\xbf\xda\xc7\x198\x82gL:6\x98\x03\xe5\xed\x14U\xc8T)\xc7J\xa9\u016a\x91r\xf6\x84\xd9\xd0\xe6O\xbby\x7fim\xbc\x8b\xa4\xd9,G\x1bL\xe8g\xd9W\x05\v\xe2\x98\x1e\x1f\x86\xcbg)\x9a\xe8\x98H\x834i\x99\x87\xfe\xbe\x9b\x8d\xb65\x83\x87\xd2\xc8\x17\u04b0\t\xc8&\\\x93\x9e\a\xc3LxFvb\xfd\n\xbfT\xe6\b\x82\u04b1\x8eGe\f\xe6qV\xbe\xddgZ\xf3v\x8f\xadlt\\\xf8*A=\u048c\b[R\xdb*7bD\x19-y \x04\xb0\x99\x8c\x19cS\xb6\xdb1t\x7f1-\xadp\u01c43\xbd\xb4\xab\xd4>\xea%\x128\x11^n\x0f\x9d`Pj\xc3K\x00y\xcf\xfd\xf6\xcf\x0f\xc6\x1e\u02e9\xa8\xb2\xd6\xf97i\r\x06\xbe5\xf1qt\x98\x19\x10\xb62\b\xdd\x1b\xff:\x98nM\xdb\x7f\xb5\xcc_\xf1{#o\xd6Oz#x\x05\xa9\xa5f\xa1\x9f\xa6\a\xe26\x13\xd1<=\x88d\x17\x93\xf5,\x8b\x13B\xef\xd3\xf5J$d\x9fc%\xb4\xc1\xac\xa5HS\xffRX\xec\x1e\xc1\xf4\xf6D\x97g\xb0+iw\xcbY\x1c-\x82\u02f5v\xbf\xdc*;wL\xf8\xfa\xb7\xaf\xee\xf3\x9b\xed\xc1\xf9W\xf7b;\xfd\x9b|\x9ff\xfe\xec\x13S\u9c4aB\x97\";\x98a\x9a\xa2\x9c\xcb\u06a6\xfa\x99\xa0\xf7\x0fA\x0fe,v_\x01\x88\x95\xbdCe\u01c4\u0645-\xdd\x16\x91qU\xb3u\x90{\a\xea\xa1\x1a\xa6\xed\u07f6F0\x9d\xef\x01<\xe9\xfc\x81\tCE\u057b\xca:\x84\x15)\xce\xed)\xd8JKeRt\u0093Vw\x1c\x1d\xf2\x0e\xcd\xf8\xdf>~u\xaf\xad\xea\xa2\xc3\x0e\xec\xe4_\xddg\u06ff\x8ds\x98\xf4[;\x7fW\x00\xf0\xf7\x1dC\x1eq\x12\x81\x91\\V\x9c\u046c\x8b\xe02\x882\x93\x9cZ\x11$\xc2W+Obw\xcc\xfd\x93\xceD\u01c6\xe2\x9c\xe7\xe7\xe5\xc9\xdfN\xf8\x81\xfd\xd5}\xb2=\xf0\xa3\xf9\xc1\xf1\x81\x8b\xd7_\x7f}\xf0\xd5\xfd\xf0k\xe2\xb7\x1c\x00+\xd9\xfe\u0343\x82\x87d\xf7\xf5\xa1\x83\x05\xa8\xfe\xbe\xee\xa5\xfc\x1a\xbaW\xb4\x04\xf7\x91|\xcb`\xf5\xbdj\x9f\xbe\x7f\xff\xf1\xed\xf7\x1f>\xbe}\xf5\xf1\xfd\xb3\xef~sJ\xd4\xdab1\x13t\u06e8\xf2J\x7f Y!\xc2/6\x01\b\xd0bH\xea\xc5y\u058a\xa6 \xae7BJ`\xdct\xba%rd\x8b\x11\xffC\xee\xf6\xf7`\xa8C\t\xea\xeb\xef\xfe\xf0\xec\xdb\xd7\xe08\xfe\x9b\x8f\xe0B\x0e\u0a2f\x98\x19=\U0004f1a1#\x1e\xa9\x17a\x8c\xf9\x7f\x1aE0\xd6?`\u048a}\xe3\x10m6\xba\xa3\xcc2\u04a23Aw\xd9#\xf9\xfd\xf3\xef_\xbd:\u036by\xfe\xf6\xfb\xef^\x9e\x8d\xab\x85\xf66\"gK:~\x16\xb3\x95m\xff\x06\x1d\xfb\x89X\xfbZ\xb0\xeaS\x19O\x00\u0477\x0f\xc5\x1c\xfc\x9bW\x8a\xd9'\xcd5!\x91sr\xb7\xc7\b|KY\xb1!Q\xa6\x80\xaa\u0389U\x9f\x05\xe7o\xe8\xdf\x02@=\x1a\xa5\xb2\xea\xcb\xf2\u057d^h\u06ffm)\xcb\xf7\x8c\x1c\x04sP+\xcd\x176\xc5\x7f+6\x98x\xa1!)\xec<q_m\xff\x8d\xc5<\xe7\x00\xe5\xbe\xdd\x0e\xd2\xd7r\xf2\tD\xc3@t\xf2/R\x12\xd1\x13\xf7\xeb\xaf;\xee$\xe6\xdfkO\u0708Ro\x87<\xa0\x9c\x9f\xe7%\x18\x89\xb4Y\xa2K\xbf\xfe\xbaP;\x81\xa5\xf1!\xa9}E)\xd6\xf1=\x89)F\xb4\xb0\"\x8b\xb2\xa4\xc5\xffv\xf0\xba\x18D\xc0\x16c\u8fba\x8f\xb7\x7fcIy\xd2\x14!\xfd\x86\x1f\x9d\xff\xb5ud\x1f\x8e\x9e\x1d\xfe\xc5?\xfc\xe9\xf0\xe3\xf4\xe8\xb2\xd8\x12\xbf2\x82\u044c3 `\u0391=\xde1\x97VR(\x88\"\xbe7Z\x8d\n\x13\x04|\xcaNt\x9a\xe8\x04\xa2w\xf4\x9a\xcd\xe8\x18\x02\x95tu\xe00,}\xd2\xebw\x9c\x11\xbd\x87\x107\x1dzr\xe84\x9b*h\x8d\xdb\x191g\xe40g8\xc2\xfedA\xb4\x16\x10))\xc0\xe8M\xc9\u04ff\x81\xb96+\x88\x8e1\x8a\xc8c\x15\xb0\xd2w\x91\fN\xd2\"\xf1!t\xa7\x8f9#\xa2CY\x93\f\x12\x85)\x14\x1e\x04\t\xa2\xd1\xe0H\xb2\b\xf8O\x1c\x02\xf8\u0101\xe0>2l\x8b\xfa,\xa2F\xac5\xd7\xee\x16e\xdd\u0772''\xfd\rD`\x80\xb8,\x1b\xa8\xd7\xf8\x16\xa1\xce?\xee\xd4}\xec\xb8\x1b\xd7\xed\xb2HF\xef\x87\n*5\u1111H\xc7t\xa1\xfb\xf3\x1d\x00_q\xb0\x8as#rh\xb3[\xdb\xe6p\xe3vQA\x85\xd9\x03\xb0\xd1z\x00r\xff\xa9\x82\xee\xff\xd6 \x81\x89\x996\x85T\x9c&\x89\xe0\x98\xf6\xb3Hv\rY\xad\x95\x93a\xee\x84\xf8\r$\x10\xa4\xd5\bf\xca@\x7f\xac\xd7\x01\xba\xed\x8e)x\"rk\\$\x98+E\xbd\xfd]qP\x93\\B\xc1\xba\xc29\x87\xc4-83\xe7G\xf3\xd8\b\xbb'\xf9\xda\xf3\xb8\x15Me\x84\xa3]\xc3\xfe\u07d4\xb5\xa5f\xf2\x82l\xb3\xc9\xc3?\xa8\x8b\xb6\xc1\xd4\xd6>D\x8e\xad\xd9\xdc}\x86\x81\xf8\xe0\xa2h\xfa/\xe6\xae\xd3\xe0B\xf9/\xfd\xb9\u03a5\xd6*\x12o\xc8\xe4\x1cV)\xed\x13\u02a8\xcb\x01\xba\x9c\xbet\x92\u05f4\xc7\xe9\x7f\x1d\x99Q\x17\u01b1,\x02\x03\x94\xa0\xb6\xf1<\x9a\xb6`\x94\xb6E\xc8.#\x03\xd4\xef\vh\xeb\xa2\xcdH\x8a;\xf9\x93\x99c\xf5O\x84\xde\xef:\x8caAt\x8c0\xbc\xbf m\xa43r\xf7&|\xc83T\u062ec\xcbt\x0f\xbd^\xaf7\xa2\xcc\xe71I\x88\xa5\xfc:v\\\xf7 \x85J]\xbe\x8a<Q(O\x88`\x8dFn\xbb\\#\xb2\x88\x9aM\x9f\bf\x19\xb5[@\x90&1\x89\xa8\x17m\xb7\fay\f\xf6\u07b0\xef\xa8T\x15\xaa\x1b\x00\xbb\xf5\xebW\xaaA\xa3z<\xf7\xfc\u06823\xe0\x9e\x12 \xf6\x80\x02)\x16x/\x16!\x1cL\xd4w\xe0\x8b\xb8\xd9$:\xfd\xaaO\xd9\x1a\x8b\xa9\xe3\u02e5\u020cL\xf6/E:K\x82U\x16'\xf2C\x16\x9ae\xcbG\x1dUb\x86%\xa4\x97\x91\x7f\xfbkI\x97B\xcc\xf2\x1bB\xceb\xcb\xcf\xcfp\xce\xd6\be\x14\"\xa1\xde\xd6\xe7\x0f\x91\x89`R\x92\xb0\xc08\xecA\xcd\xebf3\u0533\xb5\x06\xc6U;\u00cc\xa3\xb6y\xdcj6C\xe3m\x0eAkFl\x83\x9b\xaez\x9f\x1f\x02s]\xe4f\xdf\xe2|\xadv]\xc6\x0e\x004\xdf\x00m;\x0e'!\xc9;\xc3,\x1c\xfd\xbc\xdd\u0556z\xf9K93|\xb5e\xa3\xae3\xec\xe6I\xaf\xb0S\xfb3\xadH\u0539\xdfB\xfe\\c\u028aU\x19\x97R\u00ddO\x99\xf4\xfd\xaf\xf6\xb1\xc8\x02\x1a\x19a\x8e3\x8c\x9b\x11\xc9\x17~\x8e\xede\x91\x12\xf7!\x87\xbb\x96op\x9f\n\xbd\x9b\xe6\xee\xa5\xe5\xa0#\x91\f\x10\x19\xe5\xc2#\xa8=\xe0\xb1:\xcc\u02ed\x9a\x8e\x83fS\xd5\x14(\xf1\u0401\x91+\x1b[\xc2Z\xf2@\x03\x9c+\x94\xdc\xf5\xf5\xa7z\b@\xecq\x10\xd1D\xa7\xeb\x85@k\xd1y:\xcd\xdbJ\x15\xd4\xea62\x92\xd2\x14\u06e2P\xa9u\x0e,Z\xe0\xea\x84\xc4m\xe5\xb0\xc8c\x96?\x86 \x90\xb9\x90\x88D5h\x13oU\xcf3v>\x85\xa0\xa0\x05\xca\xf0\x88b\xae\x1b\xd7\xeew\xed\xc7\u0207\xe3\xa8L7\xf7V&n\xb3\xa3U\xe8\apx\xfe n\u1905\u03ee\xb2ehy\xd6\xf7Ih1\xed])\vl\x1f\xa6\x82\xccg*K4\x9b\x81s\xaa\x94\x8c\xdfo1\xcbZ{..\u0597\x9b\r<O\xee`r\x03\x9e\x10\xa0=\xf3x\x86\xb8\u051e%\xc2\xcf\x04\xb2\xcb\x04\xa8M\xfe\x02\x04\xde\"\x14\xb2=\xcaHX\xfd\xe64\x14pG\xact\xe5G\x98\x10H\xdcf*\xe1%\x17,l\xa7\xd9]( 4\f\xb7\xd6\x11\x1e\xf8\xf4\xb3U\x9c\x06P/\xb7\x16\xc1\xad\x98\x17/\xb2x\xc5\xed\xfcn\x16\x06+n%b\x96\x11\x9b\x1d\xa8\xffhQ\xfa\xe6*\xc8\xc4\xd9\u029f\tn\xad\x12a\xbc\x10\x17\x9f\x82\xec\xfbT$\xb2\v\x1c\a\xb9x\xff&\xfei\xff\xcbe\xba\xff\u077a\xe6\x8d?\x9f\x9f^\x8b(\xfb6H3\x11\x89\x04\u0494\xae\xee\xcc3\x99\x94T'\xed4\x8bW@\t\xfcK_\x8dj\u0596\xc9p)\xbe_%\x02*z)\u77d81\tp,.b?\x99C\xa2`z\x1f5\x9b:\xf6\u06cd\x9fD\x90f\x02\b)\x1ck\u05f8JJ\xe5-\xcav>\u0224\xdb\xe2\xeb\xd3\x03\b\b\x11,\x82\xd9A\x9a\xadA'\xc6dZ\xe1r\x15\xedY(\xfc\x04\xae\x88L\xd0\xe5\xf3\xf8\\\x83?\xddl\xf256\xae\xfd:\x15\x19~\xeb\xc3\xd1\x1e\x17r\xb2\xbf~\x96\xec\xf9Z\xb7\a\x12\x89\xac\x1dG/\xe2\xd5]\xb3Y7r\xfa-\xa9\x8e\x1c*\xfcrL\x86D\xb9\xb0\xc8E4\x7fq\x15\x84s\x12R\x96\xb6S\x9c\xe1\xef\xe2\xb9P\xe8\x9c\xc2\xf35\u0334\\()e\x8d\xbc\x0eq+f/\xe2\xe5\u048f\xe6j\xeak\xce\x04\xf0\xfc`&K\x1d\xdc\xf8\xe9\xc1:J\xd7(\x7fX\xacC0\r\x05\x0fh\xb9\xdd&\xa5\xd9U\x91\xfd\x8a\xe9\u015a\xd6)\u031d\u0472w`\xb1\xe4\xb3f\x19)\u0083s\xa5G{\xb3Q\xb8.\x8aqm6\xf5\x15\xa9\xab\x83\xb2/\xeaP\xa9\x8e\xdd.\xa9O\x17~\x18B\xe9\v\x7f\xf6\t*X%\xf1r\x05\xc29\xbf\x86-!GK\x7fv\x10\xa7\a\xb7GA;\x13iF\"\xff:\xb8\U00101f47\xd5\xfc\xecRD\x19\x9dX\xff\xfa?\xfe\x9f-\xcfz\x91%\xa1E[V\xebEq\xaa)\xe2\xb3\xfc\xea\xfe\x87\xf4\xebO\xe2\xee\x87\xf4\xeb\xed\xd1%\xcb\xe8\x96\xe4\xa2\xef :\xc8&\x99\x16\xf1z\x16\f\r\xf6Nw\xc9;\xf8\x15$\r\u0772\x83\xd3(\x13I\xb1\xcc$\xf8rel\x17A\xe4\x87\xe1\xdd\xfd\x1a\"U\xef2\xb4\xebv\"\x96\xf1\xb5\xa4\u05d3\xd2\x1dI\xa9\xa7\x1f<\vC|\x96\x12JY\xd8l\x96\xf1]\x96\xc9\xf1= 9\x0f5\xdbn\u0668\xe7\x8eF5)\xe6\xdc\xee\xb0;4Y\xf2d\u02c6\x9dn\u03ee);\xect\xfa\x9dJ\xd9~\x7f\xe8\u0595\xed\xf5\xddn\xb5\xec\u0236G\x9d:\x18\x86\u03a8_)\xdb\xe9\x0f\x875E\x1dg\xd4\xeb\xed\x80;\xec\u0515\xed\xf6\xdd\xc1\xa8R\xd6\xe9\xf5\x87\u077azG\x9dA\x15\xdca\xd7\xed\u0515\x1d\xba\xf6N\u0651\xeb\f\u077az{\xc3~ux\xfb\xbdN\xbf\xael\xbf\xd3\x19V\xeb\xed\xb8\xee\xc0\xa9\xab\xb7\xdb\x1d8U\x18\x1c\xb7W\aoo\xd0\xd9\x19\xden\xa7\u05e9\xcd8\xe8\x8c\x1c\xbb\n\u00e0\u04e9\x83a0\uae83j\u07c6=\xb7\xb6o\xee\xb0W-;\xe88\xbd\xbazG\xbdN\xb7\u06b5Q\xaf\u07eb+\xda\xef\xd9\xd5j\xbb=\u06e9\x03\xc1\xed\u063d*:\f\x86\xfdQ\x1d\xf6:\xfd\xfe\xa0:\fn\xaf\xef\xd6\r\x99;\xe8\xf6\xed\x1d\xd4\xe9\x0f\xea\xea\xed;\xc3Q\x15}\xbb\x8e;\xac\xeb\x9b3t{\xd5q\x18\xda\xee\xb0v\xc8\xdc^wg\x1cl\xbbv\u06ba\xfd\x9e]\x1d\x87^\xb7[Z\x99\x11\x19\fF\x03\x87\xb2\x88\xf4:n\xd7-r\x95\xf7\xbav\xaf\x8c\xd0my\xdc\x00=\u95b9]\xbb\xb4f\x00\xb4Ag\xf0\x84\xef\xd5q\x05\x96h\xa7?(U1\xec\x8d\xec~QE\xa77\xb0\xcb\xeb\x04\u0082%\xfe\x9dEU \xc6-X\xe39v\xa9\x92~\u07c5\xe4\xe9 \xa7\x18\xf5\aO\xabMDY\x12\x88t\xcbzN\xb7$O\x80\u051c\u00de\xf3\xc4Z\xaeEr\xb7e}g\xe0\x96\xbbe\xbb#\xfbiU\x80\xa1\u0116\xf5\xdd\u03b0<K\xae\u04f3\x9d'W\x91\tX\xa1\xb6mW\xba\xd2\x1d\xb9\xa3\xa7V\x12\xcd\xd1\xd8e\u02dcA\x7f\xe0T\xfa3\xect\x9e^\x0f\xac\xea\x8e[F\x16\xb7\xdby\xe2\xd4,\xe2\xe4\u051f]\xc1\x92\x18\x8d\xcap\x8c\x06\xfd\xa7\x8e\xab\x0eJ\xb5e\u00c1]\u0197\xd1\xc8\x1e\xf4\x9fZ\v\x8a\xb6`\x93\x1d\u0663\x9f\x8du\x9f\xc4]\xbae\xfdn\xb7\x8c.\x83\xde\xc8\xe9=\xad\x06#\xd2\u0556\xb9\x9da\xbf_\x06j8\x18>\x11\x96\xa5\xbf\xda2`\x1d\xca\xdd\x1a:\u00e7\x0eN\"\xe6\xeb\x99\x00\xca0\xaa\x8cp\xdfv\x86O\xac\x04\xd5\xdc[\xd6\x19V\xf0\xa5\u04f7\u0767V\x11/\x01\x8aQwXF~\u7a43\x9a\xc6I\x06;\x86[\x99\xe2\u0470\xef<q\r\xa6+\xd9\x0f\xa7\xdbu;?\x1bOP\xac\x05\xa8\xeb8v\xb9\xb6Q\xcf\xe9\xdb\x0f\xd2\u0717~&\xdaQ|\xb3e\ue80a\xf9\x83Ng\xf8H\x87\xb4\x00\u0522\xed\v\\\xcaNg\xd8\xd9Co\xe5N\x92W\xe7\xba#\u06edre}\xb7[\xb7\xb5\x0e\xecn_\nh\x11J8\n\xec\x8a^\xc7\x0f\x89/\x05\xc2WDkD\xf1Y\x82\\y\xb3\x99\xc1-\x16\x98\xc4^\x06b\xe3\xbe\xdd\xed<\b\anN\x00G\xc5\xf4\xed\x11 \xe4\xb6\xf4 \x18\xb2\x88\x02\xc4\xe98}\xfbA@pG\xfa|@p/z\x10\x0e,\xa1\xc0\x18\xda\xddG\xe6\x056\xb5\u03c7\x02\xb6\xb3\a\x81\x80\x02\n\x06\xb7;|x$pW\xfc\"\x182\x91<\x06E&\x12\x05\xc7\xc0\xe9\x0e\x1e\x86\x036\xd6/\x81Cm\xa9\x8f\x80\xa2J)h:\xae\xdbyxfp\x7f\xfe2p\x1e\x85D/\x97a\xaf\xf7\xf0\x98\xe0\xe6\f@\x00\xab\xd2\xef8\x94\x05UxX\u02ab\xba\xa2G@\u051b\xb6\tf\x00`\x069\x98A^h\x12\xefZ\x82\x8a\xcd\x06\xbeI\xe1\x9b4\xff&-\xbe\xf1\xe5\x18w{\x83\x87Qo\xa8\x88\xd2\xe7\r\xb1b\x17\x1e\x1c\xe5<\x7f\x97\x1c\xe8A\xaf\xdf}\x18\xf9\xba\xdd/X\x87\x06\x9f\xf0 4F9M\x19\x86\xee\xf0\xe1\xa9G\xb6\xe3\xf3AZ\xfa\xab\aAY\xfa+\x05B\x7fh\xbb\xbd\x87i$\xf0-\x9f\x0f\x82\xe4X\x1e\x84B\x16\u04733r\x1f]\x06\xdd^\x17\x00\xf9LLO\xc4J\xe4\xdb\xc6>4\xae\x01\x0e\xbe\u04a34\xea\xdb\xce\xc3\x13\x05\x8c\xd9\xe7\x8f\x12\xb2d\x0f\x0e\x12\x96\xd0T|X/~*\xc0\x00\xde\xee\v\xa0\x88\x97\x8f\x00\x11/E>\x14\x9d\xde\xc3\b\x03\xdc\xe1\x97\xc0\x90d\x8f\xc0\x90\xe4\x1b\xfb\xb0\xd3yx\x1c\x90\xc1\xfc\x02 V\x8f\xcf\xc7\u0298\x90\x81\xd3w\x1e\u018b\x8e\xdb\x1f}\x01\u03a6\x99\x9fd)8\v|.\xde\x16_\xea\ts\a\x83\x87\u025e\xd3\xe9t\x87_\x00$X\xc7|.x\xf0\x8d\xa6~\u076e[>Ru\u073e3\xaar\xdcr>G\x83\x8eM\xc7I\x1b\x02\x9f\x823\f^\xf0{\xd9l\xb0\xb8\xf3\u0f9d\xdfn)\xab\xd7\f\x1a\x1e>\xb1\xaa\xa5\xf8\x8a\xa1\x1a\xd7\u0405\x838\xd0v\x86\xf5,yg\x00b\x83\xfc\xb4\xb1\u02e4\u05dc\x18\xde\xc0I\xb0\u06eb\x9e\x04\xbb#\xd7}\xf8C\xa5%\xf6\xd34\xb8\x8c\xb6\xac\xd3+K)A\xc24\x1c\xeeH\x98\xd4g{\xb5\xa4\xb9i\x94\xd4Z\xe2C@\xeb\x81]95wG\x83\u045e\xcaY\xcc\x1f\xad\xbedo\x11\x88T64\xde}\xd1N\xaf\xfc%\xd86\xe2\x05\xc7\xe8\xd3\u0761S\x95\x91\xf4\xbb=\xfbs\xc11f>\xa9\u06ba\xcb\xd7;\x00\xdd\u05413\xe8\xd8\u00ca\xa8\xa4\xdb\xe9\xf7\x9f2{\xb9$\xcc\x1d\x96\x11\xbf\xdb\x1f\xb9\xddj\r\x9f1\xbe\xfbl_\xf2q\xdeW\xa0\xae\x83#wP\x11u\f\x87\u0761\xfb\x94\x0e\xeek&\u0772\x8e\xdd\x1d\x95\x17R\xa77t\xbb\x9f_\xab\xcc\xe2\x9f\xc2\xf1nT\x81\xd3\x19t\xed\xde\x13k4B8\x02\x8auG\x15\x19\x9a3x\x1apR\xce\u0531K\x92\xf5\x88\x8cl\xc7\x1e>\xe5\xfb\xb4\x02\xcah\u062d\xc8\x00\xdc~\xdfy\x12(Z\x94\x01F\xb4\x15\xfc\x1a\xf4]\xa4P\x15\t\t\x03\xc1\xcbp\x88T\xac\xdb\xeb\xdb\xf8\xc0\xe9\xd8.>\xe8v\xba\xa3\xa7\x91\xb5wI\xbc\fR\x94\u024c*\xc2\xd5A\xb7\xd7y\xf0[m\xe5\x95\xdb\x16\x82\xae\xc4\x1e\x95\x87\xb3\xebvz\xbd'Us)2\xb0\xba\xebw\xcak\x14\xa4;\x8f\x88\xa8\u0394\xf3\x99)\xcf\x04\x96\xb3\"n\x1b\r\xfbO\xacG\xb2\x90p\xcc\xecW\x84]\xddA\xdfyb%\xc5~\x0e\x18\u07e9\b\xde@+5|bM\xb0\xf5\xc2)\xa8;\xe8\xd4(\x06L\x9c\x90+\x93\xc1\xab^\x0f\u07f8}\a/\\g\xd0qe\x91\x91\u0761L*X\xf0\u03703\xeaJ<\x1a\u0272}g\xd0\xc7z\xfb\xa8\xbc\x80\xeaF#\aqm\xd0\xed\xa82\x1d\xa7\x83O\xfa\u0741\x83\x9f\x0f\xec~\x0f\xb1\xae?r\\D\xc8\xc1\xa8\xeb\u0226z\xf6\xc3TH\x92\x06\x90v\xb8\xee\xe0!\xc9 3T3\xd8\x01\xc3\x1e\xa9;(\xeb\x80\xda\vb\x05\x99H\xfc,\x86D\u02ec\xe3v\xec\xee#\x95\xf7\xba\x9d\xce\xc3K\xf6\x8f\xc2\xff\x84\u0300;\xe8\xd4*\xaeP\xd5\\U\\9\xf5zAT5\xef\xea<\xed:\x1e\x19U\u0355\xb2N\u05e9\xad\x17U\u0355\xb2\xddn\xb7[W\x16T\u0355\xa2\xbd\x81\u04eb\aw\u0629\x96\ud3fa\xb5\x92!T5\xef\fC\xb7V\x03\x8e\xaa\xe6j\xd9~\x7fP\xdb5P5\xd31\xa06\x10(\xc0h\xb7\xe7v$\x8d\xec\x8d\x14_\xe7\xb8x\xe1t\a=\xf9\xc4\xed\xda8\xc1\xddao\x88\xc8\xe9\x0e\\I>;\xa8D\x05\xfa\t\x01\n\xb0\u0320\u07d5\xb8\xdd\xef\xc8\x05\xe6\x0e\xfa#lb\xa8\xea\x90(?t\x1dW.\x8f~\x17/\x06\xc3\xee\x10\x97G\xd7\x05\x1bh\xa8b8t\xfb\x94\x95\x87\xcc.\u04d5|\x86A3^U\xd1\xf6\xedQ\xdd!\x005\xe3\u0572voP7\xbc\xa8\x19\xaf\x96\xed\xf4k\xb1\x175\u3572\xee\xa0\u05e9\xab\x175\xe3U\xec\x1d\u0675\xd2\x00\u050c\ufa09\xcbZ\xca\xfc\x80\x03\x9a\xf1*\xbc\u074e]\xdb7P\x8dW\u028e:\x83\xda\xd36\xaa\u01ab\xa8\u07ab_A\xa8\x1a\xaf\x8e\u00e8W\xabRF\xd58\xa0\xe4p4\xe8(\x04\x1c\u0608*\x1d\xdbV\xa82\xc4W\xe5\xd5k\x0f{\xfd\xda\u5c2b~\xef8=\xb7\x0e\x0fP}\xbe\xbbt\xecZ\x03\x16P\x9f\x03\x9c\xeep0Dt\xeevF\x03I\xce{\x83\x11\x12\xefn\xaf+\x01\xee\xf6\a\x926\x0e\xed\xbe$\xe7\x1d)\xed.\x0f\xf5\xa0W;\u04a0|\xaf\x8e^w8\xac5\x7fq\x86\xa3\x91\x92X\r\xba\x83\x9e\x94\x97\xf6G\xb8n\x02\x9e\xb4s7\xb1=\xa7Zp\xb9!\"\xcfc*\x94\xab^@|\"h\xcb\xd2y\x0f\xfc<7(\xda\xf7;\x83\xee\xc3\xc0\xb8]w\xd8\xfd\x8f\x03\xc6\xf0\u0210\xf08\u00de\xf30D\xf9\xf0$m\x95T\xec\x89\x10\x15f\xcc\xc5!\xbf\x1eJ\xeb\x85\x1f\xfd[v\x90\x8a\xec\xc0j)\x90\xfd\xf4\xc0?\xc8E\v\x12\xd8aw0\xf2\x04?\xb9\xafi\x93\u07a3\u0336\xfb\xc8dK1\x86\xffh\x0f\x94SiL2\xb6\v\xb1\x0f\x8eI\xb38I\xc4,\x03\x1f\xf3x\xe6\xe73<\xea\u06fd\xd1\xc308\xf6\xa8\xeb|\u0248>i\x8e\xa3\x035\xee\b\xcd\xc0\xe9\u0533\v\xa3ay\xa9\x18\xb1a\xe4\xec\xedZ\u0321pL:\xeaK\xa9\x8e(\xbco\xe4c2\xa4cu\xba\b\xd2\xd3\xdbLDip\x11\n\x02\x02\x9dz\xb7qa\xfaI\f\xb7t\xbb\xa5p\xa6t\x86\xfdGm\xc1\x87\xa3\xfe@\xc9{z\x92\xe7\xf3qp\xfbn\x87\xee\x19Cm\"\x9fq\x95\x86\x98E\xdc'\x19,\xb2\xaa\xb7\x00:\x95\x04'\xce$\x7fq\xeeL\x95\x95;\x8b([\xf3\xe0\xc45^\xba\xf9\u02f0\b\x98\xb0\x9eD^L\u0598\x9d\xe5$\x1d\xd3\xec<\x85D\x17\xb9\xc8P\xea?\x87\x9d\xc1c}\xed\xf4\x1d;\xb7\xbf\x90\x92\u00ae3\xeaRb\xa9g\x96\xd9\xe5xr>\u0545\xbd\x1a\xf7\xdeD\a\xab\xa9v\xba\xbe\xbb\x92Ptz\x8f:&\x198>\xec\x0f\xbbjF\x06\xc3a\xa7\vC\x9cOY\nO{`\x7f\xc8\xd6@\xe8\x06C\x97\xb2\xb0\xa0~\xb3|\x1e\xd9\n\x9d\x9c\xbap\xb2\\\xc0e\xa7;\xe8Sv\x95\xeb\xb4\xd9\\\x1b3=(}\f\x88\x80\xb9\x0e\u056c'\xbb\u04fd\xe4\u025e\u027e\xe6\xb9k\xfar|\xddl\x92%\x8f\u0252%\xf5\xd3O%\x1f\x7f\xc9\xee\xd8\x05\xbba\xa7\xec\x96}\xe4W\x80cg\x1cC\xe34>\u0280\x1d\x9c\u03db\xcd5\xf9H\xd1q\xe3\x92\u03e0\xcc\x1d\x8f&\xb0\xa6\xa0\x00\xb9\xa4\u079c\\\xd2\xf1\xe5\xc9\xd9\xf8\xac\u0562\xb7\xfcz\xb2$\xd9\xf9\u0654\x9dQ\x0f\x7fW\u439d\xb1[\xe5\xd0\x01\x15\x9drr\xc3\x17$c\x1f)mG\xe26+\xd5\xe9\x9dO\xc7\rr\xc1}r\xcan(m\xcf\xe3H\xe4u\xa7\xe4\x86-\xd9\xf9\x85\x94\f\xb0\xb3)\xf89y\xfa6oJ\xa7mS#\xc7\xcf\xd8\x1d(\xee\x9c\xfe\xa8\x96\xa5\xe9\xf6\xdc\u045eU\u0282\xbat\xd3\xf9\xa3\x8cE,\x903\x98\xb25,Y\xc0\x12\x9f\xac\xa5\xf3U\xc0B\x99P\xa8\u064c\x1a<\x92\xab{\x1c\x9e\xcc\xc6\xe0\x02@R\xbe>\x9f\xb5ZS\xda\xe0)\u0559\x84\xb7\xf98a\xc1Y\xab\x85e\xc5f3;\b\xa2\x835m6\xd7\u7ce9\x19\xf9\b\xdem6\xb6\xeavC4\x9b\x87\xce\xd6\xf4$\xb9\xd7\x02\x00/ \xe0\x18\xa6\u0508p\xe7\xc0\u0481\xb5[\xc7\x17\xc9\x15\x12K\xe3Kw$\a\xa63\xb0\xdd\xfe\xcebQ\u00c5\x8b\x05\x84\x7f\xd2?\xed|\x8a\x0e<0\x1a\xbb\x18\xefp.X\xc4]\xf8\x89y\a~f\xbc\v?+\u0787\x9f\x05\x1f\xc0\xcf\x15\xefa\xda\xce\\\x03\x98W5gKv\xcd.\v\xaa\x89\x18\xcd\x032\xa7\xec\x94\xfb\u41b2[\x9e\x90%\xbb\xa6\xec#O\xc9)\xa28\xfb\xc4/7\x9b5{\u01b3\xc9'2g\x1f\xa9\x17m6\v\xbc\xb6\xb5\x9b\xd0\xf8\xa3Bi\x18\xfc\xab\xcd\xe6\f\x06\xff\x14\xbc\xd4/\xf8-\xb9\u39c8\xe3\xec\x86\xc2\xe6\x1b,HF\x9f\x9d\x9fM\xf9E\xeemu\xa1S\xc6\t\x992\xee\xa0S$\x8b\xc6\xfb\x9e\xa7\xd1T\xde\xf7\xf5\xfd\x99\xbcw\xbd\x90<cw\u0281\xa2RYWW\xe6\xc8\xc2\x03]X\u0571\x82\x84\x8b\xf1f3\x9b\u033cge\\\xd0T\x17RB\xb3\xa5\xbf\xf2f\u0121L\x1a5x3\xe2R\x06\xca1oF:\x94\xa1\u05477#](\x10\u037d\x19\xe9\xc9+T\xfbz3\xd2\xd7_\xbe\x17\xb0wz32\x00\x84\xea\x0f\x9cn\xef1Z,U ,.\x96 \xf2\x94n\xb7\u04d3\xe8\xa5p*\xcd\xf7\x12\xb6\xces\xa6\xb1\x90\x9fOM\r4\xf8 4\xc2f\xd39:wJ/\x88\xc3\x0emzl\xb3\x15O\x89e\xbc\xb0\x80`\xcf6\x9b\xc6\xca$\u0313\nG3\xa3\xf9\x96\x14\xb2J\x88\x1bXt\x12\x97\xe3|\xb3\x0e\x80\x06\xa4\x10\xba\x12\xddxw\xf7\xaff\x13\x16>I\x99OLZN)e)\xc6\xfdKy\xd4J\xe98=\xe1\xf68=<\x04\xecB\x87\xb9\xac\xd9\xcc\xce\u04e9\x99\xfd3-\x96\xfd\xa1\xb3\xf5\xc2-\xeb\u067d\xfep/;%\xd7\xf2h\xa8\x89\\\xaf\xd3\x19\xf6\x94\xfb*\xba\x8c\x88\xd4\xda\u01d9\xa8&\xfd\x13\xdes6\x9bF\x99\x1d\x93\xa3p\x9e\x87\x1d\xcfL\xcfjpP;\x0f\xa65\xe9\xfd\x16q\xecA\xaat\xcc\xf2y.\xa6\xe4\xb9\f0\x05\x8cD\f|\x16\x98[8\xa3G\xb7\xf5\x1df\xb1\u0383\xf8|z\x9e\xc7Eo4\xa2f\xb3\u0705Hy#\x82\xc6,\xdbl\x8c7\x92\x8du\xb6\u0321\x12\xa6n\xbf;\x1a=vJ\x1a\x0e\xd5 +*\x19\x14\xb4\xb3D0\r\xbe\x9a\x85\x8f\xee6\t\v\xe9}\fn\x9d\xd0\xe8L\xf2\x86+\x1e\x90\x19`s\n?W\\L\x16\x87\x8eg\xb39\x17@\a\x1c\x19t\u03a5*\x97%\xa0\xf5\x15`\xd4\n|}W\xe7WSv\xd5\xe2s\x95\xb4\x15^\xb6\xf8\x9c\x89\xc9\u0571\xed-\x8e\xb9\x0eo\xb7&\xd6{4p\x80h\"\x10V\xe5\xee\x00\u00f8\x1d\xdc\x04\xd9\xd5A\x14\x1f\x04Q\x90\x05~(c\x90@\xe2.hNL\xaeN\xb8\xed-N\xae\xc6P-\x95\r7\x9b\x04\xb8\xa9\x90\xc9\xd6\u066c\xc8U\x16\x96\xc9U(\x16\x99\x17\xc2F\u0152\xe0\xf2\n\xaf%\xbf\xd7\x1b\x8c\xec\x87'\xa1n;\u03d9\xb5\xdc\xc6<\xa7*\xfe\xedx\xbf\xa6Mo1\t\x87\xd3\f\xeen\x19K\xe4>o\x84\x1fK<\fj\a\xb4fMf\x87!\xb3)L\x8c=\x0e\x8fg\xe3\xb0\xd5b\v\xd8S\u020a-\x988\x0f\x8b$\x86+\u036b,\xd8j\x8b\xa2\x9az\xcf\x06\u0707K\xa7\xa2\xf3\xa94\xa9\xa0`K\u07a9w\x8f\x81\xa1\x82!)B\x051\xbfv\x8d\xe4\x99(\xc1\x13=:*\x12\xa7G\xc7\xc3I\x80%\xbd\x94\b\xe6\x93\x04\x13\xa3\xad1~\x9f\xbc\x93\xd7\x19\u0772\xa0R\xb7\x1e;@\u07f8h\x03\x82\xad\xfb\xc7\xf1X\x16H\xb8\xcf\"\x0e)\xac\xc7\x10\xa5\x8e\x88\xf3\xe4\u0419\xb2\x88\x9e@\xec\x89\xf3\x04B\x1f`\x82\x95\xa4\xc1\xb9\xdfjA\xe49x\x1a\u56de\u0632\xb4&n\xa0n=\xce\xc3O\x00F\xe8\u02c0\xdb,\xc5H.\xf1f\x93\x1e\xfb\xd0V\xd0J\xa7<8\x8e\x9b\xcd\xf4\u061f$$;\x0f\xa6\f\x1c\x94\xe91\xb7'\xd9y\xd0jM\xbd\bOJ^p\x1c\x97\x9f\x14\x012\x8ci\xf2\xe1\x145\xea<v\xea\xee\xb9\xca*N\x9e2\x82\xe2(\x9e\xe6\x84\u06e0\xd4l\xadq\xf8\xa1\xa3\xc587\x0f\x10TE\xd64\x883#@B\xf09\xe7k\x90\x7f\x18\xae\xdb\xe0\a\x1d\xe0k \x8b\x9cs\x92q\u0603\xa8\xfc@\x9f'\x98\x91\xa4n-m4\xba\xf5\xbc6\f\x02}L{\x1f\x89\x1b\x82\xd1\x04\x89\xac\xd2\xf6\xa4\x12\x1f\xcec\xb5\xde>vO\x8d H\x85\xe9\xdeE\xcc|z\x0f~\x90z'\x9bd$!\x11\x04Fa\x11\xec\xc0^F\"\x1d\x06!\x03*+\x98\x85\xa4\xcfB+\x02\xd0\xc2\xedQm\xa8\x89)\xb4*,\xe6\xca\r[z\xcf\xda,\xe0\xf7p\xd6\xf1v7A8\xe1x\x8d\x86\xdfjm\xb7L>3K\u0158.w\x1c\x00\xae\xef|\xacR\xfe\x17\xbe6$`dg\xebr\xb7T\xf7K\xd0\xfb\xed\x03\u04a4F\xd6l6t\n\xf2\x863\x96T\xa1\u0515\xfb\xed\u062f\x85\xe5\xc1\xfeE\u060b\xed\x96\t\u26f0\xe8)\a\xf3\x19\xb7\xd7q\xf7\xd2<\x16\xf3\x84\xdco\x8b`\x02\xcc\ae\xa0\xa5\x88\xdf#L\v\n\xa6\u0610\x1d:R\x026\x1a<\xb2}\xbb\u00e1\x92\xb8*\xe9\"\x9ew\x00\xc2\xf2Z\xd4\xe0|\xf0/\xd5zTF\x0e!\xb7\x9ei\xf6\xce\xe2< \xbbs\x97\xb3\x7f[B\xcb\u0091\xc0\xdbY\xc5,b\xb9Yr\xbe\xdc\xc4\xc4\xfa>\x0f\xf2\xe2\xa95*&\xd6w\xeb\x10\x02\fTl\x98HT\x99pc1@\x84;cZ\xb6$\xe3k\x18\xb2\x94\xd2I\xe4\x85\x13\xa0\x02\x9e\xf5V\xcbKI\x82\x8c.\x85\x90+2\xb8\xab\x10tb\xf4\xd8K\x80w\x1b\xf6G\u077d3\n\x93\xa7\xbcma\xd0\xe58\x12\xe5B\xfd\xd3\xed\xccO\xe7R]<\xfb\x043q\xf4C\xf4C\xfa\xb5\x9f\x1d\x9c\xff\u055b~\xed\x9d\xff\xf5\x87h\xfa\xf5\x11\x86\xdfDO\u07d8>$&\rjR\xaa\xcb\xf0\x9f\xe3\xec\xf0pL\x05\x06\x98\xf1!\x1eRA\xc0\xc1\xaee4|\x94\xfbT\xe2\xa3\n\xdb'\xc9\u04b8V\x10\xac\xb7$\xc12\xee\xe7G\a \xbd2\xd6%\xa0[\x03\xf7%\xb6\xde\x11\x04\x8d\xd3\xe3\xf58m\xb5\x10\xe6\x88e\u0141\x04(3\v \xf6\xbd\xc8\xf9\xdc\x00V\x16(\xb7\x1f\x15V\x16\xa7}\xd5!\xbf\xe8\x10\xeeD\x83\xae\xc2\xfd\x8e\r\xfa\xc45W\xc7\xfb\x876\x1f\x16\xb1\x90\xcd\xd8j\xa7\x17l\xc1W\xf5\xe2,=\xfe\x81\x1a\x98|\xa3ip\xbe@\x8c[P&q]\x14b#\x12a\\\x95\t\t\xb9\xcdf<!\v\xb6\xda#\xffb\xc0\xc1\x94\x02f\xa1\x04\x94\u0348`a\xab\x85Q\x03$\x9b\xb3f\xf7\u0655\x9fy\u04562\xdd\x12\x89\xe4\x19\xa0\xe7\xba\xfd\xc7\xc6\x14\u0678=8Plv\xb2Z\u3b08\r\f{}\xe7\xd1\x06\xfa\xbd\xd1pH\xdb\ve\xa7\n\x9e\x83\xf2\xcc1\xe8\xb9Z\xa2)\xe75\x95\xa4L\xc9h\xd4\x14\x02\x0f\xde\x1d\f\x06\x8e\x94h\x8e\xba\u074e\xa3%\x9a\x83n_J4]\a\xf4\xbf\ud15ff\xbf\x13w(\xd9\xec\xf6\xbaR\xb2y\xd5NE\u0196\xfc\n\xccT2\x91\xbc*)\a\xee/E\xf6\xa2`5\xbc\xcav\xac\xa2\x05\u0378(\x85}O\xe8=\x8c\xfd\x15es\"\x98L\x01\x9fI\x99\x95\x17\xe3\xf9\f\x84\x0eI\x9a\xe5\xe2l\xbf\xb8N\x83\x9f\x84\a\xf1eW\x18\xc1\x05n\xb9-\u0465\x01\u044f\xd7$A\xc6[M\xac`\xcf\xce>\x9e~\xf7\xe1\xfd\xeb\xd33\x98\xe5-\x85\xf3\xd3\xcc\xf0C\xb8\xe6K8b]\xee\x1c\bp\nX\xcc|~Mp\xc1\x96s\xeb\x06\x93@\x8a%y\xe4\x11i+\xcf\x03~\xaf\xba\x81\xf2O\x10\xc5}\x12w^\xa6\xe2\xcdF\f\"[\x04\xf1:\xf5\x12e^\xcfpOU}\x93\xae\xfcs\xaf\xe1l\x19x\\$)\xc4\x7fQW<\xa0,\xc1\xf0\x18\xf0\t\u072d&>\xf6\xbf\xd5\xf2\x84\xba`\xd6+\xab\xc1e\xb2A\xe9L\x00\xc1\x8e\x03J\x99\u0632\xbb\u06883\x89\xec\x1eB,\xc3X\xcb*r\xb1H^\x0f\xca;\"\x9eHx\xc6\xd18\xe2\x11\x02##\x04}\x12w\xbc\b\xe4\x1cm\xc7\xf9\xde|\xc5\xee1\x1e\x88WG!\xf9\xb5\"\x03\xb9\x8f\x04\x86\xcd\xd5m\xd0HE8\x98\x03\xc1\x8c\xdaz\x04!\x95}~\u00cbK9:\x9a\x10\xe8\xc8\xc2\u746cz\xca4\xccc\u0544r\x87P_\xb0\xd5D\xe3\x94'\x03\x14\xe3\xf5VU\xb4\xbbkcPq\x16A'(K\xf8\x1d*\xeb\xf08.\v\xc4\\\xce\x17\xaa\xda4\x8cc\x05\x96\x02\xea<\xd1\xc0%f_}\x9cD\xecNLY\x8c6\x8fy\x87}\xca\"\xd5\x01\xc0z\xa2obx\x1e\xfa\xc5c\xbc\xf6\x01Y\"\xec\xcc\u1857\xa9\x8b\xad\xdeB\x92-\xabS\xd5\xe8)\xcaX\x94O\x12\xb0\x05OV\u068c3\x0eQ4\x10\xc5\x15\x80c\u0712\x13\xa2l\xff\x18\x9a#\xa2\u060d\x8eA\xfe\xa5\a`L3\x9e\xe5\xdd\u0772+?\xadQ#5\x1a*\xea3\x04\u06c0c\xea\x15\x8b&@\x94j&*/\x99k\xbe\x9aM\x05\u0156\xa5\xa5O\x8c\xa3\u02a5\xfcJ\xf2b\xb6'\u034d\xbd{\x7f>\xaf\x01G\x97\x16\xbc(/![\x810\xea\x8aY0\xf0\x16+C\x98\x7f\xad\xc6\x18g\a>\x9a!\\gY\x12G\x97^-u\xe2Y\xcb:x\xad\x0f%,\x96\xa4\xcc\xe7K\x92\xd01\x86\u3bc6\xb2UY\xe6$\xd1M\x98L\x85\xe0\t\x96f~&<d\xa2?\x81 83\x89.\x90\xcd\u04a9\xa3X\xbc\xbe\xb1x\xe1C\x19\xf2\u0687\xa5\xdblF\xc5lF\xc6\x12-b\xb1\xc8\xe6\u180f\xdf\xf0\b\xf4D\x12_\u0423 S\x8b\x94N,\xb0Z\x05\xcen\xa2\x14\xb5Ho\x98<Z9[OF}\xae\x94\xc0\u07e2\x8cz~.?U\xaf\xa7\xc5{\xa2\x01\xd2\xc4@}\xa0\xeed99\x18\xd1\xc4R\xa6\xd1V\xde4kD2*\x1f\x91\x87\xc9\xe1\xb0g?.\xd7\xech\u0554\xda\xd1\xfdbC\xbe\x14\x19\x18\xddA\xf4\x1c\x16\xe4\f'K\v\xa1\xc1:\xdf\xf3\xc3b\u03dfie-\xee\xf3#{\xd4\xeb\xc8}^m\xeaW|\x81\x9b\xfa\x9c/\x8aM\x9d-\xf9\f}\xfc\u0635\xba@!:\xbbT\x02(\xf4!\x01\xe5\xa0\xcd.\xea\x0e`\x02\x0e\xa7?\x89\b7ey\x89\x9a\xf9\x1b\xbae7\xbct`\rRmV\xce\u03e7[\xb6'a\xe2\x92\b]\xac>>\xf4\xb9\r\x92\xf2\fN\xbe\xe3\x9bb?\xe7{\x96\xffi\xbe\xfcQ\x99\xa3j\xc9\u039d\xe9~\xe2\x92\x7fSG \xe4\u0279\xa86\x9a\x80\x8c\x81g\x9e\xd9C\x19\xde\xee\\\xb0\frp\xef\xddD\xae\x89\xf9\x91\xd1\xdfb<2\xd9_\xb1\xcd\x05\xa9\xff\u021a\xcd\xcb\xf2\x87\x19s(k4\xfe\x01\x12\x9a'siI=\x97\x16\xd3\xfb5\x11lA\u0655\u0265\u037d;\x10r\xe2\f\xe7\xe4A\xb1_1D~\x8c\x19H\xed\x1e`\xbf\x16%\xf6k\xc9\xe7@\xb3\xae\xeb\xd9/\xbe\x94\xbc\x89OP\xed\u04b0u\xe7\x1b@a\xe3\xc9\x05I( 3\x81O\xbc\x18v\xd2\xf9\x94GLl\v\xa1\u0602\xdd\xef\x1d\xf8\xa5$_\xa8\x14O\v\u04d6\\0\x02\xa2`\xb3\xc5hrA2\xaa\x0eqDP/j6W$bY;\x98\xd3fS\xef\xeb\xe7p_\x83X?\xab\xd1+?\xad\xb6\b\xbb\x04tp\xef\xa6g4\x85-\xdd?\xd8\u00a5\u0230\x85\x89\ua01e\xdf\a\xf6\xc7\xeb<\xe9\xd0C\xfb\xe2u\x91>\x95\u029d\r=\xbc\xfb\xc3\xcec\x04r\xd0\x1f\x0e\a\xea\xe8-\xa57\x05\x81dA\xae\x19\x92:D\xd7vG\x92(\x1a\xc7\x1fI\x1fg\x85\xa0gU\xd0\u03c5$\x90vW\xdas\u41ed\xf9\x8e\xbd\u02f282]\x17\xa4\xf4\x92_#)\xbd\xe3\u05f5\xe7\xa3:\x9c\xbef\x17\xfc\x10\xf4a\xb9G0\xb1\xde\xf8+\x88 \xb6\xf3\x1c\u023f\x05\xaa\ue2c9\x95\x8a\xcc\xf2,\x7f>\xb7\xd8-\x8f!\xe1\xf0G~\xdbl\xde\x1ak\xe9\fdw\xc1\x82,\x9b\xcd\x19\xb9\x05\x11\xee\xcdf\xf3Q\xf7\xa1\xd9l\x04%\x85\x18\x01\x02}\x9b\x87\xbb!\xd2X\x83\xe0\xe9X\xc2\xfa\x89\x93k\x9e\x19\x1fa/.IH2\xf6\x89*\xa2 \xd8,\x0eUhI\x0f\xab\xcc\tB\x04\u7c48e\u79da d&A\xb8@\x82@\x8d\x1e<\x83C\x16\x1d\xcf\u0279\uca45\xe7\x06\x8bYreY,76b\xd6%f6\xb8\xf2S\x8bY2\xcb\x01\xf2\b,\u07cf\xf3-zZ\xdeA\xe4\xd2\xc0\x06\xd0\xee\x00\xbf\xe6\\\x8c\x1bD\x80\xf2\xea#\xddln\x9aM\xd56\x16I\xc9'f\x8a\x14r\xaa\x19\xf3g\x8ak+\x06a\xac\xe4\xac7\xcdfc\x05\xd2\x04\xe5qx)\x9b\xd1y&\xf2\xb8\x8bbJ\xe4\"\xb4Q\xb7\x94\xf3\xa7\x98\xea\xc6\xf3\xb72\xca\xe1\xcdfsE>\xe5,d5\xbfI=K\xb9\v\x9c\xe6.\xa5\xd5\xc15\x8f\xda\xe5\u0741dL\xb0\vvJ\x99\xdf\x16\x18\u054f\xe4\x10-\u0235\xccO\fl\xce\x19$\x9b\xb9f\t\xb9\xbf\f\xe3\v?\x04 \x16q2\x83\xf3\xab\xbdeg\bq\xd4\xce9X\xfc\xf6\x82\xb2kX\xfa\x9d\xeepTk\xbe\x8f\xcc\n\x1a\x979N\xa7/W;\xa4\xc8S\xab]\xae\xd0'h\xf1R\x1e\u00c6\xb1\xe6A{\xc1B\x88_\xc0f\xdc\x1e\u03ces!\xdbL\x87\xe2]\xf1\xf4|6\x1d'D\xb0\x15\xa40\x00V=\xc2K\xd8\xffV\f\x90}E\x91\xa5\xeb\x0f\x06\xf5\xb6\xf9JX\xbc\x04\xf9\xea^\x05\xbb\u013b\xa3\xf6\x11\n\u06ed\xa3\xf6\x91\x05s\x9fiqyT\x12\xd8f \x83o8l\xb7\x1c\xcao\xf5\x9e\x81\xba\x98\xfa\xa8!;J\xf3\x8aN__\x1e\bR\b\xeaE)\x1dX\xa1\xe2G\xb5y\xad3\x1a\x92\x11\x99\x98\xa4\xf8\x16\x90\x96u\x1c\xbb\xfb\xa8\x84\xab\xd3s\xba\x1d\xda\u05a7\x98\xbc\xe2\xaa\u012b\xe3\f\x87\n\v\x14\xc9FF\u0635\x017\xd6\xfb4'\xfbuE\xb9p\xaat\x86\x1a\xef\x0e\x03\x8fI\u00a4\xee\xc3'\xadF\xc8\"8\x01\xc01x\xa6\xd7\x02 \x10_3\x81\x11:l\xb7\x0e\xb5\xd5\xe6\x11\xe7Hlt\xca\x002\x99\xec\xf3\xfdm/\xf0\x89O\x1c\x06\x02JoO9\x90\xf3#\x03\x04\xc3?\x1c\x0e\xf6\x19+\x1b\x9b\xf8\xbd\x99\x1b\x898MA+\u9708\v\u03ca\\I\xa4\v\xf7*9\xd4\x16\xe3\xaew\xbb\x8f\xc6]\x1fv\x86\xa3\xee\xe3#P\xb7g\x06hy7\x0e\x80:\x8b\x89\x1c\x8a\x80\xf9\x98\x00\x89z\x02lO@\xc1\x84\x92\xce\xcf\xe0(\f1\xf8\xd0E\x03\xa5@\xc67t\x06\xb9<u\u0413\x1c\x05\xe2\xb26\x11\x1dI\x86b8\x84\bjlU\xa0\xe4\xa2`C\xae\x90.\f \x00\u013c0\xcaY\x168{\x9d#?\xbb\xe4A\xfb\xdd\xfb\xb7\xefN\u07f3;\x1e\xb4_\xbc\xfd\xee\xd5\xeb\xdf|\xff\xfe\xd9\xf3oO\xd9\x05\xbf\xaeY\x1c7\xfc\xba\xfd\xfc\xfb\xdf\xfc\xe6\xcf\x1f\u03de\xbdz\xf6\xfe\xf5\xc7\xd7\x1fN\xdf?\xfb\xf0\xf6\xfd\x19;\xe5\xf3\x92\xa6\U00096acd\xf1#\u03f7\xc63\x9eo\x8e\xec\xd3\u7bdd\x80\xcd\xd95{\x06'\x84\x88e,\x90\xc6*/\xd8[\xf6#{]5\x19\x17\x1c\xedL\xdf\xe83\xd7\x1b\xdc\x1fo\x9aM\xdcl\xdf\xe9\xc7\xef\xc0x\xa7b\x05w\xeb\xe1\xcfG\xf9s\xe6U\rfJB\xf6\xa8\x10\x05=\xa1\x1cH\xe0?T\xe4'\xdf\x01\xbd}g\x921\xf6\x9e\xbf;?\x9dn6\xef\u03ad\x7f\xff\xf7|P\xa7\x9b\u037c\xd9|w>\x9f\xb27\x1c\xba\xf2~\xb3y\rF\x89/\xb9\n\xd3\xc5y\xd6l\xbe\u04ec\xd5f\xf3\x1e:\xfd\xb2\xd9$/xH^*K$\xa4\x9b\x14(g5\xa4z\xb3\xf9\x02\x991\x10\x01n6!yA9\xe7\x17\x9b\r\x99Mf\xe4\x05\xbb\x00\xed\u014b\xf3\xd3)\x05\xa6\xe0\x05;e\x9f(e+\xf2\x82}\x80\xf4\xa1@\x92@z\xb8<\xff0\xe5\xf0\xe6\xb2\u065cs\xfe\xb1\xd9|\xdfl\xbe\xc7\xf4\x17\r\xbc%\r\xbf\u067c\x9b,\xc8;f\xc1S\vl%\xc9w }|S\x83\x151y/Eu\x18u\x19\xa4\xbeo\xb9\x94\x91\xa4\xdek\xf2\x11\x85\u0769w=y\xe3\xbd&\xb7\x94\xa9\xce{\xaf\xc9\x19\u0772g(\xf9\xfb\x11\xe6\xfc-\x05\xc6\xf4\xbb\u0366!\xef\u07c1\x9e\xf1\x8a\xbcc?\xb2\xb7\xe7?N\x95)qB\xee\x95x*c80\x06\x87\x01\x9fo\xd9\u06dc3\xf1\x9b\xcd\xc63\x98\xa5S8$\xbf\x81Qy\xc7N\xd9\x1bv\x0f\xdd\xf2\xe6[\u0296@\x18\u07f0\xb7\xb0a\xf6;\xddZ\x1a]\x84oP\x9c\x88\x9f;X\x1a\xecG{\xf1\xe0\x06\xaf\xbd:1\u80fc\x04\x03\xb9\xb1t\xe4@{\v&\xb4l\xc9\aZF\xc1\xe9\x80\xe1\x0e\xf1\x05\u06f8\x1a\x82A\x83\xd7\xfb7\xdco\x99\xb3O\xe48\xd8n)\x88@\xa84.\xeat\x9e\xec6\xa2\x83\x10\xa3m\xa1O\x9b\u0358\xf8\xe5P\xf2\x8f\xa8\xec\x83I\xa5<\x1c=\xef\x91\xcb\xea@\xb4\xd3\xf2\xbeu\xff\xe2\xec\xec\xfd:\x14\x10\x96\u0773\u064b\xb3\xb33\b\xe1\xfeR\xccB?A\xd7\x17\xf9\xf4\x0f0\xaa\xbaP\x18\x88({/f\x99z\xf0\xf2\xed\x9b\xf2\x9dTI\x17\xf7\x1f\xe2O\"\xc2[\x87\x81\xd8\xedC\xe2G\xe9B$\xaf3\xb1T\xa5^\x059\x10\xdf|x\xf3\xed\xb30|Q\x9c~\u4cdd\a\xaf\xe2d\xa9:\xa9\x9e\u0228\xf3\u01737b\x1e\xf8\xaa\xda7\xc1R\x80\xc5 \x12\x12\xcff\xdf\xf9K1\x87\xf0\xe5o\xfc\x15\xdc\xc6s\xa1@|\xe7\a\u043f\xbf\xafE\xaa;\xf5.\\_\x06Q~\xa1\xeb8\xfb\xc3o\xbeE\xb6W\x15;\xfb\xc3odn\xb0\xe2\xfe\x9d\x9f]\x9d\x89K\xe3A\x1cDYq[\x1a\xab\xb3?\xfcF\x8eM\x9c\xe8\x819\xc3\u0536\xd2\tG?\x82):\xbb\x12BW\x03)\x10>$\xfe\xec\u04cb\xb5\xa8>\xd2\xf7\xf1z\xa6\xa0D\xe3\xd0N\xad\xef\xae;\xec;\x10\xa5\x126\x8b\xa3E\x90\x88E|\xfb\xc3\x11\xf9a\u07a2GAi\xad4\x92f\xb3\x95\xa0\x98\xcf\xedt\xdc*^\xedx\x88\xc90\xde\xe0\xb2kw\xfb\xfbZ6\x1a8zs\xf6\xfat\xf3!\t\xe6\"\u028e\xa4\xa5B\x021@\xba\x03{\xdf\xe7\x868\u00ec)X\xf9\xf3M\xb0\xba\x8a#\xb1\tV\xf1\\\x878O\xa8>2\x82^\xae\xfdN\\\\\x84\x10\xd3\xd2\x1dtG\xfb\x9a0\xeb%\x13\xafZ5m\x7f\r\xc90\x84L\xadP4\x04>\xba\xb5\x95*\u02d8Z\xc0\xadU\x12C\xec{\x8b\xf3\x04uTxG!P\x9c\xdd\xed=\x05\xc2\x1bqa\xa7d\xd2h\x7f=\xbbJ\u2960&DP\xba6\xb4t\xbf\x127 \x0f\x05o1+\x0f\x06o\xd1\xcd\u01b2\xb6\f-\xa1\xab\u0560v7\xa7pA>?)\xf7u7\xc0>\xa3\xfdRD1\vy\xdal\xa6\xedk\x91\xa4A\x1c\xa5\x9b\u037a\xd9\\\xeb[6\xe3a\xb3\x19\xb6\xaf\x87\xe3\x19f\xba#\t\x9f\xe9\xe4\\m\x8b\x82\x11\x1a&\xb7>\xb7\xa7\xc7\u0749\xe3\xb5\b\\\"fR\xca\x1aq\xb3\x19\xc0~L\x12\x1eh\xbc>\x9d_\n\x8d\xd4`\xa8\aeO\xf8\xa0K\x9bM\xb3\xd8\v\x1c\xb2\xa2 6/+6\xf3\xa3\x80\xf3\xe2\xa86`zi1=\x03\xb4\xf8\xa3\xb8\xf8]\x90\xa9*\x7fh\x1f\xed[Q\x18\xbda\xefF\xfa0\xfdO\xceE\xcb\xca\x19Zk\x8a~h\xe0U[^\xa0\xe7\x96\xe90\x8a\xf2\x1e#\xb4\x8a\u016c 5\u03a2\x16\xb3V\xea\xd5\xeb\xf44?\xd6X\xcc\xca\xe2o\xe3\x99\x1f\n\x15\\\x82\xe5\x86]Z`\xf4vaMa\x8c\x06C\xfba\xf3\xfa\x9d\x13K\x8d\xa9\xbc\xe0:qznn\xd4 \x16\x9a:An\x01`\x03\xc8^oDY\x8c\xc5\xc4a\x03J\xd9\x00O\xd6\xf8\x10\xcd\xd3\x19\x9e`>\xc3\x11N\xf9a\xf8\x85\xbe)(\xce6i.`i/\xe4Ag\xe0\xf6\x94/\x9c\xe2\x87f\x85\x91\u026a8\xdf,\n.\u9a86\v2Ee*\x11-\xb0\x8e\xa5\xc4n\xf4^\xf1\xfbU}\xb2b\xffm\xcf`\u06c5t\x84qJ\xcfHD\xe5c\xb7\xf2\x98%t[}\xc2\xfc\xfcY\xacR\x95\x97\xd2H\xa9w\x06\x1b^:\nd\x8f\xe5\ue259\xf2W\x92\x8ew\\\xab\x13\xd9)\x17m)$c\xb7r*3\xf6Q\xd7\xcd\xce\xf8\xe9$\xf1n'\xc9\xf9\xcd\xd4#\xf0w\xb3\xb9\u07daR\xd1O\xfct\x12z!\xbeY\x91\x90\xdd0p\xb8\xb8\x99\xb2g\xfcSQ\f\xcd3\xe6\xe8HB#\xdeX\x93\xd3\xc9\u073bi\x91\u06c9\u0576<\xebW\x16m\u0359hK\x0e\x9a6\x9bg\xcd\u609c\xb19\x9cD?\xc1Q\x06\xd2|^r\u044e\xe2\x0f\b\xf7oD6!\x17<\xc5B\xb4\xd9Tnx\xde\xd9\xf9|\x8a'\xd9f\xf3rr\xe9e\xea[\xb5\x81^\xe7[\xe9r\xb3!w*\x14o\xb3\x19Mfd\xc9\x12\xea\x89\xf6M\xe2\xaf\xe0\xc1\x15YR\xefc\xb3\x19\x90%\x9d\xf8p\xb3d`\xd3s\xe5/7\x9be\xb3\xb9T\x97\xd7\xcd\xe65^R\xd0}\xdc1\v\xaee\n\xb4\x15\xf9\xc4\xe6\xec\x8e28\xcd,H\xc8b~c\x92\x15*G,\x86\x11c+\x12\x9e\xc7S\x98%\xa0\x8c\x89\xf0\xc3f\xf3\x19\x9c\x1c\xce\xe7S\xa8\xf9\x19\xbeB\xe3,\\\xef{e'\xa6\xa0\xae\xd1\x10\u0110\xcc\xe5n~hB\u05b7\xdd/\xe7\xe8\xeb\u0718\xf3\x83\"f\xc8Q/\xe2(%\xf7RL\xbce\xb8\xda\xeb\xf3\n\x80\xc5k\\\x13J\x99\xf9:-\x18p\xf3x8\x1d?\xc0\"\xa9\xc0E\xcdf)\xdd\x1d\x1cw&\x01N6\xf1i\xcdY#\x90\xe5H)w\x1b\x85\xa8\r\xc3Z\x97yC7nX]\xaan\x04<!\t6\xf6\xa8\xb59\x1a5\x18\x06\xec\xc2\xf3\xb5\xa7\xc3.\x90\"O\x12f\x00\x89\x8e\xff\xee\xb0\xf7\x05S)7\x03\xe3\xc1V\x8e\xd0n\x9a\u00c6\x19\x88\xb0\x92h\x8eXf\x18\x02tO\x1fv\xec\xe1g\xec\x01\x86\x7f\xa7i\xf1i\xf8\x1eHR\xbe\u038d\x1aq\aP\xa3=\xe3I[\xa3\r[I\xbfO\x19\xb8\x1a\xf6\x01\xbc\x85Tm\xb0\x13\xdco\xc1\xb0\xa0\"\u00d3\x9a\xce+\x96Q\xd3\xcf\xe6|\xcad\x02\xccl\x1c\xb7Z4\x01S5\xcb?\xb7Zq\u02daZ\xe3+8\xb0\u03c8\xf5\x82\xf9\x16\xb3\fb\xfe\x82X\xad\x05I\x98\xc5 \xb9\x0f\xb5r\xaa\x0e_\x10\x81q\xf1\x8a\t\t'3\x1c\xf2\x1a\xedha\x99k\xd0|\x96\xf0u\xb1!1\xdc\xf4+\x13\x1a\xf1\x15I\x98Q\xaapn\xaa\xeeq\xf1dN2\x96;\xa3D4\u03e6/\xe1\xd4k\x0333\x93\xb8\x00\x83G\x94\xc5\xe0\x14\x012\xca\xcf[\xce\xd5\x05\x9cLb\x89tq\x1d\u01ab\x95O\xe2\x12\xc63\x94\x82>,\xc7\xcee$\xbb \xc0\xfal6k\xa3\xe1\x151\xf60\xbe\x81\xaf$O\x80z)\xd8p\xc7K\x91]\xa9\x9c\x87\xa5u\x03\xc5$\x13N\x1a\xc9f\x93\xc0\xbe\x91\x7f]J@i\xae\xc7\xfb\xd3?\xbd>\xfbp\xe6\xa5L\nX\xbd53\u016b^\x88\u013e\xe3\x8e>o\x8c\x91d\u00a0\xe6\x14\x93\xa5\xd0e\x19W\x9e\x04,\xd8'\xd5/HM\xb3\x99\x12Q\x92\xe4\x9b\xef\xf6\x13PQ\xa1M\xf5\xf9i\fa\x96\xa19\u03dd\x1dj\x9d(\x04\x9dhs\xa7\xedcd\xb5\u02b3\x1d\xbb\x93\x80$\xe7b\x8a\".\xd0jR\x0fn\xe1\xcc#\xa6\xe7\x98U\x0eocy\vj@\x88\x9fP\xeb\xf03R\x12z\xa7\xebj\xb2\xa5\xa4\xe6A\xad;\xce\xfe\x80%*905\x18\xbf\x80b\x14\x18fJt\xe9f\xe3\x9f'DP<\x87@\x88\x87G\x82\xb8\xd4Z\xd0\x1b\x16Z*t\u03ba\b\x13\x11>)\xe2K%9h1\xb8k\"\xa8\x97\x81\x18\xd9/t\xca\a\x01\x89I\xc4\x04\xa5*\x16KH\xd2R,\x96 S' \xb9\xa6G\xb5.j\xd8\u07dd\bk\xae\xbb'q\u0630\xf3\x98'.(\xa1r\xc7B4\xe4\x8f&\x12\xad\xbc\x84D2\xcb\xf0\xb0\xc6\u00f6~%\x886\xb85rtnl6Knw\xc9n\x88\x1f\xc9h\x7f\xb8\n\xd2f\xb3\xb8\x86\xe33\xd9#\xeci6\xe5o}\x99T\x84\x8bf\x13\xfe\u05bf\x8f\u0697`\xe9xIK.\u0166\x1e\x85\xd0\xcdFS\x10b\x19o,J`+\a2\xfa0\xff\xa3<\x8c}\xe9!U\xe6\r\u0339P\x04W\x16\xd8l\xcaSSv\x95B\x8f;\b\xb4<\xacJZQ\xec6\xac\x17\xdcTE?u\x93\x9f\xb4U\x86?i\x02\x8ai\xfe\x9aM\xe2\xf0\x1d\xac\x9e\xa8\xb7\x80\xd9\xf9%\u02e8\xf4\xfe\xed\xf6;O\x12\xfbhI\xb4\xc5\xf2K%X\xb5Pz\u0535\x1f\xd9\xc6\xe4\xd1\x1ec\x13\x80\b\xbc\xcc\u0341\xbb\xdcg\x89\xdb}b\u0343k\x8b\u02a8B\xfb\xc5\xeem_\xea\xc8\xc1c\xfcaZc\xf0n\n\u0592\xafZ\ue446\u0265-)t2;\xe1\xd7\xc0\xdfH\x89\xf5\x93\x85\x87\xcc\x1di\t\xb1\x81\u01ec\u0672tdN\x8e\xc1l\x80\"\x81;\x95'w1\xccH\u04f1\u07471\u064ct\u05b1;\xb6\xe4\xe4\xf3\xfd5w\xfe\x1b\xc7\xe8\xc0\x00\x9e\xb0\x99\x94,\xd3\u0366\xfa\xa8V\xad\x00\xc0\x98\x82\xaf\xca7@\xdd\a\xb5Nk\x8a\x19\x8es\x11\xc7\xfe\x1d\x10=f\xad\x99\xbfNe\xaaH\xd0~\b\xa6\x1e0\xf0\x97[\xa7B\x92\xb9~}\xfa\x90B\tmr\xe6\xb0\x1c\x1f\xe2\xccs\x03\xb5P\x15\xe9K\xe9\fD\x9c\x94jh\xa77Tj\xe8\x11d\xb8\xa6\u029b\xa7/\xbdy\x1a\x0e[\xf2\x05\xa4\xb9\xc4,\xb2\xd7\xdc.\xf9\xbdH\x8b\u03e5\xd6M\xddKR\xf7\xfa\xa5g\xbd\xb5Z\u05ed\x16\xbbQ\xe6\u0228\xa7\xa1\xe0Qb\xd0\ri-db\xfb\x9d\xb2 *\xf1q\x00\x86\xb4}\x12<l/X&\xcf\x0f\xda\xc28\x02\x1b\xb6\xe8|9\xe5\x0e\x13$\xa2\x8aT@<\x81\xf6\xa2\xa8\xa8\xe4\xb0\x0f\xe5\xe0(\x81\x8a)Y~\x1c\x1f\xfbx\xaa\b\x16\x04\x0f\x16\x9c/\xe9}F\x12\x163\x87\xaaH\bZ\x12\xb9e\x85\xaeQ\xbb@\xa2E|\u0166\xe9\xbe\xcc\u0242b&\xf5f\xed\x05.d\xe5>\xe5\xed\xba\xdc\x06\x85\x8d\xa7\x95\xa2>\xb0\xd8=\xc4Dxd\xd7qqb\x9dY\x9e\xf5\u03a2-\xa1\xcdDA2\x81\u056d\x8c\xea^Y\xf8Z\xdb3[\xa7\xd6\xf8\x12\x16@n\f\xb2\x9c\xb6\xf5,n\x99aP^\x03\xe6\x9e6\x1av\xa9\x89\x86\xb3\u06c2F\x8b-\x8b\xa3W\x89\x10?\x89:\x9e\xf6\xaa\u065c\x83\x94\x05\x9c\xd4Uc`\xc0,(\x18\xab\x8c}\x98r\x18d4\xad\xac\x13\xd4\xcb\x00\x1bC\xdbQ\x1c\x96\xa2\x90\xebb\r\x85\xc5\u0099\x15\x82\u028a\x15\xbc\";\xb8.\xban\u07d5\xe6\x19j\xe9-\xb9\x9a\xff\x03?L\x84?\xbf\xd31/\x82\x9f \xb1\xf55O\v\x16\x8e]\xf2TG\u0145!\n6\x9b\x85tZ\x90;\xe1\x1dW\xb7\x9b\rQWh\x12\x7fI\xd9\x05_\x93;8\x17\x81\xd1'\\^\xf9)\x98y\xc2e*2:Nv\x1d\\o\xc8\x1d\x13f.\xe2k\xb2,\x8e\x9d\xed\x85?\xf3\xe7\x82\vv\n\xe5XFY\xb65O\xae\xc5<\\`E c\xdc2\xbf\xae\x80lI&\x83\u01de\xdc\xf2+\x14^g\u00a2\xe3\xf9\xf9-L\x14\xab\x01qE\x04\xbb}\x02\x883(\xf7\x10\x88\xb2\xa2\x898\xbf\x9dz{\xc1\x94\x85\xb6\x86\xb7\xfb=\x98)'h\n\x19\xa3\xed\xb5\xcfD\x84\xeb\xd7\xdbw\u0709\x81\xf7H\x88\x00\xf9\xe0\x96\xe56\xbc\u0783aZ\x14\xa7\x83\xeb\"$\x19\xecL\xe8\xdcKi\x1b\x160\xc8\xeb\xd5 \\\xcb0\x8d\u02d5\x9f\x81\xf8\xee \x113\x11\\\x8b\x84\x1dX-\u0472\x0e\x12\xf1\xf7u\x90\x88y\xe1\x94\x1cm\xa5\xa1\xe1p\xbf\x9d!\x8b\x8b\xe3\x10\xfa\xc8\x1b\xc6>\xc1\x13\xf3\x87\x94\x1c\xcd\x1bh\x95Jb\x19j\x82\xa3\xc5kp\xee\xcbHD\xc0\x8b\xd9\xf5Q\x91\x91\x031\x1a(e\xf4\xdclv\xdb\u02cdc\x12\x82\x15#7\xf0\x80\x94\xb5*#+\b$\u068f\xbb\xc3\xee\xc3\u0706\xc1\xda\x19^\xfe\xf2x\x99*f\x12\t\xc8P\x86\x90\x0f\xcb;\xd5\fDT\x18UJI:-Vh\xa30\xba\xd4\xd1_\x7fH\xbf&\x13o\x16\xfai\x9aw\x98\xfepq\u012exB\x16\x98N\x1cw^yIB\xa01\x95#j\xc3/9\x01\x186\xa0 \xba\x9e1\x01\x8e\x0f;\x12f\xb0\xfc\xbc~\xa4*\xa5^\xc1\r\bU*\u05b3\xf4.\x9a\xe5i\xfc\u042e\xca\xfa\x8d\x88$\xfeT\x9ec\u065a\x979\x00\x06\xa4\xf3\u0366\u0478\"\v\x06\xc7\xd4Zq\xf8\xf8Zgl0\x98\xb3\xc6\n\x8e\xe2;\x02\xd3q\xee\x04\xb4D\xd9\n\xddl\x1aK%\xfb\x96\xd7\xe6'\x02\xf6\rP\x90\n`[\xaf\xbd%\xe6\x03\xa9e\xfb\xe5>\xf0\x19k\"\x01\xbeN\xc5;\x82\xe3\x9f`\x96\xb6\x83\xb4d\xf8*P\x97=\xac0\xccy\u07a3_m~(V\xe6\x0f\xed\xa3\x9d@:\xf2\x04\xb5>\a\x96\xba8As\x88b\x165@\xc3L\x80\xccL\x12\x92Q\xaf\xd1\xc8\xc01\x89\a\xed\b\x12\xbb\xc3\x16U\xd7\x1d\x15vA\xd0<\xf3\xb9\xcf@#\xdd\x06\x8d\xe8\x8dH^\xf8\xa9\x80c(\x98J\xcf\xfd\f\xe2\x7f\xb0\x90\a\xed\xef\x9e}x\xfd\x87Sn}g\xb1\x19\x98'\xbe\xfd\xf6\u03ef^\x7f\xfb-\xb7\xdeY\xc6\xf8\x05@\x1dF]\xa76\xff4t\xfb\xc1\xb1\u078d\xcf;\x91\xf2\x1a.<E\"\xd00\xb3B\"\x80M\xe8\xdb\xf5q\xbc\r.^\x9d\x90\xfc\xcf3\xd0\x1e\xe7\xe1\xe00\x9aN\x8e\r$\xc3\xd8Et\xd2hd\x9e\xf5^\\\x9e\u07ae,\xcec\xc4w\xd0]\xf7\xfb\x8f\x04VFjS\xa1E2\x18\xb0\xf4^A\x12TD\x181\x80L\xeb\x0ec;\xac\xe4\xb6F\xc4\x1d\x13K\x9a\xa0\x15;\x8c\f\a\x14\x98a\x80\u052a\x85\xb0T\xf6\xe8\x91`\xcc\xf5aU\xeb%_EXU\x15\x9ek\x96wyU\x84R]\x142\xb2+\x1d\xe3\a\x83\xaa\x16\xac\u05b2\xb2N\xa4ov\x16\xafVb\u03a5*\xb7\x9d\x88t\x1df<\x03\xb2\xb8|d\x034=\xfc\xcbqRQf\x01\xae*\xec\x8c7H#\xdal\x1aQ\xbb\xf0Y\xa1\xecS\xf1\xf8\xf5YnvK\xd93\xe3\xf9w\x1fN\u07ff\xff\xfe\u0747\u04d7\x94\xbd\xc0@b\x1f){[\xb7:\x130|L\x98%\x97\xb0\u0144\x8cC\xb1$@&\xe9\x96\xfdX\xbb\xa4'H\xd2\u0673\xc9\v\x02~\x90L\x9c;S\xf6\x96z\xc6-\xa5\x1e\xbeV\x8f):\x06}\xa2\t\x17h]\x89\x9b\x05\xb9\xe4\v\x98x\u016c\xcc\xf7\xca\x0e\xe1\xdb5\xb9T\xda\x1b\xe9\x04\x1a\x12A\xc7\x17'w\xe3;\x15D\xf3\x86\xffH\xc4\xf9\x1d\u018e\x9a\x91kv\x93\v)o\u0186\xf6f\x891I\x13\x0e\xec\u06e5\x8c!w\xaa\x9c\xe4\xc7\rr\x8bqa\x13\x1d\x17V*Z\xa1\xe6[\xa9|6\xb6\x16\x1c7\x15\xbeI\xd0m]\xb8\xef\x1bt\u00a9\x00\xb3\xdd\x01Ff\x1b\xa8\r\x86\x9f\a~Q\b\xee\xe7B\xe9\xc7L\xd6Y:\x06\u0680\xdb9\x0ev\xc0}\xd8>\xd4\xea\xa5\xf2\xa4\xa5:\x00\x8aI\xcd7\x17\x9c`\xc0er\u02e2\xcf@\xfbX\xc0\xc5v\u03e7\xe0\xe7\xa7n\x82\xc2\x032\xa0\f\xcc\xe3\xd1\xd8\xfcA\x9da~\xcc2\xbc\xea\f\x1bx\xe5\x8dQ\xb2\x81W&\ueac2;]\x14\xe6\xf4W|U\xe2NA\x021>\x9fb\n\xa2f\x93X0\xe9V\x10\x11\x9f\xab\x87\x84\xd2\t\x89yHB\xe2\ufccc&\t\x8f\xa9\aR\x04\x1d%&\x01\x8d\u00ee\xd6\xf5>W\xaf%\xe7WSis-\xfdT\x80O\x80\xa8\x95\xde\x02\xab[\x93\x84R\x96\x12(F7\x9b\x19I\xd8\x15#{\xa4\xb9\xd4\x14.\xdd\xefX\xe6{\t\xab\xb7\xcb\xf7\xe6\xc0I\x03\xd7^#pE\xf2X\x9b\x95\xc2\xee\r\x1e3\xa4\":\xe0\x1e\x86\xad\x19\x0e\\\xe7\x97\x0ehdj&\x94\xce4\xe01\x89\xe4\x116\xc5K<\xc2bTA<\u0082\xba\x83\xa4,\xc2Lz\xd6z5\xf73)+\x9bdmyG\x00\xb9\xe1=\x13R/\x1aD\xa9H2T\x8c\x16\x9b\xf0\x1a\x8a\xb0\x902P\u0539\xf6\xe0\xf1\x909\x8fji\f\xcb'\xb5\xaa\xd7OW\u0370\x84\xa7j\bB\xee\x93D\x0e\xc1\f/q\bVx\x89C\xb0\u0611x\xd7\a,\x1aK)\x11l\u02cd\x80,h\x11\x8f\xf3Yv\x10\n?\xcd\x0e\xe2H\x1c\x00\x02_\xf8\xb3O5\xa7\u0198\xccX\xc2\x04\x9d\xe0A4\xc4k&k$\x11\xcfP>FV\xf0\x98E\x94R\x0fZ\xc1W\vRz\x83t\xa2\xdfw:{$0,ek\x15\xef)\x1f\xe8E\xc1\x14\\\x99\xc6d V\xe9\xba\xc0\x8d\xa9\x18B\xb0\xcb\x0f\xba#\xe9\xf9\x02\xf6\xb1\xe0\xf8\x12\x11\xb4\x12\u0178\xe3\x04\x8cOA:\xb2j\xbfYgh\xd5\xfd\xf6\"\x15\u0275H6\x9bU[Z%V\u07f0\x1b\xbe*\xac\xd1O\xf9*\xb7\u073c\xe5+\x9d\xe7\v\x03\xa0\xaf\x98\xf5\xf7\xb5X\x8b7\xc1,\x893?\x05\xd7\xda3p\x83\xf8(\xf7\x96\xf1\x19\u0630T\x95\xfd\x82ehYu\aa*\xf8i{\x1e/\xfd\x00\xb4\xf5\x80&AF\xe8\x18\x02\\f<n/\"\x16\xf3X\xeed@\xf53\xd3.HNi<\t\b\xf5|\x1d`Bl\xb7\xc55\xd6\x18e\"\x01n|\xb9\xd9\xdcm6\x97\x9bM\xe3b\xb3i\xdcL\x1a\xd7\xcd&z\xfb&\"\x8d\xc3k1!$\xe4\xf9\x9d\xe2R)-\xb9\xec\xddBTn\x12\xb6\xb3+\x11\xc1\n\n\u032e\xcdHB\xb7\u053b\x9b\x94\x9e\x9e\"\xf4\x1f\x82\xd9'x\xed\x919_\x909[U\xbe\x9d\xcbo\t\xeeFk~\xa3\xec\xf8\xc1\x94\x1b\xac\u04c9eI.\x06\xbd\xf3c9Od\xcd\xeegW~\xe2\xcf2\x91\xa0\x04\x12\x0em\xe5z\xd7\xf2\x00\x92\xf2F\x85\u031e\x95\x85\t\x92\xb5\xbd_D\x9e0\xe3'm\xc7F\u021c\x8c\xb2x\xb3!1\xcfX@(\xd0\x00\xcc\xc3:r\x1f\x88\fX\x9f!n\xe0vk\xbfQ\xb1\x95\xe3Z+\xa0\xc6C\x99\x00wtJr\xbf\x92os\u04e0F~r\x03G\x19Y\x1f\x11\xd40.\x91\x1f\xc0ky\xa5r#&\xcdfr\xdcuP*\xed\x0e\xbb\x9f\x11.:\xf7\x82\x8b\xf7(\xd7k\xad\x9b`\xaa\xbf\x7f\xff-\xb1.&>w\x9a\x17\xdcm\xcex\alu\xb3l\xe5\x1d\x1d\xf9\x96\x8c\x0f\x93\n?\x99]\xbd\xf3\x13\x7f\x99\xb2\x88[\xa6\xb3\xa5\x9f]\x81!\a\xb7f\xbfv\xed9\xe8P\x94\xa3w5r\x98\x8e\xa0G\xac\v\xc0\xb2\x16OZ\xb0\x9d\x83\xbfSC\xb4\xb3X\xa6[md\x98}w\xb3\xc9a8\u008a\x11@\x00\x0emj\xaf\x12\xb1\xd8l,\xbc\u00e9\"\xd6\f\xed\xc5}\xee\xc035\x01\xaa\x7fg\x06\xf8\u0102\x9a,\x10:4 x,|\x03_\xe4C\x01\u0366\xd0\xee\xbf_X\xb4\r\xd6\xe8\u043d\xcd\u01ba0\x8a\x95j\xacm\xc5\xe7\x17\x16\x95\x81\x19\xc0\x94x\xb3\xb1n\xa3\xc3C\xe1\xf8w\x17\xb3\x9d\xf6\xbc\xa3\xa3\xff\xfa\xdf\xff\x8f\xff\xcf\xff\xfa\xdf\xfd\xd7\xff\u07a2\xed\xab\x18\xa2\x87Y\xbf\xfa\xf5K\xfb\xd7\u03dd\xba\xc2\xfe\xaf\xfe\xc7\xff\x87\x85q%\xae\x00~g\x86\x03\x11A#u\xc5o-eE'\xeb\x96\xdaK\x10\xc7?9\xb7\x91\x92\xb2\x05E69\x03\xb5\x80Mm6\x8f\"?\v\xae\xc5\xc1,\x9e\v\xe5M\xe1\x93\x00\x9a\xea\x8fz\xee\xa3\xf1t\xd4.\x1f\xd7\xc5)\x1c\xe3)q%\x975\x97V\xc1\x15\xe4\n\x16\xc5y?\xdbl\xf2\xebH\xed\xc89\x8f@\xac\xe7\xfe\xfc@\x91\x88rJ\xa3q\x86Y\x0f\x12@J}.\x05\x1a-\x93G\xa8'\xb0\x969\x9aB\x14#\xd0^\xd4qw\x00g,E!\x0e\x84%\xaf\x93\xc3\xf6\x86\xf6\xe3Aw\x8d\x98\xa25\xd6%\x96\xe5eZ\xe42\xb0;\xdd\xc7D\x19 }yJ\x12\xa3\"iP\x9e\xb7\xe8\u00d58\x00\x1b\xafx~0\x8fE\ni\x97\xfc\xd9L\xac\xb2\x83D\\\xaeC )\xb7\xabD\xa4h\xd7Z\x89\xd6\xd9\xed>\x8e\x03%\xd5~\xae[5e\x15\xc5\xe1\x06\\\xfbT\x1c\xa5\xe1`\xd8\x1b\xc8\xc3M\xa7?\xe8\xdb\xf2x\xa3,/VEl\xf7\x05/e6fW\xf5v\x00l^2\x934i\xe8\xa2z\\\x01\x1de\xb3\t\xb1?\x16\xe4\xfe\xc2s\xb6lA\xae\xc0o\x0fM\bL\xef\xec\x9d8\x0fW\xd2\xe9\u057a\xc83\x18u\x98\xf9\x81\x83\xf9\x8c\xd8\xfd\x85\a1\x82\xdb\x17\x85RO\x9f\x91X\x06\x7f\xa2|\xf7a1\xb7\xfc\x8b\xd9\\,.\xaf\x82\x1f?\x85\xcb(^\xfd=I\xb3\x82Z\x9fGS>`\xb1\xf6n\xb1h\x1d\xad\xa6\xf7\x10\xef\x1f\x8fZ\xe0\xc1\xb0\x80\xee\bz\x1eM!\x98\x06\xdee\x94\xa2\xd5(\xd4\xd0\xe0q\xd9\xe4\xa0\x14U\x9c\xcfT\x14\xa0\n\u07b2\x80;l\xc1\xd7\xed\x05\xbb\x02]\xf28>\t\xc6T\x7f\xb6d\xd7|e\xa4`\x80\x10\xde\x18\xe7q\x02\x82\x8ek\xca\x16\xe4\x1a\u00c0^\x03\xf3y\xa9\xeb\xbc\xe0\xf6\xf8\xee\xe4bL\x97\xfc\xf2\xfc\xa2\u055aB\xd4\u0146O\xae\xd85[\xa2\xaa\a4\x95\xd7\xe7\u02e9\xa1\xb3\xf1\x16\x90%\xcf\u074dW_\x95\x1d\xf4F\xa3\x8eR\U00063dcbReJ\xed\xe3\x1aC\xb9w\xfb*\x9e\x97\xb4Fa\xb3BS\xb9\x02{\xd8\u05e7\x1f\u07fd\x7f\xfb\xe1-* J\xfa\x8a\xab:\x11\xeb\xb14\xb7<A\x85\xd3\xf1\x91\u0572\U00103ca5.\xbd\a\xc3\xfc \x13\xe4\nf\x04\u062fY\x18\xa7\x82\u0431N\x9f\xbe\xf2\x13\x11e\x7fDc)-\xa4\xd4H!\x03Dd[ST\xa7\xcc\xe4e\x8a\xae\x19\xd0\xf5?\u026f`OY\x86\x8b\x00\xed\u048a\xa0\x16\x9a\xdd^rk\x9dG=\xce\xed\xa25\x8f?\xd1\x17\x8a\x15o6\x93\tp\xa4\x1e!\x19\x0f\x89\x15,\x124\t\xa5\xed\x14\xfc\x0f\xdb\xf3 ]\x85\xfe\x1d\xb7\xa28\x12\x16[\x83\x15\xa5\x88\xe6/\xae\x82\x10COe\xed4\x99\xe9\xbd\xde\xfa\u047f\xf6\xe5\x00y\x16eDp\xcct\x91\x15\xfd\u05ad\xd3v\xbc\x12\x11\xa1\xcc\x18\xb6\x1c\xb2W\x8a(\x94\u0191\x89\xf6+\u0227\x94\xd0q\x81\u06816X\x88 J\xb1\n e\x88/\u03c3\xf3h:-\x14\"t;N\xcfW\u04f2\x1aEQ \u0246W\xed\xbe\xa4\xae\u04b0\xc6\x03\xdegB\x16\xa5\b\x17hy\x01\xf3\xb4`\xe6\v\x9c\xd4\b\xda\x13\u050b\xf8\x92\x94,\xe9#t_\x8e\xa4-\x19b\xf6\u00d6V\xc3\u03a83P\xc6?2\x02DEt\xac\xd2\u012cs\xca<\xce\xda\v\x8e6X\x93:*\v\xde\xe5\xe5\xce\x06D\x18\xa3+\x0f\xeb\xd0\xf8\x9a\xa0H\"\u05ab\x1c\x82\xc1`\x9e&\x1f\x83ID<\xc6LM,9\x8f\xa6\xa5-\a\x01}\x84\u00d1=D)\u00f0k\xcb^\xa9\xbe\x96\x84\fy\f\x8c\x92)(\x9b\xed\xd9AV\xfc1\x03\xea\x05\xb7\x84\xe1\xe4v\xc5-\xd3\f\xdabs^h\xabp(\xe3I0\xa91\xceOa\xfe39H)\x89(\xabS\xb96\x9b\x86\x17\x02\xc7\x00\aJ3\x16D\aQ\xb3\x89\xaeGQ\xb3\u0648\u06baQ\x1d\xc5m\xa5\"\xe76\x9bI\xfe\x0e\xd3;@\xac\x12\x15\xa61\xe2\xe5\x98F\x98G\x04\xc2A\x96\x9e&\xa5[s\xab[\xe8\xf2\u01b3\u01381\xe2\x97\xc0\xa6\xa8\xa6x\xa6Fa\xeb\u037c\xa7\f\x8cO\r\xa5g\xfeqA\xc2@.\vq\x9e\x00\x16\x15Y\n.\xa9\xb6\u03b5\x9e\xcd@6\x11'R\u011e\xaeW\xb0\x82\rQNiHK#\x84v2\f\xc5+\x0f/3C\xea\xa58\x99\xa0\x88\x9fSYf9B\xe662\xb3\x1c\x8d\x1f\xc5?\xb9:'\xab]c\"\xc1\u0351\x9b\u0452N\x1b\n\x95\x87\fC\x95\x1a6\xcd\r\xb0\xfe[H3\x16\x18\x02L9U\xab\xec7\xfc\x92\x8d\x1cS\xd24\xae\xbd\x90\xa6t29J\xca\x1f1\xff\xdd\u3bc0V^\x93\xfd\xaf\x88\xfc\x9aB^\xbbGxy\xf0o\x90\xbb\x882\x81\x98\u053b\x94\xa1iJU\x87~\x10\x100\xf7'\x82z\u0484WZf\x8cj\xbd\xd4{\xbd~nq\x89l\x86\xe2C\x89%\xe9\x9f\u014c\xb5,\xa9\xec\xfe\x1en6\xf5Rg\x16\xa3\x82\x16\xf8f\x04\x01\x00\xd8[\x93\x12}\x00\x0f\xff\u0201\xd1\b\xbda\xa6f(R\xd1)fH\xa6\xa2s\xfa\xa8\x91HK|\u046c0\x8a]\x99\xd1&\r.|=\x99U\xe2Xy\xf59\x1b\xc7*\akH\x8b\xa0\xa1\xe1T\x85j,\xa5\xc1*\x14\xac \x1e\xccL7 \xa0K9\x14^\xe9\xd5l\xb2\xc2\xc4\x0e\xa0}\x05\xeb\u0347\x855y\x04\x8e\x1c\xf5q\v\x85\x1c\xab085.\x83\x98\xb6\xd0<m\xa4\x04\xd3\\\x99c\xb1\xd9\x04\x93\xba\x00\xa8*\xa3\fi\x04\x9b\x8de$Y\xb5\x1a\x18B\x12\xde5R`\xe8\x05\xa5\xd2\x04x`\xef\x89a\xb2\x93\xd6\xe8~\xdb.\xb9nC\xbc\x00\xc0\xdaG,z\n\xe4P\xeb]\xd26\xc8H\xa8\xe3&\x96Y\xea$OR\xf8\xa8`_%~\xb2\xd1\xf0GF;\x87M%\xa1\x8d\x98\xa4,\xc2 '\t\xfe\xae\xc9\f\xb4\x142\xbd\x84\x96\xed\x87c\x8a\xefyv\x1e\xc2Y\xa3\xd9$\xff\b\xb0 \x84\x92\x83\u07dc\xb3\x98\xe1q\xbe\x1c\xadj\xef\xda\xdd5\xf0\aU\xd9\u00eb\x12\t\x7f\xbe*w\xce\xc9`e[k\xfb\u0352G\x99\x8e\x18\x192\x95M\xed\xde\xf1\\\u021b\xa6\xb8\x8b\xdd%\x94T\xc2n7\x1a\x18w\xbb\u0617\xb7^\x04\xb1\x92G\x8f\u03bc\xa1\x80\x85\x94\xd15\u00d2\x96\xd6\xf3fC\xac\x8f\x1fq\xd5}\xfch\x05\xd1\xfdv\xb2+\xda\a\x93h49\x06\xeaK\x04O\xc8#\xdd'U\x85$3\x1aAM\a\xa5$b\xe7S\xd8\xfd\"s\xa1\xe3\xfa\xd9\u034cS\x8e)\x99\xebs\"\u033aEY6\x91\xde\xec^\xd4\xce\xdb\xe1\t\xe8i\b\u0353\xe3\xb2\xe1p\xe8<\xea\xe6\x90\xcbB\x94\xd4#(\x16\x11\xaa\xf0\x14\xb7\xd0^H=\x9e^6\x8f%\xad3\xd3\x7f\xc52=\"h\xc6b \u00e1\xe6\xb7W\xdcf\vXU\xb3\x93\u0558F<<_\xe9\xa3uJb\xb5D\x16LL\xce#\x16\u00c9\u01cbMN|Q\xce\x19\xa7#Ka\xaa8\xa6\"P\x85J\x97?\xea\x95u\xa9\xf5\x82B\x99\xff'\xd6\xe6\x80%g=#\v\u046e\xf3\x86u.\u0648\x03\xab\xa5\x92B\x82\xb7*,\xb9\xd1\xd0q\x9e\xec5flm\xb9\xad\xffg(!1QCn6\x0e\xbc\xb8O\".r\xb8\xa5*1\xe1\xca;Lo]\x89\xf2\x1f\u38ad\x82^<TP7\xd0\xf8\x9c\x06$\xa7\x9b\xea\x8c\xea\xb38\xba\x16I\xa62\x82\x1fd\xf1\xc1*\t\x96\x01\nyu\x1eA\bU\xe8t\xfa{\xbc}v\xb0W\xfa=\x04\x85\u032et\xc8\xda#uKL#\xce\xf8&\xfa\x1d\x84\u0123uz%8\x11\xe2\x8e\xc6\xe0t\xbe\xc8O\u03935\xc9\x18\x94\xa3\x98\x01\r\xfd+kT\xf9]\u06f6\xdd'E\x0e\xb8G\x8f'\xaf\xe1\xa8P\x8a\x82\xd0m\x95\xeb\xd3el]\x06\u0343{\xfd=\x8cB\x91)\xcd`\x14Pr\xfe`\xf2\xa4\x04\xe5k\xa8..'\xf64R\x91f::5F,S\xa3Bl\x16i\x017\x85\x95\x1fi\x01;\xfa\xccwF\xd2\u0621\x12\x12D\xd9o]\t\x7f\xae\xc4Fp\x9b\xf9A\x88\xb7\u06f1\x19\x82c'\xa6\xb4\xac\xec>\xc8\xc4R\xeb\xff\xd4W\xba\xd2I^\x9f\xd2\x05zE{\x99\xd1X\xb6\xad\x8aS\xa5P4/\r\x8b\xa0\x18\x80\xbc\x0e\x81\xd5\x1a\x15I[\xd1r/(\x13m\x80\xb1\x14\x8a=\x03~\xb9\xe7\xd6\xce\x1d\x1a\xd2<!\xb4m\xacB|\x80\xa1\xda:J\xfd\x05h\x8e\xcf\xe3\xe9\x04\xfe\xf0\xec<\x9e\xa2A{\xcc\xe0\u04b0\xa5\x80)\xc1Fj\x1a\x7f\xd0\xe1\x89E,\xa6\xf7q\xb3\x19\x1b\xdb\xf6D\x1eM\xbdDU\x86\xa6'\xee\x03\u02a0\xa7(\nT\xd2'uZNr\x1a\u21e1V\x19\xc4\x11\x98\u0397:\xd5\x1bt\xec\xd1\x13\xe2\x9c\x1e\b\x8ej\x1d\u0440\xe9\xca\x1a\xa8\x11\xee\x8e\x1e&\u06b5\xa2\x99\xbdk\t\x16wL\x92\"\xbe_\u01aa\x81\x9a\v1\x04\xa4\x92\xc8#\xff\x82\x934\u03f6FV~L\xd6\xf4h~(w7\xa8\xacR\xe8\x06\xc5\xfe\x0f>\x80\x8f\xe6\xff\xd5\xfc\x1a\x8a\x02\xe3\xf6b\x1c4\x9b\x90-\x10\xf2A6\x9b\x11\xc9X\xfa\u0530\xd3h(\x85A\r1.j\xdd\xf8\x16\xfbo\xee\xd4\xe6\xe7\x88\u0202\x82\xd5G\xe2\xde\u02d3\x8f\xd7\xe6\xe5{0\xca/\xaea\x15\xeb7\x9a\b\u03c8\xe93\x06\xf6|\x8d\xee\xe03\xb6\xde\ud75a\xc3-ea\xb3\t\xbe\xa0>\x99\x99\x91\xa3\xa4\xff=\x9eG\xeb6\xaf\xe1 \u07fe\xa43\x1ezk`0\xd6\xc7\xf2\x19\x9e\x8b)\xf8;\x02R\xe8\xb3>:.=\xcc_\x002C+\xd6\u01cf\xb38\x11\x87?\xa6\x1f\xd3+?\x11\xf3\x8f\x1f-\x16\xf0\xe4\xdc\a/|\u20f7K\u0648\x1ba\xad\x97\xafh\x06\x1c\u06e7c\xf2\xc8\"\x8b%\xe8\xf0S\xa4u\xcb&\x99\a\x1e6\x94X:~\x9a\x05<\xb2\u030fq\xaf\x9eyV\xa7\xed\xda\xed\x8e\u0156\xf1\\x\xc9\xc4Z\xad\x13ay\x96\xf4\xe3\xb6\xd8,^\xdd\xc9$\xc1\xd6\x7f\xf9\xbf\x1d\xb8\xb6\xd3=tm\xd7=x)\xa2 =x\xb7N\xaf>\xf9\x89\xb8> ?\x85q\x90\u0133O\xeddM-\x06\x9e\x85Q*\xbc\\+\x7f\x19dW\xeb\x8b\xf6,^\x1e\xe9\x92Gj\xc0\x8e.\xc2\xf8\xe2\xe8Z\x02r\xf4\xed\xeb\x17\xa7\u07dd\x9dZ,E\xe7\xd1'\xd5`\x81'\xd1\xc0\xee\x0e\a\x8f\xec\u0403n\xd98\xfd\xe1\x05Z0\x7f\x01.\xd2:\xc9C.\x9b\x0f6\x1bIKI\xc4\x13\x12P4\\\u03fcX:\xdf\xf7\xbb}\xd7~\xf8\xb4\xa5\xb2\xb4\xfb\xb9z\x19\x97$Rx\xe4T\xc1\xd1\x18\xccy\x9ee\xf2\x8c\xadn_\xc4s\xf1L\x19\xff\xe5\xa9;\xd9\xcc\xe8Fm\xd6km\x8a\x8dy\x041\u0547\x8c+\x83\x11et\xe6\xe4\xfc,plo6\x8b\x13~5\x11\xa0\xb6\x96}\xf6\u040cs\xc5\x16\x94\x1e\xf7z\uea3f\xd9$'\xbd~\xc7\x19m6\x8b\x96\xc39\xbf\x82\u8e72L\u02c1R\xfd\x8eko6\xb3\x93\u07a0\xd3\xedL\xc4$\xc5\u03fd\xc4\x13\x93\x10.\u0662\xe5Rov\x88\x05[$9\xc4z\x8f\x8f\x1d\x9b\xb6\xfa\xbd^\xa7_>\x93\u0330\xeb\xde\f3Y\u02e1\x81\x1bLe=\xe8\xb8#\xe7K\xa2\xf5\xb8Nw\xd0\x1dv\xfa\xdd\x01\v\xf8\xd1\xf9_\x7f\xb0\x0f\x7fX\xdb\xf6\xe0tz\xc4R~t\xde\xfea\u0771m\xf7\x87\xf5\xabW\xf6)\xfc\xed;\u04e3K\xb6\xe6\xd6\xdbk\x91,\xc2\xf8\xc6;\b\xa2\xd5:;\x88\x84\x98\xa7\a7\xc1\\$\aA\x94\x89K\x91\xa4\x92\x1b\x97\xb1\x1bQ'\xf1\u078f.s\xa5DLR\u5fb42\xf3J/\x94\xaa\f\xb3\u047ePs\u03ae\xa4\ufe49\x03s30\xd0\xd2<P^\xcb\xc2yn\xd0K\xd3q\x9d\xdd\xc9;\xc3=\x85\xee\xc99\xd4r\xdd\u05a0\xf75\x11\xc7n\xbf\x9cg\xa8\x94C\xc6F9\x8d\xe0\xd1dE\xc4\xd1\xc0\xb6\xa9'NN\x1c&Z\xa0\x988\x82c\xc2I\xb7\xd7\x1bS\x81\xf7\x80\xf7I\x8bw\xfay\xean\x92\xb4:\xfd\xaf\xc5\x11\x11\xad\u0390\xd2r\xce\"#7~\xd9\xff&O\x9f\x06^d\x11\xb7Y\x92g\xc6\x1eG\xc7\xc9X'\x90\xb8\"\x82E\xad\x96\x144\x9ep\u0132f3>\u623e\xcdft\xacrM\xf8EQ\xc4I\xceI\xbf\xdbs\u0726O'K\x921B\x1c\xdb\xed4c*qT\xde\xf9\nY\xa9G\xa0LLYtx\xa8\xb2?\xc8\a\x05\xcf\x01|}M\x1a\xef\x80;\xee\x10s\x95\xce\xf8\xc0UY\x00\xedqt\\t\xa7\u0562$\xe1`\x19@\x8f\x1dw\xd8lB\xd5\v\x92PY\xe1u\x91\x99\xfb\x92_K\xfd\x9c,c\x1dZt|\x899\u00a5\u03ec\xffX\xfd'\x90\xfe49\x06s\xcc;\x9e\xc8\xfaO\xf9e\v\x93\xe0\xdf\x1d\x06'+B\xfc\u00d4\x1e\x9d\xd2\\\ubc96\xa2\xba\xb4\u0161\x04\xfd\xfa\x14\xb29\xb2\x9aF\x80Y\xc8;\x124\x9b\xadVz\xe2\x9b\xd5\xc0a\x89\xf3\xa0\x98\xdd[\x9e\xb2\x8f\x80,\xaa\ag\xfc\xe31\x9fM\x1c\xef\xe3\t\x9f\xb5\xdc\xfe\xc4\xed{\x1f\x0fg\xf0\xe1\xed\xf1\x19E\xc7\xf3\xb1\u032dr{x\u019e\xf1N\xff\xf0l,\x87\ub09c\xb5>\xfd\xfa\x19\xa5\x14lW\u0267\xa3g\x94}\x04L\xdc\xea\xf7\xb7\xf0n\xc6oH\xcaN\xd9%\xe7\xd7\x14\xe7\xe5\x12RK\xa7\xad\x16\vZ-=\x9ds\x18]\x8bn\x1fI\u07aa\xc2p]\x92kr\x87\xf9\x80\xd19\f\xff\xe0\x98e\xdc\x1eg\xc7Zo:\xceZ-\n\xca\xd2l\u0296$a3\x12\xb0\x88N\xd0*\xcdj\x9d\x92\x88z\u0141cN\x12\xac\a\x8e\x82}g\xf09\xe1\xca\x1e\xdb~\fJ\xf5 7\x8b\x9b\t\xc8h(\x1a\x1c\xb2\x04\xb9)\x9cE\xd8E\x12\u039d#\x9b\xe6\"\x8b?&qty\x10ad\xe4\x83xq\x90\x88\x95\u0202L\x19\"\xa1\xb479\xb1\xc7$999\xe1\x0e\xe6Ho\xf1\x8cR\xa7\x89\xc9\x16\xe1\xda\xf0\xe3Eo\xaa:\xab~\x99a@G\xfc\xaf8\xaa\x0e:\xdd\xe1c.\x8au\x11I\x1a\r\xe0\x16!\u058c\xf5\xaf\x7f\xfe\x1f\xfe\xcb\xff\xea\x7f\xfa\x1f\xfeO \xb8)n\xd4[\xe0b\xcf\xc5TG\x81\xc7L\x1d[6\xe8\x0e\x1f\vB\xa3F\xbf2'\x12\\\xcd\x12\xe4$}\u036ds\xab\x15\x80h\x8c\x85\\z\xcb\x11\xeb\xafVk\xddZ\xb7\xac\xafQG\xa3\x9e\xca'_Y\xb0\xcf<\xc5\xed\x1ad\x8b$+d\xe9N\x13\x0e\xdf\x11OI\xc4B\x86\xa6+\xae\xf1h&\x1fE\xe5\xdd:\xcd\xfc$\xf3V\u0121LDsoE\\\u02b2$Xz+\xd2\xc1\xb3\xac[\xe3\u0528m\xe0\xcb\xc1\aTx\u05700\x83\xaf$\x9b2\x82\x0f\xa8y\xbe*\f}\xe6E\xc0\xbdea\xf3s]\x18\xcb_\xe6v\xf1w<\x05\xf9\xf2\xeb\xe5\x12\xa2\x85g\x82]\xf0\xb4\x8dI\x8a\x8aG7<\xcd\xed\xdfOy\xda~\x19\xa4+8b\xb2[\x9e\x16!\xfc>\xf2\xb4\xfdF\xa4\xa9\x7f)^\\\xf9Q$Bv\xc6\u04f6\xdc\xd8\xd9'n\xb3g`\x14\xf6\x82[q\x84a\x10\xd0\xf9\x7fv\x05\x8b\u0392\x0e\xca<m\x87\xf1\f-\xf1+\x16<o\xab\xa7z\b\xac\xa9s\x8fe\xfc\x19\xe4yPv.p\xcd2\x10{\xd5;\xba\x19H\xfeV\xda&\x96\xb3K\xbc%\x02M\xc6)\xe4r0_\xa4\xedU\x9cf\xaa\x8b\xe4\x8c\b\xca\x12y\xec\x9b\xc5a\xcb::\xb2Z\tZ\xb1\xd2\xed\xf8\xae\u067c\xc0H\xa5\xbb\xe4c^\n\x04\xa8\x11\xee\xd9y\xab\xf5\xa9\x94\x1f\x7fMf2v\xdam\x11k\x92et\xcbb\xf2\x89\xb2O\xdb\n\xffb\xf4~\xcb.'ek\u055b\xc2\x04\xffG<\xf9y\xa7\xcd\xe6i;\x8ao*\x05\xf1\x99.\xf3\xb1\xd9l\\OH\xc0\x89\x8ff<\x1fi\x1b0\xdde>\xfe:\xed8Z\xca\xd1\xe0\xaf\x198X\x05\xe6\b\xb1\x00\f\xe1\xda\xfe|~z-d\xd0w\x11\x89\x04\\\xe7J#\x89\x91;\xda\xc1\x12\xaa<C\x85H\x8a\xe6\xe6\x16\x98ry@n\x8aQn6\x1b\v\xf2\x01\u0779>\xb0\u077a!\b\r\xd6i\xb1\u05ec\xe1P\xea\xc5\xfc\x05H\xb6\x96D\x19\xa8Ye\xf5\xf8U\xc9n\xcb(E\u06fbHjN\u0395\xcaB*?\x94\xae;\x12\x9bJb\xc5Td\x1f\x82\xa5\x88\xd7\x19\x8e)\xb3\xcba\x84\xe0\xbdw\xc7d\x1e\xe3\v\xb4w\xea:\xf5\x01\xaap\xeb\x8a%\u03fc\xf4o\x99\xaf.\x83\xe8\xb1\xc8Y\x85`\xf5 :\xb6'1\x89Z\x19\xb3A\u1bcc\xacP]S'\xbf\x93\xb6\xa9\x05\x99~\xcce,\x17*\x00\xb4\x86\x8c\x16!\x9d\x89 d\x91\xc1\xf4?\xb0\xc7&\xbc\x95\xbb\xeb'\rp\u0143\xf3g2\x81\x13\u0649=\x89\xbc\x8c\x92\x04)*\xb8\xb2=>^A\xf40\xe8\xe2\x04\xc6\x05F\x8a\x8dl{\xe0\x8cFn\xaf;\xe8\u06a3\x91C1e\x00\x1a\n<\"&\xd1;Y\x8d\xabum\xa0\x10=Z\xdd\xfe\xa8\xd3{\xb2\x8aG\x89\u096dg\xbf\xaf3V\u0250\x86\x18j\x1b4F\xca\xf8F\xca\xecf%\u00f0\x15\x983f\xf1;\xad0\xb1\x1e\x14\xe2c\xec\a0-\xce#@\x1c\x88qa\x06'\xd8J'\xa6\xce\xcd\u0725\xe6\x88d\u071a\x8b\x85\xbf\x0e3\ve\x7f(\xc0\xcc(k\xf8$\xc2\x1a\x8bx\x83\x91\xd2\xef\xcc>G\xbfS\x95N\xa86%\x9feQ\xb6&2\x7f$Ck\xa4:7H\x18w\xad\x12\x87\x91|L\x84\u0274\xea\xca\xf0\x90\xcb@\x02\x92\xb5,\v#O\x0ek\xa2\a\x80\xc3\xe8\xf9\x1e\x11\xe3\x94[?Y\x06=\xc8\u0540?MA\x01'K\x02\xa63d\x8e\x1e1q\xc1\b$\x80\x7f\xf2\xbb\xfd\x12q\xed\xbd\xcfU\xa4\x81\x1d?\x84\x17~\x14\xc5\xc54\xf8\xca)H\x8e=L\x87\x7fP\x1d\n_n\xa5\u89ff_\\\xff d\x86\xadRRc\xab\xa4\x83hmQ\xe5\xd0u\x86\x0fq\x96\xb6&\x90\x89\x1f\xcd\xe3%\x91!\u1736\x11\f\xee\xc1\x18\x12\xb2\xc3\xc4j\x11\xc3\xc5\xc1\xb2<pe\xa7\x1f\xadV@Z\xad\xb8\xe5\xb3N\x1f5\x8dn}\x8c:\xf4\xf22[\x02\xd5t\u026dj'\xe0\x82z\xab\x1d\xa5\x00}G\x9d\xc1S\x82\r\x96\x9b\xa9\x8d/\xd8u\xf7\x05\x184\vo\xa9i\u0225\x95\x0e]\xb7l\xecXI\xcb\xe7\f;\xdd\xe1\x97\xeai\xb4\x81\xdfqVhi\xbe\x8b\xb3\x03\x11\xc5\xeb\u02eb\"*l\xd9q\x03s(\xed\rh$\xed\b\x01U\x86\xce#+G\xc9\xd4\xfd\x82\xad\x0e\n\xf1z\x9a\u03e3$\xaf\xae\n\xea\x13\x13\xeb\xe6S\xaa\xec\xc2\u4b01]X\xb39\x03\xcf\x05\xf0 \x98\xcc<\xbc\xbd\t\xb2\xabx\x9d\x9d\x89,\x03\x9f\xd3`\xff\xc2l\xf8$\xc40Z\r\x8cZ\xac\x15\xe5\n5B\b\x7f\xab\x13\x8d*<\xb1Zb\x9cJ\x15\x82\xa0\x13(\xc1g\xe7b\xea\xe1\u057a\xd9\\MV$\xa3\u0782d9\u0144W(>\xec\x0e;\xd5\xc45?d?D?\\\xff\xb0\xf8!9\xf8/\xff\xc3\xff\xf4\x7f\xf9\xe7\xbf\xfe\xf9\xcf\x7f\xfd\xf3\xbf\xfb\xd7?\xff\xfb\x7f\xfd\xf3\x7f\xf1\xaf\x7f\xfe/\xff\xf5\xcf\xff\u057f\xfe\xf9\xbf\xfe\xd7?\xff7\xff\xfa\xe7\xff\xf6_\xff\xfc\xdf\xfd\xeb\x9f\xff\xfb\x7f\xfd\xf3\xff\xfe\xaf\xff\xee\xff\xfa\xff\xf9\xe7?\x7fX\xbb\xb6;\u013f\xa3\x1f\xd6\v\xb1XX\x90;\xaf\xef\x0e\xbe,\x1b\xaf\f\xe8\x11\xe8\xa8\x00i\x91\x19\x0f\x83\x06@\xaeO\x154@\xc6\x0f\xa8\xc4fS\x86\xa9\x98:o\xd8\x1f\xa9\\\xbc\x18\xa5Q\x1f\x8f0\x99/\xa6\xce\x03\x17(y<R;&\x1c\x8f0i\a\x9c\x8f\xae+D\x9b]\xf0\xb8-\xf7\xd2\x1b\xae\u010f;\t\xc7s\xeb\xaf'\xf9jc2\xea[\x99\x06m\x9cN\xf0\x8c\t\xae\xae\f\\{\xf1\xa9wK=\x12\xf1X\xa6o\r\xc9-e3\x12\xb1;f!$p\x18-\xf4\"\xc0bG\xac\xe0\x85\x97(\xfb\xbeT\x8fU&\x90\x05\xf8\xd4\xc3%s(eWh\x0f\x84s\xb2\x06\xa9c.m\x11\x90\x18\x02\xb3\ucbb7\xb2M\v\xad\aR\x8b\xad\xe1\x00<N')9\x85\xe4qk\xf8Q\xb9\xd1@\r9\x96\x92\xacSC\xed\x1e\x92\x8b\xe2\x8e\xdd\x1b\xca\x0685C~X\x053\u07a2\u01ef\xbfT7\xcf./\x13q\t\x99\xbdd\x87\xb7tl\xa6\x89\u0772\xfbr\t\xef\x14\xf3\x00\xf4Fv\xff\xcb0\xd0\b\x8b\n\xb1\xcavr\xe4+\xe3\xd1J \x1a\xccZ)q\xb0\xdf\x05\x03B\xc4\xc1\x9e\xdd\xeb\x0fu\xfaFD\xb1y\xe1\ucec4HxA\xfa\x02\xcdK\xceVp\xec\x90\x11R\xd85\xaf\xb2\xa3\xec\x92[o\xfc\xdb`\xb9^\x1e\xf8a\x18\xdf\b\xb0\x90\x9f\x8b\xdb\x03q;\x13b\x0e\x01\x05\xefxl\xf0{\x17|~\xc2{\xcef\xd3\xf0k\xdcl\x8b\xa9\x16\x18+\xd1aB[\xf5Bv!%\xcb\x01)8\xb1\xe4sL\x1d]\xa5[\xbb\x19\xc7!\xb0\xd1r:\xae\x86\xbf\xca0\xd2\x11\xc6S\x1d\x1b\xf11e\b\xb7\x9d\x8c|\xd2O}\x8b\x982\xf33\xafV\u05a8\u22ec\xd5),\xe5+\x120\x1b\xc6\xddV\xc2\xc6C\xa7f%\x8e\xb3\xe3\x04%\x8f\xc1\x82\x9c\x12\x9f\x1f:\xe8~\x12x\xc5\xfa\u03262\x9e\u02a2\x85\xe1C|JO\xae\xd5\x06uG.\xa9!T\x8eA\xd0\xcb\x16 \u01c4\u398f\x87\\\xb6`>\xc8|\xb7:6\xce\u2117\xbe\xc72\xad\x96\x91BF\x03\xc7\x17\fu\u076c;\x1c\xf6>'\xfb\xa8L0.\xaeEr\xf7\x94\xf1\x8dH\xa7\ub33a\x94X\xf8\x89E\xb7\xec\x1e\xaf\xbcZ\x01\xa1J\xb8\xbeC\xd4\x1c\x83\xa89\xd3\u0702\x10\x17\xa0]\xe3\xc0V\x86y\xe4\f\xfb\xb6N\xc4\xd1\x1d\x8c\xe8\x03\x90o\xd9\xfd\"\bC/\xdeR\xe6\x13\x10\x11\x84\x00\xb4\xeb\xf4\xec\xcf\x1f\xa6E\x10f\"y\xda8\xc9\x15\x8c-fp\xbe\x90\x80d\"\xf9\xc5F\xaa\xdb-\x9b\xab>\xb5\x0f\xd1\xfc5\x10\x00c\xfcX\xc0\xad\xfc\xb9\xc5 \xa2\x02\xa6\xb9=\x9f6\x9b\xd8C\xe2\x18\x1f\x96\xed\xb89\xf0v\x94=>&)\x8e\x80\xaa\xe3\x17\x1a\x04\xe6\x93\x00qf\xd8\xe9|\xd1H\xd4\f\xc2C\xfd\xff\x99]\xffe{\xedv;\x9f\xc3+\xf5\xfa\xc3\u0380>\x01y\u03e7\u0695\x16\x1cb\u067d\xba\x81%\x04q\xab\xdd\xda\\\x88FC\x8e\xd3\xe9u\xeb\x1a\xaa\x063\x86\xdd\x136\xb4\xb2\xc7.\x16F\xbd.\x10}\x8a\v'\x89\x97\xb2\xf9\u0460?\xb2?g\xa6\xb5M\xfe,\\\xcfE\xfad\x9a\xa1?\xf8\xe5f\xcc\xd2U\x02)\x18\x8d\xec\xc1\xe7p\x19\x86\xe1i\xc5\xcb \xe0\x9a$\xa3\xf9\xf4\xf9T\xbf\x01\x9e\xa3\x01\xc7\x02\xe7(%\x10\x99\xcda\x876=\xb6Y\xc8\x03b\xa9B\xd6S\xf0a\xbd\xd94B\x1c\x13\xfc\xa6.\x85\u0353\x06B\xef\xed\xebI\xaaG\x10\xe2h\u069e_\xdc\"a\x1b\xb9\x83\x12f\xeb\xa1\u0607P\x00\x9b\f\xf7\xea)\xd6\vj\xe9\xf7\xddA\xf7\xd11\xee\xe6\x96E\x8a\nT\xb2|` h\x15RP\x9b\x88\x013\x87Y\xbe\x95\xbeEZ'\u034a3\xf6J%`6\xb2:/x\x80\x01\x88\xaex\xd0\xce\x03\xfc\x92U\xc9\x01\x86\xe0GL\xf7\x8eT\x1c\xd1\xe5(\u0763\xf3\u038a\xa9\xa1@\x19$\u038cg\xb3O@c2\xb0pb\xbb\x9c\u06d5\xe2w\xb2\"%\\\xc4E\x1b\xbeA\xa3\x00\xac\xa4\xd5\xd2\x1e\x12\x19\u062e\xe4\x1a\xf3\t\xd1\x1f\xe9HA\ua52f\xee\xe6q$\x99xOZ\x96A\xae\rU\"Q/\x9d\xad\xa73\x7f\x1bo\xb3\xf3dZ\x14P\x0f\xcf\x13\x06\u03cb\x17 aP\xdf\xcac\u009c\xfb\xedg\x1a\xc5\xf0:\xf1\uf913\x94\x04\x80\xb2\x98\xe4\x9f\xc0\xb5\xce4NY#l6g\xdam4\x05}\xc1\x1c\xf5\x90\xe8\x1f\x98\x92\xb9\xceB\xad\x80\xd1\u5da6\xbb\xe0\x96\rz#\xa7\xf70!\xec\x0f\x9cn\xef)+,np8\x18\x86~\x9a\xbd\x96\x8bl\xcb\xee\x8d;I\xfb\xfa\xc3\xc1p\xf0\u067b\xdc\xd2_}\x1e\u00f2\xf4W\u022d,\xfd\xd5/\xc7\xd49\xc3\u03e2wp\xc0\x1e\xd1v(\x16\x19\xf3\v\n\x17\x14g\xa0T+$\x9f\xd29\x9fX\x89\x98\xafg\x18>\x17hbp2\x185\x9b\xc1\xf1\xb0\xb3e\xf7\xf2\xd5\x13\xc8\u06b8:\x04\x19\xcb\x1e\xeau\xdfv\x86_x\x96\x94\aH)\xcdp\x87\u075d\xb3$\xea}v\x83\x9aJj\xb6*\x8e\x95\x8b\xe2\x00yU\x1c+K\xea^\x88\xaa\x0e\x16rx|\\\x18\x06\x80`\x11%\x17\x16\xbb\xcb\xf5HO\x19\xee\xe5\x96\xddc\x95^\xad\x8c\x83\xc5l\xc1g\x8a\x1a]\xf1\x90,\x00\x8c5\x11\xecJ\x06\xa57\x9c\xfb\xaf\xbc\x8c]Q\xe9/\xa2\x83\u01596\x87\x8c\xa8\xa4e\x11\xe7\xfcr\xb3\xf1\x89\xe1\xe2\b\x81\x91R|-\xcd\x109\x89xt~\x8d\xb1AI\xa4\b\x19e\xea\u04fc\xd5B\xcf0'\v\xb6d\x17\xf2\xe0\x86\x11$\n\u0622\u0265\x17QrG.\x0e\x97\u0326(C\x1e/\x8f/\xc6\xcbV\x8bA\u0387%\x1c\xec\x16\x90k\x00{l\x86\xea\xd0\xf9!x\xcc\x12\u0114N\xdfv?\aS\xe4\u0486,e\x9fwh\x83/pq\xc3\xc5/w\x10q\x9c\xdeg\x00_\x9f\x05\xd0p\xadU\x18\xbd.l<\xc2B\xbc2\x93\xf6\tC\x1d\x0fGR\x06\x94\xdbA\x9an\x89\xe7\x987\xbb\"3\x81\x1d\x1e\u00de\\\x83\xb1\x11X\xfb]cx.i\xebw\xad\xac\x02!\xa4li\xef\xc42Dw\x17E\x1b\xb5%\x00\xc5\xf0\xfd)\x84\x1b\x85G\x16\x18P5\xc2j\x90\x9dy\x8e]\xc7\x03\x19t\x91,\x9a\xcd\xc5IG\xca\x0e\xaeJ\x19/\x96\xba\xf0\xf2\xb8ow\xc6\xca_PJ1 v\x99\xb4)\xec\xf7\xc6\xe2x\xd0\x1f\v0\x1eS\xe1\u05b3:\vI\"(\x13*\xafm\xbf\xef\xc9\u07d1\xfc\x1d\xd8\xea\xd7\xf5\"\u0791\xc9IdZ\xdb\xfeP\xbdq\xbc\x88w\xd5\x1b\xa5\xab\xf3\"\xeen\xe5\xf2\xb0\xc7\xc9qw0NZ-z\xadL\xaa?yY+a\xd7^\xb4\x951v\xd5X\x91Z\x9b\xed\xac}}(\xda\xd7x\xa4\xc2\u02aes\xba\xdbj\u044c_\x9f'\xd3\xf6'e\xf0Kl\xcab}\xad\r\xc3\x0e\x1d*\u0159$6\xac\xa0\xac\x97\xbfy~\xfa\xea\x9bg/^\xff\xf6w\xb0\xdb\xc7[p<~|\u0760,i\xb3i\x9cn6\x8d[\\3IE\xa2d\xc4h\xf7\xb5\xd9b\u0195\x04\x16\x8d\xed\xe8n\u072bK\x92Q\xef\x92d\xacd\xe7x>E\xff\xf1L\xd3\x1a\xe8\x7f\x88\x1dO\x0edr\x1fH\x8e\b\\\x91,1#1{\u051e\xb9\xdax49t<\x83\xba:^\u0445IK\xe0G\xc0\x90\xafIFO\xd6$\xa2\x13\xc7;t\xd0\xdd_\xbac\xc8aV\xd3\x13\x8d)\xc0\xc3\xe3\xf3\xa4\u055a*\u00f4\xe30\x8f\x1f\x93\xc9\xe7\x853\tf\f\xed;\u0397m\x89j\xcb\v\nm}\x95V\x14\xf2U%I\xad\xc8W5\x87#\xb3\xfc`\xe4\"S\xf0yU\x18K\xcc\xd5e\x10\xb1\xe5\xaeH\xf5zW\xa4*\a\xa6\x90\xa9>\x85,\xaf\x00\xa7V\x0f\xee\x93+\x19\x17<\x97U\xde\xf0\x94\\\x00\x89\xf1A\xce\x0e\xf4e\x87G\t\x16\x04&\xf7v\x12\xf1\x84\u06de\x83\xd7DZ\xfe\xde\x1c\x9e\xa2V\xe0\xf6\xd0e\t\x9f\x93+\xb4rg6e\xf0\x86\xb2\x9bVt\x98\x9c,\x95\xc8\x11\"S\u1b02,\xf3\x82%0\x88\xf6xu\x9c\x8cW`\t{\xc9O[+\x1aD\a\x17 \xbc\x04X/\xce/\xa7\u0480X\xefo\tC\xbba\xa8d\xc5O\u01eb\xe3\x9bC\xf9\xf5\x1d_\xb5\"F.\xf9\xaa\x95`\x1d\x93\x8b\xf3\xbb)\x87\x1a<\x85>\xf0`,\xbf\xbc\x19\xafNn\x0e\x93V4^\x1d\x1e\xd2\xfc\xfd\xea\u0419J\x1b\xe2`A\xa2\x93\x84\xaa\xd2\xd0\xc6\xc9)\x96\xc5v\x0e\x1d\xd5\u04a1\xf3x[\xd0\xc3\ba\xbc8_\xb5N\xa7\xc5\b\x9f\xafZn\x8e\xce\x17\xba\x8b\b\x17\x8b%v\xf7\x9c\xfe#\xb2\u02d2\xbe@\xa5A\x8f\xdb/\xfdL\xa0\x7fT`$P\xbd\x94\xb6@%R\x05\x05\xcdso\x14\xdf\xd4x>ImP w\xe7A\xa73t\x1e\x11\xa8B\x1e\xdfR;y\x9e\x8d\x1d\xb4\xd5o0\xc5(RSv\x0f\x97\xf2\x8c\xd2q\xfb\xce\xe8\xe1\u018c\x11@\xbf\xb1\xa0\xb0^L\x8bQY\x17;~\x98\xb3\xa13\xf0 \x83\u061a\x966\xad\b\x16wh\xb2\x99\x92\xa3\xf6\x91r\x14X\xf0\xd4t\f\xb9*n\v\xa7\x80\xb4d'\xba\xe4iI\xd5\u03ee\xf9\xd1\xf9\x0f\xeb\x97C\x1b<\x1d^\xbez\xf5\n\xfc\x19.\xf9\xd1_\x8d\xa7\xcf_\xbd\x9a~u\xc4\xee\xd4\xd3\x17E\u066f\x8eJ\xa6v\xa6\x17\x00\x06\xaa:\x94\xe9|\xe1\xb2U\x18\xf4\xad\xc8%\x86\xa9n@r\xf1\x18S\x86\xdf\xe9\a\x97,\xa1\x13\xeb\x87\x1f\xd6VkI\xae\x88\x80%\xeb\xf4\xa9'\xb6\x98\xbaiWs\xffoPx\xbe\xb0\xfb\xf03\xect\xad\x7fkp\b\u06e6\x1e\xcagt\xb3\x91\xe5\x84?7\n\xc0\x1d\xc6\xe6\x985\x9b\x06J\xc8q\xaf\xc8\xf0@\u05d2\u03c4\xb7\xcfY4\xe1v]\xe0<\x9f\x87$\xa6\xe3\xe48\u018d\u0387\u0364Xm\x89\fo\x92r\x8c^\x01\x0e\xba~\xbe\xa3WS\x95\xa5\x10M\x8f]\x83VQ\xaaA\xfa#\xc7\xdd\xef\xa66F\xbd\xbd\u0765$iC\xb7\x98\xea\x1c\xf8\u0270\xce\xe0\x01\xd5\x00\xb0\xac\xfd!\xd8\xe5\xbc\xf1W%\x11M\x9d\xb9\xa8z\"H9\u02ff\x96\xa9\x14}\xb5K\xc2B`{\xfbN_\xe6QG\xfb>\x80\x03\xbcD]\xf7\x91\x95\x8c\xb1)K+yO&\xb9R\xf8H\xb5\x8c\u5356\xf3\x0eK\u025fvep\u054a\xc1>\xc5kh\x19\x18\xe8\xe00\x10\x9c\xa75\xeeH\x8b\xba\xa3\xc1#\xe4\xc1\x88c&\xc3\x14\x82\xf3\xfbS\xbbS\x8d\xcb\xd6\xe0\xdcW\x80A\a\xab\xaf=_\xa6~\xef\xf6\xec'\x03\xa5\xa5\x80_\b\xd4\xddC \xddI\x80F\xddN\xbf\xff0@\x18j\x83\xb6\x95P\xeb\x01X@?'\v\xd5\x1f\xf7\x84:\xc2\xf5Gn\xf7\xe1&M\xef\x80\"\xe8M\x1eh=-\x86i\xcd\xcb\xc6D\x81\x8a\xf5\xf3\xf8\x88A\x1c\x9f\xb5\x1a\x9dt'\xd5a\x11\xff\u012b=@\x04\xc4\xd7Ie1\x1aIw\xe8>yZ1\xf6C%\x1a\x89\xee\x1d[\xe7|\xe4C}(&u\x1f\xd8\xe59\xc8]\xb3\xd0\x01&\x90\xd1ES\u032b\x89AWf`\xfd\x0e\f\x89\x8e_\x02AK\x8aL@\xd2D2SAL\xa8\x8a\a\x94\x19\xbe\xf63\x1c\ag\u0435{O\x9eZ\xc5D\x97\xechd|\xab'L_\xed\xb4\x97\xa7s_\xa8\xab\xd2\x14\xca\tt\x9d\xc1c8\x99\xa7$\xceC5>\x0e\xa4\xeen\tV_\u00bae\xf7 =\xf6\x1e\xb2\xbf\xc5d\u024e=\xfc\x1c\xe2\b\xbbd\xb9\xef\xda:\timo\xd4\x1fh\"\xef\xf6\xfbN\xf7I\x8b_\u02a5\x1f^\xfb\xb2\xcc\xc3K\xbf\xd7\xff\x1c\x8dZ}6\v\x19\xddC\xae\x19\b=\xa2\x188\xb4\x992!T\xc1\xaaM\x10\xfd0\x04K\xb7P\xd4\x05\xd8\xc0\x8d\x13#\xa0\x90\x8c\xb2\x84\xe7q>\xe0\x98\xa7BX\xb3\x19Ow,U0G\x88.\f0\x9eO\xd1I-\xe4\xcexMDy\xe7\x96~\x8d\u0eb6\x86\xdc0!H\xf0H\xc42&(\xe6-(+(\u05db\rYc\n\x1c\xc8N\bN<\xd9:\xf5\xac\xc5\x1ab\u0246\x98\xa1SEIa\x87\x87!\xe4k\vhY%\xb3\xbf\x12\xd9#\xa8#\x11~\x1aG\xd5J\xe0\xbf\xe2\xc1\u0588\xe5\xa5S\\\x87d\xa6#5\x1aaP\x00i+y\x91\x1f=z\x179Y\n>]N~Z\xcc\xf8\xba\x98\xf10\x9fq6\xe3\xd6w1\xa6\vQ\x10\x1c\xa8\x99\x98[\x8faCt\xb7\x1f\r|\xb2c\xd5\xc5\xc0\a\b\x91c\u0153\x1c9\x16x\x8d\xc8qU\xe1\x8feOc\x139|\x8d\x1ck\xee\xb0+\u0100:\f\x89\x11CBx\xbf\x06/G\x92\xec\u00d0p\xb3\x01G\xef+\x98_Lb[\x9d}U \x84\x02>\x04L\x11\xec\xf0p\xbd\xd9,\xf0\xe0\x16\x11\x9f\u03681\xdd\xd57\u01bc_\xe9y_\x90+=\xefIi\u07bb\xddN\xf7s\xccD\x94:\x13i*$\xaa\xd8\tW\x9e\xe7\xabT\xdee\xa1\xb4\xbe\x1c\x0e\x94\xc0\xa5\xdf\xcb\x13A\xcbh2\x18\xdbgw\xd2\xf3\x93e\"\xfc\xd0 \u040d\x86\x0f\x99\xe1J\xe4\xd98\x16/\x82\xc8\x0f\xc3;\x15\xf9\r\x06\xdf<\x01o\xb7\xacl\x9a\xacM\b\xe4W5\xb8\x15J\xee<%9d(\xecZ\x1bn02\xa6\x0eLs41\x93?\xeb\xb5G2&\b\xdd\xc1\x84\xbc@\x040x\x82}\xe6\xd7*\x9f\x96\xfc\x18O\x06\x8d\x18vy_\xb9\xb1-\xb8\t\xf4\xee\x10\x8dk\x86\r\u00bb\x83N\xc2x\xc5,\xf5\xceb\vv/\x83\xf9\u02380 \xaf\x1b\x0e\x9d'd\xebbi\x81D\xeb\x02\x89\xc2\xe2\xbc?\u04d8\xb3*\xe8\u0222\xc0\xb1\xab\x1c]Pj\x8f\xf1\x89\xa4\xd4^Y\xf1^s}hCk[\x8cK#\xf3\xf5(BuQ`\xe4M\xa1,;\x95O; +\xcbs?|,(\xd5\x19\xd7V(\xecS\x81\xc7\xcf\xcaY\x83^\x00\uc40f\b\xf2\xe7\xe5\x18\xfe#\xea\x1c\x86\x906\xe8uA\x10?\x14\x04\xf1;\xd49t\xa0{\xef\nS\x82\xf7\xf0\x19\xe4\xeb\xa4\xecM\xa1\x9d{\x89F\xca\x1d\u05e1\xec\xdb\xdc\xd7\xf2y\xa1\xbfx\xc5\u07d8\xaa\xb9\x9fx\xb1\x8e\xbe\xe7\xef\f\x93\x82\x9f(\xfb;\x7f\x87`\xff\xa1\xfa\xe2\x8f0\xf7Fdm\xf6\r_\xb0\xaf\xf8\x1f\xd9oyh\bB\x7f\xc7\xc3\"\x97\xd1ox\x98\xfbr\xfe\x85\xbfn/\u061f\xf9_\xd8\xefy\xa3A~\xd7l\xfeN\x85\xfbF\xaf\xbaf3\xc48\xe7\xa0%\xc7\a\x94\xfd\x89_\x90Pg\xb1y\x8f\x149\x88#\xf5R\b\b\xb1~\xe5G\xf3P\xcc\x13\xfd\xd2b\x99\xc0\x80\x88\x82\xbf'?\xd5\x182\u0712o\u040a\xa1\u020d\xf2\r\x95\xd9\xc1\x9b\xcd~\x9fs\xfe\xbc\xa4BY7\x9b\x8d\xaf4\xfa\x97\xde<\a;\xd7\xdal\x1fU\xe3T\x19\x95\xfc\x9b2\x95\x17\x8a\xbfM\xca\xf1\ubaee\x11Ur\xa4\x88\n\x89J1\xce\xee\xb7\xf4\xfc\u0554'\xacA2\xc1\xa3]b\xb0\xa5f\u049d\x04s\xbe4\x9b/\x9b\xcd\u019f\x10\b\xc1#\x019x\xca0~\u04d6\xf9\xe6\xda\xd2ta\x974\xb2X\xec\xcd>\xda \x8d\x1b\x99\xf8\u70a0\xd5\u0215\x88\xe0\x9c\x91m\x99/\xf8>\x99\xb5\xcf3\xb9\x11A\xc8\x06\xce3\x99t\x9c\xa5<\x98\x88v\xfc\xc9\x13\xed\x85\x1f\x84l\u0345\xc1\u02c9\x82\x97\x13*\xa2>z\xf8\xa6\x13\xc8hN\\PW\xb4s\x14i6gB\x06\xcd\xcf\x1fq\a\xf2\x13s\xce\xc1\x1a\xde\xf7\b:R\xa8DV,\xe2)\xf1)\x9b5\x9bd\xa6\xf2e\xb1\x18R\xf7I\xe5\xaf\xd0\xfb\xe5$$\xbf\xcd)\xea\xe1\xec\xca\x0f\xa2\x83\xd9\xdd\f\xb3\xecz$\xe1\xb1 \x11\xa5\x93\x15IX\xc4\xd6,\x04\x1b\xf6\x88R/$\xbea\x1c2\x83H\xf1\xcdf\xdeR(=\x9d\x82\ua409v\x14g\xc1\"\x10\xf3\u0346\x147\xc0\x13\xbc(\u0354\x19I\x1e\x06\xca\xc7\x17\xe98\xe2\x98\xf8\x8d\xd01\xf5\x05\x89@\xa1dV\xe3\xb0\f\xd3\x11\x19\u00f6\x16\u04a6\x8enY*\xf6d\"\x8d\u01ff\x9f\x10\x92\xf0\xd2\xd2&\x16\xfe\x80\x9f\xab\x1a+\x9e\xb1\xa4-\x99T\x1e\xb1\xa4\x1dDA&\x8b\n\xd6p@\x94\xc6*\u0500$\x94z\t\xbfW\x15x\x99\xe6q\xa3-k\xfc\t\x94v<<\xb7\xe2\xc8j\x89)\xe4DO\xa8'`vD\xb3\xf9#\xb1\xbe\u05c4\xc2`)s\x8a\x11\xd1-[\x97\x11\x19\xfc\xbd\xc3\x1d\xea!m\x9ed\x0ex\x1cM\x99s\r\xa2\x7f\v\x19f8\xe3\x1fJ\x83\xff\xed\xe47m\xb1\f2R\x90\xaa\xf7E\xc3\t\x8b\xa8\x97\n\"\x04\x8bXBe\u00b0\x02)\xbf\xddl\xb0\u0789\xeb9,\x93\xac\x9av\x9fS\xab\x04\u0653\xb06i\xb2\x83\xf9\xa1\x8c\xe9k\xe8\xec\x17[6{Ro\U000fe38bn\xe4\xf5}#{c\xb1\f\xbbP\xbc\xb8\xca_0\xa1\x13\x9e\x02\x94\xab]\x94\xa9\xca<\x13 \x80\x19\x0e\xcbv\xcb\x16\xbb\x1f\bL\xa9\x8a\b\x0f\x17\x80\xec\x18b\x9eGT7\u01a1Y$\x1a\xdce\x81\x00|\xb2\xa1\xf9\xab\xdd\u0680\xea\u02ca\xe8\xfdn\x85:\xeb\xa9\x1e\x04#Ii\xb1\xcc\x0ff\xe8AzQ\x1cQ\x0e\x82,\x15\xe1BY\x9a\xe1\xaa\a]\xf2\xe4E\xcd!\xf3^[\xa8a[+<\x12\xac\x04\xb9\x122\xa7\xe4J\x90\x85\xbc\xd4\xf4\x01\f\xf9\x84:[\x82\x1e\xda#\xbb\xbdvT\xaf\x9d\xcaWyc\xeak\u0326\x1bA\x90\x89\xaf8\xf9\xa6\xec\x96/\x99\u06af(\xc3x*\b\x18*\xa5%Z|\xafT\xd4\x00\xb4 \x12\xe0L\x83\x9b\x99\xa9\xd3\x17\x82(P\r\xb6\x81\x94\xb7\xbc\xbf\x9b\x96\x89?i\xa3=\xa6)\x11\\K\xb4\x85\xab\x9c|y\xb0\xa5~\xc7r\xac\x83\x97\xd8\x7fO\x87\xbcS\t\xf5\xa8\xd96\x9f\x93\xafX\x85\xeb7\x9c\xd7\xff ;\xc6\x12\xfe\x17\xf2\t\xaf\xd97\xc5a)R\xcb\a\x90$i\u01dfx\xe3\x02\xf77\xc1\x12\u0714\xf8\x85\x8c>\xca\x12\xb5\aqX6\xf2RA\xc3l\xce#9O\x93\xa8\xa0\xc5\x10Y\x00\bV\x19G|Ap%P\xf3L\xc6pl\xebD2\xc51C\xb5%\x99\xfe\x98\xd7&\xbbK\x18\u0323\xa0\xe5\x8cb\xa2\x9c\xee+\x9fZ3\u55de\xe6-{\xdd^\xf0\xbf\xd4:\xb8s\u03bf\xd9l\xe0\u01df\xe8\xec_\u079fa\xf7`\x8du\xb3y\x81\xd6X\x7f\xacI\x8dK\xe9}\xc0\xff\x88\xdd`\x90\x06\x86\\\x91?B\x86d\x11\xedX\xb4\xca)\x03\xc8\xcc\xd4\xcc\xdfT\xcdCV2C+\x00\xacOI\xf2\xa6tXa\xd8\x0e\x8e\xad\u017e\x92\xfcN\xa9\x80\xc4v\xa5\xf0\xfd\xa3\xc9|\x19v\x9dK\b\b\xf5G\xf6\x15\u0766\x86\xaf\x18\xbbI\xfc\x95)B\x14[v\xaf\xe8\x87\xf7\u0356\xb2k\xf2\r\xfbIo{\x97\xc0j\xfb|\x06?i~\xee\xfdiG\x0e)\xd0\xf6\x10F\xaf\xe6T\xfa\x17\xb5Bs\xe5\xa0\xe6uX\x8e\x1b,3\xce\xf9\x0f\xb5\xb4\xdelTc\x88\x14u\xa8\xf7\x16\xd8dh\x11'\xfc\x1bO\x85K\x7f\xa4\xe2DH\xf9\xdd~\x89\xcd_\xaab\xbb\xb8\x10\xdb\xf9\x95\x9dV\xa2\xc3\u074ed&\xe06K\xb93\xfeX'\x94Y\xf3@\ve@:\xb3\xda/\xb6\v\v\x81\xcbZ\n\\R\x90\xa2\xf9(\x9d\x89\x95\xa4E=2\x04,\xbe\x16\xb0\xc4\xc4\xdf\x15\xac\xb1\u011f\x89'u\x1f\xbb\x1c\xd7t9.uy\xb7\x97+\x12\x1b]*F2Q\xf2!\rh\xac\x01MH\\/\x01\x1ctk\xa3+\x99\x16\xcb\u06adY)\xe4\x83\xdc8\x80\xa5:X\xe4\xba\b \x19\x16\xe7\xecY\xe1Y\xbb*\xc4E\v\x1e\x9b\x11\xaf\xf35gQv\xb5C9\xd8<\xf7\x8e]\xf2U\x99\x03V\x97\a\x82\xe41\xeb\x1bdQ=j\x9dOY)5)\xf2-\xec\x9a7*\xb5\u055d\x83.\xf9r\xb3\xb96\xa5\x939\xdc\x15\xac\xbf\x94\x1a\x8d\u02ed\xe1\x90Z\u064c0\xef\xcc\x1a\xd8\x06%\x8b\xae\xe6:\xecL\x84\x97\x12\u04f3\x17\x8f\xb0\xd7\xcdf#7\xf9[\xa8\xa9\x1ac*\x1b\x1e\x15\xa6}\xaa\x16e\xc8g{\x06\xf5\x14\xd2d\xcf)=#\u0679=\xa5\xf2\x8d\xbb\xfb\x86e\xe7\x8e~\xdd\xd9\xf3\x9ae\xe7\xae.\xd3}\xa8\f\xcb\xce;S\xba\x95\xc8u\x0ez\xfb\xdcf\xc6's\x96\xb0\f\xd3\xf2\x12\x9f\x04\f\x90X\x16\x05\xb2P \x02d\x98\vIL'\xb1w\x053\xe3\x13\xc1V\xac\bk\x16\x92K:\xb9\xf4VR\xbe\xe9vz\x8f\xe8\xb7\xea\u00e1\x98)\xbf\x06vW\v\xb3\xa5\xcaO\xa7\x97\x7f\b!\xa4F\xcb3\x90\xb3\x1aEtw\xda3\u03dc\xf4q\xae\xf6\xca(\xe7|5\xc9\u03a3)F\x11\x05\x996\x83\xc3fJ\x12:I\xda&G\u0139<\xf8M\xe4\xad\x17\xcb\x04\xe0lE=\x9f\xcc@\xa0I\xe9D\x90\x19\x8b\xe0\x91,$U\x81=\xd0\x00H=\x93\xe3\u061d/\xb4\xda\xc5\u051c\x95`x\xb9\xa9.&\xc4\x19\f\x06:\u6055;$\x99C\xa9c\x17\xef\x18\u016d\u02ceR\x0f9b5\x1a\xff\bIZ\xc4\xd6K\xa5\xee\xf0\xb3L\x97\a\x83\xd1\xe0\xf1\xf0\xd6\x10\xa9\x96*\x83\"\x16\x17}\xf5\v\xa9^P\xf8\x04\xa5\\u\xcfp\xffYs\x1fEr!\xf7\r\x91\x1c\x18\x89\x12Y\x96\xe5CRV\x13\x99\xacu\u02a4\u074b\x17\x1bN?\xf5\xde>L\u02f5)\x8bP\x04\x83m\xc4<\x93\xb3\x91\xef\x19'<\xd2\xd6(\xf5\x0e=\x1e\xa6#\x89XL\x99\xfa\xb6U\xc4\xe4T\x9f\x88\xdcOG\x9e\x13\xfb\xf6\xa8\xe4\xf7\xb0\xab\x1e\xadN?2'+\xe1g\xe0\xc0\x05Q\x1a\xa9\xb4\x83\x18\xf4\x1f\x13>\x17HZ2\xa9\xab\x18&`\u0627\x1d\x8br\x85\u01f3\x02\x8fW\x05\xf2.\n)\xf6\x15(\x9e\xac6F\xebK\xff\x18dW \xa3\xf6\x8d\xd0\xc2\xcb\xc2\\\xf4\x1a\xad\xbf\xf3\x92\x16\x1d\xc7O\xc0\xfa\x06Yl6\u05d8\xf8]#\x84)\x9f7\xebc\x8dd\xb3)\x12\u0601<\xaeq\x8dVX\xbaL\r;\xb2&\xca\u07c2\x82jK\xefJ)Y\x92\xa7-\x16\x96o9\x94%%\xd5\xc8\xd5\xe4J\x9d\xf7\xbd9\xc9X\u0122\x96\xf6n\x00\x8a&\xdd\x1bz\x83\xceh\xf89:f\x88\x03I\xdbY\x12,\x9fB4\xa40\x1f\x83%%\xc1\x12\xc9\x06\\\xd4\x18F\xaa\\)\xd2>\xa4W\xda6@\xc2\xdf\xe9\x8e(\xb1\xfc\xf4.\x9a\xe5K\x17\xc0w\xfb\xe0\xe0\xa0\xf4\xf3\u03a0\xe3\xd6~w\u59ef\x15\xef\x01_uz#\xbbS[\xb0.t\x03\x04\xc4q\xeb\xe1\t\fP:\xbd\xa1\xdb\xfd2\xfb\xe9:cK\xb51\xae\x8b\xa5\xb3\u03e11\x8fiS\t \xa9\xe2\xdf\xccs\x9f\xa5e\xa1\x86\xb9.\xf6\xdb\xcb<B\xcb]\x11\x88\xec\xa2\u0604o\nc\x8e\xd3\xc2\x04\xe7\xb6\xc8\r\xf8\xb1X\xbagE\xb8\x96O\x05\xd7\xf9\xacH)\xf4\xa2\xc8\xcf\x02\ua6beV\u05a8D-\xaf\x8b]\xfeC\x91\xb3\xe0\xbb\"/\xee\xbb\"g\xe1\xfb\xc2S\xeaM\xa1\xa1zY\xc4\x00\xfa\xb6H\x05\xf7\xbcH\xf8\xf5\xaa\b\a\xf4S\xa1\xe3\xf9\x1e\x9d\xa3\xbb\xd0\xf0\u07f9\x9e_\xf6\x87B\xb1\xf5\xc7b?\xf9\xa6pS\x97\xee\xd7\xec+\x0eY\u0403\xf9\\D\x16e\xbf\xd5\x11},\xf6;\xfeS%@\x1b\xfb\r\xff#n7\x7f\xe1\x7f4\xb6\x9b\xdfR\xf6\xe7]\xae\xfb\xf7<\xd6\xe1\x88\xfe\xc4\x7f\xdfl\xfe\xdex'D\xc9B>\x83\xdb\xdf\xcb\x1aX$\xf6X\xe3&\x02\xd5D\xb1\xe0\x1f \x9f\x82\xe0o!\a\xa2\xe0\xef\x80\x18\v\xbe.Bp\xaf\x05\x7fITD+\xd0j\x85x\x1f\xaf\x0e\x8bG3Y\x04\xab?\xccb\xf5\xe60\x11\x97A\x9aA\x00\n\xb6\x92%\xe4s(!\xcb\x1a%\x16XB\x06_\xba\x12\xbc\x01b\x89Ffd`\xa8\u0722\xab?\x06jds\x01\x11\x9a\x16uJ\xddA\x83\x7f\"\xb1\xc83d\xd7'\xa1\x88\x95\xf1'\x16\x91\xdb\xe5`K\xdb>\xc8\xfe\xda~5\xddta(\x9c\b\xf2g\x95\xadT\x89,\xfe\f\xe1\x8ec\xa1\x8aA\xd2*\u0400\xfd\xb9\u064c\xb1(\x9c\a\xbdX\xb0e\xadf\x86\xaf\x05\x04x\xfaD\xfe\x94S\xee\u07d0H\xf1\x15\xbfe\x99\x7f\t\xbb\xb82T\x03a\\\xb6\xa5l\x06y\xa6\xdb\xc6S\x9eQ\x16m\xd9u\x8dX\x97#(\u05c2\x84\n\xbe\v\xbd\xcf$\xfc\x96\x14\xac\xfb\x05\x86\xd3'k\x01\x16\xcd\xc4\u0313:A\xc3\xe6\xaf(\xe4\x91\xf9jz\x9eL1\xf3(^q\x88f\x1f\xf1O\x00q\xf1\x81wFl\x10\x92\xa2\x14U~\xbb\xd9\xe0\x00}\xc5\u0388\xc3\xeeQ\x1a\xafk\xb0)\x9b\u00fb\x84E\x94z\xb1\xbe\x84\xe4;\x95\x01\xbb(v\xc8Sy~\x7fF\xa2<w\xe5)\xea\x80tw\xbe!\x89\xc1|eR\xf9\x93\x92;\xc1\"tn\xbfV\xf3\x85\xe9C\x01\x9c-\xbb\xabQ\xb8\xf1[\x99\xf0$%\x81\x14\xe0\x15\x87\x9d\x06Qb\x99?7\x9b8n \xa1l\\\xe18\xcb\u0707$\xdal\x1a*\x05;\xb4\xd9P\xc56\x9b+-\x05\x96\xb2\x1d\x18\x8alJ7\x1b\xe8\xf6E=\x9e\x9c\x02 j\xca@\xba\f(\xa6\xabL\xb0\xca\x10.\xb4\xe8\"\x91y\xe14\xacq\xb9h\x84MGr\n \x1d\x871\xdd8#\xf1v\xcbn\xea\xc6\xc3\x17\xe4Ty\x1d\x15\x11\x87\xbe!Y\x99;\u01a6\x046\xf5\x1c\x7fS\xa9\x06\x83\x91\x8e\xb6\ucd2ef\xc4T\x16A\v\xd9$\x14\x9el&)5\x13\x95\x9bi\xe4\xedd8\xf4\x7f\xd6m%\f\x97\x15\xb6\x97l\xc7d\xb5\u0650\xdf\xf3\xb2\xbb\xdf%\xf9\x13\x93\xac\x97\xd4<\b\xa1\xc3)\x1e\x042\xfd\xae\x7f`\xc8\"\x95\xd6A\xec\x9c\x1d\x9b\xcd\xdc\xf4\u04f4\x1b\x9f|$\xe6-\xcd\x196\xfeJ\xa2\x94\u0651\x02\x930L5\xf4\x89\x198\"/\u03ff\x9a\"\x8e\x91\x02ep\xfd\xcd\x15%\xcbpq\x89B\x93}0k6\xaf\x04\xd0)\xa4C;\xf9kR\x91\x81\x03 [*U\x02{C\xfe\xc4\xcd]\xc6\xc8eSCg\x95$\xb4\x9d\xf9\x970\xceo\xc8\xef\x99U\x8a\x90Wo\x86\xbf\x14\xe4\x95tw\x84\xaf\u07b5\x17\xfcN\xb0\x0f\xed\x05\xbf\x16\xec\xbb\xf6\x82_\n\x94~_\b\xf6\x02\xb2\u0176\x17\xfcF\xb0\x1f\xdb\v~*\xd8\xf7\xf5\x99s\x97\x82\xfc\xa4\xaaD]r,\u021f\x98e\xd0G\uba69\x89t\x9f\x8coA\xd4\x1an6o\u021f1\x98\xe2NNL\x8b\u0749\xb2<\x1b#\xcf\xec\x95Q7V\xca\xf0\x16\xdc\xcc$\xc2y\xbf\xdfR\xf6\ryF\x16\xa2b\x8f\xf6w\xa9\x166B\xd9\xfcv\xc74{%C\xc1\u051c.>\xaah\xf6WdffP\x9eAr.EH\x7fol\x01\xf8\x9cGl\x05\t\x0ex\x06+\xf5\x93\xb8{U\xa9\x1aT{wE\xb0Q!\x88hY\u0152Qa0U\xbb\xc0\xaa\xe4\xed\xae\x04\x86\xb4^\xa7B\xe2\x879\xfe\xb0\xfd\xdb\xf2]\xb0\\\x85\xa2\xfa\x0eCM<\xc1N]\x0f\xee\xac\xf0s\xa85I7\x9c-?\x11A\xbdKA>)\x1buV1\xfd\xbf\x16l\xc7?\xe1R\xb0\xbd&\xf0\x17\u2260\xee\x18\xa4c>e\xef\xa6Z\xb7D\x92\xd4;}Z\xc5e\xa6\xe8\xc7\xf6\"7\xa1\xae\xaf\xb6fA\xc1G7\u02aa\x9aE\xa0~\x7f\xcc\u07e8\xb1\xdal\xca\rKj\xf9{\x92\xfb\x06I\x99\xa3\xd5\xe0\x91 @\x9c7\x1b\xeb~+o\xef}Ol\xcd\a\xb2k\b\x01B\xbe\u07d5Ir2\xef\vb\v\xe7\xab\f\xb0\x8f\\\x13\xdc\xe9sOZ\u061f\xef\x8c\xf8\xc7s|O\xb2\xdd\xf8\xa5K\x12K\x93\x83\x94\xc4,\x8f\x81CY\xe3\x8e\x14\xcb\b8\xb1\xe4\u0701\x95\x12\x90HHW\xa8\x04\x86\fZo\xfc\xe9\xfcw*\xc8\xe7\xad\xe0\x7f\xd2\t5\xc7o\u021f\xd8\xefj\tcJn%\x8f\x81}\xfe\x03\xf9=\xfb-e\xcf\u03ff\x9a\xe2\xca\xe8V\u009a\x14\xc7\xd0%(\u025e\xa9\xf0i\xc3\u03a8\xbb\xbf\x14\x14\xe9;\x83~\xbf\xb6\x88r\xbb\xc3B;\x91~t\xa1T\xf8\x89\xach8*'\xc73\xcahs8Pmt\xf6\x80\x8d\xf9|\xb0\xad\x8e\u04e9\xaf\xa7tv\x82\xc4T\x03\xa7\xbb\xa7\xa0\x11\xd6\x13\xd2k\xf5{\xa3\u0682\xeb(\x9d\xc5+ \xdb\b]\xaf\xdb\xe9t\x1f\x17d\xd5\xf9\x86\u69902\xdfC\xbf\xab\xc4X\xd2\rM\x1e\u05c7=\x15\xcfT\x1d\xb6Q\x01\u380d\xe2\"?Q\xb6E\x84k\bO\ud761\r\xe2\xae9o\xc4\xedg3\xe8\xf8\x9f\xe4Rh6\xad\u04bd\x15D\a1[\xf2\xff\x00?7v\xcd\xd7\xc4\xfa\xa3\xf0?\xa1+\u0752\x85\x92\x987\x9bsz\x9f\xf0\x10\x0e\xae/\n\u0188,YQ\x18x\u01f4-\"\x18a\"9\xa6K~m\xb0\x14w\xdc'\x97myr\x02A\x03\xdc]\xf9\xe8A\r\x97\x97\"\x93^\u0517\x98+y\x1c\x90K\xa6T\xc3\xd5MhF\x94\x0ff\x91<bQ\xd6\xcdf\x10b\xe1'\x11\xc1\x1aW\x97RA\x0f\xc6\x17J\x91\xba\xd9\xe8W\n(\xd8p\xd5\xf7y\xa1-\xbb\xf2\xd3_\xb4\xfd\x8b\x9a\xf6\xaf\xfc\xd4h\xfc\xa2h\xbc\u012a\xfc\x92\x8dOn\xf4\x95\x97Cq)2\x03\x8a\x9b\x02\x8aTT\x95iupD9\x1cQ\x01@\xb4\x17\x80\xd3\"\u0098\xa7K\xc1\xccc\x03\u0485\xdc(2\xdeI 9\x1c\r*\xb4\x03#\x15c\x94\xb1\xde\xe8\x01o\u046a\xe0\x1b\xb1w\x9f\u1ed6\xbcm5.>\vCp\x9f\x1c\x8e\x86]\xe5(\xe4\xb8\xff\x01\x8d\x89%\x12d\x0f\xc4\xe0\x03\xd7\xc1\xa6\x9c\xee\xe0\xb3\xe2\x88\x1a^\x03J\xa4\x17\x14\xa9hP\xd48\x1a\x8d:\xfb\u0702\x1e\x844\xae\x8d1\xaaS+i%G*\x8f\xea\x01yr\xec\x1a}h]\x97\xcf{2\xd1,l\xad\t\x91f'4\x97\x1e\xa3\xd1\u0273\xb3\x8f\xa7\xdf}x\xff\xfa\xf4\f\x80|}\xf6\xf1\xf5\x87\xd3\xf7\xcf>\xbc}\x8f\xb7\xdf}8}\xff\xfe\xfbw\x1fN_\"\x97\xdeN\xb3x\xb5\x12s)\aw\xbb\xf6\xa3\x11\xbc\x8cd\x97\x86f#\x97\u079aCZHo\x95\r|X\f\xff\xac\xb0f_\x15\u00ff(\x86?~\xe2\xf0'\xf5\x91KM\xe7\t\x18\xff\xd5g\x8f?tQ\u0710\x19\u0258/\xfd\xa9)\xa5\xe8\xaf\x13K\xaa\x9c\xeb\xc1+\xf3C\xef\xf5\xcc\xc0A\xf6\x8a\xc5L\xb0\xe8\u0469\xd9R\x15\xa5\xc1\x1d\xb8\xc3\xff\x86P\x1b\x84\x8d\xbf\x13\xffA\xc8}\xb0\x0f\xb9wq[|\x01r'\"]\x87\x99\n<\xdc\x1b\xda\xffm\x8d\xea\x7f\xfa\x90F?oH{n\xd9`g\x0f\x85/\xac\x110N*\xa8Lz\xca\u07fe\xd3\xef\u0643/s\xee48PE\\\xd0\u00fb\xd3\x1d\xf4\xcdIAge-\xd0\x1f'{A\xbbL\xe2\xf5\xea\xf9]uk/LdR)x\x84\xad[r\x91< \x89\xe4\xd9fx\x89<\xdb\n/M\uac2eJ\xebdu\x19\xc8$b\u28d0w\x12\x92\x98\xcc\xf0\x12\x98\x90\x98\xac\xe0\x9aI\xd1\x1d\xbb\u07e1\x13Rk9\x82\x8c\xa7_F\xad\r\x8cVh\x8c\x83\a)\xc2K\x18\xfd94\xf8\xa1\x90\xb4k\x12\x10_\xdbA\x103\b\x14\x93\x99vR\x94\x8bj\xccL~\xeeF\xd6\x1d\xf4\xbb\xce\x17\xe2\x95D\x9b\x02\xaf\x1e@\x9aO\xe2n\ae\nw\x1c4\x1c\xcd1\b\x96rT\xc2\f\xbfjI\x17\xa3\xdfFVH\x05\xa3\xad\f\x14\xdb\xf9\xac\x90\xff\xf5\x94\u02d8\xe7/ W\x9f\xc4]\xbdC|\xfa\xd8\u0322\x03K1\xb1\xd9\xcf#9\xee\xa0?\xea\xfc\u03d1CY\xfa\xab\xdfU=\xf7\xffSY\x14\u0258\xe8=\xe1s\xf8\x93\xe1\xffL\xc7\xff\x0f\xbb\xb1\x06\xfe\x1b\x98\x01\x91\xcf\xc1\xd3g\xa0;\xec9\xdd/\xa3\bFL\x04\u00fe\xf0\v(\xc2R$\x97\xa2>^\x88\x8e0\xc8d\x90\x03\x18\x86\x9a|\x15\x18\x904\x86\x14\u03e6\xa1i\xdcjMY\xa42\xb4d\xac4\x1c[Z\x0e\xd6\xd7q{\xeeg\xf1\x1f\xf1\x02\u0390=\x17\x12a\x03\"\xbb\x8e\xfbe\xb6*j@+\xa3\xa8\xc6v] k)\xe4\x80a\xa9\xf0\xe4\x91\xf6\x1f\x8a\xff[\f\xf3\x9a\xd4\x0e\xf1\xb1\xcbb\x9eh3L\x13}\u01f8\xe7\n\xca\xc2\x12^F\u0327\xf7\u0244$\xe0\x9d\x17s\x9fz1\x17$f>\xaa\x8b\x9f\x80\x9f\t\xcd3\u07fdG\xb0!A\xb0X\xae\xb2\xbb\x83\xa5\xbf:\x00\xbd\xd8A\x14\x1f\x04Q\x90\x05~\xa8s\xdf\xe9iU\xf4e\xd4\xef\xba\xff\xedp\xea;\xa1h\xff38\xf5\x9f\xc9\x0f\xf5\x87\xc3\xfd\xf1{\x9f*\x98\xc1\xd4E\xd6z\x95\x8a$\xb3j\xc44\xeb\xd5\xdc\xcf\xc4\xdb\xe4u\x04%@0d\x0f\x1c\xb9\xd2\x06\xc3\xee\xb0\xf7e\u06c6Zu\x95\xe8\x1ej\xa2K\x1b\x87o\xac\xaf\xcf\xd9\x1a$\u0735l\\\x9a\xbbk\xadI$9\xfc\x18/\x91\xef\xf7\xf1\x12\xc9\xdbl7\xd0en^\x0fg\x82\x18\x9d\xecP!\xb2j6g\u01dd<]\xba\xf5=4\x0f\u05b8\xfeE*\xa2,_\x132\x82\xc2j\x12 W(\xc0\x97wV\x9fm\x8b\xe2~R\xd8j\xc3j\x15,#\v\x94;h6\xb2\ubebd\xfe//\x9c[\xafvf\xbb\xd7\x1bT\xc2\x0fA\f\x1f\fyU\x15Sb\xd0\x17 \xe5\xb6\xfdY;\x9a\nf\xe0\xe7\xc1\f\x1e\t\u06d2\xd5J\xe7\xe2\xf6\xc2\u0629r\xa3Q\x12IW\x95\x89\xf6i\xf2\n\xff\x17\x12i\u07d5\xac\xe4\xbb\xe2\x0e\a\xc3\xce~sM\xc80\x1d\xa7\xa8\xbe\xe9vF\x83zU\u043c(\xd4\xef\rF\xa3\xfd\n,\x99\xb0\xa7\xdb\xeb\xeeQ\x04-E\xe6C\xcag,\xd5\x1f\f\xeaK\xc5\x17\xa9H\xae\xb5}\xe7\xd0\xee\xbbvm\xb9\x95\x9fe\"\x89\xdeh\xd5Y\xa7\xef\f\u0707TgJ\v7\xe8w\xaa\r\xbb\x83.-\xec\xd6;\xee\xd0\xd9\r;\xda\xd71]T\xfa\xbb\xb4\xc8x\xb1\xe6{\x12\x8f\x8e5\xf3\x11B4\xe2DN\xf0\x8c\xc7\xe7\xe1T'1\u0315/\xe3\x15\x84C^A(\xe65\x04rY\xa1\xab<K\xcf\xc3)Oe$O\u021f\xe0\xb8\u0767G\n5\xfc\x91\x94q)2\x04\u00fe\xa3\xf7\x1d\xb4\xd1\f\xf9\u045b\xb3\u05e7\a\xed\x1f\xda*\x92C*9\x83<\xe9\xf8#\xd9\xdd\vU\xefn\">\xdc\xeb\xd7\x05/\xc5\\Z\xc9l\"H2)\x05\x15\vd\xbe\xd7\x19Q\x1e\x98,\xa6[/C\xe7\xe8R2:\x86\x11T\x8b\xf5\x1f\u0280a*\x15\xb4\xb7\x92\u032e\xba\xa5\f\xf2\xafG\x19\xe0V\xa8\xdf\xe9{i8\xdf\xeb\xd8\xdd\xfd\xa4\xa8\x82&\x8f\x99\v\x1b\xbbB\x11\x9b\xd5\x1dv\xfbC\x9d5\x18\x8dbg\x85\xaerU\x98\xb4b`v\x19\x8d\xfd\xaa\xb0n\x9d\xe7QY*\xe6\xc2\u029c\xf8\xb2`0\xeer\x94\xad\x98\v\x1b\x01^\x94a\xf0ma\r\xfc\xb1\xb0\x11>+\xc4Vh.\xec\x8elW\x99\vC\nRi.,\x91\x9e\xbd-\x82\xcb\xff\xc8_\x98\xa6\xd7\xec5\xb7\xbe\x7f\xff\xed\x19\xaa\xa5\xdf\xf9\x89\xbfL-\xf6a\xe7Y\xe1\xf1\xf1\x1d\xbf\x82ya\xef\xf8\x95a\x82\xfb\x1aL\x8a\xcd\a\x1f\xc0\xb0\x18\x12\x93\t\\\xfc\xec%\u073c\x17\x7f_\x8b4\xb3\xc0\xbc\xd8'\xd67\u009f\x8b$\xb5\xc0\xc4\xf8e\xb3\xf9\xb2Xj\xec\x15\xff\xb6\xd9\xfc\xd6x\xf0\x13\x8f\xdb\xef\xc5\xe5\xe9\xed\x8a}_\xb2\xdd\xfd;\x8f\xdbs\x011N\xbe\x7f\xff\xfaE\xbc\\\u0151\x88 FL\xdc\x16\xd1\xce\xe3?\x96c\xe8~\xc3S\x10\xf2\xfd\x18\a\x11e_\xf14\x97\xf8\xb1\xdfVB\xe9\xfeN\xbeL\xaf\x82E\x06v\xc8\xf2n%\xfd$\xfe\"\v\xc3m\x06\xb6\u0229\xe1C\xf1{~\xf4C\xeb\xe8\x92\xfd\x89#\x85 ]\x8c\x0fS\xb3X\xfft.\x0e\x1d\xb0&\x94\x17`\xfcL\xc8\xc4\xfb\xf5\xf9\x0f?\xcc\xfd\xc3\xc5\xf4\xde\xdd\xd2{\xab%Z\u0596Z\u033a\f,J\xb7,\x13\xfbR\a\xff\xbdH\x1d\\\xd8\xed\x88\xed\x96Eu\u60ff%\x82\xfd\x9eY\a\x98\xa3\xba;.\u0553\x99~\xf3qB\xc6\u0458\xc2\x17\x19\x13\x82D\x87\x87\x94e\xc2<b\xb1D\xf0\xa3\xf3\u01bf\x11\xfa\x8f\xe9\xe6\u05ee}t\xc9b\xc1\ufb46\xe5Y\xbfv\x1d\x8bY\xff\x86W\x03\x8bY\x04\xaf\x86\x16\xb3(^\x8d,f\xfd\x03\xae\x06\xa7\x16\xb3~\xed\u0696g\xb5\xacJ`\x16\xc3\n\x19M\xa2\x82\u0697\xbf%\x7f@\u066b`\xbeP\x81A\xaa.\xd8\u07d9nH\x1f\x98^\x16\xde\x19yGD\x1e\x86\x94\x9a\t\x88\xacb)\xec\x9a\xeb\xbc7r\x11\xc17\x18\x95C\xd7\u068e\xc4mF\xa4\u07ec\f\u03d1\xbb\xef\xab\xd8\x11\xea9\u05f9\x86x6I\u069f\u011d\x91`\b\x1f\xe9lB\xf0\x8e\xa9\xdb)\xf0k\x14-\b*\x11C\x00$\xdd\x15\xf0;\xc6\xfbu\x12r\xb4\xb81\x83\xfd\xa3\xd1\x12fV\x85\x80\x02\xa9\xc8\u0348\xbc\xe2\xd9\xef\xd7\"\xb9#;\x91\x8a\xc5\u011a\x00x\x7f\xc4 \u0393?\x13\xc1\x1c\xea)3T\xba\x1d\xaf\r;t.\xc7\xfb5\xee\x0e\u07ff\xff\xd6\u06c1\x16\xa0S\x1e\xff\x92\xcb&t\xcb\f\x90\x1eH\x80\x99\u00ae\xcc?)\u04fd\x90\xca\u0714\x906\x82\b\x16R\x8a\xb30n\xa0GR\xc42Jq\xf0\xc7x~\xf29\x89\xf9\x19\xb9 jL\xa9*\xceH\u0281=\x8eUi\x8cqX~\u0410\xb7x\xa7x\xf4\xef\x89uz\xbb\u00b0\x87\a)P\xbeh&\xe4IVE\xd5w-:\xfe\x8a\x98\u04c3\x02S\uf524\xaa}\x150\u252c\u0543\xad\xd2\xeak\xb6e\x06l\x8b\xa0\xd7D\xb0\x19m6\xebj\x9b\u5548\xf3\x19(\x06\xb6\xac\x98\u022aa\x84\xa0\xe5\xe8\xad\x7f!\x82YM\x8bj\xa1\x8b>\xa4P\x92\xf1\x04\x85.4\xb7\xe3%\xe8\x17\xce,nQV\aH$\xc8\xefHDu\xa7\"A\xc0\"\x19\x8aK+\xb5T$\x81\x1f\x06?\x95\x8c\x1758\x82e\xbcT%\x18P\xab\x84\rY\x0e\x95\xe029\x03\xfb\x8aD\x18l\x04V\bmY\xdcj\u175aU\xd3\"\x1az\xb7e\u0573\\y\u0568\xfa\xb9\u036a\xeb@ck\xfb\xefp\x9bWTB\xea\x02\xa7\x9bM}U\xa0\xf5\x16\xf9\x153P\x0e\xbd\x9fc\xbdl&\xf6\x19M\x9f\xd8u\xc6GcE\xce@\x89\x80\x11\x91(\xc6\xd3\t\x8d\xa57F\xa3\x93\x99`\xf7\xfej%J\xaaD\xa0\x87\xcfv\xfc\u662b\x15Y\xef\x94a\xcaW$\xd2\xe3\xa2\xd1\xd5@T\x94\xf6D\xed|\x18\b\x1a\x84\xee\x18 \xd54\xe4\x14\xccx\xc6\xdf\x19N\x9e\xba\xb1D\x1a\xf1KL\x8c\xf29\x8f\xce\xe3)L3\xe7<\x99\x80\xf3G\f\x94'n\xb5\xc6Y\x19\x8c\xaa\x1d\xd0\x13a00N9\x11\x94p.\x91\x99s\xb3\xf3DC\x11\xe5\x16\x8e\xf0\xacL\xe9\xd7a\x88\x80<\v\xc3_\x06\x96\xf3\xa9\x1a\x90\x1c \xc8\u0454\x15c\x12\x01UH\x18>\x91\u8bc1Iv\xed\xb2~\x99!Q\xe3\xd1jUG\xa4\xa1O\x14\r\xa7\xce \xea\xe1\u0581\x1ci\xb4\x88y\x92\xc3\xe0\x83\xcc1\x90\x80\xa4\u04b3e\xcd\xed\xf1\xfa8\xce%*\x90\xd6#\xe2\xf1\xf9zJ\x15DA\xb3I\xfc\xc9oH\xcc\u0587\x87\x80/\xc4\xc7\bKj\x03\x06\x87R\x7f\xb3\xf9\x8a\xc4\x12\xc5\x03\x85\xdf\u95b2\xa4\x8cU\xe5\xec9\x9a\x11\u040b\xe5-\x119\xa0\xf5\u0641\x90D\x9dd\xf0Wf\xa5\xc1P[\xa5&t\xa2\xd2=A\xb6wf%\u15df\xa5\x88(\xaf\xa7\x84\x90\x8cG\x8a\xb6c\xb7\x19B\xa7lkY9\x90t\xde\x0f :\xa9v\n\x93\xa9\n\xb7\xac\x1a\xa6\xb9\xbe\xb4Ng\xb8e;!\xdd\xeb?\xc8s\x1en\xb7%\xc7)\x14%\x87@\xde~d\xb3b\xe0e*\xf6\xfc+]\xe6a\xf7\f=\xa8\xf9\xae$e\xa9\xd5\xd6V$\x14\xecu\xc5e!w\xfa\u07f2\xfb\xcaA\xca\v\x05pik\x88\xc7\xf3\xad2\xfa[\t\x9e\x92WR6\xb8\x90\xd7(\x1c\xbc\x12\xd5\xfc\xe27\x85\xc1\"\xb2\x96\x17\xf1\x1c\xb3C\u0791\x88r\xce_\u7507\x8b\xf6\x95<]a\x94\xa3oI~O1>\u0577l%`\xaf\x9e\xc5Q&\xa2\xec\x10\xf6\x06\u021b\xb1\xd8}\xca,\x7f\x05\xe7\x1c\x1f\xe08\xba=\xbc\xb9\xb99\\\xc4\xc9\xf2p\x9d\x84\xf2\x845\x1f\xc3y*\x15\x19\xff\xfe\u00eb\xc3!\xe4\a#\x82\xdd\x03p\xdeGb\xb3S\xdc\xf5U\xfb\xf8\x04v\x89m~$\x19\xa3\xc9\xf8\x1bi&_\x8cbi\xa8\xf31E{\x10\xb1'\xd2\u051bz\xbc\xbf\x12\xc4D}\xea\xddo\xd14\x7fI^\xaa\xe1\x9c\u05de \xd4>\xfc\x1c#{\x1c\xbc\xfc\x8c\xca\xc7\xcfKQ0\xe7\x82\xcdM\x06\xf8y=\xbe@\xdf\xd4\x19\u065b\v\x80\xb1\u0222Z\x83H\xb0\x91\x9cad\xb1w\xdb-\xebt\xfa\x0f\xe5\xf7\xc0\x88\x10\xb4>\xbc\x80\xf2\x86\x0e\n\xf9GZ\bM\u0585\xcc\",d%3#\x8f\x85\x11\x13\x99-r\tH\u014bZf\xf0`\xcb<\x91\xb2t\xa3\xee\rF\xb6\x94\x8b\xe8h\x14\x98\xcb\x06\xc5#\x83\x8e;r\xa4|DIBn\n\x01\u0329\x84\xc5\xeeJ\xf9\x88\x12\xc0|\xe4\xb7(\x9d8\u3dc60\x02\xa4\x19\x16\x88IN\u06d5Qd\xcf\xf8i[\x0f#{\xc1S(\xc0\xde\xf2\u05100\xfc\xc8\x15\xcb\xf7:\xca\xd8k\x19\x12a\x11\xc6q\xc2>\u021bU|\u00fe\xe3\xa1)Xx\xc7C#s\xcf{\x1e\x16b\x867<,'\xe5y)\u07ee\xe2\x15\xc8E\xc2B\x06\xf1\x9c\x87%\x19\xc4+\x1e\x1a2\x88\x9fxh\b\x1d\xbe\xe7\xa1!t\xf8\xbb\xbc\xcb\xe2o\xe3\x1b\x91\xbc\xf0S\x01\xfe\xd8\xf8\xf5:R\xdf\xff\x91[\xaf\xa3k?\f\xe6\a\xe9\xecJ@f\xd8o\x8aGWq\x9aY\xec\xab\xe2\x01`\xa1\xc5~\u02cf\xce\xfd\u00df\xa6G\x01\xfb\x1d\xe4\x12\x9a\xb7\x0e\xdb\xea\xfe7\xfc\xe8\x87\xf9\x11\xfb\v?\xfa\xab}{\x14\xb0?C\x02!\xfbp0m}u\x04R\x90\xbf\xfe0\x87\xab?\xc1c)\xd1h}u\x140\x01\xb2\x82\x1f\xec\x1f\xb2\x1f\xa2\x1f\x92\x83_\xfd\xfa\xc8;>\x99\xfc\xfb\xf9\x0f?\xfc0\xfd\xebfz\u0132\xf2\xfb\xea\xebH`}k\xdb\xc6<E\xb6\xed\xda\xd3\u05a6\xfa\u0ae3K)\x94\x90\xd5L\xa5Db\xcf!\x12\u0211\x15\xad\x97\x17\"1\u03b7r\xcb\u0340\u12b8=\x8e\x8e\xbb\xe3\xa8\u0562\x7f \x19\x13\xbfv{}\xca\x04\x7fM\xc4\x11\\j\x0e\xeb=\x10\u04f6E\xb7Pc,\x1d\x0fvk\xb4\xacJ\xa8\u0742\xdf\xc2\x13z\xc4\x1d\x96\xc8\u02d8\xdb\xcc\xe7\xf6\xd8?\x1e\x8e\xfdV\x8b\xe2\xb9\xfd\u071fNH|\x12\xa1\xc7M\xc2\"\x1eS\xa3<\xf5\x88\xca\x12\x9a4\x9b$\xe1>e\xadVL\x8bH)\xa5\ufcad\xf4\xae\x84\x0e\x0e\xb1\x83q\xb3\x89\xf9\xfd\xce#\xf4t\xc5p\xa6\xe06\x99`\xde=\x92\xb5x4\xb1<\xf8\u05f3d\xf0Y\x0f\x1e\xbe!\xf0\x05$sb\xd1\xf1\x00\x9ahq\u02f3(-\xfc\x99\xacV\u05b2\xa6V\xb1\x0f0_@j\x90@\xf09x\x9f\xfb\x82\xdd[\a\x96\xe7\xb0\x7f\xb3\xfe\xcds\x98u\f\xd7\xd6\t\xfe\xfd\x9b\xe59[\xcaRU6\x80\xb2\xbf\xc27\x13\xfc{\x8f\x7f\xb7\xb2\xd4Z\x95J\xa1\xd4\x11\xbe\xf1\xf0\xef\x18\xffr\xfc\xfb\xef\xf8\xf7\x1c\xff\xfe\xf0\x03\xfeL\xf1\xef_\xf1\xefFV\x15\u05bb\x17_\xa2\xd8#g\xf3O:n\xb3\x19\x1d;\xee\x00\x1dk3\xb0\xd5\x17\u07ae<\x12\xe3\x0e\xce\x04\xbf_d+\xcfu\xd8\"\b\x85\x873w\x95e+oh\xe3o\xeau\xbb\x1dv\x93\xc2\xfdM\x8aw;AK\x15 \x1a\x02\x97\xe7\xd1l\x9a\xcdw\xe4\xb7\xec;\x04\x10\xbc\xb2,\xcf\xc2,+\u07e1\x88F\xc5[\xb66\x16\xb0\xe9\x95\u0426\xe5\xb0\xc9\a\"\xdf\xf4\x9a\u0355 \xdfC\x95\xcc\xc5J\x8d\xf66\x1b\xeb\b$A$\xc3&\\h\x02\x06\x14dW\x9b\x8d5\xd1\x17\xbf\u008bj\xfcS\xbd\xf7Zmx-6\x1b\xeb\u05ee\x80K\x14g\xb29b\xc8\x12\xff^\xe3\xdfK\xfc{\x87\x7f/\xf0\xef\r\xfe=\u017f\xb7\xf8\xf7#\xfe=\u00ff\x9f\xf0\xef3\xfc\xfb\x02\xff\xbe\u017f?\xe2\xdf\xd7\xf8\xf7\x03\xfe\xfd\x0e\xff\xbe\u00ff\xef\xf1\uf6fd\xb1\x85\x99\xcf\x02~\xa1\x84N\u04b7#\u6170\x80\x04\xda\xdf\xf3-\x89Ud\xcb\xd4\u0606p\xb5\xa2d\a\xbe\xccEr\x11\xaeX\xe0=\xde\b\x12a\xb8XV\xaeV{\u0359\xb5\x13\x1f\x1c\xfb\xc5\xcd\xc1'J\xdbJ\u0126\xe5\x92;\r\xfb\xdb\xed\xf8\u036el\x0ewL)'\xaau\x19T\xc1\xf1Q\xd6&e\x150\xa7s\x01y}\xd8\x02H\x1a\x06j\xbe\x84?w\x10\xc4P&t\xbd@\x1fQ\bX\u0616{\x0e\x94\f\xdb\xebT$\x91\xaf\xefV~\x9a\xde\xc4\xc9\\\xde\xc1F$\x89Y\u0606=(\xbf\xf6\xb3+\xcc.*E/\xfa\xf1\"\xf1/\x81\x15\xd3\xf73?\x8a\xe2\xec\xb9x\xf6\xdcO\xe1<\x05\x00\t\xfe\x9c\b\x16\tfY\x94\xaa\xbb\x04\xefX\u03170\x89\xab\xe3<)\xe88\x0fO\xe7\xf3\xf8|5e3\x15\x9en.<P\xcb\xfb\x9bM\x03\x16\x98/\xd3\xdcf\x9a\x05\xff\xe3x\u01af\xc5\x18X\xe9 Z\x8b\xed\xa2\xc5\xff\x8e\xc1\xba\xf9R\x98Ih\x97X\x8d\xdfl\x92w\xe4w\u0307\xc5\u04b28\xf77\x1b\xebP\xfd\xc2R\xf0)U5\x8c5\x9aX\x9e\xd5\xe0~\xb5U\x1c|h\x1aS,\xe5\xcdC\xa1f\x93\x84\xed =\x03\xf7B?$\xb4\xc1\u0473y\x01m\x02\xe5\xb10\x87\x02\x94Q&\xb9/\x121\x17\x11X\u0724\x04\xc2+\xac\u00f0\xc1\xb9\x9c\a\xe3#=\x99\xcdfC\u03976@A\t\xac\x9e\xe9\x05\xcb\u032c\xb1eP \xf2\xf9\xb9.:\xd5m 0\xf9\xa4S*;Wmv2\xe3/\x84W\xa9.j6#\u0772Y\xf2\xb2Rr2\xe3\xa7\xc2C\x8a\x15\x9f\xafZ\xcetBf\xe0Q\x0f\x892=R\x87?6\xfb\x96H\xf4C\x8c\x99\xf1\xef\x04\xdd\x1a\x13z-\xf1\"\xdal\xa2\x9d\xaf\x9bM\xebW8i\xf9t\x05\v\xb2\xa7\x18\xcc\xf9}>z\xba3\x1a\xf3\xafI\x84\x17\xb4X\x00\xf2\xd7\\\x03\xb8\x80\xeaz0\xe3\xef\x15\x12ng\\\x8f\xa7nb\xf2Bx\x17\x05\xe6\xca^]b\xaf\xac#\x80\x1e)|C\x8d\x17\xbd\x9fq\xa3\xf4v\xc6oq\xf4L\x1c\xbf\xd3\x1fc\x9ff\xfcc\xd1\xf8\x87jC\x17Xv\xb7\xdf>\xe7\t5HE\x94_\x9a$#\xca/s\u0481?9\xf1\xc0\x9fG\aq\xac\xb3\xafJ\x98\xf5\x06\xe67\x9b%\xe4\xa13~#\x8a\xb2\x13\xec\xdf\x7f8\x8c\xb8\xbe\u07c9\x82\x0eH\x94\xba\xff\x0fl\x18~u.\xeaCV\x9a\xb5\xed\xff\xbf\u6902\u0600\xc2\u6cbb\x91\u02ee4?\nQ\xfdf\x13\xe6O\x13K\xeb\xe8\x97\x1d\xaf\xf2p\x00vK\x1d\r\xac\x04s\x15\x9c\"\x84\xf0\x94\x95\xd6\xd1wd\xc1V-\x87\xd2|!T\x96\u03ed\xb1\xf6\x8c\xae\xe0:\xca\xdb5\xca\x7f\x94\xe5\xff].\xb7y\xb3I\x16\xdc\xfau\u05f6Z\v\xf4\x8d\xb6Y\xc0\x97dQ\x88Vo\xb8=\xbe9\x0e\xf4\x8ew\x03\xe9\xd6\xe1\xf9)\x0f\xceo\xa6c\xbd\u05dcn6w\xca%\x9f\x87\x82\x9c2\x10&\xdfM\x8a\x11k\xf1[\xaf\x18\xd5\x16\xbf\x95\x03q\a\x1e\xf8@\xba\xf3\xa4\u01b0\x98\x15\x9f\xe8+\x9e\xd0W,\xe1\xfe\u0146I\xe6\x9bM\v\xb6)E@\xf3\u04e9\xbf\u03ae\xe2$\xc8\xee\xac\xf1\xea\x10;\xa7:\xd3r\x98\xda\x11\xcf\u0534,Z\xdc7G\xf7L\xc8,\xf0\x9fp\u0500\x17\xae\xec/0\xd2?\x8a\xd2V*\xb7\xde\xcd\xe6\x92\xde\xff\x9c\xdeT\xf6,\xb3g\a\u07cc\x154\x9f\xb7+\x1b;o\n\u03c0\x83\xfb&N3\xb2\u042f\x0eRx\xa9\x06\xe5\xb5\xc8w\u5883\xd69\x00<\xb9\xe4\r\u06f3\xa6\x12xr\x89\xa7>\x18\xbc\x9cO\u0745\xf7)M>\x13,\xe3\xfc\x93\xf6\xed0\x11\xf7\x99\\\xc3\xef\xc8o4;\xf5%C\xbb\xd9H\x06\u0732\x1a|!\xf1\xf5#\xff\x91,\x98c#{\xfe\xf1\xa4\xdf\xebuz\x1a\xb6\xaf\xc6j9Wf\xe3#\xe7\xdcdJ&0\xd0\xdeGD\xa7\xad\xc1s\x8da\x14\x8b\xc1\u04f5n\xabx\xf6\xa2\xbc\xb1I,c\x95\r\x86\xce\xf8\u06c2\xb2\x03\x13!\xcb5xd`\xe3\x8726\xaa\xad\xb1B\xa1\x9e\xb8\xc1\xe9M\xebI_\xef\xdbz\x16\x82\xbc'\xd7$f+\x8aL5r\xf8\x8f\u0518^\xc5I&\xa2w~vE(\xdd\xd9T>\xa77u[\x83\x89Wo\r\xfe\xa3\x18j\xb9\xac%#\x12\x15\xcb>*\xb8\xd9j\xb7\x9aM\xb2\x12\n\b\b\x0e\u0770\xe9$g\x06\xf3\xc7\xd4+A_\u9684\xe8G\xe1\xedb\xf7\x0f?\u0521\xb9\xf4\xd9\xce\xf0\u023d\x00\x96\xe3\x83\xc1r\xe0\n\x94\xa4\x04\x9b\xb4,V\xc2\xcb|\xad>\xb82\xad0\x9e\xf9!T\x80d\x0f.\x90\xe2\xa8*iQgN6\xb6\xe6\xc1\xa6\x84\xe7\xaf\x15\x9eW\x89\x1d\x00\u03aa\x9bX^\x8b\xee\x91\x14\x11\xc0+u\xf3\xab|\xdf\xf6\x1b(\xc22\ua855\xefw\xd1`\xac\x9e\x97\xd1\u05c0\xf6\xc3\xfe\x89\xd8!+0\t\xa429\x92\u04b4QZA\xd6\xfc\xefd\xcd\x17(\xf1\xf8\xb5+\xf0\xe1\x1a\x0epJ\x86\xb1\x96\xcf\xf5\u0744TV\x01{\f\x02\xf3\xe4A\xbd+@\x88\xc9\xe7|\xe3\x91\xda3\x9b\xc1\xde)4\u02e7\xbf\x8c\a\vdVl\xda\x02Q^q\x0eZP\xcaj\x8ff\u0366\x1e\xda\u02a8\xa1\x19Y\xa9a\x10*\x01:\xf3P\xaf\xa31}\xa5\x1a\xa0c\xf9\xfd\x84Tf\x92z\xb2B\x84\xb2:\xf74\xdf\xefCA|\x96\njN\xfcw\xc2{\xa4\xd2I]\x9d\x9e\xc2\xc2\x1cJU\xbb/h\xa9\xfaw\xc2\u02f1w\xa2\xbe\xb1\xfe\xadf\x82&\xaa\xf5\x16G\x8b8\xaf\xb8\x95@X\xbfv;\x96\x97\xb7\xe1\xd5\x01e6\xfc^\x14 \xea\x82\n\xc6\x00,BV\xad\xd6v\xcbrRPoI\x85D\x01\xb8\x00%6D$\x9f\"\xaf*\x98\x16\xf4\x1d:\xb4\u032c\xf0\xfdVY(+\xe2\xe76+\xfd;e!\x87\xc3(\xcaj\xa4\xe4hW\x11\vM\xae\xe8Vq\xa1\x9c/\x14=A\x1eL\t1\x15uZ\xb5\xb8\xcbf\xbc\xd5\n\xb7\x88`\vH!\x06e\x87\x9c\x87\x06g\x84\x9fb=R\x02\xaf\x04\xfa )\xfd\x13\x83\xe7`\xdf\xe8\xf4\xbf\xceZ?\x92\x05\xa1(\xc5^\xb5Z,j\xb5\xf0\xf3\xb6\x01\x86]\x987\xc0\xbb\xd5!\x8fXx\xd2\u05cf\xa0\x010\x8f\xc8A\x89eo\x93\x13[\x12\xf6\xbc\xb6f39\xee\x1a]im5/\x04M\x99\xf5\x8d\xf5Cm\xb5\xa6\xa0\xb4)S\xa2\xfe\x98\xc6\xdc\xcfwi\x1b\x1e\xa8\xefc\xee\xd8_\xc7-\x1f*\x8fO\xdc^\xcflp\r\xa6\xecn\xaf\xff5\\\xb4b\xe66x\xab\x954\x9b\xdd\x06\xac\xe1P\x82\x047\xfa#\xb9yVg\x06\x86\xaa\x017\x8a\xc3\u04f4\xbdx4^\x9f\x87\xad\u0594g\xf9\xfe\xa4\xb8\u0619\x01\x8e\x9a\u026d\xf9\x16\xba\x1f\xf0\xf0p\xc6B>\x18\xdb\r\x1e6\x9b\xc1\x89=\xa6)\a\x98\xd9\xfa<<<\x9c\xf2\xf5\xf9\xac\x15\x1c:p?k\x1d\x1e\x06S\x9e\xe6{\xe6\xb0Q\xe0\x82\xfc9XoQ\x98\xed0@i\xd6\xc8\n\xb4F\x81)\x92\xbf\xac\u0622\xe0Yuw\x13\x1c\x13R\xbd#\xc2\f\xd9\xf7\xc4u\xf1\x13\x11\xa8'\x82\xd2\xeb\x9c\x10\x035\\\x9f\xaf\xf3\xd56m6\xd7\xc5!\x9cd\\\xdf\u0453\xaenP\x8c\xa5\xbdca\x1b\x87\x06J\x9a['1_\x9f'SZ\x14G\xfcql\x16\x9bd\xd8\u0195\x1f3\x9b\"\x01\x7fG\xfe\xc2b:q\xfa\u0790\xc5\xfc{\x12\xb3!P&\xc7s)eH\xb3c\x1ap\xbb`[\xdf\x11\u01c6\x12\xbf\xf7\xb0\xe0\x9f\xbd?\xb1\xd8l\x93\xffHb\xe6\xd3\xed\xb7$b\x01\xdd\xea5\xa2\xa1\r\x16$\xe0\xd1y\x02}\xe0\u0661\x83\xe0\a'\xfc\x03q{}\xd6;,\x1c\xdcr\xb1\xf9\x01\x96(\xd0\x19\xdf\xe0X\xa4\xfc%\x89\xb4\x89RdZm\xa5-l\xe4kYm\xe70\x0f\xf7z\x90J-\x98\\K\x0f`\x03\xc0\xf5\x8ed\xe5\x19\u03d5z\x11\u0292\xebZ\x96\x04\x19{\xe8\vZ\xaav\u02e4\xbc\xee\x1b\xffZ|\xaf\x0e\xd2\xef\xd4\xf1\xfa]\xc5\xd4H\xd9R\xe5\x9fo6xY\x15\xf8\x15\x82Z|-w\xe6-\xab9N\xeeV\x0eg(\xfcJ\x1f\xea7\x9b\xfc\x91>\xf5oY\xbe\x18v+8\x90\xc13\x8b\x86\xc1d\xaa\xe0yv-\xa7T\xd5\xd9\x15\x1aQ\xabAkd\xb5\x9dh6!\x11'\xb2,B\xf1\xe3\x9bM\xbe=\x1d\xee1)-\xda\xc1&dUhN\xa3;\x89\x19\x14u\xf7X\u0305<\x86\xf8\\H\x89O\x80\xaf\xb3+\x96r\xa1N k.\xf2\ud585<\x03\x0e\u0274\xfakp\x1eOH\xd8\xe2\xd6\u0451\xc5D\xfda\x1e6\xed\x16\x8fZ$\x01\x95j+\xf1,\x8b\xb6\xac\x7f\xb7(\v[<\x16$\x96H\xd9P\x1c\x0fjT[>\xa5^>6\xea\xf1\u0451\xfcF\xec \xc3$\x00{Q-\xea\x01\xe6\xb1\xf5\x9e\x04\xc0xR\u03f2t\xf5\xa9\xaagb\xb5\u04bc\u0375z\xf8+\xab\xb5\xa6,\x84\xd1\u037eI\xc4bO\x9a(\xa5\xa2\xd2*1\xad\xa0\xcaj\xd4_\x86\x917\xc4\xd8L\x82\xcb \u0683\x19j\xb6t\x13q\x82\xb1*\xad\x8b0\xbe\xb08\x17\xd4p\\@\x9b\x1dAD~.k\xc7Xs\xe1\xcc \vZ\xd0?\xad\x86\xd6\xc7m\xa1luMNM\xb4,\xef\xe8\xc8j\xa9\xd8\xe7\xf2t\xd7\u049bS\x86s\x96\xc1\x9cy\xaaJ\xe8\u037b$\xce\xe2Y\\\xb74\x8c\x0e\x01\xba\xe0\x80\xee\x16\xd76\xf1r8A\x83\x06\x85\xd9\\\x85\xb6\xd3Db_\xfd\x1a\xa7\xb1\xf6\xdd\xc2\xf9\x94-\xb1j\x95p\xb8 #\xfb\xe8\x10l{\xa5\xfa\xb9e\x15\xa6\x9a\xc8O\xe5\xf6\xa0`FP*\x8b\xe4\x0fR\x02\xb15\xa6\x90\x85aRu\xef\xebFAl\u04ba\xc2?\xbb\x1b\x86\xd2\xf1\xd1n\x14\xe2\u039dn\x94Y\xea\x12\xe6\"\t1\xf1\u05a0\x0e\xa0\xea\x9eX\x96\xa7\xae\xb3I\f\v\a\xa3\xb9\xe3l\xb72\xb9\u06aa\f\xfb\x1ezo.?v\xa6\x10\x05>\xae\"J\x05\xbc:\x90\x10\x86\xbc\xf5\x1d\xe4y\n\x04\x9f\x14\x04\xefjMe\x1f\x1c\x90\v\xddx\xf9\xdbr\xc3\xfb'w\xb3\x91l\x8f\xd4<k\xd7\x16\xadP\xf4JP>\x13T\x81\xe9gW\x0f\f\x14P\x93R\x02\xfd\x1d\x12\v{\x91'\xca$Vh\x12\xab\x10\xb8\xda\xc4\xfe\xa1$y\xab\xb9\xf3\x8e\x06\xf9\xb5\x06Y\x9a\xb4\xed\x01X\n\xfc\x14\xc4\xe8\xa7\xd3\x12\x1a\x90\ua1c2\xde\xef\x0eX\xa1`\xf7\xa4\xf8C\x9e\n1\x1b\xed\xf7\xf2\x00\u018c\x82\x96U\x86\U0009d814=B\xf3K\x96\x8d\xfb(\xa5\xf9\xb5\xcc|+\x11\xdbO\xf7u]\xef\xc8F\xef\x7fe\xf4\xbe\xfc%\xf6\xbdQ\xf4\x9dX\xbfz\xa0\xaf\xe6!\xbc\xd4\xdd\xf7pJ/\x17\x91f\xff{|L\xe4\xa0\xed\xf6\xd00<\x96B~\xe5*\xf2\xb2\xce}O\x9eJ\u0637h\xbc\xf5\xc4\xdcG\t\xffH2\xa6\xacK0\xb9u$\xcd\xdeI\u05beJ\u0102'&\f,S\u06e7\xcc\xd1&7i\xa2\xdc\xd9q\u02d2/\xf4\x06\x86\xaf\xf2\xcd\x01_\xe95*\xbf\xd2\x04W~\xa5\xee\xf0\x15\xb2\xc2\xf8\x18\x85\x95\xfaQQ\x91\xa6D\xb2\"X\u03b2\x12\\\xf3X\xb7\\^\xbany\x87\xaf\xe4\x10\xcb\x17\x12\xe9\x8c\xc7r\xe4\u0357\xf2\x89\x04\xc1O\xd5w\x806\x04V\u07b7\x82\xbf4lg\xd8\xf3\xaaM\x96D\xbb=)T\xcep\xce\u8e58\x12\x15\x1f6k6k\xac\x90u\xb9l*\xcd\xc5*\x91\xf8\xcb\x16\xe9('\xf1\x9b\xcd\x19\xf9V\xb0{\x98F\xef\xb9 V>\x91\x16\xb3\x14\xdbfQ&'\x14\v\xe4SjQ\xa6'T\xbf\xd0S*\xbf\xcd\xef(\xd3\u04eb\v\xea\t\x96\x05\xf3;\xca\xf4d\xe75\xaa{U\xa3\xbe\xa3\f\xe6Y\x17\xfa\x06MP-\xb5\xf3\xa8\x97fs\x1a\r\x8aB\xba9\xd8,tSh\xb7j\xa9-\x04aQ\xb47\x87E\xdekX\xd4\x1de\x12't1\x89\x0f\xb2\x90\xba\xd6E\x14\xd9*\x15\x94\xcf\x00h\xa01\x1a`?U5\xe0\x15E\xff\x81o\xd1\x13AF\x85'{\xb1\xe4q?\x84o\x1f\xf3hxbM/$My%\xf8\v\x95)_\xfa\\\x82a\xf4O\xf00\x11\xd7\xf1\xa7\xe2\xe1\xf8\x95h6W\xe4\xa5`V\xa5\xb8\xc5\xd6\xe4\x95`/(e?\xe5e*_C\x99\x9f\xb0\xcc\xf6\x06\xdf\x7f\x8f\xa6\xdaq\x9dy|\xa0\x92\x13\xf8\u04ad\xc2{)0(\xc0p\x04\xe1\fU\x16\xb3\xee\xb0;\xac\x89\xbe\xd0\xebvG\x1d:.\xcc\xe9\x93-\x1bv:\xfd\xba\xbc\xb1n\xd7\xeet+e\xfb\xee\u022e\xab\xd7q\a\x8e])\xdb\x1d\xb9N\xbf\xa6\xech\xd4q\xab\xf5B\u01b0\xbazG\xbd\x91=\xaa\x94\x1dt\aN]d\t\xa7\xdbu\xab}\xeb\r\x06\xc3nM\xd9!\xa4\xc7\u0701\xc1\xed\u058d\x83\xd3\x19vl\x8a!^ PB\xe9\x1bw\xe8\x8c\xea\xfa\xe8\xf4\xddn\xbf:\xcev\xbf.\x8fi\xafoww\xc1\xeev\x87\x95\xc8\\\x1d#\xa4\x83\f\x98\x10\x17\x9e\x05~\x9e\x7f-\xc0\xd8\x0e#\x99\xd2\x17]\xed\r\u00bc\xe6\xf7/\u07fe\xf9\x10\x7f\x12\u0477A\x8a\x89\x17\xbe\x8b\xe7B]o\r v\xb7\xd6\u070fhl\xe4\x10O7\x1b\x9f\xa4\f\xa2wgp\xab\xcbl61Y\xb3\x04\xf9\x87\xc0\x03q\x8e;\xea\u05a6qu:N\xbf\x8a9\xfd\u0460v&\x86vwgT\x1dgT[\xaf\xdb\x1dV\xab\x1d\xf5\xed~\x1d2\f\x9c\xee\xa0\n\x81\xd3\x1b\fj\x8av\\\xb7S\x85\xa0\xdbw\a\xa3/\x9f,\\&\xbf\xfcd)g\xb9\a'K\x95\u065d\xacNg0\xa8%\x1f\xc3^\xaf:T\u03a83\xa8\x9b\xacN\xb77\xa8\xce\xc0\xa03t~\xc6P!\x95\xf8\xe5\x87\n\xbc\xf4\x1e\x1c'(\xb0;H\x8ec\xbbn\xdd \rz\xfd\x1d\xba\xe9\fFu\x03:\x1c\xba\xc3\xea\x80\xf6\u071e[\x87{\xfd\xa1\xed\xf6*e;\xfda\xaf\xaelo\xe4\xeeL\xd4\u0435k'\xaa?\xea\xdbN\x15\xa7{\uec0e\xae\xb9Cw4\xda\xd9\x13\x86\xbd~m\xbd\x9d^\x15^\xb7S\xbb-9\xc3N\xa7Zm\xa7\xe7\xd4\xe2\xe0\xc0\xe9;Up\a\xfdN\u07e9)\u06c7|\x8d\u0572\xce\xd0\xe9}9\x0e\xe2\xee\xf3\xcb\xe3\xa0\xf4\xfd|\x10\ve\x91]<\x1c\x8e:\xb5\xc4\x12\xd2VV\xd7joX\xbf\xcd:\xb63\u0733\xc5\xf5;\x9da]\xfd\xdd\xdeh\a\x15\x9cnwP7\x0f\x9d\x9e\xdb\xdb\xd9\xf2\a\x9d\u06ads0\xb0\xddJ\u046e3r\xec:\x10\x86\u03a0\x8a\n\u00fek\xd7\u019d\xea\xd8\xc3\x1d\xb4\x19ukW\x9a;\xec\xd7\xe0x]\u0251;\xe8W\u02cez\x9d\xba]\xa6cwG\u00dd\x1d\xa9\x1c\xbf\xbb\xd8\x14G;\u057a\x1d\xbb7\xaa\x1d\x84\xee\xa8:\xb6N\xbf?\xb0ka\xe8WWdw0\x18\xd5\ud7e3a\xb7S%\xdf\xee\xa0\u06ef\xab\xb6\xe7\x8ez\xfd}\xe8\xe3\fk\xebw\xba\xa3a\x95\xed\x81\x14\xb9\xb5C7\xb0G;\x94d0\x1aV\xb6\x12\a\x13\xba\x17\xac\xae\xdd+\x8f\xb7\x11ck\xcbFn\xaf[K9\a\xddAgO_\xba\xfd^-n\xb9]\xd7\x1d\xec\xf9f\xd0\x19\xb9uh\x0e\xb9\xaf\xf7q\x95\xbdJ\xf8m\x83Q\xe9\xedp\u00ee]\xbb6:.\xf8\x84\xee\xe9\u01e0_\xfaF{\x90>4v\x15\x87Q\xa0\xa3\xddJv$\xf4\xbc\xa5\fpg\x04y\x8c\x9eX\xed\x96u\x9c\x91\xdd3%\x03\x8dRzF3\xff\xa6\x94G\xef\x84\xea\n\xa2\x03\xc1\x92<g.\xdc6\x9bEx\xaf :\x90oX\u032dWA(\u07a3\x13\xb6.\xf7\x1c\xb4&\xf2\xda\x00\xa2\xa2B\x81B\xacaW\xb5&\x90R\x8e\xc0&a\xbd\x8a\x93\xe5K?\xf3%0\x01\xb7p\x87x\xbe^,TK \x92\b(\xf4 \xe5\xe7\u05b9t\x84<x\x1deC,9\xb5X\xfe\xf0\xfb`\xef\xd3\x17\xa1\xbf\\\x89\xf9\xce\xcb\xd7Q\xe6\xf4k?\xa9y\xfc:\xca:nm\xe1\x9a\u01ef\xc2\xd8\xdf\xfb\xbc\xdfU\u03e7l\u034d\x1e\xb7\x83\xf4\x0f\x81\xb8\xd9lj$9\xa2\xd9Le\xb2\xf9\xb7\vRMX\x9c{\x04\xb7g~\x18\xc2>wr\xe8l\u01fa\x9a\x83P\xc5\x00\u0421\x97\x1a\xb9#)\n'\xe5\xb7\xf0\x19;:\xff\xab\x7f\xf8\x93}8\xfa\xe1\xf0W_\xfd\xba\xf9o_\xb7\xda\x7f\xfd\xf8\xb7\xcd?\xa6G\x81\f\x1e\x98g\x1f\x84\xf9\xcd\x1d\x9dInM\r^\xcc\xfe,\x13\tD\x17\x92~\xfb\a\x8b@\x84\xf3\x03)\x1a)\x1c\x02\r\x0fcB\xb79\xac\xb3\xa2\u04cf\x83+\x8a\xefV\x05kp\x1f\x89\xdb\x1dm\x01\xeak\xc1}9O\x8bw\x8f)\xfa\x8bt\x84*PG\xb6\xdd\xe6i<\xc1\x16*;\x97\xeb \x0f\xc65\xad\xb14\u02b6\xe0\x00\x9b\x03\xb3\u0205\xf2K\x7f\x05\xee\x7f\xe2 Py\xd0\xe3\xc5\xc1b\x92\x9f5\xaaQ\xc5\xf0\x1b\x19_Gf\x06\x95\"c\xeaI\xd6)H\xf1\x17Bm\xd5VQ\xae\x00\x15\xdb\x02\x02\v\xe4\u0548fS!OM\xfeC\"hM\xa5\xbbP\x9dgE\x8dE\x9f\xaf\x14\x92\xc9\xd0\x12\u07e7b\xae\r\x1bTpT\x15\u0654T0\xe7Y\x98\b\x7f~w\x00\x7f-J\xc7\xc5\xf7h\xfb\xaf\xab\x9f\x1b\x8b\x01jP\x95\x12R\x8eT)\xdaq\x14\xc6\xfe\u071c\xa3\x8c\b\x15\u041fn\x19\x14\xc0P\xabf\x89\x88\b\x19\x7f\x15b;\x18}Z\x16H\x05m\x16d\x90E|n\xe4\xef\xcc\xda\x00\xfd\xb3\xd4X\xcch\x06R\xd4t\x9d\x8f\x8e\xf4\xb3\u03d7\x01\xde\x12\x9b\x8e\x8bf\nJ\x06\x83y\x97\x89o\xa5\xadN\xd1Z*2R-I)\xcb\xda\x17\xd8v\xd1\xeceE\x11R\f\xad#U\x0f\x1f\x83(\u021e\xc7\xf3\xbb\x1a\xdf]Y\x00>y\x1d\x05\x19\x17LLj\x82\xb7\x15\xa5>\x88\u06cc\v/n6\x81\xf0\x1b\xe4)H\xdf\xe9\ubdcb<N\x1c~\x03%\xb9\xf0\xfcfS\xef\x05O\xfbN\x97\xe6\u008b\x9a\xcd\xca\xde\xf6\xb4*$\xb89\xed$\xd4\v\x9aM\xf0R'\x19f\xac\x84\xea\x81\x1e\xef\xad-\x03MOQ\x9f1\xf9\xfc\x1af\x0e/)+J\xe00\ua351\x9c\xd7~:\xa5\b\a1\x1e=\u041d\xcdf](\xdb\ua820^\xf1Jv\x98?\xba{T?\xd1j*\x15\x86\x05\x13\xd5\xed\x84\x7f!\xfbPC\x7f\x95\xee|\u016cL\xdcfG\xab\xd0\x0f\xa2j\x1c\x18\xaf\x8c\"\xcdf\xf9\xbe\r\x15<Z\x7f\xcd7\xf4\xf3\u0425\xd9|\xac\x8d\xcf\x0fqC\xb7,V\xb9\xa4\xdb`|\xc2w\x14\x90W*\x04\x14F\xd53\xed\xed\xca\x1d\u06a5\xaf\x180\xbaZ\xaa\xfc\xa1\x81\x1f\xfb\xbe\x7f\x1cC\xcbU\xea\xb5hp\x06\x8a\xb6\xcf\xe25\xec\xfcq\x86\xd4\xfd@\x17<\x80\xaf\x0e\xfc\xf4\x00\xba_\xf0\x04O\x81\x03\x10r\nC\x88\x8f|\x03\xd9\xf7\xa8~\xab\xd0O\xd4\xe0n6\xfb\x87\xcd\x1c#/\x9f'B\xdb\u0655\x88\xc8Ro~m@\u07dd\xd9c2\xfc\xa11\x87\xf9@'\xfb\xe7P\xf0\xf2s\xb6g\xc7az\xab\x81\x81\xc0=\u6ce7wO\xbc\x90\xeav\xc2dJ!y\xab-Yjc\u06015\xa2\xe2\xca\u0690o\xeb\u0155\x9f\xbc\x88\xe7\x02#\xdb\xd1\"T)\x04\xb1!\x96E\xb7{\xe0\xfd\xc5\xd0\n\xe6e?Z\x95\x91\x89n\x99\xafW\xe3Bo*{p\t\xea\xd5Xp\x93c\xc1\x8fi\x1c=\xe5\v\xd0\xd3IU\xbfB\xdf\xed\u00a0<\x92\xbd\xaa(\x81\x05\az\xcc2>+\xb2\x82i\xb6\xf2\\L\xc7\xc65\x8f&Q\xcbb\a`G\x96m\x99Y\xb5\f\xa2X\xda\xe0\u58c3\xfc{hfZ\xfe\xeaRdu\x81\xad4HH\x161o\xea\u0100\u0093\xf6\nf=W~ZW\x8f\xfe\b\xde\x1b\x8c(\te\xf0I\xb3\x86TT\x13E\x97\xe1\xc6\xd1)\x7f\xa2\xd8\xd8\xcag\x1a\xdb#8\x9c\xe8:\xe8>H\"\xdal\n\xb9'f,o1\x9a\xb2H\u01f33[\x04\x81\xf7.%?\x9f\x96\xec}j\xb8k\u0274B\xd4&\u030d\a\xeaXQ\xa9ZJ1\xbf\xa4\xf2\xbc\xealO\xd5:\xb6\xef\xcf\x03\xfc<b\xd9\xd4h\x01\x8eMF+5\a\xa8]\x10$z\xdf\xf1s\xeb\xe5\u9de7\x1fN-f\xfd\xe6\xf4\x83\u016coN\x9f\xbd\xb4\x98\xf5\xf6\u0747\xd7o\xbf;\xb3\x98\xf5\xee\xed\x19<\x7f\xf7\xfd\akZ\x9cq/\x8cP6,a1'\x19\xc4\x15\xb9\xdf\xd2<\xd4]\xe9\x10vQ=\xac\xd4\x1ek\u02c7\x93q\x11a\x18\xfeJ\xa4\x98\x15f\xb8\\\x98w,\xd3|\x83\xb6\xc4R\xb7HW\x17F\\=\xb5\x9c\x96\"\xbb\x8a\xe7\\\xa8\v\xf50\x9e\vx\x14\u03f5-tp\x19\xf9\x00\x81\xbc`\xb14\xf1\xe1\\\x14L&\x84[2\xefY\xe9P\xa5\xbc\x9b\xf2\xce\xe4\a\xea\x9c\xfe\x9a}\xca\xcc;m'n>\xb1R\x7f)\x0e\xa5A\x88\xc5\x1ay\xaf\u02fcS\xfd\x18d{\u0180D<S\u05eaI}#\xb1\"\xe1Q;\x8b\xbf_\xad\xb4\x00\x81\xdd\xe5\u0491\x04\xa4\x1f\x93\u010b\xa81\x82\x19\xfe\xe8\xba\xf0\x12F\xad4\xa4\x99\xbaP\xa5\xe4\x8d,\x91\x88\x85H\x12\xa1bc\x11\x04\x82s^\x86\f1\xb5\xfc\x946\x9bq=f\xc1\x99\v\xb70?\f\xe3\x1b1\x87\xb8\xcc\a\xbf9\xfdp\x10'\aP\xd1A\"\xa3\xf9\xa5\x1a\xed\xf2\x93\x1a\x89\x8d\x13\xeaM\u512av0C\u0312\x04KBe\xac7b5-Z/7\b\x16\xba\xa2\x88\v]\x9a[2\u4e12\x9b\xe8prD\x86\xa5\u01c8\xef1\xd7\xfb:\xb7\xea\n\x8c3-8\u060d\xb4O\x12\xcaj\x9e\xc6\x14O\xe1\xa6<\xe5T\x91}@\"~\x9f\xb3_\x10t\u021a\x8b\x85\xbf\x0e3\xc5\xed\xa7\x99\x9f\xadS^Hu\u0513\x89k\u06de\xbe\x91E\xe3O\xdc\xf8\u413b\xb6\xddl\x1aO\x8e;\xb6mV*\xcfB\xc55\b(\xb3If\xbc\xf5\xac\xb7\xbf+\x9f\x95v\u043c\b\xa1\x9e\xc1_\xf0\x89\xa8\x1c\u0101\x82\x9a\x87\xe7Y\x18G\x82\u05c75U\xe9\xbce\xbc\xcc\xf29\x13\xec[\u0509\u03a8\x8d\xeag\xa7\xe6\xb3\xd3'6wJ\xcam\xb0{\xd9w\xcf\x18%f\fGe\xf0\xf28\x9ejP\x8cq\xa2l\x9d(\xa3\xdau\x12\x02\xec\xa7\xed\x1d)\x8d\u0719$ \xb8\x0eu\xf3\xb6\u0668emM)#\xe2\bVe1!\xcd o\xf9y\xc7vX\xc7vY\xc7\uec0e=`\x1d{8\x1d\x9f\xb6\x131\x0f\x121\xab\xb2\x1b\xc1\x82\x1c:\x9c\xf3\u06dc\xc6d\xa6\x18\xf4\xbd\x1f]V\xe5\xa0\x12\xa0\x03@\xee\x82\x13\xad\x01\xbd\x18\x94{\xf0\xa4\x866=\x99\xde'k\xbf|\xfb\xe6\xf4v&V\xf0\x90\x8b\xd2-fY\x80\xea\u02a5\nI{\xe5\xeb:\xf6I\xa4\xa9\x7f)t\xb0|4z\xcc\x14\x8f);\xa3\xfd\x8b\xd2\u031f}\xe2\x91\xfc\xad\x02V\xa0\x8e\x16,HS.\x82u\x98X\xb6\xef\xbbR\xb8\xd3J\x7fr\n\xf0Qe\xb9~H\x00\x98@*9\xe8@\xc0\xe5\xe2\x80OP\x83\xa0hy\xb3\xa9\xaf\xda\xfeE\x9cd\x85\x88\xd2'\xbb\x83I\xacg\xb2\x90\xc5\xe4\x15\xf6\bD\x94R\x1f\x01_\xfc\xe9\u0377\xdfd\xd9JE^-\xb8\x915\xa1\xf7\xa9l\x84\xd0mZ#\x94\xcc\u03ca\\\xa3B\xdd\n\xaa]>Dp\x14\xc2<\v\xc3\xf7\"]\xc5Q*T>\x14\x8c[c\xa9\xb3\xe3\x82\t\x83 '\x93\x1f\xa2\xf3\x1f\xb2\x83\xa9\xa6\u030a\xc8\xcb7G{\xb6\x85\u0286\xe0U6\x04\xb9\xb9\xc83.\x96-\xb6\x03\xcf\xca_\xe7[@\xc2bE\xda\xe9v\x1c!\x15\xb4\x12\xd5\x030\xde\v\xa2\x83t\x92\xb6\x8dG^T\x96:\xfd\xe9P\r\xf5!\x94\x973\x11\x17\x95Tk\xf0\x8aK\x18\xc1qB\xe4\x12\x8cY\x84i\x03\xead\xc1~U@\xfd\x9d\xc8n\xe2\xe4\x93\u0790\x0f\x16~\x10\x8a\xb9\xa5+\u0224z\xf4\xe7T\x81x\xb2[\xc1\x13\xd1\x11\xebX\x89\x88\x04\x9aq\f\x90;\x85|\x1e\x96r\x16\x03\xb6$0\u0676I\u0686|\x12\x86\x0f\x19\x86l\x89\x97A\xb6S\xb6\xd9$5\xa5\x1d\xca\xf2q\x87\xae\xe2\xd8K\x01\xaa1\xeaH~\xa5\x98\x87\x05\xf9\\\xee\xd1~\xa0\x80MM\xb0\xc4h\xa5\x04\xa1L/\xdcf\x93\x14kx>?\xbd\x16Q\x06\xf6\"\"\x12\t\xb1p -\xb6\xa68\xac\u023a\xc3\xfa\x11\xb3+ \xd1\xe6\bw\xd1>\x04K`\x00_\x834$b\x19_\x8b}5C\xe4\xd0v\n\u061c\xf3\x18A\xb1#\xcaH/\xc6\x03\x00~\xfb\xb1\xbd\x8a\u00fbE\x10\x86\x10AI\xb41\b\xf5fC\xd4\x15\xff\xc8D[\xad`\x0e\xabV\r\x01\xbf\xc0k9\x92\xfc\x14(hQ*3JeF)\x96\xe5\x95*z<\x17\x8b \x12\xf9\xb16c\xd6\u01cf\"}\x13\xcf\u05e1\xb0\u063d\xd4z\x81\xfd\xee\x96@\xfcib\xad#\xf9\u027c\u043c\xa5\"\\L\xe0\x8f\xa7\x8e\xbeC\xb7?\xaa\r\uf60c\x13^D\xa1l_N\xa2\xf6%~\xc4\xea\xady\xf0\x10\xf6\xe2\xec\f\xce\xda/\xce\xce\xda\"\x9d\xf9+C?R<S\x1a\x92\xca\xc7\xe0-\\qZ\xa8g\xb8\xffV\xd4\xf47\\\x88A\"\xd2\x03?:\u041f\xb7\x8d\x9cn@\x97\xf3c\x11\x92\xbc<\x83\xec\xa1\xc3|nY,\xe0Q{\xa6$]\xcf2\xd0\xe0\xb4Z\x98[\xd6n\xc0\u0673\xf42\xa6t\xe2\xb7xv\u009df3;\xe6\x1dg\xb3q\xdc\x01F3\x05\x17\xfbf3;\xe1\xdd!\xbe\xeb\r6\x1bg\xe7Y\xb3\xd9\xedq\x1eL TG++\x94\x16N\x9f\xb6 \u04ad\xac\xc5\xc1P\xbdP2\xdbl\x1a\x04\xdas\x87\x9b\x8dz0\x92?\u59b2\x13\xde\xef\xe1\xdd\xc8\u01bb\xd1\x00\xef\x1c\u05e5\xb2\xb5H\x85\xa6&1\xf5\xcck\xbf\u016d\xff\xef\xff\xfb\xff\x95\xfb\xaa\xfa\u06f19k\x88\xdf/\xce\u0390_7\xe7\x91g,\u06d2D\xa5\xd6~4\xb5sw8\xe8wi[\xca\b\x8b\x1d66eR\xe6\xc1>\xd9lJ\xf7/\xfdLT\x1e\xc9l`\x05s\xe1kD,\u0563\xcfUI\x1b\x8eh\xb3\x89\xfa%\xda=K\x86\xc3O\x8a\xfb\xa2\xfb\xb3xu\x87\xc2\xdam\xb5V\x80\x86\x9a^\xa6\xc0*\xa1\xb0\r\xccl\x88\x94\x81\xd6\x00k~#\x9fH\xf6\xac\"\x19\xfd>\x12y\u07a0 [#?i\x19\xc7\u01608.\x16\x12\x9ezmtD\xefw\x03O7\x9b\xcaeULv4\xd2\xe0\xce\xc8\x03t>\xccoad=\xbcZC\x14e}#*\xe7\xbb\xd4\xf4y\xb1>~D\xde\xf0\xe3G\x99\xb4J\xe5\x12\x06\xb5\xf3\x16@_\xf3\x1aJ\x82\U000f74cc\xd8\xd9lt\x1frRff\xbf\xd1\xc9E\x9cq\xdd\xd7n\xce\x1d\x1a\x9f\x8c\v\xc6\xcd|\u0312\xaau\xa3\xd4\xec\xca\xd3V^\x12\xb2\x92\xa8J\x93\x9aQO\xe8\xfd\x0e\xbc\x89\x96\xef@ \x8d\xf2\xa0't\xb3QD\x1e\u010f$\xa9\xe3\xe2\xc2B\xe4\xcaS\x12\xb1\x902` I\xc2BJ1\x12\xb7\x1a\xe0\x9d\x96E\u07b2\xc02$\x82 \x1f\xa2\xc6\x18\xa1x\v\xf3\xaf\x10\xa0x\xe8\xe3\u00dd\xfa\xb3\xbc\xfe\xac\u06b3\xcc\xfc\\\xa1\x0e\xf5\xccG\x19<\xd9\xe2\x7f\x90m\x96\x8dF\xa3\xbe'\xea\x88H\xf6`~\xcb\xfcQ\xa3\x01vM;\x18\xbfE-`c\xd7\x00\xf5Q5j\x1e\xad\\\u05a9\x96\xedT\a\xb1\u058f\x81\x02\xe8\x875\x80\x8a\xf6W_IX`\xae\x00\x1a\xfc_\x1d\xd4,\xfdE\x01\xb1\x14\xb66\x9b\xf2\x17\x10bR\\\x12+\x11\xfe,k\x8bP\x00>Z\xd4\xeb\u06ce\xdd)\xc8jb\xae\u0106\x03\xce\xe9R$\xd0lf\xed }#\x92K\x01\x0e?y~\xb7\u025a\x90\x88\vV\x9e\xbf\x88N\u03a7\x1eR~\x96QO\xee\xdd\xc6q.\xd6,\x83A2\xa3\x99\x9f\x91\x8c\x82\x94\xbd|\bQe\x12$J%\xfb\f\xdfxm.\x04Au}5\xf5\xd4\x1a\xc0\xc8!J'\x0f\xbd\x84j\x17A\x98\x89\xa4,c\xcf\xfb\xb0R\xe5_\xa7\xa7\xb9g\x94\x14\xbd{\xe7\xd3-*6LJ\u0332\x92\xc5\\\x86\t\xd9\xea\x8c\xe5\xcat2\x0f\xa4\xcd\ufdc5r\xadnn \xe9\xec>\x9b\x9e\xf8<\x9b\xf2\x84\x00U\x95\xa3\xca|\x92\u0555\xf5\xe9}}\xfa!\u0643f\xb3\xa1\xed\xd1\xca\xfa\x13\xb5\f\xb0H\xb1Vv\u01a7(F\xb7\x94\b\fcM\x02\xbch6\ube95\x9d\xfbS:\x89\xcf\xfd\u9b94\xa6\x91\xb5g\xeb4\x8b\x97\xf8\x91&\xdfk\xad93_\x1a\vtw\rE\x93\xc8[o\x89\xcf\"J I\x02\x83FYD=l6!\xea\x16\x87-\xde\x1a\xa7~\xc10\x9f=\xf1\xb9/u\x0f\xa8\xa1\xc6\x06\xb9o\xdcl61\xf3w;\xc7k\x9em6\x19\xf3\xe5\x1a\xfc>\nE\x9a\xbe\u036eDr\x13\xa4\x02\xc3K,\x021\xe7\xc9X\x8a=\xaa\x8bP\xa3G\xc09\xdf!\xda\xc1\u0104H\x81\xee\xa5\xfa\"\xc1\xd4\xfc\xdb5p=5#]\xadnWK\xbb\b\x924\xcb\xf7\u0383\xf4\n\xb5\xb6\x17B\xf2\u0709\x7fgZ\x03&\x98\xba\xbf\u0280\xe8\t\x84;\xcc\xfc\xc30C\x0et6\xe4k\xc3*6\x04f\x12|*\xcc\xf5^\xcb&\x94\xb6\x87|\xe6\x84\xe2\x00wzeh\xc8m\x16q\xf5<\xe7\xf8\xb2c}=\xceP\x13\x9eM9,\xa9|]\ua81e\xb2b\u040e\x03\xf5.\xed \xe5e\xc3\"\xfd\\;\xccJc\x14\x96\xe8\xe7A\xfa*\x89\x7f\x12\x11\x8byA\xad\u0312>\xaf\xa5b/E:K\x82U\x16',\xd0%\x16\x89\x10?\t\x96\x16m\xfa![\x97%y,\xe4uG\xc2\xf7b\x11\x8aY\xd6l\xaa\v6\xe3!\bz\xc2;\xb6\xe2a!\xd4\x1b\xcf6\x1b2\xdb\xc9~\x90\xcf<~\x82\xea\xc4-e\x01,\xfeZ\xb53\x9c\xbb7\x1b\x92\xee{\xb9\xdal\x88\x91\xc9:+K\v\x89\xcevm\xec\u05d0\xdf o\xfd\x1c8\x91\xa9\xde5\x04\x89(\xe4\x1e\xd5)\xf1oI\x95\xb9St\x92\xb2\xab\x9a\x97\x98\x8fg^\xf7\x02\x93\xf3,\xf9-Q\xac\x82\xc9=\x18\tw\xae\xebJ`\x1avHxT\xf3.O\xf4sW\xf7VI\xad!\x1fR]\xcbI\xb0\x84\xfcH\xb7Dr(\xe6+\x91f\x90/\xe9#\xc9\x0f\u04b4X4\xb7\xb5\xf9\u008b%\xb3\x1bN@s\xc9$:q&\u0461\xe3a\x129\a\x92\xc8a\xf2\xc1\xe4<\x86\xfc\xc5\x05c\x1d\xe7+i\x86\x88\x03\x06\x9a\xa6Lx\xb7}s\xc5\xee4\xaf\x17pFK1\xce\u0400\xa5h4\xc9\x1b]I\x96\xa3h\xf2L\x12@H\x11\x00\xaf\xd6aX\xc8\fb^$R<<\x1c\xcbM\xda\xe7\x90>ql\x18_\xe7\x9bL.\xac^Bj\x86@\x85fJ\b\xe4G \xf0\x15\x0f(\xf3y@\xb7\xb0\x05\x811l\x8e\xee9<\x9f\n!\xed\x1a\x15\v\xd01\x95\xd2\x13\xe0Jd\x92\xd7\x19\u0258``\x8d\x03\xb9V\xa1\xb7\xa2d\x9aST\xf8\xac\xb0\x94\x18\xeb\xa3\xdeX7\xe1\xe3K\xe8L\x84\xd42\x02\n\xa3w\xd9[u\x8b}\xad\xd9RU\nI\xa3\xb4|\xb0\x15\x1c\x8e\v[\x93\x05\u0367\x15\xa8H\x1c\x8a\xf6\x8d\x9fD\xc4Z\xf8ax\xe1\xcf>\x1d\xe0\x97\xa01\xb5\x98\x8a\xb6\xb6\xcd3S\x02\xa8/x@\xce-\xdfb\x96\x7fq\x91\xc0\xcf,\x89\xa3\xbb%\\\xcd\xe7\x89HS\xb8J\x04\x96H\xb2`\x86\xf1\x94\xfd4\x98\xe3\xefz\x1e\xc4\x16\xb3.\xe0\xffy\x80\x7f\xf1>\xb8\x84\xbfa\x10}\xc2\xdfx\xf6\xe9\xef\xeb8\x83O@\xdc\a?\xd0\xd6\xc5:\xcb\xe2\xc8b\xd6\u030f\xae\xfd\x14/P\x82\vW\"\xca\x04\x14\x9a\x05\xf8\x1d\ua1d8%#$\xcc\xe2\xf02\x89\xd7++O\xa3g1k\xeeg\xbe\xfa\t\x03\x8cj0\x9f\xc3\x1f1\x8bU.ik.B\xfc\x9b\xf9A\b\xcd\xcd\x17\xd0\xd4<\xf0\xc3\xf8\x12/\xb0Pp\r\x7f\xb1$\u0522O\x00\xcc\x12K\x8c\xbe*\xc2y*2\xbc\xbc,\x00\xc6x\x11\x00\xe2\"\xc6\u008b8\x96\x1d\x00[)\x8bYW\x0e\xfcq\xe1O\a\xfet\xe1O\x0f\xfe\xf4\xe1\x8f\xf0\xe7\xea\a?\xba\xd2\xfd\xbb\u00bbl\t\xe0\xc0\x00\aK\x804\x88V\xeb\f\x7f\xa1\x1b\x9f.\xe0\xdb\u043f\xc0\xee\x85\xe2RD\xf8\x00\xca/\xfd \u009f\x15\xfeM>\u025f\xbf\xaf\x05\xc0\xba\x14\xd1Z\xfd\x04\x19vo)$\u0511\x0f\xa3\x10\xc58Q8\xe8\xf1*\xd3@\u017a\xd3\xf1:\x93\x80\xc0\xd3U0\xcb\xe4\x10\xac\xd4\xdf\xf8Ra\xd0\xdf-f%P&\x81\xc2\xc9\xfa\x02p\x00^\xa4\xfe\x12\x1e\xa7b\xa6jL\x05l\x91pq\xe5\xcf\xe3\x1b\xb8X\xfa!\xb4\x9f\xc6\xebd\x06\xf5\xa6+\x7f\x860\xa6+\x1f?\u0252\xe0\x93\x90\x17qt\x89\x17w\x88\xa7\xe9\xfa\x02\xff.\x97~\x82-\"\xf4\x19\xb0\x8c\xf0\xabp1\x83\xc1\xca\xc4r\x15\xfa\x99\xb6\xb5U(\x9f\xc1,\xc2\xef\x15\xfe\x91\x93\x04z\f\xf8I\xf0\x8f?\x83!\u0360\x14\f\xe5\x1a@\xbd\xf6\xe1\xddu0\x17\xb0\x1an.\x12kJ\xd9[\\j\xe95\x00\x88\xcb)\xcc.\u00fb\u0555q9\x17\v\xe3N\u0348\x1f\x05K\x90\xcc\xc7a\x9c\x14\xb7\xcbX\r\x98\xba\xcf\x12?J\x15\xaa\u0342D\xae\xd3Y\x18\xac \x14\a\"\xfd\x021^\xa43\xc4\xe90X\xa5\x88\xadxL+\xd0\x16\xa0\xd3`\xe1o\x820]}\x12I\x84\xe8\xe7_\n\u012dH\xff\xf8\xc9e\xe2\xcf\x03\xb9D\x00\u00f0\xba\xa5\x9f~\x92\xe8\u4ac5\xb9T\x90\x14?\x99\xac\x13D\xfe\x97\xb1\xbeR5C\x95~h\u051c(\xb4\xc8\xe2\x959\u00d86\b.\xa4s\x96\x9c=\xf5\xa3Z\u0282L\u03b7\xecJ\xa6\xd0\xe6:\x10\x80^\xd7\u0633)e?\xe2\xfc,\xc4\xf3P.\x9f\x85x\x01C\xfe\xc6\u03d2\xe0V\xdd+\u02cb\x0f8\xd8\")\x9e\xa6\x92J\xc1]t\r\u0193\xc6g/\x83\xc5b\x9d\x8ao\x83\u02eb,\x88.\u0573\x14\xf9\x10\xa0,o\xfc\x95~\x96\xf9\xa0J\xb9\xbcB\x02\"^\x85q,!\x01\xa6\xecY~\xf5<\xbf\xfaM~\xf5\x1e\xaf~\xe3\xaf\xd34\xf0\xa3\xe7\xe1Z\x02\x87'\x96\xe2\xea;IE\x17\xe2M\x9c\xac\xae\xe20\xbe\xbc\xc3\u06f7\x8b\x85\"i\xe2]\x1c\x980\xc0\xd1i\x1d\xfaI\t\xfa\xb3Ul\x14\xf9 \x83\xec/\u0107ur\xb1\x0eE4\x130\x9e\xaf\xe5\xd6\"\xd1S\x12\xed89\\%\xb1\n\xca?['\xa9\xa4\xcaA:\xf3\x13\xd9\xd1y\x12\xaf\xf2\x95\xbf\x10\x1a\xdd\x009\x0f\x17\xfe\xact\x8d\xe6\xe3~Vz\xa4\x82\xcc\x14\x0f\xd2dV\xba_'\x01\xde'\"\xb8\x8c\x94\f\x8bYW\xbe\xc4\"\xfcUh\xb3\x14\xa9\xfe1\x11\\\xa4W+U\x1a\xae\x13\x84t\x19\xa4i\x10]\x1e\xea\x95#\xcf\x0fp\x81\xa3\x9a\xc6a0\xd7\vx\x1d}\x8a\xe2\x1b\xc0\xc0u\x8a\x03\xf5\x01\aj\xa9[\x8dfa\x9cJ\xe2,\x8d1\xac\xe5\x02\xc6t\x8eW\x89\x0f\xfdY\ua196H\xe3\x91\xf4\x8b9R\xa4\xe5r\x1df\x81\x04\x00V\xfc\x12\xc9\x7f\x8c\x7f\xae\xe5\xd2\\\xf9\xf3\xb9\xacnu\xe5GY\x8ct?\x91\x94n\xa9:\x84\x9f\"\xa5\u014b\xbf#\xe1^\xeae\xb7\x94\x94u)\t*\u0729+M[\x97HT\x97j1.%dp6*.\x10\x9a)e\u07e9\xee\xeb\x1d`\xe9\x87\xc1e\xa4\xb7\x1ay\xa77\xae0\x8e.\xe5\xfe\xbc\x04\x9c\xc1T\xc3\xfa\xfa\x0e\xaf\xf2\xef\xd0\x06\x03/\x14EY\xa6\xb2k\xa9X\xfaQ\x16\xccR$\x9eQ\x9c\xf99%\xd57\x87\xb7\xb8\xdf.W\x89(\x062\x8a#\x9c\xaew\b\ufbf0kS\xc8;\n\xb7\xfe\f\x94\xcf\xc8?\xe9\xda\x00n\xfc\xc5\xc7\xeb,\x9e\xf9\xab \xd3\x01\xa2\xf0A\xbc\\\x85\"\u04f7j\xff\f\xa2b#\xc5\u01e1\x8f\x1c\x93?\xfb\x04\x9dC\xdatq\xa9\xb1\xe9\"N\xe4\xa0\x02'\"?\x9a\x890\x84)\x96\xab\x15\xee`\x1e\xd5\u0755\x98}\x12s\x83\xaf\n}\u0721g\xa1\xc0MK\xd7;\x8b\xc3T\xfdHr\t\x8cV\xa2\x1f\xcaK\xc5f\xcd\xe28\x99\xe3\xf3$NSm\xde\b\x8c\x98P[%\u06aeI\x00r#\xb4\x9c\xdbJ\x01e\xe6\xc5e\xcd0\xa87\xa0o\xce\xe0\xfcvw!\xe7v\x1e\u07e0\xb9\b\\&\xfe\xe5\xa5B>\x11\u0354\xcb\t2\x91\x9f\xc4\xddU y2MA\x92\x9c\xd7J\xf1J\u0472\xab`>\x17\x11^\\\"9P\x9b_\"\x16\xa1\x8f\xe0\as\xcd}-%)\r\xa2L\\bR\x1cf\x05\xa9d\xb3>\x05Q\x89!\x93\x9f\xaa\xd1\x02x\xe5P\x841ne\xa1\\p\xfe\xad\xfc+\x0fFp-\xe6\x01\xee\x9bh\xaa\x00\x17\x92\x93\v\xa2\xa2\b,\xf5\x95\\pki\xf0\xa0\b`\x14\x03\x15\x95Whg%ip\x14\xdf$\xbe\xe4\xe0D\xa4\x18\xb9\xe5zY\u078ea_\xba\x8aC\x89V0\xdai\x10\xa95\xb4\x8aS\xc9-\xac\x12\xa1\x06~\xb5\xbeP\x95\x03\x91\x8c\xf5\xfaK\x84?\x8f\xa3\xf0\x0e/C\xfc\x8b\x8a\xe49^^\u02ff\"I\xe5\x83\x18\xfb\x90\xc47\xa9\xfaQ\x9c\xddJ\x84!\xa2,\xdc\xcc\xe2\x95\xc89D\xfc.\xbd\xf2\xe5\xa3\xe0'\xfd\x93\x1a|a2SC\x9ff\xbe\fP\x86\x9bA\x9a\xcc\x14Y\xceD\x89\x97\xc8\xf9\xc4\u033f@I@\x89y\xf0\xa3T3\x88\x12\xb9\xd6@IV\xc8\uea75\x8e\xa7-\xe0\xf8\x829\xce\xcf\xed2D\x06=\rc\xa4\x15orZ\x11e\x879\xd6\xf9\xb3\xd9z\xb9Vu\u00fa\u0342k\xa1)\b0\b\x87\x17~*\xd4\f\xf8\xe9LnD~\x96%\xc1\xc5:\x13j\xc6\xf3{\x05\x9d\xffS\xb0\\#\x14\xf0\xf5\x02\x86_D\xb3;u\x0f\xb5\x1d\xa2q\x12<\x10r\xc5^\x04x\b\xbb\xb8+\u0445`ep\x92\xeb(\xc8\xf4\xe3C\xb5Q\xe2u\xb2\x0eE\x99|\xc4\xc9!,\x8ed\x15\x87\x9a\xc2\xd6<=\x94\fhZ\xc7 \xe0}\"`\xb3P\xd4\vf\x04\xfb\x80K\x1e\ue403\x9fK\x0e\v%i~\xa4\x88K~\xa4\x98#\xaf%\xcb]\a\x8a\xe7@\xfeH\xcc/\x85Z\xc7\"\x14\xd7\x1aL\xc5\xff\x05a\xa8~\x0ec \xa0\u065d\xbeU\x9d-xg\xbc\xd0c\x03y\xab\xe7\x87z$\xe4\x9dQ\x81dE\x96A\x98\xdf)\xf4\u036f\x0f\xfd\xf9\x8f\xeb4gl\xd2,\x11\x92\xe1P\xb7\x12]\xf1\xe6\xdaO\x02?\u028b\xdeh\x9cZ \xeb\t-\\\u00b9\xf3\xd2\xd5\xec\xbc\xe6\x90\f\xde^s7\x1a~}o\x1e)\n\x12)\xbf\x91t\x10\xb8\xb3\xd2\x04!\x1a\x05\x11\xb4\x06K\xf6\x13\xb4\xfd\t\xef\xe0\xcc\xfb\t\u03bc\xc0n\xcb\u009f\xc4\xdd\n\x98\xcdT^\x83\xe5\\$\xd4\rl\x1c\xa9A;\x91\xe2\xe5\xc3\x12\x8a,\x13\xc9a\xb1\xadA\xa5\"\\j\x9e[\xdeB\x7frR\x19*\xfe5\x9f\x17L\u01d3\x9fX\x0e\u572b\x9be`\xdch\xe2!o\U000c1437z\xcc\xe4\x9d^\xf7p\xf8Q\x82\x89\xa2@\xfa\xa9\xb8\xbe5OHU2/\x11RR{M\xce\xd7\xcbx\x96\xf9\xd78&\xb1f\xd6AZ\xad$\x1b\x05~i~ N\x14\xc7*/4n\xe7\xdb3\xb0_\v\xb9\xf7\xac\xfc \xca\x0e\xf5\x87\xc61-\x1f=\xb5;T\xfa\xa4\x9e\x9ax\xa2\x1e\xe5%\xf4\xfc\x02'%\x92k\u11eb+\u07fcO\xc1\b#\x01\xe8\xf0i\xb0D\ua9ff\ax\x12\x18,$\u02c0\x97k|,\x10\xbf\x13\x81\x18\x9e\x88\x95\xf0\xb3Y\xbc\x8e\xb2\xfcN\xae\xefD\xe8\u0253^\xedp\x01,\x1eR\xfb\x99/\xa9\xbe(\xb6\x92\x12.\xa7\xea\xe4cP\x15\xfdH\xdc\xcas >\x82\x9d.\x9f<l/\x9f\xa04\x9b\xcf\xc5u\xa0\xc7>\xcd\u0c1a\x05\xa1\u0725\xb2x\x95c#\xde\x14\x93\x98fI\xfcI\x1c\xce\xfd\xf4J*\x80J\x8f\x8c\xfa\xf1\x19\xac\x9b\x99\xbf*?\x00\xe3\xd2\xe2\xc92\xc8D\x12\xc2\xe8\x16\u03ea\xcd\x15o4\x1e\x17;c\x02\xacS>dwi&\x96\xb04\xd7\xf2xf\xee\x97~r)\xb2\xe2\xeaN\xef\x9d\nA\x80m>\xf4\xa3\xd9U\x9c\xe8;%\xa1\x93c\x84O\xcci\x80\a9\x1e\xea\x9d\x17\xe8\xca\x1a\xe8\xca:\n\x94dP\xfa~\xa9s\xfdE|\x8bWip\x11\x84\xb2\x8f\xc0l\xc8&\xaeE\x92\x1d\xfa\xf3\xeb\xc3;}#\x17\xc5\xe1m\xe5\xfe\xce\xd8\xca!v\xaaAp\x14\x1bu\x93\x04HT\u051a\xbd\x05\xf3\xc9H\x84\x92E\xc1\x1e\xde\xed>\x82fn\xa1\a\xb7\xae\xc1#@cw\xf0\xf4\x0e\x9e\xfe\x04\xff\xc7\xf1\u048f\xe6\xc0\xccL){i\xf0\x0e\x92i\xc0\x95(\u05ecf@.\u0135\b%7\xadO\x91\xb38\\/\xa3T\x97\x90\xb7\x9a\u04aa\x97\x92_\x9a\x8b(^\xears\xb1\u02aeL.=\xdfB\xf1Jc\x06znk\xb1@$\xb9\xebD\u04ac\xda-#\x04\xa4\x90loA\x99#\x91]\x05\xb3O\x91\x94\x15\x86\xfa\xd4\x19j\x991\x1c\x8dK\xc7\x1fx\xa0W\x0e\\\xab\r\x14.\x8b\rq\xe9\xdf\xea\xe7A\xa4\xaf\xe2k8#\xe0B\x90g\xba\x9c0F\xeb\xbc\xef\x8a9N\xe2\x1b\xfd$\x89o\xf4\x90I\xeeT\xa1A\xc1\xaa&\x1a\xeaDC-O\x8e!\xccH~W@\"\xef\xe1F1\xf1\x81\x14i\"\x9ahQ\xe8\xca\xd7\xf4=\xbf\x96\x94\x03\x19\x02)\u047cP5)^.]\xaf*\x0f\xee\x96K\x01Z\\@\xee\x9cr\x949\xd4)e\xdf\"z\u0742\xc8\xdeS\x13v\xbb\f=\x9c4\xf9Ts\xc2\xf0Xw\x16?\xf7\xfe\x7f\xdc\xfd\x89z\xdb8\xd20\n\u07ca\xcc7Q\x13\x11$K^\x13\u02b0\xc6I\x9c\xeet\x16g\xec\xa47I\xed\xa6%\xc8fL\x83j\x12\xf2\x12S\xe7\xda\xff\xa7\n\x00\tR\x94\xec\xf4\xcc\xf7~\xe7?\xcfL\xc7\x14v\x14\x80B\xa1V\u0307F^\xb2\xc4]\x1f\xdc\x0f\xee\xfb\x83dp2|\x96\uafc3\xf9`\xbe~~E\xe8\x1b(\xb0\xf7\xb4\x94\xfdt\x1f3\xbfA\xe6\x9f\xc0-l\xf6\a\xcd\xc1Mk0k\xb7_\xee6\a\xb37o\u07bc\x19\xae\x13\xfa\x05K\xc0\n\xab\x12\xc3\u0193uB\xff\xc6T\xb7\xe7\xa9\xffO\xd2\vI\xe44\xe9\xa5W~\x10\xca(\x95<LAwBF\xe9(\x18\xa7\xb7W\xd3)\xf1R\xf4\xdf3L\xfb~\xf3[\xa35h\x0e\x1bn\xcf\xeb\xff\xa9\x7fy\xc3\xf4\t!\xeb\x01\xa1\xbf\x98\xc6\a7\r\x05\xd8\x14FH<\xc8\xfc\x152\xfb0\xccv\xbb\t\x7f6\xda\xf0\xefA{0\xeb\xec<\x87\x7f\x9f\xb7\x0f\a\xb3\r\x95\xbd\xd1\xdex\x01\xffn\xbf\x19\xcc6\xdb\xed\xf6p\xfd\x9c\u041fVi&\x19\xaef)#\xb3\x0f\xedU\x99\v\x1bu,\xaf\xda9\u048a\xee\x8a\x06&\x8ce\xe9k\xe6;\x97x\xf6\xcc\u063c\xac\xc3\\\xde\xf9\xe4\x7f_I\xe0\xe7EK,K\x16\x9fM\xf5&\x10\xe3\xe8F)\x9f\xab\xef9}\xb7\xa8\xb4\x91k\xe0\xb1\\{\x9d\xf7\xac\x16\xbd\x9f`>\x101\u01c03\xd7\x06\xd4\xea\x00\x9f\xa20\x18\xdd\x15\x82%)\xe9\xa0\xc0\xe0d\fEUM)\x9bS,\xd8Lf\x93Ip\xebtA\xfb&\x8e\xb9\x90'\xb8\xdf\xea\xf5R\x02\xe8A\x1c\x98\u05de\x1b\xa3\xa4\x92\x95\u02c0\u054bU&3\x00\x19GW\xd3Y\x1cL\ue706+\xd0S\xbf\xf0\x1c\xb0>\xcc\u056b\x8a\xe3w#z\xaf~\xff\xf4\xf9\xc3\xfb\xcaM\x05VX%e\xac\x92<\xf2s<K$\x1f\x83l<\xa9\xa9\xe9\u059cF\xd4pj\xb9\x87\x823^S\xfd\x8c[\x8e\x16UZ[\xea\xc7\u032cm\xc1\xf3~\xbb^\xcf\xf4\xe8mM\u04de\xfd\xc3\xfb\xd9%\xb4R\xc1\xf1G\xd4\x12\x04\x83\u0796\xa6\x11\x98\xb3\xd1\xdalm:\xe8B\x02\xb8\xa6c\x88\n\xb1\xc6\xd3t\x8d\xb7\xc6\xd1\b\x1bM\xd3\x17 \xf2\xcd~\xb7D4F+\x0e\xb3\xe0\x12\xe2\xda\u0326S4EA7B]c$d\xea\xd0\xd8\xfe\x11\x81\xe9\x9a\xfe\xf1\u01849\xf2\x19o\x01\xe0?k\xf9\u0521\x92FbT$\xe0\xe6cP$\x93\x18\xea\xc47\xf8\x1e\xa5#\xf8\xe9_\xf11\xa4}\xf0\xa7t\x9a\x9b{\x8ez\xc5<\x88\xe9\xf4!\xfa\x86I\xb0q>\xf8SoDo\x19o\xa1E\xd5)\xe3\xadW\xd1\x15\xf6\xf2\x872\xb1\xfb\xe4\xc7\t\x8f\xe9\uf337\xa4\xb5\xbc\xf4\xdfl\x96\xa3\t\xfa\x1b;p\xff\x8d\u0508P\xd2\aB9Wi\x82\xdf\u0293\x00\xa4\xc4\xe7\x0e\xa1R\xa7\x8e.\x82\x10\a\x95\xc0.\u0409S\x1f\xb6\xb6\xaa\xbfDl\xae\x95\x04b\xceb\xbd\x7f5T\xdc\\\xb6\ar\xfc\x96~\xc4\xd4\xeb\xf9w+\xba\x11<6\xb0\a\xdd\x02\xb6,Sa\x9b\x88\xb3w\xee\xefT\x10\xeas\x16\xf1z\xfd=\xefE\xe6\xdc\xc0r\xb9\x8e\x8a\xf5\x14p\x16\u04c4\xb3\x80\xb7\x02`\xf6^\x99\xa7\x18\x9da\xa2\xaa\x01\x13{\xabq:\r\xad\x8c\x85\xed0\xc2\xccs.\xf5\u4497w\x9f\xfdsX5:\xe5L@'Q\x8c\x80\xa2\x13\x0ej\x8fp\xae'\x9c]\xba1\xc9v\u0687h\xcc{q\xe1\xa7w?\u03cf0N\xf1BU/l\xe2\n\xb0\x83\xbek\u00ad\x13\x98\xd8`\xc8A\n\x87e\xa2\xb4[\u01dc\xbd\xa4W\x9c\xbd\xa1\u05dc}\xa3\xe7\x9c}\xa1w\x9c\xfdB\xcf8\xfb\x95\xdep\xf67=\xe4\nI\xderv\x02:\xcd\xfdL\xe1\xe8\x89\xfb\x8a\xd0'\xee\x11\xfc\xf3\x15\xfe\xf9\f\xff|\"\x84\xd0S]\u9922\xd21\x94\xfa\x00\xff\xbc\x86\x7f\xdeC\xf9K]\xfe@\xff}\xc5\xc1\xe8\xe8\b\xff\xfd\xca\xe1\u013e\xc5\x7f?\xe3\xbf\x1f\xf1\xdfO\xf8\xef1\xfe\xfb\x01\xff}\x8d\xe5\xdf\xe3\xf7K\xfc~\x83\xff~\u00d4/\x00E\xfa\xb7\xee\xe1\x173\xb2EY\x83Q\xa5\xb0T\x1b\xb4\xacvAV\xa5\xa4\u0401\xa1\xb3\x8d\xe0((\n{\x12K\xfe\"\"~u\xa6\xd8\xc3\x11VS\xb4o&\xa5B\xc7R\xbal\x96\x98=\xfe\xae\xcfK\xf2\xf1L\x0e\xae\bB#\u7fbd\x9a\x02\x11\xf8\xab\x9e\xecO\xf9d\xf5\xdcLA\xa5\u00d0\xc9\xf3\x8d\x90O\xc9\u0487\x84>\xd1-\xfc\x9c\xb7\x10J\x8b'\xa9\xf8\xf7\x81\xcdc\xd7|\x92e\\\xec8*sx\xcd\xd85\xc7\xd6L6\xa3\x87\xdfq\xe6\\H9\xf5\xd6\xd7onnZ7\x9b\xad(>_\xef\xbcx\xf1|\xfd\x83//\xf0\x9f\x0f\xef\x1d\xface9\xa0\xf8\xd6\x11p\x7f,k\xe7\xc5\xfa\xadR\xeb\xf8\x9d\xb3?8\xfd7n\x97\u07f8\xc6\u0414K\xd6/z\u0302\xd2\r\xb5Y\xd0\x1b\x18\xd6\x1eR)\x99\xf5\x9b\niZ\xd0\x01\x98h$\x171\">\xc6\t\xf5K\x17\" E\t\x94\x0e\x98$\xd9:\xfe\x0fQA.W\xc6K\f\u052c\xe0\xf4:\a\xef\xdf\x1f\xfdz\xf8\xfa\xf4\xf3\xc1\x8f'\u8834\x87K\xc9[v\x06\xf1n9=\xb5J\x1f|\xfe|\\Y\x1a2\x88w\xc2ao8\a\xaf_\x9f~9~{zr\xf0\xe6\xb0P\xe5\xd2\xfd\x99\x13\xa8U.@\xbc\x9f9\xfdUW}}\xf0\xf9\x00\xb3\xed\xb1]\xba?eU\v\x05\x88\xf7\x13\x87\x03\xec\xbc9:~\xf9\xf6\xf5\u9ae3\x8f\x9f\x0f?~.N\xaa\x94G\xbc_ \"WVg\x01\bV:\xf1\xee\xe7\xf4 /\xbb\x00\x02+\x1d\xcb~\xe1\xcc\xf9rrx\xfa\xe9\xf8\xe8\xcd\xdb\xf7\x87'\u06b9,o\u0649\x88\xcc:H\xa0 \bO\x0f\x8e\xdf\x1e`\x1b\xf4\xa8\x94\x83\xb3\u015c\xaf<K\xfc\xf2\xf1\xdd\u01e3_?B{\x9f\x8f^\x1d\xbd?IS\x85\x0fy\v\x81\xfa\xe6\xe8\xf8\xf4\xf3\xe1\x87O\xef\x0f>\x1f\xaa\xbc\u03d0\xf7\xebOG\xef\x0fO_\x1f\xbd\xfa\xf2\xe1\xf0\xe3gL?\x86\xf4\xe3\xc3\xcf_\x8e?\x9e\xbe>\xfa\x80i\x1f\x8ai\xa7o\x8e\x0f~\xcc*\xbc\xce\xc6g\x95x\xfb\xe1\xd3\xd1\xf1g@\xb3Y\xf2\xe7\xe3/'\x9fa#\xfd\xfe\xe9\x10+~\x82\xcc7G\u01ef\x0eO_\x1e\xbd\xfe=M5FV\x8d\x9d\x1c||\xfb\xf9\xed\x1f0\xba\x0f\xf4M\x96\xfc\xee\xf0\xf0\x93Y7\xc0\u067c\xf5\xf6\xe3\xe9\xa7\xf7\a\xafT\x9b7\x19H\x0e\u0556:>\xfc\xf1\xf0\xb7Oiz\xc3\xe1\xe0\xf2\xd6\u01c3\x0f\x87'\x9f\xb0\xf8\x1f\x1c\xce\xefo\x9c\xa1\v\x04.3\x1f\b\xbc\xf5\xe9\xe0\xf8\xe4\xf0\xf8\xf4\xc3\xe1\xeb\xb7\a8`\u0493\u04abH\x87\xf3\xbb\xe4\xd83\xc6~\xe3\xbdJ\xfa\u06fb\xa2o\xc1\xe5+.-\xa1\x1f\xe0\x1b\xee\xa86\xa1_\xe0\xfb\xb0\xe2F4W&P\xb6p\xae\xbf\xf0\x16tU\xaf\xbb'\xee!\x04\x8b\xa1'\xee)\xa7\u01c4d\xf9\xc9\xf5\xb9\xc9>\xd2\xd9\x1f\xf4\xdf\xf7\xc5b\x8a\x00ML\xe9\xaf+J\xc3\xf5\xf5!\xeb\xf6\xb3.\xf1:/iN%\x1c\x165\x17\xc6n\xf5\xac.\xddCN\xa0\xe8!\xb7JeU`W\xd7\xeb\xee)T9\xe1\xea\xeb\xd2=UUN\xb9U\x8aT\xa2\x8dz\xfd\xc4}\u00abr\xc8\u286f\xd7\u077f\xa1\xa3_\xb8\xfa\xbat\xffV\x1d\xfd\xcd\x17\v\x13B\u07e89d\xaa\x04\xb8\\\x9f9\xf4y\xc8i\xdf(\xfd\xe9\xcb\x16u\u0546\x80[[\xa8ca\x00\xd6\xd7jlCB\xb5\xab\xb2K\xde\xc2$B\x83z=@\xab\\\xc9\xc0\xd9T \xcdu\xaah\x85(#\x18rZaHh\x92\x15+S\x1e\x9a 1wg\x89\x86\x19\x12:\xd3U\x8fH\xf7\u011dI\xb5\xee3I\u07ea\xe7g\xa8\xb3?Cv(\xe9G\x95<\xaa\b\xde\xcc\x04\u069d\xe0\xe3W*\x02W\xb9\xb4\x81\x8b\x1e\xb9O_\x8e\xdfz\x7fp\xaa3\xbd\x9c@\x99\x1b\xffoW.7u\t\x8d\u0655\x9b5\xa5lG[v[\x8c\xb1\x1f\xad\xa7[)\xeb\x0f\xe0w\\\x83J2\x13\xdeB\xee;+\x17B\u0417\x00\xa3\f\x12\x03\xf4\xf1\u7f4c\xa2\x90\xfb\u009d\x81yg\xf58\u07ad\x1e\a\xd2{\xd5\x03\xf9\xd1\u03ae\xd7\x13\xe82\xeb1\\\xde\xe3\x1f\x8a]S\xd1^\xbd\xbe\x86\xad\x14\xec?+\x00P\xaf\xaf\x05\xc5r\x8a\u07e0w\x92\xd92\xb6\xecR\xabAjz3\u04f1^\xc3q\xd6\xebn\xd4\x17\xc34]S\x80\x9a\x9bv\xe7tZ\xdc.c7{\xa5\xd3{\xad\xab\xeb\xf1\xb9\xe2h\xf0V\xfer\u0525^\xc1\xfb\x12\x9e\xfc\uae63-\xcbx+\x9aI\x1e\u00db\x85\xf9<\xcf3\xb5\\2\x9f\xcf\xe9\xc4\xee\x9a\nU\xb50\x80L\n\xef\x89\x02\v\x06\x06\x00G\x11XV\x9e\xb0\xf9%\u02ea\x03\u9595F\x15rU*k\x12\x9as\x82\x04\xd6\x1a\xc0\x7f\xca\xfb|\ba\xbd\x8fy\x9a~PAz\xa7\xd2\x15VW\u069b\x198\xc4i%\xd6\xe0\\N\x1d\xc7.7\xa7\x17UgR\x13\x96B\x7f\xc0V\xf8\xc4\tg\u039e\x1a\xda\xfe\u07ba\xfep\x1a\\E*W\xbb\xe0\xda\xe5t\xfd\xcf\xfe \x1e\b\xe5X\x85t\x05X\xe1G\xfd\xf6p\xbe\xea\xbaC7\xe6?\xecAb\rI\xf3\a\x88\xe9\xfd=@\x97\xfb{\xeb\xea\x0f\xa0\xc1\xfd\x1f\x1a\xbc\xe1\xec\xad\xe3\xf7\x1eR\xc9\xfb\xda\x1f\x8a\u03e2\U000b3743\tf0q\x7f\xe7\xeaX\x00\xb0$C'#\x7f\x10\xe5\x93\xf2M\x1c]i\x9f\x02>\xfd\x8d\xdbpC\u00fa4]\x93\xd9\xdbZS\xdc\xe4^\xe6\x0fc\xf3(v\x7f\xe7\xf6\xbbJ\xd9H`\x87\xe5\xea\xad@\b\xbd9\xff\x8d![}{\xb1\x94m\x84D\xc7ui\xbaP\xb9\x9b\xf3~\x058\xef\bD\xc2c\xf9\x92\x03vw\xcd\xcb\x00\x98<\xb8G\x05\xa1A+\xe7\xc1\xf4\xdbCe{L\xa8\x06Io\x94\xb9z\xfc\xcc{\xea\x96\xf2\xd4\xf5D\x80\xcf\xf6\x99\xf7\x16\x86\xe0\x05s:\xaed\xbe\xcdtc\xbc\xc8eISN9\r['?\x1d\xfdzz\xf8\xfe\x10\x89C\xfd\xf3\xd5\xd1\a\xfb\xe7\xe7\xc3\xdf>#\xec\xe8Z\x87\xcc\xe9UU7kEG\x01\xb7%/\a\xa7\xe0\x9a\xaf\xec\x93\x19\xf9ypY\xd4\xeb\x15\x99pY\xbe2\x8c\xa4\n\xb6H\x01\xdb\x00q\x9e\x9d\xec\xc4\xeey\xba\xb2rv@\x97\x94\xb2\xcfp\xe5(m4\xbd\xa4\r{7\x909\xbd\xae\x02_\xe5;0)\xbd\x03\x13Bz\x05\xa8&\xdew<!\xebu\x10\xc0\x9d\xf1\xb8\xbc\x02\xc0S\xac\xd7W,\u03dc\x9e\x17\xb12\x8d\xc9\xfd\x05 \xc3z}\xe2\xe2\au\x8b\u018ez\xfb\n\x1a\xd3X\xf9\x9d\xa1w\x8b\xf8\xceFs\xe7\xd2u\xce\x10F'\xbe\bd\xf0\xcd<\xa5\x13\x87j\xeb&z\x851\x1f\x8c=\x14\xfc\xa0kX\xf9\xda\xcdGK\x95\xb8\xe8y;\x97j-T\xd1\xfe<\xa4U\x8d\x98A\u0326\x91(\r\x01Fpo\u0220\x98j/\x89\x9f\xfd\xf3\xc4;\x04{\xbf\xb5kh\tmLu\r\u0714 6PY\x9a\xcfI\u04b4\xf0\xbb\xa2\n\xa9\xd7o\xdc\xf5\xbd\xfe\xfa\xe0f\xb8~Ny\x8e\x97\x16r\xac\x03R\t\x14c\xe8\xc1\xd0\x11\v\xd45\x98p=(4\\Uy\xed\x10\xac\xb2\xd2\xf4\x12\xfe \xe9\x02\xc4\xf4\xda\xdf\ua9fas\x90\x80LS\x9b\x02\xa0>\x93&5Gq:\x98kD\x8cl*`\xbe\x16-4;\xdd`\x9f\xb5\xbb\xcdf@\xa2\"\xe2\xfc\xcd\xf5\xfb\xc1\x10]Gq\x8e\x06\xeb\xa5q\u03ab\\\xae\xcc\xea\xf55\xe5\f\xc05\u5217s\xe2\xd6\x10\x18\x19\xbbn\r\t\xc75\x00\xce`]D\xae*\x95b&)\x81\xa9\xe7\xc2\vp\x13N\x97un\\\xc1\nKA\x05;w\x05\x1ds\xe5\x93R\xfd\xba\u04bf\n%\xd7\x14-[M]\xb52v>\x867-Td\x82\x10\n[\u055fH\x1e/?.k\x1d\xe2\xe5@\x98\xd33\xb9`\xf9\x1aL\u07170\t`\xf5i_\x10\xc8\xe7\x83o\x94z\xd5\x02\x81^?\xe0o$I\x81H=\x82\x1dq\x00\xd2C\xd8^\xd7h7\x8f4I-\x98\xb8\xaf8$\x9e\xe7\x89\xd0\x17\x90Pr\x98\xa6X\xa9\xd0\xd6\x13L\xc9j\u07f87\x9c\x02\xe4\u0390p\xb2\xdaE\rSp\x05Q\xaf\u06f2t\x9d\x92}\xc3}\xa3V\\1\u06c0o~\xe7\ne\xbc\xe6A\x90\x80\xb5_\r9\xf7\x15\xe6q\xe3\xde-\xe9Qd\x035$r{No\x96c\xb3\xcc\xea\x91F\xe6\xc3\xd7\x1f\xddE,\x97]/\xf9\xc2i\x03z\xfbFS\xb1\x87\xb0\x9b\x84!\r\xab\x9ed\x0e\x85\xef_\xd0m\x96\xe3\xd0K\u03a7\xd0 D\xaf\xd3X*o\xdf;\x05\x01r\x14\xbb>\v\x8c\f\xd8\u03ccCgL\xb0\x00\\\t\x84l\x86\xb7\x1a\x1d\xb1Y\xe1z\x831\xc4\xec,3\x96\xa4\x11`\u0410\u0424e\x06\xc4\"\xfd\x03G\x04r\x99\x96\x19\x120\xec\xd1\xd9\u0688\xbf3I\x1a:e\xac\x9b\r\x19 \x92\xc0\xc3\xd2j\x94\xae\x95Z\x01\xff\xce\xd2\r)'Vo\x84\xe0&Z\x1f\xac\xef\xaf\a4&D\x17Q;\x11991;w\xe3\uc72a_\xfa\x9c\xaa\x15\x98V\\\x10g\u049d\xd2\b\x1a\x04*r\xd4+R\b\x1fO\xdc\x11\riL\xbcb\xba\vi\xf4\"?\u8157\xc0|\xe1(W\xec\x89\xf9\x9c\x1e\xe6[\xae\xc6\xf3`Z\u0666\x1bKp\xcc\x0e\v\xbc\xb8\xcbN\xd0\xe0\xe7\xf5\xd1\a\x87J\xd5`W\xb0\xb8%\fMJ\xba\xa4\xbc\n\xaa\x8a2g\x12\xaa\x0e\xbd\x83\xd7N\x9a\xba\xc2\xdc`6\xe6\x8d\xeau\x9e\xe7\x10z\x03\x85Iwav\x8bc\x99[\xd1dt!V\xe5ISOuf>B\xf312\x1fS\x8b\xa2p\x81\u13f2\xfb\x989\x83\xdb\xcd\xd1Z\xb39\xb8\xdd\x04\xf9\xe8B4\xa7\xb8^\x87\x9b9&J7aQ\xe3 \xce\\\xeah\xe7\x12\x87n\x16\xa6\xba\x16$\xca{rf\xf9\xadE\xa8\xa5^\u0718\xc5V\x88\x17\x92\xb74\x0eby\x977\xa3\xea\xd1\x1a:\xfa\x83\x16\x88z\xf7\u0612\u0082\x12\x05c\xec'Wy\xfd\xf1e0\xc2\xeb\xcaV\x9c\xb0\xc9j\xbb\xcc}\x95-xL\xac\xb8Xya7\xc6I)(-)\x91?\xf1In\x04\x1dL\u070f<M}\xe9\xfa\xa4(\xef_\xec\xba^w\xbf)\x0e\xeb7\x9ec\xe0\xb8@\xf4\x12\xe0\xfd\xba3\xe6\x06\xecB\xbafe\xcd\u0692\xe23\xc7\x12\u05fa1\\\x83\u0126y\x81\x89\r\xc0\x9be\xa7\x1c\\g\x7f\xfe\xf0\xbe\x98\xd8\v\xd8\xcc\v\xb4\xe7P\xc5\u0758Y\x97\xda1\xdc\x1f\x80S\u0580\xb7\x88\xac\xe98\xe3L;{N\x06\xaeJ1vL<\x8c\u0171\xa6\xe6\x13\x93\xac\xf81WZ4>\x9f\a\xf5\xfa'\x88=.\xdd@\x11\x8d\x8aZ\xcc\xec\xfd'p\xfa\xbf\xf1^\xec\x05\xa4\x1b\xb2I\xe1d\x03\xc9\x12Z\xd3\x06/W\xa34\xbd\x03\u4766n\xb8\xe40\x1fJ7,\x9c\u6400_\r\xdc\x05#%\xf8\xfa\xc6\v\xe1D\x8e\x15\x93\xeb\x03GBo\u028cG\x9d\xa0$\xe0\xef\u0693\xe8\x92i\x01\xb2\xc5\t\xe2\x16\x98\xb2\xc0`\x88\u05c0\xba\xa7l\xaa[\x16t\x8a\x8bJ\xa7J\xa2\xce>\xf3^\x90\xefB/\xc8\xe97\xd3\x02\"\xff\vv\xee^d\xc8_\xfd2\u021fV.\xd3\x05\xf1.\xc0\xdfp\u0081\f\x9b\x04\xe7\x85\xfb\xdfG*\v\x04\xd8m(\x84\xa6^\xe5b \xfdS`CA7\x94\v\x92_\xc0r(\xc3\xf7\x8b\x9eI$\x1e\x9c\xfb9\xb1\xde,\xfa\xe6\xcdC~\x9dI7\xa6\x11x\x87\xa0\x12<\x91\xfe\x14E\x97%\x8d\xab\n\\\x00Z\x18\xf8xc\xf8o\x9a\xf6\x87t\xacR\xd0\x03RvVK\xcdeo\xbf\vU\xb8T2\xa9*\xaa;\xea\u06c5\x0f\u00b0T\x1e\x8a3\xe0\x8d\xc9y\xa69D\xe6.\x81\x90\xe2[\xdb\xedE\x97j(\xae\xae\xc9{K\xa9\xce\xf2*\x1dF7\u01a34X\x9b1\x1d9C\x91>\xac\u04d0M>\x8f\xaey\x1c\xfa\xd3\xc4\xe6dd5@W.\xba\xd1.\xf8\xc3\xe8f\x9fc2\x99\xcbh6\xba\xe0\u0555\x1a\x9dr\xb5f'\xab\xe8\x8f\u02f1\xe3\xa4\vr\xee\xd6U \\S\x9eb}BU\x86\x7f\x9b\xb7Mu;d\x9e\xcc\xce@\x98/\v\xde\xd9\xc2\xe8f\x8f\x99V\xeauUx\x9fe\xd5\xc1\x0f\x1a\x96\xda/\x17\u06b3\u02a8Q\x15\a\u04c4\xc0\xa0\x98\xce\xf5$s\xb8\x92\xa1W\xea\xba\xd7_QvY\xfb\xc3y~5\x16\x02\xb3\xc02Zs0iv\xb04\x93\xd6p\x9aN#+:\x9f\xab\xfd!\x96\xec\x8f\xd8\x17\xe7<\x81\x8b\xc8\xde\x17m\xaa|\x8dp\xed\x80\x1f\x97\f\x1c>\x9d\u03a6c_\xf2SU\xce5\x9bL\u0572\x1a\xcc\xfcGa5\xb6\xcf\x1by\u0122\xb6\xde\x024\xf3Q\r{\xdaR\xd9\xec\xca=\xbb)U\xaf^_\xe3-\xb3\xe7\xacl|2\x11\xd9hts77vm\x1d\xcf\x0f\xfc\xa4T7\xbb\xa2U\x0e\xef\x8f\xf1\xb8\x9cA\xa17\xa1B\xad\x98\xd0;\x1a\x8e\"\xf3\x9b\xb70\x04iBj\x94a\x98\xbbi\xb5/\x1f\xd1\u39be\xf1\x01\x17\x11\xcfU\x0e\x1ac$\xe8\x00\t\xbaz\x97\x019\xae\u06b7\x8e\xc5?\x80p\x86\v\xfe\xbb ^\u07ac\xa8\x84Y_\x0e[\xd6\xf1V \xff\x7f\x1b\xa0\xd1\xc20\xe1EH\xf7\x87\xd4/\x80[\xb0vW|\x17\xb8\x05\xc2E\x83{I\xddUUu\x00\xe5\"\xea\xcc\n\xd8\xd8\xd5gE\u011b\x17\xb21m7R{]A\x00\xde\"\x84\x8aFc\xfehx\xfa\x8b\xf0\xf4\x17\xe1i\x966\xaa^=\x03\xf31\xbf-FJ[\xb6\x9bK\xdbI%\xef\x81\xfb$\xded\x95\x99\xb8\xcbl\xb4k\x15\x88n\x1a|\x11;;\xfd\x9a\u04f0\x8ajg\xfb\xb4\xe6\x80\x1b\xe8\xa13G\x16V1j\x87\x825\x99+NtR\xc2\xf4\xd5\xf8\xd3\xdaM\x12\xc6\xd2\x15{L\xe2\xeatI\x16\xad\x8a\x8a|\xf8\xc0\x92\x05J#\x99\x9d\xa9\x16\xab\xbbA\u07de\x9c\xed\xbb\xf7at\xa3\xae0\n\xadzj\u9a42\x8b\xd7i\xa8\xdfM,1\aWl\xf3\u071b\x9f\x98\xd3\xcen\xe7\xf9\xee\x12w\xafT\xb0\x057\xae\xdaG]O\xff\xf5\xb4\xae\xba\xa8\x14\x1f\b\xe5\x15\xae\xa7\xffzK\\\xd7U\xb8\x94\xc3\xf2\x99GK \x0e\xbbri'\u044dx\xc7\xef\x92^\xf6\xe5\xad\xf4L\xfaXO\xa6Y(_\x8d\xb0\x1e\xf0hJ*-*V\xb6<\xd7R\xf0\x8f\xb8\xa1ZA\xf2\xd1\xffX\xed\xbbv\x8d\xd9\xf6\x13>D6h\x05\"\x90\nDj[\xe6\xeb\xea\xe7.\xe2[\x91\x18qV\xe9v\xb4:\xf6\a\xcaG&\xb9{Y\x91\v\xb93'\xfe\x92\xfa\x84\xc6 ;.\fi\x85\xa8\xcaT\xad\xd7\xcb)\xae\x89)\x13\x11*\xdc\xfe\xb0\xd2\xdb3!\xf3+\xdc\b>\xbd\x87\t\xa1\x97}\xaak*\xeeiyoU\x0e&\x12\xf5:4d\xfa\u011d\xe5r\x1aY\u0362\xbc\xc7oa\u0602\u00eb@J\x1e3\x9f\xda\xd1tO9\xe4%\x19\x7ft1\xebU4\x13\x92\x95\xb2\xae\xfc[3iSW3K;\xed\xae\xed\x99v\x19\x1b\x87W\xfa\xe3\xff\xe1\xf3\x05\xaf9\xa1n\xd9\xc9\xfd\x84^\xcd\x12\xb4\x9a\x80\xd7\xd2\u0754\xe7\u01ecv\xccG<\xb8\xe6c\x95\xfeC#k\xbf\xe0\x815\xdb(\xb9`\xae8\x89\x9e\xdf\xd2\xee:>X\xa9^\xa9\xd4\u070e\x9e\xaebz\xea+\x97\xfa4\xa0!\xbc\xbd\x13@\x82Y7\xae\xcf2H\x92\x9e\xfd\xab\x14\x19G\xf1\xf5\xcaP'\x9e\x9b\xdb{\xb4\x04\xbf\xc97\x9f\xcb[\xfc*\x90\xaec\xa5\xe2.h\x19\xf8\xf5\xf2OO\x10j\x8f\x84\x06\xccG*2\x1bh@T\x12\x13\xb4\xd1(\x0e#g\xf9/\xee\u00e0\xa7\xab\u017d\xbe\xa0\xc1\xd0\xeb\aT\f\xbd\xb8\x17\xb4fBE\x84\x11\xc4\v\xb2\xdb\xc1\x8d\xd8\fc\u0477A>\xaf\xadZ\"P\xa9A\xf3\x19d\xa7\xe9/\xa6\xe5\x83#f\xf9\x8f\xfd\x14%Ip\x16\U0009af67kW\xfc*\x8a\xefj!\xf7/kc.\xd1gF\xab\xe64L\x0f\x10\r\xa1\xa1oM\t\x97\xa2\x81KRC\xff@\xad\u0697\x84\u05f8j\xad\x95\xf0\xc2&pIMF\xb5@\xc0B%\xbc\xa6,\x8bIw\xa4\xa2#9vQ\x88\xcb\xc2\xc7|\xfc\xab\xaf\r\xffG-\xdd(\xe3t\xa4\xe2NI:j\xa1\x01w\xc6\xfc\xa7!\x1bQmDT\xaf\xdb\xd6D\xc5_nH*\xbc=\x8e\\\xed\xe5\x18C2\x06q\x1e\xbf\bS\x94i\xf2\x02\xd63\xa1\xd2\x14\x99\x03&\xbeoLh<l\x03\xa4\x04\xb0-\xca\x16H\xfa\u0567\xdb\xc9Q\xb6\xee\x87x\xc5|\xedI5/@s<X\b\xd3o\xc5\na\xf78\x02\x0fTRq\\:\x1c?U-x\x9c\xc2\xc0=I\xf3\xcd=\xa7\x11\x1b\xa1\xefV`\x8e\xea\xe9G\xd90\x98\xa0\xb1\x9e#\x8bl\xdf\u0313b\xc7\u0676G\xfe\xaa9\x19\x86\r\xdb\x1f\xea+.\xb6\xcc\xf4\xb2R\x11\xbc\xe9+\x0eH\xd4\x13\xbd~>\x944\x8d\x86^?\x1az\xa2\xb7<\xe4n\xc9V\x90j\xea]\xff\xee6\x1a\x82\xa8\x88\tHIg-\xc3O3.9\x87\a\xc4\u060dhd\x9a)E\xe5W\xdd)*\xb7<k\xb8\x86\x8cXCBL\xd9\xea\xc3o\x04r\xb5N\xa1j\x96l\x02\xa4\x98][\x8c\xdc_\b\xbej\xcd\xdb\xf2\xc3\xdah\xc4$\xf3LZ\xe1\x98\xf4*\xc7\xc2\xd5#\x84k\x92\xc4H8\xf48\xfeA'\xc0\x1e|\xe3W\xc6<\xae4n,\a\x13Zqi\xe9s\xbe\xe2\u03b21\u058a{\xab\xbb\u062d+s\xb5\x8eZ\x04\xce\xd7\u0554r\n\xa4\\\x1c\t\x10\x1f\xef\xffyu\xc4\x1f?\xf3Oe#0\x87\xde\xf3\xcc\v;\b/\xe1\xc0-\xc6\xfd\v\xe64\xb13\xf45\xaf\xd5Z\xecX\x11|\xaf\x9d\xa6\x91\u02d7\x84\xc6C\xd8)\u01ed\u0464V9\"\x90\xc6D3\t\xf9\xf8^h\xd5\xde\xe6\xb0\xf5k\"\x12M\xc1\xcf}\x19\\\xf3\x9a\x1a\x80\x05\\T\x88\x83\x18>\x01\x03\xd3M\xaa(\xcdB\u0235l\xc3['\xa1^\xb7\x7f\xad\xb1j\x9f\u068aV5\xa5L,\xd3U\u05fb]\xc0\xdc\xf0:\xb1@Q-&\xa5\xa9\x1a\xe8\xbc@\x87\x95n*\xf6__\x10\xf1_\x02\x7f!\xf8za\xaa\xea\x06*N\xeb|\u0674,\xe7\xef:\xe4\x94]\r\x8e \xab\u01aa\xfd!\x15\xac\xd3\x15{\xe5\xfb\xac+\x1a\r\"\x15y\x92\xe5!\aC\x1b\x11+\xea\x1a\bF\xea/\u01d6>\x89@\xc93\xbb\r|\x15\xa82\xa3\x9c\u05a2\x82\x92E\xa4\x05\xa9]\xd40\xcem{\u0740I\x88@C\x03\x9b\x99\x81+\xa3\x97+\xb0\xe2\ff\xe1}.|1\x0e\xf9\xb8\x86}\xb6\x9c\x86\x1b\xf4\x9c\x9a\v\u43ce\xe7\xd8p\x88\x03\xa6\xcf&8P\xa2\xc4I\xb7\x92\x054\xd1\xf1s|\x8d\xe7\xb39\xcc\nC\xae@\xae3\x12\xbb3\\>\xe0\xbae:\xb0\xa0\xb7\xa0\t\x9b\x11\x1b\xbb3\xaa\xdda\xab[,\xc4\xeb+vG\x104[\u05f5\xd49\xec\xf5\xf4\xc7c\xb3\a\xaa_}\x8a\xf4\xa0p\a\xa0B\xa4]9\x12lI[\x85b\u04d8\x83\xc4\xeb\xf1\xfd\xb4\x17\xfaY\xf6&M\xe0&\xc3\x1d\x83\x19\u04ec\x11\u00fa\xab\x1a\u0211\x18\xf1\u0543\u025b-\x8d\xfd\xe1>\x8aD`\xa9\xf9,H6<e\xd4CF\xda\x0f\x99\xb8\xb0\xfb\x89Md\x16\xb6\x8d+X\f\u00a8r\x01\xa1\u051a\xf27\t\xfc&m\u019a\xcd\x05\xb4\xd8{\b\x93z\xae\xb6\x00\x81\xaeh\xbc\xf02\xc7\xfa\xeayT\xccB=\x0e\x8bf\xb25\xa5*H\x00\xa1\x90\x88\x0e\xf0&r]9\x1ft\xe5\xfcf\x13\xd4[\x04xbW\x93\xeb\xfb\xc3\xe2\xfc\xee\x03VH\xa4\x11\xf3\xbbg1\xf7/A\x1c\x1f\xed\xb5\v`R\x84d\x16J\xd3[\x8cT\u07d5J\xac\x95{\x8e\xe0\xdasD\xa33\xecb\x94\x01\x90\xd4\t\xa0\x00@\xfe-2N\xa4\v\xa0b\x02\xd1K\x86\xb7\xbe\x13rA\x9af\xa7\xb5\xb6\xb8\xbf\xa2\u0244-\xdfo\x15[\xf1 \f\xab//\u0373\xa3qyk=n\x0f\x8aR\u05e6`\xe5\x8b\xe6\x9f]\xdb^N\xee*\xc1\xea?\xdd\xc9z#C+\x84\x9a\x99T\r4\xe340;\xaa\x91P\xc8UQ\xcc~\xfeD\x88Iy\xfd\xc0\xc1G\xc4|\xe5\xf0_\a0//\x82\x1b\x15\xef\xea\x8a\x12\xe5f\x8b\xe0y,\xfc4#\xbd\xea^q%\x82\x94\x10k\x04\x16\x8e\x93\xf9\x81\xb5\x1e+\n\x062?\xa01\x1c\u0438\xd9\\\u058a\x1d\xea`q'\x87\x95\xbbR\x17\x9f\x18,\xbbp\x19\xc4\xfe\xcd\xfb\xc7\xd5T\u05d5\xe9F\x01\xa5\n\xddW\xbei\n\xd5z\xa5\u07ee$\xdeE\x1e@\xa9z^\xaa\u00cbB\x1e\xae\x0f\xb2s+\b\xae\x85%\xdco\xf7d\xe1\xe4`8\xab9\xdd\xe8t\xda\x1b\x0f\x86o\xdc\xd9x\xd1!\x80\v\xddr\xfc\x10;z\x97li\x17\\0*\xc6\xed_\xa0\u05cc\u0291V|ei\xc5\xdd\x02\x9d\n\xc9\f\x03\x029\xfb\x16\a\x16E\xe8\x18\x85\xcb\xe24\xc3S\x99_\xfb!\f\xea\xda\x0f\xd5\xc0h\xa4\xa4\x14\xccws*\x19S\xf9\x84\xc7\x1c.\x7f\xdf=6\xdfYnr'\xa4\x7f\xcb|\xf7\x04?\xb2t\xe4\x00\xf9V\xe0\x14\x1a\xb5fq\xc0|\xf7\xcb\xf1\xdb,E\x1d\x1e\xe6\xcf)\x02\nE\x1ak\xa50\xd1\u05bc\x8b\xb4.\u05578\xeb\u0400-\xe3A\u04c4\xb5\u045dJ\u0181r\x1c:\x02\xb3\xfc)\xfc3\xa9\xd8\x01A\xdfo4\x86sz\xc1*\u00aa\b\xe68\xdd\xf5\xc1x\x1d\xa3\u00f8\xbc\x9f(\xf1!\xe3\xfd\xa4\xd1\x18R\t\x1f\xf9\v>#y{hf\xf4V \x97\x10\xfd\xe0t\x93\xbdY\xb7\xd1H\xe0bU\xb5\xe8\x88(\xd7\xf7.\x0e\xd0i\xc1Q\xe8\xb98P(\xd1h$C\xe29mGi\x1eC6\xf4\xda\x19b\x99\xb6\xea\xbb\xc16\x86\xc4\xc3\xdf\x11CA\x1e\xb9\x1f\xf9\tw\xce\x1c/l\xb0l\x18\x13\x97\xd0N\x9b\xe4:\r\x1bD]\xd7],=\xc2\xd2eu9W\xb0\x89K\x88\xd2\xce\xce\xc8v\xd5@Ox\xea\x03]8\xbd\xd2\xe1_\xdd|\xde\xd0]\xa1\x8fq\xe5\x88\xec\x12\x13\u01cbM$Ze\xa7\x15F>\x96\x84\x81\xbf\tn\xf9\u060d\xd2t\x87\x10\n-\xf5b/\u0383~\xff\xd9^\a\xbdj\xbb\xbd\xaf\xd8\xe3\xcf'G\x1f[jf\xc1\xe4\x0e[\xb3\vEj\xeam\xa7\xb1\x1cX\xcf\v5\x12\xac1q\v\x89\xb7\xba\x99\xdbU\xedtv\nu~{d\x9d\x96\x8c\xbeL\xa7*^R\u05ab\xe6)@\v\x12\xad\x02\x9d\xa7*\x84\xe8\b\x82[C\xaa\u0656\xe1\x1cv\\.Q\xc4\xc0wW\xbed\x82\xca\xd6u2\x8d\x03!'K\xe4O6\xa2\xe9\xf3a\x1e\xb9\x90\xcciU\xa0\xac\x8c\xdd[\x81\xe0u^+\x8c\xc0\x06]\xb6\xca\x1d\x93{\xab\x84+\x96\u0e39R\xd8\xea\xec\xeel=_\x16\x03\xd3Y\x12\x01\xab\x06\u808fk\x11lhp\xdd\xeeKd\xba;\u0191Y9\xa2i\x1e\x88l1\xf4%\x8dX\x16\xd7\xd2\xf47t\xba\u0551\x9fux$M\x06UP\xe4~\x9aj\xb6\xb3O\xd6\x18\x8b*Yr\xb2\xe1\xe7*\x99\x01EM\x8dr\xc8U:+\x05\x8a\x85N\xed\xf3\x1b\x18\x1e\xa9o\xb1\xb1ib\x96V,\xc8\xf52z\xc2\x04%$\xb8\xcf$\xf2\xc4\r\xd5l\x1a\xe3+[\x9a\xd30W\x9d\x00\u065b\xa6k2Z\x90\x8e\x80\xa71e\xed\xeet/\xecN\x1b\r2RL\f\xe7\x89\u04d8\xa2jh\xc0\f\xb4]\a\x16Uy\x87)F\xc6\x02.\xc1\xc8\xe8\v\x80\xba\x00\xb9\xaf\xe9\"\xaa\x8a=\xf5|\x80\xdd\xda\xdc!\xee\x8c\xd8\xf4\x83\x82Va\x9b\u03bb\x93<\xdf~\x19\xd0\xc0J\x87\xa5\x9bP\xbb\xa4\x1d\xbb)\x98\xcf\xe9\xf6\xf3\x9d\u03834\x05ntbm\xab%{;M\xe39\xddjot\xda+[\xa4\x11\xb3\xeen\xeag\xcd\u0440e\x1b\x8d\x167\xaf\xe5X\xcew\x7f(\xb4i\xa0\xea\"K\x8c\xd8\xde\a\xbb\x0eq\x8b\x16\u0273\x87b\xf5\xc1\xf2\xce\xd0\xf2\x01\xc3\xe0\x16,\x9ag\n|\x8a\x13c-F~N\x829\x98\x94\xf4\xec\xbc|\xe0a\u0792\x95:\xb3\x8e\x8e\x83\u0201;\x04\x86\xb7\xe0\f/\x9c\xcf\xe7.\xf1B:\x05:\xaf\xb3\xd5\xde&.\xa1\x93j\x0e\xea\x92\xe0\xb6Y\xacg\xa06\xee\xe7t\u032a\xfc\x1b~\t\x84|\x8e\u0628\x17{\x137\xff\t\xc1\xf4\ue767\a\xe7\xe71p&\xd5R=u\xbc\xaaF\x8a\x85z\xb1WL\xa0\xceSl\xf2\xa9\xa3\xe2\x1b\x9b\xdf*\xf0\xf8\xb26\xf3\x12\xd0`\xfe\xcb\xd46\xfe\xcf2H<u\xbcio\xe2\xf6\x87\xfd\x92\xd7\u02e1K\x88\x87\xf5\x92;1Bs\xec;1\xaa\xaa\x9f\x17\xd2\x10}\xeax\x17&\xedG.T\x8d\xca\u012a\x1aU]`\xa6\x8c\xae\x82Q\xb2l\xe6*\x17f\xad\xbe\xa8\xf3\xf4ep\xfeV\xc8%\x15Tf/\xf6\xd4\a\x14W.\x1c\x9e:\u0199\x03u\x9e\xbe\xf6\xa5\xffK\xc0o\x964b\xb2{\xb1g>U%\x184\xfc\xa1\xceS\f\xcb\x01\xc6SO\x1d/\xfb\xb6\u04f3\x80Gv\x81,\x91:O\xb9\u0409O\x1d/\xfb\xb6\xd3\xed\x16\x16\x13\xa9\xf3\xd4\xecC\xb3\xb3\xe0\xb1\x01e\xaf\xfd\x10r\u035b\x03J\x98o\xea<E\xdans\xc3l\xc3*\x00\xd8Ez\xb1g\xff4\r\xecl=\xd8\xc0\xceV\xa1\x81\x9d\xad\xac\x81@@(\x17\xf4Zp\xcc\u03c3D\xc6K\x1b\xaa(\n\rV$C\xc3\xf9\xc6\xf3\xa9\xf3t\u0646|k\x0e\xf6\x92N\xdfZx \xfbV\xf5:;\x0fT\xec\xecX5;;V\xd5\xd5 \xcf\v\xa8\xaa9\xb8\x83\xe4M \x02\xdc{\xe6\x13S?\xfa\x1f1\xe9\xa3\xff\x11:X\x82\x02\x96!\x01\x85\x05\x80D\x87\x01\x955\xd9 \xbd\a\xff`\xa9\x0f\xfet\u0268?\xf8\xf0\x18\x00\xf7\x98X\xaaj\x10U\xc4\xea\a\x7fZ\xaf\xc3\xe8P_\xf2\x83?%K\xf1\x14\x10-O\x1d\x0f\xfeP\xe7\xa9R\x06{\xeax\xea\x83:O\xd55\xf0\xd4\xd1jm\xd4y\x9a?`\x00\x04\xd9\x0f\x93\xa3p\x87\xf9\xa4\xceS\xad\xe6\xb5d\x86:\xb7\x17{\xfaK\u0578\xbd[^\xfe\xf6N\x95\xbe\x85\xd5\xcb\x1f\xf9O\x1d/\xff\x019\x85G>\xe4\x16\x12T\x89PM\xae\xaa'\x9d\u06cb=\xfd\x855 \xb6*\xb6\x05\x1f\xd4yz\u0097\xd5?\xe1P\xf7\x84KU\xea\xb1kw\u00a5\xb5v'\\._\xbb\x93\v?\xe6\xe3\x87\ufdc5r0\xaer\x1a\xb4\x87\xe4\xffSG?\x80\xb3\x94e{\xdfq\x96\x0f\r\u04f1\x9c\xfa\u0529\x19\x81\xf6\xd4\xf1\"\xea<\xfd\fDNF\x9e=u\xbc\x11$\xdeM\xf5\xd0\x00\xb7\xeb\x04S \xa0\xce\u04dctX2\xe3\x02\xa9\x91\xff0U_\x85\xfe\x95\xd5\xc5\xd2\x16\xecr\xa6!;M\xb7\xb7\x1aiY%t\x1b9\xda\xfa\x12d\x98hE\xed\x1cqY\xbf\xa0\xb6fA=u<\xf3I\x9d\xa7\xbfr\xffr9F\u0479\xbd\xd8\xd3_\xba\xc61\x9f\xac\xa8q\xcc'\xba\xc61\x9f\xe8\x1a\xcbw\xbe\xce\xd55N\xb8\x9c\xd3\xeb*\v\\|1\x96\x89 \x94\xc2\b\x96\xb8\x8e\x0f\x19\xd6\u02c7\xd4\xee\xe7\x8e%\xfd\xa9\xba\x7f\xf2\u06a6\u07b3\x8a\x8a\xcb\u0229e}?\u0706\xaek\xf4\x92V\xf4A\xba1\xfa>\x88\xad\x97\u063c\xd4l\u0141\u02db\x8f*\x9a\x87f#lv\xe2FV\u00d9\xec\xe7Ji\n\x8a9=GR;?\xf7\xf6\xa1\xee;V\x86\x8a\x92\xaa\xf2\x9c\xa1!\x85+\x8a\xaf(x\u0285\x8c\x03\x9e,)L\x1d\x9d_\xae\xa6m\x00\x96V\xd3\xf9\xe5j `YZ\a2\xcb\x15T`\x8e\xa5UT\xb6\xaad\xef\xd2\x12\x10\xec\xacE`\x94\xe9\xf9\xbeSLzd\u034a>W7QnN\x93\xe7\xc5vt\xe2BaC\x97\x17K\x9b\u052a\xe2|\xa1\xe8\xe2\x10\x10E\x15\xcb\x1d\xea8\x92\xa5\x82\x86\x9e.\x156\xc9\v\x15l\x12\xbaX\xc7\u03a9\xae\xb6\xb3\xb5\xac\xda\xce\u0592j\x95\xfb`\xe9B\x16W\xff\xe1\x85_\xb2\xe6\u07f7\xdc\x19a]l$K\xae\xaa\xd0\u0669\xae\xd1\xd9YZ\xa5\x12\xe6y\xfaB\x15 z\xd1;;\x16\x84_\x0e\xbaPO\xf2\xec\x13\xc3\u04b6\x8bd|n,\xf6\xc1\x9f\x16{Tqj\x8b])\x1a\xb6XN\xa5-\x14U\xc4m\xb1\xe8\x91q-\xb9PT\xe1\x0e\xc3.]V\x98fn\x0e\x8a\xf5\x10\xa9\x1cM\x96W\xd3\x05\xb0\x96&\x8a\x8b#\u04c9\vC\xb3\v\x9f\xca\v.\x96\x96FG\xd9\u00aet\xea\x87a\xa98\x84\xb6\xb6K\xc4\\?\x05\xecB*\xb1T.\x81(\xc3\v\x051\x15K\xe6tzqby\xfa\xc2\u070a\xc4{\xa9Z!\xaf\xa2*\xd0\xea\xe5*\x90\xb6P\xf4\x84\x976\xc1\t_\xdc\x01\v\xa4s\xa9J9{\xb1\x01\xdc\x15\xa5ZR\u01d4*\x15E\xe2\xb9T\u0504\x93.\x17\u0348\xebr\xf9,c\xa1RNj\x17\xeb\xe4\xe9\x95U*z\u0252\x17*\xe4\xf4w\xb1F\x9e^]\u0166\xb4+j\xda\u0655\rTb3+\xa3\xb2R%>\xb32\x16+i\u02bbTC\xa7.\x14\xd7Tw\xb1\xb4N\xac,\xbc\xb0%ub\xb1\xf0\x9c\xde1\xe1\"\u07dd\xd03\xcd]\xdf \xf4\x86iK\xb6\x8c\xb9\x0e\xbfhY&\xa4\x04\x1b\x84\x1e.\x14Gq\xc2By\x88\x878\xe2\x84\xdeV7\xaf\x05\xa8yy-\xcc$\xf4\xf4\x91\x15\x12\xd5\xfe\t[\xef\xff\xf9\xb4\xd5\x1f\f\x87\x8dt\u0407 J\xcd\xde`\f\xf1\x90\x06\xad\xc1\xb8Az$u\xfb\xce\x0fC\xe2B^om\xb0A\xfa\x7f\x0e\x06\xc3t0h\x91g=2\xd8 \x83a\xea\xf6\x18\xd6H\a\xfd\xc1\x90\xe4\x9f\xe9S\x88\xa4tN/\xd9\xfa`\xe0\x0e\x06\xa4\xb7~N\x0f*\xbc\x8b\x9e\xba\x9c\xb6A\x06%\xf0\xb3\xd9Qnj\x14Y^\xaf;O\x1dT\xa3\xcfY\xf6\x91\xeb\x04\x02\x83\xdb\xd6\x02\x01\x93K\x82QM)\x19\xd0\x1a\xbf\x9d\xa2\xc5K\r\xe2\xa0\x05\xe2\xbc\xf6\xd7\u04ff\x1c\xabEaZ\x94\xdf\xddb4\xe5\"oQ\xbdF\xfa\x99\x1c\xff\xd6\xe5\xf4\xc4\xf68\x88\xca\xf8\x11\xb9\x8f\xfb\xb1\x16T\r\x99\xe8\u077a\x11\xbd\xa4\u0393\x8eC<pw9'\x84\xc6s\xfa\xaaZ\x01\x91\xa1S\xd23\xf7\x9c\x1a\x97AO!~\x0f;\a\x8d\x9d~{\xd8p\x9e:\x84\x9e\xb9W4&\xaa\x92\u03ee\xc0<\x00|\xdb1\xc6@\xd5\xcdg\xd7nL,\xedE\xbf^_\xb3g\x1f\xb8N>k\aD25~\x1b$2\xa1\xb5\xb3\x99\xcc\x1c\x00]\xfbA\b\x9a\xf0\xad\u06a7\x10\x8d\x7f \\k\xcd\x17\xb5 If|-Sl\xbe\xf7\xc3\xc0O<A\x85rL\x88W\xae\xe7\xcf\xe7%x\x17{\x1cG\\\xf5\x83]\xaf9d^)\x145q\x9a\u02ae\x92\xc0\x99\x1a\x1a\xb2i\x91\xe0\x92\xd9\xc1\x90J\n\xdb\xfcj*\xef\xb4s#\xb5Q\x16\x02\ru\xeau\xe7L\x13\xf3Y\x8fE\b\xfe\xe0\xa0g\xb3\x0f*\x1e|\x85\xe1\x83_\xd3-\xfc`\xdcT\x1f\xa0\x8bnf\xe9\x7f\x80>\"zOc\xaf`\xb76bX^*AA\xc5W\n>!\xf3\x95\x9f3\xa3\x9f\xe2\xb7\x10\xda\xdd)\xee\x8ei\xbf=\xa4\x87\xae\xa07n\xbfM;C:%$\x97\xfdNX\x87^\x80!\xd9d\xcft\u069d4XGm\x9c1\x13\xfd\u0250^\xb3Sw\xac\xce\xe39~\xea\xf3\xe8\xfe\xe0\xfc\xc0\x18\xbbNS\xe7\aG\x7f\xfc\xa5?T\u05b9\xc9:7Y\xe7\xa4^\xbf^\x83\xbf\x85\xa5\x9fj\x11\x1e\xaeFR\xbb\t\xe4E\r\x83\xe7%\nZ\x17\xfe5\xaf]\x81(\rN\x9b\xca\xd1G\u0612\x14\xc2\x19\x1e\xd7\xeb\x17i\n\xaa0mu\n\x12u@\xe2\x06sZNcL\xf0|\x90\x90]\x81\xc2M\xe6:\x0f=i\x84\xcaR\u0305#_\v\x95\x8b\xad\U000b1008\xc9\x16F\x98Dq\xc5\xf9\x90\x17\xbc\x96Mi\xe1\xb0d\xc7\x02\xf4\xfbf\xf5\xfa\xa4\xd1\xd9\xcf\xd6\\A\xfe\x8e\xcd\u0710\x8e\xc1E\x92{\xc1\xd6\xd6\xee\xc0+\xea9\x97N jw\xf5\xfa\x9a\xab\x83\xb7\xfa!\xfa\x9a\xc3d\x90\x1d\x92\x1e\xfe\xf1\xc2\xfex\xa8\xb8\x1c\x17\xec\f[\xa2!\x83\xc4.\xb84\x18\xd5\xeb.L\x9f\x81\xbb\xb6\\.IQ\x1c\xb9Z\x8e\\\xc9B\u0571\xe1\xd4_\x1a\xc1\u0378\xbd\xd5yA\xaa\x8e\xeb\n\xc5=`\u052c\bI\xe7.\x84\xc0\x8b]g\x12E\x0e\xa9\xcaS\xb5`\xbdb(\x10\x81\x8c\x00\x9c\xd3\xe1\xc8\x16\xf5=*GZ\xada\xa1Z\xae\f\xf7\xb6\xcal\xbch-\xf0@4?SXYxb\xe0 \xc9\u0314$O$z\xec\xcc\xf4(\xbaU\xee\xd1d\xb1G\xa3b\xa2Z\x19:\xb9\xd5\u03a2J\x8a\xb6[$\xffi\v\xc2j\x01P\x8e\x84\x83\x85\n\xd9[\x1b\x94?d?a\xa9\xf1\xd6\xeb\xed\xbc7Hp91\a\xe6q\xadTX\xe5\x17\x1a]\xe2\x0f\xa0\u0707:\x05\x0f\xb8\a\x80\x91tP{\\UO\u04f8\xdf\x1e\"\x81a\x0fvm\x01z\x06g\xbcM\x0e3+\xb3\\U\xf5\x1f\xcd4W\x8a0\xec\xcc\a\x8aaW\xd0\xc3\xd6\x06\xe8\x0f\xa9\xbb%M\xd7\xda\xf8+\xb7~\xabp6\xaa\xb4\xa9\x1e\xd4DQd\xb3u\xe4\xe2*\x1au\x018\x17~b\r\x98\xcc\xe9\xd6\xee\xf3\xf6\x06\x1eeK\xc5\xd5\u0493\xb0\x95\x95\x80\a\u03d5\xbb3P\x94E\x9dr\x86\x06b\xd5: \xdav\xe8\u029f\x02\xfe\x8e\xb9?nF\"\xbcs\xc8\xdc+\xb4\x8b\xe2#\x17\xedg\xd8B\xfb\xabZN\xb8,\xb7L\u031c'1\xe7\xdf\u0429\xff\xea\xcdi\\\xb7\xe4\x14fn\xaa\v\xb6\xaey\xe8J\x830\xd3T\xb7\x18$o\xe2\xe8\x1b\x17nL\xd2T\xba1\x98BR>\xd7&\xad4f\xb2+\x8c\xc5?\x8b\xb5\xff\xb2\xa8\xe8\x9f\xca\x18\a\"\x915\xf6\xa5\x8f\x90\x80\x0f\x8c\x00\x85\xca\xcf\xf8K%\xaa\x84 \xf9\x00\xd7\xf8\xdbs\x11\xa1\x19ug\x1e\xe0'\xa6\x1a_U\xe5B\xed\xf9\xbcR\x01\x9a\xe7j\x9b\xf5\xf5s\xea\xd4\xfd\xabi\xd7!y\xea\x1e\xa6\x86\xb2\x90\xb8\x8f\x89\xe7\xc5D\a\x13\x81\xb2($\xff\x80\xc9\xffs\xbb\xb1\xdbu,\v\xe1\xc0\xe5\xb4\xd5jI\xa5j(k\xa2J\xaf\x1fQ\x9e\xcaW\x88o1\x8a\xa9\xacXC\xad\xac\\]\x11\x96I\xccU^\x02.\x8c\xd6\xd6x\xeb2\x10c\xbdD\xb3%.\xc4\u03905\x02Z\xcc\xf8\x13\v\x7f\x8a\xf9$\xb8e\xd2\xfeE\xc1\x94>\xbc\xd4\xe6\x83\xfex\f\xd1\x00`HV+\r\x06k0\x87\xb7\x8f\x8ee\x81\x84R\x92\xfb<\xef\x86\\\xd6$\xd3#\xe3\xe0%\u0284\b\xc7\xd0-\x7f=\xb9/\x0fc\xfe\xe4^\xce\xff\u04bb&\x99\xfa\x02\xb4\xcf1p\xb5\xe9\x03\xda\a}O{ \xce\xde:\x94\xddw \x16c8\xe3%\x85|Ul\x8e\u0355\xe7\xf0\xd7\x1e$\xd7p\b\xccyr\xcf\xe7\xce\xfe_\xc6\x13[X\x00\xa3\xae\x19G\x11\xba\xaad\xf7\xe81<\xe6\x02T\xfa\xf5\x88\xa5?\xbad\xfdB\xb9\xe1\xfc\x1c\xc0\x10MK\xa3\u00b2\xfd\xfc33\xcaP\x15\xa0z\xa9\x86i\u0478\xe5\xc3D\x19M[f \xc6\xcfYaQ\xf4\x0eb\xf7\xb0\n\x1e\xa7\xf6\xa8\xbb\x99\xcb8c-\xa7\x86\x82\xed\x14 \x9f\xe9~\x16\x06\xbb\xdf!\vS\xd2\xc6UX\xf5 \f\xa1v\xe2\x1a\xb3,\xb5\xdcY\xa3]\u049d\xcb\b\x98\xcd\xf9TK\n\u0585\x99\xab\xb0\x0e[d\x8e\xbb\x93\x17\xc1c\xadU\xebT\x15\xa0\x85\xdad\x9e\xa0\xf3\xd7Z\x96k\x1aX\xa4\x9az\xbce\xb6\xbd$\x9e\xcc@\x8c\xc8-\x03/@-\x87~v\x88%S\x1e\v\xf3~0\x9aT>q\x89h\u058cf\x14\x85\xe0\x1d\fWk\xf1\xfd\x8aQ\x98\xed\u07b3\xfe\xf85\x8f\xef\xd0'\xd4\xc2\xf89\xc4^\xc8J\xb2\xbeUK\xa9\xb1:\xe8\x06qq\xe8p\x8f\x86-{H\u0285\x94:\x10\xa3\x1a\xbf\x95\\\x8c\xcbG\x03\xce\xe5l\xcac\xed\xfa\xab\x15M\x01\x87%\f\xb7\xea;~\a\xb1\xf2\x15\xbc\x1d\xa0\x17\xb99\xc0\x05@\uab68Q\r-\xef\x95\x02\x1e2\xcdd\xfb\x97c\xf6I\x8eaTw\x06/s\xdc\x04]\x81\xa8\x88I*ld\x84\xe6\x0f\xa6!A\xe62B\x17\xaa\x05\xb7I\u0294\xba0;\xd2\xd2\xc8f>Q\x8a[9\xde)\\US\xfb\xaa\xea-.U\x8f{\xbc\xa5b_*\xe3\x0e5\xe8\t[G\xde\x1bp\xd6\x06C\xcd[\x03\x1e\x9b;\xe8\xf5\xd2\xc1\xc0\xedw\x9a/\x86\xfdv\xf3\xc5\xf0\x19\x81\xdc\xf5\xae\xaax\xc1\x1c\x88a~\xd0\xfcc8\x18\xdc<s\xe88K8\xd5)W\xcc\x19\f\xce\x06\xc0\xd3\x1b\fZ\x03\xe4\xe99\xf4\x9a9n\xb3G\xdc\xc1\xe0\xac\u077f\xfdm\xd8\xf7\x9b\x93\x83\xe6\x1b\u8851\xba\xc5\n\xcfH/U_\r\xe2\xf6\xf9\xe1\xb0\xdfl\f{\xaa%\xe2\xd0s\xec\xc0m\x9f\xf5\u06dda\x838\xf4\x8e\u075f\xf1\xf3@x\xce`0\x18\xf4\a\x83d08\x19:4\xe6!\xbf\x06*\xcak\xcf\xe9\x19\xbb\xc7}\xa6\x9c\xc5k@Q]\xef\a\x87r1\u01bfA\x18\xf2s?\x84\xb6\x84CG\x91\x90~ \x12\xaf\x7f7\x9c\u04db\x15m\xfc\xe0\xfc\x80m\xc0\xdfUm\x1c\x9a\xc1\xae\x0f\xce\\?\xf5E*/x\xea\xc7<}\xfb\xc3U\x1a$\xe2\a\x99\x8e#\xf5/\xc7_7\xf8\xebl&\u04ef\xb3D\xa6\xc9\x05\x04\xb1N\xa71\x97\xf2.M \xd0\xef]\xcaE4;\xbfH\xcf#!\xfc\xf4<\n\xc4yz#'i\x12\xa5\xc9lt\x91\xde\x04a\x98\xdeE3\xf8/\x86\x0e\xef\xd20\xb8\xe4\xe9U\x14s28[\x9f\xd3\u06d2\xbf]\xa0\xaf\xf4\x0e\x8fY\xe0\xda\xf3\x1e\xa9\xc8\xccf\xe2\x1c\xa7-\xadi\x0e\xe7\xe0\xd6C\xef\u02b8e2\x14\xe6?$\xb4\x9cd7>\x8eF\xd2\xcf\x17\xc6\xedy\x9f\x8f^\x1f\xa5o\xde\xfe\xf6\xe10\xfdx\xf4\xf90}\xf9\xe5\xc7\xf4\xe8\xd3\xe7\xb7\x1f\xde\xfeq\x98\xfet\xf0\xea]\xfa\xdbo\xbf\x11\xaf\xb8\xdc\xc8\xde<e\xb7\xae\xb3\xbe\xeeP\xe7\x89\x03\x9cg\xf85\x18<s\xa83\x18<[w\b\xbd\x84\xa4\xff\xd1\xf9\a\x85\xc5\xd5~\x1b\xf48\xae\x8a{\xe9\u054a\xa2\xd7\u0162G+\x8a\x9e\x17\x8b~]5\x80\x86\xe3>M\xf9U\xcao\xd3\xd1E\x1a\xf3\xab\xf4\xfa&\xbd\xbeH\xaf\xaf\x02\x91^_\xf9\xb7\xe9\xe8*\xbd\xbaJ\x03\x91Ne:\x1d\xa5\xd3\xdbt\xcc\xcf\xd3\xf3\xd8\x1f\xa7\xf0\x1f,F\x9a\xa4WI\xfa\u04f7\xf4\xf2\xa7o\xe9x\x1a\xa4\xe3\xe9\xe8*\x1dO\xa7\xb7pD\v\x83y\x9bmS\xb7\xc7\x06\xeb\xfd?\xd7\ab\xf8l\xb0N\u05ad\x85\xb6\xc7\x1b\xf3s\x0e\xf27\xb3\xb9\xd7\xd7q_\xac\x0f\xd6\xfb\xe7\xc1\xd5\xecn\xf8l=;\x17\xeb\x03a7sG\xb3#\xd17\xb5\x86\xeb\xf6x\x8ag\b\xfeG?\x17\xa0\xa5C\xbe\xa9f.\x8aS\xf9\xb8\xbc\xe4\xb8X\xf2\x93\x85HZ\x83A\xf2\xac\x84\xda\xec\xc2\xf8\xd6<f\xc5\xf7\xd4}\xa67\xaf\\\x19~8\xf8\xfc\xea\xa7\u04cfG\x9f\x7fz\xfb\xf1\xc7\xd3\xe3C8\xf4\x83\x97\xeb\xf4\xed\xeb\u00cf\x9f\xe1\xf7\x05\xfd\xf2\xf1\xf5\xe1\xf1\u026b\xa3\xe3\xc3\xd3,uL?~\xf9\xf0\xf2\xf0\x18\xbe\xaf\xe8\xab\xd3\xfc\xd75}\xf9\xf6\xe3\xc1\xf1\xefV\xd29=><=\xf9|p\xfc\xf9\xf0\xf8\x04\x12\x9c\xb5t\x8d\xa5k\x8c\xa5O\u04e7,\xad\xa7\xf5zZg\xe9`\xf0\f\xfe\x83\x8f\x06\xfc\xc7R\x9a6\xd3&K\xd7Y\xba\x9ezi7\xdd\xdbK\xf7\xf6X\n\xffO\x19c)\xfc?\xdd\xdf\u07c7\x7fX\x8a\x7f\xf6S\xf8\x7f:\x18\x00\x86\ue9c3\xc1=\xdc\x16\xe9`\xf0'\xfc\am\xa7\xf0\x1f~\xc0\xf7\xff\xe3\u0413\x9f\x0e_\x1e|\xfc\xd1SQ{\xd9~F4\xae\xff\xf9?k\xfd\x1al\xaa\xf5n\xf6\xda:\v\x84\x1f\xdf!-\x82K\x91\xa3\xa5V\xabe?\u02cc\xcbJ\xb8\x01\t\xc9(\x8f\xb9+\xe9z\xeb\xd9\xe0l\x9d\x9a\xc6\xe8\xfa\xe0\xac\xf5l\x9d\x10Z\xc4cW\\\xfaf#H\xb5\xed\x9e\x14w\x9d\x13\t\x0f\xf3\x1d\xcf8\xe0lc\x1cWt?\n\xd1\"\v/\xcb\xf9\x9cBT\xab\x97\a\xaf\u079d\xbc?8\xf9\xe9\xf4\xf0\xe4\xd5\xc1\xa7C\xef\x8e\x1e|::9=\xf9|\f\xbb\xe0\xc3\xd1\xebC\xef\x8c\xfe\xfb\xcb\xd1\xe7\xc3B\xda\r\xfd\xf4\xd3\xf1\xc1\xc9\xc1\xfb\xd3_\x8f\x8e_\x9f\xa8\xc4C\xaa#\x90y\xb7\xf4\xd5\xe9\xfb\xb7\x1f\x0fMH2\x95\x7fJ_\x9d\xbe|\x7f\xf4\xea]1\xf9\x84\xfe\x04\x03(\xa4]\x9a]\x85\xbf\x0e\xf2}\x85\xbf_\x95v\x16&\x1e\xd1W''\x85\x94\xafT\u0164U\xbf\xde\xd2\xcfo?\xbf?T?>\xdb{\xd9J\xffH?\x1c~\xfe\xe9\xe8\xf5\xe9\x8f_\x0e\x8e_{\x9f\xe8\xe1\xc7\u05e7'\a\x1f\x0eO\x0fNN_\x1e\xfe\xf8\xf6\xe3\nW\x97~\x92\x04\xe7p'\xddW,\x86\xc4W\x7f\xeb\x14\x93q\x11\x18\xefw\x86s\\8.\u01abJ\xc2:\xf6;\u00caE\x84\xff\u79bd\x1f4m\xd9B\x0f4\xad@Lg\xb2\xafw@\xb3\xaa~V\xf3\xb5\xaa)\x81\xc8\xc6~5\xb1\x9aX\xbb\x1b)\x19\xa7Q*\x80\xd2X\xe9:5\xc7l\xec\x14-\xc3@\x1c:h\x11\xc0\u0203\xb3t0H\x88\x03\xfe\nOU\x80\x93\x97\xd8\xe2\a\xca[\x97\xba\x1d\x96\x7f\xa6i\xa9\x0f\x13\xc0\xb5\x9cl1\\\xb2\xa3\x80\x03\xce~\xb16\xb1f\xf9^\xcdR\u0253\x83\xc48N\u04e8\x9e\xd4\xeb\xf9\x8f\xea\xb3\xec\xe0\xfc+\x8f\xb3\x9a\xb53\xc7\xf2Y\x93V\xe7/3\xd9\x1c\xb4 G\x17\xfa\x1b\xa7\x04S\xe6bL\x16\x18c\x98[\xab\u05f8\x18\xd7\xfc\x98\xa3\xec$1\xc1D\x94d\b\x1b\x03\xedP\xbdN\xba\xf9\x1ch\xf8\xd3\"\xc4\xdfh\t\xea\xc3\xd0\xeb\x10M\x84\x7fc}'\x9a`h\xdbq\x1eg^`\xb0|\xe9PG\xfd\x9e\x18\xd5\x1eT\xaaB\xba\v\xddsf\xe1\xe4\x87\xf9^\xfd\xa2i7G\xaf\xba\x93\x93p\xf7\xc6Ks\xc5\x1b!r\x05\xe5\u059e#^i5\x89*B\xbc\x12{\xbc\x92;X<\xb81\xfd\xe2rt4D\x05A\x16`\u0735\x1c\xa9\xa9\xb8\xa6\xa8\xe7*\xb2=\xc0[2z\x1f\xdd\x18+bx\xe0\x8a\x8a\xbe\xac'\x984\xa3OA\xf2\xd5\a\x91\xe6\x90\xf59\xfd\u06c5O*\xfa\x9d!\x19\xa2+\xb6\xac\xef\xbf\v\xd6\u00f2\xa7\xf4\xc8\xe0A^\x81\x8c\xbe\xb5\x021\ng\xc0m(\x0fn\x0e\xe0i{\x9d\xbc\xe5_\x00[M\xc3\xd99\xd0+rn\xf9\xbfU\x0e\xef\uc9df\xd2Zr\xa7\xf0Hu\xae\x9c\x06<\xc1\xfd\x84\x9f\x06\"\xe1\"\tdp\xcd{N\x80\x9e\xa3\x1a\xae\xe89\xe7\xf8I\xf4\x939\xae\xe2!\xe1\xce|\v\xe8\x89'(\u0579@\xef\x1b\xe7\xfc\xd6r\xf0\x8f\x85\x0e$\xd3\xf1\b\xa6\x11\xf4\x15\t\u0586\x97\xee\xf1,\xd4O\\\x99\xe7\x14\xca5\x1at\xa1\xaf\xbe\xdd\xf0\x90\xc9B\u01ca\xd0\xefKj\\\xac\x98\x82\x8d*'\x1d\x16d\xb8\x15\u06e0\x01\xcb\xdb\xe2\xb7|\x047}\xc6\u04c2\x15ht\xe6`$\x1d\x84\xf0N\x86\xf3W\xe8<s\xc0\x83\xa9\xd0\x00s\t\xdbW,T\xb5\x87x\xb1F\xb6\x15a\xe7\x90n>b\x1e\x1fs&\n\n\x17\f\x86u\x0f\xfcH\xf0\xaf\xb5@\xa0\u070b\x06\xebt\r\u0463\x18\x971\x9b\xaa\xd8%\x8e\xa3\xbc\xbb\u01d9<\xbekv5g\x135W\x15lh\x8d\x93\xfb\xa8\xc1b\xed\xba\b>\x81\u03e0N\xb3\u06e6\xfaJ\x02\u1f9d\xa1\x93\x1b\xbc\xdf\x1ef\x96\xcb\xce`\x80\xb7Y\xbf=\ucdc7\xf5:\u0332\x175\xe06\u029c\xb4\xea\xf3\x80\x00hH\xe2\xb9Q\x03+P\xc75u\xebup\xddf\u013b\u045c\x90l\xd2\x7f\xb9\xc0\xee$\x7f\x19T.\xf1\x9c`\x00C\x84e\xe8'\x12\xf7\rk\xcfq\x92\x9c\xdc\x17\x81l\x15)\xd6\xc8@Y*\xaf\x9b\xe9*\x89\xba\xd9I\xb30\xec\xe6Xb\x12\x8816\x92yT\x97\xe0\xf4-\xf3b\xc3\x01?\xb1\u016dmy\xf5\u051aRn\x9b\nB\x8b\xb8N\xd28\xe3fE\x95\xec\xddYh\x9f\xc1Y(\x83\xe3\xd2\xc1\x1cY\xceyl(Y\xa7\xc9\xc0\r}\xf4\xe1\xec5\x7f|\xa1U\xf0\xe1cs1Ky\xf9\xa6\xe47\xb5\xb8\xe8u\bF\xaa\x03)\u0608\xde\xeds*\x86\x00\xb7V\x8e(\x04`h\xd9\xca\x0e \xad\xea\f\x10\xc2<\xe6\xc9\xec*\x10\xe7'#_\x1c\xc8\x13\xff\x8a\x7f\xd2\x18%\xe7\x88e\x9e \xf3\xc9\xe2\x85\x19\x8cy|\x10\x86\x19,mP\x14\x91V>\x01\x85u8\x05?\xcb\xea\u0687\xbd+\xd1\tL\xbd\x9e\x03\xbc\xd1\xc8vaawY .uJ\xbar\xf9\x0eU\xb8@\xda[RW_>}tcV\xaf\vu^\x19+\xb5\xa9\xbd\xfb-\x1b]{\xd5x\x1a\x1dj\x8d\u019cWQ\xaf\x97'\xd5`\"G\xf1\x9d\x85-\xc7X\x0e\xb1\fz\xd6\u0080\xdch\x8e\xf4\x97\xde\n\xf1!pt\x13`h\xa6ie2\x84A\x02\xee\xb5~\xde\xd7\xeb\xf9w~\xe5:\t\x0f'\x0eY$\xe4\x0e\x8f\x8f\xbd\x9a)_\xfb\v\x8a\xfde\x94ar\x82\xceW\xda22\x9a6C~\xcdCp\xa4\xe9\xd7\f\x83\xb6U\xab\x9dp^31\xa4\xd1@\xd6\xf2\x97\xc9[\u064b\xf1\x00t\x9ex\xc2\x02w11MA\x1ei\t\x891T\x9f\x11\xa2\xa1\xb7\x80\xb8\x15$\xaf\x14\x002\xff\xd0I\xb7\xffrX`\x90s\xac\xa9X\xfa\v\u042a.\x19\x97^\x00\xca\x03\xcak\xfa\x9e\xbe\x19.\xab\x91\x0f\x05T\xb3`\xbf*\xa7\x05\xddB$\xbd,\xe0\xdfe\xfer\x99\xb1\xfcg\xeb\xc9\u0517\x92\xc7\xc2\xd0\xc5\x15Y\x84\u6260.\x96\xfd`_\xac\x1ft\x91\xee\xc1q\x86\xfc\x96\xa3\x1e\xc5l\xc9\xea\x7fB/P\xb5\xbf\x16\xfa\xfd\xab&\xa3\xda_W\u0458\x9bF\xfe\xa2\xb5\x97G\x9f\x7f\xcaH~\x1d\x15\xb4Us\x13\xcekP\xb2\x96\xb9\x94\"\xb5|\v\xcc\xd8\f<\x9e\xe8V\xd2t}p\xd3X\xc7\x00\x9b\xd8\xe5'\xd5\x1f\x92\x053\xbc\xe1|\x9c\xa7~\x83\x98/\xb6>x\x99\x0e\xce\xd6\tMT\x02V\u04190U.\u0180\x11\x0e\x12\\El\x82\x8b1+\x96\x80\x81\x80\x8c\xe4\xd7@^|\xc2\xe7@\x9a\xea\x82Y\xfb\xf8\xb3^w\x13\xf8\xab{\x81w\x10\xf4,y|\x15\b_F\xf1\xa1\x18\xb3\xa9\xceIS\u01e1\xe5\x86\xebu\xbfX\x1c\x9b,\xa44\x98j\xa0\u790aD-\xd5P[M=\u06f0\xb6\xfe\u0583\xca\x1et\x16G\x18\xa7c~ v(\xfc4\x0e[Z\xadV\x9e\xae\xa8\x8e*goV\x12o]\xfbq\xe0\xa3k\xe15\xd8m\xa3\v>\xfe%Kr\xcbI,\xafPj?\x7f5\x80\x8c\xfe\u0794R\xf2\x15\x8a!\xbe\x11\xe5\x97[\xcc#E\x16\u04e1\xec\xafV4ol5\x91~,\x13\x10\xde\xe0G/p\u0367\xf6\u0485]\x94U-\n\x8d\xe48l\xaeQ(FC\x8f=%w\xb3\xd9\xf0\x95*\x02\xd2\xe54QO6\xddu\xbd.]\xf3M}B5\x95Tpd\x9a\u07e0\xa8xi\xe1\u0445\xcex\x81\x8cP\xfb\x9c\xde\xc3\xcdm<\xcc\xeb;{\xae\x10bi7\xdau\vY\xf4^U\x06\x0e\x10\x06\x8c\u03b6\xa0]E'\x9a\xc2\xfa'T\x90s7!4\x01\x8a5\x7f\xd5\xfdjE\xb8[So\xfa\xf2A\xfc5_#\xab\xe2O\x05A9\xe8_%^\xdf1\u05cfC\x9d\x11\u0195u\xfc\x99\x8cT\xe4\x04gH14\xf3\x82F\u3f49\xac\xf0^\xd7\x06\xbd\u0759\xb8\x14\u044d\u0212\xd6:\xf39\x85\xbbc&\xf9\xd8\xcb\x19\x9e%\x81\x7f\xa9Z\xcfq<\xe7\"\xfc\x9a\x98@F\xe5\xae\xe6\x18\x10(\f\xce/$\x1f\xdb\x01\x0f`\u072f\xb10\x1e\xacs.M\x15\x1d\xd8N\xff\u02b6f!\xa0\xc2_\xe0\xed\xda\x14\xa99Zk\xc3$\u031d\xda]4\xab%S>\n&\x01\xe8\u05c3\xec\v\xb1\xf7\x19\xafM\xa2\x99\x18\xb7\x8cFGiB x\xf5]M\xa4\x8c9\xd1\n#\xf7\xf3\x02\x9d\x9b\x0f\xbe\x87\x9e\u01f29\x1e\xccdd\u0566\x950a2\x9f\x9dW\xac_\x9c;\xcdZR_\x8ac\xf8Vm\xb9di\xeb\x05\xf0\x81\a4`\xf8\xcci>\xe8\\(\\(\v\xc4\x16\xcb\xe6\xa7\u06a5\xda0\u0505\xb8\xae\x88\ttlg>\xa7\xc5\xe1x\xf06\x06O\xd01\x17c\xf5\xb80\x87\x18\u0528\xb9C\xef\xe7\xb4\xcf]\xbdq\xd5\x0e\xf3rm\x1b\xd8lt\x1c]}\u00ad~\x9fE\x1d\xf5\xb2h\x84z\x17\xcd\xe7dH\xb2\xf0a\xf7\x99\xa3\x16O\xd2_f\xfc\x13rP\xa0~\"Q?\x11p\xf8\u0214q\x9d\xac\xa1\xaf\x89\x03Xw>\xd7|\xb5'\xec^EY\xf6\xb2\": \xbc\xe3\xb9\xf7<\xf48\x05B<\x84~$\xbf\x95\x9e\xb0\xc4\x121{g\x1e\x91qQ5S\xbf\x96\"fHE\xad\x89\xa5\u06c6\xc8\xc5\xd7`\xb4\x9d\x87Yez\xc5\xcc\xca-\x844U\xac\x80\xb6\xe2\x03\xa8\xe6\xc1a\xa4\x1d\xbe\xc7\x0eii\x02\x9a\xe9\xaf\x1e\xbe\xe9\xa3\xc9$\xe1\x10@_\xe6\xbf\uc73dB\xba'An\xed\xc7\x18'\x19s\xd0u(f\xf0\xac]O\x96B\xf7Ti?B@\x97<$x\xe3\a\xe6\xfc\xd0\xf0]\xae\xa6J\x1a?8?\x00\x93\xc2\xd9s\x1a?\x03\x83\xa6?\x84kT\xab\x9aZ\xe1\u0729\u03057\rg\xdf)\x04\xf8A\x96\x87\xb3\xb7\xae\xdb(d\xe3k\xcd\xcdg\xa3\u0767\xf6f^Ht\xacr2G\xe6\n\u03d4d\xb3\x00\x1e\n\xf6\x92%.\xaeu\x04\ng\xc2b\x9b\xc4\xd4\x02\x9bb\n\u4fe9\xc4\xe3s\x1f\xb4bP\x93\x016`v\xa9\x85\xa4;\x8e\xeeG\xae\xc5'\xe8\x10\xf4\xf8\x8c\x9d\xcdo.\xe0\x89\x8c\r\xe4+Y\xaf[\xadc\xbc\x93nU\xdb3\xe5V\xa0r\x01u|\x1fL\xc1\xa9{\x81R\x96\xa2\x15c\xc9X6\x8d|\xd6`\xad3wc\xfa\u038d\b\x15p,3H\xff\\\u04074+^\xe4\x83\xe6\xeb\xf2\u03be\xefrK\xa5,\x9f\xeb\xa0`Q\xec\xc2\x1aDL\xd8\x11\x90\xa3n\xc4\"\f\xd9|\x12\x9c\x85\x818\u01d0\xcdQ\x16\xb2\xb9\x177\xf4/4Kh\x99\xc8t\x85B\xcag#\xaa\x16 t\u031e\xa7\n\xc2^L\xa1\xa4\x17\x81\x9a\x00\xe3nDcB\x7fv#\xa2\xc8\x19w\xfd,N/\xe24\xb8:OQ\u0733\x0e\n\xac\xe5\xf6\xa2iEs\xb9\xd6\xc3\x1c\xec\u02c0\x94P\x90\xf8\x118\xb1\x7f`@Hs\xfda\xa8\x00 1\xe8\xef\xcc\xe8z\xb2\xfd\x82c\u027f~=8\xfe\xe8\u0540\x95\xf6\x97*0\xa7\xfffF\xa6\xf5c\xff/\xc8YG\xb5\xc6!X\xa4\xd8U_\xf3i\xccG>>\x80\x13x\xf2B\xd9VM\xeb@\x16\xeb2t\xa3\xfc\x1b\xf3)\xe7,\xa0\x92g\x86\x00\"2\x92\x10\xd4\xdb\u5544^\x95\xc3\xe9\xb82\u0547\r\x01\xab\x1e\xc0\xb3\xd3<\x8f\xd7\xdd?\u077d\xfe\x9f\xfb\xc3\xc6~:\x90)i\xa4\x03A\xd6\u03ef\xe8\x8c9\xaf\xb2\v\x1fxw\xf8\x90\u03e8\x85\x1f\xee\xe7?\xd0\xda8\x18#\x910\x89b\xa5\fY\v#\x7f\xbc\xae\xb9\a\xd6K\x1f\x9ex\xb3\x90\xf7\x1c\x1a\xb2\xfbq\x90\x80\x8a\xf9Av?B\xa8\x15\xb4\x1ds>\x85~ jp38\x05\xa5\x16\x1c\xf8\x94\u074b\xe8's\xaf\x1cso\xfdOWD\xcd^v\u0550'\xeb\x015]\xaa[\x1aJ\rP#\xcc\xedy\x90LzM\xb7?\xb8i\x0e\x1b\xa0|\x13PKIU\x11aM\x87J\xff\xecX\xa9\b+U\x82Y\xc2_\x1eCD&\u04f6zp\xd0\xd3S\x1d\xf7\xc6\x1bYGvb\x1d\xd9i\xab0b\xed\x1f\xb8\x18\x8c\xc8\xc4\xf0Q'\x12\xad\xc1\x1c\xa7\xbb\xc0\t\x90=7b\x1c\xb9IE\x9a\x81\xfa\x16-Dc\x1d\x90\x8ex\xee\xbf]\xa7\xd3n\xed\xb6\xda\x0e\xcdol\x17J\xd2\x1a\xd2C\xb5V\xab\xe5\xc7\xe7\t\xa9]\xf8I\xed\x8csQ\x1bg[\xb7\xe5\x10j\xb7\xa0m\x00A\xe5?oL5c\x14\xe7Pu\x9e\xfb\xe3\xd6@\\H9M\xbc\xf5\xf5\xf3@^\xcc\u0380vX\xb7\x88\x86\xfc\xbb\xf55YGs\xc2d}ccw\u05c1}\xcai\xc4$\xc9.\xe5\xfb\x11\x9e\xf0\f\xf8\x9e?\xef\x1e\xb8\x8eb\xbb\xe4t\x86C\x03\x92m\ub825\u020c\x9e\xf9\xf0 \xa2{\x06\xa4@\u0443\x00v\x835\x14\x89\xc8t\u0381[&c\x1c\x8a\xef\x92B\b'Ac\x9aX7tXTN<\\\x94'\xe1\x1dQ@\xde\x1e$\x15]\xa7.\xb5\x8a07x\u01b9\x11\xa4^\xcf\x7f\xf6\u0170\x18\x18M\u067d]\xc2-\x93\xbd5J\x06Pp\x95\x1d\xd9z\xdd<\xe7E\x95\xa5\x95\x85\x86\xf4+\xa4_H\xccX\u07b8\a_ej\x9fG\xa4\xcb\xd9\xd8-\x94\xa5Gt\xadM\x0f\xca\r,\xa40\xb8\xed\xa6\xca\u070d\xb3+\xf7\x88\x16\xf2\r\xe1TH\xf4\x94x\xe92\x17\xfdb|\x99\xaf\r[6L\xe8\xabVY\xb7\xd4\x04\xac\xa3<'\xfe\xe7v(\n5\xed\xcb\f\xe6\xcb'\xac\x80\xd9\xee^.0\xabl\u0642~\x1aU\x14B\x96\xf1\x11\x18\x82\x19A\x95\xec\x12\x10h\x1d\xd9\"&*\xb5\xec\xa9kh\xe8\u043d\xd4&>\xb1\u078a}N\xa3\xa1b\x84\xe6#\x14\xaae\xfa\xb5\xc1\"j\xde\xce\xf0\xa2v\x9dS\x87\x80\xf3r\u0718\x16\xe7\x1b7t\x89\xf7\x8a\x01\xfby\x17\x9b5\xfa\xc0\x12\u017ed\xae\x96,k\x88\xad\x02\x04]\x05\x82\xb95i\xd7,\x9b\x9e\xc4\xdc%\xf4\x889\u03bc\x12\xfdZ\xcc\xe2z\xfdU\xae\x96\\5\x91<\t\xa6\x94\xff\x02U\xc5\xe2}\n\"fd;x\xf7\xca\xd4\xf9\x12\x82k]. u\xf3\xc8(\x1a3\x1b`\xa2\x028\xcePfHH\xd4\xeb*\x80\a\xae\xe9\\\xf19\x8e9\x15f=\x81\xb5\xd57\x1a6\u00fc1eh\xcbI\xd7\xcaE\x817\x15%s\x1b4\x1b\x86\x00\f\xaaAM\xa4\x03;\u0170\x1eyK\xcdN\x05_\xd7\xdf\x19;K\t\x19\x8a\xec\x17s\b.\\S\x1e\xa7\x9f\x03\xe4\xdaZ\x13\x98\xe0\xa5a^Y\"\x8e\x9e{\xa4\xa5\x9c\x1d\xe2\xb9\x1f1\x04\xa3\xd5\u0139M\xf5`1\xd4\xfe\x9e\x85p\xe5\xa9\xe9c\x94\u03fe(\xb2\xe4\xa9\xe8\xe7\xdaK\u00e1e\xb5#P\x18\xa1e?\xae\xc08\xd84.\x81+\xe3\x9c\\\x17\x97I,\xf2\x8c\x85\xe1\xf9\xe6\x12t\x99\x9b\x1a\xf5\x9b\xeb\x83\xc1\x9fO\x9e5z-\x97\xa4\xfd\xc1\xf0~>\x04\xeb\xa3\xc1\xe0I\xddA\x05\x04T\xb5H.\x83i\xef\xa8\xc1\xa4\a\xed\xdd\"\x1de:\x80dxS@95\x92\x97\x8a\xcf],\x99\xa6\xee\x11\x83\x87\xd3\xc4\x15\xa5\xa2\xbd\xb6g\xde=9d\xef\x16 \v6\xea\xe6\xb8\x19\xd16\xc4\r\xb8\xa4\x00\xa3\xae\x1d\u046b&\xb9\xc6<>\xbb\xec\xfa\xf6\xf8}\xdd\xf3!\xb0\xce}3D\xfceM\xc5\u03a8\xd7\xd5\xc8\xe1\x19wY<\xba\x96\xc1\x00\xe0\xff\xcb`\x9a\xa6\x05\x94\x9f\xa6\x80\xde/m\xf4~\xc9.\xf5v\xd4O\xbfK4rTIV\xa0L\xc3`u\xa3\xc5E5\xb9zm#\xf5\x17`\x1be\xfcFj\xcd\u05060\x9c\xfe3\xe0ze\xa0\xbe\xc1\xe0\x84\xf7\x06`Q\xbd\x1e\x01n\x04nr\x83I\xaa\x02\xb4\xfb\x19\xb3\xce%\xb4\r\x99\xb9\xf8\xf4L\x8bO\x91\xbf\x8a\xef.\xf5\xfb,\x13]FFA\x12\xafv\x1fQ\xc6\x11\b\x17\x95@YgS\xfd\xb7\xd1!t-(\U0008dd48\xa7]\xbb\t\xc6F\x1d\xab\x86\xe7\xd41\x11\xd4rzS\xc5&\xa1\xb2u\xe6#s\x97\x9d\xa93)\xcd;\xb7\x03\b\xe3\x8cE\x96\fX\r\xda\xcc\xf2\x1c\xa2\xee\xc0$\r\x17\u061a\xd6Z\x9ckd\xe4C\xfbAS\xbe5%\x16\xaa\x01\xf3\xa3\xf1\x83\x83\xd6\xfa(Jr~h\xb8\xd6\xfeISgo&\xe0\x811\xdew\x90;b\xe6\xc1[P\x9e]R\x0e\xa3,\x025\xef\xf9N\x8f\x90\x03\xa7'\x1b7\x9f/\x19\xb4\x06\xbc\x1d\x1c\xf4\xf3~\x87o\xd7\xeb\x9f\xf77\x9fi\xc0W\u060cN#\u0245\f\xfc\xb0\x16\x88\tz\u03ad\x85Q4\xa5\xb5\x1b\xff\xae\x06\xd6\x025e?\x0e\xa4vM^\xf8B-\rO\x9c\x8c\xa9p\xd4\xc0\x00\xd7z\xff\xa9\xf1\x1f\x82\x93\x15uh\x0f\xb5 \xef\x0fw\x96!&\xe7~\xeeP\xae\x83\xc2h\xf8~Q\xec\xdc\xec\xf5\xe6\x01D\xb9\x02\x9cj\xf4\x96\xfd\xe2\x1e\xe6\x9aV\xfe\\\xd1;'@Q\\\xb2$M\x8d\xaa\xc8\x01<\xbe_\xe1\xe2M[\xd9{\u025d\x92\xeeZ)\xa2\x03@\xba\xaf\U00033989\xba\xc0Z;\xecJ&\u0359\x956R\xe0Y\x8ce+\x19\xd4\x06m!\x87u\xe9s0xr\xd5@\x8f\x90\xf4am\xfa\x96\xb5\xe9g\u05a6\x1f\xd9Z\xa7\v.\u07e1\xff\xfcz*\xc8\u063b]r\xff\xb9\u0460\x1f{P\xda[R\x8a\xe6\xe99\x9d\xf76Su\xcas\xf1\xee\x17F\xc1H\a\xfc\xd0\xe7\xf0\xa6\xc0\x11{\x9bk\x18q\xd2}k\x94\xa0\x1b\xd9!\u02cb\xbbo\t\xa1\x1a]\xe6Vz\xf4U+\xb7e\xa2'\xecU\xcb\xd8B\xd1\xfb\\\xe9\x1aC.L\xc2(\x8a\u076fD{q9\u025f]<\xb3!X\xebP\xf3\xf0}Ee4\x05\x02Hy\xc2W\n\xa1\xd2D^\xac\u05f3OK\xa5@\x1f_\xc7\u072d\xf7Y\xbbm\xd3\xc5\xcb;\xef\xfe*9\xf7\xb2\xeaT\x87l\xf4\f\"{\xdb\xec\xb4\xdb\xf4m\xa3\xd3n\x13\n\xa7\x18\u0282\x11%M\xa2\x89\x1f{'\x05mr5\x99\xdf\xe0B\xccF>\a\xc8\a\vc\xe8<X\xd1\x06\t\u039e\"?\xe9\xd8\x0f\x12>\xf6\xe4\xdc \xc9y1T\xaf \xf7\x82\x894\x9df\xc83IS[\xabS\xe6D|\x15\xab\xe7\xde\x1e\x97\x19\xcc\xe2\xc1\u0286\u0309\xbd`0\xd00\x17\xc0\x98\x87N\xc12\x0f\xf5\xc4\x14S0\x84\xc6N\x89\xf9\xba\xd4\xeae\x92\xed\x8f]\xa9Bx\x11`\xb8\x9b#\x18\x93\x8c\f\x88ZI\x14\xcbL\xcbKi\x05gc\a\x1c\x9a\xff\u02a8\x88<\xa9i\x15V\xa2\\\x03.d\xda\xda\xcf\xcfS+\x93\xb4\xd0R1\xe1\xf2h\x82zFY\x86\x8d\x88O]\xb9\xac\x06/\xd7hv\xf2\xc8\u0344\xd0~@\x93!\xf3\xe9\x8c\x05\x99vB+\xe1\xa3H\x8cO\xcfx\"YBg\x1a\xe5^\xb3\xfb\x05\xf6DI\f\x82b\x8fi\v9KJO;\x93\\X\xdf9\xf98\x10@,\x16\x8c\xdf\xcf\xe2~m}\xf8\f\xed\xdd\a\xc2\xc1X;\xcbe/\x9a\r\xb2\u0631\x12\x8f\xe8\xbf\xe5\x0e\xf7\xce\xe2}\a=\xab\x9c\xb3u\x9bSH\x1a\xc0$\xbcc\xf7\x8f\xef2\u7aad\xe8\xf7\x9c*-\xe4|\x1cr\xfd\x9c\u0695\x95\xa7\x97\x9c\xa6:\x833\xa2n\x8c\x82\u01a1}\x84T\xb6\xf5\xa8\x83\x90\xf8]\xd9\xc8\xde7\x80'{\xf6\x8f\x96e\xac\xe2dmN[e\xeeb\xf6\x82\x83gD~VO]\xd4{\u038e[\x9a\xba\xbf\x97/],A\xe8\xef\xae\xf3\xc6\x0f\x81\xdd^;\xf3G\x97\xc0D\x15Q3\x83\xa7\xa2h\x80\xb4\xc1\b=ga4\xbal\xa9\x1b[\xf6\xa0\t\u03f1\x8b;\U000c2ca4t\xd7\aIc\x9d\xa0\xf6%^\x88\xf0<N\xd3S}\x1b*\xc2`\x92\xbbr\xa9\u2b19%\xa5J\x80\x97a?\x01\xf2z\xc63\xe1\x9cl\x01\x86~\x05\x88ZH\xea3\u047bp#z\x9f\x97/\v;\xd7\xdas\xe2]\x01u\xb5\xc8o+u\xaa\xf7\x92\xaf\xa4\x86\x91R-\u020e\x8c\xf1\x91U\x16\xf2e#\xebA\xd4zOt\xf5\xbaB\x98B\xb4\nF\x8e\xafChT\xaf\x97\xb3\"\x00\x0f\x15@Neb`\xae\xb9\x89,\x9f\x94o\xb1^\xe1W\x86\xbb\xe0\x1a\xf1\xf1\xcbN\x85\x80\x84\x16\xd2\xc0\x93`\xfd.4l\xa5\x97:\xb1s\xaa;\xac,17\xe6\x0f7\xa8\x83\r(\xf4\xa6\xa5\x82]\x99\xe57\xbf\xe1\xf9\xad\xb8\xbf;\xc8\xfd\x05z4cd\xa3F8(\xfbY\xdc\xe2Z\xed\x8b\xcd\x19FR(\xe3\a;\xa4\x9bIn\xff\x9e\xf1\xf8\ue107|$#\xa4\x98@\xae\x8d\xfch'\x17\xb7\x9d\x11\xc5\xf2?\x04\xb2,;\uaddai\tB\x06\u0159\xcc\x05\xc21\xf7\xc7w'\u0497\xdcf\u0279\x87 _\xf9\xfe\u03b3.Omn\x12S\x02|R\xe4\xe0R\x89\\0\u0647\x10\xb3\x16\a\xf6\x04\x18E\xf6;\t\r\x12\x16m@`\x0f0\x8c\x7fZ$]\xef\xe3~I\xd07drn\xab\xb7\\\xda\xe4\xc1\xa9\xa5u\x04\xea \xb2\xb5 g\xc9k\x1e\x94xQ]\xbfZ\x0f\t\xccG@G]\f]T\xae\x9aWy\b\xbb\t\xc48\xba\xa9\xd7\xd5\u07c5\xf8\xfdK3\\\xe7\xf5\xd1\a\x8d.\xdeG\xfe\x98\x8f\x1d\u02dd\"\xb9?\xac\xd7o]p\x9c\xb3\xd6)\xab{sz\x9fm5\xef\x82\x16TG\xbc+joC\xef\x96N\x82\xdb\x0f~|9\x9bV\u0657\xa8]\xbe\x81\xbb<+X\x03\xabm\xd0sQ\xa1S\xc75.d\x10\xf3\xf0\xae\x16\x88\xdau\xa7\xd3jg\u0491\r[:\x92p^\xfb'\xa2\x8f\xed\xcd-\a\x91i\xe1\xaaKS}Q\xf7rFR\x82\x17$\\\xf8\xa8Qf\n\xa8\x9b\xda\xe3\x9e]\xbf\xb7\xf2\x1e\xf58!\x9eT\xb1\xf0\u6d0cx\xbd\xb3<\xe9%\\:\xcbaW\x920a\xe9\a\x00\xb8a\x01p\xb9xI\x0f\xa5&\xa2\x9b\x16z\xbd\x04\x89\xed(\x12\x93\xe0|\x16s\xaf\xa8bh(\x1a\xd5\xe8&6\xfa\x03&\xfe\xf0\x1d\xab\xb9\xf9_Y\xcd\xed\x17\xc0\u035b2\xce\xdd)\u06a5\x96\u0467w\xb3\x90t$\xe0\f\u0612\x86U\xf8W\x95\xfe^,L\x0f\x99R.:\x87#\x18g\u24acSK\x10\x89\xc4\x14\xbc\x88#\x86\xa2\xf2,\xd4Y0q\xffp\x1dS\xb7\x86\xe8 P\xac\xf7(F\xc1pQU\xcc\xf4\x06\"\xc5\x12\x05D\x90\xfb\xa4y1\xdd?\xd4\xf3#\x9cG\xe8\xfb2M]\xf5\xc1\x04\xe0X1\xc4\x18\xb5\xb1\x7f\xf3:\xeb\x114t\x81\xb0\x81\xb1R\xa8\xac\x9cc\x82\xd2\xf2\x89\x9b\xfd(aa1's:\x13\u02c1\xc0\u027d\u05a9\x06\xcc^\xf0\xf3T\xf4[\az\x1b@X(\x8d\x92,&\xbb\x1c\xcei\x18$\x99.^\xb2\xa8MX+\xbe\xfb\xe6\xd4R\xdd\xf3N\xb3\x05\xd2\xc2\t|\xcc\xfe=\vb^9X\xb5O\xb6p\x9f\x94\xca=\xb8\U000f337f\xf5\x9fl\xfc\xe9,\f\xd77\x9eom9\xa4[\xb8\x8f\x80\x19\x90\xbd\xf1\xba\v,/P@\xc4\rc\x16H9M\xc3)\x8c\x95\xb3M\xd8D!^\x0e\xe5\xdd\xc3\xe1\u0153\xeb\xe3\x05\x91\xf0.i .x\x1cH\x8fs\xea\x8f\xc7Z\x8f\xcd\x06\xd6Z\xf1z[ v\x11{9C\u0427\xec/\xa5\x84!\xe2\xf9\xaal&\xd9\xfe\x8a\xc6\xdd\xe2ev\x8fD\xbd'[\x1cu\x98\xe1\xc6\xe3\xfd2=\\\x18\xd7\x12ZY\x0fkY\xae\x19Uu\xcb\x0f\x0e\n\xb9\x03~\u62ca^gz\x82\xa0f\xdb\xca\xd4\x06\x916\x1f\xf3\xb3\xd9\xf9\a`\xae\u06a2Sp\b\a\xc2F\x7f\xc2+\xf2\u0690\a\x8aWA$\x94%\x1cS\xf7\u00e6c\x1dB\x0e[\xf7\x98,\xe8G\x1cc z\xe1\xc2\xdfR,\u049cj8\x86\xb1e\x1b\u00fd.\xfe|R\xfcyG(\x9f\xbb\xf7s\u06d9\xa1\xe0s\xba\xd3\xd9\xd6NF-\u077c%.\x12t\x8a+\x19'\xbd\nwT\u0493\xb6\x8f }\x1b\xdbN\x15\xaa#\xc5\x1a\xca\xed~N\xe3\u072b\u0353\xc1\xbdq\xe21\xb7\xbd|(\x15\xf4\xcc\u0547\u05f43\x05\xf8\xf4\xe8\x16!%\xa8\xed\xad\x01\xd5\xec\xcfB\xee\xd0L\u1faf\u06c2\a\xe6\x93\xfe\xe0f0\xfe\x9f\x7f\r\xf1\xef)x\x1bq\xdc\xdeZ\x7f0\xb8\x19\f\xc6\xe0\"|\xad\xffdH\x1c2\xa7\xf1pN\xb2\xf7\xa2\xdd\x05r/s\x0f&O\x06\xae\x99H\xc1\xeb\to\x95=<\f\xe7\xd4\xcf\x00\xb0\xb7\xd7\xec\r\x92g`\xa4\x7f\xd3 \xebTk\xf2\xdf\xdb\r,x@pMmWU\xc2n\xf5\xf7\xa2\u02e19\x818\xef\xc1\noD\xeb\x8en\xc3Y=r*h4\x9cw\xa3\x92G\x1eK\x8b\xc5ZW\xd7\x02H\x11$Y\xa1q\xe3\x7f\xc0k\x94\u07dc\f\x1b\x85\x91kw6p\xba,w\x12T\f\xe7\x18\x04];\tq\xef\x95\xcb\x0e\x0f\xecG\xfb\xce$H.\x1c\n\x0e\x91\xe1\xcf7\xfc\x17\xff\x19\u1fd7\xf8\xafT?\u01aaP\x02\xbf\x86\xb9\xff\x809\xf9\xcb\xe2\xa5v\xc03PX\x80\xdb$\v\xbdb\xe6pc\xb6\xd0 y6p\xe1\x1f\x02\xff\u072fSK\x8a\t|b\x1b\xb0\x1a\u7efc\x95{\xbe\xa0\xf7\vM\xae\xcf\u0270\xe8FF\xb5y\xafT\xcf^\xe2$4\xc9\x00G\xc6\xcc{H\x8d\xb2\x87wo,\x9c@\xa9\f\x1c\u0534N\x9b\xc3\x068<\xd1E<'\x98\xd4\xe4\x05\x175\xa5\xaf\x12\x06\x93\xda$@\xc2\bE\x90\x80\xbb\xc6Qm\x1c\t^\x1b\xc1M\xcb\x13?\x8f\x8c\xe5\xd0\x10E<\xa1\xe7\xc8x\xc6k\x13?L\xb8C\xcffA(O\x03\xe19($\xa8\x8d\xc6hx\x17\x88\x19\xafA\xf8\xcc\x1a\xf0\xa0\xc0C\xb5\xac)TQ;\xe72\x9a\xca\x04\u053a.j\u04db1:\"\x05?\xa4&\x10/2mk\x92\xc3M\x1d\\\xf1\xa4&c\x7fZ\x9b]\xf9\xc9em&\x12.k\b\b\f\x83\\\xc3\xfe\x03\xa1\xc2b\xc75\xf0A\xe5\x8bqm\xccG\xa1\x1f\xf3\x1a\x1f]D5.\x00A\xd4.x8\xad\x01\xfd\x18F#\x10\xd0E\xe7\xd1L\u05ae\xfc)\xba\x8eW\xa1\xbcq0\xf8\x8f\x0f.\x0ej\n\xfd\xd5\x00%\xe2?\xd0\xfb,\f\xae\x02Y\x9b\t5\fHJ.\xa2\xa9\xac\xc1}\x0f\xe4@\xed\xec\x1c\aw\xc9\xefjgw\x00\xccimt1\x0e\xe2\xda(D\xe0B\xd8n\xe3\xd6\x1d\x7f\xc1\xe8\u0547T\x7f\xc7\xe8\x14\xf7L\x95\x85\xf1\xa9r\xe7q4\x9b\xaaO\xf4D\x8e_\xd2?WI2\xbe\u00ff*\x98Um\x1c\xc4IM\xbf\xb3\xe1/H\xcd\x00\x1er\xa4\xfe\x045~5\v}\xc9k\x93Qmr^\x9b\x84\x91/\xb3\xf5N`\xa1`\xe4\xe7\\\x86\xa2v\x11$2\x8a\x814\x93\xfc\x9c\u01f5\xaf\xd1YR\xbb\x04\x02NA#\x8c\xcek\":\x0f\xa3\xb3\xda4\x9a\x8e\x158k\x804\xc6\xf8o(j1\xc7%O\xc0\u01a9\x96\xa8\xc6\x13\xdc\f\xb5D\xfa`\x99\x99L\xb9\x18\u05e4\xbc\x030\xccD\xaek.\xb0\xe6L\x18\xc8\xebj\xd7~\f\x8e9\xfc@\xd6n.\xb8\x18q\xf8\x13\u00ff\xc1\xe8\xa2\xf6M\x1bJ\u05be\xa9`\xf1\xb5o\x139\xad}\x83\x84\xabh\x8c\v\xf5\r\xe3\x11\xe1v\xfc6\x8d\xa3I\xed\x1b\xb8\xdf\xff\xa6|VAV\xed[\x12\x8d.\xb9\xac}K\xe4\x1d\u0514\xa3\xa93\xb7N\xf8\x8c\xe6H\x8a\u0410&\x94\xb7\x16\\\xecP\x9f\x06\x85K+G*\x03g}NW\xe0\xeb\x1f4f\xfda}\x0eHq>\xa7\x9b\xed\xdd\xe7;\xff/\xb9\u175f>\x7f\xfe\xb4\xeen\xa4\x9d\xc1\xa0\x05n\xf7\x88\x03W\xbe5\x9dL\xa1\xdf\xccH\xba\u039f\x0e]\xef\x1f4\xff\xf0\x9b\u07c6\xfao\xbb\xf9\xa2\xa9\xeff6\x18x\xe8\u0086T\\\x91v\xd3S\x18\xd0\xcc/ i\xafV\xf4\xd4dZ\x00\x18:O\x8a\x9e\xba\xe6pYF\xac\x1f\xd3\u073b\x97@\xcf|\xa6\x96\xad\xb5\xd7\x1f\u04a2b\x11p\x88\xe7\xc3\"\x9e\x06p\xd8x\x1a\xdf)\xce\xd0rpvRq=:\x7f\xba=\xe64D\u00e9\r\x06\xe3\xfb\xcd9q2\xb7S\xd5S\xb7]T\x89\xe2\xfe)\xfa\x87\xd3.\x17\xef7\xe7\x83\xc1\x993\x1f\x16\x00\xa2\x9d\x8eU\x0f.\x9a\xcf3\xb8\xb8=\xf6',\u0530Qs[\xcfz\xa4\x86\x83}\xf2\xe00\x8b{\u0669\xa9\xf2\xf8\xd7\xd2\t\x82+3\u05f3\x01\xb0\xd2G\xce\u0578\xb31\x1d\xa81~\xf74\xf3[:\xb6E\xcem$\xa7\xe6\xf4\xc5\xce\xe6\u0596\xc7-\x0fe\x8e\u07b5ON\xd1\x17f\xf6\xe3\x99C\x05\xeb;~b\x1c\xf6D\x13\xe3\xaaGy\xf1\xc1{\x16~\x81\xc0;\xbcC_=\x90.0\xd2^~\xd5:\xe3\b\xdf\xcbhOC\x1d\xe0\x16;\xd4A#\x0f\xaa.Z vP\x03\x9f:\xb9Op\xe8!\x90\x17\xe8\x19(\x8en\xb0\fV\xd1\xee\xb4!#\x86^\x93\x9b@\xd55w5\xe4 R\xc0\xc2!\x87\xf3\xea\x84\x18{\xe8.\xe0\xe1X\x15E\xefB\b\x7f,v6;?\x87\xad\xa6bh\xc2_@\xc5\xd0<:\x99\x85\xa1]\x01\ue029\xc5\xd1\x15\xcc\xe0V\xff\xd6.]\x1d\xd0\xe7\xea#A\x01\x85\x14I\xe1\x00*r\xa8\xc5\u0365\xceG\x1fB\u00fdU\xba'\x10\x19.\xb2\x8c~\xe1\t!\xdf\n\xc9\xe3k\x1f*&\\~\x0e\xaex4S\xc3\xe5~le\xe2\xef<[?\u8cf1\xc1\u0338*ibD\xe3\xa7\x1a@\x1e\x04\xd9\xfcx\x8b\x8e\x97\xb2\xb0\xe4\xf6wf\xb7\x06M\x8a\xbc\xc0b\x04rHLF>\x86k\x9b\t\xfd9\xa4}'\xa3\x12pI\x03\xf8\x83ra\xbd\x1c\x11n&\xc5\xc1\xc6M\xa3$\t\xb0t@\xe2\x9c\xc8(V\xa6\xa4\xca\xc2\xc1\xa1\x0e\\\xd0~\x88m\xbf\x15\x12fiEz\xccB\u6072\x85\u0282:Y\xc0\xb2,\x94Z\x16\xcc\u038aO\x98\u01d74\u1c32\xe8e*\x86\x95\n{\x95\u01f4\xcaCaa\x84gl\x1f\xa3/;\u0504\x02\xccc\u0295\x824\x16\"\x15\x16b\x10\xdaQ\x0f\x8b\x11\xc0\x8a\xa1\xbdJ\xc1\"\xedB\x85\xfa\xe5\xe0c\xc5ho*6~>\xae\x97\xc1\xf9\x97\xa0\x94\x00;dH\x8b!-q?\x8a\xfcw!\x1c\xdeB\x90\xbbbX7;\xfcZ\x16|lhy\xa6\xb3\xdd\xd4\a. o\x87r\ua422\x17\xf9\xff+\x14C\xc2$\x18\xef\xec\ud0ed\x8d\xb3\xb7\xbe\xef\xd0Q\xfe@\xcei\x81\xc1\xa0u\xea5\xe1\xa9h|\x85.d\xed\xa7\x83\xf5\xfdu\x1a$\x9f\xe3Yxw\xa4\xa2a}\xf6\xcf3\xd7~\x99\xfc(\xf7p\xd4\xd0\xcaI4\xce<\xf6A\xac\x82=p\xec\x1c\xf7\x9c}\x10[@(\x18\x17d8\x8a\x13%\xe7v[hr\x88\r*E\x9f\x8ea\xe64;k\x99\x17@\xd5\xc7\xd1\xc4\x15h\xf4\x9f\xb7%\xe6h0Vp\vH\xbcEg\x91S\x96\xbf\xe5d\xf6z\x13\xd9\x03,\xce_]\u045cN\xc0=S\xcbE\xa7\xcc\xeei\x0f\xff\x92g\u0121\x17\xcci\xa7\xfdN!9m\xf7\xdb\xcd\xdd\xe1\xb3\xfes\xe3\xc6\x19\\5W\xd1\x0f\v\u0714\xbf\xc0\x1b\xb3\xfb\xe4\xfebN\\\xf7\xc9\xfdd\x8e\x0e\xa0I/U?\b:dn4\x87\xbd\x85\xa1\f\x06g\x7fe\xc4\xc4_Y+\xf0\xa1\xea*\xaf\x85y[\x85\n\u02a9sy\"D Ac\x17R\xfe\xa3\x15\x97\xe1\xa0\xf9\xc6\x14\u05bf\xc83\u046b\xa8r\xf6\x12\xaatt\xe1\u03b2b\xd1\xd1\x10\x01\xa7\x8a\xed.+\x06Y\r\x9dS|\xcf\u04eb\xe5,%\u0433\x1e\f\xee5u4\x18\u031d\xfcU?-\xfaO\xbe6\xa7\u0179\x90W\xe1_\xba\x8aS\xa4s\xffrh\xa6\xf3\v\xeaU+\x19=WCj\x93\xb9\xce\xedU\xe8\xa0J\x8d\xe9h\x94$\xff'\xfa\x19%\t\xf4s\xb7\xca\xfd\xb6\xe9\xf7/\u7876\xcb~\xbc3_\xd4\xf96\xe6-\xfd$s\xd7\a\xeb\x83g\x83g\xe05\x13\xfc\x16+\xaf\xcf\x05\xcao\t=[rB\xfd/\xf3\x88i8K*\xa8\x10\xac\xd9*\x17\x96\xb8\xb0;\xaa9\x9a\xaab\xd2P\xaf\xa2\xe4\x99\xdb$\xa9\xa6\xba\x93\xec\x11Rj(\xf7\xce\xdc\xffs \x86d\x90\x14^D\xe8$\x19X\xc9U\xeea1y\xc1\x97,:8\xef\xf3V\xd9Y-\xe5\xad\x05g\xb5\xf4\x9a\x9e\xd3;:\xa6\xbce\xf9\x83\x1dv\xafrg-7\x86l\u02f8`6_\xb8r\xe3+\x16qF\xef\u0750\x8cQ{h\x91\x81g4\xef\x05\x02V\x1e\x9a\x8c\x9c\ri3n\x1f\xd3\xd3!\x99\x0f!\x94e\xe1\xd9\xe9\xc7\xfeU\xe2\u042a6W>k*{\xbc-q\xfa~\xf6\xaf}\x15\x0f\xc9~G\x82+\x03\xe7kr\v\xa4\x1c~\x8f\xbe&6\xefoJ\xf5\x9d\xeb\xdd\x7f:8>\xf8pr\xfa\xea\xe8\xe3\u70f7\x1fO\xbc\xdby\xfe\xf2\xf9\x1f\xe4v\x9f\x1e4\xbf\rK\x9c\xeb\x8c\xcf\x1a\xfag<\xf4\x9c\u409f\xf9x\x12\x15\xdf\xd5\x11\xe8\xd3!\xdfH\xdbsBM\xe1Y\xc2Ou\xa4%\xba\xf0p\xb3\x99\xac\x06f\x7f\x82\v\xed\x1f\x9c!\b\xd4]U3\xf5\x93+\x02i\xebs\xfa][\ud30e\u0366\x0f\xdc\xf5\xfe=\x1d\x88\xe1 y\xb6N}7p\xd7]\xd7\x1d\xac\x0f\xd6[\u03de\x90\x14\xbe\x9e\xb9\x83g\xfd?\u05c7i\xff\xcfgC\xf2l\x00\x9e\u02c1\x8bK\x9e\xad\u04e4\xe1\xc0!\xf3\x1ct\x82\xf3 2\xf0\xa5\x8c\xf3#\uaee6r\xe9\xb4\xe5\x8fhp\x8bPt\xc9\xddpR\xb8\xdc\xe0\x91\x96\xaa]\x90\xe2\xc3\r.@\x02\xcdY\x17\x81\x93\xb9'\x019\xa5z\xd7\xe5\x03;+\x1e8\xba\x8a\x93\xed\xb8\x10\xc8\xe1O\x97\f\x9fU}\r\x06D}\x90\xd2g\n\x13\xa8pK\xdepp\xacl\xdf)3\xc25\xb2SyK\x988\xfa4-\x90\x1b\x95]-E\x9a(d\xcfN$\xf2\xe6\xd7)X\xe2(v\xc2\x7f\xeb\xac\x0e\xad\x05]\xa7\xeb\xcbqx\x8e\x1e\x12\xd3e\xf2\xcc\x1e\xd1\xc2|gX,\u031a\x1fi\xbfG\x96{\xebQk\x81\xda\xc5J\xa3\x16\x17\xe3y\xc5=\xbe\xc8j2\xadf\xd5\u0310\x16q Lv\xf9\x04K\x9b\xea]\xb6M\xf3\f\x9cv\xff\xbe;\\\x7f\x04lu\xaft\xb5\xc4$\x99\x13zj\xb1\u04de\xae\x1bx\xe5\x03\u0432\x8cIM19j\xc8&\x01)\x87\xb3r\x06\u0792\xbd\xfd\xcf\xce\nlz\xbc\uf5ca\x86N\x1f\x9e\xea\xb0j\x9f\x00\xb1\xef4\x12\x9b\xf0|\x02\xbfW\xac\x95\xe6\u0614\xe0\xa4S\xcd*\xb1\x85e\u02a0\xdc\xf7\x1c\x88\u02fc\u0239\xcc\x1b3\xfc\x9c9-\x801\x9f\x96un\x06gn\x8fY^P\xc9\xfa\xf2\xad\xf2XQ\x1al\f\xbd\x83Nm\x9c{\xcee\x9ap\t\xeb\xd1@&+\"y\xd7p.\x81\xf4\xc81\xec9\x97\xb5\x84K\xe7\xbbz\xcdq\u02c0\xac\xcf\xed\xdeA\xf8\ucd86\xebs\xe4\">\xdfho(\xb6\xfd\xaa\a2(\xd9.\x8a\xd9\xd0]\xad3\xa7\x18T\xa9\x82B[B\xce!s\xad\xea\xe6\x84\u25b4\x15\xb8i\x8a\xa2\xa7\xce\"\x93{\u064a\xc49\xe4\xa4-\xe7^\"\xe6_vu>V\"=,F\u03f1\xb9\xb6Q\x06q\x0f\u4a06x\x13\u012er\xe2\xa0d<;4\xfd\x8c\x16\x1fV/xD\x86\xa5\xfay\xe8\x1a\x14\x86\xfb4\xa8v@\x0e|\x95L\x15\x85\x10jH;djU\u00af\xd4\xd1|Nwv67\x1f\xb5]r\xa9o\xb3\xd7?h~\x1b\xb4\x06\x15b_\x94\xf8\x82\xbe\x8f\x8f\xf6\x928\x8dq\xa4\xe5\xbe3!\x83\x10\x8b\x04\x93\x1a\x02H\xe9\xf4\x83\xd8u\xecK\xbf6\xbe\x13\xfeU0\u00bb\x1a\xbd\xe3+Y\xaf\"ETj&\xf4\u056cm\x10&k\x04\x8c\xc2_e\xabS\x03\x11\xe54\x8eF<I4b\xbe\b\xc6c.j:.\x196\xc6%\n02\u0272?\xaa\xf9\x89\x98B\x05\x90/\xbfzs\x92\x89SG5\xe4\xe7\xd6F\xe1\xc5]m\x14\x06\xb5Q8\xad\x8d\u00a46\n\xafk#\x91\b-l\x05I\xe9\xf4\xae6\x9a\xd6F\u04e06\x9aNk\xa3Y\x1c\xd6F\xd7S\xbf6>\x9b\xd6\xc6<\xac\x8d\x83\xc9\x04\x84\xa6\xb51\xd4\xe3gS\x14\x93\xa6\xed\x1a\x9f\x82\x1c{:J\xaek|\nY1J\xc7%|\xde&\x02\u0167\x17\xb7\xb5IX\x9b\xc8\xda\xe4\xa6\x06\xe6\x9f\xe7g\xd3\xda\xf9\xa8v>:\xab\x9d\x8f\x82\xda\xf9\xe8\xaav>Jj\xe7\xe3\xb8v\xce\xe3\xb8v~qW;\x0fj\x00\xeb\xf3\xafg\xb5\xf3\xb0v~U;\xbf\x8aj\xe7\xd3\xda\xf94\xa9\x9dO\xafk(\xed\xad\x9d'\x02\xfe\x83\x8f\xeb\u06b9\xfcV;\x9f\xd5\u03afk\xe77WA\xed\"\x97\u02ce\xaej\x01\xbf\xad\x05\x17w\xb5 \xa8\x050\xe4\x00\x87\x1cL\xaf\xa2Z\x00\xe3\x0e\xe2\xabZ\x90\xf0Z\x00U\x83\x9bX\xcbo\xa7\xb50\xa9]\x81\xc9\xe6\xb8v\xc5\xfdd\x16\xf3\xdaUP\xbb\x8af\x02,< \xfc\xf5\xb4vu]\x13~X\x13\xe3\xb8&\x82\x9a\xb8\x8ajb\x9a$\xa3\x1a\x80J\\\u05e2\xf3\xebZt\xa1E\xbf\x89\x91\xfb\x82p\xbf\x16\x9fMk\xf1\xe8\xebY-\x1e%\xa2\x16\x8fk\xf18\xae\xc5\\\xd4\xe2\xa0\x16C\xf2U-\xbe\x02\xb0\xc7WQ-\x16A-\x16\xd3Z<\xad\xc5P\x1a\xe6\x1d\u03e0\xd4u-\x86\u014aa\xec\x89\xff\xf5\xac\x96\xf8a-\xf1\xa7I-\xf1\x93\xebZr6\xad%\xa3Z2:\xab%\xa8B\xaf\xe5\xf2\xa3\xabZ\x12\u0512\xb0\x96\x84\x9cOkI\x98\u0512(\x96\xb5dZK\xa6\xd0\xca\x14Z\x98B\v\u0487t\xf9\xad\x96@\x87\x90\x02}I\xcek2\x1e])\x1d\x80\x1b\xb8#\xb4\x90\xf9\xebY\xed&\x0e$G\xc4l\x10\xcb_K\u008c\x15\xa5\xa2+\x94\x96@\xa1\xe6\xe5\xfaJ9\xd7\xfa\xe0\t\b\x03\xd6\v7\r(\x92h%'\x0f\xf4I\x86 \u06ec\xe2\xad,\xf6\x98c\xe0\xbc\xc5\x7f\x99\xc4?\x9d\x7f\xad\u03c7\xb6J\x16\x8dW\xb2+`0\xf0\xc24\xb7\xfa\x9f\xf8c\xaet\xa1\x1e5\x1e[\u674d\xc7$\xfe\xf9\u00ff\xb0\xb1\x80\xd9w\xb3a\xed\xe0;@\xa0O\xa9E\xd2i\xfd\x7f\u058d\xc02ox\xcf$\xfe\xcf~q\x9eU\x8c\x9f\x8a\xd5j\xb9\u025d\x88\xa6I\x90\xa4c\x1d\xc98\x88D\xcao\xfd\xabi\u0215\xa3\xb0$\x8df\x12\xff\x8aH\xf2$\r\x03q\x99f.\v\xd38\nyj\x90\xbb\x1f\x06\xf2\x8e\xd8k\xdbr3\xac\x98N\xa2\xf8\u018f\u01e0\xca\"\xfd\xf8\x9cKTF\xb6RG\xbe\xe4\xe7Q|\a\xa1\xc0\"\xc9!)\x9e\x89d\n\x1a\xfb\xfcVI\x1d \x91\f\x92\xc6\u0931\xae\xf9AIai\f\uab58\xb0\xe3:\xe6~u\x0e\xc6\xe3\xf4\x15\xa0\xdf\xf4U\x18%<}\x15M\xef\xd2C\x01\xe3<\xbc\rd\xfa&\x10\xe3\xf4\r\xea^\xa4?r\x99\xfe\x14\x8cy\xfas\x14\x88\xf4}4\xbaL?D\xd7<\xfd\xc8oRx\u03a4GS\x19\\\x05\xdfx\xfa)\x9a\xa6\x9ff\xc9Ez\xcc\xc7Qz\x8c\xba\xc1\xe91\u01c9\x1e\xf3\x84K\xf8\x17\n\x9ep?\x1e]\xa4\xcab&=\xe12=\xb9\x88n\xd2\x13\xf0\xbfp\x02\xf6e\xe9\x89\xe4\xd3\xf4\x04o\xa1\xf4\x8b\x18G\xe9\x17\x01\x8a\xa3\xe9\xafp\xf3\xa4/\xfd\xd1\xe5l\x9a\xbe\xba\xe0\xa3\xcbi\x14\b\x99\xbeRw\x05\xfe\x8dy\x92\xa4\xaf\"q\xcdci\xfe\xbe\x89\xa3+\xf3\xfd9J_\a\t\"\xc9\xf4p\x1c\xc8\xf4\xf0v\xea\x8b1\xfc\x89b\x99\xfe\b\xb8;}\x8b\xf2\xcf\xf4\xad\bd\x80\x16\xce\xe9{\xd0`I?\xf0\xf8\x9c\xa7\x1f\xb0\xf2\xd1L\xa6\x9ffga\x80S\x06l\xce\xd3\x13\xff\x9a\xa7'wb\x94~\x11S\x9d\xf7e:\xf6%O\x0f\xa6\xd3\x18 r\x90$0\xb2\x97\xb3 \x1c\xe3\x80C.a\xe4b\x12\xc4W\xe9k.\xee\xd2\xd7|\x1aFw0L8\x9f\xe9!\xaaA\xa5o\x95\xdf\xcc\xf4\xad\xb8\x8e.\x01\xa4J\x9d;=\xe6\x7f\xcfx\x82\xd0\x05L\b\x7fgW<=\xc1\x1f'2\x9a\xa6'\xb33\x18\xfc\x89\xd2\xd3I\xbf\b\xed\x823\xfd\x92)\xac\xa7\xbf\xfa\x81L_\x83h8\xfd\xa0\xee\x92\xf4\x13D\x1d<\xe6S?\x80N\x92(\xbc\xe6\xe9g\xe8\xe9s\f\xbb\xf2U$\x04,\xe0\xeb \x19\xe9\xcfc\xee\x8f\xd3c>\xe2\x01\x80\x01\xfa\xfa\x15\x10m\x8a\xda\xc0\u93f1/d\xfa)\x8e\xa4*\x8b\xd3\xf8\"P'\x18\xe0\xa53\xbe$<}\xa3h\xb3\xf4\x04V\xe13\xe7\u9bc0\xba\x1d\nQS\x9a\xc4(\x816\x1c2G}\xc3G>\x9aj\\\u032e\x9c\xecY\u07ff_x\xb6Ts\x8f\xec'\x04\xa8\xe1\xaf\xd674\t`#\x99\xf55\xb8O\x9f,vVxbV\xf7m\x8eo\xdeM\xa1\xdc\xe2]3\xa7K\xa3\xe3\x19\xbd\xc5\xe13\x17\xa1\b\x9f\r\xe0\xa0Ur\xa2\x8b\u06b2\x8b\x9c\x9f\xea\xf1\xc6\xc8j\u0245\x83\xb3$\x10\xe7\x193\xe5\xc9\n\x9d\u02c8\xfa+/O\x17\x9bJ\xfd$\xe1Wg\xe1]\xaaU\x06S%#O\x01\xcf(t\x89\x8e6\xf0\xae\x992\xfb2\xb1\u068e\xa6<\xf6e\x94\xab\xdbX\u0631\t\x8d6\xfd$m\x9e\xe1\u05d9\x88d\xda<\x8b\xe2\xb4yv\v\xff\x02\x0f/\xf3\xbe\x966Gf\nis\xc4\xffN\x9b\xa3sH<\x97is\x14\xc2\x17\x06\xe6l\x8eBH@7\ris$ ED\u04aa*\"\xa9K\x8aH\x9ary\xb6\xb6RH\x9b#4\xc1M\x9b\xd0\x15\xbf\xf5G2mN\xd2&\xa8\x1a\xa6M\xe8\x19:\x0e\xf2\x8a\x01\x94\v #\xc0\x1c(\x16\xa8\x8e\x02\x18R\xa0\xbb\n\x04\xfc\a\xa9\x85a\x05\u0670\x82|XA6\x98\x00\x8a$\b\xa0@\x8f\v\xe4\xc9i\x13\xbaQ\xf5\xa0\x13]\x0f\x9a\u01f2\x85.D$\xa1F\xd6Q\xde\x0f\x00\x1bU\xfa\xd2f\xd6cr\x11\xc2?q\xda\xd4\xfd\xdd\x04\xe1x\xe4\xc7\xe3\xb4y\x8b\xa2u\xe0\xc1\x82V\xb8\xbd\xdc\xfai\x9eo\xa5l\xf7\x97e,t\xf2\x802q\xbf\xf5l\x00|\xea\xfe\xe0f\xd8\xe8\u05c6\xbd\xde\xc0]\xba\xb9\x1ffF\x975\xa1\xf2}(\x8d\xeb2+`\x92ey\x97\x80%}\xea\x103\xe1U\xb2\xa5e\u0310B\x15d[]\xb0\xfe\x84\x06T\x94\xb4\xba\xe1h&4^\t\xd3\xc1\x13$\xe2R\xe0~\xa4\xc8\xfdP\x81h\xed:\x896\x92mZ\u04b8\xf5\x7f\x81\x92Wa\x15@\xb2]\x15@t5\xe7\xb7\x1a\xd8\x19WAs\x99.l\xbe~?#f\x9d\xd1\x05*t\x9d\xdd)\xbd!\xad\x8c\x83Yg\x11j\u00cc\xf9(\xb8RzJ\x818G\xad\x9cq4C\x02\x1a5n@7\u0261\x8a\x7f\xeb\xf8Z\x8f\x04\xf4_\xa5\xa2\xb2\x956\u0630\x18\n\x8fVRoK9\x82\xb6\\r\xbd?hUmb\x92\xf1;&\xb9\x15\x80q\xa91\u03b8\x1a\x9f\xc0\x04\xf8\u4087\xa1-\xb0\x9a\x82\x90j\x9at\x9c!-\xfb\x99,p\x80\xad\xf0\xc0\x17\x06\xc23\x1a\xd2\x11\x9d\xd21\xf8\xee\xa6[\x1b\x9d\xed\u0772\xba\xebw\x06\xb7\xcej\xda\xf6\xa1\xf1\x12\u0558x\x99j\x8c,E\x14\x9d\xe4\xe1\xea\x96\xc7-\x94Uq\vW\xb3tb\x17\xd5]N!\u052d\xeb\xb8ZY\xb6\xdd|q\xdaj\x0e\x9fy\xeb\xd4!=\x87\x14S\xd7I\xf9u\xa5\x95\xaf\xf4\x1a\xd7\xfb(\xa7\xee\xa6u\xb4\xc3P_\xb7\x10l[\xfdZ\xb7\x98c(\xb3X\xaew\xda,_\xa8\xff\u04c3\xc6O!t\xf7\xb7N\xf3\xc5)\xea\xed\xd8!}\x01\x1f&\xd6s-\xa8\xa4\r\xe6\x84\u03acB\x15B\xc0\x85\x91df/4,\xd4\\\xe4x\xae\xa8:b\xf7\x8b<\xcfl\xf8{\xeb\xdf)\n\xb4T\x95\x8c\x0eS%Y\xc4P \xf4p\xdb\x06\xb3\x14\xf1\xf2\xaaG\xbc\u0550oq\xa3\xf3W\xf5\x92\x02\xfd?\a\x89\xf3\x03\xdb\xdb\xffk\xa8\u07c5\xc3yY\xbd\xf9\xc3{Z\xfb\xed\xc3\xfb\xa2\x8e3b\xaa[\xfd7F\xe5P_\xa2\xbe\xe7\xed\xd73\xf87\x19\u3fd0=\xd51*o\x12P5M\xae\u03d7`\x88\x87\xf5\x9e\xd7\xf7\xd6pS\xebi\xed\xaf\x17E\xcdy\x03\x01\r\xe9\x8c&\xd5\xc1\xa4\xffI?v\xd3\t4\xae`E-\r\x93\xbd\xb5fs\x9d\xae7\x9b\xfb\xeb\xf4\xbedg\x94q\x1e\xd6\x06\xfdW\xaf\x0f>\x1fX\x03\x1a\fK\U000d85e8\xd9\xe2\xc8\x06\xbd\u06eb\xd0T\xee-T\xb5+\xdaW\xe5\x1e\x1a3\x80RI\x92\xee\x93|b\xb9-\x91\xd0{\xef.\xe4\x05s\x87QI\xb5zo\xb0\x8e\x85\xf6\xd7mU\xa0vA\x14\xd9G]\x1fu\xa3\r\xe7\xabF\x85\u0713G\f\v\xcb=8.,\xb5z`_-m\v\xe7\xc2\x17\u341f\xf9\xf1\xa3\x06\xbb\x9f\xee\x81\x02\xe2\xd2\x12\xb1\v\xb8C\xb8\xb1+i\x04*G\xfb\xeb\x14\xff\x1b$\xeb\x18\xbaTk6\xf6\xf6\x97mB\x98l\x1e\x87\xba\u00aaa\x84\xd2\xe8\xe5\xdd\x0f\xd6\xcd\x00\xd6\xf7\xa1\xcf\xef\xed'G\r\xfbELU$\xf8\x86J\xc0\xb5\xbd\xb5\xfd|w\x85\xc4\x025E\x99-\u057a\xe3IMDJ\xb8E\x05s\xe0=\xfe?\xdd\xf5\x9e\xf7\xaf:k<\xa1\xad\xff\xe7\xd9\x0f.<\u0487\xa0`\x15W2\x0f\x8b\xee\xd0\x1e\xc3H\xacbv*F\xd8j\x1d\xb3\x02\x9c\xf9\xd54\xf4%o\xae\xe4\xe3\xde[\x92\xb0\xc1\xdc\xea\uea55\xa1\x10-\x8dX\xc1\b\xe1\x9fO\x05\x908\xbd\x9f\x83\xbc\x16\x91x\x99.X4\x0fAz\xe0~k\xee6\xf1\v\xff!\xf7m\xba1w\xfb\x9fem0\x90\xc3<\xa3\xe7zv\xa9\x8d9\xe9\xb9h\xfa\x03\xaa\xa4\xa4\xe7\xf6\xb1<y\xe6\xfe\x91\xf6\x9b\x8d\xa5\x15{DkO\x06\x8f\x17<Z4ca\x8f&\x0fI\x1e\x83\x92\xe8\xb0X}\xf6\xb0Lpu\x03!\xab\xa0\x05\xaa\xc4\xf67\xb0\xbfk\xde`\xb0\x8et\x1ch\xe8\xd5\x06r\b\x1a}\xd9\xfa\xfd\xb0P\u03b1\v\xfe\x90\x15t~(\x17\xfc\xa1\xd8\xe2p\xb9\x15\x8d\xf3g\xb3\xd9\x04\r\x85'\u038a\v\xa3\xa4\x8b\xd9\x1f\f\xd2\xfd\xa1\x8bj\xb8=\xd0\xf3%=\f\xd2?\x10n\xadA\xfa\x7f\u0586\xfd?\a\x03\xa1R\x06\x83\r\xf5\xab1\x18\x88\x1ey\x96O\xd0\xd9{\xda\x7f\u029a\u00de\x06u\xffis\xd8{\xba\xef\x14\x95W\xe2\xd9\u065d\xf3\xf8w\xda\xf2\u01cd\xb36\x18\xdc4\u059c\x86XUf\x0f\xed\x99\xf6\x9dUe\x1ehb1\xbf\x00\xf1\xfaR\u0769'\u038aj\x83\xc1\xb3GW<\x9b\x85h\xb0\xa3\xab6q7\xe0^(\xbf\xe2\x17\xed\x13K\xea\x1c\u04bar\x8d:\x82\x9c\x97I\x91\"*\xb1t\n\x94\xda\xccY\xa9\u07c4\xceh<\xa4#\xd6o\xb5Za\x16V`d\xc2\xd1(\x89yD0\x00\x82\xd6\x15\x1d\x99W\xe6\xef\a@tV\x11\x8a9!z\aWv~lC\u053a\xd8\xd9}\xe1i\x8fkl\xff\x1eT\x15kZU\x11M\x17b&\xdc\u074d\x17\xbb\x9b\x04\xa5e\x10j\xe6\x95r\xe6\tf\x16\x89\xa1D\xf5\xef\xd2O\xcc\u05a6U*\xa8\x15\xfc\x06\x06\x96\x7f\x87 Zk\xd3s._\x97J`R\x1c\\\xf31\xba\x04\x03\xa1\x02\x1aq,\xc9\xcb\xea]\x05\xb70\xaf\xb56\x85`q\xd9\x00\xa4\x1a\x18\x8a\u05c4\xeeUG\xbfQEU\xb8\x06\x1c;\x1aIg_\n~\xc6\xc4H\xfd\b\xe4\x1d6\x16\xb0\xfb'O\u0503Z\xcd\x1a%#\x8f\x98p\xf5\u0612\x82\xab\xe9\x99\xfd\f\a\xa7\xe6\xfc*\x82p\xe9\x81\a\xbe\xeeM\xbf\xc34\x8d\xe6I?n\xbdQ\xe2\xadc>\x19\x16G\xa5\x02\x91}\xe7\xa0\xe6\x14\u0684.\x87,\xc0M\x10\x1a\x1f\xfa\xca\xde\xccD\xb4\xa0#\x93~\u03a5\x15\xe9\x02\x1aM\xe8\xb4:S\x19=%tR\x9d\xfdZ\x8b\b\xa3\x98^X%>\x99U:\x9a\xd01+G\xda\xe8.\x12T5\xee\xea\xc8,V0\x8c\xcc\xe9\x99\xc0\u4c62\xb9\xc09\xba ]\xf0\xe7\xbd\xc6\u0638^\x87\xaa\x11\x84\xa4\x86\u0700\xa1\x9f\xdfi\xbd\xee\x06,0,\x98\xa9+\xc0\x85*86\x81B\t\x9b\xb9\x92\xd0+6s\x05\xa1\u05ec\u077d\xde\vL\u022cF\xe3Zut\u0382\xfe5:\f_s\xfd\xfe\xf90M\xe3z=\u018f\xabz\xfd\n?\x92z=\xe9\x9f\x0f\x89\xaaq\xc7\xc0H\u6720+\xa7\u0415\xa0\xd0k9s\x82\xd0mz\x97\x00\xf6y\xde\xde\xd9\xda\xce\xcc{\x94W\xbdR\xe84\x1a\xd3H5\r\x16\xd7\t{\xfe,j\xc6\xcd\x0e\x9d1\xb7\xb3\xb7\x97\x90f\x87\x86l\xb6\xbf\u07e1#\xd6\u0725S&zQ\xb3\xe3\xb5\u9109^\xb3\xe3u\xe8\x05\xe3}\u0658*\xcfJ\xd3\x06\x9bP\x9f]\u0521vs\x04\xd5/\xf6\xf7YsDG\r\x96tG\xfb\xed\xae\xcf6\xb6w\x9e\xf9\rU\x8bb\x8dQ\x93=W\xc0\v\x98o\xd5\xf5\xb3\xba1\xd6\r\xb0nPQ7\x98\xb8mt\xd4\xed\xb3N3T\x81.\x82\x89\xeb3\xc6fY$\xcf\xdeG\xff\xa3\xd7Yo?s/p\xec\xa4\x1b4\x18\xfaI\x9eF7\xee\x06\x8d\t\xf5\x9b,\xd4 4\x85\x9e\x05\u03ec2~3&s*[\xa8\xc8P\x01L\xea+p\x06\x88\xbeC\xf6\xfc\x99\u07cc\x9a\x00>\x98V\b\xb3\x9a\xb2\x11\x00t\xc260\"W\xcfj\xbd\xb9\xb1E\x9a\xf6\xef\xdd]\xe2\xb5\xe9\x05\x8b{m\xcfov\xe8\x98\u017d\x8e\xd7\xec\xd0+&\xf7\xdai\n\x93\x96\xf5zg]\xee\xb5{\x1d\xaf\x8d@\x94jR\xfeY\x02\x9b\x10M1]\t\x96T\x8c\xb1\xcez\xbb\xe7&\xcc$B\x1d\x1a\xb0\x11\xf1\u0700Y.\xa3\xf1\x13\xc2_I\xb2\x8e\xdf\xef?n\x10*\x9f\xb93\x1b`\u0340\x90\xbd\x0e\x9c\x84f\x93\u039e\xb1\rB]\xd9`Ac\xba\xcf:\xbd\xc9\xfa\u031b\u0630\xeb4\xa7\x84<\x9b\xed\xb3\r\xa8\xd2h\xd0\xd9:T\xc1\xe2#\x18\x95\x1e\x8a\xae\xef&\u0315\xcff\xcd\x0e\xb1\x1b\x81\xfb\xae\xc1\xa6\xc4s\x13&\xed\x8ciEA\xd6\x06\xbf\xc6\xfb\xecy\x97\xf7E\xe3b\xc86\xb6\xb7\xeb\t\xbdh\xb01M\xd6a7\xd1\xc8\xda{\xc1\xde^\x94&4l\xb0\xa8\x1b\xee\xb7\xedJ\x81\xaa\x14\xa8J!V\xc2\xec\xe6x\x98\xb2\xce\xc6\xf3gW\xc0{\xdd\xdc|\xb1Yre\xb7\xf0\xb4#\x8b\xb7*gh\xff\x98c0e.\u05f59\xb8\x18\x91\x1d]\x8cf\xa5J\xa1L,\f\x88a^\xf3\xd6,\xe5Q\u01ab\xb9\xbb\x01\xdc'\xdc\xfb\xb9\x10\xd0\u028eY\x99\xa8\x02\xef\n\x05\"\xab\xc0L\x15\xf8\xb1P\xc0/uQ\xaf\xaf\x85\xaa\xdc\x1f\x85rA^n\xcd]\xe3i\xba\xc6\xfb\u04e1U \xa9(0\xb1\v\xcc*\n\\\xd8\x05\xc2\xd2d\xd2tV\x18\u00a8\xa2\x81\xf1\x90\xcc\xe1\x81)\b\x95n\xa4\xfe\xf8&\x12\xcb[\xc9cx\u0332\x00\x7f\xbe\xe3w|\xcc\x12\x95\x05\x16\x8d|\xccf\xf8\xeb I\xa2Q\xe0\x03\x05\xc6BL9\x8a\xc7<\xe6c6\xa2\xa2\xa5\xea\xc5T\xb4L\xad\x88\x8a\xd6\t\x97\xcc\xc7\xcd1e\u03bf\xfeuz\xfa\xf6\u00c7/\x9f\x0f^\xbe?<}\xfb\xf9\xf0\x18?N\xff\xf5/\x87N\xca\xd9\xef\x0e\x7f?|\xad\xf2.\x16\xaa~|}\xf8\x9b\xc9\x1d\x97s\x8f\x8e_\x1f\x1e\x9b\xdc+\x96\x19\xb6_\xb3mz\xce:{{\xd7\xf4\x8e\x9d7;`;\x066N:b\x0e\xf8\x01;\xb4~\xd8>^m\xb9\x00\x16`\xe0o\xbe\xe4\x92\xd5\xf6!\xbdf\u01c99q\xc9}\xc1C*\x1e\x02&\u04f4\x9d\u0775Ba\xa4+\xff\xd6mS\x13A\xaa)\x89\x8e$\x83\a\vn\u1235\xbb\u045e\xe8F\x8d\x06\x89\xfb\u0450\xf1~\u0510\xc3<\u0420\xedO5\x1b\xb5\x8a\xbf\xc6\x18\xe3-\u0421\xc1\x81\xc2\a\xe3\xad\xd3S\x15\u0081\xbbGx\xdc 9o\xe4\x95\x1a,P\x1b\x9a\xee\u03e8\r\xa9\xae\b\xc1\xe4\xfe\xfe\xbe\x8a\t\xe24\xc4\x1a\x83ymm\xbc\xd8z\xb1\xb3\xbb\xf1b\x9b1&\xcc\xd5\xf5\xd1\xff\u0615Ld\x97\xfb^\xbb\a\x83lH;\xc0\xe8Q\x1e\u0136\x9d\xa7~5\xee\x93U\x16^\x93<M\u057c\xd6\x18\x13\xf5:\xdfcM\b>\xe6f\x93\x95\xc5\x02r\x9f\xd9\x01\x80\u07aa\xa9\x99\xb1a\a\xf6\xaa}\xae\u0217V\xfe\xc7\xe2\x90,\x18\xf7\x84\xc7\xf7\xda=kAe\x83\x13/\x1fWO\a6\xb8\n\x84+)W$\xd9'\u05a6\u01ecC?\xb0\r\xfa\x9a\xe5\xb2\xe3L\xc0\xa5h\xccz]\xfdm\xa9e\x8bb\xfa\x1e\x0e\x80\xf9\xe5\u0417\xecu\x9a\xbe\xcfw\xef\x1b\xd8\a\x18kW\xf0[i#\xceoy\xa0?E1\xaa\xd1K\xaf\xa3g\u0457T\xe4[\xab\x17\xeb\xcd\x1dy\xb19'\x11\x1dGB\x9d\x1dk\xeb}\xc9\xd6P\x17SS\xd7e\xdbV\x10\x82\xbf\xed0\xda?\x15\x10\xd9/\xf6\xa9\xab\xd7+\x00\xc2qF\xa5\x98\u070a\xdf\xf8S\xd1\xf3\xb0\xd4a\xea\xcaq\xb8Ua8\x0f\xaf\xc1\xa5\xf0k\b\xb3\xd5\x7f?T\xc1_\x16;\xcc\xfdF\xe6\xed<)\rS\x1f\x13k\x90\xe5\xe0Fv\xfcT\x15^\x87\xf7\x02\xee\x12O]^ \xb0\xe7\x7f\xbb\xc4\vya\xc0\xef\x96Tk\xc9\bq\xaf\xaa\x84m$\u0146x\v\xfcp\x1c\n\x19\u07e9\x84\xa4\xd8\xf2\x8f+\a\xa4\x1b\xe3yuhY#y\x950+\xb6\xf7G\u079e\xfb\xa8\xf6T\x03\x04\a,1m\xfe\u01ba\xf7\x8d\x06\x03[\xf0Y\xea\xf4\xdf\xea}?t\xe6\xf4M\xeb\xdd\xe1\xef'\xec\x13}\xd3\xfa\xe5\xe0\xfd\x97\xc3\x13vL\u07f4\x0e?~>~{x\xc2>P\xbb\xcd@$S>\x92\xac\xd4\x0fJ\x8f\x17\xfbQ\xd1\xc1sM\x8a\xb9\xddV\xff\xe5pI\x8d9\x95\xee\xcfp\xcf\xfe\u070a&\x15e~v\xb3g8\x99\u04df\x8bC\xe1\x7f/m\xf5\xe7G\x01G\r\xfa\xf44\x1b\xb6s\xc2\xff\xae\xdd;\u0519;\xa5\xeeF\xfe\xe8\x82\x1f+?\xf2\v\xed\xa8`\xe2\xa7X\xa6^\u05cd\xeaK\xe3\x8b\xc0d\b\x93e\x95R\xc1\xc6\xf3\x15n\xc9H]`:\xb49^=Vy}DTnqdYO\xa5\x90uz\x8aS\x15U\x9e\x02\"\u00e8\xb5Uu\xa3\xb8\xba\xf2\xa4\\Y\xba\xef\xe8\u03c4\xbe+\x80\xd7\x1c\xae\x15k\xfc#\xd4\xfa\xb1z\x8d\x7f,\xac\U0004f166\xf3C\xb4\xb4\xf1\x1f\xff\x83\xa5\xee;\xd4\x19:\xa5^\xbf\a\xa0\x9d%u\x1f\x05\xd0\x0e\x02\xf4\x0f\x00\xcd\x1f\u0560\xf9\xa3\x00\x9a?J\xdb_\xae>\x01A\x02\xf9>\xa7?k\xd2\xf3\x1d\xfd\x19\xc9\xcd?\xe8\xcf\x19\t\xfa#\x92\x9e\xbf\xd3\x7f\xd3\xdf(\xe7eB\xf1\xe4\xf0\xdfH$Z\x0f\x14\x9e]\x96\xa7\xa8\x83\u00f8\xb5c\x17Q\xb9\xe0\xf9-b\xbbO\u692b\x1aQ\x1eaM+\xa7\x90\u0264}\b\x16\x9a\x8c\xad!\x04\x864\xaf\x1a\x05D\x84,\x12kQ\xb9*,\x14\xa7\u0144Wx@\xfbVP\x00\x9fW<\x198\xb7\x1f\x1d\x80\xbd\xcd\x02\xfc\x0e\x81C\x90(\x95\xdc\xed\x0fm\xe7\xff\x89\x05\x0e\xf5\x14\f\x12\xfc\v(_\xd7\xe0\xa4|\x1b\xfdbr\xa3\xaa\u073fMn\\\x95\xbb\xe0r\x97cY\\\x17Mu gJ\x92\xdc\xd1s\xe6I\xc7u\x0eo\xe1\x12\xe0cE_\u05e2\xb8f@^S\r\x83w\xed\xfe%\xad]\x0fk\\\xc88\xe0\t\x85R\x97\xb0\xe3t\x11\x0f\x02\xdf\xe7\u0106\xf5\x94\xb3\xa01\xe2&\xe0\xd9\x7f2\x12\xe5\xe1qi\x87a\xb9\xc34]\x84O\xbd\x9e\x01\xe8\xbf4\x9e\xc7Cdd\xed4$\x9c\xf2MQ\xda\x05\xa5e\xd7K\x99\xb74\xe5e\u0695\xeb\xbb\x04&\x15\xa9 \xa2\x90\xe1\xb3\xc8<\xa6:\xc0I\xe9\x06{\xcc\xef\x06\x8d\x86\xaa\x97\xb0\xa8/z~3\xf0\x02\xc5\xc2\x04\xd2W\xbaI\xbf3\xa4q/\u9dc7^@y\x16q3hd\x01\x98\x02\xf3\xc1\x17oC\xb7\x18jt\xf2\xd0`+\a\xaa\x9b\a\x18\xbcq\xed\x18\x14\xd7\xc8j\xb1\u019d\r\xae\xb1\xef\xf7\xbe\xb8\xc4\xfb\xe6J\x1a\xf78\x8e\xbe\u0661\x1c\xa2\xfa@\xa4\x8c\x85\x11G\xf1\x92!_\xf0\x02b\x97\xbd1\xc7\x18[\x8eC\xef\x1d\a\xe2'yWE\xa2olMR\xd7*\xa2\x00\tT\x1f\x92\xe11\x15\xf4GW\xea\xf8]EG\xff\xba*\xb6&h\x8c\xae\x94\b\xf1\xaey\xa9\xfe\xbb\xef\xabom\xc2+{\x13.`)\xa0\x85\xb1\xdd+\x8e1U\x82D\xba\xd8='\xbdw\u017c\x0f\xfe\x14\x88\u05fc\xe5k^|\v\xb8E\x96\x15\xd3W\x84y\x95\xe2s\xdc*`G\x96\xe5\u067b\x9b\xab\x87,_\x83g\x8a\\c\xe6\r\xb2\xa6\x10\x1b`\xeb,\xa9\xb3\xe4\u0762\xb9\x12G\x93\xcag\x944\xb9\u061f\x9b\x05\xc0:\x9a\xb8\x840\xc6\\\u0264\x95\xf2\u0631\xe8\u0776f\rh-\x1f\x10\xff{\xe6\x87I\x9aVd\xca,s\u0354s\xa5}\xcd\u0715\xa0S\x1cE\x80\\\xe2\xec\xe1o8\x1eY\x82\xd4\t*#K(T9=\x05\xcd\xdbB%\x93d2\xad\xc44\x85\x1b\x7f\x8d\xb1\u0115\x9a\x1f\xb7\xc6Pv\x92\xa6#\xf5c\x043\xb0W\xc9f\u01b4\x99\x19D>\x13\xc5ZA.#\x14\x87f\xd4\u024f\x99z;\x05<q\x89\x15;\xef\x9a\xc7w\xd6a@\x00)d\x13\xe33\xd9%j\rM\x95\xa8^?\xe7n\x04\x88\x0e\xf8\x99\xaeHS\xfc\xdd\x1e*\xd7\xfa\xa4^\xcf*\u00bb}\xae\x1aS\xa3/\U0005321d\xa4gR\xb9\r\xadwF\xbd^\xf8\xe9\x12%\ue218\x9e\xbc\xcfx\x973I%\xf3\xe7\x8a\x7f\xb8\x06\xf2\x1ai1\xac\xf2\xd9J-\x12\x13\xbd5\fP\xefJ\xe2E\xbd\xb5sDZ isczF\x88\a)\xf9o\x9a\xad\t\xb4\u07a1k\x9d9\xc9@:5;\x8416\xc9\xf7\xdeY\xbe\xf7\u0590\u062d\xe5~8kg\xf9M\x01h[\x97\x05\x80)\x1aL\u01d7\xb3\x889\x8b'\xd4Yo{6\u02c8PL7E\xb1\xcb\x7f\x9b\xe6\xff\xdd\xfd7fY\xac\x94\x1bkd\x9c\x94\xe3Z\u061c\xabCnXW\x95\xb38,\xce\"+\r\xf3\xb8\xe1.r\u04e8\xf3\xca\x17\"\x92\xb5D\xf2i\u036f\xa1'\xc3\xda\xd9]\r\xc2\xd6p`\u0375i>7x\x1d\x82\u0306P\x91\xcfX\xf4:^&\xe1\x11\x84\xca=@\x99\x02\x98x\x9adE}\xb7\x8c\x80\xe5blH\xe7S\xe8\x95\t\v\x8e\x16\xe0\xf0s\u0103\xd0ue\x93\x93u\xd1\xec\x10\x88)\xbc\b\xcd\xdf\xcc4\x7f\xeb\xfeV\x86\xe6-P\xbc\n\x88\x16Ut\x00\xf1L\xfd\x91\xb4\xd5\xc0Oy\x81\xbd{R\xfcy\x89?\xadW)P\u05b0\x95\xa5+9\xfd\x91PiK8\u03b9\xac~Y\xe1\xc0acs\u04b3\x9e'\xfdW\xfa\xc1E\x86\xe0\x8d\xa1\xd0\u05b2\x87^\xceq\xb6\x1a\xc2\xf8\xda\x19\xf9\xa1\x98\xcc,F.\xb3\xa1\x8a\xb8+\xfa\xb2\x177#/\x1a\xd2\ba\x9f\ud4e8\xd1\xc9\xf0\u0292a,\xbc\x19\x1f1\x86\xa5$\x90\xe9k?\xd6\xf4\x0e\xa7\x11\xd5\xc3k4\xbc\xa8\xd1P\x04\x0f\x95\xae\xe0\xf4\x1d\xa1\xe2QP\xb69\u01cb\x10W\xc4m\x9f#\xa8\v\r^\xf8E\x9d\xc7\u00a3\\U\x832\x96B\x00F+\x11\xffd\xb9Ts4f\xf9\x8b\x92\x02\x8e\xcf\x00\xe7\xb3v\xd7\xdfcQ\xd77Dn\xc0\xe2\xbe\xecEM\xdf\xf3\x87]{9A\u00ed\xb8\x90~N\xe0\xfaK\x06\xb8r!\x1f5\xba\x95\x94ma\xb0\u0668\x1a\xfbQ\xb6\xd2\x01\x85\x91\xab\xf5\xb5G\xd8\x1f\xeb\x83\x15\xe3\xc1\x8a+\xa1k\xe8\xdd\xd2\x1c\nA\x81`\u816b)\xaf\xae\x10\xba\x9a\xf1\xafn\xf1\x95Nh\u0310\x04\xf9\xc5\x15\x84\x98u\x8b\xbakn\u0104\xbeJ\xd5]Z\xaf\xaf\xa1\xe3P7\xd2A\"\xe3FC\xadC\x97\u449c\xea\x19D\xf1\x7f6\x85(^9\a\xdc\x1f\xbf\xe4\xc17\xf5*}Q\x15\xe2\x87VOf3\xcdI\x13\x98qO\xe2\xd2\xe1<\u055c\xcd\xf9\x8cp\xb1\xa2\xffs\x8b\x95\x1d\xa0lWf\x92\x93\x88U\xf0F\xf4\x012\xaf\xb1\xae\x85\x00\xa3\xbe?\xa4\xbeY\xab\xec\xcc`\x17\xdd5W\xb0\xb8\xb0\xcc]\xb3\xa3\x85&\xbd\xe0\xb5\xd7\xf7\x87,\xa0\xba\xc1\xc0j\r\x9d\xabX\x87/\xfa?\xbf\xfa%p\u0115\xe0X\x89\x88aF\xfb\xd9\xf9^\xd8\x030*\x04E6\xb4.\n-\xf5\x1e0\xb3U\xf8;\xee\xdbx\xfb\f\xf7\xc5\x19\x7f\x1c\xff\xb3p\xb9\xf7\x9cc>\u5fac\xf5\x87\x8e\x97}\u05dc\x86E\x855\x1c\xf3\x1bj4\x1c\x1ds\x04\xc4\bg\xff\xf0FV\xa2.Yj\xc0\xc4\x18\xaf\xba \u03b9M\x18bT\xbe3^V\x9fX\x8ema\xe4fi\x8c\x80\x14\xc7\xe2i\xea\xd3n\xfc3\xbe\xf0\x9bo1\xeaw\xb9\xa3\x98_\xf38\xe1K\u066d\xa5\x19\xa1\x9f\xe3GM\xa8\xd7\xf6\x9a\x9dR\xfd,\x04\xfdc\xdb\xc8&\xbb\xd8\xd6\u00f7f\xbb+\xf6rh\x89\x02Ac\xf7#\x8a\x87Z\xe4\x14\x8dX\xd2\u728b\x90\u018f\xa0^\xe2=\xa1\xb6\xab\xc1\x8cB\x8f\x85x_\\u\f\n\xfd\xaa\xa7p\x15\xc8x\xf1\x11\xd2+\x830k\xf7\x0ey6T\xba\x87x\xba\x0e\xff\xe1\xe9Bb_\x1d.\xf5\x99\x9d-$\xd9\x1bN\xab\xd52)\\\x8c\x1b.\xdcv9\xe5\xdes\u0950W\xe1S\xcf\x01\x13I<}\x87\xff\xf0\xf4\xa9\x9e3z\xf8\x99\u0578,\xb5Zy$\x15\xe6ry\xd3j\x8e\xac\xe7\xadd\xf7\xd9>k\xd7\xeb2\xdfS\xf5\xbad\xcc\xd6:\x93\xa4\xd4_\xd51.\x1c\u06ec-}|]\xce\xe0\x9c\xe6\xc9\u0515\f\x0ep\x9eB\xf6\x98bu\x1fr\xb7M\xdb\xc4\xd3\xdfX\x02\x1e\xb8\u05b3\x89\xd0,U.\xa4\xe2\xe4\bY\x00\xd1\xe2\xf9T\x10*\x00\b\x11\xfc\u04fc\x1d\xc6\xda\xd91\xb0a\aOs\x84\x9bu\x16\xb3\x936\xcfb\xdb\xd3\xc3\xef\xc0\x13\u0610\x1e'\xee\xea\xc3\x7f@N\xc3@\x9a\x9d\xec\xe6\x83\xc1\x02UP\xd8S\xe2Y\xecY\xbf\r\x81-\x14\x81mQ\a\xd4_ \xa6\xbbQ\x83\xc9^3\xf6b\xebf?\xfc^\xb2\xfa\x9f\x8e\xf2\x01B;\xeaf\xcf7=H\xea\uf2cc\xce\x06\xd2$Px\xe8\xf0\x1f\xe0\xa1Cn\x8f\x0fyE\xea\xb3^\xcf\x1f\xf4\x98\xca\u0178^\u03e7f\x8a\xf2)\xe0+}\x9e\x01i\xddr\xa5\xbav\xca\xe9-\x87\x8f\x13\xf3q\xa9>n\xb9\x96\x05b\x89L\n\x88\xc5P6x\u0251\xf29\xe0U\xfa5x\x84\x83\xabYX\xaf77\u0311\x86\xdfn\xae\xceD7H/K\xf7\xaa\x16lg{{s\xbb\xee\xf2\x94\xb5\xe1A\xa0\x7fJ\xf8\x99\xad\u01f3\xb8\xe1\xba|\x7f\x7f\xbf\xb3C\x9e\xc5\r\xf1\u0315\xea\xc7\xde^g\a\xb4\xa9H\u06b6\xf4\xcf^\x15\xf8\xcaP\xb2\xdei\xefn\xeenu\x9eol\xa5\x9b\x1b\x1b\x9d\x8d\x8d\xed\xad\xddN\xdd\xe2E\x1fq\x97[\x9b3M\xb5\"\x86\u065b\xed\x87Y\u016aj\x89\x1f\x9c7Thi\r\x99\x81&\xa9\xd3U\xb8\u00b4\u0675\xf4\u01d0a\v\x03\xe3k0,\xae\xb4y\xf3\xc6\xf4\xad\x9dr\xa4\xaa\xc5\x1a\u01b1u\u015f,_\x84g\x9ct\xf9~\xfe\xbbK\u011f\x8c\xaf[%\f\xa4_qW\x90\xb9\xa5+o\xb1\x8b3\u035c\xfd7\xbc\xf7\x15\xa5Lo\x8dT\xac\x120\xc0\xea}\x15\x8dy^\u07e4(J7\x97\xb5\xe5}|^\u0662\xb9rMi\u8fd5k\x99\x90\xc5\u8d3f\xc0B(\x9ftNC6\x9c\xdaH\xb1\xe1\xce8\x06\u0583\x88\xb4\x16k\xea\xab%\x10\xfc\x9bC\xe0\xe0\x05F\a\xb2\xe6p\xde\xf4\v\xac\x05*\a~\xe1\xacM\xff\xe6\xec~\x0e\xa9\x8d\x06\xc5\xcaL\x12j\xc9Q\xb0R\x86O%kSEl\x19\xb0\"\xad%\xd9f\xe7\x99l\xf0\x168\xc8\x00P\x1dHW\x90\xb4m-\x90\u0359\xfc\x9c\x0f\x18\x80\xf6\xda\xe6\u06c3B\xb8\xe2\xddrB\xf2\xf7D\xc6zVEx\xff%\x1f\x16\xb3\xd7>\xa9\x93P(\u059ajN\xcc\xdb\xe4P\u032e\xd4\xeb\xb7^\xafN_l\xd3n\xeb\x98\x17F\x04\xdbM\xb2F\xe3=\xa7\xf9)\xad\xbf\a\xb8\xbe\xe7\x80\x14^s\xf2\x81\xb7\x12uG\x93L\xcb?k\xf2#\xf2\a\x18|@\xc3\v{\xe0c$\x9a\xe8x7\trqlR\xf3c\x8e\x91\x89\xfd0\x8cn\xf8\xb8\xe6' \x94MZ\x0e\xee\xbfO\x9cTZ\xbb\xb8\x9c\xbe\xe4\xf4\x9egs\xd5\x11\x1d0\x9e\xb9\xf9\rf\x02\xe6\u06fcr\xd4\xc0k\xf6\xc0\xbf\x0f\xa8e\xf1\x97u\xc3T\x15'KZY\xa2\v\xf3\xf8\x86[\xfet\x1a\u07a9{\xc6\xd6GY\xbe\x15\x98,-\x1aNEDc\x0e\xac\xe2\xc5\x05\xfb\xa2\x02Q\xca\b\x9dS\xfa5\x01\v\x98\xb5X3\xdd\xd4\"\xa1\x17\x13\xd6L\xf7d\x99\xc2\xc0\xb1\xf8\x98i\xcb\a\xc9a\xb6\a\xe8\xa7\x02\x1c\xc0\xac\xa6\x18l\xb8\xb4\xe6\xf7s\xea\xfc\u02e1p\xbc\xd7\u06b9\xe1M&\xb9\x9b\xbbV\\\xa1cs\x93\xe0J\x9aY\uedc9\xf2\xf3\xeaZ3\xbf\xc7\xf8\xa1\x1d/C\x903\x11\xfc=\xe3o_w1\xe3E\x9eabV\xe9@\xd0\xf5\xfaBRVw\x8e\x13\xff\xc0\xe9\xeb\xca[[\u01d3\xea\x02\xaep?pTL\xd1i\x8a\xcd\x01\x87\x8e\xbe\xe4\xcc9=\r\xae\xaef\xb8\x91\x01W\x9e\x9e:\xdd\x15Z\xb6\xeeK\xce\u0537\xfb\x92\x13\xd5\xd4\x1b\xce:;\xf4\x1b\a[\n\x9a#\xc9\x1cV\xbf \xac@\x12\xb3\x86\xd7Z&&\x99\xf2\x18\xa2Q\xe2\u07ac\xf9Z`\x13\u020b\x9a/j\x81\no\xc6k@\xe6\x15\xf0\xf7\xaf\xbcB33\x02\x81\xf4O\\Y!\x8c\x94\x15\x02\xa4\xb5\xa0\xbd\x0f3\x89\x91\x11\x13[8fH\x93\x18\xee\xa2_\xb8+\xf4\xa3\xa2\u0489\xb1\xa5\xd1,\x11I\t\xaaxu\xb6\x04\xf6\xa7*\x95\xa1\x9f9\x9a\x19\xfc\xca\xe9)'\xf4W^R\xfa\xd2\xcf\a%\xc2\xcf\x0e\x1a\xcd\u0262\x95\x93\xe0\vo\xfa\xfc\x9aa\x1bJ\xf8\xd7\xe8\xecg\nU\x8b\xa7\xf0C\x90\x80\xe7%\xa5\xb4\x82\x9e\x8e/\xf9\x1d\xa8\xaa@4(\xd2U\x18\x19\xbe!\xb0\x7f\x034&\x90\xf4\xfd\x95\xffC\r\xbc\x0f\xfe4W\xb6\xfc\xf5\xf1\xaf\xda\xd38\x8ad/\xff\x84\u2b91\xa6Q(\xee\xc9R\x83\u0272\x06}\x99)\xe4\x91\xc5:o\u014aa\xcc\xd0\xef\xe4[\xc89\xa3\x15\xdc\v\xb9\b\x1d\x15\u043f\xeaa\x90\x8f\xe3\xac\\IYg\xbc\x15U\xd5\xca\x03\xa9\x18\xc6\xd9\xe20T\x85\x92U[V\x01.\xd6l\xf7\xe9\xcd\xd2S\xcf\f\xe2\x15;\xec\xf3!V\xadl\xff\xadX\xe8A\xa4\xa9+Pd\xad\x16\xcb\xf0\u032f\xf5\xecoanX4c\xf23\xc6\xcez\xaa\xb4\x17\x97\xfaA\x17\xd7\x0frc\xe0K?\xfcN\xa3\x1b\xc1\u3def{n\x96\xcd\xda4\xdfI\f\xdd\xe4\uac80\x02\xf58M\x92\x1fJ4\xe7YS)\x04\xd1JiPW<>\xaf\xbat'z\x8e\xbaE\xfb\"]\xac\x0f\xfe\x1e\x98\x8d\x9fJ\x8dH\xba\x80&:\xa4\xb2%{\x152<WQ\xb9[\xb9\xa3$\x85\x19Rwq\xebU\x92\xeb\xd8cO\xff\xd5d\x03\xe0KO\xf4s\xc1\xe5p^=\xd2\u05dcOW\xc0\xedB>\x003\xa8\xbf\x00\xb7\a\xe7k\x9a\x1fKW\x12*\x96\xb6\xfc\xbf\x0fG\xe8\xb5g}\x7f\a<\x93(\x96U\xe8\xe2o\xe9N\x85a\x18TUzyW\x8d\U0002c2b2\xa2j\xe1N\xaa`\x89!0\xfc\u40e2-r\xd1\x17\a\x98\xcb\u058d\x9f\x1c\xa8\x93\xe5\x92\x1e\xe8\xafp\x91\xccb~\x04\x87\xd5-\x9e\\\x85\x81J\xddg-/\xbfu\xcc\xc1\xb7\x91\x81\xdd\v\\\x83'd\xa1\u0777WW\x0f\xb5l\xb7\xb2\x00\x97lb\xcb\xebk\x9cR\xaa\xf9\x90\"9\x8c\x97/\xbf\xbb\x961\xf7\xaa\x19\xf09\x02\xac\xd7\xf3\xefV\x85\x16Q\xd6?p\xe3\xb9+A9J\x82F\x94 sB%\xa1\xf1\xc2@,\xf8TmInpui\x8dx/\x969\x96\xb6p4\xe56\x82&^i\x83d\xda09\xb2\xee`\x8a\x02Q\x90|\xf0\xa7\xec'\xc5\xe5z\xc2\xe9\xcf\v:\xef\x1f\x0e>)\xc3\xc8w\x9c\xd9S\xc9i\xd8\x1f\xb5\x1e\x11\xf6S\xeaV+\x9e1\x8b\r\xf0G\xa6LTU\xe1,\x90W\xfe\xd4\xe8\xed\xc0C!a\"\xaf\xfc\xfb\xca\u02a3h&\xe4\u04ba\xff^Y\xf7\x92\xdf\xfd\x047\x9c,\x0e\u072a\xff\xdb\xf7\u05bf\xb3ksY\xa8}\n04\x85O\x8d8M\xe4\x8aL\xa3K\xc6\xcd&\x14\xd2\xd5\xdf\x16I-e\xe1\x04\xa0m\x1el=\x89\u02bbY1Q(v\x0fP\xf18E6\xb8\u05e6\xa7\xa7\u04d8_\x83\u04d6\xacB,\xcb\xca\xc7Es\xebw\xb9\xa6v\xa4\r\fh\xa4F\xc7$\x8d\xac\xad'h\xa4\xb7%\x8biT\u0702Q\xde!\\\x05\x99\x8e7OS\xf7\tg\xb1t\xdb\xf6\xfb\xc1\u03e0\aC\x8ai\x04,\n\x03\x13L\xf3\u066d{Ch\xc0n\xddCd`\xc4,\xc8\xc0Fy>,\x9a\x91\xc60I\f\u03b2\x16h\x15\x03s\b\xbb\x91VTl\xb8\xbe\xca\xea\t\xa4\xbc\xc05\x82\xd7&s\xf3\x9c\xc7T\xbbZG\xdb\xdf\u00a1\xb0\xfa\xec\xa3e\u4c20Q\x9d\x11_\xaa+\x16Q=Z\x16S^\"\xb8x\x91\xda\xe2\u010b\x01!D4\xd6DW\x06\xa9@\xda^\x19h@\x13\xcb\a\xaa\xa1E\xddb\x01\xcf\xc7\xc9q\xcf=u\x13BO\u0740P\x98\xc4oP0\xa6\xe0*\xbbh\xb6Q\xf0\xacZ\xd2Y\xfe\r\x98\xb4\xa5\xb4\x7f[\x8c\u6674}p\xe02\x9a\xb3\xc3Xlk\x91\xfc[w\xafm\xffh4T\x04\xb2O\x03\xe6*\x8d\xc0\xac\xae\x97}\xed\xef\xef\vR\xbf\xa3\x89)\x13{\xb1N\xb3\xe5\x1b\x7f@\u377d\xbd \x05\x8f\x1f4`\x8c%\xbd\xbe\x19\\\xe3\x1a\x877\xf4\\\x9fY\x90\x88\b\r\xf6\x92^\x9fS\x7f\xe8\xf5}\xca\v`\t\xad\x93\x03\xfbX\xf1\x16Nr\x8d\x92\u0234\xc5\xe9\x11w\x05\xa1\x10$cH\xb4\x80({\xa0ZzX\xb2\xef\x0f\xbb\x11\x8b\u033a\xf1|\xf7\x06p\xd8\x03<\xec\x99&]6\x94\x915\x94\xbc\xf76\xf4D\x83\xa2\x818\xf8\x9f\x981px\x92\r \xd9\v\xbb\t8\xaa\xd8\xdbc\x1d5\x94\x11\x93\xfdd\xd8\xcdXw\xa3z=YSQ\x90\xa3\x94\xcdh\xd0\xf7\x1b\x8d!\x1be\x83\xd10\xe64\x02\x99P6\xb0ia\xf5s\xa3\x8b\xe2\xb0\xceqX]\xe8H\xe0H\xc4\xfe\xfe>\ub420\x9f\fY\xa7.z\x12\xbb3\x06;\xba\u02e0\x1f\x0fY\x84;\x17\xef\t\xbf\xd1)\xf4=\u0250H\x0e\x92\xfePC_,@?a\x02\xd4wf,v\x13\xd2\r\u0704\xa4\xa9;c\xb3\x92\x15A~\x12.\xb8\n\xe4Dh\xa4\x9cT\xcd2h\\\xa9\xae#k4\x17\xb2\xf4\xd0DvYF\xde\xd6\ub072`\xc8R@GY\xeb\xf9\xf7\xb8m\xa00\xb6\x8f\xa3\xa5\xe2l\xfc\xfe\xa0=s\xe1M\x00\x8d\xe7\x1a[\xa5L$\xa9\xbb\n<\xc6}P\xd7\u04b5\xa0\x11\xe9I/\xb2\xae\x8c\xab\xf2T\xe0\u0e42\x89\x96\n\xe7T\t\xad\\\xd7\x1e@\x96=\xac\xbd<=M-4\x99\xa6\xa0\x13 \xb2r\x8fa\xfc\xc4L\xf6\x8a\xd6\x1e\x19\x02\x8c\vL\x8a\"\xfdu\xd6\x13\x9eT\xe6 \xc8\xc5\xf2l\x98B\x1b\x8a\xcb\x05T\xa6\xf6\xae`\xf6\x0e\xa8\xbf\x8a~4\xcc\xf8d\u0600W@\x87\xae\xe8\xb7\xed\v\xfaz\xe1\xbe\xc51P\x9fIK+\xcaWZQF\x10\v\x1e\x03h\xc2b7\u0216&a\x8c\x05=\xee%\xf3\x1b\xeeFi\xaa,\x16\xb8\x04\xef\xe2\xd7~\x18\x8c\x81}\xf5\t\"\xf6\xab\u015d1}\xb5\u0450E\xbd3O\x89Jf\xa0\xe6>\x02\x0eDH\x8bK?b\x8c\x85=\xee\x8d\xd4]\xa1\x997\xee\x8cxn\xa4x\x8c\x9c dftd[\xa6\x14\xee\n\xe6r\xe6>\xdf~\xfe\xe2\xc5\xe6\xd6\xf6\x8b\xba\u02db\x8c\xa3xrk\xb3\xb3\xb3\xbd\xbd\xbb\xb3MH\x03$\x9d\x1b\xf5\xac\x94N\xd9\"\xf5\x8d\ud35d\xad\xed\xce\xe66\xe5\r\xa8\xf7\x9cv6v\xeb\xae\xfa\xd1\u0671z\xbd[\x00j\xdc\xe3\u07a5e\xe4\x1f\xf5\xe5\x10(\x94\xbc\xce\xd9B\x1d#\x99j\xa0\rC\\\xaf\xcb\x06p\x83\xa2\xec\xc2Wm\xf0n\x8e\xc7r\x1c\xa6\x1c\xf1 \x1eK\xf6\"\xc0b$A\xbd}\xd7\a,&h\xc0\x9a\x1d\xe2\xe1\x0f\xdeO\x1a\xb9]\x96o\xe9\xe8\x17\xe9\x9elDM\x1c\x91P\xaa$\u0665\u0275\x8f<^\xbaq\u0500b\xa2\xd1?\u0634\xc5h\xd2\x16h\x89\x9e\xcf:\x84F\xfd\x00F\x124r=\xdah\xfe\x0e\u0674@s\xbc\xe3\xfd\xab!{g\x16\x9ef_o\x05{\x97\xb3\xe5\u83eb8\x97\xe5\x1b\u0266\xb5\xf5\xe82=J\x7f/@d\x1cL\xdcs\xee\n\n\x9a\x90ptH\xb6\x82\xfe\xb0\u07f1<\x99\x14\xbb\xae\xe4\xea\x19\xa2'\x1fC\xc2\"<n\xb3\xe2XB\u05a6#63c\t\xf7F\xf5:\x18\x83\xc4t\xd6\x0fq\x18\u0770\xd1 \xdaSM\xb87\x82\xf5\x98\xf60\xb33\x84M\xe2%\xb6z%d#]\u5099\u0494\xd4\ub9eeO\xe8Z\xa2\xf0\xda,S\x88\x04\xf9\xe3\xb4^_K\xeau\x93\xb8\xcf\x0e3\xf90\xd2\x183\xbc=\xb1\xef\tH\xbb\xb3G\xa3!7/\u06247\xf3.\xddYn\x9d\xd2Kz!cl\xd4\xec\xf4.\xd46\xf1.\xfa\xe1\x90\x15~\xf4c\x1a\r\xbd\vu{\xe1\x0fB'=\u05c6\f\xbb\u043c>C\xe5\xd2\v2\x9f\xd3?\x1e^\xf5\x92\f\x19) s\xcft\xf6\xf6\\\xd7x\x13\x01\xdd\x06N\xeaw\xb0_\xadGa7\xbf\\\\\xbf\x1e\x91\x9e\xd6_\xc1\x87^\xff\\Bb\xb3C\x86J\xe4\u06f8\xd6\xfd\x96\xc6\xf6\xc0\xb6\xc8\x06)\u0436\u423b\xb1\x1e\xa4\xa6'eOx@\x8dH\xa01g\f\x89\xc7\xd0\x1e&\x1d\xb1\xf6\x1as\xc3\xfaL\xe9F\x8f\xea\xf5\xc8~%\xe0nP\xdb\xe6\\B\xb1f\x87\xd0\t\u02e7B/\u06287\xe9O\x87\x99\x17\x14x\xc9\\PNe\xe3\xda\x1a+\xb4>f\x8c]\x94\xf7\x19t9\xae\xd7'\xd9\xf6\xb9\u0376\x0f\x92_\x13\x1a\u0484\x8e\xb1\x01\xd8\xd6\xe3z\x1d\xd4WL\xf9z=\x91\xee\xa4\xdf\xf9s\x9a\x9f5\xf5SW\x18\xd7\xeb\x9dr\xf9qVt\x8c\x93\xbb\xaa\u0717\xe7l\xd4\x1b\xf7B/\xfcs\xe6\x85\xe9\x8c\xde\xe0\xef;\xe9N \x8e\x03\xbd\"\u078d\xfa\xbe\"\xdeY\x9ejV\xfe\xaa\xe7Z\x80f\xe7\xf6C\xff\xc6\u0696Hw\x9e\xd3\x1b2\xa7\xbf\xffg\xfbraO\x9a-\xa96]\x94\xe3\xec\x9e_\xdaw^\\\xea\xfd\xbf\xbc\xf3\x14\xda\n\xed\x8d3b!\x10\xe9\xc1\u011d\x81\xa8\xafb\xc7\x05\xd2\x1dUn\xa4)\xe0\x86\x85\n\x13\x96\xf3Up\xf13\xfc\xd4lN\xf6N\xb3m\x85\u03cd\x90Nh\xa2\x9e\u01b5I\xa3\x81\r\\Tn\x831\xbb\x03\xfa\"\xa1Sz\x91-\xeeE\xcf\xcd;c\x13{m\xc7\xd6\xda\"e?\x81\xa0\x1f\xf4\xdf\xff\xf7n\x9a\x7f\xff\x97\x16\x16\x17\x11\xafr\xa3\xf0\xaa_\xb1\xa6\xebD+x\xe2\u0741W\xc6,gsRA\x15\x92\u039f\x98\xffw\xae\xb1Da\x90\x91\xfdzW\xec2kJt\xd6\xef\xfc\x19\x0e\xff\xdfpo\xfd{al\x17dN\x7f{x?\xe5\xcf a\xf1\xf9\x00\x8e=\xebgg\xe8\u0165\xe6\x1e\xda#\x05J\x04\x17\xa8\u063c\x86\x87;\xebE\x06hY_\t\xb17I\xa2\u0103\xb0.\u07acW\x01\xe5\x9e[\xa8\xce\"\v0\x8b\x8b\xa6\xa0\xe9\xb9\v{\x0f\xf7\xb1\xce&\xa4D|\x19v\xfb\xbf\xab\x12W*\u061a\x9d\x1b\xb36\x8d,\xfb\xben\f&j\xf1\x82}a\x04j\xa8\xc3\xdcV\xbat\u075b>\x7f\xff\xee\x81(\x94\xba|\x18\x9a\ub60f\x01\x9fiZ\x13\xcbo\xd9&E\xf9\xe0J\xbbb\xa5g\x1dn\xad\x13\xaa\xb3rI\xdf\x10\xca-\x7f\x97\xcaC\x9b%K1s0\u0792\xa0\x10\x95\xcc\xe2*w\xa5\xb62B\xf3&\x9c%\x8d\x98T\x8a\u044d\x06L\"\xd6}\xdek\xb3\xf3\ucf43,g\x93;7\xea\\\xb1Y3\xac\x10\xed1\xb4k\xd2i\x19\xe0HE#\x01O\xfa\x05\x06xO\xa0\xb5h\u07b6i\r\x17\xc3jK\x03_gT6\x82\u02c1C\xf2\xf5\x88\xed\x01\x98\xb4n\x018LH\u05c7\x87\xbd\tS=/f[\xdf-\xc5:7\f\x1e0\xc0@\xc4v(\xd9\xf9\xfa\x16\xbd\x85?\x1b\xf4\x14\x7f\u5c92\x13\x99K\x01\x8f\xf5\u04fe\xa4h\x8b\x17\xed%\x94\xcb\xf9\xcaj[F.\x9a\f\x8a\x82\x1d\x0f,P\u0713\x9e\xfb\v\x9e\xc7x\xbf]\xaf\xc7{\xe7\xbdO\xa0\x03\x12\xd3k\xaaB\xdd\xf2\x9b\u0691tE\xeeX\v|\\\xac\xe2\xa1 \xef\xe0$\xf8\x86\xadV)\xfe\x14XW9K\x04\x15\x7f\xac\x97\xf8\xa5\xac\xd0\xfc9\x90\xa8\xf9s\"\xe9\t'\xf4DV\xbb{\x02h\x17<>\x9d\xc8\x7f\xa8Z\x03^9r\xefV'r\x05\x9e7>-2\xc3\x10\x82v\b\u0732C\xd0b\u00d7\x06\x196\x8c-k\x1c\x9c\a\xb9\xae\x86\xa8\xd7EKY]\xf3\xfa\xdd0\xd7\xcb+\x0e`\xa9.\u0387\x82<\xb3Pg\xb9\xe2\x8cm\xe7\xa2iW\x1c7Fc#\x1e\xb7UA\x9a\x1d\x95\xa9.R\x95<\x05\xd3\x13\x97\u04ce\x91,\x17\xfa\rD\xc2\xe3U\xbaHY\xfd\xf6\xe2\xa0\xff+\xea)6\xa4\xf5\x8f\x91?\xf5G\x81\xbc\xcbTWB~\xcdCvm+\xb2\xa8O\xe9\a\xe1w\xeb\xb4\x1cK\xb7<\x13 3\u0602\x83\x9d\\\xedA.\x9a\xdba\u00b2\xe3&\xc8\xfd\x1b8>\xe8\xf7\xd3p\x02\xba9\x17\xb3\u074ds-i\xb8{\x04\x9e7\u0648)\xef\xc7\xda\x02\xb28\u00a8Jw\xe4\x8d\xdeQm\xda\xec\x94k\xe8\x88}+\xa6\xf5\xa8\xa9H\x9c\x8a\xa4\xcd\xc5yTi{k\xbc\xc1\xfb\xa2j\x16\v#*\xcdca\x12\u02d4\x8d\xbe-W6Z\xac\xbfL\xd9\xe8\xdb\x03\xcaF\x8b--Q\xe1\xf9V\xad\xc2S]\xff\xfbUx\xbe-\xa8\xf0\x94\xf1\r\xa0\xf5*\xe4\x91\xef\x0f\xbeP\xeb\x9fZ\x96\x9a6\x95\x1d\xa9\xb6*-\xb7\xfe\x80MS\x9b\xc6\uc8c1>y\u0220<\xb6l\xc9\x19c\x9fef\xa0$\xc0v\x9a\u0317v\xbf\x94\"\xd4d\xa05\x06\x17\xaecB\u05a0\xfd\xcc:_,\xb1\xcb?\x91\xff\x1d\x15\x90O\u04b5\xf1\x1f-\xe2?\x1b\xfb\xd9\n\"9\xee{\x9c\xae\x88\x82N\x90\xc0\xa5\xc9.\xa52\x80\x92e\xbd\x90\xf7oO>+\u0150W\x92\xd9\x13\u0309\x9d#i)\x86\x14\xbc#\x9a\x0e\xe5\xfc\x95\x04b\x00\xb0\xed+\t\xfc\xe5W\xd2\xf0\x97_I\xadr\xfaN\xeb\x9eBJ\xa6\xfc\x99\x95S\x05\xcc7\x94\xd1O\xadw\xe6\u0455\xa7\xa9\xb2\xe6\x1b\u048dN\xe0\xbbL=0K\xd5Zn\xef\nJo\x90[\xd4\xeezW\x92DA\x89\\\x01\ub765\x8d\xa5rr\x15\xaaw\x05\x8d*l9\u05cfzgkK\u0463\xc5\xcb\xff%\x9fD\xf1\xa2\u04aa\xd6I\x90\xbd\xce\u079e\xf4\xb2\xab\x15\xa1o0r\x99\xcb\x133d+\xd5\xef\x90\xf8\xdf_^CS\x91}\xf0\x00\x855#\xe0\xa40\xc6b\xa8)\xf7\u06d9\xd8:k\xa1\xaf\xdeEn\u0102z=(\x8c\x1d\x86\u073c\x06l\xc0\x18\xe4\xfa\xf6\xc0\xe6\xfa1\x15-\x8c6a\xef\ra\xa4\x18\xac>\u0259\x1f\xed\xeel/\xee\xce@\u05a2\xfb\x9f\rYQJ\x1c\xd5\xebn>8\x16\x11\x9a\u032b\x00|0\x91<^\x06_\xd7\x00\x98\xe8`\x14\x0f\x03\x19\x9e\x91\xcdN\x0e\xe8h\xff\x81Z6H}\x1b\xa4\xeam\xe3\xc6\u032f\xd7}{\xb4\x05\x88\xfa\x8a\xef\xbc\xd0G\xb3S\x80\xb3Z0\v\xa4:3\u04154E\x17\x81G$\x90\xf6\a\xd9 XLh\xa0\x1e=_%}+\xe9gY\xb0m\xf8(m\x1c\xce3\xac\x153n!\xad\x88}\x91J4\xc5\x15\x92\xea\xe6a\x13r\xe5\x1d\x85\xd4\u0696\x95IP!r\x96\xbdD\xe9\x83\xcetf!\x8eB\xceg\t0\x00\tB\x0f'\u386c\x14?\xe9\x8c\x05\xfb\xa2\xd7\xf6D3\xa0!\x8b\x9b\x81\x19O\xb8\x7f^\xaf\xbb!;'\xb4\xe8\xcfb\xc6\x18\v3\xfb=\xa9#[\xc8^\xb3\x19z\xb3F\xc3\xd4\u01d06|8/\x84n\xc8#\xa7$t\u01aca\x84\xcc\xd7\xc3\xf0\xf7\xf7#:b\x9d\x86\x1b\xe3w.\x90\xc5\x01\x8dJ\x03B\xd7\"]\x1cXbH\xb8D=49\xdeV\xf9\xab2AZ\x18NZh3\xef\xf4\x14\x04Na\u414dF7a\x01\xb0\x94g`\xec\x105\xaf\xa9\xdfp\xc5\xde^D \xf2r>\x9fO\xb2\x9a\xadU\xd4\x1f{\x95\xdf\xe0\x86\xa6or\x9a\x13\xf5\xf8\x9d\xd1\xf4\x92f\x04\xbd\xa0I\xa6\x1fe\x88\xf9\x88\xda\xf7\x97O3\xa2>\xa0%\x9d\xc7$\x1f\u7c65p\xf6U\xa6\xa9\xfbU2|.\xb7\xe9\xb5\xfdt\xfd \xadS\xefJ\xa6b\x1b\x905\xcb\"T\x1d\xd2L_A\xeee\x86\xa9+\x15\x14 \x92\xc1\x1bu<\x90\x02n\u00e6\xc5\x04x\x02tT\"n`\xb0\xe9l\u41e7k\xc4\xc0\xea>\x8f\x8c\x8e \xf5\x95\xde[\xees\xe0\v*\xbf\x198\x92^\xcc^K7.)\xc2\xc1b\xf9\u010b /*\xe4egN\x15\xa1F\r\xae\xa4\xb8\xa6\xd6#\xa2z@\x8f\xd2\\\xfb$\xddl>\xd4F\x05Y\x9f\xa8\xd6f\xa9\x8c\xbd\x96\x95\x91\x86\x18\xeau\xa10\x84\xd7\xeb\xc9\x1e/\xe09\xbc\x1bf\xc6\x1e\xb4 \xb6W\xee\x054j\r\xadc\xd7O \xf0\xdck\xad\xfc\u043c\xd6\x1d\x96U \\\x171&\ue1ac\"\x1b\x81\xb2\x91.9\xb3\x9bDl\xc35\v5\xab\x99\xbb\xbb\x8b\xeauT\xde(\xa1\xe9\x9eIP\xef\xf3 o\xaf\xa8R\xf5\xbe\xa8\b\n\xb6qJ/\x80g\x1c_\xee\xe9\xab\x1b\x16P\xe3uD\xeb\xc4\xeb\x0f\vA*^\xca\xdc=\xd0\xc2&\xca\xf7\xb6\xc2\xd4Ph\xaf\xb3\xb7g\x16\xaeq\xad\xf0O\x86\xf1qk\xc6\xcc\xe4wE\xbd\x1e\ufdfbD0\xc3\x19\x01\xb9Z\\\xbf\x1b\u04b8\u026es\a*\xf9\x80\xde\xe4\x1a\x10\x99o\u037a\xb6\x8f\xa7v\xb4\x0eW@R~@r\xc5!\xd4\xc2SgE\xef:\xbfp\x05\x05,jH\x9a\u061e\x06}O\xec\xb5{~CxQC\xc0<\x83|\x9d\xfc\xc2>\n\xf6Y\x92\xc3\x05y\x1c\xae-\x8e\xc96u\x98\x01d\x04\x1a\x18\x8d\xd1^\xbbKB\xa6\x17&\xac\xd7\xc3\xc2\xfa\xf7\xfa\xfa\x00\x85CX\xa3\x98@<0\x10\x93\xcf\x1a\xec\x9atG\x18K\x8a\x8dh\x04\xff$\xf0\x8f\xdf`\xa3\xbc\xe7),\x9e\x0f\x92\xe5/\x12\xf4\xd7&\xfbP{\u05b8^\xddi\xd6\x1btcDx\x1a\u06cc\xd9do\xda\xc3\x1d\x92\x80\xea\xcad\x7f\xda\xcbI\u0098xx\xe6.\xea\xf5\xc9>\xa8\xaf\xed\xf9\xf5\xfaE\xa1\xf9|w\\\xb1\x10\xceA\b=\x9d\xb3Y\xf7|\xff\xba{\xded:B\xdc\x19\x9b\xee\xef\xef\x9f\xd7\xef\xbaW\xecJo\x94\xb3!T\xc8\x7f\x81d\xdf\xfc\x82\xd2\xd7\xf5\xbb!\xbb\x80\xcb,\x81\x8e\xdd1\x84\xb0\x1b\x17\xa8\xa3\x98\xb6iB\b\r\xf6\u0644\x04M6\xa1\t\xfc3c\xd7T\xb3\x85\n\x954\x95\n\xb5\x82\xdcx9\u060f\xd2t\xb27Us\x81\xa5\f5C\xfd\x86\x05\xfb\xfb\xfb3E\xdd\u076c16Q?\x95\xa3\xae\xee\r\\\xd6\r\x8c\x876#\xcfn\b\x9d5\xb1c\xbd\x02\xfd\x9b\xe1<\xac\u05c3\xfd\b\xa9\x8c\xb0<\x88\x19\r\x9a#BhX\xafO\xf6\xa6\x852fv3:\xc1\"\xb87\x9a\xb0-\x9a\x96Bf\x95\xcaq\xd2\fhv*X`cc\x96d\xf8\x98\xcd2\xcd\xe40\xc3\xf4\xe3\xc7az\x1d\x10\x0e\xe4\xb5y\x18\x99\x05=\xccX\xebajm\xa9L\x9f.1r\x96\x19\x13\x80\x9bC\x16\x81l0\xc4\xd1\xef\xfb\xa8\xb9\xa4~\x10\x1a\xb83P\xd0\x04\xb0<\xac\xa0\x19+Qa\x98A\xc7\xdf\xcf#\x1d\xb1\x9c\xf7\xed\x13B\xb5v\xa3\xedn\xf9KA\xa9m\xef\xbc\xd7\xf68\xd2\xf6\xd7{{\xd7y\xb1\xbfe\x859\xeeO\xc0\x87\xfdE*;\u071f\xfe\x97\xedp\x7f\xb1G\xa4m\x82G\x05\xb7\u073f>\xa0\xfe\xff\xb7\x1dn\xadl\b\xd0S@\xf4\u06a0\xee\x0f*\x1ah\x1a\x10\x06\xc9j\xd3\x00\xcbN\xd8\"\xcd\xde\x02i\xf6V\xb2_\xa5\x8b\xd6b@\xb6\xd9syR\xb6\tPh\x1dTp\x02\xc6U\xb74aJ/C\x12:\xd3\x1bu\x8d\xb1\xa4\xab\xdfqgJ\xa1af\xa1t\xb5\xb7\xd8y\xbdn>7\x9e\xf9\xf8\xd5sc\x06\x0f\xd9\n\xad\xd6\x05\x9f\xa0\xca\r\t\xa8HKTo\xb5c\xee,\u075f\xe0\x80}NHk\x12\x06S7\xf3\x18n\xd3e\xf5\xba\x1b\u7fd8\rR\xab\x14!\x1e\xbc\x0e\x8d\x8a\xa6$4b\x8a\xc2\xd0l\xff \xa3)`\xa3\x18\xbe(\xc9\xe5n\xb3\xec\xa5\x1b \xf8\x12\x02\x8a\xe6\x19\x90b\xe6\u04c8\x99\xdah\u06e0\xebB\xb7\x8ax\r\xf4f5\xe5\xd4o]\xb8\xbb\x02)\u016a\xa0ZJEQ\xe2\x0e\x8a\x161\x0e\xf1~\x05\xa2\xd6V\xab\xfe\xd9f:!_/3\x81\x9a%\xfc]9\x9eF9$\xc6;\xe9\xf2\xaa\u0295\x85\x7f\xfc\x9e\xc2\x7f|O\xe1\xdf-9\u1670cS\xe5\x95\xed\x9aT\u29a9`6\xf39\x95U\xee\xfa\x8cu\xbd\u03b2\x9cZX}-k\xd3\xd4\x02\u0248\x84\x0e\n\x0em\xa5U\u0438NC'g\xb2\u0093\x9a]\xf8\xc27\xe5\xec\xf8B7\x82\xcaU.>s\x14\x80\u030e\xeeb\x98\x85j4\x89L[D\x93\xa8\u735cr\xb9\xd2q\xa6y\v\xa2\xff\xb6\\%7\xaf\xe2\u06b6\xda\xcb\x021\u0116Z\xf7\x1a\xb7\xf4\xba\xa5q\x9c\xd4o\x0f\xbb\xf9g\x96\n\x9e\u05f3O&\xb3\x8b}I\xa4\x06\x1c\xe6q\xef\x93w\xac\xa6fY\u0095\xb4\x8a\v;,.\xec\xabx\xf9\u049a\u054a\x8b2\xd4<\xb4.\xcb=\xa7\x9b\u0195\xa1Q\xe4\xe9\xf8j\x82\xfa4FaC\xbcj\x85\xad&\x1f\xb3\xc2\xf0<\xb2\xd78v\xb3\xeeT&>\xea\xe6\x84F\x85\x8e\xa3xe\xcf\x05\xd0~\xa0\xd1\x03\xeb\x1c1\xdfZ\xe7\xa8\xe0\xd44\xeaj5~\xb5\x9a4a`\u0313\xc9o\u0718&4\x1b2\x98\xf8\u0404r\x1c.!\x85\xb8y\xbf\x15Xl\x85e\x14\x19\xa2\x10\x85\x05\x15+|w\xf29\xe5x\xe6\xe15\xb5p\xf8\xd5\x16\xfd]Z\x9d\xc8U\x8d\xe9k\fv\x1e\x90,\x85Mb+S\xa9]\x02\u068d\xcdNSi\xec\x8a\xe2\xae\x13\xe5]\xa7\xcbb\xc9\xd5(\xa5\x80\x7fD\x19\xaf\x88*\xd1\xcf?\xc5'\xb5\xdcf\x84\xae\xa9\xb1U\u0275\x8az\x13\x05\u0721j\xe5\xcb\xcbE\x99\x16+\x9eT0\xbe*B*+\xb8x\xf4\xa25\xc6\xce\xea\xf5\xb5\xb5lgE\xfa\xecE\xdfs\x80U+\xa5\xf3\xdb\xf3\xbd\b\xce\u04eaC\x9csn\x10\xacH\xea?\x00Z\x9f*:\xc4:\xbe\x90\x94\xe9F6\x1a4r9\x8d{\xbe\x974\xd1\u058b\x00\xaf*\x99\xd3h\u5a76\x86R:\xd5>\xb1\u01f5x\xb2\rwU\x81'X4\xd01`Z0\xb1\x99\x81\xb9\u0788\xcd@\x8f\u051e\u0488\x86V\xbc\xa0onD\xe3^\xe8\xc1\xd4F\x80\xa4\xf0\xc4[\x04\xb2\x14E\xd4\xfd+wI\xa5+\x81J\x90\xe2\xc4\xe3\xcc\b4\xdf\b>\xe0\x97v\xb5ET\xa3\xa3^\x114\xb6\xe5U\xb6\u0369(\r*q\x91\xee\xc3 $\xbd\xbf\xe1\xbd\x03\xc3,\x8e\xb3z\x80\xc85\x8e\x16\x06\bf\xaa\xbc\xd2_\x84\x8b\xb1#\xfaC\xa2\x1etq\xaf\x1f\x80m\xa6O(W\xa3\u05b1A\xee\xec\x83\x13\x95(\xf0\x1ca\\\xe1\xb6se\xe9\xd9\x14\x8bE\xd3 \x14y\xdb\xde\u0316\xb1\xa5t\xf8q\xc1\"\x0fYT\xf4+\x1a\xd5E%\xfd.\x9f\xbdE\xdb:\x1a\xb0\u03f0&jW\xad1?M\x835\x16\x98\xc20\x14\x13\xa8\xb3\xe8\xb3[\x0f\xb0k\xa4\tA\xd3\xef\xce\x18\x9b\x81\xbc\x8b\xcd\xf6\u06bd\xb67S\xb9a\x11\x8f\x84\xda\xf3\v\x9a\xc0&\x9ey\b'&\xc2\r]\x8b\xebu_=\x16\x13\u0506r\xc3\"\u02b0#\xedJ\xa3:%\xb5\xea\x94\xdcKz\x1a\xc77|\xf4\xd61'4|\x14E\xa7\u0536M\xf8\x9b\xa4\xe0AR<\u02a3\xbb4\xb6\x8c\x01\x9a\xb9\xae\xb5A%\xfa\x11(\x1d_\x85i\xba\xe6\xceX\xd0h\xec\xf9\xd9b\x85\x8d\x06\u0554#`\x1e\xe1\x85\x10{\x82\xd4\xeb!\xbc+\u1604s\x1a\xaeD?\x86\x8c\u0117h\xbd.\x1e\xe7\x86]\xe6V\x99\xba\xe2\x02\xe5I\xd5$\x1f\xc2_8\xa1.\x89,\xec\xd5h\xcc\xf63\xf0~q\x89\x16bE%\xdf\xfc\xb1\x8a\xa6\x7f\xdc\xe3\x182l\xd6\xecP\xf8\xfd\xc9x$\xb2)UE\xac\x84\xf9!\x8a\xc4J\xaa\xf3;\xe8?\f\x82\xf6\x98\xd5\xcf\u0324\x82\x87\x17=\xb2,\xe5\v\xf4bB\xea\xf5F#\xa8\xd7c-\xaf\x83E\x0e\xbe\x83r\xfc\x9e1G\xb1=\xe8\x05\x9a\x93&\x10\xeeh\x95w\xfd\xb5\x8ae\xb4\xef)^\xb8\xa7x\u05e03uO\xcd\x18\x06t\nYd\xd9;d\xd0\b\xe9\x8c\xfa Jb\xec\x03n\x81\x18y{\x9cxn\x022\xb6/.Y Q\xfd\xd54\xcc\xf7\x90\fJ\xa3\xf71\xeb\x0e\x15\xb5\xb2\xc0Z\xe1D,\xa74B\r=8\xf3\t+P\x1ca\xee\xacsf\x91\x1c3Mr\x10:\xfb\x0e\x82\xe3{&\x12\xc5\xf6L\x16I\x952>[\xf2\xe2\xa4>\x1du\u01d1VlM\n!'H~\xb2\xa3\xecdG\x14\xb0\\\xb4\xf4dks\x11\x9d\xd6\xf5\xd9T\x117S\u0206\xfbf\xc6,\xfa\x06\xeet2\xbf\xb9\bBn\x19vD\xd9&\x02\xeacd\xf0\x85E\xea$\x05\x1d0MS\xf4\xf9\x10\x8c\xa9G\xbe\\\x8c\xe7\x97\xd3,\x187Z /7v9\x01\xbdW\xd1\xc3`\xa3\u078c\xbb\v1\xfc\xb8\xd7\xe7CE1<\xdab\xdd\\JQIu\x03i\x82\x8e\x9da\xa25\xc2\x13\x0f\x16^\xbbW\xae\xd7\x13\xd7\xd7\xd1\xdf\xea\xf5\x99\x9b_1*~YhB\xa6Z/\xcd\x1ep\xb7\v\xe1\xbbg.7\\\xef<\x186\xa1\x980\t})\xb9p\xd7\u0684h\x17#\xad\x98\x8fg#^f]\x16\x9c\xb2\x1a\x88\xcbE\x12'\xbb\xaaxC\xcc\u7136\x89\x8d\xe0g\xff\r\x04\x0f2\x80\xb5N\xb7\xa0$\x11f>(\xe0\xf0,E\xe0\x19\t\xb2&\xd34\u0713\x04\xdc\x1cp\xd2\xc3&\x1a\x1d\xe2\xa1i\x06`q\u044b<\xf0V>\"H\x1c\xad\xb5\xc1\x12W1\x04\xccA\aY<\xa1\xfe?e\x10\xc4\nY\xf7\x87\x8f\xb8\x8f\xfdn\xa6kk\xb3\x86:(\xc2\xcd\xd9Cav\xe0\u043b\rc\x1f\x94\x14\b8\xb3\x14\x94\x10]c!\xb1\a\x81\x04\xd7\x027\u0336\x940\xe8\xba\xd1@\x84\xddM\x14\xbd\f\x92}\x16\x96\a\xaeX\xb9>\u04ea\u1d8dC\t\xbb\x87\xa5%/\x90\xd99\x9dZ<\xa7\xea\x15\xa2\xca\xc4\xe5W\bQ\f\xf0l\xe7\xe6}\x8d\xc4\nf\x87\xc5\xe2\xa8\xd77\x9eq\xe3\x02_\xac\xdav\u05a5\xa4\xbc\xf9>|Q\xc4\xf9&\xf3\xd3\x14\tA\xe1Jt}\x1fA\bD\x9d\xc2u\u029c\xd0\x18w\x91X\xb9\x8b\xf2\x81\xd0\xd2>:\x86\xfa\xc1\xc3\xc1A\u0735(M\x83\xa7\x1b\xb0\xa1s\u0593\xc2\xf1\xbd\xc8\v\x9en\xf4\xbe\xb9\x82\x06\xa8\x01\xeb\x99O\u00c0\u049c%\x8b\xf50\xcd\x16\x16\xa40\x92\x1d\n#Z\u05c8\xb8\x8d#\xad^`\x99\x83\xa9\x1fSI#\xf0\xe0\xd2\xc3t \x16$\n92K\x1435\x1f]\xee-\xe7\xad\xf47\x87T\xf47\x87\x10P\xb4\xbf1l\x8a\xfe\x066\x94\xf9\xda\xe8\x15\x91\x9a\u07d7C} \xd8\xc6\xdc[\xccdp\x9d\xc1\n\xf5\u07a1\xb1\x9c\n\xe7\xea\x13\xef\x0f\u05f7\xdd\xc6\xd8\xca\x7f\x194l\x9f\f\x0fCAR3{\x1cr\x05\x16\u03a7z\x01E\xf1\xa6\x15p\xb4\xc1\u0547\x15f2\xae\xd7c\x10\x13Y\xac\xf8\a\u06e2\u00b4\x92\xcf\xea\xa2tr\u0462\x91\x14\xac\x8a\xeau\xa1\x9f\xb5Jv)\xd2T\xac1A\xd24\u07b7B+\x8f\x85\x96\x8fV\xd3wx2\xf5U\x16\xaf\x90}\x99\x8b\xb5u\x15\b\xb7\xc0KZ\xa1\x95\xad\x15\x98\xad\xe3\x82\u00aevEp\xad\\K\xdbl\xfaE]\xed\xe8!B\x8e\xe7\xe8>^>\x15@\x00\x84\xfe\xeaF=KR\xe2q\xf5p07\xdcJ\x9aM\x98\xec\x04]\xc4\xfa+\xc0\xa6\xe6\bM'L\xb4\x92\xe8\x8a/)\b@@\xe1w\x92G\xc6\x03\x10h\u044f\xb2\x13[\xd1\x11B\r\x1aX\xa0\u052eJN\x841\xf8\xb1,\xb9\xbd\xb1\xb5\x8c\xae\x85q\x11\xbef\x82\x1c\x17=\xd7W\x05\x16\uffe3\xb5_\x8659\x9b\x86\\E\f\xcf\x1b<\xb7\x87\nN\xb5\xb5\xf0\xf1\xa0 \u06fe\xb3K\xa1\x89T\xacN}\xe4Y>W\xce\xecRE\u1dcb\x95\xdeiT\xe1\xfdA,Yx\xde\xc0\u0342)\x1al(\x9b\xc6\xef\xb9\xd5\xe9.\xa1eS',\x92\xf9|$\xde\u03f6EU^S]\xa2X\u010a\xdbZ\\\x17\xbe/{\x1d\x8f\xefIpcg\x1d\xe0[\xcb5\xe9\xafy\x98w|\x0f=yx]\xf28\xefq\rUY\x9aap\xa9W\xa8\v-B\xf3d\xbe\x18\xdd\xfd\u053e\u0429\xc5\"\xd7f\x9bvp\x988\xe7\xacV\u01a0\x8d\v\xf1\ac\xd7W\xb3\x10\xe4^\x98\x00\xc1\x81\xd1d\x80(\a0\xcdW\xc2U\xd2 \x05\xef@_\x16\x80\x02\x84\x7f\u0155\xca\x02\x14f\x01|\x8d\xf9\u011f\x85\x12#j$\x8c\xcf\xd5\xfa\x80\x8c\xfaW\x0e\x17\x06\u01ad\xcc\x16\xa7\xa46q\",\x94h\x1d\v\x16\xd3x.\u077f%\xfd\x95\x13\xfa\xf7c-!\xff\xfe\xa7\x96\x90G\xf1\x98\xc7|\\\xf05\xfe\xf7j{H;\xf4\u07d5?\xd5\x115\xbaeu\a\xa1]\x90\x83\xac\x1e\xcb\b\xd0\x19\xf0d\xa9\xfd\xff\xaa\x93j\x18\x8eV\xb9\xa3V\xefv\x12A\x1d\x9b\xd2 \x96\xda\\>)\xd8\\\xfe\xfd8\x9b\xcb'\x05g\xe5\x85J\x8f\xf0x\vs\u020b\xb9$M\xad\x99\xd8\x19\xa5\xa6\x1f\xf6i[\xf4g\x8b\xedU\x11\xb5E\xd5\xd1E'\xb6K\xfa]\xe6\x8a\xd7\xeam\x12GW\x87B\xc6w\x8a<*\u0154\\hw\x99]\x94\t\xdb^\xd8\x0e\v\x06\x16\xd2\u06a2\x85\xb6\xe0.\x16\xcc\x1aV97\xa3\xf9{\xbfJd\xcd?\u04a5\xae\xe5\xa8\x16\x1b\xd6\xee[\xd5\u0102$?j\xec\x17Y\x98\xab\x0e<[H\xba\x1a\xb2\x8a\xedF\xa5\xfb\xb3\x84x\xc4?\xcb\xc7\xc7\x03\xc0+C\x87t#\xf3b\u0555\x91\x87\xb1\xa22\x1e.U\u00fb\xff\x84\xff\xbdt\x17cUS\xca-W_\xa2\x7f\xa2\xee8*\xd9o\xfa\x04\xad\xb5\x8b\x0e\u00cd\xca\x0e\x10\u076b\xc6\xc0\xf5\x00\f!\x9e\xab\xa7\x10*Kc\x81U[\xe1\x05\xfa\xdf\x16\x06X6\x96x\xd5XDi,@P\xe1B\xa07\xe8\x9f\x1f}\x84i\\q\x88\xb1\u5b1a[\x18X\xafZ\u012c=8\xce=\x17,:\u0385\x8e\\\xd0\u038dF\"\xabpD\xc1\xeaCx`*\x19\x9b\xf3\xff\xf3\xa3\xce\x7f0)\x8e\x87,\x1f\xf9\xb2\xa8\xb2\v\xa4|\\\x18\xf2C\x1a\x17\xe5P\u0091y\xfe\x02\xad\v\xf3\x8a\xbdx\xf1\xd5\xfb\xb3\xac\b\t\xfdNB\xbc\xcbw\xf2\x91\x81Y\xad)\x98bx\x88\xde\xc9\xff\xd0\vyi\xc1\xab\xb06\xac0L+C\xd7\xef\xe4\xf7F\xe1^\x02\xfb\xffZ\xecfcu+\xdd\x1f%\xfd\x83\xd0\x1f\xbf\x0f%\x15@\xfa\xe3\x7fr\t>\x12\x9c2\a\xe6\x8f\xff\x15`\xfeG\x80\u0300X\x06\xe6\x1fx5\xfca\x8f\x90\xeb\xebv5\xa2\u05b8i^\xac\xfb\xdf\x04& \x03r\x7f-\\}\xcac\x16\xb89\x18\xb8\x1b\xf7\x14\x91\b~&\x80\xe00\xbf\xdb\xc43\xb4\x87^\x80?\xfe\x0f/\x80\xad\u04b1:\x12\xb5\x9a\x87\xb4\x98\xb08\xc3\u0608e\x037\xf7\x96\n\xeb\x16\xf5b3'`\xd9d\xbf;\xf0\xbb\x83v7J\xbf\u376c~\u0531\x9f\x97e\xfc\xb8,\xe3\x8fe\x19\xa0E\xe9\x9e\n\f\xb5t*\xfe\xd9\xeb\xe1@c\u2193?\x1eN\xc5c\x8fr\xe1\xfd\x04E\x8fnD\x1eU\xae\xdcT\xa5_\x965\u02fdIq]\x04\xab\xe8\xc4\n`\x98Ql\xbd\xd2CF)$\x14\xfb^x\xa3\x04\x93\x12\rH\xca\xcd\xd6\xeby\xbb\xf6\xf3\xa3kyc\xb2\x9e}\x16\x9b\x99_M\xe5\x1dx\v\u05df\xecRA\x19C\xa4\x902X\x92\a\xc1R\x8ec\xf5\x83\x8e(\x96pY\x9b\x89K\x11\xdd\b\xf0\x04\\s~h\xf0\xc6\x0f\x0e\x04\x91\xfb\xa1aVV\xed\xfb|Rk\xf9\xacT\xf3\u02b2\xac\x1a\xd6\v$\xb9`\x95\xf0\xc9\"\x19V\xc6$IS\x91\xf5`\xd6\xcb30\x11exT\xbc\u0296\ud4ca\x87\x82=*\u0552\xad\xdcX\x1a\x96\\>,Y\x1e\xd6?x\xf7\x95Zx\x04\xa6\xeb\xe62\x94\xc5\xf5X\xe0\xb4\xda\xd1R\xb4I\x04p[\x17\x1ee\x95\xa3\xe0\xff;\x83\xe0\xd5c\xf8\xaf=\f\xed\xf5^\xfe\n\xbc\xb4B\xfb<\xe2\xf1\xa7\x1d\x7f\x9d\bf\x0f;\x97`^\x968\xedE\u0190\xfeu\xce\xe5'S\x15\xc3S\u06c2S\xd5W\\\xb0\x9f\xb1\x84p\aE>-2\xafJ1\x18Z*\xcd9\xe6\xa3(\x1e;y\xddWzQd|w/3Q\u0291h\x9d\x05b\xecfv\x16$\x0f\xfah\xd9f\x1e\xe9\xca\xcb\x02\x85Jz\x7f\u03a5\xb7\xe4\x04\xe8\u59c9]DEC,\u009c:\x16\nC\x95\x92Z\x16\x98\xb1\x16\xe3\x8cZ\x8e\xe1\x9cB\xa3\x94\x03\xf5`\x85\xb5\x15\x15vX\xaf\x85K<\x88\x12g\x85E|-\x1ec\x8e\xe5?\xc6\x1c+\x9fk\xcb\x1f\x8f\xddES\xac\xb7\xa2\xc21\xdaG0\xa49\x11\xc0\r8\x11\x86\ap\"r\xbf'Yj\xc9\xef\u0249\xd0\u038f\x8c\xbf\x92,\x05\x1d\b\xbd\xb3|\x1be9E\xbf''\"wtR\xf0zR\xc8)\xb4f\x12\n%\x16}\xa6\x9c\x88\xb2'\x97\x13\xb1\xe8\xa5%K+yi9\x11\x0fy\\9\x11\xcb<\xae\x9c\b[e\xb5\xecqE\xba_\x05\xbd\xe4\x84~\x15\x8fd\xbb~\x15\xc8\xd3B+\xa2%t\x8e\x1b\xbb\x9c\x00\x8bYib`\x9d\x7f\u01aa=\xe12'\xb3\xbe>\x9a\xcc\xca\xef\xeaR-\x7f<\xae\xaaul\xb0]\xe9~\x06\xad\x91R\v\xcbY\xa0\x8b\x8dd\xd7i\xb9\x91e\f\xe0\xc5&4\x1dUn`&\x82H|W\xa0P\fG!\x99\xfc\xfep\x14x\u02f7\xd7,\xd6t\x9a\x16\x91\x93\xf2\xdf.\xed\n\xff(&i\x83\xf8\x18L\x94T \x13\u06e0\x01\x90\x89q\xb3\xe8\x95\xc9JWbl\x89\"\xbc\x02!y\x9c\xf0\x91|\x1c\u0334^\x93\xacrI\x030\\& \xf4\x15\x8e\xeb.y-\xae\x80I\x15\xfe\x14\xe4^\xb6\x80\x8bw\xb7D\x1a\x99\xf1\x04\xd0I\x06\\wz\xc7\t\x83i\x8bPHfg2\xf6\xff\xff\x10\b+$\xbaE\x18\xd4\xeb\x0f\xc1`\x99{<\x1c\x1b\x1e\xabe\xb1\xad\x17\x9b\xf9~\xbft\u057d\x88\x85\x85Z\x12\xac\xf1\xbd(\x06k\\\xa8\xb4,X\xa3UQVT\xfdG\xa4\xfaW\xf1\x1f\xf0M\x14\xf5Y\xc16\xb1\x8d\xa2\u0718\xc69\x17\xea\xab\xf8n\t\f\xf4\xb2\x9c\xfa\x96\x95\xb4\xffW\xf1\xbf$\x90\u0249m\xdd\u0215\x7f\xc9\xddG\x13\xdc0\xd0 9\u14bdU.q>\v\xfaQ\x94]\u051d\x1cj\x0fu\x9f\x04\xfbZI\x9a\x1f\x17\x81Re\x8d,\v\xd6\xc88B\xe5[\x05_\x81\\\xb9XSBK\x04\x19<\xe1]\xe2\xf1lN\xb6C\xa1\xc2\xc6(\xbe\x03>\x89\x05m\xb6\u071a^\x18kza\x01F\xda\xfaZ\xaf\xadM\xfb\x19\xe2\n\x7f\x16\xec\x83p\xa3\x92\xcd\xfc\xfb*J\xf8o\xa0\x84_\nE\x02\xff\xfd\xbfH\x02\xbf\xb4\viR\x1c\xbd\x11|\x12@\t\x03'\xfe\x13\xd2\u009f2Z\xf8\x93M\xa5\x9a\x1f\x85TDL\x9f,\xe2\x17r\x1f\xa0 ?-\xa5 ?\xad\xa4 ?\t\xb3\xe4\xec\xb5P\xbf`\xd1\xd9\aA\xa5\xfb^\u042f\x82\xd0\xf7\x8f%.\xdf\xff\x03\xe2\xf2\xbd\xf8\xcf\xf4\x00\n4\xe6{\x91K-\xe1l\xbdTg\ub360\xdf\x04{_y\x80\xbe\xac\xd8\xd1\xdf\xfe\xb3\x1d\xfd\xb7\xed\xf0\x15v\xf4\x1b\xc1\xbe\b\xf7\xa7\u048e\xfe\xa5jG\xff\b;\xfaW\xbd\xa3\xe1\x87qo{\x10\x86Eo\x17U\x8f\xb0'\xf0\b\xfb&\xb4,\xe8[\xbe\xc6\x7f\v\xfa-[\xe3/\xb0\u01bf\b\xf4`\xfd\xcbc\xd7\xf8\x97\x7f\xfc\x18\x00\x9f\xe3\xb9\v\xeb_\u0123U6.\xb8?F\xaf@\x96Okp\xc4\u011bM\xe5\x88\t\x18\u07b9\x86\xb9\u058e\xf3d\xa9\x93)\xe7\x97K\a\n}\xd4\xeb\xf9\xb7j\xa3\xdcB\xc9k\xb2\xa6\xb1\u02b1\xd5\v\xf7H\u025f=\x86\xe4,W\xc8\xfc\xdbC\xcfT,4\xd8\xect\xc5>\x90\xdb\xcd&\x91\xec^M/+\x04.\xed\x00\x02\x9e\x9cW3\xfel\xbd\x13N\U000de634\xb5\x04\x1e\xf2\"\xfdN\xe8+v\x01$\aaX\xbe\\\xf1\xbd\xc2\xd1\u07fbR\xdc/\xc0$Wo\xb3\xaf\xd9\x04\x8d\xbb\xed5_t\xd6P\x8d\xa4%\xc8\x0e\r\\\xb8\x02\x06h\xf7\x13\xba\x1c\x10\xd2\x06\x84\xf8N@\xa8\x10\xf9\xbf\x88\a\xfdU\xab\xfe\xd0QY\xa7\\\xa3\xc2_\xb5]\v \xbb\x8c\x98\xadj\xa7\xbc\n\xe5\xb64\xe2(\xd6]=\x82h\xfa\xa8\x01\xfcW\x15\xa2p=\x8a+\xf0\x98E\x01\x14Y\x9e[\x85\xc3\xe7`\xe2*\xe7\xceY\u01e4\x82\xe7\xff\u05b5<\x9b\xe0S\n\x9c>\xe7)\xf6k\xdaT?\xe1\xe5\xaes\x85F\xa5\x8aa\xc5t4\x95\x9b\x82F\xf6\x86\x17\x80\xd1\"m\xb4\xf8\xf0a\x8em\x98E\u07f9\x87\xd1L\xa2\b\xb1\xff\xc0\xb1\xf3;\xb1\x10\xdb\x1b\x91\xd9?\x8f\xed\xfd\xcbc\x1e((\xa4\xb5\xe1\x94c\x8a\u04dc#_\xf0\xe0\x9e\xe9\\+\x14\x93)Uk\x95\x18\xe5b[)U\xc7,.\xac\x84X2\xaa(\xfe\xfeaE\xf8\x88\xa8\x1e\xd3\n\xe3\xc88\xf3\x11\xae\x85\xb8\xba\xb0\x19*\xb5\xbc\x84\x97\x8c^~\xc1\x17\aF\x01\xf9U\xd1E?\t\xfad\xf1\xcd\xf1\xf9\xe0\xd5;\xf5\xea\xf8Y\xb0_*\x89\xa6wb\xb5\x8b\xad\x9fEe\x84m}\xe9\xfc\x83\b\xdb?Zh\xe5' \xa5~\x12\xec\x9d(F\xd8\xfe\xa3@:\xd8,\b\xebh\xf6\u0150\x01\x93*\xbb*m\x8dZ\x99\xdf/\x82\xd0\\\xaea\x89yO\xee\xae\u03a20\xa9\xd7W\xe5\x96\x1a\xe2\xf3\x9f\x05\x90dp\xfc~~\x90\x92\xffy)%\xff\xf3JJ\xfega\xbd\xf0K\u07b7E\xeb\xad\u0668o\xe8\x1f\xc2\x15\xf4^\x1b\xaf\u0622\x8d_\xb8ka=E\xb4\xe4\xc17-\xfeaI\xc7-W\x9a[\xc2\x14\x80%@\xc0\u00d5\xcc\xe7TFo!V\x0fZ\x00V\xc8V\xa0\xcfwR\xab\x82S\x19\xfd|\xb2L\x00Sm\xb6\xc2\xed\x80\u010eIv\x18\x83\r\x10M\u042a\xeb\u74de\xfa\x03/]\xc5IP\xbfT\x87G\x1f\xffOty\xf4\xb1g>\xaa\xba5F\x91K@\xf2s\xaeb\b\xa5?\xf8\u04ca\x82\xbf\xea5\xcc[\xc3W\x96\x8c\xd4~}h\xb9\xef\xcb$\xe4\xe3\x963W\x14\xad\x18\xd2\xdfr\u0650\xb2\x87ZE\xad\xf7\xc2M\xb0\x1e\xe9\x956\x99\x97m\x8c\xea\x9a_\x1fWs9\xa0\x7f\xb4\xf6^u\xa9\x99\u077e\xbd\x9b]\xe2%\u017c|\xce^\xb6\x7f\xa4\xd6]\x82\u01d1?\xba\xac\xe8\xe0\x97G\xcc\x01\xa2\x1bTT=\x91\x8f\x98>\xbe\xc9\x16+;\xfd\xb7\xda\x14b\xe8\xcc\xe9\xe9\xe9bI\x9b\xd9T\xa4\xefxCz\xbc\xe1\u051c\xc6\xc29)\xbd\x05?\xf8\xd3)\x8fI\xebk\x14\b\u05e15\x87\xa8js\xaa\xec\xa2+&u\xa5\u065f\x89\xfe[\xc1\xf1\x86=e\xd8\xc9\xde2B\xb8\u010a\x96v<<i,\xb8u`/o\xe9\xa36\xb7a\x87\xd2\xc0\xe2/\x01h\xf1h\t\xcb\xeb\xc1\u04a3\xa5\x9dC+\xafj8A\xa9\xfd7g\xa6!p/\xae\x81;\x1b*\xe6T\t\x83\xaa\x17\xc7@\x8c\x8b<\xe0\x9d\x12\x88M\x021\xf6\xcaQ\x01,\xc2\x14\xf2Q\u7f60\xe2\x12\xf7@\xe3\v\x14\x8d\xf4\xcdV\xddma\xe6\xb44S\xd9\xe3J*/\u0456\x8c\xc2\x06(,U\xb16\xcf\xdd]\xf2\x9e\xe34\xb8\xe7PG\xbf\x18\x1d\x87>\x0e\xa4\xe4^\xf4\x00j\x9el0Ne\x03\xdd\u4bb1\xb8\x17g\xdc\v\x97x\x8e\x03 \x95s\nt\xc0#\x16\xfe\x13\x99\xd3+\x7fZ\r\x03\x03z[\x11\x9b\u03292s\xac\x86<\x8d\xba\x95\x00,s\x02\xf66z\x11[k{q\xfev\xaf\xc2\xd0\xca\tQ\u03cd`\xbb\xc4L\x12/6r\rAc\xaa\n\xc0\x8cc3\xac\xe3\xe0\xfcB\xae\xda\x15\x05\xf7\x9f\x199\x9b\xabx\xa8f\xf4{1.<\x16u\xe9\x15\xc7\xda\u049e\au\nx=\xad\x06m\xbc\xb0\xab\xe1dW\xd6Q\x9aTJ\x10'\u0454\x02U6\xa2XVa\t\xd3AAP\x83(\xf41\xdb\xe2\x98\xcc\xe9\xd9L\xbe\xf7\x93\xa5:#89W\u01ef\n\x92C`\xcbU\x94\xcdv~\x8e`\v\xe8\xd6[\xab\xc2g\u065c\u06c8\xc80\x10m5 \x0f\\\xde+\xe0i-X\x862\xe6\x9e\xc0\xea/\xef\xaa\x1b\x90\u00b63\xe2\x7f\xcf\xfc\xb0\x12\xed\xdeq\x03H\x85X\xefJ7j\xce\x1bS\xbe]NQ\xe5\xd36\x85\x93<O\xee\x1a\x81g\xc16!&\xa5K\xd8\xf2\xffi\x19\xf0T\x9a]d\x8a\xc4\xd2\xe0\u04cf\xd1\x12\x98i\xec\x88p\xcawR\x86.\x97\x9c\x1e\xf1 \x8e\x12\x19\xda\u03f0\xbe(`\xfd\x98\xf5#*\x86\x1a\xf1\u01ea\xcfw\xbc\xbc2\x16\u03f4\x1a\x87C<?\xb0\xbc\xc6\xfa\xc5MZ\fE\xb2\xe2\xc4c\u04fax\xdeP5\x00\x1e\xd9Z6\xd0B\x93\x8b\xf3{ds\xef\xf8\x9d\xe6TN\x82x\xf9A\xc4y\x1cA\xa9\u0417\x1f\x1e\xc2\xe5\xa1(\xe0r\xed\xdea\x15\x06\x99e5\xf4\xb5k\xed\xc4%\x84\xe7\x1f\x19\xe1YP:\xab\u0603b\xbc$\x8e%\xc6\xf5\x05:\xc6D\x8a\x93\xaa\xb5\xb7\xc2[m\x83N#\x86V\xb4hw\x1e\x15\xec\xce3o\x81\"W\x1cwc\xb0\xaeGmp\xad\x13\xee\xd33\u2751B\xa0\xf8y\x1eA\xec<\x8ef\xd3e\xc8D\x14\x90\u0245\xbf\x9c\x80S\x1a\xcfg\xc0\x82;\u00d2o\u016a\xb2o\x85U:HNfge]\xbd\x8c\xb3U\xf9j3\x04%\u011fp\rASV\xeaX\xe6\xad\x13)\xd2\xe4d6\xe5qu\xaf\xee\xb2^\xf5@u\xaf$K0\xdb\xe3\x92\xdf\x1dM\x96\xce\xdb\x1c\x82\x87\xe8\\%\x85{\xccC\xf77Q\u01b0s\x1a\xae\xb8\xe4\x16\xac\xd8Zx\x14M\xb5w+\x87\xbf\xe4h\u3711\x83}\xe5\xdfVU\x9e\x88\xfc\xa6\xb9\xf2o\x97\xed\xb5\x89\xa5I1\xa7W\x81X\xd9VO\xc4.'\x9e\x1f\xab\xb2\x0f6\n\xe5%\x94\xc7\xd6c\xfe\x10!\x00T@r\x19L\x97\xbfX\xb0\xd8\a_^\xb4\xae\xfc[W\xa9\xb2b\x95\x12\x02_@@KV\x02\xaa\xba\xdcJ\xd1\xcd\xfd\n\xee\xadV#A\x7f\x91\xea\xba\f\xa6_\x84\f\xc2\x15\xd8*k\xbcD~\xbd\xbc[\xdd[I\xe5E\xfa\x97\xfc\x01(\xb5i\x19NP\xe9\x1f\xc2\t\xaa\x96\xe1\x04i\x8f\x80ST\xbc,\xa0\xd6C@\xcaZ\xb6\x80d^\xee\xcbOY\xf1D\x02\x17\xf5U4\xe6\xcb\tU(\x01\x96\xa8\xf9/\x16(\xb5w\x02\xfa\xc6H]\xfd.\x98\xcd\xf3\xfd]\xf4\xa7\u0239\xfc]\xf4_\x0e\xd9\xef\xfa\x0eH\xe8\xefB3\xaf Ms\x13M\xa2\xfd\xcegq\f\u0241H\xa6|$U\xe1\x93h\x16\x8f\x96\xeaq\xe5\xef\xb29\xd4\x1c]\xf8\x81\x80z\xfa\xa2\u01b4HH?\x10\t\xfb=w\x9f\f\x8c\u0358\u0783\xcf\xe6\x15O\x8d\u07e5\x99/<\xe0\x0e\xcbO\xfd\x15\u6315\xfbe\xb9_\xa8\x8c\x96\xeb\xfb4\x1af\xf6\x8d\xa4dS\xae\xc6\xf1\x8e\xdf-\x1f\xc4\xca\x01\xe8H\v\xc5q\xc44Z\x1c\ap\x12\xf4\b\xb0N\xb6\xe2\xff\x16,\xae\xe2\xf2\xffVV\xb8\xcarxl\xe7\xf4%\xe5\xc3<\x137p\x86\x18\x17\x9e&|\x89L1o@\xacl\xa0\xf9p\x03\xb1\u0540\x93\xe0^\xb2\xae\xd7\x1e\xf0_[*9\x98\xdc\x01z\xd7\xfb\xcd\u05ab\x88\xe2|\xef\\\xda\x1a\x10Y\t\xbf\x00\x84\x1ax%\xe9x|\xbf\xec\x9b$\x88\x8d\u0099\x92\x84\xa0/\xdd\xdc\x0f\xacz\u040c\x94\x89?z\xa6\x02\x8b\xe1Nn*\x9c\u012e\xed\xcaK\xf4d\xc9KT\xcc6;\xcf\xe2\xc6,v\x8f8\xd4?\xe2\xae$$m\x97\x1dF\u016c\xb2\x90\xdd\\\xd6\x18\x16*6\xa1\x1a0\x19\xa4\x10M&)\x82B\xb2\x03\xeeJ\xba\xb9\xb5\xb9\xf1\xa2\xf3|s{\x93P\x95\xb4\xb7\xd7\xd9N!`V\xb3\xb3M\xb7v:\u03f7\xb6_\xb4w\xad\xdcM\x9d\xbbI\xb7u\xaa+\x99+\x1b\x9b\xcfw\xb66^lt^\xec\xa4m\xf2''\x7fB\xb1\xce\x0e\xdd\xd8\xd8\xday\xbe\xb1\xb1\xad\x1ay\x85\u0161)\x95\xbfI77vv\xb6\x9e\xbfx\xd1~AL\x1dk\u0633\xd2\n\xfe)\x1b\x1b;\xdb[[\x9b\u06fb;/\x1a.\xdf\xdb\xdb!\r\x97\xef\xefo\xc0\x94u\xa9\x7f\x8b\xfe\x04\x11\xe2\xbf\rB\xd4\xfcB\xfa\xef\"FT\x1cw\x93Z@\x89\x95\xf7@i[J\xd2p\xc0\x05\rn\xe69 \xb7\x88\xde\xe7\xb4\xd1CB\x82\x0e\xf9\a\xfc\xc1\x8e\xe1\x0f\xe2\xc5\xf2\xdd\xcf\xcb\x1e\xbc.\xbdf\a\x18\xb1c~[\xa2\xf1,U\x0fC\xcb\x15]\xb0`\xfc\xcbf\u01d3\x8aJ|\xbb\xba\x8d\x8c\x90\\\xd5\xcew\xb0\x9e:\xff\x84\xf5\xa4*M+j)h\x95\xf9wh\x9c\xc8r*%\x95\xe0\xa3\x11F,\xd2t\x83\xa1\xbf\xed\xb5\x82\xe8\xb8\xcbQ\x1d\x81\xef\xb5{ZW~&d\x16\xec>\xe3/\x1b\xa5\x02M\a\xe5 1\xc3\x06_\x8c\xa2\x17{\xb1q>j!5\xbaA\xa8U\x9d7$!\xd6K\xfc\x11\x9b!\xe3\x01,\xdb\x10+\x9f\xe2\xca\xc4\xf8{_\xd5\x1d\xb2\xe2\xa1l\ub291\xbdv\x9af\xf0R\b8M\x179l\xf5:\u07d7\xb9\xbe\x8a\xf7\xd0[[0\xc6x\xf1\xa5]\xfdt-\fF\xb9\x1f\xaf\xe0\xef-\x8c\x90\xefeI^\xb3c\xca\ua8e5\xecR\xd02b\x1a%|\x15\xccF6/\x13k\x84\u073f\xe6\x8b\\\xb8>\x14\x1bVl\x10\x02\xd8u,\\\x9b\xf2\xa0?\xb6\xa2\t\xc5kK\xda\xee.s\u079b\x82\xa9\u05b0\xd5*\xc6\u03f2\xa8\xfa\xf4\u02b2\x88]\xfa\x14=\x84\u075c\xef\xf5\xd5\xcfN\xd8G\xcd\xce?|\xcf\xe0v\xfa\xb6\x92v\xd4\x10\xa0QLW\x80J\xb7\x03\x8a\xbb\x15\xb8\xcb.\x9a\x81\nb\xe4`\xcb\xe5\x9e\xd4\v\x02\x03S\xe4*\f\x17x\xfdD\v>?\xfe\x10\xae_\xb2W\\xg(\xbb\xaa\x1ejG>(-3FX\xab\x19\x05\x96\u03dc9\xa1~\xc9\xca\xcb&\xd0\xed\xbc\x8c\x82\xf7+\x1c\x94\xc0T\xdeQ\x8b(%\x90\xf2#\x8dJ)\x7fP\xbf\x94r\xca\x17\xaa\x9d\xf0\x85z\x97\xbcX\xf1\u0788==\u0406\xfd\xdb\xfb\x99\xbe\x8a\u0090\xe3l\xbd[N\x81=\xf8+\xa7\x96\x98\xfboIQ\xfez\"\xa9\x92\xe1\xa2\x1e\xad\xf4\xbe\nj\x89\xb5\xdf\v\xaa\xecU\xbdSA\x8f}q\u03bdCN\x8f\xf9\x94\xfb\xd2;\xe34H\xbcs\x8e\x9c\xc1\x9fO\xbc\v>\x9f\u00d3gs{\xb7\xb3\xebq\xb6\x7f_\xc1\x1c*\xe8\xd9\xf4x\x8b\xdfN\xa3X&%\x8a\x02<^\xf2V\x02\x9c\xa7S&)_\xea\xd4\xcd\xf2\xb5@\xef-3,/S\xa6\xe4bv\xa5a\xb3\u05a17q \xd5w\x1b\x84\xb5\x93\xe0|\xa6\xf3\xda\xe8\b\xc2[6\"\xe5M#\x1fS\xb7\xa4\xa5C\xee\xe7]\xeb\xd9\xc9\xec\x81\xd9\xc3\a\x1aGP^\xdcHf\u050c\xcf\xe7\x00\xbf\xe7\x1b\x9b\b\xbf\xea\xc1\xd8jK\xd0\xdc\xcb0:\xcb\xf1q\xdc\xeb\u01d4\x0f\xc1\x9f6\xbd\x87\x0e<\x91\xa6\x0e\xbc8\x82\x11j\xe8\xacG#\xc9e3\x911\xf7\xaf\x9c9)\xb8\x97\xbe\t\xc48\xbai\t\xff:8\xf7e\x14\xb7\xae\x92\x13\xff\x9aC\x17dE\x9e\x1b\xc1\xad\xc9\u00c4kN\xab.\xfb\xe5\xf8}\xbd\x9e\x7f\xebUSK\xf8\xe5\xf8}oy\x96\x1b\x11O\xe7\xde\xf0\xb3\xcb@V\x97\xa1\x01\x1bG#DG:\xf70\xe4\xf0\xcbu|\x87@(Ay\x17\xf2\xd68H\xa6\xa1\x7f\xc7\x1c\x11\t\xee\u0420u\x11\xf3\t\xf3)\xc6\xc5;\x902\x0e\xcef\x92\xbb\xce8\xba\x11a\u43ddBt\xe0\xa0e\xd2\xeb\xf5r\r\xe9\xc7\xe7\\:\xd49=\v}q\xe9\x10\x9a\x8d\xe7,\x1a\xdf\xc1S\x8f\x8b\xf1\xab\x8b \x1c\xbb\x01\xa1Ak\x14\x06\xa3K\x97\u0404\xcb\xcf\xc1\x15\x8ff\xb2 \xff*\xd6V&\x17Ym\v\\1\xbf\x8e.-P`4\xad\x8dv\x1b\x1c\x9a\xd0\x17\x9d\x8d\x17;\x9e\x96H\xb0}-\xc8Y\xffs\x904\xd2A\xd2x\xb2~N#\xb6\xfeg\xbf\xd9\x18\xb6o\xfb\xed\xe6\v\xbf9\x196\x9e\xac\a\xd4g\xeb\x7f\xb6\xcf\xfa\xed\x8e\xfa\x19\xc0\u03e8\xdfn\xee\xaa\xdf\t\x9b\xfaq\xc2\xdf\nIg\u0309\xb0\xf7\xfcl\x8b\xd6y\xbdn\xfei\xa9\xb11\xe3]\x14\x13i\xb8X+\xe1\xe1\xa4^\xcf\xff]\xac\b\xa9\x10\xb8'M\xc34}c`\xe5X8\xdc!.\xa1S]\xa1B\xbf\x9fN2\x9a\x95^\xe8\xcf@\xd0*{\xb3Q\xeb\xb5/yKD7.\xb1B\xe9_Y\u053b\x1a\xb7Q]\\[\x03\"\xc1\x9aV\x9a\x16\xf0\x9e\xedtU\xbf\xa4\x1d1\xbb:\xe3q\x0e\x03^\xf0]\xbfx\x9d9\t*\xe6Y\x15\xd2t\xb1\x14\x8ed\x01\xbe|\x8eV=N_e\u0514\x8e\xdf\xd0al\xaa8+\x9c\xcc-'\x1d\x1f\xfd\x8f0\x06\x98\xaf\x99p%\x8f\x1f\xf1\xeb\u0464\x97}\xb9\xc4\xe3]\u03ae\\Iz\xb2\xe18\x9e\x84P\xc0\x86}\xb1\xb60U8Y\xbc\u01fd\x06\xd4\u2b58OC\x7f\xc4\u0758:\x8eQ9\xf1[\x92'\xb6\xabK\x91\xa6\x81I\xeb%\xaeVTv7\b\x15\xbd\r\xef9\xf1\xa2,\xf7\xa3\xff\xd1k\xf0\xf92\x14j\x85,\xa5:B.k\xd3\x11\xe8\x1bL\xe1\x9fsP\u0380\xf1gs\xb7f\xb0\xda\x1b\xab\x9f\xf1|\x1c\x92o\xa0;+\x98,\xf5Y\x94+\xbeFF\xd99d\x12\xe3\xa6*\xf6\x90\x0f\x84eV\xfd\u0322mB\xc6i\xc2,\x04r\b\xe8j\xd4CVP\x90\u05f9q\xb3H\x02\xbc9[xc\xce\xd2T\xec\xc3v\x15\U0003e602=J\xb8\xcf,7\xbc\x87\x19a=V\xee\x98n\xac}r\v\xcbR\x1aE\x99Z\x14L6]\xde\xccCNL{\x17\xae\xa0>$\x86\x04\xbc\xfe\xe0+ \xeb\xf0\u059a\xa4\t\x06N\xcf\xeb\xf5XM\u0375`e\x87_?\xb5\xc7I\x05\xbb\xd1\x1et\xe3\xfc\xf9\xaa\xd5\xd4\u9319\xd8B\x19\x182/yg\xee\f\xabMI6\x842\x8c\xef\xdcY\xa6\x96\x9c7\x80\x11\fJE\t\r\xe6\x197\xe9\u0685p\x00mz\xe5\n\xf0\x0e?bkk\xa2\x15r\x7f\f\xa8\xc9g\xee\x949W\xfe\xed\xaf~ \x9d@\xd4\x04\xe9M\xdckW\xb4t\x1aV\x05\x99\f=g\x8e\x8c\xfd \x84\x03\x05\x05{\u040eI\xf1\xce\t=m\x8d|1\xe2a\xc1\xc4:\v\xb9[\xaf\xa3\xa5\x83\x19fBp\xcf\xc7l\xc6\"f\xc0=\xa7\xa7\xadIX\xb2\x13Z\x98q/\xf0n\xdd12\x7fO\xe7s\xday\xbe\xbd\xbd\xb1p\xdf\b\xb7\xd3~\xbe\xbdA\\\xe1no\xefl\xbe \xd4y\xedK\xff\x97\x80\xdf8\xa4\x9b\x1f\xccxN;/\x9e\xbf\xa8\xa8\xbf\xdd\xd9}\xfe\x82\u0408\t\xf7y{\xab\xdd!\u0507\xd4\u075d\x9d]\xb8\xf9\x85\xbb\xd1\xd9\xdc\xd8E\xb7\xdb\xee\xf3\xce\xf3\x9d\x1dR\x88Za\xf0\x17\x04!`\xc60\xad\xed\x99\x17\x1c*\xd5+\x9e\x84\x8e\x19\xdfh\xc8=\xd1\xcd\xfc\xb1\xf7\u5c1b\xb94A\xffa\xa0BF\xe6\xf3\u0642\xf1HL\xed4\xe5+\x84EtV\xb2\x11\xf3\xe9\xac\xf4\xbc\b\xe8\xac\xe4[*\xa19hfs\xba\xf9|\xab\xbd[\x01\x9b\x8d\xdd\xf6V[\xc1\xa6\xb3\xd5\xd9\xd8V\xb0y\xbe\xd1\xe9h\xd8\xec\xecnw\x9e+\xd8lo\xed\xb6\xb7\xff?\b\x9b\xed\xdd\xf6n\xe71\xfb\xee\x83?-o\xb9\u76db;U{nck\xf7\xf9\xb6\x86kg\u3e46\xeb\x8b\x1d\xbe\xa9\xc0\xba\xf5\xe2EgG\x81\xf5\xc5\xf6\xc6\xce\xff'\xc1\xba\xf9\xbc\xf3\xfc1`\xfd\x14GWA\xc2\u02e0\xdd~\xbe\xbd\xb1\xfd\x98\xfa'\\.,\xcb\U000dd76a\xbeq\xb9\u0532\xbch\xeft^\xa8e\xd9\xdd\xd8|n\xaf@\xf0\x1d+pz:\xf6\xa5\x7fz\x8ao\xa8\xd8,\x04f\xa1\xadt_\x0e\xc9<(yo\t\xca\u0594\x11\rJ\xe0\xf5-`\x06s\xba\xb5\xb3\xf9|\xabbBx\xae\u05446w\xb7v\xf4>\xdb\xd9\xdc\xdd}\x91\x9d\xdf\x17/\xd4F\xdb\xda\xda\xdd~N\xe8\f\xcanm\xb6_X3\x0eK\\\xe5\xe2\xac\xe0\"\xb4L\x06\xf1\xcf<\\\xd8J\x11\r\x17\xb7\x92O\xc3\xd2V\nhX\x9akB\xc3\xd2V\x9aY\xb3\x0f\xe7tgc\xb7]\xb5\x15\xd4\x0eh)Z\xb4t\x17t:[/\x96W\xf9\x12\b\xf9\x1c\x85\xb5\xc5j\xbb\xed\xed\xdd\xdd\xc7l\xba_\xb9\x7fY\x81\x0f^\xec<\xdf\xddZ\xf9\xea&\xf7\xc9M \xc1$&\x8b+5\xf2\x13^k{%I%\xe9bz\xa7\x9cN\x81\x9b\xac37*3U\xbc\x11Ubsy\t\n\x81W\xac\x88\xd3Z\x9e\x88.;\xe9\xee\xeeVgc\xc5Dl\x0f8M\u042e\xad8$\x8d\x86\xd83\x96e\xd2\xe5h\xa1\v<\xf9\xdck\x04\xf0)\xb6^\xecl\xfeg\x1d\xe9(6\xfd\xa1\uac5b\u0144\xed\x8baW\xba\x01\xf6Z\xaf\xbb~?j4\x86,\xc8\xe6\xec\x03\u0571\xb5\xb3\xb9S\x85\xc17\xb6\xb74\x06\xdf\xdc\xdey\xb1\xa5NVgkG\x1f\xac\xad\xad\xce\u0596:X;\u06fb\xbb;\xfa`\xed\xec\x02V\t\x17\x1f\x91E?\x99\xdde\x93\xcd\xdd2\xc03B\xd4\xeb\x11|N\xf1sm\xa4\x02a\xd1I\xf6sm\n!\xc78\xa1\x17 :\x19\xa5\xe94M't\xcc.z\xb1\x9b\x81G\xbd^\x89\xd7\x1f\xd2+6\xb6Q\x18\xf4v]\vD\x8d\x935\x88<\x15\xea\xb7\x1c\xbd&iz\x01\x0fRU\xd8a\xec\x1a\x89{\u05c9&\x93\x84K\x95\xe0L\xfd\x98\v\xfcA\xd2t\x02\xd9g\xb3\u0244\xc7:\xfb\xecN\xf2\xf7V\x03\x98p\x945@\xd24q\xaf\xe9\x15\xb8\xfd\x19#\"t\xaf\xb3\xbd1\x9e\xcf\xe9\u018b\x17\x9b\x1b\xff\xf1\xde\xc0c\xee\xc6\xc4\xec\x8e\b\xadv\xac\xfd\x98\x05\x8d\x01L\xb3\xf5\xfc\xf9\xf7\xf4(\xf3~\x8a\xfb\xbeKx?jh;;{\xc3\xefl\xec\xec<\x961\xd7\xecP\xbfbR\xb8v\x10\xcb\x15\xc3\xd2\xf2~\xa3\x11\raz\x11\x84\x03\x15*\x16~?\x1a\xd2\u021a\x9d\x98\xcf\xe9\xf3\x8d\x17\xed\xe7\xff\x8dC\xdd%\xc1\u0135@H\x8c\u00b5\xe1ft\xe6s\xba\xb5\xb5\xf1|giov\xc0\xa0i\x18H\xd7q\x00\xefl\xbdho\xbc\xc0J\xea:Z\xef\xff9\xb8m\xb7\x9b\x83\u06cd\xc9\xe0v\xd3o\x0en\xb7\u0683\xdb\xed\xb3\xe6\xe0v\xa7=\xb8\u0745\x8f\xdd\u0270\xb1~\xde}\xa0\x9b+\xf4|\b\x0f\xa1\xfe\x10\x80\xb1\xb3\xbd]u\xf0\x9f\xbf\xd8\xda\xd1\a\x7fw\xf7yg\x93t\x97\xa3r\u05ce\x10\xbc\x16\xe1uO!\xb0R\xf6\u0201tW\xaa#FT\xe8Q\x8da7\xb7\x9e\xefl?\xa6\x7f\xea\xffClBE\x8e\t\xe5\xb0\xeb\x9b\xc3\r\x01\xf5\"@\x8b\xc4\x16\xc1\x894\xd5\xe3LSk\x98\x9d\xe7[\xbb\xed\x8aa\xae\x84\x8d\xbd\x91\xb2\x9d\x83f\xdd\xf0\xa0\xc6}\x03\xb7\x90\xcc-z\xf4\xc6i\xaa\x8d\xd3\u07ac\xba\x84_<\xdf\xdc\xd9\xd4(ygwky\xe7\xb9\x11b\xecJ\x1a\xb9\x92\xa0'I\xba\xb3\xf9\xfc\xf9\xce\ua19fwv\xdb\xff\xa0e\\\xb4J\xfalwww\xd5\x0erNOqUOO\x1d\f\xd1\x15\xf7\x14\xec\xefKr\x85\x82\x04\xa2\xad\x94\xe2<aK\"\xe6\u0103efx\u0437_\xbc\xa8Z4$#\xcd\xde\xda\xeal\xa8K\rw\xa2\xb9\xd5\u069b\xfa)\x8c\xc0R\xb7\xda\xce\xd6\xce\xc6\x0e\xdcj\xf0`|\x0e7\x93p;\u03df\xb7\xb7\xe1f\x12n\xe7E\xa7\x03\x97\x92pA\u6c05\x97\x91\xbb\xb5\xf3\xa2\xbdE\xe8\x18\xc1\xfb\xfc\xf9\x06\xa1W\x90\xba\xf9|c\x8b\xd0kh\xe9Eg\xeb9\xa1\xe7\b\xa5mxo\xdee\x17\xecY~\xc1\xde@\xb3;;\u03df\x13z\b\xf9\x9b\x1b\xf0\x1e\xbdE\xb2\xfd\xc5\xc6sBO\xcdv\xa0'\xd9\xfa\xd1K\x96q*\x0f\f\xf7f\xe8\u0403<\xd9p\x80\x87\x0e}\x95\xa7\xaa\x836t\xe8\x11\x18\x81\x1e\xf5/\x87\xec\xa8o5\x15\xfbwCg1\xed%\xdez\xa5\x1c\u00e6(%\xbf\x8c\xa2\x90\xfbb\xb10/%\xbd\t#_nnTu\x8aY;[UYo\ra\xbb\x98\xde\xd9Y\x92Q\xdd\xc9\a\x7fZJ\xf9\x88\fg\x95\xf8\xaa\x90s\xcc\xcf\x0fo\xcb\xc5O\xb8,\xa7 %RN\u052c\xe4BbN\xa0We\xbc\n\xfd\xab)\x1f/\xcb\xef\xec,\u02f1f\xba\u05a6V&\xb2_U\x85\x83B-M\xe8c\x8d\x0e]<\xc8:0\xc7W\xfa\x96~\xa6\x1f\x15\xc2\xfdD\x8fY\xa7.\xe8\a\xb6Q\x17\xf45\u06ea\v`\t~\xad\xd7\xddO\xecs\x0f\xe2\u01ab\xd2\xdeWW\x12+\xce\xfc'\x83\x0f?A\xf9\xb5CW\x96\u0723\xbfgw\xf02\b&\xee{\xe4?~BN9];6\u0140\xc2\xff\xa4\x02\x7f\xe2P^\xb21\x14x\xc3^2v\x90\xa6\u0674~\xe4BYk\xe5\u7031\x97\xd0\xf0\x99\xd5\xe9\u0315\xf4\x18\xbb{\xc9\u062b4}\xc9\xd8e\x9a\xbe\xa9\xd7\xd7>\xeb\xee?\xa4\xe9\x9b\xde\xfd\xdc;/\x0e\xe3Co\xeaJ\x9a\xb8\x9f\xa8$\xc4\x1b\xb9\x92\x06\xea[\x8d\f&w\xd4\x7f\x99y\x1a\xff\u0713\xde\xfd\xbc\xfb\tx\x9c\xf4%=&\xf3\xf9\xc74u?\xaa\xf7\xa7b\xe4\x7fc\x1f\xb5\xaba\x18\xcf7S\xf5[\xf7\xa3v\x16\xfc\x89\xd0[\x14\x1bT\xb8\u01c9\xc9\xfd'\xf5 wc\\\xad\x98J\xfaQ9\u07fcq\xe1B\xac\xac\x05\n\xa4\x9f\xb0\xf9\x88\x9a\x9aQV\x13\x87\xf5\x85\xbd7\xb1\x95\xdd\u05fd\x0f\xbd\vo\xe2}\xe8\x9dx\xa7\u010a\x93\x10\xb9_\xd2T\xd2R\xcb_\xeau7f\xb2\x1f\xb1xH\xa8\xef~\xa2U\xbd\xd0O@,t\xaay)\n\x1b\x96\x1cOP\xdff\xbbZ\x1b\x95\xdc\xcf\xcb\xfa\xa4\xda\xf1|\x9c/\xfa\xfd\xbc\x10\xe3<\x82i\u0632g#\xb4\x86\xa5\xe1\xb9s\xa2\xbc\x80\xe6\xad\v\x90\xe3[7\x9f\x0f\xb7\xe4\xf3\xe7U\x9c\xb6\xad\xdd\xe7\x9d\x1du-\xbdx\xb1\xf1\xa2C\xdc\u062e\x19\xcd\xe9V\xe7\xf9\xd6\xf3\a\x89fCt\xe4\xb48\xdco\r7\xeeu\xbc&8\xb0\xec\xf9\u0366\xd7h\xf8{QF\xc1\xfaC\x15\xae6\vKg\x11\"\x1b\x9d\xf6n\x15\xd8\xf1\x95`\xb8.\xc0\xdd\xeb.\xc5\v(\x17RXa\x064u\u0224M\xc4\xfbi\xea\xfa,\"\x14\xc2\x11A\x98a \xe3g{a7\x8b\x94\u071f\r\xbbb\xbf]\xaf\xfb\xee\x88\xf4\xc4~\xa7\xc7\xdd\x11\x15\u034en\u068b\u0744\x8e\x88\x17@\x03}\xa3a7d\xa3\uc55b\xc0L\x9eo=\u07ecz\xe5n\xb7w6\x89[\xe2b\xe0zT\x95\x86V\xbe\x97\x00\x83V\"\xa0\x91^\xec\xeeVR_\xbb\x9d\u774eju\xab\r\x9c\xf8G\x10\x95\xf0\xdew%\x8b\u044c\x87d\x14&\x1a5\xf3z]\xc0C\x883\u078f\\\xd9\x17\x8d\u0190\fm\xeb?\xc6\xfc\x1e\xf7\xb4\xa8bNw\x80]\xb8z\x99\x91,y\x80\xcc\xf6\x99-d\x8c@|\xe8{\xb1\xebS\x157\x11\x1fD\x9b/*\xfb\x01\u01ba\"?_\xec\xb4w\x15U\xb6\xb1\xb9\x89\xccb\xdbF\xfb\xb3\x7f\xae\x87\xbd\xfa\xb1c\xdem\xd93\x84\xf7\xf2kP(\xff\xee\xe3\xa1\xe3Y7{\x18\x0e\x1d/\xa8\xd7\x03x\x03d\xa1<{\x11\xda\xfd\xb8H\xe4vV\xf3i\xac\xbeq\x11d\xa1%\xd8\x00[\xdbU<L\x04\x8b9M\xed\xf66Y=\xb7\xa8(\x88\xb6\xc8;\x8c\t\x8e\x1d\xb5_lVs\xe5_l<\xd0Q\xf1\xd8f\xb27|\x1e\xe9\xf0\xb9 \xeb4qt\xd7\"\xbc<\xd6\"W\x90\x9e\\\x03\n^\xac1\xe1\xc5Y+\x9c\xa2U\t\x85\xbeWS\xe4;\xbb\x9d\x8ea3=\xdf\xdc\xee(\x8a\xbc\xb3\xd3~a$\x05\x8a\x8e\x9eedr\x98\x93\u0263\x9c\xf94\xad\xa6}'\xacL\xc7\u048b\n\xc2w\xfc\x8f\x1f\x9b\xf4\x8a^\xd3su\x16\xee\x18r\xa5\xce\xd8\f\xe8\x82\x1bv\u05dbx\xa8\xd6\x7f\xc8\xce\xf0S\x02\r\xef\u07b0\x1b\u01a6\xbd\v\xef\x860vAO\x99{\xc8\x0eU\xca!\xa6\x9c@\x81C\xb8\x90N\xea\xf5\x10\xb5\t\xe0\xba\n\xf3\xebj\xad\u04fd\x03Z\ue5adu\xe6\xaa\xdc\xda-1v~i\xea\x9ek\"\x82\u07a5\xe9Hoik\xb8\xb0\xb9\xa9\xa47Y\x82\x8aK\u06a9\v\xad\xb9p\xc9n\xeb\xf5\xb1y;;\xa7\xa771\xa8\xe3\x8cOO\x1dB\x0f\xd8i\x96)K\x99\xd0\xd0e\x9a\x1e\xa8f^\xb1K\xa3\xe5\x00:\x0e\xf4\x88\x1d\xf4d\xf6[v\xabF|\xed\xbe\xa2G8\xb0s2\x9f\x1b\xf5\x8c\x93z\xdd-\x14\v\n\x13\xc2\u0376\xbd\xfd\xfcy\xe5C\x17w\xd0?<jH\x06g\x87l\xe3\xc5\xf6\xf3\xd5\x1b\x1a\x8f\xe1r\xac\tVB\x8a=aX\xe1\x14\xc5Jl\rc\xc3j\x1cf\x969\u047e/3\x84\xd2\r\x80\x9f\xa0#\xea\x8b~0\x84J\xb3z=\xeco\f{\x10L\x7f\x8d1\xde\x0f\xfb\xed\xe1\xd0[s\xe1\xafb\xc2d\x1c*h\xaf\xdbh\x04{Iv\u06fa\xaa%\x02\u0309)\xe3\xfd\u0450N00\xbf\xd5vQ\xee\x0f\xb1u\xdcQ\xad\xd4tF~_h\xb9\x10\x84\xc1%\x900f\xbe;\xa5\x13:Bu\xdb\v\xbd\u06f2\xe6\u01bd\u021d\xd0)\u0764>\xbd \xde\xd8js\x9e95\xc0\xeb\xbc\x12\xf8\x1b\x9b\xdb;F\x9c\xbb\xbd\xb9\xb5\xa3\xb1\x89\xa2\x12\x03\x14\x80cj\x02j\\fY[\x8d\u07ab\\{p0|\xb2Ng\xcc<\vr4P\xc1\u0526#6\u02f5\xa3\xa6,,\xa1\n:a\xeaa\xe8:\x7f:\x8d\x91:&S\x92i\xe9\xac\xf7\a\x83?\x9f\xb4\x9e5z.\xe9\x0f\x86\xf7\xf3t\xb8~N\x9d\xc1\xe0I\u0771J\x15\x1bM3*\x9a\xb4\x9e\xf5\xdc\x1e\x1b\f\x06.Ik\x93(\x86\xa9\xa8\x84!\x81\x86\x9etZ\xcfz\xe0W\u7273z\xa7\xaf\xb9kp\u01e5)\xecx\xe0\x8a\xc1\x1e\a<E\x94&P\xa0o\xf0\x8d\x17\x1b\x1b\x9d\xff\xfa\xb9\xc2wrv\xae6\x9f\xefn\xbdX}Mnuv\x9f\xb75\xef\x06\xbb\xa3\x01\xb0+\x82\u5f03`9\xef X\xc2;\b\x96\xf1\x0e\x82e\xbc\x83`\xd9\xf3=x\xe0\xf9\x1e,}\xbe\a\xab\x9e\xefA\xbf\xea\xa2+T\xaaj\xa8\u0131\t*Y3A5\x1f'X\xe4\xd8\x04\x15L\x04\x1b\xdc\xd9\xfb\xba\x90\xacY\nA\x15s\xc5J4\xd7r!1g\xb6\x04\v\u0316\xa0\x8a\xd9\x12<\x9e\x9dQ\x8a\x81\x80\xf2'#\xa8\xac\xd7\xd7\u0582>lS\xe0\x9f\xef\xecn\xb4+\x99\xa9\x9d\xed]\xfdH\xe8\xeclmj\x1e\xe3\xce\xf6\xb6\xd6(Q\xa4\v\xd03\x9b/v\u069d\xd5G\xa5Ji\x10\xfc\x01h\xfa\xd6\xf7\x16\x15\x15{\x81\xbe\xe4\x01\x89s\x90\x84z0d$?\x10u\xb6+\xdfA\xbb\x1b\xfa\x05\xfa|\aU3\xfe\x19\xd3\u077c\xa6\xf3\ae\xe4f\xfeZ\xfa\xc3\xdc\x03g\x816&\x19k\x1eX\xf2\x8e\xa5\xcb\xed\xac\x81\x04A*\xe1X\xeeNR\x025\xde\xde\xecl\xae\xe2\td\xb3B<\xb1\xb9\xf5\x02\xef\x80\xff\u03ac\xfc|V\x912\u06b4&\x97\xa8\xeb\xd0-M$IS\x102\x06f\xae\t`Z\xa1\xa6\x96\x14\xe4T\xb8\x85\xaaV\xe9\xc5v\xf6\x1e{\xa1\x957\xb66v6\xb7\x96m\xa2|\x84\xa6\xf9N\x1e\f\xa4^\a\x8b\x13\xa0\x16|\x8c\xb7\x82\x12\t\xf8\v[\xc6v\x9ci\x1b:\x81lD(\x0f\x1d\xb0\x06\xb0\xc1\xab\xce\x00R>j\rv7w\xb4\xca\xd9\xee\x8b\xf6\v\xcdg\xeflo\xb57\xb5\xca\u064b\u038e\xa6\xea\xd5\\h\xf8\xf0KX\x0f)\xc0#\n\xd4to\xe6\x86\xcab\xbe0tE_E\xae-\xe7\xcc5\xd2\xeb\xf5\x00\xad\x04}\xcc\xc7GK@7qf[\x1b/\x9e? A@\x01\x9a~\xab*\xc6\x00\xccl\xfb\xc5\xees=3\xbd\x13g9[>\xc4W\xca\xe6\x8b\xf6\x8a\xa7\u05c8N\xe9\x84\xdcK%T\x83\xa1\xe5l3\x9f\xcep/N\xd2\u051d\x18\xf27q}B\x02\xac;C\xba\n\xeaw-\x12l\u069b\xba@\x9e\xcf\b\xf5\xe9\xac\xe180):!\xe6)\x9d\x01\x04$\xde\x17\xcc'4\x82\xd2\xf4\x02Myf\x805pVU\xc2:\x05\x83(\x17\x92\xe0B\xefv658P^\x92\xe42\x8eY\xaeO\x10f\xc8p\x84{{ckG\xc9S\xf4\x93n\x92\x13t\x1794A\xa0\xb2\xf3|g\xb3\xad\x04*\xfa\xcdw\x9d\x01\x16\x05*\xdb\xc0h[Ay\xdf\xd13zC\x0f\xd5\xfe\xb8e\u05c8y\xe8)\xb2_\x05\bR\x0e\x91\xd5z\x8a\xe4\xe9\t\x89!\x9f\x9eX@\xbdd7\xbd\x1b\xf7\x96\x9eR\x01\xf0\x84V\x0f\r<\xe9\x01\xcb z\t\rd/\xa0\xd0=%\U00108b7d\xaa\u05e7\xf0\xf9\x15?\u05ce\xea\xf5+\xe8\ub49d\xd2Wiz\x94\xa6_{\xa1{Kz\x97\xec\xd6\x1b\xa9\x8f\xc0\xbd%\xdeQ\xcf=\x80k\xeb\x92E\xee)\xfa\xde\xf0\xbefI\xbeI\xbad\xfd\xa17vOI\x9a\xce\xdcS\xd2s/\xd9-\x9d\xa9f\u03a1\x99\v\xf7\x16\uec89{K\xd2\u053dd\x89{J\b\xf1\xa0\x9d\xf9A\xbd\xee\x1e\"\xc7\xf7\x94^\x12z\xe6^\xd2Sz\x87\xc0\xa2\x87Z\xa9\t\x8aS\x05\x93KuX\u069b\xbb\x9d\x87%\xdd\x15\x8eh4G\xc1p\x90e\x9f\x0f\xa1\xc1\xdd\x17\x9dJUXd\xa1\x91\xee\xf7\xf6\x12\xebPz \xcd\xddY\xa1\x9f\xf4\xf0H\rK\tE\x8d\xd8`\xbb\xd3\xde}\x801k\xb9a\x80\xb7\xb7\x1d\xc8\x06]E\b\x16\xf7\xdc\x18\xe9\x11\xe2\xa1\xf2\x02\xa6+\xf7\x8ex\xf6*\xb9sHQ 9\xbc\xbd\xb9\xad\x99f\x9b\xed\xf6N\xe7A\xb4\u9eca\a\x10\x13\xca\x1bJ\xef\xa0\xd3\xde\xe9TQ\xf6Z6\x1a\xe5|J?\xd7\xf8\t\xf2c\x99<\x84\xb3\xa9\u0438k-\xb0\xeeQ\x9e]\x9a!p\x87G\u0305\xeb\xcafj\xd2)\x1b5;t\u00b8foN\xea\xf5F#\xdc\x1b\xe9G\xeb\x05K\\\xd9\x0f\x87\x88\x19\xd0\xd6\xc0\x96(\xb3\x8b4-\\\xc3:)\xbb\xfe1\xa1`7\x12\xae\xb1\xa9j\xfa\x8aM\xfa\x17\xc3\x1c=\xbac6\xeb\xcd\xdc+z\x91#Ox,\x8dY\xe0^\x91\u0795\a\xf7h\xd8\xe8\fI\xaf?\xf4\xee\xe7d\x1e\xbb\x13zA\u01c0\u02e0\xa9\xb9\xa5\x06\xb3\xbd\xb3\xbdS\xa9W\xb0\xbd\xdb6<f\x14\x9e\x17\xc8\u01e8W\xb9\xa0\xb0\x9c\x8ey\x88:\xab\x85\xe7\x1d-<\x8f\x913e\xcb\xcf\xe7\x9e\xdf-h]v\xb66\xb6_<\xa0\u0367\xc6\xdd\xec\xd8\n@r\x0f\x8c\x9b%k\xca\xfd\xa8\xd7\xf6\xa2\x86$\xd4\x15L\xecG\xbd\xc8\x13\x04sE\x83E0M\xb9/zmO4\xc1-C\x9b\u00bf\xac\x9dm\n_+/E \x16\x88Aj\xe1\xf7\xe3!\xe3\xfd\xb8!\x87]K\x8bm\xbb\xbd[\xad\u02f2\xe2\x1ePc\x17y\xe0\xd7\xe2\u0474\x8e-8C\x93:\t\x8f\xe5\xda\x1a\x1cLT\x91\xfb\x1eu\"5\x19\xae4\xb1x\x97\xc4J\x13+\xa7kc\xd0]hoovVs\xc9Q/\xac\xac\x90\xb7\xb9\xb9\xb5\x85\a\x11\x18\xe6\xd9\xfe6w\u048c%\xbd\u0727\xcer&:\x10!\xe4\xde29\xca\x1e\x16\xb9?\x02\xe4\xe6XRRu`\x1b\x8e\x03\x19\x81\x951\xeb\u034c2\xa7\xe78&\x98\x1c\x14T\x05\x9c\xb6\x83DNg]2\xd6|+&\x81\b\xe4]\xcfi\xb6\x1d\x0f\x01\xbc\xbb\xbdS\t\x8a\xdd\x17/\x90\u0343\xc6x\xeb\x0f(>\xf5x\xe6\x10\x01H\xf8F'g\xadD\xd4q\xc0\x05\xf7\x9c\x82\x9a\xff?\xba\x17\x00^p\x11l\xefn\xb5\x1f\x90\xeet\u06a8\xb0\x81T{{\xe3\u0146Z\xb7\xc7Q\xbaF\xd6C\xd5=\xe4r\x86|[T\x1d\xc4K\xb9\xc6\xfb\x81\v\xdcx|\x9cvv\xb7w\x1f8\xb8y \x8a\xc2\xe1\xa5~\xae\u04c7\\\x15}\xf0pB\t\x8b\xf7\xfc\x9e\xec\xc7C\xb3\x83\x84\x1bP\u078f\x874\xc9\xe4m\x01\x80sk\xf5\x00r\xf0\xa1y\xb9D\x95[\x80T\u0543n\xcb\xe8\xac\xebW\x03\x9a\xaflow\xb6\x14\x00w_<\xdf\xdc|\x10\x80\xb12x\u01cb\x8f\xf4\xfa|\xe8\xf9\x19[k\xab\u0769$\xf6\x11\xff=$\xf6\xb2\xf0^\xf6P\xca\xd5\xdf\x00\xe1Qx\xf6\x89}\x16\xf5\x00\xebf\x9ae\xbb[\x9b\xd5BmP\xdd^\xfd\xa2C\xd9s!`&o\xe5\u02a6\u010e\x1d\x01h\x1e\xe99\xf5\x83\xa3\x83\xe39Ej\xdd\xea\x9c3\xd1\x12Wc7s$\x92\xa9zG \xd4Y\x93-\x11\x8d9\x18\xea\xd5\xeb\x92\xfa,\xaa\xd7y\xbd\xbe\u01addN\x03\xe6\xd7\xeb~6n\u01a2^\xdcR\x9c&\x83\x87\x12\x16\xf4\x82\x96\x1f\x86\xd1\xe8\x8bH\xfc\t_%\xcf+\a\xd10\a\xd9X6f{6\x06\xec\xe6\n\xe2-\x02&G\xae\x90>\x05EX\x1a\xe3y\xedlW)\xd9\xe1\xaa<pe0\x89\xea\xc5J\u05d7x\xe6\xcb\x06{i\x10\x94\xb7r\xdd_ZX,x\xefov\xb6vm\xa5\xcf\xc1\u0373'\xeb+v\x80\xa8\xdc\x01\t\xfaS\xa3\xb2\xc5o\xf9\xc8\x0e\xbd-Z\x99'\x1d\xc6\xf3o\xa4.\xb7\xda[\x9d\x95B\xd9\xca\xeb\xc4gQ/26\xab\x8f\x12\xc9\xfa=\xcd\xee1\xbc\x1eB\xbc{\xa4\xf5\xe1\xb1\xf8\x7fm!\xc2l\x116vW\xab\x06[\xf7x\xd1\f\x06\\\xe9eJ\u0599\x965\xa8=+\r|\x8bg\x85\x8a\x96\xab\xa9l\xa5\xfe\xfa\b\xa1\u045a\xe8\xa2L\x96\xdd\xcf\xf3\xc86\t\fp\x96\xebX4\x1a\xc9\xde,\x93\x12\xc9~2\xa4#\xe6\x03\u00e3\x1f\x02w0\x1c\xd2\x105\x98\x17x\x01#4\xb1\xe4HY\a\xbd\xc8\x154\x045\x8bX\x7f\xcc-v\xd5\xff\x8f\xbd\xff\xfen\x1c\xc9\xfe\x04\xd1\x7f\x85\u00aba#Z!\x16)\x97)0\xa3\xb8Yi\xaa\xb2*]\xa5)\xa7TgCdPB%\x18`\x05\x02\xcaT\x8a\xec\xb3\xde{?\xeb\xfd\u061d\x1d\xbf\xe3\u71ee\xfeG\xde_\xf2\u03bda\x10\x01@RVu\xcfw\xf6\xcd\xd9sx\b \x10\bon\\\xf3\xb9\xa8\ny\xb5Z\xe9\xc1\xc1\xde\xde\xe8C\xd6hZ\x18\xdcC0g\x1c\x8d\xaeNuo\xb4\xbb\xbb\xfdsS\xdd\xdd\u077e\xdc0\xe60z\xfdzZH\xbe\xf5C\xf9\xba<M%J2\x8fB\xed\x90m0\x99\xe8J\xe1\xe0\u01be\xe5\xfb\ue3f6\xaf9\xa6\xca&\x9c\x90\u06cb\xd3Z\x1e\x98\xb1\xf4\x93\xd1D\x1c\xa6[\xa3\xa3z\x05M?\u065e\x80\xed\x8a\xed3\xe8\xfc\xcc\r\xcaOv:}\x8fd\x938\xdd\u06a2\x19q\xe9\x00o\xbb6\x86)\xe1$\x93\xb1\xf4\u058e=\xe2f4e#B\x95\x159*M\x84\xa7c\xab\xce#\x0e\xe5\u0478\xea\xf79r\x8b$\xad\rK\xd4\x1a\xf7UTf\xea\xec\xc2\xfd\xd1\xf5\xfd\xe6#c\xe3>`\xb4\x0ej\x9di\u0355\x15\xf5i\x12\xa3z\xa7\a\xaf!\xd5$M\xb6F\xb4\xb4\xb5\x11d\x1c\xabI\x86\xfaP\u066d\x94\x18;\x9d\".\x0f\xb3#\xd4~\x18\xfb<Y\x90)\x0f\xaf\xb0[h\x97\xba\xa5\x93\x85]\xeb\u0692f\fO`%\xcb\xecd-\x9d@\xb7b\xd9!\x9f\x94\t\x186`-\x11\xb7+N\x0f\xab#Z\u0454\x90c\xc9\xd37uk\xe3\xd2\xd2=\t\x91\xb61\x1c\xba\xed\xfd\x9b\x86k|sg\xb4;\xbc\x96|\xea\xa4{\x15\x03\x12\xdf\xec\xc0@p\x02\xfb\xb8\x1eU\xcc\xc0})@\uc537U<D\xa6\xdf\x04\x16\x8e\x91\xf5\xf6\x11\x91D9\xe8[\xdb\xca\xe5!?\x8a\xc9f\xa5\xd1[v\x0ev:w\xa5}7\xf9wn\x8e\f\xbfq\xef\xe6\x8d]d\xee[i\xeb\xe1\xaf\xfe\xbf\xff\xf2\x9f?\x8aht\x12\xfd\x12nQ\x8a\x84\xb4;%dpJ \x94Sd\x95\x80\xe4\xe7\xc6\xee\xb0\xf3X\xb2=\xb4j|8\xc2iz\xa5\xa6X\xf7\x98\xc9,\xa9]O;\x18\x01H\u069b\xc1\x01m\xb9C\u018aA\xcbS\u0455d\x15Cs\xa2\xf6\xcdz\xadW\x7fn\x92\xb7\xed\x9d\x7f\xb25\x9a\x94\x87\xd5\x04\xd8'I~\xe4\x14\xc2\xd6t\x7f\xb8\x7f\xd0\xc9sB\xc6\xebu\v\x1b'\x8eK\x06i\x1d\xect\x9a\xa5#+\x8e\xc4\x17\xbf\xff\x97\x93\xe8vD\x7f\xff\xaf\xe8\u02ff\xaa/\xff\x9a\xbe\xfc\xeb\xfa\xf2o\xe8\xcb\xff\x94DiD\x7f\xff?\xeb\xcb\xff\xa2/\xff\xab\xbe\xfco\xfa\xf2\xbf\xeb\u02ff\x95Dw\"\xfa\xfb\xbf\x90D\u04c8\xfe\xfe?N\xa2\xbb\x11\xfd\xfd_O\xa2YD\x7f\xffo'\u047d\x88\xfe\xfe\xdf\u0457\x7fW_\xfe=}\xf9\x8bI\xc4#\xfa\xfb\xbf\xa4/\x7fY_\xfe\x8a\xbe\xfc\xfbI\xf4 \xa2\xbf\xff\x0f\xf4\xe5?\u0517\xffH_\xfej\x12e\x11\xfd\xfd\xff\xa9/\x7fM_\xfe/}\xf9O\x92\xe8qD\x7f\xff7\x92HD\xf4\xf7\xffi\x12=\x89\xe8\xef\xff3}\xf9\xcf\xf5\xe5\xbf\u0417\xffR_\xfek}\xf9\x9bITD\xf4\xf7\x7fK_\xfe\xb6\xbe\xfc\x1d}\xf9\xbb\xfa\xf2\xf7\xf4\xe5\xcf'\xd1\u02c8\xfe\xfe\xbf\u0457\xffV_\xfe;}\xf9\xfbITE\xf4\xf7\xff@_\xfe\xa1\xbe\xfc#}\xf9\xef\x93\u8ec8\xfe\xfe\x1f'\xd1yD\x7f\xffO\xf5\xe5\xdfL\xa2\xdbP\xdd\xff#\x89R\xb8\xfe\x0fI\xf4\xe24\xa2\xbf\xff'I\xa4\xe0\xfa?&QYF\xf4'\xddm?\xe9\xfe\xfaIw\xd4O\xff\n6\xffO\xff\x9a\xbe\xfc\x1b\xfa\xf2obg\xfc\xf4o\xeb\u02ff\xab/\xff\xbe\xbe\xfc[\xd8C?\xfd;\xfa\xf2\xef\xe9\xcb\x7f\xa0/\xff!v\xdbO\xba\xf7~\xfa\x8f\xb0\xf7~\xfaO\xf4\xe5?\xc5\xfe\xfa\xe9?\u05d7\xffR_\xfek}\xf9o\xf4\xe5?\xc3n\xfb\xe9\xbf\u0417\xffJ_\xfe\xbc\xbe\xfc\xb7\xfa\xf2\xdf%\xd1g\x11\xfd\xe9\x7f\u0417\xffI_\xfe\x17}\xf9\xef\x93\xe8$\xa2?\xfd\x8f\xfa\xf2?\xeb\xcb\xff\xaa/\xff[\x12}\x1e\u045f\xfe\x0f}\xf9\u07d3\xe84\xa2?\xfd\x05}\xf9\x8b8\"~\xfa\xcb\xfa\xf2W\xf5\xe5\xaf\xe9\xcb_\u05d7\xbf\x84\x03\u39ff\xa2/z\xd0\xfc\xa4G\xcbO\x7fC_\xfev\x12}\x11\u045f\xfeN\x12\xfd\x10\u045f\xfen\x12}\x19\u045f\xfe\xef$z\x13\u045f\xfe\x9e\xbe\xfc\xfd$z\x18\u045f\xfe\xa1\xbe\xfcc}\xf9\xa7x\xf9\u00ff\xa2\x9f\xfeA\x12\xe5\x11\xfd\xe9\x1f\xe9\xcb?\xc1\xcb\x1f\xfee}\xf9W\xf5\xe5_\xc3\xd1\xf9\x87\x7fC_\xfe-}\xf9w\xf5\xe5_\xc7!\xfb\x87\x7fS_\xfem}\xf9\xf7\xf4\xe5\xdf\xc7A\xfa\x87\xffP_\xfec}\xf9\x0fp<\xfe\xe1?\u0497\xffD_\xfe\xf3$z\x16\xd1?\xfc\x97\xfa\xf2_\xeb\xcb\x7f\x91D2\xa2\x7f\xf8\xaf\xf4\xe5\xcf\xeb\xcb\x7f\x93D\xcf#\xfa\x87\xffN_\xfe\a}\xf9\x9f\xf4\xe5\xbfM\xa22\xa2\x7f\xf8\xef\xf5\xe5\x7f\u0517\xffY_\xfe\x97$z\x11\xd1?\xfco\xfa\xf2\x7f\xe8\xcb\xff\x9aD*\xa2\x7f\xf8\xdf\xf5\xe5/\xe8\xcb_\xc4I\U00047fec/\x7fU_\xfe\x9a\xbe\xfcu}\xf9\x9b\xfa\xf2\x97p\x8a\xfc\xe1\xaf\xe8\xcb\xff\xa9/\xff\x97\xbe\xfc\r}\xf9[\xfa\xf2\xb7\x93\u86c8\xfe\xe1\xef$\xd1\u06c8\xfe\xe1\xef\xe2\x9c\xfa\xc3\xff\x8d\x93\xe9\x0f\x7fO?\xfd\xfd$\xfa>\xa2\x7f\xf8\x87\xfa\xf2\x8f\xf5\xe5\x1f$\xd1\xfb\x88\xfe\xe1\x1f\xe9\xcb?\xc1\xcbO\x7f3\x89\x1e@\xc7\xff\xad$\xca~\x88\xe8\x1f`\xb9\xe0\x11\xfd\xc3\x7f\x96D\x05\\\xff\x9d$\xfa\x154\xff?\x85\x9a\xaf\x1bz\xcb\xc80\xbe\xd4z\x9b\x16\xbeZ\xbc\x92\xe7\xf8\x9e3\x19\ub746FZ1\u058a\u00a3\xfa\xe4\x1c_\xaci\x14\u044b5\xa1|=E;BN.\x1a\n\xee\x05\uc323Q\x97\x8a+\xe2\x11\x98\xf3\r\xd8`\x1a1!0\x89\xae8\xea \xa2\x90=\xbb\x80=\u0274>\xf1/\xeb3N6\x8f\xa7\x1bZ\x11-\xef\xf7\x97\x9fL=\xd5H\xf8v\xce*\xed0\x02\u4215gC1\xef\xf7\x1d\xeb\x7f\x8e\xd6g\xa7\x8c\xf1\xb1VS\xdb\x1a\xd1\x05(\u065c\x81\r\xcb\x04\x99'>\xbd]!O\x85SE\xa8\xbeU\x14\xf9\xba3'\x988a\x80\xc8H\u03d9:\x9c!\x11\x97\xa1\x02\xdc1\xcb'Y|NO\xe8\x8c*\xcaiE\x92,>\xa1\xe7\x14\xbd\xa4\xd2*D\x92;F\xc2\xf7\x98L\v\xa12Q\xf1\xf1\x82m\x8c\u019a\xfa\x83x\xe4\xc2\xd0\x064n\xb1M6\xd2\xf8\fm\x1d\xe3\x13-\xb9/\xe3\x13\u02b1]+\xe2h\xe63\xad\x80\x80\x8e*\u0205\x97<*\xf1\xf5\xb2y|\xb2\xc1\xd8y\xbf\xbfQb1\xcd\xe7AL\x93Te\u50dcPw\xaf\b]\xe0\x19qg\xef\x1a\xae\xb6\xe6{\xd1\xd4\x19W\u04ac\xd6\nF\x8b\xbc\x9b\xce\xce|{ts\x84\"\xe4N\xee\u0114\xe5\x93\xfcC\xb8\x13F>\x97k\x91\xbb\x05&\u0408\x04\x1d\xfaO\t\xe2w\u05fc\x9b\r\xa6\xbc\xa7\xd5\xca\xe7-\xd8w\xfa\xa9\x1e\x91\x9cY.\x05UL\x99\xdbq\x90a\xa0\xa0e\xf0\v6\xae\xccxc\x89\xec=\xad\x8a\x827\x8a\x10\x12\xa6\ua53b\x92f\xedx3\u032ab%N^\xb8\xc9\xe9\xa6j$h\xb4\xbdj\x80\x05\x91.8c\n\xaf\xfd>\x1f,xY\xa6'\x18dn\xc3\x04\xac\x1eW\x98\xb9U\xdbr\xe92-2\b\"\xa1\nWb\xe4\x7f\xe1+T\x04K\xf4$\x1e\xf5Q\xf5\x14\xbc\x1d\x9c\xb2\x8aP\r\xbe\xbe\xc1,*\xed\xc6,\\*\x16ln\x96\n\xf8la\xe7\u020215\x96+\xb6M\xe7n\xdac\xfc3\x96\u0167\xb8\xb0\xc08\xb7\x03\xc9.\x9bso>\x9c5\nil\xfe`DM\x89\x03\xcd3\x9c1\xc6\u032ds\u07cb\x06\xe5\xa8\n\xdf\xc5x\xd0V\xa7\xc5/\xd7\\\x0f\xact`\xa5\xcdQ\x1b\x13&R\xb8\xca\"7\u066a\x0fmTu\xeb\xd9c\xee\x92M\xc7Kw\x8e\x05\xe5\xe1\xa5>\xc1\xc6\xd5d\x0ezQ*)L\xdd\xe8\x9cx\uaf66/\xedJ=c\xa5\xb7R\x9f\xf6\xfb\xb6\xa7z\xa7\xb8R\xcf\xecJ\r\x8b\xf4\xb8\xacW\xe3\xb2^\x8dm\x99\xceX5\xde\xdc\\\x06+\xb3.\x99^\x9e\xe7G\xb5~\xf21\xab&).\xcfs\\\x9eKP\x90\x87uo\x8e\xcbs\xd9TX>\x9e\xc0\xe2z\xbeZefuL\xe1\x93\xe3p\x81<[\xad\xe23\u0590C\xcf\t\xac\xe0\x8b~\x7f\xe3L\x17\xeb-\vx\x95\xf4\x1eS\xfe\xf3\x18,\x01V\xab\x8dP\xad\xcc\x18\xac\xb7C\xc1\u03bf\x83\r\xf4\xb6\xdf\x7f\xdb\xcbD\xa9R15\xcf\x1d\xb1\xee\xf5\xfb\xf7\xfcX\xf7V\xab\x18\xeaS\xdbTyC\xbbl,\xf5\a\a\xc3N%\xe1\x9b{{\xfb\xbb?Gc\xa1\xa1\xaf`\x16wOiagt\xd0\xc1\xc3\xff\x05\x88\x9aM\xe4\xa5\xed\x9d\xdd\xce\xd3/\x80\xa3y\xfc\xcc\x0f=\xdf\u02d8\xd3T\x1b\x81\xa1Y\xf8\xd5ik\xae&M\x9d\xda\u0607'\xbe7\xdc\xebbL\xec\xdc\x18\x8e\x0e\xaec\xa5s\x87zTK\xc4\x15\x99\x88\u00f6$xb\x83\x92\b\xbc\xc7DG\t\xc0\xec-\x91\xbbzp\xd0)\x87G\x1d\xbf\xe2\x9a\xe6\xb2\x13\xd6)U\xaa\x00;\xc1Z\x99\x01\xea\x06`:\xa4Gc\xb8g\x87\xb0|\xc98#G\xeb@?\xf4f\xa7\x0e\x11\xda\x0e\x98ax\xe3\xe6\xf0:\xf5\x00V\x84\x0eP\xc1\xd0I\u0536s7\xf7\x0e\xb6w\xbb\xd9\xc9\a$\xae\u0743?\xb5k\xf2\x93\xb9q)\u0784\xec\x02\u02f7\xab\b\xa4\x96\xf1A\u028a\xa6\xbdA\u018a\xda\x1e\xa1\xfcy\xa6s\xba\xe1\xd3Z;\x95\n\xc6\x0f\u02e31\x9c\x14\xe0\xc6H5\x8d|pc\xe8\x1d\x044\x83\xd3j\xb6\u058d\x05Z \x13\xfcV$NL]\x1e\x11Z\xe0:\xb1\xb7\u05edrt`y|7\x86\xbb7\x0e:u\x91\x97\xa6\xca\x0f\xca{N\xb7\xa5\xd6\xee\xed\xf4\u01ce\xb2\xc7+\x8c\x05\x0f\x8f\x12\u07fe\x06F\x14\\\xba\xfc\xa7yP\"@6\xaf\x93\xc2k\xd4rMq\n_mQ\x89\x03\xc7\xc0\xe2\xe9\xc5$\xab+\\^Y\x91I\xf7\x9c9<\x1a\xf31\x91\xb1\xa2)\x8ayy\xa0\xf8\xab\xd6I\x16\x16\x12\x8d6:\x19s{{f\xb2\"4\xa0\xe1\xb1\x02\x9c\x9dQq\x05d:M\x92#^\x98Q\xde\xd5\xf6\x19yme3e!\xbd\xe6[\xe6\x19\u043b\xd0.\x0fI7\xdf*\xcfi\xeb\xd3\x19\xeb\xa0\xc7\xe9\x82\xe5\xb1$\xf4\x8c\xe5q\x01\x9a\x9fy\x9c\x02\x8cF\x1eg`{\x97\xc7%\xd8\xdeUc\xc0\nzk\x04\xe0\u0395\xbd&\xb0\xe3\x11!d\x83\xcdV\xab\xc2\xc6)\xc8\x06\x9b\xaeV)<\xa7\x03\xc9\xcb\"?\xe31\xc4Z\xaeV\x99\x8d\x95\x91\r6_\xadJ\xfb\\\x92\r\x06&\x02\xf1\u06ceYU\xe9\xe5\xace\xd6\xc0\x188C\xf6\x11\xd7\xed&\xc7\xc4$\a\x81\xb6V\xaa\x91\u011cM\xa4AK[X\xeax\xa6a\xce\xce\xec\xf3T?\x9f\xd8\xe7\xa5~>\xb7\xcfs\xfd|l\x9fO=\x91\x8dg\x0e\xf1\x16\x8d\xa0\x87\xa3\x0f5xmiF\xd2\xed\xed\xed\xab\xb5a:Q\u014cbaY\x1b\xf9T\xd7+\x16\x92\x8b\xb6\x12\xa1l*\x11n\x8c\u01be\xda\xe0\x9cUZmP\xd3sKV\xdbN\xc7\x1c)S$\xdd\xe0\xb8v8w\xfb\xcar\xb5\xda\xdc\xcc7\xd8t\xb2L66\xe2i\a@\x14\xea\xa3O\t \x95\xcd)\\c\u03f2J\xc3_\xdd\xf4\x01\x9e\x9c\xc8\xe2\u056bj{8\x9c\xbdzU\xcdn\x02\xe0\u04ebj6\x9f\xcf_\xbd\xaa\x86;\xfaq\xb8\xb3\x0f\x8fs\xbe\x8d\x8fs\xbe=\xc7of\xf8\xb8=\x9c\xeb\xb7C\xae/\xf3\xa3\xebd\x1f\xca\xc2<k\x15\x84\xbd@\x05\xe10\xddz\x7ftx{\xeb\xfb\xa3\x15\xfe_l\xaf1h\x05H\xe7Gp\xab\u07d9\x1b\f]\x1d\xfeF?\x0e\xb7\x0ezG\x1f\x7fx\xee\bQ\xdb%(\xdc\xdd\xdb\xe9\xd6\xf7%\x17!P\xa3\x04\x01S\x95\xe7\xa0TP{\na\xb8E\x03\xe8\xed\x15\x82;\x0f\xf7Q\xbb\xba\xe8\xf7\xcd^\x15dq\u020f\x02\x1f\xbe\x90\xfe\x96v\u0485\x1a5\b\xa8{i\x1d~\xe1\xe9\xac\x13\x95R\xaf\bV\x03\xa2.W\xf4\xfau^\xcc\xd2\xf2\x14}\xfa\xbd\xae\xac\xa1\xbb\u0584\x15v\x82\n;\x9a\xddA\x8c\x93\t$SS5\x88\b\xfcgS\x17\xe7X\xbe\xf6\x85\x03E\xf1\xca\x06]8\xeaF(\xb8||4\xdd\x135\xf3s]\xb8\xe9\xf7\xfcd\x98\x8c\xa88\xe4G\xe0\xd6\xd6s#uE\xcb&\xda\x05;P\u07c0\xed\xe4M\xa1_\xdeF\x81f\xd3U*M\xa2\xdfo{\xb6;\x1c\x1e\x81\u02545\xd8FOA\x11\x1auj\xafA\x8c\xeb+\x85\xe7e\xa5\xf0yY)\xad\x17\x85\xb0T\x97\xaa\xe3\x18\xaa`dOo\xa8\xb9d\xf5\x18wG\u01b0M\xdb\x7f\\\xa37W\x85\xc7\u0771\xd9\xe1T\x83\xfb\xd6\xc5\f\u04d0\xac?\x83\xbb\xe5\xe9\x03U\xf1f\xf3[\x8f\xc1\xe7\xe9S\x8bF\xac\u04244i\xbf\xdb\xdf\xed|\xe7\x19\x95\xb6^\x8c\xf6/{sIF\xbe]i\u01db\u0430\xb4\x1da\xb4\x7f\xe9\xab:C{\xc4\xefh\x03\xcdv\xeb`\xb7\xf9\r<\xee\xe6%^\xc9\xe4\xd3=\xd3\xec\x18\xc7 \xf4\xad\xee\xba\xf9h\x9eA\x18\xea\x0f\x80\xb5Q\xd7\xd9b4j\xd3\xdf\xdaL\xf0\x03\xed0k\a\x06\xfe\xe8]\xad\xa0l\x00\x04%c\xbb\xd3#4\xce\xd5,\xef\x0e\x1a\b\xcelYy\a=J=_J\x9e\xceP?\xff\x83T\xec\x1c\xa1\xb1Zml\xc4\x19\xaahr\xb0\xe7\a\xba\x03(+\x7f\x83\xffM<I\x86\xab\xc3\xd1\xd6\xc1\u046b\u066f\xc9%\xfa\x86\xb5fR\xcbaGl\x91\xa1\xc5\xe4`8\xbc1:8\xd8\xde\u06fd\xb1;<8\x18%\b\xadX;\u842b\x95u\xbe\xb1\x01K\xab\xdb\xfb\tx[\xdb\x1a\xf5\xfb\xfc\u03cd\x18\xb8D\xe3\xb7P\xa3\f\x14\xa8.\x05]ljX\xb4\xacQ\xaeXy\xb4\x01\x8ah\x88\x88J[;U\u05ee.}\t\xeao\x04l\xf1\x14u\xa8\xc5I\xbd\xf0\x96\x06\xebE \xc0\xa5\x00\fL\x8d\x17\x03\xea\xcaW*4\x1b\x9d\xfd\x94}\xfcj\xb0zu\x18O\x92\xc3\xdf\x1c\xbe::\xfa\xf5*>\x8c~uD\xe2I\x12O6^\x8d\xc8\xe1o^\xbd:Z\xbdz5 \xbf\x9e\xbc\x1a\x91WG\x1f\xa3W\x99\xcb\xf5D\x8d\f\u0233Y5U\x15\xad\x8et5E[\u06fa\x9f\xe0\xe1X/\xab\xfa\u0251\xc8\x1b8\xc4W\xab\u0639\x11Y\xad6\xd2\xfa^GT\xfd>\xf7\xcc|\x95\x99\x14\xc3\xd1\xc1\xf5\xc4XXDo\x8f[\xad\xea~Q\xf5\xa8\xd2\x0f\xb6\xb4f\u02f6f@\x1b\x8cY\x93i\u01b1_vv[\u05041\xab\xdd\xddF~C,\x01yu\x00\xfez\xb46m\xd1\xef\x17\u0c32\xb4\xd7\xc1\x83{\xaf\x9f>{\xf2\xe2\xc9j\x05\xdaA\x93H/Eq)\xa7\xe4\xf5h\x10mJ8\xbb]\t\xba\xb0\x01\xda\xd2\xd0B\x1c\xd5\xcbnl\xef_A=\\M.\xf4\xfb\xc1r4\xaee',\xee\xe0\xef\n4<\xb6I\xafVJcx\x8e\xf6\xb7/5\xa8\xbe\xc6\u0202\u1e30\xd0(\xe0U\xe1\xb2NnR\xee\x87G\rz\x1d\x1d1t\xf2%vo\xa0&\x1bnT>b:z\xde\xfcp\x9a\x93\ns<t3@\xdcBC.Q\x1bGo\x8d&j\xb0,\x961\xa9IQAG\x84nm\xb9\u0482\xb9%\xa2\n\x8fF7.-\xef//VO\xdc\x1a\u0586\x91\x02\xec\xb2Q\xf9lot\xf3\xe7\xe6V3t\x83\f9\xf9\x04q\xde\u0433\xc5\xcfK\xf3\x12\xf2\x9a\x16\xa8\x9cV\u05e1\xb85\x9c\u011b\x9bu\x9b\x19\x83\xf7CN\xd5\x11!\x898,\xa0b\xccQ\xd1\xe89\xa2\xab,\a\u0589\x89\xc1\xfcO\x1d\x7f\xea\x8a\xf3\xa1\x1eT4\x1cq\x17@\u0123\xa9\x80\x04\xaf\xdbp\a@t\x05\xa1z\x9d\u046f\u043as\u053d\x85#\xa3\xfd\uab95\xd6\xdbf-)\xb9\xf2\xf8\b\xbe1~nNA\xb7BVZx\x860\xd2\a\xa3\xfd?:9\xe3\xf9qM\xd1G\xc7\xcfK\xae\x1e .=\xe8=\xacwm\x97\xe0\xc4v\xdeyL\xc7\x01\xe3\x118\x8d\x99a\x81\xb2\xffk\xf7\rtWa\xac\xec\x8c[\\'\x9dn\xc3[r*\u02458\xdc\xdcTG\f\xdd\xfdY\x8b[\xc4!\xf8\x10\xd6W\x17J\x02\xee}\x02V\x138G\xa2\xb0\xd2\xf3\xf3\xbaZ\xf9{\xa2\x00\xe61\x0e\xfa\xbd\xedN\xf3\xfa\x9b;\xc3\xfd\xeb\xc6Y`\xb6\xe8\u02b17D\xfb##\xe8\x16\xd6U\n\xe5XI\x10-\xa6\xd3S\x1e\x18'\xc0a\xfar\xdf\x0fVIHcl\xb6<\x8e\xecw\x0f\xb8@\x0e\x02\xfbf\xb7\xf4\x03\xe19\xae\xed\xde\xc3#\v\x8c\xb5\xc18\xb9\x04K\xa4\x13,dg4\u06bfq\xb5\xb9\x13\n\x13\xffHs\xa7~\x1f\xb5Q\xa6\xbc,\xa9W\x01_\xdb\n?\x91\xfc\xc7*\x93\u073b\x8d\xa3JeyD\x06\xb0\xa39_\xff\x1c\x19\xce\xd9\xe08\x13\xe0\xe5\u02bb\xb5\xf1/\xd5\xc6*\xd7\x14\x10\x14\xaf\xe2GX\xd1\xd0u|:\xeb\xdfnM\xa17\x7f\xe1\xbc\xe01\x0ew\x84\v\x00\xe9o\x177\a\x9c\x87@\x0fX/\x83W\xd0\xf1\xb6p\xac\x88=>\rw\xbbw\x82.\xb5\xbd\x1e\xa8\x8d\x02jwf\x19C\x8b\x80\"N\xedw\xf8Ue\x96\x90\x92Xx\xb4\xea0;b\xe9\xa1\xda\x04\x945\xb65\xf2\xac\xd6uT\xb59\u0491\u0558\xe4:r\xe6\u06059\u0092\u01d5\x06M\xc0\xc50\xd7\r\x01V\xa0\x97\xc2\x1c\xd0\xe2:\x13D\xaf\x8ft\xf9om\x1b\x13\xc3\"VtH\xb7F\xc4\xf4Z'\x1c\xa4\x1b\xf1\xbf\u051bd\n\a\xbb\xe2\no\x92!\xfc-\x02t\\9|\xb2y\xdcD\xcd\t=A\u05a7op,\xd4\xef\aT~m'\xa8E\x10\xe8\xe2\xe8z\x8b\x8d\x8080;\xd2\x15\f?b\xb7$t\x9b\xf4s\x93w[*j\xb0\xfd\xa974\xb7\x9dq\xbb\x95\xa1zE\u05fa\xbc\xef\x90\xf2\xb6G\xdb7\xf6\u0690\xc3\x18\xec-\x1f\u05a1\xe7\x95\a\x91!\x95l8n\xae\x02\xfam\xc1T\fd\xdbh\x7f+.\xb6\xa4q.X\xd0\xf4\x93!\xf6\xfc\xe6\xa6\xf8\x84\xdd\x1c\x0em/\xbayz8<\xd2\u0288\xa2N\u06fa\xeb\xb1\xf6\x886\xae\xe6\xfe\u0738\xcc\x1b\x01P\x8e\x1f\"N\xd0\xc4ax.A\xafR\x1f&G\xf0Hzu\x05\xfdg\xf4\u0430\xa3\xd0Q\xd5\xcf\x1dM5\xbd\a\xbe\xad~\xf1XDwXW{\xd7\xf2$\xc1\u0689\xd8\xcfb\xba\u00ee\xed\xeb\x13\xc9\x1a\xf9\u048f\xb3Q\x80gR\xb3\x94\x8d\x0e\x0e\xecH\xc8\xfc3\x83\xd7+\x9b\x9b\x9a\xbe\xc1\xa0\xb1\xe8\xf0\u0755\u01b5QZ\x17\xb5\u027c\x04\xe0(\afQ\xdd\u0603vA\xf6\x8c\xa8\xf6o\xec\x7f\x00\xd0\xe1\x04\xd8r\x89\xb4\x1b\xe8^\xa7\x824\x12\x80\x88hp\xf8\x9b\x01p\x8065;(\u079a\xbc\x9am\u0193\xe4\xd5\xe0\xd5l\x93L\x88e\f\x19\xce\xd0v\xc0\x19\"\xaf\xb6\u026b\xa3U<a\xf8\xc5\xea\xd5\xe1\xab#R\u07ee>\"\x00\v\t\u0726W\xf1\xabWd\xf2\xf1\tZ\xa1\u015d\x94\x96\xa9\xc2\xee>c\xc0\xa9?M\xe5\x9db\xc6\u0460\xcb!\xb2E\x11\b\x8dk4\x85\xd8g\x1cj\x04\r\x13SN\n\x17/\x05d\u0288\x80Km\x8ep\x1a`.\x18\"\x8f\xa0\u0137kD\"\xc3\xecr\x90\xb6\xb64d\xb5\xf2x`=n@\xdbx\x03\x84B\x01\b\x05o\x81P(\x14\x1f\x02\xb7\xa8^\a\xdb\u0220\xd7\x11R\x1e\u034a\x84`\x8b\xb0\xaai8\xef5\x14\xd1{cFq\x14\xe1Zqp0\xf4\x8a\xf4\xf1\xab\xf2\xe3kT\xaaB?45\a\xd6Z\xe9\t\x12\xda;\xd2\xfd\x80[\x1c\x1d6\xe4\xd2G\x11\xea3\xfc,\xf1\xf4QD%\x8b0\xa1\x9d\xe9\xa1N\xe8\xd8K\xb0`\xd1\xe1o\xda\xf9\xa4,\x821\xec}6\xe3\xfb\xf8~6\x9f\x1f\x91\x8b\xeduD\xb3F\t\x8f\xe7\xf3#\f\x98\x06I\x95\x98T\xb4)6\xa3U\xb4)7#\x12mF\x93\x88V\xfas_x>\x89h\u03aa\xcdr36\xb9\x83p\x1e?>Do\xc7G\u0194q\x05\xf8\xaa$\u0684\xa8\x11\xf9u\x84j/&\x1e\xe43\x89\x8c~\xadj|A\x97V\xf6/7\xa3x\xc2tyV\xd1\xe6t3\xff\x00\xc3E\xeb\xcfii\xfd9m\xdf\b\x04\xf8\xd0\xce\xdb7t\xed\xb7o\x1c\u03f1\xbf\u04ad\xf7\xaf^\xbd\x9b\u0377^\xbdz7\u07c7\xbf\x9bx;\u01de\xb9\xbd\xf5\xfd\xabW\xef\xa6\xf0\u027b\x19\xbc\x9d\xe1\xdb\x19\u01eey\xf5\xea]:}\xf5\xea\xdd\xf1\b\xc2n\xc0g\xf0\x879\x80s*\xed\x9d\n\xddSi\xffT\xe8\xa0J{\xa8z\xf5\xeeX\x0f\x06S\x9e\xe1\xfe\xbc\xf7\ua542\u03cf_\xbd\x82o\xd3!6<\xe80\x88W\xaf$F\u06be\xa9/\a\xaf^U\xa3\xfd\x9b\x10cts\xc8MB\xfa2\u0497m}\xd9\u0457]}\xd9\u04d7}}\xb9\xa1/:\xcd\u107e\xa4:\a]\xb8=\xb8\xec\f\x87C\x1cs\x87\xd1f\xb1\x19\x1d\xe1\xd8z\xf5j\xb6\x89\xa3\xe7\xb0\u046aGz\xec\xe0\x88:\x82\x11\xd3\x1e\xc1\x90L\xb6\xa96\x05\xf4\xefQd\x86\xc7\xd5\xc3y\xf9a\xc3y\x8eY\xebdO\u0368\xabph\xe78\xc2f&l\xee\x85-0L\x1b\xc8\u0193d\xb6\xca\xf3\xd5b%\xf9\xaa\\\xa9\xd5\x19\aN6=\v\xe3\xdc]=|\xb8z\xb4zvo\xf5|\xf5b\xf5\xf5=\x8cs\xa2\xe3\xfc\xbc\x05`u\xd9\xe4\x87\x14\xcf;'\xe11;\xdf<\u9604\x9dK\u0154.\x9b\xf3\x12\xbe\xd6\xf3\U000ad757eW\xbccz\xcf\xce\xc7\xc39L[h\xc9\xcdhsa&\xe7aJ\xe74\xfa(j|Gg\x18\xeb\u030b\xb5y\xda\x15O\xa7y\xaa\u04e4s\xfd\x15`=\xcf~\x1dO\x92\xd1\xf3\x17\xab\xed\xc7wW;\xcf\xee\xae\xe2\xc9\xc6\xe1h{\u723cz5{\xf19A@\xe7cT\xb4y}D\xa2\xfa\x93R\xad\xb6\xc5l\xb5#g\xc1'\xea\xd4}r{\xeb{\xfc$\xa3o\xbd\xe2\xfc\x8c\xc5\xe5\x9e]\\\xf6o\x1et\xa2&\xec\xde\xdcuhe`\xb2M\x02\xf6ZxRW\x03U<,\xdery'-y\fZ\xdc1\x98\x85+\x92\xa0\x16e\xc3\xe1\xc9\xcdn\u0659\xb6V7\xf6B7\x86\xc3k\t0\xd8\xfd\u00cc\x11{\aP\xd6~\x861\xbf\v\xe1\u022e\xdb\u067eqp5\xf4\xec\x8d\x1b\x96\\\x1e\xed\xde\xdcE\xedN\xcb\u0560\xa5\xb9\xcd\xc4u*\x12\x00\xb5\x01&%\x00\"\u01c6`\x906\xa2g\xff\xac\x1d\xebWT\xb2\u0712\x02\x15\u02edC\x9b\x19St\xe9\x1c\xeb\xcbK\x1d\xeb\xcf\x18\xa7\xf3\x96\xd3\xf7\x85\xf6>\xbf\xbc\u0131\xfei\v\x9d\xf54p\xac\x7f\x06\x8e\xf5g\x9f\xb0i\x97c\xfd\xe2\x12\xc7\xfa\xf3\x0fr\xac_\xe3%\x9d\x01\x88\x10\x9dB\xe0\xec\x1a\xc7\xfas\u03f1~\xa5\xab\x16{m\xb5\xect\xac_\x04\x8e\xf5+\x8f\x13\x95k\xc7\xfa\xa7m\xc7\xfa\xf3\u06b1\xbeF\xcb?\xb3\x01\x81\x1b\xfa9\xe9h\xf3\xf3\xf8\xb4\xedh\x1f\u0714\xb6\xa2\x12\xba\\\xbb\x89\x9a\x1aG\xfbR;\xda_\x04\x8e\xf6\xa7\fLYBG\xfbY\x9c\xb6\x1d\xedO\xffHG\xfb\xf3\x86\xa3\xfd9\xc19P\xb1S\x96\xb3\xf9\xcfq\xb4?\x9f,\x93wq\xe1\x1c\xed#rD\x97b\x06 \x15\x98\u9ad7\x99\x94}|\xa8)\" \x88\x90\x1e\x02\xba\t\u0266w\xf3\xf9\xabj8\x82\xfd\xa7\x1a\x8en\u030f\xf04\xe5\xabs\xfe,\xca\xf8\xdaE9\xd6\xfa\u07201\u1764d\x03\xa6\x02\x01\x95n~\x98\xa7\x1a\u03b4@B{\xab\xd9`h5\xb1\xb33\xea\x86\u073b\xb1;$1\xd8\xeb\x1c\x1c\xdc$M\xf6=\x04vm\x0f\xe0\xb6\xcazwAp\f\r~\xb7\xb7\xb7\ubbc8\xd7,\x83e\x97\x1fV`\x17\x94\xc4:\xab\x1a\x1bD\x1a\xa3\x8f2LRO\x00P!\xe0d\u0172\xb8\u072c\xe8\u0400\xd7\x16\xb1\xa2;\x06\xde\x18M\x93\xba\x8e\xe5\xe0\x00\xeb\x9ani\x97\x8d\xa0\x0f\xcb\x118\x1cF\xba|g\x7ft)\x93\xf7Zp\xb9\x86v\xb5\f\xacQ\xdc\x10/&\")\x108wx\xd0)=5{\xe6\xf6\xf6\xf5\xb8;N\xcd\u00c2\u0122\xf6\xd0\x15p~\xfe\xe6HQ\xa5\xa9[Us\xd7\xf7\x10\xd1a\xd4\x01\x02\x95\xa6EK\xc9\xd2n[\x8f\x8a\u0278=\xe7\u074a\xba\x8e\t\x99\xc8\xe4R\xe7\x13\x0e\x85=\x82+\xe7\xa0 \xb9Q\xb6\x02\xd7\xe3\xc0\xa7?h\xeexg,\xad\x88\x90\x95MG\xea\n\x8c+\xba5\x98<\x0f%Z\xc1\xfdzGV\xb6/<7\x04\xce\x05\xcd\xf6\xee\xfe\xa5\xf8J?\xd3\x1d\x87\xb4\x9c\xc4\xd1\xee\xee\a\x02\x03\x8a\xf8`o\xb8\x8fZXm\xa1\x19J\xc7\xdaB\xb3\x12a\xd7=\xa1Y\xda\xc2\b\xacX\f`\xa5Y\x19\x04\x83j[\xd8\x1b\xbb`\x10\xdbi\xe15\xb4:b\xe8\x96$\xad5\xedB\a\f\xa6\x9d\xaa\x1as<\xaf\xbd\x06\x04\x1e\xa5~\xb1\u05c0\x86\x17\x1f$\u06b4\x8e9Z\x17\x81\x06S\x9bc\xd5\xe5\xf5\xc1h\xb9\x00\xa67|6\xc5\x7f4\xf4\xb1i\xbb\xa5\xd1C\xfd\a\x12\xb1\xe1\xbeH\xadV\xa1\xf2&s\x82\x93\r-c\x80\xafrOq\fI\xe8\x86\xd7t\xa1}\x1cd\xf3xY\xfbn\xa85\xcd|_=;\xdd@\u01de?\x97+5\x8c4\xc0AS\x8d\r\x05\xdfv\x1d\xecp6\x12\u05b3\xd3\u06e7\x1f\xe1vy.\xa6\x97\xbd|*\x8bw\xe7\x18\b\xf3\x03f\xed\xb5K\xa1\xa7\xa4f\xfa\xaf\xad\xe8\u021aZ\x93\x88\n\xbd\xdf\xe9\xb8\n\x1dZ\x19\xd2dod\xf03P\xa8M\xac\x18:+\x1f\xa5K\xb4q+\xe2\x8c$2\x94\x05c\x13\xff\\\xbd\xbbz\xf9\x89=\x11ac|\x1a\xad\xbe\xe1\a\x88\xc2\\rm\xaf%x\xc6\xdc\u07f9f\xa0xJ\xbb\xce\xf1O\x87\xa7\xa6\xb2\xbd\xb5T,\xab-#sV6\xf7\x19\xf0\xe4\x84\u3e2d\x9e\xd0\x1c\x8cZ\u0376e\u02f5\xc1d\xc7(\xb5\xb3\u0428\x1f\xaaz!\xd0'\x90\xdc\xf9N\xf3\u015e\xc8ao\xeb\xf3]\xa2\xc9\xe7KVD\xbfo*\"\bcS\x14S\x1elw\x0e)\xf0\xe5t\u0750z\xce\u0565Cj\xf7F\xb7\x9br\x7fb\xe3R\x9b~\xd8>\u0535\x12n\xe8\xbd)m8\x8c2\xda\xe3\xb5\xcf(\x10\v\xfc\u046e\x15=eRW\x80\xa6\xab*\xa3o^\xe7\f;D\xb7\xe3\xf3\u0743\xebZ\xf7\u0179U\u05bf\xb4\x91\xc1\\\xba[\x85x\xc7\b\xa4\xb6\xad+\xac+P\x15\x03\x15q\xa0NIR\xc4\u01b2\xe6\xc6\xf0\x9a\f\xd0\xd1\xce\xcf\xcf\x02\xb4#m.\x88|}\xed\xea\xd3A\xe5\x9b4\xc1\x88X\xd5\x18\x98Pn\u040c\xea\u0498\xd2\xc2Iw\xf8.\x9cbA\x17\xab\xc4SU\xeex\xad~\x0e'EO\xe7\x86\xc8[zG\xfc\x82\xa9\x892\xcc\x13<\xecK\x92H\x80\xdfL\x990\xcaX\x80B\x81R\u0662v4\x842\xdeB\xa7\x9f1\x1e&P+\xd2a\x02,5\xbe\x9a3\xa0\rh\xb6n\xbe\aM\xc7bp\a\xeeW+I\xa8X\x9b'&i\xc0~\xbby\x99\xbbNp\x8bc\xd5\x06v\xf7;\xf9}\xe6\xa6\xc5\xd2\x03\x96\u0625\xc0\xab\xddByS|9\xb0\xca\a1\nQo\xecu\xea\x0f\x19X|\xb3Q\x1c\f\xad\x8e\xe8\xee\xd0X\a\x18\xeb\u04f2F\x91E\xef\xfe\x00v\xa8I?\x84\xae\u0424\x9f\xf1\u07ffdy\x1cw\t\xb8\xb5\x9b\u843c\ub271V\n\xda\x18\x8d\xb5\tj\x97\xf18\x02hRNh\xbeZ\u0175?\xe4OFZ\x10J\u02d8\xd3)\x1a\f\x13\x9a\xa3Vr\x11\vz\x83V\x84x0+\xf63\x8d\xb6\x92\u0182\xaa\xc3\xe5\x91'\xc2\v\x9a\x7f\xb9\xa6\xe8m\xac\xabK\xc1y\x8b\xe5z\x8c\xf6\xac_\x01\r\xb5~\r@}s\xd2#\xa0\xba\x9d\xf4{\xbb\u076a'\x1e\x86\xa7\xf6\xcf@\u04da?\x80\x04:\xf8T\xd1\xdd\xf4!~\x87+\x86\xa6{2\xc9h^\xcf7\xabF\xb0\xe3\xb8\x001\xa7i\xac\xe8.\xa1\x82\xe6\x1a0cg\xff`\xbfS\x99\x1a\xbc\xa1\x90\xeb\xd5\xcf\xec\x8a\x15\xe0\xba\xef\x1dt/\xa9\x06\xf9\xac\xc1\f\x01\xcf\x15\xcds\xc9\x15\xf8\xc1~\xb53]\xed\u0533\x06,M\ft\xbfa\xceM\xb4r\xdc\x0ed\x0f\x01\xc4\xc0\xe5\xca\xf9:-\xe4\\\xe0\xe1\uee98\x1a\x99\xe8f\xf7\xd82\xbc\uf08d>\x1e^\xe7\xa5!\u6680b\x8c\x15\xab\x15g\x8cm\x8d>\x1eNF7\x0en\xec\x1f\xec\x8c\x00\"{\x1b\x8c\x94\xf9\xf6\xc1\xf6\xafc~k8\xd9\x1a%#\x92p\xdd\x01\xc3D\xfby\xe6\xc9\x105\xeb\xf6:}-cA\xafU\xa8E\x05\xd9?7r\xbb\x0fcj\"&jK$\nS\xbf\xb1\u04c9\x0f\xf3!H\xbamq\x04Z<\xdc\xdc\xedj>\xf4\x7f\xe1\x1f\x91\xac+;\x8dv\xfb\xf1o\x0e\xb76\x8f\x86\xef\xc0\xf6:\u075a\x83\xb1J\x86\xaeM\x87\u01c7\u00d1~\xac\xe0\xb18\x1cn\xdd\xd0\xcf9[\xa6\xb2\xe4\x0f\x84\xbaB\x89\xa2ux\t\x1c\xe4\xa4\x1e\x9b\xfdq\xfa\x18\x82\xf0|f\x05\xcf]\x87W\x83\xc56qw1I\xf8\x98k\xfc`\x80\xf7J\xd4\xdaS\xdf\xd8h\xe5l;w\x13\xbe\x92\x96\x1b!Xi\xad\x8e\xdc$X\xad*\x1b6\xc9c\xeb2`\x9bP1\xd9Nn\x92\xc4\xd9,M\x1e\xa7\x8f\x93M\xf4\xc5s\x89_~\x0fj\xfcC\x91x\xac\xf1\x1f\x8e\x85\xaeE\x00\xbc\xbb\x90\x0f\xf3^\x1eEVm\bE]\x9d\x05\xbc9\xdc#q\xa4\x8a\x97\u02e5\x1ePM\xc5l\xc4F\xeeZ\xf6\x0f\x86\u06d6\x89\xb3\xe3\xecy\r\xdf[{n\u06fb\xf1\x01K :\"!\xb4v\x92\xa4jKs\xa5\xfd\xa7\xa3GJ\xacJb\x05\x8b\xca\n\x16ol\u07fcq5 \xfe\xe8\x8ar\xf8,,H\x90*\xfcG\x96\xe5\xc1\xfe\xee\r\xbf\u0362\xaa\xe4=\x18_S\x159V\x16\x80\\l[zf4\xdc&c58\xcdNN\xf3\xec\xe4T\xb1\x8czO\xb7+Ut\xa9\xba\u0452\xa2\x9c\x0e\x0e\xc6\x17k\xbad\xd3AY\x1d\x97\\\xadVr\x90g\xa5z\x98\x8a\x93*=\xe1e\f2\x9a\xe9`)\xf9<{GO\xd9\xd2\x1ay\x03\xda\xe4X\xf7\xba\x96\u03a4\x9as\u04de\r\x9a -<\"\xf4\xb7:\xd2o\xd1\xff/N.\xda;)T\xef\xb7\x7f\xae\xfcmD9\x19\x97\xecB\xf2\x9c\x9f\xc1\x011\x19\xd2\u0714\x06\r\xe2\x8c+\xa8\u00e35\x15\x1f\x14ml\x9cF\xcfn\x9d\x8eI\u0396\x80p)\x81D\xb5\x95\x8cs\xd8{\x90\u065e\xa3\x9bH2\xb0I\xb1\x9cV\x03\x97\xc7'e}\xdf\xef\xc7%\xabH\xf0Z4^\v*\x18P@\xa5K\x0fH\xa3A\u0267\x85\x98}\xcaK\xc5|\x1f\x9aT\r$?\xc9J\u0165-Ys\xe0\xb4\"`8|\x19tZ'9\xda\xe8V?\xbb\xdby\x96v\xaaD\u0482\xf11\x18\x83\xc4\x05\xb8l\x00\xe3\x10\xa5\t:\xe4\xa5\x15D\x86i\xf02.\x00\xe9\xea\xc2\xd6\xf7q\xba\xe0\x89X\x935-=Fd:\x9b\xbd\xe0\xefT{#\xa3\x82J\xad\x11Y\xaat\x8a\x8e\b\xa3\x88\xd5\xc4*P\xa8\x87\xd2)\xcc\x1f\xd1\x18\xadBN\xb3|&\xb98\xaco\xeb(p\x12V\xfc\x1d\xf0m\x98@K\x85\x89\xd0\v\xfa&\xe3\x89\xf7\x05\xaa\xfc]@\x84D\x7f`F\x10\xef(\xfd\x97\xfc\xfcm!g\x8d&\u00c2\x17K.\x1e\x173D\x96\xc3\x00S\u0658\x9b\xe7i^\x94\x1cc\xb4\xd3}^\x1d\xe7\xdd]\xefi\xa8b\xd3P\xc9\u0121WM\u8a41,\n\x05)\xbbJ\x01\x93{b\xea\xc4s\x0eDfDUz\x82\xdd\x12\x95\xcbTD\xd4H*2^&\x17\xd3<-K|\t\xda\xe8\xd4&\x93\x14\x80\x16%]\xb2\xac\xbe\x1dL\xd1\xea;N\x1b\x95\xb1\xcdp\x99\xb6\xb1\xae\x84\xa9Q\xb1\x84\x18\xd04iY>\u0165f\x93\xc3H8TA\r\x7fYM\x84_\x13X\x0fd\xa3\xd3\vB\x95\xbd\vk\xe1\xfa\xaam\xa4\a\xe5\xd7\u0196\x1d\x9f\xdc\xces\xf8\xaadU\xf0n\x9e\x894\a\r\xde0X\x15\x9f\xbfx\xf4\xb0=i\xa3h=\xd6\xe6\x1f\xd1i\xfeC\xb9\x15\xd5,\x82\u0327\xaa\x81b\x92\x03\xeb?\x0f\x80\x8f\xe1d\x18\vX\u06c9Y\xba\x7f\xd9\xda,\xd2EsiF\xff\x15\xc1\xea\xc9I\x9d\xccK\xf1F\x14oE\xcf-\xc5\xf8a/+{\xa2P=\xbbV\xf0\x99M\xaaU$\xf5\xb3\xb7\v\xe5\xf1*\xe1\u8673\x94\u04209^\xbf\xe6\x8bL).\x93\x92zC,\xc9\xd7\b^Po\x97\xb1\xaaW\xae\x84\xd3\xecD\x14\x92?\xc8s~\x92\xe6%\xba\x1b\f\x12\xae\xb0\x81i6\xe0R\x16\xf2Y\x9a\x95|f\x8a\x1f\x84\x99%\xde\u06eb\xb2z\xa3\xa8w\xad\xcc\xed\x12f\xe5\xc9\x06\xa6\xdc\ud27d\xaeU5\xe0 y\xe1O#\xa6U\xc3\xddG\xec\xc2\x1f\xfd\xb4\x1e\xbe\xec0\x88wT\xa7Y\u0160\xbdK\xb7o\xec\x1a\x06|\x8b\x10QW\xc3\u0589\xeb\xa4OT~\x18>^\xc0\x15\v\xa4S\x9d|.\x93fZ\x96\u0649\xe8MS\x01\xc3\xee\x98\xf7P6:\xeb\xbd\xcd\xd4)\x92\xaa\xbdB\xf6\x9c\x81L\r\x1a\xee,\xf2\xd6\xdd\xf6\x16\xf2\x1c\xb9\xe9A.!\xf7\x1c\xf9U\x86\xd9\x1bG\xe9\xf14\xc2\xe1\xc9\x0f\xf7\x8eX\x04Z\xa9\xd1^\xc4Xw\xe3\xc1r\x05\xbdy8<j\x83\xd4*v\x01\x04\xcfp,n\x8d\x86c\xb1\xb9I\xd4a\xf4:\xda\xd4Y\r\xe6\xb2X\xdc1\xfa\xee\xb1 G\u01af\xe8p\xb4\xbd\xb3\xbb\xb7\x7f\xe3\xe6A\xb4qe\xae\x8a\x00\x1af\xdce\x95\x89\x0enI\xed\x06%\xac\xb0\x04\xae\x92\x0e\x81\xea\xce\xf8\xfc\xe44\xfb\xe1M\xbe\x10\xc5\xf2GY\xaa\b\x85\x80\n>\xec\xb6\xfe\x91\x87\xdc\xd8\xfet\x7f_\xb7\x16\x98b\xc6A\xdb\x03\u01bb\xf4\x8aV\xeb\x9e\xd7\a\xfd\x98L\x82o<\xf9:8\xa6\xb2\xed\x8b\xc0\xed\x1a\xc33g\xa3q~\xab\u0255\x19\u76dbu\xf4)\xd0?\x16\xbb1vq\x01\x9d\x8d\b-\xcc\xc84\x94Zu8\x05o\xfeS\r\u06e6\xc8E\xc9T\x9c\xf9l\xb1\xe1xy\xcb\xe5\xb2\xdc\xdc$\xd2&P\x02{\f\u04c0;H\x06\xaf\xa4\x86/G\xe6\xc8~\x87\xf3\u034e#\xed\xa3t\xd9\xef?J\x97\x9e\x88\xa9\xe8\x1e\x13wy9\x95\xd9R\x15\xb2\u07d7\x93k\xa2\xc4a\x92\x11\x88c#\xa2\x89\xf0\x14\x90S\x8aN\xbc\xde\x02\x12\x9c\u0fce\x9bA\xdc -;`@\x8b\xb9\x9d\xc0s\xae\xfa\xfd\xe7<\x94\x98][\x9d\xf2\xda\xea\x84I\x06\xd5\xc9\x017\xa5\xea\xac\x0e\x82\xf4O\xaa\xba:S\x88\x1b\xa4\u5ab3\uca8e\xc1\x8d\xec\xf7\xcdM\xfd\u0764\x15\x02\x8b\xa9\xcee~YR\xd8:\u6991TX(\x97\xd4\xe9eI=\xe3s\x9d\xd43>o$\x15\x84\ff\\\xf2\xb9Nl\xc6\f\xba\x95\xf7\u06b0Q\xe8\xe2r\xb3^zv\x95\xad\n=a\xfa\xc6{\x87gsz\xdeU\xf6O\xb3\x93\aBM\xf4\xa5]\x0e]\xd0\xe3\xab7\xb2\xb7\x9d#\x0f_\u05b83\x8d\x17\x83Li\xc9\xfd\xc4<\xb7k\xa2\xf3\xbewe\xe2Mys#q\xfa\xee\x03\x11f_w\u00a9<\xe3\xf3\x9cO\xd5\xc4\\\x1bx\xbfI'\n0\xc0\xe7\x1c\x1e\r\x9c\xfd*cM\\\x93I\xa7b\xb6\xfb`\x8d\xf5\x06g\xf5`>\xb6\xbf\xb7K\x06\u04eaT\u0142\xbea\xcf\xfb\xfd\a\xf1s2y\xae\xdb\xe6\xf6Um\xe3\x14.M\x9bx\x80\xc1\x93v\x10&X\x13\x12wB7\xd0\u046c\xa8\x8est\xaa\x1d\x8b\xc1\x8fU\xa1\xf8su\x9e#\xc2\xcc\xe4W\u046f\x92\xe8W\x91C\xfc\xdb\u46f2&\x93\x9ex\xb54[>\xafu\x1b?\x8e>>\xa1Q\x1fR\x1cG\x9en\xed\x0f\xde\xfe\x14\x87\x98q \x91gOQV\x7f\xbbc\x04\xf0~\xff\xb6\xd6a\xa9S{`\u0222{\x8e\xdf\xd9\xfd!\xf7%\uee8d4\x01\u0794\x1f\aj?\x1b\xa0\xd9cR\xf3E\x90\x1bok*\xc0\xb3\xffzk\xcd\xc3h\x00\xb5\xec6\xe3n\xdf\xcd\xd6\xec\xce\xe8M\x02U\ry?\x8eK\x1a\xd5\xfd\x01\n\x06Q\x99\x89\x93\x9cC+\x95^W\xf5\xfb\xb6\x13\x1b/:\x89\xc4_i:\xb9\xe7\xa7\xdd[T%\x12\x8a6\a \x10m\xa2\xbf\"\xae8\x8b\xf4\x9d\xeeh\xed\xc5\"\n!\xc3L\xfb\x94\x83F\xb4I+\x04\xb4;[\x81\x1b\fd\x0e\x89\x16\xef\xb2\xd6krum\x9aE\xa3\xbdl\xde[\xca\xe2,\x9b\xf1\x19u\x15L{\u02e2\xccTv\x06\x03B\xf1\x13.i\xcf\x1a\x10R\xa8\xf5o!\xff\xdf\xfe\x8a\x18\xf5\xd4\r\xac\xb7\x9e\xa5\x0fD\tG\xb2\x88\xacV\xe5 \b\u00a1T\x03o\xd9=\u0447\xe6bU7\xc9n\xca\xff*\xcc\xe3\xd5e\xe5\xff\xad\x92\x15\xff-\xed\xfdv\x9e\xe6%\xff\xad.\xf2\xaft.\xbf\xfamTwU&f\\(\xe8!\u05e0:\xa8\u07cf^\xa9(\b\u0608\xad\x84!\xb6\x81t4\x04\xb1N\x1d\xc7\xde}2\xbc\xb2#\u029e\u0379\x1eS\xaf^\xa9\x88\xf6Ra[\xbc\xf7Io\x18\xb6\xb5\xaf=o\xf5m\xa2\xfa\x80\u04a1\x8d\x13\xc1s\x14\xb4{\x87\xdb\xf3I\x04\xcd\x15%\x11\xb6V4\xbe\xd2I\xfa\xa3\xd8:\x8bh\ri\xe5\xcb4\xd4d\xf4\xf1\xf0c\xf5\xc9p\x12\r\xa3\x04\x8dN\xcd\xea\xa7\xcf\xe2\xd1qv\x92\t\xd5\U000752f6\x19\x89\u0238\xc2p\xf5\x06\x9f\fKu:\xd9K\xcc]\xd0*\x80\xcb.\u0650P\xf9\t[\xf4\xfb\x8bO\x86\x1d\xeb\x9c\xcb\xe8\a\x90\xd1D\x87\x16\x1e2:\xb4\x1aOc\xed0\xa3\u02e7>\x94\xfc\x95Bv\xa3\xe9j\"\x18\x84\x8cy^r<\xf8\xb5\x1b\x86\xbb\xf1\xc1\xbd\xf1\xe1\tE\xc65\x16\x82~\xbf\xe9<J\xf6\"k\xe9pq\x9c\x96<\x11t)\xf9YR\xa3s\xe8x \xa0\x8dQs\xc2o\x8f\x82\x14\x88\x9en\x1c\x1e=\x8b\v\xaa\xc8'\xcc\"\x11D\x87w29\xad\xf2T\x1eyl\xa3w\xb1q\xc7\v\xe3\xa9\u07cf\vVX\xf7\xd4\xc4B\xd0\xd4\xdez/\xb0\x17lo8\u014c\x8eu9\u03bcE7\\\x81),\xf0\x19\x95\x9b#\x10_\xd7\xc0*\xb4\xb4a\x81\x9e\x8b\u05d5X\x8a\xe7MA\x9fv\xd9C\x02\a>\x86#q\xa2w\x9f3\xb7\t}\xfc\x1b\xfb\xf1\xab\xf2\xd7\xf1\u1af7\x1f\x1dm\x92\x8f\xcd9\u031dnGG\xbe\xea\xe0\x1a\x18\xb7/\xd8\xcbX\xd1w\xb5\xae\xa6%J\xa3\xcd\xf8\xf9$Jz\xd1\xe6\xf3$\xea\u0169(\xc4\xf9\xa2\xa8J\x02\x16sG\xd1f\xfc\xc2jF\f'Q\xef\xa2\x17m\xbe0\x9dM{\x10\xa5\xb7\x8e\x128\xa7f\xf3\xf8\x81s\x8ey\x97\u075b\xb8\x99Q\x93\x0f\xbf\x89\xf56\xfd*\x1e\xfc\xfa\x15!\xaf\x0f\x7fC\x8e~\xfd\xd1\xc7\xc6\x10\xfd\xad\xf5\xb6c\xcb\xd8\u0725\xd5juor7y\x18\xdf\xc5\xec\x9a\x1a\x81\x9d\x1b{\xbd\xa9C\xa7\xd4\ub3cb\x01\x8c\xca{\x9a\xfd\xda )\xbc75\x05q\x99\x92\x1cjW?F\x97K\x9d\xa2\xd7\x13\xaen+%\xb3\xe3J\xf15\xb6\x93=-\xff\u0222[\x96\xe9\x11+\x97NC>M\xbffj\x90\xda\x14J\x14\xb0}\u00c6\xe3on}m\xcf\xd9\xdfln\x92\x1f7Y\u050b6\xbf>\xfc\xe6\b\xc7\xd1f\u0122\xcd;\xf1\x93\x18C\xf0\x98@\xa8\xa5\x02h-\xab\x81\xef>\x89\xa8\x11\x1e \xa7\xb7\xdf\xf7\x9f\x9c\xbb\xa1\x18\xa2\x0e\x06\x83\x88P\xb8\xbb\xf5\xf1\xf5e\u07cc>\x89\xa0\xc3~\xc0zg\xf3\x18\x97\\\x93\xa2\x9b\xddf%\xfb<\x1c\xa8\xbd\xe3~\x7f\xa3\xdb\xd3\xc2p\xacn9\x155\xb5\xb9Ip\xd1\x00\xd4\x19\x1a\xbd\x12\x91\xb7t\xf8\xaa\xd0\xf1\u7c0cF\x9b\xef\xe3\xcf\xe91\x0e\xf1$:\xecE\x9b\x9f\x87\xa3\xfa(j\x0e\xb1\x16}k<o}(}\xeb&\xc7G\x8d\x1aBk|d\xea\x81E\xf3\xf6\x14(\xddE\xaf\x11\u058b6?j\xceA\\s\x9a\xb9\xab~\xbf\xc2\x06\x7f\xd39*\xd5\u16e3z\xd1xs\x14\x13\x9f~\x06\xfa\xa6\xfb\xb3A\xa6)\x1a\xf7\xad\r\x88;\xe7e\xbaZ]77\x81\xe0v\x1e\xe9\xf1)o\xc32\xb8.4\xd9\x06\x93\xf5Q\xba\xec\"\xcf]\xa3\x7f\xe1\x81idV\xdb7\x0e\x11v\xbf\xd0\x1b\u01bbXP\x05z\x93\x9bQ\x8f}\u048b6\xdf\u178a\x18\x19\xf7\xe3\xe8Q\xba\x8chj\xd7*\xfa\x05=\xee\xacu\xfea\xb5\u0383Z\xa7?\xb3\xd6\u03f9\xba\xb2\xd6_z\xb5\x9ev\u051a\\|i\xeb\xecW\xf19W\x11\xcd]\x15\xbf\xbc\xa4\x8a\xcb\x0f\xab\xa23MX\xeaJ\xce\xed\xf3\xfcC\xabi\xb8E\x97U\xd5|\xf2i\x1c\x99\x88\x9afn\x16w\xfea\xc5\xf5\x8a\xd7,\xfe\xcf(\xee\x15=\x13\x16\x17\u06ba\xb3\xb8\xa7\x1fV\\\x93\xda\xe95\xe7\xd4v\xc6\xcf\xf8\xbc\x9dqk\x91\xb3\xc0\xe1?g\x953\xf9<\x8c\xdf\xc5\xfas\xed\a\xf1C\xb7\xec\xd5j\u3f33\x8e\xe7\x1f^G\xc8\xfb\xdc\x0e`r}5\x1dp\xfd/\xaa\xe7\xcc\xe5tmFVi\xfe\x17\xb6\xa7\xdb\x06tV\x1bW\xe6\xa5a\xf7\x7fFN\xfd\xfe\xd5\tZ$\xf8\x9f\xbd\xe5}f\xb6<\xfa={=y\x1d+R\x8b@j\xb7\xa1\u029fC\xfa\xedj\x15\x18`\xb8\xaf\xe8w\xac#6\xa8j\xe1!\xb2\xe7\x12\x8d\xe8Wl\xe3\xfb~\xffv\xbf\xef\x00\xb05\x1a(\x96QM\x9e\x02\x81\xaa\x0f\f7\x01\xa9/\xf9nbDn@\xd6\xd2oY\xfc}'\xf2]P.\xc88\b@\xdak\xd2\x0e\u068cz\x90,\u064c\xbfZ\xad\xbe\xc3\xcd\xfe\xf0\xc8*\x1c|\x85D\xddw\xf0o\xcfS\tn\xefG\xfa#\x9f^\xf8\xcc\xd2\v\xdfnF\x17\xeb(9\xc6\x1b\xa0j>C\xaaf\x1d%\x10\u040b6?kQ\n\u0353\xab\x16\u027f\xb8N\xbe\xb9ZuqB\xb1\x15\x01\xb6\xab>\x92=\x0et\xc2^xN\xba\\\x94\xa7^\x12\v\xb7\xe7\xb9\xd7\u03dc\xb9\x80q\x92\xf1d^\x1f\x8fL@\xacj\x81\x93F\xbc\xab\xa1\x95nI\x14'\xa2\x94R\x1cy,\x86\x9e\x18[[\xe5:\xb7G^n\u6a23Z\x9c\xaa\x86G\x90\xadV\f*\x91,\xee!\bMoQH\u079b\x9e\xa62\x9d*.\xa3\xcdX|2\x9aDe\u040f\x8f\x9cZ\u44363\xa4\x8alJ\xdbWw\xe2\xda\xda\xfc\xe3\xf8\xf0W\xaf^\x1d\x01\x86X\xf4\xea\x15\x9c\x9c\xeaW\x87\x1a\t\xe8\xdd\b\xed\xe0\xef\x12j\x99\x7fA\xeb\u07ed5W\x1a\x90b\xa0\x7fv3\x89\x8e#z\x80\x9e\xbaGCt3>\xdaN\xa2yDG;\xe0 |}\xa8\xea\xe3\xe5$z\xf5*\xda\x14\t\xe0\x12E\x9b\xb1\xba5\xda\xd7,\x94\x88l*\u01f1\x8eG\xfbd\xe0iG\xc6^a\x1e\xd6C\xc1L\xbc8\xda\xe4\x00\xd6R\xc7\xf9\xd4\x1fq\x9bp\n\x9d\xf4\xd6\xde\xfb\xfb\u0587p\x10+\x8e6\xd5fDz\x17\xd1f,'\xefcx\x9f\bo:\xe0<\xa9Sy\xef\xc6\xc1\x901\xd7\xd3\xf6|\x12\x19UW8Zl\xc2,\xe1g\x9bj\x00\\\x0e\xd7\x18\x9b\xdc&\x1em\n\xb2\xe9\u016c3y\xe9+?\x01\u07dcJ\v\xa8\v\xdap:\xcfz(\xdb\x11^\xb0\u1e28O;\x05\x88T\x0f\x8b#\x06s\xad \x13\x15\xf3\xc3\x02\xfc\x1d$Q\x84n\x00S\x9auI\x1b\x8e'\x00\x11\x92\xe8\f\uf44b\x14D\xdd6\x8f\x92\r\xc7\xe5-\x8b\xfc7.77Iz\x18}\x14mf\x87\xa5\x96\xd4\x1e\xadm\xdcJ\xaf\xf0\x90}\x85\x98\xed\xfd\xbe\xe9j\xb3\xe3W\xa8B^\xf5\xfb\x95+\xf5ju\xaf\xdf\xd7)VG-\xd6\xfdj\x15\x7f|\xf8\x1b`h|\xacU\x83+2\x91\x9a8UqE9\xd9D&\x05\u0534\x82\x9a\x92\u013c\xad\x9a/\xf4\xd6\xd8U{R\x03\xc6\x0e\xc7\xf9\xad\u0317\x83\xbf\xb3\xebT\x06\x12\xef~\u07e4\x0eK\xb4\x8a1l3:\xb2\x19\xc13\xe6e\xfb^\"~\xe3h\u03c3\xeaD\u037fZ<p\xb1\xf6\x15?\xc8E\xcdp5\\\xe3\x1a\v\xa4w\x9aj\xed\xa2c\xceE\u03e9t\u0503(\xed\xfa\u0787\xe9\xb86\x85\xccP`\n\xb8\x94.c\xbbJ\xd6!1\xa7ClN\x8cY\xacV\x1b@%\xb4\xbfP^*\xb4\xf9\xb9\x8f\xb6\x87!\x86x\x13]@|(*\v#\x85\x114\x8c9DX\xaf7\x1a\xaa,\x9dz\xefuq&\xf5mR\xd4\x14\xa4b\x05B\xfe\x89\xae\xaf\xfdf\x9d\xf8\x0fIZ\xa7 X\x8a\xc0\xcf8\x8dh\x05\xde\x14\xc0\xfc\x88N\x11\xa5\xd86\xfa2&\x17y\xbf_\xa2J\xd7\u0188Z\xa6\u02a4b\xa5%\x02*\x92\xc0G\xb4r\f\x97y\xec\xa3\xdf\xcccs\xbc\xb4h6Y\xbc$\xe3\x1c\x80\x88j\xceH\xe5\xb8\"c\xcd0\x01\xb5=(\xd5xss\n\xe0\xc8e\xbf_\x1eN\x8f\x06\xb2\x121\x19c\x86\xf5Wk\x8d\xf4\xa1k\xd04\xa4g\x8c\xf9\x8d\u0409\x82\xa3\x15\xe10.\x9c\xfd\xc1\x0e\xa6\xeb\x1b\x11\xa4D\x9bI`\x8f\xd4Ga\x15\f\x16\xe1\x0f\x16/J\xf8Z\x0f\x15\x18(\x90N\u0748\xa7\x9ev\xeb\xbc\x12V\xbd,\x0565Su\xbc\x19(\x8d\u0241\xe0\xef\u050bl\xfa\xa6\xcbX\xd2\xfa\xb1\x8c\x9b\xca5[#l\x85f\xf0'#RS-\xa3\xb1h+\xe5hE(\xb15:\xaa\u0368\x0e\xc5\u04782\xecl\xfe\u0594\x9f\xd0\x11\xf0h\xdc\ua683\xfb\xeb9Y\xd3S\x8f\x86\x93\x95hiz\xce+a\f\x18\xb1\xfd\uae935\x95\x03\x95\xa9\x9c\xb3\xe8X\x16oK.#*\a\xe6\x96m\f\xa9\x1cpq\x06z[r\x90\u02933\x18\xe8rp\xc6e\x99\x15\x82EQ\xfdP\xeaH\x85`3\x88;\x9b=\xccJ\xc5\x05\x97\xf8\\\x88)\xd77\xf39^%_\x14g<\x88\xa3\x83n\xe7\xb9\r-1\x18\x14\b\xf1f)\xf9\x92\x8b0]\x13\xf6DL\u00f4r\x97D\x9b\x86\x05\rBi\x01\u0743\xf7\xad%\u0580\xca\xdb\xc8V\t\xb4\xac\x96\xb0\xc6\xe3\nK\xe5`\xfav\u05a1\xf9\xfaq\x84\xefNg\x99\xfc\xb0L0\xea%YT\x8b\xb4|\xd3\xce\x04mr\x0f\xb6o\x04\x98m\x9d\x16\x17{\xc3\xdd\xd1.\tw\xa4p\x7fY\xa3\xd3U\xae\xbeI\xa5\xc8\u0109\xb6O-h\xa7\x16\xa1'\xfa\xc6l)bs\u2091m0&\x9d \xbc\xae\xe5\x9d4\u03e1\t\xe1t\x01\x12\xc7\x12\xd4`\xb3Y\xaa\nY\xf6f\x99\xe4S\x95\x9f\xb7j\xdf;>\xef\xa9S\xde\xfb-\xa8\x85l\xc1\x00/\x7f\xdb[\xa6\xd37\xe9\t\x1f\xf4^\x96\xbcNo0=\xe5\xd37\xee1&=U\xa0\xd2$$\xb0\x18\xf4\x9e\xf1t\xa6\xc9\xf4T\xf5N\x95Z&\x1f\x7f<?\x1e,\xf8\xc7U\u0277\xf0\xe3\xad:\x97\x88\x8cuG\x95x\x8ec\xd1\x03q\x96\xca,\x15\xaa\xf7uV\xe4)\xee\x19\xb4\xf4\xd6\x18\xe5\xa3\xd5\xf1AV>\xd3.\x06f\xc6c\xbe`\x178\xe7\x12N\xb5p\x11n\x8a\"O8\xae\xb9\t\xa7Z0\x97p\xaaO\xd8\t\xb7\x8eQ8\xd5,\u0684\xd3T@\x02\x98\u0413y\xa2\xa8Q$O\xb8\xbd\x83\u0283\xe2\xaf!\xb70\x12\xb0\xe9]\xb2\x18R\b^_\xf1\x13E\xcb\xd3\x14\xaf\xfc]:U\x89\xa2a{&)m\r\x8f\xa4\xf0L\x9d]L&\xb47\x8f\xbd\xfd\x83\xc0\xf7\x83\x1bH\"\xc6!K@\xef\x1c\xc7e[?\xb7\x8e\x1c=\xbfw\xe7\u067d\x17\xaf\xef>y\xfd\xf8\u024b\xd7Oo?\x7f\xfe\xfa\xc5\xe7\x0f\x9e\xbf~\xf2\xec\xf5wO^\xbe\xfe\xe6\xc1\u00c7\xaf?\xbd\xf7\xfa\xfe\x83g\xf7\xeeF\xe0\xe8u\xef\xe6\x8dv\x82^7\xf9\x87\xd5k\x8e\xc0\xdeQ\xb6\xdbK\x9b\xd6\xfa\x10\xa0\xf1\x11\xf5#*\xd1\xe5\x1a\x8b\x8c\u03bbV\x02i\ubb2fV\x1d\a\x8e^j\x8c\xcd?~\xb5\xf9\xf1\u02583n\xd4N\x85!3\u0608\xef\x8cA\x11\xb1)\xc0-\xe0,\xf9%:\u024aK\u67ac\xfa\x83;D\x94 p\xae>)\x11\x95\xab$\xe3\x908\xae\xc0Q\xaf\x9e\xb85\xf8!?\u030f|\xa4\xb3?\xb7=\x8c\b]\xb0\x99;\x95K2^|\u0086\x93x\xcafh\x81\xa5d<\xa4\v0\xe1v\u03cb\xcd\x11!\t\xc4\x00\xbd\xc1\b\xec\xb1f|Z\xcc\xf8\xcbg\x0f\xee\x14\x8be!\xb8P\xf1\x94\xd0\u04ee\xf0%\xa1*N\xe9\x9cL\x02\xe0\xa78=\x04\x00S\xf8\xd7\x1b\xe5)I\xe0\x81\x1d\xc2?==\xd2O\xce\xd3r\n\x06}\xdb\x00\nv\x896\xb8\xbfH[\xaf\x98\x96\x03\xab\x9dcZ\xf7\xfb\xce,\x13C\xad\xee\x82\vnj,`,\xd3i6RV\xde\xcfD\x86\xf8\xf4\x13\x0e.\xcbf|\x9eV\xb9Jj\xbc\xe9\xf1UC\u0391T\x8d\x91G\xad\u00f5~?\xe6\xce\x14\xb9\u0373\x9b\xf8\n\u027c\xa99]X\xa7\xff\\\xb4\xbaC\xc5\x05!\x9b\xce\xd7Y\xd8'p*%\x13\xf8\xbfT\x17;\xdd\xecL\x94\xa30\u00aa\v$\x97\u0142\xf4\x83\x88\xc5\xe4\xf22^\x9a\x13\x9c\x9a\xd7ttc\xff\u01a5\x1b\xa7\x1a\xe8\xa1\xc8\xd4\x00\x15k\u0402~\xef\xe6\rB\xd5@'\xcb\xd4@\x0f\x88l~\x0e\x06\x930\xb6`E\xbb12\x98\xad\x9d\x9b\xf1\xb5f\x05\xf5R%\xe3\x10\xb9\xbccnx\\\"X5((d\xb4\xa4\x16Z)`\x1d\x9a!x\xe9v\xb4\x12\xbf,\x11\xbf\xce\r\xd31\x84(\u0495\x94@\x0e\xc0\xf1\x03\x96\x98\xb4\x97\x89\xb6\x91\f\x9a\xcaG\x93\x88P\x84\xb3\x12v\xa9M\xb5\xc48\x96\x8c\x1f\xa6G\u03ab\xa1\xec\xf77\xb2\xf2q\xfa8\x96\xa0\xc2)q\x19IY\x11\xa7\xc0P)bI\xec\xc8O\xf5'\f\u0210i!T&*>6\xae\fR\x14\x8fK\xa7\xc4a\x19\x02\x13\xb5\x99\x19VN?\xc2\xd1Am\xb7w\x8a\xa2\xa9`\x1f\u01c7\xbfa\x93\xffO\xffh\x93\xb0I|\xf8\x9b\xfe\u046f\x81C\aF\x86c\u0144v_\xc8\xc9\xd8\xce$\x19\x83\x9a\x06Z\xeb\xc4\xeap\xfb\x88\x8c[\xe5\xcdV\xab\x14m\x12W\xab\xb88L\x8fX\xedD\xa1@s\xf5\xdd\x00\xf3\x06y\xcd\x1a\xdaa\xb8\x7f`\x1cF\xec\x1f\xec\xee!\x02\x874.\x8d\xeaE\x04\xed\x86z\xfc\xc2\xf7il\x19`\u0685C\xc9\xd5]\xbd\f\xc1\x9a@\x03\x19\x97\x16\x05\x10\x8c\xa8\xad\x8a\x80\xb1\u01f8\xf7\xa0\x0f\x16\x8b*WY\x9e\tx\xe7\xee)<\x95E%\xa7\u0729\"]j\xcb\xe5Q\x8d\x1e\xe8\x8b\xe4'\xfc\u0772W\u021e\xf9\x8e\x8c\x9b\x85Q\xfd\xfe\x16\x1c\x91\x94\u06dd\xa2\xcc8\x8e\xf1\x8a\xd5\x11k\x11\x915\xc6R\xc5\x1b.J\x8d2\x144\x87==.\xd2w\xc6g=rp'\xf8o\xf4\x1dy\xa0\xd3\xfdn\xd2xNFC\xe3\x12\u03ec\xf5\xcfRq\u0099\n\x1e'\xe1c\u048a?\x98\xe6\x85\xe01,C2\x15\xb3\a\x02g\x12\xc42\x8f\u033d \xeb\x13\xee\x9d\x15t\x1fC\x90WUzxD\xd6\x18\xe8\x99\xccJZ\u040c\x96\xd674\u01e1\xa4\xf7\xc0^:x\xf6\xe4\u024b\xc4\xdc\x7f\xf6\xec\xc9\u02e7\tr\xd2\xe7E\x9e\x17o\xf9\xec\xd3\xf3\u054a\x0fD\xa1\ueec0\x9a\xa5\n3\b\u05ac\x05\x87\xdd\xd0s\xf6\xcd\a'\xb2\xa8\x96\x9a\x99\b{\x97\xff\u032cs1\u043c\xde\x1a\xc1\x8c\x8f\"\x9a\xb1!-Y,\x18\xb7fc\x13]E\xa8\xfes\x9e\xa3\x19\x94}\x056\xf0h1\xe6 \xf5\xc0\xc3T\x06lU\xe3\x8a\x1c\x1bA\x1cfG\x1e\u0427_\xd4X\x1d\x06\x85:b\x92P96\r\xf1\xf4\xc9\xf3\a/\x1e<y\xec\xf6o\xfb\xe2\xf9\xbd\x17\x89\xa6\xc3t.\xfc\xdd2\x153\x0f9\xc1\x9e\xe7']fP\xad\nU\xb8y\xd9\u011f\xdd{z\xef\x85\xce\x17Z\xb6`\xfe@\x889`]S\x1c{\f\xf5r'\x18\xb2i\a2\xc2\x02\xbc\xab[s\x9c\xdd*\xdaMb\x8c\r\xbcV\x91u\xf6\xf7\xef=\xbb\xf7\xf8\u03bd\xa4\xb6\xb3\u04b1\xb7FG\xb8\x19\x98\x88w>\xbf\xfd,\u0464fc\xce\xf6\xfbu\x15A&\x1b\x13\u04c9\xaax\xa2N\x8d\xf4\xc0$J\x12s3\x0e$Za\x8b\x81\x8b\xaf\xf0cOF\x10\x1f\u0738\x85^[o\xb1\xd1\xf6\xf6dkg;\xd9\u07f3!\a\xc3\xc9\xcev2$k\xaf0\xe6\u04cd\xa0]\x87tD\xd6~\xa7\x84\xe21\xb7d\x16\x13#\xbc\x8a\x1b\x9f\xd7\xfe\xd2\bI\xf8\xe1\xe5o\x8f\xd6\xf5x\xd1\xf2*\x98\x89\xb0\xb5\xe1M\x89\rK<g\u0785k\xabqW\xf4g\xb7\x1f\x7fv\xaf\x19\x1f\x9a\x8f\xf2\x81*\xc8\xf8\"\xe7\xca0\xb9\n\x9c\xab\xf0\xac-\xf6\xf8\xa0\xe4\xcagYa\\\xd9\x18\xd5\x10\xe9P\x18;1\xe0\x02\xc1\xc6\xdc\xe8rb\x13\xe6l8\u6dec\fd\xccm\xa2\x82\x19\x97\xf91:\xafl\x8f\aA\xc6b\x03\xfd\xfe\xe9<\x8a\u069a\fW\x9e\u0265\xab&\x9cH\x94LQ,|\xf9\xda:\u0204\xe2\xb2\xd4\xd2\xe3\xf5\xda\xcd'\x1f\xc4y\x13Q\x94\xe7y\x81\xd6c\xea\x14;\xb0X\xc4\xe4\xd7\xf1hSm\x019{\xc2U\xcfO\xbe\xb9\x14K\xbd\xfe\xd7\xf7\xab\x95\ue4ddm:\xda\xde'\ubc99\x805\x935\x9f\xf2u\xa9R\x95M{\x907\x7f\xb7\x8ck\x9b\x90\xcb\xf4\x165\xdd\x05\xd9\x18\xc8l\xf8\xc4\xc3\x11Qz*\xf2w\xcbI,1\x1eZ7\x10Z\xbf`\x92$\xb1\xf4b\x92Ac\xabDl\n\x11\x13[\xbc\xb2:IeL.t\x96\u07aex\xc2E\aS\x8b\x0f\\}N3t-\x06L\xae\xed\x9b\xdb\xd71\xb9P \x03Cf\x7foog\x9f\xa6lw\xfb`\xf7`\xff\xc6\xf6\xc1\x9e9[#\xac\xd6\xc1>1\u0437\xb4dbp2\x98\xca\xf3\xa5*V+\xb8_\x94w\xf0i\f,\xf3\xc1\t\x87\x96\x9f\x15\x8b\xafaR\x95\x93+<\xe8\xf1OR\x8f~\xc1\xfe2D\f\xf8\x9a\xe4%P1\xaa(z\x8bT\x9c\xf7\xf4`\xe9\x1d\x9f+d5\xe9\x83A6H\U000fc63e\x14e:\u71a9\xce?\x19\xa2X\xfb\x93\u00b1\x91+6\x1cW\xb7\xf8\xb8\xdad\x05i\x151\x16F\xc6\\\xd1j\xb3 D\xabWwD\xbbLh\xe6\xd8\xf55\x1f<\xf6!\x9f\x95f\"k\xa49\x87\xfc\x91\\\xe2\u076dA\xcf=\xe7\xd3Jr[}}&\xee\x9dh\x98X\x14Su\xf2\xff\xb2\xb2gX\u0483W\x02\x98~wNe\x01f\xfd\xf73\xc9\xe7\xc5; \n\x1f\xc0\x94\x15\\\xf5\xee\xbd[\xe6\x85\xe4\xb27\x1ai\\\xf6\u075d\u19404\xe6,\xa6\x05f\xf6\x04\x16+pD\xc8\xcbG\u016c\x02Q\xfa\x85\xb6eG\xe3y5\xb8S,\xcf_\x14w\xf2ly\\\xa4rf\xce\xd8f\f\xa61\xa2\x8c\x1d\xec\x12\x18\x86\xf0\xb4=\xdc\xdf\x1d\x12\x8f\xf3\x9a\xfa\xdb\x05\xe8\xdc\xd7YMxra\xf9\x00|\xdd\x10\u0799O\xb2?\u029a\xaf\xcb6\xdaB\xc1v\xa1vwk7\xdb\xecxCW\u01c5\xd7fm\xb5Y\x9d-[\xe22\xa4Y\xa0\x04R\xfa\x92\xf2\x90=\x01C\xf5*\x1bGk\bwU\x1cHF\xa1\x05\x86\x1c\u0333\\q\xdf\x03[\x8b#x\xa9i-\x94q\xc0\x9di\xe2\x9a\x10b\x9ck[\x11\v\xad\u03d7\xc2\xc7#\xb0\vE\b^x\xb1\x1e;\xa2\xbb\xbd\xa8\\\x11\xf7bM\xd3f3\xc1\x12\x81\x9e oY\u05eac\xb9\xb9I\x04K\x0f\xe5\x11\xad\x0f<\x02t\xa5\xf1\x94)\x8e\x18\xc7\r\xdb\x1d41\xef\x0fk\xf0\xf4\xda\x06\xff\x99\x05\xfa k\xd0\x1a\xf8\xba\xdf\xf7jP\x1f\x95]\x9b\xe7\xae\x1d7\xe2\x802S\xdd\xf6O\xd1\x1d\x8d\xb6\x00\x19\xf4\u049e>0\xa7e\x808Z\xa7>\u0569{:Icq+$\x91\xf4\xa8\x04\x8ah,\xbdA\xc3\xfc\x87\xd5jc\xe4ar\xe0\xfb\x8d!\x8dp\u04492\xd1C\xb3\xa1\xc1[\x99)\xf3\x8e\xd0\xeee\x8bS\t#\x01q\xb8<\x19\xb4G\xb6l(_\xff\x92e\xa8\x02\u0625\xec6\x01\xceO\xa2|1\xb4\xef\x84\xc5\xe4_\x06\xa6\xb5\x93N\x83\xdb\xe4js\xda\u056a\xf3+X\x18\xe8<X\x1eN\r\r\\\x1f\x19\xfd\xed\x96\u03f9\xe4bj;\x12\xf7\x8c\u04f4\x14\xbf2*\x11\x99\xc8T\x96\xe6\x80e\xd2\u06c2\xfd\x85\u02d8\x0414\xbeF\xad\xad\xc5}\xb1\xb0O\xfa\u037a\xab\xef\xab\xcbQ\xd5UW\xa6(_\xd3YC5n\xd1\xf4\u0604\x9a6\x93\u02faX\xd9\xedH\xd0z\f%\x1bC\xea\x0f x\xb6\x03\x06\xf7-8e\x1c1A9*\v\x9d\x85\\-_\x82\x05\xaf9\x15c#K\xf7t\xee\xd2\x16\xc8'\xcd|q8\xa1Z\x9f(EE\"P\x1e\xaa?8,\x9d\x06\xd9\">\x8d\x05\u04e4\x1d\x8d9\x03\xbd>\x82S\u06ac\x9f\x9c\xe2\x81\xc8iIf\xa0\xe0C\xa3B\xdc\u0273\u96c8v\xb8\xcdDC\xfbeIS\xa6\x06\x8a\xbfS4cjP\b\u0629i\xe9\xa1k\u044a)\xcb\x10\xa09\x93\x96\xf8\x1f\xdc1\x11\x06\x85\xc8\xc1\x015\x9d\xb2xH\v\xfb\x9e\xc4)\xad\xc8\x18<(\xc4)\x9d\">l\xae\xf3\xec\xdc\x1f\u037b\x81)s\xbf\xdf\b\x88\xb5\xffM\xb1\u05abyJ\xb3\x96\xef\xde+\xe0\x93\x953\a\xbd\x04)\xf99\x8c\xee\x1e\x7f\xb7\x94\xbc,q\x88U\xa5\xea\xf1\f\xceO`\xcci\xd1c\x02 e\x8fYe\u01f7v9\x1f+\xf4;j_R\x9f\x7f\x98\x98\xb1\xc8\xfd\xd1\xd6\x1c\x89k \x9b\xfa}3\xee5\x06\xaf`\x8a\xa6\xec\xf0\xe2\r?O\"\xc9\u014cK\vV\xd6\xc0r6\a$\xdd\xc1\x8a\xc5\\\xf70\xb7\xfd\xeb8<\xb4\x86\x16\x82\xf4a\xbf=4(h\x91\x8e\v7:nD#\x1b7:\x02J\xed\xb2\xa1\xe01=\\\f<$\x1a;\xb1\xb8\xa0\u0776J\xa3\xb1jkz(\xbb'\b\xc3C\xac'\x88:\x9a\xf8\x0f\tp\x91\xff\xdc6:\xc6\xda\x18v\xc1\xce(raV\x0ep\u027d&$\xb9\x86r);\x97\x94\x8c\x03\xd9u\u0767\xb1 $\x81\xff\xee\x82\\\xbeV]\x93p,\x8c\x11\x8a;\xbe\xaf\x01\x0fG\xd0\v3S\xf4\x19\xdd<@\xbc\xf5\x11M\xfb\xfdi\xec\xfb\x1dH\t\xcd0\x8cf\x84\xaau\\w\xd5\xd3Jr'\xd4\x00\x90\xc9\x16\xf1N\x17\xf1\x19\x8dL|(`\x19a\xe6\xc5\xf2\xdc\xfaE1c\xc6<\x02\x94\u078d\u075b{{\xd7\x1dF\xf1\xdcA\x9a9\x8ee\xab\f\x92\x86\u0221;\xbb\xbb\xa3\xcb\x12\xff\x05\u757b\xfc\xb8\xa8\u0114?\x10\xcbJ]wZ9\x18m\x1f\xec\xff\xbf\xa7\x95\x0f?\xad\xfc\xbf\xf4\xfc\x9f!=_\xfd\v}B\xcc\xff\x99\xec \x95i\x96X\x90\xcbw\x92\xb3?\u06dd\xc4+\u04df\xc1\x8e\xf2\xff\xcf\a\xb6\xde\xf2\x8f;l,\x1b\x87\x8dyM4{tVh)\xdf\xc4WZ\xad6\xcc]\xbd\x80\x06V\xf6\xad\xb7\x83\xf24]\x04Q:\x96et\xefT\xdb\xd9{\xfa\b\xe8u\xa2\r8\xa5\u05c9Vf1D\xa7\x87G\xd4gR\xe2\xa4\xf3\x8d\x06}0\xbdq\xdb\u036cY\x86\xd9\xc2,%f\x95)\xd8B\xf3\xa0\x03\xaf@\x82\xb5\v!\xa9\xe7r\x84\xac\x91\xe9*\x98\xf4\xfd\x86\xb8\b\xae\x00\xa7\xfa\x85\xe8PU\xfe\xb9\xe7\xf5Y\xe3\xbc>\xfb\xe7wZ^\xf8\xb6^\x7fF\xac\x82E\xb07\x9f\xfd\xd9\x1f\xa9CE\xe2\x8d\x7f\x81\xcfqfIA\x13\x9d\xb15|\x01u\x9fy\\y\x84c\xe5V\x9aq\xbb5\xfe\x84\xec8=\x85*B\xcf\xe2Y\xacX\x16\x18\x02h\xbe\xc1)\xc8b\x1a\x8c\x03>XrYf\xa5\x8a\xad\xf4E\rJ\x05\v\x8f\x96v\xa3\xce\x01\x9e\xd9\x17\x99xh6_\x18\xc5\xcf!Rl\xdbk\xa0Ry\u0095\xfeh\x1d,Az\xfd\bR\x1d\x17\xd6(\x80\u0249\x1a\x88Bes\xf4alQ\xb0?)\x9c\x15\x88{\x9d\xc79\x9c\x898\xa1\x17kz\xa1\xf3Kl\xa0\xc9_\xbf\xd3e\x8a\xa25\xee\x7f\xf8\u04ed\x82\xcd\xf0%?\xbf[\xbc\x15\x8dv\x88\xee\t\x85\xba\xa4\x8c\xc3V\x04\xf9\xce\v9\xe5\x8fm\xd9\\\xebX\x0e\x86Ig,PK\u00f5\"\x151of\xf9i^\xc9F~\xd7&\x0f\xdf\\\x9f\xb6\x1e\u0498J\u019by\x80\xbc\xec\u0590\xd8\x16\xec\x900\xa2.\x9b\x03\x1e2\u02e3\x8d\xae\x06\xb3B\x97\x0fc\x18r+\xe4\a5\ua515\xe6\x94\x05\x16\x05\x1b#Z'\x81\xac\x1e\x98)\xed\xd2t|9\u011a\x82\xf2\xd9e\u0795\x85~\xa3\xc1\xef\xdbn\x9c\xdbE\x11&\x16x<\xf1[\xd0\x160j\rZ\xee\xf5\x86\x9e;c\xeb\xff\u029c\x88\xeb\x9d\xccO\xd1\xeb\xd8\x0e.]\xdd\xc73]>nL\x7f\xb4\xaa\x80_\xec\xd5j#\x16\x80Aua\xab\xd8\xef\u06fb\xd8\xfaC\r\xa7k\xd11]\xa5\x9bl\xc5$\xe8\x90\xc4{\xfa\xb9\xb3K\xea\xc9\x05\xf5\xee\xeav,\x133q=\xe5&\fp\x8a~\xe6\x19\xac\xc8\xcd\xedz|u\x1b\xd5\x16q\xc1\xc0\a\xa2^\xd5\x1e\xc4+\x1a\v\xcbT\x9bZ\xb6\xc7\xddl\xf6r9K\x15o1\xd8\f&\x83V\xcf\xf0\xaa\x12\xa0\xdccy(\x94\xac^\x18\x1b\xa5C\xe0\xfefXjkI3\x9d\x90\xbf\x0e:\xf0K\xd1\xef\xa7\xfa\x92\u92ce\xdaXf\u015aPi\x14>\xe0}\xa3\x11\x80|^\xd3F\xb5\xbf\xc9\xf2\xfc\xa5X\x14\x95P\x1d\x9cEL\x06gR\xbf_\xdf\xc7\u0125s5O\x12\xa8\v\xbfy\n&\x06\xc6\xfe\x82\xa6,\x16n\xe6P\u3a01\x8az`R\xd1j,\u1bca\x9f\x9e\u368c\xee`\xfc\xf0'\xb86\u048a\x89z\x15\xa6S|\xc2\x17K&\x06\x190y\x9e\xf19x\xa5\x8f\x05=\xac\x1d\rx\x1b\xa19\xa9\xd0\xc8\x15)\xa2Q\xa3H\x11\x8d\xdaE\n\x03\x9f\x98\xf5\xdd\xdf[\u0722\x1f\u0652\x00\x7f\xf5\xb4=\x028K'\x17\xeeC\xcb\xe63\x8f\xeb\xa4\xf2_V\xeb\xe4bM\x15\xcb&\x17:y\x1b\x1d\xee\xd7\xc9\xd4\x05O!\"\u03a4\x19[N.\x00\xc9w\x89Am\x1e.\x8e\xa0\x9a\x89\x9b\u01f9]\n\xe6z\xba\xdb\xe6r\x1cH|2\xe3\xe1\x14W\fE\xe8\fY\x92`\xef\x18\xfb\xb4\x95 \x9aK\xa9\x807Y]\u0251\f\xd9s'\xf4,>i\xf1#\xad\x91\x8fn\u0508\xfa\x1e>\xeaf\xb2\xecJ\xdd\x16\xe6\xc9_\x89\xa8\xeb\xefdH\x1b\xfd\x8d\u02a9\xed\x1eO6\x82P\xdd\xe5\x10h\xbb\xd7\xe7\x8a\u07b8\x96'\x8a\xbcM\x12\xd6y,\x1bm\xd0\xe0\x87\xee\xef\x86NF;\x13\xd6\\L\xedP\b\x10\xff\x8ds\xbb\x9d\x9b\xbbC2\x0e9\x935\x87%\x02+\xb02\xf9\xf8c\xc9\u04e9\xfa\xa1\x1c\x14\xf2\xe4\xe3Y1-?\xe6R\x16rK\xeb\xdb\xcb\xc1\xa9Z\xe4\x93\xcc\xda\x7f\xb1h\x93\u04ebL97Y\xd4O\xe5Iyx\x04Q\u06fa\xf5\xbe\x91\xa7\x83\xbe{\x94\tX\xccf`\xa66U=,@\xef\xff\x83\xc0\b\xe3\xdeYVf\xaa\x87\xe8\x06\xe8@B\x9d\xf2\xde\x1c\x88\xff\x05/\xcb\xf4\x84\xa3+\x80\x92c\xb8(\xc4\xd6\xc2&6\xe3g=.\xce2Y\b\xc8\x11?\xc6\x0f1\xfd\xb2\x97\x8aY/\x9d\xcd2h\x9d4\xef\x9d\xf2|9\xaf\xf2\xde[m\xe3U\x0e\x10elC\x1a\xea\\S\xe5Y\xbc\xbd}\x83X\x9b$t\x15\xc0\x15\xad\x02{w#x\xd7\f\x17:\x8d\xf9ft']\xaaJ6\x80)<\x8eL\x85\x9ey\xa8\xd6\tT\xbeN \xfa\x9f\x01\xed\xdb#\xb2\xd6h\xf3\x1b\x9d\uc2b7\x99\x98\x15oW+\xb7\xed\xea\x80\xc1\xac\x98b{_\xfe&\\\x0e\xc0<\xe9\xe3\xdf\x1c&\xb7\xb7\xbe\x7f\x9dn\xbd\x7fU\r\x87w\x86[p\xb9\xbb\x8f\xff7\xf1\xe1>>\xdc\u01c7\xed\xfb\xf7_U\u00dd\x1b\x18m\xe7\xc6]\xfc\xbf\xbf\xf5\xaa\x1a\u07477\xdb\xc3\xe1\x9d-\xbc\u0705\x7f\x8c\xb6=\xba\to\xee\f\xf1\xe1\xfe\xbd\xfb\xaf\xaa\x9d\xe1p\xb4\xf5\xaa\xba{\x03\xbe\xb9\x7f\x80o\xee\u07fd\x03\x0fw\xef\xe3\xc3\xfd\xfbw\x8f\xfe\x9fZ\xb0W[\x83\xe1\xd6\x01d\xfd\xe9\r\xc8f\xa8\xf3\xdc\xc7lv\xeec6\xbbC\x84\x7f<\xbd\u05b5\xc6\f\xd8\xe1\x8b`L\x9dY\xcc\x0fkl\xaa\u0358\xa7S\xbeT\xa5\x81s*\xd96CW\xd9;\xfa\xb2\v\x17c\xefl\xa1\x14\x01\xaf\x90\u024e\xc0r\x99N9+\xac\xb9@\xa9^\x96\x8eK\xc0\x04u\u06fd\xf58a\xad\xc8\xf1\xa8mr)S\x91\xa9\xec=\x7f\xf9\xec!Ku\x906k\xbe\xb7X\xaas\xcd\xc7b\x99\xe1\x18\\\xac\xc7N\f\u065b\xc1\xf6\"\x8b\xaa\xcc\u03dfs\xf5@\b.\x01\x8f\xd2\xea\x7f\xa2\u059e}\xb8\x03\x96\x99|\xd6\xcb\\,P\x98\x93\xbc,\xef\x14Bq\xa1\xee\xcd\xf4)\xde\x18k\xba\u05df\x9f\u03f4\xa2\x9d{\x81\xf0\xa7\u05bfE\xef2\a\x17'09a\xaaC'\f\xe9\u0188r4\xb7\x81\xbb\x8d\x11\x9e\x02\x0e\x0f#\xdd\x17\xa0\x89]r  \xf4\xf3\xd6\xd4\x04\x1c\xd1\xc3\xc8\xf94\x8a\xa8\xbe\xc7PX`\xef\x17\x86\xbc0!jy\xef\xc7*;\x8b(\xdeoq|8:\xea.\x9f^\xcf\xf9\xe1\xf0h|r\xa8lQ\x15\x1daQ\x0fGG\xad\xd2F\u04f0\xa5\x80\x02\x92\xe9\u0249\xb9/\x97<\u03f1\x99\x1d\xb9t\xf4\x01M\xb3\x8d\xf95\x80<[Y\xa7\x95*\x9eq\xb0\x9b\x87\xac\xf8;\u0165H\xf3g\\\xdb\xc78\xbbal\x8eiU\x9a\"A\ar\t\x06\xf2\xcb\xd3\xf4g\x94\xa6\x99\x7f\x94\x82Y\xc6\xfd*\u03dfO%\u7897\x96\xe7b\u0683B\u0747\xec\xf0\xeei\x9e\x9e\xf7\xa0\x89d\x91\x97v\xd8\xc1\x95\xcb\xde,\xc3\"\xcd\xec\xcd\xd3l\n+\xfb\x03anl\xf83\xbe(\x14\x87\x94\x8e\xd3\xe9\x1b\xd8{\x16\x8f\x8b\xaf\xb5\xd17\xef\x9df\xb3\x19\x17\xbd\xbc(\x96=Qh\x89bO\xd4\xef\x8b%\x17\xbde\x9e\x9e\x97\x0fD\x9e\t\u0793<\x9d=\x11\xf9yO\x9a\xf6\xe9I\u0746\xb3^9-\x96p\xe1\xe9\"\xe7e\xd9\xcb\x14_<\x87\xb0\x9f9\xb2w>\xa8\xfb\xa6z\xf6\x01\xfd\\\xe5*[b\xef,*\x85A%Z\x04\xf0\xd9\au\xd0\x0e\xdd\x18v\u0324hj\xf7\xcahV\xbc\x15y\x91~Xr\xbb\xdd\x133\x9a\x169h<\x80\x06md\x9c\x81P\xedo\xecCR\u077f$UY\xbc}\x0ei\u0428T\xa9T\x1f\x94\xd6\xde\xf5\r\x8ct\xc59\xfb\xf8\xf0\xd5Vr\x14\x1f\xa6[\xef\x01\xf9\xaa\xde\x06\x8e}\xe6\xf0\xe1\xe8\xe82\x98\xa9\xb75D\x94\xe6\u011d4\xf6\x18\x80\u05c6\xc5B\xbbV\x88\r+\xb6\x98 25.\xea\xc9\x06Hr\xb6\x1d5\x02@\xf9\x05\xb0\xca\xd4\xe1\x10\xcc[\x9e\xd8{\r\xa1\xaf\x9f\xd0\xf0\u5c7d'\x04\xac\x15C\x1f\x9eTz\xa2`\xb5Z]\xfaV\x9f\x88\x87\xce\xeb_-Q\t\u0353\x951O\xb6\tEh\x9d\xe5$\xd8N\xc4\xd2e\xad\x8cU\xb4\x99M6DsCM\xa2Y\xaa\xd2-\x90>\u011c5\xba\u03a1\x99\xed\x11\x90K\x00}\x8c19i\x983\xa3\xdc\xc5V/\xf0\xce \x031\x91-\b1\x15\x14\xbe\xe5\u064eMLaMz\xbb.qhl\x1d\xb6W\xdbW\x83\xad\xa8\":x\xbf\x19\xbcZ\x8d>Q>\x90$bXH\xe8H-Y%T:vM\u0461\x88\xb0\xb1a\x008\x17\x14p\x11\xed\u04ccrHc\xee\x9c\xdd.`\xf8o\f\x93x\xa6o`\x94\x1b\xe8E3\x02\x98\x98p\x8b}b\xc9\x11\xb0NA[\x1a/\x84F\x002F\x92\xa2I\x9b\x80\xb9u@\x98\x1c1\x97\xf2\u0386\x1d\xcc\xfd~\x14%\"\x89\x15+B\xb2\x87JVt\x10B\xf4\xea\xd2\u0142\x01\x8d\x15\x17&y\xa2\x89\xad\xa2\xdf\xdf\xc0\x01\x8b\u040c\u0466\xa0r\x12V\xe4\xf1\xf3X\xe2Q\xae]A\x01\x9a~k$\x1b\x84\xda:\xe5\xe0\u03ee\x97\xe6\xd9\t\x1e^\xb6\x00z\rw\x82T\xa6\xc7\xd9t\v6\x94\x9e\r\xdc*O\xb39\x88\n\x96\xf6\xc3i\x9e-\xb7\x96\xa9:\xd5w\x12\xf6\x97i\x91\x17r\v\xad{\x96\x06\u0123+lKK\xffK\xf3n)\x8by\xe6\xbe\u059c! \x9ef\xc5\"\x13\xa9_2.`\xe3\u06c2\xfd\xeeD\x16\x95\x98\xf5\xe6Y\x9eo\x15\xcbt\x9a\xa9s\xfd\x80\x05\x99\xe7E1\xdb\xc2\x04\u037d\x8bS\b\xb55O\x17Yn\xeea\xbd\xae\xef\xb6\xd2\xd9\x0f \x05\xd2\x01Jr5=\xb5\x0f\u7e49h\x91J\xf0\xe1\xadn\x8e\x93\xfc|y\xba%\xd2\x057\xb7\x85\u0338P\xba\xbe\xa7\x85\xcc\xde\x17B\xa5y\xc7\xcb3.U6\x85\xe3!\xc4\xdaJgg[\xef\xcc}!\xb3\x93Ll\xbd\xebe\x8b\xf4\x84{M\x93s\xa5\xb8\u0702\x91\x84\x8fP\x84L\x9c\x98\x1a/R\xf9\x86\xcb-.f\xf6v\x91\xb9[\xdcMz\xc5\x19\x97\u062f\xda\xd5G!\xea\x10u\x9aM\xdf\b\xd8\xe7\x97i&\xd4V!g\\\xf6\x96\xa9(J\xbe5\xea-\v\xec\xcb-~\u0185*{\xaeL\xd8\xc5B\xf5\x10\xe6\xc4+j\xa9\x8a\xa5)\x17\xde\u068e(\x95\xcc\xdep8\xf9V'\xa7u1\xc2\xe0\xba,\xa5\x92\xc5\x1b\xbe5K\xcbSDg\xf1\x03\x8a\xf9\xbc\xe4\u0286@%\xa6\xe9\xd2\x7f\x04\xd3s\xfb\xbc\xc8\x14Tt\x91\xb9\x0f\xbc\x12\xc1\xe3\xdbl\xa6N{\xc0\xfd\xd9J\xc5\xf4\xb4\x90\xfa\x1e\xb8\x15\u018a\x06\x9f\xeb\x1a\xc2Y\xb9\u0458uP]\x83Jd\xc0\xab\xd8:\xcef\x99{@C3xR\xe5\xd6\x12Zu\xd1;\xdbJ\x81\x04=\xe6*\x9b\xf6\u03b6\x80/\x06\xb9\x9cme3^\x9c\xc8ty\x8a\xe1\x8b\x14pwR=t\xce\xf8T\x15r\x8b\xcf\xe7|\xaaz0\xa2p\x1c\x9d\xeb[7\x8c\xfc\xa7\xf3\x1e\xf8\xa7uC\b\x04\x93\u040b\x8bb\xc6{\xef\x16\xb9(\x93wy&\xde\xf4\u0799\t\x7f=\xadg\x110-d\xc29=&]\xe7\x85\x16\u014c\x19%\xe9TU@\x99\x9a'9\x95En\x9f\xbc\xdb\xf2\xb4xkn\x11Y\xcb\u079f/\xf9\x9f\xb0\x88\x91A0z\xfb\xf6\xed\xe0\xed\x0er\xaeF\a\a\a\x1fcfQ@\xa8\xbd[\xe4\t\xacP\x11\xc5\xdb<\x15'\xe6\x16\x17\xfa\xe8\xe8O_\x94o\x1f=\x84\xe2\xdc\xfcX\xd8\xed$,\x92J\x8f\x1f\x80\xa6\x18\x9c\x02eQ\x96O\xb0\xcb?\x88\x84\x1c}\x00\x8d~2\xc0f\xf8\\\xf2\xb9\xf9.r\x01\x91N\xc1\xf4\xe9)\x86\\\u05d8\xb8k\xd3\u00e8\x94S\x88\xac\xbfI5\xad\x85g\xd6\xc5m\xfd\xf0GV`\b\x1aZ\x96\x06\xbe\xc7\xe4\xe0\xf5k\x83~\xf4\xe0\xf1\x8b{\xcf\x1e\xdf~\xf8\xdc\xe2 \xbd|~\xaf\x13\x01\x89\xbec\xfb\xc3\xd1p\x87\xbe\xc6\xeb>}\x8e\xd7\x1b\xf4\r^o\xd2\xdbp\x1d\xed\xd2;\xf8|@\x9f\xe0\xf3\x90\xfe\x80\xd7m\xfa\x00\xaf;\xf4\x05\\\xb7\x87\xf41>\xef\u0467x\u0767\xcf0|D\x1f\xe1\xf5&\xbd\x8b\xd7\x03\xfa\x10\xae;C\xfa)^/S\xfa\xb1:\x97\xfa\n\xad\xa5\a\xda}V\x87\x8c\u07f1\xfbq\x84<Y+T\x89\b}]\a.\v\xa9\xd2<\x02\x1fl.l.\xd3\x13\x13\xf3M\x1d\xaa\xd9\u012fa\u0348\b\xbd\xed%\xa1\xb7t\x19\x11z'\b\x05WM\x10\xfa\xa4\x0eEN\xc1;H\xf9\a/\xbfB\xbeM\xe5\xec5\x8c\x05B\x1fxYV\u548b\x12\xf2{\xd1\x0e}\x9dg%\xa4\xf4\xb8~\xb5\xe0\x8b\"\"\xf4i\x1d\x92\xa7\xef\xcf#B\x9f\xd5!\xc7y1}\x13\x11\xea\x02\xf0\xc4\x1b\x11\xfa\xa8\x8eS,\xd3\x1f+>\xc8f\x11\xa1w\xeb\xe0\x19?\xaeN^+\x99N\xb9m\x87\x87\xdeG\xf3y\x89\\\x80\x88\xd0O\xbd\x12\xf0\x93tz\xfeZ\x9f\xd1#\xcdv}O_\xb2\xeb{\xd4\xea\xee\xd6\a\xb6\x1f\x1b\x182 \xff\xecB\x81\x9f\xc0\u02e4\x9dC\xcc\xd9\xcb~\x9f\x1f\xbe<Z\xad\xf8a\xf4/\xfdK6\x8b\b\x90\x86\xf0\xf4V\x9f\xfa\xben\xaa=\xbd'\b\x13\xea\xf1\xaf=d\x1b\xbb\xc4iO\xd8Jf\x8b\x98ho\x8c\xf1\u01efD\xdc\xfbu\x9c\xaa\x1e\x99\x80\xbf\x9d\xf7\x00\x1bbNw\x16Q\x1b\xf1~\xdfoj\x05\xa0o\u0606\x87\xfc\xf9y\xad\xea\xc2W\xaboj\x00\x8co\x00\xb8S\xcbz\xb18\x88`\x98J\xfe\x1c\x8a\xf0\x02zi|I\xb8U\xdd6\x1e|\x15\x98\x05\xab\xb6\xb1\xad\xad\xe4%*\x87\x81_\u0425\x91/\x95\\%\x97&\xb4\ue00f2\xfao\xfd~[1\x10\x81\x85Z\xc1\xb1B\xa0\x91\xa0\xe5%\xe3\xebvDT\xe5SZ\x83\x0e\xd32(\xb0\xde\xc7\xf0\xa1\xd1\x1a\xf6\xaa\xe3\x7f\xd2\xdd\xdd\xf8\x1d\xd4\u0205d\xf3\x98\xf7\xfb\x12T\xd1[\xfe}pP\xd4\xe2\xa2\u008d\x13\xb3\x87\x83\xc7\x19\xc4\xd9i\x87f\xacp\x18\x0e\xb4d\xa9{\x18\x8fn\xb1\xac\xdf\x1f\xde\x02\u01ea\xc5av\xb4\xc1X\n\xc6Q\xa4\xdc\xdaB\xe5j/\xc68\xdb\u06a2\xe5\xd6\x16t\xb4\x17\x17K\r\xf85\xd9j\x05\x97\x92\xcc\n\b\x82\xd8\xc3O\xb6\xb6\xca\xd5\u028f\xed\rU\bv\xdby\x040\x88\xb0'E\x14o#\xb2~{\x9a\xe5<\xae\v@\xc6\u01d2\xa7o\xd6\xeb5z}\xcf\xcf/`\x90\xd3\xcb\u01a70\xd3\"\xe6\x8cO\xf8`\x96\x95\xc0\xbe\x83\x03%\x02\u00a0\x97\xfb\x88L`\x8a\x82\x92\x92\x9b+\x1fy\x80k|\xa0\xd2\x13r\x11\x9e\u5ff6\b4\xfa0?\u06af_D\x0fq\xad4/v\xbc\x17\xcf\xdd:l^\x1et\xbc|\x88\xab\xb1\x8e0\u05186\xdb\xfa2r\xb9s\xf6\xb9\xc9\x1f\xa8\x00\x93\u0628\xfdv\xa0\t\xee:\xd2\xf6vG\xa4\xd7\xcdX\xa3\xae|\x86\xa4\x03\x0f\xce5\xd8\x17\r7\u2fab\xb6\xeem\xd7\xc5\xe9\xec\x96\xd5\xca}\u069a\x06\xeeC\a\x06dz\xe7\xb9-\xd9}\xbb\xf3\xea\xfa\xbc\xb6\xe1O\xf5.\xadCo\xbbP\xbb\xf1\xea\xf076\xfc9\xee\u050f`\x83\xd2o\x1e\xb87\xb6#u\xf8\x8bf8\xf6a\xa77$N\u0728\xfa\xe8#\x1df\n\xff$\xb1C5l\x8f\xe8\x8e\xdd\xeb7\xa3\xc1\x9dB\x94\xd5\xc2\x15\xf5N\xfd\xcdkC\x12\\\xf1\xf1SKJ\xe8\x8f\x7fHjr\x1a\xfa\xdf)\xf4\xb0F\"\xca\xf4H\x14\xd1F\xd9\xe2\bXkj\x12\xdd\u05e4\xc73>7\x80\xf7Q\xe2\x85\xd9\xe1l\x91\x88z_\x98Qe\u009f\xf9\xe1f4\x9aWO\x13(\xe0\xebez\x0e,g\x84\xecz\x9d\x89L\xf9\xba\xd9_\xc4<V>\u011cC^\t7\xe2//GQt\x1c\xc8\x00\x17\x11\x1fL\x0f&\x01\xda\">\xd4\xf2\xde\x1a}\xf1\x8a)\U00099ffdC\xfe\xe3zm\xaa\xbd\x96\xf5\xfbF\xa3\x02u\xb2\x02\x8a\x1c8\xba\xc8\xe8?.\xdeEZ\x98\x18\xc9t\x96i\xfe\xaf\xc7g\xfe^k\x93\xbeF!\x11,\x84o\xb8\\\xad\xe2FH\a\x924\x14q\xe2\x84\t\x89U\xca\x11\xecZ\xfb\x92\xc0\xcbH\xbd\x9d+\x8d\xe4\xb4\tz\xc9\xda\xf1m\x9b\xe3\xed\xf9\x1d\x16\x9d\xc6R\x02\xf2\xbd\xe4M\u025dZ<F\xa3)\xc3\xc0qh\x18\u04e5_\xdd\u0524>\t)\x0e\x93@Q+\xef\x925\r\xa8\x12\u073e\xa1r4\r4|\x81<\xb9\"_O\x9f[\x0469\xf4\xe2\x84k\xa1jG9$f\xfeuK[N\x97`M\x81O\x84\x1d\v\xe0\xbc\xde\xe7\xcdN\xd7n\xc4y\xce\x15\xefA\xaf\xac50\xb97z\xbes\x8e\x91j>\xb8\x1d\xb6AZ\u0621\x9e[B\xab.hk\x11\xeb\xce\x1f\u05d6p\xb1\xd4C\x8c\x0f\xcc\x18k\xc0\x92Z\xd5CBc\xce$\xd1#\"F\x1dg\x9d\"\xba]\xf2\xca\xfaU\x93\xb6\x86\xc9\x04\xebR\x87\x93E\xabY1\xb17FO\x88\x84\x8eE\xbd\x85\x85\x0f\xe0@}f\x950`o:.f\xe7-\xa4w\x13\\\x17\xeb[\xdf(L\xd9\xca\u0686(@\xa5K\u044bPrnJC}\xe1z\xa7\u0194I\xcd\x00\xfc\x89\x89H\xf8\xe0\xf5[\x99\x82\xc4\tU\x14\a\xc6T\xc2$\xbc\xf6\u068bs\xbfdF\xdc3\xf0\xb3\xd4n\x84\xfc\x10*\x1d\xba\xa0\xed5wWG5\x99\x8d\x05\xfb2\xb6\u0475F\xa9\xb9&\x82\xd0FA\xd9EX\xd2DR\x13\xa0k/\xa8\x11\xf7\xe6|\x96\x84K\x1f.\xa0\xc1\xfa\x87!\x93FI\x93\xa0(^\x0f)\xd3\x10\xfa}\xac\xeaOH\xbf\x0fr\xbaZ\xa4\xaa\x90\x85\xe3\xbe\x14\xe6K\x93\x82\x19\xf5_\xc6\xca\x0e]i\xcaR\v\x8f\x04\xa9\U0005165c\xa0r7,u\x91\xaf\x87kn 6j\x9a\xe3\x13C\x01K\xe2\xde\xe1s\xf3\xb5S\x1a\x8f\xca\xeax\x91\xe1\xd6\x01\u023b\x92\x97\\?\xd8\x01\x8e#\xa8-;1\xeb<\xa8\xfe5Vg\xfbfR`m\aF\x930iG\xf4G\f\xf8\xae\xf5?\x80\xc6\xf1\xdf\x13\v\x8c\xea\x1a\u075a\x9d4\x87\x13V5\fb\x1b\x1b\xcdX^\xefHn\xedlP\x85\xfb\x92\xea\xacV\xedWa\x05\x9c\u065d\xeb\xca\r\u05fc\x1b\xe86\xd94\xef\x06\xb6\xb5\xdb\xc1\xcc(\xa8\xcdh\xf4\xb3]b\xc6\n\x97\xeb\xee\xf9\xaag\x9bX\xad\x94?.\\_+\x98?~)\x99Z#\xfd\x85p\x93B\x13\x0f\u0191/B\xd1v\xb4\xdc\xd5+\x05\xc5\xe4D\x9d\x8a\xf0Z\xb6p-\xeb\rf\xd5\xef\x7f\x15\xf3A\xf1Vpy\xd7,\xabDsS\xccQ@L\\1\xbe\xb6C\xf6\xaa\xfa'at\x7f\xc87\x93\x11\xfe\xae\x95\xf2\xd0\xea\x90\x15\xf1\x85Ud\xb2\n\xa1@\x8e\u012a\x83\xee\xa97)Y\x83-X\xb6)\x0f\r\at\xc7\xc2f\xa66\x99\x06\xcdP\xeb\xb8\x06\xf4 \xba\x17\xec#v[]\u030c\a\"u^\x03\x85j\xbc\xe2\x8bu\xc3\u0752\xf0\xdd-)t\\$\x0e\x8b\xa3#\xebl\xc5\"!z\u069e\x05k\x8f\ue3c0\x06\x13\xce\xfb.\xde[\xbd\x10\xad\xc5\x1e\aa\xac \xb4@6\x84\x0e7m\xff\u073e\x86]\x18y\x1b\xba\fQ\xb4\xf9%*\xfek\xfa\xa2\xc3S\x14\xd6\xf6\xb00%\x80\x05\xd0\x1d\xe3\x0e\v/\u07cd!\x02\xff\xc5:\xe7\xa23g2\xb6\xb3k\xb5\xd2q\x8c\xfa\xcfj\x15\x83\xcaUqD\xd66\x86\xa1\x1f\xbcb\u05ddQ\xf2\xc0\b\x1e\x97\x9fn\x95\xb7\x86\xb6\xe9\xc1\x88\x90\xe6n\x1e\xec\xd1]\x9b\xb8\x1b\x8d\xd7\xcc\x00\x7f\xbf\xaexHI`&\xb5\xdbz\xbd\xcc\t\x1fNF5\xf6sj\xb7 \xaf\x96\xcd\xdalkoU!l\xba \xc6,n\xf4\t\xb3\x83\x904\xbf\u0701\xbe`\xe2px\xb4VL\xac\u036a\xaeq\xb4#\x84TY_\xb6\xe7\xeb\xb6\xf92\x0e,M\xf3\xa0\xbe\xc1\xa6\xda\xdaD\xcc \x80\xb5A\x8f@\x01\x04#\xb7\v\xb0[7E\xbd\xdb\xf8\xdf\x03\xf8Bc\xa5\xe9Xf\x84\u066b\x10\xed\xbbc\r\x92^gMyp\xc2\xe3\xef\x94Q}\x1c\xeb\x05\xfd\xf2.\aZ@\x0fVo\xd8\xd6\xeb\xbeV1\xe6\xecR\x99\x10(+Ft\xde\x19c{8\x1c~\\\x9e\x9dx\xfe&N\xb9\xcf\u07b2\x8e\x04\u039c\x17\x81+S\xc1\xc8 H\xbd\"6\xca\xd9\x00\x81\x14\xff\x1e=l\xb9\x12\xb8\xb2\"\xdex\x98\x85\xab\xba\xeeG\xa0\xfb\xaeL\x00Z{r\x8a:!WUE\xbb$\x00Y\x19\xcfN\xc4\x13\u02e9\x01~\u0195\xc9'\x9a\xbb\xbe\xe0\xf4\x8c\xd3\x13\xce\xe23\xde\x01\xbd9pr\u0197\xcf\x1el06\x87b;\xed\xd8\b]\xd7\xf1\x81\v`j\xec\xd6\xd3x\xc1\x19\xb0W\xbau\xc1\xe3h\x96\x9dE\x84x\xdfF\xb7\u02b3\x93O\xc0\xd1\x1f\x0e\x99'\xf3\x98\xd4~\x0f\xc9ft\xebc|O\x15[\xf0\xc1<\x93\xa5\xc2]n\x1c<\x10K\x18\xe2c\xec\xbf\xd3 \x1bc\u0548\x0e\xa3Y\xcctt\xff\x1d\x98,u\x9d\xc0\x1e=\xbf\xbd\\\xf6\xfbxA0z\r+\xfa\xb0\x98\xa6\xf9}\u04c0\x93\xb6\xea\u0655\xf1\x03\x1cPK\xe6\xeaQ\x03Fq\xc9\x19\xf7\x8c-\xce\xeb\x05_\xd5\xde4\xbdJ\xc1\x1a\xd9\xef\v\x9c\xaeyjB\xfb\xfd\x1d\xd4w\x13\u014c\xbf\xa8u\u0790\x9a\x8eu\xf0\xd7v\xa6\x06\xf3\x9e)\x1c(\u01dc]\xa4\"[\xa0\"\xc4\x03epE\xef\x14\x95P\xc0w8Fe\x91\a\xa0\xad\xf2\xa4R%o\x06>\u03f3)o\x84}\x03\xfa\x16:\xec\xdd\xfd\x9c\xbf\xf3n?\x93E\xb54\xcfO\xe4\f\xb8\xe4.hZ\xe4\u0562\xceY?\x96p;7\x89\xccu\no\xed\xfdS\xd4\xce8\xe3\xf6\xf9\xf9\xa9\x04\x19\xb5yz\xccOR\xff\xed\x13( <\x9c\xc8lv[\xf2\xd4\xde?+\xdez\xb7\xf7\xc4\xcc{\x02\x8dM\xffQ\xa5R\xd9\xe7;X\xc2\xf0\xc9\xfbZ\a\xf8\t\x98\x10\x9b\u01bc\x10\xea\x1bT\u0140\xa7<\x13\xfcN\x9e.\x96\xf6\xe1s\xf7\xca\xe8\xb3\u0b6dD!\x97\xa7\xa9n\x1e\x95\x1e?\xcf\xdek\x83\xfblV\xbc\xc5\xc0\xf7\xa8/\x80wE\xb1\xc0\xec\xb2<\x7fR\xa7\x84ZT\xde3\xf0h\x82GY\xbc\xe1w\xadvN\x18\xa4\xf5s\xea\xb0GN\x05\xa7\x0ek\xa5e\x87\u015a\xbe\xe5\xec0\xfa\x86\x1f\xbf\xc9\x14h\x02\x83\x92\xed\xa3\xe2}D\xa3'\xd1Q=\x1f\xee\xf1\x06*\x81S\x00u\x1c\xd1\x1a\x83\x17\x18\xc0zu\x8c\x12\xf0\xf0bN\x01\x1b^\x84\xa1\xe6J\x1e\xb7\xb8|p49\xe6\x87\xfch\x12G\u0466\"F\xb0\x99\xa8\xcdh\xf9\u0393\xbb\xbc\xe3\r<\x14DI@1\xd7y\x0eo\xbaNu\u009d\u05b4^\xaas\xa1\xb0\xb5\x15\x81\xfd\xd5=\x1e\v\n\xf8)T\x92q4\u03cb\x14\x97y\x81\x8a\x95\u0474,\xefc\x10\xb1z\x81u\u00b4\x00H\x05q\u010a\xf5\xda\xc7\xf69\xe6\x97\xe8\u02bc\xe5\xdd\xd81\x8a\xa9M\xed\xe6\x16]\u0706\n\u009b\xdc8%B/\xb5\x84\x1e#\x8a\x036\x97\xb6\x98G\x1e\xc3k<\xd2,\xb8\xa82\xc5\x17\xd8\xc9\x17\xa9\x99b\xa0J\x83Wi\xa65\\\xf8\xe2\x98\xe3L9\xc5\xd0lq\xe2\x8c\xe4\xe0\xe6\r??\xe1\xc2\xcc\x04\x9c\xd1\v\xae0\xb5e*S\x1c\xceZ\xf9\x1f\xee\x14p\xfcp\xf4c\x16koM}\x1e\xac\xa9\xd9<~\r\x05w\x1a\xbc5i\xbaZ]Gd7\xa8\xcb\xd1\xce\rto\xfa\x01\xe4\xb9O\xc2\xdb\xfc\x1a\xa9\xed\x0fuR-)\xff\xa5\xa9\x82\xcdw\xf4\xfa5\xee\xf9\x99\xe8}p\xb9\xf7A\x9d\xd6+\x10\x8e\xdd~\xbf#c|\xd3\xfcz\x9b\xf8\x14\xf1\x9b\xbay\xb7F\xb8/\xd5\xc3;\xb2l\x856\xa4\xfa +\x1b\u04b1\b\x81+\xb4\xfa\xe4;\xa0b00\xd0&5aF\xdds\xdaz\xde*\xe5\xb4\x15V\u026c\x15\x06\xeaG\xa9j\x05\x03Ed\x02\x17Y\t^\xa1\xb7P\xb5\xd3)~\x8f\x9a:\xdaC\xaf%n{~\x13\xb4\xde7Z\xc2\x03\u7b14S\u01e3\xd5vt\x80\x8e#%/\x97\x05zI|YZ\xea\t}P\xf1\xcb\xde\x12\xba\x83ml\xb7z\xf0\x97\x92J.\xd4ctW\x87\xdb\xf9\x1d\xae\x0f\xb9O\xcc\xf5\a}\xadg\xc4\x03n\xe5\xf8L\x161'\x97\x81\xad\xdciv\xfd\xf6\u0361\x99\xecF\x03\x04\f\xcag|\xac\x0fTi\x11+B\xef\xf0\xd8{E\x8dtV\x05c\xe6\x05\x16\xe0\t\x9f\xfc\x00?\xed\xa7\x84\x93\xe4\a\xce\x0e\xf9Q\xf2\x843\x8f!\xf1\x98k\xb0\xa5'\xdc\"-<\xe1T\xb1\x1f\xf0\x94\xf9\x03<\xe9Zb\xa5\xa8B\xc4\xf7\x0e\x83\xc8\a\xdcXC\xd6)?mpdb_D\xf5\x8c\u05e6s~\x14\x13RG|\x04\xe5\xc3v\xbf\xcb\xd9SN\x1fr\x90\xf7\x7f\xca\x03\u0556\xfb\x10\xc9(\xf2<1\xcc7\xc6\xd8\x0f\xc0\x86\x82\x04(\xd4\xd2K\xf4}p\xcc\xf4\x9b\xda\x1d\xadk\xfe\x04v\xae\x01',\fF\xbd\x89#\x838\x82I\x10s\xf1\xc4\xca\x18\xcd\u01330\xb1\x89\xffdmOm\xe0\u0762:\xcey\x18\xd1\vkF\x7fTT%G\xfb\xf6VHg\xd4G\xc5YGHg\u0517\xcb\xe6sg4m}\x9f\u0112mH\u01c0\xd1~\xb06\xe2\xe8\xb8R\n%u\xc6DC\xab\xe3{\xa2\xcd\xd5\xca\xd8%\xd9'\xa0\x9ba?\xc3gB(g\x1bR+{xbU\xe8vXZ[\n\x06\xa2\x13\x83\xaa\xb9\al\uf328\xa2\xee\xa5\xe7+\x00\xfa\xf7%\x0e\xaal\x1e/QA\v\x82~\xe4\xc0\x91\xeb\x16\xe6\xfd\xc8i\xb4L\xcb2;\xe3\x11\x8a\xee|\xb1\x1b\xa4\x85\xe8D\u01ac7\x9d\xcd\xee\x9dq\xa1\xacG\xd88R\xbcT\x11\xfd\x91\xd3\x1f\xb9\x8be\xec2/\x8fhDOg\\\xe70\xf2\xb4\xccxh\x8bJKZ\xe9\x01\x9e3\xcd\u0629\r[\xd1DF\x8b,k\x84\xb0\x1d\xed\xedX9@\xc0\u0713\xa8\x1b\xc0\x01\u074e \xe1D\xf52\x9c\x8a\x9f\x9b\x05\xe2#|\xfa\xc2<}\xc9\u0645\x89\x1f\x88+\xe1\x9b!|\xc3\u05de\x1d\xedg\x9d\x85\x0f\xd3\xff\xda\x02\xbe|\xc9}\xb0\x97Z\xe8\xeds_\xa8`\\{\x9bIs4eT\xda\xc5\xcbX\r\f#\x9e(\xe6\xee\xf1\xe0\u0359\x1a\u03ca\x8b\xe1\x06\x8bG\xc3\xed}Xq\x81\xca\xcb\u04d3R\x9b\xe0\xd8\xe804\xed\xbd\xd1O\xe2\x0e\xdaoG\x8b\xa2\u0493\x89h\xa8\x01~gw\x85\x91\xdebP\xa9\u022e\xf5\xa0p\x99\xbd\xe73d\ry\v\x8c\xaa-\xa1p\x1e\u0575\xc1-\xa1\xf1\xa1\xe3U\xb1\xda'\xfa`\xc6O\xd1\u0096\u03fa\xb5\"\xbe\xb2\xe5\xc2\x06\u0728Q\xd9\x1c\x19v\xf3\xa6\xbfv~[\xefn]\xaa\u066e\x84F:\\+\x05\x81\x94\r\xf3 ]\x19\u0629\xa8\xa0\x04Z\uf4afkXD\x04y\x19\x8fkY\xbf\xe9\xba:\xf5\x82\xe0ba\u071c\x16aAL\x9c\u0537c\x8b\xc1\xc8\xc8t)\xf8K\x95c\xeb\x9ao\x8d)\xc1:Shj\x12>\xd5w\xfah\x02\xe9\xe3\xe38\x1dc\x92\xa9\xbfa|\xc5\xe3\x82P>6\xe12\fW\u3525\x832;\x06\xd7\xc3\ubba6\x86\"\x9a\x82\x81t\u0256Q\xb0\x82J\x96\xd6l\"\x8dv\xb01\xa2\x95+N\xa5\x8bSaq.J\x98k\xf63W\xa7\n\u02e4_JVP\xe1^V\xacr\x05\x83\xde+um+\x96^\x95|\n\xa9\\\x96|J\x85{\xd9N\xbeY\xfb\x03 c\xb0\xfa\xae\xf7\xa0\x05\x9a\xf1\x0e\x86\xba\x95\xc0\x92L\xe0D\xbab@\x89zw\x1fL+)\xb9P\u0684,Qk\xd4'\b\xf7\x92\x1a\x8a\x83\x8fum\xf7\xec\x94^\xad\xf6\xed\xad\xfd\xc4 E\xe9\xa1a\xae\xa6\xbf\x98\xa2\u029eF\x9c\x7fA\x85\x9b\x9b\x1e\xa8\xb8\x1em(\xdb$c\x83|d>_\xad\xec\x1dk\xea\xd3\xd5+\xd7\xda}\xed257\x98\xb7m\xec\xee\x89\xcfU\x13{\u051f\xbdv!\x19\x13Wn-\x98\x14\x1e>f]\x10g\xb6\x88m\xa7\xa8PT*Z(\x9a*\x18\xa1\x99\x02/\xed\xa5\x11\x0fU\u669b\xebT{\x7fz\x94.\u9cbe\x9d\xe37\xa7\x8aE\v\xa07\xc0\uce07w\u0572\xa7\x8ajz\xaa\u0477\xf4=\x173}\xa3M\xb5\xd2\xea\xdd\x14\u0226\xde\xec8\xd77\xc6\x04\xcb|c\x9e0Ms_-{`m\x0f\t\xc1U\xa73\x93\u0172\x87\xf8M\xdaH\t\xdez\x8f:\xd2\x1b~\x8e\t\xbd\xe1\xe7\x88h\x007\u0572\x87\xb4\x0e\xda=!\x86LoZ,\xcf{\xd3J\xf5\x96i\xa9xO\x17k\x8a\x10>=\xa3\xbc\a\xa7\xfb\x1e\u0295{F\xd6\xecY\xe7\xd4\xdb\xe5L\xb5\x89\xe7\v\xd4\xc7\xe7\xb3'\"\xe1tV,\x90\x88\x00\x8d\xb2DQ4;{~^*\xbe\xb8\x0f\xfbY2\xda_\t*\x90u\x87\xf1\x92\x82\xea\xc3\x140.\xd3LpY&\x87\xf2\xc8#\xe6\x17f\xb04\u0394\b\x06\x90\tw\u049bVeQ\xa9(1=\xad'\xbe\x16\x19\xe8\xc6UN\xaf\x0e\x9es\x9e\x02YZ\xb5ccG\x17g.\xb6~\x86\xa4\xf3vd\u04c3^t\x1b\x02\x1f \xe5\x96s\xc5A3[\x87?\x98\x11\xff\xfb\x93B\xb9\xe1\xe1S\xbayQ\xb6^,;S\xf3Z\xeaL\xf9\xb4L\x87\x99\x01\x1fx-\x0f*\u0453\x98\xb3\x99\x8a\xebo\xa8'\x052\xb7\xb1\x82\xb3\xa4\x02\xe1\xb2Pp\xa5\x9c$1\x1f4\xbbv\xc5$U\xeel\\w\xa7M\xb2\x00G\xa3\xccw4Z\x10\xc4\xe0\x843\"\xecZ\xdeN\x7f\xa2\xea\x8d]\x14\xb1M\xd4c\xc80w\x86\xfa\x9e\xc7\xc1\vA,\xa5\x13+\xb3F\a{\xafb\xdfq`\xdc\xd5J\xc0n\xfc2\xa5]\xbf\x15*\x06&<P\xdcY!3u\x1e\xa0\x02\xa6\x83\n\xa6\xdfq\xce_\xcbJ|\x93\xa9S\x1b-\xe6\x83e\xd7\x17R\xc5\u00a2PZ\x8d\x99\x1dMc\xf9{\x84\xa1\x96\x02&\xbf_:-\v@\n\xcf\xfbjj[\xfa\x81\x98\x17H\xf9\x91\xb5\xffU\xb8\xf4\x9e+O]\x1a\x85\x96.f\xads\xe7\xedD\xad\xee\x1c\x0f\xeb\xe3\xb7\xed\x82o\xa1\xbd\xfc\x99O\xdb\u00c3\x024\x00\rF`\xd8k\xdehu\xa3N\xb8QG\x83*\u044d\x11\xe0\x8f\x82\x91sl\t\xe0\x8da]\xcbce\xb9\xcbX_hf;u|P\x04p\xf1\x82D\x15\xec\x14\xe3\u1b6cQ3\xce2(\xf5\xb8\xbb\xb9.j\xf2X\x16~GA\xa1\x15\xe4k\xa8\x8f\x7f.\xcdy\x114\x98)I\xddf\x0e{\xd1E\xea\xf7\xb3\xf05XS\xa8~\xff\\\xc5%\x1a\u86d5\u056d\x11\x95~Y\xe1\u02ea\xf12\xd7/s|\x99\u06d7S\xe5\x98\xd4\u01ca\xd0e\xf0Xw\xcc=\xb3\xe4\xfbU\xb0\x92\xe9p`\xd3T\xadV\xd8\x7fC\xea\xcd\xcbrz\xcagU\xce\xef\xa4y\x0e\xe6\xe7\xb1\xf7\xee1p\bs7\xb3\xdf*\xe2\x9f1\u07a9\x86\x13\xa0\x9a\x8dtO\xa1\xb7\x16 \xff\xbc\xa1B.\xee\xa98\u04fdQ\xbb\a\u0488hY\x17\x9czf\xf0\xd4\xfd\xaa\xa1\x82j\xa3j\xb0\xb2\x172\xf6z\xe2\x1e\xf4\x04\xfa\x8b\xa9;\xe0\x1et\x00\xe5A\xbb\u07c3v\xa7<h\uec35\x15\xa1Z{g\x1e\x940\x96l\x0e\xa5#\xd7\x17N\u04d0\xc3:\x01\xc7\xfa\x8a\x05$2\xf4\x13\x19\x93\x13D\xdc71\x84?\xe8\xe6\xf5\xa0s\xad\xfeZ\xf9L\xb2\x1a\xb1P\x1c6\xf4\u048f\x98\n\x03\xa8p\xb2\xa7M~\u0122\xb7\xe6\x1e\xe0\xfcQ\f\x85\xa1\v\xb8Q\xc6\xe3\xd0s\xe5\xc9H\xb9\x98%\xafU\x1c\u0776\x01\x11\xad\xef\xef\t\xb0&tq3+O\xbd\xfc\v'r\xf5\xbfC\xd2\xed\xf2oP\x82\x18\x11\xaad*\x1c\xe9\x87\xd1_\xb8\x90\x88z\x0fX\xac5}\x03*\\\xf4\xb6\n`\xc2\xee\xd8\xe5\xfe\r\xf2HM;\xe2\x03\x9e\x92\x9f\xfb\xc1\xdc\x02X3\f\xc6.F\xb0p\xa1}\xd2w\xe8\xcf\xe3\xeb\xdb*H\x98\x81\xe7\x84\x1a\t}\xd9\xef\u01f7\x15\xbbR\xa3\xc0\b\u067c\xa6\x86\x05\rD\x1f\x16\xc6.6\x8a\xe3\xcf\xd5\xc0\xef\xac\xfa\x81vDp=tu4\xec\x90\xfa\x91\x04\x8d\xdb,H\xfd}\xd0A\u0793\xe6\xa3?Q\uc38a#\xbf\xb0`3\xdb\b\u037c\x01\xf2\xa0\xf1\xae4\x03\xe1\x85\x0e\x0f\xb2\x03\xa3\xd9\xfa\xbc\xf2\xb4\xbe}\xa6\xd8a\x94\x1e\x17\x12!\xc7\xf4\xf5\x89\xa2u\xaa0Z\xe8\x0f~H=H\xe9\x03?\\\x0fD\x1aMS\x01v7\xfa\uea7b\x830\x03\xfcP\xbfz\xe1\x02f\x95NtjaVm\x80\xc3]\u52e5\xca\xf8,\xbc\x13\xe8}\xb6\xe3~\x16^\xa5,\xa4wm\xd3\xd1\x18\xf6T\x879\xb4\xc5\b\u0463\x82\v\x9f\xcdR\x95\xba\x87\xbb\xfe\x03\x88%\x83\xb7\x8f\u0080\u04b4\x10\xdc?w\xf7e\xbb(\x10\xd8*\v4`&N\xc2;Y\x9cH^\x96\xe1m\xc9\xf9\x1b\xfd\u07bbS\xda\xe7@p\x87F`\xe1\x9d\xca\x16\xbc2\x88\xc7\xf8`\xe1\x8f_(\xea\x8d)\x1c\x16\xd1\xdb\x14a%\xbc;Od\xffHuy\f\xf1\xf4?\u0676\xdd\xe3@rM\v\xb8l\x8e\x8e\xc6\x05\x8b\n\x11m\xc6\xc5\xe1\xb0\x01P\xb5Y\x18\u0424\x11!\xf4)Z[\u0112*\x18\xdc\xe6\xbe 4\x8f\vz(A\xc0\x13\a\x9b\xbc(\xde\x12\x03\x80}W\xb1\x9buA\x1f\xdaE\x0f9\xaa}\xa7\x02\r\xd1F{t46\xaf\xb6\x1b\xafv\xe9\xb6}\xb5\xdbx\xb5Cw\x8dtl{\xb7\xef<\xae\xa3\xda\xf5$\x86\b\xdbT\x91\x04\xbe\u0701Tu\u0608\xeelc P\xb3\xa3\x83\xfa\xc5\xd0F\xde\xde\u06f7\x81\at{o\xdf\xc5\xde\u067b\xb9k\xdf\u0734\xb1w\x87\a.\xfa\r\nO.\xfe\xee\xe8\xe6\xfeh\xe4r\u0627\u02bd\xda\xdf>\x18\xed\xee\xed\x0f\xed\xbb=x\xb7\x7fc4\xbcys\x7f\xb7\xcf1l\x97\xda\x00\x9d\xd3hgw{t\xe3\xc6\xf6M\xfb\xd1\x0euA.\xe1\x9b\u00fd\x9d\xe1\xfe\u03be\x8b\xe4\xda`4\xbc\xb1\x03\xb8\xb3\u06ee\x0e#Z\x87\x91D\xd7\xca?\xf0}\xaaB\xb1\x18\f\xdeL\x9c<L\x05/\xc7\x06\x94^x\x1db}v\rA\xe1\x17\u0475\xf9\xbbe&\xf9\f\xbf\xa0\x19H\xd6\xf4\x14\xb0A%\xa4\x9a\x89\x13>\xab\x13\x85#0\x01Na\xc1ph\xd4\x18\xf8P\u00d4\xd9:\xdf\xe8[E\x8f\x8a\xa5\xfd\xdfecx_\x81K\xf0\x87*\xae\b~n\x9a\xa5\uccd4\xa0\xb9\xd1C\x15\x97\xe6\x95>\xf4\x99DE\xffw\x191\u07e6\xfe\xb7e\xf33[q\xc7\xc6\xd5\x18aL\xf4\xe3x\xf8I,\xd9\xceh\xeb\x1b\x15KB&\xc3dt\xeb\x96$\xb7n\x8d\xc8\u0588\x0e\xf5\t^is\x81!\xf4V?\u04e7\xe0\x87x\x98*nA\xfa\x8e\x93xW\xb1bm\xab\r\xa7\x14.T*Nr\xd3V\u0108^\xebp-\xb7Q}&\xe1\xfc2&\x05\x1b\u077a\x15\vS \xe0\x11\u0215^\x04T\x9f\xfd\xae\x18;K\xb2Zn\xaa< \x84\xa1>Em\xb91\xb2\xd7\x0f\x87\x00@\x1b\xf8\x83jR?$\u07b1\xef}'\xab\u0233^\x1e\x19+c\v\x96\xd6\xdb6\x01\xce8\xd9Xr\xbdT\xf1\xf6n\xffw\x8a\x90\xc9{\x15\xeb\t\xcbM\xdca;.Ln\x1b\xf9\xa6\x17\xf7f;*\xce\xec\xdf!;%\xaeC\xed\xfc5/8\xdb\x1bm\x13BM2a\xe9\x14|PO>\xf3\x89b\xdb\xfb7ww\xf6v\xf7\xf6\xc1, dK\xef\xec\xc1t\xf3\xe6\xdbK\x158\x8a\xdb\xf2\xc4\xf2?\xaa\x10\x17\xfa\xf0\b\xcf\n;\xa3O\x84Ft\xb6R}\u06eb\x9e\x9f\x9d\xaf\u0779;\xec\xbf\x15Sf\u00aa\xad\u047897\xfb\br\xedMN\b\xd0\xe3\r\xa8/\xc0\xe4.\u0261r\xa3\xeb\x88i\xca\xfd\x1b\xc5@\xc9w0\xcd\xdf\xeflO\xea\xdb.\xcf9C\xd4\xcc\xdd\xd9NvF[\xf1\xe7P\u014f?R\xab!Y\r\xd7\xf4s\x93N^\x9c\u040f\xcc\xfd\xc3\xc7\xdbX\xe2/\x14\U000f6717%\x97\x9f\xc2\xd9%\x13'\xee\x04\xf9\xa5b\x97\xb3\x84\xe8g\n\xed%\x9c\xacP\xd5\u06a5\x0f\xf9j\x05:\x02c-Y\xfaJ\u0454=\xe4c\xd03\xd0\xc0\x16\xcfx\\P\x1b\xdd\xc1\x0e\xc4\x0f9K\xc9ju\x1f\xd1\x1bj)\x9f\x97\xf2\x97*\xfeB\u046f\xd4\xe08\x133\xed\xa1\u07be\xf3M\x18U\x03\x15\x12\x96\x97\xcfP\xd3-.@\xa3-\xde\xed\xe3\xe0\xf2\x8e\xbb\xc0\u013buZ\xf3\xf08!\xc8B\f\xf2\xa0Y=H\x9c\u04d0\x14\xf9\x1a&F \x19+\xfa}d\xf2J\xe2\xa4\x15\x85Q;jd\xe5Llf*N\xeb\xec\x90i\xe7\xe5\x99\xcd[ \x93\xb4p\u02c2jr\x90M\xaa\xa5bg\xfa\x84\xed>\xa1\x16'\xd2c!\x9b\xd8\x15\u01ae:c{,d\x13;\xc7\xd8yg\uc007le\x88\x8e\xc1kg\x99\xf6\x1a\x15\xa7\xf4L\xc5\xda\x1e:\x86A\xe0\xb7;-H\x9dj\ag\u0664\x14$O\x97^\xba\xcb+\xd3\xf5\x00\"\ubdb7\xd6e\xa6\x03\u05cf\xd0\xe3\x1f\x95\x1a\xc3\t,,<\xf9ms\xbc\xb1\xdb<\x96>\xdb*.\x80\xdd[\x10b=0~\xcf\xe3\xa21X4\x0f\xde\r\xab\x8c\xa5\xc0\x12\x1d[\xa6o\x160{\v`\xf6\xa65\xb3\xb7\x18\xeb\xef\x036\xac\xfe$\xbd\x9c\x13\v\x910\x97Iz%\xe35H<5\xc6M\x85\xe5\xe6\x98\xc4\\\v\x15\u0530Dp1\u31a1\xa5\xccU\x88\x86n\x974\xbe\xe9D\xcd*\x15c\xcf\xff\x86\xa0\xd2Y\xcb\u0402\xd5\x0e\xf8\xb8\x98p\xe3x#\xe1\xc2\xd7\x12\xa7\xa9\x83f\x19\xd7\xdaU\xb2\xdf\x17p\x8cg\xac\x00.\x00\xe8X\x8duK\xcb-\x8e\xf1\xb4;F\x00F\x11\x87rK\xe9\xa8\xe9\x96:B\x8f\x8c\x8e[#\x98%\xef9\x1d\xddR\x93\u04563|\xae\aE(\xc3\x7f\xc3\xcf\xef\x802\x94N\"\x02\xb5Ux\xc6jL\x86V\xa7\xc7\x06\x93~\x7f\xb4c9\x83l\xb4C\x12\xce\x14\x1d\r\r\xd3\n\x83\xe8\xce\xf6-\x90}`D>\xe1>\xbd\x90\u059a\xfb>\xf7\xb8\xf4\x82==\x97\xaa\xe9\x1f\xdc\x13\xb5\xd8\xed2\x03~\b\xaa\xac\xbcF\xec(DF7h\xe8\xaf5\xef\xf7\x81(\x95E\\G\xb0t\x03\xa6\xeeqr-\xec\xba\xfe\xc0B\xa7\x1b\x11\xf2\v\x1d\xa8'(i\xe9;gF?\xe30;\xc2\xcf\x0e\xb3#\xa6&*.H\x02\x887\xf5\xaem\xfd\xdc\x18\xdf\x1a\xb8\xd5\xf2\x193S\xc7ywro&\xed\xa0\x04\xc1f\xad*\x83\xb6\x8d\x9a\xa4\")M\x95\xb2\x12\x8a\x95\x9e\x18vB\xb1\\\xf2\x193/\xd7\u0388-p\u00f6\xd4i\x9bb\xb5\x9c\xd44K`\xf1\xa38k\xb6\xe1X\x1bb\x85\xe9M\x9a\x011I\xa2J\xbc\x11\xc5[Ow\x8b\xfb\x15\xc2T\xbcg\x06\xe0w\x975^*\x88\xc6C\xf0\xea}\x89\v\xefvQ\x1b\xdfMZ!\x97\x14V\v\x96?\xad\x8e\x8fs\x9d\x90\x1f\x80\xfe6/\xef\f,\xb0\xf1,\xe6\x17tM\xb3\xf2\xa9\x0e\xe6B%)\xf8\x1c\xd2&%\xb9\xa0SA\x97\x82\xce\x05\xbb\xc0z?=MK\x9e\f\xe91fX&C\xaa\v\x808\x13C\n<\x87\xe7\nL\x1f:\x1d\x1a\xba\u05eb\x15z\xba\x14\xc5[\xc0\xebj\r\xb5!\xcd\xca\x17\xb2*\xf1~MO\x05\xabD<\x17\x84\xce\x04CC\u0239\xa0\x17g\x19\x7f\x8b\xde\\T\x9a\xe5\tx|^`\xbc\x99 \xf4\xcc\u011b\tz\xa1Q\u07beM\x86T\xdf}\a\x85\xce3.\u0537\xee\x0e\u0096\xe9\t\xff\xd6\\1\x8e\x92\xf9\x97\xfc\x1c\xbe\x03\x9e\xb5\xbeMss\x03\xec\"}w\xc2\x01\xbc\a\xfd1\xa1zT\xf2DP\xad\x97\x98\f\xcd\r\xb4\x93\xe4y\xaa\xf8L\xcf\xe8\xae\xe6\xf1|f\x05q'|0\x97\xc5\xc20Q\xf1}\xad\x88<\xe1\x03U\x98\xfb$\x88\x984\x92YSP\xfa[`\xb5\u06f9G\xee\xa5^~\xf9\xa0\x8e\x1d\xf3\r\u0196`\xc7\x00\x7f\x9a\xe0\x81\xb7\x06;\x06\xf4\x97\xe3\x1c\xf5[uKo-\x85\xbd\xa5\xd3:\xfc\xbb:\xfc;\x92L\x05\xcb\x05\x1b\u04a5`\x9c\xd0\x1c\x86\xa6\xcd\xf1\xbb\xab\xca\xf7]\xb3|\xdf%S\x01\x1a\x90'\xd8\xf9g\x82\xd0s\xbc\xc3\xfe?\x13\xf4\x02xx\xc8\xe3\x9ds\t\u00c4\xd0\xe3:\x02\f\x90\xb0g0\xc6\xdb:\x06\f5\xc7&EM\x87!\xe5y\xba,\xf9\f\x0e+0`J^\xcd\\'\xe0\xf7\xf7\xbcq:\xb5\xee\u0381\xdd\xd8U\xb5 \x82\xad^\xf8\x95Q\xdc\f\x02\xa1\xd2\ufc1c\xf7\x04\xa1\xaf\xc3\x12C\xa5uQ\x9e\vvq\xaf\x9c&\u047dr\x9a.yD\x9f/\xd3)?Ne\x12\xf5\"\xfa\x90\xcfU\x12\u0756\xb2x\v\xb7\x11}\xb94\x8f/\x97\x11}\x86vK\xfa\x19\xef#\x8a\xfe\x96t\xc8]\xf4{u\x97\xe7It\x97\xe7\\\xf1\x88~\x93\x89$z\xf2<\xa2\x8f\xb8\xa8\x12\v\v\x05\x0f\x11\xbd\xbd\\\x96\x8d\xa0\xe7SY\x00\xf2\xa1\xbe>\x04\xa4G\xfa\xa8x\xffTf\x02\x0fX0\xc1\xa2\x97\"\x9bq\x81\x0e\xcff\u045a\xbe\x11\xec\xe2f\x12}\x9aN\xdf\x18x\u0543$z\x91\x1eGt\xb4\x9dDwr\x9e\u0288\x8ev\x12\xe3\u0791\x8e\xf6\x93\xe89L\xe0\x88\x8en\xe8\xfce\x91Gtt3\x89n\xe7\x10z\x90DO\u04ea\xe4\x11\xdd\x1e&\u0b67\xd4%\u067eQ7\xda\xce66\xd7\xce\x0e\xc4=\x01\xe5e\xba\xb3\xab\xefu3\xec\xecA\x8e\xb3\x88\xee\xec'\xd1\xe7\xc5\x02\xbe\xb9\x11\xb4\xec\xceM\xafew\x0e\xc2f\xdd\x1d\x06\x8d\xba\xbb\x97D\x0fD\xc9%\xbc\u06af\xdbw\x04u\xbc?\x82\x9b\x9d$\xba\xbf\r7\xbbIt\x7f\an\xf6\x92\xe8\xfe.\xdc\xec'\xd1\xfd=\xb8\xb9\x91D\xf7\xf7\xe1\xe6f\x12\u077f\x017\aIt\xff&4\xd50\x89\xee\x1f\xc0\xcd\b\x12\x1c\xc2\x1d&\rioC\xda#H|w7\x89\x1eW\v\xdd\x1e\xa3\u077d\xb0\xab\xb6\xb7w\x93\b\xd8\xe4\u045a\xde\x16\xec\xe2v\xae\x92H\xaf\x90\x115\r\x9dDf\x1d\x851\xa1\xd2$2\vgD\xb1S\x92\xc8.\xae\x91/\xc9\xf2\xe8\xc4\xd6\x06\xeaTN\x9b\v\xef\xa4\x1d\x14s\x92ll\u011c\xdd\x06*\x97\xf4\xfb\x1b\x1b \xb5\xaa\xe9\xbc'\x9e=\xe7\x1d\xcdi\xf8\xc1\xdb;\xc0!^\u04ff&\u042d\xb6p\xcfAJ\xf9\x86\x9f\x03@'\\\xd1\xf2'\x18\xb0\x81\x96\xacE\u03f4*\\\xde\n\xaa\x95X8\xc3|\xc8\u010c\xde\xc4\xf8\x7f\x86u\xfd\x8e!\x84\xa1N\x91Q\x06\xab\x13X\xad\"\xd4\a\xf3\x92|c\n\a\x1f\x01xgP.\xc0!\xa4S07\x19\u04bc\x98j\xea\xe5\xc37=\u0257<U\xe6[\xdc\xfa\xbb\xb6AK\xbbw\xadz\x1dm\x80\xefa\xc37\x85\xbe\xe4\xb3k+\xeej\ri\xbd=\u0366\xa7?\xab\x00?;\x8f5\xa1\x0fp\xf9\xfdA\x10\xfa\"\xdc}\xdc\xe1;A\x9bNt@\xa7Q\xbba\xe7\x80\xfc+\x89\x94\x13\b\u0284\xca@[\xa1\x0e\xccr\xf5\xad\xb9\x02I\xa2\xde\x02\xe96\xa4&Q0\x1dBZ\xe9\xa9\xcc\x16\xa9<\xd7\v\xfe\xe3pw\xd3z\x8b%f\x01\x1b\xdc\v\xf7\xac\xa5u\xb3:\xa0\xa3\x9f;\xc7CGWC\xc6O\xc3-\xc8\xf7\xcb\xf0!{\xe63\xc1\\\xab\xcdx\xae\xd2N:E\xbf\xb1\xbb\xa4\x89\x17\xbd=\xe5<\xbf\xeb\xbd\xda\xe2\x03/\f\xc6\x01F\xfd\xee\xd2$\xbf\v\x92\xfc\xceO\xf2\xbb\x8e$\x83\b\x1d\xef]\x8e\xdf#\x89\x9a\xab\xf4\x91\x1e+\x84>\xc2fz&\b\xbd+\xd8\xe1\x01\x1d\xed\xd0\xed\x1btg\xfb\x88>\x14l\xd9\xefGwj\xcd\u03c6D\x9a~j\x18\x05\x10\xcd\n\xd9\x1f\x993\xb3}\xee\xf7\xe3OE-\x82\xf7\xa3\xe9\xb3\xfd}\x9d\xcd\v\xfeN5\xd2\xef\xf77>\x15\xf4=\xbe\x8f7\x1e\x8a\xd5\xeaS\xd1\xef\u07fc\x05\xff\xa3\xd1'\xecSA\xe8K\xc1\xba\x16\xa5\x9dmB\x7f\x14\x81\xb9\xd4\u05e2S\x99S\xcf$\u00e0\xda\x02\x1c\u05fb\xb51\xad\xb2\u04ca\x8cmd\x9c\x8a\x8e\x87\xbf}\x80pG&\x96\x8b\xa4g\xb2\xa7\xc49\xab\x8d\x96j}Q\xa7R\xdc\xf6\xd1\xe2J\xfd\x8d?4\x1a\xb8\x9a\u0210\xd0\a\x0e@P\x9cy\xf4\x1a\xdc'\x8e\xc1\xf39\xb6\x04\xdc}$\xd8\x05Z?\x82Y\xeb\f&\x8b\xb9\xc2Y\b\xee#\xfb\xb0\x85Ki\xa4mj\xe1H\x03\xb6\xb2\x85\xd0\x06\xf8\xda\nZ\x9b\u0356%\xb8\a\x80{tQ\x007%O\xe5\x14#*\x9e\xeb\xcb;4\xbf\xb5\xb9T\x12\x83\xdfr\x0e\xf6\xb5\xdeV\xfb\x85\u03d2\xe9\xf7\xb5M\"LY\xff>\u053b\xb1\f\x1bge\xa5&\x1b\x1b\x1f\xa1\xbe\xce\xf9\x92\x1f%\x81\x8d\x95'0\xf8\xd2\x03[x\xc1cI\xe8\xf0V\xac\xd8C\x19+\xcfG+!\xb5\xcf!\x81\xaa\x0e\xa7\"\xae_\x03b\x9a\xbe\u045c3`\t\x1b\xdbC}BM\x04\u034dQS\x99(\xd0\u0084\xca}f8m\xdf79m\xdfa\xfd\x7f\x901\xa7\x01\xe2\x9f\xdd\uefcb\v4\xae$mo\xff\xdfz>\xeeM\xa1|%z}\x84\xe6\xd2Y{\xe9V\x96\u0783\x90 -\xd7\xed\xe8\xcd`\x88\xb1!\f\xe3T\u02ab\xd5i\xc6R\x86\xded\\\x8a42\xfc\u0688P\u0229\rl)\xe5\xc0D^+\u0244\xd4\xccL\x85E\xe6\x92)\x00\xa3\xd9\xe8\\IV\xab\x83[\x9d/|n\x9f\x8c\xc9\xc5gpn\xfcL\u0b19\x9e\xe2z\x03%\xb4\x9b\x83\xed\xccT\x12\xe8\x9b\u03ec:\x9cK$\x95\xa6#\f\x8f\x13w`\x7fk\xe9\xf7\xbf\x12\xf1\xf7Vf\v\",h\xbe/E\xac\xe8\xf7\x82r\x8a\xf6\xbc\x84r\xf6\x9d\xa0\x0f9A\x05bd(\u05e2\x17cB\xea\x84.\xda\xf8S\x8b\\\xbc\x95!\x93\x0e\x9a\u030a\x13\x90\xcd\x18CEqd\xd1\xf83\xc1\x14\x19\xa4\xea\xba\u0292\xa4^\x964\a\x13\x12\xa9\xf3*]\xb5\xb5\xf9b\xad\\c\xec\x18kzD?8\x82\u0159\x01a\xab\xd4)V\xd2\x1b\xadh\xff\u0648\xed+e\xe6^\xe4\xc0\x98\xb2c\x9c\xebO\xa1\U00067743\u03185f\xe5\xc4\xdd%!\x8e\x8d\x93\xf4h\xee.H\x88\x81\x89\xfb1gl\xf41x\xac\u2204\xa66\x98Z\u04e5\xbc\u0385f=\xb9\xe7u5\xa6\xfa6\xf0\xc0\xd5F\xe2_\xad\xae\xc0\xe9W\xee\xad\n!K\x05\xf3Q\x1b\x10F\xca\x0f0\u02bafYC[+}\x1b*aK6\x1c\xcb\x1a\x88Mnn\x82pnc)\r\xea+\x15\xa0\x18\xb3Zm@U\x0e\xe1\xe1\x88*}%uJm\x0e\xf7\xa9\xb42\xde1.\xea\x01\xb8N\x88K\xd3^\xe3f\xd2\xd3\u0560\x92abN~ \xb5\xd9\x0e\x1c]d\x8d[\x03A\x82\xf1M\xe9\x8b\x1fL\xb5(\xbf\x05},>qmx\x01\xdf%\x92\x1a$\x12\xb5\xc5\xd7c\xce\u011a'\xba\xcc&\x0f9\x10\xfc\x9dz\xaeM\x8d\x00\x8f6\b\xb0\x16\xbck\b\xafM\xe9\xd7\u04b8\x0eXc\u0265/B]H\x7f\xf0m\xa0\xb7\x82\r\xa5\x05\xf2\b/\x82!;\x1b\xbe\x9d>\xbcU\x1a\xa7G\xb9\xc0\x89N\xc9\u02d6$\x91\x91\x17\x95\x8e\x9dc\x9ec\x05\aRx^@|\vf\xf8\xd4\xd0{p@\x8dG\xfb\xfdK#\u010a\x04*\xccg2\xae\x05\x12\xdcx\xf7\xa5\x8a}\x15\x93\xb1\xeae\xa2T\xa9\x98j\xd61`H<\xb8/\u04c5\xdd@\xc6\xdac\x81\x1e\xc1\x1d\xd8\x0e\xc6O\xe87\x98\xe6\xc0\x1e\x12\a\xa7\x92\xcfk\x93]aL\xa57\x84\xb17\x83\xbcc\xce\x1a\x9f\x13\xb7I8\x1bV\x8f28\x91\xbf\x94\b\xe9\xc1\x82\xe1Q\"\xf0\x04\xa3.8\xbbi\xea(\bR<\x0f\x9e+\x19>[*\xab\x0e$\r\xe3q\x00\xaaATa\x8c\xd1\xf0\xa9\xaa\xd7\xc3s\u026e&\u0501\xa6\xee\xdcE\u9c41P~k\xae\xf7\xcc\xf5\x9d\fH\xec\xd7nK2\xee\xc0\az\x04\xa0\xe9\xa1pI&\a\x01\xb2\x14\x18\r\x87`\x9a\xe3w\xce\xe7\u07f1\\\xad\x8e\xe5\x06c_\xc5\x12\xec\xec\xeb\rHk=f\"\x96\xec\x18\xfc\x05\x9e\xc0\x8c\x9aHv\xa1\x95\x9c\xe5 \x8cI\xb9\x98\xf9\x81\xf7\xc4l\x9dH\u043f\x06\xcf]\x88t\x11K\x16\u02f0$\xfd~#\xc0a\xe1e\xfcm\r\xbbq\xc2\rT#\x8a0\u0220N\x94\xea\xdb'z9\x91\x03\xff\x91\u278bY\u02c1\xbb\u05e1\xee\x03\xefiM\xef\xc9~\x7f.\xe3{\x92bc\u0713L\x02\xd5*\x81j}+\x81l\xd5\xc5\b\xc8V\u546d\xe6\xb5u\x8fj\xc8V\xe8\xb2\x06\u066a<\xb2U\x82\x04\xc6J\x04\x8f%\xcc\xf8G*\x8e\x8cU\xa1\xbdh\xcb>\xf3_\x94\xdc\xfe{F~\u04da\xdbj\f\x03\xadu\xe0\xb4\xf2\xcc\x17\xd3\xea\u075d\u040eqVcB\x04\xf6\x8a\xf7\x1av\x8b\xe9\xc9\xf3\u0682\x11\xff\fi\xa4\xaf=K\xe4\xf4\x8e\xf3J\x1a[E\xfb\x7f\x06\x0eo\xdd\xd53o\xbc\xdb4s|\xea\xd9;\xbe\xe1\xe7/\x97\xbd\x86\xad\xe6]\xdfjs\xa1\xe1$\x8c\x01\xa4\xfd\xafJ\xf7\x0f\x1e~\xf5_\x97\xb5\xe6\x9dKm7\xef\x86v\x9c\xe6\xee\xe5\xb2'S\u014d\x89%\xdc\xde1\xb7ha\xa9\xffK\xce\xdf\U00019ee0\u0565\xbd\xb4\xacL\xeftY\x9c\xde\vMO\xf1V\xb7\xfd\x19\x80\x91\xd9\xfc\xf5\x839,yf\x9dtH(\x8c!\u8c9e\xfb\xe3P\x01\u04f1\xf6\x8e\xbf\xcbt\xcf\u07b37h?\x89w\x0f\xdd]qf\xa2?\x81\x1b'\x13\xd2w\x8f\xdc\x1dt=\xde<\xb17\x85\x8b\x8f_\x9aF\xc4o\xcd\xfd#\xef\x1e\xbe7\xb7O\xea\xdb\xc2\xfb\x12S)\x91+m/\xaa89\xc9y}\xa9\xa6\xa7\x98>\xdea\xea\u0228\xd1\xffA#\x8d\xb0\x91\x9e)\xba]\x9b\xf7<\x97\u0310\xbd\xbd\x06-\x1eX\xdd6\xacu/\xb7\xe65\n\xda~\xb6o\x80\xf6{#o=\xb7\x94\xe1\xf8\r\x90\x7fFI\xfa\xb9<|#\x8f\u840c\xa7q\b\x8bB\x0fk\x8bU\xeai\"\x1d\x11ZG\xc5>\xbb6\xaa\xd1Xw\xe9\xd6\xed\x1fQ\xf7\xd0\xf1\x81K\xfd\xaa\x0f\xf2\x8e\x03\xbcY\xb9\x82\xe5\xa2p\xab\x83\xb7\x1aT\xcbf\xbb\xfbmg\x12w\u02ecK\xc7_\x06\xed\nf3\vSo\xdb}_\x9f\u07e7|^\x80\xe3o<g\x1fF^\xf7jM|\u01d2\xa2\x91\x1b$\x11\x02\xb9(^\xb7H\xfd\x95V\xc9o\f\x1aW\x97KL\xbf]\xc1;\n\xe8\xa5\xedl;\x9ac\xf4O\x94\xbe52\x88Z\x83\xfc\x17\u6007\xaa\u06d2i\x9b\x96\x9e\xb1?\xe9\x85v(\xbd\xd0\xe8\xa4g\xecJz\u0392\xa4\x87J\xa7=\xb4\x1a\xe9\xd5\xd6\x1f\xbd\xd0\u0723\xe7l;\x9a\xbb\x03\xb8\u07b4v\x19\xfe\n\xef-\xe5\x19:QE\x8b\x8c\x9eQs\xed\xd56\x18\xe1\xc2l\x8d,\xfcy\x7fG\"\xa5\xf0\x9c{\x9b;\xee\xe1vS\x84\u0085\xab\x9a\xff=P\x9d\xd3T\u0177%\xf1\xcc\xf6\x9f4h\u009a\xc6\xd5\xea$\xda=l4\xe6M%#\xda\u05a3\xa4)-iEs<\x82}f\xa1q\xd0\x01I\r\x8eC\xbf1\x9e<\xbeia\xba\x1c\xdc4\xbd9e\x9f\xf3q\x13\xc9g\xb5\x8a?B\xa0\x9e/8\x9b\x82\u0247\xa4\xc6({H9\xa1\xad\x02\x06\xc6\xcd?\x98\xf3\x9bb\u0686C\x91\xf1\xa5\xc6*\x9b\r[\x15\xa9\x95\x8d\xc7pZtT\u05d8'F\x11Q\x17\x0117\x88M3s\xa7\xf6-\xb0\x1ef\x19\xf8(\xd3\u0256L\x82\x02V\xc5\u0281=p\u045c5t\xb8 \xb5\x92\x95.;Z\x81AB\xbf_t\xea\xea\u0104XL\xaa'2.hIsBSVi\x06\x1d\x94)c\xc3qv\xcb\xed\x17\x99\xc1>\xafX\xac\x8bC\xae(\v\xfd\x13\x14\x04\x01T>\xb2\x1d\xce\xd9\x17\xbc\x01\xcd\xe41\x11\x1e\xf8L\x04\x96!\xc0\x9cd|3z\xfdZ\xeb\x11Ec48\xd4\a\x8eg2V\x94\xd3m\xf4<*\x00\xd2*\x96\x86\x8b\xfbB\xb2H\xeb\xdai\xdc*\x98O\x9b\xa8\xc2-S1+\x16>\x1e\xf1\u03beu\u04be\xedM\x8e\xc7x\xd2\xe4\x87/\xe4\x11\xf8<\x80+\f\xc0\xb2\x1bO\xf3\x8e\xc4b\x01\v\xea)\x94\u02b9\xc8%T?\x0f\xed\xf3:8\x95?\x95M\xe5\xd7\xdd[n\xbe\xb8s\x82\xf3\xe6\xe0^\x1d\xee\x1eM\xfc\a\x90\xc911\xbe\x8c\r\xd8\xef\x1fl\xf8\xe7\xba~?NY\xe3d\u7305e\xbf\xbf\xa1\xfa}S%\v\x17\xa8\xd7\x16\xed\xb9\xdep\x8b\x8b\x15\u06e6)\x93k=\xec\xb3\x02,WJ\xdd]\xd1f\xac&\x91S2\x8eL\xf7\x91q\x86\xa9\x96\xd0\x7fp\x06/Vl\x97\xd0g\x12\x95\x87\v\xaa\b\u0370#K\xbf\x95\x9e\xb5Z\xe9\xa9\xd6IV\u0102J:\x9d\xa8b\xb2\x9d\x14\xc6\xc4c\x98\x14\xec{\xe5!k\xf4FI\xc1\xbe\xb3!V\xb6\x03J\xf6kPK\xad\xf5\xe2!3\x0e\x067f\x91\xd9x\x89\xbc\x00GU\x1bt\xf4\u0211\x8c6@S\x8a\xda\x15@\\\xa0\x9e\x9d\x9c\xb8\xfe+&\xbc\x8d\xbc\x06y]\x98\x96\xb2r\x1b\xc00.\xd6$\xb9$\xfa\u0190$\x1f\x90\u81e4\u4ed2y$}\xc5x\xbd\xc4Ico\x14\x8f\xfa\xc0\xf3\x82\xbbm\xbc\xb3\xa3\x85\xf0\x04\xd9oc\x1fZ\xcb\x1a'Y\x15a\xab\x8c\x8d\x8a\xd5\xda\r~\xe6\xd6\xc4\u02f4\xa7\xf5J\xc8X\xb1Z\xddd\x8c\x95\xde\xf0-=&\x9a\x87\xb5\x95\xcdc\x9d\xb4^\xf8,T\x95\x05\x0f\xca\xc6\xd6^+\xb3\x05\xc2\x12U\xbaD\x15p\xedbxyI\x81H]\x98\xca+L\xd5,\x8c\xad|\xc62S\x024\u06b7\xc5(\x83\xa6\x8a3&\n\x18\uf906\x10\x03\x88\xa7\u0614\x92h\x98\xa7\nX\x99)\xcb\x1c(X\x8f\xafau\xae\xb3^#O\xd3\xe4\xb7\x11n\u0458\u19f5\xa7B\xe8{2\xfe\xd4\t6\xeeZ\xe0e'\xda\xf8\xd4\x13m\x04\x98\xe6\x06y\x92\xa2\x9e\xbe 4\x03A\x8a\xd9\x11K\xf6XOLmn\xe1\x86hi\xdb\xfdT\u041c5\xfd$zbYc\xdbV\x88X\xd4\xfbI(\xdf\xf5E\xc3\x15{ \xbc\xe9]\x1bo\xe4L\xdfG\xb4b\xc7\xed(\x1a\xb8\x87E\xc0khG9F\x92\x1d\xdf\xe9\xdc\u04b9\xe2R?7\xe3j\x01\t\x94{[c\x18\xa0\x1agXt\xcb=1\xa9Y\xd6\xc9%B\xe8Zk\xd2{\xae\x96-\u0721NX\"\xef,\x03e=\x11M\xc4#\x0f\xec\b\xce\x1f\u0265PHp\xb8o##\xb9g/O\xc7\xe5q\xcf\x05v\xcdy\x90\xb9\u01fb\x88\x92:\xa4.D\xbd\x94z\xcf&\u074a=\xf6\x13\xeb=Q\x18\xa7\xf7\x83\xb9>PI\xc5\xde\x06Q^@\xd0\u04e0\bf\vK*\xb6\b\xc2\xf5\x9a\x9dT\xecQ\u0635\xc5\xf2\xdc6\xabkp}.K*\xf6N\xfcR\xc4&\u07fc'h\x10\x8f\x91\x14\x86x\xad\x12\x00I]\x895\xa5\xe7\xc7\v\xad\xaa6u\xb4/]\xb2\x8di\xbfo\x1b\x03\xa8\x03:g\u04c9]\x9b&\xe5f\xe4\xf0U!0)\xc7S\x98\u25be=\xa53&\xedR63+\xea\x82\u0167lFB\xe0ZX\xc5Na\ts\x9b\u0162\u07cfO\xd9\xc2R\x1as\x87'\x15/\xd8{\x1e\xcf\xe8\x1c\f\u02e6\x9a\xddyW\xc63\xba\xa0\xa7 \u01e0K\xb3\xc2\u03d8\u0173[\x0foMkNj\x89\xe7\xa3*.in\xe5\xfd\x05\xd0\x12>\xe3\xb4\xf4\x18\xa7\u04f5\x81\xf6\x83\r\xed\x06\x18\xb4i\xa2\xb8\x9e`F\xb6\xe758\xb6U\xbc\x11\x97\u031bw\x8dh6\x88\xacVh\xf3\xbc\xdf\a\x9ap#\xce\x11\"\xd2S\x1f^\xad\x84\xaf\x84\r\x91D\x11\x83\xb6\xc8F~\u020b#\x84\xcfY\xad\x10\xeb\xb3dE\u036e/&E\x82!!\xf56)C\xfe\xb7\xdd\x19\xb4T\xc5\xe8\x05\xd3j\x12WL\xda\xf6\x8fs\xd6]0\xa7)N&X(\x1c\bP\x12\xfcj\t\x06X9!\xab\xd5\x1e`\xcb\xe8\x1e\u07b7\xb7\x18M\v\xe7I\x12WxGs&\t\x9e\x1fr\xdd\xd0Sv\"\xe8\x8258Os\xd6\xe0Z\xcdLK{\x8c\xa2\ni/\xde\xef\aM\xae\x05\xc1\xf1\x94\xbd0\u0246L\xa79k1\xaef\xcc&\x10\xc1\x9c\u041bq5)\x93\xa2\x00\xe3\xedS\x13\x92\ub41cP3\u0126\xf1\x82\xce6#\xbd\x1e\xd2\n\xc7\x19\xb1\x1c\xf8%-\xc3\xc6d\xa7t\xa1[\x00-\xd8\x18R\xd7q<5I\xcd!)\xbd\xf2\xd2<L\xea\x94N\x1bI-\xe9\x82M\xa1\xac\vZ\xf5\xfb91r\xcf9\xcb\xe9\x8c\r\xe9)\x9b\xb2j|:>e\x9f\xca\xf8\x94\x90\xd9\xe6&\xce\xd9S6\xa4\v6\x1f/\xc6\vx\xb3 \xe4\u053c\x19\x0fo\u0376N\xc7d\n\xe1SBg\u05bf\xf6\xf0\xd6\xe9\xd6lL\xe6\x10>'\xf4\u0506C\x04\xd3}\x8c\xcdW\xabz\x1ac\x80\x87\x06\xeb\xf6?\x93\xb4Ij=\xf5L\xe1\xf4\xbd]I\xaa~\xff\xbe\x8c\x11i\x97N\xf5\x89N\xbf\xc8\xdd\xea\xb14Q\x964\x87(C\xb2\xae\xcf;\x91\xa6\x98\xe0L;)\x8aX\x92\xc4\n\x80j\tay\x89\x840\x00\x82\xae\x00\xb89\xcb\xf1\u0724M\xb1\b,pg\xec[\xe1\x80\x00\xbe\x10@\xafe\xf3\x98Kr\xc6ri\xec\x0eY)\x91\xde=a\x99V\x90\x89+Vv{\u04ad\xae\xf6\xa4[\xb6\xdcI\x9a\xa2\xf4\xfb\xf1\x19\xabd}\xec\xc1\x803\xb4\xb2$\x93/E\x9c\xd13\x1cGI|\xd2\xef\x9f\u011c\x96T\x12\xdaT!\x89OX\x19\xba\x7f\x02)\u0760\xf6n\xd9\xef{N\xf5t\xde\xe8B\xb1\xa46\x9c\x96\u0593 =i\xb49m\xa11B\x8b\x9d\x10O\x0ez\u0492\x83\xf6\xfb\xf1\xb1d' \u01d4V\x88I\xba\t\xb7{\x92\xbd\x05\xc9b7H\xa3\xde>A\xfc9\fws\x8f2\xea \xac\x1cE\x04_\x8e\xe8k\x18h\u0410~\x1a\u03635\x90}\xe7\x92x1:\xa9T\x97\x96\x96\xf5\xc2\xd6\xf8P\xc0\xf4m\x90\xc1M\xb6\xab\xb6\xfb=f]L\xdaq@a6\xb8\xca\xc91k3\x8d/\xfd\u0088\x19Z\x1f\x19n\xad\xfdn}lU$p\x1a|.&\xa8\xb6\x89\xdeA\xbb\xf2k\xa8G\xf7\xfb\xdb\xdbZ\xb8l42;>\xd3U#\xe3\xe3~?~\x0f\x16Do\x8a\b\xf9\x16Zw\xbc\u07cf?\aG/\x1d\xdfl0v<i\x97\x811v\xdc\xef\x7f\x0e\x9ae\xe7L\x82\xfc7\x89\x95\xa8\xad`c.XA.5\x84\x05\xe5\xcc!A\xed\xc3\x13\x90\xe3Jz\xecKo\x8fq\x15\x7f-\xe2c\xc3\xdc\xe9\":\x8e=\xa2\xe3dM\xe8\xf9\xe4\x18U@\xd9ybw\xe1s\xf6\x8d\u0420\x86\xb1}\a$O|\xce\xee\x87\xee\xa7\xc8\x15#\x06\xfb\xdd\x1c\xef\xbe\x11\xc0\x19i\x1c\xad\xac\xd5\xf26\xaa\u0162\xb2\xbb\x86\xb5\x8eA\x1bwH_\n\xf3I-\x86H\x9cs\v\x85\xe5\x82\xfd\xeb\xa5\xe8\xf7\x7f\x14\xfa\u02e6{q\r\xe8\xbb\xc6Q\x91\xb4<\x90}nM\x94\x9b\xc5\xd6$\xd4\xc6C\xd1\xef\x1bM\xe0I\u0331\xbb\xa8\x10L\tf\xad\xa0QY\x96rM\x8e4\x0f\x91\x862\xef(\u0478}\xc8\u0708\xd5\xc0h\xac\x03\xb0\xb1\xd6g\x87;\xa3\u040e\x1eYM\x84~\xdfF0N\\\xc1X\xa1\xdf\x1f\xdd\xd2w\xa1\x8aVO\aj\x18fld\xfb\xa2K\x17\xdaFY\xb7J{I\xcfz\xb3B\x99Y\xa1\xbbBw\x90i{D\x111\xba\a\x926\x84P\xfe\b.\xec\bn\u0121\xe6\fl\xd4C/\x1b\xdbEC\x13\xa1p\xa3w\xfd\x03\xacy\xda\xe5\x99c/\u0755\xa1w\xa7\v\xcbxN\xb8K(Q4\xe0@'\xc2\xd3\xc4z(\x9bXS\xaa>\xacP\t\xa7\x143\xa7\xb8Cf\xe7h\xce^\x9fK\xf6\xd06\xd9?\x94\xa4\xd8\x10\xa9\xa17\u253d\xe7\xb6\x15\xe5\xa0\x12\x1a\x87\x10\u02de\"\xe6A\x18O\xe9x\xf6\xc4b\"\x11\xca\x19\x0f\xb1\xa8}\x9c\x9bO\xa5\x87\xb7\xda\xc2\u04de\x15\x17\xde\xd7\x06\u047f\xdf\ab[\xc3\xf4;\xf5;M\x82y\xf89\x01\x03\xcf6S\u0294gx\xae\x196\xa6\xee\xa2\xdfGL\xf7\xb1e\xc6\t\x94N8B\x0eE\x02-o$\x9a\\\xd3\xc0\xeaz\xf3\xdb\xd3d\x82\u05ea9\x9e[rZ\x98\x93e\\A{\t\x9aB{e~\xbb\nZ\u0452\x90\xa4X\xad:\xa3\u06a65\xf1\b\x15\x0en\x7f\r\u0325\u030d\xe7\xcb\xf5d2\u07c9\xe9{i]\xb8\xbc4\xcaR?\u0286\xb2\xf7\u05f2s\xbd5^D\xf4\xee\xae'G\xe2Q\x06Q\xe2\x16PT\xfe\xb2V\x03\x1bj\x90V\xaa\xb8\x0f\x14\u033a\r!\xf0M\xa0`\x18:\x1e\x81\xedni\xd4d\xf1I\x14\xe5Tf\xcb\xdaiI[\x1b\xcf\xf9\xb7\xaai\xb8\xae\x97\r\x93\x85\xcb\xfdIy\u03a3\xaf\x89qi\x84\x81v[\xa5M\x1e:\x95\x7fK\x8e\x90>E\xa5&\xf5\xaduU\xfbQ\xe7'\u04dc\xa7\xd2~\xe4?\x98\xcf<\xdb\x05\x9co\xa3\xa6+%o\xafgQ\x94\x1c\x04\xefk\xde\x04g\u06a1\xbev\xa4\x1c~\xe4\xafo_\xd6\xfa\xb3\xfaK>\x86O\x03uT\viasA\x84\x12\xad$\x88\u02a2z>\xad\xdb\xfa\xb5\x9fa\xe2\x9ci\xac\x82\xac\xa8J\x93\xa6\x87\xcb<\x1cs}>\xbb\x19\u0524\x06P\x83\xe5\x19\x85D\x1f\xc1\xf0\x81A\xf0\u0446\xbb\x9bD\xccz\xf0\x1dz\xaa\xcb=>V[[H\xf4E\x1f\x7fd\x1c\u0569\xcd\xcduGY\x02`\x7f\xc8\xf4{i@\u067e\x93\xec\x03\xc4o\xf4+\x90\xdb\xe9\xb5\xea~v\xcc\xe5G\xd1\xe6w\x92~[\x87\x82\xe0\xb1\u0521\xbcp\xa1\x0e9Y\xbfQ\xf5\x1b\x94x\xe8\x0f\xea\xe1 \nO\x8b\xf4\xf0+y\xa4%\xb8\x0e\xf9\xcc\xf7@P\xf3\xd7\xc7\xc2\xfa\x1e\x10\xc0\xa0Y\xad\x04|\xea<\x1e\xd7k\xa6\x9b-8\xd9V+o\xad5w\xfa\x8d\x01P\u00de\xf57.L\x10\xcb\u5d85\xb1\x89V\xab\xc4\n\x18\x97\x82\x04:\xcc]^\x15\xd0\xe3\x97\xd3]\xe6:]pS\r<\xa6\xd5\xcam*\x9a\x83cnG;\xf5\xbd\xbbuNPl\xd2h\xefb=R\xf0\xda#\x05\xf7=R\x04~\xc3B\xf8\xb1\x1d\x7f\xf2\xa4\x85\x0f\x00q\xf8-\x142\xacI\x16\xf4\x9a*\x1c\x9a\xac\x93\xfd)\x03r\xa2\x8a#\xab.a\x01*\xca\x02 \u02ea\x82myJ\xb1\xb9\x97\xe7\x85!;\x12\xee\x11\x1cS\x8c0\xfc\xa4*\xd0\uff49\xc2\xca\xe2\xb0*\x8e\xa8\xbe\x18\x17\x12\xc5\u0596W\x9be\xa1\x97\xf4\xaa\xd8\u0734\xf1\xdc\xf7\xb5\xbe\x82q\xc8:/\x00\xa4\xf7\xb4`y\x11\xcf\vB\x17x\a<\x90\xb3\x82\u034b\xba\xc0'E\b\x88\x886\r\xe6d\v3\xbd\xd4\x06A\xb6\xed\xe7\xc5\xd8jv\x04{\xb8\x04\x8a\xc5L\x90\a\x02\x87m\xfe\xc88\xeey)\x16i\xf9\x86k/\xba\xc6\x06\xdf_\r.\xfd\xf0Q\xeb3\u033c\xa0\xa9u\"_h\xd4\xe0\xf4\xb08b\xea\xb0\xee?d\x89q\xbf\x90\xe4g\x95\x0e\x1a\xf4\x83\v\xc5RB\u04fa\xa3\xce\xfda\xe7\xad\xf7S\xef\x13lY\xafs\x8f\x8b\x98\\L\x8bxQ\x10:-\xe2S\xdf=\xdc\xdb\xc2\x13\xb8\x9d\x16\xb6\xa3\x81KV4u^\xf6A\xe7e\t\t\x80\xdc{\t\tR\xdf\x10\xe7^\xd1\xd4\xcf\tz\x913\xd5.&\xed\xf0:&A$\xe7\xb7A\xbd\xac8\x12\x11\xbaF\xb2V\xcc\x18\xd9\\\x1b1\xbe\xe7-GI\u00db\xf4\vTz\x88^\x1a\x04\x1a \xda\x02O\xf4\x1a\xa4\xce\x01\xa9\a\xb3\x9c5z\xbd\u07ff\xbc#\xb9<\t;r\xb5\x9a\x170?\xeaF\xa6\xba-\xb9m\u02c5{\x83@e\xb5.\xfc\xd5\xed\xba\xd1\xf2\xef\xb3\x7f\x00n\xe5\xe1Pj\xba\xe4\xac T~xQ\x19\xa7\xc1pq\x05%\x89\tw\x9d\x8fz\xa4\x1a\x1c\x8c\xbe1\xd7\xdb\xc5UP\x82w\nv\x05\xc6=}\x12\xbc\xd6\xf2&\xf7\xf2\x87\xf0\xdb\u04e2\xcag\xdfe<\x9f\xd1\aa\x9e\xfc\u01ca\x97\xeai\x9a\tE_\x04\xafD\xf1\x96>\x0eB`\b\xe9f\xb7e|\xc8\xcfxN\x9f\x06\xb1\x1e,\x16|\x96\xa5\xaa\xf6\xa6\xf1\xac\xb8\x16T\xf1Q\xc1.\xc7\xec\xbf\x1b\xbc|X\xbcuo\x1e\x869\xcf\xf2:\xd3Oq\xe9\xbdo\x15=6\x18{PL\x1e\x14!\xea\xd1{\xd3\x11/\xcd\xf5\xc7\x02\xf8\x10_\x17\xecE\x11\x13\xfaM\xc1F|\xf7\x93\xaf\x8b\u024b\xe0;3\xca!\xce\xd6\u05c5gI\xfby\x11\xbb\x93\xc5\xe3\"&F[\xe5iaO\xf9\a\aZ\x86\xf9\xac\x0e\xb9\xa9C\x1e\xd5!7t\xc8\xdd:d_\x87<\xacC\xf6\x1c\x83\xa6\xb9\xf5\x86N]?*\xda\x0e\xf8{\a\a6\x9d\xa7\x85N\xf9\xc0!\x97>\xb3!7l\xc8#\x1b\xb2oC\xee\xda\x10\a\xb4\xfa\xb0\xf8\xc0\xf2|Q4\xec\xfd\xb0\x80\xf4va\xcc/\x1d\xc5]4\xfcE\u06d8w\n\xa7\xcf\xe0\b\xe8\"\xf6A\x00_\x16\x16x\xebe1\xb6\x1d\xfb\x04>^\x7f_\xf8\x06\x96\u07db\xef6~,\x1c\x01\xf7\xbe \x170\b,\u0318V\xa28C\xd2\xe4}1\xfe\xa2\x88\x0f\x0e\x02_/\u06a8.tQjvq\x04\u055f\x15\x17\x82\x89\x18d\x1a\xfa\xbc\xef<v\xac\x01\xf1\xc0\f@cL\x05\xba\x8c\xd8|\xae4\xc0/-\xd8{\a\xac\xb7\t\xc8\xd9w\x8a\xf8iA?+\x80\x04\xb2Z\x1d8r\xd7\xda]\xe2w\x05\xbb7x\x86\u4cde\xae\x9fB\xeaw\n1\xcfN\xea\xc1\xfaU\xe1\x18xhd\xe5 \xb8\x8ae\xd9\xf0\x8b\xad4,\x83\xd2\u030f bM\xa2\x1d\n\xf0\xc5\f\x17\u0531\xf4\xf0b\x03k\xe8o\x91\x04\xd2\x1at<\u057d\xa3\xccU\xa4M\x10\xc4\x14\u072d\xa6L\xa5\xcc\xc4\xf5\x88\u0534\xa6\x1a\xbfu\x1b\xc2xZ\xc4\xdf\x16\u0138\xab\x1d\xbc64\xd4\xe0\xb5yo\xfc\xe7\xd7\u0264i\xcdzj2\x99D\xd3\xcd`l\xe8\a\r[\xab\b\xd2O\x1e\xbbG\xacV\xb1\u8222y)\xfe\x9b\x15S\x9a\xdd\xce\xc3@Z\x9f)\xe2F|2\xf6\x98Gu\x052S\x01\x9e2Nu[\xe9\xc6\xf4\xbc9\xce8\xe2\xef\x8ai\xc6\xcbZ\xc3\xcb\x1a~\xea&\xd2f\xb7\xc6\x13R\x89\x9a`\xf1\xc3\f\x15\xdd\u0088M\xfb\xec2u\x03I\xa4Z\x84\xbb1\xd2Zs\xb0\xec\"\xc0l\x9ba\xd1\xef;P\xe7\x1d\xa3U'\xa0\x06\x8a\xd5\xe1\x84*\x80N\xc0\\\x13N\x8b\xe3\x92\xcb3>\xfb4Se\xa2(\x1c\xbf5\u0782\xf54\xa2R\xbf3x\xda\xd8\xeew\x86@\x97\xa9\x94)\xca\u04e0M\xd8\x05V:\x19R\xbf\xa2\x89\xa2\xc6u3\x97\xa5\xceI\xf7\x19$\x91\xe2\xf1\x9f90\"\x1e\x0e1\x1c\xebU\x1a\x98\xea\xe58b\xf9@\xcbe\xbe\xaax\xc5\xd9\xc5qZr\r\xa1\xd2\U00031a4b\xf2iZ\x1a\b\xffD\xcb\xdb\xd3vXy\x9aJ>K.\f\x04\xb3i\x13>\x9f\xf3\xa9\xb2\xe5\xaeO>v\xb8\xb0\xa0 T\xf9OF\x98\xa8./\xaa\xbbo\x15\x93\x0f\x1a!\xcd2\xf3A\x18`\xcb\xcf\a\xfa\u0195\x9c\x0f\u031d\xcf\xe3[\xa6\xfe\x0er\xe1 \xa3\x81\u055c\n\x9e(\xaa\xd2\x13\xc4\xf0;\a\xd5y\xddBSC\x17\xe9\xa7z\xe0\xd4\xc9\u03bdA\xecM\x1c\xaf\xfe\u012e\t\xf8B\x17\x95X\xd8\xeb\xb1]\x00&\u028c\x8b$6w\x02/T\x98p4\xfa\xd3\x1f1\xe5\x15\xe04\rO\x80~\xdf\xc8\xe6:T+\xf4\n\x86\xfeEeGI\xcd\xd6g\xd6T\xafb\x82\x89f\x1f\x11r1+.\xb4N\xa7\u05e6\xa2\x86\xe4\u05ad+pu\xc0&F\xd7du3\x0f\u031d\xd7\xd6\x03{\ubd78m\xa8tR\xb0\x94eI\xca\xccD\u0290\xfb\v\xb7\u034d2\xfcD\u055f\x98\x15\x14C\xed4\x14\xfe0\x95W\f\u04e29,S;\x0ees\x1cJ7\x0e\xa9q\xd2\xe6O\v\xe1\xbck\u015c\x89\xc6\xd8&\x93VS3\x95pS~\u068c\xeeoL\xb34\u050dN\xc3A1\u058b\x8b\xc5Dn\u03b9\x92\xa5\xcdiV\xb1\xd4\xd4\u030dZ\x8f\xedO.\x9ao\x99\xf3G\x9e\xb3\x8aNY\x8e\xa5\x1e\xe7fX\xdb=\x86\x81nY\u01a6I\xa9_Li\xc9\xf4g\xcbK\x86\xad\x81>\x99\xb3x\u0256\xc1\xc0m\x14y<7~\x12L>\xf3\u0272\u055a\xd3dn\xf3]6[37N^u\xb6\x191j5\xa97,J\x00\xa0dS\xa6\x15\x9b@\u05f9\x02\xb9C*\xb4w\xa4S\x96\xd5S\x00)\x00\u066f\x88\xd6\u06ad\x95W\xb0\x1eX\bo\xee\x9c\xea\x193\xc4\u0252\x05\x93%kO\x96\xack\xb2\x10\xab~;c\x9c.Xf%\xa3\x15S\xa0\xc7D\x17\u06b7\xb3Q\x80\x0f|\xff;\xbc\xa2\x19[\xd8\xdc\b\xb9\x98\xb3\x19f\x14\x9f\xd29\xad\x88S\x01\x98\xb3Y\xa0F\xd0\xdbIf\xda%5\xdb\xda\x1d\x1e\xdc\u86e7\xd5\xfe\xee\xd8h\u0ecd\x16\x94\xeb\xae\xcew\x12d\x9a\xccj\r\xe0\xb9\x01\xc9\xf4\xcab} \xc0\xf8\x1e\xae\xadk\xba\xba}\x90W\xaf\v\xc3v\xb6\xed\x10D\xdf\xc1f\x9a\x92\x89\xbbe\x87\xd9QRi\xe9Qf\xdd1\x9evtS\xf5Gt\x93-\xc3r\x12O\u0652\x9d\u049c\xcdI\xe2\xc6\xc4)-W\xac\x1a\a\x8a\xe1\x19\xbe\"\x81\xbax{~\x12\xab\xfd\xce*\x8cO+o\xee5\xe77\xabh\xe7\f^\xdb\xe5i\x89\xfa{sB\xbd\xf1\xcf \x9d\u0594j\xa7\xbd\xa4/\xcb\x15+\xa9\xa1\x0e\xf1.\xa0V\xd8\xdc\a\xd6H=^\x19g\xca\xf6\x06uw\x01\x89\xaa=\xa6\x83\x94C\xd5&[\xca7\xd9R\xdad\u02f6\xbd\xb7\x96\x14\x06\x1a\u013e\xd2\tK&\xba\xf8e-F\xdd\xc1\bd\xba\xe3B\x0fO\x00\aA\xba\xed,e1\xb0\x99\xe5\x00\xd5N\x04\x17\x8a\f$\x9f\x97\x1e\xc3\xd6[\x9d\x85Q)\x84\x8d5\xd6^Q\x1b\xde\xd2'*\xd1'(m\xf4\x1f\xbce\x82j\xc8_Mw\xf7\xc3\xed\xc5\xeb*\xc3D:O\xd9EV>**\xc4In\x83\xd9!\xd8%\x1f\x84,,\xa0\xfa\xd1%:\u4d26\\\xfc\b\x89?\xe7Jo\x94M\x9b\x82\x8e\x04\f\xc3y^\u0144\x16\ucd02\xc3x\u0296):m\x1a\xa7v\xaa\xb8\x13\f\x1c`R\xaf[\bE\x12+%tV\u015c\x16\xc0=\xb4\xe5x\u0197y:\xe5\x7f\xb2\xb2\xa8\xf4\x84\x8d\xe8\x1fW\xa6\xfb\x85\x9cZ\xd2 \xd4x\xb9\xac@B\x17H\x9a\x02\x15P t~\x81Z\tl\xdb\x14\x02\x8d\xa3\xeaB(S\x88\xc2\x14B\xa2\x17\x85z\xa0\x1d\xa7\x81-f\xe6\xa4\xc9\xed%\xb7\xc1i\xd7\\?7\x82u]&\xbc;<\x96\x98x\xb2\xe1\x81*\xadV\xfe\x13\x18\aV\x92k\xbe\x82\xfd\x180r\xe6\x12\xab\xb9Z\xc1]\x01|b\x8fk\x9e\x86\x1c\u064d\x11\x05\xc1\aM-:\x8c\xe6n\x8f\xbb\xc1\xf6zi\xad\xcb1IY\x99\xc6)I\u209d\x17\xb1\"\x93\xb3\"\xf1X\xc4)\x8b\xb5\xac\x7f\x03n\x82\xd4KB&(`)H\x02R\x18\r\u03a1P\x11\xa1=\x1b\xad\x8c\x0f[\xd23\xd13!\x13s\xd5G\t{B\x92\xec<\xa5^\xeb3EUs\x900N\xffX\x81H\xf1s\x05\"\x1e=y\xcf[\xb1\xb8\xad\r\ud43e\xab\xc1\xd4v\xef7Y\x9e?\xe3S\x9e\x9dqd\xf7\xf4\xfbW\xbc\xc4Q\u041d\xe0\xcb\xc7\xcfo\u07ff\xf7\xfa\xcat\xaf\x8b\xa3\x937\xe5\xd6l\x86\xf3t\u0431\x82\xc4\xca\u01a2\rN\u017b\xb4in\xe8K\b\n\x04\x95+\x99\xa0F\xad\xa8\xb9\x8e\xd3\x027\x01v\x96R<\u028f\xad\x0e\x8e?\x92\xaf\x1c\u00c5\x8dj\xc7r\xda9\x96\xebh8lSB(\x9e\x06\x04.Q\x97\x16\xafcI\x80\u049dpu\x97\xcb\xec\xccD\xbb/\x8b\x85\xe6\u0741\xba\xb0n\x91\x94\x8aK\x93\xbd\xa4K/Ku\xb5\xea\x88^@\xf4\xe7\"]\x96\xa7\x85\xd2\xfahz\xd9Y\xad\xba\xb6\xe9\u03b1\x80;^\xbf\xdf\x19\xbf\x1d\x11\xacP\xadz\x18\xed,QW\xe2]\xa11\xe9\xfe\xfe\x8a2^\xf12&\x14d\x86\x85]^.\x19\xc2\x05-\xfc!\xfc!\xddO\xae\xa9\xe6\xddlf\x8aW\xd3\u03fb\x9a\xa4x\x9d\xb2\xdbR\xa6\u70ec\xc4k\xbd\xfb<\xf7)\xb9\x9a1\"`\"\x90\xce\xce\x00\x03\x8e\x16\xa8\x9e\xfe|\xf0\x1a-[\xf4\x03\v\x1eG\x1b\xc6\xd7y\x8bew`\xe0\x03$\U000fc82f;\xa5y\xbb7(7\xb1\v\x16E\x9b\xceqc\xed\xe5\xde\xdeA\xf9\xfb\xfd\x8e\x063o\xf02x\xadu\xb0\x9e\xf19\x9a\xe7``\x12+\xe6\x13]\x9a\x01-5y\xa8\x18cg)*+\xe8\x10v\xb1v~\x90\xf9\xc48\x93\x05\x11y\x02\x7f@\x8a)?\x17\x90\x17k\xdb\a\f\xf2[0\xac\xeb\xf6\xcd]B\xb4N\x80m\xc6F\x84\x83!\xba\x80k\xeb\x1b\xbd\xa99]\xb5F\u0686\xc38k\xb4\xff\x88F\x87\xba;\r\x8a\xe3Q\xc4X\x1b~\xd1*\xfd\x18\xa0B21c\xa0\xf76S\xa7\x80\xf4Q\xf6.\xa2\xcd\x10\x12q\xf0C\x91\x898\xa2\xbd\x88lF\xeb(Q>\xe1p;m{\xdf\xc12sKJ(<\xa3\xdc\xc3\xf3\x84e\xdc\u02c9A\t\xd4\xc1LP?\x16\x13$Q\xfa\xb4c\x02\u00b7\x86Gg\x1f\xf1\x84b\x0e\xc17\xbd\u04cd\xc0\x9d\b%G\xa1z\xa7/B\x90c\xa20\x1e\x95L\x0eJ\xa3\xd1\u056d\xc8S\v \xb8u\xeck\x93Qcb\xef\xa0\u0640z\xe3JC$\xa3\xdfA\xf3\x88\xd8\xc9 \x9f\x81*52\xf3\xba>\x10\xbe\u015c}Ta\x00\xd1\u07f3!\xe5\xf6\xe3\x16\x80C\x1a\x9b}\xd3iC\xebo$\xe5\xd6\xc2\x10\u026e\xdaRh\x82lI\x8cEn\x89I\xacLSn\x83\x0e\xb9L\xc2gQ\xe7Tz>\u0639s3\xee\xa5\xdc\xef\xd7\xdf\x06\x14NU\xef\xee^Ck\x1d\xbc}lBPv\x8ac\u017e\xafb\x01\x94_1C\xfb\x1a#Ta\xd0\x18\t\xbc/p\xb8\x05\xe1u6yg6z}Q\x03\xae\xed\xeb\x80\x16@\xa3\b\xed\x1c%\x96:MMb`\xcas\xe6\x16W*\xeb\x9c$\x94@\xb2/\xaaX\x7fK\u046e\xc2~\xa95\xb6\x83\x92_\x9aN]\xe2\xe9\x15\r\xb3k\x1b\x06\x14\xe3/1\x8f\u05dam~H\x189[,u\xad\x117\x04c\x87A\xd8\xe8\xdf}H\xa3{z\xac\x87G\x97\xf5\xc0\xd2\x1d\x89\u048e\x1a\xdd\b\xba\xfaK?W\x9a\x86I^\xdf\xd9so\xffk\xab\xe4vi\xe2\x9a%A\x8f\xb2\b\x1c\u03db\xdc\xc3<|\xf4Y_*f\xc7R\xed\xbfp\xf0\xd1G\xfa\xb5\xe1\xf4\xbd\xb3\xd6\x1b\x02F\x89\u04a3\xc4,\t\x1d\xa3D\xf8\xa3\x04_(BE]\x12\xa1\x19n\xaf\x13W\xee\xef\xaa\xf8\x92B\xc3\xde\xf4:E\u0160\x1fc\a\xa5\xab[\xb9\xfe\x84j\x1bQ\xbf\xb2f\xd3\xe9\xd6d<mR\xe5\xb6\r&X\xab\xc4\xca/Z\xed\u07e5\t-H8)\xb5\x9az\xa2\u05c6(\u06b4\xee([m\xefiq\xba\xb6\x17\x97\xb5}\x0fg%R\x05z\x9a2\u019eO\u0338\u051d\xe0F2\xea\xec'\xf5\xaa\x918c\x90\xde\xebvr\xd30\x9eiq\x81-.Hw\xe5\xea\xf9\xa0\x8d\ubc35\xc5%\xad=\xf3-\t\xbaZUv\xb4\xaa\xf3I\x8c\xfd\xcc8bA\b\xeb82\x8a6\x91\xf3\xd2\u056c\xd2\x03\x14\xb1\xcd*/mV\x97\xb4\x9e\xcd\x127=\x91\xe0\xd5\xe6&\xfd\x06W\x94S\xd9l\xf0\x82\xea\x0f\x92\\\xbf\x87\xb2\x85\xed=\xf5\xabqU^`\u0727\xbb@b\x178\x17\x98\xbdeWK\xa0\x9bG\xdb\a\n\x16\xe1\xce>X\xc4\x05E\x8b\xd8Z\xd1B\v \xa8\xb6\x9d\xa5K\x96\x01\u00df\r\xe9Y`L\xbb\xec\xf7\x17\xb7,~\xd0x\x01<\u04a5\xdec?YL\xe23\xb64\x16\xcf$9cKG\th\xdb\xd5\u04f8\xa0KZ\x1e.\x8e\x80\xdb^3\x9eO\u0205\xc7!^\xb23\u00ca_\xf3~\x7f\xe96\xe0\x13\x7f\x03V\x90\x14\xa1\x19K\xe3\x13\x9a\u0445\xa3q\xa7\x93\x9c\x9d$S\x9b3;\xa1SvB\x97\xec\f\x1aq\x81\xb6$\xa1U\x95\xd0)\xe5^y\x96F\x19\xa3QQKe,\xd9<.l5\xe0\f\v\xa5X\xb6J\xb1\xf4J\xb1\xa4S\xb6t\n)9b\xb6,\x99\u012c/\xcb\xe6\x8c\xcd\xe2%-\xe8\xc2\u03ca\xbb\xc1|\xe67\xc7r\xa0){[\x833\x1cJ\x8b\x04\xaf\xba\x99\xceZ\x05<\xf3\nxF\xa7\xec\xcc\x15\x10Sl\xa3Q\xd5\xfa\x95\xd0\xfc\x1cL\xb0\xa87\xa6\xce\xe2\xc2\"\xb5i`\x88\x1fc\xdd\xcd\x1d\x87\xb4i\xf3\xe8\xb47$\u0790\x88+6\u0554|EZ\xaa\xa1{#R\u3b01\xe8\f\a\uc095\xf4\x8c\x814\xcd\u040e\xe7Ff\x11;\r\xf8E\xbf\xbfq>\x98\x15 Z\xdb\xdc\xf4\"\x90\x8b\x85\x19\xc3g\x93\xf8\x84-\x8ce=IN\xd8\"\x18\xc3\xc78\x86\x17\xf4\\[u\xd2\xdc\x1f\xc6\xc7n\x18\x03\x18\u0142\x9dx\xc3x\xe1\x86\xf1qs\x18/\b-Y\x1a\x1f\u04d2\x9e\x91Z\x923e\u01c9\x9b@\xec\x98.\xd91]\xb0\x13\x18\u01ba\x0e\xfe\x00^\x10:\xf5J\xb20\x03\xf8\x92\xda\xd6\u05a10\x90\xeb\xbah@\x884>o\x15\xe5\xdc+\xca9]\xb2s7T\xa68\x96\x178\x96\x17\xe4\xfa\x1cg\xf1\x82\x16\xf4\xac\x91k=\xac\xcf\xfd\xe6Y4\x86\xf59\x0e\xeb\xb3\xe4\\\x0f\xeb\x9f[VL\xf1\u00c6\xf5\xd4.\x9bu, \xde`\xbd\xd4k\xe5U\\\xb6~?u;\x84\xeb\xf7\x14\xca<\xce\x11\x1f-m\xec\x17\x16\x9f\xf0\xaaD\xa1{\xa7\xc4\xec_i{\xff2\x98\rS\x9d\x11\xcdk$\x95\\\x1b~\xe4f\x87\x9f\xe2\xd3\rfA5.\xd0\x17\xb1-/\xb9\x80\xaa\xe6\xb6\x05\t\u0143CN[E\xf6\xa8+\u03a4\x93\xe3:G\xc7y\xe3 \xa2\xb3\xb8&y\x9fR\xcci\x1a\x9c'\xfc\\0\x11;\xbd\x14>\u041c\xb9d\xd7u}\xf0\xe4\xf3e\x157\xcboiE\x90Y\xc2`\n\xab\x03ty\x05\u012dNH\u01f1\x85\f\x88\xdb\xca/2\x90\xf7\xb4\xf2S\xaa\xdc\xfe[\xc6\xdc\xd1\x00\xa6\xafr3(\xea\x13\xbb\x16%\xea~\u0480\x97\xbbH\x18\xa0\xb9\u0325hf\u063aAH\x189<\na\xec0H\xf7\x8al\xf4\x8a\xa4\u9947\xa1fgH\xbf3B\x9e\xc3:\x96@\u0427a\x8b\xd5\xe9\xf8\r\xb4\xee\"\a\xd3\x0er0\xb5\xab_\n|\xb6\xd4\x03\x18\u0737\r6\x89/\xa9T\xbb\xafu\r \xc2\xf7W\x14\x94\xd0\u04a0\x8f\xbdN\xe3\u0511a\v\xb74\xc0\xab\x1f\xbd7g\xc1\x9bi\xbf\xff\xc6\b\xfb\x9c\xcej\n\x98<vR\xf3@\x99\x02/\xdb\u06c9Qv\u0421&x\xb4\x974\xf7\xc4m\xfaE\\;\tp\u2c68\xe6\xc0\xe9Jj\xf9\xf2\x9d\x94\xddNA'\x98>\xd1w#B\x7f\x00k\x1a\xfa ey\x11\xff\x90\x12\xfa\xc2\xdd=\xb6w5[\xf6ij]\x112\xc6~h\xea9\x8en\xec\u05b6\x1a\x1e\x0f\xe7Y\x1a\u063e.\x8b\xf8qj\fT^\xa4\u01ba\xe2AJ!O\xeey\xb7\xb0Z\xe3\xae\x11\x14\x8b\x15\xab\xbd\x158D!5\x10\u90a3K\u0397\xcf\x1e$3\xbdq\xd0(\"\r\\F\xc5f<V,\xe6\f\xed\x19'\xbe\xe7\x8cD\x91 \x19CXsm|\x86x+\xeb)\x94\xd3\x16\xd7?\xad?J\xb5\x01\x0f\xbc\x9dB\xad\xf0\xf28\xf5m\u0571\u97a6\xf1\xe3\xd4\x19\x91\x8c5\x13\xf7i\x1a?\xa8\x03\xa9\xc0R\x1aMb2VF+\xb7\xd1Z\xc2\xe7Z>\xc4\xc4_\xb8D\x8c\x1eeP\"\xcdr\xff\x14;u\xe8\xf5\xe9\xfd\xd4\x1a\x99\xea\xd2p\x8f\axa\xdd\xdd+=H\x8d\x82y(\x00\xf0\xb4\"D\xad3\x85\x9c\xf6\x197.\xeeg\xa46\bE\xa3\xd1\xdaR\x14\x1fI\xed\xba\xca\xee$\xa3\x03\x9bo \f\xb5Y\xa3\xd0g \xf9\x19O\xf3'rf\xf8\xf9\xa0I\xbc\xbf\xdb7|\xba\x8eTCSJran\xec\x8cW\xc8\xc9\xc4 \x87\x8b\xb8F\x13M\xc6\f\bQ\xcdn\xc5\xf2Y:\xd1W\xa9Q&\xb9\xd5\xca\u07b5L\U000952f4vi\xb8B\x98\x1b\x9f\xab\xda2\x84}o\u052d_\x9a\ub3e1\xce\xef\u05c1Z\xe77U\xbcG\x9d\xea\v\u0e4b`\x9f\x8e\xee\xde{x\xef\u017d\xbb\x115\\\x05?\xc0\x17.\xfb\xfc\x1b\u02e2\xa6N\xad\xbbfjOb\xff)d\x8d\xf3\x06k\x9c\a\xac\xf1\xf0\xadg\xd3\x1e.\"\xfe\xaa\xb9\x97\xf8\xe6\x8b\r\xe1K\xac\x18*\x85\xbbeE\u00d1yXI\xeem\x1b\xc8\xc9@a\x10\xe38\xbdn\x87\x8d\xa1\xd9\xd5\xf7\x93Vv\x91\xf1\x14\xa6u\xa1\x8cpr'(\u00d5\xe9^\xe1!\xf0s\xbb\xfe\xfe\x98Z\t\xd0\xcbT\xdb\x1a\u06f99F8l\xddX\x18u\x03\xd8d2\x16\x81\xd98hM\xd8HN\x91\xdc\xe8\u074d\x86\xdb{}\xf3\xb4\xda\xd6\x03K+\xa0\xbeO\x19'\xe3\xaf\xd3\xf8=\xac?kx\x84\xf1\xf7\xa5\x8c\x95\xe7\u02c9X\x03\x83\xab\u0483o}{!\xb7\b\u0576\x06v\x1d\xf2\x91*<\xeba\u07e8xL\xbc\xcf0\xe9\xda\xf2\xc7mY`\u0612\xd6\xee\xaa\xd0\x02\xc7>\xeb\x02`\u0646\u0528\xb7*;\xa0\xc0 y\xc3\x19$G\xa7<\x9dYt^\xb0\xe27\xf7\x1b\xdfH\u034b\xf4\x17(bT\xcc^\xa6c5&fRR\xa5\x9b\xcc\xef\x10\xc8D\x97\x01\xd7[\xeeh\xf4\x8d\xd8\xe9\x86\xc4m\xd9\xeb\x84{k\xac\x06\xd5kI\xd2n\x10\xd4\u7d0d\xeb\xe5J\x7f\x8e\x85\xbf1\xd5\xf7\xcc\xf9/t\xdf\xf3\xb0\"\x96>t6\xfe\x1fEzg\x80\x05\xdf\xdeM\xf0n\xb5\xb2f\xff^\nk\xb3\xa2\x993\xc5\u02d4\xbdO'_J\x7f\xa2\x04\x19j\xb6f\xdb\xf9\u0617\xb0'\xe3\xd7\xde\x02\xa9=2\xa6\b?\xe9\xec\xb1\xd2\xc0\x91\xd5p\xcco}\x96\xfavU\x9f\xa5\x87\xfch\xf0\xfam!\xdf<\x10O\r.\xff\xd7\\\x96Y!\x8c\xff[\xcd,s\x9f\xb1\xa16\x88J\x1b\x06Qw\xb3r\t6Q\\\u04af\xd2\u02cd\xa5\xe8\xb7)\b\xc52]pe\xae\xc2\\e\x86\xeaLY\xb0\u07a7YL.\x1a=\xbf=\xf2i\x84,\v,\rB_r!~\xbdr\x00(\r,{\x18\x8f\xda\a\x1c:\x80\xbb\xda\xfb[\x99\xf9\x1ad\x98\xf1\xb7)K\xa1^\x8a\xaa\x0e\xf5\xa7\x86-\x88\t\u048a\x9fC\xfa]M\xdd\u051e\xf2\xec]\x98\xda\xe4i\x96<\xcb(G\xb5\u0202\xd0\"#\x17)\x1b\x82Q\\6\x8f\xb1\xe5\xe8F\xbc\xbd\xf7I\u069a-C\xe02\xa5\x9bl\x04\u036d\xb2K\xcb\xe5\x95\xe6Q\x9d\x931!(2<\xd2xq\x1egT9\xf1BVK[\xb4>\xae\xeeo\x9d!\x0fzY\xb5\xca7\xec$\xb1\xab,\xb6\u0587\x17AShu\xb1\xda4\xc1=b]\xf4\xe3\x8f\xf5\xadg0\x11\u02b7D6\xe1Y\xb3\xcf2\xc6\x13\x18\x95\xba\x16\x8cS\x91\xd5E\u02b38\x18l\x99- \xcf<\xed|\xb7\xb8\xf1I\xa3\x13\x93\x1a\u0611\u06c62\xeb\xf2\xe5eJLY<\x9aT\x11\x81\x03.c\\\xc3*\xd6ejk\x1d@\ubd9a0\x86oI\x98\x91\u05e4*\xf3\xf4\xfa\xeb\xa65\xc1\xf8`\x9aXe\x03\xbc\xe9\xd0\xe1\xfe\xa0\xf6uD\xa0\xd7\xcc\xd3,\xc0\xfa\xe9\xd0,\x99\xa8\x98\x93\u0113W/\xb3Z\x81\x04z\x89\n\xa6t\xc1<f\xa2h\xb5\r\xcc\fm8\xf2\x8c\x8b\x19\x97|\xf6\x8c\u03ea)\x97\x8c\x1bM\x19\x95\xa1\xbat]\ud509\x0e\x9b\x8f\xd4\xd7\xea),PT\xa1;N_\x8c\xa9\r\xd5\x17V\xae\xbdT\x19bk\x85\xba\xe6Az\x85I\v\xd9\x11\xaek\xc6\x1a0\xbcd\xa9E\x90-\xc6\xc6\xf6\blL\xd0\xf4\x02\f-\xbeM\xfbS\x82\f\xb3\x1a\x99*\xae\fSS\x1b\xec\x81s\x04l\xca$\x1f\xe8\x1b\xca\xd3\x13.M{$\xf9\xc0\x7f\xd4\xef\xf4X\xc9\a\xf5\x83opA%\v?\xc2\xf3\xa9\x1f;\u1c646;\xe3GVs\xc2u\x91\xa6\x7f\xe2\"\xd9\x13N5\x89KV\xb1%M\x81g\xe2\xdaaI\xb96W)Wl\x8aJ\xfa\xd3unLuBC\xaa\xbc\xdf\xd7\xfdR\xa7\x982\x99\x98tJ:\x95\xb1ln\a\xe0\xcc\xc1\u06006\xde0\x88\xeb\xfa\x94\xa5Ty\x03\xa3\xa2\xe1\xf84_\x98is\xd8H\x8a\x8a\xc1\xcc\xec\xc7G\x9e\xe0\xfd\x9f\xd5\xe4\xa8s\xa3E=-P#\xf9\xb2\xe3tA.D\xdb*\xaadv\x80\xc3\xf8M\x19\x8fSZ\u0681\x81\x0eV\xbcN(u\xd3Oe\x9c\xfe\x8cFN\x9dek\u077a\xa8\xfe\xe2\xb5<\xe9l\xec\xd46vJ\xa5\u05ea\xa7Y\xa8\x8f\xad\x06\x80\xea`h\xa8\xb1d@\x0e\xbf.\x8bJN\xb9U\xa4SWS\\~3M8+\x18c2\xd1\x14r\x85\xb0\f\xcfx\xaaM\x98i\xcc\x19\xccjm\x84\x80\x8eT\xafN\x9aI\xfaY\xaamw\x14\"\xef\xd52\x17\xaf\x94z\x04\xd4\x11\xa9\x1b\f \u052a\xab>\xcbZ\xbeFJo(5mCvv\xad\xe6a\x1a\xb6\x12\xca=\xea\xfci\xc5j\xfa\x82\xe6\xac\x1aT\xa6k\x02\xe7\n\xa6\xe4\xa7Y\\h\xf8\x02\x90r\xb0\xfcptD\x97,?\x1c\x1e\x8ds&\xb2\xb1\xb6\x8bk*\xf6\x9e\xb29j\x13\xd2\x19;\xf55i\x01\xfey\xa0\xcb1\x9e\xc3mu\\Nev\xac\x87\xfa\x19\xe3Ym\x9d\x1c\x0e\xac\vH.9\xa5\xfa\xe3DQ\xf7i\"\xd7\x14\ub80f\xfeA%\x82\u0319\xa0\xa7\x83\xd2{\x9e\x8e5]\xe1\xb7\xce\xd8P\xaa%\x05\x13S\xce\xfc\x9e\x83\x15gIq\xf8O\xe1\xc0\xc5\xc1p\xe2\f\xf4[\x9b#g\xc5x\xbf\xb0\x13\x10C v;\x1e-\x06\\\xa8T\x9c\xe4\xdc}\xe8Hj\xc9\xea\xb7\xe8]\x87\x02%2\xbcU\xfb\x0e\xd9\x19m}\xa3\xe2\fd\x19\xa3[\xb7\xaa\xb1<\xac\x8eV\x8c\u04ec\xcf~\x97\xaf\xd7kB\x0f\x05UT\x1e\x91K[\xc84\xb77\x8dh\xd3\xc5\x06o\xf4\xa1`A3\"\xe0\x84\x88\xb9\xd7PV\x01\x16\x9bg\xdc\xd5<\xb2\xd1<\x9es\u07a0xz\x88s\x84\xc8'\x04*\xa4\xab3\x95\xf1\f\x01\x7f\xa72^P\xa5o\xe6\xda\xe1i\xccY`YN\xed\xf2Y\x1b\xa47\x96\xdad\x9a\xd1\u058a\x94,\xd7\u012d\xbcl\xca^d\x9eW\x1e\x9eQNh\xae\xd7w\xc6i\xeem&F\x1f\xc2\xcd\x1c\x9a7\xc6r\ueb46KB\x97\x9e\x9aE\xd6@\x11\x99e1\xee%M\b\x913o\xb3\x01\x12~|)\x15\xc7\xd1;4\x8fI{\xb1\xf6WeN9C\xcb7]\xa3?I\x03r\xbf\x01;\x9a\xaf\xb5\xafro\xe1?\xc9Z:\x80\x9c]\x80\xcd#\xa7S\xc9!}Eg\xbcT\xb28O\x04\x9d\xf1e\x99\xc8\x0e\xfa8V\x8cg\x81\xcd\xee$Fj\xcc\xf2\nM\xf40\x12\x1e4\xeb8\x86\xd1\xc0\f\xe0\xaf\xe6R\xfb\x11\xc8\xc4\x7fr\u0453X6\xcc\xe995\xefd#\x03\xe2+\xaf\x9eg\x018\x95\x87\bG\xa1\xb7\x1b\xfd\xe8}x\x9c\xd5s:o\u016c\xe3\xbdm\xed-8\x8axf\x15\xed9-\xc2o\xd9I\x16\x8fV\xf0\x89A\xc2tB)\xa9\x99\x92\xbe\xfe\xe6\xbdV\xf29\x0eR\xd6\xfah\xdct_\xe7\x0e\xb8\xc4X\xf7\xab\xacM\xe6\xa4,\x1b\x98\xbe\xf7DyY\x16K\n/\x965\x03\x1f\x13>\xc9P\x198\x05y\xd6\aU1\r\xa1\u0082\x93\x12\xb4\xdd\xdeh\x9f\xee\xd2P\xf1\xf2u\x18\xeb^w\xac\xe7\xadX\xbbt\xbb\x11\xe7\xcd\xf5'3=\xa5\xa9\x82m\xc8_.c\xe3\xd9\xcd z\xd7\x11\x1dC\x81\a\xf1\x03\xce\xc8\u06ba\xf2\xf2\xb4\u069b\v\x920\xc7np\xd5m\\9\x1e\xf2#\x9d\x1f5\xb5y\x93\x05\xfe\xcb8\t\x96\xae;0D\xeb\xc7'\x99/o\xc0a\xa2\xeaa\xa2\f\xc3\u06d1\xc2\xe1P\bY\xe8\xb5\xe6\x9e\xc2\xc1\xa0\xa8<\x1c\x1d\x91\x89<\x1c\x1e%q\xe3[v\u0229:\x02\xf5\x8d\xda=\xe3\x9fUQt\x9f\\_\xa0\aA\x81\x00\xac\v\x01\x9dn~\"&\a7\x13\x11\xec\xd4\x1c!\x9b\b\xa1\x10\xe3\xc6-19\xb8\u0448\xa1S\xf9*\x1d(\x99\n\r\xe8=\x0e\x9e\xd8\b\xf7r\x8e\xe2]\x15\xd7\x1e\xb8\xc2Xb\x1d`i\xbfhP\xe8\r#W}\xc8,\xec!\xb3q\xba\u053bA}\xa6l\xf0\x92h\xc6T\x93\x0f\xc0\x18\xcb&\xe6x\x9f&\xb1\xb93\xfc\xb0\u0304\x13\xea\xbec)\xcd|\\\a\xca\x19c<s`\xa8Y\xbf\x9fa\b)2\x06\u0333\xa1\xe3\xf6\x84f\xce6\xf3\xd5\n\xc2\xcd!\xb6\x06'\x8a3\xb3#4\xf6FB,\"W\u0648\xa07\xbd\x8aeq\t>\xd0P\xaf&8\xcag4\xf5\xce\u062c\x02\xb2\a\x91\xa7\x8d\x00\xd0\x11M\xeb\xda\x1c\x18\xf9\u01cf3 \x98SkX\x99\x94)\xadJ\x87\u0117\xa4\x19>\x9a\x97\xfa\xc9l\x86\xfa\xe1\xc1b\xc9e\xaa\xb23\xfey*f97\xc1\x0f\xd3\xf3\xa2RAL\xb0\xe64\xb7\xb6O\xed\xd3\xdc\xdc\xe9\x9e\xd5\xf7w\xf9qu\x82xC.`\u03a5\xe43?\xec\x85\x1bk6\x13M?>\u05f4\xbf\x0e{\xb2L\x7f\xac\xf8\x83\x19\x17*\x9bg&[\v\xac\x97\x95\x8f\xf9\xdbg\x1c\x16\xa8,\xe72\xd9\x18\xad\xe9\u04eb\xdb$4\x99\xb6\xca\xc3\xed\xcd\xf6\x90\xd3\u0592pD\xf9\xdaoQ\x9d\xb4i\xa7w\xdd-\u06b4\x1a\xff\x90\xf5\xf5\xed\x15\xebk\xab\x7f:+\xf4\xd6\xdbo\\\xf7\x851\xf52\xe1\x11\x95\xbd\x8eE\x90^\xb9\x84\xad\xfd\xe1\u042c\xa8^%\xba\xd2\a\xc9\xceD\u010a$\x8a\xcaF\xca\x1e?\x8e)M\xab\xca_H\xab\xf2\x0eRU]G\xaa\xca6\xa9jG\xf9\xb97\xca\u03da\xa3\xfcN\xc7(o[\xe3!9O\x01\x81oxD%S\x87#\aI\xfb.k\xf9<T\x1f\xb0\x88#Ds\xf7\xfa\xad\xf0`\u020f\b\x15\xeb\xc6tk\x9d\xfc\xce2\xbd\x190~8tE\x02\xea\x94=\b\x1a\t67H\x94\xaa\xa3u{\xc6^?\x04d\xe7q\xff\xc2;v\u0091\xdf{\xd2\u06c3\xe1\x06p\x8f\x1b \xd6t\x06t\xa091u.\x15^5=\x918\xba\x99\fm%u\xe9.\xac\xf2d\xf2\x88Z\xbb\xc1\x84STH}2\a\x98\xe6\xae3+\xe04\x83PX\u0111L\xa2\xcd\xf8{p\x8f\x1f\x80\x8d\x13\x9f\xf5\xb3Gp\xf7\x16\xd0\xe4\x8ax\x03@\xfb9\x05\x1e\xbf\x06\xa6\x8dk\n\x16\b\u0313,\xde\v\xb6\xf9+\xf2[[U2c\xf0B\x1d\xd4!d\xca.\xfd\x8e\xaa\xf5\xd5k\ubceb\xd7\xd6'\u0665\xab\xe3\xeb\xee\xd5\xf1v{\xbfy^\xef7?\x04\xfb\xcd\xd2\xed7\xc7\xdeLl\xf38\x96Y<\xcd\xc8\xfa\x17MP\xfdm\xf7\f}\xfd\xcfu\x86\xea\x92\u0573\xf3\x10\x8e\x805\xbew\xd7t\\d\xd7\u034a\xa0\xc5\x0e\x87G\xd7t\xff\xa3\x7f\x9e\xdd?\xff\xc0\xee\x9f\xff\x11\xdd?\xff\x7fl\xf7\xcf\xff\x19v\xff\xfc\x03\xbb\xffn\xd6\xd0Hx\x02\xf6\xdd\xf4a\xa8h\xf0\xa9\xc7\x130\x9arN\x1e?y\x82\xe6\xba\u06b9\x8f$\u025d\x145b0R\x03\xb7\xfb~\u66c3i\a,\xb0\x93;\u03b7\xe4s\xdb5\x19$S\x10*\x99\xa7R\x90BH\xad\b\xf00s\x96\xbe+6\xa2\xa6\x94\x9085\xa5$I\x03W\xb2\x89C\x89\x1f\xf7\xd9\xd6\xde\xe8\x86\u015b\xea\xb3\xdf\x154-1\xad\xc2?-\xbdo\xeb6\u0632X\x86\x87\xf0\xb5\xd1:la\xb2\xd5\xea\xf3*\xce\xc8j\xe5\u0226,\xc0\xb8\xad\xfd< \x98B*\xb9\x17U\x04Q'q\xcc}\xd3]m\x12\x86Z\x16\u014c\x1b\xdd\xe7\xb9nU\xca=eG\u04c1\x1c\x1b\a\xe1\x8b\xf6\xa8\xb6\xecd\x19}\xa9+\x99\x99J:\x95\xe2\xcc\xc2\xd9\x03\x80T\\\xf4S\x82\x0e\x8f\xb2P\xf5\x89\u0182\xf9\u020f\xa6\x0ed\"\x92\xb9$1\x9cr\xfa}\x8e\xe52\xc0\bdb\x9a:%\x89\u07d9\xdab<sv\xc7WT\xa3\ue857\xdd=\xa4\xf5\xc9\xe62n(ju\x94\x06>\xc1\xc1\x8f\xd5L\xfbE\xadN\xaaG\x87=TRW\xec\xb1v\u047ass\xd7*\xbc\xd5h\xb6\xb6\xf5\xbe\xf1\u01b0W\xde\x1f[\x122_\x81P\u00d0Y#\x86\xf4j\xa5\tT\xd7:\xcdf3\x8eN~$\x0e\x82\xd5*\xb2\xb3\x7fk\xc6\xe7\\n\x01DBQ\xa9\xad\xd3l\xa6\x15\xefMLb\x9c\u0602\x1a->\x93&\xc7\x19\xa1.\x1fj\xe4\xda5}Wi\xe7\xdf\xdeq;vX\xba\xbb\xfd\xdax\xd4\xe9z\xa4\x93t\xe0\x92X\x017\xc1\xb6\xa8\xf2\xa0\x87k@\xde]zE\t\xb8)\x01\xd7\xcb\xc1\xf8C\n\xdbQ\x8cD\x18\xbdE\xf72\x96,(f\xa7\xce\x12I$\x13:U\xe9\xc8`\xb3\xfa\x14 505\xaa;\xfa\xeb\x80\x1b\xa4\x17:\xb7x\u0536\xc0n\xf6s3.!\x90\xd4\x18\x04+6\u06be\u935fo\xb2\xb6\xab\xfd\xf3\"\x16\r\x8c\x9f\xb13\xa08)b\x18\xb2\u052e\xae\xe2g\xaf\xae\xe2\x9f\xd5\xea\xfay\u05b0\x15\u018a\xd8Zm\f\xc7\xef\x8aX\x99\xeeB\xb5\xe7l\x1e\xbbj8\xc5l\auU7d\xec1\x90\xacjW+\xc0Ts\x9b\u0437\x16}\x82\xbe3wz\x1b2\xbc\xa5^\u01ea\xef\xe5K\u02e6\xba\xfa83PO\xa5QF\xc9,\xe0\x12\u0359\xb8\x1a\u03a9\xf6Z\x9bOrV\xa6\xe0D9\u05dd\x98wu\xb45.\x13\x97\"&\xd1e\xa7\x0f\xacN \xa5\xec2 \xa5\xf1\xb2\x13J)\xfb\x00\xe8\xad\xce\xcf.\x8d\xbfZ\xa1.\x83\\\xad\xb4\xd7\xe7~\xff^\x1a\xeb\xcd)'\xb4\x06\xa5\x9d\xb7\xf5)t\x97\xb09\x9d\xc1\x17\x92f\u040bU3\x1e5\xc9\xc3D\xabV\xab\xda\xd7\xc7jU\xa5\x93\xb8\xab\xa94\x90\x15\f\x8c)\x95\x1dI\xa2\x8f\xe7*]\xad\x8eu\xac\x92J\x80\x1c\x05\xeb\xc7I\xfcs\x1a\xeer\f\xaa\xac\x1b\x83\xaa\xb3\v\xbb\x92\xcc>\x18y\xea\u0292]\xf12&\x97$\u05c5\x10\xa5j\x84(\x92\u013f\xe43\u0698s\xac\xa5\\\xc4*t;i^\xda\xd1QQ7\x19Y\x0e\xa4\xe6/\xcd\x1f\xc0\x06\xf5\xdat\x11.\a\x06\x01\xbd\xbd,\u041c)k\xb9\x18\x00\xb9L\xca\xe4\xab\xc2\"m\x94u\xa9A.\u0760\x0f\xe6\xdeZ\xd2\\;\xe2*\\\\jfw5\xa9`)\xa9@\xb1\v\x97\x92\xea\xf2\xa5\xe4\xf4\x8a\xa5d\x1cO\xbb\u0592\u04df\xb7\x96\x90?\u06e5di\u6ebf\x94Tf)\xa1\xade\x84v.#c\x8d\x92\xdc\\r\xbc\xe4g\x1f\xb4\x94\x9c\xd6K\xc9),%\xb3\x8e\xa5$\xf7\x97\x92\x1c\x97\x92\x19X+N\xe2\xe9\xcfh8\xdd\xd4\x1f\xd2d\x16)\xef\x03\x16\x13\x9bhgp,\xb1\x9c\x1f\xbe\xa6\u0529]\xfe\xd6$\xfa\x01K\x8bM-\x98\xa4?gX\xfa\x9fn\xef\xed\x87\v\xd3\xc6U\x99\xaeVe\xa0\x9cnF\ubf2d\xb1\xbeZ\xd5y\x04\xc5\u06f8\xb6x\xbf,\x13\xa8\xc8\a,\x95\xb3\xae\xa5r\xe6-\x95\x15\x95,\xff\x17\xa7E\xf4\xe2mm\x82Bz\u0633Vj\x9c-\rIo@\xf1\x1b6\x88\xda\x03X\xbf\xbf\x91\xd9sP\xd1\xef\xbf.p\x16\x03S\xbe><\xca`\xbb\xb8\x9b\xd5N\xfd\x8c\xdeg\xd69m;\x97e\xe4H\x1b\xe5\f\xc3Z\xf1$55)_\xd3\xc6H\xe2\xebst\u0237A\xd0oB\x83\x97\x18X\x02S 1\x87\x81R\xc7i\x8a{\xb0>\u052b\xf1\xb0\xe3H\xf4\x85\xa7\x06\x15\xb8w\xb4{\x9ca:N\xde\x16\xf1\x906C[\x01\u06beS\u07d3\xc4\xdd\xf6\xfb\xe6s\U000ccb6fm\x93C3zm)\xfbeF?\xcb\xe8\xf7\x19\xfd.c\x17\r#+*\xb9\x92\xe7\x0fQ\x15\xdc\xc3L\xfe*<\xc3\u04e2\xb9K\xa7\xec\xd3Zg\x13\xf9j\xbaGb\xd9\x1c5\xa0\x01'Y\x1cZ\xbal\xb4\x060A_7\xf1v?%\x84\xcaI\f\x9c\x06\ufc35\xbfG\x92\xba\x87\xbb\x8de,S\t\xddX\xcf\r\xb3w\xb5\xda\xd0\x01\x8ec\x98B\xac\x17\xa7Yy\xdfE\x89\xd3\x15\x1b\xa1=\xf2\xa7)\x1d\xf5S\x0fq2v\x8c\xaa:\xcd~\xff\xf34VZ\x83\xd2ce\xd4\x11h\x06z.\xdff0\xf844\xac\xb5\u03bd\xf4D/\u05ad1\xf7\x1dH\xfa\x92\x16t\x80W\x15\xfen\u0267\x8a\xcf\x1e\x16\xe9\f\xb0\xf8\xffD\xb9Z\x1e\xc6\xce\xce\xde\xde\xee\xee\xce6\x14#\x8e\x05\xfb\xac\x8a/\x80\x8f\x92Dg\x19X\xf3\xf2\x88\xda\xea'\b\xc4\u0649^Vs\xb5\x04\xec8\xbcI\x8dL\xe2\x82)sv\xae\x9b\xd3kLAP\xed\x02_\xd1\u0532\xec\x9a\xc9t\xb04\xd0\xe5IP\xdb\xc4{\nX\"k\x9a\xe9d\x1f\x1a~X\xfd\xd0\xff\x9d\xe8j\xa4\x82$\xb1`\xbcUpA\xbaM\xc2\x023\xf7o\xb36\x8e\xb2fp\u06ackY\xb4ir\xc3\x06\xab[\\\xad\xa9\x16\xc5\x15\xc4\x03H\x8eS\xbf\x1eC\x9a\x06\xf3\x96)\x92\xa4\u040f\xc0\xd31R7*4\xc4_a\xd1\xcfh\xea\xdbF\xbb\u06f4\x86\xb6\xb4K*K\xa9g\xd8\xcc\xcbv\xa5\x82\xba\xc0|1iP\x01\x9c\u0402^:\x9e\u011a\x98\xea)'h\x14f\\J\x0f~OQ\x11Bn\x06<\x92&\x1a)w\xa6\xdeW\xc0\x98\xf2z\x8b\xf0j\xa7\xca6[\u028cx\xc7F\x1es\x96\x05xK\u597d'\u05a1<\x15X\xcf&W\xe0\x9fObT\xe8\xc4g\x12\xf6\xa8\b{\xb4\xa4\xb5\xbe\x8f\b4@\u3c0e\xc2\x7f\n\x95=3\xa3\xa7\xe45\xd5\xd5@\xaf\x18AhfvI\\\x9bO$CDTI\x12\x8dZ\x83\xf4\x86]\x0f,yB\xa5\xdfy\x1d\xfd(\xeb\xf6\xa7\xb2\xee\x01Q\x1a\xf7\x01\xd6\\G\x8d\xdb>\xe6\x02\xefo6\x1e\xa1i\x1as\aF\xe0MDY\x86\x04\x90&|\x1ak\xd4\u0629y\xb5\xac\x00\xb2\xf2\xd3t\xfa\xe6m*g%:;\x03\xf2\u0129~\xb8\xc7\xe7*\x95\xdaY\xca\x10u=\x12IU\x9a\xe5\xc0.N\xb3\xfcQ1\xb3\u0794\xac*\xd3:\x89\xb3\x81\x976S4\x1b\xb8\xe4\xf4X\xce\x06\xed\xf4\u0650f\xd8QH\xe4B\xe2L\x98\x1b\u0205\x15\xe6\xb5\xe9G\x9fm_\x94\u05f2\xed=\x8c\n\x9a\"HM\x86,z+\xa3\n\x16A\xbd\x9b\xc7\xd2#\x14\b!\x92\x8d\xfar\xb5]3'\xf7w\x03[F=s\r\ta%\x0f\x84'\xda\xe6\xdb\x02Z\x84\x1e\xc1=;\xf3n\u06a2\xdf\u01e1#\x88cv\x8e\x0e\xeaO\x1a\xaf\\\x12\x06W\x83\x87\xb8\x1a\x9c:\x1f\xc8\x01\xae\x06\xaf\xdd\x14\xf6x\x80\xac\xc1;\x915\xb8C\u05b0wA\x02\x9e\xa3B\xdeD\u0570o\xb0$\x0eU\xa3\xcfFP\x0eM\xc3\xc8\xc6\xe2I\xba\xb6$]e\x03CQh\b\x8ah^H\x1cq\x116\xb9[\x83h\x11\xa0(\n\x87\xaa\x8cX\xe6n\xfa\x11G\x9b!\x18\vJ\xd3\x04A\xc7d\xb6\x11j}\xf5\x82\xe0\xd6o2\xf0E\xb0\xe8\xa3B\xd4{E\xb0\xc2\x13*\xcb\x18h^XYh\x1a\xacd\x16'\a\xabrlg\x8f\xad\vN\x9b\xee\x1cm\u014aq\x03\xa8\xbd\xb8\xb4r\xb5\u0538\xb08y\xde\xfeVx\xbb\xa5`\x05-\x18_\xebr\x0f\r\x85tU\xd1Uq\xc2\xd5)\x97Qb\xebZc\x9e\x18\x8d\x99\xaeo\x1d<PGo;'\xa2\xcd3KZ\xb6\x11\xea5\xab p\xf0\xd8\xf0\x81\x89\x96\x92\x86P\u0139.\xfa\xbe\u020b4R\xab\xb76;\xb1\x9a\x00P;5~\xa2\x0fk\xa3{\x0ev\x15\xd7o!\"\x89\xb7Y{\xbb\x89[\"\xea\xc9\xe7\xcd\x16\x94\xe8\xda\a\xbde5\x12%^J\xc1\xe8k6c':iV:\x84\x02@\xe6\xf0\x90^\xf4:l\xe6\x9a!\n\x12\xc4\u7035\xb4\x06/\xf0\u01e4\x0fa\xeecx\v\xd6\xc0+w.\x14\xb9Y\xfa\xe1\xd0\x1c\x16\xdf\x1fd\xd3\"\xcf\xd3e\xc9gQ\"\x9a%\x90\x97Lw\x11\x94@^2\xb9\xe5Dy\x88\x06\x90pP$}\x1f\x94+\x91a+\xd7mY^\xb9/\x8d\x1d\xc6s\x8d\xa2c0\xc7F\xfb\x0el\xac\v\x83\uc1be\xdc4\xa1\xdbI\x80\u0475\xeb\xa3\xe1\x8c}H\xb3\x91\xf3\xeb|n9\u06a4\xdf\a\xc7\xf7\xb4\x8e\xbbc\xe3\x00\x9eV\xc3\xc39\xa0t\xd08`\x91\x90\u01b9\x1f\x9a\xd61\xa6d\xe3%m\x06\x98U1<\x1e\xd73m\xb5\x8a\xbf\x80\xe3\xea\xa4\xe6B%r`\xb8\x00M\xbe\x91_\x89\xbd\xe4!|g\x14Y\x1a\x80_0U\r\xb3\xbf\x95\xb3_\xb3\xcf\xea\x93\x0e\xb5\xd2_\xe3\"\"\x14\x00;2`C\x86\bTuZM\xdf\xf3\xfb5\x82\x84\xb5\x9d\xe7M\f2\xac:\xb9\b9R\xb6\xe4c\xab\x05\x1f\n7\u0358\x92\x87_\xc9#\x06\x96\x12\xdf\xca#\xa0\xb3\u0374\x9dei^\x9cD\xc9\x03\x19G\xdak|\x04\xb5\xc3\u01fc(y\xe4\xd0\x04\xf5<\xcb\xe62]\xf0\b\x87\x8f\x95h\xe8\a\xbe8\x86\xd9\a_\xe6E:k~x\x96\xcdxa\xa2\xa6\xd5,+\"C\a\x01\xb2\xcbm\xe9#\xbb<\x90\xf1my\u020f\x1a)h}T\x9d\x03\x87vk\x95mqb2\xc8\x16\xe9\x89-d\x9e\x897\x8d\x8f\xe8e\x85\x9cq\x98\u0325\x8e\xae\x8a\x93\x93\xbc\xdd\x00bY\xa9(\xe1<\x96pZ\x80\x88\x998K\xf3\xac\x95X\xc9sl\x1d9x\xfdV\xa6\u02e5\xb54\xb8x\x9b\x96\x8f\xaa\\e\u02dc'\x1b\x1b\xe5`a\x1e\xd6W\xa5\xe6\x9cs$Uw\xd6k\xbb\xdc\xe5\xe0\xa4\xfb9\x8f\x05E\xc3X\xcd\x14$\xe5\xe04-\x9f\xbc\x05\xe3\xea%\x97\xea<\x06am\x9c\xb2\xf20?\xa2\x91%v#\x06\x92\xec6\xea\xe3D\x0e\xa0\x008I\x85\u04b8\xaa1g\x87\xf5\x874=\xea\xe0\xef\xa4\xfd~\xf3K\x80\x88l}\f\x81G$\xa9\xba\n\xa9\xe7a\n\x9ed\xc4\xf3\xa9,\xf2\x1cK\xd9\xefC\x03\x94:\x80JB\xecP\xb7C\xdb\xf4\xd4\xf7\xb1$Tb\x9b!\x90Wg\x9bb\xa4)\x8f\xbb{\x10\x1f\x8a%2x\x93\x90:\xe9\x10Y\x94\x83B\xdc\xc93\xf4M)\a\x85\x98\xc2={/\xc9Z\xb2\xa6ge\xe9Y\xd8\xf9\x9cu-\xa94\x15\xca\xd9\x01\xe2\x84:\x9c\xb24I\a\xe8\xe6\xe5\xae\x01]D\xb3\x9b\xa5\xb6F=\xe5\xb1 \u0104L\xa0\x81\xb2%H\x1d\x99@}\xb4|\xa0\r<\rNc\x1c\u0372\xb3\b\x9d~\b.?\x7f\xf1\xe8!\x8bn\xe9o>\xb9\xf5\xeacs\x17QM\xc3/\x8a3\x8ehb1\xf7\xa1\xc5H\xd2\x1a1r\x90\x95\x93vn\x82^de\x02/\xd7\xc0>k\xbf'\u0536:\xd3\a\u07dcq*\xdd\x14\x99\xe4\xee\x96m\fq\xa3}\x0f\xd5\xce\xf1\x86\xe9gBH\xd2J\xfa\xf1s<\x1aQn\x16B\x8e\v\xa1\xa4_f\x06\xfd\xab^R\x19\xa79{\u00f5\u00d6\xab\x16J\x1e,\x94h\x91%\x7f\xe1b\xd9\xfe\xf8\x92\x05\x13\x10\x93Ro\xc1L\u0742\x99\x82}\u06f8\x91J\u01e2\xd9Q\xce\x0f[8\xb9\xbfp\xb6S\xe9\\<;2s\v(\u2f26\xec[}\x13,g<\x98\x87v\xea\xa5,\xe5\x1e\xbem8I\xf9\xd5\u02ec\xf4\x96\xd9T\xfb\x99\x95\xf4\x02\xcd\f\xaca\xe6\x95e\b\xd6_S\xf2\x92_Ut\xbbD\xa4L\xaeq9N\xad\xf6O\x8a\xa4i\t\xeb\xf4\x14\xf4\xfa\xa6\xcde\xaf4jUK6=,\x8f\xc6Q\xa9\xces\x1e\xa1o\xe5w\x90\xe7\x92$\xd1,\x15'\\\x16U\x99\x9f?\xe7\ua05d\xbe:\x96q}\xb8d\xcb\xc9r\xf0\xfa\xf5\xa9Z\u4996\xa4\xdf?\xb1I\xf8\xab~\xd9^\xf5\x97\x93\xd0'\x148\u0608\xd0cs\xbf\x7fn\xd3h\xae\xf8K\xf3.\x8a6\xe1uY-\x97\x92\x97\xa5Y\xff\xef\xcd2\xe4\xf6\x7f\x93J\xa1]Z\x81Gg\x17\xebs$\xe4\xb2B4\u07a7\x95*\xee\x17\u04ea4\x01q\xd5n1S\xe7ec\x9f(\xc3}\x82[\xd1\u02f2\xdf\x7f\x1bsZ\xd2%\xcd\tY_\xb6wp\xdc;8\x95 \x97\xbal\xef\xe0\xb8wt\x8fY\x9d\x9b\xd4\b\xeb\xa04Xru[)\x99\x1dW\x8a\xc7\x11\x06\xe3\xfe\xf7el\"\x91K\x86w\xbd\xf0y\x83\xd9\xec\x1fqi\xb3 \x93\f\xca\x1b\xc4)\xa1\xf0\xae$f\\~\xad\v\u050e\x1d\xc6\xf0\xf6\xcc+\xf6\xbc\xd4\xdb\xf3x\xb0\xe7}\xad\xfd\x816\xb6\xb7\xd0\xf3ZC{\xb2uZ\xd9\a\xf7\u049d\x14\xf9\xf7\x19\xc8\x0ei[m\xd8Q\xe0-\xe7i\xd2\xf3\"u59.\x1a\xe7\x04\xdaE\x8eO\xe269\xde(\x8c%\xbdq\x03\xc7F5L\xddP\xaf*\x96,>@\xec]\xb7\u044bD\x84\x1b=1\x9b\xda\v\xfe\x0e\xf1\x91cI\x88I\xde\xdf\xc0dG#\x8e\xdc9n\nl5BeK\x99\xa6!\xed\x9c\u0106'\xc2\x04zG\xb2\x1eU\x99\xa4\x82\x196\x0e\xca\x15/\x05\x02\xae\x85\x8c\xd8V\x89\xd3=o\n\xce@\x12/\xac\xd4\xd4\x13\x928\x05\u070d\xae\xe4\xaf\x12\x83BR\xa3\xbe\u01ef\x9d\x80\xe8\xf4SX<>-\xd9\x0eI\x00\x95\x18\x9fw\xf0\xbaZA\xf8\xae\x93\x96>-\xd1\x149\x1e\xed\xecn\x8fn\xdc\u063e\xd1\x7f\tj\xe3a\u040f%Y\xad\u03ab\xf8iI\x1f\x95`r\x16\xcb\xd5J4\x06\xbbq\x01\x83\x9d\xb0\x1b\x9c\xa5\xeb\xea=\x96\xf1\xa5\xfe\xb6\xfcc\xechh\x13(Pj\xeb\xbd9\x80Ib\xba\xd6$\x1c\xb7\xba8p\x19\x04\xe7\u07b2)\xe2v\xdf\xe6L\xd6Ly\x02\xdbTI\xb22\xd6\v\xa1\xd3*7mw9\xbb\xfb*f\xb7y\x8as\u00c4\xd4\x1c2\x8f\x9dNm\x86N.T\xb2<\xc0\x1b\xc1\xa6\xf6\x02XI\x9bM\x8f*\xf45\x7fQw\x8e/KB\x8eC \x13\xf2\xe3\xa3\xfbp\u046c\x81\x00\x1e\x9c\xa4q\xc9\x04\xb1\x82\xfdmZ\xb6\x84te++Z6\xa5O^\x93\x97\x81\u02fc2\x14\x96\x95f6rZz\xdc^Z\x86\xb3\xa2\x19\xe8\x89m\xcb6bf\x192FMX\xbd\x92\x18\x0evP\x92\xdc{p\x85\xd2X}\xa5+\x9a\x89\xd4*]\x1e>\xb7\n\x9a7\xf5\x7f\x832\a\x9dOK\xad\x9f\x99\xe3\x85\u008d_\x99\xae\xba\xe1r\x057\tB'\x94\x895\x16\u0467\x19cE\xc7\a\xfe\xe3:d\vZ\xf3=\xa3_Q\xaf0\xab\xedZ\x89\u0197f\xd8E\x13\x19\x85\xfd\xfe7EL>\xf9\xa8\xf4\u05c8\xfd]Z\x82\x8a\x88\x1b\xedM\xa5\x05\xab\xb9:\x8f7J\x12\xb0\xf4\xef\xa7qN4\x83\xba\x95\x9c\x8d%\x18\xbfz\u0388`\xce\xe8R\f\xbd\u0263\v\x1e\x18\xafX\xee/\xf8L\xf0\u0668\x1ex\xb3\xcd^\xb1K\xe7\x96.JSZKk\x8c\xd1\xed_Csm\xc9\x0e\xf1 \xb6am\x8c\xd2\xdcR\xafo\u04f1\xf4\xa5\x92\x93\xb8f\xe26\x85*0\x03\xea\xc6\xd4\x15 \x93\x9a\x19\x9d'.&\xd5oY\x1e\xec\xc0\xae\xfb'\xb10wT\xfa2P\xaa\x03=9\x91\xdfLA\x03\u04ae\xc6`\xdf \xcf6\xd4\x1eP\xbe^\x93\x1e\xaf\xe5d\xd4W\xab\xedd\u0507\xcd\xc3w>\xb7\xbdc\xbc\\\xb8-\xeau\x15w2b\x83\xf9\xb9\xc1j)GS\a\xea\x1a\u04e6\rk\xda\u0531]\xae\x9b\x12\x95}\xaa\xb9\u2790\xb7*A?\xad\xd3_\xc7ya\xfdohn\xb6C\x0e\xc7\\\xec\x14\xde\x1d\x1e\xec\xf7\x11\x14\xdf`\xa2\xef\x0e\x0fn\xf4\xd5j\x7f\x97r\xbfiv`g\xbd\x8c\xf9m\xf6\xbb\u0625\xde\xf2\x91\xb5}s\xcfC\a\xee\xc8\xcbr\xa8M\x14t\x1bA\xaf \u0730\xdc^\x86\xd7\xd7`t\xd0H\xa2~\u0566H:(\x8d\xa0<W\x8c\x94\x06J~\xaf!\xfe\u020d(\xc9b\xb6\b\x16ET2D\xcc\x14\x9b\xec\xa3\xd88\xab\xd1_\x1b\xacLi\xa1'=H\x96\x82E\xaf\x04\xb6o\xef\x84\v4o\x16'\xbdR\x81At/\u06841Z\x96\xe9\t\u07cc^\tx\xc47fB\x9a3?\xf7\xd0\x0e\xf1\xbb\xc2+\xe8\xd4+\xe8\xb4\x10e\x91\xf3\x012AbeNYuYJ\x8e3\xb0\xa8\u0525\xe0z_f,\x84\x01\xa9ecm\xb2\x02\x96\xf2=f\x1c`\xafV\xfb\xf6\x96\xf0\x01p7\x84\xf6\xea\x1f{\x1e jy\xff\xaeu\x9c\xed\xa6\xab\xb0\xb2G\x11\x8a\xfe\x05\xeeim\u047f'\xb9\x0f\x04\xff\xa2S\xf0/\x9c\xe0_\xf8\x82\x7f};\x16\xee\xfdZ4\xe5\xfe\xf6\x8d\xbf\xb5\xae\xd7\xf4\xb3\xb0\xa5j\xfd\xab\xb4\xa99\x8c\x88>\u068f$\x0f\x0e`\xe1!Mk\xffR\xbd\xfb_\xc2!\xd6,(<\x11\x19^T\tP\xf4\x973\xa00j\u02bb\xe3\xda\x03\xbba2\xa5-&\x93\xbc\x8c\xfb\xd4L\xa9f1\x18V\x13\xe6[\xb6\xf3m\x1f\xcc7:\x0e\xe6\x1d\xc7vy\xe9\xb1\x1d\xfa~\xe9\x04\t\x12I \xadr\x8cR\xad&\xfb\x05XBiW\xa0a\xde\x1f.\x8f\x88>\x8c\x1bF\xd6\xd2\xfan\x83W8\xd22\xc8-'y3\x95\f\x0f\x82\xabU,\xb4\xfb\xf4\xc3\xec\x88E\x91\xa6\x88.e\x82i\xe7\x985\x83\xcb<_\u03cdZ^\u00cdZ6\xb9Q\xcb.n\u0512L\u041e\x85\x1d\x1e\x01\xf1\xccJt\u06651i\x97F\x85l\xec\x9aXZ\x1f\x8d\x12\xda\"\x9b\u01f99&\xa7\x13h\x1d3@hg\xa3O5\x96\xb3\u0644\xa7\xf6$\x96\x93fsC\xb2\xe4\xc2k\u834e\x96^\xad\xa6\xfd\xfe\xb4+\xbc\xa3\a\xea^\x9b\x92iW\xaf\xe5\x87\xd9\xd1\x06c\xd3\xc3\xec\xa8\u0745\x10jm{\xa1\xa9ls\xd1\xd25\x13\"\xb6L\xc7Wv4\xfa\x19\x8c\xa7l:\x99\x86|N\x9a\x83If#\u0334\x92\u01be\x9e\xf6\xfb\xad\xae\x99\x92\x06[t9i1\x90\xa6\xfd\xbe\xe5znx\xf6\x99\xad\xb4\xa2hs\xfaA\x1c\xd0\x0f\x18s\x9dC,v\xd5\t8\x9e\xcb&\u01d3\x96\xab\x15\xfa\x19\xb5mL\x92\x96!\xeb\xd4\xed\x188\x02,(\x0fc\xec\xd1dZ\x03\xd6t\x8c\xe6)!k\xd1jJ3\xf4\xa8q\xa7\xb8d\xe5\xb8q\xe8X6y\x82k\xfa}\xd7\xea/:\xa4c:\u0372\u02fa\xed\x1b\x9e\xbey\x94.'\u6680\xfb\xfc\x1aF\xdc\xe9_\u0102-\xd3xk\x04\xa3\f6L\xb6\x03\xba\xa8\xe99\b7\u0605\xb1\xf63\x98\xebVW\x03\x97k'\xa4\x1fL\r\xaf\x89y\xbb\xfe\xf70\x8e\xbf\u01c3\xc7w\xa8\xdb;-\x81AI\u05ben\xf1\xe9\x15\xa50\x99i\xea\xf5R\x13\x92\u0411k\xbd\x9c[]e[\u05baJm\x00\x12S2*\xe3\xc2\x00\u06e5\x81\x99Gx|I;\xf7\x8f40&\xba\x03$\x11\xea\xaav5M\xc7\xe6$k\x16\xdfW\xe5\u4ad2\t\xfe\xb6\xf7\x9c\xab\xf8P\x9df\xe5\x11I\xbe*\a\xe9l\x16\u00d3kJb\x80\xa5\x95\xa6\xea\xc6\xf0\xb2]\fK\xa8\xd1\v\xf7\xea9DOj\x8c\x86$\x8a\u0580\xda$\xb0\xf2\xb3KG\xd3s\xae&\xe6\x9a<\xe7\xaa\x1eM\x8b\xd27\x90\x91|\x1e\xf8\xb7\xe8\xee\"\x85\x80\x85\x16JT\x13\x91\x8a\\\xbc\xaf\xb4Gt\\\x0f\x1b\xc0\xa1.\xbf\xb32p\xb6\xe5\xab\t\xb5}\x12\x06.\v\u0363Q\"\xdam(\xffX\x95\xa0l\x1eo\xef\xed[N`}\xe2\xab\xdd\x0e5\x18\xdb-\xddb\xa5a\x8e=]\xa0K\x8c\xc1b\xd5\xf0H\xaauX&\xc23\xad\x15D\xeb\u067c~-y:U\x0f\x042\x19\xf2\xae\xe4\x98Z\xfbUq\a&\xf4\x8f\x15\xd4\u92eb\x18\xad\xa4y\xec\xdc\xdf\xf1\x0f\x9c'n\xde:\x87\xf0\x1f\xd2\x03\x1e\xbfF1\xef.\xc4M\xf6\x8f\xf8\xc6e\x14\x10\xb6L9\x9f\x02\xd9<\xdea,\xde\xe9\ubcee\xd5!\xe3F$0\xe6\x16\xb5\x97\u02588\xffM\xe6\x14\xc5qL\xae\xffTe1&\x11ci\x9dz\xc01x\x174d\v]8\xcd\b\x1e\xa1=G\xfc\xb0\x8a\x05\xecCw\xf5\x95\xa0\xa7\u0460\\\xe1@\xac\x91I<\xba~\xb7/l':}\xaa\to\x1b~\xc7ZR\xd1\x18_\x1a\x83g\u0490 \xc0h\xd3o\x9aV\x97\x84\x86I;\xfb\xd6\x16\xac\xf6\x87\x8cO\x10\r\xe0X\xbc\xac\xc9\xfb\xfdE\x1a\v\u011b$\x1e\xd3\xe1\xb2\xe8\xc6\u06e7o#b\x0fznh\xe2c\xe0\x8f\u03f4.\xb7q\xeb\xc6]\xbb\u0703\x9e\xd8\xeb\xee\tW\x13\x86\b\xbc^\xc7|-ms\x8aFs\x82\xe4s\x0e4sL\x9c\x8f\xbe`1\xb2\x8a\x8e\anq\xd2\xf3\xc7\u032b\xedQ\xd2\xcd|h\xf2H\xfc\u0089\xa6\xea<\xaa\xca\xd6`\xb5\xbe}E3r\xf3emk\xe8\xbdy\xa7bA\b\xb9n\xe18/\x9b\xc7~>n\x1c\xf4\xedl\xf6\xdaY{\x10l\xef!1\xfa\xf3\x05\xf2\x8a\fJ\xae,E8\x91\xfe\x13\xe8\xf5\x94\xcb<=\x8fh$\n\x01\"\xe6l\xb1,\xa4J\x85\x8aH\"\a\xe65\xd3o\xb5@'\xcc\xdep]\x1a27\xccx\\\x98\u0163\xe8\xf7\x8b&e\xear&\x93\xc2fclH\xf4\xd7.\xef{\xdc+&P!\x96\x8fQ3=\xbc\x02\xd5\xe2S\xa6&Q\x944J\xe6\x98 \xf1\xf6N\xcd\x06\xd9v,\x11\xa7\xc4+\x9a\x96\x96\xc2x \xf9#X&\xfc\x8fc\x99\xf0_\xce2\xa9\xd1\xe9k=\xed7E'\xb5\xf6\xa6\x80\xe3~\xb1Xd\xea~v\xcc\xe5K\xb1\x80\x05\x13)\x92K\xde\xc5\xcf\v\xaa,\x9d\x02X\xc8\x1f@{\xec^\xb5\x03r\xa6\x1a\v_\xfd\xc6w2J\x1c\xd1\xc1x\xb0\xebH&\xd0~\xc7\xecu\xe3L\xcf\a\x95\x9e\xd0\u06ba\x95\x18\xa7\xb3\xbb}I\xc8C\x1f\tK2\xedS\xa3\x88\xbdZ\xbd\xafb\tk\xdfZ\x18\x87\x02fs\x12H\xfd\xack\x96\x8c&\x92\x16%\x88^;&f\x83\xf6\t\x01\x12\xbc\xc6\xe6\xc6x\xbf\xa9)`\xc8o\xd6\xdec\xba\x92j\xd4@\u0470\xa8{\t\x96\xd3c(\xf5v\x937z\x90x\xc3\xe6-\x12\xb1-\x04&\xeeK\x18y\x87\x90\x90\xb7\x05\x9b\xbc%\xd8\xe4]\xa2I\xde%\x9a\fM\x16l\x98\x1d\xf9\xfa\xa9)\xbc\xac+q\xaf\xf4\\:\xec1\xe7\x15t\xa7\xbe\u0775\xb7\xf5W\xeft\xd5\x13\xdf\xcdr\xc3\u02e9\xf1\xb6|\x0fZ\xd2\xd93\xf9^\x82\x1b\xcb\xfe\xd08t\x16L9m\xfe`\xef\x14-\x0f\xb9\x00n\xe0w\u048e\xdd\x17\x15kX\xc0k\x8c\xab\x86uL\xa3\x00\xe0\\r\xb4\xef\x91J\xe7<V\xe0t\xdbz\x06\ueced\xd1\rp=\xaa\x8c!\x11nE?g\u077aW\xc6\xf6\x81 H\xa43\xc5\x00\xff\xf3\xf5\x02\x86\xc9\x7f\xc8\"6\u07ab\xd7\xeb\xfd\xfavt\xd3\xde\xeb\x82l\xdbZ\x11\xbb\xfa\xf6\x94\xef\xfd\xcbZ\b\xec\xba\xcd\u00cbw\xf9Z\xbeFw\xaeu\xe2X%o\x1b\xb45[\xcb\xc9k\x98=\xa8\v\xf3\xdc\xdey.*\x1a\xb6\x1d8\xd8h\xc1`8J\xcd\xc7\xc7s{A8\xf8\u01aasH\xbc\xfbA&J\x95\x8a)\xa7jr\xb3\xa1\v\xe4\xb9E\x87h\\\x1a\x12\x13gt\":\xc2\xe2F\x128\x16\xebTH\xf3\x13A\x12\x88B\x02A\x03\xb7B@$\x834\xad\xfb\xac(\xd4\x1d;4\x89S\xffP\x96\x85\x8c\x96\x18\x1e;9\x94P\xc8p\xd1\u05fb-*\x8a\xb86\xf4\xcd\x01=\xa5\x91\xce\xf7\x9e\xff\x8f?u\x17\x88\u0386\x0e[\xe7\x03\xab\xf6\xfc\x9a\xaa=\xbf\xbajo\x9a$$E \v\x8a\bkz~l\xa4\xe0O\xb5\xb0+\x98\xb63\x1d\a\x938%\x1d\v\x96\x93\x8a\xb0\xd4[\xa8\xd2`\xa1\xaa\x17\xa9\x1eo,S\xc2\xc0Xu-S=\xbeN\x99\xb5\xc0_\x03H\xe0\xda\x10\xbdE-\xdd*tN<\xb1u+\x19\xa7\x15+h\u03aa\xf1\x18\xb6\xf2\xe32.im\x15dTK\xfa\xfd]|\x80\xaf\xf3pz\xe74\xb7\xb1\x9c\xaa\x12\u053f\xea4j\xcd;\x17\xbc\xdc-xyM\xa8\xd5\t\xe4.\xc2:o.r\xf6\r\x96\xc2v\xa5\x04\x97\x8d\x02*\xe6\xb52L\u0432\x9e\xa0\xa5?\xc7}u\xfb\x8a$e#\x00F\xa2\x1f\xe2%K\x1c!\xbd[\xb7\xaf\xe7\x99\xcf\x11\xb8~Y::\xd1BA\xd8z\x15\xb4`E\x93\x02vy!\x01Z\xb8^r\xb9|H\"0)/\x91<\x16\x9d\xbdS\xb8\xde):$\x8fPm\xe4E\xe8g\xa27\x948E\x1c\xa1\xa2\xd9]6\x1a\x16\xacMX\xdf\xfep\xb6[\x9b\xf4\xb5R^\x8fv\xf1\xb8\x845\x18/\xb8\xa4h3]\f\xc1\u02c4#\x815\a\b\xe9]\x84\xab\xaf)a\xea\xeeX\xe0D\u0280\x95\xc6D\xcb\xd6=\xf2VB\xbeM\xdeKx\x1a\x0fY\x84{5%\x8f\x06s\xf5\x80\xd3Fi\xe1y\u031c\x1f\u06d8\xb8\xf8:\x91c\xee[\xae\xa5\xedF\xeap\xf0\\\xfb\xaaE\xea\xc2\xd8p\x18A\xaeV\xa5\x8cd\n\xc6\x12ZK\xe9|\xe94W\xe4@\xa4\v\xb0\xde5\x02\xcd7\\\x0fW\xc5\xde\x18\x89j\xc1\x86\xe3\xe2\x96s2^l\xb2m\xeb\x067=,\x8eh\x05\x97\xcdQK\x1f_\xd0\xea:}\xfc\x13\x1b+T\xb9?7\xc1ocAKZ\x01=aF\x19\x0fE\xd4B\x17\xba[:\x9c\xb7_:\xadq&B\xb3\x88\x81g\x15A\xafx\u05e9d\x9e\x06J\xe6\"T\x1bOQ\u025co\xb0\xe0S'\x9a\f\xb5\xca;\xbeo\xab\x9d']\x91\xec\xed\xe4\xf0(\x89\"\u0213\xac\xd7\xc1\x18\xdeO>\xcc\xe2r\xbb\xd6\xcaA\xa6\x91\xeaf54\x18Y]\x1c\xe6\xc6d\xb0\xf6\xa8Pw{\x0f\xfa\xd2\xc0-jr\x9cI\x93\x7f\u056dM\xd5\xef\u01df\x97Z\xc9\ubf34\x88`\xd0F\xc6\xe9\xc4\x1d<\xea5t}\x9ao\u068a;\x18\x03\x12\xa4\xdd\xd9^\xc3\u05fa\x13\b@\xba\x978\xc48i\xcec\x87uR\x93\u0656\x8a\x87F\xf3_\xa0Lh\x86\x96\xf1\xf3B\xdeK\xa7\xa7\x9e\x9a\x8d\xb2K\u43d5\xef0\x85*2\x16\xc0\x96\x8a\x15\x8a\xae\xb5\x10\t\x92P\xa7\\\u0112J\xf4\a\xe2\xad\xf1O\xca\xc0\x93\x8f\x87\x1c`\u02a5}\xd86\x1c\xf5\xdah\x1es\x90\xf4\xfb\x1e\u05f6\xad\x13\xe7\u01a5\xf7\t\x9e\x18\x7f(\u0663T\x9d\x0e\xa6<\xcb\u90f2\x01\xfa\x7f\u05f8\xcf\u14be(;\x1d\x02<\x06\r\xe1\xa7\xe6\xe4\xfc\xcc\\\x1fA\xe0]\xf8{X\xb2\xbc\x88\x87\x84~\nO\xf7\xcd\xfb\xf7\xf0\xf0\x12\xfe~\x84\xbf\xaf\xe1\xef\x1b\xf3\xeesx\xf8\xa8d\xa3\x8f\x87\xb5\xc8\xeb\x8b2&\x17\x1f\u9478\xb97\x1cjL\xb6\x92~f>\x02\xd1\xe7\x88~g\x9e\xbe2\xd7o1\x94W\xfaIU\xec\x00D\xf1\xec\xf0\x88J\xfc/\u031b\xb4\x02\xcc\x17\xf3PVlkD+\b\xca\xe1oj\u0097U\xe0\xf9`^\xd5\"Md\xf9\xdc\xec?.\xc9\x04\n\x98l\x8d6\x18+\xabIY%e\x85e\xf6\x84\xb0\x15\x8c]\x03\x8c\xbe\xdd\xd7\xfd\x8b[\x99\xd5_\x1d\x8d\xcd\xdb\xdd~\xad7\x7f\x00\x16\x1a\xe0,n2J\xb6M\x04V\xa13\xf2\x8a\xbd/Q\x1f\x90}Wx\x1e'\xc8\x05\x04\xe5\x10%\xaf\xecv\xf8M9\xf9\xa6\f\x9c\xb4&C\xa0\xfc\xab\u02a8*\xee\x8en\xee\x8fF\xdb\xfd\xdf\u5547\xad\xc4b\xd5g[\xa86\xab\x9f`\\\xba\xa8\x9c\xf4\xb7\xb4v/\xbb9:\xd8\xf6]\xd0p,\xb3\x91\xd2<\x06v\xfc\xc1M\u0505\xe6\ucf4aG\u06f4\xaaH\x82\xf7<\xf0\xd1\x13\xeeF\xbd\x03\xb7\xb6\x8c\xf6\xf4\x9arp\u04c5\fM\x88\xe1\xdc\x1f\xec\xdb77\xcd\v'N\xd8n\xea\t\x0e\xd7k8gVU\xe0\xa8sV\x99#\x11r\u0287\xb7\xd2\u02ac\xe1\xc18qk\x13\xaaY\xd6K\x7f\xcc\xd9\x02\x13 \xa1\xd1\xc3\u05ea>h\x81\x99G\xbf\x1f\xffX\xae\x98\xa2\xbb\xc6>\xe4\x1c\xbe\x02s\x0e#\x8b\x87\x96\x1b\x8fP\xdc\x04\xedwS\xb7\x1f\x0e\f=\u058ea(%\xf1Y\xa5-L\xa1g\x1eC\xb20Y\xe8gE\f\xf6\xa8f =\x06\x83\x91\x83\x9b\xfa\xa4xp\xa0\xb1\xad\x1dM[M\x8a\xaa\x96\x81\x83g\xb2\xa2\u00b5\vdf&y\x02\x13\xd4k\xa4E\xf5\x01@RH,u\x83IY\x14\xa0\x90\xef\xc5\xc7\u0107\x8d\x83\xe6\xf1\xb5\xc9}\x18\x99X\x84\x11[I\xeaKo\u01f2e&\x1ew%iH\xb8\xab\x96\xa0\xc4\xe9\x12@t\x149\x97U\x89\xccH\xeb\xe5\x99\xf1\xc12\x13'\xf69e|\xc0\xdf-3\xadF\x03\xaa\x99%-\x19\x0f&\xdbxx\xabl8z.\xad\xa3g:e\xe9a\x85zX[\xd0\xebS\xb7J\xe4}\xe0u\xc0\x18\xc8\xc1\xed\xc4\u0154)\xfaP\u0179Uq\xb9\xab\xc6\xf0%\x1b\ro\xb1\xe5d\xba\xb9\xbd7L\xf6\xf5\xed\x1e\xdfI\xb6F\xe6\xac4\xbd\x05b\xb3\xd8\x14\xd39\xa7\xce\u0278D\xdf\xd2\xc8\xd2\xfe\x14\x86\xa9\x1e\xa1\x93G\xb8:(vW\xe1\xe0\x92\xc4\xefK\xb0\xc0)\xfa\xfd'E,\b\r\x9b\u02f1rM\xd8S\x99\x152S\xe7lH\x9a@Uz\x92uD\xf5\xf59\xfd\xac\u05a3=\x9c\x12\xb1`\xc7\xc1\xe6\xeb\xec\x06\xde\x17\x93\xf8}\xc1\x0e\xc5\x11}Y\xb0;E\xfc\xb4\xa0\xdf\x17\x84$\xef\v\xad#\x84x+\x9f\x16$\x19\xedbR\x82}Y\xc4\a\a\xb4\x91\x1eb\x13^\xb1(\x8d\xf6\x9a8'\a\a\x8e\xaa\n\x8f3\xa3\xa4\xa1\xdb|`\u05e6\x00@\xe5F\x97\xba\x84[\xbd\x0en\x04\x1c\x10\x9b\xb6{mV\xc6:\x8f\xe1%\xdc\u069d\xbd\x9bP\xbd\xf5\x1a-\xb9\xa0\xf2\x82\x9e4\xeaN\xba\xbaO\xb5\xfa\xd9#eN\xec\u01a67\u043cb\xb8\x87\xd6{c\u04f5\xfd\xf6\rR+\xc8\xfb\xc9\xc2\x14xT\xc5(\xbb\xf5\xc37\xea1\xd1\xf3\xa8\xb9\u0588\xb5\u06e3hE\xd6B\x9c\xc7\xe5\xf81\xd8|\xee\x9b\x03\xe0\xed*\xd6J\x85OK}\x94{Tj\u019d^I\x9fk4>2\x1e\xa3\x10\xe5\x87*6G\x9eZ\x8d\xe6\x8dQ\xa3\x81\x19\x04\xea\xec\x0fJ\xa7J\x93\x02\x85T\xd8U\xecY9\x91l\x98\xc4O}BI\xb2O\xf5\x06\x1e\xbf/\xc1\xb4\x8f`\x8e\u00da\xc37\xd4\xfa\xc6\xc0\x8bfZ)\xed\xb1\xb64\xe1\x1e\xc9\xcf}\x92\xff\v\x19\xb7\x94L \aXF\xef+X\xd7\x11y\xe8\x8eY\xdb\t\x1d\xe1\xfc\xd6=\xa4\xd8\xfd\x92\x9a2\xd0s\x1dEo\x03@\xca\x10\xeaD\f \x8b\x11Yy\xcag\xdf\x14\xf2\r\xe3\xb6\u04be_V\x17\u5871\xe8\x94\r\x1eFsp\xee\xee\xd9\u00c9S\"z\\\x85F\xceZI\xc1\x96,\xde\xdf>\x18\xed\xee\xed\x0f\xfb\x82hJ~4\xbc\x15K\xf6y\t\x94\xe2\x16\x96\x998\xa3A\x85\xd52\xfc\x1e\x90\xda\xc6Ekm'}A\xf4\u0684~o\x83u~\u054a\xddw\xd0e\x03\xa5\x15\xf2\xb5\xc33\xf6\xb9\x8c\x1f7\x96({Z^\xb7\xaa\xb4\x1bT\xc9\xd2V\xbaF\x1ew\n6\"~\x06\xfa%\xb8\xc1\x14lk4\x1e\xde\x12c\xcb0\u041b\x8a \xe3\x146\x95\x92\xc6%\x93\x87\xe5\x11\xf9\xa4@\x1c\xb9\x92P\xd1g\xbfK\xb5\xfc\xb8\xa0\xd0R\x82\u0163\xed\xe1'\xb1@JuK\x90\xc9h{\x98\xec\xde\x1c~\"&\xbb7\x87\xc9h\x88\xb7pIF\a\xdbx\x7f\xb0=Lv\xf8\xce'b\xb2\xc3w\x92\xdd\x1d\f\x85K2:\xd8\x1f\xfe\xfa\x872\x16\x1f\xc3\x1d\x81\xf4\xc8\xc5\a\xb4\x8c\xe8j\x99K\u05af\xed\x03X\xbd\xecY\xb2\x1e\x98\x8d\xd5\t\xd6\xf6\u01ba\xd6\xd8\xf7\u03fd}_\xf5\xd9\xef\xbe.)\\~,i\xb3\x93WL\x85\xe3\xa0\xcf~\x87\x0eS\x9b{\xfexxK\x8d\xad\x10Y\xf7\x86\"TBo\x881?\x14G\xb08B&\u0497\xa1\xbbC\xc1\u056b\xa5Y\x18\x1d\x05\t\xd1\xc3\xed\xbc\xff\xc8b8(\xf6\xa8\xa4BOqE\xc6\xf5\xf2\x82']\x1d\xac\xa7\x03P\xaaF#:\b\x1f\xea\x1c\x91\x93g\xb4\x1a\xccI\xf9\x97/?\xaa^~\\\xd9\xcc\xf2#L\x95Ek\xf9Q\xc1\xf2#<\x9b\xa6\x9f\xbb\xfc(\x8a\xc3+H.\x18\x11o+\xdf\x1f\x93\xdd)\xb4s?\x93k\xacj\x1f\x7fx\x1az\\j_L5\xdd\xedu\xed\xbdv\x82}\xb6\xb5M!\u075b\x7fD\xba\xefL\xba\xcb\"~X\u04bb%\xa1wq\x90\xbe\x87\x7fO:\b\xc7\u053b%{\xe8v$\xb0\"{X\xfa\xde\xeb\x1d%\x1f4\xa7\x93\xb6\xfb\xed7tD~0\xa15\xd1j(\xc3\xc6;\x18\xef\x1fI\xc4\x06r\x9b \xd1\"\xe0ge\x83\xfc\xb7\xeb\x98d\xc2\xee120\xb0\xd3\xf1\xb4\xe6\x05(*\xe3\t\xe0N\xed\u0324\xb4\x86w\xc1fq\x89\r\xdd8\xd0Zx\x98\x86(L\xbd]\xfc\xce\x0fqt\xddA\xa2\xed\u0642\x97\u00e4h&\xe1q\xbe\xa0#|)\xf5\u04d2q\xe0\x97\x00p\xa2\xeb\x1am\x86\xfa\xa8dwK\xf6\x1eFk\xc05\xf9\xbad?\x96\f\xd8&\x9e|\xce[\xc1\xc6n\xddyV\xe2\u0232\xe4\xc8w\xce.\x89=\u03a8\xcc\ua0ced<\xeb\xc0\xe9\x05zcl\x95\xba\x8dKf\xfb\x027\x12\xe7\x87]\x97\xd81\xf8%\xc0\xd8\xc3\xe6\xf2m\u0286TdLe\x8cg\xba\xf8\x05\xbc\xa3/\xca@\xcb\xd8\x12\xed\xc2S\x932\u0093\x8bOK6\x82\xca+\xcbW\xb2\xfblbM\xb3h\xe6\xa2S-\xe4B\xc9<.}\xa5\x83\xe4\x1b\xee\xdel\xd8\xc3w\x9b\xc2o0V\xf5\xfb-\x13\x85\xaaS\xa9\xa9B\x9e\x9e\xb5&\xaa\u018e\xa3S\x1av\x0e\xbe\x99\xfa\x96\xf5\xe3)\x98\xd6\xfb<\xc9i\u00ec<\xe8\t6m\x99\xa4kK\u2a7e\xa2y|\x87a}\x87[\xba\xb5>(\xb6\xb0)\xe8\x9ceV\xc1\xe9\x14\xaap\xca\x10\bx^\xab\x06\xce\u063c1@\xea\xc3\u06cc\x9cZ\xc6\xd2\xccc,\xea3\x1e|\xbc`\xf3\x90\x8d=>\xad\u0742/<\x98\x8exC\x87\\\x85\xac\xb1\xb1$k\x18Z\xa7\xba`gl\xde\xc5\xfae\x8c\x9d\xe9\b'\x96\xa51>AfFNh\xf0\x05;\u045b\u0799y[w\xe1\xdcva\xf6\xffc\xef\u07d6\xdbF\xb6\x84a\xf0U(\xf4n\x1a\xb9\x99\xa2\x01\x9e\t*\xc5v\xb9TU\xee\xed\xb2\u0776k\x1f\x9afiCdRD\x19\x04X8HV\x89\xec\u82c9y\x81\x99\x8b\x99\x88\x99\x9b\xb9\x98\x89\xfff\"\xe6\x15\xbeG\xe9'\x99X+\x0fH\x80\xa0,{\xef\uefff/\xfe\xde\xd5\x16\x91\xe7\xc3\u0295+\xd7qe\xafL\xc3n\xf5\x1b\x83:\xaaO@\xe7\u344bwX\x8a\vW\f\xc5\xd8}\x92\x8a\x90\x9a\xc3by\xee\xa4\x15\x86K&w\x98\u0661+\xdfN\xe9\x1d\u0667\x8aU\xa2\xc5\xdf\xfb\\\xc9\xd7R\xc9k\xb9b>R$\xcf\xfd\xc5\u069c\xff\xd5\xd4.e\xe1:lS\xaay<\xf4\xaa\x9d\xf2\xcc\x0e)Hsu\xbc\a;gW\xa07o\x87x=\u05d6\xa6'h\x10\x84\x8e\xa2\x04\x8b(\x15o\xb8[\xf6\x93Ii\xf94\xa4)\x99\x84\x82\xf1}Ko\xc9^/$\x98\x11\u04d5\x84\xe5LOo\xc5Ve\x03\\\tY+2\u0259 \x83\xec\x7f\xb6\x85\xeb\x05\x88\r\xf5\xac\x81\xcc\xe8\x86VQ\xb3H\xcbjh\xaa\xad\x81\x8d4\xb4\xc9:m\\\xe5Y#\x8a\x1b\n\xe8\x1a?\xbdh\xdc\xfai#\xdd\xf2E\xb0\n\xf8\xb2\xfd!\xfa\x10=[.\x1b~\xe3\xec\x1d6\x93r]\x9a\xb5\xdb\xed\xf3\xa2\xaf\xc6:\xb8^\xf3\x04\x8c\u04325od\t\xe7\x8d,nl\x93\x18\u07275\xfc\x06\x18\xbf\x80mp\x10-\x83\x85\x9f\xc5I#N\x1a\xdb\xd0_\xf0u\x1c.y\x02\xa5\xa5\nj\xdb\"\xfb\xfeI\xe1#\xa6Ch\xce\xc2\xd4\xcei\xaa\x0f\xa8\xbc\fW\xc6e\xd8\xf5|\x96\xd3\xf2\xaa\"\x97V\xad-P\x03k\xdf^\xd1\x15\x18\xb0\xf8h\xc2RR\xa5p\xa1\x05\u073d\v\xb6\x12\xea\u04df\u062a\xac\b\f\ab\xd0k\xae\x8a\xf8\xa55\xb8\xf0\xe2\xa8\xc1\x90\x96U|\xaaE\xa2\x9f\xea\xedx\x94i\xcenw\xf2/\xe8?\xd2\xfeD\xd0K\xcb#&\xbbN\xedUy\xb2\xfb\a@k\xff>\a\x9e\x92\xd6g\xcc\u0125\x8c\x84\x8c\xc1\xec\x82\xfb\xa7\xb8\x98\n\xf5\x01q\x1f\x19\"\xfb\\\xc7p.X\x00\x8a\\}\x91\x9a\xb7\xb0\xf6\n\xf2*\xf0\f\xb6\xea\xf3Z\xfas \xb9\x17\xf5\x9c\x8al\xb7\x934\x9cdQ\xbc~\x04\x8b\x02\x88K\x93O\x91\x18\xd4Y\u014f\x00(\xf7\xa9I\x94\x18\x17\u07e4\x86$\v\xa6\xae\xf5'\xb0\x9d\ty\x91\xdboMR\xf3\x97\xc3B\xcd\xe6\xc9/@\xb0\x1d\x94}\x91\x17B\xbe?\xa4f\xacN\u0281\u079dT\x95<\u02ea\x9cT\xdbj\xbc\x87\x86\xbc\xb7@GT\x88\x8f\xa2\xb3\xf7Fg\\\u0748\x91\xe9+z\x12\b=[Im\xe0E\xe1\xf4FE\x88\x9e\xfb\x92zE\x9a\xa2a\u00f7i!\x05@\x91-@\x12\xde4\xa8 \x8e\xee\xa7\xe5\u04ea\xfby\x85q\xbc\xbf\x8bX\xba\xdf\x02S_\xf0\xf7#uc\x15\x84\x9dCc\xad6^8fOv,\x96'&6\xdd\xe9\x18*(\x13\x93\xb1\u0392\xbd\xe1cIM\xda\bg\xac\xe0\u0624\xaf\xf0\x11P\x0e|a|\x91B\xe8[\xf2\x8e\xa4\xfa)\xa7\x9a\x9f\xa6\u01d6J\x93\xfc\x98\xdb\x12B\u0773\xacd\xc0S\xe9cz\xb4\a\xaf2\x89J'\xa4\xf0\x8eS\xec{\x8e\x1a\xbbD\x1bk*\x17MNoH\r\x00(\x05X1;\xa9\xd3e6\xe8\u060a%\x95v[~\x00d\x19\x99\xc0\xbf\x8c\x971\x1e0\x02\fwd}\u3f3d2\x8e\x00\x8a\x9ed\x8b\xff\x8c\xbc\xf17UAw\xf5\xe1\xfcF\"\xaee|\x0f,\x8ar\xb7<'\x93\xcfs7\xd4\xd3\xd2|\x86\x9a\x8a\xb6U\x1fb\x8fz\xb0R\xb4s\xd0\x14o\xb9_w\b\xfd\xd6\b/\x14\xb7X\x1e\x95\xa8|T\x12\xea\x17\xd8F\xc67\x8a'\xe5\x14\x16\x1f\xf0\x90\x98Sf!\xe1w\x89y\x83\x956\"\xb0\xff[\ue6e9<\xca\xfc\xe8:4J\u01acH\xdd\xf0(K'\x86\"\xa3\xc9$\xcck\xb9T\xfeD=^\x04\x97\xca't\x01\\\xaap\x12\xcf\xc29\xb8\xfd\x82? \n\x97\x7f\xfd&\xfb\xb7\x85\x01\x7fq.1\x02X;4\x9b\xb1\xa0\f\xb9\xf8\xb9\xe4!\u03f8\xcd\v^\x15@\xa5y{\xc0\xc1\x94\xe7c\xaa-`\x8cciGG\xceeD\x93r\x1c\x1e\x8c\xba\xedU\x12\x15\x92\x11|s`\xfa#\u02e5\xdb9x\x81\xfe\x94\xb0\xef3z\x9d\xd8)\xbbI\x14\xafXj2\x05q\x84\xbe\x8e\xac j\xa4$g\xf7)|yi\xbb\x9cMy\xb44\x13/\xa2\xe5^p\xef9\xb0us\xa0\xad\u04caG\xc5f3\u05daG\x01\xbf\xdd\xedn\x83h\x19\xdfR{\xc1r4_U\x8d5\x9b\xe5o[Z9\xb2E;\x01\u076f\xe7h\xdfq\x9f\xb3E\u06cf\x16\xeb8\x11z\xb4\xfa\xf3\xf5j\x95\xf2\x8c\x86l!,\xe10{\xa1\xbeD.\xb2\vr\xad\x10JC\xfd\xb3\xa0\x8d\xf2\xb2\u01bd|R\xd2\x15\xc0\xc6\x1a\xfeY2\x87n\x98CoXJe\xa4\x84L+\x03+\u063c\x9b\u0708\x90\u028e\xb0\xee\x86k\xefF\xf7\xb6\xdb\xd9+\xb6m\xf9\x84B\xa9P\x94Z\u0514Z\xb3mkAh\x97\x99\xe9\u0366\xbdm\xb1\x9bB\x8bJ\xaa\xd4\x15n\xfc\xee\u060d\xe9@zB\xae\xd9\r\xbdaw\x13Cg\xf9\x861\x96J}[d'\\3d\f\xb4ZK\x86C\x86!\x12z-\x9c\x81\xb7Z\x1b\x86C\x84!\x95;\x02\x98}\xa7\xf03\xb67\xb9f\xf6\r\xbb&\x86\xba\xed\xfe\x86\xdd\xedAb\xc6\x18[\xedv\xf8w-]\xc5\t`[!t\xad\xa5\x10W\xeeB\x0eK(\v8X\xc0)\x17\xf85a\xf7\xb8\xc1|\t\x9e\xa8\xbd\x94j\xe8|\vP\xe3\xe5{\xfa}\x06\x1c\x99\x92\x82\vh\xd4$@\x00\x01@\xbc\xcdM[\x9f\x02\x13\x7f_%\x11\xbb]\xe0\x15\xff\x96\xdb\u07e7\xc0\xcb\xff>e\u07db.\t\xf7\xe5\xdb\xe0{\xd0f\x93\x9d\x9a\xdd\xc1\x1e\xa4L3\x9a\xbeW\xa2\xf2+hMx\u0402\xb0C\x83\xe6\x15\xfa\xfa\xfd\xde\xf0\x17\x88\x96&ng\u053c\x12Un\xa1J\x89v\x93\x8d\u078a\xfc\vv\x8b\x86\xf32\xf5\xe2\u023bfza\vG\x84\x17%\x94A\xf6J]\xd2u\xba\u0627\x94W}J\xed\xefSB\xd5p\x9b\xec\xb4k\xb2\x03\au\x05\xe8\xb3\xd46\aKayJ|\xc5N\xcf3*\xb8N\xa7_\xc9\x1fU\xf3?\xd7f\xcf\xfbL\x81\x11Xl\xc1\xcd\xf1\xbd|\xdf\x7fb\xb9\xb1\x9e\xb7\xa9\x9dkX\xff\xd4l\xde\xc2\vm\x7f\xb8\xed\xff9\xb0\x03\x86Q\xec\u05c4\xde\"\xbe\xa6W\xec\xa2m@:\x05=\xb52\xac\xd3\xdb\x13\u01ae\x9a\x007We,\xdcln\x12\xbb\x92\xd6^\xca\x1f\u0483;\xbd\x02\xdb\x1c\xd1}\xdal^'\xf6\x15\x10\xbe\xb7\f\xe1/\xc9h\xc1A\xb9`i\x9bG\xa8\x8cw\xc1n\xb5Oy\xf3\x16\x01\xe6L\xe5\xf6`\xb7\xf4\xaatw\b\x8d\xbcM\x10\xd9\x17\xf4\xaa}cb2\xe2\xd9\x17\u033ee\x951\xefv\xcb\xe2^\xb9\xad\xbbWH\xe5N\xb1/\xd8E\xe5Z\xa1\xc0\x002\x82&\xc8>\xe9\xa7b<j\xca9\xa1)\xd3\xf3\xc6IO?yF1\x1ea\x94\u74cb6\xff\x94\xf1h\xd9l~:G\a\xda,\xa5)\xfbD?\xb1\x1c\xfa[&\xf6\x15\xfdD\xa8/~\xa5\x84\xe6\xcd&\xa0X\x10&\\\x18\xf7\xdbnwa\xdcnp\x83 \x96/\x92\xc5=\x86\x191\xfe\xdc\xed$X\xc8\n\xbe\xae`\xdc{\x98.\xca\u00e6\u0677\xecV\xba5@\xc0\xb1\t\x9a:\xe3.\u0662G\xaa\xda'\xf4B\xdaA<\vC,\rb\x9aO\xe7\xe9\u053e\x00\u0398h\xe0\x96P\xb5\x04\xb6\x18\x01\xd5\x1d\x12\u03fe\x85\xe6/j\xf2h\xa9\r\xf8?z\v\x8e\xb7\x00G^\xb0\xab\t\xec^q\x89L\b\\\x1b\x17\xc6Ex+T]\xb4G\x99\v\x1a\xf2U\xe6]\xb4\x85{\x9e\x97|\x95\xd1,\xde\xea\x84\xf7\xf1v/X\v5x\xf0J,Y\xb3)\x7f\xe0\xa1s&Wg\xb7Jg\xfd\xaa\xd5\"\x00\xf2\xb3\xab9Q\x1e\x10\x8c\xae\xd8E\x1b\xba\xa7\x17\x95\xbc\xf7\xf1\x96]\xb4\xb3x\xbb\x87\xab\xe8\u49c4\xfe\x9a\xb0\x9f\x12\xad?\xa4p\xee\xc15qUsM\\\x96\xae\x89\xee\xa0y\xd9l^\xa7\xf6\x15\xad\":\xbc(.\xc9\xfd\xad\x84`Dp\xef\xa0v\u0657\xca;\xd1\xeeGf\xde4\x93\uf44fKo\xd9\u01fa\xb8\xe1\xef\xa6\xef\xec[\xe2\xbd\xd3c\xbf\xdd\xff\x97\xe1E\xa5\f\xfb],\xf8;\xb1 \b\x8cu\x84\xc9\xfd9%Z/\x96\xd3\fD&X\f\x96\x15\x97\xd9X\u05ec\xdc'\xfd\xfe\xd0\xd1\xf0\xa8\xa9\uf7a6m\xc3\x1e\x90\xb27\xd0\u02eaW_\x98J\xa6ttP\xa1\xc1|7\xc11\x94\xfa\xbbR+e\xca\x19cA>\xf5\xf3V\u02f3\x95\x0e&'\x1e\xfe,\x19M\xd2GZ\xa0\x83\x1da\xad\xf99d\x80\xedy\xa4\"\xc4\rz\x82\x01\x1ai\xe1\xb2d\xf7\x18\xb6\xe9@3\x16\xf2\xe4\x7fU\x1b)t\x929\xfbK\xaa\x15\x93\xb5w#\xad\u0439\xdb}\x1f\xdb\u0577\xf4\xdb*\x9bL\xc38/\xd35\xdb\\3\x8b\x16\xf9ng\x8bf\u0546\x90)\xcf\x00z\x169,*\x90w\x0e\xf1P\xbe#`\xb8\xd9D\xf5\xf3\xefa\xcd\x0fK*\xdd,}\xa8\xa0q\xf4iC\x9a\xcd\x1bY\x11\xf9`}\xb7\xd3\x04\x85\xf7?\x83\x13\xaa?\xa3\x13*P\xa8\x1bR\xfb\xd0\xfb\u04cf\xcae\u7790:\xa0\xd6k\x00\x05\xe1x\x8c\xd1\x01{\xae\xa6?\x1e\x9ee\xf9t<\xf42\xad\x9f,\xf4\xba\xff9\xb69\xfd&WfE'n\xd1\u0537\x925\x11\xe5\x02)fp\x9e\xbex\xb0E{/e{\xc9\u07e9\xbdo\xe4T\xe5N\xf2\x9c\xa89H\x97S\x1c\u014bJ\x8f\xfd8\x17\xa5\xeb\xea]\x93\x9c\xe3n\a\xbf#\x96\xe4\x13\xd4y7\x82\xd39\x93\xe4,R\b<\xd1FG1\x8bf\u025c\xfa\xf0\xa7\xe5\xcei\xcab\xd3\xe9A\\\xb5\xf6\xaa9n)\x9e\xad\xb4\xfe\xf1\xe0\x1f\xc3s>\xbaBPQ&\x95\xa2~\xdd0+C\x9c(\xbf\xae9\x8b\x95S\xa2b\x98\xf9W\x0f#7TJ\f\u0382:\x94\xf9\x84p\x96\x1b\xd0K\xf3\x1a\xec\x98k\u4617\xf1b~\x80\x17s\xa6\xf1\u00eb\x94e\xa8\xf2AO\fa\xffwZ1}\x85\x81\xea\x19Jv2\x16\"\xb3\x99P\x97\x10H4\xfc'+et\x17Ez\xa8\x82\xeeju\x9a\xcc\x04B\xe9\tLz{\x92q]\xb1CH.\xae\b\u0164;T\u07105\xa5\xa7\x81\xef\xd0\xe7\x12U\x8e \x00I\xba\x9fq:S\x03I\xd1\xc3\xfe\xe8v\xbb\x9a:\xc9c\x85J\t!\n\xe4\u05f0\x82\x1c\x16\x12\x0f\xb3\x8b\x8f\x8c\x95oG`\xa9\x17\x97\x974\x82%\x8d`I\xc9\x1f3\xf8\x01enr,\xabU6\xff\xd6q\x01H\xd7T\xc0\xe1\x19W\x8f\x14@\x19z%\u014e\xfe\x94W\xad\xd2\vaq\x11\xfd+Q\xac\xbaL\xc3\xce\xe7\xf4\x1d#\xaa\xc4O\xf6\x8f\xa9V\xba\xb4\x85\x1d\x83p~\x81\ff\xad\x97\xf9c\ne~L\x9b\u037e\u3723\x96\xe1\x0f\xe9T\xaaxy\x7fLw,\xd2 Y\x8c\xff\u05d2(\xacj\xb3%$s\x911zi\x99\xe2H+\x150\xae\u0274q\xcd4c\xae'd%\x10\u0151\x95\xeci\xbcCk\x1a\xd1\xd6OY1\x89\x7f\xcbs\"\x8c\\z\xee\xb8\xd7uz\x04\xfd\x84\u059d\xb6\xac8m\x99\u059d-\u016e\xffc^8\x9aDG\x82 \xfd\xe7\x14\x7f~\xe4w,\x12?\x15\xc2\xc0\x0f\xe1\x18\x05\x7f\xaa\xb8\xaaXFc\x11\xd1\xd0\xddV\xfe2\x1d\x91\t\x03(H\r\xa2%\xff\xc4\x1c*\xdbY\x19Y\xe5 \xf7\"\xad\xe4\x80\x05S\xca\xea%\x98d*U\x94\xca\x18NUD:\f3\x11\xbf\x85\xe7p9\x10S\xae\x82\x99ARN\xa8\"\xd6bE\xa4b\x9fhEp\xd5\xf1\xa3\xecX\xa6X\xfa?\x19K\xaf\x04\n\xfc\xd6\u0711\xa2\xec\x0fy\xe1\xdf\xe5\xc4>Ac\xadm\x12g\xb1TB8\xe1\xed \x156q\xea\xa4\x1a\xb5\x7fW\x01\xe0\x82n3\x04\x192@^\xc4`\\\xf8\xb2\xc8(\a\x18\xa0\ntK\x1b\xc9\xcd/*\u0423\xf4\xdaI\rtj\x1e\x17j\xb8\x1ec\x9c\x96\\\ue00dCu\u07eb\x8d\xaa\x8d\x8a\x0e6!:\xf4\xc5\x13\x1d\x84\xb8\xa7%q\xa2i\xddC\xa5x\x85\xa9`\x18\xb2\xa4*Tu\xe6v\xe0\b\xb2\" =\x88\xeeSr^W\xb6\x14\xa5Y%\x183\x8dj\xa3vd\xa5\xa8\x1dY]\u050e\xac\x1c\xb5\u00c8\x86\xc0\x8d\xe8\xdb\xe2\xdcq\xf1\x17\x83,\xaf\x84\xf3N\xd3C\xeb?\u71d1\xf4S\xd6\x11\u03a6x\x1d\xa9\xc5\t\xc2(\xfa\xc1u\x8d\xeb\xa7\x1aS\x8d\x93\x94\xf5\x95H\xa2b\xc8\xf2NY\x8c\xfc!\xb7\xa3\"\xfe|\x8cj\x14\x82\x1f\xf8\xad\x97\xb2\x11\x8dQ\x15\xc1`\x13~\xd4\xc9f\xea3\u065e\xcd\x01\xa8\xdd\x0e\x8dhFG\xbb\xb8\x02\xca\u03e8\x001\xfc!\x00\xc1WA\t^\x94\x9b\xe8b\x13\xd0\x00VxAK\u01c0\xbd8l\xe0}\xb9\x81\xb1n\xc0\xac\xf7\xfe\xb0\xdeK\xb5\x16\xdf\xe7vy\t\xbe)\xb5\xd8\xe9\u0576\xf8M\xa9E\xa5\xbe\x0e\x1bR\xd5*4\"[\x14\xb1\xad\x95\xfbc\xb91\u03fd\x94\xb9NYi\u7d57\xb2q9\xe9\x17(Vq\x93\xf2\n\xd2z\xe5\xb47\x906\xa0II\x8e#\xb2\xdez)\xebtTZ\xd5\xfa\xba\xebHm\n\xf0^\xab\xc6\x0f,w\xf5\u06b23X\x93\xb4vI8\x15\xde\x16\x00\xef\xeb\xb514\x94\xffp\x80\x8f\xc5\n\x0f)\xa7\t\\\xa5\xb2Rd\x9ah~\x7f\xa4R\xa7\xabk\x99cxIy]+\xff\xaa)$\xb3\x8d\x01\x15\x8e\a\x8e\xf5\xfd\x97j-\x9c|\x8f\x96#F'<\x9a\x16?\xbd\xd9\\bu\xb3Q3t\xda}I]\u07ab\xbaEQ\u046aU{8\xc0`\xb3\x15\x93D\xc1\xae\xc7\xdb\u513d\xb9\xcc\xff\xa2G\xad\xe9\ry\u0157:RDHI\xb2\x8e)\x85:\xa1\xa8%yJ&\xe5\xa0Fg\\\xd1\a\xaa\xe0\xa5\xf22\xf6\xb6\x1e\x86\n\xc4-J)\x83\x02I\f\x1dZ\x18\x96\x92\v#C\x91^\b\xc0\u066f\x19X\xa0\x8b\u0532\x1c\x1c\xb2N]\x95W\x12\xb1\xb3\xd2:\x18IU\xf9<+\x1a.\x953%\xfd\x98P\xd1\x060W\xe2\xa5I\xb9\x94\x84\xfa\xe6\xe0e\xcf\xef0\x80\u0205\x7f\xcd\x13\xed\xb6\xfd[?\xf3+\x84\u039f+\xf4\x7f\xf7\xccO\xaeQF\x90\xca\xe7t\xb3\xa9\x95qu\u05ac;\x9f\x9a\x1f\"\x9a\x8b\fa\xa2\xf0\x93wI?r\xe1\\\x13\x18t\xf0\u05f3\xacVB5\xbcsZ\x86\xe7\xac\n\xac\xe6K\x85\x87\xe5\xa0\x1b1\u04ee\xa8\xa9/(\uc52ds\x1b\xdeW\x80L\x01\x88=xo\xfe+7\x9c\x83)G\xb8)\x111C]\xa5Pu\xa0\xf4\xa1\x98[9\x8b\f\xe5\u02bc\xa4\\\x99\xb3\xbc\xe2\xa3\b<\xe1(\xa9p\xe1\x94\xf1.\x86\x8a@\x0e\x92\xfbr\x9d\x8a\x7f\xde\x1f%i\xf2#O\xae\xb9\xf0\xe2\xf5\xbc\xdc\xea~\x0f\r\xd4)*\xe6dR\x9d\x03\xf8\x1f9|S\x87\xd2\xe1\xf0D\x8c,D\xafr\x17\xb1\x1dQP\xdf-4\"#\x96+\x8b\x9fU\\!G35\xdbiV\x1cJ/\xab\x1e\u0688\xda\x19\xe8/\xfb4%\xe4\u0405=\xdf\xebWQRH\x8a$\xac\u0238\x9c\xea\u8c84\x80\xe2s\f\x9c\x89en\xc74\xa5>\xa1\x86ra\x16\x16t\xb8pp&\xd5y\x04\x82\x85@D\xda\xed\xb0r!Y\xecD\xd5\xea+\n5\xab\xc3t\x9aV\xe72\xa3\xeae\xe3\xde`\x7f$wpj'\xc6o\xe6\xc8W\xe9Y6\x8d<\x93\x9b\x99\xc8.e\xd7\x14;4\xad\xd9eFQ#\x0e\u02e7W\x8cF\xab\xa5*\a/A\x1c\xbd\xc6 1\xe07\xfd \xad\x8c1d\xbcD\x94p\xa0r\xb7\xba\x13T\xd3'\x0eB\x93\x9c-\xf0\x03\xfe\x94\xdb]\xaa\r$h\a)\xe2\xa1'\xbc\x12t\xc1:<*n\x82\xd2}\x16\xd1\x10#E\xf2\x19\x8f\xe7L\x97\xa2\xaf\x12\xf4\x0e\xc8\v\xe7c\xdc\x10dyh#Y\x04\xe8/\xc5\u75f8\xc1\xce\x18D\xe9'\xed\xcbk\x9e\xfd\x91'i\x10G\x93\x98\xc5v\u05be\x14\xb1\x95\x94)x\xf49\x8c9\xfdl\t6\xcbh<\xf7>[Nq\x82cp]\x13\xa4\xed\xcb@\x1e{\x90(0\x83\xc4\xf7\xc3\u0493\x92\v<\xc5\r\xd1\u0778\xf2\xedV\v\u0623R\xc2ng5\x10\u04dc\xa2{\xd6\xd3m\x1cD\u0669\xb2\xa4hX\xba\xf0\x1fE\x94\xdfb,AX<7T\xb8\xa3ZW\x8b\x002\xf29\x120\xbf<\xb9#\x9c\xbbX\xbd^\xe2Il\x86c\x90A\x14B; \x13qy\xdb\x1c=0\xdb\x19\r(\x87\x05TZ\x95G\x06S\x89i\x05\xf6>\xe0\xf7\x91\xc1\x03=c|:\xae\x82WE\x9e\xef\x991\xe4\x85\xc73\xb1\t\x99\xb1\xa4'\x19\xb0\u2300\xc9K?\xf3Oq0I\x1cg\x160\x7fN2\xa29\xa2\x93H\xaanb\xab\x13Rv\xa3\x17imJ8vx\xb4\x1d\x9aM\xef\xe5I\xf3N\x9c\xbd\n\x93\xbd\x17^\xc2\x0e\x96\x99\x1e_\xe3\xfc\xa15\u038b5\xbe\xc8K\x91\xc2\xcc%\u05d4|\x03k\x15 \x92\x86&\x0f\xa3\xf38\x12\xa2c\x92\x10\x1dIB\xa0\xa7\xc8\xd0\xce\x0e\xc2\xd49N\xa1^\xfe\xe7\x02#\u0448\xec\xff\x90V\x02\xb3(l(_\x12\x86\xb0\x96\x13\xd4\b-1\bpOM\xee\xc6n\xb7\x89\xf5\xdd\xf12\x00\x7f\x91:\xa2,pv\xc1m\xb3\"\a^\xa2\xb9ZV\"\n\xfe9\x00\x94\xf6\x87\x03\xdb\xc1o\xfd\x8a\xc7c\f\x05\x98\xa9P\x80\x9f\xe2\x03\x87\xc8o}\xbb\x84/+\u05b2\x15\xc3\xc2\x03'w2\u038a\"\x96\xa0\x9f\xf6\xa5\"R\xb6\xb1\xfd\xe7\x98\xc6\xedK9Sq\xee\xab\t,\xa9\x986\x16kYu\x1fe\b-\xa3\xa6t\x02f\xf0q\xc8\xf4_\x02\xb9=\x9e}\x18\x9f\xb4`\x8ff\xccW\xdeF!\u0603d\x8e\x88#8\xa9\xa9X\x1a \xc6\x19NXi\x10\xa2{Z\x8d\xfd\v\xfb\x99\xa8Q\u01ea\u02c9\x11\xa3\xd3T\xa3\x8ek\xdce\u0671\x19%\x13\xad\x17e\xa0L\xf1\xfb\x80\xc9%FoN:\x91*\x7f\a\x01\xb1\x0f\u0761e\x9as\xf9\xabZHu\x1c\xf5\x82\xed_\x06\xc2~\x0fL\xcf\xf4T\x05\x11\x87\xa0\xaa\xfd>\xeb\xc6L\xd8\xed\x88\xd5S!NL\xf5\xf3\x8a\a\xee\xec0A)\xa0\x13\xca+\a\x8a\xc6\xec:\xb63\xba.\x8e\x15\r|\xf4{Nc\x96\x06\xb6h\x81&\x80f Q7\xe6\xd2\x03\x96H\xaci\xaez)\xbe\xda\x13\x85s\xd0\x1dg\xc12A\u03cdhbW\r\x96\xa1\xe6q`,y\x17\x83\xb0E\xde|'\xce\x04\x0f\xaaXStp[\xd7\x0e\fO\x9c[\x03\xf7\u0254\xa9\xfc+\x9e\xe7H\x00M\xc4-\x98\xd4\u0270\x84Id\xcdLA\xcd\fV1\xa1\xa8\xfb\x11\u02d1'\xec\xce/\x11Yx\xca\u02cf\x1f\x96\xd1O\xa2*z\x92\xc8\xd8\xef\x8cM8\x01;\xb0H\x87\xfb\x81\xc5r\xe87\xba\x80\xd8!\x1d\x1bRA\xa7<\x80\x03\x0fN\x8a\xc1V\x99pO!\u02ff'@\xd9>\x8b\xe1\xee\v2b\xc7\xedK\xf9\xb2 \x8a\x8d\x14S\x9f\x89\xa1\x9b\xbey\xea\x89\x10\x8d\xba\x90I:u=\xa7\xb81D%$\xc45\f1\xc6~QU\\\xf4\xc3\xc6\xc1\xa9\x96N\xea\xa9c\xd9\xd9\xdb1\f\xfe_b;\x86\x1d\xf2\xb5S\x93\x8c\xfd\xc9XO\xae\xfdZ\x14Fw\x19\xfb\xe1\xe1\x12P\u4ec7\x8b\x80\x97\xf6\u07cc\"0\x0eq\xb49\xa1\x89Q\xbe\xc2\xca\xeb:\x03\x1a\x9b\x9c\xbbFV\xf1\x1a\xa4qD\\\u0759?\t\u0714\xd0\n\x18\xe0\xb3.\x86\x906\t\xba\xe1%\x95p:\xc7[\xfc\xe1\xcbZ\xc4+J\\\xc1I\xd9k\xab\xb6\xa6\xd3\xea0\xc9A0\u070e\xf0f\x91\x1c\x02\xdc\xf1\x8b`\x1a\xb7\u0350lt\xe1\x8b\xe7\xdbR\x9c0I\xa6\xd0\xc3x\xf7\xaa\x1a@TL\x80`\xa0\xc6\u59c9\x0e\xdb\x17\xbd\x1e\xfa\xf0\x84;\xe8'\x9f\xfd\u1070Q\xa6\";\xfd\xcdG\xa7\u073f\x02\x1aCp\xd4pns\x16\x7f\xee\xf5\"\\\x85\v?\xb4\xbc\xe4\x87\xd6\xf6\x19\x9f\xc5\xf0\u063a\x8d\x93\x8f/ \xda\xcau\xc2\xd3T\xbe\xbb\xde$\xc1\xc6O\xee\xa0\f\xe8\x8a|/\xdfA\xbeP%\x8c\xd8k_\xfa\xf8D\xa0\u0501\xad\xa3I4!J\xb2t\xdaU>\xf0w\xa0\xeb\\\n3\x82\x88\xea\x1b\x05&\x91\xa4\xbd4\x8a\xaa\x00\xb1v\x95\x85\xf4\x98\x06\x8af\xf3\a_\x01M= \xfa\xc7\xdd\x05\xe3\xe0S\x16\x17r\x91?%\b\x96S!#\xf2\x8a@u\x98\xe1\x97\xc2\v\xba\x03B\xff\x18\b\xa0\x91\xd3H\x8d\x85Pj\xe1e\x06\x8c\x1ep],fMjU\xc2(\x7f\x86\xa0\xa4\ap\xaf\rP\u0566</\xed\x95g\xaezi\xb8\xee#N\xf6w_v\xb2\x95\xbbg\xb5\xd5\u0561VF0\xd2\xee\xd5\x1e\xaaV\xec\xd8\xc1\f\x1c\x8f{\xf7I\x95p>\x9c\xc6a\xa8\x14\xb8\x9b\n\xda;?\xa0\xbd\x83\x95-\xc8\xef\xbcJ~W\x12\x98\xaf\xa80\x8c\xac\x0f\xf6A\u062eP=\xf0\xd9\"\xb1s\ua4e9\xe39\xbbzm\x92\u02c5\x1f.\xf2\xd0\xcf\xf8\xf3\xb5\x0f\xac\xe6o\x82,\x9d\x1eI\xc7\xc6<m<\u0695fN\xc5\"1f\xc0x\xb3yb<\x94\xeeM\u0525Y\x88Z#H\xa1\xcf\\\x9dJ\x82\xcaNJG\x81\x18\xfaR\xca[FI\xd4j<\xe2Br\x9f\xb2\\n\x95z[/XX\x92\xb0\xaa\xf6\x16B\xe7h\xa1\x99\x94\xa8\xcf\x024\xf2\xa2\x1d_\xa5<\xb9\x11So\xfa\x84\xdc\x03\xc7*\x97\xee\xd8\ud14a~\xd9<U\x010\xd1\xf3BN\x17\x046JZ\x94G\xfa\xe1\xb20\xad\x1f`z\v]\x86P\xdf\u05f3\x05H\vu\x962\xc1f\v\xe1\x1cE,\x19\x88\xf4\x8a\xc1\b\u07b1\x11\x88\x10P\x8aZ\x81baR\xa2\x95>\xf2B\x17+e\xb9Z\x8cT,\x06\x9a\x83\xc3\"\x1a\xeeR\x8aV\x10\u02b4\xc5\xe8\xbd\u07a3\xb4\xf0\xa1\x92\xcbJ\xa9N\xdc\xe7,\xdd\xcb\x03\x16\x97\xcf\xd4\x114\xac\x9d\xb5\xaa\xa7)M\x00\xa2\xcbg\x8b\x14M\xa9\aC\xc2\xe0\x0eJ\x81\x11\xec\x17\x9e@\u032d$\xa5\a\xc4q\f\xa5\xb1\xa2\x8f\x14\x9a\x1aFe\x04\xf479+*\x8a\x89B\xbe$\xa0\x94\x8fG\xd9\xd2O\n\xc5\u0534dV\x18~\x1e9~\x06'~-)\xad\x1e?\xf8\xa8\x99\xda\x1cTQ\xf1AC<t\u02a5\x96\xf9V\x10/1Q\x0f\x05$\xfb+\xcf\x04^\u0328\xd8\u03b4t\xf5\x1c\xbec\x8b\xd7kEZ\xd1\x1f\xc8\xc7(\xd9\xd38,Th\u40eeD\xc9\va\xd0\x01\x0f\xb5\xe0BW\xdb\xc8EX\xaa\x1a\x06\xd8a#\x19\xab\\\x8c\x13\x1e*\x9bf\xfcS\n\xa5/X\xd7B_wO\xb3\xac4NW\xabI6\x9b6\xba\f\xeeQ\x10U\x11\x8a\xcc\xfe\x1eT\x89>We0t\x9d\xd1hP\xaa\xa9\u04a0\x81$\xab\xber\x8a6\x94\xb1\xb6\xd0@c\xe8Xz\x82\xad\xa22(\xb6\x15Ax\xe38\xab\xb0L\xd5q\xb5\u025e>\xe7\a,6\xc5H(\x87\x02\x004\"\x82\xf5\u040cE\x18\u0500\x161\x0f\xa2R\xcc\x03\xe9\x90*b\"\x14pa\xa6\x12\x95\x02\x01I\"1\x02\aS\u025d\xb0D\x8a\x93gah\x8bNg\xd0\t\xb3Z\xff\xfc\xee\xf5\xab\xb6\xd0h\tVw\xb6e\xb52\xd2z2\x9f!\xea\x94c\x98?\x81q9\x93\xacP\x16\u0394\xcc a\xd1,C\u03fa\x898YI{\x15'\x1b\\H\xf8\xa1$\v>\x9c\x1c(v\x12W\x9e\x0fc\xe0Q\xfe\xc5N\b\x8d8\x1e\xd3\xfd~\x7f,&B\xf1\\+\xc7D\x90\xe48,\x9e\bf\xd0l\x06P\xfa\xe4$*\x02\rd\x10Y`\xbf\xa7o8\xbb\xcd\xe9[~\x10\x98\xba\xe0\xd4K\x15\xef\x9e\xe9D\x0e\x8c\xf5G\x94\x1b\xb6\xfa\xaa\u04a1o9\xbf\xea[\x8e\xfe\xc8\xcdC\x84\u0692c\xe1u\xda<\x16\xa6\x05\xb8:jq>Q\xce\xd4y\x8d\xbf|8\xd6\x15\xaf\xc3\x1d`b\x99\xd2o\xc3'\x1f\x1c7\x18\xd3\xde&\x14t\xe6\u025e~\xcb+0\\r\x90\xd2\xf9rGz\x82~\v\xd9\xfd\xc5\r\x8f\xb2\u051b%1\x8dc\xea\xc7\xf4=\xa7\xaf8\xfd1\xa7\xf7\x92\x00\xf2N\xdc\xfd|O\u00d0\u076f\x82h\x89\x86\"\xdf\xdc\xfd\x10\xa7\xd9\v\x19\xb2\u024bbz\x95G\xcb\x10\xa5\a\x9eCo\xc4\xf3\u0233\xdca\xdbiw,*\x10\x1dO\xde\xf8\x8b\x8f\xfe5\x7f\xe5o\xb8g\t\xa9\xcd2\xdeX{\xba\b\u067d\xd1D\x18\xb6\x8b/\xdd\\\x18\xb6\xe5\xcf\xda\x06\u00f0]\x93\xac\x8b>\x8f\xa3Upm\x96\x12)4\xbe\xe1I\x12,\xf9\x0fq\xfc\xf1]\xc1\xbf:H\xfe\x96\x87<\xe3o\xfcl}\xa4\xc0[\x0e'\xf6\xb0\x80\xf1\x94*%\x1dk\x103\xab\x8d\x81!\x9et\xcb$\xd4I\x12\x99\xbeX\xf3e\x1e\u0290\xb7\"mQ\r_\U00016bfc\xa3\xa1\r`W\xcd\xed\xfc\xe6\x0e\xf7\xd83\xe1\xb7\xfcD\xb39\xfb3\xb7A\xaf\x18\x12\xcc0^{z\fF\u00b0}$k\xb7;\xb4\x15\xc1k\xe7`d\xe9w1L%\xe1\xe9\xba<\xf9\xfa\xc48\xce\xf4\xda\xc9\x12\xa5\xa5\xbb\xe6j5\u0104\xb1S\x14\xe8\xe5\u0452\xaf\x82\x88/\x8b\xa8\u95d7o/\x9e=\x7f\x7f\xf9\xed\xc5\x1f\u07ff~\xfd\xf2\xdd\xe5\xf7/_\x7f\xf3\xec\xe5\xe5\x0f\xaf_\xff\xe1\xf2R\x9c\xc8m\xc8\x1e.\x86\xd8u\x1b\xb6\x83\xf4\xdb \x05\xfan\xd9ln\xc3v\x9ao\xb7q\x92\xa58\fT~\x7f\x173(\x16\x01\x8b\xd8^\x84\x84~\x84\x04\xa9\xfa~\x03\xba\xef\xfb\xac}y\xf9\xee\xe2\xf9\u06cb\xf7\x97/^\xbd\xbfx\xfb\xea\xd9\xcbw\x97\u07fe\xbe|\xf5\xfa\xfd\xe5O\xef.._\xbf\xbd\xfc\xcb\xeb\x9f.\xff\xf4\xe2\xe5\xcb\xcbo..\xbf{\xf1\xf6\xe2[\x96\x03\xd1$\x8cD\xde\xc4I\xe6\x87,\x85\x14X\xe8o_\xff\x88\\\xd5\u028d+\xb6\x9cW\xbd\x8f\xb8%1\xa3\xca\xe5\u06a3u\x85/\vU4\xdb:;\u02b0\x94G\xb3\xaa\xb22\x1a\x91\xaa\nHg0\xa2\xaf\x91\x83\x0eze)\x00#)\xc2.\x94\xe04\xab\x85S\xa0\x1d\xf3t\xfd\xee.Z\x1c\u00ed\x85\u03d6H{\x98\x81\xe6\f\xaf\xa8\xc0#%%W1\xa5\xdb\u01f8w\xd0\xe3\x14\xa2u\xe8[)wU\x89\x8fG\x89\t\x03M\xb4e@\xacF@\x9d\x1d\u0492_\u06e0+\x1b\x94\x84\xa5V\xed~\x96\u0541\xc7\tJ\xf4+\x8d\xf7\x8a\xb6ONx\x9d\x14\x1b,\xb0\xcb\xf2X5\x069\x90\x13\xb7D\x8c\xd66\"o\xdc\x12\x9d\n\x82iG\x8e_>\xa1\xae\x10\xcf\xc9X\xe2)\x10\x16Ff\xe9,\xd4\u0489B\x06L\xff\x06\xe1oy8b\xa3\xde\xe5WY\xc2\xf9\x8b(\x8b\xebE\xfbB\x1fL\xaepT\xbf}\xc5\xf1\xdc\xed\xf4\xe9:8{US\xafQi\xe3EW'.h\xfe\xd3L]\xb0L]\xdd{:\uc3bb}O\xc2\x13;\xbf\xb7rx\xc6gI\xb0\u022c\xc9I\xa1\xc5&h\xa3/G\x9d\xb5\u00ae\x87\xab\xb4\x17k\xbe\xf8\xf8\xed\xf3\v\u0115\x8f,k\x9b\xa6C\x8b8J\u34379.\n\xa8\a\xa0\xe5\x0f\xff\x84x\x98E\xf6\xa0\xd7\x03\x7fS\xb4\xd3\x1dw\x9dc\xb3G\xea\x1aX\xe8v\xaf\xdb\x1dw\xc1\x90\xdf:;\xf3\xa38\xba\xdb\xc4yz~n\xd1\xc0\xa4)\x83\xe8\xc6O\x02?\xca\xec\x13\x97Z/6\x92/\r\xf7=\xba\xefm\xc0\xec\x1b8`\U0001ee08\x97\xbc\x11\xa4\xd8\xddv\u02d7\xe0oq\x9b\xc4\xcb\x1c\xdb\x03\u01c9\x93\x00-0~\u0341\xb4d\x81\x94\xb6\x1d^\xa7\xc1\xbe\b\xddc8\xbaRhW\x81\u00f3$\xf1\xef\xdaA\x8a\x7fAdd\xf9\xf0\xcb\xf2xC\x85\xe7\x8cW\x8d\xb7\xfc\xfa\xe2\xd3v\xaa\x04\x98\xe5\u0338\xfd\"\xe3\t\xccjZL\xb0m\xb5x;\x8b\x05\xff\xdd&\xedt\x1b\x06\x99m5,2s\u67a1\xa6\x8b\xdaA\xfa+\xb3\x951@@\xd3\u0087N\u03aa'\x91\x86L\f9?\x1fL\xf3\xd3\x01hf-\xd8`\xb28\xcb'\x8bV\x8b\x84\xb3\xc5\xe9`n\x9c\xd2\x05>\x87R\x96\xeev\xb0\x7f\xf1n\xa7\xf8\x91`Z\xa9q=\xc4\x1c\r\xef\xe4\xc5Egz,sxE/|\xf4\x15*\xa3\x90\x04j\r\xb3i\xc4\xd5a\xb3\xd4\xd64\xac\u05b6e5\xfej\xb5\u0496\xf5Wt\xbc\x19\xc5Y\xe1|\x13v\xf6\xafV+nY\x7fm[\xca)\xa9\nil\\('\xae>\xba\x91\xb9\xf3\xa52\xa0\x96V,\u9884\xcc\"f\xa9\xfd\x81M\xa1e\xe6\x83,T\xeca;H\xd5O4\xb3\xc8\xe0\xa8\xd0\xd0|\xdb\b\x0e\x12\r\x94\xc2\x13\x97o\u0353\xc4V\xbe\xd8C\x96\x83\xa3TC\aH.\u03cb\xe8\xc6\x0f\x03X\x1d_\xacN\x00\xab\x13\xaf\xc49\xf8\xab\xd5\n\xe1\x1b\xa8\xa3\x10\x16)\x8b\xd5\"\xd1\x06\xff\xb4\u52cc/!%\x12\u02f67iG\xb91\x11M\xf6v\xc2\xee\xc3 \xcd^\xaf\xbc\x94n\xfc-\xfe\x8d\x93%O\xf8\xf2G\xf9\x99\xf2\xccL~'?\xd3\xcc_|\xc4_\x81\\\x06\xfcH\xf8\"N\x96\xa2\xc4\xda\xdfr/U\xfa\xbe\xa9\xe8\xe2y\xf1Utd$\xc2p\xbc\x00Jz\x81Q\xc2\v` E\xd2;\xfc\xc0A`\u05af^ \xfb\xf6\x02=\"/\u0613v\xa07\f\fl\xf8\x92-`u\xf1\xa7E\xcb\xfb)R\tM\x8aJ\x1f\xf9\x9d\xa8\xf2\a~wP\x01\xd3L\xe4\x98\xec\xe9h\xd4\xed\x8f\x11+\xd6\xe2\u0108\xd5\xf9\x86\xb8\xdb\\\xc5a\xb3)\xfe\xc23\x99&,\x9a\x16\x9f\xb6x\x11*\xe6\xa0E\xbc\x81\xe3:]\x1a\u05d6\xda\xe2\x95-\v\r\xa8_[h\x95\xf8\xd7F[C\x1a\xd4\x16\x13c\xbf\x04\x833YrD\xd3\xfa^\x93x\x15\x84<\x11\xc5\xdc\x1e\u034f\x15\x03O\xb8\xb2\x983\xa6am1\u027a\x97\x8d9tQ[\xcaO\uf88518\u05e5\xdbc\xcd\u0257_\xb9\xf4\xaa~m\xe2\xe4\xd6O\x96\x97\t_\u0252\x1d\xba\xae_\x1e\xf9\xee\x94\u017at\xf9`\xb1K\x80nQ\xb6\x03.\xd1\xea\u0282XI6\u05e77\xb5EB\xff\xb7;Yd@\xafk\x8b\\\x85\xf1\xe2\xa3\xec\u0265w\xf5\xd3\u0323\xa5\x8f\x8a\xf7\x12X\xdc!\xbd\xaa-\x99\xf0t\x1bGz\xd7\xdc\x11\xbd\xad\x9f\xe7\"\u07aa\xb5\x18\x17\xf7\xea\x85R\xc4x\xc8\xd6I\x87mTJ\x17\x932\xbb\xb1\x91h\xf34i\x82(\xd3\x17\x82\u06fc\x15\x7f|\xf1'\x15\x7f\x02\xf1g\xed\xe9W\x982\xbd*\xdaj6\x8b>e\x93\xa1\xa8\xb6\x12\x7fn\u011f\x8d\xf8\x93\x1f\xb6%\x13\xd0\x7fK\xca\x1b\xb1\x91`\xc4e0\xae\x11X\x10\xc6\xd8v\x9f\xb5\x9f\x01\b\xff\b\u03c7\x05\xcd\xda\xcf5\x9cb\xd2V$I\xd7Ki\xbe\xe1\t\v\x8b\xb47\xf2,1\xa0\u06e5\x12+ZS}'\x00\xf8-_\xb1\x15|\u02b3\xce|\x9a\xb5_\xfa\xbf\u0771\x1b\x9a\xb5\xc1\xee\x80mh\u0596$~\f?\xe5!f)\xcd\xda\xef\xf0\xec\xe3@\x02\xf8\x94@\xcc\xd64\x03JH\x0f\xbc\u6784\xc9\xeevr\x9a\x8b=V\xa8\xcc\xed\x93J,\u036e\xa6-\xd9J\xb87+\xe8\xa9\x1f\xaf\x90\x8b\nj]\x0e\v>\x04\x8e&P\x80\x1cF\xb4e\xae\xeb\xd1~W\xb2\xacZ\xf4\xe3%}Q\x12w\xe4x\xa9\x1bQ\n\xb7\xebx\xa9\x8d(u\xf0\\\xab\x96\x8be9\xb5\xd1\xc7K\xa6\xa2\xa4\x01\x05\xc7\xcb\x06\xb2\xac\x02\x91\xe3%\u05e2\xe4\x1f\x81\xba\xb90d]5\xdbs`\xaaZ\xeb\u0541\xefv\x1c\xd6R\xfc\u064a?\xa9\xf8\x13\x88?k\xf1g\xb9\xdb=\xbc\xe5vi\xcfov\xbb\xd2\xf7\xa6\xf2\x9dW\xbe\xc3\xca\xf7\xaa\xf2}W\xf9\xbe\xaa|\xdfV\xbe\xaf\xf1\xd5\t\x9f\xafW\xecbO\x87\x9d\xf1\xb0{\xec\xbde>\u0410\x04!P\xa1\xe7\x8c\x1e|\xa0\xb1\xc8\xee\x80\xd8\x1ft2\x05E\xe1\xe3\xdf\xc1\xc4@\x1a\x82<(\xa1\x03A\a\x18\xe8\x02o\xfc\x890\x03\x10\xd7z\xca\xc4\u015d\xe3\xdf\xce\xc4\xc0\x1fxSN\x04\xf9+\xae\xb9\x05\xfe\x1d\x1c\xb1\x188$\x8f$O\x91\x15)\x93\x98m\x0f\xa8$\xea\x17\x89\x8a(2\xd1\xe1\xf6\x90\x18*\xcfs[K\x06\x99\x13\xdf\x1e\x92?4(\xa5Jj\x87\xa6l{@\xdc\xd0\xdc\x18\x83It\x98\xe8v{Hl\u0410m\xcb\xf4\x02]\xb0m\x99<\xc07\xd2\xea\x11\xe4&\u04baY\x9c\x14\xf75\xf2\x01\u051b2c\xd6:\u02f6\xa9\xf7\xf4)6\xffK\u068e\x93\xeb\xa7\xcbx\x91>E\x1e\xc1\xe9\x92\xc3s<i\xaf\xb3M8\xd5\x0fy\x06\x8f\xa8\x88\xb9\x93\xe8\x80=4\x89Z-\x92\xb5\x98\xd5\xf4\x93\xebt6\x87\xa2\x11\xb4\xf1\xd3\xdb\x17\x9a\xa9f\x17o\xd2h\xae\x9eH\u058fA$\x9e\x85\"\xc6\x03\x0e\xa0\xf1\x0fV\x8b\xb7\xacI\xe3&H\x83\xaca\xb5\xb2\x96\x05j\x10\x18\x81a\x95\x87ac\xc3\xd3\u053f\xe6\x10j\x01\xce\x00\xa4Gqt\xbaQ\x8d-\xf9M\x83G7A\x12G\xd0#V\u018a\xd8~\xda\xf0\xa3e\xc3_.1|\xae\x1f6\xd6<\u072e\xf2\xb0q\xeb'Q\x10]\xa7mk/\"\xa2\xdc\a\xe9\x8fq\x1ee|\xe9\x1dp\x1aN\xdc=\xe5\x11F\xcc\xf9.N\x16J*a\x94\xd3\xf9o9\x06\x84x\x97\x1d+\xf0\x8eg\x87\x99{\xbaa\xf7\x067\xe3\xa6d\u05fb\x15\x8e\x12h\u02646\xd3\xde>R\xb6\x11\xbf\x95Bq\xb4\xdb-\x8d\x18\x8e\u0401\xfe\xba\xfb\xdb[\xbe1\xe4\xf4UO\x19\xec~O\xcd\xfcT\xce\xf6\u043eG\xa1\xf3\x13\x03\x9d\x17\x00\x7fr\x80\xe4\xcb1\xd7\xd76F\xe85\a\u05ee,\xb0\r\x99\xc8\xfc\xb5\xd4(,R\x1e\u076a\xd8\xcc\xd2%V\u05ec\xb1\xf1\xaae\u02e8\x0fM_\x17M3\xa3\x1b\x19\xd7\xe5\xce\xc8\x05n\xc1\xf5\xe4\n\x96<\u0352|\x91\xc5\t\xbb\xa3\xe0-\u04e8G\xe8\x15\xdc\xf6y\xc2+k|\xe2\xc8\xf0,Z\x98)\x04K\x17L\xca\x0e\x8a\x19\xae\xfd\xf4\xf5-(xny\x92\xdd\xd1O\xec\x1el|O\x1c\x9a\xf0\x15\xfc\xb9\xbcLy\xa8~!#\v\xec\x96\n@\xbc,\x99\xe8P\x1f\xf6W\x86~\x92\xfaHZr,\f\xa70|\x8a\xe6 g\x00E\xe8\xc7\x02\x7f\x11j\xe4|\xe4w\u0366\x1d0\x90\xfc\xc3\a\xa1\x19\xb9\x10\x86M\x19\x05c\u0493O\x95\xe1\u06d0j\xfb\xb3d\xce2`hM\xea\xf9f\xa7\x1d%\xca\u0249_\xe8\xc2E\u0691\x86{\x96\x178R3\xd9\x00\x0f;&s\xad\xc4Zku\xe6\x13\xa31\f\xac\x8b\x94\xa6|H\b\xed#\xbd\x029\xab\xe4hF6\f_\xcd\"\xc7YT\xed\xb0c\n?<\x8e\xe6\xd8\x01\xeeTJ\xf1\xa4z>\xbdD\x17\xb1\u07adR\xe43^)\uff8a<\x8e\xf7\xc2\x1f\xe6\xd3\x0fO[O\xaf\x8b\x9d\x7ffr\xd8\x1enO\xfcB\x86\xcb\xd4<F\xe2\n\xba\xb7\x98\xe5Y\u0331\xa8\xe5\xc1\x8f\x8e\xb5W7\xc2\uf003\x9a\b\x8ci?\x9d1o\xfe\xf4\x9a\xda5\x04h6\xe3s\x90\x83\x80\x9a\b\xf6C\xbc\fX\xafHe\xda\u0741a\xdc\xf6\\+Uh\xe6\x9df\b\x97\xd8\xf8\xe0\xf7\u05fa\x8a\xe3\x90\xfb\x11~\xeev\xb6\xf4\xda&\x01\xeb\xc45\"\x15p\x92+\u04f2\x86|\x80\xa6R\x99F\x12\xbb\xf8\u01b4\xa2|s\xc5\x13\xcb\xc3\u0486\u0188\xe2+\x1f\xf3\v\x12\xab70T\u0118X\xcae`#`\x81\x9d3t\xbboY\xa8Yf\xb5\xad\xd63;\aO[\t-\xf3\xb7\x03\x02a\x94-Kr~9\x06e,V\xf9#\xb5~\xd7|\na\x95\x9eZ\x84>\xb7\x03\\,\u02ea]v\x0e>\r\xa5\x8eK\xd0l\xda\xef\xec\x80\xe0\x99\xad\x13)\x1d\x02\xb0P\x95\x03(\xce\x10\x8a\x85k\x1c\x01\xc9\\\xdc=\n\x9ey[\xfc\xd8\xef\xed\x80F-\xfb$\x80]\xde\xedr\u0426\x04\xe7Y\x8ca\xca\u0532<\x00\x02\xfc \xf5\xb3jqT)D\xa5\xf5\x00\xe2P\xc2.\xe6\x18\x93\xb7X>/iY\x9eE\xab\xb2\x01R\xa0\x05g\x12\x16z\xf3\xa1R;Z\xb0\xa4\xf5\xcc\x06\x86p8\xa7!\x99\xe4-\xf6\xdcNq\x11\x174\x90V8\xa0\xc1\u028e+\x1a\xf0\xe21S\xdcs\xd2\xe1\xc2\x01\xc9gs\xb6j6\xf9l5\xdf\xed\xf8\xcc\xfa\xa7\x7fR4\x9f5'S\x19\xf3\xdc\xe6\xa4\u0394t!\x8d\xae\x17\xca\\\x94\u00acN`\xf4\xa8\xbcj\x13\xd2^\xc6\x11\x9f\x101\v\xad\xae\x8c\xb3\x11\x13\xa50s\x1a\x18^\x884*`\xa9\x8e-\f\u01d2\xaa\x9b\xb9\xebRk&J5\u01054\xb7\u0436\\Vm\xdc\x06\u067a\x01\xf2\xed\u01bd\xd5*\x8b\xbb\u06ff\xc4Ad[\xb4\x01{\xb9\xb7\xbc\xac\x90\xea\xe5F\\\"C\x0e\\\x91\xe4s\xf9:\x9a\xcd)\x98>\xc8T@\n\t@\xf9\x11@\x17n\v\u0439a\xdcj\xa1\xbc5)\xba\xfbE\xf2\xc0N\x85z\xc0e\x9a\xf9Y\x9e\x16\\\xaf\u02c4\xa7y\x98\x81\t\x03\xca\xdbd\x01\f\x05\"\xf3\u0410\x1e\xe3\xa6\x15\xddg\xa8\x8eU4\x88~\xe82um\x18\xed\xb8f;dO\xe8\x83m\x14\xf5:\x95z\u06b9D1\a-\x13\xd2s\xc0\x1d\xd5\xdfx9\xbc\xa8\x10\x1a\xc5%\xf1\xbe\b\x8d\xa5#c\x99(\xb3L\xb2u;F\xd0)\x11l\xe2\x15\xbb\xaf\xd7\xdf\xf1^P3\xe3\x1bH\x95\xbaN\xf7Y\xe2G)\x12\xf4\x9e\xb3/\x15{-.G\xfa\"}\x17o\xf8[\xa9\x11\xf5l\x91\x81I\xaa\xa9\xfaE\xfd4\r\xae#/\xd9O\xb2\xb6vqs\x0f\xa2\x8b\xd7T\xea\xbayU\xad\x03\x00\xbb\x92.\xa9\x94\xa4!9\xa8i\x05\xd8 \xd05X\xc0k\xc2;\xbc\x0f5P\x1e4\xd7j\x01\xe0e{\x9a\u0148\x8f\xeat\x95\u0295\u02a8z\xb7\x9b\xcd\xf74\x8e\xc2rM\x10[\xbd\xab\xaa3\xacm\xb7\xd75wc\x8f\xbcIEb\x02\x93\x11(\xcf\"\xe5\x8e~\x95j\xce+\x9a\xb5\x17a\x1c\xf1C^\xde\xc1\x11.\x8f\xaf3\x18R.\x05^>KlxB\x89K\x03\x9e\xe9\xc2\xf1R*\u076d\xe5L] &=z\xaf\xd5s\x0eIQ\x9a\xb3\xdb\u00bc\xf5A\xb2\x94K5X\xf1\xb7B\xd6\xe1UQ\x93\x83j\xb0\v\x8c%XP\xb5\x8bZ\xaav!\xa8\xda\u017cp\xa9\x92\xcd\x16sC\x11#\x9c\x029\xeaA\xaa\xe0\r,\x1e z\x17\u01c8\xde\x05\xb9W\xc4\xee\x82hK\x8c-s&\u06f3\xc5d\x8bD\xef\xd6$z\xb7\aD\xef\xe7o\xfa#\xf4j\x8e\xf0%\xd4R\x94\xb3\x99Z\xbd\x94b\t\x10\x1d\nCl\x9b\xb3\xa2\u03d4\xd6\x1a\xc3x\x19-\xd9\xe2x\xbc\xfc\u0741\x84l\x9dp\x7f\x89\xc1\a<\x87*F\xb4\u041bS|lOHZ\xdb*\xd7\xe8:\xa0\xca&\b\\\xe1\xf0\xb6\xaa\xc2x1;\x05\xe7\x97:\xe5;\x1f\xdewe6\xb1@\x05\x97\xa58\xe6Z\xd6.}5\"&\x90m\x94\xd8\u059a\xe2*a\xe5=\u054c\xa8z&w1\x8f\\\xaa\x8fz|\x7f\xc8\xd2e\xef\xa8\xe0C=\xd8\u0102*#^\xef^^&\u07a9K\xe5\xb5\x01\u02c3\u05be\xde/\xd8\u00e6\xcc\x00\xaf#\x1aC\xf5\xea\x01\xff\xb8~\u008b\x18\xa6\xd2]c\x86-\xe5)\x7f\xae<\f\xd5\x02\xd0{\x9b\x98\x850OU|\b\xf0T=Q\u01ac\xf6-\xbf\u02af\x85}W\x89\xa1\x83\x99\xd2a\xc0CM\x8a\"f\x8b/6[\x9e\xf8Yp#\x95^\x0f\x90b\xb9\x81jqYJ6\xf6\u04bf\x8b\xf3\xec\x11\xe30\v\x9a\xa3\xf9\xf1\xd8\u6a0aP\xc0\xac\xf0\x96/\xf3E\x8dV^\xb9\x96,U\x1e\xec\x11\U0004bbb2\xb2\xb9*Z\xe5\x1bU\vc\xbe\u034f\xe8w\r\x86\x9dq\xef1\x1cw\u4d13=\x1d\xf7:#\xd7\xe3u\xbc\xf6\xac\u011b\x8bl.}\x14\xeevv\"\xa2\xd8\x12\xe9\xb5\xe3\xe0\x88S\x835\x1bK\x13\x9d{Mt\x89k\x01\xa8\x87\xd2R&G\xc5(\xd14\xf2T\x99\xbdl\x8e\xecv\xd0\xc4^\x9b(qjg,&\x06\xbbI\xd2\xd8\x02\x95\u0609\xc9b2\x99E&7*\xc3\xeb\x1e\xf3./YB\xe3=\x98Q\xc4Fi\xb4\xe3H\xf0\x0f\x8dK\xad,\x11y\xcd\xf8\x9c\xc5\x05\x11\x9dh\xce\xdf\xc1\x9bK;\xe0R\x0e\xe1\xe5\xfa0\xde\xde\xf8[\xbb\x8e\u0711/\x7f\x8e\xa4zt\u0799Zq\xc4A\xc7\xc6RJL\x19\x05M,\xf5\xc5\xdbi\x18,\xb8\xed\xd0\xe8\xd45_\x19\u0522\xc0Z\x86W\xe3,:u\xe7^\a\x9d\xf7~\xae\xb9\x993\xa7\x96\xa8X\xa4\xb9s\xe2Y\x0fWR\xea<\x0f\x14\xd3S#\xfb\u0236.\u07be\xbd|\xf1\xea\x8f\xcf^\xbe\xf8\xf6\xf2\xf5\x9b\xf7\x97\x7f|\xf6\xf2\xa7\x8b\xd2#\xa68\xafO\u07afy\x03_p\r\xebI+k=\xb1@\xcf.\x90\xeaH\xc0\x18\x8f\u0445\x18\xe4\xf2\xd6\x13\xeb\u025eP\x10\xe0\t\x10\xa6\x95\u079e\xbd\xfd\xfe\xf2\xfd_\xdeT;S\xcc@T\x8f\xa2\xa8N|\x00\xa6pw\xfb\u030a\xe2\xacaQ\x10~\\\xa5Yb\x9f\x04\xbb]p\xe6L\x1d\xaf\x15P_n6\xcaR\xc9\u050e\x99\xb5\xc9\xd3\f\x15\u022e\xb8\x85v\x88\x9a3\xf43\xa4>E\x17\a\x9e*\b\x85\xea\x11P\xa1\x92\r\x01\xe8\xcf\x15X\x11\xc9\t\x91_\x94\xcbq\xc1bG\xa7:0\x12\xba>\xcf\xf66\xa7VC\x91B\x16!)\xb3`u\xf5\x9e\xf1\u049e\u0165\xaf\x04v\x14\x96\xc2\"\xa4\b\f\x9e\x1f\xc1\x97\x8ac\xa4Y\x01\x11\x0e\xd4!\xf4\u010eZj\\\xe64N\x85\xf30T\x8az\xbdB\x03@\x1co\xdb\"Sk+\xc9J\u02f3\xf4\xf0')C\u0630\x9e\x14\xc3\x7fb5\x9e\xe8\x11\u73dc\x8dB2i\x8bY\xed\xc6[\xbe\xe0\xe0\xfbD(\xb6\x15\xe0,\xa7Aj\xc1\xeb\xdd\xfb\xb7\x17\xcf~\xbc|\xf3\u04fb\x1f.\x9f}\xf7\xfe\xe2\xed\xe5\xc5\xeb\xef,\n0\xc4\xfd\x8d\xe0\u0690\x86\xbf\xcax\u0480\x1c]\xf1\u01cb\xf7?\xbc\xfe\x16_\x1b/~|\xf3\xf2\xe2\u01cbW\xef/\xbe\xad}\u03cb\xad\x02\xc1Rc\u00f3u\xbc\x84s\x000\xa4\xfdU\xf2\xa5\xb5'\xbai5\xa6\xb7\x17?>{\xff\xd3\u06cb\xcb\xe7/_\xbf\xbb\xb0\xa8\xf5&\xe1\x1b?\xcb\x13\xdeX\x841J\xef\xca5\xbe\xbdx\xf7\xfe\xed\xeb\xbf\x1c\x1b\xc6s?\x82^\x01\xcd\xcb\xe1\x88y\xf9\r1[T\x99\x941-\xca#\xfa\xf1\xa7\x97\xef_\xbcyyq\xf9\xfc\xd9\u02d7\xdf<{\xfe\a\x8bZ\x8a\xa4\xc1\xf6\xf8\xb2\xa1\xec\xcb\x1aY\xb0\xe1\xe9\xc1\u061e?{\x05k\xf5\xe6\x05\x1ca5\x94m\xb0\xe5\x14\x97\x02\ba\u0405;\xa8\xf7\xa7\xb7/\xde_\xa8\xady\xf5\xadE\xad\xdb$\u0238\x1c:\x8f\x96\a5^\xfd\xf4\xf2\xa5@L\xef,j\xfd\xe8\xdfa\a\xa2\x16\x90n\x02'\xa5\xa0\xe9(\xe6m\xd5\xc0\xc5O\xaf\xfe\xf0\xea\xf5\x9f^]^\xbcz\xfe\xfa\xdb\x17\xaf\xbe\xaf_\u045f\xa2\x8fQ|\x1b5P\xe6\b\x8fzX\u05c7\xe0\xec\xa7W\xef~x\xf1\xdd\xfbb>\x97\x17\x7f\xbcx\xf5\xbe\x00\xb8<J\xd7\xc1*\xd30\u01e3e\x03\xdd\xccZ\x86v`\xfbW\x96\xedi\x7f0\xecw?'\x94\xef\xf6\xdc~\x1f\x84\xf2\x06ck\xb7; \f\u0330-\x11<\x139\x91,\xcb\u00af\\\xb67(\x95\x85|\bG\xf6p\xdc\x1b\xb9\xf0\x06\x06}\xeeNgL&\x91\xdd\xed\x0f\xdd!\xb1\x17\xd4'F\xc4\xd8\xd8\x0e\u033b>g\xce$?\u04d2\xdc\\\xf14C\x96\xce\xf2\xf9dQ\x94\x9d\x85\xf3\xdd\xce.'\xb0\xa0\xf4IJ\u02b8\x82\u02c0\xb4\x8c\xa97\xbd(\xa2&\xf3[,7\xf1\r\xaa\x87\x13\x1a\x94?\xa5\x97\xff0\xbe\xfd\xc1\x0fW\xaf\xb7<\x02\x8bg`b\x9f\b\u0195\x02[x\x1d\xae\x83T\x7f\xb3\x13\x97PY\x06 \xcf,\xa3\xbe\xcd2\xa5>T\xc1J\xc7\u04b1q\x1c-\xb8m\x01\xe0\xd3m)\xc6\xcf\u0596\xa2\xc2K\u0543\xf4\x13\x13-\xf9r\xb7K\x90\xc9\xfa>X|\xb4W\u0612Qu%\xcd'\xa3\xa5M\xf6\x12R\x84h\xa2`\v\x14\xcbM-\xd5\xc1\x0f\xc1\xf5\xfaO~\u0193\x1f\xfd\xe4\xa3E\xefy\x04\xefO\xc8\xf1N\\z\u0373C\xe1u\xa3n\x88k\xb3\x9d\xfd\x9e\xd0/\x18\xc37\xf9j\u0153\xaf\xef\xbc\u066c\x1b\xd15\xcfD\xc36\xf9\xc2\xf1\xbcDp\xfe\x1b\x16C\x9c\x87G\xf6Z\xa0\xeb\xc7uX\xb0\x96\xb0g\x05\xaf\xef*\xce\xc6j\xd7\u026e\xa9\xd3\xd6\x03\xa8_G\x9dM\xf645G\x04\x98\xe7?m,J\x87\xe0\xd8`\x18\x18\xa3\x90=\x1du\x86\x9d\xfe\xe7\x9fe\xb1F\xa7\xc3\xde\xc0\xe9\x13\xf3\x11u\f\xd7\xc4%\\\x03\xe5&I\t\xb9\xec5\x9a\x8ciBJo\x96Kd)\xa3\xfdx\x95H\x8b\x14{f\xbf\xa7\x88y?o6#\xae\x00c:\x1f\xe9\xc7\xf6[s\xe1\u063bI\x04\xfe\x9eGC\xd2F[\xe5\x8bM\x90e<\x918\xbe\xf61\xce\xdba\x90f<\xe2IjgD\x01-^\x03\x9dN\xdf\u909aRd\xf7F\xc3A\x8f\xb4\xc5Q\xa29\x8b\xda\xd7\ud7c2(\x1b\xe1k\xabd\x94*\u0325C\xba`\x91=\xee\r\xdc\x01\x99\x84\x10\xf4x\xd1^\x02\xc7#\x8c\xaf\xa7\xc5O[\u0797\x16\xf1\x0e\x9a\xd8\xd2\x15]\x83\xfa\xb4\xdd\x1fB\xd4w\u040e\xb6\a\xae;\xee\x13\u0402\xb6G\x9d^\x7f\x88\xb1JK\xe8\v\u051fm|v\x93\xf6\xaf\xf4\x8e]\xb7\xeb\x9e\x1f\xf4Jf\xd4\x12\x8e\xf4V\xe6\xd6S\x87\xf4\xa2\\\xf9\b5P\\\xa0\x1fA\xd4%b\xe3n\x84\x19\xd5\xeb\xe4[\x01\xc4\xf4\x92\xcd,L\xb2\xa8%\xc8A\x8d\r,jm\xfd\x1cS\x12\x0e,Ak>1\xe5\xf2\x18B\xe1>a\xc9n\x17\xd9HF\x10\xcaA\x0ex\xbf\xa7\x86\f\xba\xf0\x99h\xc7,3\x81;\x91W\xa3\x90\xa0\xa1\x82\x1b\xd8 \x16\x9f4VG\xd4(R\xf9\xde\xed\xa0\x8e:\xc1\xafu\xbal\xbbt'\xb0\x1b\xad\xe5\xa2*T\xee\x9eXV\xbbBPC\x95\x96\xa5H\x11\xa0\xa9<\xdf\x03\xc9i\xc6\v\xc2od\xc1\xaa\x12\xab0\xbe\xd5>91\x05oP}\x01\xf3h)\xceG\x91\x04c\x82*\xea\x1b\x14\x9a\x81R\xc0\x8f\x88\xf3\xe5\u06c2.\x90\x8d\x88\x16\x0e\xd2\xd5\xec^\xe2\xe12\xdb\x14;\xf9N\xda]\x17}\xe3N/uo\xd0\xf0s\x00\av\"\xdee:A\xe4\xfby\x16K\x10\xc2=3\xbeE\x81\x02I\xaa\x1e\xa4 \xe1B\x12\xb9\x8cWSv;+\xcfV#K\xf6p\xeb\a\u0677\x89\x1fD\xcc)-\u03cfq\u008dFQ\u056f\xb4\u02b2}L\xe2\xfa\x1b\xc2E\xeev\xf6\x16PY\xa7\xdf\xef\x92vJ*m\xf0\xdb\xc6\xd6.j\x90J\x83FNA\xf3|\x94\xa8\xbb|\f\x0e\x11\xf9\xc7\x12\"\x87Z\x92\vW-\x98Lj\xee$\x1c\xdb;\xe9n\x86f\x84V\xc8DAM\x1e1\xd3\xf6\x97\xa5\x8bN\u049b\xb5\xc2u}\xb9\xe9\x1a\xf2\x9b\xe9\x1cR\"o\u027e\xac[\xa3\x1c\x7f\x84\xb6>a\u03d6\xcb\xe7\xeb<\xfahQ\xe9c\xd4GIWy\x82\x86\x805#\x81y\x12\x0et\xecB\u06ca\xa3\x8bx%\x1a\x05f\x1f\x1e,\xb9\xbc\x13t\xae*\xb7T1\xe1t\x82\xa0M'\x18\x8fMY\x06\u06d9<\xed\xea\xa5B\x15{\xa2\xc52\x03\xd1L]OU\"\xfbL\x9dfp^\v\xe7t\xfa\xc2\xe6\u0133\xb3\xc3sZ=\xa4\xe0\x02\xfc\xf0\xe0:\xf4\xbd0\x8c\xb7\xb9\xa9\x98\x10\xefv\xb6_g\xf4>IXF\xd3v\x90J\xfa2!\xbb]b\xc2Q\xbe\xdb\x1d\xb2\xb0\f\xdb\xe3\f\xb4\xbbM4*]\xed\xdf\xd9\xd6Bl\xd7L\u0567\x96\xa2\x8e\xad\xe2\u05b5\xe64\x93\x82\xccD\xdb\v\x82\xa2KF\b\xf5\xc9'\x9bS\xbf\x98GP\xea*k65\v\xc8!\xb5\u0336\u076e\\E\x12\xb1\xd7<{\xa3\b\x1c\xe0\x12\xa1]@A\xf3\xc0\xda\xd6q\xd7\xd3\xf6*\x897\xc0Y\xb5ax\t\t\f\xfc;\x85\xb1\xa2\xa9#\xf1@\xa7\"@[}s\xec\x02\xc0T\xb1\xab\xc2-f`P\xa6:\x14j\t|\x03\x05z\xcd\xe6I4\xb53\xa6\x13\xf0\x05\x87\xf1\x00\xcb3\x15\x12[\xb1:S=\x1e\x97x\xaf\xe071\xc6\xe8J\xcd\x1c\xe0\u0657:\x95%\x95]\xbf\x9c\x00H\x80e\xbbgA\xf9b\xdc\xed\x1cTFR\xe0}\xa8x\x06\x8a\x01\xf2Jk6\x1dV\f\xb1\xd9<\x91'\xc0\xce\xcaH[\\\x1a\xc2!\xbdE#\x82\xc7\xe33'\x8b&S}\x1c\x15\x13#\"\x9eN+\x8e\xa8y\u039aM8}\x04\xe7\x9d\x1d{z~\xfc\xcf{\xf0<\xf8\x8a8|\xb1\u0536\xf1\xb9\xa7\b> \x8d9\xa8<\xb6Q\xbfJ\xb9\x97yT\x14\u0223\xda\"\xaa@\x19\xbd\b\xc3a\xb3 ,z\x1d\x0e\xa2I\xddr(d\x90\x98;\f\x1c\x0f\xef\xe0\x94\u00f4\xed\fPQR%\x06 4Nb^\u079c\xa9C\x8c\xf7\x1f\xb3\x80c\x87>d\x9f\x15J\xd1\xd2#\xc6\u01d2\xd35\x84\xa2:\xac\xa0+Jcl<Q\xa5\xcaA\xfaF\xd0E5\xda\xf3\xac\x16\x18\xd4!)\xb7\x83\xb1\xf3%\x19a\x0e\xe4\x80\"\x91D\x81$E\u0224\x1e(\x04\xbd\x92\u047a\\M\xae<PU\x172\x82,\xd7\x15\x97\x87n\xcd\xfd%\x8d\x99e\xe9h\xac\x13\x12\xc3\x11\x16\x18,i\xc3\tG\xaf\x88\xc8\x1c\x9a\x94\xb8\x12\xb5-.B\xee'6\xa1\x96%|\xa8?P\x14O\xbc\"\xcb+E$M\x1e\xcb\x1fXF\xbc\xde^3\xedA\xb471u\xde\u0337\xe7\x19s\x04\xe2+p\x99\xbc\u05e7\x8eWAP\xfc\x04\x1d\xd0j\x14\xa81tf\xae\x12\xae\x84\xcc\xf1T\x11\xcf\xe6\xe7Y\x19\xdf\"\xd5QJ\xa9\x83O~\xce^O9{\xed\xd9\xfc\xf4\x94\xf2\x1d\xe3\xe7\xe7\xe7\xae\xfa\xd1Q?z\xea\xc7H\x97\x19P\bCC\xf9\x1e\xd1\"?+.\x14\xee\xa9)\x16\u00eb\x12-\x0euL\x8e\xe0\v\x9b\x97\xd4\x02\u0373\x1e\xda\x16 yU\u05ea \xe6C\ua9ca\xba\x8f\x91H5\r\u02f5'\xb4\x96|\x8a\v\xc6\xe4{Z\n\x1e\xf3\xfe\xf1\u3fc4~4\xd2\u0544\xa0\xa61\xcd\\\f\xbdb\u0701\x92Aj\xabk\u03d0E\xd4\f\xd8=\\\b\x03\xbaTs\x05\x9c\x9d\xb1\n\xc0\u041767&\xf9J\"o\U000d5114f\xe9\xd5TZ\xa67\xb4\x12Y\xfb\x8dh\x03\xe3\xf9\x9f\xe8\x9a\xe6h\xf4\x05~\x96U\t\x88#\xe4\x01\x99\x14D\xb8H\x99\b\x1a~\xe3\xdf]ah:\x18\x19\nn\x1a\x0eJ'\xe0'\x04\x92\x8b\xccV\x84\xaf\xd7\xcal\x8c\xc8\xf9o\x1f\xd8\xe3\xac\xe69\\\xf0\x9d\xf0\xd1n\xee\u05b9C\xb3\xea3\x19\xd7@<\x91\v$\x00W\xdaA;H\xec\x90s\a4\xebD#\xb6\xb1\xc2?\u06bc\xf46j\xc0fd\xc1\xe2c\xcd\x02\x14\xb5\xbe\x15\xfb\x82\xf5\xa0E\x8b\xea\x85 \xc5O\xa0\xe7\xd5\xdaeu\xef\xfc\x020\xb1\x11\x82\x10D\xcbP\xa7\xf7\xbcf\x18/\x1fXc\x80\x99\u0436\xa0)\xf3\xa8N\x8c\xd6u\x942l\x98L\u0224h\xfa\x9b\x12b6\xe1G\xa8I\xd9Y\x99\x98\xd0HW\x8a\xb9<\bO\xc5\u03cbZ\xb6\xf1\xe8+P\xb4\u0410\xb0\x88\b\v\xa6R\xab\x88\x1c]8\xdb\x06\u0469D\xc0Z\u029eU\xee1\xe2\x19CZ\b\xfd9\x98\x91~\x87\x82\xa3M<\x05F\x88\xfc\xcf`\xa5hi\xe2\xbe\xe2\xb5BJ_\xe21\xa9\x9e\xa2\xc6\xe1\xfe\x8dfe,\xf8\x9b\xf9\x806Z\a\xb1!7Z\xa4\x852\xc1\x89\x99.\x8e5/\x9e\u037c\xc4\xc2rhf\x8a\xad$\xca\u00de\x10\xa8\r\x06\x11)0B\x99\xb3>\xb1O@\xb9\xc1,\v\x0fu\x15\xd9\x12\x9c\xda(\xf4\v\xf2\x94\"~|\x81\xb7D\xc3`\x15\xa1\x15o\xa2\xb3\x04\xed;\x83\x95\xcdg\xd1\x1c9\f\xea\xbd*I\x95Sw\xff\xb1\xe4\xeb\xd7_\x96\xeecyb-\x8a\xe6*[?I\xf9\x8b(\xb39u\x1d\x93\x8dS\xd9F\x1a1.\x1d\u04e1\xc5J\xfd-\xe0\b?\xfa\xd5\a\x8dm\x8bw`\t\xcb\xea;\xfb\xbc\x9a\xe3\x19\xef\xe9\xddN\x82\x84f9\xc9\xf1{\x8d\xea\x95Z\xbd\xde\ua261\xef\x04\xab\xc7{!\xfeRe\"\xe7\b\xbf}\xbf\xa8@\xf2eNL\xa3\xdc\xd8wfe%V(\xcfzR\f\x17\x92\vi>\xb0em\xb3\xb9\xddN\xfd:\xe5\u056b\x884\x9b\xa1m\x89\xdcF\xc8\u04f4\x91\xad\xfd\xa8q\v\xf9\x1b\xc1\xe4\xc5g\x83\xbe\xb15\u059b\xcau\x02\x87^1\n\xcd\xd1\xc3\x0el\x93\a\x9c\xe8\u0436\x961\x8e\xca2\xf0n\xc1\x03\x82_\xe5I\x1f\x92V&![\xa5\x01\x89n\xc75\xd1:.q\x04K\xac#E\xd8\t\xe3\xe7\xceT\xa0M\xe1\xb8nZ\xed\xeb8\xe5\xc0\x99c\xbc\xc5O1\xf0\xb1\xf9r'\x87\xb3P\xc4M\xcd|\xa2\x13\t\xc0\x92F\x90\x1b\xad\xfd\x83'\x92\xbc7\x99\x01\t\x98\xa2\x94\x1f\xa3\a\x87\xee\x13\xb6\x83\x1c\x97[\xdb\x12\vF@]\xa7T\x0f\x18\xef\xb5N!\xb1r\xfd\x03UZ\xab%\x06\xd7^G\u0151\x89\x8c\x97\x83\xa4\xa9\xe4\x99\xfcA\xf9\\\x16\xd0>WD\x86x\xafp\xb27[o1\x97\x86\xb6\x05\t\r\xb4\xa0`\xff\xb8\x04\xb5\xb5\x94\xfd\xe3/\x165K*\x1ei\xc0\xec\x93l\xb7;\x11\xd1\xfex\x04\u020f\xcb\xf0M\xcb8\u03cc/\x9e$\xd3\u073b)^9)Fw\xbcG>i\x1eA\xdb\x00\xae\f\x03~\xc6\xcd&\xbe\\c\xd0\xd7\xff\t3\x97\x18D\xac\xf8\x04(\x0em\v\xae\xb6(\xdfJ\x8a\x04\"\x04\xbe\x94\x94\x8e\xad\x04E\u02fa<\x81\xad-\xba\xa9\xcb\\\x02\x80YtQ\x97'\xa5P\xeb\xba<9\r\n\xf8\xe3\xb0\"(1\xe4Gsn\xear\x04\x18\xae\b\xdd\xc2tO\x12\x03\xfe\x81\x8e\xaa\u0289O\xaaIx\fd\xf1\x85m\u07b3\xb9-\x97^\xdd|B\x1d\"1\x19\x8e\xc6=\x1d\x10/2\x951\x02\xa8\x12G\xe6\x94'\xc28\xa2\xe6\x81Xq$_OI@3\xaf\xa3o\xc5\u029b\a\x9d\x94\xbe\x9aM\xf3\xeb\xf4T\xe2\x00\xb3\x80os*VN\x85\x8eQt0\x92\x92\xe0\x991\"\x131|\xbd\xd3\xd2\xe9\u0789;1\xf4D2\xb9F\xa21\xa9g\xcc5G\x14\x86\r\x17\xbdH@\xf4/\xa0\x16\xeeE\xf8a\x1e\x99fS~\t#D3\xeb\u0715z\x85?\xd9\xfa\xdc\xc2Eu\xb2\x15\xd8|\xe5\x87)\x97:]\u00a5T\xcaiC\n=\x93\xd2R\x99_\xf0\xb4\x8e\xc4s\xa0\xb4\xf5k=/\t\xcb`\x84c?\f\xeb\xb0\u02b8\xae\"\tb0V\xe2\xeb.\u0467\xea\x03\xc7\xec\xc6|al\x14\xf8\xc9\x02\x0f\x1f\xdfR\xd5\x1bQU\xa3\x8c\xa8-~\"6S\x9e\fqo\xd5\xe1\xa91{\xaa\x15*m\x13\x8c\xa2\xa2FP\x18\xe8U2l\x11s\xaa}\x89\xbaji\xb3\xa9\x7f\u03b2\xf9\xb4\xa2bmd\x91\xa9\xf9e2\x8e\xcdt6\x8b\xa8\xf9=\xf7\x10T\xb5\xa6\xa9\x89\x80\u01094\xf1\x9cH)a7q\xa3\x89c\x8a \"\x0f\x84`_@zC\xbf\xb5\"\xfd\x1a\x04\xb6L\x85CY\xb9\xc4\xd4i\xae\xa7)\xef\vT\rF\x7f\x8a\x10\xcb\xcc\xdb\xcc\xe0\xc0)\v\xaa\xba|.\xae\x11\x99\x05\x94\x06\xfc\xbf\xfa&T\xfeP\x11<\xcaB\xee\xe2\xf8\xbb }T\u02e1\U00016e3c\t\xa1j\x10'\xbc\x88\x89*\xceb\\\x1a\xd4\u4c7di\xa6\xa5\u03dc\x89\x7f\x16O\xfcV\x8b$3\x7f^7\x82\xcaj\x11\x939\xb9\x177\xedOv\xa6\x91\x83~\x10\bgM*\a\u0760.\xb8\x1dP\x97\x94\x86v\xca\\Z]]\u010db*\xf2/\xe8\xc8\xd3\a\x17\xa8\f\x11qTK\xd2\x04\xa5\"\xa6\x1a\x10\x15A\u00ce2\xe3\u0151\xc5 ZvR\xc3\x0e\xc1\x9a\x0fqD\x90\x10I\f\xf6g\xa1K\x00\xcf\u07e2\xb0x\xcd\xd8I\xe9\x8dZ\xd3%\x98\x99\xd4\f$9`E&&\x9c%u\x1a\x0f\x88\xee\x8c\xc7B\xa2eI\x05\x97D\xbf\xf2\xe5\x1b\xc6K\n\xfa\u06b8\x8d\x7f\xc4\xcd@B\xbb\xbc#\xfeR#)V\u07a9R\xb12\xa6\xfd\xec&\x96\x8bW6Tm]ei\x8d\u047e\xa5\xf2I\xb5\xaf\x19\u01330T\r\xa75\xb8%x\xb0B\xbd\r\xf0\xc1\x88\x04\xe5\xafec|\xb7\xab\x19]V\x1d\x1d\x00\u0371\x188\xb5\x92$^F\xac\x1a\xa7\xf2\x024\xf8!,U4\a\x0eXb\x92;Za\x93\x95\x98(\xdfJ\x0e\xa9\xdc\x14@\xddZQ\u01ad9\xb7\x98W\xe3\xd3\x19\xc8iP\x97\xc7\xfc\x86\x1a4\xd0\xfe\x0fH\x90\x888t\x0f\x94\x10D\x8c W\xc8CM\x95\xf4\x85t\x8d\xfa*eE\xa0\xf2\xfcn\x13\x7f{\u47a2Q\xedu\x95\x98\xf8:F\x95p$!\x90\u05ad\xc4j\tm\v:\xd8\xf2\xa5R\xc87e\u74b7 \xa1%\xaaht\xe0\x05T\xf0-\xd4#L\xfe\x12\x9e\u0749\"\xad\x05\xfdR\xf4\x1e\x93{\xb3s\xcc.\xf5n\xc7F\x87\x82H\x8d\x01G\x18LI\xc1\xdfd,&\xa0IQ\x12\xe8\xc7ph\vs\x9dL\x89\xb4\xd0\xd2\x0eTw4))\xc6X\xb8\u0541\x15\x9d\xc5\xf3Z\xb7\xeb\x1c3lY\x84\x99\xce\x13\x0e_\a2\x05\x1c>\u02e3\xcdM\xdb~\x88\x11K*\xd7\xea\xa5\xe2\x9f\xc1\xed\x8a\xebv9\xf3\xe7\x05\x18\t+_\xf8\xa4\x90A\u0221\u062f4(c\x81/\x053\r\xee,\xb8*\x04oZ_&{\tx\x0f8\x8a3e\xf93\x91(\\\uff90nD\xe6\xec\x98\b\x9f1\xf0<b\xaf@\xab\xb4?\xea;\x84\u0415\xd4d:\xa6)]R\x1c8\xa27\xf8\x05j\xda\xe5\xe3\xf6H\x9d\xf5\xda1|\x85\xce\xfa#\x14\x17\x04\x1b\xfb\v\x87\xf2\x9d@4\x7f\xc3Bh\xb9\xf9\xd7kK(l\xa7t%.A_\x00\xee\x03\xf6\u0357\xcc\xe5+T\xef\xeb\xc4\xd20\x86\x87a\x18\x86\xf7\x19\x87\x01\xc0}[\xb3\xc8v\xfb\xee`H\b]\xdb\x1f\xf1N\x02\xb5s\xd4\"\xff\xbc\xda\xf9B\xab\x9dk\xb5\xe4\x98%\x0f\xe9\x17\xfb*\xbbj\xafE\x03\x99\xf3\xfe\xed\xb3W\xef\xbe{\xfd\xf6\xc7\xcbg/\xdf^<\xfb\xf6/E\u028bW\xdf\xd3\xf4\xa0\u061f^\xbc\xff\xe1\xf2\xe5\u016b\xef\xdf\xffp\t>8\x95\xa6\xe4\u010c'Pf\xde\x19\xfa\xeb\x82\x1a\x88\xda:A>\x04\xe4\xc4\x04J^\\\x19J\x84\x89\xf9\x04R\xa2\b\xf1\xbe\x03\x86\xa2O&\xaa\x16\u8e89'\x87n\x87\xe9\xe8&'\xe0\xb1\x01\x9b@\x9c\r\x18Kin\u01b5\x14Kl\xaaa\xd9q\x89\x9a\x05\xeaH\xb2d\xe3\x03\x96u\u0458]\xcd\xfcJ\x9b\xa4\xbc\xc6\b\xa9\xb2\xa4\xec\x1e\xcd\xc3\u07abD/,\xb0:\xa10\xf6\"\vH\bc\xf9\xe1[.\x97\xf04Q\xac\xa6\xf1\xad-\u0684/\xbf\xba\xc3R\xa5\xf7\xeb\xca(\xa6\xf8Q%W=2\x8d\x19t\n3r\x8f\xe8\xbcbt!]\x13\xbf\x98LUD\x12\x90\r\u06c4\xab\xe7\xff\xb6b0U\x90\xb0\x93\x1aw\x8fF\xbb\xbb]\xdd\xfc\xb4N\xc1T\xdcEF\bI\u03e8l\xbaY\x02v\xcbJ\xb9! e#,\u034c)\x1e\xfa\xe5#\x90\x91\u00a3L\x04\x94\x93R\xab\xe3\xf5VD\u0489\r\x80\x16>\xe5y\x15\x90J'\xd3(\x1d\x14$\xbcA\x88\x19\x96}\xe0\xa0\xf0aM\xb3\x12\x9e\xad\xf4Z\x82P\x00\x90\xbc\xd2V\xe5I\xb5/u\xf6\xb0}\f\x9a\xdbXE\x19){(5\x800\xce\xea\f\u0253z\x14\x16\xac\xec\xa4\xc0241q\x10W\x9f\x85\x06\x170\xa6K+{\x7f\x1c\xf3\xd8Iy9\xc4[\xec\xef\x80}*s>\x90\u04d8\xbc\xaa*\u0196\x1a\xdd\xc64A\xdcfNi\x9aUw\xd1\xf1\xecr\x91\x02+\x14\x9ba6I\xb3\xf2\xba\x01[\xbb\x84\xd6\x0e\xf6\xad^\xf11\xaf)R\x02\xa0\x92\xedn&\xfc4\xec\xf7\x14\xedU\x1fm@\xa5\x0f\xaa_^\xbc\x89\xb4\xf2\xf8\x94\x95\x8c\x19\xb2\xe4\xce\xf8\x16\xf8\xc7$nO\ua84f\x8b\xaa\x13n4\x81\x04\xfe$\x99((\x12\f\a0\xbe\x9e\u8e3f\x8b\xab\xd3S\x1a\xdbQ\xa1\u0377\xcf\u068b8\xf9\b\x80\xf4k\xce\xd3,\xfd.\xe1\xc2\xd3\x1e\xe3{;C\x1b\xb2\xb2Q\u061fL\f\xc2\xdeIy\xd6\xfd\x92o\x13\xbe\xc0\xc0\x87\xf6\xb87\xee\f\xc1\x96\xaf\xb0\xf3\xca\x0f\xec\xbc\xc2\xcf\xdby-\u8db0\xcaZ=`\x95\xb56\u025f%[\xd7[em\xd8\xfa!\xba\u81ad\x8f\xd0E\xd7lmZd\x19V\xea\xf4\xae\x9c\xa5\x8d\xeb\xe9U9\u00f07\xa7\xb7\u5b0a\xf1:\xbd\x90\xd9U\xebr\xfa\x89m+\xf6]\xa6\xd3[\xd3c\xb20\xdf\n\x1eo\xbe\x15\xa0\xff\xb0/4\xdf\n\x1ek\xbe\xa5.\x9d\u03d8o\xad\xb4\xf9\xd6\x11\xd3\xe1\x80\xe8s\xe2\x87\xcf\u0463\x80\xe6}h\x89\x9di\x82Ub\x8e\x94\uccd4\u0589N0-\x9ad\xf4.iv-8\x03\xc2\u0149<\u01e5$v\x92~\x95\tT\xd9\xe4\f\xa6l\x0eW\x9cJ\x95[\xb2\x15\x13O\xb87I\xbc\xe0ij\u0589\xa3\xea\x95U\xc1 \x85\u06da2!@\x81\u0306>\xa8_\xa6\xb7k\xc8\x1d\xdf \x00n\xa0\x8c\xd9\x1b7\xe7\xc1\xcb\x147/4\x12DF\xc8#Z\xfcd\xce\x1eU\xff\xc9\xc9A\xa8a\x9f\u071f\x9e\x1aH\x8cFS\xdb\xe0\xe3\xf9\xc24\xd6T\x10\x05\xee\xc3\x01\xb5\x83g\xc7Pi\x02Q\\\x02v\v\xbe\x9d<\xba8\x18\xf2\b\xae!\xbaT\xa2\x99\xb2\x83\xb9\x17\x88\xf0\x17;\" c\xd6\xf04\t@\xe9Il'\xfc\xaa\xee\xdenw\xa2\x125\x16\xde\xed^\x8b\b\u05c9)L~N\xa1\u02c0\xfa\xc2HD\xfc\xdcK,]@\x91^\xf0\"\x05\x97\xd7\x04\x1e\u0753Q2\xf4\xd3\uc6e3\xb9z\xf1UC\x9a^6\x0eQy\xc9\u073f\x93\xb1ae\xc8%;\xcc\xc3\xcb\v\xb5\xeb\xfd\xaa\xf9\xda\xc7\xf2ul \xba\xb2m\x9f\b\xbb\x9d5\x9b'\xd2\x03\xedG)3\xa8\x9a\xf7\u0558\x8e\u05db\xf1\x15\x9e\x1c\x1e0\xe3\x83B\x05\xdf\x03\xbf\xd4)9\xf2\xaa\xc1\xbc\x9br\x95\x1bU\xe7\xe6\xab\xcd\xff\xea\xeb!\xc2\u0575\xf0\x8b\xc9TBh\xfa9\x83A\x11\x95.+`1QD\xdd\xe2\x8a\x05\xf2\xf7\x81\xae\x95\xa1\x8c=\xcd\x14n\xb3\xa5\x11\x1b~\x80o\xa5h\xaa(\xf5\x1b;\xa6\xba\x1c\u0286E\r\xe8\xbeH/\x94\xb0j\u0360\"\x83\fA\x84Y\u0559\xd3\xf7\x8cV\xff\u04b7\x8e\xb6\x88\x82\x04K\xa0\b\x80\x82\x12\xf5\x95\xd8\n\x85T|\x03\xc3\x02\x1dbvg\xa2c\x0f\x96O\x81|\xa8)0@\x9bG\xc0\x14\x85\x14\xb6\xee\xd8\x00\xa9\xcaoExI@z\x01\xab\xa3\xff&\x81\xa2+\r\xc7,\xc0\xd79q&\u0444\u0133t\xce\"\x1a\t\xf3\xc4\xdd\xce\xceQ\xd51bb\x044m1w\x12\xb7\xfd0\x14\xe8$e9\x15\u0400\xbb*\xc5w186\x0e\xe4E\\Z\xa5V\x8bf\u01f1Q\x80\x9dL\xed\xba\x813\x91)\xcb0\xf9\xc4~\x00I\x14\u02beU\xf4\x82\xb6wBY>\x9a(\x9f3Q[<I\x16,\xd2F6@\xa3\x16\xa46\xf8\x8a\xb3\xa5\xadR\xc5\xc6$T3\x0f\xe9\x82n\x8d\xf5\xaa\x1f\xc2\xe9iq0\xa4\x82\xbc|p\t\xd0;\xb6@d\x7f\xd0\x1e\x8bh\x1dl\xb9\x15w\xd1\x057!ZjM\xffB\xee\"{\xe7\xd5\xd6Q\x7fJ]\x05\xf8!G]\xb21\x01\x00\xe7\x12w\x18\x9c\x8e\x88\xdc\x1b;\x7fzJ#\xa1\"\x83\xeaK\xa5+\xa6\xb08\xd4\u0256>J\x15\x83\x90\x82\xd2\xf9\u0156\xdc\x10\x90\nT$\x86F\xf3h\xe0PP\x95\xbb]\x1d\u58c6\xbf\u06d9\xa8\xc9~\xcc8Q!\xb3\f\xe0%\"\xb6$\x97|!\xe4\x92\n\x7fH\xf5\x0fU\x197\xbf\xae;\xbd&YY\x1d\xbbx4Vy\n'\t\b\xefK\xfa\u0626d\x9f\x88X\x11J#[[\x01\x1b\x9e'RB\xdf\x19\xefj\xed\x10\xc7|\xc3*\x14\"\x1f\a\x15\xe8\xa1\xe8V\x8aO\x88\x16\xebQ.}\xbd\x17>\xa5\xa8\xd1\x1c\x04\x1a\xaeg\xf7\xbf3\xd9\xfdWJz\x02\x8c\xfd\xa0\xad\x9f\xa7\xa5P\xd7&\xf7\xc9t\xe6C\xa8U!\x06Es\x8d m\u8596\xed\xc6O)o\x1c\xf3\t\x84$\x06\xf7\x97m\x8bZ\xdf^\xbcq\x1c\xa7k\x91\xbd\x11\xf9\x18#\x1d\x7f>^\xd2\xdaOU\xd4\xfdZI\xe1w2\xe5Pff\u051cO\xed\x05{\\\xc9c\xb2\x14zX\x96\u07a3\x97\xb4\x1a\x9f\xdf'\x8a|\x92\xfci\xc1\x18e\x8c\x81m\x1cJs\xcb\xcbf><\u07c1|\x87x\xb5*\x91\xa5\x825\x12\xfa\x92\xa6UI\xc9\xf8\x9aT\x85\xddG\x99{\u052fs'D\x03\xb8\xe3Sv\xe2\x97\xe4\xc2v\xc28\xcd\x1f\xb0\xd4\x0f\xb5\xe84m6O\x8c\x92\x10\xc6\u05ee\xf5\x16\x9b\x17\xf6\xec\x9c\xd4\xc2H\x86\xfe\x16\xe1\xf0\bo\xd3\xe94c\n\xe4\xbd\f\xed\xe4\xfd\x03\x9b[Z\x83\u04c4\xe3\xc6KB}\x89\xf5\xa7u\xefE\xd4\u049eH\xbcl`*\xa1\\'\u0598xvZ&\x9e\x04E\x05-\xf8\x93r4\x89h\xeac\x9bW\x87F\xc3\x11`\u05d2\xe3\x02Qt\xf9\x80\xe3\x829*Z\x9d\xf8\xbb\x9d-\x1c\x13\x98c\x84\xe7#\x9a\xfe\x8aq\xfa\x14&\x01K\xef\x97\x10rp\x10\xf3\x1d\xe8V!\x8f\x91\x80\x11\x1c\x80K\xd9\xc5B\x1d\xbf\xa0p\xd3pb8?\xb03&\xf7\x18\x9a1\x9c\xe7I\xff\xb8\x93\xe4\x84a\xf4\x12t%\x17\ubb65\t\v\x847\xf5\xb4jb\xafT\xa0&\x85\x11~*\x83\xde\x1d\xb3\ua6c4\xbb]\x99\x86u\x88p\xb0!/\xf0\xddN\xd1N*\x90H-\xd91\xa9Me\xf7B\\\x94P-&\x8a)\x02\xbf\x17QE/y>\x85\x8d\x92\x02\xa4\xc5t!\b\xb7\xda\x06\xbdC\xba\xa6\xb6\xdc\x11z\xaa\xc5\\\xe1OA\xd3g\xa9\xdcc\xb5\xfc\xa1\x06\x11an\x0e@\x15\x94\xb1\x06\xac\x86\x89`\xea\x1c\x88\x89\x15k\xb5\xaaj\xa0\u057a\xa6bT\xa9\x85\x89j\x03\u0370\xc4O\xb0\x10.\xb6\x85k~\x02\xaf\xe3'\xf0:~\x82Rr:0]\xff\xb6\u00b6\xaa\xc4a\xa85\xeb\u7337\xb3\xf8e|\u02d3\xe7>*\xb7\xd0\x13{f\xad\xf9'\x8bJ\x1e\x17\xfc9\x85\xbf~\xba\b\x02\x8bZWA\xe4'\xe0v\xea\xcaO\xf9\xa0\a%\x16iG\xfc9\xed\x88\n\xee \u4caa\xfc\x99\xf8\xb7\xd6\\\xfb\x8d\xb5y\u02f2H\xa5\xeb\xf3S\x97\x18L\xa9\v\x9bW\xb4U\xaa\x0e\xde\xca\xf3\xe5R#\xe5\x11:\x04\xff\r\xdc\t~\xfc/u\xb1\xf8\xf1\xf3\xf20!L\xdb\u0602b\xe25F<\x8a9\x81\xcf73\x83G\xcb\a\xc5k\xe5S!\xc6]\u01df\x00{PNE ,u%z\x8f\xb85u\xb8\xa9\x82S&H\xee\xa48\x80\xea'\x93\xdc,q\x8e\x01\xe0\x13ycV/=xZ(\u07b3#\x1f(4*\x91\xed&W\x0f\xd5\xd8\xcb\xea\xe7p%\x18v\x9f\xdc\xf4\x06*qT\x82/\xa4/\x85\xdb\xfft\xb7\x93\x7fO/,\x8f81\x9f\xf5\xc2R\xef\x10\xf2A\x87\x8f\xb5^X\xb6\x9f\xf3\u00b2\xfd\n/,{\x8a:j_%[,E/\xc8P\xf3rZ\xbf%PT\xd2\xe9\x115\xb7\xc1\xa1\v\fN\x94\xebo\xb5.\x10O\x91x\xa0N\xc8\"\u02a5\x1e}d\x8f\x06\xaeCh*\xc3\xe0\xda\x16\u073doy\x1a\x877\x1cc\u02d6\xd3a,\x18:V%K\x13\x18\xba(R\xd0\x04\x13\xac\xb4\u032ao\x92x\x13\xa0\xce\xebJ\xa7\xaf1\x88D\x91\xb3\xd69\xca\xc5\xe3\u0130\xa81\x83u\xdc\xc8\xc0*\xcb8\xe2\x9e\x19zpcXh\xcf\xd2y\xa12\xc1\n1\xc9l=W\xf6\xec2\x0f\xce1\x87\x903\x11\x8a4f\xa9\xfe\x95\xcb_\x99\xbd\xb4# 8\x89i\xc4|\x03\xbd\x19\xa7~\x03<{\xe8\xe5\x9a\xd5\xfb\xbe2\x9f\xa9\xa07z\xa7\u02a5\x95r\xbe\x9d0x\xe4J?\xd5\xe5\xf3;[\xcf\xf7\x82\u00aa%<\xa8\xe0\x86\xcf\xc2\xf2\xf4e\x03r\xb9\xdb\t\xee\xa5d\xa4`\xf9\xc5\xfc\xb0\f\u0081\xbd\x94\x9e\xec\xc1\xc5VQ~=?\xf0\xa4\x85W\xb5\xac]\xd5|1\xd6\xc9\\\x06p\xe1<\x8dl\xf8C\xbc\xac\xdc\xd5\x1e\xff\x9b\x98\x8e\x8bf[\x9cUB\"f\xf6U\xab\x7f\xa2\x13\xf1\xc9\u00aba\xcf\xc8=\xc41\x9aF\xe5>\xbdl\xb6\x9ac\x8d=\x81\x7f\xf6v\"\x85\x05\x85@\xc8gj\x01$\x1c\x15\xeb\xec\x1f_C\x1f\xe1gR\x1e86\xb4\xd2\xe1\x18\x1ar\x8e,\xa2\xd1~O\xeb\xf4d\xe9\x11^\a\xc0\x93o'\xd4\x12ieUmC\xd9\xe9\xf3;\u014d\xad\xc5y\x95\xb5(8l\u05d1\xbd\u06a3\xa76zm:\x9d=\x8cE\x12U\x02\x81\xdcQ\u06f71d\xef\x9a\xea\xb3]\xc2[\u05373\x9a\xaaL\xad\xacV\xce\xcf?\x93\x1f~&\x7f\xa1;?t\u0424]-\x1cV[\x1drL\xa8\xe6\xd8G\x06\x94$S;\xd2X&\xd2X&\xd2X\x06\xa0$\x11X\u01b3\xb1\x00\x17\xb9\xa0\x06U\xee\u0608GX\xb4\x19Tc\xb2\x89\u0630\x0f\x85\x16@\xd9\x1d<m\x15\u0684\xfe\u0307\xfd\x89\x88\x80\xf5\xf0\xc03di\x004\xd8\x11xh\x97h\x10\xa6\x9f\xce'\x85Q\xf9g\x9aI\xca Eh\x04\xc1\xc8`\xbe\xca\f\xa0\xb0\x18\xba1BV\xc1\x9b\x0e\x8e\vz\xfd=v\xf3V\x03\xc0\x88\u06e0\x1c\xf5\x11\x0er\x81\xb9\x8d\xd8h\xe2 \xa6jW\x1f*\x03\xcdd\xc8BJ\u06ab \xccxR\x0e\xb5\xa8\xe2\xe7\xd55\xf1-O\x17I\xb0\xcdb1\xc6vq\xb3\xefq9\x84\x82\x9c\xb0\x06@\x14\xa5\u0677\xa6_\xea\xffJ2\xa2L\xbe\x94\x1czL\xa2\xb3\xcc\f\u05ae^\x01\xb3h>I\x8c\xa91\xf3\x03\x18.H\xa9\x17\xbd\x03\xb5l\xe1 \xad j$H\xbe\x1b\xf2\xd7cT+\xa7\tl+\xe2pE\uc515\x95RPi\xeet\a.i\aQ\xba\u52cc\xe6\x10\x8c7m/\xf24\x8b7\xbb\x9d%\u04ed:tF\xee\xf5\xe4\xb9}\xa0\x93\x81*\xbfe\u03a6\xf9\x9e\xd5q\"\xecRp\x0e\xbf\xb1\b\xfd4m\xf8i\xc3\xd7\xf7\x97UX3I\r\x1b\xee/\r)~\xe6\a\xa1\xf1\xa9TP\xf6\x12\xd9\xd2P?\x9c\x01\x9f\xb0\x19\x86\u07b6\x00\x94,z\xc8\ub541\xa6\xc0\xa2\xc6\xe3\x06#gb4~\xeeLu\u03d2\xb9\xe3\x15#\u02ccae\xb4\xd52*\xee\xf7T\xf4.\xed}\x1f9\x00\xdd\xf6~\xa2ll\fO\x17Eg\xe6\xfa\x1c\ube7e_\xdc0\xfdL\x11UJ\xdc\x1c\xed*N-\xa6[\x1e\xc8\xd4\u8e74)^%\x03\x1b\x82Y\xd1\xd3S\xa3>\xe5{=DtQT3\xc4c]T\xf6]5\x03\xae\x93\xea\x16XY\x1f\x1b3\x954\x83\xa5E\xc3Y1X\x1a\x89\x18\x948\xf9\f\xfd\xbf|\xca&$j1\xaeR5\x1a\xd23@\xf7K_\xd4y#\xc0\xb0\x17\v\xdb)\u031a$\u02d6\u026c\x9f\xa2\xd4_q\x1b\xdc\xe59\x84\xfa\xc6\x10S\xb0\x7f\x9a\x10d\x90\xfb\x99O#\x16\u04c4\xa54(\xb1\xf9\xb6J7\x14\x9a% \xd2\xf6M\xef\x7f\xd4g~IF\x15\x9b\xf3\x11^\xbc\x8e\xdd\xf4\xca-Q\x83\x9f\x95\xc1\xc5\xf4nU\xceP\x81\xc0\xccS\x8d9\xf5\x059\xba\xac\xe2\x8cU\xb3M\xf8S\xbe\xb52\xf1yy\xcd3\x1d\xc6\xcb\xd3IZVA\x8b\x1dC\x0fZ50g\xf2\rt\x9f\xbaV\xd1\xc1\xf1\x93l\x02\x92\vd\xbc\x80\x19\xd8c~\xca\n\x16\xb7\x86,\xa51-\xb6\xd2g\xfc\\\xa9AO\xd5\x0f\x0f\xf5\xb2}\xf4\x8a\"\xb3\x92\x16\x8b=\xf8\xc7\\X\x871\xe8\xc4'\xe4\xbeT\xd8n\xb5\"*\xfa3\x0fn\xbb\x8cm*\xc7\f\xe4\xbd&\x92\x13\x9b\xa5\xfa\xf3\t\x11\xeee\xf6\xadVd\x92\xf3ZC.\xa2Ii\xe1\x14\xc3\xf1\xd8\xc2U\xa0\x9eP\x03\x84h\u0084\x91f$\x80\x00\x80\xdb\xce\b\xe5\xa7,2\xc1b\xa2\x14\x12\xf4\xb2F\x9f]\xd6X\xb6F\v\x87M\u0521\xfe\x83\xab\x99\u0428\xba\x9a\xd1\x17\xacfD\xa3\u3ad9\u052efB3\xb5\x9ay\xed\xb1\x94\x95\xd2j`\u00c2H\u0258;\xc9\u03aa\x11l'\x99\"V\"&HW]\x02\\Z\x98\x1f\xde\xfd~\x92\xfdcg\x9aH\xaa\u044e\bP\xaem\x19\xb8\xb9L\xf8I\xca\f|_\x80(\xf43\x04`ZK\xb2\x05<\xb59\xfd\\U\x1b\x1c7\x1bc:2\xa0\xe34\xe1g:\x10\x9e\xad\xf6E\x186\xbe\x87\xa8\xc8\x19\x05\x85\xf1l\xed9T\x10P/\x04\xf5\x84N\x1c\xc8~?\xa7\xe8\x90\u0188\xf4\b\xfc\xce\x10\xd3h\x88\xaeO\u025e\xa2f\xf8\xe3\x82[M\xcaT/\xb9\x0f\xf0\x0f\xf5Kn6}\xa1\xc3\xfay\xff@Z\x85\x11\xdd\tU\xac\x17O\x0e\x1f\x85fq\xa1\xad!\x83\xb3\x15}\aJG\xa6b\xcbc\xa8\xdf\xdf\u02d7\xb6w\xd4#V\xfa\x15\x1e\xaci\xfe\x15\fW-\xd3\xde\xed\xf2\xa9\x9dM3\xb85\x8epX\xa7u-\x99\xaa\xa2 \x86\xfcL\x11\xe1+\xa2\xf0\xabD\x958\u02ebM\xa5\xc2\r\x84\xfd5\xbe\xb8\v/n_\xc6=.\xea\xc94\xf0\xb7r\xc8\x13\x01\xe5R>\x8d\xaak\x14=4\xfbibj;\x83\xa8=\xfa\x82\u014aiT])\x91\xe4eS\xbb\u04b2x\x9f{\x95\u053dZ\xd1=\u0363\x03 \xfc:\x97\xe7J=\xb8R\xa0\x1a%\u543dr\xbcn)\xe8\xcaWn\xa2K\x8f\x86G{ \xb34\xe2rn\x9d\xb1B\xa5H\x9d*\xf5a+\x0f\xe6W4\xaf\u025e\x96-E\xbc#f\x00U\x7f\x0eUL'\x82i\x18*b\xbb]\x02ZbF\xcaT\xaf\x9f\x9d\x11\xef\x10\x7f\xed\xf7\x14$\a\x9f\xc3\xd3\xca~\xa7}\x9c\x05e\"qr\xbf?|h78\x12\xec\xfe1_U\x91\xb6\x98\x84r\x82\x1f4\x8908\xc6=\xb0\xe8j,\xdfN\xdc\u0261\xc3\x05\xd4\x11'\xf7\x19(\xc4\x16l\x8c*u@\x13C\xd96\"4f\xce$>\x8b&1:4\x8a\u0370\xf5\xf1|\xc2M\xaf,\xc0\x89\xd8\xdb\xfen\x17+\x87\x81\x91v\x81\"\xfd\x06FF\\\xc1\xc29+p*4\xcbC\x97,\xa2\vf\xfa7\xcdK\x8a\fF\xa5\x05\u072d\xe1\x81\xff\xd6f\xb3\x9a\xa2\xa1\x92.\u0336\xc0r\x86\x86\x80\x84\x82\xddN\xc5N${\xba\x85&\xab7\xd3\x03\xfc\xd3R\xf8x\u053eBw~i\xa9\xd1ui\xcfT\x06ZD,\x0f\xb4-\xd4V\x06\xe0$njW;\xaf\x1f\x0e\xdeOBc8!\xd4\xe8\x81x\xa0\xd1\x15N\xed\xea\xba\u052c\xd4\xc3\xed\bN\xe6\x9en\xca[\x92\xf0_\x91\x95\xa9\xe4\xc2\v\xb2\x9738\xa9\u04cdk\xa7<\xfb\x81\xfb\xe8\u04e4V\x8d\u07ff\x8a\x93logd\x9a\xa2;\xe8C\xdcXxo\xc9\t\x15_\xd2KZ.tX1%\x86\x90\xb4\x19\x87\x01\xc9B\xd82\xbaR\xc3QO7\xe8`9F6,j\xa0\x80O5B\x8d\xf6W\uaad8\x1c\x95\u040a\xa8\xa3\xd9\x14\u0645\u06f6\xd2p\x96\x84V\x96\xaa\xe2\x04\xaf<\xc6jvy\xbc\xe5<c\xc4b6\xe2|\xfdz\xd4I_m\x0f\xc5\"\x1e\xf1\xcfW\x9b\xf7\xf9&W\xb59\xc6\"\x1du\a\b\x88\x18\x1dM`\xc4\xf8Z.\xa5\xa1D#\x18\x8e\u02bc\x18\x95\xd5TDb\xff\xc6\x0fBH\x05~q\xb6\u636b$\xbeMyb\x81H\xbb?\x1e\xf7\x06\x0f\xe2\xfa\x89|\\\x16\x16\x9b\xf0\x96C\xcb\xcb\x17\xef\u07bdx\xf5=Xl\xbe\xa3\x01\x8b\u036b@[V\x9a\x9eP\xa5\xdcB2K\xb9\xe9\xa6\x13\x06\x80\n|\x8fF\xeaB/\x0f\xf5-\x04&\x96\xf2\x85\u0093\x0e\x88\xf8|\xa2\xad\x03\xa5/L\xb9\xc0v\x19\x03\xa2$B;\xfa\x10\x9ep\xa4\b\x1d\xddL\xd0{\x85h\xbc\xac\xe0\x98\xc7\xfbC\xa9\x8c\xba\xb3\xe0q2\x81\x96\xa9okag^7\x17\xc9SF}SU\x1b\x8c7\xe8\u07c258\x99\xca\xdfv\xadr\x8d\xa6\x02\fz@b6\xdf\xcev;\x80\xaa@\xfaS,\x89\xc6C\x18M\u024f\xfe\xa2\x12D\x12\xea\xd8Y\xc9)\x839\x01\xc9Z\xa8\xd3\u061fi\xa3Cw>M=\xde\xde\xc6[\x9bx\u9f9eI\xaf5\xd5\x0f\xae\xf2\u0338\u0291\xbd\x022\v\x8e\xc2\n\x90R\x18Wy4\u05da\x1b[)3/\xbb\xb6\xcc\xc0_  [\x86\xbf4\xeb\xe4\xacc0\xfd}\xa5\u0650Z\x8a\x02\xc8\xda\x1b\x7fk\x9b\xf2k\xa9.\xe5\xb3B|r\xaa\xa1\x01\x8e\x80O\xa3s\xa7\fQ\t\xfa\xa5\xe2\x04l\xd1\x02\xfd\xd8\x0f\t\xf51\u0314\x91\x10\u06c9\x96\xab\xcb6\xb3v\u0097\xf9\x82\xdb\v8\xeah\x8d\xfdEd\x9d2\xc9~\xfd\xe6\xbd0\x866\x84%\xf7U\xabn\xaf\xa2\x90\x1b\xab\xd9\xd6\u01e4\x97\"\u020a\x12\u06d4W=\xa9O\xc1M\xbc\x90S\u0600\"\"C&/\xf5}\x83\xf4\xbb \n2n\xfbd\xb7\xfb\xd1\xcf\xd6\xedU\x18\u01c9\xedC\xd8\"\x7f\xb7\xf3\xcf\x1cc\xab\x12;\x9eF\x9e\xb5.\xeb\xe0\x15j\xa5\xa5\x064\x1b\xa4\xa4\xbd;\xf0\xdcAw\xd4\x03\f\x8d\xf6\xf2\u01a2\x16\vT\x1b}uO;\xc3^gtl\x17\xea\xf96\x19\xb5./y\xfac\xbc\xccA>*\xc5z\x80\xb2\f\xa5\xa3^\xb7;\xee\x02Ml',&@\xf3\x17u\xa6\x89w\xaf=c\x8bx\xae\xc3\xf1\xc0\x19\x82\u069aL\xaf\xc1\xba\xd5C\x05\xfe{\xb5\xceWq\x80\\\x93q\xe6\xce=\xad\xc8\xde\xfe\xd1\xdf\xd2\x039l\xbd\xb3\xe4\xe4\xb07\xa7\xb67\xc7\xec\u0359{\x99\r\x84\xba9\x80\"\xe2\xd5m\x90\xad\x7f\xcc3\x1f\xfaI\xcb\f\xb2\xa8\x86o\xa6\xb5\x19\x81\xc1<\x8b\xe6\xc4F>\x19>\x05\xc8\xc4vh\u043e\xf1\xc3`\xe9g\xfc\x15\xff\x94!\x11F\xec\x04A\x9d\xa2\xa2\x8fT.\xc1\xff\xf6Ehw\xa6WyO\x87\x9da\xf7\xa8{\x8a\xac\xfd\x13\x13s66\x16\x01\xe6Q\x1b\x8b\xf5\xfd\xa2\xafq\xaf/A\xedo\x064Z\x0f(\x85\xe7Z\xeb\x9f\xfe\tp\u0367\xa7/^\xbdx\x8f\xce1\u06c0\u0527\x16\x9c\xcc\xc0\x0fq\xb5\x1aj\x9f\x1a[?M\xf9\xb2\x91\xc5\r\xa1(\xf2.\x8b\x13ny\xd66\xe17A\x9c\xa7\x8d\x14\xcb'|\xc1\x83\x1b\xbel\\\xdd!\xe5\"\xd0Yb\x1dY\xdcqw\xd0w\xff\xbeg\x8b\xf9\xb6:[\bh6\xa0\xc8~gD\xaa\xbeC\x8a\xa5\xe0\xe6\x06\xf1b\x83\xf8~_\xb7\x8a\x85\x1e\xad_:+\xe2.:\xf1+\x82<\\\xa9\xc62\u60b8[\xfb7\xbc\xe17\x100\xd5\xf2\xb4\x1b?\xfa\x1fy#\xcd\x13\x8e\xabV\xb7\xea\xf1\xe6*\x88\xf8[Q!\x05R\u044f\x1a\x02\xc75n\xd7q\xca\x1b\xb8\x12i\xc3O\xf4\xb2\xa7mK\xb9\xc3\a\x0389\x15b\v\x94\xac\x83\u01b5\x83\xf4\xc5f\x93#\x854=\xa9M\x06\ue8d1\x83\nP@\xb6\x06\xa9\xfaise\xc3m\xbd_\xf3\x86\xd5\nZO`\x94\xf1\xaa\x91G\xfc\x130\x9fa& \xfdk\\\xa8O=\xd1,n\\q\x98\x91\x12\xd2C=\xdd{\xfby\x1c\x86\\r&\x12#\xfd-_\xc4\u0272\x01x\x03\x17n\x15\x87\u0089]c\xab\x99\xf4^\xc3z\xd2\xf2E\xf0\x9a'\x16mXOH\xeb\x89\xd5~\"\xc9L\xde\xce\xe2w\xfcW\x9b\xc0&\x8a\x1fY,\b\tr\xa84R\x18`e`\xa8e\xb0\xe4\x85?\x1b\u0376-\xa4\xf3\xd6O\xc5\xf4\xad\x16:\x93\u0582jK\x0e\xf3\xce\xf2\xd4\u03c0\xa7\x16i=\x81A\xa7\xd5A7Vq\x1e-\xe1U\xf0\x04\x96\xd7X\xc8,n\xac\x82h\u0648#\\9X\x8b\x8fQ|\x1b)HP\xebq\u05c8\xfc\rO\x95\xed\u0711\xb5i\x18C.\x86\u0578\r\xc2\x10\xb6)\xb8\x8e\xe2\x84/\xdbO\xa4YI=\u0784\xfb\xea\xefu\xb4iv\x88\xc9\x19\"\xfbb\xa4/\xa2\x9bx\x81W\xc7\x1b?\xf17\x1c(\x05\x9e\xa6\xfe\xb5,\x89\x95^\xf9\x1b^\xc2\xd8,(\x10\x04\x8d\xc5\x17 %\xd0\xe0\u00efQ\xbf\xdf\x19\x9a\xc8#\xf8\x02\xe4Q\xeaV\x1f\x1f\xfa\xf8\x91\xc7F\x9d\xc3\x150\xae\x0e\x1c\xe6\x7f\xe2\u0561\x9d\xa2\xe9'\x17'\xd5\xd7\xec\x13\x89\x9d\x00\xa82\x00W\xb1J|\xd9\xc8#\xd1\xfb\xb2q\xbb\xe6Q\x03\xb5\x8d\xe1\x94ZOZ\x11^;P\xda\u01ee\u068d\xf7\xb1\x840\xc0\a\"\x916\xee\u2f31\xc9\u04ec\xc1?\x81/\xf1 \v\xef\x1aZ\x14\xc9\x1b\xe5k\xa8\xfd\x84\x1c\xbbp\x86\xc3\xe1\xd1\xdb<j/\xed\x8c\xdeo\x96\x9eM\xd8\xf9\x86~{\x81?\xd6\xf4\xd7\xe7\xf8cI\x7f|\x8f?\xb6\xfa\xb2\x89\xec\u07b8\xd79\x94~\xfd/\xaa\xd1\xe6\xff\xa7\x88l\xe3\u01c8lm\x10\xb9\xfc+\xf9\xaf\x15\xdc\xc6\xff\xa5\x82\xdb:\x14c\xfd\x18D\xc1*\xe0\xcb\x06\x1c\xaeO\r\xe4D5\xfe\xc1j\xf1\x965i\xdc\x04i\x905\xd6Y\xb6M\xbd\xa7O\x91\x9ek\xff\x92\xb6\xe3\xe4\xfa)\x1e\xc9t\n\xfa\xa4\fK7Vq\".\xc9<\f\x1b\x1b\x81`\xe0:\x85#\x00\xe9Q\x1c\x9dnToK~\xd3\xe0\xd1M\x90\xc4\x11^\xd0P\x19+\xe2\x00\xd2v\u00d2f\x92\x9f7\xb0\x8e\xafR\x9e\xdc\b\u05b7\xf5O\xffT|Ze\x16\xb9\xf9\x9cK\xfch\x19o\xf0.\x96J3\xdd\x01i\xa7\xf9\x950\x96\xb3\x87\x04c\nd\x10\x8eN\u01a5k[\xc8U\xbf\az\xd6+S\xb7\xad\xdc&\xf4\xed\u015b\x97\u03de_\x14Y2A\xe4\xbey\xfb\xfa\x9b\v\xed\x12\xec\xd9\xf3\xf7/^\xbf:\u053d\xd1u\xeb\x8acC\xfb\xfd\xe4\xc0\u07e8%\xc84\x83m\"\u4588Du\xdc\xeb\xe2Xq\xa5\xa6{,j7hW\x1d\xcd;@\x01f>'\x8c\xb1\xac\xc4\xe81\xcc\xc2&\xf5R\x9d\xac\x96q\x15\xedvu\xa9\xb5e\x8bS\u07dd\x1f\\\x1b\x81-M\x19\xea\xbb\xd6\x17N\xa4\xad\xcbD\x92b\xff\x01O\xbb\"\x90\xd2+\x1d\xd5\xf4\xe6\x1a\xfa\xda\xf6\x96\b\a\x17\xf5\xd5yM\xf5\x0e\xd1\x11w\xa8\xcf2\x9a\xb3\u065c\xaeXN\u05e5(=\x10wf\xc5\x18\xcb\xd1]v.uiH%\xd6L\xb0\xb2\xd75}t\x8b!\xfa\x15\x1b\x97\xc7\x0f\xb4'V\xb5\xae\xfd>Q\x81\xf7N\x1c\xd5\xd1\xd2&t\xa5}D\x94\x85q\xd9\u0451\x0e\xc0\x94\x0eDGK[4\x19\xb1\x95\xb6&\xe5d\xb2R\xb1?\"\x88\xfd\x91\u35707\x98\x93\xd7rN'pXj\xda\x1f\x8aI\x14T\a\x92\v5\x05G\xc7g;\x861&w\xf7k\xe4\xed\xb2\xd8\xf6A|\x85r\xe3\x10S\xdd}q\xf8r\xb6\xa2u\x1a\u04f6C3\xe41\xd4!\xec\xbb/\xdb\x19\xe0QO\x00~\xae\xed{(\xe6\x85m\x89\x8c\xf6\x06|\\)\xa9\x1a@\xbc\xdc%\x9b\xb3{\xc0\x82\x8b$\xb8:P\xe6|\x10\xcf\u050c\xc25\xc9\xda\b\xe3$E\xfc\x13\xa8O\xe0_{c\x17\xb7Sd\x13z\x9fGE\xdfp'\xee\xf7{\x02\xb6\x03G\xac`(W\xb5\x8by\x02>\xde\x13\n6U\xcb \u0742\xaf\x0e\xef\x9a\x16\xcd\xdePE3{\x1b\x9a\xf0m\xe8/\u0523\u05fb\x13\xbd]\u0464\xe2\xaf\xf7\x01\x9f\xfd\x99\xcd\xeb\xa3q\x98\x1c\xf2\xb5\xd6\x13\xaf\xe3\xbb+\x0e\xbf\xe8j\xf2\x15\v= \xeal\xdc\xef5\x9eO\xd0\x1a@\xa9\x02\xf2Y2\x9f\xd4\xf4\x1e\xa3\xa5\x06x\xe4\xb7c$\x18\x0e\x89\xb2\xe5\u07d1\xb3^\x17B\xb6\x8e\x7f\xb4\xf7\\\xb3H\x86\x9c=\u017c\xfe\x8c\tX\u044a\x9d= \t\xaa\xa2\u02bf\xf3\x14?\x1f\x0f.bG\x05U4yH\xaa\x17\xd8n\x1fl\xb4cv\xaf\x819\xd2oA\xaa\xe1\xfepE\x92c\x1d\xee\xc1\xd9\ub040\xc2X\xc9\xd8\xe4>$lYn(%\xa0\x9c*\xfb\x05\xa5<\x1f\x94\x04#B\xef\xf7\xb48\x87\xc9\x1eN\xf4\x9ev\xfb\x83\xc1\x00\x85\x98\x02%\x9a\x1d\x1e\xc8 \f\x13\xb2B\x970aQ\x85=\x02N6\x8eQ\x8aS\xf1\u01fb\u07e3\xa42\x90\xd6uH-\xaa\x0f\v\xe5\x95%\xeb;\xcc/\xa5X4e\xb1&\x19\xdf\xfb\xd7X\xc4\xf86L\x8f\xf2\x8at\xe3\xefk\x95C\xc1*g\x0f\x97N\x0e+mY\x86_\xa2\xfc\x98\x80EX\xf2\x94%w\xa6\x97\x97\x18\xdd\xe9\x17\xcblZ\xb4\xdcL3\xef\x86\xfa\x15k\xbe\xb8(L@\x8a\xc0o\x1b\xaf\xedd\xb7\x9b\xcd\v\xfa\xa2}\x19D7\xf1\xc7c\x8e\xb8\xb7\a\aF\xb9mI \xc0\xc2\x01\xba\xb3\xbe\xe7\x91\xd8\r\xe4Q\x86\t\xf7\x97w\x8d$\x8f\"\xd0L\x17\x8cG\xc6\xd8R\xa0[\xac\r\xdc\xe7X6\xa4\r\x18^\x80\xc0\x12\x95\xaa7<[\xc7K\x16\u04e8\xed'\xd7\u031fL\x94\xbb\x98\xa8\xbd\xe4!\xbf\x96\xae\xc1\x03\x91\x9c2PT\x14,\xce\x14{I\x19c\x1b\xb2\x88\xa3,\x88r\xad\xa3\x92\xee\x91\u80db\x0e\x06\xa0\xfa!Q;\xe5Q\u01a2\xf6\xa5\xfc\xeb'\xd7ho\xda(\rX\x97WK\xb1\x953H\xd8R\x8ctR\x1c\xb9\x8bO\v\xbe\xc5\xc5\xc3\x1c\x82\x8eR\x94u\xa8\xd1\x18\xea\x82]%\xf96\xb3U\xaeh\x8bL\x12\xb6\x96\x02\xe9\x85\xdc \xbc\x89\xa28\xd9\xf8!\xb4\x91\v\xcaH\f'j/\xe3\x88O\x97\u078a\xe6\xb8j5+ \xe1\x1a\xf3\x85\xf9\xb6\xa8\xb5\xdf\x17\xb3\x14\x8d\"\xc3aI\xd5(\x99\u0317\x1b\x92\x8b)\xed\x85\xc7\u0580P\xbf\"i\x06`\x82\xa3 \xbbEJ@\r\x1cp\x9c\u01f5-\b\xd9\x17\xe7\xa4T\\\xf6\x88\xa5\xf7\xfb\xbd\f\xa6\x14\xca\xf0\x98V\x9a\xa7[T\x01z\x97\xf9If\u0455\x91\xf4\x97\x80\x87K\x8b\xae\x99\xc5?\xf1E\x9e\xa1\x85\u0112i\x15\x96\xa5E7x1\x9b\x91\x1cM\n\xd5\xfc\xba\x83/\xe8\xf4\n\xaa\xe4\xf6\x15\xf5\x1f\xb0\x02\xc6\xe1\xdd\u05bf\xd5\xe8\x05\xbbm6o\xed[\xfb\x17{6'\x84L.\x9a\xcd\va\x02/\x83\x99]P\x1f\x04\xd9W\xecB\xb4\xf4\x89\xdd\x15\a\x9a\xdd\x18\xbf\xcb\xc7\xfe\x8a\x98\x8e\xab9\xb9\x9f\t0\xa7j\x19\x15p\xcd\xeb\x99\x1a\x027\xd6]4B5R \f\xa1\x05F\xca\xce\x01\xdfI\xdb\u00c2\xacD\xef\xa4Tr\xb7\x10zg\xf1\x9cr\xea\v\x00\x16#:)\xe0W\xf8T\x12P\xb9ea\x1b\xa1T\x1d\xdam\xb3\xa9\x88/}\x89l\xf5zm\x81\xa7\x89\x81F-2\u0374\xbd\xf7\xb6-SI\xd5\ue713\xfbH\xa2\x00\xcaq\x94@\x9bV\xb2\u56a9|\xe2\x19-\u05f5(\x87\xcc8\r\xec\xf0\xa0AMO\x1f\xb4\xbbOmy\x94\x10\xd9O\u03356\x91sb,\xaf_\x80\x1c\xa0\xe0\xcc\xdcG\x10\xe8cy\n?\rNS\xcc\xe2i,\x06\ue0f3c\xbf\x14\x0e\xfec\xa1\xf8\x900\xaeo\xe3\x99:\xfds\x85\xc2\x05\xc5\\\xe0`a\xffv\x04C\x16\r\xb5\xc5(\x80\xae\xd5\xf8\xa4\x84\xebXF\xc5\x18\xea\xdaR\xc4\xf8fr\x04\x1bU,:A\x16\xa5z.\x84p\xdb$\xbe\t\x96\xbc\xe17\x9e`\xe5'\r\u0456\xa5\xd7h#6\x81-\xec\x84\x16cW\u0238|u\t\xc0U\xdbP?\xac\x18\xfe\xa5\xd5\xc5\xdaL\x84\x18\x11s\xf5\x8d<\xf5\x05\xf6\xb6\xa3\x99\b\xec\x15f @\x983_@\x96\xb4\xef\x91^\x1c_\xc6\v}\x9eO\u032b\xc4X_\x01\xe1ru\xc9\xe10\x88\xe7{\xf6\xe3\x16T/\xa6\x18\x98\xd6YS\xc2H\xab\xae\xf9\x92\xd7bmV\x9a%w/\xe3\x85\xc7g\xce|?q\xf19\x84:\x92x\x03\xbc\x8c\x17\x8c\xcf\xdc9\xa1\x9d\"G\xbe\xd9E^g\xaeBU\x88\xef\xee\\\xaa\xa4g\xc9\xddE\x94%\x01\xd7q\x87J\xae\x88\x8bh\xcf\xf2\x06\b\xe2\b\xdc\xf6O2\xdcH\xa6\xaf&\x98E\xc6\x1b\x19n\x9dY\xda\u483d\xd6\xcep\x8an\xd9L\xcd\xcdJ\xe28\xb3\xf6s\xca5\xa6}\xa6\x02;\xca\x18\xa0<\xb3O\x1cRq\x15\x1b\xac\xd48#\xc6g>\x9e\xb9\xa8\x001\xc4v\xfc\x18\xd3L\xc0\x85*\x8d\xb4\xd1I\x90\xbe\xf2_\xd9:\"\x8d\"(O]\xc31_C\xe9hMZ\xad\xf8L\x95\x9d\x10\x94\x15\x8b.iL\x8aQH4\a\xe8\\P\x0e\xc0\a\x8a&\x95\xfcLg:4R\xfa\xb5\r\xe9A8\xd8\xcb\x03w\x0f\x9f\u078b\xbd\xe9\u04f6\xe2c&\x13D\u0289\xb3\xd7l\x05\xe3\x06\xbc\xa3\xb9\xfd\x89\xa2uh\x96\xe4\v|\r\xdc\x11\x9a\xdbw\x95\xc4kB\xaf\x910\v\xfd;\x94\xcbA\x91\x94\x16t\xabr\xe3\x89!2\x83\xf4 \xbdF\xf3\xa6n\x13\x80\x8dbt\xac\x14\x8bO0\xc0-c\f\x1e'\x87}2\xc6\xec\xcc\x1c\x1e\xf8\b\x04\x99-A\x91\xd6\xc67}\xdc\xf1\xea\xeb\xa5\xec\xbbfZ\x9bjszG<\x1b\u45b8z\x97\x97\xb8v\xfc\xc8\x1a\xc0\"\x1c\xa33>a0f\xde\xf6\x0f\"[\u028d\x93\xf7.\x90l\xf4\xd2\xf4\x1f\v\x1bc|\xd2\xe0!W*\xbc\xfd\xcc|\xe5\xb1w\xd0%\xa40\xe3\xb2\xd3\x0e%5\xab\xd0\a\x1f\x94L:V\xd1j\xfe\xe8B>T\x15\x88\xa1GV\xbb\xd9vD\xa6\x81'\xa0\u056e\xbb\xedueD\xd9\\\x00\xbd\xae\x00\xb7.\xbd\x84\x85\x02\xe84W\xd8\x12I\x0fQ\x8f\x02\xa2\xd5\xf3\u056a)i\u0364\x06\x88nvn\x89\x15\x03\x99c\r\xa0\xce\xe6\x85=\x85\xe0?\xa9\x18\x9f%\x1d\xc4\x1b\x9e\xa4\xdc&\xf4\x103h\xa6\xa8\x11\x8d\x1aT>\x91(\x10-V\xce\x7fb\"\x87\"\x12z\x81\x12\x00\x82\xb0h\xca~\xa1\xaf\r`\xbb7\x8e\x8f\xf7\x9a\"\xae\xac\xf2<U\xa8\x84\x1b\x15\xb0\x00\u044a\xfc\x8d\x0f8\xfc%\xder\xd2\x1b\x82\x1a\x8d\xf8(]U\x98T\xbe51I\x90%\xd5\xdbE\xa1\xf4\u711epRZX(J,\xf9\xb2\\\xac\xfd\xe4Yf;DS\xaa\u04a5k\xb3)\x11s+\x92\x02\x02\x17]\xa7B60\xae\xc0\x00#\xcd\xe2\xed\x81-\x98\\\xbd\x89\xe1\x15\xa1\x18\xd7\u0319\x1b\x97U\x99X)\xf1\u03f9Iv`#\u024d\x1f\xee\xe9\xc1\xb3\xb5v\xd5a\f\xaa\xa1Ia#i\xca\xc2\xe1\x84i\xe3_y\xbbJ\xe2B\xac\xa9\xa6e\x12\x1a?D\xb1\x9c\x9c\xc4\xfb\"\xc0ju\x1f\xb4j\xae\x7f\u039c\xc9\u9a6f\x18\x04\u0545\xf1\xe74eAuq\xf0\x8ef\x8c\x05mqk+\b\x8e\x85I\x00\u00b6\xca;c\x1a\xe2\u050bFni@-E\xb8\xa0[\xb6\"\xb9\xa0ZD[y\xb3\x19\x96\x81\xf7,\xd0DO\xd1y\x91F\x95\xe3X\xa3|\u0468Y\xc3H\xdd+\x9eE\xfe\xc5}aU\xa8t\x12\x1e\xf2w\xb2\xe4NhY\xa0\\\x18T\xaf\xe2\x1c\\\xa6d\x8b5H\x93\xe5\b\xac\xaf\x180\xf0\x1e\x05\xf7\xa3bMW\x98\x80\x1d\xdd\xf9H\xec|T\x8a\xf1f\xec|4\x17\xe6\xf4\a\xbb\xa8\x8fd\\\u07a8f\xb3\x18|l\x0eSj\xfe\xc5\xd2&~\x0f\u05cc\x85\xbf\xf1|\xedv\x96\xe2\xb3\xe07i6\xfd\xa2\xd7f3;c\xbe\xd1\x1c\xdeQh\x83//(\xa0\xfe\v\xf8\x04\x15\tM1\xe1\xf9\xe14\x90\xd8\u021f\xda\xc7\xd0\x15\xfc,u\x03$>\xe6\u0226\xb9\x1d@T7\xf9\xe1\x1d\xf8\xcby\x04\xc20\xe6\x8cE\xaa\x13\xc7\xc4i1\x1c\xac\xeb\x19L/Y\xc2\u0588\x87iT\x8be\xcb\xc8X\xd63\xe6'\x0e\xa7g\xb0\xc0\xb8\xe4Ve\xca\f\x15\xcbe\x84n\xf6TX\xfex\xf5\xbe\a\x8e\x82U&\xc0\xaa\x1cY\xd5\x00\xabLP\xe6\xe6\u00e4\x10\xea7\xca+\x1e\x19\xfbJ#\xfdr!\xf4\xb9\x1d\xc1\x10\xf7tQ\x16\v\xfc}\x87\x98%zx\xf7*\xf2\xeb\xb1[\"1x11Kp\xd7a\x94\xfb\xc2\x1b\xcb\x01^\bB\xb8HC\x89\t\xfc,\xe3\x9bm\x06:\x19\xea\x86E>\x9c\tj\xc8\xd86\x97J\x95d\xf7\xea\x95\xe9\xc1k\x88\x16o`/\xa2\xf2\xcd\vz\xf1\x9ayk@\x8a\xdazyq\xc0\xb2\U000bdb55\xc1\x84$8\xe1\u05ca`z\x9bGY\xb0\xe1,+\x18\x8f\a\x1c\xa6\xeb0\xbe\xf2\xc3\xf7\xeb \x9d\x16?\xdbu\x8dx\x9an\xb4\x12d\xb3U\x8b4X#\xb1\b<I\xf7t4\x1ew\x1e\xb6\xef(\u9569\xb5\x12\x01\x95\x96\xf1\x02eC\x92\x16\xbf\b\x11\x1f\xdbV\xc6?e~\xc2}\x8b\x90v\x10E<\xf9\xe1\xfd\x8f/\x99\xd5\x14\x1aC\x16M\x04\xad\xb5\x97\xcan\x97\xa8\xd0\xf6\x9cK\x8d6\xff@\x96S\x91\xe3L\xaa\xfe\x14\u0533\x86\x03\x9e\x93\x8fD\xf3\xc1\x9d\x9a\u0127 rD1-\u0722\xaeA{\xd6\xf2#\xb5\x06\u0081\xe05\xab\xf5\u3575,\xd4\x15\xbc\xe2\x9a)1)kj\xd7[\x13p\xa4\xb9f\xd1|/\x04\x90\xd27k\u039e~\xf8`\xcf>|8\xb1\xfe\xe1w\xff\xd8|b\x93\u07f7h\xfb\xc3Sor\xc6\u03a7\xff4\xfb0\xff\xf9\xf2\xaf\xf7\xbb\xfd\xbf\x9d\xce\xc9\xd3\xebI\xd9\xf0\xaax\\H\xa5\b\xeb\xc3\a\x8b\x9c9S\xee\xf1\xb6\x14p\xdb9\xb5~\xe7Z\xd5P\xc5rim~\xce\xfa\xfd\xcex\xd0l\xf23\xd6\x1fv{]\xa0\x111}\xd0\x1b\x0fE\xfa\xa0\xef8CH\x1f\xf4\xfb\xdd\xfe\t\x13\x7f\x9bp\xff\xc0\xaf^)ETv\xb0\xe2\b\xbe]\x8c\x1c\xa62\xdc\x1e\xe6t]]\xd4\xed\f1\xc9\ud3c1h\xb5\xf9\xb9\xeb\xba=\x17T\b\xf0\xff*\xa6c\xc0\xbe8\xc7\xee\xd4\xde\xe3\x04Z6\xf8\xac\x81\xf4\x019?\ag\xb7\x11\xeb\x0f\xba\x1d\xa7e\xbbN\xa7\xdb,\xacM\u0113\a\xad\"\x9f\xaf\xfd\xe4y\xbc\xe4B<\xf0@\xbe\xf4t\xb8bO\x9b\xf6\xcc?\xfd\xed\x1f\xe6\xf0\xafs:\x9e\u07fb\xb4\xeb\xee\xc9\xe4\xe9u@\xd7\xec\xe9\xcf\xff`\xdbS\xef\xd3\xcc?]\xc9\xdc\xd1~7\xd3?\ty\x1a\x1cx\xbb\x95\x8e\xf4h\xc2bC\xc9*\x03_\x8a\xd3\xc4\xeb\xf6Q\x1a\xbe\x90C\x91\xb4\xfe\xba\x9d\xf14\xb33\xd2l.\xec\x88Y\x9f\x10U\xcd\xdcy\u067f\xfat\xeb')\x7f\x11ev&\x1f\x01\x1dB\xdd\x01\xf1\x0e\xd2]BAud\xbaE\xa7\xd6\x15W\xbb\x87\x80\xd6<\x80\xb3\x15\x98\xa9\xc2Tn\xd8\xd3Y\xf3\xec\u071a?\xa5\xd7\xfa\xe75\xbdc\xf7V\xd3\U000ac9bf\xd9N,j\x9d\xc1\xef0\x83\x9f\xe7\xf0\xf3\x1a~>\xb1\x9exV\xf3\xd7<\xce&\x96!5\xb92\x06q7\xe3\xf3bx\xb7F\u038dX\x134vT\xa3\xba\xa6W0\x1d\x18\xd7EI\x10\xf3\xc9D1\xadVv\xae\xa3%\x9dv\xa6\x99gm\xfd\u013fN\xfc\xed\xfa2\xderAS\u0332\xb9$\x02\xc4\xcf\xe0z\r\xeakA\x14\x06\x11\x97%Z\xae*\xe3\x14\t@\xbb\xf0\xa8\x88Df4.\fPe\u044e\xd1~\xab#{\x98\xe2H[\x1d\xe2e\xfb\x8b\xf6U\x18/>\xc2\xfap\x1c\u05e1z\x8cuV\x149\xff\x10Y{Z\xaa\x84\xfd\xd5\xc6q\xb6\u039e\x1a5\xad\u05a5@\xb6\xf4\x02\xfd\x86\xd6VA\xa9\xb2h}j\x9dm\x13~~\x06e\u03ed\u05ad\x8dYr\xe2\xa4e\x9d=\u015c\xb3\xa7\u06e4h\u0733>W\x1e\x87\xbf\xe2\u0442\u05c4\xf8\x90\xe42Mi\x8e\x9bC\x17\u03320\xa4W\xe8G\xd7o\x12\xbe\n>\u16e8\r\xeb\xbdQ\x02[\xdbg*E*z>\xfd\x90\xb6\x9e^\x13\xa5\xed\u0670\b\r\uce1d\xe4!OE\xef\x97\xc2\xd3\x11\xf5\xc1\xeaS\xbf1jJ\u0320\xc4\xdc\x18\xe3d\xc1\x9e\bo\x8f\xccz\xd2\u06b6n\xed\x8d\x1d\xda)!`\r\xf1d\xaf\xd6^/\x9e\xd5Z\xb4\xacs\xabeGh\xfc\x18\n 3>\x94\x16u\x91Bg\xb9Z9\\\u0085\x9f\xd9>!\xbb\u076d\xad3\u0231=\xd0\v,'\x00\xeey/\xd0[W\x10]W`\xac\x04-k\xab\x85{\xb6~\xc9ox\bc6+>\bg\aU%\x9c\xae\x93#\xea\vv\xd4\xfe\xb4\xce6\xe1\xeb<\x9bZg\xeb\xa4\xf1\x14P\xc6\xd9:9\xb7\x881\x8f\xab<\fyv\x19\x06iv\xf4t\xe4\xa1>\x15F\xe9\a\x87\x9b\x87\xa5\xd5\xc2\nA\xc67G\xfb\b\x83s\xab\\\xb2\u04be\xd1x\x18\xa8\xf1\xc4\u0252'|Y7|\u3680\x85S\xaf\xa4\xb38D@\xc1\x9a\xe7\xee\xf4\t<\x9e\x93\x8c\xa1\xe9\x03&\xb6\x10\xa1Z\xa4X\xe3R/\x0fN;.O\xbb\x8c\x10\x1f\xc0\a\x02kY\xb0A\xdbs\xab\\\xb5\xaeC1\xaf\x13\xdbD\xaa\ax\xf5T\xe3\xd5\x13\xf9\xa5\x00[k/V\xfa~\xba\x05\u0c23\xa9\xc25\x96\u073d\xe8\xe3\xe1\f\f\xa9\xa4h&\v\xf9\xf4I\x03\xff\xc2r\u00a9-r\xc4\xd9\xf5,\x8b\u0180l\x82\xe8\xe3{?\xb9\xe6\x19\xd4\xc0\x1fb\a\x8a\fY\\\x0e\xf5\u0259\xdfX'|%\x1a\x16'!\xe1+l\xb4\x95\xb4bu\x96p\xa8\xc7!\xc7\x17\xa5\x82\x8d\x7fm\x14(M\xe6I#M\x16F7i\xb2\xc0^h\xfce\xf3\xd4\x00\x17l\xae\xadV\u04b2\x9f4\xfc\x10g)J\xfba6\x15\x88M}\x82ok\x80\xbb'`\xf6\x15\xb7J\a\xb8\xf1\u05120\x89\x13@\u0366\xa3g\ts\x15\xf0\x8a\xa2\u01d7\xa4\\\x18p\xd1\xf1v!\xb7\\\xf4\x81vK\x85\xaf\xe2\xe5\xdd\xf1v!\xb7\\\xf4\x81vK\x85\x93\xe3\x8d&\xe7\xaa\xc8\x03\x8d%\xc5t\x1e\x89?\xb25\xe2\x0f?\f\xae#\xc4\x1ew\x00\x06\xf0\xcc;\xc54\x0f\xc0\x18\x7f\x19\x88Du\xf1\xd0r\xc9B\xcb\u01cec\xf9\xd5\xe3xp\u06d6\xa2P\x9a%\xf1\xc1eV\x94\x13\u0665\xa2\xc7\xdb4\v?p\x05\xf0\x8d.r\xbc-Uh\xc9\u00e3\r-yX\x14:\u0794.\x16D\xe9\u0476\x82(-\n\x1doK\x17\x03\x89\xe0\xd1\xc6 \xd3(v\xbc\xb9\xa2`\x9a_\x1d\xb9l\xd2\xfc\xaa\x9e\n\xc4\fQw{\xb4\xee\xf6X]y\xff\xac\xfdd\x89\x1c\u0163\xee'L\xf2\xe2\n\u020b\x0f\x11\xdc!W\xfaH\xa5\xf1*\xfbL\x13\x98\x9dN\x1fn\u02f3\xd4\x19\x05\xf6a\xed%Z\x99\tN \u06c4Hf?p\xed\xca\xf2\xaax\xe6_?\xae\xb0\x7fu\x95\x1c\xbf\u049f\x9cA\xfe\x83\x17\xc4\xf9\x13\xdd\xcc\x03\x97\xd5\u0555\xc4a\xab8\xce\"x\x8a\xc0\rX\x87\x19^\xe5\x9b+\x9e\x88^\x82e\xcb5\f\xb5\bM\x98\xb5\x8a\x12\xbe\xb2Z\x85+f(\x98\xe6W/\x96\xe0&\xc2NZ\xcc\xf2\xacV\x91J\xe8\x13\x80\x11E\x84\xab\xfeO\xa1\x95s}\x15\xff\xc3*z\xd2\x02\xf4\xd2\b\x96\f\xef\xe1'\xd6\xf9\f\x92\xac9\u0735\x068\xe9\x19\xe0\x96\xd4\x13\x13\x87t\xeb\x13\xa0[+cHOS\xbe\xb5\x10D\x9ex\xc7\v@6i=9K\xa5\x9dz\xb5\x10\x148\x8b\xc3\xc3\xca@\xe4a\xed\x9aa\x1f\u07eb\x18H\u4ce7\xb27u\x02t\xf5\a`%\fp\xf5`)\x1f\xdaEX\xe5\x83\xed\x00:Y\u0092\xee\xea1d\xb3.\xecG\x8bu\x9c\u0502\x94\x82\x99\x87\x06u\x04\x9c\xa2\x1apj\x14P#a\xa6:\x19\x88\x8f\x88\xf0\xf5\x1f\xff\xe7\xff\r\xa0\a\xa7\xb5|\x00\xcb\xebW\xc9\xf2\xf8\xd3e\x99\u025b\xe08U\xb3\x947\xde\xf2\xc1\xbb\xc2\xec\xec\x81b\x99.\xf6\xd0%\xbb\x14\x84\x11\xb2x/\xd9\x05F\xc2;@\x94\xfa@dL0^\xc8Y\x11\x9c\u06aa\xbc\x93J\xcc\x16$\xe6\xb1\x03C\x0fTJ\xaa\xf1\xf9\xcdR\u0402\xbf\x90JO\xba\xf7b \xa6\xfa\xa1\xacxy\x89U//\xc1\xbeN\xa6,\xfc\u015a_^\n+\xb2\xbaH\xf8\xb2.P\xd4\"\xec`\x9bG7L\x84\rj\xc7(\xcbNY$>\x91\xbd\x96h\xb1~\xfc\x91G)\x8be^\x9c*%\x82m\x9c\xfe\xe8\x7fb\xaa]ms\xb2F\a\xd87<\xd4\x05\xa5\x9fR\xcb*}\xbf4\xcb\xe0\x04\xf4\x84\x82\xf4E\xf4\u04bf\xe2\xa1\xd6F\x80\xe7D\xa9\x02$<\x17\x17\x81n8\x84*?E\x1b\x90W\xf0\xe5\xbbE\xbc\xe5)s*\xe1\xf6\xe5\xb1\x12Z1B\u03cb\u02f9\xd0T\xfc\x04\x96L1\x06\x11\xf0^\x7fJ\x06\u02a9+\xd2\xeb\xfa$\x85\x87\xb0\x9a\xdc\xd3Sz*\xacZ\xb13\x96\xb5\\\xca\xcd);\xe0\x84~\x82\x99g\xc1\x04\x19?c\xb0*\xb2c\xc6q\xa5\rv*\x96\"\x84D\xad\x96\xd6\xc5\x1fwA\xf9R0\xf1P\xf2\x9b\x80F\x84\x90\xcar\xb9\xbb\xed\xf4c\xb0}\x0f[\v\xeca9\xdedj\xfbr\x05\xf8\x91\xc5$\u0791\x9c\xe8\u0525b>ii6\xb9\xa9\xf5\xfe\xbab\xb7!4\xa0iNq\x91{\x1d\x11\xe9\xc7d\x16\x97V{\xecV\v\xb8\xe5\x02\xa7\"\x10\xaef\xf4\xce=\xab\\\xc0\xf6\xd9s;\x16VQ\xfap\xcc\u613a\x84\x9c9\xbb]\x7fT\xed\xc1o\x99}\xc0\xae\xa5,V\xf0\x120\xbf\u0559\x04gi\xb3\u90b9m\\\u075e\x80L\x82VK\xa3g\x80+\xc9\u0256\xbe\xea\x99\x1d\xea4\xbf\u0561\x01igI\x00\x06\x86\u0284\xec\xd4\xf5\xec\x04)\x14~\x13\b\x0fP\xbb]5\x05\xbd\xc8\x16N\xff\u02993\xb8\x03\xf29\x06\xa5\xa9\xcb`!\xa1AU\xd1RH'6\xe8W!\xb9\xbb\u03d8\x88.\xfc\xd3\xdb\x17 \xa3+b\x89+P\x8f\x8c\xdcj\xf8}\xf3\xc0e\xc6y\x83\x1d\x19\b\xe3\xd5\u02baeD\x88x\xb3Vk\x92\xa9C\xe0\xe2zE\xb5\xa5\vsqh\xb3\xc3\x18\xd3Z\xa11\xfb\x05\xde\xf6XI-\xb4\x8bq\xee\xe9\u0249>\x0f\u0289\xc8\xcb \xfah\xc7\x18!\xdb8\x9d&\xb6\x89Q\xebd\xdc\x11\x96\xd7Y\xcb=\v\xa6Y\x8bu\xbc\xac\xd5\u06aba\xa0RN\xc2\x1c\x18}\xb3\xd9\xed\x9c\x1c\x1d9H\x8d\xa2\xb3ng\xb7s;C\x1c7*\x96\x96\xda'\xd0\xfeD)\x9f\xf4\xa4\xd1w\xab\x95\x9c\xbb\x04\xcf5\x1e\x1fW$\x9f\x9e&g\x8eL.\x86\xd4\xf0e\x94\xac\x98U\x16\x83f\x9f_\x89\xf2\xfc\xe5\u0288\x98jz\xb3\u07d76\x9be\xc0\xa7Q\a\u016f\x9b:\f\x1a\xe4o\xa0\x90\xd8\x1d\x8b\xbf=\xc7\bP'\x8d\xff\xb3V\x8b\xf6\n\xc5\u015eK&\xd9Y,`\xe2\u0222\xb2\xa2\x91\u01b1},/C\"`\x82\x9e8\xa5\xad\x8d\xeb\xb6V\xcd\xf8UI\xbc$\u03ad\x16\xde\b\xbe<\x05\x86|;\x8b\x7f\xdan\x95X\xab\xa8\xff\xe6(:\xa4\v\xba\xa5\xabz\x9c\xe7\xfc\xbdp\x9e\xf3\xb7\xe2<\xbb\v+e\xe7ux\x8f\xecvx^s\x89\x01Ai\xea\x85\x1d\u04e0\xd2\xf2\x82\xc5\xe6\xae\u0410\x05\xa2/\x1a\xb4\x98\xfb\xe5\xfd@\x9bX)\x14\x91\xcc\xdfc\x9fS{[\xe9G\xf6B<{\v\xf4C\xc0B\":\u00fe\xea\x109\tZ-\xads\xf4\x10\xcaG\x94\xbdb\xa0U.\u0153\xd4/\xf9dm'|\xc5\x13\x1e-8\xc4UD\xbc\\Ja\xf7\xf8@\xf5\xb6\x14\buo\xb1G\xfcl\xa8\x05\xb7\x13\x1e-y\xf2\x02\x99\xcc\a\xcf7mH\xcd\nB\x13\x0f\xa3\x0e!\xe4\u0400Y\xd6$>=\x85Y\xb1d\x06\xaa\xf4H\xb1\x82\xf4\xc7o\xb5\x10J\xa0\xb6\xbe\xba\x82==\x1c\xc0Wt\x8d\x04\x97eMZ-\x1f\xcep\x89Q.\xc70\rZ\xb2\xba1K\x1b\xb3\x17\xeb \\&<\xc2\u07bc\x9a\xa1\xd7\x0e\xbc\x14\x17\xf7\x12\xfc\x81]^\xb2\a\xb4\x894\x85\xad\x86\x1d1\xd0'\xc2\xd5Z\xd9\xe5\"\xb3V+\x9a\xa3\"\xba\xa9\xe0\xa4\xde\xf7\xa7n\xb5w\xd0,\nBn\x0e\xa0\x12\x18ufY\xf3\t7\x06q\xa8\x92\x81\xc1Bx\x04\f\xe3%\xa8\xd3\xfbaV_*3\\N\x9c9h$+\xfcX\xc88\x98\xdcx;@t\x94z5\x13\xa3\x14X\xe2\xce\xe6\xf4\xe1\xe1E\xe4>*\x86g\xa3\b\xd0\x0f\x8b\xb1d\x88w\u02ad\x8a\x81E\xedU\xa4\xe3t\x96V\xce\xcf\x1e\x8c\xd8-w\xd5\xe6\x04\xc4\x1ah\xb5\xa2pcr\xa8\xaa\xf9\x06o\xba\x06\xcc\x00\x8dt\xd0}\x9c\u05f0Z@\ud5377\x99\xb7W\x91z\v\x95\x92A\x88\x10\u00ff`6\\\xfb\x10+M\xe0\x8a\xaf\xe2\xe4P\x12\\\xd2\xcb4\xa7\u1ce44\x8d\xf8o\x99\x86r6\x12S\x87\xde\x03\xb0z\x19\x95\x1b\x04&\u06ab\u020b\xa8\x1ff\x9e\xaf\xe6\xb3'\x9f\x9f\x11*\xea\xfd\xef=\xa1\x96\xfb\xf7\x9c\x12\x00\xe1\x118\x13@U\x19\x05\x02\xad\xe8\x9dWz\u03f0\xf7\xe4Kz\x17\rTx\x0f\x9c\x95\xbd8\x83\x86\x887\xe3s\x9a5\x9b\x95\xd1<\x8c(\x18\x868\xa2\xbc\xbeX\t\xfd\xe9M\x83\xcd\xca\u039c\u00ddz\v]66~\xe4_\xf3\xc4k\x04\x91\xf4\u06c9;\xe7ox\xedY\xca\xe6\xc5X\x1c\xb9 \x8fX\x96e\x90V\u0585\xdc\xdb\u01d6\x85\xfc\xf7\x98\x9e\xfb\xf8\xe9]\xf3\f\xbb\xab3_\x92\xceS\xcam\xe8}\xd7w\x89]\xedf\xc6\xe7\bt\xf4Y\x05\xb8\xdfH^L\xd5nBpy$<K\xcbrT\x9b\x96\xccv\xcf\xe4\xdcPd\xefx\a\xcc\x1c\x05\xe3\x05\xc3\xe7p\x00\xec V\xbe,-ge\fR\xcd\xca\x1c\x1b'5<\xa4\x82\xe5T\xee\x0f\x17\xe3\x1d\xaf\x8a**Z\xf3XJ\xbb4:c\xd2?J\x91\x85\x1d;dR$\xcd\xf8\x9ce5}}\xcf\xeb\xbd\n\x9f\x1dt4-5\xe69\x82\x05\xf9\x96Y\x8d\x0f\x91Mf\xf3'\x1f\xac6=\x99\x9e\x1a\x8e9~4[,\x9e\x19\xf6\xec\xd4&\x1ff\x1f\xe6\xf7\xfb\xd6\xf4\xf7\xed\xdf}\xf8yG\xbd\x7f8;\xf9\xf0\x01\x94.\xa9\xf5\xe1\x03jPB\xf3\u07f2\xa7\x1fZ\xa7\xbb\x0f\xed\x0f\xed\u0747\xa9\xf8\xdf\xee\xe4\xe4\xe4dG\xe9\xee\xf4\xf4)}\u025e~\xb0\xed\xc5.\xdb\xec\x92\u0756| \xa0\x18\xf8\r\xbb_x\xd6\xff\xf8\xdf,\x9ax\xd6\xff\xf8\xffZt\xebY\xff\xe3\xffc\xd1l\xe3Y\xff\xf1\x7f\xfa\x7fI\xee\xe9w\xec\xe9\xec\t\xa8\xcd\xfd&\x7f\\\u04df\xd8\xd3\xd9\xe9\x87T\x8cn\xfe\xb4\x98\u026f%\rY8\x81\xbb\x9d\xa1\xc3\x06O\u27e4:\x1c:4\xd4\x15\xffXu\f\"=\u07c1\x0f*\u048aZ\xfa;k\xb9b\xca\x7fb\xb3\x99\x852\x03\x8b\x96\xb1\xa2 <\xd1\r:\xaf\x03\x7f\x91_\x1c\x00\xf1X,^w\x91~\xdc\xe1\xbbO\x9e\t\x87B\xad\u051b9\u051dSE\xaez\x80\xff=.\xb4\xcd\xc4;[<>)W\xccW\u0281#K\xd5P\xc8~Ng\x16pk\xca\xe36\"\x8e\xe2\xa3Z\x94\xc6\u05d59%\xb4\xb9\u0298K#\xe6\x1b\x1a\xf1g\x11z\xa1\x04\x85\xe1C\x15A\xdfTT1\xe8r\xbfP\x1d\xac\xd3\xfd\xf3\v\xbdAq\xb4\x12QC\xae\xdb$\u046c\xf2\x13\u06ce\xd9ka7\x8e\xadWg\x0f\xefQ2!\tK\xe4\xc3)V\xab;1\x9bd\tU\x8d\xeev\xb6\x1a6(\u03e0\x1f39\x1e\xf9\x8d1\xfdfV\xf1\xbez\xecz\u220cw\x19\xab&\x00=@\xbfp\xd5?\xb7\xaa\xf5{\xf1\xb7\xaf\xfa\x9b\xff}V]\x9d\xa1#+^\xac7\xcc@j\x11\x17\x988ADl\xac\x99\x9d\xb1\x18\\\xcd\xc9uQ\x13\x92\a*S#=8T\x99~7\x8ac\xa5\xa5n\x99\x1f\x84\x0f\xc0C\xc1\x8da\x0e\x84\x80r\xe9\x8a\t\x02V\xc0\x82j'E\x1e\x99\x98\x8a\x9e\xd3\x03>\xb2-S\x8c,\xa0I\xef\xbc2\xfe\xc1\x88S\xe8B1d\x92\xe5\x0eQ\xf7\xbd\xba\xba\x85\u0a68\xec\xd2\x15\xb2w\xc39\u02f1\xa2\xbdm6s}\x89\x9el\xe5\xfb\xaf4\x0fT\xfa\x93\xac\u05c0\xd5e\xd2Z\\Y#T\xb6$B\\\xb4Z@\x160\x10j\x04jo\u0551\xb8\x17R\x90\x87Z\x14m\x05K/+5\x18\xe0\xf1\xc1zS\xdbN\xd9lNJ\xf5+G\x8a\"`z'n\xa9\x91\x87\x11\xbe\xa5\xa7P\xe0q\xa3\u06c3\xfa\xd5SZ\xed\xf3\xf4t\x01NR\xb0\t\xdc\xcff\xd3N\x99\u0625\"qN\xe8!(I\xbd\xd8\x14\x1eQ\xb5\xfa\u05f2\xe0L\xd7\xd0\xc1g\x04D\x14k\x8cah\u04247f\x818\xdey\x94\x9d;\xd3\xe2\u00c3\b\xc8\xce$9\x8b'I\xabE\x1e\xde\x1f!\xb8\x96;\x842f/Q\xeb\xb6'\x13\xbf\xd9,\xd7\xf7\xc9g@H.\x9e\xb1f\xfb\xc7\xc0\\M5}\x85v\x1eu\u0181\xe3J\xd75w@IfB\x04\u022a\xf4\xb7\xfc\xfa\xe2\xd3v\xb7\xb3W\u0332\x7f\xde\u036c\xd6[\xc3\x01/\xb8\xa5\xfbQif\x83\xde\u05dc\xd8V\xab\xe4\xfd\xba\xa6\x87\xe3\xde\xec\x14y\xe3\xc2\xe1m\xa7q\x92\x1d\xf1)XDE\x96?\xf6D4\xabG\xb3\x83\xe1\x10\xfbw\x8f\x18\xb2E\xab\xf3Ef\xb2\xf8i\xaf\xa8um\x11B\x17\xacZ\x8a\n\x8c\xbe\xaeb\xf4\xf2E\xb8\x9eE\xf2\x1e\x13\xb7\xa7\x1d\x8b$\x8d\xb2+\x16}\xd9\xe9)\xb6\x90I\x137\xdbg1P\x89\xc6]\x98#\x83\xd3\xd7\xf7\xc1\xa2\x1d\xfai\xf6\x02\xb8O\u0321!^\xce7<\xa4\x806&[\xb6h\x83\xff+d\xf1\x1a%\xcf\xf3f\xf3\xa1\aQ o\u02dcn\x05c\xab\xb5\x05\v\x16I\xc2J@\f\x0f\xb0\x84\xd6X\x02\xfc\x00L\xde\x1a\b@\x94\xb0\x9du\xe6s\xd5L\r\xba*\x8f\x06J?\xdcg\xe5\x80@\xa9\x9c\x19\xf3=\xdd\u03baj\xf8\x93T\x93\x0fv~\x16\xe8\x8fG\xad\x879\xf7\xd2N\xb2\x18,\xdd$.+B\xa0g\x84\xa6T}\x02\xe1N4\u0346\xb46\xda\xc2=x\x82\xc5Q\x95w>\xc0A\x8c\xf8\x91'\bR\x05YW\xb1\x87\xf7OO+\xb0\xa8\xf1\xa8_\x05I3\xe7!\xc8,\xc022\xc0R\x04a\xd3\xf0\x980;`\t)d66\x1a$\x81;\r\xf5\xbexI\xeb\x0f\xf67\xb3\xacl$\x05&y\xf4[\xf1ZJ\bv\x94\x18\xaf\x94\xd6)<S\xfe\xc7\xff\xcf2\x05S\xed\xfb\x0e\xddC\xfa\x7f\xfc\xfb\xff\xdb\u0330g\u04d39\xf9\x8f\x7f\xff\x7fC\xde\xef\xdcv\xfb0\xf3\xbe'j\xfe\u0385\xff\x99\xf9T5JK\xb5~\xde\xcd~>\x9d\x93\xd3\xd3S\x1b~\xec~G\x9e^o\xa0\xfe\x7f\xfc\xfb\xff\xf5w\x9dJ\xd1\x0f)9=\xb5?\xa4f\xa9\xff\xcbA\xa9\xd9\u03e7\x1f\xd29\x11-~H\xe7\a\xa5\t\x8d\n\xaaU\x90|\xe9\xc6O2\xb4\x0eJ\xbf\xe02\xa0K\xba\xa17\xf4\xfaa\x00\xbb\x06*mS\x03e\x1b\x80\x8a\xcdq(\xdb\x18PvSJ\xd6r\x8ek\xd9\x1a\xa8\xbd\xa04\xf9\xc6\f'P\u0083\x11\xbb1\x00\xee\xe4;\x01\x13\x11*kJ\x9a.\xc5\"\x12\xfb\xad\xd9u1\xd45Z=\x9e\xd8\u05f3\xb5\xcc?c)\x99\xacOO\xc9D\x8fa\xddr\xa5\xf0\xc8\x04gu\f&\u0703>&>H\xbc\xed\xdf\f\x9c\xeb\u04d8\xfd&\x90lB\x84x;g'\xbf\xda\t\x8d\xc5\x198u\x89\xf0p\n\x1f-\x17\x9c\x10>\x819\xc53gN\xedP\x94E\xab \xe1\xb3a\xcbNB\xbab'9.]\xcdD\xec\x05\x83\x99\xd0\u0484R\"&\x14\xac\xecE;\r\xa2\xeb\x10d6\xcbf\xb3(\xc3\x18K\u027d\xac\xbc\x9c\xda7\xb3\x85\u0615\xe2\x19\xf4\u01daD\xba\x90J3\nD\x04\xa4\xcd:s\x13\x14\xffh\xeb\xdfj\xe2\x87U\xbas\x88w\xfa7\xf6\xeb|y\xbf\ue710\x02\xda\xd6\x13\u5ca0\xc1\xf7\xdb\xe9\xb5\xc2\xfb\u043b\x97\xd1m\x9cz\xaa%\xb1\x90\xdeRb\xfdtO\xbcU\xb3)\u072c=4\x02\xeb?\xfe\xfd\xff\x0e\xf1\x18Qk\xe9\xf1\xc5\xf7\xf3y\xc1H\xfaA\xb1/\xe5dP\x86\xa5\x04\x8f\"\b\xf2\xc7I\xe1\x1f\u0659\xf0\xb3?ihUL>,+&\xf8\xa7\x19\x9f\x03\xc8\xe1_\xd7\xe4<\xfd\xee\xd0r\xcf\xc0\x15\xd8GU\xc1\xae\xacFW\u04713\xb4\xef\xca\nvW\x10\xc5/\u055ap\xbc\xfc\x99\xbd[\a\xabL\x7f^\x85\x1f\xe1\x84E\x99\xa1\x15\xc7\xcd\u07e0\xa0'?\xe5\xd3\xdc\u0543\xe3Q\xf6\x1e\x1d\u03e0\x9b\x17\x91\xbc\\\xca\xf6N\xdd\x1aE>\xe1n\x00\xc4\xe5\xfaA\x9c\xb2\x9c-\x90\xa8\xb2\x03\xad\n\xa81B~\x16Nrx\xe7\x05+\xdbg\x81)&\xcf\xf1\r\n\x19(p\xf7\xc9\xfd\xa2\xd5\xd2P\xb7\x87\xe7\xef\x1e\x05\xec~\xb3\x99\x9f0\x16\x9e\xba\xbb\x9d\xadS@D],\x97\u063d\x94\x98k&\xd2rb.\x9cH[\x101v\x18w\xcar`\x15\x1e\xb4\x15hB\xee\xa0\xc9J\x96\u0672C\xcaKo6\xab0T\x01S\xff\\\u044e\x9ah7\x06\\V\x9ae\xf3\x16\x97=\x00n?\x17\xa4\bW\x99d\xb7\xeb\x1dS-\x82\xa7\x1bi6{}\xe19\xb5\u05c5\xbf\xbb]r\x16K}\xa4\xc3\x1a\xa8\xb9`\xf8\x90\xffCi\x80\xc7FEKC\x82\xab2i\xb9\xe7,.+\xa1\x1c\x1b\xe1Yo\xb4\xdbE\xe7\xfdaY-d\"\xd4{\x92j;'\xc7[:g\xbdQ\xb3\x19\x81'\x00B\xee\xb5B\xd4n\xd7\x1b\xc0_\xa9\x11\xa5\xe5\xf2Z\xe9\xf1\xf3\v\xf2\x83)\xc3H\xe2\x05Ok\\\x88\x01r\x10x\x00y\x1d\xb6\xa1\x02\x91h\x19\x0f<\xa9H\x95\v\x92\u03329\x88\xe4\xe9\xef\x8c~\x82\xf4b\xb3\xcd\xee\xd81_\xb3r+\xf8\xbce@\xe1\x8c\xcf\u03d9\x01\xb0`m^j\x15\xb4>\xb1\u0757AT\x918Ut $\bO\xf8Y\x06t\xc1\x83}\x9e\x95\xbb$\x88[\xb5J\xfa\xe1\b\xdem\xfd\xc5\u00fd\x17\xfa\xc4b\x00\x88#t\x8e\xb1E\xfc\xb3\x9d\x81\x1b\x84\xf4a\tP\xa9\xb7\xa8\xd9\u0509\xa5\x8e`\x04\x8f\xea\xec\x1b\x7f\xf1\xb1.B\x18?+\x94\x12\xb9\x80r~\x1e\t\x9d\x92\x93\xfa\u975e\xea\xe8\x81\r\xder\x8f\xf4|\u0373\xea~\x1e\xd1+c\xe8\xf4\x92\x9f3\xe5\x05\u04f2 !l\xc1QQi\u0291\x98\xdc\xeep\xde\xc2\xd0C\x9b \xb2\xcd}\x0f\xe74\"4`\xc9\xd4\xdc\xfep\xder\xbdr\x02\xd53S\x1a\x87\x01\x91ZeEx\x82\xec\x14U7\x9cIx\x96M\xc2V\x8b\u01ad\x16\xb1sV\ue45c\x83\x7f\u071cE\x84\xe6\xa0\xcf\x02O} \x1d+\xe3\xcdA\x9f\xab\xe5\x9ee\xbb\xdd\xe7G\x97\xce\xe29\xab\x1b\xa2\xf6\u03e6\x98!B\xd2\xf5=\xf0\x80g\x96\x9fd\xc1\"\xe4\x16\xb5\xfc4X\xc2\u07eb<\xcb\xe2\b~hW\x04\xf0\x11/\xef,j-\xfc\xe8\xc6O\xf1\aR\x02\xf0+\x0e\u017f\xd7I\x9co-j-\x97\xf0Op\x03\xffB\xd62\xb3\xa8\xc57W\xe0\x89\xdbZ\x81\x97\xa0\x94g\xf8\xf3\xbah\x05}\xedCG\xc0\x10\xe3\t\xfeH6\x16\xb5\xd6.\xfc\u04c1\x7f\xba\xf0O\x0f\xfe\xe9\xc3?\x03\xf8\a#o\xc3\x0f\xd5\xfb\x1a\xbe\x82U\xe2o\xa0\xb50\xb0\xa8\xb5\xf1!C\xba\x8a\xa1\x16\x8e7\u03b3m\x0e_\x90\xb5\u015e\xb7I|\x9d\xf0\x14f'\xa2x\xc1\x0fa\x80\x03\xbf\xc0 \u04a2V&B[Y\x99\\\x91\ff\xa5\xbd\xf2P+[!\x1dbek\xf8'\xc1_\u0707B9\xf4\v~\x89\xe3Z\xc7\u071c\xdc\x7f\x0f\x12Y\f}\x8e[\xf4\xaf\xec\xe9\xcfg\xe8\xeb\xe4\xd9\u9fc2\xf3\x12\xb7\xbf'\xb3\x0f\u91e7\xe7\xf3\xa7\xf4/\x90\xfb\xe1iM\xfe\xb9)\xa5\xfc\x97\x92\xc5g\xe9\xee\xd3t\x17\u078a\xc5\xddW\xf0\u9485\xe2\xd5E49\x8d\fJ\xf2\u03d5[\xff\xe8\xb5\xea\xd75\x1d\x9fC\x1ch\xb73\x80[?9\xbc\xb2bq\xeb\xa3Ng\xb2\xdb\xc5ZcZ\xa3];\x86wTt\xce|\xb8\xdc\"\x94\x92r\x0ebR\xf4\xe0@k\xb5_h,\x9e\xc0zt\xa7\xc6\"\x9c\xf5\xca\xfa\xba1K@\xddv\x92\x9c\t\xe4\xa6/3x\xfd%\xd2P\x01\xbd\xe8\x15-&\xa5\x16\xcfY\x8f\xc8\xfb:f\xadVR\x04\xafF\x027\xa9g!\x8b\xf1\x17\xf2Z\x85\x161\xdav\xaf\xb4m'\x0e\xa1xJAYGJl3\xa1\x1f\xcc\x15\x1f\x8dK\x95\x02\xd0\t\x16b\xa3\x03\xf9\xe11,\x8b\xc4\xe5\xb1}\xddV\u0265E\xab{\xbe-)\xb0\xcb\xfd\xad\xb1\xf7X\xc0\xee\x8e!7.\xd5\xc8\u0642\xda>\xb3\x17\u0338\x88\xec\x05\x8d\t9\xcd\xc9Y\xb7T\xd8\x0ed\xc3\x02\xd9-\u8db0:\xd0<\xa9\xbfZ\xe4\x9c9\xa5z\x89\xfar$\xfa\xce&'v\xab\x95\x9e3\xf4\xd8i\xdb\v\x96\x17\xd3N\x8di\xa7srf\x1b\x13O!\x10\xbd\x91{fl\x0e\x91@s0sa\xd5b\x00MZ\x05\x9a\u076e~\x01\xce|#G\x1e\x83\x05!g[B\xee\xc3\xc28F_\x7f\xe6vI\x98K[v8u=\xe7\x98\xf8\x02`\u00e2\xc23\x8b\x17\xd4Ba\u02e5)\xf5\x11\xf6\x1e\x03rtV\x88z\xaa\x97\n\xdaF\xce\x01(\xcd\xe4\xcf\x02\xa6\xc9\xd7:\n\x9f7U\xf8\u071c\xdfT\xcc+\xea\x88\xe5\r`\x1e\xb3\x98\x9c\f\xe8u(^\xc3\xc6\xff\xf4\x8a\xa7Y\x10]?\x04WH\xec\x1d\xb6O\x9a\xcdM\xab\x85\xb6Y\xc5A\xe6\xa57p\xcaf\u01ac\xe6\xd4\xf8`\x1b\xea3{\xc36g7\xd3\x12\x1cl\x88\xb7!\xe7\xec\x86\x06lf,\u00dc\x1a\x1flsj4\x85'X\x1aKT\xc9{c;\x80\x9c\x014\x18\x03Iyb\xdb\u018a\xc7\u018a\xc7\xf8\xac3\x16=\x9eC\x104\xc1\xdd{`\xa9\xf1M-\x91$\xac\xdaR\u0227\x1d\xbaf[E\u036e\xce\u0593\x95hi;[\x81\x82tL#4\u06f8_\x16`\x1f\xac\xec\xa5lH\x02\xb51PB\x03\x95V\f\x98\x1a\x1f\xec\xd4\xedv\x87\x82\x8d\xf3\xf0\xce\xd55n|<b\x87>3\x12c\x97b\xe1\xcf9d\xdc`uP^\xe2{\x98G\xa7\xf6PW\xfcUY\xf2\xd8.\xd8,\xa3N\xe5\u0322\x84F\x83\x056\x16\xfc\xc6\xf1$\xc6\xe4\xb3\xcdW\x843\x05\x1e(\x8d8\xa4\x8b\x99;g\x02g\xc0^OVZ8\x83\xfb\xac\xa7\xbfjes\x06\xa6\x04\xc5\xfa`R0[\x19TJqt\xf2\xc7#\x9cu\xf2\x19Dc\"\x16\x9a\x17p-\x90\x89\x9d\xb6X\x89\xa5\x91\x97PA\xafs\xe4\xe2K\rvF\xdcl\x8e\xfb\xe5+P\bz\xdcIz\x96K\u00e0\xe0H\x1b\xa2>>\xf8\x03\xa3kq\xb9\xf8\x85\x9d\x8f\ud7e1;\xc1d\xb7\xb3\xe5\x1d l\x88jv\x12\xd6\xe4s(\x1dC\xf8>r\x8d\xf1\u05d7\xa1s\x10S\xd0;zEo\xe9\x05\xfdD/\xe9;\xfa\x11\x8e8,\u0156\tN\x0e^\xe8\x1bH-(0{\xcb\xfe\xb9\xc8,\x16d\x03:\xac_\x84\u0157\x87\v\xbe\x05\xc6~\x05\xbb_W\x05%t3\xb5\x8fSKk\xedR\xc1$\xaa\x17t{\xba8u\u0251\x93u\xe0\x8d\u02a2\x98\xe4\xad\xe56]\x1d;\xc2\u012bm\xb0\xea\x9c\xcb\xfa\\;\x80\xfb\xe9\x1d\xa0\xe4\x8b\xe3\x97\x05n3\x99\x9c\xd8'v|\x16\x91\xdd\u03b6W\xe2*0\xb0\xdf\x16v\u01b8\x1c\xa6\xaews\xba%\xe7=\x8c\x9f\xea\x12\xba:s\xd5O\x9fmM\x14\xd8Z\xd5/O\xd9\t\x98\x9a\xcb\xed\u0479\xa4\xa5+\x17\x8e42\x93\u0452\xb3\u0629\x03\\\xab\xb3\xd8M\xe9\xfa4\x11\x8fOy\xa1DVF\xcf\xe2\x10\u0523T\xbc\xc4T\xd5f\xf3\xe4n\xb7\xb3?\xb2\x13\x97\xd0;\x89\x1eO\xb3s\xb7\xd9,\x9e\x1e2\xd5%\xa5\xee\xd3\xd20\x03=\x98\xbc\x8ax?\xb3\x8eG\xf1w\xcc2\x85\xafo\x01w\xc7H^\x15k\x11\x03\xed\xbc\xdb\x15\xe3\x8c\xf1K\xdfi\x15\xc2\x189W\xef\x00\xac\x80\xaf\x0f\x1e\v$\xf6\xfftv9\xf9$n\xfa\x8b\u0667\xd2M\xff\xaet\u04ff+\fF7\xe4\xbe\xc0\x0e1\xaa\x01\x8ab\u0290\\\xe2\x06#\v\xcf\xf9\t\xab?\xe9\xa4DE\u05ed\xd8fj\x1d\xbap\xb3\xca\xc7\xeb\xe8R^\x89\xf5\x93\xb88\xa6\x1f\x9b\xcd\x1aw\x19\xf2I-\x80\xb7#\x15\v\xb3V\a_\xeae\x01mGk\xa4\xa8\x1c\xd0VPr\xc0\xb8V1\xd3,)%\xfbE\x92v{)\x80\xd9(\xa9\x12\xa3\x16\xeb\x10\x88\aw\xfd\x19\x12\x7fn\xea)>\xe6\xc9y\f\x7f.\xaa\x17p\xd8\xea\x9d/J\x88[\x9a\x98Vv4,S\xf3\xe3^m\xa1\x96[.\xf6\xc8\xebB<\x1fa\x83\u04b3\xc5$\x95\"\xa2z\xf21\xad\f\xa4{\xa4P\t\xfaR\x10\x19\xb5:\xe8\xa67\x05q\xc4B\x1b\xbaVj\xb6Z)Q\xf7|\xdajUU\"\xf1\xf6/\xa5\xa0\xad}%\xad\x9d\xf0UMQL\xc6\xf2y\xe9\xc5\x1d\xb6:4=\xed\xd47\xa3\xac\xf2O\xdd\xcfh\xcaU\x94G)\xea\x0fzy\xeded\xe2\x9c\xf2\xfb68\x8e\xb6K\xf7PJN\xd3\u0483*51i\x8b\xf5*\xe8\xbb\x00^\xa3I\x13\x9d\x89\xa3\xa3rZ\xd5g\x9d\xea\xe7\xd4\xcc \x9f\xb9\x0f\x8c\x01\x04\xe6\xf0Nqx\xc5\xcc\xfc\xd2L\xe2G/\xf41\xe4D\x84-|\xe9<\vjY\xb8\x14\xfd\x02\x92\xb9tz\x0f\xc8\xe7\xf4\x9c\x95\t\xe6n\xff\x18\xc1\fl\xberqI$\u04f8\xfe\x14L\xd0[s\xdcl\xa6gy\xb3\u97f1\xc1\x84\x80-\xf0\xb1\xe2\x9aP>\x1f\xecvX\a\xa9\xeaX\x9d\xa6\x9cU\x84\"vN\xbb\x1d\x9a\x12j\auY}\x9a\x12r\xaeL\xb0\x0f\xfa\fN\xd1\xedv\xce\x02B?K\x90\x1b.`-*\\\xb5z\xfe\xe7\xd9.8\x8b/0\a\x11\xc79\xa5y\xc5\fD\x1d>\xf7\xb0\u02d2M\xc8\u00e3\x97\xd0V\f\xff\xcb^\x14\xe2)q\x04\x025c\x17 P\xb0k\xe5v\x06\x82\x9dgrg\x832\x1dR\xcd,q\xe1\xa4\x0fv\xdb\xc09\x81\x01\xd4\x01\xb2;\f\xdev0G\xf4k\xf7\xfa\xec\bK\x1b\x00y ,y\xd1\x7f\x85\xc9\xe0\x8biB\u826dS\v\x1e\xf7\x99\xafJ\xd7\x1f-\tB\xc1\x17\x81\x90\x18\xc6\xd4\xf5:\x9f\x87\xa5/\x06\xa3\x98\xeaE\xc9\xe6\x8f\x05\xa8S\xf7o\x00)s:\a\fH\xe9\xc0\x1e\xb1\x98r\xe1\xf7\xd5O\x7f\xa4P\xf4\xe2#&k\xb1\x90\x9e\x14d\x02\xf4Q\xc2l\xe1yw\xb7K[\x9d*\xc6\x1b8'\x9f\xa7\x12\xba]v\x8c\x8f\xe0\"<u\xd1\x02\xf9\xbe\xc4}\xd4\xe1}zC\xc1%894!\xedvv:\xe8jv\xce\xc6C\x8c\\\xe3v:{;.\x0f\xa17\xd4]\x9c\xd8>;@\x18\xe8?\xc9\xfe\x8b\xe1\xbbF\x13\xde\x0f\x94\xff\u05ca\xaf\x9b\x13X\x8d\xefg\xfe\x81\xf7\xfc\xf9\x11\x16\xeb^\x18w\xc0\x89\x0f\u03b8\x92\xf3\x83kb\xfd\x06\xa98\xbbP'\xe5\bd\x15\xb0Q\x82\xa1\x1a\xccW'\x99\t\xa8\x83h\xec1\xf4\xb0\x94 ~\x01_\x04\xa6\x9e\xb5:\xe7QEX\"/\x0e\x01\x8fy\x19\xbb\x1dHJ$H\xe7\x06\xfe\xc8\xe7\xe6\xa3<\x9fW\xa47\xbd#wr`\xb2\xb0\xfa\xa32\v\vu\xa4P\xe8\xd8r\t=y\xfa\xf3\xec\xd4\xdb5\xe6\xad\xdf=\x15\x9a\x9c~y\xe3\xed\x90\xf9Jo\x7fg\x11r\xc6:\xe5\x9b~\vjb)s&\xe9Y\xa8\xf5\xba%\xa5}b\xafX8K\xe7J\uc0c9\xe0\x9b$\xdd\xedR\xc6X\xa8\u0555\xaa\xc1\xb1\x05W\xe8\xe4\xe9\xcf\xde\xf4\xb4\xe5M\xd5\xe0V\xc6\xe0\xfa#\xc6\xd8\u029c\xf7\xaahn\xba\x15\xd0sX\xc8!Sk\xc1\xa3\x8c'\x96g%\xf0b\xb2\x88W[L6a\x85|\x05e\u0527E\xf6\u02b7\x80Z\xc9\x1a\xb9\xd6\xce*\xaf#,\xa3\xd6.\xfe\xf9\xc3n\xf7a\xf7;Pc\xb6\x88\xb1\xbaT\xb1\xd4O\x8a\xb5yH\x84Q{R\n_\u044a\xe5\xb2~\x80\x93\\\u05c0v\nm\xb0\x1b\xc1\xee\xee\xd1\r$\x8f\xac]\x036GF$\x1b\x14\x9e\x8e\xb7\xb3t\xfeu#\xab\u078b\x06p>\xd4\xe0c\xae\xbel}\x94l\x7f`\xaf\x92\x87x\xf2G\xf7\xe6\v\xebhg\xdcjG\x96\f\x82g\u0503\x04`\xad\xce$\x17\u04a4c\u022b\xd9<uO4\xfc\xe7\xf5\xf0/t2\x85|\xe41\xc0\xff\x19X\xfaz\xe8Y\xd6@\xcfAk\v\x81\xa9\x04\xe7\x17\xdc\x02\xb8\x9d\x1ec\"\xb1\x8c\x17\\\u03e1\xf5\x99\xf8]\xe0\xa0\u04a7g|i\xaa\xebQ@\xba\xf8\n`<\x0e#\x93/\x05\u01078m\x96\xe1\xbd\xfd\xf1\xf0Xx\xa8\xaf\xab\xb3\x06\x06\xdc\x12\xfe\xc9\x15\xf5|(7\u008bz\xc9W_)\xc2(\xe1R\x9bk\x85\xe43\x88\x1b$Tg\xc0\xa8\x02\xb4&\xb4\xe3LA\xb4,\bx\xc4[T\xaez}N\x16\x0f\\\xf21\x83\x96\x17\xc8\xe6\xfc\nfVx \u0468]\xddeX:\xea\xf9Q\xdc\x1f\xb0\x8c\xfal\xa1\xec)$\xd7\xf7\x1a\xf8\x877\xc0\xfa\xado\xbd,\x98\x98\x054\xf8j\xe4k\x10h\x01\x15\x0f$Cu\xc6\xfd\xdc\xd3\x04\xbb~\xc4YX\x1eg\xf6J\xf5\xdf\xfaj\xe5+0e\xb3E\xfd:n\xb4\xa0b\xc5\nHB\xe9}1\x9de\xf1.\xf1\xe7h\xfeYb\x86\xe9\x82F\vf\x8dV\x87\x1a_,.\x04\x1d\xfe\xfc\xb8hC\x9f\x8f:n\x96\x7f \u0778\xd9\xed`\xfb]\x82\xc2\x03|\xf5\xf9\u01e5\x1b\xc5h\x96z\x04\x9b\xf2\x00\u05a5\x99m\xa91\xb7#\"\xa3\xe5\x03w\x1b\x18\x8f\xb0\x85\x1c\x19]\x9c+]k\x11a\xfd\xd8\t4\x8a\x94\x0e \xa6O|\xb6\x00b\xaeh\f\xca\x05lA\xcdWJ\x91s\x8cS\xa1\v\x80\xf9A\xcb%\xa5\u62a6\x80U\x00\x9c.\xbf\x92\xaf\x97\xf2H\xa3b\u073e!:y\b%/\xc3\xe3k\x98\xe3\x1a*\xb4\xba\xa0\xd7\xff3\v7BR\x7f-\x14\x9f\xf4\xc8\xe4\xd4\xc5 \x9e\xa7+;?C\xedE\xf9H%\xa5WjN\x88\x10#\x1c\x95\xaf\x16\xfd\x11I7\x99\xb5\x91\n*k!\xe6UV\x96@\xbd1;Q\x86oj}\xc1\xcam\xe2\x8b\x06\xe0\xac\x03\xb1\xa5\x04nqI\xe0\x16\x97!#a\x06v\xcdh~\f\xb7\xea\v\xb6\xf0\xe4Q\x8f\x0e\x8f{:\xf8\xbb0\xa8\x92\xbf\vG\xf3s\xbe\x11J<\u0352\x89W\xc6M\x87\xe9\xf56]\x9c?d\xd4\u0179\xb4\xea\x12?\xdc9\xbd\xf7\xc3\u0313\xe9\x1dt\x94E$\xa3\x85\xec\xc9>3\xe2\xfak\xbc|\xdc\xd3%\x8d\x8f\x19z\xa0I\xa3\xa4\b\xe0NO\x19\x90\x15\x00\x87J\xa1D\xb1\xc1\v\x93\f; T\xb2`I\x89\xce\x0fjE\xc1\xc2O\x83\xdfl\x9e\u0133\x04\x800\x00 \x84\xb8\xa1\xd2\u7abe\x82RZs[\xa0\a\n\xb8k\x90\xcf\x01\x89\x04\x86W\u00b1\"\xee\u0709C[-\x1c\xbb\x90\xcb#\xb6(n\x94J\x1d\x81!\xd5$\xf7{\xa18\x1fq\xf6t\xf6!\xfa\x90\x81s\xa8\x84\xb3\xa7\x1f\x12\xf8\xcc\x1dg\u051f\xeff\x1f\xf2N\xaf\xd3\xfb\x90w\x9c\xceH&>\xbd\xa61\x94\xcb\x1d\xc7w\xcc\b\xa2>\a\x8e\\z\x1bH7\xd4\v?\xe5\r\xd7\xf1\xf0\xef\xb8#\xff\x0e\xc4\u07de\xfa\xee\u02ff=\xf9\u05d5\x7f\xbb\xe2oW\xfd\x1d\x89\xbf\x03\xd9\xde@\xd6w;]\xf5C\xb6\u0515=t\x87\xb2dO\x15P]\xcb\x1a\x03\xd9U\x7f\xe4i>\xc1\x92\xaf\xfc<\xcc<\xcdW)C\x1e\xe2\xb4\xe3\x16\x1d\x88\x8d\x1c\xe1\xf8I\x92\xa2\xb3\xf9\xc4\xe6\xcc\xe6\xac\xf0\x0e\x16s\xf4QU\xd8O'\x9cB@\x02\xf3A\xf8!CM\xdff\xb3T3\xe2\x86\x05z!/P<AW\xb8\xed6\x9eX\x11\x01\xef\xf1Q\v\xfc\xbb\x82Q\xbfg'\xccj4\x1a\rK\x9e-;:\xf5O\x03\xf2\x8f=\xa0p\xa3Sp\u009d\x80\x1f\v\xf4\xd8\xc9o\x85\x89%\xba@\u0149\x1a\u0191@\x17\xc54\x16\xf4E\xaco\x83\xbdF\x03\x01\x86\x0eH\x01\x1b\xa4\xfc\xac\xd3\x1fLR\xc0\x04A\xe1\xbbMCN\xceM\x87\x1a\xc2f\f\xa3\xc7\xeev\x106\xb6\x8f_c\a\xbf\xc62\xb6k\xa7S\xa8\uf1fc\u07a7\xf9\th\x9f\xa2\xcb\x1e\xed\xf4:\xacuz-/\xedsgz\x98{\xea\x12\x0f\xbc\x13\b)T%\xd7\a\x05\xe8\x10Erj\x17\xfcs\x967\x9bv\x80\xb4\xa1\x1d3\xff\x14\x9eG\xbdi\xc0\x00\xd7x\xb6\xf0;\x9e0\xff,\x9f\u05b4\xe7!\n@\x9bJ\x90\u05c9f\xb0N$\x93#\x10\x8ac\xf2\x18\xc43\xe0\xb2&\xe76\x8a\x87D\xe9\x9c\v\xaf\x03X\x88\x10z\xbf\xf0#\xbc\x88@\a\u068f\x04\xba\xf7R\x88u\x1dlR/\xde\xef\xad\x0f\x1fN>\x98\xf1\x82u\xb8`\x1d-\xd82\xfc\x91\u051a{\x04|Vq\x89=g\xae2\xfeXp\x11\x96\xb8\xf1\u0638\xc4Pi\xfb5\x95V\x9c\x81\xd5\x04\x1a\xc9,c0\x98\xf9\u017f\xf1\xb5\t\x8c\xef\xfb\xe2_0\x8c\xf1\xafb\xb4\x9a\xf1\x17X\\\xfe\x1b\x80y\xcb\"\xc1?K?\xf3\xf1\x0f\xda\xff\x04hq\xb3\x8cR4\xf1A\u00d9U\x06u\xaey\f\xff\x8a\x7f\xb6ka\xc3\xd3\xed\xa0\x89O\x96m\xe5\x1f\xa8\x16\xf8\x9f\xe0_\xd1U\xb0\xc1\x7f\xc4\xefh\x05\u0543-~$A*\xff\xb4\xaf8WI\xedO\u06c5\xf1S\x17\to\x7f\x03U\xc5%6\xb4\xf1\x830\x83\xa668\x83M\x9al\xe5\x1f(\xbf\xc9~\xc5\xcf|\xbb\xf4Q\xc11\u2dd0\x1e\xad\xf0\xdf\x00\xff\x01>\x7f\x14\xe1\xb8\xe3\xad\xffk\u0381\u73e7\x1e\f\x8bbid\x04\x15\x92,\u0762aQr\x13,8\xfeJSib$g\x9e\x06\xfc\x06s\x02\xf1\x85\xc3H7\xf8o\xb4\xc1\xb4\xd8\u07eai\xea\xdf)Z']\u00ff<\x14\xffFhh\x95\x89%\aL\xb4\xe1i\xea_C\xe3Y\xd4\xed\f\x1d\xf8\x81\xbdd\xb0]\x18\x81\u07fa\xe1\x9b\r\xcc\ngy\x8b\xf6P\x9f\x16q$\xff\x9c\xe6)\x17;\xfdi\x13&\u06c5\x1a\x86\xf1\x8556\xb8-\xbfu\xc7\xed\xbe\x93\xe8_\x90\xe5/\x83|\xf3)K\x10\xacV[\xfc\x17\xd3qs}\x01tY\xe6/\xd6\x1b\x1e\xe1\xc7-HQx\xba\xf6\xd1N\xeb*\xc8\x16q\x80\x16jq\x18#\x14\x86b\xff\x16\xeb$\xdep\xfd\xe3\x94\x7f\xcax\x94*3\xb5\xcd)\xbf\xe1Qv\x95\x04\x19?\xf5\xb3\x8cGK\x8e\x85\x05\x8d\b\xbfnR4Z\x8b\xfc\xd3m\xe8\xdf\t\x9f\x00f\n\x14\xf5\x83\b\xa1u\x99A\xb3\u02db+\x8bZ|\xd9\xf9\b\xa0\xed/x\x16\xe0\bV\\\x9a\xbbE\xd7Xz\x15\xa4\x00#\u05f0=\xd7A\x86\xff\xfe\xb6\x89\xb7I,\xad\u04ae3?\x846\xd6\v\x84]\xb1\xe0\xc1\x16\xffM\x04\f/\x06\xe2\x0fBq\x86\xf0\xf0\x8b\x0f\x8d\xff\x82\xbf?\U000bbb4fEC?\xcdV\x1b\t\xe0\b\xc3\xfe\xb5\x00\x85\x8d\xfaN>\x8ao\r\x0f\x1blc\x93\x9e\xaey(\xc0?\xc2\xfd\xd8\xe4\x1ba\U00036e41\xb1\x88`W\u050a\x11\x04\xb6~\xb8\xc1?@\x19\xff\xf6\x1b@\xcd6\xf43i\xba\xb7M\xe2O`\"\xb7M\xef`T\xbf\xe6<\x81Oy\x0ex\x1a\xe7\t\x9e\x80\x04\xc1-I\xef\"(\x96d\x02\xc4\xf9\"\x8e\x96a\xb0\u0093  8\xbd\xc6c\xf2\xf1n\x8b\x89\x1bX\xfa4\x0e\x97>L%\xdd\xc6Y\xb0\x82\x0eR\\\xea4\xe3>\x8c\"\xc5q\xc3G\xba\xe5\xfeGq\x10\xa2k\x18C\xbe\x84V\xf3(\xe1>\x1c\x98<\xeb8N\x0fO@\x94%\x01\xc2\xd6M\xc0oO\xf5Ho\xf9\xd5\x02\x8b\xdef>\x1e\x91\xbbEp{\a{\xfai\x15 p~J \xfdn\x93^'\u059c\xae\xb9i\xc2\u770e\xdb'\x88\x95\x7f\xdf\xfa\xf0\x94M5&n\xfdSQbnO\xbd\xe2\xebt~\xef\u0401\xbb7\xf2\xc9\u051ez\x1f\xda_T\x83\xfc\x9e\x9c?\xa5\xcb\xd2h\xda\x1fN\xc1f\xb0\xd3\xdf\x13\u03de\xfd|v\xfe\xe1\x93\xe3\x9c~\xf8\xd4q\xe6P\xba\xa056\xbc\x1c\xbc\x0e\xe8\x01\\\x10\x9a\xb1l\xb7\xb3\x8agp#\xb2\u0473D\x11\x88'f\xb1,\xbc\xdb\xc5\u0524\u0320 \x8d\x88g\xf8\xe0\x82^\xf6\"~9g\x1b\xf0\x923\xf5\xf2\b%\xa7\u02dd8\x8c\x97\xf2k\x19\xe7W\xfa\x8b<%\xb6\xa5\nZ\xf4\xe9\xecg\xeb\t;;\xffk1\xa1\x16\x94(\xb5`\u0467Of??\x99\xff\xfe\td\x95\x9a\xb3\xe8Sk\xf6\xb35\xff\xbd\xf5\x94\u0604^\xeb\xb1|H[~\x96%\x97\x91\xbf\xe1\xf8\xf9{\xf6!\xfd=&\xdd\xf8a\xce\xc9\x14G\xa2\xcb\xc0P\xc4Z_z\xf3b?\xbc\xf6\xe5\xe9\xfc\xf7\xba$V\xb5\xe8\r\x87\xce\xeeDgg\xb3g\xa7\xff\xea\x9f\xfe6\x97\x7fa\x17\xb1\xa3\xe0*\xcf\xf8\xef?\xa4\xbf\xff\xf0tz\xae\x9a\xc0D\x8b^c\vW\xa2\x85\x9f\xed\xa9\a\x04\xcce\xe6_\xef\x90|\x11\xbf\xe2\r \u055d\xb4\xff\x0f\xa2\xeb\u0752/B?A\x97]\xbb\x05\xdc\xde8\tU\u05e2w\x9c\u0616n\xc1\xa2O\xcf><\xad\x1b\u0787\xf4\xf78\"\xd9\x05\x14<9====\xdf\xc1_{\xea\x9dNg?\x9f\x9f\u0389\xfcy:'\xbf?=\xc5*\xc5h\xa0\xd6l:o\xff~:\x9b\xce1\xcf\x18\x1e6\t]\xce[\x1f\xd2\xd6\xec\xe7\xf3\xb9\xecQ\xd0\x1cO\xcfN>\u031e\x7f\xfb\xec\xfd\xb3\x0f3\xb0\x92}7\xff\xfd\xf4\xc3\xfc\x036#h\xaa[8\x00\xcd\x7f\xb0\xed\xa9\xf7i\u67ee`\xdc\xf7.\x1d\xedw3\xfd\x93\x90\xc9\u04c0^`I<*\xb8s2\xbb\xeb\xee!\x1b\x1b\xfb\x84\u05a6\xc2{\xd81\xbb|$\xa2'\u0459\"\xa6\x9b\xcd\x13\x9f\x1f\x1a\x05F\xe0N(*\b\xe2\bUC\xb61x\xe4\xccP\x1fS:\x8an\x95\xf4)\xb0\b\x8d\xd0|`\x1b\xa7\f\x197\xa8\xe9\x12\xf1\u06ea\xcb\xd2*\xf7\r\x06\x86\u0448\xea\xd4P*: \x11\xd3#\xd0\xf2&d\xd6\xc9'\xc2I\x06\f\xa4\b\x9f_R\xebM\x15/\xcfR\x94r\x1f,\x05*\xd8z\t}\x16\x9dvJ~\u03a4\x8f\x8b\x9a\x9a>!\xf7:\xc3(R\x88\xdc\x1c\n1a\x8a\x18b\xa6\xfe\x87\x8aOjUy9B\x9d\xa5\xb6a\xe9\xfaM0\xac\xcd\xd6t\xa8\u0483\xd6&\xaa\xb5\u01d5F\xbe]\xab5\x89\u03d2c\n\x851\x99\x90\xd8Ts\x01P\x88\x95\xb5/O\x17\xfe\xf6\x18 \x88\x1d4\"\x1c\xa1f\xf2\x11'\"%\x88h\xb5\x92\xb3\xf8h\u8884\x10x\xbe6\x9b\x00\\\x01\ag\xb7rtu\xd0<K\xe6\x12\x82[\xacC\x85\xb1\x0f>\xcc%\x7f*\xdb\xed\xca\xebu|\xaf(\xd8eKW(\xacv\x16\x134\xdd.\xadU\x02k\xb5\xaf\x1f\xa0\xf5\u10e5\x06\xd7Rk\n\x91%\xb3`\xf11\xfd\f\x03\xb88eh\xea\xfc\xa0\xb2\x97xO\xa7\x14\xba)N\xd6$\x85}\x1f\x0f\xeau\xc5'$m\xb5\xa4\xad\xba\x89\x17\"\x8a.V\x03\x96N\x94\x18]d\x1bV\xd14 \x8a\xfb\x06\xa1\x90\x80\xa3w\xb4'\xa5H\x05\xb2\x8bS\x1f\xe3\n\x95tG\x1a\a;T\xb5a7u\xc0|\xc3\r\u07ac\xf1!\x9a\xb7*.\u0225E\xbb[\xc3\xe9\x85\xed\n`\x1b\xeaw+\xd6p\xa4F\xa8\xb6l\xc9\xc3Gm\x16`\xb3\xdc\xc0\x8e\x9d\u068d\xcb\u02c7\xa1,\x87\xcd[\xbds\x96\xd6\x18\xc2\x1f6\xf3\x95f\a\xa8b\x95\u05f1^r\xc1z\xa1A]g\x1dB\xdd\u0380\x95\x02\x93\x89\xc1\xb1\xb2\x11\xa14a\bdL\xaa\xa0\f\xa5\t\xcb[\x9dI\x82\xa1\xa3:\x83\x87N\x19z>\xca[\xddR\b\xb3\x16KNs\xfa\xc0\xad\x96\xd3D\x040+bK\xe6\xad\x0e\x8dU0\u0256{\x96\xcaHz\xf5\xddc)\xd2l>\x94\xddB\xae\xb1\x7f$\x17\x10\xba\xd83[\x86\xf9ku\xce\xd2\u9466:\x8a\x03\x855|\f\xf4%~\b?_\xd3\xf8\xf4\xd4\u00e4@&\x05\xcdf\f\x1a\xe9g`\xabH\xee\xa3G\x06\xb6\x8c\xa6\xb6\x02R\x1d\xdfR-\x8f\\PSN\xc7\u00e3\xaa-5\x02[B\x0f\xab\x1f\xb7\x13\x10\x1d\xab\u0460\xf8X\x0e,\x05\"\xc4S\x1b\a\\|\xe9C=\xfd\x9a\x03\x88\xfe\xbe\xfe\xe6\xf3\xd7\xeb\xfe\xf78~\xbd\xee\xc1\xe9\xebu\xcb'\xec\xf1\x87\xaf\xd7\xfd\xdc\xd9;aP\xfa?\xeb\xf4\xd5\xf7\xaf\x0e_\xaf\xfb7\x9c\xbd^\xf7\v\x8f^\xaf\xfb\xdf\xe9\xe4\xa9\xf8\xff_y\xf2\xa0\xfa\xdf\xf1\xe4\x01\xaf\xe7k\x8e\xde\xc0\xfd{\x1c\xbd\x81\xfb\xdf\xe3\xe8\r\u0703\xa37p\xcb\xe7\xeb\xf1G\x0fk\xfe\xefx\xf4\xea\xfbWGo\xe0\xfe\rGo\xe0~\xe1\xd1\x1b\xb8\xff\x9d\x8e\x1e\x00\xfb\xdfr\xf6\xb0\xfe\xdf\xf1\xf0\xf1\xcdv\xed\xa7A\xfaYU\x11aw.\x0e\xe1BNt[\xe78\t\x9f\x13\xa0{\xbfm6\xd1\xcd\xc4\xf6\x81\x03\x191;g(\xeb[\x10\xd2\x16\xd2+z\x92\xb7\x95x\xab\x02\xa0\xd1C\xe0\xb9\x10\x93\x15 \xfae6\xb2bU\x16\xad\b\\\xdbDs\x19\x14<\xac\xf7\x90$z\xc1\x89\xd5\x02\x86\xf6\xfa\x90\xe8\xc9\u0241\xa9\t\x8a\xf9\xe1.\x8a\x17\x8e\xcfd\xc8\b\x1a\xb0d\x02!\x84e,\xe6\xe0\xcc'\xf7*\xa4\xc3i@\n}\x97\xe0\x94\xf9\x18\xd9Z\xa9\xcahm\x00\\)\x9f\xea6\xf7\xca\xe6@\x15\xbc\x8f\xd0U\xb4\t\xdeP%)\xdc\xc2\x16\x1b\xa0\x9d\xd1'\xfa\xe9\xa2\u0744\xc5G \x7f\xd1\x12\xfb$%\x9c\xd2#j\x85\xa5\x90%\xb1\xb6\xfa:<\t\xee\x03U\v\x7f\n\x8f;@\x9fi\uba2e\xd8#\x86\xff\xe8\x83\x18\x15\a14\x0f\xe2B\x1f\xc44\xbf\xfa\f7L\x05x~\xf8\xe9\xe7?t\xff\xf9`\xe6\x15\x7f\xad%\xb9\x182\xbc\xc5\xc5\xf9\x88\x1f\xf1\xca\xf9\x02<\n\xba\x81\xaeb,N\xed\xa4t\xb8\xfd\x96\xabO\x91\xb0\xd0\x12.\xea!\xfc\x9a\xfd\xe1\u00c7\x0f\xe4\xf7\x1f\u04a7d\xaa\x06)b\xf1\u0502'4u\xc0\x10\xc0\u5be8\x99+\xd5,\xcd\x0eXp\xf0~o\x91\xfd\xc1\xfe\xba\xc5\xfe\xc6\xe6\xfe\xfa\xc6\xfen\xbfl\x7f\u01fd\xff\x1e\xdb;\xee\xfd\xaf\xb0\xbb\xdb\xcf\xef\xee\xf6o\xd8]\by\xfd\x98;\x94.@\xc7q+G\xbc*6~-\x93j\xbc\xfb\xac\x89\xb6\xb4\\\xa2\xcb\xfd\x13\xa7\xaeX\xab\xb5&\x84\xa2\x93\x89\xe5W\x92\xae\x11\x86\x1e\xb0\x13\xf6\xdc\xe6t}\xa0\xa7o\xa7,i\xb9\xe4l\x05\xf1\xe3\x8fx\x87\x10\xf1\x0fZ\xadI\n\xc5dD\xf1\xf0\x88\xd9<R\xb1!A\xcb\x15iu\xbf*\xc3\u46a5\xf4\x85\xcdi\x8aZO\xa5\xc0\xf2\xeaU@<\x9fY\x16]\xb3\xf4\xeb\xfa\x84:`o\x96B\x10s\u8268\xb0\\5\x9d}y\x17\xc2\u0594Y\x96\x9a\xe0n\xd7s\x1fd\xb6J\xae\uf59e\xb8\u0404\xb6\x96\x15\x03B\x8b\xe2s\xa7\xbcL_?\xf3\xb1[\xbf\x91\xcd&\xac=@C\x8a\u0410\xa2\xe3\xaai\x99\x8f\xbb\x06n0\xf1R\x06\xd6>4\xde\xedl\x1d|=n6%\xb8\xd0*\xef\x17\x1f\x11\u8d60\x1c\xe8o\xf6\u028e\u027cn\x15|\x96\xb7\xd7\t_\u0440\xe5m\f\xe7S\u1be2lI\x9f\u0444.\xa6\x95\x17\xeb\x06u\b\xd2d\xe1\xf92\x1eP\x80q\x83k\\\xc8V\xb9\xba^\x85\x82\x87MP\xd6\xea\t_\x19\r\xd6\xd1#z\xcb\xd0\xe5I-y\xaf\x8b\x9c\x9e\u049a\xae\x8e\xd2\x18\nM\xa5\xc5\xccW\u0687\xaa\xf2\xa9q\x18\x1a\xf0\x003\x15HH\x01\xb9\xb2\vB\xab\xf0\x00`a\xdc;\n&G H\xbc\xe4N>\x83} \x1e\x05K[\x1d\xf0- \xd0\x0eT$h\x86$%\x8b_\xe3&&\f\u049a\u0298\f!\xec\x10$\x0f\xb3\n\xb3\xa2\x03\x80\xe2G=\x96`H\xb6\xb8F\x14`\xee\xbc_c\xbaT\v\v\x87\x83\x02\x87\x8b\" HZ\xf8L\x93Q\xb9}\xb2/\u00cf\x02\x89\u013c\xb2\x02\xe5d\xa0\f\x198\xf6/\x02\v\xf4&\xd0=/s$N\x0ev\xe8\xa4\xce\xdb\xce#<!\xa5\x8f\xf1\x84\x94\xfe\r\x9e\x90\x12\x80\xb4Ir\x16\xa0\xda\xf6qOH\x15Q\xa2\xeb<\xa2\xd0\xf8\b\xb3\xb3b\x95\x00\xf7\x8ct\x97\x94\u0223\x95\xb4Z4*\xbb!\x00K\x13\xc0\xa8\x02\x9b\xe22\x1c\xf1_\x14\xcd\xeb\xcf\xc9gO\xc0\x03-\x9e9S\xfbs\a\xe4\b\x9c\n\xd7H\x11\x15\xf1\r\x9d\xfdC\u0770\x98x\xf1C3\xa3\xfeA\xae\xecH\xc4O\xa4\x0f\xe6\xb6Z\x9f?\xb4\"\x8eb\x8d\xc3\x17%w\xad\x9e!<A~\x9e\xc5p\xe6\x1e'\\\x95;?p\x8e\"P#\u0586\xdc\x7fSm\xfc\u0712\xd8\x10cA\t\xe2x\xc9\t\x99\x9e\xd8+\xae\x8b%\a~)d\xad\x80\xfdb\xfb,\x999s\u0678K\u045f\xe3\u0249\xc6@\x18s\xdd\xcf\xf8\xcb \xfa\x88&Z\xf6!\u06eaz\xe9\x05u\xb2\u03e31\xeb\xfc\xaaq\xcbA\xf9\u00db\xae\xba\x1b-1\t-5%\xc4;9\xb1c\xbd&kN\xd4|\xa5\xbe\xacg\xb50hae\xea\xc7\xe7\x1e\xfc\xf7\x9d{\\\x99\xbb\xe10\x06u\x9e\x8eBb\xf9F\xaf\xf8\x81\x91\x8e{\x94\x92\xcc\x11\x97/>\xd9\xed\xe4\xcb\x12\xa1\xb5\xdb=\x12\n\atW\x80\xe1\u0695\xa1p\x86\xe2\xef\x97ywA\x83\x1c\xfb\xe4\xa4\xfaBT\x0f\xc3+\xb1\u03c7:\x17j)\xea\x84\xfa>\xf5[\x06\xf8\x90z\xc9\xfd!\x88\xc9e\xe6Q\x16dw\xc7VY\xae\xb1qc\xe2{m\xf4\x88\xe7\xbb\xdfr\xcf\x02T\x1a\xea3vdA\x05'\xb1v-n\x8b\xa8%\x00\xb6\x11\xb3>\x81\xc5\x10 \x83\x99S\xc1\aS\x84\xf8\x17Q&p\x85<\x11\x84\xba\x03\u2572\xa8+\\\xbc)\x16\xeb\x02LN\xb6vD\xbc\xad=\xe8\xf7\xbb\xddc'R\xbb\xd592\xda\vN\u0122\xa5,\u019e\xf0\x15\x02?\xe0V<\xa2\x80\x93\x1e\ubb3e\xb8\u056ch\xc3\x18&n\x97\x8f0q\xfb\xf4\xa0\x89\xdb'e\xe2\xf6I\x9a\xb8\x91\t\x161\xb1\b{\xc7\v+\x96w\xbc\x80y.\xd5G\xca\u06e2\x03%\x89\xb8\xdb\x1b;3\x91\xbfg\x91\xdd\x0e\xf3f\xd6\u0355\xb6x(\x99?H\xab\x05T1\x9c\uba99\xb2\xed\xf0,\x02q\xea\xf6\x97\xbc\x12C\a94\xf5\xf1\x95\x8eZ\xda\xc5L\x99I\x9a\x9c*\xc4\x05\v\x7f\xb1\xe6\xdfs\x04\xf1s\x87H\x1aZ\xf0\xc2e\xb8\xa6Iv\x16\xabx\x8a\"\x18\x13\x1e2\xb9\x93H\xec\xc8v\xdeA;\x92#4Q\xdbY\x93\xb7\xdf\xd3\xcb\u03d8\x11\x1a1\x8f\xbepjp\x90\xf1\u01d9/\x15\x91\"\xe6L\xa23\f\x8e\x90\xb1d\x16\xe1\f\\B\xd0\xfeW\xb0\xe3\xc4C}\x1b\xa7\xe7\xcc7\u077c\x1e\xe8\x94\xc9Y\xcd\xf7:\al\x91\x00\xcc\u0788O\x9bT&\xf7\xa0\xa5\x9a\b\xf1S6\xed\x9aTL\xbbd`\x9d\x8f\x9c\xdd+\xa3\xb8{y\x1dx\xf7\x80?A\xa7\xe9\x13\xfcx\x9d\xa3\xd1(\x8e?\x85_\xa1\x1f]\xbfI\xf8*\xf8\xe4Y\xf0;\xf7\xaf\xf9)\xba\x06\xf8\xf8\xdeO\xae9\x86\xf16\x02vB\x1d\x11\xf1\u0433\xfe\xe3\xdf\xff\x1f\xff\xf1\xef\xff\xcf\xff\xf8\xf7\xff\x1b\x84\x16\xa4\xeb\xe0z\x1d\xa2U*F\xc7.hu\xaf\xe3\xec\xe9\"\xdel\xe3\x88GY\xea\xdd/\xe2\x84{\xf7\xb0U\xa9'\x030\xa0Q\x8dx\xd0Z\x05\xe3\x00?\xcc\x18\xb6\xe50\xa4\xe5\x922Pu%8\xfd|/\xb5\xba\xca\xfd)\x97\xe1B]LG&\xb1\n\xa7\x9b\x85\xe7I\x11\xd8\xc7p\xd3e\xf8\x04\x94N\x96K^\xb7\x84\x97\xad\xf9\x9e\x8a\x19\x15=\x17\xf4\xa5\xa9\xc8'4\xc4\fy\x9d\xbe\x95\xb4\ne\x85\xc4-\xaeD\u015e,\xd4n\x05\x912\xdf\xef\xf7t\x95\x87\xe1\xff\x04p\xa07\xa8X0\x18=(s\xc7\x11HE\x0f\xe6\xe0\x18sp\xfe'\x82e\x01\xa1_\b\x91_\t\x86\x8f\a\xbf\a\x01\xefQ\x90\xb6/\xae\xe2g\\\xd9w\xebh\x9eY\x11\xb03*\x87\xf2\xd44k)\x8e\xa7\x8a\xcd)\x86\xffc\xbc\xe4:\xe8\xa6Hb\\\xfeP1<\xe3\xc5GtI\x12/>\x8a\xa4\x84GK\x9e\xf0\x84q\xfdSvQl>\xe3\xe6Wq\xb3?\x976'\x96\xd0\u0736N\x18\xe0\xe8x\xd5\x00o\x0e\x19\xe3T\xb8\x1f\x01$k\x11\x9a5\x9b\x00!',CnM\xb0\xbak6\x17q\x94\xc6!o\xdf\xfaId[2\xb9!\xa6\xda\b\xd2F\xc27\xf1\r_\xb6\x1b?\xa5\xbc\xa1\xb2\xb7a~\x1dD\x8d J3\xee/\xbd\x0f\u0447(\xd8l\xe3$k\xbc\xe5p\b\x00\xa74VI\xbci<It\u0093\x89.\xa5\u06a9\x16y*3\xa0\xa80xQ96i\xe7)\xb7e>\x01\xa3\xe6\xd2*C\xe9\xcb\xd2\x1aCJ&S\x00\xee1\xe1\x87\u0292C\u06bb\x83 \xb2\xb46\xca\xec\"\x8eD\xf45\xfb#\xd0]\xb2\xff\x94g\xf0\n\xb8\u07d3\xfd\xf3\x12m\u00f3\xd2\xf5\x9f\xdaf\xa3\x94\x93=-\x95\u05ed\x1f\xd2BH,H\xdb\xefl\x9d\u0137\r\x18\xe5E\x92\u0109m\xfd\t$\xc0\x8d\xbf\x16k\xf8\xd7\xc66\xe1)\xcfhc\xb1\u62cf\r\xb0\xb0y\xaal\xf7\x80\x8c\x91Ch63\x1c\xbcN\x00\x8a\xb5@\x16\xcd\xe6k\f\x04\xd7\xfe\xc8\xefR\xdb\u0329\xb3\u048d\u023dY\x04\x1c\x85\xe0An63\xf5;i\xf3\bw\xb2\xb6\xa0\xf0\nY]\x94\xbcBi\x14\xc6U\xb6 0\xc4&Tj\x1dR(*\xae\x1bR'\xb2.$O\xcc\x18\x9b\x00$*\xe0\xa7\x1d\x11\x1a\xc93^i]\xc0N\xfd\xb0\xd0\xd0K\xc1\x8b\x022\xf9\xc3.\xc2\x04c\x9d\x12\x90\x81EW\xcd$^\b\xe0\xfe\x92\xa9D%T\xe4\xd0/\x9fZm\xa7_6A\xd1D\xfd4\xf7t<\xe8\rz\x1eg\xe7\xf7V\x9e\xf2\x06\xa0\xaeEfM$q\f2*\xde\xe6\x9f\x00Q\x94b\\&H\xd5\x1eb:\xe3L\x80s\ny.\xf8\xa7-_d|\xd9\xf0\x1b\xb2\x06\x92\xc6\u00b1\xb0\x0e\xcb\x19\xac\xecN)\xa5\xc5'\x82\x90U/\xb0\xdf'\x13\x19\xb1\x93\xefvZ\xae\x94\x11@\xaeb\xac\xf2\xb9\x19\xc9\n\x85\xe8\xbb\x11)\x91\x8eCca\xa32\x89\xcf#\xed\xe1%9w'\xc4m& }h1Nhr~\xce\\\xca[Ls%\"&\xb2\u0306\xf6{\xda\x1b\xf6\xdc\xd1\xe1\n\xd6.\x9bx\fd\xac\xf2\x14\xa3'6g-n0\x02\xa4\x7f\x8dL\xf8\xd7\x10\xe6\xe5\xe8\xc7\x02,\x9c\xa5\u07ca\xc6\by3\x13]\"\xd5E\x8a2\xbd^\xb7(\x04\x16\xa9*\xa3\xe3\x16\xe9\u049a]e\r\x8dv\xf1)Y8\u0290%\xb0\xc0~O;N\x7f\xd8\xf5\xe4\xc5]]\x81\xa8\xbd\xb43z\xff\xc6\xf5l\xc2\xce\xf3\xbd\xb0gK\x98\xf5\xea\xf5\xfb\xcb\xef^\xff\xf4\xea[K\xeeo=Z\x81\xad5\b\x04\xdfd\xb1\x800BE\xc7d\n\xfa\xb2i\xe6\xdd\xf3_s?\f\xb2\xbb\xe7\x80p\xbdlOS\x16\xb4K\x894g\x1av\xd2i\xec\xa54d\x01H\b\xde\x05\xbfq\xba(2!\xea\\H\xb7,\x90\xe1\xb7/J\xad\xac\xea\x02\x04\x97\xfdv\x00$\xe6a\b\x13\xd9\xed\xe4\xafh\xb7\xcb\n\x8f\xa6\u0441GS\xed\u0746\xa9b\x18\x9b5>KTd\xb2\x13n\x03O\x9bF\x18\xafLW\xd4^\x86\xf7vN\xe8\x9a\xc1\xe9ZL\ud22d\xe8=P\xb05\xa3\xf5\x9b\xcd\xc8\xf6\xe1V\xa1\x9cL\xfd6\x9auz\u025ens\xb3<,\xbb\xcf\xee?\xf2;\x8fSQ&\xdb\xef\xe95\xcf.\xa2,\txZ\x94-\x9a\x9e\xce\xfc9\xb8%\xa2\x8b\x90\xfb\x89Y\u0097+\xbc\u07d3\"\xd9\xc0\xa63\x83A\x13\xab\x8b\x17\xb8\xba\xab B_i\x9f\u0297\x9d\xec1\xb39\x8d`.pw\x01\x9e\x88\xcfO]Q\xd9g\xb0VE O\xf0\xb8\x12)\x89YL]@\xc2y\x94\x82\xb7\x1f\xe0UP\xb9\x12ZD#\x7f\xe02\xc6\xe5\xc5\u0268O\xeec;#\x80\xb9\xb0Y\xd5\x10\xaeW&\xd7\xcb\xdfC\x1f\x12)\xf1f3\x12zx\xe43\xab\x18\xd5,\x1f,\xd0~\xbf\xb7\x17te8WY\u068a@Y\x03\x13\xc3\xf6\x93\xeb\x1c\x9f\xbe\xb8\x14\x19\xe2U\x81{x\xdb\xdfn\xc3;\x84LZ\x14\xa3[\xb5\xfe\xebv1&\x9bP\xb5\xf2e? r\x80[\x9b\x8b\xb5\x82\xdb\x04\x82\xe7!\xa9\x1b\x8b4\xb2_\xb7\xb7\xb91\x16(\xa4vK\xfdX\xb6q\x86\u03c1\x8f\xc3\x0eW`-\xb2\x81\xf3\xb1,H\xed\xa0\xa0\xc70\x86q;H\xf1\xaf\u0341\xb15\x85\x7f=\xbcPN\xb26\xbf\xe1\xc9]\xdd\xe0-\x95T`\x10\x0e2t\xb9\fY{\xe3o\x1f[o\xaa\x13\x1bV\xcb\xe6m \xf4v;+\x8f\xe0\xc7\xd2\"-\xcb&\x96g\xf4\"#\x1b\u04c6E&\u015d)\xef\xcbE\xc2\xfd\x8c\xbf\xe3!_dq\xd2\x10\xd7g\xda\xf0\u00f0\x11D\xdb<;MeV\xda\xc8\xe2\xc6\x15\xd7h'\xa5\x8d\xab<k$|\xc1\x83\x1b\xbeldk\xdeX\xc5a\x18\xdf\x06\xd1u\x03\xbaO\xbd\xc6\xccjE-kn\x19{\xa1\a\x9f\x96#\x82\xeb\x9dS\xa8(2\xe3F\x9f\xbb\xd3\xec\x14\x03s&\fb\xcef(D\x8df\u0269;/\xaa\u0392y\x15\xcd?\xd8EbvA$\xf2\xcb\x10\xf9% Q,\x1a\x8eE\xc3>x\xbd\xa59\xbb\xdf\xf0M\x1c\xfc\xc6_\xcb7\xbf\xc424d\x898m\x00\x10\a\x97\x06:\xd6aaQ\x8a\xd0b\x835a\x13\x92GoR\xd4\x10q\x9a\x1b\xc5\u0375\xcax\x82{\x81\xbbW\xd9$\xdc\x10\xd5\r\xee\v\xcej\xc1r\xbae\x8bvyRtU\\O\xdbi\xe4m\xe9\xbar\x02Vd\xba\xf2 \xfc\xe0\x92\x05v\"\\q\x8a\x03/*\xd2\xd9\xe1!\x03VjX\x8f\x16\xf6 ;\x8d\x16>\u8721\x13L\u06ee\xd9Ft\x02\x95\xb1e\x01&\xc0\x15\u0364\x13Dd\x94/\xe1yQ\u06c5&\x97}\xb61Kp\xb2/\xf2\xe4\x03\xc8O\xd3\xe0:\xb2o\u8f78\x92\xbf\u02e3\x85\x17R\xb9H\u02f7E\xe2\x86.9pQy\xb4\x00\u073a\xa4\xa1\x9ff\"\xbf\xee\xba\xda\u04c4\xc3S(\xcf|\x01=5\xab\x04eR\x9e\xbd\xfdlA\x06w\x1b\xbd\xd9\xeb\x1b\a\x1dD\xe4,E\xf5\x8c\x813\x18\x0f\r\xa2\t^\xfdY#a\x91=\x1atz}\u0138v\xd7\xe9;=\xb0\x88\x8d\xecqo<\xee\xa0\xe7.{\xd4\xe99Cb\u0416@v*F\xb6<\x05(\xfb\x8a\xdbo_\xbf~O\xd3\xcc_|\xc4[8d9]\xb0\xbc\x8d)\x14\x1d\u052fX\xc6\xce\xef\x936Gx\xe6\xd4z\x15\xa3\x97\x11\xc0)\t\xdfr?k\xf8Yc\x11\x87\xf9\x06\xb1\x1a\xf8\xc8\"{\xbafI;\u0352\xf7\xb1\b\x03\xc2\x051\r\xf7\x8er:}\x96M\x88$`#\xb6\x9e\x01K[\u04b1\x1f>X^}\u0395\xe5-\x04\xa0\x04\xed\xdb8Y~\x13\xe7\xd1\xd2O\xeel\"\x15\xdc\x059\xfa\x8dQ,\x8a\xa3?\x1d/y\xabK\xfa\xd8`Z\xc9\xff\x93\x91\x1f\xc5\u065fj\x8a,\x8d\"A\x94U\xb3\xbf-\xb7\xf0\xe2\xb0Dj\x8ea\x1dd<\xdd\xfa\v^)\xf4\xae2\x90\x9ar\x8a5\xff\xf4\xc3R:\xfd\x8f\xc8ta\xca:\xe3\xf6\u06cb\xef.\xde^\xbcz~!)\x0e-\xc0\x8b@z\xb7'^\xa5\xfc\xf3\x1f\x9e\xbd\x95E\xa3\xb2C\xaf=\xd9\x1b\xc3\xfb\xd9X\xf1+~\x1dD\x95\xe1\xff\xce\xc8\xe7\u0472\x92;\xb3<\x00\xcf\xe5\xc4\xfa\x19\u0110\xebY:\x9fB\xbc\\G\xa8\tB\xe4\\\xc4v\x1bV\xa8==\x0f\xfd4\xb5\xd7Z\xf3\x81rPPd\x1b\x10DV&\xf1\xee\xe2=My\xe6m\xe0\x11\x15\u0159\xb7\u0717\xbao\x1bk\xebGw\x00\xb1\x95\x01\xdab\x807\xfa\xdc|\xff\xf6\xf5Oo\xf4\xc1\xa1\t\xdfp\b\x82\xe9\x9d8\xfb\x895\xb5DT\xf7\xb5\b\xa3-\x81\u061dSt\x03`1\u020e\xa67mq\xe7\xf2\xe57w\xec\xc4\xf1\xac\x13\x95\x1e\xc5\xd9w\xe5,\xcf\x12\x12\xf1\xe2\x18\xfe\xf5E\x84\xc2\xc3\xc6u\x12\xe7[\xe0\x1c\xf9\x89\xbf\x80\x1b\xe4\xc9\xef\xee\xa3\xfd\x13y\x9d<\x99>1\x8e\xe7_[v\x8aJ\x1c7m5bt\x12'g\x7fC\xa8\f\xd5\x10\x12\x1a\xb2\x1bx\xe5\bT`\xae\x05\xb1<\xbcW\x8aGq\x81\x1b~\x8aPb\u02d7\rR\xc6\n\xa2\xdb\x05\xe8\x98n\xe5\x05\xaa8\f\xd3P\xfd\x9a\xe9_\xda\x17\xc7\xdc\v\x0fG\xb0\xb3<]r\xb7\xb3\xf5o6\x93\x85\xe7\xe0\u038eg\xbc!\xbf\xc5]y\ro\x86\xa2\v\x9c\xe85\f\xea\xdal\xfc^l5FjeO\x7f\xb6?,[\u0126\xf8gJ\xa6\x1f\xf6O\xdb\xfc\x13_\x18\x80G&\x82C\xcbn\xa7h-\xb3\xd0\v\xb3\x02\xb0\xbcc\xfa\x8c\xdd*!\xf9\x15\xbb\x9du\xe6\xd3\xdbYw>5r\xbb\x98\xeb\xb9O\x1d\xef\x0e`\xe5\xd6\x10\\\x1f\x9c\xe37\x17\xef_\xbc\x7f\xf1\xfa\x15\xdd\x04\x91w\a\"\x04\xefJ\x9e\u05054\xdf!\x0f\x1dg\xb7\xd3-\x9f\x82\xa9\xe5\xd5\f\xff\xe1~\x1d\xec\u05ed\xf6k6\xdb\xfa\x8af]\xd1\xecS\u7846\x7f\xff\xf5\xe3=\u06b0B\xa1_\x82\x05\rf\u01d13\x91\xf1d\x13D~\xc6\xe5i\xb5\b\xcd\xf7T_\xcf\xe8\xca9e\xf1\x9e\xe2\xc5]{\u72cb~\x92\x95n=\x06\x1c\x139\u0224\xfd\xe6\xf5;1Q1P\xeb\xca\xda\x13\x9aU\uffc7\xeb|#\xea \x06\x7f\xb8\xe4\u03e2$\x8f\x96\x0f\x97\xfb\x9d\xb5'{\x8a\xc4\xc9\x03\x13\xa31\xb62S\xad\xbc}\xf6\xea\xfb\v\n\xb2\x06\xaf7\xa2Y\xec\xf5\x87{P\xeb+\x152ve\xdc\xdf\u04da\xba\xe3!\xd4\x05\xed\xa0\xba\xdcA\x1fr\xc7NA\xbc\xc6@\xd7\a\xc7{\xd9\u04daT\u05e9Ov\xeb\x93;\xf5\xc9\xdd\xda\xe4\xee\x91\u0483\xfa>\xfb\u00c1S;\u0551;\xee\xc0dG\x1d\xa7\xbe\xc5Q\xa7{4\xa3{,c|$c4<2\xf5\xcehT\x9b3\xe8w\x86\xe3\xfd\\\x82wZ\x86(u{\xfb6\xc1\xcb\xfb\u0115p\x9d\xfd\xe9\x11\x85\x1d,\ftY}\xc1\xf8\xa0\xd5\x17\x9f/+\x1a-h\xb5\xfa\xe2\xc1\xe1\x80\x1f[Ct i\x91\xfa\xb2\xb3/\x00\xc5\xeeW\xec\xf9\\\x8fdO\xf1\x90\"\xf3\xbaxS\xdc\xc3\xd3\xc1s(RB\x9eK\xd5\xc1\xf7:\xf4\xdd\xc5{\xafK\x11\xfc\xbc\x1e-P\xb0\u05e7\x9a\xfe\xf4\x06\x14z\xf4\x86\xfb=\xc5\a\xcd\xc3\xd8A\xbfo|v\xefx\x0e\u037c1\x8d<\u05e17\x9e\xeb\u0495\xe7vh\x02\xf3\x9cd\u01ab\xa3\x8e\xf5j:\xea{j\x7f\x98}\xf8p\xf5aNv\xf6\x87\x0fd\xfa\xe1\x03\xb8\xe5\xb3g\xcfN\xbfC\xafh\xbd=\xd9}*>;{\xb2\xb3\x9d\xe9\xcc9\x1d\x8a\x8f\x85=\xfb\xa7g\xa7\xff:\xfb\xf0\xe1\xc3\xfc\xe7)\xb42s\xb2\xe8f\x95\xcc\tyzm\xb8g\x96\x1a74\x16\xd6\\\x82\xe5[\x884\x80\xde\bY6\x1dy\x89\xa1l\x87\nvq\x91\x10cBP$\x04tD\xbctj\xfd\u04f3o\x9e\x7f{\xf1\xdd\xf7?\xbc\xf8\xe7?\xbc\xfc\xf1\xd5\xeb7\xff\xf2\xf6\xdd\xfb\x9f\xfe\xf8\xa7?\xff\x05\x866\xff\xb91\xb5\xb4v\x17\x18=\xcdr\x88:\xfc\x0eE,m@\x0f\xcf\xe5\x8df\x87\xea!\xfdt6\xfb0\xbf\xdf\xff\xfc\xbb\xf6n\xfa\xfb\x96M\xe6\xf2A\x011\\\xec\x05\xfa\x8ej-\b]\xa0\xfc/+\xd3\xe4\xcc\xe6b\x1b\xb5\xa73\x985<%st\xe1;\xf5\xec[\xb2\xb3\x97dg\xa7dg\xff\x89\xec\xeco\xc9\xce~G\xc8\x0e\xdc\u05c1\xfb\xc1\x0f\xc4n\xc3j\xfe\xfca\x0e\x86|\xe4T$N1e\x0e\x05q\xcft\":\xfc\x15T\x98\r&?H\xa1qp\x03\x05\xaa\x92\xa0n'\u027d\xb8x\xe4)!\x90?\xeb\x18\xd9\xea\x01W\xe4v\xcd\u02a5WWQ\xa6g\x941_\x89E\x89~\xb9\u010b\x83n\x06\x95&\x8e\xf44\xd4\xc5j\xb0\xbc\xed\xcfF\xf3\xdd\u039f\x8d\xe7\xa4L\xa6\x00\xee\xf7g\xae3\xafR/\xda\xe4\xfc\xc4\x0e\x98?s;ZV0\x03ks\xe0\x86 /}>\xa9tk\xa0\x8b\xe0\x80$\xca$\xf5\x13U\xa8\x9f\u2472\x00H\xb1\x10x\xb0(C\xd6>;\xbf/\x18h\xef\xee\xa2\xcc\xff$\xd9h\uad53\xf0\xeb<\xf4\x91\x8f\x96\b\xef\xc2^\xe3\xa9\xd5\xe2-\xeb\xa9\u05f0Z(\xac\x1c8N\xbf\xeb\xa9\x06\x0f\x04\x96R\xbf\xb9\x96\u0377\xe5\txx\xf5\xa3\x05o6k8\xb8F~;\x8ao\x05\x138`F\xf2$\x03\u05be\b\xd4\x14\u01775\xbcj`I\xdc\xdad/\xcc\xf3\x84Z\xeb\xb7~\xc6i\xceR\x91\xf5\xf96d\xc1\xd3|\x0f6\xfaV\x1e-\xf9*\x88\xf8\xb2\x18\xe9m\x10-\xe3\xdb\u076e\x86K\xf9\xa3\xf0\x8c\xfb|\xedG\x11\x0f\xc5\x1cB\x86\u0334\x85\xf8\xb35\xbb\x94\xe2)\b\xacG\xb2\xe4\xee^p\xf2\xcaC\xb4\xc9$\xb4!\xec\b\xbc\x1b\xa1\xf4~\xe1K\xcf\xffbCS\x9e\xbd\x0f6<\xce3{KAMx\xbf\x9f\x94uHU\x1fS\xa3hD\xa1I\xcf\x0e\x19\xa7\x95\x16\u021eV%\xfa\vf\x94\xe1(\x9f/q\x93QD\xa0\xf2\x17\b{z\x0e\xe9:\xce\xc3\xe5_\x02\x1e.\x0f\x97\xfb\xc4\xddS\u07dc\xf1*N\x16\xfc\xbb\xc4\xdf\xf0\xb7~V\x92G\x18\xbb\xbabb\x0f\xda\u0168\xe8Z\xa5\x99c\x99\x94\xb7P\xef\x93\xd4\xf3\x11\x1b\xb4\xd45\x01\xca\xc2gQ\xb0A\xce\"\x8ebR\xb3\u02f2x\xc2\x7f\xcdy\x9a\x95\xcb\x17:D\xe2\x9cZ\xef\xd7A\u06b8J\xe2\u06d4'\x8de\xcc\xd3\xe8I\xd6H\xf3-\xaa\xfe\xd46\xd1n\xfc\xe8\x7f\xe4\x8d4Ox#[\xfbY\xe3.\xce\x1ba\xec\x83\xf4~\x1b\x87w\xab\x00\xc5\x10\x8d8\\\xf2D5\x9d\xb6\x1b(\x81\xf6\x9e>M\xb8\xbf\xc8~I\xdbqr\x8d*D\"\xe1TUM\xadZ\x06\xfb\xf2\xcbF^\xb7V\xff\xf9\x03\xdf\vV\u0549Ko\xc4q\xba\x86P\xf1w\xacO\xaf@\x95\xf9\x91@\u05e8\x9e\xb0sv\x05`h\x02\x1b}\x04Lrr\xef\x9c\xf3\xdd\xce\xed\xf4\xcf\xf8\xb4\xb2z\xe5J\x8d\xcc\xff\xc8S\\\x874\u0202\x1b\x90Dd\x8d+\x9e\xddr\x1e5\x9c\x86\x1f-!\xb4\x06m@5`\x01\xaf\xa0f#\xf13\x9e6@\xa5\x11\xe5\x17~\x04\x85\x1a\xabm\n\xcahQ\xac\xb7\x03$\\\xde\x1ds\xce\xf8\xf4G?[\xb7Wa\x1c'\xb6\u02fbO9\xf1\xfaB\xc5\xf8\x16\xc5:e\fE/\xd8m\x1b\x1a\xe8L\xc4_\xb7\x1dG\u04bdw=\xa2\xba!\u01f0\xd4\x15\u3b7b\t\xa21\x81\xaf\xa6\x17\xa0N\x9d\xc9\x1e\xb1\x01\xe2\xd9\xc6\xee\x91**\xab\xa9@\xb98\xf7\xb8\xeb{ZQ\x8eg\x9cnv;h\u04e15\x95\xab\x98,\"\xf7\xd7lU\x12\xa0p\xbb:\x11\xf05\x10U\x11\x1c\xb2\xb3\x00\xd8\xf6\x85\xa8\xee\x93)3/l\x14\xc4e\x9e\x11#\x86\x9dP'\x88N\xdd\xf3\xf3s\fA\x0f\"9\xa4\f\xb45a\xdcl:g\x1f\xed\x98f\x84\xe8\xf8`P\x8ee\x14|\xa1\xb2\x98F,1z\xbf4(o-\x91\xb29\x13\x02X\x98\xbeg\x1a=\x186\x0f3\a;\xd7]\x1bs\xd0R\xbaH\xa4CY\x16\u0259\x88Y8\xb4P\a\x02\xaf\xa9\x13%\xe1\xef\xfc\xde\x06\xd3r\xe1N\vB\xc0\t\xbf\x0f9\u3cf4\xdca\xd0l:\xe7\x1f\xed\x80F\x84\xe8\xc4\\$\xe64\x00\x97\x120\xf1\x9cBM\x16\u0444\xa5\xc4\x13i\x01\x85\xa61\xcd7)\xac\xc3f\xa2\xea:\x9a\xcd\xed\xf7\abp\xbcZ\xf5z},\xefm\x1a'\x82X;\u034a\xdf\x13\x83=\x15M#\x8f\xb7\x83\xe5i\xd6\x0e\x96\x88\xa5\x9e\x01Y\xfe\x1c\xfey\xcd\\\xfa\x8b@W/X\x97\xbe\x87\x03\xf0\n\xfey\x03\xccw\xdd\xe5\u06f2\xe4\xf7\xd2~\xae\u065f\u0664\xa4\xca\xd2^\xf8a\bz\xc0\xe4\x9d\xfd\xdc\\\x05x\xa1\xf9\t^\x89g\x8c\xcb\xf9O\xa0\x105\xc6\xcd2xi\x06\xc2=6\x94\xa5\x9f\xecgp\xa7\x8bN\r\x18\xfb\x11\x86\x14\xacl\x18(\x85\xf1\u0453W\xa4\xc0\x05\x97\xf63B^\xc1\u064b\xeco\xe58j\x06\xdfl&\xf6\x8f\xd4\x18\xdb)7;\xf9V\x1cL\xb1\"\u0366\xe8\f\xd9E\xef\xc1\x8f\x89\x00\xae\x17\x88X`u\xde\xda\x11\xa1\xbf`\u07ea\x87_\xd0\x04\xee\x97\u02ac\xce#\xb2\xdb\xf1f\xf3\xa4\xfeZ\xb0\u0455\xad 2\x7f\xd1+\x8a\x04\xc3!q\x1a\x90\xfb\xa2\x8c\xda\xca_\xda\xdb$\x88\x93 \xbbCK\uf2607\x83\x83\x81\x9c\xb1\x88L\xa2\x03\x84Ik\xfaI\xa7F7\xa9\xf7\v\x13\x8b\xdcl\xbe\xb3\x9f\x11\nS\x17\xc8\x10>'b\x11\xf6\xc5~\xfcB\x84\x94\xf3\xc4)\xf6\",\xedE(\xf6\"4\xf6\"\"4g\x85bY\xbe_\x05\x91\x1f\x86w\xf7\x1ad}\x04Y\xe1c\xff%\xf3\xcdk\xf6\xc52\xe4o\xe4\"\xb0\xbeyg\xbe\xd8l\xf82\xf0\xb3\"\xdb5\xb3_\u01b7:\xa3gf\xbc\x02b?\xd4y]3\xefM\x12\xaf\x82\x10\xdci\xe3\u020c\x9c\x9fR\x9e|\x03\x8a\xceAt\xad\xebv\xcc\x12\x82^y\xae\xd6\u05bcExygKW\xbf\xf2\xbeu\xf1\x89/r\xa8`^\n\xafv\xbb\xf7\xbb\x9d\xadO@\x99\xee\xbd\xe6\xd9\xf3<Ix\x94\xbd1\xc1\xa4\x86\x1cyQ\xad\xf7]\x90\xa4\x99\x1a\xeb+\xd0]=\xac\x84{o\u058b\xf8\xa7\x8a\x92\xb5\x90\xf3\xbeP!\xc2D\x18.\x19\u042b\xebI\xab\xcf\n{>c/\xf6\x02\ubf58\xbc`\x19\x1e;\xadfL4p\xbc`\u047e\xd4\xfb\xd6\xcf\xd3\xfaU*\x15\x93\x04\xef\x1b?\x882\xf6\xb2\x94\x93G\x7f\n\xb2\xb5\u07bd\xf2\x03\xa4\x1a\xee\xac2\x17\xfc#\x03\x90\xf5\xbd\xf2\x8c8\xeb\x1a3\xe2\u6332\x87f\x94\x82\xb0-\x0fy\r\u0300\xf6\xa32\x9b<\xa0\x83\xe4P\x0f\x1e\xc0\x81\xb27`\xc14`V\x94\x83\x8cPg\xdb\x01\v\xc0\x15\x9d\x7fG\x80\x12\b\xa6i+\xf0R/`)-&-\x8e\xf7\xa9k\xc8j\x1a\x1d/g\x9d\xbec&\xf5\xbd\x9c\xb9\u03b0\vz\xb1\x9d\xae\x99\u04c3\x1c\u07ab\xecy\xce\xfa\xbc\xbb\xd7\\\xbc\xfb`\xe9\xbdn\xb5\xa8:\x15\x9eOK\x88\xce\xe3T\xa3\x0f/\xa0ed\xe7\xe5,h\xe5T_6\u07a9\xbb\xa7\xc1y\nn\xa2t\"\v\xe8'\xfb9<i\xe5\x95&\x90\x1c\xc7_\u03c1\x11\xf6f\x1a\xdb\xc4{\x03'\v\x10Vp\x9a\x12B\xbcR\x1b9^[\x9c\u0403c\bD\xa3\xb9\x93\xb7\x89\xbf\xad=\xf9\xe2\b\xbc\x98TuG\xc9}\x010\xe5# \xd5cP\xd1\xdc\xd0\xd1)\x01\x11\xf0I\xba\xa3\x9esL-\xb7`\xf4F62T\u021e\x0e\x87\xee\xd1\xf2\x92z\xb4{\xa3\xe1\xa0G\xda\xdf\xe4\xab\x15O&\xc8\xe6i\xc4\r\fW\xb4L\x85R\x94\xe0\xef&\xf9\"\x8b\x13D\x01\xf9\x96'v\u073e\xdc&|\xeb'\xfc\x1d|+\x12\x99\x13B\xa5V\x8fx \xbfI\xe2-O29=KD#\xb9\x97\u04a6Wq\x84]XT\x19g\xc0\u0682\x15\xd7m\x12d\xf2\xf7\x9eP,\xd4^\xf8\xdb,O\xf8;\x10\x11\xbfO|\xe0\xf9\x1c\xc9\x10\x9d\xc5d\x9ff~\x16,\x1aG\x86zo\xec\xc2?\xbf{\xfd\xaa-\xf4\u0603\u055d\xcd\xe5;Be\v\xf6+$\xef\xf7\x82\xdf\xed\xb3\xd9\xfdVN\u0393\xf3\xe2Q\xbe\xe1r\x0e O*\xf2ux\xa3\xe3EP\xf4\xfdP\x01a\nf\xe6\x83\x0f\xa0\x80\xbd\xbb\xdb\\\u0161m\xb5\xb3\x18f\u04403\x06\x8f7\x9a2\xfb\x1e9\x8d\x9c\xa6\x9cG^F\xb3\xf8\u048b(>#/\x8avb\x10\xb3~\u02f7\xd9\xda\xcbA\xc1*[{\xe1\xbe\xe0\xec/0l]E%\x93Lgs\xef~/\x14Qe`PB\xc3s\x96+F\xf9\xe2\b\xdd\xc3\xe58\x9b\xcd\x13\xd48\x9f\x05*(\x83\x8d\"\x8bY0\ajC\xf4\x9d1U\\\x9bP7\xa4\xb2\x00\x14\xa4\u065e(e%\xac0\x8b\xa8?\x8fWJ\xb3\x8cK\x85WNH\xcdH\x12\x10\xfc\x06\xa9\x00~\xdb'\xd3\x05<\x8a\xac\x99\xc0\xb2\r\x91>\xb7\xbc\x1a\xce\x06\xf8\x99\xf5\x9b\xcd\x03\x84\xecO\xb3v\x10-\xc2|\t\xbdB\xbc\t\xd5\xe8\xf3 Y\x00/tny6\xb8\n\xc2\xe4Tm\xd0,\x9a\xcb=R\x91S\x1f\xb9K\xc4\u00c6|c\r\n\x90\xc9Lh\x89\xf60>\xa2\xcc;\x8a\xfd\x98e\xf3f\xb3\xfe\xd0.h\xa6\x0e+\x14+\x01\xdfI\xbc\xdbE\x0f\x1f\\\xb5c\x8b\xbd\x81\xa0\xeeS\x9e\x04~\x18\xfc&\fI\x10C\xb1\xfb\x02\xde\xee\xf54#\xf6\n/4)\xa5\xfe\xe3\xc5\xe5\x8bW\u07fdx\xf5\xe2\xfd_\xf6,\x93m\x1f\xec\x00\xd7W\"\x9f\xa6e\xf8\x9f\xcd\x0f\x16\xf5\xc4)V5\x92\xab\xea\xec\x89W\xab\x0e\xfc\xd7\xd9w2\xd5k\xfc\xee^\xeb\x03\xfbQ\x1c\xddm\xe2<\xb5\xf6\xf3\xbfz|O\x97\xfc\xef4E\xb01G\x1b@ 4\xe3\x95\xc0\xc7%\u06da\x87\xa6\xdfl\x9eT\x0f-\xb9W\xc7J\ubf2a=:\\+@\x16Y\xed\xf2\xd0\xe2\x85\xcboQ\x9d\x1f.\xa8N\xcf\x1d\x8d\x8d\vG\xdf1Nw<\xd0w\x8ci\x05\x00T\x18Z/]\n\x8b\u00a4\xed\x87a\f\xb2 a\xd4t\x89w Xp(\xb3QQ\x10S\xa4\xe5\xe1e\xc8#\xe6\xecc\u04e8\r\x03$V\xa8\xbdC\xb8G\xadv\x88\x1b\x96g\xab\x91E9KP\xbc\x86\xc5\u0264\x88\x9ddtLc\xf3\v\x86A}\u0379\xa0\x01\xd3#\x02\xbd\xcdI\xe1P@\x10Y\xc1?\xc64d\xc8L\xdb\x04\x91\ud7e64>\xcdA\xa5\u0259,\xce\xc2\xc9\x02\x15\xad\xf3\xd6b\x0e\xfc\x8d\xd6b\x0e\x1ar!\xb5\x83\x16\v\xc9?\xc6\f\xa2\x1a\x89\x1e\xc4\f\xe1\xe9f\xda\xd7A\xc7\xe0\x11XX\xec\x99+\xb2\f\xaey\x9a\x1d1|\xc4z\xffX\x99\xd7\xc4\xf8\x9ees\xe6vF\xe6\x0e\xb4\x81yj;4\x03\xc7\x7f\xd99\xabl\x17,\xad9P\xa3&\xa9iFF\x1a\x8d\xd8\xe8\xf7z@\xc81:c\xbd\u03b87\x1e\f;\xe3>1\xeb\x01\x9a\xe1?\xbd\x88\xb2n\xe7\x9b\v;\xaa\x02\xc7i\xcf`\x1d$\xcc.ZiF\xe4\xfc\xfc\x1c\x18Nvt\x9a\x90\xa7:g09\xde~|\xd0\xfe\x88\xd0\xe3\u0153\x9a\xe1\uc3ee\x87\xe4F\x88\xa4\xb5\x9f\xae\x8b\x9b\x8eO\xfdv\x16k\xda\xc3\xf3\u02fbzY\x05tr\x7f\xa0\xd0.\xcb46y\n\xbc\xe1F\xb0\u0646\xe8>\x81/\x1bWw\x8d4\xbf\u04b2\xbd\x02E\x83n\xd3\xd8\x19v\x0e\x0es\x9d\xfd\x1b\xb9\xe7\x8cW\x9c\x9d\b\xe0Jf\\\xb0$\xb3\x03E{\u07b2\x0e\xd8\xcd\r\xfb\x967\xfc\u0142o\xb3\xc66\x0fC%\xc9H\x89\xa5W\x04Z\xc8\xf6\x93\xa4\x9d\xae}\x16\xd9\xc3^\xaf7\"\x14?]\x16\xd9\xee\xa8\xdb\x1d\xc8\xefN\xa7\x87\x04n\xaf\xdbQ)\xfd\x01\x90\xc7\xc3\xdex,S\xba#(\xd3w\a#U\xab\xefv@%{8r\a@BC\xfb5(\xad\xdb\x1f\xbaC\xa1\xe8\x80XO\xe8t\x97\x10\x1d\r\xd8\xcc\xed\xbb\xa3\xbe\xe3tzc\xea\x8e\xfa\xe3\xe1\xb0\xdf\x1dw\xe9\xa9;\x1a\xf7\x1cg\xd8\x1f\x8d\xe8\xe9h<\ue347}\xb7\a\xbc\xcd\xc2FbT\x8a\xb1\xac\xfc\xd9\x04Q\x90\xd9\n\xf2nYJcd3\brw\u0423\xfd\x011\"+\x9bj\x14gg]g\xc7\xcf\xcf\u03cd\xd0\u02cb\u00b5\x88,\x86\xbe\u02e6Y3\xda\xfd[\xd6L\xbc\x8e\xfe\u031a\xc9.j&^\xf6s\xf4s\xb2O\xec\x9c\u0184\xe6\x06(\xc2\xc8\xea\x84\"8R\x9f\xb9\xc3n\xa7?\xea\xb9\xe3\xae:\x1a\xac\xe7t\xba\x9dn\xb7\xe7\x0ee\u0482u\xfa\x83Nw\xd4u\x9d\x8eLZ\xb2\xce\xd0\x1dv\xbb\xa3\xa1B>\x9cu;\xa3~w8\xecw\x1c\x89\xe7\xf2\x87ND\xd9-\x8c<d\xb74a\xceN\x8e\x8d\xc6\xfa\xf7\x15\xf5\xf5\xef\x05M\xf5\xef%\xcd\xf5oN\xb7\u0319l\xcf\xdc\xc1\xa4\xd5\u0692h\xb6\x9d\xa3\x8a\x8a\xbfT\xa7\xbf\xf7\xfb\xad4E\u075e\x8d\x9c\xa2T4\u06dev\xe7?\u00df\x91\xf8\xe3\xf6\xe4\xdf\xc1\\_7+\xe6LV\xa2\xdeJ \xe95\xfb\xb7\x7f\xb3WO;\x0e\xa1K\xe6\xecl;c\t9;\xeb\xef2\xd8\xce!i-\xec5\xfa\xa2KI+oE\xb3\u057c\x15\xcc\xd6\xf3I\xceR`\x97S\x9f\x85v\f\xb0\x9a\u0404-\xf7jK\x92\x96\xfc\xb5s\xf4\x9e\xc42\xedJ\xa7-\x98/\xd3\x16:m\xc9R\x99\xb6\xd4i\x9c\xe52\x8d\xef\x9c\u029e\x00Nc\x95w0g\xbe\xb8\xeb\x7f\x8aR\x7f\xc5\xed\x8eS =\x81M\xd5r\x16\x1b\x85\xee\xaaj\xf3\xaeh\xefh\u0782\x8e\x8e\xe6-\xa9\xdb9\x9a\xc9A\x81\a^\xfd\x05\xd2\xcb\xf7\x141\xcc\xff\x1a\x18\xa1/\x10\u00b0\x84\x11\x1eF\x19\xdb\xff\x03e|\r\xca\x10\x87\x1aQ\u018a\xc0\t=@\x19+\x892\xf4\xd1\xc7Rv\u01a2\xd9J`\x8d\x95\xc0\x1a+\x895V\x805\xc8\u0659\x8bh\xa0[\x98\x18\xaf\x993Y\x8bV\xd6J\x17\xe1\xdf\xfe\xcd^#\x02\u0670\xd0NHkk/M\x8c\xb1\x06\x8c\xb1\x9c\xef\x1c\x03g,\n\x9c\xb1\xf9?p\xc6\u07c63\x90\x06y\x18gH\xa2\xc4/\xd0Gp\x80>Ll0\xe8=\n\x1b\xf8\x87\xd8\xe0\xcbOb\x17N\xd8\xd0u\xba\x1d\xbd\xe9c\xb7\xe7\xf6\x9d\xc1\xa0\xab\xb7|\xe4v\x86Ng<\x1e\xeb\r\ufe7d\xde\xd8\xed\f\xc6C\xbd\u07fd\xce\xd8\x19\x0e\xfb\xa3\xbeJZ1w\xd8w\x06N\xd7\xe9\xf4e\xd25s\a\xe3\x9e3\x1c\x8c\xba\xaa\xad5\xebv\x9c\x9e3\xec\xf7\xe4#\xe4Q\xc0\x12\x94\x81et\fX\x8e\x83\xcaq@9\x0e&\x0f\x00I\tD\xea\xb2V\xb4sl\x10\u05f4\xd3;\x04,\x84\x9a\xbf\xe12r\xddA\xb7\xdf\xe99#\xea\u00ad\xd3\x1b\xf6z.\xed:\xbdq\xb7\xd3\xed\r]\xda\x1dw\\\xc7\x19\xf7\x87]:\x1e\xb8\xe3\xd1\xd0\x1dt\xa9\xdbwF\xe3\xa13\x1ewi\xa7\xd7\xef\x0e\xba\xfdaoD;\xa3\xa13\x1ct;\x1d\x97v\a\x9d^w\xe4:#\x87v]\xa7?\x1e\xf5\x1c\x97\x0e\x9ca\xa7\xd3\xef\fG\xd4\xedu\x06\xa3\x11\xb4F\xddq\xa7\xef\fG\xdd\u0448v\xdcA\xc7\x19\x8e:\u0380v\x06no4\x1a\xb9N\x97v;\xbdQ\xa7\xd3\xe9CS\xa3n\xbf;v\xa0\xad\x9e\xd3\xe9t:\xbd\xe1\xb0G;\x83^\xb77t\x86#:pz#g8\xe8\x8c\xe8p\xe8t\xfa\xfd\xf1\xa8K\xddNo\xec\xf6\x1d\xb7\u04e1n\xbf\xdfwF\xee`\u0721\xeex<p\x06\xbd\xf1h@;\xfd~\xaf\xd3qF\xa3\x0e\xed\x8c:\xee\xa8\xdb\xeb\xf6\u01b43\xeew\xc6\xe3\xc1\xc8\x19\xd1n\xc7u\xbanw\x00\x8b\xd1\xed\x0e\xfaCw4vi\xb7?\xea\xf5;\xa3\xa1\xebR\xd7\xed\x8e;\x03X\x8cnw\x84\x8b\u0767\x83\xc1\xa0\xeb\f;N\x9f\x0e\x87]h\xca\xedP\xb73\xee\r\xfb\xc3\xee\xb0C\xdd\xeex\xe0\x8e:\x9d\xb1K\xdd\xc1\xb8\uf3baC\u01e1\xeex4\x18\f\\\xa7\xef\u048e\vS\x18t\xfb\x0e\xac\xf0`\xdc\x1f8\xdd!\xed\f\xbbNo\xd4\x1fw\\\x18+\x1c\x99\x9e\xeb\xd2n\xa7?\x1ev\x9d\x91\xe3\xd0n\xb7\xd7\x1f\x0ezC\x18k\xdf\x1d8\x83\xfe\xc8\x1d\xd2\xee\xc0q\xba\xfd\xce\xc8\xe9\u045e3\xee\xf5\x87\xee\xd8\x19\xd3\x0e\x1c\xa6n\xb7\u05e3\xbd\xae\xd3\xe9\f\x87\xdd\x1e\xed;\x83qo4p\at\xd0\x1f;\x03\xa7\xdf\x1f\xd0\u0468;\x1e\x0fG\xc3!\x1d\xf7Gnw\xdc\x1f\xba\xd4\xedv:\xb0+\ue23a}\x18{\xc7\x01\xb0\x18\xf6\x86\xa3aw8\x1cSw\xdc\xef\xf7\a\xb0G\xb4\x03\xa3tz#\xb7O;\u060d\xd3\xebwh\xa7;pG\xfdN\xaf\u04e3\x9d^g\xd4\xeb\x0ez\xb0\x97\xc3\xfe`\xd8\xed\xb9\xa3!\xc5\xf3\xdeu{\xc31\xedv;\xe3n\xa7\xdf\x19\x8f\xe6_\x83\x01?C\x0f\t\xe7d\xeaq\xf93o\xda\xd9\xcf\x119x#\xe9\"\xbc\x99\xed\xa2\xa6\xcdw\x19)QE\xaa\x80\x8d\x04\xd3\x0e\x89'\xf23~\xb9]\xf8t\xc7\U000b30f9\xaec\xd4_U\xea\x0f\xa0Dg\xa0\xea\xbb\xf8\xe9\xaa\xfa}\xf8\x1c\x1a\xd5\u05d5\xeaC,\xdfW\xd5G\xd8_\x8f\xfc\f_\u076f\xa2\u0106c\xa7\xdb\x1d:\x05%\xd6u{=\xb7\xdb\xeb\f\vJ\xccu\\8\x9e\x9d\x9eI\x89\r\xbb\xe2P\xea\x1b\xc0\xed\xf6\u01e3q\xd7u\xc7\xfa\x06\xe8\f\x1c\aNC\xa7\xa7o\x008[\xdd\u07a0\xdb\xd7\x17\x80\xdb\ufe7d\xfe\xb8\xd3\xe9\xd7]\x00\xff\x05$\xdcR\xff^\u044d\xfe}Mo\xf4\xef5\xbdf\xce\xe4Z\x90y\xd7$\x9a]\x1f\x92y\u05d2\u033b>\x1b\xf4\x8aR\xf0\xa8CR\xef\xfa\xb43\a\xf6\x94;\xdcegg.l!Pw\xee\x18?\xbb\xe4g\xfcrH\v\x8a\x0e\u7b75\r?\xdc\xfe\\\xa4\x98\xef\xc8;\xe6L\xeeD/w\xe2F\xbcb7\xad\x95\x9d\x93Vh\xe7tI7\xa4\x15\xcc\xee\xe6\xadhv7\xdf9\xf4\x96m\x812\\\u0628mMv\xce\xe4\x86m\xe8\x86-\xe9\x92\xe5\xa0\xeb\xdc\x02\x02O\u0406\xb1$\f\xafZ\xb7;\u7fc28\u0530\xb2\x94i+\x9dv\xcd62\xedZ\xa7\xad\u064dL[\x7f\x15a\xd9\xed\xfc\xaf@+\xd4e\xadigtHF \xff\xeba2B0\xc4\x1eO\x9f\xba\x03\xe7\x8b\tT`1\xbbn\xe7+H\xd45\x83k\xc3\x19:\u0741B\x19Wk\xe6\x0e\xfa= J\xfb\x1a\xf6\xd6\f(\x93~g\xdc\x1dj\xd8[\xb3n\xbf\xdf\x1bt\xba\x03\x95\xc4\xd7\xf0\xf8t{N\xbf\xe7\xaa\xe6Vk\xd6\u93a1\x93N\xd7U\xa0\xb7f\xdd\xc1\xb0\xef8\xa3\xbe\xa6^\xd7k\xe6\xc2\xdd<\xe8\x8c\\\x85.\xfd\xb0\x86\x84\x0e\x0fi\xe8\xf0\x90\x88\x0ek\xa8\u8c06\x8c\x0ek\xe8\u8c06\x90\x0e\xff\x0e\x94tod\xeclfKv@\x15\xdchr\x00\x82\x11MZ\xbdBb\xa1\x0e\xd3Z/\x13\x1c+\x95|\xb5\xd6+EGE\xf2b\xadW\v\u03c6J_\xae\xf5\x92!\xf4\xabt\xbe\xd6\xcbF\xbb\x9d\"}\xb5\xd6KG{\xce\xe1\x91@x\xff\xbbP\xd6\u07413\x1e\x0e\x86\xbd~\x89\xc8\x1e8\x9d\xd1\xd8\x1dv\xfaer{\u0403\xb7\xe2xlR\xde\x1d\xe0\x84\x8c\xfb\xfd\xde\xc8 \xc2{@\xcbvF\xbda\u01e4\u01fbN\xbf;\xea\xf6:\x83~\x894\x1fw\x87\x83\xa1\xdb\x1f\x8e\xcbT\xfa\xa07p\x80\xb64\t\xf6\x0e\xdc\u0623nw\xdc3hw\xd7\x1d\xf4\xc6c8M&\x19\xdf\x05*\xce\x19\x0ez&E\xdf\xed\x8f\x1d\x98\u0478g\x12\xf7=g0\x02\xb5\xa1Q\u05e4\xf3\xc7c\xb7\xdb\x1d\xb8n\u05e4\xf8\a\xdd\xee\xc8\xe9v\xdda\x89\xf6\xef\r\xc7\xc3ao4\x18\x99\u03c0\xce\x00\b\xe6.\xac\xac\xf1\"\x80\x15v\x81*7\xde\x06\x9dn\xcf\xed\f\x80\x1c1\x9e\t\x1d\xc7\x19\x8e\x1cg\xdc\xed\x9a/\x86\u07b8?\x1e;c\xc7-=\x1eF\xfdA\xaf\xebv\xba}\xf3\x1d\xd1u\x87\xfd\x8e;r\xbb\xe5'\x85;\x1e\x8d\xfb\u03a8;4_\x17\xdd\xf1x<t\xc7\xdd\xee\xd8|h\f\a\x83!\xac\xf0\xc0|rt\xfa\x83A\x7f\xdc\x1b\r\u01e5\xd7G\xc7\xe9v\xbb\xc3q\x7f`>D\\\xa7\xdb\xeb\xf5\xe1\xb1`\xbeI:\xbd\x01P\xf2c\xa7\xf4<\x19\xf6G\xdd\u03a0;2_*\xee`4t\x87\xe3\xee\xc0x\xb3\xb8\xeeh\xe4\x0e\xc7\xe3A\xcf|\xbe\xf4{\x03\xa7\u05c7g\x82\xf9\x92\xe9w:#\xa7\xdf\x1b\xf5\xcdG\r\xac\xfb\b\xfa\xe8\x9a\xef\x9bN\xb7\xd7\xedw\x86\xddq\xe9\xa9\xe3:n\x0f\xb6m\u462f\x1e\xb7\xe3\f\x86\xfd\xb1\xdb\xeb\x98\x0f\xa0n\xaf\xe7\f\x87\x83N\xe9)\xe4v\xc6\xceh\xd0\xed\r\x9c\u04ab\u0205\xd5\xe8u\x86\xdd\xd2\x03\xa9\xef\xf4\xc7\xfd\u03a0?4\xdfJ\xae3\xe8\xb8C\xc7\x19U^M@\x96\xc2\x03\xc9x@\xb9\xbdng\xd8\xe9\x0f\x87\x03\xf3-\xe5\xf6\x06C\xa7\xeb\xf6\xc7=\xe3Y5\xea\xbb\xee`<\xec8\xc6\x03\xab\xeb\x02\xa5\xdb\x1d\xf6;\xc6[\xcb\xed\x0e\x80\xd1\xe9\x8e\xfb\u01b3\xab\v\xd8|\xd4\uf3fb\xc6\v\xac;\x1c\xf5\x9d\xbe\xd3\x199\xc6c\xac\xdbuG]g\xd8\xeb\f\xcdwY\x17n\x92N\xb7\xe7t\xcd'Z\xc7q\xbaN\xb77\x1e\xf7\xcd\xd7Zw\xe0t\x9c\xee`4.=\xdc\xdc\xfe\x10\x0e\x82\xe3\x96\xdep\xae\v\xe7\xa43\x86\xe3c<\xe7\x86\xee`\xec\xf4\xba\xce\xc0|\xd9\xf5\xe0y0pz\xa57^\x7f\xdc\x1d\x8cqY\xcd\xd7\u07b0\xdbu]d\x8a\x1b\x0f?\xb8\a\xbb}\x17P`\xf1\x06\x84\x99\xf5\xc7\x1d\a\xe6\xdb\xed\x8e\xdd\xfe`<p{\x80.G\xddQw\x8c\x9b\xec\xf6;\x83ag\xe8\xd2\xfe`\xd0\x199p\"\xba\xe3\x9e\u3386\x03g@\xbb\xbd~\xcf\x19\x8c\xfb\xdd\x1e\xed\xb9\xeeh\xd0u\xa0h\xcfq\x9c\x0e\x1c\xd2\x0eb;w\x04\x83u\xe1\x8a\xee\x8e\xfa\xfd\x1e\xac\x17\xbcP\xe0\xed=\x04\xc4\xd8\xef\f\x01\xe0\u0180\xac`\xbf:Nw<\xee:\u0380\xf6\x06Nw\xdc\xed\fp.\x83\x0e\x00\x06\x1d\x8c\xfa=\xe4J\xd3\xfeh\xd8\x1b\x0fF\xdd\x01\x1d\xf5;n\xaf3F\x00\x1c\r\x86\xe3\xceH\x1c\a bp\xa2\x83~\xbf\a\x10\x03\x8b>p\x1c\a\x10a\a\xce\x18\\'\x03\xa0\x96\x00Q\xf6\x86\x80\x1e\x9d\xf1\xa8\xdfw\xe1\x8a\xe9;.@yoD{\x9dn\xaf\xef\xc0Q\xa3\xee\xc0\x19\xba\x83\xe1\xd8\xedS\xbc?\x86\xbd\x01\xdcF\xee\x008\xea\x00\x83\x9d\xde\x00\xf0\xc1\u061d\x7f\x15\x15\x17\xd7Qq\xff\x19\xcf\xec\u0093\xa8x\v\x8f\xe0a\u0513\u03e4\xe2\u065d\x19\xaf\xe0\xd23\xbbR\xdf\xed\xe1\xc3jT<\x953\xf1T\xc6\x16\xc6\xd8B\xb7\xfc\u04ae\xb6\x005\xba\xeam\x8e\xf5;\xf2\xa9m\xc8U\x96\x8f\xad'\xdf\xefYe\xe4\x9b\xc3\xfa\xfaI(\xa6\x8ec\ud28e\aE\u015bGW\x94\x8c\x87\f\x19\x0fE\x03\xd7e\ufb60:q\x06\xf5\x9c\xa9\xeb9_C\xa8\x1f2\x13\xd65\u0704u\r;a]\xc3OX\xd70\x14\xd65\x1c\x85\xf5!K\u1027\x80\x84z\xcf\x19\x8d;\xdd\xfe\xb0\xe3\x14\x84:`\xba\u0470\xdb\x1f\xf7\vJ\xbd\xd7\x19\xba\xee\xb0?\xect\vR\xdd\xed\x8f\xfbC \x18\xc6\x05\xa9\xde\x19\xbb\xc3\xfe\xa0\xefv\rR}\xd8\xe9\xf7]\xd7\xd5T\xfe54\xe7\xf6\xbb\xa3q\xbf74)\xf5\xa1\xd3\xedv\x9c\xf1\x97r<4\xbf#*\xf8\x1dk\x83\xf9q\xb56\xb8\x1f\x8b\xb5\xc1\xfeX\xae\r\xfe\a_\x1b\f\x90\u055a\xde\xe9\x8f\xeb5\xbd\xd2\x1f\xeb5\xbd-\xfa\x01\xeb?\xddOH?\x15\xfd\x84\xf4\xb2\xe8'\xa4\xef\x8a~B\xfa\xb1\xe8'\xa4\u03ca~B\xfa\xbc\xe8'\xa4\xaf\x993y}\xd6\xedL^\xb7X\x87d\xb3\u05c7\f\x96\u05c4f\xb3\xd7-\xb7&\xa7\u0553\u0317\xd7g\xee\xc0\x11m ]\xff\v\xcbf\xafO\xbb\u039c\xbe\x90\xbf\xc0\xf9\xd7{\xb6\xb6\x7f\xa1/\b}\u0156\xf6\v\xfa\v\xa1o\xd8\xc6\x16e{\xaah\xaf\xe5\xce\t}\xcbnD\x89\x1f1\x11$\xb7\xdf\xca_\xd0\xd2K\xd1jgN\xbf\x91\xbf \xf5;\xf6\xaa\xf5\xed\u03a1\xbf\xb1\xf7\xad\x1f[\xd7\xf6w\xf4\x15p]~c\xf6o\xec\xb7\xd6\x1bHa\u07f5\xde\xee\x1c\xfa\x96\xec\x1c\xd2z)S\xbe\xd99\xf4\x1b\x02\xbc\x0e\x98\xffoj\xba\xdf\xed\xd5\xfe\xff\u011c\xc9O8\u015fp\x8a\xbf\xb1l\xf6\x13\xf4\x97\xcd~j\xb9\u00bb\xe6\xafla\xa3a=\xa1\x7fd\v\xfb\x96^\xd0O\x84\xfe\x89m\xed\x88\xde\x12\xfa\x03\xdb\u06b74\"\xf4wle\xa7\xf4\x1d\xa1\xff\xccV\xf6;\x9a\x12\xfa\a\x16@k\xdf\xc3\x1f\x98\u01bf\xb2\u0406\x88\xc7w\x84\xfe\x85\x85\xf6;\xfa\x91>#\xf4_\xd8\xf3\xd6?\xef\x1c\xfagv\xd5\xfa]\xeb\xda\xfe\x17\xfa\x1c&\xf7gf\xe3\x7f\x7fn\xfd+$\xb2\x7fi\xfde\xe7\u043f\xe0\xfc\xfe S\xbe\xdf9\xf4{L\xf9M\xa6|\xb7s\xe8wP\x1dF\xce9\xfb\xa1\xf5G\x98>g\x7fj\xfd\u06ba\xb69\xa7?@\xee\x15\xbb\xa3\xcf\xd93z\xc7r\xfa\x8c\x81C\xe8\x94~d\xef\xd0d\xf0\u03edk\xfb\x1d\xbbl\xfd\xcb\u03a1\xff\x7f\xee\xfel\xbb\x8dci\x18\x05/\xfb!\xfa\x06\xacmC\x95F\x02\xc4\xc0\xb1\xc0\x14>J\xa2lzk\xb2(Y\xb6A\x98\x7f\x11H\x00%\x15\xaa\xe0\xaa\x04\a\x13\xf8W\xdft\xdf\xf4]?\xc1y\x91^\xbd\xd6y\x94\xf3\x02\xfd\ngE\xe4PY\x85\x02Iy\xfb\xdbg\xaf\x7f\xafm\x11\x95\xf3\x10\x19\x19\x11\x19\xc3\x05,\x1e\b\xb1.\xd8\r\n\xb2n\xd8\tMX\x04v\xab4b\xbf\xd4\x04\xafM\xdck\xf6S\x8d\x83\xe8\xe9'\x92\u0278B\xa6\x7f\x80\xe4+\xc3\x10\xfaG\xed\u0124\x0eu\xea0\xac\u0758\u0511N\x1d\x85\xb5\v\x93\xcau*\x0fkg&u\xacS\xc7a\xed\x8bI\x9d\xe8\xd4IX;6\xa9S\x9d:\rk\xcfM\xaa?\xd5\xe3\x9d\u05a2\xda\xc45g\xf4\x9ad\xa3\xd7e.\xa7\xb5\u0114\xb9\f\xe9IVf\xa8\xcb\f\xa7\xb5\u0614\x19\x86\xf4&+3\xd2eF\u04dao\u028cB\xb9\xdc\x06]\xeb\x1f\xb5\u0514\xe1!=\xcb\u028cu\x99\xf1\xb4\xb60e\xc6!\xfd\x92\x95\x99\xe82\x93i\xed\u0594\x99\x84\xf48+3\xd5e\xa6\xd3\u06a5)3\r\x11\x10\xff\x82Lpo\xe7\x7fA\xa9\x87N\x9fL\rt\xd1\x1d\xab\xdf\xe9\xd4\xc0\x17\xbc\x9c\xacII:\xc0E<hb\xd2l\xb7\x9aJd\xd2:l+\xff\xa7\xfb\u037dN\v\xa4\x88\x89\xeb|k\xa2\n|\x8bV\v\x90\xf4\x89\xfb_^\xfb\xf3o\x1d\x88[A\x17\x98\x96}\x87,v\x1dU\xc4\xda\xc9\t\x172\x7fX\x9e\x9f\xea\xfcyy\xfe\xd4Oe\xfe\x18\xf27\xb4=]\xcf3\xed\x8e\xd6\xf3L\x9b\xb3\x82B\xb2Q0\xa6\t\xe3\u0698\u048dX\xd2\x00\xab;\xd2MX\x84v\xb1\xe0\xfd\x1c\x03\"(\x10\x92\xf9,\xc2?T\xfea\\~q\x95G\xa3UY\xac\x02\x05\xdaR\x85\x87\xdd\xf9i\u0293\xbc\x0fy\xd0\xd6L`\xcc.'\x96\xd2f\xe0:g\xc1\x88W\x86\xd2\xc4\x1f=7\xa0\xf2\xe60\x8e\x84\x1f\x80\xcfH\xdf\xe5\xca\xf3y\u05a0\xf4N\x9eV1\x02\u00da\xaazb\xbb;\xc9Re$D\xae\xa7\x1bb`\n\x13sPzc2\x8b1v\x85\x9dk\xbc4\x95\xb9\xa0\x97T\xb4\xd1$\x05\x7f\xed\xd2#<\x9cQ\xf0\x81\xeb\xa7\x7f\xef\xd0\xe7\xf7\x0e}\xf4\xa8\xa1\ub01er\xf0r\xa8\xdd\xcc\xcfI\x9a[n\x1a\x93\xbb\xaf\x1dr\xcf\xe5\x10Z\x11\xb9>p\xc8\bc\xa61!\u07a2\x871R\xa5\xa9\x00\x04\x1fp\x85\xcaq#\fB\x89\xbe\xf0\xefV\x14@\x0e\x03c\xad\b\xcd\xfb\xd0\xd2^\n\xf4\xca\xf7\x12\xb9\xe2\f\f\xdb\x11R\x95?}lB&i\u01cd\xab\x95\xa2P\xc8\u02b8\\N 2G\xfb\xa0c\x9b\xb5e`\x1e\x1b\x94\xd3\xdao\x1d\xec\x93\xc6\xc9\x15\x8f\xc4\xc9,\x10\"ow@\ue48ca%+-\xd5uc@\xdfq\xe3=\xf7G`\x1c\x02X\xeap\xe7\x00\"\xdb7>)\xb3\x16P\xd5\xd9i\xb7\x0f!\xed\xc5b\x1e\xf2\x1b\xd0\x1f\xde\xdb\xdf\xed@\u0287\u010fRp-\x04Uw\xf6\x9a\xe0\xf6\xb9\xf1\xceO\xd3\x0f\xd3$^L\xa6\xe8\xecy\xbf\x8d\xc9\xe3 \n\xd2)\x1fA\xda\x1e\"\xc8\xc6<\x98s\x19k\xc9\xdd=<\xdc\u0643\xb43\x91p\x7f\x06Onv\xe8\x9a`^\x1e\xb3\x06&d\xcfT\xe8+\n\xc6^\xadn\xc9\x10\xee\x90\xc0]A0b\x01\xd8\u061a\x1f\xae\xc5\xfa\xf9\x10\x96\x00\x89hY9\u0080\x193\xeb\x97KVQ#\x8e\\\x19\x93\x12\x88I.?\x13?\x88\x1c\xea\xc3\xf7E\x90\x9e\x89Q\x10/\x97B\xf5\x8f^(\x01\x80\xb0,\x8fF\x0e\u0415\xf2KE\xc7](\x83\x80 \xe7\xcc \x05\xa7!\u02e5\x1b\x80Q&W~\x89W9\xf1\x84\xc9.\xb5;\x1b\xf1T$\xf1m\xb5j~\xba\xa4\xa8\x1e\x19\x8c\u0761K(\xa85&\x18h\x9aG<y\x1e/\"\xa1,\x18\xd1\x15\x8b\xa3\x91#\xb7e\x18r\xbd \x9a\xd7+U1\xb74kYf\x99\u05abe\xcbR\xcc1KTV\v\xc7F\u00f2\u07b2\xbc\r\xbd\r\xef\xe9mX\u05a2\xc93\xc6@rG\xadA\u4fed\x1d\x1f\x16v|\xa8K\xdb\xdf|\x16\b\xd7\x01`w\x80\x19\x81\xd84\x9d\xf6\xeen\xe7a\x8a\xc3~\x8e\x89Y\xd2\b\u0493h\x18Cp\xbc\xe5\xb2\u011c\xdd\xe5\xccqj\x1c\u0305\v\xc1\xb5U\xa4\x1e~\xa3\xa2\U00020a50\xf9Y\u05ff\xfdt\x18\x04\xea\xf7e\x10\xf9\u026d\xfe\xf0S\xbe\xb7\xa3k\f\xd3v\xf6\xb3\xde\xce\x1aj\xed\x85<\xfb\xaa[\x9f\x89\x7fm\"\xf54\x8du\xb5\xb9\x00\xf2\xa1t\x94U\x8f\t4$\x03\xed\u0249\x97\x18\xff\x14/~}q\xc9Y\x1aU\x02\xd15\x9e\u07d5\xd9x\xc92\xe4j\xfe\x95\xd9f\r\xe0\xb7l#\xf4E\x10\xb5\n\v\xabJ\xaa\xbc\xee\xfa:\u06fb\x81[g,\xe6\xf4\xfaY\xf7p\x973\x177?\xbf\xf3T0\x8c\xba\xc3e\x80\x89bL,\x01\x01\xfd-\xb0b\x8c\xc5\xcb\xe5V\fD\xd0zT\x89\x8f\u0457(\xbe\x8e*z/\xc0\xf7\x1d\xcfb\xa3-\x97|el\xdet\x99l\xa5\xe5\xfa`\xa6\x80;s\xa1\v\x8eXH\x05\u06f1\xbd,\u02cd\xc1|0\xadz\u59c2\xa5\xc5Bz\xb1\xb2&\x87Y\x93s\xba\xee\xc0\xc1\x16\xe2\xe1\xe5\xc1\xc6\x14\xbc\u3e26\xd6T\x198\x85~*\xdep>bMj\xbe?\xc4\xc2\x0f\xed\x04\xf4\x92\x9a\xe4\xd8+A\nq`\x8c\x16:k\xb5\xf7{M\x8f?}\xba\xcb\xd8^\xaf\r\xbfv\x18k\xed\xf4:\xf0\xb3\xc3X\xa7\xd9\u06c1\x9f{\x8c\xb5{\xf5\x96Wo\x17B\x9fXR13\x9ezn\xb89\x1fPT\x87yj\xb5\x0f\xb6\x98\xdb:lW\x058@2\x86\x97\xf6,\x9d\xff\xff\xff\xef\xff\xebt1\x18\xb0N}\u0682\xc8\x7f*,Pk\xad\xa5ViK\xad\u0496\xdaVK\xedj\xd5n\xa6]\xdaL[6\xb3Z\xc9\xdb*\x032\xe3\xcc\b\xbc\n\xe5\xe6~d\f\x18{\x189p~\xeb\x9a|\xd8)*h\x93\xe6j\x90\xfcVf&j9\x00\xa6E\x10\x90\xce\x166t\xc0M\xc8\xf6\f\xb0t\x7fu3\xc2\xfc}\xafC\xaf\xb9:\xbb.\u0237m\u019a\x99\x93%34s\x90\x80\x10Er[\xbf\xe9\xdb\xee0u4\xa7z\v\v%O\xd9\xeen\xfbp\xafZM\x8e\xd8\xee^\xa7uH\xec\xa3`\xadz\x01\xd8w\xf2+\x04\x1e\xafx\u07cc\xb2=(d\xb7r\u066d\x01\x8d\x94\x15x\x93\xd6[\xd9\xf5\xba*\xed\xbcU\xec\xbc}o\xe7\xad\x01-_\x16\x9a\x15)\x12E\xf2\x00\xf1jU\x17\xe9e\xa8\x00\xec\x12\x1d\x84\xdb<\x8cX\x14\xe9\xa6Sg\x10`m\x03<e\xa3k\u04a8,\xb2\xd1\xd0&~s`\xd0\xe9Z\x06*Q\u03de\xb2B\x7fT\x10/?f\u05a9G\xc5\xc5\xec\xd0\x166p\xff\x92z\ueff4\u07c4\x96\x8e/\u06d1\x88\x14\xd5M\x1f\xb1%e\xd0\xd2\u06fc\u05ba\xdb&\xed\u4dc9x\xa2TW\xb5\xc27\x9c\xfcr\xdd\xd4\xca}\x83]\x89\x06\xea\x16ZL\x8e\xbah\xf24J\x13\x19\x98\\x=\xc7\x04\xb6,\x01B\xe3\"N\x86\xdd\xcf]\x8cxW\x9b6\"\x96\xabJ\v\u05d9\xe4\xd3!\x04\x92>\x8dG\xd9d\xf4\xaa\xc2]\x8a\x9e\u01fc\xe2\xe7r\xe98\xab\xdc\xf4\xe0\xd6\\'\xc9\xfe\u0286\"\xc6\xf7D\xbey\x91\xf7\u0714\x9d\x92r\x0e]\x18`D\xbcwd\xa4\x11M\x15\xe6+pE?\x19\xa8\x10|\xacIra\xf7\xac\v(\xae\x03\xbf\f\xc5\xea\xf5\xe4(Z.\xeb`\x12\x16g\xcd\x01\xbe6\u0351\xfb\x9bj\xffKMa\xe9^\u031a^\xaeQ\xe0\xd4\rrXY\xf1]\xd1R9\a>\xebp\xae|\x05\b\xd25%%\x9e\x88\xba\xdaBZ-\xa4\x1b\x15NQ\xa6RZr\x056\xa5\xe4x\xad\x1f\x14L\xd9\xfbj\xa8\xba\xc2\xc1\xd8p\xa5\x93\xfb:\xbd\x97\"\xfa\x9b\xee\xfc\xee_\xe99#\x066\xd0\x01 \a\x02&\xaf\xcc\xdb\x04z4B\xae\xaf\xe8q\xc2/\b^\xc1o,'\x10?\x99\x81\x03\x96\x1c\x05\x9aSG\x88\xd5\xf7*F\x0f\x11\xd5j,\x89W\xf3CR\xb1\x85\u03f30\xbe\xeee\xe2\xa9\xc4s}7\xc1\x80\xbdjd, 4\xc8\xf6\x96)\x1f,2\x06\x9dk\t|\b\xf5\u0758bi\u8fccV-\xfa\xfe\xda\x14\xb5\xf7X9\x98\x92\x0e\x01@\x84{\xc9+~E\xd5&\xdd\u2329R\xfe\xbc\xaf\u03ed\xaf\xea3\u07df\xdc6\xe0\x9b\xd6(U\xd1[\xf3\xd9\x11\xf5\x12<\x01\x18\x89\xd5\u04ff\u036f&\xa1\x89\x19\xb1\u0704\xe29\xf9;\u019c\xad\x11)\xf4\x06[\xfe\xdf\xd8c\u0480\x0e$\xf0@\xe7\xe0\xa6a\xa7y\xf8@\xac\xde?\xf70V\xef\x8cN>\xe1\x8f+\x1a\r\xf1\xc7-\xfd&\xc1\x1f\x97\xf4\x9f2\x9e\ufd49\xe7\vR\u025d\u0581\x8c\x14\x1a\xb9\x89\xd2\xfd\xee\xec\xef\xed\xa2\xeew#r}x\xab\x89\xdc\xf6\xe1\x01(\x95/0-E\x8f\xe2\xee\xfe\xfe\xfe\xde\x1e<\xc3@Z\b\xef-\x91\xbb\x7f\xb0{\x80O+\x906\x87w\x94\x92x\x0f*\u0301u\x8feL\xb9\x94\xd9\u009a\x8f\u02aaZ\x81\"\xfa\xbf\x9f_\x0f\xb6'\x01u.\x1cb\x89Hf\xd6E\u06c8\xe7<\xf2\xe7\x81\x16\xa6o\x89ju\xe1\x12W\x10\u064f\xa0N\xc7!k\xaa4\xf2\x12-\u01b7\x04\xe6\xcc\xc0\xad\u0244\xa0Q\xf6\x87\xe784Y\xaf\xdb)\xad\u06f1\xebv\xc0\xd7\x16\u2d2b\xf6\xdb9\x97\xae\xf1NG\xcf\xe3\xd9\xdc\x17\xc1e\x00\x01\x8c!\x1e9\xdea\xe0\x93HI\xff\xc11\xa0K\\\x13\x84\x1a=\xd2*\x87#\x18\x97\xde4\x05\x04\n\xc9V\xf0<\u075eP'\x83<_\x13%\xa3|5\xe2M\xf0-\xf4\xee\xfeqy\xf1j]\xa9H?~\xfd\xc5\xc5|\xf4\x82\xc4\xe4.\x8bk1t\x89\xeb3\xc7\xd11\x84DA\xec\x03\x10\xa3 \xc0\xa7\xdcZ\x91\xfey\xba\xf5_\xff\xf8\xe6\xdb\u07eb\u07f9\xe4\xa2\xc6\xfaw\xe7\x83U\xd7;z\xball\xf7\xe8\xf9\xf9\x13\u01e9\x0f`\xd1.\u032a\xb9)K\x97K\xe81\xb0z\xe4*\xd48\\\x9e\xad\\\x87\x01\x15\xc4\xea\xd1u/\xc8]\x9b\xae\x88j5\xcb\xf9\u077d \xdf\xc9\r\xb2\x8a\xf7/\x06\xe4\xbbod\xb2\u6560\xf3\xc8\xea|\xea\n\xa2\xbb\x8b\xe8\b\xdf\xec\u033e\xdcZ\xfb\u04bd\xa7\x01\xea\u051d\xac\x11n\xb5p\x99W\x17\x03bv\xee\x8bi\xda+\r\x06^r\xa5\x94\x02\xefr\xb9\xa5\xdaY\u03d4\xe9\xeb\xf0\x9d0\x95e\u01181\\\xf7\t\u045f>~B\xb4m\xc0\xd1\xef\x8e\xdf\x1f\xbf>\xf9p\xf2\xfe\fZ\xf6\x1b\"\xfe8\x9fgR`\xe9\x96\x13J\xf7}ti\x93\u06be\xdfp$\xa9*\xb6`w\xe9\x9c\x0f=N\xa1\xfb7\xfe\f=\xb7q1\x8dG^\xa1]j\x0e\x92\x97B0O\xe1.\xa4l\xa2Z\r\x89\U0005daf6r\x85\xab\xb4\xd5$2\xe88|\xbaE\xfe!R\x93\x86^)\xac\x80\xec\x17\xbdk\x9b\xbe\x10G\xc4k+\x19[Q\xc7%\x82\x88\ud0ee\xe0\xa1\x7f\xe5\u01a8\xfa@o\xf1)\x92\xfa\x83F\x1a\xcfxi\x1ci.\xddo\n\b;C\x88\x97\xf7\x86}m\xe3bX1\x1a1!\xb7\f\u07a6W8L\x8c\xab\xfe\xcd7\x11:\xf0\x85X\xb0\x99\xf3\xb3\xdc>*>&\x85[H\xed\x90|P_@\x9c9\u29ac\xaf\xa7k=\r\r\x14\f\xa7\x14\x16`\xa1\xa3D\x87l\u0458\xfb\x89?\xe3\x82'\xa9\x1d]C/3M\u0662\xcfM7\f\x8e\xf9\xd6\x18O\xca}\xfdDT\x01\x8a\xe6:\xb5'`\xc9\xc1\xce\u0655\x9bR\xc0:\xd0\xf0\x9c\xdc%\xfd\xf9\xa0\a\xffH\xff\x8a)\xf1\xe0\x83\x81\xebs\xe9\xe9\x01>\xa1\xec4\x936N\x1b\xe389\xf1\xe1Q\xa3\x84\xfb\xeb\xf2\xc6\xc5E\x9c\x04\x93 \xf2C\vo\xb2\r\xe9\xcbe\x0e\xdb\xd3\xdc\x17+\xe2\x86y\x86\x14\xc0/\xd8\xca\n\x85c\xf0y\x9a\xbb=\x94\x7f\x8a)\xb8\x8f\x1fm\x18\xdah\xd3\xd0rM\xd1Qnhs\x19i%\xdbF\x00s\xb5}3p\xa0>\x01\x103\u0192\x00C\x828\xf3$\x1e-\x86\x1c\v\xdfV\xab\xb0?\xf0\xcei\xbeS>\\$\x81\xb8\xc5\xef\xe5\u049d\xf4o\aL\xf4o\at\xa6\xe3S\xe2\xdeA\xf8\xe9\x89\x05C,\xb4\n\u0419\xe6n\xa4Y&\xbdf\xb1K\xdc\x191\xee\u022f\x1b\xa9K\xba[\xee%\xbbnD.!\x8dQ\x1cq\xe5X\xfc\x84]\xca7t3\xf8\x1b\x18\xfc\t`\xb1\xb4\x7f3\x90$\xa75o\xc6\u060d\xaczA\u03f0\xa7\x13(\xd6\u0571a\xbe\xack>]\xa8\x1e\xa0\xbd\xe2\xe9\x16V\xc0\x8b\bc\x93\u023f(\x97\x91\x0e\x11E\u36c4\x8f\xabU\xf9\x173\xe0\x87<\xca:'\xcbRy\xf0\xb1\"d\xb9\xc4^\x95CQ\xb9Egj=.\u0619\xbd\x1e_\\+\xd8\xc3Y\x83Cy\xed4\xf7\xac1\x86\x98=Rj\x03-2\x98\xf5*+\x7f\x9d/\x7f\xad\xcag01\x85e]\x90\xb9;%\x99c\xff\x1c.\xc2ghx\x93l6\x9b{\xe5\x01\x8c\x00\xa1%i\xf1\xad\xbe\xecAi\x03S\xa0)\x1eh\xd1b\fT\xed\xccK!`L\xea\x837\u00fb\x15M\x11\xa3\x86\x81pA9\x9b\xf9\x8d\x11\a\u07f1\xe0\xae3d\xcdnx\xa4)\xadnX\xab\xc9M\x1f\xb2\xb4\x1f\x0e06\xb9\x8e=\xe60G\xcaD\xdc\xf9QS\xa1\xc61\x1b*\xea\xc5m\xd29i\x88$\x98\xb9@\u029b\xe4ZmNu\x8cO\x9d\xdf}\xe2<ax\u022bUw\xcat,\xd6\x16\xc5(\xb3\x12\x83&\xfd1\xe4\xc2\x1f\x16\xb8SP\x010\u02de\x80\xe7e\xe3R\xb3T\x82\x15\x83\x8f\u06bb\x15\rX,\x85\x11|\xb9L\xf2\x0eh\xb72\xc7\xfb\xa5K-\x03\xcd\xca7:\x0e\xce\xe9\x02\x19\xc4J\xad\x82/C\xaaqR^\xdbl\x14\x9c\x81|m\xed\xc3_\x8a\x98\xd2jU\xb7\x95>\xd4\u0595\x1f\xae7\xb5`\xbc\xe60\xa7\x96f\xb7\\\u0718\xf97\xc7\x13\xae\xef/\xfd]G\x19Y\x90\xbe\xf1\u07f8!\x90QA\xfa2\x88\x02\xc1\u0750\u073b\b\xb2z\xbe\xefE\x8d9\xdd\xcak\xff\xa6~<\xe1\u0329YAaB\x8c\x18\x107F\xf1\xcc\x0f\x14!\xa7\xe6h\x12\xef\xedO\x96)\xeb\xef\x05\xe60\xa7\xa6\x1b\x92=\x01\x9dP\xe8\a\x93\xee\xed\x05J\x94\xf5\xf1\xce\x17S\xec\x01\n\xc8\xf6\xd1\x019O\xc9]9\b\x99\x02@\xd0}x.\xb9\xd6\xfb\xc1J\x96/\xeb\xfeDf\xe1\bJ\x9auA\xec4\x15b\xfe6\no\xabUWV\xfaA%8\xa4\x1b7\xf0^\xe2&\xef\f?%\xdc\u018d\u051f\xf1\xb3@d\xda\x0ekR\x95\xacL/\xfb\x99\xe7\x8b<\xbb\x1dxD\xdejz\xaa3\x95\xcc\xce\x14\u03b3\x1e\x9aC\xff\xc6)\x16{\xe5\xdf\xe4\xca(T\xf9\x88\u05a28\xe2k\xe5\xde@b1\xde\xc0=\xfb\xa0g\x91\u06c8U\x16\xa9B9\"\x95\x18\xf3\xe3\xfbS\xe0(\xe3\x88G\x02\xe8\xe9h-1f\xdb\xdd\xcaw\xdb\xd4g\u06ff\xf7\xcf\x17\xcdf\xf3\x10\xfem7\xeb\xf0g\x9f\u00ff\a\xf2c<\x1e\u053e\xd9\xee\u614c9\x1f\xe4\u00b8\x1d\xb7\xaeY.]\xeb\xb6\xf7\x0e=^\xbc^$\xf9\xbc&3Q,!\x8d\xd8\xf6\xb7\xed\xe6\xf6\x84&\xccy\xff\xf2yk\xbfs\xe0\xd0\x18\x7fw\x0e\x0f\xf6l\xaf\xf1wz\xedb\x8a\xa1\xf1\x84\xe0I\xea\u0769Z^\tq/\x948\x86F\u0529\x81\xfbP\u056a\xb7Q\xaa#%V\xba\xc9\xc4T\x88W+z\xd8\xde9\xdc}H\xaf\a\xccO\x95\xf15\x98.\xeek\x7f\x04\xed\xbdCb\xcfE\xce \x85\x18\x03p\xfbz15\x8e\xdd=P!\xc4\xca\x0fv\x06\xe6\x82\u0419\x92\xca\xe64z\xdf^G\xdaG6\xf5Y\xce\xcb2\r\xd8\x1dH\x04\xaf_\xc4\"\xf5\xb6Z\x14?\xde\xe9\xdaY\u0499\x1c\x1c|B\xbdW\xc1,\x10^\xbbI\xe19\x1a\xd4:\x95f\x8d\xfe>\xe3\x91\b\"\x1eB\x85a<\x9b\xf9\xf0C\x82i\xe2%\ua2a7#\x1eB;<\U0005caa3\x1c6\xef\xd2`\x12\xc5\t\xffi\xc1\x93\xdbw\t\x1f\a7P7\x88\x04O\xe6\t\x17o\xc0\x1bv0<\x89D \x029@C?\xcaQ\xb5xG.%\xce0\x05\xaf\xd9\xf3\xd0\x0f\"\xb94XC.\u07dbE\x18\xfe\xe0G#\b\xa8\x82\xee\xeb\xd3\a$u\xd5\x7f`\xa8\xf6n1\xfejQ \x98\x8b{jB\xaa\n\b\xc0N0\xaa\u9095V\x87\xb8\x90%\x8e\x9fE\x03\x97\x10\xe4\x13\x86\u0721\x0eyZo\xf54\xe9\x04\xdf\xe0\xcc;d\u0178\xb0J\xc9W\x85\xa8\x8a\x1af\xb7{\xd6\xc4\xce\x1bn\xff\xf7F\x7fPC\xe9M\xff\x9b\xd6\x00\x9a\xa3\x01\x83(\xb6\xfd\xdf\xfb\xe7\x83\xc1w\x10\a\x15D\xa8\r\xdc'x\xb3\xcag\xca\xc0\xa8>\x88T\u04deoT\x02R9f\xe2\xf9t\b1\xf2\x81\xe1\x90wa\u0530\xb7\x05\x9e#\xf0\x8c\x16!\x98\x86\xa4Z\u074a\x1a\x05\xc8\xd4\nQ*\x9azH\x8cI\x11\xb8<\xb5F\xa9u\xd5S\x16\xe8!\x92ju~\xa4\x8a\xc8\xc0R\xf3\x1ak\xd1G\x8f(\xed\xb7\x069\xb2\xf0\x11\x03\x84:F\u0415V\xab*\xd9\xe9;5\xbdXz\xa9j\xce\xc0!t}#\xf5\x04c\x96\xf4\x84\xb7\xc0g\x05\u02e3w\xbd\xd5\xf5\x9f\xb2f\xb7^\xf7\xe5v\x83\x04\x91+\x01\x83\xd3\x1f\x00s\x95J\xe5Ys8H\xc0\xfaY\x84r\x1dI\x8b\xe5\x17\xa2\x97\x7f\xea\x91a\xec\xeeV*\xb4\xb0\xd3\u01c6Q1\x05c\xb4V\xab\xce \x97\x94\x9a\x01\x92^j\xaf\x9b\x97\xd2!3\xe7#\x84\xf3\xd1\xcd\r\x0f\xe4\xbc\x18\xb7sK\x92\x85\x10\"8\x95Q\x9c\x14\x96\x1e\x12\x86\x9f\u00e7\xe0o<W\xb9Z\x1d\x1e\x01\xc0\x1b|\xd5sa\xb6\xa4?\x1c\xb0\xd8s..pC/.\x1c\u0660\x1b\xf4\xc3\x01\x8b\x89\x17@\x14\xe8x\xb5\x8aY\xa0\xf7+^\xb9C\xb5\v\xabR[\x85\xd2'\ubf1eb%\xc8H`\xc6\x15\nL,\u1c55VB\u0119\xdcr\xc2\xed\x85\u032cL\xfd\xb4\"b\xc9w\xe9F\x1a\x92\xb8\xb2:RX\xbaZUh\xbb\x90\x18\xa4q\xfd\xe0`\xf7\xb0\xde\xca\xe5\x94\xf7\xfca\xca+\xaa@E\x13\xe4\x8a\xf5\u3058\xf2\xa4\x82}\xd0J\xd6*\xad\xc4I%\vJ\xaa=p\x1b\xdd\a\xd3c/\u043f<\x93\xa6d{\u05b5e\xd5\u02f0[\u0430\xee\xb5-+g\xed\x8as.\xe38\xe4~N\xf9\xbaP\xa6\xb7\x96\xe2\x05\u0154\xdc=\xb9\xb1M\x99\xdf\xcb}y\x81\xfde_\xb0\xeb\xef\xa468\xdb\x1f^`}\x98[Y\xac\xdd\xc7e\x03+\x94\u9b65xA1E]\ua96dANO\xfd\xf5\x02\xf9\xd7\xdc\xfd\x1bT\xdd1\xb3g~y\x81\xfee\xd3\bkWc\xc3d.\x97I#H\xdf\xf3\xc9\xc9\xcd\u0735\xd2I\xcf\xfa\xf0\x82\ucde26J\x16\x183\x96Ke}\x80_\xbd\x9a\xfa\x81-\xcc\u0174\x8cF\x91\x00\xb8\x96\xb1\x99r)[\xbcM\x85{\x9b\xb3\xbc`cV\x912*\x99m\xbeD\xaf\x98\xe0\x05\x85\x84<e\xd5B\xf4`%\xe5)\xad\xb2\x19\xe6.\x96\xfc\xa7\x17\xe4>\u02e8\xb4\xb2\x16\u05cb\xf5\xca\x12\xbd\xa0$\x11TO\xa5\xce4\xdcX\\\xbeV\x80\xec\xd5\x18\t<\xe6\"\xd4\x17\xf3\x90\xad\xc3h\xaf\u43a0!\b\xc0\x86L\xac\x83\x8bM\x95\xfd~\xde\u06c6\xe71x\x9da\xa2\xb0\x11\x8c\xb1\xd6v\xb3'q\x9fW\u0325(\xfbB\xd2PX@\x8f\x0f\xd9\xf5\x16\x1d1a\xd0)\x86D*\x1co|w\x02\xf5\xb1\xe8h\xac\xa5o\xb5ZD\x00\xc6\xc7\xfdh\x90\u0461\xa0\xf9\xc3\x1c\bX&\x7f~{\xd2\xfe\xf6\xf0\xf9\xb7\x87\x1dG\x15\ud358\u05baW%\xda{\u07f6\xc1UH\xb3\xf3m\xe7\x99.V\xad\xba#f_<0\xd2\b4\x9dU\xff\xa4\xbbaL*V\xab\x12P\xcf\xe8\x15\x9d`\x8b\xf4\x96M\xb2q\x0e\x98C\xe8%\xab\u00e9\xbe\xedMrrC\xef\xb6\xd6\xeab\xcee\u03dd1\xa1q\x8f;\xa1\x16\x1e\xa2\xce\x17~\xeb\x10z\xc5D\x19\xc8a\xbcY\u062c|\v\x86\x0e\xbe$\xe5\x8d%\x8d\x99\x7f{\xc9_\xfbswa\x8a_b\xa0\x12B\xddRVV7\xce\xf3-\xa2\x18\xdc\x01\x06\x83\xd0+\xe0\x1a6\xa1\x85\xfc\r\xcf\x18\x1bU\xab\xee\x15K\xdd+B\xa8\xb54}X\xb4\xa7\xf5\x16\xe6\xfa\xee\x15\xe9\xf5\xaf\x06\xde\x15\xd1\x1eTB:#\xbd\xb0?\x1b\xb0\x04\xb0\xfce\x10q\x17>\xe9\x15\xf10\xf9J\xd3O\xf8\x00\x18I@~\u0511\xa2c\xcd\xc9~\u1de9;$Tz\n\xb7v\xde\xf8\v\x1f\xf7\xa7\x03\xf4\x15>\xa2\xc3\xfeh\x00,\xfe\xda)$\xdd9\xac4O&\u071d\xd3Y\xa6\x9f\x8bh;\xca\xdd\xcds\x0f\xa73\xf7\x87\xf0H\xb4ZQ\xe4\xe4\x1f\xe2\xc0\u047aX\xb2\xfb\x8a\x19\xcf\xd8}\x1a<\u0217\xa7\xec\xee2\xf1\x87_\xb8H\xcbd\x12\xbc\x06\x04\xfcJ_\xbb\xf8\u01e1A4\n\x86<\xf5\xf2(\u01aa\xe2\xd4\x040\x13+\x9a\xf09\xf7Ei\xcb+`G\xf3B\x81p]J\x83\xb8\x84\x0eU\xc1,\x1d\x98\x98\u0723#\x8c`\xa8\x82\x04r\xbap\x05\xe9\t\xaf/\x06\x04\xf6\xf4\x85/xN]\xe6\xf4\xec\xad\xec\x8aNQ\xf2\x8fb\x1d:bw\xfehT\x90\x00\xe4\xc4\x14\x8f\x10;\xe4\x85\vR\x1a\x06\xb2\x00\xf9+\xf1\xb4\xe8]%\xfc\f\xa7'\x05!%T\x96B\x19o\x9a\u0257<\xbfa~\xa7\x00qz\xf1A\x9c\xa0\xa5\xfd0\xbf\xb2E\x1eg\xda@\xe9\x97`\x0e\x88\xe3>9\xc4\f\xae\x87+\xb3\xa8\x15\x8eZ+\xa8\x12B\x87tL\xa7\x80\xe4\xe8-\xbd\xa4\xd2\x17\x86\xe1\f\xe1\xd1L\xd0/\xec\x06\xfc\x92\xd0\xe7`\x90h\xa8~\xf7\v\xfb\x02v\xe1\xee\f\xf9\xd5\xe7\xea\x89\xee\xadJ\x95\xd7\xe01\xf0\xc1\xa6\xca[\xe4`\xde2\u018e-\xda\xff\xbd\x1fM4\xf1\xff\xfcv\x18\x06\u00ca\x8aA\xa70P\xf79X#\x19\xaa\\\xf7Z\xad\xba\u01ec\x89\xc2\xf7\x12*p\xdc;cc7\xa2g\xc4;\xb3\xa3\x88\xc1\x92\xf6\xce\xd8\xc4=#\x1a\xee\x19c>\xa8?\x9dA\x93g,\xce0\xe8Y)\xc6\xe4k\xeda|\"\x8e\x98R\xc5\xdf<\u00e9\x06&\x1c`\xb5\xbau\xdd\x1b\x82\u0589\x02\x93\x84\x9eH\xa4Mo\x89\x17u\u03d8\u3b2c\xa71=\x0fx\xf6#\xcb\xe5\x1a\xa5u\xb1\\\xae\xd3.\x90\x98b<\xc4BAx?\x16\xb9\xb48\v\xfdw&\r\xb7\x87\xda\x1f\xccu/\xf26\x8d\x14I\x1b{\u056e3`9e\xa1\x04K\xc5J\x9f\x11\nb$\xfa\x819\x0e}\u00da\xdd7G\xa7\x19\xba}C>\xd4\x18j\xb4\xbf\xe99\x8e\a%k\x97\xee\xd0=\xed\xbf\x19\u4ed6P@o\x89\xd6,\xea_\xba\x9f\t\xbe\x02}\x18\xac\x8aI\xd0\xc6\u0666\x06\xac\xe2\x91.nFK\x06\x18\xd5\xf6\x1d}\xaf\xe4J\x06\xe2\xce\xf46\xbe_\x9b?@\ry\xc7\xfa*N\xe0\x99\xd6Eh\xf6\xce\x1a\x9f\xe3 BI\x9a$\x03\x15_\xb9\x1a\x18\xbd\x80\x85;\x86\xca\xe3,Z\xd7\xeb\xdc5uF\xba\xef\u0634\xf7\x1ac\xb4\xbaS\xe2\xbd6B\xa9\x17\xac\xd9}q\xf4.[\xcf\x17r\xfb^\xb1w\xfd\x17\xe0kg\xcd\x04\xfe\x95%\x1ax%\u07f8{\xea\xafw\xd6\x7f%cU\xa5r\xa8[\x8c=\x93\xed\xbdd0\xc3^\xc9\x01\xf3{\xbe\x1b\xd1W\u010b\xbc\xa8\xe6^\xf5\x9c\x86S{\xe5\xc1\x1d\xf1\n\x05N\u075bF\nx\x80\x1eK~\xfcO\x96\xb8\xa4\xfb'&\xce\xc0\xe1\xce\xdc}O\xb9\xfb\x8c\xbe\u0708\x89\xfe\xb4\x9eA\xdf? '\xa11\xe39qeAZ2\xcaIK\x14t\xe4\xa4%&\xadTZ\xa2r\xcbe\x16'\xd1\xfd\xd2\x12\xad\u98ee\x95\xe5rd\x93\xcb\xff\u1094(\xbbI\v\xa3\x95\x17\x98\\\xe8@\xa9\rZ\xb7\x1a5%\xca\a\xa8\rCe!=\xc6y\x12_\x05#>\x82u\x8bL'+I\x17\xe5.\xcdh@c6\x02\xe5g\xc1u$\xc7\U00098af2\xc8r\xb9p\xf5o\xb8\xb3\u0718\x99OZ\xa4\x10J%.\xb9\"\xbdb\x827*$\xd0\adJ\xa3\x8d2\xa5\xbf[\xe02*\xa6X\xe4\x8c54\x93\xd8\x1be\xbf=+]\xd3=e\xa3\x90Y=\xfd\xc3\x1b\xe5\t\xa2\r\xb2\x1a\x95i\xaa%^\x86\xbb\xd7(\xa9\xcd\xddf\x85z\xebI\xdeh-\x89\xca}7/~20\xb0\"\u0352\x02\x05V:\xf0\\\x91^\xe1\xdb\x1b\xe5\xbf-2\xadl\x0e&\xb7g\xfd\xf6F\xd9o\f\xff\xbda\x1cq\"z\xf2\x0f*\x19\xfe\xddb\x8e\xd1F1G\xc9pBu\x9ez1s\x9bT\x7f\x11\xd7\x01/\x10\xde\xc25)\u056a\x1b1\xf3\x85xfH\xc7\xea\xe2\u03543U\xb3\xb1\x16\xa4\x18\x03(\xc7\xe9\x0e\x99\x00\xae\x14\u0154/%\n\t\xa2J\xda\xcb%y\x886%i\xed\x04QE`\x88b\xfc\xec\x99t\u03d1\xfc\x8c\xe3\x99$\xa5c\x98\xf6\x87\x83\xaet\xf8b_\xcc1!4\xc4%\x877\x02\xf8\xeb\xca\xcfL'i\x06\u05dd\n\x8a\x10e\xd7\xf4D^W\xb7\x10\xef`\xd0\r\xb3\x1d\xaeV\xf5\f!$\xc1r\xee\x8e\xe9\x95\v\xbf\xe9-\x9d\u04b0d\x13\xa8U\x9b\x86\xfa\xf8\x85\xe6\x1c!0\xe8%V\u00e5\xa1\x85d\xc2\x02\x8c\x86\n\xb9\x9a\x1f\xb2\xde\xda\xd1\t52\xa13BV2\xba\xc2XR;\xa1%*\xa5\xd7\f\xb9\u1c087\x9d\x9ec\x19\xf4\x85E\xd4\x04\xb2\x9f\xbc<\xc1\x14\xe9]\xd7X\x99\u0527\xeaxY\x8e\x96\x18UAB\x93\xd1c\u05f5K0\xb5\\Qd\xa7\x1fb\xbf\x15\xa3\xfdW\x1e\xc0-\x8dB\r\f\x1c\x94.\x05kv\xc5Q{\x17\"d\b\xc2\xd5{\u0777N\xcdu\xc5Qk\xaf\xe74aYHMd\xe6e\xad=B\n\xda\xd8]\xc3g\xbb\x84\xa6\x9b\xbcj\u0263\xf1\x18\xc1H\x02F\x9d\u019a\xb3[\xab%$\xbb\xe1\xfb\t\b\u04a2~2\xc0\u07e6\U000dc0ed;<n\x1fb\u0641\x97R?M\x83IT.E\xb0O\x91 \x8d\x84\x83\xcaiN[\xd7\xf2\x83\v\xb6i`\xa0F\xf9\x8a\x80\xa6=UR\xa1\u04a6\xb3wGH\\Q%r\xf1\u02bd\x84\xf6\xef\xe2\xcb\xcf\xde]\f\x8f\xdc\x101\xdcsbg5\xa0\x11l\x94\\\x12a/I\xf6T\n\u65a8h\x17_~\ue8d5\xda\x1c\xdc\x06\xdb\xf3\n@\xef\xb0\xd9]d\x9a\x86\xb5\xdaBk\xa7\xa5\xfd\u0140\x0e\x19<\x0ev\xd7h\xf4\xa1y^\x1eV\xab(A\x8c\x8c\xf8\f\xde*u\xb0y\x1c| \a\x0e\x8e\xa7\"\x99<$\xe6E\xb88\xeb.7Z\xd2\xddL\xf3|\x1e\xcf]\x82\xaa\xe70\x1b!g\x03\x18\xd8w#\x92-W\x02\xab\x12\xb3f7\xb6QY\x9c\x01J\u050f\a\x10N\x1eG\x01\x1f\xa4\x9bk\x92%+\xbc2(\xc6\aG\xea\xa0\xdc\x12\xd7\xd6!\xa8\x81\xee@E\xbe5\x16\x10B\x94\xb9\x833\xe5\xbf\xed7\xeb\x87~}<\xb8k\xaf\xc0\xfai\x11\xf1t\xe8\u03f9T\xf65\xd1\xf3\x8bJLnb\xe9\xd1Z\x9e\xb6xT2L\x8c\x0e\xb3\xc1\x12\xbb\u0095\xae\xa3\fM^\xe4\xc3y/eg\x98\x96\x13X)\xc1\x98\x12\xe7x\ub6b1\u056a\x9b2\xa3BD\u8995\x90\x93uS\xcb&\xe6\u06c5Y\x92\x1d\\\x92\x12a\x86#\x11\xa9S3\xcf\xe6\\\u024c\xdb\x04\xbc-\xd6\x1c\x10\xaa\xaf\xac\x90\xe0\v`\xe7\v\x9a\xb4\xb5Zh4im\x8f\x12!\xe9\xee\xec2\u0186\xcb\xe5\u039e\xfc{\xa8\xbe[m\x950|\xcav\x0e\xf0m}w\x1f\xbf\xf6v\xf1\ubc09_\x87\xfb\xf8\xd5j\xb7\x97K\x1f\xfdR)\x1d\xaaj\xd5\xddi\xaa\xb6\xe1\xa4\fIoQ\u02d4\x04B\xe2\r\x8fZ\xed\x03H\f\xfa\u00c17<j7w\xd4g\ubc3d\x1c>}\xba\a\xa1\x1f[\xed\x83\xe5^\xa7\x8a%\xd0\xdf\x05v\v2\xda\x1dY\xb8\xdd\u0781\u00ad\xb6.\r5\xab{\x9d|e7\x04Q\u05d0\xed\xed\xeev\xf6j\xae\xdbj\xb6;\xd5!\u0128l.\xf1waa\b\x95\x8d\xef4\xb1\xf1\x03\xab\xf1V\xdbj\xbd\xac3\x92i\xecQ-\xc6)\x11\x13n\x15\f\x8a\x8c\xf0\xbaZ\xdd\xdaBg$Q*\x92\xc5P\xc4I\xb5\x9a\xfb4\u00a1M\xe9\xd2\u0761~!-\xe9\xdb\xe9\xcbn+\xb2\x04*p\xac]\xa5E\xf0_Q-y\xf3\xd6t\xc6\xd1\u00e2u\xc1\x19<m\xae\xae\xa4\xc6ZDaB\xe1\xe2}e]X\xb6\x96!E\xc1\xbdW\x90\x87&J\x8dH\x9f(QJ\x8aFj4\xc0\xd4\u02be\"\xad\xe6\x02\x16\xf4%\xeb\xad}-\xf5\x05\x8d\x06]7A\xe7I\xf6\xd5\f\x8f\xcdEe\x1fp\xa7\xb4A[(B\xde\x15\r\xb7\xb7\x9a\x96_\x90\x87\xfb77d\xa4=\xcci\ud1ca\x8f\x1e\xf1\xb6\x00\xef\x83\xea\nK\xd1?#\xa12\x1d\x92{nTb]\x13)\x84\x18k\xbbQ_)\xcb\aL\xa0\xaa\x90m*\xa6\x06\x14T\xabQIr\u0503\x1a\x8c\xbb\x01n\x86g\x96W\xda1`f\xb4\"\xf0\x90\xe5\xd9\xd7mTBF\xc08\xd40\xd0$J\xcd\xd2\x1a\xa5\xee\f\xfe\xd0\x00\xfb\x83\x84\x80\x8a\x15\xa1\x01XC\xd0\x16\xc4=@\xbd\xd3\xcd\xdeD\xd9(\x1e\xa2\xba:\b\xa5\xcfx\xc8U\xae\xb4Em$ \xdbF\azk\x0e.\x89\xf5\xd0+\xb2f\xfc\xa1\b\xae\xf8\x89\fSOsp\x9e5\xd6Mj5\r\xea\xe8\x9c\x15e\xe8\xc7p\x99\x11\xe3\xff\xac!\xfc\t\xd8\xc2\x15\xcd\xf9P\x9d\xf8\xf4\u037b\x8f\x1f\x94\x97\xb0\x0f'\xbf|8~\x7fr\xecx\xa2q\x19.@LWP)fh\xbdf)0\x82/\xbc\xe30\xc4nS\xd7R.#w\xces?\xe1\x02\x9f\xbf\x1b\xb0\xb3\xd5jY\x05{6\xcbe\x19d\xa1\xf7F\x7f4\xc2*\xe0\"\vv\x1e\xa8\xdaq<\\\xa4\xae\u0721\xce\u07a1\xdc!M\u0454E\u8de2\xe7G\u04acF\x1f|\xccF/\v\xd23\xa7\xe5\xd00\xc9\x13&} \xfa\xfa@\xb9m\x19'(\x16\xfe\x98k\v\x91\xa8\x17\xbbs\xe2\xcd\xe9\x14\xde\\\xd0Jf\xc6\xee\"$%(\xfc\xb9\xf0\xe6h\x1b\xe9e\xf4jB@\v\x82G\xc2\v\xfa\x81\xe5\xa9H&\xa6^@\xc1Oh\xa2\xee\xe5z\x8b\xf4\x9b\x03\xc0\xbdq,<\xe5\xaa\x11\xeb\u0410_\xf1\xd03\x9f\xc3 \x19.B_q\x82\xd2i~\x01\xb1\xce\x1a\xb2\x9d\xe5\u049d5d\x7f\r\x18e\x7f\x06gk\xc08\x18lA\x02\xe3\xb0\xf8\xee\x88m\xb5\xc0\x04\x9d\x87\xbc\xf02%\x93*e\xadP\x9e\u0554\x90\x90\xab\x99\xe6\xbb&\xbd\xdc'\xbe\x14\x0e\xb9\x8bM\xd1\x16\xf1\x1e\xd9\x11\xa0\x069\xf1K>\x8e\x93|\x97\u04c6Ld|E\xfd\xb1(\u071e\xd3\x06\xa61d\r\x8a\x15\xe7\xb2\xd6<NE1'N\x05d\xa5\"\xb6\xee/r7d\xf0\x06w\x19\xc6\xc3/v2\x8cs%\r=\x87\x1a9\u033a\x96\u017d4\xbf(bI\xb9\x1d\x86O\x90\x9f\xb0\x910\xe1jU~_0\x9d\x83\x1b\vY\xccwUY\xd8\xd2 }\xc5\xfd1k2&s\xf5\x1d\x9a1\xac\xcd.?\xd2\xc0\xd8\xe5\xb5\x1a\xe8E\x04}>0\xed\u03e1S\rb\f\xb2$\xd6Pfg\xa6\x0f8\x01r\x04h\x9e\f\x83\x102C\x17A\x10\x13\x00\x862\r~\xad\xae\\yGM\x98\u04b5\x9f)H\\w\x951\x81iK\xf0\xce~\xb9\x13B\xf56W\xab\xfaW\xa1):\xea\xad\xdf\xf0z\xe1\x94<F\x7ff\x93\x057\xab\x12\xf7\xce\b\xbdr\t]\xa85\xa6\xf6\x05\x14\x83\xa3],%`$s9\x8cyq\f}1\xa0BN\xd5g\xdc5\x89\xa4\v\x86\u042a,&R\xb8\x89\xb3\x02\xccWs\xf0a\x19\xfdT\xb0\xb8\xb0\x9b\xf5\x16\xe6\xbd\f\x92T\xc0V\xc7T\x02)\x8e#N\u037a\xfa\x84&\x92\x15\x04\x1c\x1b\u021f\x84\xaacP\xad\xaa\x1f\u0175\x9b\x11o\x06\x9e\x1b\xf1se\xf9\xdd\xe5\u541b\x01\xadq\xd5\t\x16ahT\x06\x82?\xfd*fHG\x10J\r\xa4\x859\x96\x01\x04\x0ei\xf2\xca\xfb\x10\xccx\xcf\xfcr\x89\xc73\x83\xdb\xc7\u0463\xd0\xec*kZ\u6e0fk\x06\x9f0\x8a\xad\xdc\xcdx\x9a\xfa\x13\xee\xf1\x86\xfa\xb5zL[\u03e4p\xb6lL*\ub443z\x83\x8f\xc4e\xed\u021cG6#\x89\xf2\xb2f\f\x1fj\x9a\xc9I\x95\xaaU\xf59\xe1\xc2\u0432o\xc7D\x14\x9c\xfb\x94\x96\x82^L\xb39\xae\u00f0\x0e\xb0\u012b\xec\xb14b\xf7\xf20\x86b\x06;n\xa3\x9d\x8d\x86\x92\t\u02d1aVY\x16Q9\xd5\xc4\xf0X\xc8zXG;\"w\x99\xd7$ JVF$\x17\xe5]\xe6\xdf\x17\xa1\x06\t\x0e*\xf5\xcf\f\v\x13i\x8b\xd4\x04[\xef*\x92~+\xd4t+8Y\u05ca\xcd\n\xdd\n\x14Iel\x00\x8d\xf2\xc2\xca\xff\x96A\x18g\t\xb9\u07b7\x9a\xf9\xdeS.6\v(\xb3\xfe\xf3|\\\xbd\xd5M\xf4\bb\x14;vCmX\x1f\x13\x10\xc5\xf7\xe3\x01\xbb\x03\xa9\x17\u029d\xf4\u0323>\x94\x1d0A\vk0\xf3\xe7e\xe4`\xe2ZC\xe0\xe0[\"_MQ\xa4eU\xb3\x8a\xac\xd8J\x8b\xd0,!\u07e0dRJu\xfcA~Qt\x04C\x13\xedx\x11\xdb\xf2D\xce\x03^)\xbd\x8c9\xf2\xfe\xacV\xe1\xd5\x02\x04jYp\xb2\x84\n\xb4\x17\xa2I~`\xe8vb\x9d\xb3\xe9\x0f\x1e\xeeQI\xb1\xb1\x004\x83\xcd\x17\xe6\r\xd7\xc3\xdf\xd0<^:%\xcd\x0f\xc38\xe2e\xcdSa\xf5a\x91\xfc\x96\xe5K\xc0\x9a\xdd \x83\xfc@\x929\xbc\x1f\f\x80\xac6\x82\x80~0\xe8\x96\xddh\x89\xb9\xd1\x12\xed%%v\x13\xcb\xd7\x1d\x8e>!T\xb1\xb2)\x90\n>$\xe4\xd0o\xda\xe7\x03\x16\xb9I\x9f\x0f\xe4\x04\x95\\V\xa8\xbfif\nnA\x1bY)\xa2\xc1\xe2\x85\xf3\xfe\xbd\x95\xa4{\xd0-\xfa|3r\v\x834\xba\xa5\xfe\x87\x1f\x16\xd8\xc8I\xe7^@\xf2c(\\-X\xe2\xb1\u00a0\u017a\xe7\x00s,3\xd7~*\xc1\xb5\xde\xdeJ\x10\x1a\b\x84\"\xd0\xe5\x84u\x83M\x88l\as\xb9\xed\x10\xb0\x1d6\x14j\x0eP2`r|\xe6\xb4\xd2\x16*i\"o\x99\xc5\u05c8\x81R\x96J\x8b1\x1a\xf9(\x9f\xfe\xa1\x9ew\xfe\x15i\xb9\xcc\xcfT\xef\v\xee\x178:\xdb\xd9\xdd\xdby\xd0\xf1\xfb\xbevY\xe6\xee\xee\xb7\u0687D\xd9\xe5\xde4\x9b\xf5\xf3\x9bv\x13\xccp\xfd\xe6\xf9\xa2\xb5w\xd0<_\xb4\x9b\x90\f\x7f|\xf8\xb7}\x80\xff\x1e\xe2\xbfc\xf8ww|\xbe\x00\xe7\x0e\xe7\x8b1\a\xeb\xddm0\xdf\xeb\x9fG\xe7\u0279\x18\xa0\xe9\xde\xf6\xef\xfd\xe3\xfao~\xfd\u03c1\xfa\u06ec\x1f\xd6\xea\x8d\xc1w\xde\xf9\xf6\xf9\xf66]\xb0m\xef|T\xfbf\x9b\x86l\xfbw\xb7\x8f%e\xb1F\xad>\xf8\xce#=\x17J\x92\x9e\xdb??\xdf\x1e\xd4\xf0\xc7\xd9y:\xf8\x8el\at\b\x1d\xf8\xf5?\x8f\xeb\xbf\r<\xcbtxnG\xfc\x97\x9e\xbb\u0303Y&\xfd\xf6\xd1\x11\x94d\xca\xfb}\xe7\x1f\x0eu \u04913\xa0}\xa7\xe7P\xe7\x0fx\x90t\x06\xb4t\xe1g\xae\x82\xcca\x1c\x92\x9ca\xe39<JlK\xe3\u023e\xb3\xedP\a\xd0^\xe4\xcf8\xb6\xfc_\x0eu\xfc\x85\x98:\xb45\xa0\xfd7\xfe\x1b\xeaL\xe3T8J\a\x92\xb60}\xdbs\xcfG\u07d1o\xb6\xa9\x03\u048a,\u05ee\x83m\xda\xf5\x06 S\x809x-\x8a\xa3\xf7Z\u05b9\x1d\x99\x13O\x13\xe6:\x99\x12\x8f\xe1k\xae\x83h\x14_\xf7\xe4\x1f/{\xbbiLzQc\xe2\x95UIy8\xee\xc1?\xde\u074a4\xc2x\x88\x1ef\x90\x84\x8a\x95\xcf\r,\xe8r\xb0uH\xe4S\xcde\x18_zR\xfec\xd6P\x9e\x90\x89\xab\x1fe\x94\xeb*\x98#\xa1w\xab\x8c\xf0\xcb\xf4\x1f\x99\x8f\xafnx\x06tu\x0ee\xe9\x94(\x19@\xdc\x17\x16\u06d0y\xa4\xf2\u025d\xa9\xca\t\xfe\x99.\x97.\x14g\x92\xb72:/q#\r\xfdt\nJ\xec\xae\xf9\xcdR\xe5\xee\xa21M\xf88{T\x8bWygz2\xd9\x19\a!\xf7\x94\xbd\x873\x16s\xf3{*\n\x1f\xa9\xf9\xba\xb6\x7f\xaa\xdfk\xee\xf68s9\x03p\xcf\xc0:\x00\xb0\xa6B\xfa:\xe9j'r\xa1\xb4D\xe5p\xfa\xc1\xc1r\x0f\xfe)\xf80p\x1c\uaced\xad\xa4\u07c6\xf7J\xf8\xd1\x19\xe0;\xa5\x9a\x9c\xdfK{n\xc4 \xbf\x06y\xb5\xa4\xbf\x03\x05 AaRLW\xbf\x89\x97\x15.\x96#\x9ej\xcan\u016a\tY;\x03\x9a-\\\xdc[<em\xd4\t\x89\xcc\xf3\x13\xf1fnLz\xb2\xb0\x17\xf7\xfc\xb5|Y)wX\xb1\fT \xf4N'\x82\t\xbc\xdcX\xcf_.\xa1Q\xfd\x8d\xe2GoA\x13\x9e\n\b\xba\x93w\u04a7\xbc\xb2m\u0705-\xbc\x8dme\xe0I\u628d_\x9bF\xe4=MS\xba\xa0!\x9d\x82v#\x1b\xabY\x80\x92\x84\x16\xd3\xd3\x13$\x8a!l$\xded\x96\x8b\xb2\xeb\u0310[~\xb9\x11\u0439(Q!4*UY\x8c\xb0T,\xad\xa9\xe0.\xd8rSv%1&\x15l$}\xe1\xe95\xaaV\xb7R\r\xfe\xf4\xc4:\b\xea\xd7r\xe9\x83\xec5+\xa1+\xb2\xd4\xfc\x04\x1fG\xd9o\u01e1\x9c\xa5\rXZ\xeaf[\x9dZ]\xbam\xf4\x89eo\xc6r94\xaef\x96\xcblL\xf0\fj5\x9e\xaft\xd4^.\xb7fn6(\x82O$\x97\xfd\u0380\xf5\xb7\xdd\xc6wd\xdb\xc6\u04e4{s\xa4\x15C\xba7\xb5\x1aY_=7d\x97\xe0(\xaa\xe7.X\b\xe2\xd6[\x16\xf6[\x03\xba\xd8b\x8b\xde\t8\xbe\xe2\xeb\xf6\x83\x8b\xde\xfft\xa7\xcc\xf9/\x98\xe6\xa2'\xdd.\x9f\xaa\xd7\xfb\x05\xf12k\xfb\x05\x0eoM\xa7;\x04/\x8f\xael\xdd\xd8\xf9L\t\xe5\xe6sZ\v1\xf4\x81\x97/\x95+\x03U\xa0\u0114-4V\x80\u07b0\xc2\x14\xe6\x90+*\xc7D\b\xc5\xfc\x13\xd4=\xf2\xab\u0570\xdf\x19T\xab\x02?\u1e77\xbf3\xd0m\xc0?\x8581\xc4\xe3,t9=A\xa1\x95{\xd2\xc0\xeb\x89E\xfa\x17\xbc#Y\xd0S\xad:\xdb\x00\xc7'\xe6\x0e\xb0\x8d\xbd]'\x9f\xa7l\xb6\x85I\xc0\u0658\xaf\x12\xefR\x88L\xb3W\xbc\x8c*\x84\x90^pyk'\a\xf83\v5`\xf9\xa84\xf9\x04\xd80\u0351\xc5,\xea' \x99\xf7\x19\xb8\x90\x00\xdcY\xafw\x89\u04c0\x1eAK\xa6\x17iqu\x02\xa2j\xa7\x91\u5e39,\n\xac\x86\x17T\xab\xa8I\x00O\x82><\xe6\xd1B\xa1z=\xf3A\n\x9af\x8b(\x9d\x06c\xe1\x02\xf6q\x1a\xb0,q\xb5\xea4\xe4/xF\x91:E\x0e4$\xb5\u03f7\x1d\xb2\xb2V\x8bZ\xcbH\xe8}\u06d0;N\xf9\x15w\xb6\x9dZ\xf6Ih\x02\x99q\",\xac\x00|\xfaI\x03\xc8\x18&\xff`\xe7\xb2\x18\x83\xf1\x9d4\x16)O\xb09h*M\xaf\xe3d\x04\x9a\x05'\r\xa0\x9f\xaaU\x17\u0392\xfc\xc8,\xc8<\x87\x90\x9e\x9b\xab\x8b\x05\xac\xc3be\xae{\xacqK\xf4?\xb2\np\n\xb2\xb1\u469e\xd6Z\xb9\xcc\xc76\xad+\xc0\x19\xf9\v#\x83\x11\xe0\xa8\xe0\x87\xb5P\xbd\xac\xad\x9a\xe39\xb5,\xc7\xea\x05\xeaI\u05c1L\xa1_\xb9\xd9\x06\xfd\x16\xb6XnT/K\xab9\u06f8\u04d0\f\xb6\xbea\b\xdb\x03$\x11;\xb1H\xee\x95e\xd7\xc5\xeer1\xf9\xac'4_\x86g+\x84R\x92T\xf8:*\x15Y\xe8\x17x\xebf\x10tO_epqQ\x1f\x984a;HBJ\u0693\xe94\x817^\x8b\x84\x87\xb7+_\u00a3o\xe0\x11\x97N\x10o=G6\x0f\x1e\x80\xad\x0et\xa6\xe9\xc4Gp\x86\xf1\xd5\x184%\xbfA \x8f\u0349beSq!\xaf8Az\xae`Bc\x1b\xcf!\xaaI\xa69\xfflDL\xa8\x03\rG\xc0s\xed\f]\xa90\\=y\xc7\xcb\x16\x82\x15\x1d\xfe\xfa\xe6\xa6\u07car\x95\xf5e\xa9\xe2H\x01\xe7$\xe3F\xa9W\xf5\xac\x04 \u06de\xb3\xedx\xce?\x9c\xae\x9c`\x86G\xc0\xbdj/\xa8\tO\xc8W\xa1\xf5m\xd3\u042az\xd2p\xac\u05aa\u4808\xdc,\x91\xd9\xf2\xa4\x18B\xe4\x10E\xf7\x7f\xa6=\xd7\xcf\u039c\xc8\\\xc8\x10j\xa5?\xee,\xfa6\x96\xf03D\xa0[Mk\xad\\\xfac[\xb5\x11\xc4W\x0f\n\xc4y\xfav\x93\x1aY\xe3\x12\u07c6cP5\x1c\xca\x1b\xdc\xef\x0f\xfb\xad\xc1\x80\xa9\xbf\x85{|e\xfc]#\xbe\xc9F\xd7\xf3\xf3\xf8&\u02f1\x06M\xfdut\xe3\xe7\u040do\xa3\x1b\t\xc2=\xbf\x80n\xfc\x1c\xba\xf1%\xba\xf1-tC\xfd\x15\xd5_\xb9\x17Xn\x93\xc1\u066b\x13\xc6\xf7\x8c\x1b\xc6\t\x16\xd1\x01S$\xb5\r\xd7;\xf4H}\x16\x99\xa1t\xfdj\xd5Q\x13P\xb0\xecgNg`\x1d\xf1\xc4k-\x1a\xbf\xe6F\xd6D\xa3\x8ch\x9eY\x19\xa4\a\x13\xf4,\xbf\xe2\x91Y\xbb\x9e\x1b\xd4X\xf6I#\xb3\xc0\xa0\x8d#\xd1K\x96\x06\xe4\x03\u0419\xc4\xcb\xd2z\xeb\xc5L\xa9l?\xa2\xdc~D\xf6~l%\x9a.\x8b\xcc\u076e:\xff/\x87P8S@\xaa\xf4\x93LCa\xb9T\x98,2\xe8H\xba.B<\bzNr\x99` I-k\x96\xbab\xdd>-\x92\xb4b\x8f\xbb\xea\x17\xf1\xf4\x0f5\x8c\x9e\xa4\x03\rr\x01Er@-@\xed\x00\x86\x92\xc5\xe4oB\x83\u054aN\x1a\xfcF$\xfeP\xbc\xd3\b\xf0\x8aN\x8c\x1c\x83\x8d\xe8\x04\x9dt\xbe\xe2c\xc1\xe6t\xd2\xf8#e\xb1\x15\xe4y\xb2\xa2\xbb\xed\xddV\xab\\Y\xb5\xcbY\u0508f x\u0264H1\xb9\u00fb\v\xa4\xd5`\xeaDQ\xffE\x7f)\x89m\xc9\xdc'\x006\x93\xae\u07d8\x84\xf1\xa5\x0f\"e\x1f\x8e\x88\x14\xd6\xe8/\x90\xc4t\xb5'\xa6vkg\x7f\u7833\xb7\xb3O\x17\xac\xb3\x872\xb6\x9b\xa8^\xdf\x06\xc1Y\xffw\x90\xf8\xd5\xcfo\xf6O\x06\xdbt\x0e\x82\xbb\x9b\xf6\t\n\xf4\xda\u72d7/\x9b'\xf0\xef^\v\x04ycv\x17_\xf1d\x1c\xc6\u05de\xf3V\xff\xaa\x04\xd1|!*\x11\u78f4r\x1d\x80u[\x10\t>\xe1\t\x9a\xb8\u0353x\xc8\xd3\u0521N\x14\x8b\xfa\xa5\x9f\x06C\xc7sN\u00d0O\xfcP\xd5}\xca*\u035b\x83f\u014dbQ\xf1+X\xa8\x82\xfeE\xe7q\x10\t\xe2PG9!\xacc\x05h@~\xcb\x06\x9c\x15\x9d\xb2\xcc\xd1&\x1d\xb1\x12\x7fh\x85\xf8\x06\xd2\xd0\xcc2)\x1e\xa3T\xbd(Y\xc9x\x02\x9e=\xb7\xf4\a\xdd\b\xe8\xf9\x04\xf5\xceQ`L\xb2\u0ee5\x0e\xfc\x8d\xafY<#\t\xcb\xcc\x1a\"\xa3o\r\xe7\x80E\xfd\xe6\xa0\x06\xe2A\xce\"\xf0\xdcE\x93\u0695\xebrK\xe1y\x0e\x14=1\xbcI\x03\xc4<D]\xfa\r'\xef\xa8>\x9b\x80\ff-\u0573-\xdf]\xdd\xf8\xc8\xef\x12W\xdb\x1c*\xad\u04f8V\x83HA*\xaa\x9bPQ\u076a\xd5\xf8\xc8\xef\xed\xeeu\xdaM\xc6\u073d\x9d\xddV\x1b$\a\xeb5IO)4(\xedV\x81\u06ad\xa4&\xbf\"RC\xddW\u2e59\xdeC\\\xaf\x13\xcf|\x96,\xe6\xa5\xf5\xdcpU\xe6\xd5]Xk\u029fB\x0f\xbb\x92\xe2\x1a\xb9.\xafKu[\xf2\xf4\xe9\xd3V\xb3\n\xc3X\xe2\xe4\x80\x1f\xc6\tI\xd5[N(V\xc0\xf7#\xb5\xa4\xf6\x8a^\x17\x1c\x1d\xb4\u06f5\xfd\xdd\xef\\~\xd4\xde#u\xd7mn1\x98\xea\xaeU\xe3$\xaf\x1d&\x056\x9cE\xbd\xa9\u02f7\xf7\x9bM\x02!\x19[\x94\xd7\x18$\b\xd2\xe5OwvwAEuA8\xa6uv\xcdrL\u0764\xd6\xd9\xfb\x8eo\xbb\xbc\xd69\xb0c\x12\xdcdB]\f\a\x8d\x96\xb2!\x1d\xd29\x1d\xd3\x11\xbd\x92\xbe\xbc\r\b\u07f2&\xbdf\xad\xf6\x01\xbda\xfbm\x1c\x13n\xa4-\x88\xc0\xe8\x05GM\x14\x0eA\xc4\x18|{\x8d\xd0\xc8!\xb7\xe3\ty\n-\xc15a\x1dqB\xaf\xb4\xd6a\xae\xac|{\x89Y\xf4\xb4\u064bj-\x0fL\x05&]\t\xa6>\x03\xab\x98\x16\r\u0662\x1b?Em\x1d\xb7p\xf0\tu\xdd!sG%@W\xdf98j5{\xa3z\xbb\xed\x8d\xea{\xbbG\xed\xbd\x1e\xfc\xf5F\xf5\xc3}\xf9q\xb8\xef-\xc8S\xb6\x00\xc5\xed\xa9\xeb\xa6\xf5[\xb2\x1d\x10\xe4a\x1d\x8d\xd9\x1cBokl\xf8]@\xb7\xdc\xe1\x91;g\xe1\x11\xbb\u9d7c\xf0)\xbb\xa9\xb5\xf7z\xed=/\xac\xdf\x10\xd2\ra\x8b\x82\xa7S7\xddv\xc7lQ\x9f\xaf7\x14|\xc7\xc6\xdd\x1bv\xe2\xde\xd6}*\u0615\x96\x8e\xb6 p\xb2\x0f\xb1\xc2o\xb7\x05y\x9a\u05af\x8b5\xafkLf\xd2\xdbo\x99\xa0W\x9a\u06ff\xad\xd5h\x93^\x1b\x02\xec\u04bd\xb2\xa0\xe0\xe2>(\x00{\xe9KzC\xc1U\x83z\ua6c0\xc0\xf2\x16\x05\x96\n0\x04\x02E\x84(b\xbfM\xe5\xb3\xe7\xa4[\xab\x05\u013db\xf0\xdeI\x8ep\xb3\xcf\xe4\xee\x8e\xc0u\v\xb6\x95\xb0\x98i\xa3v\x1a\x9b\x02\x00E\xdd\xc4lq\xc8\xd2\xd2F\x9f\x82\x1d\xd2\xd5\x11\x88\xfaBv%[\f\xebB\xeeRD\xb6\xddK\x96\xd4Z\xeb+\x1c\xd5\x18\x94#\xdf]R\xc1\xc2\\\xd3\x10\xf6L\x0fYT\xab\xb5Z\xf44-V\xbfbL\xe1\xf8!\x8b\xe8\x9c-\xba\xb8\xe9c6?b~\xaf\xe5\u035f2_m\xfa\xbc\xee\x13\u049d\u00e6_\xb0a}Lo\u0622>\xa6f\x1d\xae\xddq\xed\xe2\xdb\x1b\xda$\x84\xd0!\x9b\xba\x17\xdb7\xa4ke\x0fe\x96\xcfN\u0708^\u0484\xb1\x98\xe0J\xd7j\u026aV\x8bh\xadf\x14D\xce2\xdc\x13\xb0\xbb+\x9e\xa4A\x1cyN\xab\xd1i\xb4\x1d\n!\x83\xbd;e\x1es\xab\rP.\x8d\xc5\u030dN\xba\xa0\">>{~zZ\xe6\x1adRD\xa2*\u0748U{\x0eP\tN\xedB\xfb\xac\x00r\xfac\x14\x14\xcc]\x1el04\r\xde\x18\x9b\x91\x9dB\x1ca\xd5\xc1\x8afa\bs\xaa7\xaa\xa9`\xa5UK\"*(J~]+t\x18Y\xb9dE\x0fv\xf7\x1f\xf6\x91\v\u051a|\x02\xddk\xef6\u06e4\x9b\v\xe8.\xd5\x144)\x88\u02a1\x98\xa4y\xe0,\x05\xf9\x9f\xec\x13\xf9\xf9\xec\x13\xd9\xed|.rmV\x92\x9f\xda\xf5S\xee'C;A\tH\xb3\x16\xb5l-\x9fd\xb7\b|\x10|\xae\xb4[\xfdK*@\xba\x1e\x87W\xbc\xdc\xfd+\u0123\xd9j\x81f\x8b.\aj\xd4Y-\xf9\x14]^\x97\xf7\xd6k\xcb\U000ae010\x98T(\x1b\u045c~L\xdc\bRI\xa3\xb9(\xa6\xe5\xec\x12\u057a\xca\x1c\xa7\x80O?\u0644K<?\xafx3\xf3E\xa6\x06 \x1a\x1f\x93\x90\xf9\x8a\xd7R\x0f\xc9\xea\r\xb9\xe6\xc1Kq\u02b6=0u\x1a|\xf7\r\xbe:\xff\x8e\xcf\xca=\xb7\xb7u\xbeM\xfa\xbf\x9f\xf7\xf0I\xd9=\xef\xf5\x7f\xc7_=|\x92\xee;w\x0euV\x0eu\x96\x0eu\xce\xcf\x1d\xea\xfc\xeeP\xe7\x7f8F1\xbb\xef\x1c9\xd4y\xea\xd0'\xce\x13\xc8\x00s4\xea\x9c'\xf0O\x04\xff\bg\x00X\xa1\xef<\xc9*a\xc0\xaf\xbe\xf3\xadC\xf1y\x18\u079a\xbb\x0eu\xfe\x91\x95\x18B\x1c\xb0\xbe\xc9\xfd\x87\x03/\xbb\u06ff\xf7kr^\xc7\xf5\xdf.\ua0fb&\xdd\ubb3e\u0666#\x9crI\x1e\x81\x87\x8do\xb6A\xbf\xfc\xb3\x7f\xe5\xa7\xc3$\x98\v\xf0H\xe4X\x9f\x8e\aJYW\x0f\x17\x99\xb0;|\xae\xdcjR\xf9R\xb9\u0564c\xf9=\x89\xe7S\x9e`\x02\xf0\x91P\x1d\x8b:\xe6g\xaa~\x8fM\xaa\xaa\xa3\x92\xa1\x9a\xec\x06^\x05Z\xfb{\xfb\x1d\xebh^\u068fm\xe0%7H\x15\xa8\x01\x10\xe5\x81&ol\x97\u0ef0\x9fE\x88\u00d3\xa1\x9a\xa3\xc9\xca\xcfi9\x15cQ\xa8.\xb7rP[\xee\xaa\xe2\x9d\xf6\x86Wy\xb2H\xc2'k\xc1((\x06\x13tj\x99\x7f0\xa5\u041b\x89\xa4z\x0e\xd8\xeb\xd6[\x8a\x99;\xb2\xb2\xfe\xe1\x10i\x14\xfd\x0f\xb0\xac\xd3,EJ\xba\xf0\xf4\x84\xefO%\x1a\b]ix\xcdY(o\x95\x14_\xdf/\u0665\x8eK\xb1\x15U\xab\xd2\xe9\xa4l\x0f\xba\xc9\x05_\xb9\xd6/D\x97X\xf5:\x17\xde\x19\xd1\u03e5\x85{.\v\x98\xea\x1a\u0792\xae\xf1\xc9\xca\xc6q\x90b\xe38\u047bU\xbbb\x95\xd2\xf12Z\x84x\x9b\x92\x81\u02b7\u0685G\u02ecUP\x03\x80\u03d5\x8c\n\x13\xd8\xf38\x91\xb3\xbba\xee\t;\x81\xd0\xe5\xf9;\xa9\x9b\xbf\x05n(,\x99\xea\xf8D\xaf\x0f\x18SE\xcb\xe5\xc9ry\u0658\xa1u\xe8\xf6\xef\x80S\xfa\xbf\xff\x17(\xaa\xfc\x97\xfa\xbb\xad\x8c\x9d.\x18\x88v\x18\xcbZj\xd26\xe9n],\x97'\xd5\xeaU\xffd\xb0\\\xbaV?m\x92\xbfx\xb6\xa4\x1b\xab-(Y\xad\xba\xb2\xda\u05a4\x7f2\xb0l\xde\xce\xe8\x17z\f\xde\x16\x9f\xb3f\xf7y&\xea{\x0e\xa2>\x84+\xf7-\xbb4P5\xee?\x1f\xe0\xcb\x1f\xda\x11\x1f/\x97o\x8f\x8e\xa5\u03ec\xb7d%\x8b\x7f\x91\xfe\v\x8f{\x97y\x16\x01dFkI\xf4\x98H\xffX\x97F\x90\xfa\x85\xd0K\xf3\xf9\xa5\xd6\"\xd6\xe5Y\"\xae<#\x04'\x80d\xa0\x9c\xc4<7\t\xf4\x1f\xd6]\x9f\xca\xfc\xfe\xa9@\x1a~]\xe6#\xa7\u2b5d\x8d\xf6\xd8\x1e\xed1\xd1\xe0\x9c\xa4\xfc\x878\x15.)\\\xe6\xb9/x\x84\xecJ\xf7X\xd2?u.\x17c\xbb8\x83\xf5\xf4\xdcW&7C\x95\xda\xcf$s\x9c\x95/'\x8f\xeb\xf6yc\x1b\x9cg\xc1JQ\xed8\x8bt\x9f\x1f}\xc8\x16\xeb\r;\xed?\xc7\xe6\xdeT\xab[o\x14\xa8N-\xa0y\a\a\xe7=k\xd2\xd7\xec\x8d^\xeb\xf7G\xaf\xbb\xefk5\xf2\xc6f\xb6\u0793\xa7\xad\xf6~\xef]\x8d97\x8e\xf7\xae\xc6\xde\xf4\xdf\u02c1\xbe\xb3\x9a\x95\xae\xa7N\u035a>'\xf4\x95\xf9|\x0e\x10\xf0\x8c\xe9a\x8cH\xf7Y\xb5\uaf90\xb4\xf23\x94z\xbc2O\x98\xcf\xf0!\x9b\xbe\xca^y.\xf1a\xf1U&\xf2\xa8]\x16\xf7\xe4E\x96\xa9\x8dMVek\xfc\xb4\xbd\xbb\xdb\xcbWu\x1c\xef\x9e\xfd-<\x8b|^.\xdd|\u9921h\xee|\xbaR\xeb{\xc9\f]\xd8\xc3G%\xfd\x05Z7\x7f\x96\x80\x92Ia\x7f\xd6^fH\xb6\x96\x15\xa5\x9f5\x02\xdc0b\x8d+i)\x90\xb5\xf5\xeb\xee%\x02\xa7Z\xdcK\"\xf1\xcc\ft\x1b\xd4)\xa4\x1f\xd8\u041cB\x1b\xb8>\xb2\xa1\x02.<\x91\xd9q\xfc\xa8\x00\u13f2g\x8a\x8f\xa4\xfb\ac\xecc\xb5\xea\xfe\xc1\x94\xb2\xd7G\xa2\x8e\x1e\x02\xf6G%\x82\xf9\x83\xac\x10}\xffl5\rw\x94D\x00?\x9b\xe9\x03)m0\xe7\xcf\xf6!n\u049f\xd5\x06|\xb2\xdb\xe8I\x9f\x01\xd8\u0327\xfc\xedd\xda\xf9D\xec\xeb$KF$\xa6\xbb\x96\x99\xb9\xfbK\xeb3\u0603\xf8\xf4\xa8\xeb\xeaR\x9707\xe8%\xa1\x93\xfe\r\xe8Y\xd8;X\xadn\u52adU\x83\xcb?\x7f\x17/\x97V\xd7\xe4\xee%+\xe4*\xdc\xf5\x03\xb3\x8ae`\b\xe5\xd8\xcb\xda\x0f+\xfb\xfa\xc7+_+f#\x8d.\xef[\xbaN\xa9\xaf\xeb^\x9b[\x00;\xe1\xc8\b\xb8\xa5\x8fZ\xb6R\xd5\xf6\xb7\x9d\xe3\xed\x80\xe2\x83\x01\x97\xaf\x0e]\xcbV!\xa7Y\x14\xadO\x91&\xcc\xc0\v~+%\r\xfb\xb4\xf5|\xc6k\xe6\xcb+\xac\xbb\v\xb9\xf2\x8e\xc9\xe5\xe4\x9e\x15\xf3h\x05\xfd\xdd\xe5Rd\xb0\x05\x83\x00\xcc[Q\x86\x13\x88\rz9r7K&\u056a\xa5\xd6mgd\xf82`\xb7\u066bV\x0e:\x95#\x8a\xdcn\x83!v\u03e9\x05\xb8%z\xa7\xf5+\x97\xd0\xf0/_\xb7\x84z\xb6\xb1i\x94\xe5\x12\xad@&\xa0>Y\xad\xa2\xa7n\xbf\xe7\xfaH\xf8\xd4\\\x1f\x9a\x95\x9ah\xea\t\xc9\xd6\xe8\x89\x10\xf9D\xf0\u0139\\B\x1d\x90\x95W\xab\xce?\xa0h\x92+\x9a0\xe7\x1fN-!4\xc5\xf1n\x15\"A\xb8)<\x03\xd5R\x10\xe8\u00ab\x1b\x8b\xac\x18\xb5\xbd\x7f\f\xf2\x91T2n\xb6\f\xf2\xc0]g\xcdMYj\x9a@-a\xf0\x86AH-\u0243\xfa:\xa3\x9d\xb7\x06\u0273\xc8\x19\xefL\xcc\xf9)mo\x8d\x05W\xc6\xf46C\xa2\xc4\xe0\x92\xd3\x11\x86\u0151\xedS\xce\xc4*{\xcd\xc0B4aE\xe0!J\x86\x96\u0626\x0e&\xb0k0\xe8F\xfdt\x80\x00\xd3O\aH\xfcJ\xbc\x8b\x16DS*U\xaa\xa46\xacy\u07d8\xa2D\"\xc3\x0f\x18[\x95gJ^[\x96\xfe\xef]\xe6=\xc4\x1e\x1a'\u0293\u0222\xe4\xddz\x81.rt\x13\x8et\x8a\xe3F\x10n\x83\xf7-'\x18\x93~\xf6\x8e9\x80g4\v\x93\xe6\x9e2\xe5\x87\xf5\xbc)Qi\xc9LV8\x93\xec\xa94\xfb\x9d{C\x95\xec\u391fe\x0f\xb2\x99\u038b3\x1d\xc33AF\xf1\x8e\xf5L\xa7l\xde\x1f\xc3\x0eLab\xd3\u0320\xa9|\\Y\xf7\x96\x825\xe58\xeb\xe5\xf2*7\x18k\xaa\x99\u00b54\xa0\xd3\xc3\x1c1K\x17[j\xd3g\xdao\u0751A7[\xae\uc08d\x1a\x92\x80#\xa4K\xba2\r\xc5u\x98\t'\x9b[\xa4\x8e\xcbm\n\x8cPT\xe5\x1b!I2\xcai\xb3\u938e\xda\xc5\x1ck\x0e#K\xa9\rWB]\xb6\\\xfd\xa0\xea\xc1\x98q\xf9\x97JX`z\x94pqH\xee\x84\xe3\x1f\x9a\xc1\n\xb3G-\x7fS\xf9\x8e\u0378Tn\x8b\xacU\x8a\xcc]+]\xbdE\xf9\x9b\xe8\x96E\xf6-+s\u066cv\x9bm\xad\x119f\xf8\xd5\x1c\x9dR\x88\x94\u073e\r\xcf\x0er\x9cQ\x89\xf6\x1e\xbd6S\xe6k\x15xY\x85\x13v\xbd\\^\xc2\u0126h \x9c\x15\xa27\xec\x84^\xe4:\xb6\xba\xcc@e\xb9\xec\x0f\xe8\x99|\xc3\u024a\xf2\x8dEmM\x84\xad\xdc\x11F\xe2\xed\f\u03eb\x05;z7P\"\xaa\x87)u=/\xfa\xcdA\x0f\xfeQ\xaa\x1c\u0785\x01 \x99@\x88\x86\x04\u01e1\xf6\xb9\xb6\xc1\x13\x1b\xe6V'<\xd7\t\x00m\x0f\xfeQK\xebeP\xcau'<\x13\x14\u00d2\x9e\xd8u\x97K3VB\xe85Y\x03M\x89a\x81HQ\x1dD\x1a\nKa\u052a\x00\t\xbd\uc9d7\u0560\x0f\x1f\x91\v62&\x14\xfa\x10\x92\x8b\xe5\u04bd\x80\xd8F\xf4Bi\xa2]\xb0\v-\xbe\x1c\x91\x87\x9b5M\xa2p\r\\\x14\xbeM>j\xfb\x12W\u05d2\x8e\xa7\u03c85E\xf9\x93]h$C\xdd\xcflk\xcb\xd5\x1b.\xfff\x04\xd9\x7f9\xe4i\x93\x98\xf4\xec-\x1e\xc9\x0ey\xd6?\x9b\xb6T\xe3Vw\x9f3d\x96\x1d\xcd\aVL\xcf\u020dl\x95d+UMn\xb9T%\x98U\xb2\x97\xfdDg\x7f\xa6xO\xff\x80\u4357\xd2\xd6E\xc1\xe9XTx:\xb0\x9a\u00ee\x91\xf0\xd2-G\u05a3B\xd9\xfd\xad\xef\x84/\xecB17\xca\xd9\xc81s#\x83P\xe4\u07cb,\xea6\x809*:\x7fY.\x95\xca\xf3\x17\xa2 \xf4\vH\xab\xe8[\xa6\x8bw\xdfBH\xb1\xb7\xf5\xba\u049av\xbf\xb0\x8b\xfe\xdb\x01\xe9]\xe8\xb7\u0437\x96\xe6\xf4\x97\x9e\x9bK\xa7\xc0\x9cz\u03eb\xd5b2(J\xc3\xf2\x80\xd8\xec\x069\xdb\xee\xf3z\xbd\xfb\x9cd\xa8\x00\x9a$\u076d\x13\xeb .\x97\x17Rt\xb3\xadS2\x94\xb8\\ZU\x1dB\x8f5i{\x91]B9\xaa\xf9\xc2(_KA\x11=e\x8f\xea\xa8[@u\xf2';\x05\xaf\xe8z\xd5z\xe6<\x80@\xe1\xdfu$\xf4\x99\x009jv5\x18\b\xacV\xb7N\xab\xd5\xfc*\x99\x01[pi-\x98\xe7\xae\u00eb\x01I\xf2\xef:Y\x16\x05\x00\xd3R\x94\xc0W\xdf\xc8t\xedu\x01\xe4\x88\x1b\xb8aD\u50a5\xda$\xa3\x8b\x17\v2_.\xd8\xd07\a\xc40\xfaR\x038\x93\x85K\x8b\r-W6\"\x1e\xad \r\x05\xd6dE\x18m\x14\xdf@\xd7\x03\xa7Z\xae;5\x9fQ\xe6\xc1m-\xe8\t8|SN>K\x8a\xdf\xe3\xeecE\xe5\x0e\x96T\xab(/+\xa6\x8cuOl.\x8eaa\x0fw\x0e\xdbv\x18\x15\xdbu\x93\x8c,+\xa3BJ\x85\xbf\xf0L\u0109?\xe1\xc6uA\xd1\xeb\xe3Vk\xa5\x18\xadB\r\xf0lc\xf5\xbe\x05:\r\x8eH\x16\xa8\xfa\xac\xd84QxdXm\xf2U\x1f\x8c\xdd\xc4u\xa2\xf8\x05\x9f'\\\xaa!:$\xff\xa8\x15A\x98\x8duoa8\x19\xdd\x00>R\xe5\xdb\xc8\u07ad\xe4\x9b\x15\xa8[\xb9\x8eH\xfc!\xcf\x15\xec\r\xe3(\x8dC\xde\xc0,x\xcd\xd5\t\xd7~\x12\xb9\x82\xd0\b\xc2m\xe8!)+f\u05155F\xcf\xe8|\xab\xd3\xea\xb4\x1ez\x90?\xdc\xdb\xd9\u06c1\a\xf9\xf5\xad\xdc>:\u07ee\xf5\x7f\x7f:\xa8=\xdd\u05aa\x04`QZV\x12\x8b\x9do[\x05\xcb\r\u058d\xec\xdc=:\xdf\xee\xc9\xc6\xc9\xf6\x84('\xc8n\x99\xd7\x13\xe9I_\xbe\x99\x81z\xd8\u031f\x97\x95S\x01\x1e8\x05\xf0\xf6\x80\xa1\x03\xa3\xeeLI'\xb5\x86\x11\xa3\x9a\xc5\xf30N\x83h\xf2\xc1\x9f8\xa51\\\xca\u7e55[\x0f|\x01\u074a\xe5\x1f_\xa5\xf4\x9c\xb7s\x1e\xe9\xa6}L9\xe3\xe1\xd8\xee\xd0\xf9\xc0o\x84\xb3*w\x88&\xe1\xbc\xe8p\x024\x15\x8dU\xb0\xc9\x04kR\xfb\x03|\x06GJ\xd5>\x12qBc&\x1a\x82\u07c87\xf1\b\xfcCCl\xeaWA\u0129\u03da4E\x9dJ\x88N\xefT*\x95\x8a\xa3B\xb8\u00d6\x15\u05d9\n\x1a\u0211-\x18W\xfe4B\xe5B\xaek\xaf\xa5\x8c\xcf\xe9\xd7\xeb])&H\xd0\a!\x04\xef\xaf-\xd0\xfe\xd8Z\x1cU\xb6V\x03oL\xd2\x16<\xe8\v\xb0\xe0\x9a\xe2\x8f\xf6\xa0\xa4i\xb9t\x10GL\xf9\xaf+\xb48U\xc9\xee\x9c9NmX\x9b\xca\xd1\xd6\xc6\xea\uf0a6J\xe1\xb1\u07a6\x02\u00b9\xea\xc34_etf\x99\u01cb\f8\x16\xe8\x12\x02\xb4=\b\xa1\x8b{\xc0wk\x8bg:\x8d\xe7\x11D\xf3\xa6\a\xcd\xf6\xee\xae\xe5\x15\xef\xce\x011_\u055f\u037b\xa8\xca\xe09\xd5?\x16\xb1\x00\xad\x84'\x981\x8fS\xf88\x82\x8f\x10\u04df\xc2\u03c9\xe8:\xe5Q7\xec\b\xc3F\x83\u0576\x93w\xfbU\xe7\xe8\xe9\x13\x8c\xf1[\xee\xdcYH\xef9\x1e\xb87\xd8\xed\xec\xec\x1fZ\xa8\xc4\xc4\xc0\xdai\xed\xeeJu\x1e\x9c\x93\x8c\x81\xb5\xd3>\xe84I\xe3L$\u071f\x15\"\x8aC\a\boM\x89\x82\xa8OS\xd09\x12\x14\x10#z\x9f\x80w\u0766Z\xb1\x04\x05\x1e\x84.\x94\xd3\u0792;\xccu\x17\x10\xf4\xb6 \xcc\xe97\apa/\x1a\x17<\xe43\x83\xc0\xd5w\x03\xaf`\x9f\xea\xcf`\x18/\"\xc1\xa2,\x01\x0f\x0f\x13\x85\x84\x94\xa5V\x8a\xe0I\xb2\x98\v\xb6\xd0i8\xa5\x10\xc3\x1e\x83\x8a\xc9 \x9b;Fd\u038f\xb0\u0721\x8a\x8a\x12\xbc!\xc0\xd7\x13\xe6<\xa9\u016e \xb5'\u0393\x95+(\x1a\xcbc\x88i\xedTR\xae\xcbB\x99o\xa9\xf5\xf2Th\x15\xb0{%\xd28g\u0478\xf0\x85H\xaa\u0571\xab~\x02\x1c_\fG\xbe\xf0\xab\u0579\x1c\x86\xeb\x1cm\xf5\x9f\xbf8\xfep\xdcwj:\xd7z\x108\x1f\x9c\x0f\x9e\x82\xba\xc3`0\x18<5e\x9f:\x10\xd2x\xf0\xd4\xc1\xa3!\xa7\x89\x1a\x86\x10\xbb;3\x81\\\x94\x9f\xb0\xf5M\xee98@\x87\xador\x0f\xe4\x80r\xf8\x9ejZ\xc1\x19\xa8-z\xee\\\xb1\xc7!\xb8\xb9S\x05\x00[\xe3\xa2\xd1p\xb9\xcc\xc6St\xaaiJ/\f\x82\xb8C\xe2\u0567f\xef1N\x99\x10Ip\xb9\x10<\xf5\x86t\x18G\x82G\u009bS\tS^D\x15\xe8x\xa9\xfa\xe5\x89U\xeeV2z.\x1b\x1d\xc3V\xb8\xbb\xd5\xd2N\xe8\x12&2\xd8\xeb\xb5<\xd1P}\x1a\xb9e\xe6\xe8M\xb9\x16_+\xa2\x9d7e\x19\x8a\xa5\xc8\u014f\x89\x95'\xddX\x1f\x9f.\x8c6&\xab\x15\x8e\xc7M\x9e\xb6z\xfa\x9eI%I/\xf0d\xf5\x9c\xa3m\xa7&\x7f\xd7\x10U\xc9<Y\x14\xa4\xbe=@\x85\x8a\u072fV#\u05fa\xa7}Wd\xb8\u04da*:W7_@\xf5\xf0h\xc48\xb5\x13!%\xb6S\x00\xe2\xb8\vbx\xa5\xc1!WR\x0f\xda\x1a\xb0\x1e\xaf\x1ek\xb6\xab\x9acr@}\xc8N\x978\xbd\xe2\x10Y'\xe9\xe9\xc6p\u019e\xfe\xda^_\x00X\xb9l\x05\xb6\x92\xfc>\xabb\xa6D\x17\x96d\xb9\x8c\xef\xa1Z\xd7A\a\x96\x8b\xdd\x19\x88#\xca\x15EL\x172\x9c%\xf7g=|\x8cPa(@\xf26\xc4\x03jh\x87\x1eF\x87\u0206\x83\x14\x82\xa7\xbf\x81\xd1\x05\xe7\x91\x19\xbc!\xad>\xee%\x8d\x88\u07c8\x0f\xc1\xf0\v*\xb0\xda{;24\xb6\x012\x18hXc\x02y\xa4-x@X\x80\xa28\x0e\r\x06\xd4\u010c\xa16\xe7\b\xbb6\xb6 w\x8b\x06\x9f\x01\xfb\f\x88\u0261\x91<\xd5\x10\xaa-\xe7\t\x04:M\xdd\x11\x05\xd40\xa7\xf3^\xcbk\x12\x88APt\xe6\xb9\xd0,\xe1\xfd\xddpBu\x02\x8fF\x88\xc9\x12\xee\x8f\xfc\u02d0\xc3\n\xea\xbca\x18\xa72>\xa7\t\x92\x95ou\f\x1eF\xe1\xb5\x11\xa2z\x86~\x82\f\x80\xf4\x1da\xa7\x80+\x17K\x17\xb9\xa9\x82\x1b\x02W\x185\xf4\xcf\xe5\xd2\xf9\xf8\xe1e\xfd\xc0\x01\xe7[\xa9\xf0\xa3\x91\x1f\xc6\x11\x97^S\xcc'\xb3\xf3\b\x9d\xb9wN\xeff\x16:\xde\x1d\xe2P\x88\x82\x0e\xeb\x17f/n\xdbOA\v\xf2\xa9#\x99X\xe3\u0369\xc7\xcb\xee\xaf\xcc\xf4*\xaa\xb5,\x0f\xfc\xf8nx\x05\xfd\tp\xb6\x84\x9eC8\xbd\"t\xd1s\xed\xb5k\xd2\x05\xf1\xc2Uf\xe1\xd5\xe0\xd2\xcfr\xe68\xb8\xa1</\xaf\xb3\xf1\xc5H\x95e\u03a0\b\x15\xec\x0e\xefj\x0fh\\\n\x18\xde+\x86$\x93\xaf\xe3\x88_\u05986\aM\xa50~\x06\x1f\x81\x9d\x95_\x91nn\xb7r\x8f\xe1\xfa\xfd\xdb&\x1e\xba\xa9k\xb5\x8c\xb0\x18Q\xbb\x14^\x1857B\xf0\xb4}^\x91;\x8d\xef\x00\x95\xa1F8\xc2Vn\xe4\xe6Dq\xa5\xaf \xcd@\xd4#1\x8fF*\x19Z\x81\xa0\xf6\u01b5\u060a\xee\xec\xb6\xf6\xdb^Y\u0336\x84\xc6]\xb4^\x8a\xec\xf5^\xe3$\xf9\x06\xd2\u04d9p\x01/\xba\v\xc1Gg\xe26\xe4N\x10)7G\xe00e\x16\xc7b\n\x14\xbbLj\x14K\xc3\x15\xef\xa4\xc3$\x0e\xc3\xfa%\x9f\xfaWA\x9c8\x03t\xc9k9Cby\xffI\xcb\xe5\x96\xebho\xddY\x7f\xfa\x0eSNy,\xf8Q\xae\xe5!5\x96\xbc\xd0\xe1\xe1!M\x96K\xf4\xfc\x80N\xfa\x0e\xb5\xba\xa8=\xcd\x18e\"\xf6\xba\fC\xee'\xe0\xec4^\b7&\xd4w\xc1ga)]\x8eVs3\xff\xc6mR\xf4\xb3\xf9!\x9e\xa3\x87\xcdz\x92\xf7\xab\x96\u0418\xa6\b\x96\x81K\xc0|\x05\xdcH\xc4`\x11\xd6\\.\xb9+\x1a\x97\xf1\xe8\x96\x10\xd1\x10\xf1\xaf\xae|\x95O]\x92\xb9\xe0\x04l?\xe1\xe2W$\x81\xacN\x13R_\xd0!s\xb5\xcbV\x92\xf9i\xed\xc6\u0407,\x1bD.\xfe\xf0/S\u041b\x8e2C\xca\nw\u025d\xef\xa6\\\xe8\t\xbb\x85\x13\x191\xd3F\x8b\xbae=\u0547d;\x06\x13'k`\x999\xa1\xbb\xa8\x85\u07f9\xd1Qc\xb7\xd7\xfe.\xfa.\xf2\xa2\xef\u071dz\xfb\xbb\x88\x00\xcbF\xbaf\xce\xd1Q\v,:'\\\xfc\xc0\x83\xc9T\xb8\xa4\x96\x1c\u0255iH\xe8\x91\xe9=\xee\x12\xcf\x1eq@\x0f\x0f\xf5\x92\x91\x15\xa1\x87\x04-\x19V\xb90\x85\x92<[\xa0\x1f\\\xfc\r\x81\xbc\xad\xecH\xb3\xae>C\x9f\xa2\xcf\xe2E\x04\b\xf9y\x18\xf0H\xbc\xe7C\xe1\x92\xc6\x14\a@\x03\x96\xdb\uc68f1\xc1\xad\x81\xd3y\xb6_c6\xaf\r\x81\xe4\"G\xf3\xe5\u04af%O\x87\xbdPu\xe8\x05\xb5\xe4\u9e37p\x83\xfa\xb0\x96\xc8$\x80\n\xd0b\x98\xe7G\x97\xc0\xf8\x16\xeeF\x80\xcb\xf5\xbf\u076e\x01\xcf\xf5\xd0D\xb6\xdb@\xb8\x01)\xa8\xd0\xc8]\xca\xc5b^\x1a\xf6G\x86bY.\xb9T4\xe1\x84b\x8aX.\xa5\x052\\\xfdw\x8a\xe6~\xb1\x907\x9e\x17Q>\x9a\xf0\xb7\xe3q\u0285\a\xd1^D\xec\x85T\u013fz\v \xbe\xe3\x9f\x03~\r\xd46\x8f\x04O\xbc\xb9t\xe9\x1d\xd0Y|\x95\x93\x95f\x84d\xbc\xa2\xb0\xac\x9e\\]\xaa\x17\xc0\xcb\xd6b\x05b\x13\xe3\xe5_\xff\xd0~\xfe\u02ecb\x14\u0592 \xf6+>:\xe3\xcf\x0f\xf1\x1c\u072f\t\xf7\x0e@\xd03m\xca\xdc \x9a\xa8F\x97K\x93\x05\x05qz6\xaa\u0235\xff!\x06\xd12Q\xd3H\xa8\xd94o\xe3\xc0\x82(\xe2\x89,\x04\x83\x1b\xe2>\xca\xefU\xb6\x02e\x98{\xe3\xf6\x8bx^K\\R\x8f\x1a1\xee\rLu\x85\\C\xac|\a\x9f\xe1hyb\x03!\x82\xa0\x89\xef!W\x85\u79cb\xbeD\xcd\xf21\xa1\xe6\xb9>7n/\xf2}\x8b`\x90\x0f\xcf\u035c>\xbc8\xe4\xa1\u0571\xe6\x8es[Q\xc7\x1f\x8dN\xaex$^\x05\xa9\xe0\x11O\xec\x8bnKu\x19\u017f\xf1H^z\xa0|\xe3\xe6v\x9fh$\xe2L\x83T\xc4\xc9m\uea84\xbb\xfcL\xf8\x02/PU\x80\x06`\xa7\xa0\xee\xc7\xf7\x1c\u04a4\x049+\x02\xd1=\\\xf5\xbb\xb1V\x909\xfeB\xc4`\xf5(\xc7W\x9c\x83\ub131?rr\x84\b4\xb8\x01\xe7\xdf\xd3\xcf\u030f\x16~\xe8 \x8a\xdd\xdc\xdb<\x9e\xa78\u01fc\x8a\x18o`j\xb5\xea\xfc\xc9#u\xd8`\x8e&=\u018b@}6\xb2B\x80\u04b7Z\xc4\xf4\xa8\xdd\n(_\x04\xf7\\]\xe8\x8f\x020\x9aK\x1a\x19\"\x0207\xc2Y;\xac\x88:\xcf\xcfnOG\xeeZ_\t\x1f[\xf6\x1d\xa0d\xdd\xcd\xfc\xb4D\xf6\xd5\x17gxY\x90:\x87\x8b1V\x97A=\xea6\x8f\xc0\rUrtX\xad\xae#\x86\b\x04\xfe\xb0\xbar\xcaJ\xbf\xd0r\x9a\uee3f/\xcf\xcfSb\xc1\xa0{~\x9e.\xbf!\x0e\xe9n\u0711a\x18\f\xbf\x14\xb6#\xf3P\xcd\x1b\xc2O&\\\xc0S\x99s,\xd5\x14UH\x93.A\x1f9H\x19\x83\xdc\x19\xdf~Q?\xb1\x85\xd2\xfc\xebi\x00\xba7\\\xca\x1d\xfe\xc9o\xe1\xf7\x8c\v_\xfd\x1c\x8a$T?\xfd\x10\xf2\x89\n\u05edV\xcd\xc0\x9a\x82\x8c\xa2\xc8(\x97\xdf\xcb}yw\xabndA\x89Yc\fC\xa6\x8b*\xfe\a\u03dc\x1b\xa1\xabR\xf3\x1c\xb5Zi9\u0304\x8bc-\x0fp\x1d\xd8j\a\xdf\u057b*\x02Y\x92\u04dbF\x17~\u04b1Pc\x18\xfai\xfa\x06\x15\xd4\x15\x9d\u05a4\xe1F\xa0J\xd4\xeb\"\x86\x1c$\b@J7Sr,\xa1\x16\xd0,\x98\x05D!\xc8\v\xe6\t\x87=}!/X\x15\xb3ah\xdff\x05\x88e\t\x90\x10\xa5\xf0?G\xee\u0702X\xb0\xbe\x06\xcfqn\xaeA\xbd\x86\x06i\xb9w+\n\xfa\xbf\u021d\xcb\u04fa\xc08\xect(\xe1\x16\x02\x82\x18\x17\x9e.\xb1\xacQcV\xe22&\xeaE\xfa\xd5\v\xe2\x02EysT\xc0\u01c7\xbb\xadf{M`}\xe74\xb6\xfd0l|N\x1dog\xb7\xd3<\xa0\x90\xb0\x10\xd3m\x1f{H1gw\xf7\xa0\xd569\xb8\x81\x98~\xd8\xd9o\xee\x9at\xe9,<\x91Uv:\x87{Y\x95\x14\xa3\f\xc5*o\xaf\xd9\xecd\xd5\xd29\x1f\u05af\x13\x7f^\xb7\xbb\xdc9\xe84\xb1\xfe0\x8e\xc6\xc1$\u034dg\xbf\xb9\xdf\u06b13\xa7<\x9c\xeb\x9e\x0f\xdb\xed\xdd=;3\x1boko\xafe\xe7\xe4F\xdc\xd9\xdf\xdf\xe9\u0639\x85A\x1f6[\a\xb9l\x18\xb7=\xaav{\xef\x10K\x8c8\x9f\xd7\xc3 \xfa\x12D\x93\xdc\xd0:\xad\xc3\xfd\xe6Z\x89l|\x9d\x9d\u00c3\xf5\xfc\u043f\x8d\x17B\xaeJk\xf7\xf0p\xad@<\xe7\xf2Z\xa9\v\x7f\x82K9\xe7I\xe3sz\x03-\xee\x1e\xec\xdcS!Wx\xafy\xb0\xbf\x8f\x85\xe3\xeb\b.\xba\xfa\"Q\xa0q\xd0l\xe1\xca\xf1$\xc9\xedDg\xe7poOgp\x90\a\xd4E\xe2G)\xe8\x1c\xf0$\u075e\xc6\xf1\x17\tB{\x87\a\xed{\n\xe6>\xa2X\xd4\xe3q\x1de\x16\xb8\xb2\x9d\xc3G\u05ddk\xb3\xc7z\x1c\xf1x,\xeb\xb7\x0e:\xbb\xba\x81l\xb9\xf7\xf7\xf7\x0f;:9\a\v\x87\x9d\xdd\xf6\xbe\xce\xc9\xc3\xc1\xc1\xfe\xde\x1ef\xc9\a.\xab\xb9\x9d\xc3\xc3\xfd\x03+'\x9e\xa7/\xf1\x97:\x10M\xdc9\xb9\x9b\xb9El\xef\xee\xec\xefXyY\x93\xed\xbd\x83v\xcb\xca\xc9\rrwo\xbfm\xe5\u51f9\xb3\xd3l\u0699\x00\xac\xfcF\xf0(\x85n\xb7\xf1\xbc\xe9\x1a\xb2\xab\x83\xc3\x039\xc08wf\x0e[\xbb\xd8P\uc9dd\u0728\xf7\xf6\x9b\xcdv\x96\xb3\x10\u04cd\x1d\xa8S\xdb\xd9owL\x85\xa1VkO\xb7A u\xe9\x0f\xbf\xa4\nf;;\xed\xfd\xb2r`\xb6[\x87\x8ed\xb9\x83\xbd\xfd\xfd\u0772r\xd6\x0e\xef\xed\xec\x956\x95\x9d\x008\x13\xb2\xbd\xd6\xee\xfe\xee\x03\x85S\x9e\\\xc9\xe5\xbfq\xbc\u00fd\xfd\u00fd\xb2\xf2\t\xffc\xc1SQ\a\"\xb6\xceG\x81\\\xe2\x1b\u0130\xed\xfd\x87j\xa8\xa2\xed\x9d\u0743\xb2\xa2j\b\xf5a\x1c\t?\x88\xf4\xd9=<l\xdfW\\\x16\xda\xdd\xdbke\xfdg\x98\xe9F\x9e\x05\x93c\xed\xfe\xfe\xcen\u02e4\xe7\xc0o\xaf\xddjfU\xf2[\xbd\xdb\xdc\xcbv\xa6\b{\xf9\xa2\x87\xad\xfd\x9d\xd6\u01b2%`t\xd8n\xee\xec\x98\nX\xc0\x9a/\xc2a \xf8LM\xab\xd9\xd9\xdd\xdbX\xd8\u00bc\xfb\xfb{\xad\x8d\xe5>\xa7\xb0\xf7\xc3)\x9f\xf9uIs\xa8Eo\xb6\x0f\xf67\u059a\xf9\xc9\x17\xc0\xa4\xb2l\xbb\xbd\xb3\xd7\xdc\\6\x1e\xf1PmRg\xe7\xf0pc\xc18\n\x83\x88\xd7\xd1\x13\x8d/\xe2\xa4~\xe9\x8f&\\-zs\xf7`cE%.\xaf\xa7\u009f\xcd\x15\xbe?\xdcQ\u00cf\xb0d\xc8\x05\xb7\x91\xcf\xc1\xae\x1c\xb1\x86\xce4\n\xe6s.\xd2\xedq\xa4v\xa2\xd5\xd9--\x905r\xb0\xb7\xbb_^\xa6\x98\xa0\x0e\xf5N\xbb\xb9WZ\xbe\x80\xdf\xf6\xf6p\x91R\x7f\xcc\xeb\t\x8fF<\xb1\xc1^\xde\x12\x97\xc0\xb0\xfb\x89:R\x9d\xbd\xd6\xe1\xee=u\xc6\n\r\xa9\xfd:\xdciv\x8a\xa5\u01d1>H\xad\x83\xb5\ueb55\xdb\u07d3h;\xf5aU\u0342\xed\xee7w\x9bv\xba\xb5N\a\a\xb2\xb79\x1f\xe6i\xafV\xbb}`rl\x88mv\xb2\xf4\xdc\xc9l7\x9b\x9d\xb6\xc9\u02af[\xa7sp\xd02ykd\xd7\xfe\xfe\xae\xa4\x01\xd3k\x7f2\xe1I\xfds\xaa\t\x9eu\x1a\xad\xb3s\xb0\xdb.\x14\xb6\xae\u00dd\x8e\\\xa3\x85\bB\x1b\xa5\x1c\xec\xb6q\x1b\xae\x02~\xadWf\u7833\xb3o\x12-\x04\xde\xd9i7Mz\x12\u01e2\x1eD\x18\x81Tm\xc4\xee\xfe\xfe\x1eu\x86q\u00b7\xe7\xe1b\x12Di\x91\x9e\xcd\xe7m m\xd7\v\xadQ\xb9\xebE\xca\b\xde\xf5R\xa5\xb4oI\xb1\xfb\xc8\xe0\\\xf1{(\xe2\xd2r%\xc4qi\xb9\"\x9d\\Z\xa8\x8cd.-XJ=\x97\x97\xdc@H\xe7\n?DSo.\xbcF^o.\xbaNio.\xfb0\xd1\xfd\x98\xbae\xf4w\xbe\xde\x06R<Wh\x03U\xbeV\xe6\x11\x04\xfac\xea<\x82V\xff\xeaf\xee!\xdb\xd7\xdaZ\xa3\xe0\xd7J\x94\x11\xf3k\x85J\xe9\xfa\\\xa9M$~Y\xa12j?Wn3\xe1_Vl\x8d\a(+T\xc2\x0e\x94\x15+\xe3\fJ\xcb=\x96I\xc8W.\xe3\x17r%6\xb1\x0e%\x85\x1e\xcdE\xac\xd7}\x90\xa1\xb8\xb7J9oqo\x9556\xe3\xde\xd2\xf7p\x1c\x8f\xacW\xc6|\xdc[\xf5!>\xe4\u0455s,\u027d\xb5\xee\xe5N\x1eS3\u01e8\xacW(\xe1Y\xd6\v\xad\xb1/\xebE\xca8\x99\xf5ReLMI\xa9\xc7\xf07\x0fW\xdb\xcc\xea\xac\xd7}\x1c\xd7\xf3p\xbd5\x06\xe8\xe1*\x0f\xf0B\x0f7P\xca\x16=\xa2\xda\x1a\x87\xf4p\x9d\x87\x99\xa5\x87\xdb\xd8\xcc7\xe5\xeb\xde\xc7B\xe5J>\xc0M\xdd_v\x8d\xb1\xba\xbf\xf8\xbd<\xd6\xfdU\xcb\u062d\\\x8d\xaf\xe7\xbc\x1eS\xbd\x94\t\xdbX\xb1\xc0\x8fm,\xb7\u019a\x15J\x96qi\xa5E\x8a\f[\xbe\xd0\x06\xdem\xbd\xd0\x1a\x1b\xb7^\xa4\x8c\xa3[/U\xca\u072d\x17\xdb\xc4\xe7\xe5K>\x9e\xe5\xdbTo\x8d\xfb\xcb\x15,g\x04sEJx\xc2\xf5\xfc5\xf6p\xbd\xc8\x06Nq\xd5\xcd\xc7|VV%n\x16\xa1;rEN\xf3V)\xc0E\x8d\xd8Mh\u07b3\x85R\x7f{\xeeGQ,*\xe3 \x1aUf\xf1h\x11\xf2\xca\x13\xa7\xc6k\xce\x13\x87t\xa5\xb2\x9ch\f\xe3\x11g\xce\xeb\xb7/>\xbe:\xb9x\xf3\xf6\xc3\xc5\u02f7\x1f\u07fcp\xb2@\xbc\x10Is\x15\u02e0\xe3\xeb/\xf7\xb6\x927\xa8C\xc5\u01b9\x87o\xf9~\x8fi\xdc\bF\f_zV\xb4\xbd\xdb\u06bf\u03d0\x0e57\xbd`\xe6O\xf8vz5\xa9\xdd\xcc\xc2\ue95f\xf2\xbd\x1d\xfa\xee\x877\xed\xdfn\x9fu\xfcO\xef\x9b\xfe\x8bf\xf0\xfa\xf3\xf1\xf5\xf0\x87Ip\xfa\xfcY\xfc\u06e70\xf2\x7f\xf8\xe9\xf0\xf4\xf3\xe9\xf5\xeb\x1f\x9e\xed\x9c\x06\xc7\x13\xfe}+\xbd\x8c^\x1f\x9e\u03a6\xcd\xd1\x0f\xc7{\xafn\x0f;\xa3\xcep1\xfa\xf3\xf5\xe2\xb2\xf3c\xf4\xea\xcf\xd3\xeb\xd7/\x8e\xaf\x86\x9d\u07e2\xd3\xe0Y\xdb\xff\xf4s\xe7\xa7\xd9\xe1\u03bb\xb3\xd3\xeb\xd3\x17\u01d3\xd7\x1f\x8e\xafO_\x9c\\\xbf~~:\x19\xfe\xf0c8l\xff|;\x9a\xfd\xfcl\xd8y\x16\xfe\xday\x7f\xf6\xeb/\xef\xe7\x97\x7f6\x03~\u049a\xff\xf62|\xe3\x7f\xfa)8\xfd\xfeM\xfa\xeb/o\xfe|w\xf6c\xfa\xdb\x0f\xaf\xc5pv\x98^~\x1f.~\xbb=\x9d\f;\xefw/\xbf\xffxx:\xfbq\xfak[D\xc3\xd9a\xebr\xf6\x93\xf0?\xb5\xa6\xbf\xb5?\xee\x9d~\xbf{u9\xfb\xb8\x7f\xfa}>\x7f\xf8\xfd\xe1\x9f>\xf4\xd5\xde\xd9;\xfd>\\\xc0\xef_?]O\xfcO\xbb\xf3\xd1\xf7\xe1\xf4\xf2E:\xf9u\xf6\xf2\xb3\xdf\x1e\xdd^v~^\xfc\xf6\xbcu\xfb\xdb/\xcf\xc2_\x7f\xf9iS\xf9\xe0\xdd\xe7\x9b\xcf\xfe/?~\xbe\xfc\xfe\xe3\xe4\xd7\xce\xe4\xf0\xf4\xf3\xc7\xeb\xd3\xe0\xd9g\xfe\xa1\x19\xbc\xf9p\x1c\x9c~\xff\xdb\xfc\xf2\xfb\xeb\xc3\xd3\x19\x8e)8\xfd~7z\xf5\xe9es\xf4\u00e9\x18v\xde\xdf^\xb6E\xf8\xee\xec\xc7}\xde~su9\xfbm\xfe\xdb\xed\xee\xe7\xcb\xf6\xcd\xd50j\x1d\x9e\x06\xcf\x16\xbf\u0776\xa6\xa3\x1f\xde\u07fe\xfa\xe5Ms8;L~;k\x99}\xe3\x1d\xf1\xf9\xb2\xbd;\xf3?\r\x17\xa3v\xf8e\xf4\xfd\xf4p|v:\xb9\x9c\r\u016f\xbf\xbco\x0e\x83\xd6m\xa1\xed\xdb_?\xbd\x9f\x8f~yS,\xf7\xe7\xe8\x87\x1f\xaf\xfc\xf6G\xf1\xdb\xf7/\xff\xf4\xbf\x7fy;\x9c\xbd\xdc-\xd4\xfd\xf2\xeb/o\xe2_\x7f\xf9\xf1\xf6\xd7_B\xac\x9f\x8d\xff\xf4\xf3\x9b\x0f\x1f[\xf0\xdfi\xf0\u0334%\xc7\x04kr\x02kr\xfb\xee\xec\xf4\xcf7V\xbdW\x9f\xdeO\x87\xed\xe9t\x18\xfd8\x85\xf5z\xfd\xe1\xd7\xe6\xab\xcf_\xfe|\xfd\xe7\xaf7o^\xbc\xbey\xfd\xe7O\xed7/NZ\xa7/>\xb6_}\xfe\xd2y\xf3\xe7\xa4\xf3\xfa\xc3O\x7f\xbe\xf9sr\xfb\xfa\xc3\xeb\x9d\xd3\xcf;\a\xbf~\u069d_~z\xd9\xfc\xed\xe7\xf7\xb7\xbf~\xda\xfd\xf3\xb7\xd9\xe1\xed\xe5\xd93\\3\xff\u04cf\xad\xd1\xf7?\xbf\xfd\xf5S\v\u05b89\x9c\xbd\\\f\u06ff]\rg\xcd\xe0\xf4\x87\xf7\xbbC\x80\xa1\xe8\u01eb\xd1\xf7/\x9b\xbf\x9d\x9dN~m\xbfL\x7fm\xb6\xae~C\u063a\x99_\xce~\x9e\x0e\x83\xd3\xc9h\xf62\x1d}\xfa\xf9O\t\xcf\x1f\xf1\xbf\xb7\x7f\xben\xbf~~\u0712\xff\x9dN\xfc\xf6\u03fb?\x7f\x1f\x8a\xdf~y}x\xfa\xf9x\xff\xf5\xd9\xe9\xe4\xb7\x1f~\x869\xdf\foO'\xbf\xce~\x8e\xfcO;\x87\xa7\x9f\x9f\xfd\tk!\xe1\xea\xfd\v\x80\xb3\u044bf\xe0\x7f\xda\xfd\xf2\u06e7\xdf\u65f3\x10\xc6R{\xf7\xfcpz9\va\x7f\u009f\x7f\xf8qz\x19\xbd\x99]v~\x14\xef>__\xfd\xda\x0eo\x7fm\u07c4\xf0\x1b\xce\u073bI\u031c\x15m\xed\xb6\xf6:\xf7\xa1\x84'\xf5z\xfd<Z$\xa1WQ>R\xb7\xb7\x81\x18\x10q\xc2\x1b\n\xcd7\x82x\xfb\xaa\xad\x91~\x03\xc8A\xe7<\x1a\u0173\x8b`\xe4U\x9c\x7f\xe8\xdb`\x118\u7461\xbc>\xe6\xda4\u0279Fu\xa2s\x1e=Y\xd1\xf6\xce\xde\xee\x8e\xe7\xc2S5\x84\xb4\xe8\xec\xb5\xf4\xc7\xe1\xce^kO\x7f\xec\x1c\xee\xb47Yt\xda\u05b5X \x1f\xa4\xb6\xa7\xb0\xaa\u051a\xd5qm\xb1\xa8\xb2\x9c\x8c(\x8f\x163\x9e\x80*6x\x85\x95\xd7\xe2\xc2|_'\x81P\xbfW\xc4\x03\xcb#\x16Q\xbe\x8a\x1a#W\u043b\xdfp\x8c\xc9\n\x1cA\xef\xef\xec=f\x98f\x84\xc6\x1d\x92T\xac^.\xcb\xf5MZ]qT\xb4\x89\xec\n\xed\xa5'\xb2L\"E\x16R9\x81\xd9Gd-\x9aq>\xbe\xaf\x8e_\x9e\xa0S\xe4~2\xc0\x98p$\v\x1cO\x93\rv\xb6\xeb\xd3\xdf\xebt\xf6\xf6\x1e\xb7Kx\xdd*\x8b\xe9\xbc~2\xeam\xca\b\xaey\x9b'\xe5\xf4\x1d\x82\x02\xe8\x887\x18\x8e=b~?\x19\xd0\xcc\xcf@D\x9e\xb2&\x06UU\xa1\xf0\xb3\xd8\xc7%\x83n\xb5\xf7\x1f\x05Ykv\u07ba\xa9w\xd8\x16\u05d6&1\x98\xae\xbf\x89\xc5\x14\xf41\x93\xbfn\x97.bT\xeb/\xa9W\xc9\x05\xb5\x06\x1b[\xee\xc1\x00{\xfd\x81\a\xe4\x05M\xf8\x9c\xfb\xa2\\\xad\x1d\x9c\xce\xc1B\xca@\u0522\x1b\xd5X\x8b$5\u01b3\b\x18`\xee\xce'\xbe\b\xae\xf8o<\x89\xcbF\x80\x9a\xad\xd5\xea\x1b\f\x13\xd9xs\xf2\xfd\xf1\x87\u04dfO.N\u07fc<}s\xfa\xe1W\xc6Xk\x9b\xaf(\xb2\xbd\xa3M\xfa\xf5\u0517\xfa`j84an~\u03c5\t\x13\u040d\x8e\x129T\u078f\x99\u07cf \x9a~?6\xd6\xef|\xb5\xb2\xfd\xa9g\xfd0\xf4\xbe\xc7\x1b\t\xf7S\b\x0f\uce0b\xe8K\x14_G\x15\x99B\xb2\b\x1e\r\xe0X{\xae\xfc\xdb\u040e\xb9j\xecI\x10U\x9c'5+\xbd\xf6\u0129<\xc1P\x00\x8e\xeb\xd4t\x8d0\x88x\xadE0\n\x95N\x1b\xc6\xe1b\x16a*q\xe8\x16\xba/\xc2\f\xc5\x7f\xc9\x1e\x9c\xf3\xe8<rj\xf9,\x88\xc3\x02\x86W\x11\xf1\xac\xa0$\x81\x9c\x1b\u04a4Y\xfc|e\xea \xfd\x11\xfdz\xfc\xfa\xd5\xc9\u0350\u03e1\x82\xf2\xf5('\u02f8\xfc\x82n\x98P\xbfy\x9a\xfa\x13\xce|y\xbe\xb7 \x98\xa0j|.\x16\t\xe8\x81\r\xbf|H\xc0\x88vC\xba\xac\x88\x8d\r\xe3(\x15\xc9\x028\x15\xed=\x19\x8aIe{\xacNd\n\xa8\x8a\xad\x82\f)\xe9m\x97\x9a\xb4\xae\xec\xc9\xe4\x12j\x15\xb5;aA.G\xc7\xde\xda\xe8\nOE\t\xab855]\x1d\xaa>eA\x06?\v\x89\r\xa4\xf2\xaeRMu\xd0]#M\xad\xa0?n\xbc\xdd&u\xe3\x0f!\xa9\v\b\xf7\xe0\n\x96\xd4\u04da\xeb3\xa7\xd2h4*\x99ck\x1a\xd5\x13,\x11\xb1\xa4\x96\xd6\xdd@\x96\xb0\n\u0725\"\xf1\xfc\x9a\x0eY\x00\x18\u02728\x15`n\xfa\x7f\xfc\xbf\xfe?\x0e\xa9\x05t\x1e\xa7^R\x175\x8d\t-\xab\xaf0g:\x1b7$6\x00s=*\xea\xda.\x89\xd4\xf8\xaa\xa0\x0f\xa7\xb1\xb2(l\x06h\t\xa3\x0f\x94-\u07b8\\\x8c\xc7\xdc\x18\xedArW\x80F\xdc+l\x14\\\xd3f_l\xff\x90P\x1dI63\xcf3\xa6\x80\xc6*\x90\xb5J\xcb\xc1yJ\x9f\xf1q\x9cp,l}\xb3\xce\xe6\x1a\xc7c\xc1\x93\xac\x02~\xb2\xb6\x15\x81\x9f&l\xfb<\xe9\x9dG\xcb\xf3dy\xde\u071eP\x9f\x81'\xa1\x00\xac}\xc0\rz7b\x89r\x86\xa2'\xdc%\x81\xb4*\x8aTTZ\xea\xe7\xbek\x10D\xc9\xec#o\xcc\xe34\x80E=b\xaa@\xb5\x9abd\x9b\x94\xf9\x99\x1f\xdc\xeeZ\x9aR`\xc5\xf8)\x00oSf)s#\x82\xb1\xa7E\x03\u0765\x1df^&\xd1\x11\xb3\xf6\xa2n\x16\xbb6\xadu\xe4b\fY\xab;<b\x85\x85\xder\xd3\xfa\xf0\xa8I\xba\xc3Z\x8d\xcc\xd9\u00ac\x01\xf5\xfbi}8\xa0\x81\xfc\x93\u0371\xee\xfa\xfdtP\x97\u0644\x8e\xc0~#\x0fu\xaakR\v]5\x8d\xfa\xb0\u05b2\aM\xa7\xa4\xe6T\x96\x15\xa76o\xa4\"\xa9\x81\x01hm\x8c\xc3,\x8e\x01G`\xf7\x8f=\xd6\x1e\xec\xf2\xe1\x0e\xf3\xad\xd4\x1dj\xafY\r\xec\xa9SRs~\x87\x92\xf9\xb5S \xb7\u59b5\xe1Sf6\xa5t\tkr\tk\x1b\x96\xb0\xa6\x97\xf0\x11\x13z\xc4\x1a\x1ag-\x16\x1e\x89 \xb8\xbf\x83\xe62}\xe7K\x10\x8d\x1c\xea\xcc\x16\xa1\b\x1c\xea(q\x83C\x1d\x83e1\x14\x9a\x8cr\xf0v\xecPg\x9e\xf0Q0Deu'\xe1\xf3\x84\xa7<\x12\xf6o\xd0\xedu\xa8\xa3\f[\xa4\xf1\x1buR\xf8{\x1c\x06~\xcaSg\x80Q&\u04a1\x1f\xfa\t\xe4\x81P2\x1aB\xb1\x99?\x9f\a\xd1\xc4\x19t\xa5\xff\xc9\x12\xec\x84\xf1\xe5i\x9eN(w)\x80\x9e\x96\x19csC\x1a\n\xdb\x19M\xea>\xf9\xa8\x88\x81\x18/K\xb8\xe6\x05\\\xeeAZ\x99q\xe4\x1e\x90i\xc0=\xaa\xc4c\xc8\xc7\xcb\x1f\xae\xd8\n^9OP\xb3\x17/\x17\xd9F\xaa/V\xe1O\xf4}\v\x8b\xcc\x04\xfe\x91\xb8\x94\u068eY\x99\x89zb\x91\xff\xc6~\xa7\xb9*\\\xadLd\xbf\xf3\xfcBF\xb2c\x8dl\u05d8\xb0>\xec\x11\x98\xbdd\"\xfb\x9d\x1f\xa2\xdaT&\xb2\u07e5\x05\xde\xc8@\xa6\xb9o\xbb\xa0\r\x0eL\xe4>\xedb\b\x88L\u023f\xcb\xe5\x96\xf2!nCO\x89\xbf\x98\xbbU\xde\r\x12\u0426\x0f\xfa\x9c\x88\xc8\x1d0\a\xe5\xae\x18D\xdfx\xb9\x1d\xb0h%\x9d&\x88\x95+rC\xd17#B\xd98\x832\xbd\xe9\x1b\xa0\r\xb2\x10\xd6t9\x05s \xc3\r\xc6\x01\x1fU\xc6qR\x0el\u0762E\xb9\xa4r\xfb\x19%\xdc\x17\x1b\xa6\xa4]Hi\x8a\xbalI\xc0\x1c4B\xd0E\xcfC>\xc6~D\xf0\x85o\xf8\x01\tr\x93 \x05\x7fI\xa2\aW(\x02\u0798\xe3\xafU>\xfa\xa1M\x86I\xb6\x00\\\x06\xcd,\xeaM\xa6\x96\xec.\x1a\xe1\xf6\xd1\xdd{.\xe2\u0294D\xda\xd4\xd78u,\xb2F$b\x91\t\x9e.\x8b\xa1\xa0\x97/\x97[\x85\xb2\x8d`6\x0f\x83a\x00\xc6yky\xfcF\xe5\xe5v\xd49\xc3\a25\xf4\x8af\x8e+\xe94^\x84#\x19\x85\x05CmV\xfa\xf8\xb72\xa0\x958\xa9\xf8\x15\xf9\xb0fc\x17\xf7\xae\xa2\xfb\xf7*\xfdF\xa31\xa0\x15~\x93K\xa8\xac\xc0d%\x1b'\xae\xbb0\xb3\xcb\xc6Oh6`\xa47\xb3%\xb0&\xb2\x12\xe5p\x02\xcbS\\\xe8\u00ac\r\x9c\x86A*\x001\x1a\x18M+\xae\x9c`\x10MB.'-\xd9\\RQ\x8f\xc5i\u016fDqT\xb7\xf2\x1a\xd2->o\x80\n\xcc?\x11\xca\xf4\xed\x80F3:9?\x8c\x0fS\x9e\xf0J\xa0\u06d3\x15p\x14\x80\xb9\u0154\x9b\x155\xe3\xd4+\u07e8\x9c\xea,\x89{\x83h\x02\xf9\xe9b8U\xf3\bR\f\x9c\x93.\xe6 4\xe3#3F\x84\xf9\x8d#\xc1\xdc\xc7\x0e\xe2\xb5,\xecO\xd2\xca\u040f*q\x14\xde\x02\xd0@A>\xaa\xf8\xa9\x81\x80\x86#\x8f\xd7\x7f\u048e\xad\x88v\u02d2\xe7\x17\xac3\x9d\x85\xd54\xc0\xc9$v\u051f\xe0p\x97h\xe8\x04\x9e\xd7\x00\xa8*\xc8oJ\nFP\x10^\x13\x83\x90\x8f\xf4N\xb2\x91\x9bPG7\xec\xd8ENn\xec\"\xfc\xa6\xa4\b\xcc\xed\xb5?_s\xae\x00\x9c \xbb\x93\xb0\x05\x0e\xc64\xad\x02\xbf\x15\xb1\x02?\xf5{&&\u00eez\xbaN\x7f\x90\xd5\xe9\x0fL\x9d\xfe \xab\xd3\x1f\xd8\xe2\x8bD\x9a\xfea+=Wa\u06fe\u013e\x03\xe3\xdb@\xa57t#:\x83x\x91.\xdbG\f>`\x91)\xa4S\xf8J\x06\x05m\xd2u_k]~$\xba\x1cD-&\xa7\u03f3+%1{\x1a\xad\xdc\xf5M(Yt\x88v\x85\x80r\xc5ft\x82OjS\xd7\x11\xfe\u013b\xf5ga#N&\xb4\xddl\xb6\xbdT$\x0e\xbd\x83\x91{\x8644\x94\xce&\x87\x8b\x80\x1ez\xe0\xba\x06\x8c\xa9n\xefi\x9c\xff\x915n\xc8\xcd\xc76\x0f\x1b\x04\x81566?\xf3\xe7\xa6yM\xc2>\xba\xf5;\xb4\x04\xbb\xc6\u05af\xdc;\x83\xf5\xfb\x13zK/\aJ\xaex\xb2\xb9w\x19\x90\xbc\xb0t\x8a\xa6,\xfa\xf8\xd0N-\xb5\xb3\xc9fW\x9b4j\x91\xaa\x1c\x1f\x86\x9a\xa8V\x9d\xff\xe9H#\xf6\x1d\xf9\xed\xca\xe8\xe72\xcdyc\xff\xfe\xf8\xea\x15\xfe&\xab\xb2\x99\xe7&\xbe\xa2\x86\u06bc\xdf\xf1\xa6!'\xbd\xbb\xa1\x1f\xc5Q0\xf4\xc3\xf56\x9d\xff\xe9\xach\b..\x87~\xcaK\xf2q\xcc+\xba\x98\xcf7\x16\xc1\xe1\xaf\xe8\u041f\xf1pS\x11\xd9\n\x9f\xcd\xc5mI\xb6\xb3ZQ\x9b\xac\xf5\x1c3$G\xed\xe1\xcd\xe6=\xbc\x8c\u3ffc\x87\xad\r{h\xf6L\xbb\x04\x85}\xfa`\xff~\xff\xf1\x04\x7f\x93\xe5rW\x17\x1e\xfbajJ\xbc\xcc}\x1c\xbf:;\xb9g\x8f3\xc9\xf6\x83\x1d\xde\x0f\x01N_\xde2\x95gq\x1cr?\xc2\xf8Uk\x0f\x19\x9a\xf5\xcd\xe21Z\xe0R\x02\x0e\x16\x8b\u0513#\xf4\xd4dKA#W\x1c\a\xee\xa9\x15(\x05\x93|q\u067a\\\xbd\xfb\xe1\"\x1fKW\xef\xdc\xc1\x11\xf00\xfc\x88\xed\xeef\x84\xf4Yy\x89\xfd\x95\xf4\x8c\xbd\x11\xb6\x82H\xfc\x8b\xa0\x85\xa1\xbc\xb9\t\xb6\x8b\xd1z\xb7Zh\u06dcdE\x03\x8c\xe1,\xfd\x06\xf3~\fn\b\x9d\x1a|C\x18\x06H\xaa\xd5\xe2\x01\xa1N\x13\xb6S\xf2\xe71zKJ2l\x04m\\B~VA\xbe\x84\u0175Z7>J\xba\x10z\x19\n]\xd8\x1da[N\u04d1n\xb5\x9c\x16\xfe\xc8\x06\xe6[nc\xc1s\x00\xd6\x15\xe0\x15\u0379Qcy\\\x17[.\xac\xfbZ\brp`\x1d\xc1N,\x97{\xbbG,\u00af\xfd\xe6ry\xb8\xaf\xbfZ\xcd6y\u0500\xe2\xaf\x1b\u0405[\x1c\u0283\xbd\xacdS,\xb7D\xd0\xddC}\x9d=\xae\xaf-w\v\x82\xee\xa8\x1e6a\n\x05W\x8c\u04c4\xb5LP\xac(\xb3\x1b\xbfp\x88b^\x8c\xec\xea\x02D\xe0\x0e!\u0500Y\xd4o\xe6\xc1\u0329;\n\x91%\xac\x0e\x11\xb3\x186!%\xea-\x02\x9e\x135\x04FD\xbffu%\xf4\x18\xa8\x94\x10\x88\xd1\xf0U\x91\xe4;t\xa3}\x1a\tW7\xd6&\x10H\xd1\xc0\xd0\u00e5[{\xa4kv\xf8\xe1\xe2\adU\x92O[M\xf2H\xe4)_\xe7\x1e\x87;\xabU\xfem\x8b\xb1&\xb8\xf4m\xe4\xdf\xff\x8a\x98\xf52\x88\xfc\xa4\xf4]\x92?e\u035e\u04fctjY\an\x9bxN}-\xcdl\u020a\xc6C\xe1\x87\xf74\x17\xe7\xaa\x1e`s\xc54\xab\xb9\x11\x1f\x06\xb3\r\rfUp\x15\xa7\xfc\u01bf\xaf\xb8\xec\xff&\xd7Wk\x0fd\xae\x1f\xe7s\xedO\x1b\x86\xf3@\x91lt\u015b@\xf5\xeeP[\xaad\x96\xb8\u07e6\xcee\x109\x03\xb5F\xfd\x03\xea\xc4C\xe1\f\xcc$\xfb\xad&HZ\x87\xce 7\x99~k\x8f:S~\xe3 \xddz\x9cs\x87\xf1\xbb\xdb\xf3\xfa\xf5\u06a0\xe7\xf6ddb\xf8\xe7\x02\x82\x10\xf7\xbc\xf3\xf3\x86\xfe\xc2l~2\xc0\xa2X\xaeFzKS\xa0V\x9a\x8d_\xe7\xe7\r\xb7\xe7\x05\xd1xy\n\xff\xbdyI\x962)\xf2\xa3\xe5\x1b\xff\xcd\xf2\xcd\xf1\x1bB\xbeQ^\xe3\x9eCx\xe1\xac\x11\xbe\x8d\xa9o7_f\xe30\xf6\x1fw\x9d\xad\x89\x04\xb7\u072dc\xed\xbfZ#(\u07b7\xa2_>\x84\xac\f\xeb\xc3$\x9a\x01\xf4\xb8\x86\x9d\nq\xc6\xc1Uk\xbd\u5d68S\xab;\x99\x88\x10\xf0\xd0S\xd6T2\x1c\x83\x9e(\x14\x19C\u06e2\a7c\xd4So\xec\xef\u079e\x9d\xe6\xde\u063dM\x8f\xef\x9e\u04c8\xfcH6\xf1\xc6\x7f\xe3E\x12{\xbc\x84us\xc5\x7f\x1b\xfep\xf9\xb7\xad-\u043b(\xc1\x1f9\x04R\xa2\r\x00h1H\xdf\xf8o\xa0\xac\xf6\xe7\xab\x1c\xf9f\u0112\xa7\u0187\xd3\xebb\x92!\u06f2\xcc7\xc7oT\xa6!\u04acL\xff\x8d\xb3\u04b2\xc2M+\x8b\xf4\xcf\u00e3\x80}\xda8\x8a\xd37/7\x8f\xe24\x1a\xaf\x8d\xa2L\x87\xe2\x11\xa3\xa8\xdf;\x8c\xfa\xbd\xe3\xa8\xe7\aR\xb6o\xbad\xb3\xd1t2\xe0\xcf\xe3Q\xfa\\\x9e\xa9\x88\xf4\xb2\xab\u0681\u01dc\x06w\x88\x17\xddG\xfd\xd2\xcf\xecZ\x8b\x83\xef\x8c\xfc\xb3\x7fBo\xe8\x17\xfav\xb0\"\xf4\x94}\xa6\x1f\n\b\u0320-\xeb\x1fRw7}|\xe3\x10\xfa\xe6k\x9b\xe8\x15\xbe\x00\xdf}\x10\x83e\xbfr~.\x065\x92\xcb\xf4\xec\xeer\x1f\x12\xa9\u0294\xef\x88\u012a\xd8\xc2w\xeeoK\x17\x10\x1f)v\x93\xabOz\x84\xf44\xae|\xb7\x19+\x8a`\xc6\xd1t\xe1\xafaFW\xfd\xfc\xa0\x83\x80\u0217\x8d-\xc6\xde\u8507p\xa3\xd4\xe8\xa1\x01M\xe9\x02B\xe7\xd1!z\x89\xeffL\x85+\xb2\xf6\t\"\xbe\xacq\xaa\u0288u\xbf\xa3\xe0:QIiy\x05\xcd\x0e\xa4$6b5\x01\x81\xbe\x13\xf8\xdb\x1e\xd4[4\x86_\x9d\x01\xdd\x12\xfd\x1dC\\i\xef\x8b.\xfc\xd3\xf8\xf8\u1e4bC\x95\xbe\x84|\xa8\xb1\x03o\xff5\xd1\u07c5\xe7\xff\x9a\xe8\xef\r\xa8\xe8\xef\xabxy!\x83\xdf&\xa0j\x87tC\x1d\x0f\xae\xd3%a\x8d9M\xa7\x1b\xb2Zhb\x94\xf6\x0f\a\xe8\x17h\x8f\xef|\xe7\xee5\xbf\x83Q6\a\xb5J\xcd\x15\xfdVk\x00.\u0791\x80\x85\xd9\xea\xb2\xf5!\xbaz\xdf0V\xb5\xac!!t\b\x9e\u0755\xff.w\x91s(I\x17+\x9a=\xf3y\xd0D)\xd6\u0351C\xa7go\xf5\xfb\xf1J\t)\xde\xdf#\xe6\xe2\u0244\x7f\x05\x849GG\x8a\xf37\"\x9d\x15\xa1\xaf\x99s\xfc\xec\xf9\x8b\x93\x97\xdf\xffp\xfa\xe3?_\xbd~\xf3\xf6\xddO\xef\xcf>|\xfc\xf9\xd3/\xbf\xfe\xe6_\x0eG|<\x99\x06\x9f\xbf\x84\xb3(\x9e\xff\x91\xa4bqu}s\xfbg\xb3\xd5\xee\xec\xec\xee\xed\x1f\x1c\u05b6\xd9yt\x9eH\x0f\xac/\ue469 \x19\xf5w\xb0\xbeM\x1ag\xec\xaf\xcf^[*tq7\x92\x1c\u04d6\xeb\n\xe6\x9b\x1b\xde\u0130\x8b\b!O\xf7v$\x0f%\x8e\x9aY\xfbI\x8d\xed\x19\x1a\xff\xdb\x03\u019a\x0f\x9e1\x9b\xde\xe8\x9f'\xe7\x11\x1bH\xaa\x83\xc6,\xb1\x06H\x03\x1d\xe3\x02F*X\xb3+\x8ebT\x19\x15\xdf\xee \x9d\x0f,\x92r\x95\x1b<}\xda\u06abb\x14\x83,\xe5 \x97\xd0\xde\u076d\x06\x84\u0400\x05GG{\xcbl\x9a&F\xaa\xc8\x02H\xa0\xff\xaa\x88\xc5\xdf\xee|\xb7Gz_\u07cb\xd7:@\xd2\u01ee\xd9,\xd6lc\x02\xf1Zm([\xadf9;\xb2(\x80\xc5\xc7 \x12\a\xf2\r/},\u0653\xd5\xf9z\xb1S\xf9\x9e9\x8e\x12\x99\x18\x10\n\xd8kkc|\xb51\x1d\xb31I\x8d\x05\xfd\xf8\xe9\xd3\xd6Au\xaf3\xa0\u6cdd\xfb\xdc\u02fe\xf6:U\x10\xad\xc4\u030d\x8f\x8e\x0eH\r\x9e\x81\v\xdb\xe1\x7f\xdb!\xbd\xbf\u06b2\u05d6;b\xca7s\xe5w\xac\xaf\xa3#\xab\xad\xbd\x9d\x01\xf1Zr\x87L\xe5v\xae\xf4N\xaetV\x8d&\x80,^\xb1\a\x14\x98\xe9\xb3\xcd[\xa4\"\xa0oD\x10\xb1-\xb7\u03de\x05\xbeNt\x9e]}R\x81\x8c\ub765\x11\u02d4\xb5\x8f\xa2\xae\x80G\x15h(a\xa0\xa8-\xa3@\x1b\xb0\x93\x93\x18\x80\x1c\xe3\x99\x04\xad\x84\xe4\xc53q%\x88*\t\xa0\x9aW\xaa\x00\\c\u0420_\x90\xc3\x006\xf2sr9\x14\xae\x04\xe6\xd4\xc6V\xd3J\xb3-&\xabL\xe5\xe4\xeb\x1eF\xfe|`\a>n\u0781\xb9\x1f$\xe9\u07f9\x05r\xf5}\x96\x05U1\xfaXT\xeeI\xb0aO\x02\u0613\xb2\xed\xf8s};\x82\xb1\v\v\xea\xc6,o\xb2E\xf21\x10a?@#\xa2\x1f\x83\x82a\x82\x7f\x06\x83\a\xd7y}\xa2\xfd\xc1\xdf2Q5\xc9\xe2\xa8i\xc9 5\xf2\xf0a\x83\xffx\xe8\x10ve\xb4\xfc{\x9e\xdf\xc4\xfa\xfb\xd8\xd7\xee\xb1>Y\u04ae!\x18\xbb\x7fh\xe3\x05P\xd9PP\t\x1a(\xd6\r\xfb\xd5\x10-\x1f\xe3>\xb1\xd3\x12\xb6\xe4\x1d}?\xa0\xd9\xf3\xdc\v\xfa\x92~\xa4?\x03\x97\xf2\u00c3X\xea\x1b\xb6\xdd?\xbfi6\xeb\xe77\u0343\xf3\x9b\xe6\xb3\xf3\x9b\xe6\xf3\xf3\x9b\xe6I\xfd\xfc\xa6\xf5\xf2\xfcf\xffe\xfd\xfc\xe6`\xe7\xfc\xe6`\xaf~~s\xf8\xf2|\xf1\xf2\xe5\xcb\x13\xfc\xf7\xe5`\xd9?_\xbc8\x80\u028b\x17\xcf^\xbe\x1c\xb8\xbd-Hy.S\xa0\x04Y\x02g\xf1{\xbe\xd8\xf2wR(\xb6M\x7f\xc4q\x1c\xec\x9e/\xda\xcd\xf6\x01\xfe{8\u0626\xffd\xdb}z\xde?\x1f\x9c\u07dd\xaf\x06\xdb\xf4{\xb6\r\x82\xa2\xad\xe5\xd6\xd6r\xab\xef\xd7\xff<\xaf\x0fj[\xe4\x9b\xed\x80\xfe\xa6\xb3\xfa\xbfg5\x80q\xf9\x16X\x16\xbf>\x1e\u0735WK\xf9\xfb\xcf\xf3\xfa?\xba\xe7\xdb\xe7=\uffea\xec\xbcv\xfe\r\xbd8ol\xfd\xcf\xf3\uf79c\xbb\xe7\x04j\x0f\xc8w\xdfl[\xca\u05ffZ\xdb\xf2\xf0\xd5k\xaa\xfddUk)\xbf\u06ed\x0e\xfc\xcd\xca\xfcb\x959\x94E:\xed|\x11\xce7\x95Y.75+\xec:;;\xb2\xd0aK\xfd\xed\xa8Jm\xf3c7_=\xe2\x86X\u85bc\xed\xf4x}\xe7\xc0\x83w\x04W\xb0N\x1b\x9d\x89\v|N\xe8\x89\xfa\xe1~\xad\xd5\xf4\uab6c\xb5$7\x18 \xa3x\xcf9o\x82\x8b\x04\xf9\xfb\xff\x06\x06\xb9*\xf9\xd2\xf1Z\xad=5P\x95& \xad\xa9>\"\xf8\u0425\xaf\x1c\xaf\xd5l\xab\x8f1\xe4\uca0f\x04rZ\xf2\xe3\xff\xeex\x1dU\xa8\x02v\xc4\xf8\x13\"{\xed\xa8\x01l\x83\t\xbc\xaax\xeex\xfb\xaa\xf9\xff\xfd\xff\xe9x\x87\xbb\xea\xf7\xff\x06\xbe6T\x19\x84S\xc7;hZ\u07c7\x10\x1d&\x9btlO\x9a\x1f\xb1\xbd\xdd\xdd\xcenO\x81\xca8\x89g\xcf\u0563\x05\x84e)K\xde\xddm\x1f\xee\xd5\\^\x87\x9a{@\xdd\x10\xba\xbb\xd7i7MZ\xb5\xd5lw \xa8\x9e\xd2\"\xf7\xb9\x85x\u06fb{\x84\x06k))g\xcdn\u028f\u06bb{\u0754\xd7j\xc4\xe7\xfd\x94\x0fX\xc2\u0754\x13\x88uA\x03;%;\x04\v.\x85cJ\x8fs\xbe\x10Z\x95t\x1c\x84<\x92\xaa\x96\xfa\xa7\xad>)\x15\x8b\x98P?\x96\xcbO2=\x8e>\xf9I\x14D\x13&\xb2\xdfv\u0150O\xfc\xe1-\x13\xeaG\xa6y\t\x86\x7fL\xe0\x9f,1T~\x92\x990?\xed\xc64\xc6\x04\x9d\x9a\x94Y\x03[W\x17\xc1L\xa1to\xcaJ*\xbd\x1c=J4,0d\xb4\nJ*\x95\xa8YS~\x87A\xc4\xed\xdfg\xc2O\x84\x9dp*\xad\x0e\x9azE\x93T|\xf0/O#\x88\r\xc8\xeaj\x8a\xda!1\xf0P\x19\xa4\x85\xdc\u05ba\x94A\xb1\xb8\xd9\t*\u057c=.\xf7\xcc\b\r\xe0\x1dJ\x8f\u04b3\x14\xd7a0\x9eT\xe6\xa6\xd2R\xc8\u02ad\xf3l\xf4++\x04\xb4\xb4\x0ebC7\x92,N\x8av\"\xd9\x10\x87\x06v@\x8e\xa2\x06\x9ce\xcfU6\xcf\xe0\xa0Z\xb5>\u0515\n{\xa9\xea\x12|^\x1esv\a:c^1>\xc4\x15\x06\xb3\x03c.}\x8b6\x94/\x8dj\x15\xc7\xe2\x8c\x16\xb0\u067eV\xa2\xfe\x16\x9a\xa9\x8c\x82\x84\x0fA\xd4\xe8\x10*\x1f\xfet\xc0\x1cY)_\xa8\xe2\x0f\x87|.@!\xce\x1f\x8a\xf0\xb6\x12G\u0728[:Fz\xe4&p7\xa9\xe7\b-v\xab\x91o\xb6\xa5\x9c\t\x1f\v\x89\xee!\b\xc3:\xba\xa5\xb2\x147\xc1\x8cd\xca+k#\x8c\x99y\x81K@\xe6\x04\b\u00b7\xd3\xda2\rf\x12\xeb\x0e\x16\x91\x1c5\x98\x8e\xca&\xd5\xc2\xe8n4\x8c9\x84\x9aE\xc3\x17M\n\xc2\x03>\xfc\x02\x10\xf9\fB\xb3\xa5\xcc?jc\xeb~\xb5\u0696\x7f\xe6\xaa\x13\xa3\xa1\xf8@\x1f+\xfa\xe1\xf8\xfbM\xdb\xd7m\x97\xec\xc1\x87\xe3\xef\xef\xd9\x02q\x1d\x9b\x85KA\x97N\x0e=\u01a7M\xfa\xbd\x14\n'd\xb9\\[n\xe1O*S?\x1a\x85\xbc\xe2\xe2\xf13\xed\x10=\xec\\\xd7\x0e\xa1?\xa8+\x1f\xf4\xd7\x00\x1d$z\x1b\x9f\x88L\xf7\x12<~\a\xf1\"\ro+2\x88\x14\x1fU\xd2\xc5x\x1c\xdch\x85\xea\x04\x14\xaa\xb3\xee\x9f\x10\xfa\x9b\x1cg\xbci\x9c\xf3\x84C}7\xe5\xc38\x1a=8Pt\xa5\x1e\xb3\x11\x1f\xc6#\xfe\xf1\xfd\xe9s\xed\xf2\u010d\xb5\x1fu\x10\xe6cOV\xf3`p\xe0\x87\xb2[0O\x8b\xc9JO\x15Lrc[?p\u02b5}\x9a\xdc=%\x1eD[\xca#\x19O/ey\x14\x04;M\x134\xb4\x94\x82\b\u00d7\xc6G~7\x06\xd6\x00\xee\x7f7`i\xe1\x81\x1f\u021f#\x16T\xab\xc1\x11k\xb5Z;\xadVK/\x14\xbf\x99\xf3!\x80\x1dZtW~<{\xfb\xa6\x02\x95\xfd\xa1\xe0 \xa2\xc5w\x85o\xe4\xf2\xa6\xe6\xd4\xc1\x9a\xc9 l\x99v)\xe8\x96\u0393 \x92'\u0174\x91B#\x8d\x84\xa7\x8bP\xd4Xj\x99\xb8\x8d\nK\xa0\xc4\u0392Knh\x93[72\x9b:\x94\xce;P~YQ\fH\xda\xc5\r\x9c'\xf1U0\x02H\x89\x17\xc9P\xeb\xb7\u008e\xd8\xe7\xd7!4eM\xba(\x9a\xa8F\x99\x89jz\xb4\u89b0\x94\x01C3%\x05\xb2\x82\x06\xe0\xa5]\xf4\x030\xad\x0e\x80\xc3\n\x06\x10\xdd-\x9b\u038cg\x16\x87Z\x84.'\x16\xd2awM\xab=&DJ\xa6A\xc6\xee\xc6\xf7\x85\xff\x8a\xb3\xe1\x85G\xc3n\b\xc3+4\xd6\x0f\afs\".\x15\x8f!'\xad\xf8\t\u03eb@W\x82(\rF\xbc\x023w\b]\xb3_\x8e\xab\xd55\xfe\x991\xf6\xab\xee\x04\xff\xb2\xb5\"\xa44\xc0\xe9\xe6\u01b0\xa5\x92fh\xacc^\xc7\xd9{\x82\x8c\x06\xb8\"t\xa38\x1b\xf5\x8f\xd6\x16\u0677\x17\xd9_[D\x05\x81~?\x1c\xd0H\xc1\xbaN3\t\\\x91M?h>\x15\xb0\u0316\x81\x8bXz\xefGz%X.\xe5/j]\xfb,].\xadO\xcb`\f\x02\x05f_4\x7f\xcf\xf2\x91\x06q\xd8)T\x97\xb9\xb8@\xf0\xb8\xc0'\xefx\x83G\x04AczW\xf4}\x90\xf7\x8c`yB\xa0\xd2w\x82\xbf\"\x1eXb3\x9f\x8ex\xc8\x05\xafD\x96]\xb6\xb0\x02\rZ|N\xab\xa9\x1f\xcf%\x96\xb2pN6+Bz\xd9G\xad\xe6!\xdf%z\xae\x9dH\xb1\xa5\xfb\x9b\xa9V\xb3\x8fZ\x8dxr\xb1\xfc\n\xack\x05\x03\x9f\xc2a\xd7\xd8\f\xefb\u022a\xb1Vn/\xac\xe5\xe6\xebDc6\xcf\t\xd7W\xabq\u0120\x9e\x0f\xee\x1bd\x17\u00ceve\xa5\xee/nL\xba\x88\x92\xe3j\xb5.\xe3\t\u6ec4\xf7\xf6\xe2(\xac\xe6\xca\xfb\xab\xd5\xec\x1e\u1fa8V;\xc0s\xc5d\x14\xdf=\\eu=\rB\ued9a\x92\xcaiu\xe4_\xfc\xc4\xf6\xb6~\x02\x8c#\x83\xc9\xe2\xeca\xcf\x1f\x9c;Mj5\xcas\xa4y\x17y\u0238K\xec\xe4Z\xad\xbc\xa9\xfc\x18%\xe4Ie29\xb6\xa4Z\xb5\x9b9\x8a4\xed\x04\xf0?\fx$*\u04b8R\x85\xa1\xa7\x96\xbd\xfd-\xcf\u9a99~\xb4d\xc9\xdd\xd9\xdd\xda\x04\xca\x11\u041a;{R1Ml\x95\xc2)\xf8\x06\xb8'\xb3\r(\"\xaa\xb1\x0em\xde\xdf\xcd\x16\xe7\xf0\x02c]*\x97\x8a\xd0o\xe1\xa9\xc9\xeeQ`\xcb\x05\x04Tw\xb3\xb4\xcc\xd4\x14\x8c[e`p\xd3\xd05/\xf8m\x90\x96a\xf8\x9c\xe1G\xc3i\x9ch\u0277%j.\x02g^\x92m8\x05Y\x1f\xc7\"\x7f\x02\xc1\xa3\x7f\x0fX@hR6\xe5\xe2\xc1\x81\x16J\xbb\xadV\xad\xb2k\xb9T\xd3a\x97\x16\xd1Q\x99-R\x81\xf7\xde%\xaf,R\xbc\xf5\xf2\xf0A\xe8\xce.^\x1a\xd5*\xe7\xee\xbd\u00c3P\xcc]\x82\x14\x19\xe0R\x1bs!\x9e\xd8B\xe6\xb0\x00\x9fL\x18ks\xb4.|p\t\xb4\x06G\xc4d;\xf4B\xe2\xa0\x0e\xddjA\xf8_\xaa\x9a\xd3\x1bN\xec\xce\x1fl\x9e\xea;\x8aA\x84H{\xa4OA\xee\x8a\xebO\xe4J^\xfa#{\xa9\x94\xb1\x93\x12\xeaWx$\x92['\x1bnn\xd2B\xa1\r\x1d\xb6.\u016d\x03K\u0118j\x80@/hh\x8dh\xbd\x14\xe8I\xb1\x80\u622a\x13\xbe\xa6\x9c\x00\xef-\x81\x82\xd2NG\xca\xef\x1f\xb8\x82r\"\x7f\x03\xb6h\x18Y\xca\xdc\xfa\x8ai\x90W\xaaC\xe8^\x93m\xea'\x87\xb7H\u03c5\xa7\x9bG`8\xe2u:x\x8b\xbb\x01T\x88\x98\xb3\xb5\xe5<\xaa\x1e\x14uh\xee*\xf3\xc9\xdd\u05e0~\x85\xf9\xf7\xda\x12\xe5g\x05\x8e\xb4L\xa6\xe7&k\xcc\xc7\xd7]L\xfa\x86^D\x86\xc3\xe0\xd1H\xf3[\x8aw\xb8\x0e\xc44\x88*>\xb0\xbb\x97\xbe\bf\xb0\xee\x0eAe&y\x87\xaa\xa1\x02b\x84\xcbT\xaeY\xb5\xea\x06\xbd\x8c\xfbR\u0321\xe2\x0e\x14?R\xe17\xc3\u041f\xc9-\x05W)\xa9C<72\xe3V\xb3\xaa\xb7h\xee\x9ck\x8e7c:\"_3\x91\x8a\xd9-\xf4\x83\u01866\xb7CqC\x05\xcb\xe3\x8f\xc7\xdc\xe5\xf7\xaf\xf9?5/^\xad>4\xf7q\x18_\xc3\x11\x06\x90\x8e\x93\xdc\xe8V\xe0\xe2\u0df2\xa6`\xa2\x0fL\x0e\x98\xdaDr\xc7I\x19w\x9c\x94r\xc7\xd8p\x917N\x8c^\xb4\u07d3\x18\"\xf1\x8a\u2048\xa8,\x9d\u048f\x06\xb5\xc4s\xb6P\xf5Z\xe59[\x0e\xa6\xe5\x13\xd7\xd9\x04(%e\r\x8b\xc8\xc8\x15\xacmu\x9e\u0522\xda\x13\xe7\t\x84Z\xcf\xf0\u03cd\x8d\x7f\x10\xe3\x1cl1f\xc1\u0443\x18\xa7\xf4\xa6,\xc7:QE\xe6\u06d8'z\x10lr\xa0\x86\a&\x92\a\x06\x88\xe6-\x01\x7f\xbb$z\x04\xf4i}\"\x9d$\x19\xaf\xec\x14\xe4\xc7\x18\xc5#.oY\r,\xbe\xa8\x84\xdcO\x05\x8a\xf5,\x19B\x86\xf9\xef\x03o{\xd5/2V\u0697\xab\xaf\x14\xd2(\xfaT\xa1S:\xa23\u05a2Wp\x17LX\x01\xafkav\xb5\x9a\xfdv\x9dx\xce#\f}.!\x04Jg\x03S_x+\xa9\xdf\xea>\u00af\x80\xa5l\xc1v\xe4\xdd\t((\xa2I\xb5\x9a\xbb\xfb\xdd+I\x1b\xd8\xf7jo\xc6Z\x9e\x9d\x84D\u070c5\xbd\xfc\xa5Y\xad\xba30s \x14\u023c\x19\xb2\xac]\xbc\xf9\x96K\x04\xc0.\xb1\xfa\xea\u026e\x16,\xf8W\xbb\xf3\x16j\xe9\x16\x18\x18\xefj\xb9\xf4\xa5\xf0sV\xad\xeel\xe1d\xdd)k\xc9iK\xd5\x11\xe1\x89Z\x8b\x8eX\xb9\x84[\x8e\xbf\a\xcd!\xbd9\"\xb6o\x8b\x82\xa4\x99\x9a=U\xa4\xe8<#E\xc7\xe0:nZ0I\x96t\x94\u06a0\x99\xfcs%\xff\x00\f\xd0\u06ff\x9ft\x1d\x13\x1a>\x86t\r\xbb(\xb1\x9b,\x97\xa5|\xderi\xd5\xf8\xfb\b\xd8\ah>\xb8\xce|ML\xee\x01\xa5\x14V\xab\xbb\x80\xbf\xc2\xe5\x12\x10D\xa2\xa2R\xea2)\xb3\xf7raS\x18[x$#\xdaV\u0128\xa2\xf3\f\xfd\xc7\x18\xf3\xb5\xaa\xe5\x03\xeb\xf5\x8b\x1b\x92.\t\x1f\xc5\xda\xee\u00bbbH8w\x1f.onl\xbfr=\r\x04O\xe7\xfe\xd0\xc2C\xb6x\xa0\xe2\x8f!\x05(\x91/\xfc\xb6\x8e2\x90J\xca!\xac\x05\u0717\x86,\xb9\f\xe3\xe1\x17-\x86q\b\x9d\xc0\u0441>\x14\n\x92A \xa5\xa4\x8f\x8e\u060cIp\x04\x1b\u6b66\x04\u0298m\xc9\xf3\x02\x10>c\x1a\xafd>,nI\xa6\xc8\xe9O\xd80CJs\xba\xd5\xec\x1a\xc9'\xc2B\xc2\xfd\x11\xe0`\xe3\x8d@\x8d\xad\x02\x1a8\u074a_\x19\xc6a\x1c\xe1e\x1b\xa4)\xc8AV\xab\x7f\xa5\xa7\xfc\nH\u06bf\xab\xfd#\xa0\xe8\xe5\v\xbf\xad\xcc\xfc[\r\xa9\xf6\xd8Pl%\xfb\xaf\xec\x01\xd6\f{\xeeW\xaf \xd0\xd2[M\xe2Mz\xae^\u03e6\xa6-\x83H{q7.\x16\xd6\x16\x04\u0187W\x95Y\x93n%N*\xe38\x04\r\xf6Q\xe5\xf2V\xb9E\x10\xfe\xe5\"D\xb1\x1b\x9a\x06\xa3`\u0271\xbd\x80\x81()d\t\x80\xa5\x05\xf4%\xbc\x14N\xf2\x11\x87\x8ah\x0eo\a0;\nD'\xbd\fD\xbc+f\xb1z\xcbe~\u076e\xca\x16\xcdf\b\x1f:\x87\x84\xde?\vDk\xf7q\x849\xa0x\x80!\u0534\u07a4Z\u0778\xfb\xb7\x86W\xccA\xa6\xe6\x15\x8d\u0091\xb9\x9a\u01c4\u07ae\xe0\x96\xa1SR\xb8hr\xfe)\xe9\x1a\xf1\x00`5Sg\xf2*\xbbu&e\x17\x0e\xec7\ua078\xd3\a\x89\xbe\x80\x1dvh\b`\xea\x83\u04ae>y\xad6 _K\x9d,`\xad\xf6.\x14\x84W\x9c\xbb\xd5\xeak\xae$\x9f\xd0\xe9\u00f8\x13vo\xaa.%\x05\x14\x82\u0407\xa7\xc0\x18\v\xc8\x1a%\x88\x92=\u0619Y\xb63Wzg\u009e\xd9\x1b\xaf\x8c\xa3\xf7\x01\xb9\x8cz\xa8Sc\x1e%\r&\x06\x1c\x8c\xe7\x13\xa8H\x1e\tZ\xb9\\\x88\xca8^D\xa3\xca\x13\xfa\xc4\xd1g]\x9e\xdd\xca0\x9e\xcd\xfc\xca%\x17\u05dc+>g\x18\x87!W\x8a?\x91H\x02\x0e<\xd8X\xd2\x05@\xb6m\xb5(b\x9f\xe9\xe3\x04=\xe8\xcao\xb1Q\xd0#\x88\xa4\xc9\xf1l'\xb9\xb3\x1d\xdb\x17\xa6:\xda--\xbc\x99+p\x1b\x9aCm5I\x1f\xda\x17\xba\xb5\xd0\xe2%I\x97\xe15\x0e\xae\x1aQ$\xf50@\u061d\x15\x876\xb6\xf0L\xd8\xc3\xf3\xe9\xd3\t\x9d\xd3!\x1d+\a\x9a^\xdaS\xae\x121[\xd2\\\xb9\x12\xc4S\x05\x86\xb9\xbep\xd7\x1f\x06\xbb\x9e;z\xdc<\x887b[\xad\xd5\xd7\xc8\x16\n@\xe2\x10\xc0\x19S\u0483\xcb\xc5s\xd3j\xb5\x1cq(\xb4\x81H\x7f\xabE\x87\xf0\u03dc\t:f0N\xa5=\xda\u0791\x0f\xae\x0fr\x83PA\xa3\x03\x94\xbb\x04\x96z#(\xfb\x02\n0b1e\xe2`\x8e\x90\xe3\xe0\x81\x0eP\b\xb9\xd3\xd9\xd8g\x91\x1eB\tg@\x00{-z\v\x865\x83^\xc7k\xabC%\xe5\xc5\x12\x9d\x0f\xa7\xa0\xab\x13M ,\x02\xaf\x04\x80\xbd\x83q`\u0780\xa5\u077c\xeb34\x9dOY@\xc0\x19&\xa8\xb6\xa5\xa0\xdaVo\x81\x1d\xa4&\vAZ\xe6\xf7\xb2\xbb\xc3\xdc\xce\xf6%r\x1d\x8c\xc4T\xf6-\xa9\f9\xedn%\x10Z\f\x01^\x94x\x9aV\xc4\x14\xfd*\x81!\u06b0\xb76\xf4\xa8\xa4Y{\xfc\x9e;g\xa2\xe6\xd7q\x13\x9b\x04\xf4\xaa\x7fq\x03\x822\xb3\xe0\xb123\xac\x81\x02\x00\xb9\xa6_Sw\xeb'7P7j \x95\xcf\xf4v\xdee\xef+\xf9\xa7\x13\xfa\x10Lu\u076da\xfe\xd6>\x9a\x93j\x15_\\\x82\xb5\x17\x97\xe0Q\xd4\xf6\xd60/\xc3~\n\x01\xd3\xe7\xccN\"\x14\xe6B\u01b5\x9a\x81\x8a\xc2\x18\xee:\bm\x9b\x9e%\xc2^\xab6\xf6\xc6\u049c`Q\xad\x86\xb9'\f(B\xba\x92d\x80\xa5Iz\xb0\xee=w*\xf1\xf1\xfdM\x12o\x8a%[\x1bK\x8e\x01\xc1{\x00\x9d\xe3^\xa1\xe3\x8aC\xbc\x8d\u0548\xf7P\xdf\xf22\a\xf8B\x04\x91{d\xb2\xb7\xbfK\x1e\xb1\x15S\xc9k\xd9\xec\x17X\x9d\x1b5h\xc4_\x1b\t\x1e\x04\xd2\u00ff \xa5\xba\a\xfd\xe4\xef\u0084\xc5E\x91\xd3\xc3}!\xee\xea\x1c\xa2\xc7\x06\x00\x1b\x9cd\x92\x9bd\x93\xd0{\x06^\xab\x95\r\xbd\x89RS\xebQ\xd7\x1a\xa7=J\u0246\xfc\x04\xf6\xad\xba\xeb\x18{\xc4\x176yc\xb5\xf0v\xcf\u03cex9\x81\x98u\xdfW\xab\xf8\xac\u063b\xef.\xd2z[\xd9m\xa4\x1c\xb4\xfd\xb1\x88\xa1\xa8Zj\xe2\xb9\x1b\aN\xba_s\u06557\xff\x00\xc4\xe8\xbb\x0e!\a\x04?nZ\xb6\x01\x7f\x03\xe4D,Y\x83\x9c\xf4a\u0239\u00c11\xc6R\xd5o\xa5\xe4\x884I\xbe/\xe9\x86\x06\xb5\x94\xd3\f\xe2\xd6\xea\xfc\u498f\x80\xb6\f@\f\x9f\x93\x82Jp\xb5\n\xea\xc0\x03\x92\xe1\aP\x06\x1e\xe4Fbj\xb8@\xf7\xe3K\u0482\xa5\xa4\xd7\xf6Z\xad}D\x96;\xde\xc1.\xfe8\xf0\x9a\xe4iS\xb9\x8da\x01\xf5Y\xb3\x1b?mv\xe3z\x9d\xb8\x01\x8b\xf8\xa3\x06\v\x1e/|\xe6\xfaGG;\xa4\x16x\x05\x8a\xdbr1\x91\xd7\xf4\xcaP\x1cw\xfd\xfcb\xca\u04e3\x01Q\xfa?\xe5\xe9\u041fs\xf30\xe8\x90n~w\xf5\x89K\xf5\x89\x03X+=q\xf9z\x7f\xf7\x89\x1b\u014b\xcb\aO\\n\x00_E^\x967o\u04d8[\xa5\xf6\x84\x00\x9c;@\t\xba\xc9\xd7\x1d\xb6\xe4/\xbc\b$\xf2E Q/\x02\xe0\n=\xf9\x1b_\x04\xc2\xc0O\xbf\xe6A \xba\xf7%\xc0<\x03\x19\xf6\x97\x9a\xe7\xb8'\x8b\u0210v#\xd5q\xf6rc\xf0\x8e\xcd:G\x83\x9c\\\x04\xefO\xd2{\x84X\x1ay6@l\x16\x87\x04\xbb\u01b9;|h\u02d6K\xc1\xdd!(^\xc2\xc1\x1e.\x97\x9d\x03\xf9w\xa7\xad\xbe;\xf2\xafd!\x86\xcb\xe5\x9e\xce8T\x7fUzg_\xe5\xab\xefC0\xa9\x18\xe6^\xb4\xdd=\xd5\x18\xd2\xfbCt\xe3\xc1\u0747\xa5\xc3\xe0\x1f\xa0ZEx\xf8\n\x84\x1e3\u07feq\xf1)\x1e`l(\xf14\x8al\x87\xf8\xf3\xab\a!\t?\x8d.;\xbbvK\xf7\xb6\x03\xaf\x19Vm\xacr?\x12\u047d\x0e-Q\xf6O\xf0\xa55o\xa1\x02]\xa8\x1fX\x93\x86\xeaK\u04ba\x06\x85\x01T\xe5\xc8f\xf4?%\xe9\xc1\a\x88x8)A\xb4\xe0\xab,\x91\xf9\xaa1\x96\xe64\xd7\x16\xb9>X\xa8\xa8\xe4\x158nF\xf4\n\xb0\xbb\xd5R\xe8U\x96\xad\xa7\xa4l\xbb\b\xfd\x05a\xd3\xf5\xf3\x8f\xd4t\xf8 NX\xd9\xf7\xaf\xeeqkK\xc3\xc7r\xa9Agn\xc9\xe9\x90|\x05\x84\x88\xefC\x11\n:ay\xb4\x8d\xa0\xd4\xc4P\xc2?\xa7\xe7\x10\xe2\x95\x150_\xf2l\x1bq\x7f\x86}\x94\xc7f`\x1d\xa7>(\xbbG\xb7\xfa-\x15\xc5B\x84>V\u02a6\a\xaf\u0605\x19\x0eyQ\xad\xaag-Brc#_\u076c\xa1\t\x9c\x9ecZ\xb9\xcb\xc9\x01e\u0242\x0fe\xe9\xbc[\xa3B\xcb2\x01\xe7\x0f\xb9\xa8$\xbfu\xd4{\n\x8f\xdb\xc8Lg~\xac\xcdy\xc6%\xc2p8\xcaq\xf9\x13\xa2\xbc}\xf0\xbc\xdd\u03da\xca,\xa0\x9bq\xb1\x14(\x06k\xf7\xf7\x99~\x12\xb9\xd3?\xd98\xf3v\x9f\xe5+\x99\xe2\x18\xfe\xfd\xfa\x8d\xd1\xf0o\x16r\xcb\xd92\v\x19\x8c\xddL\x99@\xda\x1e)\x7f\xba\u02e5\xa3\xfd\xe8:\x039\x02B\xc6\xec\xdeb\xca\xe1\xae\xdc3\xd8#%]\x94+\xe6\u03b3\xda97\xbf\xb96H\xd9Jb\xbb\xc6\xc0h\xde\x0f\a\x98 K\x12\u0198N\"wc\xfc\xadY\xe3\xe52O\x8b\x81\x1e\xc3\xd6\x11\xfa/\xf3'5\xe7\xa9cA\xba\x86\xa31\x0e\xaa\bE\u03bdP\xa4[|R\nMOjc\r?\xa5\x105^\x83\t\xb5\xe0=\xf7^\xc8P\xa5\xfe\xcaa\xcd\x19\n\xa8\xde\xf1\x81g\u0111d\u02edR&\x97\x92jG\x05;\xe2\r\x8a\x04\xc30N9j\x12\u061ad\xc6\xd5N\x86\x9e&\x96\xcf\xcf\x125\xb6\xe2\x1d*\xef]c@$\xb5\x10\x8a\x06D\\\x99\xf6Q\xad\x12S\xfaFn-SY\xbe\xe4\xbb\x1e\u051a\x03\x9cg\x11N\xf1\x832\xea\x9c(\xe8i\x13h\x17\xd40#J\xd0\x15<N5n\x9d|\xb54\xc0\x1e\xae\xae\x88\xe4\xfe\x80\xba\xf7\u0499\xfaD\x1e\xb5\x8c>\x8e\xb1\x92B\xe2\xd6~\x81\u03c9!\xedG\xe5\xa8\"[qJ\x95\xbf\x1f\xa7\xc0\xad\xb4\xb7\xff\x8a\x0e\x9f\xd4\xd5Vh\x01I\x98\x9c\xea\xf6\xbf\xb8\x92\x89\xd68\u077c\x88+\u056a\x14b*\xa4;\u683b5\x86\xc0\x1c\x92\xef#\xde\\]Y\x12_\x19.-[rC\u016fl\xa0k\x1a\xe2\xedT\x05\x9cB\x1a\xf7![\x81\x87\v\xd5Z\x8f+\xd6&9[\x05\u05b1\x99\t\xe2\x05k\xb0\x93\"\xb3\b\x1a\x87\x05\x83\x84\x8b\x8c.\x93s\xa9\xb7\xe8\x8e~\x83\xb1\xe7\xbcv\xea\xab\xd5\x1f\x95\xc3\xc3\xdcF\xf84\x7fZq\x89\x1dx\xcb>>{~zj\x99FH+\xa0 \x12<\x99'\\E%P\x0fn\xf8\u01ad\xf7#-\xaa\x18?\u010a\xef\xec=b7\xee[\xc0\x12\xe5\u04fa9\x90\xebl7\x0690\u0413\xa9l\xe4\x96:\u00fb_\xb4\x19\xad\x8a7\x84\x88\x8f3\x13\x15\x86\x18[Ii\x0f\xc1\xf3s\xd0\xe3!\xcaLbs\xb6\u02d5\xf0\x9a\xee\xed\xb6\xf7\x0fY\xa1l\x13\x8b0\x9e\xb9\x88\x94^\xba\"\xb46W\x86\xe2J\x9fG9\xce=o:\x12\x95\xa1:\x13\\~Q\xb6\x17\t\x1dr7\xa2\xe8\x12\xbdry+\xb8\x0et\xe1+\xb5\x06T\x16\x9a/\x04\u04bcr{`\x80MG\xdadD\xeb;\x16Y\xe7\x9eD\xf6\xf3\x01k\xd1\xc8\u0582\xc0Qu\xb3\xa4\xa3\u022cD\x97\x9c\xa1\xbe\xa11t6\x80\x85\xe6\xc7\u01dc\xddA\x14\x90\xe30\\3aU\xd7'\x10\xbcE#2Q\xad^\xc5\xc1\xa8\xd2T\x1e\x88\"&\xa8\xd4\xcc\u04f1+p\x9f%2ut\xc3VL9\xe3\xa2\xd7\u010f\x93\x06\x94\u025a\x01%X\x01\xc7\xe0A\x14\x86Y\xe6\xe4\x92)\x90\x82\xae\x9a\x96\xb5-\u049c-f%\xe85\xe87\a\xdd\\\x18\x8fL\vI\x8bk\r@\a\x91\x05\xec\xf6S\xf8,N8(\u04ec\xe8s\xbe\xd9O\x0f}\xcb\x1fta\xf2\x993\x04Qz\xca\xd9]\xd3s\xce\u03db\x0e\u0747\xbf\xbeC\x0f\xe0\xef\xa5C\x0f\xe1\xafph\v\vD\x0em\xb5\xe0\u01d5C[m\xf81vh\xab\x03?\x12\x87\xb6\xb12whg\xc7{r~\xee<\xa1\x87X\xe6\xfc\x1c\na\xa97\x0em\xedaS\x17\x0e=hw0\xff\x15\xfe\xc4\xfcw\u038a~\xe0\xac\xef\xdc:\xd4\xf9\u0561\xce-O\xe1\x97\xfc\xf7\xe4\u0321N\x1c9\xd4y\x8b\xff\xbcq\xa8\x03?\xf0o\f?\xf0\x9f\xb7Pj\f\xc1\xcd\xde\xca\x7f_\xbet\x06\xf4\r\xb7]\xd3J\x97\xb7\x9e\xfaI\xe0\xc3r\x96\xfb\xcdvf\x9f\xfb\x8e\xafI\xeb\x04\xbb\xcf=0\xe5G\f<\x96E\u0339qh\xc2\u0699B\x8c\xf2e\x01Y\v\xc8\u06b1^T\xf9\x11\xdbi\x1f\xee\x1c\xee\xed\xb7\x0fw\v\xf1^@\xbf\xba2\x8f\x83\xdc\xfb\x01vo\xab\\M\x90\xb2K$u\u04bcy\xa9\xfe\x87\xf2X\xe7#\xf4w\xa0([p\xd5Q\x8bj\xd9\vV\u04e1I]\x98`\x92\x96\x9d\xdf{\x9c\xbe\ud342\x17\xbdQ\xa8\xb0\x8f2\xb4\xa1\x7f\xe3\u00b3\x9bL[.\xdb:\x96\xa9\f\x7f\xabd\x16<\xffm\x05\x06\xfb\x12\xccOe\xfci\xc6\xed\xaf\xac\b\xbc\xe2\xbf\xe2W<dq\xc3D\xe6uy\x96N\xc0?\xaf\xf5m\x85\x1c\xcbE\x84\xd9\xfc\xf4a\x9cW\x9aH\xc6\xd2?\xe0\xddJ9~s\x93M\x81m\r\xfe\b\x18\xe0\x0f\x9a\xea+\x06\f\x86\tU\xda\xe9\x81a\xf5\xda\x04U\xc4J\xd5\xd4\x03\xe3<\x9cPw\x01\xf7H\u0789F\x16\a&\x00s\u0737\xcaHx\x91\x8biFS\xa5\u0212K\x85\x97\n\n\xe6\xcb,\xb5\xe2\xbfX\xbbL\xb9,ob\xa2\u027c8\x11\xff\xe4\xb7\xc0}\u87f6\x17\x91\x88\x7f\x827w\u01b3\xdf\xcb\xe5ASC\xc0{>N\x19W?\xb2zQ\fv\x03\xbex\x1d\x8f8\xe3\xb9\u03ec\x10X\xeb\xf3\b\xdd\x1e_3\x9e\xfb\xcc\n\x81\xe8=\x88&\xb0<\xec\x89\xf3\x04\xef^+\r\xdeY\x14\f\xc5\u0250\xff\xb4\x88\x05\x87\x01Y_Y[\xca-c\u00b2k\xc4\\C\xc6ic\xd2\xcb~z\x7f\xdda\n\xbfy\xa8\xfc\u024d]\u07a8\xad\xab\x91j\x81,~\x1a\xb3apu\"\x93@k\xf8E\x96\fU\xb3\x03\xfeZQFv@\xd5|\xacJ\x02\xafO4\x00_*)t\xb3\xc8\"\x93\xf8G\x8b.\xa9\xb72=\x14M\xb3D\x0e\xf5A\x9b'2\xa4\x8e\x0f\xcd,\x88g'\xd1@\xaa&\xe3\x9f\xcc]\x05T\x97\xd6\vnZc\t\xa1i\x8d\x19g\xddi6\xf4\x17\u070e\x8d\v\xb5j\xb9\x91k4\xf4\x9d\xed1\xe5\x95\xed]H;\x83:\xcc;qz\x96/\xa3\xdd7\xb5\xda{\xcbek\xaf\x95\x05\xf3h\x1f\xeeV\xabp\x8fI\xbf\xbbp\x8dma\x83\xbb\xfb\x9d\x9d\x1d]\x0e\x90~\xa7Z\x05\x8d\xad\xcf\x1cbJ\xecv\xf6L\x9b\xd2\vC\xd6\xf7K\xbbo\x1c\x88\xae\xa9i\xcfjU\x92\xa8Y\x9d?y\xee\xf5\x82\xbdT\x86\xba\xf0\u0683\xf3U\x8b\xe7F\xbd\xc4K\xaa\u055d\x1d\xd9\xceaK\xfd\xd5\xed\xb6\xcd\x0f\xb0\x83\x85\xae;\xbb\xda\xd9:\xca\xf3\x05D\x14 \xcb\xe5K\xeeb`\xbdW\xf2/2\xae0mU&\xce\xc6\xf61oo\x9a'\x87E\x16R\xec).\xe7^\xb5\x9a\x1c\xb1\u077dN\xeb\xb0Z\x15\xb5\x96\xe1\x05\x90\xda\xcb\u05c57\x83\xa7\f\x1d;\xa9x\x1d\x9d\x9dN\xaf\xd5l\xef|\xe7&ul\x8d\u0522:\x16\xa8\xe1\xa2\u06e1\xb7\xff\xb0\xd6y\xfb\xf7\xf3\xe8\xbb\u02b6v&\x9f\x15\xfay\u0355\x83\x9c\x88\xb4Vh\u04b9<\x87c\xd05A\x85\x93\x11\x93T:\x05\xc3\nz\u015eq7d\xb8\x04M\xe0\xccB\xb5\x91\xb0n!\xf2\x9dR\x19?\xaf\x94\xaf6(T\x1b\x14\xaa\r\n\xf5\x06\x85z\x83B\xb5?\xf0W\xd7l\xaboSP5\xb5\xa7\x9a\xda\xd3\x05\x0e\xd5_\x95\xdf\xd9W\xf9\xba\xeb=\xf9w\u077f\x9e\x04)5X\xber?*~V3A\xd2\x16}\xb9\f\xd0~e\xc1\x9a\u0745\xd9\xc6\xee\xf0)\x1e\x87\xbd\u07a2\xc6\xda\u07a2V\x93\xb1H\x9e\xc1S\x1a6\xb40OP\x95\xdd\xee\x15\xbb\xaaV\xff\xe4\xee\x90\xcei\n\xba\x93\xc3\xcc$\xf0Q\rK\xd7\x04V\xd3c\x10=\x8d\xe0\xa9\x04\xb4&\x17\xf5Y\xbd\xf54\xa9V\x9d\n\n\x8d\xfb\xb3Zk@gl\x91\xc9\xe5qh\xf7\x0f\t\x9b\x1aU\xab\xa5\xadia\xe2x\xb9\x9c\xf6\xa2\xa7\x87\xd5*B^o\xd7\vzmT\x8f\xdb\xf5\xda\u07b4\xb7\xe3u\xbc\xad\xab\xe52X.c\xc8\xcf\xf2,\xe4\xf0\x89\xdb\xc1\xccyc\xb4\x98\xe5b\xdc\x01/\x02'\xb0\xc0z\xb4\u05ee\xc2'\x8e\xf3\xc4s\x9e<qP\xdd+\x7f\xebj\xeb\xeb\x0f\xdc\n\xb4\xbb\\\xbe\xe1\xf2|\bB\xeekW\xc6\u0705\u019d\x9a\xa89O\xa4[g\x9f\x19dl\u0449\x11\xc1k\x851\x9bd\x00\xf2\u0354)\xc4\xcb\xc6\x02t\xa7I\xa8\xf5]\xf7\t]\xb0d\xb9\xb4\x88\xbe\xa7 ;\x88\x9e2+\xa9\xab\xbc\xef\xff\xcc]A\x17\xe6r\xa0\x01\xcdE\x19\xd6\xdbUF&j?\u0452A/{\x1e\x89\x8e\x92nd\x84\xfa\xf9\xb7\x91h`\xc4\xe0\xc2V[\x92?Z+\xecjEhnEi\x8e>\xa9V\xb7\xa4\x97\u0621\x9f\xf2JK\xb9\xfe\xaf\b\x8c\tPi\xabo\\\xfa\u0331\xf4\x13p)\xfd\xe4\x89Cp7\xb0dG\x97\\:\xb5\x1f\xa4$O\a\xa4\xfe\x86\xbb\xaf!\xc5'D\x96\xdd\xd1e\x9fn(\xbb\x81\xa2f\xdb\xeey\x04\x9e\xf5\x7f?\x8f\x06\xdf\x11\f\u032e\xb4V2*\x81`\x88\xf6-\xc6\xd2^\xeae\x11\xa9\x1a\xa1\x9f\n`\x0enXJ\x7f\xe4.7\x04sJPS$@!\v\x06\xfch\x0e\x96K\xa7\xa2\x7f\xcb\xf8\xffR2\x01\x84\x8c\xf2H\xdfUx\x9b\xa1\xbf\xaf\x90\x81\x8b/`\x8b\xb0^\b\x8e\xae\xfc\x1a[\xd4\xdc`\xb9\x8c\x96K\aS{\x8e\xe3\xe1\x18k?r7\xa4\x02z\x8d\x8c\xb1\xea\n]\x01e\u02f4\xab\x96\tN\x80\x8d35]%h\x04\u0113\xf4\x1e\xd2\xec\xc6\x19\xe6J4\xe6\x8a\x01sA\xc0\xa6D\xe2\xab\x18D\u909d\xf2~\x02\x04\xfe3\xee&@R\xd50\x88\x13\xd5\u056aU\x95Tk\r\xc0*\xbc\x06\"\xaew<\x1f\xdaQ\x108\x94]\x15\xba\xc1\xcbq\x97\xc1l\x1e\xa7ip\x19*\x7f\xfc^%\x90L\x97Rb\xa9 '\x00B\a\u05fa\x1a\x7f\xc8\xf9\u03938M\xf3:\xc4\u00d9\x9a-\xb2b\xb2\x981\xd5\\\x10e\xad\x17i\xc3v\xaaT\xd2sj\x8e\x97\xe0F\xd4\x01~\xcf#\xcbO\xe47\xd6\r^\xd6U\x8f\xdb.\xfb<\x8b^\xfa\x91\x9b\x00\u438eeg \xc8X=t-\x92\x18\xc0\xb9\xd2\xff\xbd2\u061e(j\xb8\xa9<H9N7\xb2\xe1\xccMu\xd4+R\xf7\x9f\xca\xc8U\xc1S\xbf\x17x)]H\xd9`-#\x80\x13 \x7f\x13 \x7f\x83\x8c\x15S\u0172;\x15\u06c1F\x165\x9bx&\xb5\\k@E{v\tB\x17Y\xac\"3\xf9\x7f\x96;\x1c\u04d4=<\xefe\x9e\u0162\x12\xc6\x16<\x02\u044c\xf1Q6N\xfaS;G\xca|7\x05\xc0\xbf\xfe\x86\xbd\xd6Z\x14\xfc:\xe0\xffQ\u07bd\\\x1a\x81^P\xad\x9aBHU\xd9\u5009\x85\x8b\x156)\x05k\x87\x1aS\xd4?J\xad\x17\xb39P\xc5x\x91\xc0G^\xe4\xdaKk\x10\xe1\xc7\xc3?\x15\a\xd8\tY\ft\u0740\x99Z\xa86\xa0i\xa7?\xb0`\xec{^\xa6\x17\xb4\xa0\xa1r\xd7\f\x90\xe0\xc6hn\x9e\xe3\xe2\xbc\x02\xf2'\x19\xc7\x14t}\xfd~\xee.X\xdc\xf7\a$\x17\xcf}\x91\xc5n\x87Yo-r\xb9e2Qa\xc7\n\xb6\x8b\xab\xfaV,x\xeb\x03/r8\x02Qo\xa1#\x7f/\xf2\x11\u07d5\x11}!\x15\u03b7\xce\x00\xb9\x85Q\u05e0VAl9d\xdc\b^\xfaXx\x00C\xb0\x03\xc8d.\xba_\xaa5G\xbf`\u03cd0#k\x91$\xf6H\\AC\xcb\"\xe1\xedz\x05\x1a\x16\xc4h\xf0\u4ef0\x9e\xb1\xf5\xbbpb\x9c!\xaa\xb7\xeb\x10\xfc\n\xe2\xc0\x9f\x803\x06\xab\xcd~8\xc0\x8eW\n`\x92L\x19\xdb\xdc\xe3\x06x~+p\x0f\xe4N.\x95z\xd2\xc5\x06\"\x8a \x86\x9a\xdd\u02e5\xfe\u0754\xf2\xec\x05\r\xcdJ(\x88\xa5C\x96t\x13\xc4*\x16ms\xd4\xccS?B\xd6G+7V\xe6w-\\.Mr\x16\x1c\x01\u00fb\x00\xab5\x96\x972j4d\x02\x05ssG\x04\x8et\xde\x7f\x8a\xd3s\xb2'\xf0\xf1r\x89,\xb1\xa2\x14\xaaU\xf1\xb4)\xfd\xbc\x81\x9a\xd0\x18\xde\xd1\xf3B\x89\xfe|@\xe4\f\x99\xf3]\xc2\xc7\x17Nmn6wZ\xad\x8e\xe1\xb9r\xbd\x0e>)\xad\xa5\x82q\a-\x9d5I\xa4\xfa\xbd-\xbdS\vk\x1c\x9el\xe5\x1f$\xf2hR\xeb\x03:\x0e\xea\x02\n\x7fB\xa7\xb9\u0594\x01\x85DEZHF\xa6\xf8\u04f5\xb4}\u02a4J\xc5\xe2VJ73\xaa4i9\xd8\u05a9\xf2\xb1\x1aC\xd5_\xcah\xab\xf2\xa9\xcc\xf4G,\xec>]\xc3\xeeC\x98\x99F\xb4\xf3\xe5\xd2\x1dZ\x88v\x01\x1e\x0f\u0674\x1f\x0f\n\xf8\x7fQ\x82\xff\x03`\xb7\xe8:\xdaG8pCv\x0f\xf4h\x84.\xff\xaa1>\x05\xc6^\xbe!>\x84\xee\x875\xc0F\x1e\xfe\xa98\x84\x0e5\xbe\xa7`\xeba\xcf\xc8\fo\x01c\v\x1f\u07fc'\x9b\xf7*\x0e\x9d\u05d8\xe9\x80\xe8\x1be\xac\xcf\xf7|\xb9t\xeeV\x0e\x12\xf7*\r\u8eb1\xe9\x899U\x05\xef5\u0746W\x84\xc15\xadT\u0625\xd0\x18\x12\x16\xe0O\xaa=\x00\x9994$\xe6Q\f\xe1iZ\x04\xafy\a\xd6z!\xa5k\x0e\xad\xe0\u00ef-Q\x959\xa8\xb9\x03\xb7\xbd\u03c6\xfdd\xf0\x88+\u07e7\x81YS\xd4\b\x04\xa4f&j\xef\xa3\xea\x1bw\xc7\xdc\xc657?\f\xf43\xee\x00\xd9\xe79ky@\x12V\x1c\xd3[\x90\xf5\xb6\xa8\xb1tm?\f\xbeu\xee\x9c\u06a2\xe6\xe4wd\xc3~8\x15\xa7\x96\xb5\xa3On\t\xda\xd4h%7\u045e[x\xe6\xa8V\xb7\x02\u0104=I\x82\xa1\xcb!\x05\x10\x9eL\xfa{ \x04\xf68\xb5H9\t\f\xd1\x1a0\xf8,\xea'\x85}\xf57\x93r\tr;\xd4-l\xb0E\xc2\xf9\x9a\x84S\x04\x1c\xe6\u00de \u0101\x82j\x00\x10\xb7q7\t\r\x8a\xa4Yj6\xae\xef\u0502\x9a3\xf8K\x1bwgo\x9c\x9c\x0e\xc6\x02\tI.\xe7c$}h\x8e\xf4\xb6Z\xba\u0579\xe7\xa8,'\x87\x84s*t\xa8='\xf5\xe2U\xf3\"\xae\xc0\x98*N-$+\v\xe3U\xab\x9f\xa4\b\rrqe\x87d\xb5\x11?J|\x1b)\x9fK\xaet\x82\x849\x10\x9b\xd2\xd6$l):\x8dd\xb2\x80-\x90\x05|\xdbn9\x80\xcd\vU\xc1\x81\xd2\xc2+{\x8a\x02\x93\x14\xc3K\xb5\x0eH\x0f\u07b1j\x86\xb9\x80 \xaeH[\x81\u00a1\u07af\x85\xbd\v\x19\x95\x94E\x86X\xf3C\xd8\x1fHky\x00\u065f\x94Z1\xa1R\xdc\xe2\xaf\tX\x84M\x98\u02107}\xbf\x1f\r\x06\xa4+\xd6^<\x8c'\xff\xc4\x0e2\xb1N\xdb\xdb\xee\x83J\xf4\x0f8\xd1^e\u0718\x89,6\x18!\xf2\x11$\vw\fN\x1c\"5\xac\fw\b\x99\xc2\t\xcd{\xa7\xe5\xc4r\xe6\xcc\xd7.e\x18(\xf0\\\u00b8\xa2\xcdJ\x17\x9e\"9!\xe5\xd5\x13\xbc\xb5\xf3\xbe\xe5\x7f\u027d\x9fT,9\xe2Z\xd4>M\x9cW\x102\x9c\x1a\xaf9\xa0i\x92\xf0Y|%\xb5L>\xa7u\u022b\xec4*\x1fS\xae\xcb\t(\x17\xa5\x82\xfb#\n\xdeX\x86S\xa9\xa0r]I\xfd1\a\xa7\x1b\x8a\x19h\x80|\x015@+\\\xb0;`\x9a\xbc)=\xc3g0\uf2be<>}uv\xfc\xf2\xe4\xe2\xec\xf9\x0f'\xaf\x8f\xbdk\n\x0e\xaa\xf5\xd7g\xfa\xfc\xed{\x93wJ_\x9c\xbc<\xfe\xf8\xea\x83N\xf8$\x958\x8ey\x03\xfeR\xadx\xa2\xbe\x8f\u00d0\x02\x90zw\xf8o\x99\xaa\x87\f6\xe9*\xe5!\u048d\xcc#\xe6\xafJ\xdfD\xbe\xbb\xf0L\xdf\xc5B\xa9\t\x8b\n(\xf5\xceq\xbcdE\x81\u0512\x17fD\x9b4Q\xe4Q/\x92\xb7!\xc6\xebp\x9c\xd5\n?)x\x83?\xb9\x01\xec\x12\u0111\x97Rq;\xb7b\v\xbf\xa0\x18H\xd7{Kg\xfe\u073bD\xfc\xeb\x9dP\x8c\xc7\xe4}\xa4)\x17\xde\xcf\xd4D\x95\xf4\xdeQ \x0e\xbd\x1b\x1aD\xc2\xfbB\xd1s\xb2\xf7\x9eB\x00-\xef%M\xf9\x1f\xde-ME\xe2MV\x14\xf6\xe9\x15,\xdf/\xdcu\xf4\x87C\x1dX:\xb8\xbdU\n,\xa8]\xe28\fU!\xf8%\u02fd\x80\xf5\u0545\xe0\u00e1\x0e\xccM\uacc0_\xe7L\xed\"r5\xdd\xcdD?\x19\xc0\xc1\x94\xd7\f*\x92\xaae\x06\xb1\xcb<ND\xaad\xccP\x92\xdd\x05#/\xc1M\xe6#\x0fl\x80e\x11\b\x05\xa4\xb7\a\x04jr'|\xdd\x02\xf5i\xf6;\"\xd4o\xc8\x16\xd05\x87\xceXE\x8d\x88q\xf6\x14\a&\xf0\x05\xafqq\xc1\xd3\xd7\xf1h\x11\xf2\x9eK\xd8S\xae\xb9[\x0f\xbf,\x05(W\xd0;\xdf\x13+B\u014aF\x8d\x11C\bcO3\xc7\xc2p\x8c\x04\x89\x1a\xb1+\xd0#\xfe\x16\xfc\xe4\xf8\xb3\xdc\xc53\xa7\t\xbd\u02fbt\x9ep\xe1\xc12\xac\bt2)<\x0e\xaca\xb4I\x18_\xfa\xe1\x87i`\f*\xb3\x14\xe9\xdeO\xa6\xc2;\xf2r\t\xe7@c\x02\u05f1\xb2\x1c\xe2j\x8f\x7f\xbc\xbc\xa3\xeb \x1a\xc5\u05fa\x13\xf9\x05\x02E\x1a5b\xbd\x12\x0fh,)>\x98\n\xa8\x94\xe0>8\v}cgJ^g\xb7\xb3\xcb8\xacV\xe5_\xa3\xa8\xf3\x01n\xceM\u02f8^\x96\xdeI\x87\u060e\xdc[gE\xe8\xa6\xcaN\x06\x02\x8e\xae\xb6\u0554\x1b\x10\xcdF0PP|\xf4\xc5\x14\x9f\xe4\xe1\x113\bG\t\x8f\xd0HF\x7f\xb0\xfe\x80P\xae1\u025d\x86U\x17\xc0\xe8\xceY\xa4\xa8\f\x16\f\x85\xd3\x05XJ\xe8\x9d\rg\x9f\x12\x15\xed\x93C\u0368\x91\xc0\x1d\x03\xe58\xbd{\xb6\x10\"\x8e\xb0\xd8iD\x9f\xc7!\xfe|\x8b?C\x7f\x9er\xfc~\x01\xdfh\xad\xc7\x13L8\x8e\xe8i4_\xc8\xf6\xdfD\xf4U\x10}\xc1\xdf\xef#\xfa>\xbe\u019f\x9f#z\xc6C>\x94\x85\xdeE\xf4\x03\xbf\x11\xc7\t\xf7\xf1\xfbC\xa4\x06%\xf4\xa0\x84\x1c\x94\xa0w?\xa6q$\x91\xbb$\x90\x05\x9f\xbd\fB9\x94\u04c4\x96dC\u04f2S;\xfbe\x9c\xcc\xe4p\xed\xd4\vty/\xe7\x99KW,\xb1\x1c^.G\u00ab\x9cG.C\xeaYa\xc6s\xbd\xca1\x8b\xdcvg\x7fo\x97\xa0\x884B)'\x8b\xdc\xfd\xfd\xfd=\x88\x14\x84i\x012\xccn\xbb\xd3\xdc\xdd!4\u0134\x05\xc8o\"\xb7\xddl\xb5\xf6\xe0\xed\x10\u0486\xe8\xa4\xc6=\xd8;l\xb6\t\x9db\xda\x18\xdd\u06f9\xbb\x87\x1dx\xeb\x9aa\u0688\xd0+\xa8\xbb\xbfwx@\xe8\x04\u04ee\xc0?V\xe4\x1e\xec\xef\xed\xb7\t\xbd\u0134[B\xaf\xa1\xdc^\xe7p\x87\xd0\x13L\xbb&\xf4\x86E\xee\xdea\v\xea^`\xda\r\xa1g\xd0\xc7N\xab\xd9!\xf4\v\xa6\x9daxyw\xb7u\xb8\xd3&\xf49\xa6\x1d\x13\xfa\x96Engo\xe7\x10\x02<C\xd2[\b\xe4\x1c\xb9;\xad\xddV\x8b@<\xe7F\xe4\x9ebTf\xb7\xd3\xee\xc0\x12\xbc\u00f47\x84\xbe\x87\xa1\x1c\x1e\xb6 N,$\xbd'\xf4\x05\x8cd\xbf\r\xa3{\xc5\"\xf7p\x7f\x7f\xff\x90\xd0g\xd0`\xa7s\xd8!\xf4%\x96|\x06\x91\b#w\xbf\xbd\xdf9$\xf4#./\x04e\xf9\x03&\xdc\xde9l\x13\xfa3\x16\xfc\x03\x02\xbcEng\xe7\x10z\xfe\x01\xd7h\xb7\xb9C\xe87\xd0z\xb3\rS\xf9\x91\x95E\xcc]\xe1n\xfe\xd3F\x92\xfag\x85\xbb\x99\xa3p\x13\x17E\xf3\xb0M\xad\x88\xba\u0172L\xa0\xa1\xed\x0f\xefn\xd5=q\t\xaaUQN\xe8D\xff\xbeK\x85/ 6\x1d\x9d\x87\x8bI\x10\xa5^\x7f\xa0\x7f\xbe\xc5\xfb\x1dn+\x9a\u07a6\x82\xcf<\xe5\xc3\x1f\x93\xc6\x11\xfc;\xd4~V1-\x89cq\x1a\x01\xf4\xcaZ\xd0\xf6;\xd5\xee\xddjE/Ao\xf4L\xb5\xb5\xa2\"\x8e\xc3\xcb\xf8\x06\xb3\"\xa5\xd75\xe1B\x16`_`\x8cR\x05\xe9\u00a4\x12\x1d\xe2\x00\u0489\u05a7\x8b\x13\xce~q\x7f\xa4n\x93>\xc3\xf8[?\x9e\x11\xa5B\x06C(6\xad\xbe/\x17A\xa8F\xe3n\xb5Tb\xc2'A*x\"\xab\xab\x950f8\x17.q9\xed\xdf}\u1dde\x03\xedA\u05ce\nX`m\x9du\x1f\xc9\xe1\xadVTV\xd2\xed\xafU\u049a\x9dl\xcb]\xdb\xe3V\xe9\x1e\xb7\x06d\xb9\xb4?i\u013ewya\xb2.\xa1\xf6T\u0526\x92\xee\xafj\x81\xb0\x10.\x7f\xb5\xba\xb6,\xfa\x0e\xf8M-\xbbUc\xbd\x1f\x02\xc2\xe0\xf5&\xcc\u052d\u0512%\x93W\u0196\xfbh\x00\xcfM\xbe9\xa0\x82\x99\x01\xc1\x8a\xbb\xa41\n\xd29\x10\x024Z\xcb\u009f\xbe\xe0]9\xde\f.\xd9s\x97\xb8\x00\x9b\xaa\xc2\xfb\f\xa4\xdd\f\x8e>%\xfe|\xceG\xc7\xd1\xe8\x19T=\xc6I\xa4\xae\xd8XB\xdeMq\x92\xbaQ90\xea\x01}\x98.\xa2/ ;3\xc9/#\xab\xe3\xe7\xf2\x00\xba\x84P\xae\x16;\u1e30\xef\xf9h1\u4275\xde\u0669y\x00B\xad\xe9\x9b\xca\xf9\xa9o\xda/*\xa8\u0446\u00c53]z\xf9YR\xbd\xf6\x1e\x1ck\x9e\xdf\x0fb\xc8)<\xd48M\x83V,4\x90\xcb(`\x02\xbd~\u07a6\x9d\xa6\xd9\xeaa\x9bQ\x86ZT21A?\xb1\xc5\u04d9\xf7\xd2%\xf4=\xf7\x87\xc2{\xb1\xa2\x16\xf07,D\x87lXn\xcdUs\x0f\xa1\x05\u0652\u00a8\xf6\xba?T\xdf`\xe1\xd2vtC\xe9\u618c\x16u\xae*\xb3\x91\x94\rR\xf7m>M\xba\x19\x92\u04ec\xa5\x06E7av?\xf6u@9s\x9b\xf4\x9b\xc6\xf1-q\x13\xea\x96\u0748\x8dD6\x93\xae\xd0\x1d\xc7;\x84\x83)@\x0f1{\x9fUD\xe1\x89\t\x94\x1f\r\xca.\xd9\xc2\x04\xc4_\xbdL\x81\xf7x\xd6@\x1f\x10\xebM\xb4z6J\xf6dsR\xb5\x89\x18\x1d\x19\u0163\xf7#4\xd2E\x96\xd2\xf0\x98?\xb9\t\xc1\xe9t-\xdbOt\xcb/\xbcX_Db\xb5r\xc1\x94\r88B!\n\x11\xacL\x94=\x1d5\u97cd\x8f\x90\xe2\xfdH\x88\r] \xce\xd8|\xf9\x80\xd6A\u0786\xa0\xf6\x19\x97\\\x1f\u0456\x1e\x16n\xdfOm\xe2n\xdc\xe2ls\xb2\x97+\x16\xf5\xe5t\r\xe3|)\xf1mR\x134&\xab\xfcX\r\xd6|\xe0,\xa9i\xb9Nj*\xe4\xdaQ\xf8y\u3b53o\xc4W\xa5s3\x05@\xe5e\x80j\xd6!\x97\xabT:0\xf3\xd5O\b\xb3\xf9\xf9\n\xcaq\xb6\xf9\t\x97\xdf+\x9b\xb7\v\x06n\xddnv%K\xd5V\x8f?\u028d\u0428\u82b2\xdd\xeb\x7f\x96\xf0\xa4Pb\x93\xd6\xf7\u0260q\x9d\xf8s\u057e\x86\u03a4W\xbe>\xba\xfd\x98%\xfd\xc8\xe8\xda\xc4=\xf7\x83K0(\x9e\x1b30k\xa2\xef\xf0[v\x14\x97\x8fp}\xdb![\u420f\x86?\x9f\x87\xb7J2C\xcd\x19$+<{f#\x12\xfb!\x1e\xf6[I\x15\xad\x99U\"\xceG)\x88\xc8Ug\u0653%\x18\xb4\b\x95\fq\x01\xa1\x11\x93\xe7\x06\x1c-\xa5\xae\xe5&V$\x1c\x11\x03G\x158\xd9+B\xf9r\xa9%\x17\x99\x80\x01\xcc\x0eW\xea\x9f{ b\xf3\x89\xb0d\x84\b\x15I\x1e*LE,X\x84\x8c$\x87I\xcdaE H\x88\x8e\t\u07e4\xf5C2@>\xb3\fbb\t\x1f\xa6\xa7\xae\t\xa6`\xfa\x11e\xfd@T\xbb\xc4@H !\x04C\xdb\x05\xac\x1f(\b\t\xd4(\x82\xb2\x16\xfc\x1c\u06e4\x03\xe9\xd2`\r;\u04c5%\x80\x0f\u043fD7<\n\xbaa\xadF\x16\x10B.\xc3\u0761\x19Q\x02\xd8\xf8\x1eXK]\xe2\xfa\xac\xaf\x88\x8dS`\xc1\aj\xb4>\xbc<\x17 \u043f\a\x02\xf5\xda\xfd\xb7\x81\xa0\x0f2\xbf\r\xf0'V\xea\x9f\x1c\n\x86\x1d\u078c\x81t\xbb\xd9\xed\xbc\xe9:x\u0525\xcda\x05\xdd\xc8\xdcjk#\x91\xe4\xf1\xbfk8\xebxGm\xb2\x1b\x91U\xd9\x18_F\x9b\xa9\xb6q\x94#\xd8\xc6\xd1*O\xf4i\xc2\xf6q\xd8\xde\xd0n\xba\x1a\u072bj\x90\x1f\x10u\xf7\xde\xe50x\x01\xb1n\u0123\x00\x04\x86\x06\u2f4d\x9dy\xe59\xf6\x9c\x1e\x83\xb1\n\x88\xc8P\ufe96K\xca\xe9\x88M\xa8i\xe3\x96\xe1\xb9\\\xdd{%\x96\x11\x8a\xb6-\xd2\x1a6\x89s\xcfy\xa0\x89\xd9\r\x8e\x92nP\xab\x91\x18,\xda2l\x12Hm\xe0\x05\x83p\xf2\n}Ho\xd0\u020a\xf4}\xd7\xe0\x8c\x88\x82\xf7\x049\x92\x12\xdd\x1c\x19\x17\xe3'wA\\\xe1\x12B\xe8b\xb5NG<D=p\xd03\xdd\xc4.w3hkd\u0113\vD\xb8%\x922{Wf\u073c\x01\xe3[{\"\x10\xd0\xd6(1X\x13\x14\xf6s&6\xde\xe8&\x9e\x0fgw\u0423\xf7\xa9\xf1\xe6\xe4\xd3\u0147\x1f\u07bf\xfd\xf4\xe6\xe2\xe4\xfd{*\x95\x87\xb7\x9at\xee\xdf\u20db\u06e4\x1f\x1b)O\x02?\f\xfe\x94\x18\x97\xc0\x18V\xe3 \xf2\xc3\xf06\x93\u00ed\xd6`\xa4t\nn\x93\xbej\xbc8!.\xa4Q^\u0700\xd7\xfe\\\xe2\xab\x18\xa4\xf3\xf7S\x9e\xddu\xa8\xb3Y\xea\x15\xe5\xf9\x03Z\xe8\xe7\x85\u06b6M]\xe5\xd0\xc7Z_\x19*\x98\xc8\xce\xc4=\xf2\x8d\x88\xc8l\x14Ip\x18\t<\x14\xac\\+\xc0\xfc\xf7\xfa![S\xbf\xff\x8c\b\x9a\xe8\xc8\v\xf0\u02cf6-\xfc\xb3\x9a\x1f*\xaaa\xfe\xa7\xd0\xce\xff\xde\x05@\x91\x8f\u037a\x84jA?\x943g8\xf5\x03\u0538\x8e\x94\x8c\v\xde\xfc\xd0\x02D\xe4%\x06.\xf1\xeeV\xf6\r\x91\xb0\xd79\u07a6\f\x15\xe8\xf9\xac\b1\xc8\xe6W\xe0T\xb4A\xae\xa5cYB\x83\xad\xb1\x87\xedR\x0e\xb3ms\x98m\x10\xd7\u0498%\xf0\xe8\xf4\n\x9f\xfe\xa8\xcfb\x1b,\xcb\x16\xb5Z-\xd5\xe4\u00f0\"\xd0\nj\xd4l5\xe9O\xae\x95H2\xc1\x1ej\xa1Y\x9b\u0433\x84~T\xed\u00dd\x19\x11\x86g\xb5z\xef=\xbc\x94\xbfi\xfc\xc6\xd7Z\x02\\\xe0[\n\x19\xe6|\xfceq7\x15\xec\xd1b\xd4<\xc7~g\xd1k\xff\x8c,p,f\x18\u02d9\n\xef\n$}3X\x03\xc5B\x83?\ny\xe5\x17\x1a\xb7.\xd1j\xd5\xfe\x02\xe28\xaeV%\xdf\xd4s\vY,\xcd\xf1O(\x13P\xf1r\x8b\x1d\x83\xed\x86\x17W\xabkM\xf4c\x1a\r\xee\xab\x05\"\x18\xa4\xa6\nyZ\u07b0\\n\xa8\xac]\x8a\xf0\x1c\xf1\u0575\x91C$5N\u0333s\x94\x89\n\x92A\xaedL4\xb9\x1f\u06dc(\r\xd4w\xc6y\u0635\xfc\xac\xfd\x05\xb4\xef\x9b\x00\xd8\xcc\xef/\xb0\aXZt>8d\xfd\xe1\x80B2\x1bJ\x89xn\xdc\xc5\xef~2(I\xb2\xc7\xf6@v\x7f1 \x0f\x15\xc0\r\x0eq\xacj\x97C\xfa`\xa3+{\x05\x82l\x05\xe6\xb0\x02\x81\\\x811\x1d\xb1\xa0?7+\x00\x91\xb1\xdc\x11\xeb\x8f\x06\x14\x92\xd9\xe8_[\x01\xb3\x1b\x0f\x16\x00\x8d\ub1cb\xe0:\x8cq\xc4j\x1d\xc6\xf4\x11\r\xafV+\xeb\x82C\xbb\xb6L\xed*\xbb\x1c\xff2\xb2\xa0\x11\x13\x8d0\x9e M\x01(?s\v\xb3\\F\u074d4\x12\xef\xe5\xc8\x01\xa0yl\xfb\xac{i\xcd\b\xed\xe0\xbb\xfeQ\xd4\xf5\x91\xd6\xf4mZ\xd3\x1fd^ra\x9d\x14\x1d\u0151\xd6\x14\xac\x0f\b}@\xb2X\u15ba\x84\xaa\a\xd1\x00\xe3(\x8dC\xde@J\xcaU\x8e\xe5V\xd6m\xf7K\xde\u03bbT\a\x94\xf5\x11\x06/v\xe1\x94\x0fh\xcc~h\xfc\u05b8\xb8x\x7f\xf2\xe2\xe3/\x17/N~\xfe\xf0\xf6\xed\xab\xb3\x8b\x93_>\x9c\xbc9;}\xfb\xe6\xe2\xf9\xdb\xd7\xef\u079e\x9d\\\\,\x97\xaf\x1a\x7f<\xefZ\xa4\xd6\xeb\x0f\xb8y4v_5f\xa3<i\x98@xb\u0571\x9ez\x82\xce}8\x97O\xe1\xf0\x94+8>E\x1f\xb4\xc1\xc0\x1f~w\xf6\x9b\x9d\x03B\x13,\xd3\xd9i7\t\x8d\xe1\xf7\xc1\xc1A\x87P\x1f\x7f\xee\xed\xee\xef\x12\x1a\xc0\xef\xc3\xd6n\x93\xd0\x14~\xee\xect\x0e\x0e\t]`rg\xbf\xb9Kh\x88\xbf\x0fv\u06fb\x84\x0e\xb1\xccA\x13^\xa4\xe7\U0003bd77\a\xb6\x06\\>\r\x1f4\t\x9db\x91\xc3\xc3\xfd\x03\xc0\xd60\xb0\x83\xdd=x_\xe7\xf2]\x1d\x06y\x05\xbfw\x0f\xda;\xf0\xba!\xdf\xd89\xa1\xb7\x98\xda\xea\xc0C\xf5\xa5L\xbe\xe5\x84^c?\xcd&<\xaa\x9f\xc8\xe4kN\xe8\r\u03a2\xb5\xb7\xd3\x01g_\xf2\xb1\x9d\x13z\u0199\v\xfa\x00\x87\x9d&\xa1\x91{p\xb8\xd3<$\x84~\xe19\xb1\xf9%\a\xe6Dk\x82\bv\x82\xdf\xc4\u058c\xe2\xe6\x02\xcb\u07971\x16\xab\xb0\x89\a\x9a\x10\nr\xcf\t\u01fb\x8a:\"\x9eLB~6\x8d\xaf#\u01fa\x0e\xf5\xad\x1f\x83\\b\x9e\xa2\u04fd\u043f\x8d\x17\x9a\t\xa1\x91\xd24O\x18o\xc4s\x9e`|\x8d\xd3\x11\xea*\x06)6\x88\xd7\x01<_I\x8b\x9a\xd1\xd9\xe2R$\x9c\xbb\xa4\xebk\xfd`\xd0\x00^.\xe3F\x02~\xc2\xd3\xf5\x92\x80W\xa6\xf1\xb5\xdbwL\x1f\xa9C#\x9a\f\u858f\x97an6q\xf4\u070f\x86<|\x1e\x06\xc3/\xf9\xf9\u010dTI.\\8\xe2\xa7\xe2\xedB\x9cD\xa0!5\xf2\xb6b\x89\xbd\x1a\x85\x8cUY\x0f\x1f\x12X\x86\xff\xce\x1eNn\xf8p!\xf8\xe6\u05b9,p\nZP\x93\x84\xa7\xa9T-*\xb6\xb4\xbe\xf4\x0fmq:\xe7C\x83\xb3q\x8bAC\t\xf7x\xc6\xc54\x96\xdb\v\xa5\xde\xf9b\xaaO\xb8\xdf\x13\x98V\xdc=\xbf!\xe2\x1f\xcf\\\x90e\x95\xe6\xf7\x1dh]\xed\xe7\xfa\xf0\xcba\xe21S\xb0a\xf4\xb1\x13\xd8\x00\x81\xf6\x1c6\x14Y\x9b\x861n\xb7\x9f\x89\x8b;\xdf5\xea\x8a\b\x18l\x1dd\x9a2\xf6\x9a#\x92\x05\a\xae\u02a7%\xfb\xdeZ\xd1\xd8\u0589\x88\xb4N\xc4\xec\x01\xbe7\xe7\x7fC4\xe29\xa2\ny\xc63\x10\xf0\xe1qPX\x13!.HW|p\xddvr3\xf7\xa34\x88#\xba\x80\x04\xce\xe7\xa0\x0e\x16D\x13\x1a\xc2w\x90\xceC\xff\xf6\xad\x85\x1a\x86Y\xf2{\xb9\x94/\x162\x93\u0399\xdfH\x17\xf3y\x9c\b\\\xd8Y ^\xe3~\xa5t\xccb\xc4\x01g\x8b\xd9\xccOn]P\x87J\x94\x1c\xc9B\v\x0e\xa8\xdc\xc5I0\x011\x82\u056b\x03\xea\t\xe5\xe5\xe3B)\xb7I\xcfxc\xf2\x89\xb8X\u07b5\n\x03\"BH\x12\n\x8et\xa3\xae\x13\x80\xc6\xeb\x88\xe51\x94\x90V`\xe86\x02\xd8A?L9\x1a\xd8\xd0+v\x01\x00>W\xd7\xfe<k\xf1)kB\xa4XM\xb4\x88\x06\xba\xeb\xfb\xa0\xc0B\x9d1\xb35\xf9\u0717q\xe2\x16G\xe8\x15Z\x00\xfd\xb0\xf2\x85H\xf9p\x91\x04\xe2\x16W\xa1\u060f\xcet\xf5\x8d~g\xad\x9b7\xa5A\xfa\"\xdbz\r\xbc3jm\x997\xa6\xeb\xd0\xe0\x85\xb4\x1c\x16\xbc!\xcd\r\u06fb\xa2z\b\u0784\x06\xe9\xf1BL\xe3$\xf8\x93\x8f`\x82\v1\u0346jg\xba\x13B\xd5=\xe4\xc5\xfaFrG\xd4\x19/\xc2\xd0\xc1(M\xf4\xf3b6\xff\x10\xff\x93\xdfzR\xf8'\x0fr\u00c1\x87\xf8\xa1/\xf4\x8a:\r\x87d*\tzyi\xc2\xd3y\x1c\xa5\xdc+\xae\x98\xce(\xdb\x14\xaa\x90HI%L/\xab\x93\u0259\f\xb3\xf8\"\x18\xbd\x8e\x17\x91\xb8\xff!\x15\xb1\xa2\xb9\x8d3A\xe2\xfa\x85\xcc-'\x8a\u00a8\xb3\x94\xdf\xc9f4\x1f\u07e0\xf6\xbd\x19\u0527 \f\xdf\xf3!\x0f\xae\xf8\x03\x020n\xd6HF{P#L\xee\x19\xa1\xd8b2WNJ\xd7Wc\xbd\xf7nl\xad\b\x8d\xac\t&\x8f\x9d`\u00a3\xd1f\xad\vk8x\xf3\xc4\xf3\x1cQ\x84{\x18\x17.\x1e\x05\xc82\xe4\x96\x05\xadh\x9ae\x93Q\v(\x9d\x1d!\x1aZ\xcb4d\xbca@W\x06F\xb6\x0f\x8c\x0eH!\xd7w\u02b8\x9e(F\x9a-\xc1\u02f3,\xb9\x88\x97\xaf\xb0\xdb\xf5\x03N'\xd65Jo\xd7H\x87\xcb\xc2M|\xadoC\x05*\xf4$w=\xd2\x1bC_fm\\\xac\u045cg0\u0545\x98\xea\xef/\xea;\xabs\f\xcb\xe8\xa7\x1d]\xe2\xb9\xfa\xceJ\xbc\x05\x13\xe9\x88~f\xd7y\xfc~\xba\x19\xf6\xf0f@5\x13\xb8\xfd>\xb0\x9cr\xe3]<\xf7N)\xd8GE\x14\xb6\xdcKh\xaa\u041e(G\xb62\x17q\xad\xe3\xd0\x11\x9f'|\xe8\v>\xf2N\u5152\xa58\x88\x8e\xcb\x1a\xb1\xca@;[-*\xa1\u030b3d\xe9\xe7\x91e@m\xbc\x9d\u0492\xbb\xd2;-\xedl\u04fd\x9a\xc3\xf1\v\x83mC\v\xaf\x16q\xf9\xdc\xe0\xbfi\u065d0\xdat'\xcc\u02af\x99\xab\x12\x92(\xd36m\xace\xd2\"\x91e\x15^\xa3\xcc5\x85\xf6B\xb9G?\t9\xb0\xf5\xeeg\x9a\u0740\u0787\xec\x1a\x18[s\xcb\xd6\xc2b\xb0doV\x02\xcdq\x132;\x97Ds\f\x8d.`%Q\xc3-\xe8L\xf5I\xf5\xe1\xf4&\xd4:\x88\xde%\xcd\x1dT\xef\x96Z\xc7\xc5;\xa6\xb9\xc3\xe2=\xa7\xb9\x03\xe8]\xd0\xc2!\xf5n\xa8u \xbd3\x9a;\x8e\u0797\x9c\n\xa1wm\xab\xfe\x9d\x80\xe2\xf2[x\xb5\x05\xb7\x93+\xf7E\xe3\xdd\"\xe1\xa60\xe9\x023\xf0\x85SG\xd94\xa8+\xe5\xce\x06\xba\xadf\xb6\xfc\xf8\x88\x97\x87\xb6\xad\xa6\x86'\x1b\u0236Z:\xb5\bepSt\x95+\xe2\xafd\xbf\x8d\xc4\xc6f\xbe\xf5\xc3\x19~[\xcffe\xf4\xfa\x84\x8bW\xb8\xb6\x8f\xbdnr\xe84Z\u01df\x8d\xe1\"I\x00^\t\u072b\xaet \xa1zN\xacho\xe4n\x03\x9c;\u04d6#mv\x9fT\xde\xc4\x15\xd9|E\x99\xd6`\b\b\xe7\t\x8d\xe8\x13\xa7\U00084b3e\xea\xce4su7\x1e2\x19F\xd6\x02\x0e\v0\x8ey\u00c6\t0$\x81\u019f\xff\x85M\xc3A\xd1\xc4\x16\x99X^\xa0\xd7D\x7f\xbe%\xfa\x8b\xf53s\x8c\xcf\xcc\xfe\xda3\xb3^k%\x81\u0470\x80\xc2?\x9e\x17\xfeq4\xa26\xdcoB\xa8\x0e$a3\xbb\x89\xa2}\xac\x13\x874\xc2\v\xd8\x12t\xb6\x9d\x82\xfa<0\xd2I)\x8c\u077f7\x9a@\x94 \x16\xb1\x02uM\x13\x95\xa2\xbaF~1\a\x84>\x13\r\x9e$Y\x8d\x80\x15\xa8\\\x9a2\xd1\x18G\x8d\xe3\xb3\x0ft\x91\xc9g\xd3\xde\xdd\xcaK\xd1\x1c\x06\xe6\x13\xd9\x13\"t\xc8b\u05c1\x8eSg#\xb88\xa3\xe0\u02a1w\xc3\xd0OSpG\xe39\xa3\xc0\x0f\xe3I}q\xe3\xac\xe8\u00e5\xc1c\xee(\x89\xe7X\x9e<\xa2\xc2,\x1e\xf9\xe1c[\x97\x85\xbfnD\xba\x83z\x10E<\xf9\xba*S\xee\x8f\xca\xebL;\xeaH;\xc7W~\x10\xa2M\xba\xafh\x04\u017b\x96\xcc\xfe\x12\xad\xc5\x1c*\xdf\xf8\u0367\xd55Bk\x1d\a\xe0\xc0\xa5\x95]W\x98S2\x92\xf4j\xe2\xd0;\x8c\xc7\xeb9\xed\xa6C\xa7<\x98L\x05\xfe.)\xbe\x80\xc3p7M\xf8\xd8s\xfe\xa1\xce\xc6M\x18D_~\xb0\x93\xe0m\xf8kv\xaf\xae\xc3\x1c\xac(\xbe\x9a\xb2\xb0\x81g\xe3\x8c\xff\u14b2'T\xcb2\xba\xd8\xcbP\"@A\x8f\xcf>x\v:\xca`\xd8\xe3\xf9k0\xa6\xf6)\xf1\xfc\u00a5\x19\xe5\xae\u0524pa\a(\xe8\xc3\xff\x95!Gp\xf2\xfe\uffba\xbe\x8eM\u02b1=\x91bs\xde\xc5\xf3\xc5\\\u0292#E\xd8\x00\x99\x9d\xbf\xe5\x88D\x03\x06V\xb1\x92c\xdfk\x0f\xef<4P\x97Jy\xa5\a\u0100zVG\xf4\x9cK\x11\x99c\xc2+\x10\x8f\x9a\x8f\x1c\xaf\x90\xbc\x88T\x869\x00I\x19\xdc\xcf\xfd\u021cA]\xd7!\x7f\xe7\x01\x11=\xe7\x1ff\x8c\xff\u0206\x95\x9d\x97\r%\x00\xb0\x80S.6\x1e\xcb\u02f8\x1c\xe0>\xff\xa7\x03\x9c}gEk\x1cc\xb2\u01bb\xc6E\xe2\xcag\x89\xe1\xdc\xf3WS\xc0\xa2\x86u\xd0?\xc4fG]BS}oa\xc23\x11Y\u029f\xbd\xe2\x12\xa7\xf4NCM\x89j\xfd\xda5\x1f\x90U\x9e\xbb\xdb\u068a\x1a\xa6\xaf\x91K\x1ai\xf0'\xa7\xe6ha~\xc9\u075a\xc7K+\xe2\xc9\xc7\u0152m>\xfd_\x8a\xbaRk\xed\xe4\xd5cx#\x15\xf1\x1c\x88J\x7f\xe2\xcb-P\xd3\u04e4\x97\xaa\xd7\x05\x19\x99\xfb\x97)\xad\xbct.\xdb\u014dh\xac\x04+\xf1^\x1e\x19^\\\x0062\x87\xba$/C\x04\x8e\x9f\x04~=\xf4/y\xe8\xac5T\x91\x9dmhJ\xe7\xae#;\u035c\xc2\xc7\xdfz\xdf\xf3\a\xd1\x19\u07cc\xceJa\xf9\u00ff\xf2\xbaZ\x82\xbb\x1ezd\x8d#\xd8\xe3\xe7S?\x9a\xf0\x02\xc8iyh\xe4\xcfx\xd7z\u3cccS\xd6_\xc6R| \x816\xd7\x00x\x9e\xf0+\x90\x0fK\xfe\xc8%4.a\x1a\f\xa2\xf8\x14\x88\xe9;\x9e\xa4A*\xa49\xaa\xab\x9e\xa3\xd6\xfb\f\xe3I\u0673gi\xa7j\x1d\xe3\x02+\xa1\xbaGF\xc2\u009a\xd4G\xed\xbfL\x85\xb8\x9c\xde\x02}\xf8\x86\x90\xbe\xb3\\b\xaf\x15h\x10\xfaF\u03ff\xbc6\xef\x8b\x01\x06\x1c\x97\xaa\xe2\x18Y\x00g\xb4\xbe\x04%\xef\xcak\xcc\xd8\xe3\xd7z\x03\x83f\x9e\xfd6\xbd\xdf=\x8aW\xd3:\xe8r\x9d\x93\xfcm\x84\xee\xbd\n\x17YT\xb8\xfd\xe0\x02\u02f1lxi\x01d\x81{\x05t\b\x15\xbbN\f\x95\xdaHi\xd1\x10\x12\xa4\xfb\b\x87\xe0\x93\x9e}\xed\xd01\x9b?\xbc\x97[[C\x18\x98T=\xa6\xd3\xcdUt\x05=\x02tw\x055\x1d\xe0D\x1c\xac>z|u\xb6^\xfd+)\u01e1v\x87\xe1\xac\xe8\xd6\xd6\x14o\xd9uz\xc9\x19\xc7\xc9\u0301\x1b]>e*1\xa39\xb5\x92\u06d8\xaa!O7X\x04\x94\x90\b\x00\x19\x11\x95q@<N\x01ixQ\x91\xb9\xb0\xb1\x8d'\x1a\xf6'\xcd\xf6\xca\x1b\xe6\xb9\x10\xc9Wd\a\x8c>rE.Ed\xd1\xd3rE\x18cc\xfc\xb1F\xe6\x84y\xae[D\x15\u024aiJ\xbap\x9dXHgE\x9dW\xf8\xe5\x10\xaf\xa4U\u0259\xca%\xceq\xa6\xeb}d$\xbb\xb3\xba\x9f\x06\x7fh\xb4\x15\x98\xfc(\x8ex9\xcf\xeb<\x87\xbf\x0e\x10\u0563juT\xbe\"rY\x91\x1fxx\xc5\xd3a<\xe7\xf5\x11\x1f\x97]\x99s\u054cs\x06\xa5dT\xbfE\xcaG`\xbc4I\xfcH`4\xf6\xb9t\x80\x06\xd7\xda(\x18\x8fy\xc2#Q\t\xf9\x15\x0fStL7\x1cb0\u02f82\xf2\x85_\x81\v\x9fO\xfdp\xac#\xed\xf1h\x04\x8d&\x8d\u0289?\x9cV\x8e\u07ddb\xb4\xa9\x11\x1f\x86\xd0_\x1cqp-:\x8b\x13^\xc1\xc1\xa6\r\x87\xdc3T\xa8\x0fR\xf3 \xe1)\xb6?\x8eA\x82\vA\xacT\xf5\x8a\x04Q\xe5',\x8exZ\xb9\x8d\x17\x95k\x98\x8f\x99\x98\x88+g\xd7\xfed\u0093\xca\xc7Sx\xadU\xec\xfc\xbf\x82\x19\xbe\x92\xfdW\x9b\x85\"\x80\xf5\xcdY\u043b\xdc\xd1\xcb\x1fa\xb1\"\xf9\xc3'\xe9\xf0r\xe2\xe5\xcd\x7f\x1a\xbf\xb5.7\x94\xb3\xc3{\x1e\xe6W.)\xcc\xe1\xa5@\x91\b\xe65\xb4 I\xc4k\u021f\a\xff\xe4\xb7P\xcbQ\xf7\u0425\x9f\x06C\x950d\x91\xbd\x83:\xb2\xc3P\xc6EP\x95\x1d\x8f\xb3\x92\xbd\x81Y&zS\"\xb9)I\x1e?\xa66\xee\f\xd6q\xae\u00b7\xfeJ\xc5[\xc5\x00\x00r\x80e\x9d\x86\xff=\x9dj\xffO\x9c\xdd\x03\x9f\u024a:\x1fU\xacU\xcd\xd7V2\u00a1\x02\vXq\xe8\xd0\x00\xc3=m9FY\"\xa1N\xfd\xb3t\xdcFy9\xe8\xbe\xfb\x0f\x16\x15H\xfdZ\xfd\xb2\xe2:\x88\x12\x1dB#\x9d0\xe3i\xeaO\xe0\x9aHtR\x1a/\x92!\xff\x1a\xa94v\x92\x96\n\x9e\x14JL\xa8\x8a\xafu\x9f\xe4(\xda\xc0\u063c\xffw\xa9\x8dj\xd0+\xe5f\xe2\x8cY\xc6BJ-\"\x99p!\u016b4\xd1\xce]\x14\x19\xac=\xa4E\xab\x1ca\x9f\x10*\xd0R[\xab\u0529\x96Q\x03\r1K\nzc\x12\xdb,\xa4\xb2\xe9\xcf\xd0P\xf6\xaed\xe8l<^\x81>n\xa9\x02\x88\xc5&\xf2[\xb7\xf4X!\x13\x8eFK\x97\xf2\xdc|\x04^?\u057b\xba\xa0\x0e\xb6\xe7\f\x1e\xabeB\xc5:\x91\xaff\\J\xdf\x17\x1e`\"\xbdN\xb1\xeb\x9c\u0298\xaf\x12\x99\xbe\x8f\xaf5\x16}\x1e\x87\x8e\xf5\u0302\xfa\xf3\x0e\x90\xf2\xb1\xeb\xbc\xf6\x93/#T\x0e\x06\xe2\x7f\nI?\xe2\xeb>\xbc-\xcb\u0111y\xd4SkOgx\xf1r\xe0\r\xc2\x10\x9bK\xcb\xc5\u958f\x11<P\xd0\xfd\xe9\xc8!\xa0\x9e\xf5 q^N3Mw6\xe5\f\xe3\x11Wy\x81\xd1\u0783\xd5\x01z\xc1\xf9\xdf\xff7W^\x11\xc4Y\xab:\xa5w\xa8\xda\xd1wJ\u0100\x0e\r\x06+I\xe2\xad\x0ffoM\xd4;*\xa1\x87\x16\xe5#\x1e\xd3;\x89a\xbcDk\x86\xa4\xc3$\x98K\x8d\x95\x15ytC\x19\xbd\x85X\xa8\xe2\u073b8\xf9\x95\xf9+\xbd\x9cF\x8f\xec#\x88\xbe\xae\a)\xb2R\xbd \xb4y\xa0|\u067b\xaf+\xa7\xf2\x1d\xfe\xafR\xca8\x94\xf6\x93j~B\xf0\x1b\xe1d\xf7\xac\x16q\x19^*~\x19\x0f\x17R\xfbZ\u045c\x82\xcd\u059f\x90\xc4\u37d0\xa4\xbd,\xa7\x92\x8e$\x1bEX\xaf\xff\xd31=\"*\x89\xed}\x8d\x85\x02\xa6\u0431\xcc\xe8\x06`.\x93P[\xb5\x1d3\x80\x19\xa5\u0096E\x95\xe0\x7f\x85\x04S}\x15\xe4\xf1\x7f\x03\x98\x14H/\xbf\bR}\x11\x04\xfa\"\xe8\xdd\xe9\x1a\xdeb\x85\x8e\xf0\xfe\x9e\x8b\xc1\xcf?v\xd9CB\x9b.u1D\xd9\xc5\x00~\xac\xfe\x9b.\a\xb5\ve2\x9f\xbfr9\x14o\x82\xe9\xfa\x8d\xb1v9\x98\x8d1\xb7D\xf0/\xdc\x12\xfe\xbfzK8\u03c0B\u03ff\x81;e\x98\xf8\xbe\v\xc0\xffo\xba\x00\xa6\x7f\xdb\x05\x90C\x9c\x1f5\xa0?\x02w:tD\x9d\xbf\x8e:\x15o?\xf2\x1c\xfd\u02d1\x8c\x8e\xa3\xc1\xe0\xf1\xe8\xf5/\xcd\xf5\x9d\x9f\xa6\xd7q2\xfa\xef\xba'`\x94p\xc6B.\xb8\xe7D\xfc\xba>W=\xea\x89f\xdfra\xb2\xef\xf2\x89\xff\xbb\xaf\x92n.tn\xf6\"\xc1o|\x98\x96y\xa2\xffYQ\xee\x85\xc7\xd18\xaf\xc8\ubce4\x88\x02\x02H\x9a\x06\x93i\b\x0f>\x102%\xe3\x98D\xef\x11<\x93\x1c\x88\xa3<\"\x14N\xc1z\x03)\xc7\xe9\x946rq\xa1s\x1f\xa3PS\xac\x94)\xd68'2\xab\xf2\xc2\x1e\xcbf\x92\xa8\x98\u16d3-\xcaO\xb6\x94\x00\xc1c\xbc\x00\xe7\x03\xae\xba\x1d\xfe\xaf\x9e\xae\xbc\xfa\xd6'\x1a\xd0;K\xb53V\xd7\x15\u069c\xbe\xfb\x93\xb8j\x92j\x0ed\xa5\xa6'\xffE\xfb\xd0Wh\x92y\xd8\x01\x0f\xbe\u03e4y\xe4+N\xe8\xcb\xff\xa5^\x9d/\xe2H\u07ba\xa5\xc4\u053ff\xf8\x1c\xa4g\xb7\x91\x98r\x11\f\x15\x02\x8d\x99\xa9\r,h\xb7\xc4)E\xf6\xac-\xc7U\xad\x16S\\N\xef\u059a\x06M\x81U\xc9\xe4^\u0133\xd2\xf9\x95\u01f6*v\x95\xa1\x1e%+\x90>\x04\xf9Hy\xca\x05\x87\x88\xe0\x05G\x88$\xb8\\\bn\u0a5b4\xcc\u02ba\xa2l\xbc\xa0\u063bZ\x1b1@\xac\u0511U\xe0]f[\x98X\xb4\x9c: R\x8dd\x98\xab\n\x96\x18J\xcc)\x8d\xc21\xf6\x06b\xee\xc68HR\x81\xda\"2\xdf\u03e4\x13\x18\xda\xf7\x19Ga\xc8=\xea\x04\x7f\xc5\xee\aG\xac\x97\x85F\xd6\xf0\xbb\xe5\xfb!\xb4\xadxdF\fD\xe4\x17~\xfbv\f\x81C\xa5\x97V\xb3\xd0q\xe9B\x83\x1d\xea\xdfb \xb4\xbe\xbc\xc5)Dy\x8b \x9d\x85!\x12\x00e\n\xf2\xb7O\xe8\xeb\x14\x8f\x1e\x00\x17\x8c\x17\x9c\"F}\x1d\x8f\x82q\xc0Gx\x9b\x06\xa9\xfe\xc4<\xa3\xa8)-\x88\xa6\xf1\xf5+ p\u04af\x10:\xaaq\xd4\xe5\x81rV\xd4\xefm\x90.\xdeS-\xbb\x16$\x81\x95\xdd\n\xa9Wq$*/\x11Z*|pO\xc3u.\v\x17\t\"\x1b\xa1\xa8\x15\x8f\xab\u0564\xe7\\\\\xbc~\xfb\xe2\xf4\xe5\xe9\u024b\x8b\x8b\x9f\x8f_}<\xb9\xb8p<\x8c\u043b\xa2\xf1\xfa\xcc\u2e7a\x1fe\x1b\xa5\xd5W\xd4\xe9\xebU\xaf`\xb9\x81\x9e\x12\x92b_I}e}J\x95P\u0673P\x0e\xa4\\c\x03\x04\xf6=\xf8\xf2c\xd1y\xf7\x1bD\xbc\\7\x88\xd0\xcb\tN\x92\xc1H\tP\t\xd5P\xed\x95xP\x93l\xe3\xdaE\x98\xe4\xfdZ\u0200\xc4Q\x17c\x0f\xf7c\xfb\"\x8c\xf5E\xe8r\xa6\xdcS\x10x\xab-\xfa\xb7p^\x9c<\xfb\xf8\xbdW\xd1p\xa2\x9f\xf2|\x19Ts\x12\\\xf1\bCa\xa9\xc1V`\x91A\x15\xdb\u027cb$\x84\xac\xe8\xda\xe1\x91[\x93\x1d\x06<\xa7H\x14\xfc\xc9\xcb\x1c\x0e?k\xbc\nR\xb8$\xe1\x0f8I\xb2\x88\x13NV\xf4\xe3\u05cbS\x14r\xc9\tS\x92\xbc0\xa5xCj\u07c9/\xe3D]<p \u04b9?\xbc\xef\xeai\f\ve\xf5\xe2'R\xa4\xd1\u70dc\x85\x1a\xbc#\u02b0$n\xc9-\x9d>n\f\x06\x13?0\x88J\u04b0\x9b4\u066er\xab\xbb\xb9\xf7\xf2n3\xbf]\x9b\xa7\x87\x91\x89^p>wEf\u0472\xa6:\xc5\xf1\x95dm\x04A\xaa\xa6\r\\0\n=\xce\xfc\x19?N\x15l)\xd9\u0383\x9bajc\x05k1\u0588\n%\xf7\x80\x17\xe6\xf5\xd1h\xb9\xc8\xcb8)\xa1A\xec\xd5\x10\u02e5\x1a\x02\xc9nA\xd5\xed\x9f\xdcu#{\x91@\xc3IK\x96x&Y\"\xa5\x03(\x19n)$\xb8\xdc\x1a\x82Z\x86\x7f\xf2\xdb\xfc\xdc\v\xb3\x010\xc8j\x95\u044cy\xdc\xf0o\"\x8c5Q\a\x12EC\"\x81tr1\x1f\xf9B\xae\x01\n\x14Kw\x1b\x04c(\xc6\xfa\xc1OOF\x81\xe0\xa3g\xf1\xe8\x96\x0e\x99\\\x82M\xc7\u02c5\xe0.C\x8cb\x0f\xed\u025a\xb2\xc11+_=\u93ef\xf4\xc6\x02x\xd2^\xcf\x02\xf7O\xee\u0381zl\xdcw\xbe\u077b\x02iq\xa6\bl\x89=\u02e9B?\xbb7\xa6t\xb4~k\u032c[c\xf4\xb4\xdd\x1b\xd5\xdb^\x13\xa2\u04b4\xbbWG\xa3\xeeU\xadFf\xfd\xabz\u06fe?\xae\x06]\x7f\xdds\xef\x94\xf5K\x99\x8dh50\x9aR3BV\x0fM\x12\x16\xf8E|\x1d\xa5\"\xe1\xfeL\xbe\x12\x8c\u99a9G\xd5j\xb8\\nm-\xaa\xd5\xc5\x16cc\xb0\x15_.K\x16\"\xa8Vq\x9d\xc7\xc4\b\xa4cv\u03c1\xb70\x13\n\x9e5ZZ\u00e4\xd4:\xb7%\xc0\xe1\u074byh\xd9l\u3373\xd5m\xadA\xefryo7\x10`\xed\x11\xec\t\x10\xf8\x1f\xa3\xd9\x06\x0e\xc5\"\xd6S.\xdes\xe1\a\x912\xc1\x84!H\xe0\x0f\xfd\t\xeaH\xfe\x8d<Da\xc5l>B\x99\x9c(\f\x00\xd4\xf7\xfa\xb9\xf6\xad\xd07\x9b\x8f6<I\x94\x1d\xedT\xa5\x17\xf6\x88.\xb2V\xd7N\xbd\x85]\x81\x88\b\xd9|\x93\x03\xe1\xa2h\\q\xc4\xe0\xc5a\xb9\xcc(\x9c\\&\xe6\x02\b\x87R#-qC\xe4\x97\xecnI\xcf\xfe\xf2\xc2ufv\x03\xaf\xe4\x15\xfc4\x94nA\xb5*d\xe8W\xf8\x03\x1e\x1a\x1f\x0f\x19M\x15\x81\xa5\x9c\xe8(9\\\xa5Gj\x13hl:6\xf1r\tC]\u063ee\xbf\x8e\x17|$(Z\x1b_b\x86R\x06\x9d\xc1\xa3\xa03e\xc1\x06(\fJ\xa16dAc\xc3b\u0237\xa0\u00b5M\xba\xf7\u0221UQ/*\xa1\u34ccS\xd1L_\xbei\xba\x89\x1d\xf6\f\xceNi\x81\x8d\xce\xdcf\x8bj5\x94`\x96\u0158)C\xd6\u02e5\xff\x80%\xfa\xc7u\xc6km3ddI5\xdb\x1c5F\x8bp\tl\xa8\x8eHz\xf1\xe6\xf8\xf5\xc9\u067b\xe3\xe7p\xaf\xd3{\x0f\x80\rg\xab\xff\x10&/\xbf_8T9\x01\x9ex\x95(\xae\xfc\x0f=\xcc\xff\x91\xb9\xc7\a\x16p\x9e\xc4W\xc1\b=X\xe4\x98=\x8b\x06\xfb\x0f\x9f\x985\xd2\xc7\xcdM\xf1\xa8\x7fp\x88v\xb7\xb7\xd3:$\xf4g)\xec\xfe\x83\x13\xfa\t\x93\x0f;\xcd\x16\xa1?\xc8\xe4O\x9c\xd0o\xd0-\xe2\xc1.x+\xfcQ&\x7f\xc3\t\xfd':\x14\xdc\xd9\xdd\xdb!\xf4{\x99\xfcO\u0170\xfe\xf6\xefR\x0e\xf8\xfb\xed\"r\xcdg\x8a\xda\x0f8}+\x9a\xf8\xf1$\u045fI\xf1}\x8a\xaf\x99@\x14]\u0124,q\t:5\xcb*\xba\x10\xe0w\x18r?q\xef\xe4\xfb\xb3\x87\x8f\xd8\xf2MO\xaa\xae\xab\x17\x1d\xf9\xb5\"\xb4\x8c$\x81<\xa3'f\x8f\xd1\x1a\xb2tA\x9c\x1f\xaf\x1e\x7f\x90Y\xcd\xfb`5\xef\xa3\xe7 \x85]\xcexr\xc5\x13 -\xb4\x16@\x88?\xe39O\xe9Pk\xe3\xceaS\u00c0G\xe2tD\xc7l!\x89\x83q\x88o\xfeS\b\xb6\xadTg\xc7Ju\u05bcXz\n\xc9\xe3\x10,{C\xfd\xc8\n\xfc\xb8R\xb65Z\u6387)\xb2\xc3\xe7\t\x1f\xf1H\x04~\x98\xe6\xd2/\x86v\u0186^\x8e\xb3F\xb3\x8eP]\x1d\xdf\x14e{\xb9\x87\xfcM\xe9\x17\xf8\xec\xebMe\xe4mG\xbb\x10\xb9\x80\xcddC\xf9@i)\xf1\x063\xe88\x10\x9bj\x88\xf8\v\x87W;G\x86\x05\xcdX\x86y\xb5\xaak\xa8y\x06#\xe6\xd4L\x9cvs\xc5\x00\xf3\x86\x87s\x04\f)\xaa\xa4\xbf\xe7\xa3 \xe1C\xf11\tsq\x8eG\xe4.\x1b\x85,r\xb1H\x82\xf2fG\xaa\xd9\x19\xeb\x1b\xe7\xc2!\xe9\xcdX\x88\xc2B[&\x16BH\xfe\x19\v-%t:3\xbe\xbe%\x00_\xb1@B\xd3\x19\x9f\xfb\x89/\xe2d\xb9t*NW\x0f\b\xf3\xcaG2k|\x8e\x03\x88MJ\b\xbe\xf7Md\u0531\xa4C\\\xc0\xdb/|!y]\xd3\x14\xf2L\xa5MM\b\xa1f9\x82F\xc2\xfdp\x96-4~\x96WTe\t\xa1n\t\x9c\x80\xd1\xcarY\x06(:'\x835H!\xd5j\x00\xf4\u063b/C\xb4\xa3;.\xb6\xf8}\xe2GJ~s+g\xfb\xf13q!\"+~\xfcrI\xdc[b\xd6\x0ez\xba\x18N\xfd\x10\x96\x1cf~Ihy\u0785t\xf5\xc4\xce\u06bb{\xe8\x98\x10\xb2\x7f\xe6\t\x90>\t\xbb\xc5\xe5\xbd\x06\xad\x9a\xd1\bq\xab\x1f\xfe\xb4\xe0\u026d\x8cb\xfc\xceO\xfcYj\xdeCO\xc0\v\xf5\xb5\x1c\xe4M\u05ec\xeau\xffd`\x96\x14\x04\xe07\xac\x7fB!U\xdfg7t}\x81\x89\xdcc\x879j\x93/\xe8\x99F/\xb9e\xfd\x98\x80N\xd1\x17\xd6O{\xdf\x03\xae\xc7\xf5x3#\x10j6\x05\x9d\x01\x13t\xd9%\x9e\x9d9U=T\x1d2\x90?1\xc4>zT<S#;\xa3N\xcf!=\xa7\xe7xP\xae{\xc1\xb2\x03\f\xfb\u058b\xe0\x82\u04bb\xc5OU\x9e\x87\x9b\xf9L\x1b\x14\xf0H(\\\x83{k\xb6\x1e7\xb5gc%\x93\x05\x05K\xea{\x1b\vC\x9cb\xb9\x1fT\x96ASm\xf7\x8b\xb4\x15\xf1\x84\f\xdb\xeaMh\x92\xe1\x02oD\xb5\x90\u073b\xa0<I\x9e_zI#\xe2\xd7\xc7R9kEV<Ly\xc5N37\xd6\xd0\\QW~\x18\x8c\x94\xb2\x13j\xba+\xd5t\x87*5w\xcfYCC\x15y')gI2d\xbf\xa8\xc0\xe5\xc0G\x8d\xca[,\x9eW\xa4\xaa\f\xfd\b\xca\\\xf2\u029c'`\x14\xc7G\rgEVrD\x9e\xd6\xfe\xce]_^\xd0H\xf1\xc7\xc9x\u0307\"\xb8R4z`\u0783e9@P\xb6\xa3\fA\xb3+\x14X\r\xa5\x8b\x90R\xeb\xfa\xf4\x16\xa5\x0ew\xd1`j\xb3\x9a#\x8dhb^\xa5\xd1\x1b\xc0p\xca\xc1\xc2\x18T[\x1a#_\xf8\xa9\xd6l\a\x14\xe6W\xab\x19T\n\xa3\xf2(\xafaC\x0e\x06\xeaurAC\xf4\xa7\xbe(/\xb8\xa0\x10x\xcbV\x8a\xbf\x93\xf9^\xa8\xb7\x1aB!\xf8\xd5*v\x17\x95\xb7\x12\u0440<\xad\xb7d\x8f\u00f2\xd6@\xc20,\xaf<,\x178lI\x1d\xed\xe2\u00fa\\Q\xe4q\xef5xV\xab\t\x12Z\xbd\x82H\x9d$L-%\xf5\xb5\xc8,\xa2In\x05J\ft%h\xe0>\xa6e\x1dvu\x87\xa63?\f{%\xeb\xf03\xb4\xf8\x83\xde9%\x92@ZJ\xe110O\xe3#\xd5\x13Y.\xcb\n\xa5*Wo6\xac\x92W\xd2Y\x7f\xb0\xdadh\xfdw\xd8X[$\xa5\xaf\x88\xbfnR b\xfd{)\xd8\xcdF\xd2}\x7fp\x9f]\xc6\"S\xd0\rs&\xc2\xc6b8#\xb7\xc7,\xacVC\\\xb9\x00\b\xd0a\x8e\xec\x06mX:b\xe3ju,\x17\xd7(\x0eJ\xff\x893+KS\xb5\x90554.\x16\xbbZ+v\u0187\t\x17vQ\x99\x82\xc5'VqM\xfeb\xbcN\xe8T\x1au\xd1[\xabLj\xe0a\xaa\x0e\xcfr\xd9\xd7|\xe6\x1a]x[\xad\xba\xb7\ucd91\xce\xc3@\xb8\xd32\x9a\xca6\x11\xf7\xe7s|8\x9f6\xd4/\x9a7fYP\x05O\xb7T\xcf\u065bQ{N\xde\x155\xda\xcd#:7:\x91\x0e\xb5'\xe7M\xfe\xaa1:\u0347:\x94\xc0\x00\xd2q\x05\x03\xbet<\x9c\u0272\x00q\xe6\xa1`\xc1\x92\xbcVr\xc8\x12\tK\xf0\xae\x92\xf7\xd02f~\xa6\xb0<e\xbeVX\x1e1_+,\u03d8o\x99\xa9_\xc1\x97\xad\xbe<a\xfe\xba\xfa\xf2-\U000cbe8b\x97\xb2\xa7@\xc8pU#\xdd\xe95\x1b6\x82\xf4\xed\xf1Y\x87\x9e\xb0k\x97\xf4b\xe31::\x1d=\x8f\xa3H\u0799Z\x89\xe0\xc6\"B\xe8\x05\xb3\x94@\u03f0\xfaI\xaf\x94C)cgl:\x94~\u0475\xcb\xf8\xa82\xa6+\u01da\xd1c\xb6\xb5\xe5\x06k\xe7\x8d<L\xd7\xd2\xe7,\xce1\x8eo\xd9s\xc6\xd8Y\xb5z\xdc{^s*\u05c1\x98V\xde\xfd\xf3\xf9\x89\xe3=\xa7\x9fY\xbc\x11{\xe6\x8e\x0f=e[[A\u0791\r\x14\b\xc1\xed\xaa\xd4\x16_\xfc\v\xda\xe2! \xdb7l\vZ\xfa\xa0\xea~(\xabk\xd3G\x99i\xb06\xf7\x83\xc7Ut\xaf\xf3\x8e\xc5%\u069b\xff\x92>:\xe8!\xbboa\xcd\u06f4\xe2\u0437\xd4!%\xa64\x93\xfb\xf5\xd1C\xd4G\xb7|\x9b*\xbc\xb1\xae\x1b3\xdd5\xfa\xe9\x19\\\x80\xf1\xceze\xa9\x06\x8e\x85\u07edk\xba\xdf\x1a\x85\u05b8T\xa1\x95\x9e>^;\xfe\xa4Z\u0768H\ubf05\x13\xf6\xa2\xa2\x8eX\xe5\xe3\xfbW\x0f\xd9\x1a\x9d\x00\xc3\a\xc0y\xb3\\\"\x8c\x92\xfb\xda\u03c1\xfbc\x9a\x8f718\xba\xdb\v\u056d\xfc\xf3\xe5\xde\xde?\x80(\x01{u\xe8C\x8a\xf9\xaac\x94>\xe8\x0e\xcbZ\xb6U\xaf\U00030ba8\xf32\x8c\xaf\x1f\x9a\xd8[B\xe8\xf3-\xc6.z\xf0\xe9\xad\x1b'l\xb0Y\xb8W+\xffn*f\xe1\xcb8Q\xfc\u0145\xb9\xcdW\xd4\xdc\uc82b\x7f\xfa\xa0]\x82\x05\xa1\xbab\xb9\xa5\u0088\xde\tx0\x10^\xabIG<\xfd\"\xe2\xb9\xd7j\x96hD\a\x88\xdd\xe9]0Z\x1b\x1e\xb5\xad\x1a\x1c \"\xeb\x98~\x9f!\x83E\x04?l\xcd\xf0\x95\xebf.\x90\x15u\u658d\xc3\xe9_\xb6q\xf8W\x96i\xb3\x8dCn\xa96\x9b>XK\xf5\xaf\xad\x8e\xee\x02%s\x0e\xfa\xe0\bx$*\xd6\xc5\b\x9e\xad$\x9e\xfbZ8\xb3\x89\xa5\x7f\x15\u058cb$\xacb~\xd4\xe5\x8b&\xc3\xc5\u07f7p\xf4A\xd5GI\xb9\xae\x8aXN\xe9\xf9\x93\x87\x1bP\x1e\xae\xeb\x97\xf1\xe8\x16\xdaQoT\x15\xfc&\xc4\xe0\xbc/\u02e5\x8dq\xe5\x9f\v\x101n\x9d.\x97\xa7\u056a\xb5\xaa\x9af-A\x8c\x8f\xdev#^\x85A\x99\x8f\x7f\u04c1\xb8\x94\x9b\x98\x8d\x81\x96\x9a@\xe1\x12\xd0@\x12\x93\xf2y\xabd\x15\xf2\x9b\xafS\x1f>1\xf6\xb2[\xeb\xbdu\xfc\xaf\xafj*9\xa5\x15\xcd'\xfc_\xb2\xbaj,\x0f,\xa3\xe4z\xeeGFv\u0247\x97w\xeb\xb4Z\xfd\\\xad~\xbe\u03c9\u03da\xa7\x9eRG\x0f\u04f6^\x1dI\x06\x97\xdd\xf4\xbe\x93\xb9\x83\x94\x93\xb3\xe4\x1bj\x1a~\x18:p\x99h\xe1G\x05\x12\xc8W\xb6\x95\u054e\xe2\x88k\xc79\x9f\x15m\xfc\xb9L\x171\xa11\xf5i@\x17\x9b\xe8\xdc\xe9F/8\xeb\u02c4\x82\xb4\u02f8\xcc%\xf2\x98\xde\u0249J\xb5\x15O\xd0`\x84qZ\x12\x19h\x93e\xaeG\x04u\xeaF\xda\x12\xd3\xe7\u0529\ub1b3\xf4\x84F\nF\x00\x00\b\xf8\x80\x971\x00N\xa9\x12\xe7y?r\f \x1f\x95I\xc1|*\x88\x02(3\xe8\fj\xa2FN\x8a\xb8\"\x0f\x9f.\x98D\xa0D\x7f\x1b\xa6\xb2\xd80\x95 ?\x95\x8d^f\xed\x95\x0e\x04\x9f=\u03b55\xa2\xad\u0543\xa4\xa4\xa2\xda\xc4\xc3D\xa7\xcd\n\xac(\x97\xbe\x8b\xd7\xdd\x1d)\xa3\xc7\x0f\x7f\xdd\xe8\xf1\xaa\xcc\xe8\xf1\xaf92{S\xad\xba\xeb\xc8m\xf6\x90C0\xeb\u017a\u0111\xd9}>\xcc\xfer\xd3&=\xef\u030c\u042f\xee\xe1\xb1\xde\xccJ\x1d\x12\xfc\xfa\xbf\xba\x7f\xd82\x83\xb0\xd2`sY\u031f\xae\x90\xd2\xd6\xf7\xea\xb9\x18#\xcaS\x93\x88\xa4\x13\xa6\xfd\x05G\xb2_\xe1*\x16\xf7\x1a6\x17\xfb\xad\xc4\xf3Kp\x90\x8a\xbe\f\x938D\u7c1b\u0738:\u03e1\x8aS\xbe\xe5?\xe5\xb7\\K\xf6%5\x99\xfe%1\x88\x11G\xe8%S\xb4i\xa9[\xfay\xc2\xf3\xf3\x9c\x05\xc3$F+cDLdE\x7f)\x1f\xe2H=b\xfd\xabc\x94\u012fn\xed/\x8c\x91:\x95\x19<\x14\xac(\x17\xffv\xdfX\xe94^\x84#\xb3\xa1\x1fQ\x9b\xa9L\x9fVU.\t\xbf\x85\x0e9\xf5\xc7ri\x95\x80\xa3\x80\xb9\xf0#\x97#\xcf\x06\xe6\u025f\xb9\xdc\xf2\xd0,X\xba<\xeb\u046e2\x8a^\xea\xf4\xb0\xf1\xad\xa4`\xd3.\xf2:CbC\xdf%\x11.@\xf9\x06\xd1\x00\xa8\xde\xc8\xf9\xa1\u01a4T\xfe\a\xad\xa7\xd7\v\xe1\v>RM\xd11\x1b\xea\x00]gQ0\x9fs\x91\xea [#6\xef\x05\x8dY\xae<\x04\x88[\u0410xA#\u0267\x80*\xbe\x12T\n_,R\x94{\x8fd\xca\x02_\xdc'\xba\x809T*\xba%\xbd\xd59Q,^\xc4C\x84\x1a\x94\xc5]\xea\f\xae$\xe7\xd7:\x01\xc9\x04BO\xf4\xb7u\nn\x18\x84(\x9f\x10z\xc1&}G\xc5X\xa8#o;X.'}\xe7\xb9JB\x9ev@\xcfX\x92\xe9\xd4<CV\x92~A\xa7\xc07F\u0760\xe4\x11\x11\xb4Y&}> =\xf8W\xea\x03\x10\x0f~o<\xd7\xebt\x91\\\x8a0\x80k\x0f\xc0\bn\xba\x8aC9uP\f\n\x1f\x88\x9e\x8f\x19(F|\xd1w\xd1\xf3u?\ao!\xe9}~'e\xceg\xc8\x19\xe2\x1e<\x02\u3300Kn*\xa5\x13\x1d\xfcs\xbcF\x8b\xbc\xa5w:\xde\xd4h\xb5NO|\xb6\xb2\xed\xb0K\xa8\xc6\x7fU\xad\xde3\x80\x87\t&-\x06\x80)\xad\xee\xf3\xae\xa2Q\xe4\xc7\xf7\xaf\xbe\x1e;^\x95J4\xb3\u01a5\x1e@E\x03NY\a\xc8b\x16\xc7.\x8b\xa7u\u032c\x84\xc1\x15\xaf\x17\x12\xcb&%\x00R6\xad\x90H6u\xb29\x16\x8b\x18\xe5\xeb\f\xe3\xf0B\x966S\xaaC\x9a:\xcdp\x19\xa3j\xda_o)O\x88;/\xb8\xf0\x830-\x17\x1c\v\x14\xe9|\xe5t\x1f3\xcd\xf2\xb9\xcd\xe8m\xef1p\xa7\xea.\xa2Q\x86\xa5J:\r\x8c\x04\xe2\xa3U\xb2\xe2(V\x83|\xe50\xf3\vw\xb96\xd2\xe7\xe6\xf5C\x92\x97\x19?\xe7\x80\xcf\xea\xc8\xf6\xa8\xd6\xcb2s\xe9\x88p\x88\xe78\u066bVT\xf0<IT\x80\x06z\xbd6\x823z\xa7\xf0\xacwM\xd5/|\u04fd\xa0\x8b$\xf4\xae\xa8B\xf9\xde$\x87\f\xf2.E\x13\xdd\xfe\xf1Z\xfb?qz\xa7\x9b\xf8\xa2\x8b\xf9\xd5\xea\xc9Z\xc9_8\xbd\xd3w\x81w\xa2\x8an\x8eW#\x04DQ\xdfkw\b\x8d@\xe9z\xc2A\xc0\x8e\x02fg\x1e\xa7\x02\x83\x1d\x86\\p\f\u062b\x1e\xb8\x1cy\x16\x9d\xb9/\x86Sg@\x13\x81\x8ct\xa4\xed\xb4#A\xfb\x8eH\xfc!X=\xd2X\xfc\xaf\u011aH2\xc7\x04\xb4\xfb\xe0O6\x98\x8c&\xe6\x1d>*\xd0(~\xd17X\xc0\xa2\x82\x0e\xf4\x82Ek\x019C\x93\xa6y\x9e\xa1nH\x12Jsx-7#{n|\xa5\xe3%8\xcee\u00b0\t\x06'\xcdGZ\xbe'\xc6\xd7X\xd1y\xa6l\u0769\t*\xfc\xc9\xdb\xcb\xcf\x1e\x87\x1f\x9e(\xc4/\f\xd6\xe2\x15.\n\x11\rC\xfb8\f\xf3\xc7\xc1\xc7\xd0K\xa0t\x177\x16I\xe8\x92\xc7H\xb4\xb2\xd1\t\x7fR\x88/U\xea\xf1=\x8b\xc2\u0375\xbe\t\xfa3\xf6\xf5\xb7$\"\x1d\x80*\xad\xb4\x9b\xc5\x1cO\xa8?@\xa7mJ\x1d\xc1%\xbdDx\x91P\x8b\x98)\xa2-\x8cL\xc9'\xe5\x0fzs\xb9\xbe*\x14r\u03af\xaf\x15\xff\xd8'Yh\u0240\xc6s\x8f\ubc27r\x9c\x9e/w\xa2\xe0N\x9e\xfc-\xc1Rr`\xdc\x10\xe0\xf1{d@*\xcdl.\x9b\xf8h_.\xaa\xcdB\xa0AT\xc3\f\xf0Ld\xc3 \xaa@?[%B\x1a\x8bH\x82\xfd\xe4\xe6\x94\xe2\x18\xd7O\xa6\xb5\x00T\x8e\xe7\xa8\xf5/\x8cH?\xc0\x97cR_\xa0\v\xa5\u00fdCB\x03\x81\x06!\xbe\xed\xde+\x15\x16/\xc7\x1b3\xc0\x9d\xee\xf6\xefn\xcf\xeb\xfb\xf5?\a5\x8f\xf4\u03b7\u03f7\xb7\x03\xb22u\x16\"\x1fE\xa3\x87\x8d\xf4\u0708qbZP\xb5zR8\x9bA\xceu\x10\x8d\xe2\xeb\x86~n\x83=\x14\xf10\x0e-\x89,\xf1\"/\xe2\xd7n \\B\xb0\xab\x86\fa\xd5U\x87\"\x1bJ(l\xe4\xb6f\x13\xde.\xb5\to\xdb6\xe1m\xb4\tG\x1f\x899MR\xe3)\tbC\xf7\x1c\xc7K@\x8dS\xfa-\xc2\xf9j;k\xae\xd4\xdd\x16\u008di\xe6{ \x15.\x9c)k\x1a\xbe\x9a\x86\x9dV\\\r( \x8b\xad\xb2I\x0e\xff\x9d\x93\x14\u026d\xdeX\xb9\xb8\xf4._\a\xfd<\rq\x97\r\xe4\xacP\xb3{.\xfe\xe3\x9d\xd0\u02cb\x01\x99{\xe1O\xa4)\xd34\bG\t\x8f\xa4\xff\xf9\xdc}\a\x8c|\xf1\xbeKM\x9a\xbe\xef\x16y\xc1@X\x14\x1c\f\x958\xe0c\x12\xd29[\xc8x(\x8dQ<<\xb9\x99\xfbQ\n\x12\x83)$d\x91\x8c\xe9\x88M\xabUg\xec\x87)\aR\x11\f\xeaCTb\v\xfd9\xf24W,,r\x9a\x13H\xd2\xe1\x90\x1d\xe0\xdeC\xd7Q\xbf\x15\u00ceA\x9c\x85?\xd14>\xcdI\xe1\a2\xa0\x1b\xbd\xdeT\x98\xdf\b\x9eD~\xf8\"\x1e\xae\xd55<\xff\x83\u0540=\x1c\x90.\x97\x06\x0f\x9fB\xe2\xfa\xf0\x04l>\n JzC\xe1\x9e\xd0\xe1\x1a\x18\xfak\xaa\xdf+\xe2\x9d \xac\xdd0+V5po\x13\x87&\x03z\x81\xf6\xab2\xe6\xffM\x16\xf3\x1f8\xea0H\xa5!\xc0\xe3\u0776_\xf4\x1c-4\x85K]\xf9A\xaa\x04i=\x9e\xf3\xc8\xf1\xcar7E\u07fc'\u031b4\x8aso\xe8\xd6\x05\xf8\xbc1\xdd_\xe6\xba\xcfwW\x89\xe2:\xec\x8e\x03\xafc\xf7HLd\x17\xb8\xf4?\xee\xa1\xcf\x1b\xa2\xcd5\xea\xc0{\xe0K\x1b\xb6\x9e\xa8\x0f3\xbb\x8bU\x99\xfe\x19W\x11\xb4G&\xd6\xf5\x85$\x04\xb0\x8f\xf8G$VAF\x04\\E\t\xcb\xe4\xa43x\xb0,g0\xaf\f?u\t\xbe\x02\ufafb\xe9}g\x03\x9f\x94\xeb\xf6\x9a\xf2\x1ep]\x92\x9bY\xa7\x15nU\x042c\x82\xc2\t]\xdb>\xfbN]\v\x1f\xb7\xa2R\xed\xdcs..C?\xfa\x82\xef_\x96\xef\xc3\xfb\x82\xad\xca(m\x1c0\aX\x92z\x174\xe7C\v\x92\xebV\x98v\x11\x88\x10AU\u338c\x92p<\a\x11\xd0\xc8J\xa2\x8f\x84\u00cd\xa1\u072c\xc1\xf8I\x12_;\xb4<\xb8\x9b\x8a67\rF#<+(\u0462c\u0405B3n\x85\xfb\xee\x8f\x01w\x01\x11\xde`%\xeb\xd8W}1w\xbc|\x8a\u010eYL\xb8G\xd6(\x8f\x18;\x03\xf7\x06\xa0i\xc8G\x00\xfe\xf1\x06\u03e9\xc0\x97\xcd\u015a]\xb8bI\x80X\x1f'\xf1\xec\xc73\xb4\x00\a\xaa\xd8q\x94\x05\xf0\xb8\xe4\xfeL\n\xf7gb\u075fI\xc9\xfd\x99<\xea\xfeL\xfeZ\xd0LM\xe3#[b$\xe51~H\xb95\xf0'v,{\xb4\xa3\xcd\x05\xafO\x19/D\xab_0n\x85\xa8\x0f\x19o\x8c#:,::\x98\xe7Mv\u01c5\x87\xb6\xe9Z\xc4\xceQ\xc1\x9ev\xb6f\xe4{\xa5\x8c|u\x89\u025a\xd1\xef\xad\xcdg\x98cB/\u066d\x96\x91_\xb3\xcb\u0188\xcf\x13>\xf4\x05\x1f\xd1\x13v\xa9\xaf\x18z\xc3.\xa5\xd0\xff\x82]j\xa1\xff\x19\xbbl\xc4s\xfa\x85]\"\xfdq\xcc.\xb3fOG\xf49\xbbl\xe4\xa2\xd5\u04f7\xecr\xd33\xc3ghD\x95\u04cf\x03\xa7\xec\xb2\xc1\xe5J\x9eF\xef\x92x\x92\xf04\xa5\x1f\xd8Y\u00fa\xad\xe9\x1bv\u05b0oe\xfa\x8e\x9dI\xad|\x9e\xd2\xf7\xec\r\\\xb9o\x80\xbb\xa5S\xc9\xe3\xae]\xbe\x93\xb2\xcb\xd7q\xe8kvk\x88\x80x\x0ed\xc1+\xf6Zr\xacF\xfa\xe9\x10\xfaL^\xfe\x939q_S`[\x13\x7f\xc6\x05OR\xa8\xf1\x92M\xb3E9\xc3Q\xb97\xf4\x82\xd0?s\u05faC\xbf\xd0\xe3\x01\xfd(\x9b\x8a~!\xeekB\xff`\xc3|O?C\x82\xd5>\xa1\x9f E\xad\x91C\xe8\x0f\xf09\x94\x0f\x9a\xf4G\xf8\xb0\u022c\x7f\xb2a\x91\xcc\xfa\x1e\x92\xd4Z9\x84\xfe\x06\x9f\x86\xbb\x93\x8b\x01\xe9\xbf\xe6\xd2O\xf0U\xe4\xa7|Y\xed\xee\x8f\xfe\u0086\x86R\xe3\x18\x9f\nq\xed\u024d\xe0H\x1e\xa2c\xcbW\xd5j\x02\x9eX\x81_\u0526\xb7\x82\xb3\xadW\xb8\xba\xca02\xc9?\xf5\x10\xd0\xf5\x92\x054:\x02I\nF\xae]{\u05a1\x82K\x03\u0348\xb3\xfe\r\xbd\x18<\x9a\x12\xba6\xa4\x88yF\u038e\x84\u376cg\x1bn\xf0\x82:\x86b\"\xde=\xe5\b\x902\x86R\xf93\xa3RJ\xe4/?\xd1;\x03&\x88~\xbd[C\x8f\x9cP\v=y~^\xb63\xcc\xd9\x0e\x8e\n!\xb8g\x99\x8cC\x94\xe8\xcc\xfch]\x0f'\x8f\x13\n\xc9I*\xb5\xc9\xd7\xd5\xeak\xdc\xdd\xe5\x12\x88\x00\xc6\xd8\xebr\x91\x8c\x13\xcc\xe0\xaa\u0577i\xa7=\xbf1\u05ec\xfcH\x93\xa1\x17\xb9\xed\xdd\xd6>\xa1%\x1d\x86\xb1?\n\xa2I\u074f\x82\x99\xbc\xf7W\x84^\x97\xa9\xe2\uf50f\x18\xa9\x8a\x8b(Nf>\xba\xfc\xac|\xf2\x93(\x88&^\xe5E\xb6\xef\x84~\xd8\xf4\xa0T\u05a6\x85\x9f\xee\v\xc5\xfd\x98\xfa%\xf5\xfeih\xc7\x0f\xa8\xb9\xf7\xbe\xf7\xf8\x965\xa6\xac\x8f\xe2az\xdf\xd8\x1e\xbb\\/\x83h$\xa3\xe1\x8d\xf4c\v\xfd\x8b\xc3q\x1e\xa5\xe6UZ\xf5\xe2\xe2\xb1K\xf6\u01be<\u0294\xa7~\xa1w\x05\x8a\x96>\xdc;\x10e\x0e\u0353\xd2\xef\u024a\xbe7~\xce\u0349X\u06ec\x9f\xe9]\x86\u04fdg\xd6\xc1T\xc6\xf19\x8co\x90\x81\xf7\xda(\u787bPNs\xb4\t\x88.m\xca\xc4Ki\xe1~\xf5>\xd3\xdc\xf5\xec=\xa7\xe3\xc8\v\x8bX\xc4\"L\xbcq!T\xff\x14\x19\xa2\xd7R,\x8ax\xd6\x166\u03e9E\x90xW\x05\xc9\xf5D\xbf\xb1|^[\x91\xdf\xe8]a\x10\xc8w\xddh\x01\xec\x05\x8d\v\x97\x94\xa7\xee\xe5\xd4\xdcYPCgN\x91tI]\xe31\U00086692\x03\f\x11~v?E@\u04f5\"W\x8d\xb54Y\n~\xfd\xec'\x01\xac\xb1T\u07bdj\x94g\xd0I1\x19;.-gL\xc5u\x85p\x91\x95\xce\u06d1\x9bu\xadV\x9fW\xab\xef\xaa\xd5w\x8fU\xe9\xd5\xc0\xad)\x82\xf5s\xf4=\xbdS\x99\u07bb\xb5M\xc9C\x8a6y\xc7\xe2\xdeK\xed\x11\xffa\xe4\xf0\x19\x89\x83\xe7=P\xea\xaaO\x92\x18\xf9\x1aE\xe3X\x18\v\xa6\xb76\xa7O\xd6m\xe9\xbd~\x00v\v\xf0X\x80\xd65\x90\xd3T\xbd\xb7\xb04k\xad\xc5N\xca\x06\xf4\x03\xbd\xcb\x0f\xa2\xd0\xecJs\u0267\x8f\xd8\x1f}\xd7\xd91z\x1f]\xcb1;\xf0j\xad\xab?\u87613\xbdWT\xabU\xc4\x06ehe5/)\xa2\x87\xdcy/[\xe1\x8d\u7ff0;\xf3$\x1e-\x86\x1cO\xab\xfa\xa9\xdc\xe2\x83\xfa\x0f\"\x17b\nI\xf8\x9fj\xb7@\xefT\xb2Ur\r\x8b\u0684tqo\u02d9\x12\xef-\"D\xbd\u015cW\xab\x1f\u02cf\u04af\xe0\x03N\x13\xb8\xde\xc7\xc2\x1ae/\xc4\xc8c'\x1b\xfc\xaf\x8d\xd7\xf9\xec\f\x96q\x04z\n\xfaK\xee\x12~\x98\xe9\xa2S6xA#.\xa1\xca\vw\u0195O\xe1\x05e\xff\xf0\xa0\xd3!t$_P\xa6\x82\xd0\xd9\x7f\xbe\xb0[3\xa2(\xed\xb6\x98\xf2\xd2\u022b6\xab\xac\x03\xaf\xe6\x15\xd8\xf24\xb5\xf4\"\xa5\x05\x02C\xb6\xd0\x1c1j\xb4\xc9ED5\xb6 \xcd\xec8\u0450_\xb1\xc2#6\x04Vx\xa6\x14\xe0\x14;D\xaf\xd8Pr\xcd\x136\u033a<\x1d\xd1[\xf8N\x82I\x10\xf9\xe1[+\xfd\x92\r5\x87l'_\xb3\x91\x19\u0189v8\xa3\rcQ\x1d-\xce\xec3\xb9\xa9\xf9LD\x0e\xa8\xa8\xc5\xeb\x9c\xdak\xfd\xe4zV\x96\xfbN>\xd0~)\v\x81u\xccN\xaa\u056d\xad\x93\xc60^D\xc2%\xf49;\xaeV\xe1\x15\xf6D\x05\v?\xd1.4\x1bAz2\x9b\vx\x1b|\u02f6\x8e\x97\xcb\xe7\x8fw\xe9o\xee\"9\xa2J\xe1;\u39a6d\xf5\xa0\x88QZ&\xac\xa9\xaeLQ\xf7\xcd<q^5\x12>\x0f\xfd!w\xe1\xb9oB\x9d\xff\xe3\xff\xf1\xff\xde\xc6\u0409\x059eD7\x0fT+\x1dg\xe2\xc7d}|\x17\xf4N\xe1\x9ei\t\xf3uV \x7fbZ\xe0\x00\x17\xd9q\x0fW\x84\xcez_\xbd\x9c\x05\x0ec$\\\xe2^/\x97s}E\\\x82\xff\x86\xe5rBz_A\x91\xeb\xb63\x05\x01\xb4V\xc3v6\x05O\xf8\xf7\vZ\xa3\xaf\x16\xb4F\x8f\x17\xb4\u04b7\xe5l\xee\r\xbd\xb3Q\x877.\x13NK1e\xd0\xc8\x02\x15\u00c5\xf6^Z\xd7!*uOH\xd7_s,\b\xb1\x88\u05e1\u82f2\xa0\x0fW\x0f\x04\\\x98\xdds\xefHx\xfb\x9a\x1b\xe6J\xfc\aG@VF\v\x8f\u05dd-\x82\xb6l\x00\xde8\x1a\"\xfe8\x9f\xf3\u4e5fr\xf7\x81\x15\xbez\xd4\n\xab\x05\x9c\xc0\x15}\xd8\xde\xdfk\x13z+\xaf\xe8\x89 \xf4\xf2?h]-G\xa5\xc5k:w\x15'k\x17\xad\xbeWcp+\x95I\x9a}\x96\x98\v>`\x89\xbc3\x17,A\xb9r\u0212\xdc\xdd9\xc4\xc2/\xb2\x17f-/\x9e\xb3@9\x9c\xd9v{\xec|\x9blO\xe0y\xba\xd5\x1d\x1f\u0355~Aw\\cmr\v\xe8n\xae\xf0\xfe\x9c\x8eis\x1dc\\_&*\xb8\xc9X{\x01\x9a\xb2\xc8~\x94~<\x18\u017d5@\x82\x19^\\dK\xf0\x7f\x92\xf7\xee\xdbm\xdb\xd8\xfe\xf8\xff\xbf\xa7\xa0y\xbax\xc8\x13\x88\x91|7]V\xcbu\x9c&\xad\x13gl'\x99\xd6\xe3\xe3C\x8b\x90\u0106\x02U\x10\x92\xedXz\x97\xf3,\xe7\xc9~k\xe3B\x82W\xcbN\xa6\x93\xe9wM'\x16A\x12\xc4eccc_>\xdb\xf4j\x1fQ\x11\x9d\xfc\xb7\x17-\xeb\x12(*;\xe5 \xd3\v\x06%;ey\u07db!\xf3\xb9\xb6\xef\u014e\xb4cN[X\x05\xba\x01\xba\xec\xed\xee\xec\xec8\xe8H\xd0\xe5\r\x80T&$e\xc6-\xf3k@\xc58\xf6\xb3\x12\x8b\x11\x05\xe5v\xd1\x10\xe2\x14\xd5\xca\x1c\xe3\xe6\t\"\xc2#2\xc0\x95\xdeiq^\u03bd\xb0s\u0775\xb9R\xb0\x1dw\xb0n?\xa4I\xaf\xed\x06\xdf\xe3\x87\xfc\xb0\x1b\xee4\xfaH\v=\x1d\x8eC\xd3y\xeck2\x1d\xdeC\xfe\xd3\"\xb0\x91\xb8\x980z\xd7\x18\u0618\x13\xc6\x11\xac@\x8c\u0581\x13\x90\x8b\xee%\n|r\xd1k\xd4\xd2\xd3\xdcq\x8fit\x9c\xe8\x8e{\f\x05\x0e\xba\x05UX\x82n?\x04\xb1\x17\x88\x8c\x90p\xec\x92Tz\xc5jc\xa5nU\xee-x\rQ\x9f\xf4\t\xe7S\xf2\x8f\xcd]\xb8fq\xdcH\x99\xeds\xc2\xef0\xa7\xe5\xe6\x84\xdbB\xa1\x9d\u04243~F\xdb\xdd\xebm:\xe8\x93Xhg\xccA\a|_\xd8\xec\xedn:\xe8P\x14\x1f0\a\x9dp\x9f\xb8\xed\xad\u075e\x83~\x87\xdf\xeb;\x1b\xdb=\a\xbd\x16\x8f\xfc\xce\x1ct\u038b7\xb6\xb6\xbb\x0ez+\x8a\u03d9\x83\xde\xf17\xb7v\xc11\xf9T\x14\xbf\x83\xbc\xcb\xfc\xf3\x9b\xbb[[\x0ezQ?`\xf3\f\xda}\x18\xc5\xf8\xad\x00\xb5\xc3nFD\u070a\n2\x11\xa8\x1e\xb2\xc4^\x9a\xc1\x13,\xa9\x83\x80\x1c&\xd3;nC\x8d\x032\x9a\x05#\x8cf\xfe[\xa0\x8c\xc0\xe9\a\xb6\x94\x19c\x7f\xad\xb7\xe6\xfb\xaf\xa1|\x86\xcc\xf4\x8e\xb0\xe0\xf6\x95J\xb6i:\x96\xd5p\xcb\r@'\u0199+?\xb8\f\x80\xff\xbcpg)>\xc5C\u01c6\u029d}U$Th\x8em\u05dcD\x05,\x13\xc7\xcd\x1b(\x8d\x83\xf0\xb4z\x9b\x848m\xc5hZ[\xc3.IB\f\x9e\xea\x96%\u01c8\xa3\xd2J\xfe\x90\xdazH\x88\xa3e\xfb\xfd\u011a\xf2ri\xfe\x18A\x18\x1e\xcd1aP%&\x98\xda\xe6$\x99\xa5\xf8f\x8cql\"\x9e\xd57M\xa3\xb9L\x10\xe8h\x90\xcd\xce\xfdJ\x1f\xa0x\x92\xccq\xdb7\x1c\xbek8\xe8\x82!\x8a\xd2Ki\xfe\xf7[!\x11\xb1\x1b\xe2\x98\x05\xbfr\x11!\x85\xee\u01ef\xb8\x9c\xcf\xcf\xf3\xc9p\x98b&\v\x82\xec\x89\xf3d\xbaO\x7fH\xc0\xbf\n\xc0\xa1-\x8b|\xdf],\x92g\xc1\x0f>\xb5,\xf2C\u05f1\xac*\x8c\xe0r\xf5\r%K\xe1\xda\x11\x10\x0f \xfc\x0fj3\xaeU^U\u052e\xfc\xa1\xd3Z\xf7\x93S>\u2200\u04c9\xf9B\xbe\xa20\xa7\xa2U,L\x83dz\xd7aIg\x10G\xd3\xeb$\xa0u\xb1!o\x98\v+\xeb<9T\x0f\xa1{\xbe\xbf\xb3\x96\x93\xb2\xd4P\xa1\xb8\x1a\x86\xc5\xdcp\x03\u076b5\xea\xa5\xda\xe9\xf7\x90q\a\x8c\x02\t\xa3\x94\xdd\xc5<\xfb\xd8\ts\x0f\xd7\x1d\xbbi}\xb21\x9e`8\u050d\x02\xc6c?\x10s\xbc\a#\xa8\xea>)ba\xf7_0W\x17\xb3\xfd{\u015e\xf2`\x17\x97A\xe8\xbf\xdc\x0f\x8e\x99\xffB\xf8\xe1\xfe\xc8\xfeb\xb1\xdd\xdc.\xa4\u0522\x1f\xa5\u04bcv\x89S\xcd\xf3\\A\xd7\x0f\n\xaf\vt\xda\v\xaa\u017d\"Z8Q]\u05a5BK\x88\xd2\x1a\x1f\xe6\xd1:\xad\x88\xa9Ru\x92\x02\x88\xf0\x94\xbd\xe2B\b\"\u0646S\xcaj\xa59\xbb@b+\u07ac\xd4O\xb2\xc8t\xcb\nD\xae\x95J#T\x12~\"\xe4\xe4H)\x82\xd3\xe5\x93}\xf8\x11\x93\u0410(\u044fD\xd0\xd0L\xf1\u031bY\u05a8\xab\xac\xfe\xf9\xa9)\xf6\x13}\xaf\u4635\x05g\xa0\xa9\x9f\x80W\xd1P\x80\x92\xe6S\x04\xd9\xe9\x9b\xfckBY\vWpN\xd4p\u0373\xe1B#9\xa4\xba\xbfPq\x90%\xaaw\xf47\u061f\u044d?\xb3M-\f\x8b\a\xca\xcel\x13\x82\rO\xf3x\xc5[(\xd3\xe2\x17\xaf\xf4\xe3\xb8j\xbde\xd5\x14\nM{\xcd\r\x8f\x14VyV\x8e\xce2\xc8J\xdb\xe9\xf3\xb6\xfe\xed'h\xab`\xaf\x9f\xfc\xaf\x96w\u037c2\xf7\xb5\rR\xea\f/\xfe\xfb\x1f7\x9d\xcb\xe7#\u011ce\xe5\xc85\u03e5\x84\t2\xaf4c\x04hT\xf3\xe7>\xf1\x14\x82\xed\x19g\x9a\xa3\x06\xd3'y\x1b<\xe6,t\x9a7\x1ci\xa3]\xef\xdbQ\x06\x849h4\xf0\x17+7$]\x19L\x10V\xf9\xa5\x1b\x85\xd65D\x01\x8d\x82C\xc96\xbcO\xfc\x92\xe7\xcb\xf4\x1a*+z\x98\n\x9bb!|[\x0f-L\xc1#UT\xfe:\xf4\x0e\x1a\xd2\u0657\xb8l-\xba[\u06d4ED\x1c\x12\xa3\xfe#\"\x96\x8fr\x8b\x9d\x17\x15\xedM3\xdd&\x17\x97lr\u0494^\x821P\f\xb0\xa2*k\xb2\x8c\u0569\xad\uba64I\xf3\xab\u25a5\x82>\x9as$\xb8$\x8eXq\x92\xcaq\xcb\xe0:\xf5\t\xd1$\xe6\xf7F\r\xe7\xfe\xbfb(s~YXz\xab|\xc0h\xfa\b\xe8\xb8yk\x8f\xf9\x0fe\xa8\\\xe5\xb0\x1f\xacv\xd8\u05ce\xfa\xc4gp\xd4O|v\u0443\x13?\xe4\x1b,\xfa\xf8\xf9>\xe9\xe7\xa9G\xe4Q\xcb\xf4L\xb3\x89\x15\xde\n\u0140\xdc\xc8'\x8a\x8e\u7e76:\x14\xe6_\xe2 \xd0\x19\xf2m\u00fb\xf6}\x9f\x80mw\xaaQZ\x80@\xe8\xf7HneM*k\xa7N@\xf1\x12\xc0\xa3C\"\x1a\xb4 \xe5x\xd4m\x91\x81\n1\xcc\xc3\xe2\x9a\xe5'W\xac\xb23\x81Nc\xe4\x16\xcb\xde\xe0\xc95\xa6\xf6\x04\u0351f\xd9\xe6\x19\x8d4\x9b\xfb]\x893TB\x16[\u073e\x7f\xacq\xfb.{\x03pr\xc8\xf6en\x18\x10\xfe\xe0\x8e}\xa1\xe30?\xff=\x15q5\r\xec\x04\x0e\xa7\\\xd4~\u0255\x1c[{\x9b[\x0e\xfa,t\x12/\x99\x83\xdeC\xf1\xee\xdef\xb7\xeb\xa0?D\xf1{\xe6\xa0\x0f\\\xb1\xb1\xd5\xdbu\xd0G\xf6\x05\t\xa1j\xf4\xe4\x0f\u517aJ\xc8jR\xad\x0e__\xf3\x8eH\xa8\\CV\x85\x14\x06\xb4JD\x1e\x06\xf4z%\xc9\xe2z\u04a45\xe8\xfb#\xcc\xce\xf91\\#\xaf\x87\xb2O\xa9\xef\x8b<\xefy#\xb8\u06a7B\xac\\\xea\x15\xe8m5\r_,\x04\xa8\x8c\xf2\xac\x96\u0559(\xb8D\xd5\xfc\xbev\x96K]\x1eQ+y\x1a\xb3\xb3\xd2b\x11-uP\xf9\xbaA\xe3\t\u051f\b\x00\x8f8.\xa0.\xddG~\xa0L\x15\x81\xda0c?\xe0\xe9jx\x1a\x80l\u0726P\x9a\xa9\u01c6~\x90\v\xe3c?\x00i>T\u0278\xd41`R\xc8\u0385\xe6\xf2\x9d\\>\x1f\xf1/\xe5sq'\xaf+'\xa8k?(\b\xf27\xfe\u060d\xc8\x10\xd33\x81]\x7f\xe4\u03f3\xed\x04\xdd\xfa\x93\x8a\xcb6\xba\xf2o\xfb\x99g\xfa@n\xe9g\xfe\xa0\x84\xb5\x83>\xa9\"\xb1\xaf\x80h\x1b\u06994\x90Uh\x82\xd3@\xa8\xbfx\xc2/\x95f@n\x9d\xbfC\xe1$\tq,\x89\x8b\u00e7\x87e'\xf6s(\xca\fD\xd2\xf5\xfc-\x14\x16\x8f'\uf828\x9c\xd5\x11\x9dj\xa5&x\xf8kP\x9b\xb5\xd4;B/\xfdA\x95z\x8f\xcb\u050b>\xfb/k)\x18\x1c\xe0\x8f\x04]\xbdW\x8f\x88,\x02\xa6\xb3O\xfc\xf7\xfd\x1b\xfb\xbdT<\u02d1\xa6\xfe\xfb\xbefu\u057e\xda\xdeX\xa4\xea\xbdt\xbc\xa1H\xa5B\xd4\f\xa9/\"\xea\x0fxr\u052c\xa4?\u0312]\x89\x02o(r\xfa\xa1\x0f\xfeZ\x0f}\xf4\xef#2\x88g!>\xc5AxB\xe2;\xc0\xe8\xd4:\xf5\n~\xff\xe1K\xbfo\xfbU\xb9\x8f\xceb\x91\xc5\xe9\xbe\ua2df\xde+\xd9c\xf4YT\U000b3607:feC(\u00c3\tb\x97\xfb\xd9G\xec\xc4\xff\xc5\xfe\xcc\xef\xfe\\\x9a$\b*\xe0\xb7\xff\x00)\u5cd4c>;.\xc1\xb7\xccv\x84\xe2\xc1\x81\u020b\xb5\xae\x18\xc0\xecxX\x9c\\STT.\xe4/\x8a,6\xf7\x7f\xf8\x04}\xf4?\xf3\x0f1\x91\xf7\u58c3\xee\x97H\x8d\xe7G\x1a1\xac\x06T\xec\\?i\xa4\x96\x93\xd1\xf1\xa5\xb3\xff\x13\xff\xdeO\xf2\x03<'\xa2\xafcd \u00a3\xbba\x16\xd6|\x95\xca\xdf\xd7\xcc\x18v\x17}`\xee\x89cch:\xf5M\xbeG\xb7\x06\x1b\x96o\xb1\x82\x9c\xa9:\xad\x8b2\x04ejC*Yk\x9e\xb3\x97+\x8e\xe5\xe4A\xfdK\x91&\xeb6r\xec?\xd01\xfa\x88>\xf4\x13\x99\xe8\xd4A'h\xe2\xb4\x1a`\xea$z\xc3|fO!\u0749\x8a\xff\x14I'\xe2'\x83\xf8|!\xae\xce#\xf0\x7fJ/_]\xa9cb\xb9\x8e\u05d9k\xfb\xa06/\x81\x83n-\xebJhS\xdep\xad\xc4UUr\xc7\x0f\x99\xe9\xa80\xd3%\xadf\xba\x83z3\x1d-\x9a\xe9\x12i\xa6\xa3\xc2L\x97p\x19E0\xba#\u02d2\x9dP\x1c\xae\xce\x01I\x9a{\x9b\xc6M\uc02b\x8c6W%W^\xedLp\x18\x05R\x1bp\xdfv\xbb\xd3\t\xf8>\xabn\u0158\x9a\xde\u0772\x16\xaeV\x04\xe5\xb65Y\xab\xf8\ua287Y\xc0\xc1\xec\r\x146)B\xde*E\xc8\x03\xfb@A\xa7Q\x19\xe1rA&b\t\x89\xfe\f\xff\x01\x9e>E\xe5G\x9d\xfc\xabk_D\xb3\xe1\x1e\xc4\xe3\xdc\xf5\xbfx@\xe4H+\xa4(~\xc0\x163\xfd@\x92\aS\xc8B\xc0\x02$\u0526\xab\u03b9\xe8s\xffQ+R\xb4*\xe3\xc3O\x9cf\xf5\xbe6\xc9jw\xab\x99\xe2wZz\xe9\xcf5\xe9\xa5\u06f6\xc9j\xf6\xe4|\xb7\xbcvS\xcc\x0e\n\xa2\xbb<S\xde\xf3\f\x15\xb8\x10\xda\x11\xa1\u0665\xa0\xa2[)Hk\x87NY\u03bb\v9\xdb\x10H\x8f\x9c\x0eR~\xb2\xd3\xc3\xc1\xd1o\x8b\x05\xe9\xd7@\xf9e\a\xf6\x92\x87y\x01\xdaoR:\x91\xcfU\x82(\xe1\xea\xf2\x13\x9c\xf1T~j\xef7T#\xa7\u4326:\xf7\xa7\xd9P{BZh\x1b\u06d2\xb0\xd7\xd6\xe6d\x12\x89\xdc\xcaZ\x03\xce\xea\x10\xd6\x14\xfc\xd8Y\xa9\xba,H\xe0\xa8\xff\xb8\xddG\xa9w>\xf5\x85\xce\xe6\x13\xa4\x9d\u41e7'\xean\xa8\xcfZ\xf8\xff\xb9\xd2\xc6p\n\"\b>_\x99O\xc1\xee\x9b\xf1\xec\xde&\x86<=\xb4@\x01q\r\xc5\u01ea\x86\"\xd3\xdd\x14t\x11\x10\x9c^\xa7\x9f\xd1S\x8d/\x95+\u04eb\x15\x9cD\x1e\xaf\x99\xbf\xba\xca<\xa1\x04*.`o\xca@W\xa2<?\xbe\xe3\x1e\x19\xbd\x8d\x9e\x83~\x16Z\x8e\uf603~\xe1\x0e\x19\x1b\x1b\x90\x05[\x94\xfe\xc2\x1c\xf4\xdb_\xcab\u0277.\x93\a\u00a58\x943%\xbd\"\x8bO\x8a\x8c\xe4\xef\xf4\xe7j\xf5.\xca@)w58\x93\x00\x96/s\xa2\xa1\xcd,\x8b\x19\x11IY@\x06\x90\xad\xee\xc78\xb9V\x88D\xd0\xe5\x97Q\u0319\x06\xa6\xfb\xc4M\xb8\x91\xde\xd7h\x85j\x9a\x99R\x83a\xf7\x9d\xc5\x00\r\x86\xe0w\x10\x1e\xa4\xe7p\x92`Y\xd2\u04a6W\x99\x96\x11\xb6\xcd\f9Pk\xe0E\x14\xbeIf\xd0\xfb\x8a\u0282\U000ed681\xb2\xe5zB\u056a\x9a\xa1\x91\x1b+\xcb4A\xce\xca\x00\xc52\x8b\x9eT\x9fP\x9f\xa8*P\x92\xff\xe6\x12K\xe0\x13\x1e\xb3\x1f\xf9D\xc1m\xa34\xc7w\x8a 3x\xc41\xfc\n\xa6RR\xc6Y(\xe4\xb6\xd1Z\x0f\xd1\x1cU\x8d\xc3\xd8\xcf\x17\xac\xf9,\xcf\xdb\xcc7\x81h\x82m~x\x0f\xfc\x80\xa7P|\xfe\u07da\x8a\xf3\x1f\u03d3\x01\u00ec\x932\x8a\x83\xc9\xf3\xc8e8ev\xe2,\x16i\x8e\x06\xfc\"J\xa7I\x1a\t\x94!\xcbz\x1e0\x16\f\u01b0\x86\xd4\vM\x0f\x8bz\x94\xa5*\\\xa5\x9e\u0687K\xed\xd1Q\x8f,\xeb9\x10\xbeqN\x03\x92\x0e1\xadk\x92\xfe|\xa9I+VU\xfb\xbc\x03\xab\u0484ehB\xb6f\x8e#&\x88&\xf4\x13\xc0\b\u01b7\xec9X\x11!\xcd%-/\xdd>\x05\x182\xfe\u04fe\xa0\x97\xe8\x9e'\xb1\b\x97\x00#\x15\xc1)^0\xe9\x93\xeb\xdf!e\xe1\xe9\xb1=\a@\xa9\x8b\x10\x05n:\xbbN\x19\xb5c\xees\xa6\xd2`\x80s\uccde\x83F\x12q\xd9\xf48\xeaT\u04d06\xcfp!\xad\xb9\xe407\u008a\xfe\xe2\u0531\xaf\x9d}q<\xf7ox\xba\xcc\x1bg\xc9\xfcW\xeeo.\t\xe6\xd1(`\t\xb5\xac\u00a5;I\u03c29>\x01\x04>\xc2{\xfe\x18\x8c\xe3@E@\x8cZ\x90q\u06bfg\xcf\xd1]\xc1w\xc9\x00\x0f\x1b\xd3q\xbc'6D\xb9Myw5\x95\n\x96\xc9\xfcG\x82*\xe7\xf5H\xc6a\x84\x98\xf10]\xe3z\u018c\xbbdF\x8dk\x9a\u0724\x98\x1aa\x82E\x92\xe7t6\x9d&\x94\x19\xaaA\x11\x19\x19\xd7\x11\x81\u0223y\x14\x18\x7f\x7fuj\xd8|\x9bpMg_%%\xe6f\x8d|\xa9\x8b\t>\x12J\x96L\xbdBA\xbdr\xa4\xd4+\x1c\x92\x0e\x83\x8e\xd5\xfe\xf9\xec\xe4\xad`J\xb0\xb1IxD\xc3Txt\u0339\u01fe9\b\xc8\x7f2\x83?e\xf0\x17\f\xe34\xb81\xc46\xe3\xfd\x83\xfc\x83\x98\xcf\xe8\xb2:FC\xcda\xeb\b\u9f98\xdeZ\x17\xe5~Qz,\x94+\x9a\x88\x94IA\x13bgH\xbakr\x11\x96O\xcc\xf3\xdbI\x9c\xf7\xbdoc\xffg\xe1\x99\xc5}\u0378g\xe4\t9\v&\xf88\"\xfc\xa3\x11\t1a\x90u\xc90\xe0PX\xdb\xe8\x15[z;\x89Wi\xa8\xe31_c\x1e\xbe\xef\xff\u0138\x19d\xb1x\x0e\xc5\xffx>\x8d\x83\x88<\xcfz\xf1\xf4\x16\x8d\x99\xd6$\xda\xdc$\x91}\xe6\xf9 \x9d\xd75g\x90\u03bfFc\xa0\xf6U\xda\xf2\xfc\xbf\xa3I0\xc2\x00n\x99}\xf5ga(R\xa9\x86x\x88\x96\xd3\xc6h\x00L\x9e\xd6'\x8b\x93\xe8\x1e\x00\xe1Q\u02c8\xa9\xc3\x1b\x11\xcc\xc2()4\xe2\x91K\xbe\xf28\xaf\xd1\x14\x88\xd1\xdc5d\xad6C\x9dHw*\x1a(\xf3D\x03z\xbc\xe3U\x12\x1a\xd3/\x98\rv\xcbV\x9a\r\x85\x87\u04df>\x8a\xaf\x97r\x11E\xc0\x02\xdf\x13\x8a\a\u0248@\xb0Yf\xf1\xe7Z\xa4}C\xda`\x81\xc3)\u05d8 5\x80\xfe\xdc\x1a\xfd\xc3\u04fa9m[\x93_\u0703\x19\x8fx1X\xa2\xfa\xa24:\xea\x14\xc2\xfa_\x98\x93Ed\xfb\x03\x7fT\xb8\xd1\x18\x13\xf3+\x87\xf6\xde\xebnl;\xe8o\xe2l\xf6+s\xd0\u07f9]\xba\xbb\t.\xf4\x98\xf0\xe2\xbf3\a1\xf2h\x1b\xb5\xd4\xdb\xd7Y\xa8i\xd1B\xdd\xe0\x02ZB\xceFD\u0518\xb9R\xc2>\x95\xd4\xf9~\x064\x98\xfcx\xf7\x9a\xa7\x94dw\x8e\x9d\xb8\x1a\xda\b\x12U-\x9b\x1cO\x0f\x13\x92\xce&M\x8e\xa7\x85\x03\x1a4\x80\xd54@U\xc1\x15'\x8e\u034a\x9f\xaf~Z\u0104\x9f\a\u05f5^\xae:\x86\n '\xf7K\a1y\xefC\x94F\x92\xc8ah\xaf\x83\xc1\xa7\xac\xa8\a\xbc[\x95\xd6\xd4Ry\xa1\x8bj*\x86Z\x84H\xd88x\\u*ra\xda\xed\xd1\x17\xb9\x86\xae\xe4\x1b\xab\r'w:-:v\xa6%\xc7\u0399\x1f\x81\x05\xf0}\x8a\xe9Q\x181\x1cB\x82\x12y\x80\x8f\xd0\xdf\x18'K\a\xc5\x10^\xc7\x13\xea\x9cb\x16DD:q\xc0\xc3|\x92*o\xec\xa7\xc2\xff\x96?V\xe3~\xcbt\x15#Y:(u#\x12\xb1b\xbdQ\bK\x95\u0484\xda\xf7\xa5\xc7g\x8b\x85\x1d/\x16\xfaW\xb2\u01a8o\x88\xb1.\x7f((f\xad\x92-\x0f\xf2\xbe\x06\x85\fV\x8d\xf7U\xfb\xf8jI\xe1\x0e?\xb8+\x0f\x84*\r\u0551Dw\xf9T\x8f\u399c\xf4E\x90B\b\xae\xcc?+\x12\xd3\x17`\xf9x\xa0e\t{/\x96)\xe9% \x02\x05\x17\x85i9\xc7\xfdP\x15\x88\xe3\xf7\xb8\x92\xc6>\x94%9l!\u0549v\xee\xd3\x02!\x8e\xe4\xb5\xee\x83L\vP\x85S\r\rId\u013f\x812\u0555,\x1d\xfe\x11\x94\x16\xcd\xff\xb7Pt\x98\xad_\xee:p\x05e\x1a\xe9\x88\xd23\x7ffY\x11\xfa\xe4\x8fs\xb7\x88\x03\x81\x05h\x9bT{\x1a\xdc\x18\xdeq\xfd\x16&\xb6c\xbf+\x9c\"\xeb2\x16\xa8DL\xb6\x19ii\xd4\xe5!\xd0&>\xbe\xa0\x97\x8e\xe6\x82L\x16\v\x1b\xca\xfc\x8bK\a\xc1\x0fa\x99g\x0e\xc2K0\x1d;\xb5\xf6;\r\xce<-b\xb8C\x9a\xf2\x8b\xcb\x7fU\x88\xe5\xa7\xfeJ\xb1\x93-^\x98\xe2\xf1\xe6\xd3+q\xb3m\xa0\b\x9b\xb5D\xa5oD\fO\x8c<'eAKT\\\xa0\x96e\n\x0f'\xd3y,L\x9a\xf9\x80\xbf\xf3;\xad\x85\x8e\x83$\x85\xe5{\x8c\xd3\xff\xb2\x11\xd0jZ}\x00JL\xeb\x9f\xd8\xfd|1:\x99\x8d\xe8K)\xe4\xa1\x06\x15\u01fc\xc6\xe7\xfa\x86#\x1f\x1e\x9cmx\xda\xea\xaf\xec\x8e\u07a8q\xc7\x1c\x89]b\xe28HEf\xcfJPl:\x0e\xab~\xa3\x84\xe0\x96 \xee>\x8aY\xd3d\xcf\x1f\xb7\xf3M\x96\x10\xa7'MIm$\xbf\xc2:\xcd\xdf)\x04:\x1fJ\xdbA\xffkFI\xd7\x7f\xf7In\xdf\rw\u018d.\u0645~\xc6W*\x91?<g:_RS\x9b{\xf7*\x0e\u0607\x92\xab\x1f\xd6f0n\xe2\xf0\xd7\xe8~H$\xa8\x9f\xc0\x8f\x91;\x8af}(\x193\vG\xb9!\xa2\xc1\r_B\xdc@\f\x7f\xc79\xfd|\x8c8\xad\x05\xf9\xb9\xc1\x9e\xc0\xc9D9\x85\xe8\xa93p\xbe\x0f\"\xd3\xd5r\x18c=e\x94SL@,~\xa2\xf21C\xbbW:yT\x10\xc9t\u0331\xf0\x01|2}\xe9\xa0(U\xf0og*\xae\xdc\xfb\ua020S\xcd\b:-l\x0e\xc2j\xdc\u0326WXp\xea\rm\xc5\x1a\x8fk\xdf-\xcaD\xda\xd4\xcb\r\xe0\xd5}K\x83_\xfb\xddv\xecXNn\x8c\xba\xa8\xd3s\xa4{\xa1\xbe;\xa9\x0e~\xb2\xac\x83B\xea\xfc\x9a\xed\xf8\xd1\x12\x88Q\x96HJ9\xfe\xbfv\xa4T\xe3\xfe\x93\xcf\xe9\x15\xe7$\xf9\xfe{\x90\v\x97\x11\xc5!x\x13j\x17\xcb<\u0360TL4\x85Z5\xc5\n\xcd%^$\xad\x1c\u01aa\xbbV\xc1Y\xe8\xa0\xe8\x1b\x84t\xf0\x9f\xdcQ(_\xa5\xfa\x81\x95\xb8\x95#m\xee\xaf^\u0697t\xf1\x04\xfa\xd8\xe1CU\n\xcd*Dw\x89\xf1(\x04w\xad\x18z\xf58\xa2\xbfB\xf7|\x97\xad;\U000be303Q\x9ds\xcd\xdcm}\xa5y\x14f)\xa6\xaf\x82\xf4\xb1\x82\xc6\n\xab\xadxn\u046e\xbc\x03DKm\xcc\t\xa5|\xb8\u05fe\xa9=\xf1\x9a\f\xe2Y\xca\xd1X\x19\x03\xe8\xe4\x91\xdbr\xb7\xb5*~\xd2O\x8b\x15\x88\xb2\xeak\x1aK.\xeeQ\xab\x86\xcfdU\n\x8fEU\xb1r\x88\xb9(\f\x1a*\\]:\x0e\x12F\xf1\x83\xca\u01cak@\xcay\xf9N\xf3\x18\xc7+\xa2\x05\u9262\xb2\x17\x96\xd6@\xdd\x0f\xabp\a(\xabf\x85\x82\xb4\x00\xde\x10\xca\xf5a\xa5Y\xf79\xd3w\xa3\xf4M0\xb5\x89\xd3'\xdaF\xe0\xec\x17\xe9\xbf,\x98\x166T\xa9\x0f\x87'_\x13\x9bq\x9d\u07b2U\xa2\xad.\x17\u0569\xd7\xc2\u044b\x83\r\x96:X\xae1\xa3\xc6\xda\xe60\u1e849G\xd2\xe2FV\xe2\x9c\xcb\xd6x*F\xaa `\x05q\xff\xa5l\xb7\xc8\"\x06\f\xadtl\xa8y\xa0\f\xe9\xbc\xd6+a:\xafu\v(\xd1\x17\x979\xaf\xb8\xb8\xcc|\x9e\b\xf9\x9a>O\xda.\xb7\x82\xd3\x13%\xfe}\x958\x9d\xfb%\x8aR9\xaf\xa1\x04\x82\xf5\xee\x970\xe7\x7f5\xa8\x06<\xf8t\x9d\xdc\xd6\u0190\xd9\xdd\fiA\x8d\x91c+\x1c\x15w\x00\xaf\xe2\xf0\v\xbd\x85j\xb3\xa3T\u019e\xd3A&\x81Co\x85.\x18\x1c\x1e\xc0^\xfd\x9aD\x02\xbcE\xd28_\xb9\xfb\x80\xcbb'\xab:\b57\x82\x7f=J_H\xdc\xe5\xa7d\xb5W\xc1\xe8e\xf7o\x9dZ\x81\x7f\\\xf1\xb6]\t5\x8a\x89\xee3\xacgR\xeb\xd8\x1d\x11\x9e\xc9U\xf8\x9c\x98\x039\x99f\x0e\x11M\x90\x9c&o\x8dX\x16\xab\t\"\xd7\xe7\x7f\xe9@\xd6g\x12\x1a\xbc-\x86\ffi\xe6*I\x85\xabP\"\x96B@\x00\xafm\xaf\v\xc9\x12S\xf2\xe7FG\xaa>\xb6\xd9\x7fV\xc6^\xb0,\xfd\n\x11?\xd1\xfc\xc72\x8a\f|\u2ab3i\xb6&\xed\x00\x99\xdcR\xb3X`\xcb\xd2rfr\xbc\x02\xa1\x10\xad\x06v\xcamY\x86\x88\x15\u06de|\xd9~\x9e<\xb0\x9fk\x87\xbe\xc2v\xce\x11Ax\u07f8\xf7qMXg\xddV\xf8`@\xaa\xae\x9b\xa7<\xad?\r&\x1cqIk`\xe4\xd3B\x16\xe7\u0527\xf9\xd9}?\xd3;\ni\x88\x7f\x9774\xdfh\x03\x14\xa1\x14\xe1j\x9bS\xcc^h\xdc\xe2\xa1(\u0522m\xa1d\x00\xe3Y\x9c\xe4\xfcs\xb8\xb1j\xf6\xc36=\x05A\xd4Y,4Q\x06\xcd\xc0Q+ \xeeo\x8e\x1de:A\x96\xe9\x04\x97\x8eH2\x14p\xbbX\xcd!ITS\x8dW\x05x\xe9~\x16\xe8\x94!M\xc7\ra`\u00ae\x1d\r\xed\u0232r\xf7\xc7B\xa8\x9be\xf1#\x93\xb9\x96\u0740\x99\x11#8\x85W9z\xf6M0\x1aa\xban;\xce\xd4\xcf\u0599|\xfe\xb6\x93E\xac\xf5\xabE^\xf1q\x1e\x8c&\xfa\xce\x13\"\x8a\xa7.\x9d~\xeb]ofY\xb3\xec\x01\x95\xc0\xe72w\xa6\u0287V\xb4|\xc8#c[\xc4\xf6\x80o\xb1C_\x19\xe5\x04G\x1a\x16S0\xa1\xd2\u02b9t\x9c\xfdr\xffK\xd1uc$\a\xb6\u0425\xda\xfbu#\x93QA\xdc08\xf5\x0fx\xe59\xa9\xccH\xcd|\xd8jP\xb5\xbbN\xbf\xa6\xb0\xf6\x155\t\x85W\xb2B/*\x15,3\xf2\x9b.\x16B\t\xe0F\x1c\xcb\u039e\xc2\u0699\xfaYh\xdf\xd4qP\xf6\xc5i?qK\x1b\x82=\xe5\xf4`&\xdc\x13\b8\xb4\xfc\xba\x88s\xb2\xac\x81e\xadE\xa5\xe8Y\u01f2\xaa\x15\x15\xdb1p\xfa\x83<\xc0p\xc0q\xe9xDx\x91\u0440\u00a2V\\z\xff\xf6\xec\xe0\xe5\xd1U&5}\x8c\xe2\xf8\x14\x0fp4\xc7rw\xad\xfaG\xe7X\xa7E\xfeD\xcb\x06\xfa\x02\x7f\"\xb9q\x01\\\x9c\xdbX\x13E\xe0 \xc6=\\\x81\xa9p^\xc0\x93\x7fJl\xf5~\xe2E(\x10\rI\xeb\x98V\x90\xf1\xaa}\xe6\xa7\xfdT\x8e+\x99M2\xc2P\xae\x96Q?\xaa\xb9\xcb\u0144\x19\x8a\xf3\u06d2\xf3d\xf7\xd5\\\xc7\xfd\x99\x1f\x8b\u076a\xa8\xcd\x02\x97{\t\x0fo\xcf|\xa6\x98\xa1F&@\x82P\x83T\xff\x95'Zx\xcd~\xe7\xd83G\x19/\u0293\x9aI\x99\u068a_)\xc1\x80\x18\xfe\xdcy\xa8\f\xde\xc4\n\xfb\x9f\x16\u0308\x91f\xceU\xbeB\xe81\x80\x02e\xb00\xb1\x03\x83\xcfFF,\xab\x00\x84e\xaa\x10\x1d\x1c\xac\xac\x13\xe7\xf8`e\x03}\xa2\x13\xe9DG\t\x9bW\x1cGF\xbaE\x1cR\xf2:<{`\t,\xe0F\x16\x1d&\x93IB\xf2\x1b@\xb8\xc1ba\a~\u4835\xc8\u046c\u07dc\xc0\x8e\xd0-\xbaBg\xe8\x13@\x86\xfd\x9c\xcaty\xc1\u02c4N8\x92\xc0\xcc\x16\u01bb\xcc\xf4\x1e\xe4c\x8fN\xfcl\x0f<\xacG\xa3:@\xf7m0I\x1c\rFX4\x024\x90#\x06\x19F\xe4O-\x17I\xe8d\x0fdyHr\xb5\x00/J\xed\xd0)\xf8\v\x1c\x16|\x11\ua064\x94\u0362b\xde\x18j\xaa\xaeA[\n&\xf0y\xff\u075f\u0560&\u032a\xa8\tj<1\xc3T\xe4\xf4{[(+H\x92\x00\x9f0+\xc3'\xf0\xae\n5'\xa6\x1cKaV\xc4R\u0218Q\x901\xa3Q.8An\xc46\xc6\x17\xa2\xa8$\x93}\xf6\x8f\xfb2\x05\xe00\xa1\x93\x80)\xec\xcc\xf7\xf9\r\xb1\x85\x88\xe2?T1\xdfx#\x86'\xa9\x89\xc4\x13\x97\xf2\x91\x0f>\xaf\xeaE\xc0\x02\u0607\x0e\xd1G\xdf|\xa9\n\"\xee\x10\x8f~\xf6\x83|2\x05GC\xbf\xf8/\xfb/\x8b\xcc\xd04\xd1O\xfe\x8d\x90\xed\xa6\x89c\x1f\xab\x90G\xff:G\xcfPpt\xbf\xfak\xbdL]\x96\x1d{,\xeb\x98{\x8b\u02fe\x88\x16\xeb\\\xf2\xa8o\xdf\xfaG:\x8fFW\xfeQi\xa7v<\xa8\xe5\xd6?\u059f\xe3q\u0dd2\a\xdfJWS\u02f2\x7f\x05\x00\x01\xa47\x01\xd23\xdaW~9\u05e2z\xc8\xf7\xfd+\xfe@\xd0\xfc\x80}\xe6\a\x85\xed\xdb\xe4H\vY\xa9&]\xd6\x1a;!\x9e_!\x9f\x83\x1d\x80\xb3^/(\xf2a\xed~DLOc\a+\xc4\xf7\xd7\x1bv\x1fTn\xfd\xdc7\xcbF\x9c\x8c(\xbc\xf2-s\x89\x8aM\xfe\xb9\xdf\xea\x10\xf1\x7f\xff\xfb_fc\xd6\xe465\x9b\xb0~\xa0\xf7\xe8\x0f\xcb2/\xb2}\xeb\x0fd^\x9a\x0e\xfalY\r\x9f-TG\x93iG\xae\xaa%2\xed\xefL\xf4\x19\x99\x8e\xe9<\xb2-\x1a\xa6\xfd\x12\x8d,K\x8e\x80V\xec\xf4\xf5\xab\xa7\xf47\xe26s\xdbD\xfa\x16\x00m\x85\u030f?\xe9X\a?\xfd\xb3\xb0\x0e\u03bf\x14\xeb\xe0\u06b2~\xcb[\xca\xfc\u07de\x00\x9e\xfe\xa7\xb4\xd4y\xf4b*\xfa6\x045\xb0\x14\xfdf\x14\x8b\xa0\x16\xc5B\x8c\xd9\u0689e\r\x16\x8b\xb5_\xeb\xb7\xf8\xd7M\xf4\xc29 \x92\x1f0\xbf\x8f~8\x98\aQ\u031d\xc89\xf3N\xbf\x7f\x1e\xfd`x\x86\xf9\xec\xc1\x04\xf3\x06.@\xa8\xc9\b1\x04q;\x8ej_\xce'\x1f\xd9N\xc5J\xf5\xa6J\x19W44k\xe7U\xcd\xd7\xce\x1a\xbf\xa6U'\xb7\u7b22\xb3\xa5\x83>X\xd6\xda\xc7&\u00fa\u0301\x0068\xef\x81\xe0\xa9l\xdft\xb4\x85\x9f\x9f\xdfV\x84\xf2\xc8\u01a3\r\xcbC\x87E\xa8|(\a>PX\x15\x05\x95\x9e4\xdc}\u02113\xca\x12XIP\x94\xd3r\x9e\xbc\x84S\x8b\xac\x8b;\x89\v8\x06X7\xf36U\u015c\xab*\x88PU\x84j\u0451\x92\xaaB\xc1\r\x14\xb4\x15\xea\x1b`\x02~M\xa63\x89$\xf0K\xc6G\x1a2\x0e}\xe2\x9e>\xd3rW\xc4i\xe4\x17\xa46-\xef\xe7\\S\xbd6@\u06aa+\xed\xb6\xed\"+\x16f[)\x10\x89+P\x15\nt\x86\u3943N,\xeb\xb8\x0e\xf7\xa1\u053e\xcc:5)\x81P\x15\xa5\xf56yX}4\x03\x828A\u035a5\xb1\x882b\xe5\xc63.\xf1\xca\xc4\x17\xfd\x1a\u0215\xfa\xa1\xd0\xc5e\xcdt\xa5\xfbG\x95-\xe1\xfc0Q\x12\x11\xb4\x1d\x8dCVf\x93\xc3%\u0213u\xc7\xfe%c\x87+-2\r\xd5\"\xa8\xd1f\xad@\xb7\xb4D\xb7t\x15\xba\xbdt\xdaPi\xb3\x94\x88u\x86\r4\x03\xd3\xc5\xc6Fwc\xddA\xb1\x88\x9f\x99\x11\a\r\xfeR\x96\xbfq@\xc2\x18\x17\"\b\xe4xV\xb5\xe0\xb4U\v\xaek\xf1\x13\xa9u\xe2*\xf0b\xba+\xe2\xce\xf5\x8f\xa5\xf6E\x82\x82K\a\xb1\xac\xfcG<L(\x96+K\xde^\xb6\xb7\xbb\xe0<\xdf\xdep\xde,\x925\v%\xb2\xe9yg\xea\xd4\xf6\xb8\x10!\x90\xfa\xf7\x93(M#2\u029dU\xd6zH\x96\xc9Ti\xe1/\xf8.\x05\xf3\xf6~T\b\xe3h\x88(\U0005808d%\x12uMj\\\u0f2a\xaa\u052a\xd0#Y.\x18\"\x97\x0e\a},\xfbN\xf0<\x0f\x1c\x05\xb2ax\u016b\xd3\xfcU\xbdbx\x19\x14&k\x03\xa5'I\xddr\xcf}\b\xaa\xad:L\xb4\xf4\x0f\u0266@\xba%\xc1\xb1S\x90#z\xfcK3\xf9\xa5\xb5\xae\xcc\xe0\x91\xb7\xfcl\xcc\x19\xa3\x1a\f\xfb\x1e\xe6\xa4}h\xc0\xe9Y>T\xec\x9a7\xd5o\xe4\xd3\b\xf9\xcfd\x03\x86\x8b\xc5P\xae\xc8\xef{\x8b\x85\xcd\x13\x95\f3\x05\x7fA*\u02c6E\x9f|\xb1w\b\x8b\xd3\xd7\x19\xa1\a\xd6A\xc4=\xb5\xc1\b\x1b\xa4+\xad`E\xd0\u072a\x9e\x05\xb8\u052e\xde\xcc\xfe/)\u01f2*E6\xacb\tgn\xebYc\x05.r\xa2\xba\x17,W\xed\xc7\xcb \x8a\x1f\xdb\x0f\xde\xf2$o9\xab\r\x92\xba (\x81%C\xecb\xb6\x1d\xe6\xce\xeb\x1e\\:h\xb3\xbbj\xabK\x06O\b\xd0k\x9e&\xdb\xf1\x9anC\xef\xed\xda\xf0Fp\u0129\x1f\x97&~\x0esS\xb9\xad\u0463\xed\xc0\xe0Y\x16\xdboj\x8d\xdd\x12i\xf9\xa7\xa7\xf8\xa0_\x98\x821|\u0205#K\u076a{\x842bH\xf2\u039cy\xe5\xe1\xe0\xea\ua691<\u064e\x12\xc9\xe0\"\x97l1\xc7X\xe3\xef\x9b\xf5\"\u01d4|\xf3\x19\x90\x15\f\x0e-\xa7YL|j\x9b`\x9f\u0094kj\x03\x9f\x96\xb4\xbd\x99\b`Yd\xd5d\xf0\xf2sm\xee\xea\xe3\xcd\xdaW4`;\x81@\x9cz\xb5\xb1\x12\xd5\xe8\x0eY\xc1\x17#\xfa\x8bz:\x90Jv\xf9p\x90\x86|z\x90\u010f\t\xed(\xbeU\x84\xe7\x7f\xe4\xcb\xc2*\xb0J\xd4\a\xf6\xc9j\n\xadF\xe86\xd8\xe9_\u068e\xe6\xc4I\x9d\x8a)&s\xf9(ha\x94\xe7\x87n\xe2\xbft\xfa\xb4j\xf6W\x1a\xf6\xfc\x96,\u1c66-^\x02\xed\b\xaf\xb0l\xc8\n\xba\xdd\xc2\xe8\x12\xe7\x91/Tc\u0082L\x83\x12-W\u0589\x15\xeaL9J\u01acRs\x82\xeea\x81\x83\x02!3\x9c (\x01\x1d\u070c\xff:\x84*\xb3\xea\xd4P\xe5\a\xa8\x12\xa0\x7f\t\xb0@cqC\xf2\xedf\xe6\xe5\xf2\x04\x0e#\x96P]\xa2\xc0\x94\x16\r\xeaq\x00\xfe\xb2yY\x92\x95\xa9\u05c2\xda,\xa3\x87I\x1c\a\xd3\x14\x9b\\\xa8\xe6\xc6\xe8\xdfy^s\x00\xa7q\x04\xc9\xebE\xfb\u0098N\xdc \x8e\x85\x1c\xc8=\x82\xa6\xb6c\xa7r\u0565\xb58\x03lL\x81\xe9rO3\xcd\x1c\xb5X\b\xa5\x88v#\xc6s\x1c\x8b\x84\x7f\\\xfa^,f*\xa5\xfa\xf7\xbd\u028a\x8c\xf3\f\xb9\xf6\x85\xa8\xeb]@\xb0y)S\x1b\xce\xdc4\xa1\xec\xc7;\xdbnF\u018e#\x82\v\x19\x06\x1fFY\xe1\x1fj\xdf\x03F4\x99Mk\xdeZe\xc3\x10\xd5\xeb@\xa8Js\xb4\xbaX \xab\xe0\xa2f\xa7 \vT\x03&\x13n\x98.\x0f \x87\u010b\xfb\xe6\xab(\u0126g\xc2 \xd7Y=\x84\rs\x8a\t\x0e\xbd\x18\x05$\x9a\x04\f\x87\xf5\xc82\x95\xddT4\xd3\\r.>\x90$4\xa8\r\x12/R\xce~\x85\xb0\xc8b\xc1s\xfd\xf3\xdf\x15\x8e2&\x82O2\xa1\x93\U000f0992\x03:\xc4(\xa7r\xce\xceL\x90\n\xeb\xeb\n\xcbu\x95\xdf\u0350,\x1a\x1c\xdf\u0478\u07b1\x9cW\xc7W\xb8\xb6\xe4`\x15\xb0\n\xe1g\x11\xf3\x82zW\xf7B\xe7\xdf\xd0(\xf7\x91\x980*\x97\x91\xfc\xba\x84\n\x02O\x96\xc2\xf2\xedO\x88]z\xc4yf\x1a\xe6\xb3\xe2c`\x1b\x96%<\x95\xb3\xd3\b\x88,!\x95\x02f\x94\xdeh\xdcx\xaa\xa6<\t\x92lH\x9aY\xaa\x8ad\xf9j&=1~|\u0517\b\xfc\xb8\xfb\xb5@nj\xb1}\u2ec7Rl\xf3^P\b\\\xfby6\x99\x02R\x0fT\x04@Qj\xdf\x12\x7f\x96(\\\x9dBP\x01\xbf\xde(\x8e'\x04\xa6\xe8\xae`\u0171{`\xb8\xab\xa3-\xed:n-\xb6\xdd\x03\xef:^\x81d-\v\x1c\xbfm\xea\xb7\u05d3\x105F\x85\xb7\x1f1W_L\xeb\xab\xd12\u0629a\"\x1fA\x89_\x83\x04\x1fO\x7f\xc5q\xac\xd2b\xf1~\x91.s1h\x92\u04e5\xa2<a\xb0\xb51\xcf, S\xce\x03\xfeY{*\u074b\xee\xa5\u02d2\xf70E\x87A\x8am\xe7\xd9\xef\x05t\x0e\xaec\x92t\a\xe6\xc41)e3\u0558/\x17\xf28\x7f\x9c\x93\xbfb\xe6\u0495\xd4\x19\xea\xe1< F\xa4\xeb\xf8\n\xe0\xb9\x05\xec`\x1e\t[\xc8Q\x99}\xb9\xe1\xe1\u033d1\xf3\x06|\xb2g\xe9>.5\xa3x\xad|*9j\x1f+fgJ3z\x94\xa0Q\xb0\xfd\xeb\xa3V\xdb\xe4\xa7\x06\xeb\xe8\x99\x18\x11\x91\x05<Z\xb7&9\xb8\xfee\x14\xc8\x02\x9ef\x91\xab\xfc\xe7\"xH\nm\xe0\xf7\xbb\xa2\xd2B\x8f\x17V\xfc\x10\x92\x80P\xbeT\xeb\xf0Hd\x90\x87\xccC\x98Y\x9c=\x86D\x89\b\x1b\x82(\x9e\xfa\xaf\xf0\x84\x84\xc1\x03\xe6Q1\x82\x114BH\x809\xbeb\x1d\x81WZ\x99L\xa5\x99\x1c\xe6\x05\xcb\xea\xf0\x12\xe1\xf2\xf1\xaf\xf1\xf4\u01c3\x85\xe6u!\x88u\x91w\xe2\x03\x9c\x89\x16\xa2\xc1\x1f\xce\xf0&-(#\xb0\xe0m\xed\xee\xac;\xe8N\x18\xf0F\xc4A\xd7P\xba\xdb\xed\xad\xaf;\xe8F\x14_\x13\a\x1d\x11\x9e\xc6mws\xc3A\xb7\xa2\xf8\x888\xe8\x8a\xf8\x17\xe6p\x16\xc7\xe9\x80bLL\xc4/\xccKt\x067\xc6 \xac#\xf3\x13\xc6\xd3\xc3,\xe5\xb69I\xae#8B\v\xe5\x123\xa1\xbf\xe9'\x96LMd\xc6\xc0#\xcc\u02dc\x15~\x82\xce*\xbe\x87Q5$\t\x11\x8d\xf31p\xb7\xe9\xee\xd3\xef\xd9>}\xf6\xcc!\x00\u02d4s>\x9aq\xbe[\xc29\u0774\xb0\x1f\xd5g\x86/\xf0{\xf9,\x16\xc9|\x0e\xbe\xf5\xc3z>1\x88\xc8KD\xfd\x1b\xdeyt%\x8cW\xcci\"\xe8\xcc\xf1\x83\x8a\x1e%\xbe\x99\x8a\x00\x15\r\x11\xe6\x99M\xfaf\x87O\xbag\xb6\x88\xfeYewD\xa4U\xa2:O\xf8Dl\xaas\x1f\x91\xae\xbe\xe6\xacrH\xfc{A?\\Z\xe7\x04\xe4\x99\x1dEI\x92\x90<\xb3\x93\x91\x14\xa7(\xcf\xec\x8c\xc1\xd5\xed\xe4\xdf@\x83\x1c\x85\"tS_5(\xf1m\u628e#\xe6\x8a\xee\"\xe6\xca^\"\xe6\xf2n\">\xb5\f\x9d\x11\xa1\xb0 \x96\xb5F\x9b\xe77\xf3j\x14\xfd\x0f\xfc\x8b\xcbL\u0108\x8c\x88\x18\x87\x040\xbf\x05\x14l\x1e\xd0\uc383\xf4\xe4\x86(e\xb6\x84\xb8!(\x92AA3\xff\x90\x80\x94\x011\x10PK\xdeAq?\u05ba,\x9f\x8b\xbf\xef9\xf7\x81\xf4&!\t\xc1\xe6\xb3\x19\x8f{f\x11\x99\u197a\u00cd\np\v\xa9\x92A\x12w\xccg\U00073673\\\x12\xcbR\xc5\xe3(\f1D\xbe\xc1\xf7\x06\xfe'\xa2|%\x04\u0792\x94s\x92\x9c\xe4\n\xe2\xce\xeaD\x9c\xe8D<h\"\xda\u07ff!\xaak=\x9a\xabni$Y\\\xa4\xba(\xa5\u0291\xa9\xce4\x8d\xab\xf6\xf5\xbf\xc1\x00(\xdd\xd5\x13\xc7@\xbe\xde4\x04|k\x7f]\xdd\xda\xf3\x9aMSn\xcb\xe7\xc4_E\xdc`\xf8\x96\x05\x14\a&\b\x18\xe8\xedj/\xc9@hx\xe3\xdd\x17\x84\x1b\xa3\xe0K\x02\x8e\xcdZ\x8fYD\xb38\u0480\xc3c\x8a\x87yd\xebd\x16\xb3h\x1ac\x94\xfap\x1c\xbb\xc8V\xab:S\b\xd9+u\xf6\xa3>\xf3Av#\x0f\xab\x81\r\xec\n\xc1\x12\x879F#ix\x92\x93\x91\xc0*/\x1ed\n)`\x05\xb1\xb1\xa5\x83\x02\xcb\nl\x11\xb9\x1c(Y\xb9/\xffz8\xebP\xff\xc24/a'\xcb\x12\xa2\x8a'\x82\xe5W\f~\x93\x9f\x85\xc4*9\xe9\xf2\"\x85\xe2T\xea@\xd6\xdd/\xc8\x19\xc2}Qp(\x02mx\xe6\x90l\x12\x03u;\xf7\xe1\xe3\xf1u\xca\x12\x8cR\xdfV\x89)\xb1\x9e\x1cD\x9d\x8f2gZ\xc8S+\x1f\xe4\xf3\x92\xfc|\xa6\xdff*q%Sb\x9b\xb3X\x94\xebka\xf6\xf2\u0511\xaf\xd1\u06a5\xaf\xfa\xe5%rx\u0486\x83Fn\ua396(\xe87\x1f D5p\f1;\x1d\x15&\U000c67fe\x95s_=\x92h\xfb\x99\x84\xc9\xf6Ip\x0f`\x02\xd9O\xa0\xd4f\xc6\xf5\xae\u02b8\xb2^+4\x93|2\xf3\u0318\xa7\xff\x06|?\xa8g\xf9\x14\u01deI\x92d\x8a\t\xa6\x06I(\x1ebJ\xa1\xe6\x156\x83\x98\xe7\xb7m\xda\r\xdf\xd4kS\a\xe3(\x0e)&\xabk\xcfI\u0499\x04t$\x02-@W\x86\xb8N\n\xbd\xf8W\r\xfb\u06c4\x1dH\x93K\xf3\fhC\xa6L5\x95\xb5\xf0Fi\a\xb5\xe1\x95\xc3S\xa3\xea%\x890\x80Ka\xf6\xa9\xba\x11\xd9r\tc\"Z&\xd4\"\xa5\x99a}\x9b\xfa\x042\xda\u0529j\xdfd\x9auG\xac\xff\xca\xd8\xd8\xcd+\xedEu\xa5e\xf6,Xi\x99AK%c?~\xbaj\x11%\xb5\xca\u0160z\u010e\xb4#v\x006\xd6\xee\xfe\xec\xfb`\x7f\xf6\xec\x99\x13]\xcc\xf4#\xf6L\x1d\xb1\xedd%\xe5b\x04;o\x8a\xd9y0\xe2vS\xff\x13\xf7eN\xdc+\xad0\xe3zR\x94h\x8a\f\xd7\u07e9n\x84\xc0'5z*X\xa4\x85\xc9\x11\x1e\u0248\aJN\xa6_\\\xcd\xe3h\xb0\xea7\\6\xa6\u04ca1=\xf1\x8b\x96t\x0e\r\xc2\xe0\x9c\x1e\x9e('\u00d4\x87\xb0'\x05\x1b\xfb\x13\xe0y*\xc0\x89sL\xe7\x11\xbe\u0251{Od\x89\xe9\xf0\r\xab\x15i;QF\xd4\xcc\x172\xe5\x8eQ\x17Z\xbd\xc1(5\x11\xbbD)\x0f\xc6\x17\xa6\xf5@\xf7\x96j\xe0\x92|\xec\xb3j\xccg\xac\xc9\xd0\xddl\x87\xa6b6\x03\xb4\x96\x16\xa1\x0f\x81\xbb\x1bz\x13%\xfbM\xfbf\xc7\xf4\xccg&bU\xc3I\xa4-\xe4\xb4h\x98n\xd15\xaa\xbd\x81%\xe2\x10n\v)[\xba\x9f2\xe5\xa5\r~\x11\x11\xc8M\xfaPB\xca\x00\x14k\xe3v\x91\xa2Y\xb3\xf7\u038f\u048e\x1c\x95\xddQAw\x9a<3;\u6cc0'\r%^\x8c\xc4\xe2x\r\xa8\xc9\xea\xe7;\x8a\x87\u046d\x97\"\x9e5\xca\xfc\x8f\xac%\x9d,\x9am\xe6df\x7f1\xb8\u02f2j\xb4p\x89\x02\xaeQ\xfe\xbeW\x13\xf54\xde\u022c\xb0o\x13#\xef\xb5\x11\xe2aDp\bz\aXLkF\x030\x13\xfa\xf1\u0249>P-\u0712]:\xfe8\x8erq\xccy\x9a,X\x99\x9fe\xbe\xac+r\x0f9\x15\x88h\x17b^\xf6\xb9\u00cd\xfa>\xb8\xed\xb2K\xb4&\x9e\"O\x86\xfe\n\xf5P\x01*?*<\xa4\x81\n\x9aH\xed\x94\xc8\xdcbI\xbdK\xa8\xee=\x02\x1a\x9f\u031d\x94\xaf\xbc<8\xb2\u03d94\xe1\x8a\xc7\xe5cr\xa2\u05e5\x00\xbeN\xe2P\x18\x12r\x82%<Ed\xc1\x1e\xb8\x92E5\xaf\v\x8c\xaaM~\x17/AE>\x17\xa0JfX\xc0X2#\x12\xb1(\x88\xc5\xe5%\xfa\xfc\xe7Kt\x8f4\xc3\xe9\r\xb6,\xfe\x05\x97k\x1bN\xf1P\x1c\xb4\xfc\x86\xa7\x1fE}e}\xa9-\x8f\u1a08i\x87X\xe1\x13J/\xfa\x92\xb4(\xf7\xa4nD\x1d\x06\b\x9c\x01\x86y+\x98\xa6\rP\x1d\xf3\u0672I\xc8\x7f\xff\xad\xdb\x06\xc6I*\x10q\xae\x83\x14C4\xdf\xea\x0eg\xf0FgF\xb9[\xec\x85\xf1c\x90b\xe3\xfd\xe9\xb1\xc7O \x04\x99\xc6e\x83\xff\xf6\x1f\xdf\xfa\x98\x84\x01\v\x10)\xcbS\x9c\xb1IM\xd1\x19\xa6sL9\x87\x9b\u0458\xef\xc1Z` d\xc1\x1cc#\xc4s\x1c'\xdc4\x1e\xf9\x83\xcc\xcd\x05F\xcc\x01\x95q\xb12\x8fBV\x1dU\x11\x9e\x04\x11<6\xf3\x89m\x1e\xf3s\xe4\xea\a\u0088\f\x93\xab+n\x9c\x190\xf0\x92m\x8d\x17.\u07daI\xae\u0303\x18\xdfN\x1c;r\x90Puy\xe6\xd5u\x1c\x90O\x10(\x8eL\xa3c|\xc4\xd7i\u0138\x87HjY\x0fVdB\xa7X\xe2e\x8c5\x05W\x8f\xa8\xafP\x14\x83(\x06\x97\x8f\xecv\xe0x<Yg0`za\xc3R\xfb\xf0\xad\x93U\x1c\r0I\xf1#)+\x9b\x7f\x14)\xe2\u0228\xecX\xd4h\xa2t5\xfaz,\t\xc9\x16\x83St\x9d\xa3u\x89*Pq\xb6A\\\x8e\x1c\xafa\x9f\xe4\xa4\x175L\xe5\xc7o}*ajH\xad\xf7r\xfbZ%\x0f\r\x1as\x96\xabH\x16\x92\xeb\x02\xabu\x9c\u0194w\xaf\xbe\xf5a\x04*CD\x8e'U\xbbQ\xa2\xedF\\m^X,\x91\x8f]|\xcb0%A\xfc\"\x19@\x9cie\xf5\xccd\x95\xb1Z1sLS\x11\x171\xf0Y]\xb8\xc4T\x15\x8b#\xac\x83\x86\u068ab\x98N\u0493!T\x1e\r\xe0\u6b32\xb8 \xf2p\xac\xeaP\x9c\xd7Aa\xee\x11+V\x92\x83&PqdY\x91\xbeZ\xeb+\x9c\xfb\xf9s\xc5\xe6\x8e\xfc\xa0\f\x12u\xe7\a\x8a\xfa\xd05\xfc\xfe \xfa|\u0182\u0254'\xdf\nl\xf35\x19&\xef\xf9\x17\x8f\xd4\xe5\x8fr\xa4\x1f\xbb\xc1\xac\xea`>\t\xa2\xda\\T\xe3\xf5\xe2sJw0Equ+\xb9F\xf7r\n!\xf6\xd4At\xb1H\xfa5\xb9\x13\x80~<\x8a\x14\xf5\x004\x8a\xd0\u0391j\x9d7%@\x83\x00\xcdh\fq\u016b\xb8;\x16aS\xca\u03cf\xb2 \x140\x06\xa3\xa1e\xad6\xa6WW,\xa9\x8b\xa8\xba{\x88m\f\xc1e\xf2\x1c\xc8\xd4H\x86F\xaa\b\xd5Ac\xcb\x1a\xd7;d\xfdA*\xfd\a\xb9\a@\x19J\x94\xa8\xc6E\x8cdhYa}\x8d\x1f\xaa5J\xb2\xf7\xc2\a*\x9d\xf4k\xba\\\x19\x1c|\xcb\xc2d\x90\x9a\xa8}0&\xce\x12\xcd\x17\x8b\x89\xf2\x0f\xad\xdba\xbe\xfb\xd6YcU\x05X\x11\x19\x8a\xe1\xf7\xa0w\x80a\xb2\x1d.\x8e\xcehl\v\x91A-\x06[\x88\x97\xb0Dx\x80\f+\xb0Q\xdb\x11y\x04\v\xd3\xc4\x11p\x89-\xd6\xfb*\xaaB\xee\xff'#c*S:@\xf7P\x91\x97\xf0\xb9\x0f\x10_\xaci\xbeX#\xa47H\xa1P(b\"e\x12\x8a\x97m\x13\xfc\xf3\xb7gsj\x8c\xf6\xfa\xe5\xdf\xc51D_\x92\xc3$a\xe0\xc0^?\xfc?\xfd\xc5\\\x9d_F1\u00f4\xd9q\xa1\xec\x0e@k\xed\x02\x02\xccH\xd4e\xb3\x16\xe7\xe7\xafo.h\x88\xb4\x83\x93\x82o\xca,\xfa\x10Y\xc4\\y\x01\xae\x00\xb3\x94s\x13s\x18D1\x0e\xebo\x83\xc9^\xe2\x1e\u024e\x01\x9f\xb9\xc8U\u041d!/\xee\b\xc5J6\x15\x81eI\xec\aU\xbd\x83\x92\xbcL5\xc9iS\xe8I\xab\x7f\xb4X\xac\xf5\xc4_s\x18\xc4)\x86\x96F\xf5XK5\x84,\x9a\u05d6\u074e\u05bc`(w'$\x1d\x11{\xeb-)\a\xf2\xd7\xd3\u070d\x14M\xe3`\x80\xc7I\x1cb\xea\x99b\xf4\x8c\xeb;\x03l\t\"\xc39w\xef1+\x8e\x04:5J\x1aY\xeb\xca\x01`t&\xfbo\x9a^\x94;\x1b$\x8d)O\xd0o\u012f\xc9W\xf2\ub7dc\x88@C\xfaJ\x1b\x96\x98@\xdc\x15\x86Y\x05\xa2K\x85Gz\x0e\xf1\x8a\x02?s\xfd\xa00\b\x14E\xfe\xf3\xdbI\xacr\xd6\a@\xa0\xdc\tZ+\x99\xf9Q\x9fi\x18\xa1W\xb7\x13\x88\x05\u04cbD@j\x0e\x82\xac\xdc\x18\xd7f\x96\x95\xf6\xcd\xfb\xa5\xe9\xcdj\xfc\x80\u2943r\x80_\x1b\xc0\xe3\u04ffOb/\x02d\xac0b?&\xb7<_\x85\x00W\xd7\x00\xb8\xed\xc4My\x04\xb1m\xf2\xc64\xbc\xe8\u057d\xc1\x1f\xd6k\xaf\xe0\xf9\xcb\x00\xe6\a\xb2\x0e\x88\x11\xe7\uc0f9C\x02\x12\x06\xa6\x02\xec\u05f1\x89\x04\xc4W\x92A\x86\x94O\x11n\x80\xc8o\u0305`\xd6\xc7W27\xeb\x06\xf7\x86\xe5#P3\u02388&HXY\u0120\x00\xa9\xd5\xe5\x8eh\xf8\xb0\x9d\x94\"G\x16\x8b\u07c8c\xd7f\xa0\x10  '-\x1emj4K4J\n\x14\xc9\xfd\xd5+\x0eeY\a\xa8\x9ay\xa2\xf5R\xba\x8a\xe5\xe3S3\xb8\"1\xcak\xf5D\x11\f%\x8f\xb3UcY\xe3\xf3\xa6Q\u045a\xfe\xad\xa5H\x9f\x9d\xf9\xabi\x8f\xf5P\uee14|\x99MAg\t\x1a\xef\xd0<\x89\xbf(h\xa7\xed\x1b\xf8\xa9\u05b0\n\x927\xcd\x18WR`\\Ae\u738a0\xf4\xa9\x12\xfa\x05v\xf8\xac|\x06\x88\x01\xc29K\xa3=\x80\xabs|\xcb\x0e(\x0e\xb8Fc\x06\x0e\u0423q\x1c\x8d\xc6\xec0\t\x85VcV\u03b5=\xf6\xed\xa0\x1f\xb4\x82;G\x10\nJ\x9d\x02``1\xbb \n\xfd\xa0\x06X;j\x03\u059e\xf8\u0148,>Z\x96US(\x8e\x9b57\xbcB\xd8]V\x8c\u66bb \x1a\xf9si\x1c\xba\xf3\xe7\x1a;\xb9\xd6#T\xed.\xfa\xc0\xdc\x13\xc7\x1e\x01\xf6\xf1\xb5o\xf2\x18\x99U4\x01y\x06D\x13Ua\x90i+\f2\xd5a\x90\xef,+\xa9;3\xd5~\xeb\xea\x8aK\x06\xcf\xecqv\xdc2\x8d\x88pX&n\b\x95\xd4:*\xc9\x0eE\x86\xb5t\xbcQU71m\xfc\xa6B\xb4@q@F\xb3`\x84\xbdk\x1d*0U\x1f]>n\xe0:\xd2o\x17\x18F\xffQ/\xe20b5\xf2Z\xac?\x7f\xd7\xe7\xe0\x03\x03\x9e\x9d\u0368\u93a8EB\x14\x84\x11k~\xa6h\xa7.q\xd7%\xba\xeb\x9b\"\a\x9c\xe9\x99P\xa8\"U\x9b\x13\\\x8d\xd9$~\x99P\xce+WK?^\u0322Y\x1d\xe7\xa1\xf2\x1b\r\x8b\xe1`\x13\x8d\x10\x1e\x9b\xc0\xb3\xe1\xe3%\x81\xb2\xa8|\xe6nl\xbf\x16\xdd\xd8`\x1a\xd5\xca}8<M\"\xfc\x17\x1e\xbc_j\u89bf\x91*\xfc\xfeo\n\x17\xf0o\xc4'\xf6\xdezoc\xcbA\x7f\xff\u0595=\x92?\xe6j\x1e\xc1\xf1\xb9\xd8\xf57\xa2n\x9f\x91h:\xc5\xec'L0\rXB\xaf\x063\x1a_]\a\xe9\x18\xec\x06(\xf1\t?\xad\xbdf\xb0\xfb#3\xbd#,\xb8}\xa5v\x00\x81\x1d*A\xbd\xcbTs\xc2\xdcp\x03\xddg\xcb\x1al\xbbc\xb3\x10G9\xa3\xb11\x89\x064\u1d59(ew1\x86\xd99a\xee\xe1\xbac7}\x96\x8d\xf1D\xc4t\xd3\x1acP\x1e\xbepOq\x10J9\xb1\xfca5\x8e\x8f2a\xc1\x8b\x9dA2\x99\x04$l\x028\x91\xc9\xed\x85\x12~\x85*\x93\xe9]\x87%\x9dA\x1cM\xaf\x93\x80\xd6\xd5\xfb\x86\xb9\x87\xc9\xf4\xee<9T\x0f\xa1{\xe8\xa5G\x97\xcdH)\"\x9e\xbd\xf5\xac\x1b4\x1c\xde0\xfd+\x06\x95\x97\xa3\u0279\x90\n\u01ce\xda\b\xf2B\x15\u0653\xb5\xe28-\x1cn8\b\"\xcbA\x10u\xd4=\ud2c8\xa0\xa4E[S'{\xae\x9a\xc1Q$\xd8\xc0\xe9~\x16\x82\xa1z\xf9\x15\xe3\u04b5\xcfIU\x8d\xf8\x8aee\xb1\xe8\xb2\x19Y\x18z\xd3+Y\xe8\x84\xd6R\xf9n\xb5\u014f\rz\x94\x15\xf1\x93^\u1acd\xab\xbe\xb2\x91\xca*\u0315\u0328\xf2\xe1\xdco\xf5L\xbe\xed\xb4D\x9f7Du(\x0e\x95a\xbf\xf1\x82\x16\xec\xb7\a\x835\xd4\xc9\x16\x86\xb2.\x80\xbc\x96\x1d0\xfao`\xd8\xd0q\u00ea\x99\xb8J\x80\x89$\a]\x95\x14\xe7\xf0\xd8!9y\\\x05I\xedl\xe2\x1dM\xc1\x18\xd4\x1b\xaa\"t_\xa0./A\xf2m/@Z\xfb \x84\xab\x19\xa3\x8d\xd0/\u040f=F1&\xe4L\xe5\xb2\x1d\x16O\xf1\xb9\xb2\xe2\x9c?fY\xe5\x92L\x9f\xc1\x13\r\x01\xe1\xa35\xa5<\xc0\xb7\u04c0\x848t\x90\xa6\x06\xb8W\xa5^\xe5\xb9Z\x05\xceq\x12\x84%^\x1b\rm\xad%%mtY3R\xba\r\xf3\x7f\x06rF|\x9ep\x84\xf2}\xc0@\x8cR\x9b\xa1D\x03G\x05\x93\x11O3W\x1a\x1e\xdbAI\xad\xf2\x1d\u42fb\xf3DTm\x97\xabB\xfc\x90\x8e\t\x93D\xc2\xd3\xd1\xc9xh\xf9,\x8a\xfc \x1b\t\x94\xf2\x13\xaf\xfc\xa8<\xd9\xee\xe7:\x15\xa1\x16\xc9F2B\xe5g\xbdt\xb1(\xa2\xc6T\xaa\xfb\x12\xfdI\xbd\xb3_\x14\xe23\x1c\x0fO\xc8\x11o\x18_\x80Y\x8f`\xede4\xb2\xcf,\x8b\x94\x80T$=QD\xbe\x82\xdeET\xaa>\xbe\xe6k-\xa9\xc4#f\xc3X\xa0\u0127\xe9g8\xaf\xe7\x1d\xe7\x1b\x01N\xf7\xf5\x80\xa4\"\xb9\x17\xfa_\x1e\xbc\xfe\xc3\x1b\f\x11(\"\xab\x85/\u057f\x8d\xc0\xb4-w\x1aXh-B\xa4\xc4EQ\x8d7\xbd\x9a\x1e\xe9\x925\x9f\xeb\xceur\xab\xa0Tj\u03f7\xd9\xc2Z\"\xb6Zr\xa2$\"L@[\xad\x86;\u015b!>f>\xb3k\x1a\rJ|\xd3\xc8V\x87H\xdfR;]mgh\xed\x85\xf2Bs\x1c\xfdn\xed\xecg\x13\xd7\x18\xb3Eh5\xac\xbb\xbc\xe6\xcd{\xd7u\x97&\xca9l\x0fq\x82\x14z\x02\xb5\u010a\b/e\u0083\x97\xb2\xdc\x1b\xc0\x1b9\x88\xdaE\x06\xe8B)?\xfa\xae\xef\xae;(\xa1\x1c\xa3\x85R\a\x05\x7f\xd6~\xc5\x0f\x9a\xf8<\xb8n7\x94\x86\x01\vR\xcc\\\x02\xdcF\xdf~\xb2\xf7a\xff\xad\xe7\xc2\xda!\x19\xf8p\xaeT\x9d\xf9\x91\xed(\xae\xfa\x06(\xeb\x94s\x87\x88\x8c@_*\xd7z\x86b+rY\n\x12\x94\x17v\xecg\xb7\xb9[k\xb1 c\xedy+\xe3\xaf\x1b\xa5\x9du\u01b2\xd6\nA\x92Yq\x81\x7f\xf2\x86U8f\xde:3\x87\xa1}\"\xc7,\xc8c\xf5\"\x9b\xcc\x12\x98p&\xce?\xc7\xd5\xdb\xf9\xbcDE\xcdF*k\xe1;/(\xb6\xa5\xb1\xe8T\xea\x00P\x9c\x97e\x06$4\xa8L\xaeX\x10/\xf0\x94\x8d\xc1\x85\xcf6y\xa9B$\x03}7\xab*\xc1\xc7~Bm\xc7\xde\x02aZF>s_\xf6\xedM\xee\xb1\xd7rs\xd2vs\xdevs\xa4e\x91]]\x85!Xc6\x81Un:+E\xaf0Xt4\x89\xc5\xcf8J\ubd23f\x1c\xd5\xe4\xd6g\xc1u\xc4\xf0\xc4D\x92vr\xc2\xf1}=\xfa>\xa3\xac\xa5#\xbf4\xa58\u0144\x05\r\xcep\xe5\xfd)\xc7\xed\n%n\x97r\xec1\x1f\xfc&*\xf6\x15\x02\x81R\xa5v\x17\n\xf7\xac\x06\x88W\x1b\x177\xb4\xbc\x9al\x88\xc0\x95\xbd\u03d5\xb5\x86\b\xb2\u0270\x9d\xe55\x1c\xfa-\xeb\xa9C(\x18\xcb?m\x00\xe7\xd5\x01l\xfd\"\xaai\xb1\x1c\xc3\xfb\x88\xc8F\aK\xa78\xa2\xa2J\x18\xcf\xc9*\xe39\xea\x8bct`zb9r`\u0307f\xb6\xd1ER\xf4P\"\xf9x:\xe7\xae\xed\xa1\x86\x04\x17\xe3\xf0\xfa\xce\xf4\u01b5\xf4\xf1. \xb2Sa\xde\xfa\xa9(d\xc1\xf5k\x12\xe2[\xcf\xec\x82Ib\xb1hT\xb2\x9b6I\fY\xa3\x11\xa8Du\x8e\xa9[E\"82\xb5\xcf\xcbc;\xef\xaf\xdc\xf9I\xcdT\xe6]\x9f?\xd0\xf5\x1a\u02d0\xcc\xd8U\u02bb\xc6\n\x1d.\xa5\xf9\"\b\xe7lZ&\x01\xe3\xb2K\x8aJl\u07dbU\xd3\x7f\xc5M\x90\x14(\xfa\x8bi>\x85\xecW\xf1G\xa8u%\xb3\xac\xdab\x11\xab\xacn\x01\x1c\x1b\xdfa\x9f\xeejV\xd5\u0455\xdcP\x99\xbe\xad\x83\tB\xad\xf9\xfd*\xceC\xe9\xa0oY6\xf6\x9bog\x01\xcb\xda#\xaaG+\xf9hg\xe7\x9b\x1aJNj\xd1>4\"\xa6\xb9\x8c\x8eQ\xc8)\xb7\x97\xcb\xe7\xf2@&\xae\n\xe4]9\xdb\xf2\xe2\u0162\xdbH\xc6)\x88\xeb[\x1b;{[\x0e\x9a\xfd\xa5Hz$u\xc4A\x16cP\u7022g\xbc\xd1'_\bK\xfd\x8b\\\xdd\x01[\xbd\xe0?\xa9y\xe9]\x98<\xc8;\x12\xa6\xe3\xcbe\xcd\xd7\x0fK\u7bfa\uf6c6\u0650\xb1\xa8a=\u00b8\x12\x94\xec\xdb-+P\xa4\x90\xcc\x00\xaa\b\xe2Y\xf9\xa8[\x19\x11\xdbq\xd0\x05\x86\xccn\xf0\x9fe\u90d1+\xae\xb8\xf9\xef\x14\xa7I<\xc7\xe1\xd9\xec\x9aQ\x8c\xf97\x92\xfc\x1b\u0243\u07e8\xcbL\x04*\x05\xbe`\xcb.w\xb8\x89\xc3\x14\xd5h\xb5\x9fC\xb8\xf5S5\xba\xc2<a.?&\x1c0F\xa3\xeb\x19\u00f6\xb6{9\xfb\xab4H\xa4\xce\xcdF\x85\xb5\x8f\ndu\xc3\xce\xf2\v\xb9c\x15\xf6\xaaxB\x02\x1dv\t\x9a\x83T\\t#\x9f\x14;\x86f\xea5\xc1\\\xc1=_\xa3x[\xb8\xfc\x80\xa3\xcf\xc0\r\x93\x01?\n\xa5QB t\xa9pHJ\xb5S\x12G\ue3f9Z|\xb1\x18~\u07ed`\xf8\x8f}\x95\"\xb22\xab\xa1\x1fd<y\x8c\x86\x90\x91\\\x804\xae\xf9\xfe\x14\x0eG4[\xb5p\x18\xaa\x1c\xc6FE\xe4\x11t\x97=\xa3\x15^C\xe1\xcf\x1c=[p\x8c\xb5\xee\n@\x8c\x1a\xdb\x0f\xfb\x82\xf1\xa7F\x94v\x92)\x97\x9b&\x92\xc2K\xba41:m\xa6\xe1\xd5umU\xcdZZU\xabU\x99_$\xf8\xc5\x18\xad\x85p<o\xd3_M\x94\\\x9d*\xc1\xba\xdeN5\x1f\x99\xe8\xfe&\n\xd9\xd83\u05fb&\x1ac8\xfb\x8a\xdf%y\x92\xfb\n\xa3a2\x98q'aOzO\xd7\x1d4Sl\xa2\xfb[8,\xbc\x82Q\f\xfb\xe6\x7fp\xac\xd1N@ir\u04d9MM\xafX\xc2#\u04f8\x8bH]xT\x06\x82\x12*\x83Y\xbcR\xb2$\x82b\x95.\xa9\xe7@\xaa\xa4\x81_\xcfk\xc7\x0e\xba\x88y\xce\xc6LC6\x00\x12\xa6|m\x96y\xe9@\x90/\xdc\xfa9\x85\xf9\x91\x89\x8f\x06@\xb5?j\t\x98B\xa7\x1fz2'\x93\rAu\xfa\u0349\u04dfh7\x8f\xfcQ!\xa6p\xb1\xb8)]\xc7\xe8V[S\x03\xc8]\xb8\x7fkY\xe0\xb9<\x92h\xe17Y\xf6\x7f\xb6\xfa\xd60\x10\x02\xc3U%\x8d\xc1\x1c\xdd\x03/\xf5\xe2\x82\xcc2TyxG\x8bE\xde\xfc0J\xa7qp\xc7)\xfa\b)\xc9K\x97\xdfK\x19\x8b\x93\x92\xdcO\xf5C\xc1\f\x95\x18\x9e\x17\xa0\x02\xbb\xf3\"%\xfb\x9fj\x8e \xb5N\xc4\xe8\xcc_Y\xaf\\/\xfe5=n\x88\x97\x94=\xf8\xc8yHY\x13\x85\xeaCY\x80z\xecT\x95\xecyp\x01\xb7\xe6*\x06!\xf9W\xe1]\xfd\xbc\x16\v\x8e\xa5\xb3\xab\xd5\xfb\x92v \t\x01\xb8\xab\xf0T\x17\xd5\x17\xaf\xd1}>\x95ua\x8f*\x06\x0f\xa7\xfahV-Y\xf2\fP,\x85\xbe\xe4\U000b2acbTR\xe5}V\"\xb1\xcc\xf6\xe4\xc5:\x8d=L7U\x05y7\x97\xde\xf9>u\xbbDW\xce*\xf6s\x00\x1bN\x99\x11\xd3Zl\xbe\xb9\xf4\u076e\v\xa6)od\xd4ou\xe8\xe3\xad0.xj\v\xe9\xc1) 3\xf6\xdbq\x9a\v\x96\x16\x9aL;<_>\xa4c\"\xb3\x89g\xd6\xecXT|\xb2:\xbd\xa4\xc6@A9\x96\a4*O\x96/\xda\xe5\x88\x1c\x16\x03\xeak\xd9\xe08\x99\"S\x9bG\x13\x99Qz\x8a\x87&2\xf5\x01R\x97\x9c\x19\xc0\x1b\xc0xL\xfd\xd4kf{)2\xd5\uc6d7hJ\xbf9\xa8nD\x10\x05#H.\xf2\x81qB\x8c\t\x8a\xfc\x84[4\x10$&\xd6\xc6\x05\xc5~\xe2\xf2\x91A\x03\x91\xb28\x17\a\x87\xaa@\bzcx\x13\xc6\a\x94\xd1\xf9)s\xee'\xb9\x19o\x04_\x94\xa3\x84\xee\x04\x96{\x82\x06`\x8a\xf1\xefJ\xe2\xe7\x8d\x7f\xa7\x9fE\u0451\x7fW\u0477\xdf\xe6e\xb9\xbe\xfd\u02bf\x96\x12\x1d\x17\x1b\x83\x8a\xb0x\xe6\x0fm\x87K0G\xb7\fs\xe93E\x9f\xfc\xa0.d\xfd@\x15O\x05`x\xc4\xddl\x0eUi\x10\x86\\\xae\r\xe2w\xfa\xfd\x13u?\xdb3g\x8bE\x84^\xabb\x95\x1b\xdft\xd09\xc7\xfam\x85\x9aS\u040d\x9d\u079a\xef_a!8\x98\x93\xe0V\xfb&2'\x11)\\CwE\xfeK-\xfb\xa1\x126\x84j\xe5m\xde\xe7)\xc5\x03\xe1e\x89\xde\xf9\x83\x8a,\x8bN\xfdA9t\xff\xd8\x1fH\xfea:\xe8\xa5?\xa82\x93\xcfP\xa8\xa5\r}\xef\xaf\x10\xb2\u0674%>\xb0-\xbc\u04f6\x85\x11\xc4y\xa0?ZYY\xdb=\xf3\x1e\u0607\xeb\xbaf\xfbcK\xd3Aq\u0160\xfe\x9e\xdfu\xb8;\xfb\x87\x8c\x16m\xa7\xafh\x86\u071d\f\x150\xee\u01da\a\x12\x82\xf3\a^\xd5<@\x12\xa6n\x7f\xe7\x9fX\xd6\xcac\xa8$\x848K\xeb\xff\xddw\x14\x0fM\xe7\x11u\x8c#\x02\xe6\x99\xe2\xfb\x0ez\\\x1bd \xc0\x12\x9d8\x8f\xd9:x\r5\x93\xff\x12\xdd\xe7\xdbp$7\xea\xef\xf2\x8d<\xd4L\xd7k\xf3\xc5b\xfc\xbd\x7fS\x15\b\xfeXIJ\xb9\xa6\xc1\x00\xf3#\x9a\x91pXCs)\b\xa6\x99\x12\xda\b\xae\x88\x17@0\xedd\xb5\xae\x92\u03f6iD\ny]?\xf5\x1f\xccg\u06ce\f\xc1S\x8f\n\x9a\xd7\x1e\xac\u03f9\u06d4B\xf74C\x97\xf8\xb4Tyd\xd0\u06c7[6U\xfc\xe3\x81f)\x0e\xd6\xde*qxT\x9f?\xb0\xac\x03\xe1l\xf7&K\xa9\xc2\xfc\x83\ua64e\xb5&\xc0\x85\xac\xb72 g\x8d)\x0e/\xb6(\xe0\xfdG\x10\x9c\xa3n\u0728}\n\xee\xdc:K\xa7\xe5\xd8(\x80\xe5\xb3\u03e0\u0127px\f|z\u047bD3\xff\xcav\xb2\x85\\`\xe1q)U\xddk\u01f2^\xbbR\x9cOA\x81:\xf5/\xb2\x91\xe5Y\x8c3\xad\xe9\u0332\xa62\x9a\xb9Xg^\xaem_\xcdit\x13\xed<1\u0552\x8e\xb5LNR\a\xa2R\xe7\x00\xcb\x02p\xd41\xff\xab\x96\xf14R\xe0\xb1\u051eC\xeb\x84\xc2M\x1c\xc2M\xb1\xe2\xf2\xf3L\x84\u030e\x99\x83\xea'\u023c2sJ\b \xea\t\u076bA\xf0\xe2\xe2yR;i\x8e\xe4xi\xb2\x03J\x1c\xfd|\x99\x9da\x03\xa9\xad\x1f?\xeb\x89\x00\x15]\xe8\x17\xc4zV\xbfV\xea\x99KN\xf0\xff\xf7\xbf\x19\xb9\x9f\xf5Ev\x81\xa0J\xe0\xe4\x91\x19\x9e\xcd\xdb\x0e\xd8\xf3~/\xe4 \xea\xa2uG\xa1\xe3\xd2>\xe5q\xb6\xf2\x0f\xa4\xc2\xf7\xf4\u070a\u0344SH\x84\x85\x95\x84\u05ba\xfcI\x1b\x19L\x04L\xa9\xb3\xac\x19\xd3C\xcb:l\xc8\xfc\xb5\xca\xd8~o\xfc\x97\xf1\x83g>\x85\b\x8b4\xb4\xd6{\x90\x88\x1aD\xcd:b:,\x11\x93\xe8\ub1e7\xf5\x91\x8b+F\xe7\x87\xd6^\x02]}\x90t\xf0aU|\xff\x1c\xec\x98-\xbf\xe28q\xf1\n\xb1\xfa\xa1\xc1\u0161\u0446\xe7\xe3\u04c6\x87\vk\xab\f\xcfG9<\x1f\xff\xb5\xc3#\x84\xcb\xc7\x0f\u03eb\xa7\r\x0fI\xd8C\x83\xf3\b\x90\xc7'\xf7\x9aK\u0335=~UZ+*K\xa7\xb3\xba,8\x88\x13\xae~\x86\xf3\x80\x83\xces\x81\x82\xfa\xe7UNKk\xf7y\x8d\xd7R\x9f\x00\xafM|\x92\xcb\x15\x15\xea\xf8\x8c\xb4m\xcc\xccQ\x85\xf5\x9d\x8b\xc1f\xa3\x92\xbf\xd3,\xe9{\xa2'}\u03e5+g\xa9\xfa^\x9f\u06dd~\xf3\xc9\xc7\xdam\xfcL\xe9:\x02\x9fIEE\xc4\u0464r\x15\x03\xa0K\x11\xa1\xf5`\x05-\xc8\xc0g\xb9\xdeB\xea=\u029a\x82\xb1*\x8e\x18\x9e\xa4\xc2'\xaf\xac8_,fh\u00a5\xccZ\xc8\xf4\xea\x81\xdf\xd7\x0e\xfc\"\xc0UV_\x10\xc4M$\xcfa\xe5\x03\xfe\xdc'\xe5S\xfb\xc8'\u0543\xfa\x9d\x9f{@@\x84y\xe1\xd4~\xe3\x87O8b>\xf1<\x18~\x95\xf3\xe0\b\u074b3\xe0M~\xf0\v\xbe\xf7k\xc2\x19\xcc\v\xd7u/9\x16\xb0\x89&\xfaY`\xb2\x92e\xe7\tK\xf7\xfa\x9f\xb7t\u0470_c;\x91\u01ee\xe1\xd2\xf1&\xab\xa69\x9d(\xa2Y\xb6\x1f_k\xef\xdd=\uc992q\xe8\x81\xe4\xd0j\xd5pC\x0f\xafU2\xe81\xd2\x19\xbd`\u0581`\xd6\u023cl\x02\xc8\x1fS?\x1b cJ\xa3I\u012296Q\xf8m*fuV\x96\x80IO\xb1*Z\x86\x11\xa5Ep\r\xaa\x18\x16-1,*y\xdc\u0427\x05\x1e7\xf6\xa3\x8a\xf2\x93\xebI\x93\xc5b\x8d3,\xe7A\xc0<1Z9\x7fS\xc8\x18\xf2z\x98\xd0I\xc0\x84o\xb1(\x11@@#u\u0255\xff\u0712^\xc7 \xaf\xeb\xf9\xeb\x8d\u03c1z\xc8\u07f9\xbb\xfe\xd1S\xb9(\xff6R\xccT6u%n\xea\n\x04\xad\xb7\t\xb3\xeb%\xb8\x1bw\x1c\xa4\x12 \xed\xb6\nuz\x05E`\xefP\x8c\xf6\xcc\x0f\n\x8c\xf6\x13\x7f\xa7\u011a\x0f\xfc\xbb?\x8f\xfb\xde}\x15\xee\xfbIq\u07c3\x9c\xfb\x0e~\xf0\x875\xdc\xd70\xabf\xb0\xe1\x9a\xef\x0fV\xea\x02\xac\x17s\x89f\x965\xf8\xa1\xb7\xd2(e\xdc\x16hb`Y\xfa(\x98\xc84\xb8mJ\xf8\xed\xc0h\xac\xda\b\x01>\x01\xbb\x17\x9aX\u05aa/I\xe2[\"\xd3\xfe\xceD\x13d:\x80p\xab\xefAG\xff\xac=\xe8\xec\xeb\xecAc\xaa\xed=ce\xf6\xef\x8b\xec\xf57\xab\xa9\u047e\x8d\xc6_W\xf6\xc4\xdbl\xe3\xbcV{\xe0\u0732\xe6\r\x1bh\x9be\xa1\u0656Y\xa5\x8c1\x982o'\xb1's\x10\x11\x7f\xbe\x9a\xb2\xe6\xf1\u00e8>\xbe\xdaX\x16\x9b\xd8\xd2G\xf3\xff\xfe\x17\xfeg\"\x8aL\u022a \x83J\x92\xb2![\fi\r\x90\u03d5rS\x1f\x15\x8fu\xc1\xd2i7\x7fO\xea\xcd\u07d2\n\x10\x91\xbf?\x04\x02\xb5;\xa3\x84\xd5Y^{\xcfY\xa1\xc3D\u065e\xe7\xdfzl{B\xce)\xb8&\x88\xfc5\x84\x97\bD QBy\xc9)N\xb1|\x84\aL\x11\x91\xee2\xf0yJ\xe5\xf7)\xa6\x10\x8f\x82\xc3\x1f\x93\xf0\x8e\aN\t\xab\x95e\x05+G\x0eE}\x93\u047bN2c\xc65#\x1d\x01\xcb\xed\xa9\xb2z\x9c\xa5\u0335M?\x963b\u0217\xae\xaer\x14\xa5\u0723\x8d,\x91\xc2<r\xbc'U\x99\xd7\xc5\x00\u00da\xde\x19\x113\xa0\xe1\xa6S\x9b\xb8b\xa5fR\x18\xe2\xbcf`\x05|\xd4\u0356\xec\x96sZ\x93q_\x9bO\xaf\x06#\xb30\xbd\xf5\x0f\xe4\xb3]w_N>\b\u4579\x87B1\xf5y\x9e\xbf\u0477\xbe\x04\xae\xef\xa6A\x9aJ\x8c\xce3\x91'~\x9d\x13\xbe\xe8\n'\xf9 N\x13\xf0y\xcb3)\xb6\xa8\xaej\xa3\xa7\x89e\xd1\x15\x0e_\x124\xbe3\xa5\xc1h\x12\x00\u0763\u01fetu5\xc1i\x1a\x8c\xb0\xd1P\xde\xe9\x04\x93\xebh4Kf\xb5\xa8#my\xf4\xb2\xece\xef9\x19@\xf2\x131\xda<j\xc2\u023d}\xeb\xd4~\u04e6j\aI\x88U\xc52S?w\xe2\tHh\x98\xed\x8f'SL\x82i\xc4\x1f\x1fF8\x0eSX\xf1\xa0z\xbc\u0186\fS\x13\xb9\u0731\x91\x06\x13l\xc8\x196\x12j\x80C\xe7\xc1\xbb\xd7Z\xab]\xe3]\x8c\x83\x14\x1b\x14O\x9296\x12\x82\x01\x9e\x1e^\x16\x95\xbbm\xfd2\xcff\xd3iB\x19\x0e\xd5\u022b&\x05\x14\x1b\xe6J\xfd\x86]\xe4?\xcdu\xb7k\xfe\xa7\x1a\x016NRl\xb0q\xc0\x8cI\xc0\x06\xe3\x15\x87\xc436\u072eK\xf8\xc8\xd8\u00c4\xaa\xa8/\xf4\xa8\xf7\xbb\xf0\xbe\xe3\x9aB=\xbaX\xd0G\x13\xfe\xb7B\xf3\x93(M\x01\x9f\xf9_A\xf1\xe6\xf9\x18\xa81\x99G!\x0e\xb5w\x8c0\xc1\xa9\x01\xd4\nJ\x91hxg\x04\x06GD,\x12P;\xd5I\x92\x8dH\x18\r\x02\x86\xb3*j(\xbdX\xab\xf1\x17\xa7\xd7V\xa4\xbfQu\xf3TL^\x88\xa7\x8a\x82\u0155\xd8%\u0136&D\u03bb\x06\x8fK1\x92\u0342\xa5\xc8\xe0WOm\x95\x8ce\xb2\xb6B\x9edg)[p]\xdf\x02%\x9e\x11\t\xce\xca\xf728\xe5\ufde4\x92.\xa6g\xe6Py\x9a\x9c\u04ef\xcd-?\xa5x\x8e\t{!F\xd1v\x96\xf20\xc8]\x80\xfb\xe6\x7f<\xd7\xf2\x11\xf2[\xed\xe1\x024\xef\xd9\r\xf5WE\xf8o\xd0P\xf2\x98\x82\xdbILR\xcf\x1c36\xf5\x9e?\xbf\xb9\xb9qo6\u0704\x8e\x9e\xafw\xbb\xdd\xe7\xfc\x19\xfe\xc8\xdf!J\xa0\xee\xb9\xde\xde\xde\xdes\x1eCP\x80\x16L\xe7\xa3N\x90\xa6\x98\xd5\xef\x9fx\x986\xb6\xebnr\x9d@\b<\xa4`\x05\xf0a\xb3kt\x8du\xf8\x8f\u01f7\x9a3\x02\t#q-\xfe\u07d4\xbb\xe4\u0747\x9e\xf9\xa6\xb7\xe5\xee\x1a\xbb\xafz\x9b\x1f\xb6\xdc\xed\xc3\u07a6\xb1\xee\xeet7\x8c\u07ba\xbb\xbd\xbde\xf4\x8c^\xd7\xe8\x19;\xee\xc6\u01a6\xd13\xb6\xe5\xddmc\xcb\xdd\xfe\xb0=^\x9fw\xdc\xddn\xefp\xd7\xd8pw\xb66\x8d]wgo\xc7\u0600\x976\x06=w\xbd\xbb\x01m2\xf8\xbduc\xdd\xed\xed\xed}\xd8}\xb59\xe8\xb8[[\x1bF\xb7\xd33\xdc\xed\xcd\xedN\xcf\xe8\xf1[\xbd\x9dA\xd7p\xb76\xf7\xdc\xcd\xf5](\xdb\xd8s\xf7\xb6\xe0\xeeFw'\x86gv\u070d\u075d\xc3-w{g\xdd\xe8\xed\xba\xbb\xdb=c\xdb\xdd\xda2z{\u018e\xdb3z{\xe3-ww\x00U\x18]\xa3\a\xd5t\xa0\x16\xa3\a\xf5t\xb2j\xb6;P\xcf\xc0\xddZ\xdf\uce3d\xed\x1dwok\xa3\xe3\xeel\x89\x1f\xf0\xb9\xed\x0f{\u0424\xc3\u078e\xb1\vm4z\xdb\xee\xc6\u05ba\xb1k\x88\x01\xfbl\xd6&\xcbypV\xfe\xd9s\xf2\x17\x19\xdd7\xbduc\xf7\xd5\xee\x87-\xfe\u0623\xc8\xeb\xa93\xa3\f\xb0\xad\x13\xb3\xe9nl\xee\x1a\xbdMwwso\xd0q7\xb7\xf7\xe0\xff\x9d\x9e\xbb\xbe\xae~m\xef\xed\x18\xddc\x98\xa2\x9e\xbb\xdb\u06cb;\xeb\xee\xf6V\x0f\xb6\x94\xf5\xd6W\xf8-\xed\x1f\xfe\x80\xd1\x15\xb7\xe3uwgk\xb7\xb3\xe1\xf6\xb6:\xf0s\x8f\xff\\\x1f\u053d\xb4\xab^\u028a\r^\xac~f\r\xdcu{\xbb\x1b1o^g\xc3\xedn\xf4\x06mo\x18\xaa\xe9\xd9}A\x05\xd0:\xde&\x98\xa5\xde\u05ba\x91\xff\x1e4\xbe\xf2\xf4\xf5\x93\x87Y=4W\x1b\xee\xfa\x96\xd1\xeb\x1eo\xbb\xbd\ue7b1\xeen\xed\x0e:\xee\xfa\xf6n\xc7]\u07d1?v\xba|\xe0\xf7v\xf6\xd4\rw\xa7\xdb\xe3\x7f\xf7\xb6\xf7\x8cn\xbc\xe3\xeen\x18;\xee^ww\x00O\xb8\xeb;=\xfew\xa7\v=\x81\x17\xe3\x8e\xf6LG=\x04U\xf7\xf8wx=\xea\xbb\xdb{\xf2W\xfe\xe1c\xd5\u03af0\"2\xf0\xac}Xv\xdc\xcd\u07ae\xc1\ae\xe0\xae\xef\xacwT\x87\u010f\xbd\x9d=\xa3\x9b\xf2\x8e\xeet{\xbc\x93\u06fc\x93{\xdd]\x03\xba:\xe0\u00e3z!~\xf0\x97\xe4C\x9d\xec!m\xb4yU|0\xc4\xe8T?\xc9W\f\fE\xcc\x1b\xd8\xd9q{\x9b\xbd\xaf1&\xb3\xe9\x03#b\xc8!\xe9m\xf2>\x1e\xc25\x90\xeb\x96\xdb\xdb\xd9\x06>\xd8[\xdf\u046e6\xf6v\xb4Gw\xdd\xedm~\xbd\xbd).x=\xeb\u075d\xec\xd1\rwoc\xcf86z]wswO\f<\xbc\xd9u\xd7{{\u0196\xbb\xbb\xd93\xf6\u071d\xdd\xf5\xec\xf7VO>u\fk\xa5\xbb\xae\xea8\x04V\xbd\xb1\x9e}@]lo\xaa\xe7\xb2f\xb9\xbb;\x1b\xaa\xcd\xeb\xeeF\xaf\x97_l\xed\xf6\u0503\xd0(c\xc7\xdd\xd9\xde1\x8eK\xa3\xf0\u06e3F~\xd3X\xdf\x14#/\x1d\xec\x1f\"\xc2=cg\xbe\xf9j\xcb\xdd\u07487\\\xce\u0636\xf6\x8ew\x8d\xed\xb8\xb3m\x88\xff\xf5\xdc\xcd^\a\xfe9\x86\xa7\x8c\xde\u01ab\xf5\u0787\x9d\xcfOl\x960!=\u052a\xae\xd1\xdb\x1do\xce;\xeb\xe3\xce\xe6|\xfd\xf3\x9b\rc{\xbe>\xee\xed~\xd8~\xb5\xf1y\xb2a\xec\x8c{\xeb\xf3\xce\xfa\xab\xed\xf9\xfag\x11\xdf)\xb5\xb3G\xd4'\xf6\xeev\xb7\xb7\xe7\xa0\xdbo]M\x85)-fLz\x10\x86\x94\xda\xe6\xd9|t \xe4T\a\f\xca\"\x7f\xe2a\x1e\u06b7\u0595X\xa42\xf5\xe2;~h\x16\xc9zL\a\xa5pK\xcf\xcc\x0f\xcf\xcf|*M\x95\xb2 \x86\x82\xd3\u41a7t\xa0*I\xd3\u0527y\xea\x05xl\xc8\x1b\xc43\xa1\xa5\xa5&\x8c\xf9-\x01hZ\xba\x15\u00ad\x83\x19\x1b'4\xfa\x8c\x7fd\xa4t\x7f\x02\xf7E\x83Kw\xe6\x1c\xc0J)\xf4\xec\"\xa2\x15\xba\xf3\xd7d\xd0;\xa36w|\xa9\u427a\x11\xe9\x16\xc0\xe3UK7umY\xf6\x8d\xff\U0010c4d5\ae\u076d\xe9\x9c\x1a\xdf\x12\xf4\x8c\xb4|W\xff\x9a\xf6\x8d7\x8b/e\xf8\xc4/y\xc3@k\x02\xf5\x18%u[\x8d^c\xaa\xa0\u0173^\t\x8f\a\xde7\xb16\x8e|\xe6\xc6A\u028e\x80\xbal0\xb8\x1f\xf5\x8f\x84\u07c0\xd4\xf6\x98\x10\x82\xb3\xbf\xea0\x18\xe2;\x9d\x81\xf8\u041f>(\xa0ld\xd8\x10\x9f\x9f\u0460ih2\x95\xcf-\xb00p\u0738\xb1\xac\xbb\xfa\xf9\u03b1\xe2\xdf&\xa5Q\xcf\x14Q\xa0%A7\xce\xca\xe0nR\xe5\u04d9EO\x1e\xa3\x1bG\x05z\x137\x15\xbc\xc0\x06o\b\x1d\x1c\xf9\x93\x7feYW\xdc\u060a\x0e\xfc3\xcb:\x13\xbf\x0f\xfd5X\xb3x0\xa3\x11\xbb{\xa1\x03K\xec\x7f\x9d>$\r\xb6\xda\b\xdd\xe7\x9c\u011b+\x1b\xc7\be\x1a\xa3\x06*^6\x91w{\x02\x10N\x95t\"\x92\u0475\x91\xd8\x00\u0777\xe4r\v\xd4RB\x9f\x16\x8b\x83\xc5\xe2p\x05\xfb\x83\x98\x86\x87>Zy!\xadM.W\r>\x1a\xeaaQ\a\x95\xdbc\xfdv\xb5\xb9\xa1v\xbb)S\u0264q\x80k\x1f\xd7G\x10\x858\xfd\u0112i\xed`\xa6\x8d)\x0f\xbe\xb4\xe6Y\xc6\xf1\xea\xfd\u066e@L\xd9\xd9\xd9r\u0419\xc0F\xbd\xa2\x0e\xfaD}\x85\xe8\xa6e\xdb+\xa0\xb0J'\xba\xfb%D\xf0\x8b\xc92\v\x0eubw\xf6\xf4\xbcHKt\xf0\xe7\vBOF\x87\x06\xb77\xd00\xbf\x06F\x10\xc4*Q\x99\n{\xa7Zr\xab}\u05a7\xa0\x86\xe4i\x17\x99eQ\xdb4\xbf\x10\xf6?\xe0\x8e\xc6b\x10\xb9\x93\xf1\\&\xf3c\xd9WU\x06\xfc\\,\x03?\xe3!Q^\xc6BQ;\xf0\x89eq\xe4\x9c>)\xba\xd1\t\x12\x9fV\xee\v\xb7;qw\xe8\xd7(g#\x1b\xa3\xb5\x1e\xba\x87\x9d\xed,\x8a1a*\x9d\x1d\x1a\xfb\xd3\xfe\xd0\x1e\xf4\x05\"\x96\t\xe8!\x02\xa5\xe5*S\xd7N\xf5\xa8'\x8c\x06\x8eW\xff\x98\xe3x\x91\xad\xdfJ\xb9\xffC\x0e\t0^,\xec\xb1_\xffLu!\x8dk}G%\x95R4$\xe5\f\xbb\x91\x9c\xb0$_\x01\x81\"{\x92'\x92\x8c\x97\xf5+\x8b\xdb\x01\x0e*v\x80OT\x10\xfb\xe1\xff\vyJ\n9F$][\x16d\f\u580d\xc5\x1b:\xf1\xf53Xdx6\xbd\xe8^z\r\x19e\u0557m\x86T\x91dHu\xa0[\xe0%Y\xdb\xd2\x12\xf8ZV+\xfejYi+\xb0\xc19\x1b\xd1\xe1\x82\u0142\x0fd\x16$`\xa6\xdc\xe7Es#\xe5h\xc1\xd9\xf2\x9e\xf9\x14\xa0\xc9`\xf9R\xdd\tV,\u07f8r\xb7\xb8\xf8\a\x95\xfb\xfa\xe2\x9fV\xeeFD\u0783\x83\vY, \u0211\xe7\xccMDL^\xa2b\xf2..\x91L\b\xca1\x87\xc5Q\xb2\x19\xcdc\xa8\xef\xfa\x89$\u0fa9ep\x93\xb1\xd7\u067d\x04\u02828Nnp(\xd2\xeby39\x13D\x94\x1fM\xa6\xec\x8e\xdf\xf2\u0582|\xbd\xa6\x95\xf4\xb19Q,\x9d\xa5\x00\xfdJ\x17\x8b)\x10jB'/\x02\x16\x00\xb1N-k\xcd6_\xaa\x92\x88\x187\x11\t\x93\x1b8E28\xfbNgZ\a\a\x1a\x99\x0fjD\x8d{\x91\u03d6?\x82\x9e\xd2\xf5\x86\xb4+Y7\xc7\u02eaq\xfc\f\xce3\xe2\u02f1e\x99`s\xbcI(?\xd8\xc5\xfd\xfcR\xa5\xd9}J\xb3\xd4\fL\"r\u030b\xbd.\n\xf1u2#\x03|\x1eMp2c\xde\xc6V\xb7\x90\xea7Z\xa53\x8d,\xf6\xb0\x91\u015e\xd0?7co\x1d\x13\xd43\x92\xe4Ii9NmM\xbe&Q\xcbk\x86'\r\xc9Xk\xb3\x93\x92,;\xa9\x1c}Q5<j3\x81\xe7\xa7e\u072d~Q\xb8\xa3\xbc\xe6P\xcd\x05\xa6X\xfb5V\xfa\x9ad\xc8n\x88c\u0330\xfd\xf0\xe7\x820,\x7fK\xb1\xccSZ\x1a\x9d\xfd\xda&\x94Z\x80E\xa4G\x96nWU\xa1o+*\n\x04\u0116Z\x10\xad\a\x9b\u0772y\xac\xd4\u021a\xfa3\xf0|\xf1\xc8)\xb5U\xbf\xb3\xb0I\u0649\xaf\t\xa9/\xa8_\xfb\xd8>+\xa2G\xf3\xd2\n\x84\xbe\x9c\xed\xa5\x83T\xa3\x8ao\xa9\u077d\xf4Z\xb9#\x8f\x14\x8b\xabX\x92\x95tH\xd9>\x99eA\n8z\xa4\xdcJg>\x1121\xc96\xcd\xfd\u020f\xc4~\x15\xa9\xfd\xea\xdcv\xec\xc8\xe9G\xde\u0165\xc0uBC\x90ey\xa9X\xf4Q\x9d\xc0`Jy\xd3\xf7\x81\xab&C\x03/y\xf6\xd7\x1c\xf5\xa1\xf5u#\u02de\x8d]\x82qx\xcaWb#tC\xe6\x1b\xc1\xbb\xb6t\xb2\xa4\xb1\u06bc\xa1\xb9\xbf\xb6fO,k\"p\xb4\xb2\x1f\xb6\xf3\x03\x8f\xd2\v$\x96\xdfE\x16\xf0\xc7\x05\x86K\xd0ZV\xefqq\xe0\x12T\x98\xd5{R\x94\xb8\x04efi\x99\x1d\xf9k=t\xebg[\xe0\xddb\x91\x0f\x15\x04\x9e\x98\xd7\x11\t\xe8\x1d\\]\xef\xdfY\xd6u\x1f\xb4\xbb l\x0e\xebO\rw\xfa\xa9a\x88\xae\x1d\a\x92u&1\x0e\x88\xb9&\xaa\f@\xd2U\x17\x12\xecd\x8d\x7f\u06c6\xca\xeb\xabu\x1c\x9e\xc6|\xb0X\xdc.\x16\xf6\x91\xcfC\x19\x05\r^\xf9\xf4a\xc9\xe5\xaa\xe0g\u0732Of\xf7\"(\x9b\xccb\x16McHT/\x17\xc0D;R\x94\x04\x9bQU\xa0I\x1e\x96b\u03a0\xf9*\x1f\xf2\xea:,H7\xda\x11\u02e8#Ft\x89\xe6<\xf2b\"G\x7fR\u0615\x88\xdc1Q\xe0\x17r\x93r\xd8\xc97\x1c\x94\xf7\xe1u\x80\xdd\b`\xd6}\xdf'9\xf5'\x0fP\xff\x83h\x84\x15\xd0\a\xbdo@\xbd\x1d\xa0Ws\x89n+\x12\xdak\x9a'\x9c\xab*@\xf2\xd6\xc0i<\u07ef\xf9p,\xf5\x89\x94\xe7\u02e0x\xb4\xa4K\xc7;\xaa|\xf3\xf7\xaf\xfc\xcd\x1a\tp\x90\x1f\x82\xd5\t\xf8\xab\xf6Rr\xfb\x9bRw\xf9\xe9\x1a\xf8U\u0707\u00c3W\x13xSr_\a7\xfdtb\xd4NXG\xfa\xcff\x8b8\xac.;\x198\xc1W^v;\xf4\xcc\xdc\xed\xac\xea\x02\xc6\xdc\\\x12\xb2yN/\xd3\xe8p\x978\xa5\x0e\xfc:\xcd\x0f\xc20o\xfb\xb8\xbd\xed\xd9\xed\xb1\xdev\xbe\xe4\xa5\x1c\xb5D\xe6\x01T\b\xe9\x96unip3\x85\b\xeb6\x1fpW<i\x94\xa1\x7f\xff\x7fGM\xf14\x05\xc3\xd7P\r\xcc3\u0562\x94Yh\xe9\u011fh'~5\x04l\xb1\xe0\x91U\x0e\">\x11\xd2\f\xd1N\xdf-g\xbf\xca\xf1\x8e\xb4l[\xd9=\x92\x1f\xef\u0623\x8ew\xf4\xc1\xe3]\xd2r\xbc\xfb\xbd\x914_\xff\xc5H\xf3e\x14\xe3U\xc8Si\xc4\xfeL\n\xad(\xaftB\x95\u04c8\x12M\x0f\x82\x02\x9f.\x16\xf5\n\x93\xa6];i\u048a\xacN\x9feJ\xcb\xc74\xa7\xb6\xa0\x85\xda^7R\xdb\xf9_\x8c\u06beMMhf\xef\xc09RAY\x13\x9a\xf1A\xbf\f\x04&\xcfn\xa9\x9f@\"TP[&U\x95\xe8\xcc_K\x17\x8b\xb5\x00\xc5\xfe\x1a\xe4\\)&\xde\x17\x10\xf0\x12\xf8\xfd\x12|NV\xd0^\x16l\x96\xb4\x85X\xb3{4g\xa6YTgN\xa1QI\xfeO\x01\x1a\xbd|\x04\x98\xb5\x9f\x00\x9a(\xfc\xbc\x91\xc2\xdf\xd2:[\x0f?\u0276\x87\x88k\x87X\x19\x12\xdb\xcf~yR\xb2G\u052f\x9e\x95\xfb\u062b\x16\n\x1a\xe8\u02ff\x99\xb2y\rB\u07147\x01\u0556\x91|\x10E\xbe\x99\x87\x04d\xdfw\xf6\xd5I\xd0\xf7\xfd\x80\xabr\xf6E\xcbgy\xbb\x93\xac\xdd\xd9//\x11\xed\x86O\x8b\xe7|\u07df9\xd7\x14\a\x9f\xa0,z\xa6\x7fn\xe6@\x0ea\xde\x0e\xf9H\xe2\xcbk\xb5^\u0281\xd0\x11\x8f\xe9\xd5#\xa19\xb6\ucee7\xb2\x99:\x85%\xd6\x15\x96\x1a\a\xc0\r\xa2\x10\x83|\x8b\xe5=\xa5\xa8\t\xc3Y:\x98\x93\xfa\n\xa01`\xc4,\xc8R\xb9\xa5\x14\xe4j\xc7A\xf8Oc#\xd9\xee\x14\xc0J>\u01f7\xec\x80\xe2 _\xcbU.r\xceyd\x9f\xd6IQ-Q!A%\x01\u07bdd\x00\u0672_:e>\xf0\x96\xda\xd4\u0470\xc5s\xb6\xc0\xb5\x99\xef>;\x05\xc6P>\xf5\x17gb\xd9\"\xe5g\x04sJ\xb5\xc5]\x04\xf3\xc4N\x1f\xf3\xdec\xa7_`\x8b\xd8)\x9a\xf1\xb3\xca\xdeP[\xcd\xf8}\x9e\x96\u023b?\x98N\xbd\x03\x8c\x02\xe9Y\xc7]M\xde%\xd3\xd9\xd4;\xccK\xc1\xdf\xce;\xc1\xa8\xd6\xff\xce\xfb]{\xf0D9\t\xc2\x1b\xaf\u014d\xd4;\x17\xaf\xc2\x19\xc8{+\n\xb9\u03d6\xf7\x0e\xa3\x04\xae\u05bd\xdf0\n\xa6\xd1/\xf8\x0e\x1e\xf4N1\xba\x0e\xd2h\xc0/\xde`4\x88q@\xbd_1\x8a\xa39>\xc5\xe94!)\xf60C\xd2\xe2\x1f}\xc6!\x17g\xbc\xcf\x04\x81\u04cc\xf7\x8a\xa0\x82\x8f\xa3\xf7\x1dA9\xa0\xb5\xf73A\t\x89#\x82?\xc0\xb4\a,\xa1?\x06!\xcc\x15s\x7fC\xb9\xa7\xa3\x97\xb0\xfc\xca\x1b2\x94\xf5\xefl6\x99\x04\xf4\u039bT\xcb\xde`6NBo^\xbd\xc3?}\xcdP!\xaf\xa7w\xcc\x10\x95]J\xbd\x1f\xf3\v\xef#C\xaa\xaf\x19P\x8f\xf7*\x7f\x80\x87Z\xff\xc6\xd04\xa0\xc1\x043LS\x8f\x91\xfc\xea4\xb9\xf1RHa\xc7\x13\x99z\x03\x82\xc68\b\xe1\xa9)Qz\x80!A\x03\x01\xc4r\x0e\xf2\u071c\xa0d\x8e)\xf8\xe1z\xc7\x04\r\x93\x84a\xea\xfdBP\u0273\xd2\xfb\x89\xa0w\xf0\x1d\u0784_\t\x1a\xcch\xec\xfd\x9dd\xd9\xe81Ee?N\x8fQ\x91(A\xa6\xa9\xf4\x02\x8a\xf4<;^DQ\x01\xf9\xc6#\xb2\xc0K\xa9\xfb\x9b\xf8\x99z3\x8a2\x00\x1d/\xa6\xe8\x84\xef\x19\xe2rJ\x11\x97\xc9\xc4\u0550\xa2w\n\xf5I\x94\x84P\"\xb0v\xbc\tE\xe7\xf4\xee5;\x991\xa1y\xf3\xe6\x14)\xb4\x1e\xef\b\xbe\b\x19\x84\x8ey\xc6\x06\uf5a2\x1a\xd7Xo\x94\x15\x9f\xb1`2\xf5\xeeh>\xe7G\xb7\u033be\x85k\x98\x91+\x86\u07a9\x19\x82G\b\u026f_\vs\a\x17\x1d\xbc\x84\xe4\xef\x9e\a#o\xaa\u0555\x0f\xea'\x8c^`<=\x86\xc0\xb5k\xcai\xfe=\x8d\xbd\x8f\x82\xfcU\x0e$\xef=A\x99\x0f\xb0wC\x91\x9a\x83\x17X\xfdL\x85\xe8\xe4\xbd,\x97p\x19\xe6\x14\xcb\xef\xbd\xc7\xcb%\xa2\x05\x1e\x82\x97()\x14\xb0\xa5d\xd9\x17S\xac\xf29\xa18\xff\x19\xe5?i\xfe\x93\xe4?q\xfe\x93\xe5?\x13\xedYDQ\xaa\xddA\xb3\xfcb\x90\xff\x1c\xe6?\xc7\xf9\xcf0\xff\x19\xe4?\xed.\x9adW\x8e\xed\\.a'}\xd7(\x83\xbd\u0e6aw6\xb7z\x1a\xcb>\xa6\x99\x8e\xea\xe2\rE/\xa8\xaa\xf0\x92\xeby\x7f\x84w6\xb76\xba\xbb\x0ezI\xfd\xb5.\xfaL}s\xb4\x11\xe0\xe1\xc6\xf5\xd0D\xef\xa9on\xba\xbd\x9e\xdb5\xd1\x1f\xd47\xcf\xc73dt\xb7\x8c7\xc1\x9d\xb1\xde]_7z;\xde\xe6\x8e\xd7\xdb3~zsn\xe6\x9f\xfd@\vp\xd1\xfb\xaf\xdc\xdfT\xfci\xea\xeb\x17\x8b\xc5\xfd\x12\xe9\x05\xae\xf4\x83|\x1f\xf9\xf7\xb2\xcc{O\xd1(b\xa7x\x1e\xf1\xcb\xcf\xfc\xf2E\x04\x8b\xe6%E\u05f3(\x0eA\x81\x90r\x8a\xff\x83\n\x1f\xfa\u013f\x0f\x93\xc9U\x14\n\xd1=L&o\x81\xb7\xf1\vp\xaf\x06\xf73\xe0\x11\xa6\t\x7fRqC\xa4C\xf1\xcc|\xa1\x99HO\b\xe6\x99<\x110\x9a\x04\xb7/\x04\xb2\x19\x0e\u03c3\x91|[`q\x89\xdfs\xc5\u0181\xf8y\x00g\xea=\x7f\x9e\x95\xaan\xbaQ\x92\x17\x9ar\xef9\xc5aD\xf1\x80\xc1\xab\x05L\xe8L\x02\x14\xa7a7N\x06A\x8e\x821Hbd>\x7f\xae\x81D\x97\x1f\x1b')\xcb\xc5\xc6J%\x01\x1b\x93`\x82\xddtv-Dk\xbb\x8bb\x0e\x19\xda\xf4\xa8\xac\x8b\"\x13>\x8b\xcc\xe7\xa2\x03\x1d*{\xe0\x8e\x19GY\x9b\xc2L\xa6\xec@\xdf\xd4\xc1\xd1o \xb1\xf7\xee\x97h0KY2\x81_\x123.\xe3-\xaf\x05\u021e(=\x15i\x9a^Hod~G\xb2\x9b\x88\x8c\xe0\x92I&z\x94\xe3\x81\xc8\xdcN\xaf\t\xc3t\x80\xa7,\xa1u\x9aj\xbc\xcc6\xb2\x87\x9f\x04\xb0\xba73\x160\xb0\xbc\xf1\xda\xc1\x1cS\x9b-]K\xa4\u0710p\xdb\ub866,s^\x0f\x15q\xf1\xbc5Qr\x98L&\t)\x96\xdfDl|Hq\x88\t\xc8 \xa9'N\x1f\xaa\xfbg$\x9aN1K\xab\x03\xa3\xeex\xf7#L`\xd4a'\xbe\x87-\xf4\xea:H\u01de\x84N3\a\xefO\x8f\r\x1b\x8a\x1c\x13\xa5w\x84\x05\xb7\x1e$\xe6\x86\xdc\x17\xfc\xe9ir\x83i:\xc6q\\z\xe7\x1d\xdc8\x83\x1b\u069b\xf9\xd3\xea\xfd\xc1$,\xbdx\xf8\xe6E\xe5[K5ZGY\x02\x85.\x8a\x032\x9a\x05#\x9c\u0280\xeeTA\b\x9c\u036e'\x11\x132P\xea]\x98#\xccLd\x82\xa6\t\x99\xd3\x04V\xb3)\xfc\x0fL\x88\u0617\xf1$&H&\xf0@\xc0\x06c\x13\x99\x8c\x06\x03l^\xa2?f\x98\xde\t\xbf\x7fm\x189\x9c\aK\xbd\x8bcz\x89\xa6\xf1l\x14\x91\u053b\xc8~\x9e\x88Z\xbd{q\r\t\xa8\xb8pc\xc6x\x14\f\xc0H\x16\t\xa9\x91\u06fda\t\f\t\xfc\xab\x8b\xc5K9\x06\xaf\x94\xa4\xe6\x89\xcc\xd8\x01\x13\xddg \xdbxf0\n\x18\x86\x11\x02uG\xb5\xb1}~4x\xff\x93c;\x9c\xff\xc1!G0\xc6}1\x06F^\xc0M\xd9\xfeH\x98|\x12\x84Q\x04\xa12\xf7\xe9]\nR\xf3\xbdZ\xba\x03W\xfeZf=\x1f\xb8r@\xca\x030p\x8b\x05\x88\x1b\x9f=\xfe\r\xc9z\xd5\u07c1\xcc\u0a18*\xd8\x01\a\xceR\xb2n\xfe\xaf\xe0\xdc\xde\xc0\x9d\xd1xY!\xe5\x81[*Y\xa2\x81\xab\x8f\xb3\xb3\xe4\xc6\xdbb\x99\xd2\r\x84\x00\xcdR\xba'\x04\xbb\x1cq\xc8\x1d\a\xe9\u024dJps'Xa\xf1\x1d\x14:\x96\x95\xe9\x00\x8a\xf7.\xc2K\u02d2\xa3>\x14f\xf8\x8bPh\xa1\xe6\\\x9b\xf7\x8b=t\xf6\xe7.\u0163(e\x98\xda\x17\xd9\U00021aa7\u0210x\x03wHt\x9a\x19\xb8\xf9\x85\x1c\xe9\x81\xf8\x0e\x1c\xf5\xf8\x87\xee\xfc9\xcfO\xc9\xe7\x94G\x1bU\x95\u01e5\x1cH\xf0\xfcq2\bbAX\xfd\xf6\u06c2\u0388\"#\x86\x06\b\xf3=?\xe2\xa6\xf3\x99e\xd9DQ\x9c?s\xd0\xdcM3$Q8\xbe\xde)\xeaRi\xfa v\x03\x87\xb6\xc3\x0f\u04a07\x824\x16\x11\x90\x80e\x95\xd45\"\x8c\u02b1\xacq~\xa1\x0e\xcf\xf6]!\xf9\xdfl\x1a\x06\f\xbf\xa718_\xa3\xba{\u01c5\x18,3\x9d\r\x068M\x1b\x1e>\x9b\xe2\x81=\u027f\xea8^\xf11\x10\xe9\xa1'\xe0=\u035b\xbe\xc6\xff\x82N\xb1\xa9a\xfc\x01\a\xd5\xd7#\xef:\x0e\xcaF\u04f9s\x85N$\x1f`d\x1eL\xa7\xa6\xb3\x8f\xe3\x14\x1b\xd1P\u0738\x8aB1\xd3\xd4\x0f\x93\x01W\x12\v\u01a1\xa64\x7fl?\xab\x92\u02aa\x96\xbc*\x98\n0\xbf\xcb\xe7\x16\v\xbd\x00>\xbcX\f\x12\x92&\xb1\u052e\xd8\xe6\xd9'X\x93\xa1A\xb3=\xd2 \x89\xf1?\xe2\xfd\xff\x01`\x98\xff\x91\xaf\xfe\x8fq\x13\xa4\x12\x84&\xc2a\xae|\xb9[\xa2\x1b?\x92\xe4\xb1X(6\xf4\x9ef\xd9\x1c \x06I\x1f\xad\xd2e\x8eY\xfb\xe3\xdd{\x1a\xf7\xed\xb6\xbb\xf6=\xb0\x9a\x1b\x04\x83}\u02a3\xa2\xc4M`\xbd5\xd2\xc5\xc0\xad\x16\xd6\n\x17\x03\xb7\xa6t\x89\xae\x1dt\xe7x\u05f6\xb3\xfc@\x153\xf5\xef\x83i\x94z\xc7t\x89>P\xc5\t\xfc\x1f3\x89^\"\x94|\xa4\xfe\a\xbatl\aQ?\xbb\a\xd7K\xc7\xd9\xff\xff\x9e?\xff\x0fC H\xbe\t\xa6\u04c8\x8c\u079f\x1e\xfby\xe8Q\xe7z\x06Z!\xf7\xf7\u051d\x04\xd3\xff\x7f\x00\xc1\xd8\xea\xf2\u0335\x10\x00",
+	"swagger-ui.css":       "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xec}{s\xdb8\xb2\xef\xff\xe7S\xe8dk\xeaNvL\x85\xa4D=\xac3[\u01c9\x9d\x8c\xb3q2v^\x93lMMQ\x14$\xd1\x06H\x1e\x92z\u063a\xf3\xddo\x81/\x11@\x83\x00i\xef9{\xab\xcef\x93\xb1\x89_7\x1a\x8dF\xa3\xd9x\xb0\x9f\xec\xdc\xd5\n\xc5\xc6\xc6?x!\x0e\xe3\u04ff\f\xe6C\u02f1f\xff\xd6\xeb\xbd\xf8\xeb\xbf\xf7\x820&.\xf6\x1fP\xdfK\x92\xdev\xdc7\xfbf\xef\xff\xf6\xae.?\xf5\xde\xf9\x1e\n\x12\xd4\xfb\xbf\xbd\x95\x9f\xae7\xf3\xbe\x17\x92\x17\x01\xf2B\xec&/X\xba\xbf\xbeX\x86Aj,]\xe2\xe3\xfb\xd3\xc4\r\x12#A\xb1\xbf\xfc\xb3V\x7fo\x9d\x12|0Hb\xa4h\x9f\x1a\x89\xff\x80\fwq\xbbI\xd2S\xcb4\x7f\x98\x19;4\xbf\xf3SI)\xf6\x03d\xac\x91\xbfZ\xa7\xa7V\xdfr\x18\xce\xf3pq\x7f n\xbc\xf2\x83S\x93)q\xe3\xd4\xf70:a\x9e%\xfe\x82}\xb2\f\xc3\x14\xc5\u03235r\x17\u0723\xc0\xdd2\xbf'\xc8K\xfd08,\xfc$\xc2\xee\xfd\xe9\x1c\x87\xde\x1d\xdbb\xeb\x90\u9176\xe6\xd4FdV\xc8\xd8\x1f\x8d\x11\u9c52.\xfd\x95\xe7F\x94\xe1\t\xf7x\x13\xb3\xd2\x12\xd7o\xaa4\xa7(\xd5a!\xd2\x1b\x9a\u045e\x95+>\xcc\xc3=\x15\xcb\x0fV\xa7^\x18\xa4(H\x8dy\xb8\x9f\x15\x1a6g\xe1\x16\xc5K\x1c\xeeN\xb7~\xe2\xcf1b\xe8\xa3\x18\x1d\xea\x1dN\xc2 L\"\xd7C'\xd5O\xb3c\xc3-D\xd8>90]\xbd@^\x18\xbb\xb4\xe1Fr\xe7G\xa7\xe1\xfc\x16yi2\x9b\xbb\xde\xdd*\x0e7\xc1\xc2\xc8-7\x8d\xdd \x89\xdc\x18\x05)\xcbo>\x8f\xff\x91\xfa)F\xbf\x1f\xe6a\xbc@\xb11\x0f\xd34$\xa7A\x18\xa0\x19W\xc9\xe9&X\xa0\x98\x9a\xd3L\"\xc6\x11\xd1[\x84i\x8a\x163\x15\x805F\xd6H\xd28\fV\xb9\xb6v\xb9r\xfd`\x8db?\x9d\u055f\xcdC\xbc@1\xc3\xc7\v9+\xbd\x9b/X\xd6.\x89\x1e\xd1\r\x8bePXgz\x8f\u0469\x9f\xba\xd8\xf7\xfed\r-\xbe;\b\xdd\xf0\x97\xe5\u049c\x15?\x9a&k\xc4\tq1\xae\xd9\xfc\xc4\xfc\x81-\xdfp\xda\xd9D5\xf4\xd8a\u01f99\x8b\xc2\xc4\xcf4\x1e#\xec\xa6\xfe\x16\u0376\x88\x8eh\x17\x1b.\xf6W\xc1\xe9\xdcM\x10%\xe1k9\x14\x16`\xf4m\x87k7\xad3\r\xa3S\xa3\u03d7\xb8\x9b\x85\x1f2\xf2m\xfd\x05\n\xab\xb1\xe6\a\x99t\xe2\x90\xcb\bO\x830\xfd\xf1\x1ft0\xc5!N~\x7f^\x91eVX6\x89\xa1\xf3\u026a\xb4\u063c\x13(\x94\x15v\xbb\xca\xf8\x9e\xc6a\x98>?T\xa3r\xed/\x16(`\xedn\x93\xa6\x9c\xf3\xf0\x83h\x932O\xc2(\xa5\x9d\x19q\x8e\f#\x8f\xc5Q\x8bwc\xe4\x1e`\xbf^7+\xc8;\xcf@g,\x93\xf0\xd0\xe8l\x00\xaa\\\xe0C6,3\xaf\xb0\fc\"*\xef\x1f\xe9}\x84~\x8eQ\x82\xd2\xdfO\u0102d3'>W\x02TF\xa7\xad\x82\"/\xfd\xbd\xf2_n\x14!7v\x03\x0f\x9d\xe6E@\xf5\x05\xcd\xe9\xa9A\xc2\ac\x19z\x9b\xc4\xf0\x83\x80\x9b[\xea\xa2jA\v\xe1\x15\u063cr\x11$Z\xdd,r\x17\v:\x1b\x98\rm8\xb2\x89\xfd`%o\x80\x12WJ\xdf\x00,Dg\x11\x87p\x93R[;\xb5\xa2}\xe1{{/3\xe0'\xb4O\xb9i\x10\xe1E\x82\xd2C\u0670\xfe\xc0A\xa4\xd7\x1fg\xff\x8e\x04\xb7\x80\xd1\n\x05\x8b\xfa\xbcX\xcd%\xfb\xc2\u0755\x8e\xbb\x1c\u0629;\xc7hF\u073d\xb1\xf3\x17\xe9:\x1f\n\x95\x1eg\xbb\xb5\x9f\"#s\u00e7y\xb8\xc4M\xa2\xe1*FI\x02\xba\x17-?W\r\xd2j\xfc\xb8\x9b4\x04\xfa\xcf[#\xefn\x1e\xee\xa1Q\x10\xbb\v?\xfc]\xd2\xee&\xa3\b6d\x8ebj\x81\xc5h\xc8,\xcbH\"?0\x80a$!\n7)Kt(\u0708\xa4)\trco\r\x0eA\xaa\x8e\xac\xd7g\x85\x95\x18\xe1r\x99\xa0\xf4\u0530\xa3\xbd\x9c\xd5Q\x96\xfc\x81\xe1QnX\xde\x06\x19\xdd1@\x80\xa4\x13\xbc\u04d1~\xe9cdl\"\x1c\xba\x8bR\tR\x0f\x93y\xde\xd2\x12\xd9\xf9\x1c\xa5\xae\x8f\x136TD\xc1\xa6!TL6\x84\xb8\xf1}\x85\xc0~\x92\x1a~\u028d\f\xcf\r\xb6n\xa2\x9e\x05\xff\x91OJ\xbfs\x13\t\x89\xb0\x9b\"f6d\xc8\xfa\v4\u07ecz\x7fe\xc6v\x12b\x7f\xd1[\x85x\x01`\x8dld\x81\x14\x7fY.\x97\x10\xc5\x1c\xbb\xde\x1dL\xc1\xc70\x05\xc5*\xf6\x17\xb5\xe0\xa7\x1e}\xf661\xfeq\xe1\xa6\xee\xa9O\xdc\x15z\x11\x05\xab\x19\x1d\xa0\xa3\xe1\x89\xff\xe5\u51db\x9d\xf9\xf77\xab\xf0\xec\xec\xec\xec\xfd\xc7\xcf\xeb\x8b\xcf+\xfac\xf6\xcf\u5af3oggg\xe7\x17\xe7\xe3\xd1;\xfa\xe0\xcdo7\xaf\xbf\xfer\xf3in\x7f7\x17\xf6\xeb\xfb\xef\xd7/_~\x7f3\xf5\xbf\x7f|\xf9v\xfe\xf5u\xf0\xfd\xcb[\xfc\xed\xeb\x8d\xe3y\x18\xffJ\t\xee\xd7\u0457\xd7k\xf3\xeb\x85u\xf5\x81\xbc\xdf\xce?:\xeb\x1c\xef\f\u7fdd\xe5\xff;\u07fd@\xbf\xbc\\\x7f\xb3S\xbcx\xf5\xd2\xff\xfeu\x11\xcdoM\x7f<\u07bc\xb8\xf4_F\xdf\xcfM\xff\xcb\u00d7\xf7W\x17\xd6\xee\xda\xfe\x12\xba\x9f\xd7#\x8f|\xf9\x84\xee\x9c\xcf\xdf\x06Q\xfc\xed\x01\xdf]\xdeN~\xba<\xdf\x0f?\x04\xeb\xd4{c\xe1\u015b\x8b\x15zc%\xf3\xe0j\x84\xceM\xff\xdb\u05db\xed7\xf2yD\x7f\x9f\x7f\xfdb~\xfb8\xf1/\x7fY\x8d\xd0\x1bk\xb7x\x93L/\xef^\xdf\xcd\xed\xb7\xf8\xf2\xf5\xfa\xfd\xe7W/\xcf\u70f7\xf8\xf2\xfc\xf3\xe6\xbdo\xdd^}\xba\xd8_\x9e{\u03bb\xdb\v\xeb\xc3\xf9\xd5\xfd\xfb\x8f\xbb\xd5\xd5\xed\xd9\xfe\xfd\xc7\xc9\xee\xc3\xc7\xc9\xfe\ua579\xbb\xfa\x14\xee\xaf\xce\xc3\xfb\xabWg\xab\xcb\xf2\xef\xedp\xf5\xeb/o\xef\xbe\xdfF\x1fo.\xbeU\xf2x\xe4\x86\xfc\xfa\xf1m\xb8\xf8\xe5f\xf7\xc1\x9fl\x17\x83\xc5\xe0]\xe0=\xbc#\xd3\xfb\xef\xf7\x93\xfd\x87Ow\u03bb\x87\xb3\xfbw\x0f\x97\xf7\xef~{{\xf7\u0777\x1e\xd0W\xc7\xfc\xf6\xdb*\x9d\aW\xb75\xbe\x17\xdf\x7f{\x7f\xeb\x11\xbc[\xbc\xc1\u06f9\xff\xf2\xfe\xfb\x9bo\xa3o_\xdfn\x17\xbf]O/\xfd\u02e3\x0e\xdeX\xbbO\x9f\xcd\xe9%Y\x9b\x8b_\xceF\xef\xee\xa7\x1b\xef\xbe\xea\x8b\u06f9mn\u045b\u05fbw\x0f\x17\x9b\xabW\xd3t\x9e\xe9g\x9d\xce\xdf8\x0f\x1f\x82\xf7\xe6g\xf2\x85\x91y\x1e\\m\n\x9dn\xbe\xd9\xd3\xf4\xdd`\xbd\xf6^M\xf6\xefn\u03f6\x9eu\xe3xo>o?\x93/\x0f\xf3\xc1\x97\xfbo\xf6\x97\x8f\u07ff~\xbb\xbd\xf4_\x0e\xe7_\xf7\x1b\xef!\xa2}\xaf)K.\x87wa\xbd\xffpw\xb3\xfd6\xf8\x92~\xff\xea\x98\x1f?_O/i_\xbfr\xee\u072f\u05e3\xabO7\xe7\x1f>}\x1b\xbe7?\xdb\xef\xcd/\xaf\xaf>\xbd~\xfd\xfeve_=|?\xbf\xbe\xbd\u06fd\xbf\xbb\x1e^\u076evW\x17\x975~x\xe3\rn\xd6s\xf2\x1e\xd7\xf8E,\xbfk=~\xe7\u0479G\xbe\xac\x17o\xa6\xf7_\xdeL\xb7\xf3s\u04ff\xce\xf5\xb3\xfa\xfcf\xbd]\xbc\x99>\xb8o\xa6\xbb\u02cb\xf7\xe7\x97\u75fb\xabO\x9fW\x7f\xbf\xb0\xd6\xdfl\xbc\xc9\xca^\xdd\xf9\xbf\xfag\x93\xa2\x9fF7o\xbe\u073b\xbf}\xc7\xdf/\xbe\xdf\xcfms\xe5\rn\xa8\x0eG\xeeW\xe7a\xf1\xe6\xf5\xe6\x9b\xfd\xe5\xed\u0379\xe9S\xfc;\x82\xa3\xef\xe7\xe1\xe0\xfd\xadw\x7f}w=x\x7f\xfbmp\xfd\xf0y\x7f\xf5\xf9\xb3}}\xfb\xf6\u0575\xf9\xf9\xfe\xfd\xf9\xd9\xf0\xea\xd3\xd9\xee\xea\xf6bp\xf5\xf1\xb2\xe2\xf7\xfd\xcd\xf4v\xf1\xd5\xc2\xf3\xe0\xa6\xc6\xef\x86\xe5\xf7\xa0\u4de5\xb2\xbf\x1b\x006Im\xf5\xd54\xb3\xcb\xcfw7or\\>\xee\xb2q\xf8i\xb8\xfa\xf5|:\xf4\u07bc\xbeu\xed/\xe6\xe5\x9b/\x1b:\xde=\xff\xf2\u016f\xc9\u02cf?\xbd\xb9\xa2\x1e\xe2\xf6\xe3\u76d7_~\xb9u\xbfm\x1f^\xbcx\x98\x9c\xbfK\u0387+\xef\xcdo\xfe\xb7\x8b\xb37//\xff\xfe\xe6e\x80^\xbcX\xbe\x8evg\xab\xdd\xd9\xcb\xe9\xe4\xcc}mG\xb7\xf8\xf35%\x7f\xf9\xf6\xe6\xb3s\x11\u07fd]\xadV?\xff\xfc\xbc\x17\xa3\b\xb9i\xcf\xecI=\xa9a\x8d\x9e\u0419\xbe\xa4\xbf\x9e]\xe7\xce\xf4l9y1]\xff\xaf3\xfd_g\xfa\xaf\xeaL?\x9c\x7f\xbb\xbf\xb9]_\u071cSg\xfa\xed\xfe\xb1\xce\xf4\xc8\xefZ\x8f\u07ff\x903\xbd\xfe\xf4\xb4\xce\xf4\xfa\xf6\x7f\u0219n_}4\xadK\ua32e*gJn\x86/\x9c\x9f^\xbe\xfe\xf5\xe5\xf2\xec\xea5yq\xf5r\xb5\x9f\u071c\xbd\xf9\xfa*\xb0\xce\xce\xc8\xc7\xd5`x=\xba{y\xfe\xf7\xab7W\xe7\xafv\x97\xaf\xd0\xd5%\"\xaf_8\xd7\xee\xee\u008f\u03be\xee>\xafw\x17\xb7W\xe7\xdb\xf9\xd9W\xfc\xf5n\xfb\xe5\xe5n3\xd9^_\x9e\xbd<\xbb\u063d|\x15\xad'\x9fG\x9e\x99\xf9\xa5\v\xfc\xfa\xd3\xdd\xc7\xcd5y\xf5J\xcb\x01O\x8c,\u07ad{a\x1a,\xf3\xee\xf76B\x95\xff}1\xbd}1\xbc>\xfbv\xf3\xdb:\xfa\xbe:;\xfb\xf8\xf9\uefca@\xf6\xf8\xbf__$g/__,\xbe\xbe\x8f\xd15\xf5\xcc\u05e5\xe3|1\xbc\xbe\u06bb\xbf\u0718\xdey\xb8}g;\x0f\xefH\xee\xc7\xdee\xbev:\xfc\xf6\xdb\xd9\xf6\xea\xe3p\xf7nw6\xf9u\xb0\xde}\xfb\xfa>\xfe\xfe\xdb\xf5\xea\x1b\xf9\x12\xb8_\x87\xd3\xcb\xcdO\xe3\xed\xfd\xe5\xca\xfdz=\xbd\u011e\xf5\xe9\xd3\xcb\xf7\xde\xc5{\xec\xbe\xc1\x97(x\x8b?\x0f\xa2\x0f_\u07bc\x1f\xb9\xf6\x95\xf3\xfd\xd5\xe5\x8b\xccF\xcf\xe9\xf8}\x99~\xff\xedf}\xf4\x11\xab\xe9eQ\xf7\a\xe2<| \x99\x0f\xde\xd21\x9f\xf9\xe4_n\xe2_?\xbe-\xe7\x8ao\x9f\xbe\x9c\xad\xae\xed\xe9\xfd\xf7\x8fg\u05bb\xdbo\u9dc7\x8b\xfd\xd5\u01f3\xc1\x87\x8f\xc3\xfd\xfbO\xab\x87\xab\xdb\xcfI1N\xb6W\xe7w\u06ebOg\xe9\xd5\xf9\xc5\xe8\xea\xd3\xd9\xe8\xea\xb6\xe6c_]R\xbf_\xd9\xd7Q\x1ej\x8b\xa6_\xe9f\xb0\x18,\xee\x9d\xc1\u057d\xb3\xf5\x88\xb7\xbd\xfat\xe7|\xf88\xd9]\xf9\x93\xfb+\u07e2\xf4\xa97\xa0c\xf1\xf5\xf0\xddW\xe7\xe1\xf2\xfe\xc8\xf7\xe6\u0357\x87o\x83\xb7\x91\xf7\xcbM4\xb7\x87+\xea\xbf?\x90\xd7\xfe|\xf0\xc5\xfc\xf5\xe3e\xcdOR?`\xfaZ\xfdq\xff/\xe8\x9b\x7f;\x1b]\x0f\xde\xe2o\xbf\xddl=|\xb3\x9d\u06fb\u06bc\xf82\x9c\x0fn\xb6\x9e\xbd\xdez\xaf^\x9e_\u07df\xdd_\x9d_X\x97\xaf\xd6\xef\xbf}}\x1f\xcd\x03Z\xb6\x8ar>/\xdf\x7f\xfa\x14\xbd\x9d\a\xef\xcdo_\x9d\xdb\xef\x9f\xf1\u016f\x1f\xdfR\xfe\x1b\xf7+\xbe\xfbpw\xb9\xbf\xba\xbd4?|\xf2\x1e\xde\xdf.^]=\\\xeco>}\x7fuu\xf7\xf6\xfc\xe6\u04e5yu\xbe\xda_\x9d\x9f\xdd_}\xf2\xf65~\x17s\xfb\xbd5\xff\xfae\xb3\xb88\xf2\xfb\xfe\x86\xe1g*\xf9esg5\x9f`\x8f`\xfb\xfb\u05db7\x1e\x99\xa6\x97\xbf\xe4\xba\xfc@\xe4\xf3\xc2\xf5\xed\xc5\xfd\xd5\xed\xb5\xf3\xfe\xe1\xc2~o^>d\xf3\xc2\xdd\xe5\xfd\xf5\xdd\xfb\xd7W\xb7\u05fb\x0f\xe7\x17\xbb\xab\xf3\xcb\xfd\xfb\x87\v\xff\xc8O>o\x1d\xf9]*\xf9\xbd{8\xfaR\xce&\x7f\xba<\xdfm3\xbb\xc4o/nr\x9f[\x8f\x85~\xbadc,<'T\x8eK\xff\u05c7\xf1\x8b\xf1\xea\xecC1W\x9d}\xcfb\u05f3\x97/\xa6\xc9\xd9\xfa\xecl\xfef\x1dF\xbf|\xc4/\xdf\xfa\xdf_^\xfb\x93\xed;\xf3\xed/\xbf>\f\x7f\xfa\xd5\\\xfcr\xd3\xf1\xef\u064d\x19\xfd\xfd\xd35y\x7f>\t\xff~>\xfd\xe5\u05c7\xcf/n\x1e\xc1/\xff\xbb|\xb1;;\xbbyuvvyv\xb6:?\xfbxyv\xb6\xbexy\xb6\xbfx\xf9br}\xf6\x95\xfa\xcd\xeb3\xe0\x7f\xdf^\x9e]_\x00\xcf//.\xce.^\x9e\x9d]\xbd\xe4\n^\x9e\x9d_\xbc:3/n\xce\xce.\xce_\x8a<o.?\xbd~\xf9\xf9\xeb\xc5\xc7\xcb\xed\v\xf7\xeclw~v}\xf6\xea\xe2\xfa\xea\xe6\xec|r\x16~\b>}\xb1\xae?\x9d\x8f\u07feM\u05bf\x0e\xb6\x1f\xaf\x06\xbf\xbex\xf1]3\xc0\u05db`Z\xc5\xf7t~}u}\xf7ue\xffo|\xff\xffw|\xaf;\a\xb0q8\xf5\xad\x9as\x96m\xa5\xf5y\xab\x88!u\u7b47/\xbf\xe0\xdd\xf7\x8f\u04cf\xdf\x7f{\xbf]\xfc\xf6\xf6\x96\xda\xd2w\xff\xea\xc9\xe2g\x9d\xb8\x9e&\x06\xae\xee\xde^\xe4I\x97+\xab\xd9\x7f\xab\xdf;\x8e\xfc\xbe)\xf9=Y|\xff\xf0\xb4\xf1\xfd\xcd\xf9\xffT|\x8fFo\aw\xd4C<\xf0\xc9\x12\xf3\xf3yr\xb5\xbb\xba\xfdx\x13~\xfb\xd5y\xf3_\u00cf\xbf\xbe\x9f\xdf\xdc~\xb9\xba\xb8\xb6\x96\xafn\xceW?\xf9\xc1\x8b\xd1Oo\xad\xb3\xb7\x9f\xf7\xc9\xea\xec\xe2\xcd\xd9\xd9\xc0zy\xf6\xf6\xea\xa3\xf9\xe6[\xee\xcf?~\xfe\xf2\xe1\xe6\xef\u03abo\x97\x97\r\t\x94\xe3R\f\xbb\x83\xe5D\xb5\u01c5\xee\x889i\xdcM\xb0`7\x13,|vs\xcb\x02\xb3\xbf\xa6'\xd0\xe2\x9aj\x17\r]\x97=aw\u0130\xbf\xda\xec\xaf\x03\xf6\xd7!\xfb\xab\xc3\xfe:Rm\u0621+\xb7\xe2js\xbe\x88\x83\x88\xeb\xe3\xdfe\xa5\xc52\x95\xac8r\x93d\x17\xc6\v) E\xb8\xa1l\x9fJ\v71G\x98/K\xb2\x8f|a;\x10\xf3 d\x1b\u036e\xf5G1\x82611\u03f2uM\xf6\xc9\x02\xdc\x1d\xc0>\\\xb3\xbf\xb2}\xb1\xc1\xf0\x12#k\xefn\x12!/5\xb2U\xb4\x83|\x17\x88\x9c\xc80\xac\xd1~Z\xae\xf9\x96\xfb\x80\x9cQ\xdfv~h\xa2\x9a\xee\xad\x11Oe\x8d\xc7\xfd\xf1\xb8\x91l\xb8\x1f\xf0T\xe3\xe6\x8a\x06\xfb\xa1P\xcf`\xd0\x1f\f\x1a\xa9F\"\xd5h\xd4\x1f)d\x13[\u460d$\x93\xbd#Tc\xf7\x9b\x1b\xe4\xec'B5\xa3\xe6j\xc6b5c\xab?\xb4\x15\xf5\x8c\x85z\x86\xcd\xf5X{K 1\x9bI\xf2\xcdg\xe5\xee!\xb3\u0736\x93orAK\xc6\x1a\xddy\x12\xe2M\x8afqa\xa7tW\x919\xab\xed\x05x0\xfc`\x81\xf6\xf4\x97?\xff\x93\xa0\x85\xef\xf6\x12/F(\xe8\xb9\xc1\xa2\xf7#\xf1\x83b\xe7\xc0\xc0D\xe4\xf9A.W\x90<b4P\xeaN\x03\x02 \xd4\x1b\x13\x00\xa1zX@\xb5i\x8d\f\x80Pgp@\xf5\xa9\xc7\aT\x99\xc6\x10\x81*S\x8f\x12H\x8f:\x03\x05\xaaM=V *\xad\xe1B\t\xff\t#Fc\xc8\x14\x0f\xab\x1d8#\xc58\"\x8f\x19F\xa4\xe3(\"\x1d\a\x11\xe90\x86H\xc7!D:\x8d \xd2e\x00\x91N\xe3\x87t\x19>\xa4\xdb\xe8!]\x06\x0f\xe98v\xc8\x7f\xff\xd0Q\x8d\x12\xfc\x98Q\x82;\x8e\x12\xdcq\x94\xe0\x0e\xa3\x04w\x1c%\xb8\xd3(\xc1]F\t\xee4Jp\x97Q\x82\xbb\x8d\x12\xdce\x94\xe0\x8e\xa3\x04\xffSF\t\xbf\x17\x9b\xdd\xca\u024a\xe5\xd1M\x96\xf5\xdd\xf0\xd9\xee\xe7\xec\xe9\xbf\xfb$\n\xe3\xd4\xe5\xce%\xf4\xe9^pzVC$\u029e\xd7\xc8\xda\x06\x87Y\xb5\u0674\xdbA\x1e\x98\x8e\x17\xe9if\xdf\\P\xd2MN\xf2h1\xa5\x12\xe1n\x12a\x1d\x89\x18\xca\xf9\xca\xf0P\x90\xb2\xa6S\u066bc\xfeP?\u74a7\x81N\x83\xb0\xf8I`\x95\x86\x11\xc8'\r\xa3V|\xb2\xe1\x01r\xca\x06I\x1bV\xf9\xb8\x04y\xe5E\xad\xb8\xd1\x01\r\xf22\x1b\u0674\x1d?U\xafpc\xe11\x1d#c\u0569od\xcc:v\x8f\x8c]\xc7\x1e\x92\xb1k\ue927\xf1(\u01ee#O\xd6s\xe4\t;\x8e<i\xbf=\xe9\xc02\u0213\xf7Zc\a\xe1'\xeb \xfc\x84\x1d\x84\x9f\xb4\x83\xf0\x93v\x10\xee\xd2A\f\xa7b\x83\xbb\xb8\xd1\x1d\x84\x19\xb5\x8d\x98\"IOv\xe2\xb2\"7+\xa2\xf6\t\xae\x92G\x90t\x10\x16\xa4\u0490\xb7N\xf7T\x19\x86\x929\xe9\xd2\x0e\u04ad\x19D\xbb\x15\r\x02\xe3.\x02\xe3n\x02\xe3\xba\xc0\xac\xed\xbb\xeci\xb4\x8c\xe5\xacxTD\xe3\xdc\xf9\x9d\xfe<-i\xa8w\x00\xe8\xe8c\x19mu\xfa-\xf7\a\x00u^ \xa3\x9f\xb3\xa7\x8c!\x06E\x89\x8c\x03.9d\x83\x1e\xa0\u03de\u02e8\x03\xe0\xf4\x1e\xa3\xae\xf6cq\xee\xe69\xbc\xd6\xddP#\xeb\xd2\x135\xf2\xae\x9dQc\u047d?jL\xbau\x89\xa0>\xa8W\x9e(\x00r\xb3\x9cQ\xfb\xae\"\x8f\xea)\xf2\xe8\x8e\"O\xd0O\xe4\x91\xddD\x1e\xdbKp\x87\xe0N\x1d\x82\x1f\xd5!\xf8\xd1\x1d\x82\x9f\xa0C\x1e\xe9\xca\f\xac\xd1!,\x8d\x91\x1f\xe6+\xe9$7\x11P\\\x80\xdc\x18\x04[\x96%\x80\x17n|g\xacb\xf7\x9e\xc3\x0e\x06\x03\x01K\xfc\x05\x04u\x1cG\x80\x02\xb0\xf1x,\xc0\x12\x1foQ\xcc\x01\xa7\u04e9\x00\xc4ygCp\xd7uEI\xc30\x80d\xf0<O\xc2\x1a\x00#\x84`\xddf\xe709\xf0rH\xff\bx\x10\xba\\\xc28cj\xb2\xd0u\x82\xdd\x1f\xcd\x13\xf3\x87\x13\x1a\xa7\x9f\xf4\xa7\xcf%\x84\x13\x05\xe1DF8V\x10\x8ee\x84#\x05\xe1HF\xe8(\b\x1d\x19\xe1PA8\x94\x11\x0e\x14\x84\x03\x19\xa1\xad \xb4e\x84\x96\x82\u0412\x11\x9aN3\xa1)\u054ei\xabHm9\xad\xa5$\xb6\x00%e.F\xb0\xd9x5\xcfHOL\xd0^s\xa2I\x03\xd1DF4n \x1a\u02c8F\rD#\x19\x91\xd3@\xe4\u0208\x86\rDC\x19\u0460\x81H\xaar\xbb\x81\u0216\x11Y\rD\x96\x8c\xc8t\xe4D\xa6T\x13\xa6\xddDf\xcb\xe9\xacFB\xc8\x06\xb3\xe9+F\v\xdeu\x8f\u0361)zY\x11\xb8\\\x0e\xad\xc1H2'@\xf0\xb1\xed\x88SH\x18\xbb\xc1Jt\xf3\xa3\x010;\xd3\xc3\xfd\x02r>\x06\x90\xf7\b\xe3p\xc7b\u016b\x01*i!\xf8_\x96\xf3\xa5\xe5\x8a\xd3i\xb4\x89#\xcc\v\xec \xdbs\x1d\tw\x90\xc2\x1d\x8e\x06K\x1b\xee\x94\xc8\x0f\xf8\xf8c\xe1\x98\xe6D\xec\x95u\x98Bh\xda5sqF\x05\x91\x13S:\xb1\x83xw\xb0\x18\xcbB!\x84\x02>n\x1a\x8c\u01ce\xd8L\x10:u\xa7\xe3\xa14\xc6\x10\t\xa6\b\xcd=Q'\x81\xbb\xbd\x17B=k>\x1c\xc2R\xcf\xf1\x06\t\xf0\xe1p\x8a\x80\x91& \a\xce\x18-dV\x05\xe0\xa7#\xcf[.a<J@\x12o\x81 \x92\x9d\x9b\xac\xd1\x02\"X\x8e\x96\xcb%\x92\x11@\x8aD\x93\xe5b\xe9\xc8(\xe0\xc1\xb1\\zh.#\x81F\xffb\xb9\x10[QO&2\x04\xd2\xfc\xd0\xdc0\x8a\xabLX\x02\xe8~\x93\xfe<\xae<w\xec.\xfcMr\u02bb\x8a\xd8\xe2\x00}\xcbvb\xee:\x93\xfe<\xb6y\x18\x88\x1a\xf0(\b4\xe4@\x16\x801,\x93\x97\\\\_\x9e\xc7F\xe4c\xcc\xe1\xa6\xd3\xe9\x14x\xf7\xabV\u074e/\x8a\xd9{V\xa9\x97\xfa\x1bd\xfe\x16(\u04d8\x91\xaf)2o{ \xab\xa2L\u016dXYT\xf3\x13d\x16y\u52c1\r\xd574\xb3u\x06,6\xeb\xc9(\x99}\x89\x18\x99\x89\x01H\xd8\xca\x00 hh\"Nfk\x00Rfn\"\xb4\xd9\xe2\xb8|\xdfc\x8cNL\xdc=\xda\xee4Yj\x9a\x9e\xc8M\xdb\xfa\x9e(\xd3\x17\x9b\xb5\xb4\x9b\xd4$\x89\xaeE\x12M\x83$z\xf6Ht\u0351h[#ig\x8cO\xe6\x00\x85\xdc\xe4\xe3M\x91<\xa5%\x92\x7f\x8e!Jl\x0e\xabm\x0e\xeb\xda\x1c\u05b49\xacgsX\xd7\u6c36\u0375\x9br\u0664\xed\xa3l\x0e?\xb9\xcd\u19f49\xdc\xd9\xe6\x84\xc8<\xbb\xa8\x91M\xf4\x02\x17\xe7f\xd0,\xe4\xe4\xa0\xd93\x01Z\x9c6\x16\xf2\xee\x02\x90\u6501kV[G\beC\x84\xb5\x9e\u01b6\x88\xe8\x86\xe6\xc0\xabp`\x8b\xc0\x15\xa7\xa7\x9ax\xaa\xa6\x926-%-\x1aJ\xb4\xdbIZ7\xb3\xb1E\xb8M\x8bp\x8b\x16a\xed\x16a\xa8E,rW\xbd0\x94\xab \\\xb9\u0156\xc3\xfewgs(\x104\xe0@\x10f\xc8b\x00\xb7\xbbsX\x88-BR\xc3<\bK[\xa2\xfb1\x0f\xc0\xfa\x95)\xac^\x99\ah\x8d\xca\x14V\xa8\u0303\xb8\x12\xd5\xe1\xfd`W\x0f\xabe\x9d\"@d\xfd\"\x02\xe1\xae\x11q`\xef\b0\xb0\x83\x04\x14\xdcG\\\x8c/\xed&a\xf1^\xdaSb$-\xed,~%\xfe\x89\xd7\xcew\xb5hT\u0689D\xb3\x0f\x89^\x17\x12\xad\x1e$:\x1dH\xb4\xfa\x8f\xe8u\x1f\xd1\xed=\xa2\xddy\xa4C\xdfI\xba\t+\xbb\tkv\x13\xd6\xeb&\xac\xd5MX\xa7\x9b\xb0V7a\xbdn\u00ba\u0744\xb5\xbb\t\xc3\xdd\xc4\x00\x93\xb5\xbb\bw\x86\x95\x1f\xdb\xce~95{fo\x18\xed{v\xb4\xef5\xad\xb5\x14\xb46O;\u0467\x1d\xd4i\ud0aeE\xddC\x88\x9e\xd6o\xeaP;u\xeaaQ3H\xddv\x1e)\u055a\xfb\xb9\u039a\x05\xc8\xdb(\x97#\xef\xa2_\t\v}\x15s\f\x1a\xb5\xfc4\u07bf\xd2=y\x94\xea\u02634O\x1e\xadx\xf2H\xbd\x93'R{\x93\x86\xf1\xa34\x8c\x1f\xa5a\xfch\r\xe3Gj\x18\xebk\x98a\x10\u0168\xba\xcb\xdf\xd8gW\xe0W\x1f\xe21\ue2cf~TON\x13/\x0e1\xfbQ\x81>\x9dK\xcc\xec\xfb&\x9c\xe3\xcfg\x0f\xf3\x10\x03\xdf!\xe9\x173\x86Y\x9d\xb2c\x8b\xb3i\xc2<\xe0\ucd1dX\x9f\x95\xd5'N\x85y\x95VQ\xa5X^\xd4j\x95\xb5\x8a\x88\xacb+\xafX,\xa5u\xdbY\u0776\xa4n\xbb\xa8\u06d6\xd5m\x97u\xdbp\xddv^\xb7\r\xd6]4\u0710\xb5\xbcj\xba!m\xfb\xb1\xf1\x86\xa4\xf5e\xf3\r\xb8\xfd\x85\x02\f\x99\x06*\x15\x18R\x1d\x1c\x95`H\xb4P\xaa\x01(/O\\\x1a\xf4+\x06\xb5S\x9a\xc5\xc9L\xe6\x1cf\xeb\xc923f:O\x00\xf6\x9c\xdb$-\x84\u0332\xb0vZ\xdch\xf0\xf5\xab\v\x00\xc3.\xeb\x96YfU\xbd\xd4\xf8\x8f\x12\xc8\xed\xbf&\x84\xc4\xc8K9dVZ\xc9!\x1d\bG9\xe4c\xa1&\x87\xcc\xe0KA\x1al\xfeXS\x93\xd9\xd7\xea\x92[~\xd5,\xa9\xf1W\xf2\xc8\xed\xbf&O\xc3\x10\xa8\xcb#\x1d\x05GyT\x03\x81\xb9\x12\x03\x1c\vO\x13\xd2\xe4#\x844\f\x10\xd28>\x88bx\x90\xc6\xd1A\x9a\a\aQ\x8d\r\xa2\x1e\x1aD52H\xf3\xc0 \xaaqA\xd4\u00c2(G\x05Q\x0e\n\xa23&\x88\u0190 \xaa\x11A\x94\x03\x82\xe8\x8c\a\xa21\x1c\x88\xeeh \x8f\x1a\fR\xbb\xc7\rv\x8f\x1b\xed\x1e+\xec\x1e7\xda=n\xb6{\xac\xb2{\xac\xb6{\xac\xb2{\xdcl\xf7Xe\xf7Xm\xf7Xi\xf7Xi\xf7X\xc7\uec46\xddc\x95\xddc\xa5\xddc\x1d\xbb\xc7\x1av\x8fu\xed^\x15\x10\xb1\xc4\xde\xf2\xd4]\xf2W(\u04a7s\xb4\fct(>\xd4y\xfa\xac\xf7\x8c\xfd\x06\x19\xcc\xe6\xe0a\xe4\xc6\xf4,\xea\x9a\a\x1c\xfe\xfa\x10R\xdb\xe2\x9e\u3084\xca\xca\x15\x95\xdc2\xf9\xb9\xb2\xb9\xbc\xa6\xa0(\xea\xb4\xe2\xe6e\u04e8\\\xa4c)(\u0571\x18\x12\xecX\xfa\x84\vg\x1euu\r\x02\x93FyI\x93\xb8D_ZX\xb0\xa6\xce5p\xa3`\xb8I0\xcc\b\u0194.1\xdaW\x1f\xfe\xa2\xbf\xb0\xc5\u0157\xc4\x18T\xed\x99\xc8\u02e0o\xc4\a\xfa\u04e9\u0573z\xf4\xb7\x19mvu]\xd1Q\a&@\x9d-\x04g\xd4\xe2G\xc82\x80\x17\xe2\r\t2\x88\xb1\xf0\xe3\xfcj\xcc\xd3\xfc)\x00\x8f\xc3\x1d\x8f\x8d\xc3\x1d\x00\xdc\xc5nt\xa8~:\xa5\xff\x80\xe2q\xb8 \x94 \xe9c#F[\x14'\x88\xe3[>\x96\xb6\x8e\xa5\xe3[\xd9@\x1e\x87;\x19m\xad\x88\xeb\xe1\x14\x91\xc4HR7N\x0f\u0647\f\xb3\xaf\xcb%\x99-\xe4\x8f!<\n\x16\"\x1a\x05\v\b[\\\xaeR\x87\xe7\x8f p\xf9\x15E\x06\x0e~Z\xb1\x92<F\xa9\xb7f\xf0\xc53\x16\x9e \xbcd\xdaI\x1fH\x9b\x99\xa1\x8f\xad<b\x85FfH\xa6\x8d\x19\x18jb\x06\xe5Z\x98\x81\xe1\x06\x16\x12\xd7\u06d7\xa1\xc1\xe6\xd1\xefp\xfb\xcb\xfb\xa2\x85\xe5o\xe5|$kf\x89\xa3-\x05i\x84\xe6V\xa8\xbc\xc5<\x11\xd4\xec\x123G\xe9\x0e\xa1@\xa0\u027e\xbfY\x96\u00a4nv\xb3\x82\x842/\x14\xaf\x03BAZ\xa8#W\x9eJ\x19%\u0371\u06dbUQ\xe2\x99\xceoRDIP*\x82\xa5hPCIX\xa8\x01\xa2kVB\u0746*:\u020c\xf2E'\xf3\x90\xfd\x97w\xd1y\xa1U\x14ZP\xa1]\x14\xdaP\xe1\xa0(\x1c@\x85\u00e2p\b\x15:E\xa1\x03\x15\x8e\x8a\xc2\x11T8.\n\xc7P\xe1\xa4(\x9c@\x85\xd8M\u04a2\x9cn8\x9b\x02\x0ewE\u076ay\xa8~\x06\xe7\xb4\fd\xd5@\x16\x00J\u05b1\x1f\u0715\xbc\xf2\xdf@n\x05\xd0b\x80V\xeb\xc0-\xa3\x0e\x12\xbd\xa9\xbf\x0eT\xcf\xfe\x14\xfd\xa8\x00\xa0b\xd0\x18\x03\x94(\xcd0\x00\x80\xcb#\x81\n\xac\x0e\x06X\xa8V< r\xd7\x0e\t\xa4\x8d\u058b\n`%(\x03\x03J\xd6*6\x00\t\x1a\xc3\x03\x9eB\x1d!\xf0\x14\x1aA\x02O\xa2\x88\x13\x8ep\xadP\x01\x827E\v\x1c^\x190pxu\xcc\xc0\x11\xa8\xc3\x06J\xd0!r\x90\x92)\x82\a\x88N#~\x80\u0234C\b9\xb12\x8a8\xaa\xb3E !!R\xc4\x12\"\x95F8!\x12iG\x142R\x8d\xa0\x02 m\x88+(\xba)\xb48\x96\xc3\xd1\u0171\x1c\x0e0\x8e\xe5p\x8cq,\x87\u00ccc9\x1ci\x1c\xcb\xe1`\xe3X\x0e\xc7\x1b\xc7ri\xc8q\x844G\x1d\x95/W\x04\x1e,\xae)\xf6\xa8\x90\x1a\xe1\a\x8f\xb5\x9e(=\x93\xf1$\x9aa\ti\x13\x95\x90G\x06%D'&!\xadB\x12\xd2\"\"!\xda\x01\ti\x1d\x8f\x90\xce\xe1\byT4B:\x05#\xa4e,B\u0686\"\xa4m$BZ\a\"\xa4U\x1cBZ\x85!\xa4]\x14B\xda\x05!\xa4e\fBZ\x86 \xa4S\x04B\xba\x05 \xa4S\xfcA\x1e\x11~\x90\xce\xd1\a\xe9\x10|\x90.\xb1\a\xe9\x10z\x90\u0391\a\xe9\x1cx\x906q\ai\x0e;Hs\xd4A\x9a\x83\x0e\xd2\x1cs\x90\u64034G\x1c\xa49\xe0 \xcd\xf1\x06Q\x86\x1bD/\xda z\xc1\x06\u044d5\x88~\xa8AZE\x1a\xb2\xa0\x02k\x06\x15\xb8MP\x81\x1f\x19T`\x9d\xa0\x02\xb7\n*p\x8b\xa0\x02k\a\x15\xb8uP\x81;\a\x15\xf8QA\x05\xee\x14T\xe0\x96A\x05n\x1bT\xe0\xb6A\x05n\x1dT\xe0VA\x05n\x15T\xe0vA\x05n\x17T\xe0\x96A\x05n\x19T\xe0NA\x05\xee\x16T\xe0NA\x05~DP\x81;\a\x15\xb8CP\x81\xbb\x04\x15\xb8CP\x81;\a\x15\xb8sP\x81\xdb\x04\x15\xb89\xa8\xc0\xcdA\x05n\x0e*psP\x81\x9b\x83\n\xdc\x1cT\xe0\xe6\xa0\x027\a\x15X\x19T`\xbd\xa0\x02\xeb\x05\x15X7\xa8\xc0\xfaA\x05\xe6\x83\n\x06\xbb\b\xaa(@\x9c\x9b\x17>\x17\"p\xc5\xf3\xaax\x8eC\xef\x8e'\x9es\xd4\x06\x88Jy\x14\xb0\x8dhq\x04\x81\xa5\x1e[lx\x88?\x8b\xb0H\xb3-\x12,L\b\x18r\x14\xd5\xff&\x12\xb1\xf9s\x81\xa2\u0629\xc1I\x00D.\x15\x16\xac\xa0^$\xd0\x19K\x7f\x8f\x16\x87\x1c\x89\xdd\xfbp\x93\x9ef\x8fj\xdf\x13j\xbd4\xb6\b\xea\xeb]P\xf7\x8b\xeba\xbc\x05\xd4\x11\xb0\x11\x88<$v\x00\x00\xc1\u03ae\xe3`k\x10\x10R\x83\x10\x91\x8d6\x01\xc2\x1b\xcd\x02\x10Em\x192\xa2F\xe3\xa0D\xcd\xf6\xf14\x89\xcbE`\x90f\xa3!*\x9b!*\x93!\x9a\x16C\xf4\f\x86\xa8\xec\x85\xe8\x9a\vie-\xa4\xad\xb1\x90\x0e\xb6B:\x98\ny\x94\xa5\xc0F\x81\x9b\x8d\x02\xab\x8c\x02\xab\x8c\x02k\x1a\x05\xd63\n\xac2\n\xack\x14\xb8\x95Q\xe0\xb6F\x81;\x18\x05\xee`\x14Xe\x14\\\x90q\xf8\x83\xd5\xf3l\x89C7\x05\xf6t.c\t\x14\xd8\xe1\xb9\f\x0eyY\xa7]\xba\xcbl\x97\xae\xbe\\r4(Z\xbe\x16TI\xf7T\vA\x06i#2i%1\xd1\x17\x18\x96\xadM7\x1b\xb8\x95l\x98\x91\x8d)M\xdc 1\x12\x14\xfb\xcb\xc32\fRc\xe9\x12\x1f\u07df\x1an\x14ad$\xf7I\x8a\xc8\xc9K\xec\awW\xae\xf71\xfb\xf5u\x18\xa4'\xee\x16\x05~\xdc\v\u043e\xfc\xf9d\x8d\xf0\x16\xa5\xbe\xe7\x1e\x7f\xea\x05h\x83N6\xf3M\x90nN\xe2p\x1e\xa6\xe1I@\xffI\xd0*D\xbd\x8d\x7f\xe2\u01be\x8bO\x8eRp\xd2\t\x82\xadP\x18\xaf|\xf7\x04\x02g\xd2\x19\x92\x16I\xab(\xa8D\x02\x11\xeb\x85\v\xf6\x13\xf9\xf4\x01C\xf4*\f\x92\x10\xbb\xc9\t\t\x03\xd7\v\xe9\x7f\xc2\xecU\x91g\xb4\x89}\x14s\xa4\u0673\xde{\xaa\xd2\x02 \xa3\xaf\x14\xccp\xe0\xd4~\xec\x0fY\xdb\xf3\x8ecx\x00\xfd*\xa5N\xd7\b\xbb\tK\x9e?;i\u89a2H\xb7WS\x9f \xb6\x8e\xec\t\x04\x9d\x87\x8b0\xf0\x19\xec\xcb\xecQ\xef\xea\x13\x84\xf7\\\xec'i\xc8\x10\xbc\u029fI(Vn\xec\x920X\xb0\xc2\x17\x0fA\x91\xdc\xe4\x0e\xc5[\x1fc\xd6Nj\xcf!\xb2\xa2\x11\xf9UQ~\xeab\x9f\xbb\xe0y\x99\x18A\x18\x13\x17\u05c1\xf9\x93\u05be<{\xf9\u042d\x8f\xc3\x16U>\x8d\x83\xa6\x01\xad\xb6\x1c\xa4\xb5\x18`\x8dX\xbfFH\xd7,\xb6\xde%\xbb<g?\x14\xae6g\x8a\x85\x9b\u03d7;\x8b\x01X\"\xc0f\x00\xb6\b\x180\x80\x81\b\x186\x8b\xb8\xdc9\f\xc0\x11\x01#\x060\x12\x01cU3'\f`\"\x02\xa6\f`j\xb6?I\xceY\xac\xb4Gx\x04\xd4)<\x06\xea\x17\x1e\x03u\r\x8f\x81zG)\xf1r\xe7\xf0\x18\xa8\x8fx\f\xd4M\x1am\x9f\xf0\x18\xa8\xb3x\xcc\xf4\u027e\xe5\u03cewy'\x12u\x1f\x12u\x17\x12u\x0f\x12u\a\x12u\xff\x11u\xf7\x11u\xef\x11u\xe7\x11u\u07d1\x96]'\xef%\x95\xf33\xb0J`\xcb\xc0\xea^\xc2\xea^\xc2\xea^\xc2\xea^\xc2\xea^\xc2\xea^\xc2\xea^\xc2\xea^\xc2b/qK\xce\xd1&5b\x94\xa0\xf4`\xec\xd0\xfc\xceOi\x18\x8f\xdc\xd8\r\xbc\xe2+[\x06\t\x1f\xf8\x87\\\x17m\xd24\fr6\xa7\xa7\x19~\x19z\x9b\xc4\xf0\x83\x80?\xa5Z\xabQ\x84\x16\u05cd\xd1/\xb5\xe7_\x7f\xe7\xb3\xe7k\xebP~\xd6]8R\xbb\xb6\xcb2\xf1\xb8\xedzP\x96\r\u0172aY6\x11\u02dc\xaa\xbe\x91Xh\xd8U\xb1\xcd\x7fs\x7fM\xbf\x89\xb3.m@(\x1cW\x94c\x91\x92~\x06\xa0\xf6\xf5z\xb68[\xdc\xcf \xb5e~\x11\xb6e\xa5\u06ee\x85\xe2\xba|@q]B\xa0\x98\x95\x91\aP\u0476\x90\x94<p\x9d\x1f\xd5,\x00\xf4g\xbe\xbc\xfc\xeeC\x01)~m=\xb1\xaf\xb3\xe9\xb8\xc1xj\u0150\xfd\u050a!\x13\xaa\x15CVT\xaf\x1b6\xa4z\xf5\x90-\xd5\xcaAs\xaa\x95\xc3\x16U\x17AnT\x14\xa5\xb6+FX\u0434\x18qA\xebb\x04\x86\r\x8c\x15Ync\xa2\u0430\x99\xd5\xf85XZ\rU\x1a\xdb\xd3D%k\x1aM4Y i4@\xd2h\x7f\xa4\xd1\xfc\x88\xca\xfaH\xb3\xf1\x91f\xdb#\n\xd3#:\x96Gt\f\x8f\xa8\uc3a8\u030e(\xad\x8e\xe8\x19\x1d\u04739\xa2cr\xa4\x9d\xc5\xc1\u0185\x1b\x8d\v7\x1a\x17n4.\xdch\\Xe\\\xb8\u0678p\xb3qa\x85qa\x1d\xe3\xc2:\u0185U\u0185U\u0185\x95\u0185\xf5\x8c\v\xeb\x19\x17\xd61.,\x18\x17\x03Lc\u05fbC\x8b\x03Fi\x8ab\x83\xa6\x0ei\xe4\u0577\x10\x01\x81FJ\x99\xf1p\xa3o:2\x02\x82V\xae\xc0\u07a6\xf0\xd6\x17\xbd\x15\x1c\x83D\xe0\xd7,.@\xa1\x92\x18\xaa$\x13\xfa\x89n\xe4*\xabj\xdb\x12\u04ba!\xa4K;\x1aD\xc6mE\u01adE\xc6\x12\x91\x19\n\xbc.>\ue42d$\x96\x83E\xc0\xa4~\x8a\x11\x8b\xe9\u06ce\x00\xf3\xc2\xe8\x9eC9\xad\r\xb4\x14)3\x1e\xb5T\x02L&\x98\bt\x9e\xc8\x0e+\x89\x89\x96\xc0DS^\xd2R\xdc&\u0270\x96dXS2,J\xc6\xe2\xe8\xf7\x0fS\xb4O\x8d\x05\xf2\xc2\xd8\u0376\xef\x8a/\xbd\x14v\"<9u\xbd\xd4\xdf\"\xa0 {\xcd\x05\x9e\xaf\xe95\xad\xc0s\t\xff\xad\x9f\xf8)\xddm\x14\xbbA\xe2\x97\x1b\x93\u00f8\u05f7\x9c\xa4\x87\xdc\x04\x19~\xf0\xa7\xa4\xfaC\xb8Ii\xe3\xe9\x17\xb6{\xf9\x17-z\xde&\x8eQ\x90\xbe\xa2Lx\xba$=\xd0\x7f\xf2\xfc\xb5\x91\xdeG\xd0\xdb?\xd9\xe5\xefy\x95\x91\x01\xf3\xf0\u03aa\x97\vQ\x02\xd9\u0675r\x1b(\x1f\xd4\u0287@\xf9\xb0V>\x01\u029dz\xfd#\x000\xaa\x01\x06\x90\x04\xe3\xba\x04P\x15\x93\x1a`\x04\xc98\xad\x01\xa6\x90\f\xf9\xc5CGP\xa7e\xf6\xbc;\xb2W\xa2\xe6\x1e\xe1 P\xa7\xb0\x10\xb0_X\b\xd85,\x04\xec\x1dN\x16\xb0\x83X\f\xdcG\x9c4`7\xb1\x18\xb8\xa7X\x8c\xbc\xb3X\xdc\x13n<(z\x91(;\x91(\xfb\x90(\xbb\x90({\x90(;\x90\xa8\xfb\x8f\xa8\xbb\x8f\xa8{\x8f\xa8;\x8f\xe8\xf5\x1di\xd7u\xf2^\xc2\xca^\xc2\xca^\xc2\xca^\xc2\xca^\xc2\xca^\xc2\xea^\xc2\xea^\xc2\xea^\xc2\xea^\xc2z\xbd\x84\x85^b`;\xeb S\xeb\xce>\xc8\xf4\xb9\x1b\x1cd\x8a\xdc\r\x0f2\r\ue703Tu\xd4\x12\xcaR\xde\x02v\x86]\x96\xd9@Y\xc9Ux;\xde\u046f\x9d\x97~C,+\xdb0\x18\x88ee#\x06C\xa1lX\xf2\x1c\x8a<\x9d\xb2\xcc\x11\xcbFe\xd9H,\x1b\x97ec\xa0\xacl\xdfXl\u07e4\xa4\x9b\x88t\u04f2l*\x96YfY(\x8e\xb7\x9d\x91\xae\xfdxQ)\xa7?\xa8\xfe'\"wa\x8eN\u02b6\x8d\xfa\xa3\xf2\x7fc\x01\x9e\xe5\xaas \xfd\xb1\xf5\u071cO\xbar{=\x96B&{,\x85\xac\xf6X\n\x19n\xad^\xd8vk\x00\xc8|k\x92A\x16\\+\x86\x8c\xf8X\f\xdaq\xad\x182\xe5Z1d\xcd5\xb5@\x06},\x06m\xfaX\f\x9a\xf5\xb1\x18\xb4\xecZ1d\u0735>\x81\xec\xfbX\f\x9bx\xbdO$V^W\x9d\x9e\xa1\x1b\x81\xbe\xad\x1f\xb1\xf4\xb7'\nm\xb2\xa0\xa5a\f\x90\xa6!@\x9aF\x00i\x1a\x00Da\xff\xa4\xd1\xfcI\xa3\xf5\x93F\xe3'\x8d\xb6O\x1aM\x9f4Z>i4|\xd2h\xf7\xa4\xd1\xecI\xa3\u0553F\xa3'\x8d6O\x9aM\x9e(-\x9e\xb45x\xa2o\xefD\xdf\xdca\xcb\xc6M\x96\x8d\x9b,\x1b7Y6n\xb2l\xac\xb0l\xdch\u0678\u0472q\xa3e\xe3F\xcb\u018d\x96\x8d\x1b-\x1b7Z6n\xb4l\xdch\u0678\u0472q\xa3e\xe3F\xcb\xc6\u0356\x8d\x95\x96\x8d\xdbZ6\u05b7l\xccX6\x83\xa8\xbe\x1cDsKs|\xfc\xb4\xd0i\xf1@\x02\xcf?3tD\xe7\xbfK\xc0\xf97\x88\x0e\x8d\xdf$\xaa\xc0Y\x9cUA\u0175\x8d\n\xb8\x17d6\xf6\n\xa9\xf7\xbc\xdc\u01beY\xf2=/\xbb\xb1o\x96~\xcf\xca_|\xa0I\x02\xbe\x17[p\xafh\xc1\xbd\u0402\xfb\xe6\x16\xdc\v-\xb8on\xc1=\u05c2\xfbn\xe1.oX\u0665bml\x8b!\xd00/\x06\xaf\xb40\x06\xadcdu\x02m;\u3234L\x8d\xa3\u04706\x8eB\xc7\xe0\x18\x12]\x9b\u3234\u030e\xa3\u0470<\x8e\xe2\t\x83O\xc1$IK\x8b$\xed\f\x92\xb4\xb1G\xd2\xd2\x1cI\ak$\ud351\xb4\xb5E\xd2\xda\x14I\aK$\xed\r\x91\xb4\xb5C\xd2\xd2\fu,\x0e\xb7\xb48\xdc\xce\xe2\xda\u0331u\xb0\x96\xc5\xe1\x0e\x16\x87\xdb[\\\u06f9\x96%\u04328\xdc\xc1\xe2p{\x8bk;\xe7\xb2\x04@\xb0\x96\xa4n\xea{\x87(,\x16\xff\xf2\xdfYL\x8c\xb0KW!\x8f\xa8\xf2\t\xfc\x11\x9a#\xae|\xc2\xe2\xf2\xfb\r*P\xf6k\xfb\x0f\xdaf\x82R\u03ee%;\x03l\x16\x9f\x816\xb4\x80\xc1\xe5\x8dx\xa2\xef\xc5\xe6M#\x9a-#\xda\r#z\xed\"\xed\x9a%o\x01\xd6l\x01\xd6n\x01\xd6k\x01\x16Z\xc0\x0e\x92,\xe1\x1b\u04ad \xe9\xbdp\x93\x8d1=\x96\xf5\xa7|\xe1\xa4V\xc8_\x15c\x8ck\x85\xfc53\u01a8V\xc8_Qc8\xb5B\xfez\x1bcX+\xe4\xaf\xc61\x06\xb5B\xfeZ\x1d\u00ee\x15\u06a2\x12\x8e\x85\x82\x12L\xe7Xh\n\x12\x99v\xbd\xd8\x16\u02ebR\xfeCha\xea\xa6\xc8\x18:\xf9\x8e\x83e\x18\x93\xd3\xfc\u064fCg\x81V\xcfA\xf8\xd4\x14\xe1SS\n\xb7\x06\x00{k \xe7oM\x80\n\xac\x89\xbc\x06\xdb\x06j\xb0my\r\xf6\x18\xa8\xc1\x1e\xcbk\x18X@\r\x03+\xaf\xa1\xad\xb3\xac\xb4\x9e]\x1c\xd2J\xf1 E\xb3\xeeA\x12\x85\xfaa\x9a\xe6\x1e\x00i\x14\x9d\x00\xd34\xf7\x03HSv\xc5\u04f8\xfcc\a\x91\xb6\xfdCZw\x0fi\xdf;\xa4}\xe7\x90\xf6}C\xdaw\r\xe9\xda3\x8d\x9d\x80\xdbv\x02n\xdd\t\xb8}'\xe0\xf6\x9d\x80\xdbw\x02n\xdf\t\xb8\xa1\x13\x18\x9a9v\xbd;\xea\u0373=f\xa7\xf5\x8f\x92O\x9fC\xd0\t\x04\x9d\x80\xd01\x04\x1d\x83\xd0\x11\x04\x1d\x81P\a\x82: t\bA\x87 t\x00A\a \u0506\xa06\b\xb5 \xa8\x05BM\a\x80\x9a|\xbbvk?\x9f|s\xec:\xc1\x19\xf6\x87\x13\x9as\x16\xbb,\x87Od\xf0\t\b\x1f\xcb\xe0c\x10>\x92\xc1G \u0711\xc1\xe1\xa6\x0ee\xf0!\b\x1f\xc8\xe0\x03\x10n\xcb\xe06\b\xb7dp\xb0K\v\xec_L\xfe\xdcf\x80\xdc\xd8` \x96\xc5E{\v7\xbe3V\xb1{_\"\x06\x83\x01\x7f\x94`\xc1\x00\x1c\x87\v\xf9\xea\x85\xe31\x17\xfb&>\u07a2\xb8,\x16.O\xc4\u0666n\x16\xe4\xba.'A\x18\x06\x8c\b\x9e\xe7Al\xea\x10\x84\x10\xa0\x8bL\xbb%d9\xa4\x7f\x00\xf5W\x80\xe5\x12PV\x8c\x16U%csh.\xf97\x9b\u0151~h\rF\x90\xa4\fhl;\\s\xc2\xd8\rV51F\xc2\x01\xe2U\x88k\x1c\xe6\u00a1\xde{\x84q\xb8+\x10\x14\fI\xc1\x80\xfe\xb2\x9c/-\x97\xeb\x9eh\x13G\xb8\x12\xc4A\xb6\xe7:\x10'\x16\xe7\x0eG\x83\xa5\r\xa8.\xa2\xfb\xa1\v\xd0\xc21\xcd\t\xa7\xbbu\x982\x18\xaa\xc09\xd7Cl\xf9\u0104M\x81E\xb9\x83\xc5\x184{\x84\x82jd\f\xc6c\xc7\xe6-\xbb\x0e\x98\xba\xd3\xf1\x10\xb6\xbb\x1al\x8a\xd0\xdc\xe3\xda\x15\xb8\xdb\xfb\xe3 \xb5\xe6\xc3! \xcd\x1co\xd0\x114\x1cN\xf9\xa3\u0475\xf2\x813F\v\xb0O\xeb\xa8\xe9\xc8\xf3\x96K\x00\x85\x12\x16\xe8-\x90\x00\u0739\xc9\x1a-\x18\xd8r\xb4\\.\x11\bcT\x80&\xcb\xc5\xd2\x01q\x9c\xc9-\x97\x1e\x9a\x83@f\x84,\x96\v\xe1.$\x1c\xc6\u0541\xde\x1cX\xfc\xc6imeT\x91\xc7\xdc\xf5\xeeV\xf9\x85\xba\x1aAHI8Q\x10Nd\x84c\x05\xe1XF8R\x10\x8ed\x84\x8e\x82\u0411\x11\x0e\x15\x84C\x19\xe1@A8\x90\x11\xda\nB[Fh)\b-\x19\xa1\xe94\x13\x9a\x80v\xaa(H\xa0T\x05D\x15\xf1D\x83x\"#\x1ek\x10\x8fe\xc4#\r\u244c\xd8\xd1 \x96*l\xa8A<\x94\x11\x0f4\x88\a2b[\x83\u0616\x11[\x1a\xc42\xf3\x12)\xc5pl\xbe2j\x11\x99H \x04g\xf3\x95q\x8c\xcfD\xbc\x10\xaa\xcdWF\x15\xad\x89p!p\x9b\xafdP!\x8c\x9b\xaf\xca M\x04\vA\xdd|e0q\x9dH\"\x84xT\xf2*\xca\x13\xf1B\xc0WU!!\x10\u00bfR\xf7y\x80'\x12@\xc1\xe0|%\x87\xf3\xd3\xe5|ed/\u0091\x1b\xa3 \x15)j\x85p\x1f\xd3\t\x0fh\a\x10a\xceW\x120\x14oV\x8a\x92\x91\x88\xd1\xe7|e\x14\x01(\x84\x17cQjF4\x1c\x85\xd0bd:_\x95A\x80\x80\x17\xe3\xd4Jz\x19\t\x18\xb5\xceWe@\n\f\x02 \x86\xadj\x91RA\x11m\xd9qY\xa8)\x92@\xf1\xed|eT!.\xdc}s\xd1\x04\xa5h1\xf6=6DF#F\xc2G\x1fC#8\xc0)\x01q\xf1|%\x87\x03Qrm\xb0\xc2DP\xccL\a,\r\x9b!\xbf*F\xd0e+\xb2p\x15\"\x01\xe2\xe9\x95\f\rE\xd7U\x1b$4P\xac]\u04a0DJ\x06E\xde\xd4\xed\u0502o\xa0\x1b\x818\xfcH$S2\x14\x95\x1f\xa9\xe4\x03\f\x88\u044fd2\xaf\"F\xec\xf3U\x15\xae\v\x04`\u479df\xcd\xe7J\xe8\xc4k\xbd8\xfbY\x9a\x05\u0251\u01d9W\u03ad\x86aX\n\x13s\x0e\xaf\xe6f9\xc7#\x84a(\xcc\xdc9\xba\x9c\xbc\xe5\xfc*\x04\xc3N\x98\xd9sp3+\x91\x8d0\xeb\xe7\xc0|\x16\x973*\xca\x19VBL\x90C\xeba\x81\x9c!\x83b\xd8\nqC\xa1\x952thP\\\x05a\x18\n\x81E]\x82f\x8e5\f\xc3R\b=j\xb6\x95\x85\x13\n\xfb\xcb1\fK(8\xc9)\x14\f!^\u02e5t\x9c\x19SS1\xd4(\xa2\u03ae\xe9\r\xbaN6Q2\x9e\xc8\x19O\x1a\x18\x8f\x95\x8c\xc7r\xc6\xe3\x06\xc6#%\u345c\U00068071\xa3d\xec\xc8\x19;\r\x8c\x87J\xc6C9\xe3a\x03\u3052\xf1@\xcex\xd0\xc0\xd8V2\xb6\xe5\x8c\xed\x06\u0196\x92\xb1%gl=\x97\x8e\xb4\xc6\x01R!\xea\x8cUy\x82:\xe9D\xc9|\xd2\xcc|\xd2\xc0|\xacd>nf>n`>R2\x1f53\x1f50w\x94\u031df\xe6N\x03\xf3\xa1\x92\xf9\xb0\x99\xf9\xb0\x81\xf9@\xc9|\xd0\xcc|\xd0\xc0\xdcV2\xb7\x9b\x99\xdb\r\xcc-%s\xab\x999<\x88\x8a@O\u03bb\x04\xd4Y7E\x87+e\x80\xb8b\x02:\x8d,ME\xa7\x131\xb20Y\x1d\x92\xf8\xb1\x9e\xdei\xac\x82\x8f\"5RA\x15\xad:\xa6\xac\x83d\xfc%\x11\xe6|\xa5\xe6\xdd\xc8W\x12rV\xe9\xa6F\xceL\x84\xa8\x91\x97\xaa\b\xf5\xc2P\x1e(\xabG\x12\x94\xd6SZ\xcd\xca\xe7BS\x8d\xf4\x17'\xa0\xb2\n!V\xd5\u0218\xb1\x06\xae\b4Y\x98\xac\x0ey\x1c;_i\u0520`.\tl\x99\xc4\\#\xff:NR\x8b4\x8d\xc7z\x9b\xe6@z\xc5\xc7\u04ad\x16\xa68&\x13\x9d\x9a&Z5M\x9ak\x1a\xeb\xd44\u05aai\xdc\\\xd3H\xa7\xa6\x91VM\xa3\xe6\x9a\x1c\x9d\x9a\x1c\xad\x9a\x9c\u6686:5\r\xb5j\x1a6\xd74\u0429i\xa0U\u04e0\xb9&[\xa7&[\xab&\xbb\xb9&K\xa7&K\xab&\xeby\x93\x03R\x8d\\.\xc8o\xbd.\xc81\x9a\xe8\xd46\u046em\xd2\\\xdbX\xa7\xb6\xb1vm\xe3\xe6\xdaF:\xb5\x8d\xb4k\x1b5\xd7\xe6\xe8\xd4\xe6h\xd7\xe64\xd76\u0529m\xa8]\u06f0\xb9\xb6\x81Nm\x03\xed\xda\x06\u0375\xd9:\xb5\xd9\u06b5\xd9\u0375Y:\xb5Y\u06b5\xc1\xa3\xbb\\aS$j)\x82\xcd\xe5\x01\xcbo9\xbe\x91\x99\xc0\aZ\x99\xabg\x11\x1b\xb9\x1d!\x1cOq\xe9.'\xc8W\xef\xe4\f\x8br\x8e\x9b\xb8\xb0\x97\xa3\u9a9c\x9cWV\xcaq\x12\x17\xfdrl\xbe\xc6 \xe7U\x94\u05f9\x89K\x82u\x9d\xa8\x182(VH`\xd90\xa7\xc9\xd7\x00\xe5<\x8br6\xff\x0e,*\xd6%P\xf1dP\fgh\xe1\xb1f\xb0\x91\xe4:P\x1e\xc2\xf0\x84w\xdeQ\x82rqR\u03b2B\b\xe6=\a\xe3\xfafn\x10'qU\x93\xd1Q#\xbf\x1a\x86\xe3*\xae{2\xeb3\b\x05\xca5\x1c\x8aaW\x85\x80\x95\xd1ri\xa5\x91!\xc4\vX6e\x97\x19\x1a9\xd6A\xec:\f\xb0\xb2\x9a\x93\xd0\xc5U9\u00ec\x94[V\x13W]k\xfa\xa1K\x95\n\x15f\x10\x8e\xa7\xb8,[&i\x9b\u0609\x9c\xa0%\u06faj\x9a\xf9\xd50\xac\xf6\x80E\xdd\x1a\x05J\xb4\x18W0v\xe5\tX\xf9\u0349j\x8b\xbfr\xceu\x10k\xeb\xc0\xe20C\xa20%\x06\xc5p\x86\x16\x90\x19\x1a\x95;fa\xfc\xaa\x94\xb0\xca\xcc\x105\u03905\f\xc7U\\\x87f\x93j\x8dl\xeb i\xdaD\x1a!\xccWJ\xeeM\x8c\xe5!C}K\x8fF\u05a7\xb9\x12Y\fQm\x02j\xac\x81\x89$\xf4v\vU\xb4\xcdqE\t\x90\xb3\x96E\x19\xd5\x06\xa3F\xe6\x8c\x11j\xecD\u252aQ\x03\x10|\xe8m_\xaa8\xa8\u0086#D\x9a\xb6\x95\x06&\u071e'\x8d\x96(\xaa\x92G*\xf5\x8dR\xea\xc1V\x9b\xbd\xf56UU\xe4\xea\xe8\xa5\x0ej\x1awsY\x8eR\xc9^\xc1Z\x16\xdc0\x1b\xb7t\xfa\xa2\xb9\x1aY\xb4\xc3\xec\xf5\xd2Yq8\xce\x01z\x1b\u00cecWY\x83\x82\xb94\x1eb7\x93ie\xbd\x9b*\x92\aH\xc5\x06\xb4\xc6\x1aja\x92\xde>5V\xc1\x8a(g%\xc4Mz;\xdbji1\x05\xffF\xd6\xf2\x98\x8a\xd9\f\xa7\xd1\x05\x8d\xd5\u0203,~\xff\x9c\xba&\x94(*\x93G]\ucbbb\u01aa\xc4\xd8Ko\x8f\x1e\xcf@m\xc1@0\xa6\xb7\xb1\x8f\xe7\xa01_A\u0459\xdev@\x9e\x85*.\x11\xc25\xbd\x1d\x84\x15\xbdr\x15\xb9\x86\x91T\x00\xae)G\xaey\x90|-2r\xad\xaa\xa8o;\xc2\ud611k\x1f\u02e1\xe2AUl\x01\xa5\u00ea\xd4\x06J\x9d\xaat\b\x94\x8e\xaa\xd2\tP:>\xd6+\xde\xe9\x19\xe1\xaa\xc1\x06\xfd\u043f\xd0jl\xb1\xe5`\u04f1\u0341 \u0300\xc5\x00J\xc0C\x16\x02h\x02;,\x04P\a\x1e\xb1\x10@'x\xcc\xc9\x02(&>*&\xce>\xee\xc2k&\xb68\x00\xa8\x9a\xd8\xe6Q\x10h\xc0\x81\x00\xe5\xc4C\x0e\x03h'v8\f\xa0\x9ex\xc4a\x00\xfd\xc4c^\x1e@A\xf3\xa3\x82\xe6a\x9a\x86D\xd0\xd0\xdc\xe2\x11\xa0\x8a\xe6\xb6\x00\x83P\x03\x1e\x05(i>\xe4A\x80\x96\xe6\x0e\x0f\x02\xd44\x1f\xf1 @O\xf3\xb1 \x13\xa0\xa8\xf4\xa8\xa84\x8c\x04-\xa5\x16S\f\xaa(\xb5Y\f\x04\x190\x10@9\xe9\x90A\x00\x9aI\x1d\x06\x01\xa8%\x1d1\b@'\u9615\x03P\xc8V\xb4\x9cY\x93\x86\xb6\x12;\x9a)\xf5\xb6\x85Mk\xa6R\xe6\x164\xb6\x99B\xc1[\xd0\xfaf\n\xa5oAs\x9c):b\v\xda\xe7L\xd19[\xd8`g\xaa\x1e[\xf3\xb3\u012c\xd97\xae\xc1Yc\xa6\xe1/\xd7\xd0T2S\xbb\xd050\xbd\u0314Nu\r\xcc83\xa5\x9b]\x03\x93\xd0L\xe9x\xd7\xc0\xbc4S\xba\xe254U\u0354\u0799\xb8\xf4#Z\xf1\xca\x0f\xf8\xbe!\xaeU\x96@\x1d@\\\xbb*\x86J\ae\xa9\x05\x14\x0e\xcbB\x1b(t\xca\xc2!P8*\v'@\u1e2a\x13h(.\x1b\n\xc6/\x04[L1\xd8dl\xb3\x18\b2` @\xe3\xf1\x90A\x00\x1a\xc0\x0e\x83\x00\u0500G\f\x02\xd0\x05\x1e\xb3r\x00\n\x89+\x85\x80c\x93\xc4\x16[\x0e\xaa$\xb69\x10\x84\x19\xb0\x18@)\xf1\x90\x85\x00Z\x89\x1d\x16\x02\xa8%\x1e\xb1\x10@/\xf1\x98\x93\x05P\u033cR\f\x1c\xaf\x90\xb9\xc5\x01@\xd5\xccm\x1e\x05\x81\x06\x1c\bP\xce|\xc8a\x00\xed\xcc\x1d\x0e\x03\xa8g>\xe20\x80~\xe6c^\x1e@Ai\xa5 `\x16&\xa9U/\x05U\x93\xda\f\x04B\f\xea\b@)\xe9\xb0\x0e\x004\x92:u\x00\xa0\x8etT\a\x00\xbaH\u01cc\f\x80\"\xb6\x82\xa5\xcc\x1a\x14\xb3\x85\xcdf\xa6\xd2\xd6\x164\xa4\x99B\x83[\u0232f\xcdJ\xddB\x966k\xd6\xf3\x16\xb2\xbcY\xb3\uadd0%\u039a{c\vZ\xe6L\xd1Ck\xce\xeb\xcf\x1a]\xde\x1a\x9a\x04fj/\xb8\x06&\x86\x99\xd2/\xae\u0179b\xa6r\x94kq\xf6\x98\xa9<\xe7Z\x9cOf*W\xba\x16g\x98\x99\u02b7\xae\x819g\x06x\u06f6\xf7RFnv!\xa3<\xdfR/\x95\xa4\\\x18\b\x98u\xa9#\xc0\xc4K\x1d\x00\xe6^\xea\x000\xfdR\a\x80\x19\x18F\x060\tSC\xc8\xf20\x02D\x92\x8a\x11q`6F\x80\x81\t\x19\x01\x05\xe6d\x04\x14\x98\x96\x11P`fF\x94\vL\xce\xd4a\xb2\xfc\x8c\x88\x91\xa4h\x00 \x98\xa5\x11q`\xa2F\x84\x81\xb9\x1a\x11\x06\xa6kD\x18\x98\xb1\x01d\x03\x936u\x9c4o\x03\x80$\xa9\x1b\b\tfo\x00 \x98\xc0\x01p`\x0e\a\xc0\x81i\x1c\x00\afr \xf9\xc0dN\x1d\b\xe7sx\x84$\xa5#\xc0\xc0\xac\x0e\x8f\x02\x13;<\b\xcc\xed\xf0 0\xbd\u00c3\xc0\f\x8f \x13\x98\xe4\x81,M\x91\xe7\x91\u069dN\xaaGf\x8a\x1a\xd9\x1e\x89q\xaa\x13>\x12kU\xe7|$\xe6\xabN\xfbH\xecY\x9d\xf9\x91\x19\xb8F\xf2G\x9c\x9d\x94\xf9\x1f\xc9l\xa5\x97\x02\x82\xa70\xad,\x108\xad\xe9$\x82\xc0\x99N'\x17\x04N~:\xe9 p>\xd4\xc9\b\xc1S\xa4VR\xc8\u023e1-\xc9\v\xd5\n%\xa9\xa1:\x02\xcc\x0e\xd5\x00`\x82\xa8V\x0e\xe6\x88j\xe5`\x9a\xa8V\x0ef\x8a\xea\xf5\x83\u0262#@\x96/\xe2\x11\x92\x94\x91\x00\x03\xb3F<\nL\x1c\xf1 0w\u0103\xc0\xf4\x11\x0f\x023H\x82L`\x12\xa9\x86\x92\xe5\x91\x04\x88$\x95$\xe2\xc0l\x92\x00\x03\x13J\x02\n\xcc)\t(0\xad$\xa0\xc0\u0312(\x17\x98\\\xaa\xc1\xa4\xf9%\x11#I1\x01@0\xcb$\xe2\xc0D\x93\b\x03sM\"\fL7\x8900\xe3\x04\xc8\x06&\x9dj88\xef\xc4\x01$\xa9'\x1e\x05f\x9f8\x10\x98\x80\xe20`\x0e\x8a\u00c0i(\x0e\x03f\xa2xy\xc0d\x14`Y\xcd\xf9(\x99\x99i\xa4\xa4$\x86\xa7\xceJ\xc1\x96\xa8LL\xc1\x96\xa9\xccM\xc1\x96\xaaLO\xc1\x96\xab\xccPI,Y\x9d\xa4\x12f\x1bU\x9e\n\x9e|\xb4RU\xe0\x84\xa4\x93\xad\x82\xe6(\x8d\x84\x154ki\u4b20yL#m\x05\xcdl\x1a\x99+p\xae\x83\x92WO\xf3%\x0f\x9a\xd2\"M\x19-\xa2Lh\x11U>\x8b(\xd2YD\x91\xcd\"\x8ad\x16Q\u4c88*\x95E\x94\x99,\xa2\x97\xc8\"Zy,\xa2\x93\xc6\":Y,\xa2\x93\xc4\":9,\xa2\x95\xc2\"\xea\f\x16\xd1L`\x11\xbd\xfc\x15\xd1J_\x11\xad\xec\x15\xd1J^\x11\xad\xdc\x15\xd1K]\x11\x8d\xcc\x15\xd1M\\\x11\u037c\x15\xd1K[\x11\xbd\xac\x15\xd1KZ\x11\xbd\x9c\x15\xd1LY\x11U\u018ah%\xac\x88N\xbe\x8ah\xa4\xab\x88F\xb6\x8ah$\xab\x88F\xae\x8a\u892aH\xebL\x15yD\xa2\x8at\xceS\x91\x8ei*\xd21KE:&\xa9H\xc7\x1c\x15\u9722\"\xad3T\xe4\x11\t*\xd29?E:\xa6\xa7H\xc7\xec\x14\u961c\"\x1dsS\xa4sj\x8a4d\xa6\x88*1E\x14y)\u049c\x96\"\xcdY)\u049c\x94\"\xcd9)\xa2HI\x11UF\x8ah%\xa4\x88N>\x8ah\xa4\xa3\x88F6\x8ah$\xa3\x88F.\x8a\u8922\x882\x13E\xf4\x12QD+\x0fEt\xd2PD'\vEt\x92PD'\aE\xb4RPD\x9d\x81\"\x9a\t(\xa2\x97\x7f\"Z\xe9'\xa2\x95}\"Z\xc9'\xa2\x95{\"z\xa9'\xa2\xc8<\x11\x9d\xc4\x13\xd1\xc8;\x11u\u0689\xa8\xb3ND\x9dt\"\xea\x9c\x13\xd1H9\x91\x96\x19'\xd29\xe1D:\xe6\x9bH\xa7t\x13\xe9\x94m\"\x9d\x92M\xa4S\xae\x89tL5\x91\x96\x99&\xd29\xd1D:\xe6\x99H\xa74\x13\xe9\x94e\"\x9d\x92L\xa4S\x8e\x89<E\x8aI\x92M\xc2M\xd9$\xac\xcc&aU6\t+\xb2IX\x91M\u008al\x12Vd\x93\xb0*\x9b\x84\x95\xd9$\xac\x97M\xc2Z\xd9$\xac\x93M\xc2:\xd9$\xac\x93M\xc2:\xd9$\xac\x95M\xc2\xeal\x12\xd6\xcc&a\xbdl\x12\xd6\xca&a\xadl\x12\xd6\xca&a\xadl\x12\xd6\xcb&a\x8dl\x12\xd6\xcd&a\xcdl\x12\xd6\xcb&a\xbdl\x12\xd6\xcb&a\xbdl\x12\xd6\xcc&aU6\tke\x93\xb0N6\tkd\x93\xb0F6\tkd\x93\xb0F6\t\xebd\x93p\xebl\x12~D6\tw\xce&\xe1\x8e\xd9$\xdc1\x9b\x84;f\x93p\xc7l\x12\xee\x9cM\u00ad\xb3I\xf8\x11\xd9$\xdc9\x9b\x84;f\x93p\xc7l\x12\xee\x98M\xc2\x1d\xb3I\xb8s6\t7d\x93\xb0*\x9b\x84\x15\xd9$\u071cM\xc2\xcd\xd9$\u071cM\xc2\xcd\xd9$\xac\xc8&aU6\tke\x93\xb0N6\tkd\x93\xb0F6\tkd\x93\xb0F6\t\xebd\x93\xb02\x9b\x84\xf5\xb2IX+\x9b\x84u\xb2IX'\x9b\x84u\xb2IX'\x9b\x84\xb5\xb2IX\x9dM\u009a\xd9$\xac\x97M\xc2Z\xd9$\xac\x95M\xc2Z\xd9$\xac\x95M\xc2z\xd9$\xac\xc8&a\x9dl\x12\xd6\xc8&au6\t\xab\xb3IX\x9dM\xc2\xeal\x12\xd6\xc8&\xe1\x96\xd9$\xdc9\x9b\x84;f\x93p\xa7l\x12\xee\x94M\u009d\xb2I\xb8S6\tw\xcc&\xe1\x96\xd9$\xdc9\x9b\x84;f\x93p\xa7l\x12\xee\x94M\u009d\xb2I\xb8S6\tkf\x93\x18\xc2\xe0x\xaa\u07c0\x14\x1d\x1c\x8f\xf5\x1b}\xa8\xb8:\xd7oX@iu\xb0\u07f0\x81\xd2\xead\xbf1\x04J\xab\xa3\xfd\xc6\x04(\x1d\x1f\xeb\x15\r1\xe0N\xef\xc3M\xe3\x8e\xef\x83\xed\xe3\xce\xefC\x8d\xe4\x0e\xf0C-\xe5N\xf0C\xcd\xe5\x8e\xf0Cm\xe6\xce\xf0\x83\r\xe7\x0f\xe9\xc3-\xe7O\xe9\x83M\xe7\x8f\xe9Cm\xe7\xcf\xe9C\x8d\xe7\x0f\xeaC\xad\xe7O\xeaC\xcd\xe7\x8f\xea\x83\xed\x17\x8e\xe2\xc3\n\x10\xce\xe2\x83\x1a\x10\x0e\xe3C*\x10N\xe3C:\x10\x8e\xe3CJ\x10\xce\xe3CZ\x10\x0e\xe4\x83j`\xcf\xdc\xc3:`\x0f\u0743\n`O\xddC\xadg\x8f\xddCMg\xcf\xddC\xedf\x0f\xdeC\x8dfO\xde\x1b\u074e\r\a\xccA\x15\x99\xb7c \xa0\u00eb#@\x9fW\a\x80n\xaf\x0e\x00=_\x1d\x00:?F\x06\xd0\xff\xf1\x1bXe.P\u0101^P\x80\x81\x8eP@\x81\xbeP@\x81\xeeP@\x81\x1eQ\x94\vt\x8a\xc2Q\f\x99_\x04\x80\xa0k\x14q\xa0w\x14a\xa0\x83\x14a\xa0\x8f\x14a\xa0\x9b\x04d\x03=\xa5\xb8;]\xe6,!$\xe8/\x01 \xe82\x01\x1c\xe85\x01\x1c\xe88\x01\x1c\xe8;!\xf9@\xf7\u025d\xaf\x90yP\x01\x06:Q\x1e\x05\xfaQ\x1e\x04\xbaR\x1e\x04zS\x1e\x04:TA\xa6'\xdc\xcd\x1e\xd47^I\x1d-Q\xf9Y\xa2p\xb3D\xe1e\x89\xc2\xc9\x12\x85\x8f%*\x17K\xf4<,\xd1r\xb0D\u01ff\x12\x1d\xf7Jt\xbc+\xd1q\xaeD\u02f7\x12M\xd7J\xf4<+\xd1r\xacD\u02ef\x12-\xb7J\xb4\xbc*\xd1s\xaaD\u05e7\x12M\x97J\xf4<*\xd1s\xa8D\u03df\x12=wJ4\xbd)\xd1r\xa6D\u01d7\x12\rWJ4<)\xd1p\xa4D\u00cf\x92\xd6nT\xe21\xb1\xd2cb\x95\xc7\xc4\n\x8f\x89\x15\x1e\x13+<&VxL\xac\xf2\x98X\xcfcb-\x8f\x89u<&\xd6\xf1\x98X\xc7cb\x1d\x8f\x89\xb5<&\xd6\xf4\x98X\xcfcb-\x8f\x89\xb5<&\xd6\xf2\x98X\xcbcb=\x8f\x89u=&\xd6\xf4\x98X\xcfcb=\x8f\x89\xf5<&\xd6\xf3\x98X\xd3cb-\x8f\x89u<&\xd6\xf0\x98X\xc3cb\r\x8f\x895<&\x86<&\x83\xf2B\x8c\xdd(A\x87y\x18/Pl\x94\xbf\x9f\x96?\u030a\x82$r=`\x8f[\x92\xc6~\x84\x16\x06\xfb\x15\xe6 ]\x1b\xde\xda\u01cb\x1f\xc3\xc5\xe2\xb9\xce\xe7\x98+>\xc7\x0f-+\x99\b_\\\xe6\x84\xd1\xe3\"|S\xb9\xe2R\xfbX\xb2\x92\v\xf4\xd5\xe4\x9cQ.\x8c\x92\x83\xea\x1b\x89\x05\xb3\x85\x1b\xdf)y5}I\x95\xf2\xb9C\x87\x14\xedSc\x81\xbc0vS?\fN\xb1\x1f #]\xc7\xe1f\xb5f\t6\xc1\x02\u0174X\xa0\xa9J8\xc3\v\r9M\x10\x06\xa8u>)\x97\x99\xbeGu\x10\x1b\"\u04d0\x1c\"\u02c4\x7f\x9a\x17\xb7\xa2I\xa4S\x8bH\xa7\x06\x91.\ud44b\x8e;\x89\x8e;\x89\x8e%\xa23\x14i\x81r\xb1\xbf\nNiT\xc0\x95\xc7\xf5\xf2l\xa2\xe4\x00^\x1d\xe0\xa1 E1\x87\xb8\xad#n7I\xea/\xef[\x9bs\x8a+\xe3\x92\xcb\xcaA@q9\f,1\a*\x85~\x1a3N\xb1A\xea\xdc\xc1\x96\x10uC\x88F;H\xfbf\xc0\x12+\xed\xc4\xc0j\x89\xb1\x86\xc4\x18\x94\x98E\x95F\x97}\xb4~\x19\xc6\xe4\xd4s#?u\xb1\xff\x80x(\xe6\xa18\u0721\xd8s\x13\x01\xb9\u145b(\x82\x91\x01\x8f\xec\xe4\xa1\u04da1j\xb5\x04@\xcb\x1b\x03\x80\xe5\xed\x01\xc0O\xe8\xb7\u04e3\xb1\uad53\xb4h&i\xd1J\u04a1\x91\x92\xf6\xe06\xedia\x83\x06n\xd1\x1e\f\xb7\x87\xc1-\x8d\xd1\t\xf7`\x8d\xdcE\x16h,\u00c0\x86\x9f\x0f\xe8T\x8c\xf2\x97\x86\xc3\xd3%\x9b9@*F\xf5K\xabV<\x10\x8b\xedZ\xb1\r\xbd;,\a5\x84\x05\xbc7,\x87\f\x00b\xe1\xd4\x11b\xf1\xa8V\u071f\x8c\x01\x06\xe3:\"\a\xb4\x1d\xe1K\x83f\xbbe\ua9c3N\xd1\x03\x00u\xad\x13X\x06P?\xb0\b\xa8+X\x84\xa47X\x10\xdc!<\x06\xec\x13\x0e\x04t\v\x8b\x90\xf4\f\a\xca1O\xe3\xabh\x97\x11i\x8f\x11e\x87\x91\xa6\xfe\"\xaa\xee\"\xaa\xde\"\x1a\x9dE\xd4}E4\xba\x8a\xa8z\x8aht\x14i\xdbO\x92.\xc1\xd2.\xc1\xca.\xc1M]\x82U]\x82U]\x825\xba\x04\xab\xbb\x04kt\tVu\t\xd6\xe8\x12\ft\t\x83!\xc8M61:\x1c\xc7\t\x1d< \x86\x963\xc0\xa1\f\x18\xb8q\x1c\xeejP[\xe0\xe9\a\v\x14\xa4\x8d[*g\xd9|\x97\x03O-\x9eAB\\\x8c\r\u03cd\n\xef\xb0DnJ\xebNP\x9a\xfa\xc1*9}\x96\x10/z6\xcb\n\xb7n\xec\xbbAzz$\xe2#\xdaM\xe0\xb9):\x84[\x14/\xb3/\a\xfa\x8b\x05\nr\x11\xaa\x87\bc?J\xfcd\x96\xe5<\xb2\x8c\x0f:\r\xc2]\xecF\xad\xa7\x8bJW\x89\xb6\xee9\xacB\xfd,Z\xd6\x03\xaa{\xf9t;\xc1\b\x9e\xb2\x1f(\xb7\xee]\xf14\x93C\xa9M\xa2\xdf?\xa4M\xf7\x10\x9d\xde!O\xd39\xe4)\xfb\x86\xfc\u04fa\xa6\xa1\x17\xb0~/\xe06\xbd\x80uz\x01?M/\xe0\xa7\xec\x05\xfc\x98^`X\x96$\x86\x17\x06\xa9\xeb\a(\xaeX\x1b\xf7\xa7\x89\x17\x87\x18\xb3\x14\xf9\xdb<\xb3\xbc\xe4n\u0490\xdd\xe2K\x9fp\xaa\x8f\r\xfa\xf0\xa0\x82a\x06V\xb1o\xedbs1\xf9mY-$\x15v/I\x85\x85*y\"?T\xb4\x82tn\x04\xd1m\x03i\xdb\x04\xb9\xb4\xb8\xb3\xb4XWZ\fH\u02ca\x82\xfd\xe8@\xff9\x8d\x91\x97\xfehE\xfb^\xed\xef\xf3\x19StR\xfb\xfb|\x16\x85\x89\x9feQ\x97\xfe\x1e-\xfe\xdd'Q\x18\xa7n\x90\xce\xfe\xa8J\xdcy\x12\xe2M\x8a\x8e\x85\xed\xed\x13\xfb\x115\x9c\xffN\x19\x9f\xc8&\xa9\xe4\xe4_Hp\x99\x8c\xf8\xbfWFF\x80]b\x04aL\\|`]0}\u0127\xf2\xa9[>\x00\x9e\x9a\x81E1b0Q\xdc>\x0fY\tE\rOO.\x11\t\x8a\xc6\u00e8tOcmG\x99\x89\xae\xc8DKb\xd2R\xe0F\xd9t\xbbY\x00\u00b2aQ6\x06\xb35\xe6n\x82\x0e[\x14\xa7\xbe\xe7\xe2\"\x87N\x9f\x89KE[\x83\xf8\v\x1eJ\xfc\xc5\x02\v\xc04\x8cx`\x1aFB\xd5)\x11j\xceB\xa3\xd6\xf6\x987\x83\u068e~K\x00\xb4\xb41\x00\x16n\x0f$B\u07a4\xa71\u28a1\xa4M;\x89~3\x89f+I\xa7F\xca\u06c3\u06f4\a\xeb\xb7\ak\xb6\a\xcb\xda\xc3`\x17>9\x84\x91\xeb\xf9\xe9\xfd\xa95\xcbR\xe8\xb9\x13/\x1e\xf6\xfa\x96\x93\xf4\x10m\x90\x1f\b\xa4\xd0'\xfa\xe9\xe3\xfc\xab\xfc%\u07fe\u04de\xb1\xeb\xa5\xfe\x16\x1dYL\x9aY\x84\x1bn\x99m\x85\xc3\u0761U\xad\x94\x02jO\xf6\x9cmPKE\xad\xfd\x05\xca7]\xf4\xfa\xd9\x7f*FfWF\x85~\n~'2T\xd6\x1c\x15(k\x1b/Y\xcb&\x1e\xd7\xdb3n\x90\x1eyH\xf6\xaf\xee\x92\xfe\x8af\xcd\f\x12>\x18a\xb27\xf2\xcc\x1d\t\xc3t\xed\a\xabS\xbaY'\xf1\\\x8cf\xc6\x0e\xcd\xef\xfc\u0520\xdbZ\x96\xae\x87\x8c\xad\x9f\xf8s\x1f\xd3\x16\x15\xef\x82\rE\xc7\x05\xa4\xec'\xec\xa6\xe8\xfb\x8f\xe6\xf3\xba&*H\xafo\xcbm/\x96XR\\Y\u04b1\xaaL\xf0\x1f\xad\xbe\xe9<\a\xb8\x14\u00c0\x87\xf7\xa7\x00\xd8\xc0n\xbcB\xff\xaaZ\xf2\x03XQ\xb9\xd42u\x15\xa52\xa5\xd9R5\xc85\xc7\xeb9\n}\xfabV\xd4\xe1m\xe2$\x8cO\x8b\x87,2Y\xbb\x8bpg@\xc0cX\x1c#\xec\u049a\xeb\xfap1\xee\xf5\x9d\xa4\xe7m\xe6\xbeg\xcc\u0443\x8f\xe2\x1f\xfb\xd6\xc89\xe9O\x86'\xfd\xe1\xf0\xc4z.\xaf\xea\xd4]\u049cB\xb1\x99.v\x17\xfe&9\xf5\x835\x8a\xfdt6\x0f\xf7F\x0e>5{f\xcf\x1aE\xfb\x9e\x1d\xed{\xf5}\\\xf6\xf3\x19M`\xd0$\u0333g\xb35\xca\xcf\x19\x9b\xe6\x0f\xb3\xe2\xf8\xf7\xd1\x1d\t1\xfc\xacH\xe4\x00\xce@\u0460Y>Af\xf5<dI\xa0\xfd\xa9a5433\x81\xbc\xb1'rT]%\x95\xb3b\xb9\xceW\x86\x1b\xf8\xc4M\u0449\xe49dn\xb5\u049a\xb9\xe5m\xe6\xb7\xc91\x9eP\xb4\xeb\a\xc3<\x94M6\xf9\"\xab*\xb2\xf8\"\xbb*\xb2\xf9\xa2AU4\xe0\x8b\x86U\u0450/r\xaa\"\x87/\x9aN\xa7U\xe1t:\x05\x8a\x99r\x01@\xdc\xfdQZk8\x1eN\x06\xa3\xe1\x98G\x15fZ!\x8b\xdfE\x98\x9f\xfa.\xae`bn\xe3\xc1\xd8\x04\t:2\xca~c!\x01JRD;(\xba7\xf2y&\xb3\xf4^\x88O\xb4p\x91\x1el\x83\x0f\xb5_\xe9:\x16(\xc6qi\xadF\xbb\xb6N\xf4\xb1v\v\xec\xa0\x05v\xd8\x02\xeb\xb4\xc0\x8e8\xbd\u0630b\xb0\x9f\xa4F\x8c\x12$\xed\x99\x1a\"Sv\x92\x1aIz\x8f\x91\x91\xdeG(\xdb\xdc1c\xaf\xafh\xbaC\xb2\u078dy&\xba\x17\xfd\x14\u99ed\xfbB\u07ba\xce1A\x11\x8a\xdd4\x8c\xebL)\x13\xab\xefH\xe8|\xb2\xea\xf9duX\xf8I\x84\xdd\xfb\xd39\x0e\xbd\xbb\xd9\xf1\r)s\x98\xc7\x1f%:\f\ue49e{(v\x10\x0f\x9c1Z,\xeaNZ\xf4RM| w\xc8!\x8aI/\xafo:\xf2\xbc\xe5\xb2M}\xf4M>\xca&\xb1=]r\xa5\xf1H1\x9f\xcd\xc3\xfd\xac\xbc\u02ebW\xa4C+U\fGf\xb4\x9fUw\xa4\xf6l\xfa\xabL7a\x94\xa9\xd2H\u0755\x91 \x8f\xcaU)y\x89\xd1~F\xff1\x16~\x8c\xbcR\xe6\r\t\xf8E\x84{\xea\xce\xfb\xf340\xa8\u00cfD\x0e\xa7f\xdf\xea\u0679\xa8\x92\xdb[\v.\x7f\xfc1O\x03\xf6\xbe\x0fhE\xbb&\xf7!{S3\xfc\x14\x91\xa4\xd8\x198\xab\xf4\x94\xdf\xf0\x12\xed{I\x88\xfdE>\xc3;\u04d3\x91s2\xb1N\xfa\x83\xe73.$a$/%\xb5L\x1a \xd0\x7f\xac\xf2\x1f!R\xb1\x13\xa9\x80\x85\x1d\x1c\xe7Cf\u00f8i?\x97RV\xb6:\x1fZ\x8e\x95/\xe9,]\xe2\xe3\xfb\xd3\xc4\r\x12#A\xb1\xbf\x9c\xd5\xf6\x10\f\xa3\x9aa\x98='\xdaKy\xf7\x83\xd0X\xa0\xc4\xeb%\x91\x1b\x1c\x96X\x9c[k\xe0^\xb2]\x1d\xf8&\x0f\x93\x06<]p\xe2\u0167u\xd8\xeaVX\xb4\x15\u066f\xbb\xdc-\x0eM\xb3f\xcfT\xfd\u048a\xff\xb6\xf0\xb7EczV\xcfrL\x80U\xf7\x95.\xee\xae\xde\xd8%(E\xf1\x1f\x7fP\x1f\xdb\xd0W$\f\u008cO\xbd\x916'\u0668\xd6H'\xda\xf7LIm\u067aZ\x1cb\xf6\xd3~c\xc1s\xa2\xbdK\"\x8c\x92?\xfeH\xfd\x14#\xceu\xd6\x04\xa1\u039a\x91dlV\xae\xbd\x18@\x8d\xecK\xb7\xd1\xec\xc8\x05|6!\xa2\xb8\xbe\xa3d\x1a\xab%qb\x19g#A\x18yi\xd5P?\u0226\xd6r\xaa\xd0lO\xc1Ex` \x8c\b\xddc\"\xf3\xa4\x1c\xfe\xd8N\xec\xce\x11\xd6m\xa6\xf4~\xa7\x82}Ku?\xbd\xb6\xb7>\xda\x19e\x1cs\u01ff\xd1U\xbe\u01c9\xf6\xc7\xf7\xa2\xe3\xeb]\x18\x9d\x0e\x00\xe7\xe9$\xb3r\xcd\\2\xb6\x8b7\xb9\x9a+\xff\x8bi\x9a3\xf6\xfd\x8e\xfa\r\xee\xddn\xc0\xbd\xd6Y\xd3\xe7u\ai\xc9<d\xaf\x9f\xba\xf3Ri\xe2tf)\x89\xf2\x9f\xe9\xa4$\xbc\xf5V\x9elhF\xfb\x16\x8cN\x97~\x9c\xa4F\xb8\xccb\xba\x03\u02e7g\xf6\xcc\x16\xbc\xfaE.l=\xcc\xfd\xbf\xd0W\xddy\x95\xaf\xde\xc7\xe9\x8efQf\u0569@j\x1a\xe2\xab5\xed\xbbl\xbaw\xcc\x1f\xa07j1\x81\xf2\u06cf\x86c\xfeP\xbd2\u06d2\u0426\xef'F\x18\xa1\xa0z`$\x1bB\xdc\xf8\xfe \v\x12\xa8eI\x9a_\xb1`G\x15\x14\x81\x1c\x9b\xcf\x1f5\x9b<g\xad\xd4\x02\xd2\x0f\xd6s6\x14\xa1\xc9\xf3BSN=\xbc\x9b\x14\x91I+y\xff\x96\xbb$@jv\x0ec\xed^9m\xf33\xda\u045fdc-\x8fT;\b\xfa\xb7\xdcB\x99\x00\xa0\xc1\xdcaV\xbd\xf5\x10\nG,\xcdp\x04\xbe9W\xac37-\x83\xa0t\x1d.\xeac\x00pW\x83l d\"-\x97\xcb\x0eqQ\xa6\xe0jUeR\xb7\v\x9a\xd2*\xde\xc9\xeaGf\xf2'\x8c\u567d\xa6\xe3\x8b\xd2\x16\x86\xd9;\x1c\u056f\xcfe\u0265$\x91\x9b\xae[@\xff\xf8c\x81\xa2\x18yn\x8a\x16\x8f\xb0U0\xf8\x1a5\x04_\xb9\x81\xcdva\xbc0\xe61r\xefN\xb3\x7f\r\xfa\xa0\\\u05ea-\u038dG\x93h\u03edf\xfd\xabh\x8d\x1d\x9c\x7f\xfe\xa9\xcf&\x8b\xa1\x8dd\x1d\xfb\xc1]\xf6\xa6_6\xd7s\xb1\xf7#\xf5c=\xa3ge#\xd1\xe8Y4Lx\xfegG!\xf5\x8f5j\xa9\xf5\xc0\x0e\x18M\x0e\xf4M(\xf6\xa3,\xb2\x82\xdcD\xcf\xca_^\u0543t \xb5\x1d-I@K\xd7xGut\x9b\xaa\x1b\xc2I\xc27\xbb)|\xd3t\x8f\xe5\xea\x19'\b\x13<\x16\xb3\xfaD\u04ac\x8ae\x14&\xa9\xf0j=\x1e\x9c\xd8\xe6\xf0\xc4\x1a\x0e\xb3\x99\xf4x\u059e\xf6\xebp\xeaySS\xcdU\u02ebwfv\xe8*T\xa7\bL\x8f\xffF\u0524\xed\xd8'\xd6\xc8:\x19N\x00M.=\xd7\x1a\xa8\x99j)\xb2+\xafCG\x91\xba\xa9Q\x87\xfd\x02a\x94\"Q\x93\xc3\xe9\xc9\u0226\xff\a\x149\x1d\xa0\x01\xd2a\xab\xa7\xcb\xee\xec\x0e\xdd\x05\xeb\xa8Q\x8d\x1aVH4\xcc\xe9\xf8\xc4\x1a;'\xb6\x03\r\xf1\x91\xe5.\x97j\xa6Z\xca\xec\xca\xeb\xd0Q\xa4nj\xd4a\x1f\xb9\xa9\xb7\x16\x1491Ol{|bM!E:&\x1ax\xb6\x06[-Uv\xe7v\xe8,V7u\xeaT@\xb9\n\u06a4S\x8e5\x91\x98\xe5\u0534\xec%Rs\xd5Rfgf\x87\xaeBuS\xa5\x0e\xff0\v\xbc\x12Q\x9b\x83\x93\xa9yb\x8d\u01802\u0345\xe3\xbac-\xbez\xefg\x8f\xe0wx\x84h\u0774\xaaSE-\xc6\x06\xb3\x11S\xfb\a@\xafhN\xffT\xdb\x1b\xfa#\xddJ\xb4\xb4\x9cs\xef\xcc\xf2\x00\u026a\u03ed\x9b\xae\x1bj\xa9\t\xe8\xad\x11A\u0261mb&'\xeb\xf5\x8b\x1f\x8c<Y\xafJv,}\x9c\xd2F\x1c_|\xf2'\x86\x1fdQ\\\x9e,\xb5\x8f)\xad\xc5d\xb1@\xe32\xb8\xb73\xa6\xcc\xfa\x96t\x85p\x11\xee\x02\x1c\xba\vc\x13c\xa3X\x92\xec\xf5\x97\xae\x8f\x11\xf7\n[\n\x95\x97\x15\xafQ1Z\xe8\xf0\xa3O\xfc`\x053,\n\x0f\xb2\u02ecH\xb8@\xd8(R\xdeLb\x9c\xcf^\xa7\xee\x9c\xcd\xe6\x1e\x17\xca\xf35r\xd9\xe2\xa4;\xefa\x9f{3\xe4^\x9c\xf4\x12d\xf5]\xb0\u047e\xb9>8\xe1\v\x7f\x7f+c\xae\xc8\xe2B\\E{\xd7\xdb\x05%\xa6hs9hf%\xdf\x10P\x18T\xb4\x87\x84(F\u0741KgA\xd0\xde|\x93\xa6a@\x7f\xcb\x16\xd6\xeb\xb2f}VX\xbbYd\x84\xca]^\xf5\xfe`\x9e\x15\u0555\xcf$]p\xf4\x1fUf\xa04W0\x1dc\xa0}\x8a\xe2\xc0\xc5\xc6\"\xf4\x92fh6\xc8\xff(\x8es\xb4\\\xe3\u036d\x88Y\xe3=\x0ed\xa7\xc9\xeb@M\x11v\xb64\xb6F\x8a\xae7\b\u0234vh\x93~\v\xa26\r\x884\xe4\x8fZ\x8b\xaf\xcc\x10K\xd5\xd9\xf8)W\xb4G\xde&E%\xfc\xf8\xdd\xd9l\x03B\xe3\x8dI\x1ci\x8fn\xce`\xe6\xa6a\x93\u03df\x87\v\x9a\xa8\x8b]R\x85fm7\x85\x88,\x98gh\xe1\xa7\af\x7f\x85\xa9\xa4\xcfW-\xea\x8d\u0424\xe9\x15+\xc3E\x17\r\xa0\x195FI\x14\x06\tJ\f?\b8]7\"\x851!\x94;\x8f\x19\x0e\xc5\xfc\ufa24\xe8{\x9bX}f\xa8\xa4\xa2\x01\xd4\x1fI\ua99b\xa4\x8b\xb1+\x99\xd6\x1en\x82E\xe8m\xe8\xd2y\x15\x13\xfcej\xd2?\xea4\xbd%\xa6\xe9\x1b\xaa\xceg\x87nc\xb7Lo\x0f\xcd\xda\xd7\x14\xf3\x0f\"!\xa2\xaa\xf3\x7f\xae\xb5\xf5\b\xb0\x96C\xad\xd64\u014d\x01j\x1e\xbd~=\x19\xceo\b\x90m\u0122c\xef\xf8[\x11\xb1\x15\x9b\x83\xeb\x9b\xca\xea\xdb1N\a\x88\xc8\x17\x05s\x96Q\x8c\xfa\xc4\xf7\xe20\xbb\xd6\xf3\x90e\u05a9S\xab%\xd6\xebk\xad\x7f\x19\f\x06\xc0\xae\x00\xc92\x9b\xf6\u019cr\xf7\xff\xfa>Z\xa3 \u024f \x1b$a\x1f0\xbf\x1c\xf3\xe8L\x94\u035e\xc2\u02da\".\x17\xb8\x18\xb7XC\x80\x14\xd5\xeb\xe7\xef9\u0645[\x8c\xee\xf9\xd31\xabuF`x\xe1\x02\xa9\xb6\x01\xb0\xe8\xbf\xd5;\x86\x0e\xa1u\xb5\xc7*bV\xacG\x88\xccjw\x00\x88=.\xe7\xdb\xcb\u0102\xb4V;E\v\u93ed\x80:G\xc3\v\tq\x83\x85\xaa\x91\xd5\vJ\x11\xf9&\x8a\xe5\xfd\xbf\x8c\x17\x13{:\x00\xf7\xa2\xe4\xa3\xd0d-\xb0i9\xa7\xfd\"05\xcfB\xcf\x03ZQq\xefa)})pm\x9dH\x1a\xbb[\\\\\x01]\xb3\x98\xbf\xa7\xd6.w`\x92\xb9\xcb%\xbc\xb5\x81[dv\x98M8v\xfdEh\x00\xc4\x02|\x9d\xe5\x13\xb6g\xb2\xa0\x04\x05\vF\xa1\x9a\x8c\x8a\x1d\x11MK\xcaP\xc8\xf3\xb8M\x11F\xbe\xa5\u0501\xc2\x11\x85\xa4UDS\xbdMZ\x83\xaa\xf3\x94\xf7\xff\x95\xde\xf9\u0609\x80\x85k\xb4^bj\xec\vx\xdd\x11X\xb5\x8e.6-\x8d\x84\xf8J\x90\xaez\xa4\x1a\xb9r\xca\xe2-\x97{\x7f/\xdfl\v\xd03\x8d\xee4\x81\xeel\u06b6$\xe9\x8f\xec\x15\x99\x92\x00\xfb\x9a\xb2mM'\xf4\x9f\xe7\xda\u035b\xa3e\x18\xa3C9MU\xd3\xeei\x1c\xa6\xd9\x0f=+\xe9\u0479\xc0\x8d{~\xb0\xf4\x03?E'\xb5CI\xb3\xf6\x14\x8f;\x10'd\xa8\xf2\xb5\b\xe7$\xff\x7f--Y\xf8\xd3,\xe9P<J\xc3\b\xb8y{\xc4\xe4*\xd8x\xa3\x9c\x88\xcc\xfa.\xb3r\x18f#\xe7x\x86\x13>\xce\xe5T\a\f2\x8b\xfd\u03f2\xf9w\xe8~\x19\xbb4\x85W*\ue406\xb5\x13t\xd9S\xf4\xa3\x95n\xe2\xe0\xf9\x9f\x7f\xfegK\xbc,v+\xb6\x00C[\x01\xe0\xcc\x17Okd\x1bY\xf2\xc4\x12\xfb-\xcd\x16\x94\x86\xe1z\x1e\x8a\u04b2\b\xa3\xb8\xf4LL\xa2v\x15#\x14hs\xfd\u33c2+AI\xe2\xae\u0421\xc6dV\xbf\x80N))\xb7\a\xfaD_\x82\xe6=\u04f4j~\xab,}O\x00\xac\"\xcbY\xa1\xf8\xe0\x98?\x1cO03v A\xf0'\x8f\x17\xe8\x18Dgw\xb5\xf2\xd7w\xe7\xf2\x94\xb9_\n)\xcd\x1e\x8eG\xf9\x942\x0e\xdd4\xb7\x81C\xf63\x94VH\xb6+\xc3M\x12\x94&\x87\x827t\xfc\x11\xda\fR\xec\xc6\xeb\xad\aZoh\f\xad\xdb\x0f\xc2,G\xcb\xed\xed\x86A'\xe0\xd3S\ua0ce/ee\xf2\x8f\x8b\xc5\xf8\xbdH\xe0\u047b\xa5\x8b1um\xb5|\xf41\xc8\x17\xf6K\xa38\xf1\xc3\xc0\x88bwE\xdc\x03\x93B-C2Dzf\x13\xd5\x1f\x7f\x94#A\x8c\x15\xcbK\x1f\x11a\u04b3\x92\xa9\x99;\x84w|\xd7=5{}\x1a\xa6+\xa2?\x89\\\xb5S\x17\xb5wi\xc7\xf1\xd6Z\xe4y\x90/~\xe4a\x81\xe8\x9fc\xc4@\xd3Vy@\u027fG\xc1\xefE\xd9\x1b1w\xfaC\x8e\xec'\xebp\x174f\xa4\xe9\x02Q?\r\xefP`$i\\[\x92p\x1cG\x06\f\xc2\u0520\x9e\xddMk)\x01\xc7qfM\x15e\xe9\xbaZd\x9d\xcd\x0f\x91\x1b\xa3 \x15\xa7\xceb{u\xe3Nx\xc9\x1e\xe3.\xc9\x12>\xfc\xa9\x1fZ\xb1\xa1\xdd\xfd\x83Dh\x1b\xfdk$\x84\u06e0\xc8\xf44e%\xd0\xfdc\xe1'\xee\x1c\xa3\xc5\xef\xe5\xb65\xaa^\x17\xe3p\x87\x16\xc75\u02c1@X\x9e\xc1bO\a8\x9cF\x06\xcfEI=7\xf0\x10\x16\xadS\xec\x92j\xd3\xccrd\x8e\xcc\x19\xfb\x9b\x86\x9b\u02eas7\xe9:\x8c\xfd\a\u0526\xc6|w\u05cc\xfd\x8d\xf5\x95\xec\xd0o\xa8\xb7<\x0fFg\x00\x1a*\xb1\x9d\x02\xaeFr\xf4\xdb\xd5a\xe9c\fo:\xa3\xd8\"9\r\x8c\xf8\xe1tj\"[h]\xf6\xf0\xf8\x06-p\x84\x8e\x1d\xd6_(%\x04\u064f\xe0\xd17\x16R\xac\x98\xe5\x97}\b\x03\x8d\xea\xa37l\xae\xe4\x14\xbb\x12\x06\x19i\xf6\x97ST\xa9\xd0l\"\xcaOEJ\x16\xbe\u0605\xcbL\x1c\xf1\x8c\x9c\u022eO=\x1fZ\xc8.\x04\x00\b6\x01G\xd2\x1fB\v\xb1t\x90\x151\u0549X\x9c\xc0e\xfc\u0781\x02tp1\xae\xdd\xdbP?\xb0a\n\v\xb0\xf9\x04$\x89o\x04\u0660\x03\xbc\xac\x84\x10B\"g\x0e=\x84\x9b\x94\x8e3 \u0145\xf6\x91\x1b,\x8a-\x11\xc9\tTV\xa5a\x9b:\xba\x89+\x1dz\u035c\xb3\xc1Y8\x81\u06b9`q\x91\x83e|h:}\xc9B\x8b\xbd\xbd5'`\xd2?*\xb1\v\xf0\u0624\x7fT3H\xbe \xdct\xedH\x8e\xe8\xfb\xc1\xd6\xc5\xfe\x02x/N\xd6\xee\x1d\xa2GX{\xd6\f~\xca\xe4\xb5P\xb6'F\xbd72;\u07de\x86\x86\x87\xfdh\x1e\xba\xf1\xa2U\xee\xb0\xde\u0672<\"\xe3\xe3t\xf2~\xd2\\\u07f1\xfbE\x17)\xb4\xa3\xd4yM\xf6M\x8c\x7f\xfc?\v7uO}\xe2\xae\u040bd\xbb\xfaiO\xf0\xcc[\xbbq\x82\u049f7\xe9\u0498\x9c\xfcG\xb2]\xf5\xf6\x04\a\xc9\xcf\xcf\xd6i\x1a\x9d\xbex\xb1\xdb\xed\xfa\xbbA?\x8cW/l\xd34)\xe1\xb3^&\xc9\xcf\u03ec\u0473^\u07aa\x9f\x9fY\u03b3\x9e\x1b\xfb\xae\x91\xbf\xfd\xfc\xfc,\x8d7\xe8\xd9\xdf\xfe\x83\x9e \xe8Q{\xf9\xf9\xd9\x0f\xf6`\xb9\\>\xcb~3\xe2\rF??C[\x14\x84\x8b\u0173\xde\xe2\xe7gW\u00dee\xaf\x87[\xeb\x97\xe1\u05b0\x1e\x88c\x8c~\x19n\xad\xb5\xf3e\xf4@\xec\xde\xe0\xcb\x18\x1b\x83^\xf6gk\xd8k\xe7\xcbtm8\x0fW\xa3\xbe\u04dbd@\xbb\xef|\x99<P6\xd9\xcf[\x83r\xb2\x1e\u0234g\xad\xad\xad\xed\x19}\xd3\xee\xdb\x13\xa3oY}\xc76\xfa\x83\xfe\xd8\xe8[\u04fe51\xfa\u00fcd\xdc\x1f\xfc2\xf0\x8c\xbe\xe3\xf4L\xc32\xfaC\u01f0\f\xeb\xcb\xc03\xe9\xb3\xec\u05deeXk\xfa\xc0\xa2|&S\xc3\xee\u0646\u0763\xbf\xd1\xf4h\xaf?\x99\xf6\uc7bd\x1ex\x19\x97\x9e\xd5\xeb\x0f\x1dz\xbcy\xeb\xac\r\xeb\x8b\xf3\xcb`;][\xe6\u05b0\xa9\xa8\xc3\xf5$\xe7]\xd6eXk\xc3\x12$H\x8e\u0146\xd5+\xe5\xc8\x18\u04df~q*\x8a\xb2\xf0\xe1\u064b\xbf\xfd\a\xed\xad\xbf\xfd\x9f\xe7=\xc7\xfc\xa1\x17\x84F\x8c\"\u498c\xfdf\xb9\xc2\xec\"&kV?Eb\x95\xe6j\v\x8b\x99\xf5\xf4<4\x88\x8a\x01@s\xd7u\xe7U\xcbZ\xcb\xfc\x98\x82si\xd6e\\\xc4\x1f7(\x92\x19\x95\xff\x88\"\xe4\xc64 \u031b\x9a]\x1b\xc5?\xe4\x7fg\x1c\u0258\xfe\xe9=\xed\u0421\xc7(^\x86\xfb\x9f\x9f\xe5\xc9\xf4\x9em\x96\x83\x84\x0e\x01k\xd0\x1fZ\x93\u07b8?q\xa6n\x7f4u\xe8\xdf<H\xe8\xf5\xa7\xe3I\x8f\xbe\xf9M\xfa\xa3I\xf1\xcc\xec\xf5\xa7\xa3)6\x06\xfd\xa99\xe9\r\xfa\x93\x01\xa5\x1a\u04ff9\xc2\xe8O\xc7\u04deY \x8c\x02Q\xe7`P\x0e`U\xef,\xb3gY8\x13\xc9\x18\xf4\xad!kTY\x87\xe6[\xder\xf7U7\xb1c\xb0\x98\xdfu`F\xfb\xd2\xeej\x1b\u0746\xd6p8D\xba\xafC\uce84\xed<\xfd\x1bQ\x96\xe6v\x8a[#\x00\xdb\xfa\a\xd9\xe0\u050f0\xfa\xfd \x1aJ\x99\xb4q\x98\xedz\x0e\xc8\xe8\xbf\x7f\xb2c\x96\x1f\x85\x05\t;\x9bUZ\x9d\x97\x93p\xb2\xe8\xc9F6!\x06-\xd7<\xfa\x80\xaa\xa3+o\xb6\xc9\xf2\x1f4Q\xf83\"\xae\x8f\x7f?\x91\x94.}\x8c\xa4\x85\x91\x9b$t\xe5R\nH\x90\x1b{ki1M\xd5\xfc^\xbbo\xde\x1a;\xbc\x9a\xfe\x85\x04e\n\xe9\x137F.\xb0x\u021f\xf2_L\xe9\x1f`<3C\xa3f,&\x7f<Z\x18x\x82V\u02a1\u04e8\x1d\x15\xa8\u0492\nXhK\x05\u02f4\x06\x82J\xed\xfd\xb7\x8f\xf8\\\xba*\x05s\x02x3\xb8\xb0\x94\xf8X\f}\xd2\u0565\x7fJ\a<\x99Lfb\x8a\xe7\u03e6*\xb9\xcd\xe1\x93\xc9\xe4\xcf\xd6B\x14\xd3G-\xd7\x00\xe4\xf43=\x1e,\xba\x85\xde\xfc\xe1\x00,\xe1\r\x16?\x1a\xf4\xf2o\xf3\xc4|\xfe\xa7m\xfep2\x91\xe2\xec\x1260\x7f8\xa1\v\x7fc9\xcba\x89\x1d\x9a?\x9c\x8c\xa4\xb8\n\xf6\xe7\x7f\xfe\x7f(\xb3\xcaO\x80\xb7)4\xbd==\xc1\xbd<\xb5%l;;f_\xbe\xe73\xa9\x97\xa6m\xf4eK\x8a<\x81\x18\xb7\x00\a\xb8\xaaq\x9em\xedcNs\u0282\x9c\xc7\xees\xaa\x16\x89\xea\x8b\xf6\x8cG\xcd\x1a\x19\xa3\x8c\x85\x98\x8f\xf0\xd6\u023b\x9b\x87\xfbjj6\xe9\x1f\xf6^#\xe1\xfe\xac\u06aa2\u032d\x98\xee\xe5\xfb:*`\xd4\xe2\x16\xa6lU(\xfb\xee\xa2\xc7_\x11U\xdb[$\xd3\xd0\x11\"\x11\xa5\xe6\xca\xcbg\xbf7,\xe05\xd2\xfd\x94\u07c3\xd1\u03ee\x93\xa9\x1b\x02\x9a\xd0?\xfcR\xb9x\r\x8e\x99E\xbb%\\\xb2\x01\x89\xb90)K\xa6\xd5\x17\x11\xb3;\x13\xaahi\x92\xe7=\xe1mE\xc2}?\u0160\x18E\xfb\x8e\x8d\u05be\u04f7\x91\xe3i\xf6\x13Z(\xd4\xd9\xe6\xf5\xacL[\x98\u01f4\u0164\xf6F6\xe8\x8d{\x96\u065b<\xd3x\x8f\xe3\x13\x1bCzA\xe8\x85<\xb71\xea\x0f\x06\x83\x9e\xe5\xf4\x06=\xcb\xea\x8fFcl\xd1'\x06\xfdwH\xf3\x05\xef\xf2\xc7T\x84AoB\xcb\xe4o\xf0\xfce\xe2.\x0eW\xc6f\x7f\xa8r\xad\xe5\xa1\x13\xe6K\x16\xc5\x1b\xb8Y\x9c\xf8\x90_}Z1\xec\xf5\xa9\xbe\x17q\x18e\xdc\xe1S'\x99K\u05efWZ\x15\t\x17.\xe6\ua444\x99\u01697\xd5k#}\x99\x1b\x98\xe2\x8b\xe3\xf3\xfa\xae\x92\xea\xda~\xc7d\x0e\xfc\frG*\xdd^\u04bc\x17\b\xba\x1a\xb8Q\xd1e\xeb\u02cc`}\x8b\xa6\x93m\xff\xe7\xf6d\xce\xe4{\xde\x1b\xd8\x1e}.\x1b;=\xd9\u064f\xa6\xba;\x1c5\x99\u0227=x+\x1eT\x7f\u00fdO\xb2K\xa5\n\v\x83/\x98\xb4\xa3\xbd~\xb5\xf4c4a\x82\x8c\xec\xf1\x93\xe5\xa6\x1aV\x94,-{(\x84\x137xh\u07b3d\x9bM\x01\t{\x9f)\xb0\xb4\xf3\xd8\xfb\x17\a\xa6\u067c\xfb>\xab\xa5\u05ef\x9d'\xa5+\xa5'\nLZ\xad\xf9\xbb&\xfd#\x8b\x1b\x04\u02bf\xa5\v\xee\xfc\x9f\xfeE9\x193#\rW+\x8c\x0eZ\xb3=\xbb\u00f1\xd0:u\n\xbd\xfe\x00\x91\xa3\xd3b\xa6\xf6Q\xb4\xaf9\xadb\xe7\xda\xd4\\\xa0\xd5\xf3\xe3s#\x8c\xfd\xecE\xdd\xfc\xa1Wy9\xe1\xca{\xe9%\xb8\xf5\xa6\xf4\xbd\x10c7J\xd0B\xdc0\x97\xd5*'\x15\x8f9\xfeS\x96/\xec\xe11\x0e\xb0\x87L\x1a\xd6\xec\x8dz\x93\xbe3\xed\x8d\xfbC\xabg\r\xfa\u05b8g\xd9\xd8\x18\xf6\x9dIo\xd8w\xa6Ynt\x82G\u01a8)\xd1\xceeA\xb3\xe9@\xb5\xed\xb1q\xa5/\xd7\xd2\xed\x86DF\x1a\xe6w?\xa9\xae\xe9W1P]1\x04O\x81F_\xfc\xbab\u0783\xf9\x91h\x1d)2hy\xbdP\xfeh\xed\a\u9876\x015\xfb\x11\x83\xd4\x19T\x12\x93\x8c\x9f\xcb_\xb7\n\xd7^\xba(z\xa1j/\xdf\\\x057\xd4\xeaO\x10\x99\x89\x9bbU7\xce\xe6>\":\xd4/\xb0\x04U\xd6\xebGq\x18\xa18\xbd\xaf\xce M\xa7\xe2kO#e?\x8a}\xe2gQw\xc1c4\xa7\x7f\x18\xa2\x94&R\n\xca4\xee\x03\xb7h\x8dF\xa3Y\xd3\x16,9\x87^\xe5\x00\xc5O\xb1pT\xa5\xccF\x1c\xee\xfa1\xfa\xaf\x8d\x1f\xa3\x05G\u07f8\xb1\xab\x81\x1f\xf5\xe0\x8a\x8f\a5S3B\xb0\x87\xb6\xfb\xfc\xe1\xb2&N\xfd$uc\u0269z\x8e\x0e\xedS\x14$\xb5^\x18\x8f\xc7Zx*nm\xab\x8a\xa2\xdd\u015e\u039cO\"^\xd1*\u07b6-M\xe3\x02\xc7>X\xee\xd57G\x9a\x96\xfdY\x92\xf2\xe6\xcfC}o\x8c-.\xc3@44\xb2\u053fD\xbc!|\xe5Z\xb1\x1e6\\e82\xf3\x8dc\x8f:\x1fT\x7fM\x7f\xcc\r\xe6\x82\xe0:w\x80\x03D\x92\xdb\xc5\x05d\xbb[\xd2y\xf2\xea\x8cm\xb1\x83\xa4\x9d\x9e\xa0\x1dS\xbc\xf9\x01\xf3$\x1c\x159Z\x8c\xc0\x03TL\x8b\x9d\x86\xe1b\xf6\xaa\xb3CZ_\xd1i#\x90\xa2#\xc6\xcf[1cC\xd8\xda\xfd#\xad\xb8`Wdb\xf6\u06b2\xa9\xf6w1\xbdx\xbc\xe9\u0191\xee\x96q\x8a[-&Z\x15\xd2\x04(\xf7f%\u064b&\u04f2\x05\xf5=\x18\xb9\xd7G\xb8\xa4\x92\x16\xb6;\x942\xa9\xbd\x99\x1c5\xe6\xc8\u00f5\xea\xa6.\xfaGo8J\x99e\x9f\xfe\xe0\xbf:\x92\x7f\x1b\x02z7\x81\xf8\x1c\xa57vn\x1c\u0536n\xe7\xeb\\\x9a\x0e\x03~=\x95\x9c\x9c\xa1\xae/\xbb\xbb\xb8\f\xad\xe6\xb1\xeb!#{y?\xa8\xf6k\u04a9\xdf\b\\\x82\x1a\ufcc7+\u0389\xeb\x1f%p\x1c\x17@\xa0`C\x9a\x0e\ag\xa0|\xef\xfa\x81\x99\xa2XX\x82b\xba\xb1\xffo\xdd\x17\xe5u\x0eD\xd6+9^\xe8\xc0|\x00\x86;\r\t2\u8b47\xfd\xf24E\x19\x8c\x15\xa6%\x9c\xf4/I\xb2h\xa9\x97\xc6\a\xd9\a\xe39\xe0B\xd6g\x95\xb4\xb5\x93\x17F}w\x1fs\x84\x8b>\xd0\xf8\xc2%_\xb9\xe4\xd6 \x89\xa9\xf0\xd4y\xe2\x9c9\xa7\"\xbb%\xa4$\xed{!\x896th\xd1u\xaa\xd2\u014b\x87Z@|~\xf6C\x95\x94`&k\xeb\xa8<\xd1a\x15\x95\x14^\xa8y\xf3\a\xbf\x9d\xa5\xbc\x05\x010\x15\xf6\xb0\x17\x18\xb6\u05d6\x9f\xb3$\xc5i\xf9\x83p\xb34\xacO&&\xcfv\u00a41\xb5\xa5\x8az\xd6\xe65\xe4\xc8@\xfa\xd9\x00\xfa\x87\x9e\x1b*\xb7\x8f\xf0\xba\xcc\xf9\xe5i\xbd\xa4\x96\xc8z\xc2\f\x9b\x86}\xb3R\x14?T\x97}i\xbf\xde\x16\xf6\r\u8d7caMK\xbd\r\x8a\xad\xdd\xecU3P\xe0n\x9b\x82\x11mI\xce\u8929t\xad\xfb\x1eb?\x9f=\u0146(6']?\x10Fg\\\xc9Gi\x92\xec:\x11\xf9\xbd!G3\x9bN\x7f\xd0\xe5\u0474\xd9i\xd0x\x7fQ\x03O\xf6x\xaa\u43b2\u06a7}\xb2)\xb8\xad^G\xc0W\x87\xd8\x0f\xbc\x88\x1f\xa0\xe1\xea\xac\xd2\x18\xcdW\xa4\u0268\xf2\xf7.\xc9\x15|2\"\xe6\x94|q\xfd\xb3#\x1e\xae.\xe1\xcf\xf8\xb4\xb1z1\xd8\x185\xa8\xba\xcaB\x9c\xc8\x10~P\x9d\x05v\xef[8 \xe9Z\xff\xa8A\x9f\xb5\x80\xb7\xd2\xe3?\xbbND\xa2\xf4\xfe\x8f\xad\x8b7\xe8\x8f\"}/\xfdn\u04e0\x16/\x94\xbe\xc1\xe6b\b'\u06b7\xa8\xab\x98\xfb\x19K\x1d\xb7\xe2\xd0/\xf7V\x1d\xdf\x11\xc6\u009d~\xb8~}\x88\xfa\x9e-\xc1\xb5H\x1c\tp\x17\x14\xe3\x92at\xcd\xec\xfe\u0676\x95\x86\xd1\u070d\x81Mg\u059c\xfei\x9a2\n\xda\xf2\xbf\xf0\xed\x82\x05\xc6U\xdd\xe6\xf1'LU\xdf;\xa4\xfbi\x94,\xcb-n\xa9\xdd3+\xde\xfc\xba\x95\xf8>\\\u0290{-`\xcdO|?*\x15\x02]'\n|\xb0i \x9c\x8f)\xaf\x8b\xd1g+\xceG\xc06.\xdb5\a\u02d9\xf4\x90\xe015\xc7l!\x93Md\x8d\xe2\xf4\x8b/\x93\xa9\xbe\xe6\xb34\xe9\x1f\xee{B\xa5\x18\xb5\xed\nf\u04d4\xaa/I=\xe5\xc7O\x8a\u2f76\x8a{\f\xdbT[\x9f\xd9\xc1>\xa9vrT\xe7TN\xed\xa7\xe8\a\xe6\xa9xP\x8a5\nh\x9bb\xed\x10h\xbb/\x02\x8d\x1a\x02\xb8a\xb1W\x85m\x89\x1f,\xc3r\x849\x80\xa3\xa1\xe5\xc5\r\xbet\xa8,\xfd\x15\xf31\xce\xdaU\x12\xc5\x04\xc1}~t2\u0478D\x8aV\xd2[S\xf5D}\xe2\xfa\xc1\x81\xbb\r\xaa\x19\xdds\xf9\x0f\u06c8\xf0(F\x8d\x1f\x82\xc9@\xd8?\x01(\x81g\xf9\xfb\u0763/J\xcc\x1bb\x01\x15\xacm\xe8\xe1\x00z8\x84\x1e:\xed/\xd5\xc8)\xdd\x03{\xc8Z\xabU3\u0557@s\xd6\xec\xf7o\xad\xe5h\xea\x01\u0196\xdd\x1d\u0474\xff'c\u059f\xbb\t\xca\xf2\v\x8f\x7f\t\xd5\xd8\xdd\tI\xc0\xe45u\x8d`0\x8a\xf6j\xae\xc5\xe7R\xb9\x83\xa1\u00e9\xcd\xf9\tg,K\x03\xc3\x1b8\xf2w\xfc\xfa\r\xc1v\xeegd\xe1\xb9#\xa6\x9c\x92M\x84b\x85\xe4\xd5\xc5\x1eI\xea\x92\b\x88p&\xd3\xf9\xd2\x1c*\xb8d\x83V\xed\xfeT\x17\u0678\x9btm\xd0\xe3\xf7`4\xa0\xb8\x1e\x0f\x8a\xbdx\x86\xf9\xe1\xfeE\x18\xa8nN\xca\b\xe51\x89\xa5\x19\x93\xd4\xd9\xf4j\x17D\xb0\x95\xd7\u04c7q\xb5\xed\x03\xe0T[yR\xecU\xe3\x96\xc7\xc4\xd5\xc4&\xee\xec\x9aMuG\xb7|i\xb2\x89\x19]7\xad\x1f\xf8\u0252\u0132\xadT\x1ci\xf5\x1dm-\xc39\xd2)O@5\x10\x14\xe9\n\xee0\\\xa3\x94(\x8e\xc38\x81\xce\xc5 \xf9N\x7f\xfaJ\xfa\x14\xe7\x1b\x8a\xfd\x8f\\\xdeJG\xe0\xde\\\x18\x04\xfc\xadw\x9e\x1be\xafG\x0f|\xe2\xda\v#\x94\xf4\xd6\xf6\xe3g\u058a\x950\xa1A\x97\xf4\v\x17\xce\x14\x8bI\xd0\xcb\xca&(nQ\x05*4\x16h\u067c\xb9\xa0PR\xe5\x04\x80\x93Z\x9e\x8b\xd1\u723b\x84\xaf\xfeT\xe7CR\xf5p\xb7X\u0412\xae\x1d\xdb\x1aC\x99\x15\xbb\xf8\xdd\xe0\a\x12\xb8p\x05\x92&\xcd;uAC\x1d6l\a\u056a\x92\xf9\x069\xb4p%\xa1\xab\x92\xfe\xfc\xb5\xb3\xa2\x19(8\xf4\xd3uL\xef\x94b\u05e9\xf4Xd\xff\u036a<(.)\x93\x98(\xc78\x0f\xa0\xdb\xe5\x06@\x16\u077f\xa4j\xd7C\x13\xf0`]n\xf6\x87\xfaMx3\xe1\xcc\xc7\xe4\xf9\x9fix8^\xd8\xc8\x03,\xee\xe4\u06d31ets\x93\x9d\x81\x8a\xfbe\xb8tL}\xc9\x0f\xf9\x107\xbe\xa3/\x8b\xfcK\xc6\xf1y,\\RH;\x17-\xae$\x94by\\m\x90\xb0\x8a\u06f5;\xdf/\xddF\xac\x18\t\xb7\xd6\x14Fb\x9a\xa6\x90\f?\xaeiAW;K\xa4\xa0+\x85\n1\xb8\x8b\xe446\xd40_\xc1\xea\xe6\x94\xea\xa7\u06c4ti]\x87\x7f\xd3hA\t\xe3\xd6\xe5\xff\xed\xdf^\xfc\xf5/\xbd$\xdc\xc4\x1e\xbar\xa3\xc8\x0fV\x9fo\xde\xfd|d\xd5\xf7\x92\xa4O\xdc\xe8\xaf/\xfe\xdf\x00\xf5YF\xedl2\x02\x00",
+}
@@ -0,0 +1,65 @@
+// +build ignore
+
+// gen generates assets.go of files under swagger-ui/, run by go generate.
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	"sort"
+)
+
+const dir = "swagger-ui"
+
+// ignored files are kept along with assets but not served.
+var ignored = map[string]bool{
+	"LICENSE":   true,
+	"README.md": true,
+}
+
+func main() {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	names := make([]string, 0, len(files))
+	for _, fi := range files {
+		if !fi.IsDir() && !ignored[fi.Name()] {
+			names = append(names, fi.Name())
+		}
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by go run gen.go; DO NOT EDIT.\n\n")
+	buf.WriteString("package openapi\n\n")
+	buf.WriteString("// swaggerUIFiles are gzipped files of swagger-ui/, keyed by name.\n")
+	buf.WriteString("var swaggerUIFiles = map[string]string{\n")
+	for _, name := range names {
+		data, err := ioutil.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			log.Fatal(err)
+		}
+		var gz bytes.Buffer
+		w, _ := gzip.NewWriterLevel(&gz, gzip.BestCompression)
+		w.Write(data)
+		if err := w.Close(); err != nil {
+			log.Fatal(err)
+		}
+		fmt.Fprintf(&buf, "\t%q: %+q,\n", name, gz.String())
+	}
+	buf.WriteString("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile("assets.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,426 @@
+package openapi
+
+import (
+	"encoding"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sevenNt/ares/server/echo"
+)
+
+// sources are request parts of parameters, in order of echo binding.
+var sources = []string{"path", "query", "header", "cookie"}
+
+// wildcard names the path param of "*" routes.
+const wildcard = "wildcard"
+
+// Generate generates OpenAPI document of routes, e.g. of
+// echo.Server.GetRouteInfos. Routes are described by their documentation, and
+// undocumented routes by their path params only.
+func Generate(routes []echo.RouteInfo, opts ...Option) *Document {
+	options := newOptions(opts...)
+	g := &generator{
+		schemas: make(map[string]*Schema),
+		names:   make(map[reflect.Type]string),
+	}
+	doc := &Document{
+		OpenAPI: "3.0.3",
+		Info: Info{
+			Title:       options.title,
+			Description: options.description,
+			Version:     options.version,
+		},
+		Servers: options.servers,
+		Paths:   make(map[string]*PathItem),
+	}
+
+	for _, route := range routes {
+		if strings.HasPrefix(route.Name, routePrefix) {
+			continue
+		}
+		p, params := convertPath(route.Path)
+		item, ok := doc.Paths[p]
+		if !ok {
+			item = &PathItem{}
+			doc.Paths[p] = item
+		}
+		(*item)[strings.ToLower(route.Method)] = g.operation(route, params)
+	}
+	doc.Components.Schemas = g.schemas
+	return doc
+}
+
+type generator struct {
+	schemas map[string]*Schema
+	names   map[reflect.Type]string
+}
+
+func (g *generator) operation(route echo.RouteInfo, pathParams []*Parameter) *Operation {
+	op := &Operation{
+		OperationID: route.Name,
+		Responses:   make(map[string]*Response),
+	}
+
+	var params []*Parameter
+	if doc := route.Doc; doc != nil {
+		op.Summary = doc.Summary
+		op.Description = doc.Description
+		op.Tags = doc.Tags
+		if doc.Request != nil {
+			t := indirect(reflect.TypeOf(doc.Request))
+			params = g.parameters(t, nil)
+			if route.Method != echo.GET && route.Method != echo.HEAD && hasBody(t) {
+				op.RequestBody = &RequestBody{
+					Required: true,
+					Content: map[string]*MediaType{
+						echo.MIMEApplicationJSON: {Schema: g.schemaOf(t)},
+					},
+				}
+			}
+		}
+		for code, v := range doc.Responses {
+			res := &Response{Description: http.StatusText(code)}
+			if v != nil {
+				res.Content = map[string]*MediaType{
+					echo.MIMEApplicationJSON: {Schema: g.schemaOf(reflect.TypeOf(v))},
+				}
+			}
+			op.Responses[strconv.Itoa(code)] = res
+		}
+	}
+	if len(op.Responses) == 0 {
+		op.Responses["200"] = &Response{Description: http.StatusText(http.StatusOK)}
+	}
+
+	// path params of pattern not bound by request fields
+	for _, pp := range pathParams {
+		found := false
+		for _, param := range params {
+			if param.In == "path" && param.Name == pp.Name {
+				if param.Schema.Pattern == "" && param.Schema.Ref == "" {
+					param.Schema.Pattern = pp.Schema.Pattern
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			params = append(params, pp)
+		}
+	}
+	sort.SliceStable(params, func(i, j int) bool {
+		return params[i].In == "path" && params[j].In != "path"
+	})
+	op.Parameters = params
+	return op
+}
+
+// convertPath converts echo path to OpenAPI path and its params, e.g.
+// /users/:id<[0-9]+>/files/* to /users/{id}/files/{wildcard}.
+func convertPath(p string) (string, []*Parameter) {
+	var (
+		buf    = make([]byte, 0, len(p))
+		params []*Parameter
+	)
+	for i, l := 0, len(p); i < l; i++ {
+		switch p[i] {
+		case ':':
+			j := i + 1
+			pattern := ""
+			for ; i < l && p[i] != '/'; i++ {
+				if p[i] == '<' {
+					k := i + 1
+					for ; i < l && p[i] != '>'; i++ {
+					}
+					pattern = "^(?:" + p[k:i] + ")$"
+				}
+			}
+			name := p[j:i]
+			if k := strings.IndexByte(name, '<'); k >= 0 {
+				name = name[:k]
+			}
+			i--
+			buf = append(buf, '{')
+			buf = append(buf, name...)
+			buf = append(buf, '}')
+			params = append(params, &Parameter{Name: name, In: "path", Required: true, Schema: &Schema{Type: "string", Pattern: pattern}})
+		case '*':
+			buf = append(buf, "{"+wildcard+"}"...)
+			params = append(params, &Parameter{Name: wildcard, In: "path", Required: true, Schema: &Schema{Type: "string"}})
+		default:
+			buf = append(buf, p[i])
+		}
+	}
+	// trailing slash is kept, /groups/ and /groups are different routes
+	return string(buf), params
+}
+
+// parameters returns params of fields tagged by path, query, header and
+// cookie.
+func (g *generator) parameters(t reflect.Type, params []*Parameter) []*Parameter {
+	if t.Kind() != reflect.Struct {
+		return params
+	}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous && indirect(f.Type).Kind() == reflect.Struct {
+			params = g.parameters(indirect(f.Type), params)
+			continue
+		}
+		if f.PkgPath != "" {
+			continue
+		}
+		for _, source := range sources {
+			name := tagName(f, source)
+			if name == "" {
+				continue
+			}
+			rules := parseRules(f.Tag.Get("validate"))
+			schema := g.schemaOf(f.Type)
+			applyRules(schema, indirect(f.Type), rules)
+			if def, ok := f.Tag.Lookup("default"); ok && schema.Ref == "" {
+				schema.Default = def
+			}
+			_, required := rules["required"]
+			params = append(params, &Parameter{
+				Name:     name,
+				In:       source,
+				Required: required || source == "path",
+				Schema:   schema,
+			})
+			break
+		}
+	}
+	return params
+}
+
+// hasBody reports whether struct type t has fields of body.
+func hasBody(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct {
+		return true
+	}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous && indirect(f.Type).Kind() == reflect.Struct {
+			if hasBody(indirect(f.Type)) {
+				return true
+			}
+			continue
+		}
+		if f.PkgPath == "" && bodyName(f) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// bodyName returns json name of field, fields bound from other request
+// parts only are not in body.
+func bodyName(f reflect.StructField) string {
+	tag := f.Tag.Get("json")
+	if tag == "-" {
+		return ""
+	}
+	if name := strings.Split(tag, ",")[0]; name != "" {
+		return name
+	}
+	for _, source := range sources {
+		if tagName(f, source) != "" {
+			return ""
+		}
+	}
+	return f.Name
+}
+
+func tagName(f reflect.StructField, tag string) string {
+	name := strings.Split(f.Tag.Get(tag), ",")[0]
+	if name == "-" {
+		return ""
+	}
+	return name
+}
+
+func indirect(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
+
+var (
+	timeType            = reflect.TypeOf(time.Time{})
+	jsonMarshalerType   = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// schemaOf returns schema of type t, named struct types are referenced to
+// component schemas.
+func (g *generator) schemaOf(t reflect.Type) *Schema {
+	t = indirect(t)
+	if t == timeType {
+		return &Schema{Type: "string", Format: "date-time"}
+	}
+	pt := reflect.PtrTo(t)
+	if t.Implements(textMarshalerType) || pt.Implements(textMarshalerType) ||
+		t.Kind() != reflect.Struct && (pt.Implements(textUnmarshalerType) || t.Implements(jsonMarshalerType)) {
+		return &Schema{Type: "string"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: g.schemaOf(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: g.schemaOf(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return g.structSchema(t)
+		}
+		return &Schema{Ref: "#/components/schemas/" + g.component(t)}
+	}
+	// interfaces and others accept any value
+	return &Schema{}
+}
+
+// component returns name of component schema of struct type t, registering
+// it on first use.
+func (g *generator) component(t reflect.Type) string {
+	if name, ok := g.names[t]; ok {
+		return name
+	}
+	name := t.Name()
+	if _, ok := g.schemas[name]; ok {
+		// types of same name in different packages
+		name = strings.Replace(t.PkgPath(), "/", ".", -1) + "." + name
+	}
+	g.names[t] = name
+	// placeholder for recursive types
+	g.schemas[name] = &Schema{}
+	*g.schemas[name] = *g.structSchema(t)
+	return name
+}
+
+func (g *generator) structSchema(t reflect.Type) *Schema {
+	s := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	g.appendFields(s, t)
+	return s
+}
+
+func (g *generator) appendFields(s *Schema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous && indirect(f.Type).Kind() == reflect.Struct && tagName(f, "json") == "" {
+			g.appendFields(s, indirect(f.Type))
+			continue
+		}
+		if f.PkgPath != "" {
+			continue
+		}
+		name := bodyName(f)
+		if name == "" {
+			continue
+		}
+		rules := parseRules(f.Tag.Get("validate"))
+		schema := g.schemaOf(f.Type)
+		applyRules(schema, indirect(f.Type), rules)
+		if def, ok := f.Tag.Lookup("default"); ok && schema.Ref == "" {
+			schema.Default = def
+		}
+		s.Properties[name] = schema
+		if _, ok := rules["required"]; ok {
+			s.Required = append(s.Required, name)
+		}
+	}
+}
+
+// parseRules parses validate tag of echo, e.g. "required,min=1".
+func parseRules(tag string) map[string]string {
+	rules := make(map[string]string)
+	if tag == "" || tag == "-" {
+		return rules
+	}
+	for _, rule := range strings.Split(tag, ",") {
+		kv := strings.SplitN(rule, "=", 2)
+		if len(kv) == 2 {
+			rules[strings.TrimSpace(kv[0])] = kv[1]
+		} else {
+			rules[strings.TrimSpace(kv[0])] = ""
+		}
+	}
+	return rules
+}
+
+// applyRules describes validation rules of type t in schema.
+func applyRules(s *Schema, t reflect.Type, rules map[string]string) {
+	// siblings of $ref are ignored
+	if s.Ref != "" {
+		return
+	}
+	for rule, param := range rules {
+		switch rule {
+		case "min", "max", "len":
+			n, err := strconv.ParseFloat(param, 64)
+			if err != nil {
+				continue
+			}
+			u := uint64(n)
+			switch s.Type {
+			case "integer", "number":
+				if rule != "max" {
+					s.Minimum = &n
+				}
+				if rule != "min" {
+					s.Maximum = &n
+				}
+			case "string":
+				if rule != "max" {
+					s.MinLength = &u
+				}
+				if rule != "min" {
+					s.MaxLength = &u
+				}
+			case "array":
+				if rule != "max" {
+					s.MinItems = &u
+				}
+				if rule != "min" {
+					s.MaxItems = &u
+				}
+			}
+		case "regexp":
+			s.Pattern = param
+		case "email":
+			s.Format = "email"
+		case "oneof":
+			for _, option := range strings.Fields(param) {
+				if s.Type == "integer" || s.Type == "number" {
+					if n, err := strconv.ParseFloat(option, 64); err == nil {
+						s.Enum = append(s.Enum, n)
+						continue
+					}
+				}
+				s.Enum = append(s.Enum, option)
+			}
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package openapi
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/sevenNt/ares/server/echo"
+)
+
+// routePrefix prefixes names of routes registered by Serve, which are left
+// out of documents.
+const routePrefix = "openapi."
+
+// Serve serves OpenAPI document of routes of s at path/openapi.json, and
+// Swagger UI of it at path/ with its assets compiled into the package, e.g.
+//
+//	openapi.Serve(s, "/docs", openapi.Title("order"), openapi.Version("v1"))
+//
+// The document is generated at the first request, so routes registered
+// after Serve are documented as well.
+func Serve(s *echo.Server, path string, opts ...Option) {
+	var (
+		options = newOptions(opts...)
+		once    sync.Once
+		doc     *Document
+	)
+	path = strings.TrimRight(path, "/")
+	specURL := path + "/openapi.json"
+
+	s.GET(specURL, func(c *echo.Context) error {
+		once.Do(func() {
+			doc = Generate(s.GetRouteInfos(), opts...)
+		})
+		return c.JSON(http.StatusOK, doc)
+	}).Name(routePrefix + "spec")
+
+	s.GET(path+"/", func(c *echo.Context) error {
+		return c.HTML(http.StatusOK, uiTemplate, "ui", map[string]string{
+			"Title":   options.title,
+			"Path":    path,
+			"SpecURL": specURL,
+		})
+	}).Name(routePrefix + "ui")
+
+	for name := range swaggerUIFiles {
+		name := name
+		s.GET(path+"/"+name, func(c *echo.Context) error {
+			return c.ServeContent(bytes.NewReader(swaggerUIAssets()[name]), name, assetsModTime)
+		}).Name(routePrefix + name)
+	}
+}
@@ -0,0 +1,158 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sevenNt/ares/server/echo"
+)
+
+type Address struct {
+	City string `json:"city" validate:"required"`
+}
+
+type User struct {
+	ID        int64     `json:"id"`
+	Name      string    `json:"name" validate:"required,min=2,max=32"`
+	Email     string    `json:"email" validate:"email"`
+	Role      string    `json:"role" validate:"oneof=admin user"`
+	Address   *Address  `json:"address"`
+	Friends   []*User   `json:"friends"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type UpdateUser struct {
+	ID     int64  `path:"id"`
+	Token  string `header:"X-Token" validate:"required"`
+	DryRun bool   `query:"dry_run" default:"false"`
+	Name   string `json:"name" validate:"required"`
+}
+
+type ListUsers struct {
+	Page   int      `query:"page" default:"1" validate:"min=1"`
+	States []string `query:"state"`
+}
+
+func nop(c *echo.Context) error { return nil }
+
+func TestGenerate(t *testing.T) {
+	s := echo.NewServer(nil)
+	s.GET("/users", nop).Name("users").Tags("user").Request(ListUsers{}).Response(200, []User{})
+	s.PUT("/users/:id<[0-9]+>", nop).Summary("update user").Request(&UpdateUser{}).
+		Response(200, User{}).Response(404, nil)
+	s.GET("/files/*", nop)
+	s.GET("/groups", nop)
+	s.GET("/groups/", nop)
+	Serve(s, "/docs")
+
+	doc := Generate(s.GetRouteInfos(), Title("users"), Version("v1"))
+	if doc.Info.Title != "users" || doc.Info.Version != "v1" {
+		t.Errorf("info = %+v", doc.Info)
+	}
+	if len(doc.Paths) != 5 || doc.Paths["/groups"] == nil || doc.Paths["/groups/"] == nil {
+		t.Fatalf("paths = %v", doc.Paths)
+	}
+
+	list := (*doc.Paths["/users"])["get"]
+	if list.OperationID != "users" || list.Tags[0] != "user" || list.RequestBody != nil {
+		t.Errorf("list = %+v", list)
+	}
+	if len(list.Parameters) != 2 {
+		t.Fatalf("list params = %+v", list.Parameters)
+	}
+	page := list.Parameters[0]
+	if page.In != "query" || page.Schema.Type != "integer" || page.Schema.Default != "1" || *page.Schema.Minimum != 1 {
+		t.Errorf("page = %+v", page.Schema)
+	}
+	if states := list.Parameters[1].Schema; states.Type != "array" || states.Items.Type != "string" {
+		t.Errorf("states = %+v", states)
+	}
+	if res := list.Responses["200"].Content[echo.MIMEApplicationJSON].Schema; res.Items.Ref != "#/components/schemas/User" {
+		t.Errorf("list response = %+v", res)
+	}
+
+	update := (*doc.Paths["/users/{id}"])["put"]
+	if update.Summary != "update user" || len(update.Parameters) != 3 {
+		t.Fatalf("update = %+v", update)
+	}
+	if id := update.Parameters[0]; id.In != "path" || !id.Required || id.Schema.Pattern != "^(?:[0-9]+)$" {
+		t.Errorf("id = %+v", id)
+	}
+	if token := update.Parameters[1]; token.In != "header" || !token.Required {
+		t.Errorf("token = %+v", token)
+	}
+	body := update.RequestBody.Content[echo.MIMEApplicationJSON].Schema
+	if body.Ref != "#/components/schemas/UpdateUser" {
+		t.Errorf("body = %+v", body)
+	}
+	if schema := doc.Components.Schemas["UpdateUser"]; len(schema.Properties) != 1 || schema.Required[0] != "name" {
+		t.Errorf("UpdateUser = %+v", schema)
+	}
+	if res := update.Responses["404"]; res.Description != "Not Found" || res.Content != nil {
+		t.Errorf("404 = %+v", res)
+	}
+
+	files := (*doc.Paths["/files/{wildcard}"])["get"]
+	if len(files.Parameters) != 1 || files.Responses["200"] == nil {
+		t.Errorf("files = %+v", files)
+	}
+
+	user := doc.Components.Schemas["User"]
+	if name := user.Properties["name"]; *name.MinLength != 2 || *name.MaxLength != 32 {
+		t.Errorf("name = %+v", name)
+	}
+	if user.Properties["email"].Format != "email" || len(user.Properties["role"].Enum) != 2 {
+		t.Errorf("user = %+v", user.Properties)
+	}
+	if user.Properties["created_at"].Format != "date-time" || user.Properties["friends"].Items.Ref != "#/components/schemas/User" {
+		t.Errorf("user = %+v", user.Properties)
+	}
+	if user.Properties["address"].Ref != "#/components/schemas/Address" || doc.Components.Schemas["Address"].Required[0] != "city" {
+		t.Errorf("address = %+v", user.Properties["address"])
+	}
+}
+
+func TestServe(t *testing.T) {
+	s := echo.NewServer(nil)
+	Serve(s, "/docs/", Title("users"))
+	s.GET("/users/:id", nop)
+
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(echo.GET, "/docs/openapi.json", nil))
+	var doc Document
+	if err := json.Unmarshal(rec.Body.Bytes(), &doc); err != nil {
+		t.Fatal(err)
+	}
+	if doc.OpenAPI != "3.0.3" || len(doc.Paths) != 1 || doc.Paths["/users/{id}"] == nil {
+		t.Errorf("doc = %+v", doc)
+	}
+
+	// Swagger UI and its assets
+	rec = httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(echo.GET, "/docs/", nil))
+	if body := rec.Body.String(); !strings.Contains(body, `url: "\/docs\/openapi.json"`) || !strings.Contains(body, `src="/docs/swagger-ui-bundle.js"`) ||
+		!strings.Contains(body, "<title>users</title>") || strings.Contains(body, "unpkg") {
+		t.Errorf("ui = %s", body)
+	}
+	for name, contentType := range map[string]string{
+		"swagger-ui-bundle.js": "javascript",
+		"swagger-ui.css":       "text/css",
+		"favicon-32x32.png":    "image/png",
+	} {
+		rec = httptest.NewRecorder()
+		s.ServeHTTP(rec, httptest.NewRequest(echo.GET, "/docs/"+name, nil))
+		if rec.Code != http.StatusOK || rec.Body.Len() == 0 || !strings.Contains(rec.Header().Get(echo.HeaderContentType), contentType) {
+			t.Errorf("%s = %d %v", name, rec.Code, rec.Header())
+		}
+	}
+	rec = httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(echo.GET, "/docs/favicon-32x32.png", nil))
+	if !bytes.HasPrefix(rec.Body.Bytes(), []byte("\x89PNG")) {
+		t.Errorf("favicon = %q", rec.Body.String())
+	}
+}
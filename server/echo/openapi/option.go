@@ -0,0 +1,53 @@
+package openapi
+
+// Option is used to set options of documents.
+type Option func(*Options)
+
+// Options wraps document options.
+type Options struct {
+	title       string
+	description string
+	version     string
+	servers     []Server
+}
+
+func newOptions(opts ...Option) Options {
+	options := Options{
+		title:   "API",
+		version: "1.0.0",
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return options
+}
+
+// Title sets title of API, defaults to "API".
+func Title(title string) Option {
+	return func(o *Options) {
+		o.title = title
+	}
+}
+
+// Description sets description of API.
+func Description(description string) Option {
+	return func(o *Options) {
+		o.description = description
+	}
+}
+
+// Version sets version of API, defaults to "1.0.0".
+func Version(version string) Option {
+	return func(o *Options) {
+		o.version = version
+	}
+}
+
+// Servers sets server URLs of API.
+func Servers(urls ...string) Option {
+	return func(o *Options) {
+		for _, url := range urls {
+			o.servers = append(o.servers, Server{URL: url})
+		}
+	}
+}
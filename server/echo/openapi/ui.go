@@ -0,0 +1,66 @@
+package openapi
+
+//go:generate go run gen.go
+
+import (
+	"bytes"
+	"compress/gzip"
+	"html/template"
+	"io/ioutil"
+	"sync"
+	"time"
+)
+
+var (
+	assetsOnce sync.Once
+	assets     map[string][]byte
+)
+
+// assetsModTime is modification time of assets, which are revalidated once
+// process restarts.
+var assetsModTime = time.Now()
+
+// swaggerUIAssets returns files of Swagger UI keyed by name, which are
+// decompressed at the first call.
+func swaggerUIAssets() map[string][]byte {
+	assetsOnce.Do(func() {
+		assets = make(map[string][]byte, len(swaggerUIFiles))
+		for name, file := range swaggerUIFiles {
+			r, err := gzip.NewReader(bytes.NewReader([]byte(file)))
+			if err != nil {
+				panic("openapi: invalid asset " + name + ": " + err.Error())
+			}
+			data, err := ioutil.ReadAll(r)
+			if err != nil {
+				panic("openapi: invalid asset " + name + ": " + err.Error())
+			}
+			assets[name] = data
+		}
+	})
+	return assets
+}
+
+var uiTemplate = template.Must(template.New("ui").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<link rel="stylesheet" href="{{.Path}}/swagger-ui.css">
+<link rel="icon" type="image/png" href="{{.Path}}/favicon-32x32.png" sizes="32x32">
+<link rel="icon" type="image/png" href="{{.Path}}/favicon-16x16.png" sizes="16x16">
+</head>
+<body>
+<div id="swagger-ui"></div>
+<script src="{{.Path}}/swagger-ui-bundle.js"></script>
+<script>
+window.onload = function() {
+	window.ui = SwaggerUIBundle({
+		url: "{{.SpecURL}}",
+		dom_id: "#swagger-ui",
+		oauth2RedirectUrl: location.origin + "{{.Path}}/oauth2-redirect.html"
+	});
+};
+</script>
+</body>
+</html>
+`))
@@ -22,3 +22,17 @@ func WithAddr(addr string) Option {
 		o.addr = addr
 	}
 }
+
+// TrailingSlash is policy of requests whose path matches no route, but does
+// after being cleaned or having trailing slash toggled, e.g. /users/ or
+// //users for route /users.
+type TrailingSlash int
+
+const (
+	// TrailingSlashStrict serves such requests with not found handler.
+	TrailingSlashStrict TrailingSlash = iota
+	// TrailingSlashRedirect redirects such requests to the matching path.
+	TrailingSlashRedirect
+	// TrailingSlashMatch serves such requests with the matching route.
+	TrailingSlashMatch
+)
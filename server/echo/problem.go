@@ -0,0 +1,76 @@
+package echo
+
+import "github.com/sevenNt/ares/codec"
+
+// Problem is a machine-readable error response of RFC 7807, see
+// https://tools.ietf.org/html/rfc7807.
+type Problem struct {
+	Type     string `json:"type,omitempty"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+	// Errors are failing fields of request validation.
+	Errors []FieldError `json:"errors,omitempty"`
+}
+
+// NewProblem constructs a problem of status, with optional detail.
+func NewProblem(status int, detail ...string) *Problem {
+	p := &Problem{
+		Type:   "about:blank",
+		Title:  StatusText(status),
+		Status: status,
+	}
+	if len(detail) > 0 {
+		p.Detail = detail[0]
+	}
+	return p
+}
+
+// Error implements error interface.
+func (p *Problem) Error() string {
+	if p.Detail != "" {
+		return p.Title + ": " + p.Detail
+	}
+	return p.Title
+}
+
+// ProblemOf converts errors of binding to problems, validation errors are
+// 422 with failing fields, bodies over limit are 413, HTTP errors keep their
+// status, and other errors, e.g. malformed body, are 400.
+func ProblemOf(err error) *Problem {
+	if err == ErrRequestEntityTooLarge {
+		return NewProblem(StatusRequestEntityTooLarge, err.Error())
+	}
+	switch e := err.(type) {
+	case *Problem:
+		return e
+	case *ValidationError:
+		p := NewProblem(StatusUnprocessableEntity, "request validation failed")
+		p.Errors = e.Fields
+		return p
+	case *HTTPError:
+		return NewProblem(e.Code, e.Message)
+	case HTTPError:
+		return NewProblem(e.Code, e.Message)
+	}
+	return NewProblem(StatusBadRequest, err.Error())
+}
+
+// Problem sends problem details as application/problem+json, e.g.
+//
+//	if err := c.Bind(&req); err != nil {
+//		return c.Problem(echo.ProblemOf(err))
+//	}
+func (c *Context) Problem(p *Problem) error {
+	if p.Instance == "" {
+		p.Instance = c.request.URL.Path()
+	}
+	c.response.Header().Set(HeaderContentType, MIMEApplicationProblemJSON)
+	c.response.SetStatus(p.Status)
+	c.response.SetData(p)
+	c.response.SetEncoder(problemCodec)
+	return nil
+}
+
+var problemCodec codec.Encoder = codec.NewJSONCodec()
@@ -22,7 +22,7 @@ func newRequest(req *http.Request) *Request {
 
 func (r *Request) reset(req *http.Request) {
 	r.Request = req
-	r.URL = newURL(req.URL)
+	r.URL.reset(req.URL)
 }
 
 // IsTLS return request TLS flag.
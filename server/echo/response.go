@@ -20,10 +20,15 @@ type Response struct {
 	encoder codec.Encoder
 
 	commited bool
+	// written reports whether body is written through writer.
+	written bool
+	// wrapped reports whether writer wraps ResponseWriter, e.g. to compress.
+	wrapped bool
 }
 
 func (r *Response) SetWriter(w io.Writer) {
 	r.writer = w
+	r.wrapped = true
 }
 
 func (r *Response) Writer() io.Writer {
@@ -57,7 +62,33 @@ func newResponse(w http.ResponseWriter) (r *Response) {
 func (r *Response) reset(w http.ResponseWriter) {
 	r.ResponseWriter = w
 	r.status = StatusOK
+	r.size = 0
 	r.writer = w
+	r.data = nil
+	r.encoder = nil
+	r.commited = false
+	r.written = false
+	r.wrapped = false
+}
+
+// unwrap drops writer wrapping ResponseWriter and its Content-Encoding, for
+// bodies which are encoded already.
+func (r *Response) unwrap() {
+	if r.wrapped {
+		r.writer = r.ResponseWriter
+		r.wrapped = false
+		r.Header().Del(HeaderContentEncoding)
+	}
+}
+
+// encodedWriter writes body through writer of response, e.g. of gzip
+// middleware, instead of ResponseWriter.
+type encodedWriter struct {
+	*Response
+}
+
+func (w encodedWriter) Write(bs []byte) (int, error) {
+	return streamWriter{w.Response}.Write(bs)
 }
 
 // WriteHeader sends an HTTP response header with status code. If WriteHeader is
@@ -105,6 +136,17 @@ func (r *Response) Flush() {
 	}
 
 	if r.encoder != nil {
-		r.encoder.Encode(r.writer, r.data)
+		r.written = true
+		r.encoder.Encode(streamWriter{r}, r.data)
+	}
+
+	// ends compressed body, e.g. of gzip middleware, bodies written to
+	// ResponseWriter directly are not compressed
+	if c, ok := r.writer.(io.Closer); ok && r.written && bodyAllowed(r.status) {
+		c.Close()
 	}
 }
+
+func bodyAllowed(status int) bool {
+	return status != StatusNoContent && status != StatusNotModified && (status < 100 || status >= 200)
+}
@@ -1,44 +1,182 @@
 package echo
 
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"reflect"
+	"regexp"
+	"runtime"
+	"sort"
+	"strings"
+)
+
 const (
 	skind uint8 = iota
 	pkind
 	akind
 )
 
-type fn interface{}
-
 type node struct {
 	kind     uint8
 	label    byte
 	prefix   string
 	parent   *node
 	children []*node
-	ppath    string
-	pnames   []string
 	h        handler
-	fn       fn
 }
 
-type handler map[string]fn
+// handler maps methods to endpoints of a node.
+type handler map[string]*endpoint
+
+// lookup returns endpoint of method, HEAD falls back to GET.
+func (h handler) lookup(method string) *endpoint {
+	if e := h[method]; e != nil {
+		return e
+	}
+	if method == HEAD {
+		return h[GET]
+	}
+	return nil
+}
 
-type route struct {
-	method string
-	path   string
+// allow returns value of Allow header listing methods of h.
+func (h handler) allow() string {
+	methods := make([]string, 0, len(h)+2)
+	for method := range h {
+		methods = append(methods, method)
+	}
+	if h[GET] != nil && h[HEAD] == nil {
+		methods = append(methods, HEAD)
+	}
+	if h[OPTIONS] == nil {
+		methods = append(methods, OPTIONS)
+	}
+	sort.Strings(methods)
+	return strings.Join(methods, ", ")
+}
+
+// endpoint is a registered handler with its pattern path and param names,
+// it's immutable once added so that lookups can share it across goroutines.
+type endpoint struct {
 	h      HandlerFunc
-	mws    []MiddlewareFunc
+	ppath  string
+	pnames []string
+	// regexps constrains param values by index, nil if unconstrained.
+	regexps []*regexp.Regexp
+}
+
+// match reports whether param values satisfy constraints of e.
+func (e *endpoint) match(pvalues []string) bool {
+	for i, re := range e.regexps {
+		if re != nil && !re.MatchString(pvalues[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Route is a registered route, which can be named for reverse URL generation.
+type Route struct {
+	router      *Router
+	method      string
+	path        string
+	name        string
+	handler     string
+	middlewares []string
+	doc         *RouteDoc
+}
+
+// RouteDoc documents route for API specs, e.g. OpenAPI.
+type RouteDoc struct {
+	Summary     string
+	Description string
+	Tags        []string
+	// Request is value of type bound by handler, whose path, query, header,
+	// cookie and json tags describe parameters and body.
+	Request interface{}
+	// Responses are values of response types by status code, nil values
+	// have no body.
+	Responses map[int]interface{}
+}
+
+// Doc returns documentation of route.
+func (r *Route) Doc() *RouteDoc {
+	if r.doc == nil {
+		r.doc = &RouteDoc{Responses: make(map[int]interface{})}
+	}
+	return r.doc
+}
+
+// Summary sets summary of route documentation.
+func (r *Route) Summary(summary string) *Route {
+	r.Doc().Summary = summary
+	return r
+}
+
+// Description sets description of route documentation.
+func (r *Route) Description(description string) *Route {
+	r.Doc().Description = description
+	return r
+}
+
+// Tags appends tags grouping route in documentation.
+func (r *Route) Tags(tags ...string) *Route {
+	r.Doc().Tags = append(r.Doc().Tags, tags...)
+	return r
+}
+
+// Request sets value of request type bound by handler, e.g. CreateUser{}.
+func (r *Route) Request(v interface{}) *Route {
+	r.Doc().Request = v
+	return r
+}
+
+// Response sets value of response type of status code, e.g.
+// Response(200, []User{}).
+func (r *Route) Response(code int, v interface{}) *Route {
+	r.Doc().Responses[code] = v
+	return r
+}
+
+// Name names route, names must be unique in server.
+func (r *Route) Name(name string) *Route {
+	if other, ok := r.router.names[name]; ok && other != r {
+		panic(fmt.Sprintf("route name %s of %s %s is used by %s %s", name, r.method, r.path, other.method, other.path))
+	}
+	delete(r.router.names, r.name)
+	r.name = name
+	r.router.names[name] = r
+	return r
+}
+
+// setHandler records function name of handler, which is a HandlerFunc or
+// handler wrapped by server, e.g. gRPC methods of GRPCProxy.
+func (r *Route) setHandler(handler interface{}) *Route {
+	v := reflect.ValueOf(handler)
+	if v.Kind() == reflect.Func {
+		if f := runtime.FuncForPC(v.Pointer()); f != nil {
+			r.handler = f.Name()
+			return r
+		}
+	}
+	r.handler = strings.TrimPrefix(fmt.Sprintf("%T", handler), "*")
+	return r
 }
 
 // Router router.
 type Router struct {
-	tree   *node
-	routes []*route
+	tree      *node
+	routes    []*Route
+	names     map[string]*Route
+	maxParams int
 }
 
 func newRouter() *Router {
 	r := &Router{
 		tree:   &node{h: make(handler)},
-		routes: make([]*route, 0),
+		routes: make([]*Route, 0),
+		names:  make(map[string]*Route),
 	}
 
 	return r
@@ -59,51 +197,140 @@ func offtake(path string) (raw string, vals []string) {
 	return
 }
 
-func (r *Router) add(method, path string, h fn) {
+func (r *Router) add(method, path string, h HandlerFunc) *Route {
 	if path == "" || path[0] != '/' {
 		panic("path connot be empty and must begin with '/'")
 	}
 
 	var (
-		ppath  = path
-		pnames = []string{}
+		ppath   = path
+		pnames  = []string{}
+		regexps []*regexp.Regexp
 	)
 
-	r.routes = append(r.routes, &route{
+	route := &Route{
+		router: r,
 		method: method,
 		path:   path,
-	})
+	}
+	r.routes = append(r.routes, route)
+	defer func() {
+		if len(pnames) > r.maxParams {
+			r.maxParams = len(pnames)
+		}
+	}()
+	newEndpoint := func() *endpoint {
+		return &endpoint{h: h, ppath: ppath, pnames: pnames, regexps: regexps}
+	}
 
 	for i, l := 0, len(path); i < l; i++ {
 		if path[i] == ':' {
 			j := i + 1
 
-			r.insert(method, path[:i], nil, skind, "", nil)
+			r.insert(method, path[:i], nil, skind)
 			for ; i < l && path[i] != '/'; i++ {
+				// skip constraint, e.g. :id<[0-9]+>
+				if path[i] == '<' {
+					for ; i < l && path[i] != '>'; i++ {
+					}
+				}
 			}
 
-			pnames = append(pnames, path[j:i])
+			pname, re := parseParam(path[j:i], ppath)
+			if re != nil {
+				if regexps == nil {
+					regexps = make([]*regexp.Regexp, len(pnames), len(pnames)+1)
+				}
+			}
+			if regexps != nil {
+				regexps = append(regexps, re)
+			}
+			pnames = append(pnames, pname)
 			path = path[:j] + path[i:]
 			i, l = j, len(path)
 
 			if i == l {
-				r.insert(method, path[:i], h, pkind, ppath, pnames)
+				r.insert(method, path[:i], newEndpoint(), pkind)
 			} else {
-				r.insert(method, path[:i], nil, pkind, ppath, pnames)
+				r.insert(method, path[:i], nil, pkind)
 			}
 
 		} else if path[i] == '*' {
-			r.insert(method, path[:i], nil, skind, "", nil)
+			r.insert(method, path[:i], nil, skind)
 			pnames = append(pnames, "_*")
-			r.insert(method, path[:i+1], h, akind, ppath, pnames)
-			return
+			if regexps != nil {
+				regexps = append(regexps, nil)
+			}
+			r.insert(method, path[:i+1], newEndpoint(), akind)
+			return route
 		}
 	}
 
-	r.insert(method, path, h, skind, ppath, pnames)
+	r.insert(method, path, newEndpoint(), skind)
+	return route
 }
 
-func (r *Router) insert(method, path string, h fn, t uint8, ppath string, pnames []string) {
+// reverse builds path of named route, params replace path params in order.
+// It fails if no route is named name or params don't match path params.
+func (r *Router) reverse(name string, params ...interface{}) (string, error) {
+	route, ok := r.names[name]
+	if !ok {
+		return "", fmt.Errorf("no route named %s", name)
+	}
+
+	var (
+		path = route.path
+		buf  = make([]byte, 0, len(path))
+		n    int
+	)
+	for i, l := 0, len(path); i < l; i++ {
+		switch path[i] {
+		case ':', '*':
+			if n == len(params) {
+				return "", fmt.Errorf("too few params of route %s %s", name, path)
+			}
+			if path[i] == '*' {
+				buf = append(buf, fmt.Sprint(params[n])...)
+				n++
+				continue
+			}
+			for ; i < l && path[i] != '/'; i++ {
+				if path[i] == '<' {
+					for ; i < l && path[i] != '>'; i++ {
+					}
+				}
+			}
+			i--
+			buf = append(buf, url.PathEscape(fmt.Sprint(params[n]))...)
+			n++
+		default:
+			buf = append(buf, path[i])
+		}
+	}
+	if n < len(params) {
+		return "", fmt.Errorf("too many params of route %s %s", name, path)
+	}
+	return string(buf), nil
+}
+
+// parseParam splits param of form name<regexp> into name and its anchored
+// regexp.
+func parseParam(param, ppath string) (string, *regexp.Regexp) {
+	i := strings.IndexByte(param, '<')
+	if i < 0 {
+		return param, nil
+	}
+	if param[len(param)-1] != '>' {
+		panic(fmt.Sprintf("invalid param constraint %q of path %s", param, ppath))
+	}
+	re, err := regexp.Compile("^(?:" + param[i+1:len(param)-1] + ")$")
+	if err != nil {
+		panic(fmt.Sprintf("invalid param constraint %q of path %s: %v", param, ppath, err))
+	}
+	return param[:i], re
+}
+
+func (r *Router) insert(method, path string, e *endpoint, t uint8) {
 	cn := r.tree // Current node as root
 	if cn == nil {
 		panic("server ⇛ invalid method")
@@ -128,15 +355,13 @@ func (r *Router) insert(method, path string, h fn, t uint8, ppath string, pnames
 			// At root node
 			cn.label = search[0]
 			cn.prefix = search
-			if h != nil {
+			if e != nil {
 				cn.kind = t
-				cn.h[method] = h
-				cn.ppath = ppath
-				cn.pnames = pnames
+				cn.h[method] = e
 			}
 		} else if l < pl {
 			// Split node
-			n := newNode(cn.kind, cn.prefix[l:], cn, cn.children, cn.h, cn.ppath, cn.pnames)
+			n := newNode(cn.kind, cn.prefix[l:], cn, cn.children, cn.h)
 
 			// Reset parent node
 			cn.kind = skind
@@ -144,22 +369,22 @@ func (r *Router) insert(method, path string, h fn, t uint8, ppath string, pnames
 			cn.prefix = cn.prefix[:l]
 			cn.children = nil
 			cn.h = make(handler)
-			cn.ppath = ""
-			cn.pnames = nil
 
 			cn.children = append(cn.children, n)
 
 			if l == sl {
 				// At parent node
 				cn.kind = t
-				cn.h[method] = h
-				cn.ppath = ppath
-				cn.pnames = pnames
+				if e != nil {
+					cn.h[method] = e
+				}
 
 			} else {
 				// Create child node
-				n = newNode(t, search[l:], cn, nil, make(handler), ppath, pnames)
-				n.h[method] = h
+				n = newNode(t, search[l:], cn, nil, make(handler))
+				if e != nil {
+					n.h[method] = e
+				}
 				cn.children = append(cn.children, n)
 
 			}
@@ -174,25 +399,28 @@ func (r *Router) insert(method, path string, h fn, t uint8, ppath string, pnames
 
 			}
 			// Create child node
-			n := newNode(t, search, cn, nil, make(handler), ppath, pnames)
-			n.h[method] = h
+			n := newNode(t, search, cn, nil, make(handler))
+			if e != nil {
+				n.h[method] = e
+			}
 			cn.children = append(cn.children, n)
 		} else {
 			// Node already exists
 			// PANIC(PATH)
-			if h != nil {
-				cn.h[method] = h
-				cn.ppath = ppath
-				cn.pnames = pnames
+			if e != nil {
+				cn.h[method] = e
 			}
 		}
 		return
 	}
 }
 
-func (r *Router) find(method, path string) (cn *node, pvalues []string) {
-	cn = r.tree // Current node as root
-	pvalues = make([]string, 5)
+// find looks up handlers of path preferring ones serving method, param
+// values are written to pvalues in order of endpoint pnames. pvalues must
+// have room for maxParams values. The tree is read only, so find is safe for
+// concurrent use. Empty handler means no route matches path.
+func (r *Router) find(method, path string, pvalues []string) handler {
+	cn := r.tree // Current node as root
 
 	var (
 		search = path
@@ -201,6 +429,7 @@ func (r *Router) find(method, path string) (cn *node, pvalues []string) {
 		nk     uint8  // Next kind
 		nn     *node  // Next node
 		ns     string // Next search
+		np     int    // Next param counter
 	)
 
 	// Search order static > param > any
@@ -229,14 +458,19 @@ func (r *Router) find(method, path string) (cn *node, pvalues []string) {
 			// Continue search
 			search = search[l:]
 		} else {
+			if nn == nil {
+				return nil
+			}
 			cn = nn
+			nn = nil
 			search = ns
+			n = np
 			if nk == pkind {
 				goto Param
 			} else if nk == akind {
 				goto Any
 			}
-			return nil, nil
+			return nil
 		}
 
 		if search == "" {
@@ -250,6 +484,7 @@ func (r *Router) find(method, path string) (cn *node, pvalues []string) {
 				nk = pkind
 				nn = cn
 				ns = search
+				np = n
 			}
 			cn = c
 			continue
@@ -263,6 +498,7 @@ func (r *Router) find(method, path string) (cn *node, pvalues []string) {
 				nk = akind
 				nn = cn
 				ns = search
+				np = n
 			}
 
 			cn = c
@@ -277,11 +513,12 @@ func (r *Router) find(method, path string) (cn *node, pvalues []string) {
 		}
 		// Any node
 	Any:
-		if cn = cn.findByKind(akind); cn == nil {
+		if c = cn.findByKind(akind); c == nil {
 			if nn != nil {
 				cn = nn
 				nn = nil // Next
 				search = ns
+				n = np
 				if nk == pkind {
 					goto Param
 				} else if nk == akind {
@@ -289,40 +526,53 @@ func (r *Router) find(method, path string) (cn *node, pvalues []string) {
 				}
 			}
 			// Not found
-			return nil, nil
+			return nil
 		}
-		pvalues[len(cn.pnames)-1] = search
+		cn = c
+		pvalues[n] = search
 		goto End
 	}
 
 End:
-	if f, ok := cn.h[method]; ok {
-		cn.fn = f
-	} else {
-		if cn = cn.findByKind(akind); cn == nil {
-			return
+	if cn.h.lookup(method) == nil {
+		if c = cn.findByKind(akind); c != nil && c.h.lookup(method) != nil {
+			pvalues[n] = ""
+			return c.h
 		}
+	}
+	return cn.h
+}
 
-		if h, ok := cn.h[method]; h != nil && ok {
-			cn.fn = h
-		} else {
-			cn.fn = nil
+// fixPath returns cleaned path, or path with trailing slash toggled, that
+// matches a route. Paths with backslash are not fixed since browsers take
+// /\host as //host of redirects.
+func (r *Router) fixPath(p string, pvalues []string) (string, bool) {
+	if p == "" || strings.Contains(p, "\\") {
+		return "", false
+	}
+	cleaned := path.Clean(p)
+	if cleaned != "/" && p[len(p)-1] == '/' {
+		cleaned += "/"
+	}
+	candidates := [2]string{cleaned, cleaned + "/"}
+	if cleaned[len(cleaned)-1] == '/' {
+		candidates[1] = cleaned[:len(cleaned)-1]
+	}
+	for _, candidate := range candidates {
+		if candidate != p && candidate != "" && len(r.find("", candidate, pvalues)) > 0 {
+			return candidate, true
 		}
-		// pvalues[len(cn.pnames)-1] = ""
 	}
-
-	return
+	return "", false
 }
 
-func newNode(t uint8, pre string, p *node, c []*node, h handler, ppath string, pnames []string) *node {
+func newNode(t uint8, pre string, p *node, c []*node, h handler) *node {
 	return &node{
 		kind:     t,
 		label:    pre[0],
 		prefix:   pre,
 		parent:   p,
 		children: c,
-		ppath:    ppath,
-		pnames:   pnames,
 		h:        h,
 	}
 }
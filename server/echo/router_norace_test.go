@@ -0,0 +1,21 @@
+// +build !race
+
+package echo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// sync.Pool drops items randomly under the race detector, which allocates
+// contexts.
+func TestServeHTTPStaticAllocs(t *testing.T) {
+	s := benchServer()
+	w := &discardWriter{header: make(http.Header)}
+	req := httptest.NewRequest(GET, "/users/new", nil)
+	s.ServeHTTP(w, req)
+	if n := testing.AllocsPerRun(100, func() { s.ServeHTTP(w, req) }); n != 0 {
+		t.Errorf("static route allocs = %v, want 0", n)
+	}
+}
@@ -0,0 +1,332 @@
+package echo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestRouterFind(t *testing.T) {
+	r := newRouter()
+	for _, path := range []string{
+		"/users",
+		"/users/:id",
+		"/users/:id/files/*",
+		"/users/new",
+		"/static/*",
+		"/a/:b/:c/:d/:e/:f/:g/:h",
+	} {
+		path := path
+		r.add(GET, path, func(c *Context) error { return c.String(200, path) })
+	}
+
+	cases := []struct {
+		path   string
+		ppath  string
+		params map[string]string
+	}{
+		{"/users", "/users", nil},
+		{"/users/new", "/users/new", nil},
+		{"/users/42", "/users/:id", map[string]string{"id": "42"}},
+		{"/users/42/files/a/b.txt", "/users/:id/files/*", map[string]string{"id": "42", "_*": "a/b.txt"}},
+		{"/static/js/app.js", "/static/*", map[string]string{"_*": "js/app.js"}},
+		{"/a/1/2/3/4/5/6/7", "/a/:b/:c/:d/:e/:f/:g/:h", map[string]string{"b": "1", "h": "7"}},
+	}
+	for _, tc := range cases {
+		pvalues := make([]string, r.maxParams)
+		e := r.find(GET, tc.path, pvalues).lookup(GET)
+		if e == nil {
+			t.Errorf("find(%q) = nil", tc.path)
+			continue
+		}
+		if e.ppath != tc.ppath {
+			t.Errorf("find(%q).ppath = %q, want %q", tc.path, e.ppath, tc.ppath)
+		}
+		for name, want := range tc.params {
+			c := &Context{pnames: e.pnames, pvalues: pvalues}
+			if got := c.Param(name); got != want {
+				t.Errorf("find(%q) param %s = %q, want %q", tc.path, name, got, want)
+			}
+		}
+	}
+
+	if h := r.find(POST, "/users", make([]string, r.maxParams)); h.lookup(POST) != nil || len(h) == 0 {
+		t.Errorf("find(POST /users) = %v, want handlers of other methods", h)
+	}
+	if h := r.find(GET, "/nothing", make([]string, r.maxParams)); len(h) != 0 {
+		t.Errorf("find(GET /nothing) = %v, want none", h)
+	}
+}
+
+func TestServerConcurrentMethods(t *testing.T) {
+	s := NewServer(nil)
+	methods := []string{GET, POST, PUT, DELETE}
+	for _, method := range methods {
+		method := method
+		s.add(method, "/items/:id", func(c *Context) error {
+			return c.String(200, method+" "+c.Param("id"))
+		})
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		for _, method := range methods {
+			wg.Add(1)
+			go func(method string) {
+				defer wg.Done()
+				for j := 0; j < 100; j++ {
+					rec := httptest.NewRecorder()
+					s.ServeHTTP(rec, httptest.NewRequest(method, "/items/7", nil))
+					if body := rec.Body.String(); body != method+" 7" {
+						t.Errorf("%s /items/7 = %q", method, body)
+						return
+					}
+				}
+			}(method)
+		}
+	}
+	wg.Wait()
+}
+
+func TestServerManyParams(t *testing.T) {
+	s := NewServer(nil)
+	// pooled contexts grow with routes added after first request
+	s.GET("/", func(c *Context) error { return nil })
+	s.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(GET, "/", nil))
+
+	s.GET("/:a/:b/:c/:d/:e/:f", func(c *Context) error {
+		return c.String(200, strings.Join([]string{c.Param("a"), c.Param("f")}, ","))
+	})
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(GET, "/1/2/3/4/5/6", nil))
+	if body := rec.Body.String(); body != "1,6" {
+		t.Fatalf("body = %q, want 1,6", body)
+	}
+}
+
+func TestServerMethodNotAllowed(t *testing.T) {
+	s := NewServer(nil)
+	s.GET("/users", func(c *Context) error { return c.String(200, "list") })
+	s.POST("/users", func(c *Context) error { return c.NoContent(201) })
+
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(DELETE, "/users", nil))
+	if rec.Code != 405 || rec.Header().Get(HeaderAllow) != "GET, HEAD, OPTIONS, POST" {
+		t.Errorf("DELETE /users = %d, Allow %q", rec.Code, rec.Header().Get(HeaderAllow))
+	}
+
+	rec = httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(OPTIONS, "/users", nil))
+	if rec.Code != 204 || rec.Header().Get(HeaderAllow) != "GET, HEAD, OPTIONS, POST" {
+		t.Errorf("OPTIONS /users = %d, Allow %q", rec.Code, rec.Header().Get(HeaderAllow))
+	}
+
+	rec = httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(HEAD, "/users", nil))
+	if rec.Code != 200 {
+		t.Errorf("HEAD /users = %d, want 200", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(GET, "/groups", nil))
+	if rec.Code != 404 {
+		t.Errorf("GET /groups = %d, want 404", rec.Code)
+	}
+}
+
+func TestServerTrailingSlash(t *testing.T) {
+	s := NewServer(nil)
+	s.GET("/users", func(c *Context) error { return c.String(200, "users") })
+	s.GET("/groups/", func(c *Context) error { return c.String(200, "groups") })
+
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(GET, "/users/", nil))
+	if rec.Code != 404 {
+		t.Errorf("strict GET /users/ = %d, want 404", rec.Code)
+	}
+
+	s.SetTrailingSlash(TrailingSlashRedirect)
+	for path, location := range map[string]string{
+		"/users/?page=2": "/users?page=2",
+		"/groups":        "/groups/",
+		"//users":        "/users",
+	} {
+		rec = httptest.NewRecorder()
+		s.ServeHTTP(rec, httptest.NewRequest(GET, path, nil))
+		if rec.Code != 301 || rec.Header().Get(HeaderLocation) != location {
+			t.Errorf("redirect GET %s = %d, Location %q", path, rec.Code, rec.Header().Get(HeaderLocation))
+		}
+	}
+
+	// backslash is taken as slash by browsers
+	for _, path := range []string{"/%5Cevil.com/", "/%5C%5Cevil.com//"} {
+		rec = httptest.NewRecorder()
+		s.ServeHTTP(rec, httptest.NewRequest(GET, path, nil))
+		if rec.Code != 404 || rec.Header().Get(HeaderLocation) != "" {
+			t.Errorf("redirect GET %s = %d, Location %q", path, rec.Code, rec.Header().Get(HeaderLocation))
+		}
+	}
+	s.GET("/a b", func(c *Context) error { return c.String(200, "space") })
+	rec = httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(GET, "/a%20b/", nil))
+	if rec.Code != 301 || rec.Header().Get(HeaderLocation) != "/a%20b" {
+		t.Errorf("redirect GET /a%%20b/ = %d, Location %q", rec.Code, rec.Header().Get(HeaderLocation))
+	}
+
+	s.SetTrailingSlash(TrailingSlashMatch)
+	rec = httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(GET, "/users/", nil))
+	if rec.Code != 200 || rec.Body.String() != "users" {
+		t.Errorf("match GET /users/ = %d %q", rec.Code, rec.Body.String())
+	}
+}
+
+func TestServerParamRegexp(t *testing.T) {
+	s := NewServer(nil)
+	s.GET("/user/:id<[0-9]+>/posts/:slug<[a-z-]+>", func(c *Context) error {
+		return c.String(200, c.Param("id")+" "+c.Param("slug"))
+	})
+
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(GET, "/user/42/posts/hello-world", nil))
+	if rec.Code != 200 || rec.Body.String() != "42 hello-world" {
+		t.Errorf("GET = %d %q", rec.Code, rec.Body.String())
+	}
+	if ppath := s.GetRouteInfos()[0].Path; ppath != "/user/:id<[0-9]+>/posts/:slug<[a-z-]+>" {
+		t.Errorf("route path = %q", ppath)
+	}
+
+	rec = httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(GET, "/user/bob/posts/hello", nil))
+	if rec.Code != 404 {
+		t.Errorf("GET /user/bob = %d, want 404", rec.Code)
+	}
+}
+
+type labeledMiddleware struct{}
+
+func (labeledMiddleware) Func() MiddlewareFunc {
+	return func(next HandlerFunc) HandlerFunc { return next }
+}
+
+func (labeledMiddleware) Label() string { return "labeled" }
+
+func listUsers(c *Context) error { return nil }
+
+func TestServerReverse(t *testing.T) {
+	s := NewServer(nil)
+	s.GET("/users", listUsers, labeledMiddleware{}).Name("users")
+	s.GET("/users/:id<[0-9]+>/files/*", listUsers).Name("file")
+	g := s.Group("/v1")
+	g.GET("/orgs/:org", listUsers).Name("org")
+
+	for _, tc := range []struct {
+		name   string
+		params []interface{}
+		want   string
+	}{
+		{"users", nil, "/users"},
+		{"file", []interface{}{42, "a/b.txt"}, "/users/42/files/a/b.txt"},
+		{"org", []interface{}{"a b"}, "/v1/orgs/a%20b"},
+	} {
+		if got, err := s.Reverse(tc.name, tc.params...); got != tc.want || err != nil {
+			t.Errorf("Reverse(%s, %v) = %q, %v, want %q", tc.name, tc.params, got, err, tc.want)
+		}
+	}
+	for _, tc := range []struct {
+		name   string
+		params []interface{}
+	}{
+		{"none", nil},
+		{"file", []interface{}{42}},
+		{"org", nil},
+		{"users", []interface{}{1}},
+	} {
+		if got, err := s.Reverse(tc.name, tc.params...); err == nil {
+			t.Errorf("Reverse(%s, %v) = %q, want error", tc.name, tc.params, got)
+		}
+	}
+
+	info := s.GetRouteInfos()[0]
+	if info.Name != "users" || !strings.HasSuffix(info.Handler, ".listUsers") ||
+		len(info.Middlewares) != 1 || info.Middlewares[0] != "labeled" {
+		t.Errorf("route info = %+v", info)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("duplicate route name should panic")
+		}
+	}()
+	s.POST("/users", listUsers).Name("users")
+}
+
+// discardWriter is a reusable http.ResponseWriter for benchmarks.
+type discardWriter struct {
+	header http.Header
+}
+
+func (w *discardWriter) Header() http.Header         { return w.header }
+func (w *discardWriter) Write(b []byte) (int, error) { return len(b), nil }
+func (w *discardWriter) WriteHeader(int)             {}
+
+var benchRoutes = []string{
+	"/",
+	"/users",
+	"/users/new",
+	"/users/:id",
+	"/users/:id/files/*",
+	"/orgs/:org/repos/:repo/issues/:number",
+	"/static/*",
+}
+
+func benchServer() *Server {
+	s := NewServer(nil)
+	for _, path := range benchRoutes {
+		s.GET(path, func(c *Context) error { return nil })
+	}
+	return s
+}
+
+func benchmarkRouterFind(b *testing.B, path string) {
+	s := benchServer()
+	pvalues := make([]string, s.router.maxParams)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.router.find(GET, path, pvalues).lookup(GET)
+	}
+}
+
+func BenchmarkRouterFindStatic(b *testing.B) {
+	benchmarkRouterFind(b, "/users/new")
+}
+
+func BenchmarkRouterFindParam(b *testing.B) {
+	benchmarkRouterFind(b, "/orgs/ares/repos/echo/issues/41")
+}
+
+func BenchmarkRouterFindAny(b *testing.B) {
+	benchmarkRouterFind(b, "/static/js/app.js")
+}
+
+func benchmarkServeHTTP(b *testing.B, path string) {
+	s := benchServer()
+	w := &discardWriter{header: make(http.Header)}
+	req := httptest.NewRequest(GET, path, nil)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.ServeHTTP(w, req)
+	}
+}
+
+func BenchmarkServeHTTPStatic(b *testing.B) {
+	benchmarkServeHTTP(b, "/users/new")
+}
+
+func BenchmarkServeHTTPParam(b *testing.B) {
+	benchmarkServeHTTP(b, "/orgs/ares/repos/echo/issues/41")
+}
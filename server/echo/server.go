@@ -1,10 +1,13 @@
 package echo
 
 import (
+	"context"
+	"fmt"
 	"log"
 	"net"
 	"net/http"
-	"path"
+	"net/url"
+	"strings"
 	"sync"
 
 	"github.com/sevenNt/ares/server"
@@ -42,16 +45,23 @@ type Server struct {
 	mws             []Middleware
 	notFoundHandler HandlerFunc
 	errHandler      HandlerFunc
-	pool            *sync.Pool
-	Func            func(*Server)
-	addr            string
-	name            string
-	alias           []string
+	// methodNotAllowedHandler handles requests of paths matching routes of
+	// other methods.
+	methodNotAllowedHandler HandlerFunc
+	trailingSlash           TrailingSlash
+	pool                    *sync.Pool
+	Func                    func(*Server)
+	addr                    string
+	name                    string
+	alias                   []string
 	//events             trace.EventLog
 	hookersBeforeServe []func(*Server)
 	grpcProxyWrapper   func(interface{}) HandlerFunc
 	wsWrapper          func(h websocket.Handler) HandlerFunc
 
+	config     server.HTTPConfig
+	httpServer *http.Server
+
 	running chan struct{}
 }
 
@@ -59,11 +69,22 @@ type Server struct {
 type RouteInfo struct {
 	Method string
 	Path   string
+	Name   string
+	// Handler is function name of handler.
+	Handler string
+	// Middlewares are labels of route middlewares in order.
+	Middlewares []string
+	// Doc is documentation of route, nil if not documented.
+	Doc *RouteDoc
 }
 
 // NewServer constructs an instance of echo server.
 func NewServer(lis net.Listener, opts ...server.Option) *Server {
+	options := newOptions(opts...)
 	s := &Server{
+		name:   options.Name(),
+		alias:  options.Alias(),
+		config: options.HTTP(),
 		router: newRouter(),
 		pool: &sync.Pool{
 			New: func() interface{} {
@@ -71,18 +92,39 @@ func NewServer(lis net.Listener, opts ...server.Option) *Server {
 			},
 		},
 		//opts:             opts,
-		mws:                make([]Middleware, 0),
-		notFoundHandler:    notFoundHandler,
-		errHandler:         errHandler,
-		grpcProxyWrapper:   defaultGRPCProxyWrapper(),
-		wsWrapper:          defaultWSWrapper(),
-		listener:           wrapListener(lis),
-		hookersBeforeServe: make([]func(*Server), 0),
-		running:            make(chan struct{}, 1),
+		mws:                     make([]Middleware, 0),
+		notFoundHandler:         notFoundHandler,
+		methodNotAllowedHandler: methodNotAllowedHandler,
+		errHandler:              errHandler,
+		grpcProxyWrapper:        defaultGRPCProxyWrapper(),
+		wsWrapper:               defaultWSWrapper(),
+		listener:                wrapListener(lis),
+		hookersBeforeServe:      make([]func(*Server), 0),
+		running:                 make(chan struct{}, 1),
 	}
+	s.listener.keepAlive = s.config.KeepAlive
+	s.httpServer = newHTTPServer(s, s.config)
 	return s
 }
 
+// newOptions applies opts to options loaded from config app.server.http.<name>
+// if server is named, so that opts override config.
+func newOptions(opts ...server.Option) server.Options {
+	var options server.Options
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if options.Name() == "" {
+		return options
+	}
+	named := options
+	server.HTTPFromConfig(options.Name())(&named)
+	for _, opt := range opts {
+		opt(&named)
+	}
+	return named
+}
+
 // IsRunning checks which server is running.
 func (s *Server) IsRunning() bool {
 	return len(s.running) > 0
@@ -95,6 +137,9 @@ func (s *Server) GetListener() *Listener {
 
 // Scheme returns server's scheme.
 func (s *Server) Scheme() string {
+	if s.config.CertFile != "" {
+		return "https"
+	}
 	return "http"
 }
 
@@ -108,6 +153,18 @@ func (s *Server) SetNotFoundHandler(handler HandlerFunc) {
 	s.notFoundHandler = handler
 }
 
+// SetMethodNotAllowedHandler sets server's methodNotAllowedHandler, Allow
+// header is set before it's called.
+func (s *Server) SetMethodNotAllowedHandler(handler HandlerFunc) {
+	s.methodNotAllowedHandler = handler
+}
+
+// SetTrailingSlash sets server's policy of requests whose path matches no
+// route, but does after being cleaned or having trailing slash toggled.
+func (s *Server) SetTrailingSlash(policy TrailingSlash) {
+	s.trailingSlash = policy
+}
+
 // HookBeforeServe injects hooks executed before server run.
 func (s *Server) HookBeforeServe(fn func(*Server)) {
 	s.hookersBeforeServe = append(s.hookersBeforeServe, fn)
@@ -126,14 +183,30 @@ func (s *Server) GetRouteInfos() []RouteInfo {
 
 	for _, r := range s.router.routes {
 		ret = append(ret, RouteInfo{
-			Method: r.method,
-			Path:   r.path,
+			Method:      r.method,
+			Path:        r.path,
+			Name:        r.name,
+			Handler:     r.handler,
+			Middlewares: r.middlewares,
+			Doc:         r.doc,
 		})
 	}
 
 	return ret
 }
 
+// Reverse generates path of route named name, params replace path params
+// of route pattern in order, e.g.
+//
+//	s.GET("/users/:id", h).Name("user")
+//	s.Reverse("user", 42) // "/users/42", nil
+//
+// An error is returned if no route is named name, or the number of params
+// differs from path params of route.
+func (s *Server) Reverse(name string, params ...interface{}) (string, error) {
+	return s.router.reverse(name, params...)
+}
+
 // Addr return server address.
 func (s *Server) Addr() string {
 	return s.listener.Addr().String()
@@ -167,16 +240,23 @@ func (s *Server) Serve() error {
 	defer func() {
 		<-s.running
 	}()
-	return http.Serve(s.listener, s)
+	lis, err := s.listen()
+	if err != nil {
+		return err
+	}
+	return s.httpServer.Serve(lis)
 }
 
 // Stop stops server.
 func (s *Server) Stop() {
 	s.listener.Close()
+	s.httpServer.Close()
 }
 
-// GracefulStop stops server graceful.
+// GracefulStop stops server graceful, it waits for in-flight requests and
+// hijacked connections, e.g. websockets.
 func (s *Server) GracefulStop() {
+	s.httpServer.Shutdown(context.Background())
 	s.listener.Close()
 	s.listener.wg.Wait()
 }
@@ -187,23 +267,48 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	defer s.pool.Put(c)
 	c.reset(r, w, s)
 
-	h := func(c *Context) error {
-		var handler = s.notFoundHandler
-		if node, pvalues := s.router.find(r.Method, r.URL.Path); node != nil {
-			for i, pname := range node.pnames {
-				c.params[pname] = pvalues[i]
+	h := s.router.find(r.Method, r.URL.Path, c.pvalues)
+	if len(h) == 0 && s.trailingSlash != TrailingSlashStrict {
+		if p, ok := s.router.fixPath(r.URL.Path, c.pvalues); ok {
+			if s.trailingSlash == TrailingSlashRedirect {
+				s.redirect(c, p)
+				c.Response().Flush()
+				return
 			}
-			if f, ok := node.fn.(HandlerFunc); ok {
-				handler = f
-				c.handler = handler
-			}
-			c.SetPatternPath(node.ppath)
+			h = s.router.find(r.Method, p, c.pvalues)
 		}
+	}
 
-		return handler(c)
+	handler := s.notFoundHandler
+	if e := h.lookup(r.Method); e != nil {
+		if e.match(c.pvalues) {
+			handler = e.h
+			c.handler = e.h
+			c.pnames = e.pnames
+			c.SetPatternPath(e.ppath)
+		}
+	} else if len(h) > 0 {
+		c.Response().Header().Set(HeaderAllow, h.allow())
+		if r.Method == OPTIONS {
+			handler = optionsHandler
+		} else {
+			handler = s.methodNotAllowedHandler
+		}
 	}
 
-	if err := h(c); err != nil {
+	if limit := s.config.BodyLimit; limit > 0 && r.Body != nil && r.Body != http.NoBody {
+		c.body.reset(r.Body, limit)
+		r.Body = &c.body
+		if r.ContentLength > limit {
+			handler = requestEntityTooLargeHandler
+		}
+	}
+
+	err := handler(c)
+	if s.config.BodyLimit > 0 && c.body.exceeded && !c.response.commited {
+		// whatever handlers return, e.g. problems of binding errors
+		requestEntityTooLargeHandler(c)
+	} else if err != nil {
 		log.Printf("ServeHTTP error %s", err)
 		s.errHandler(c)
 	}
@@ -212,6 +317,21 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	return
 }
 
+// redirect redirects request to path p keeping query, methods other than
+// GET and HEAD are redirected with 307 so that body is sent again.
+func (s *Server) redirect(c *Context, p string) {
+	code := StatusMovedPermanently
+	if m := c.Request().Method; m != GET && m != HEAD {
+		code = StatusTemporaryRedirect
+	}
+	// escaped path keeps location within the host
+	location := (&url.URL{Path: p}).EscapedPath()
+	if q := c.Request().URL.RawQuery; q != "" {
+		location += "?" + q
+	}
+	c.Redirect(code, location)
+}
+
 // Exit exits.
 func (s *Server) Exit() error {
 	return nil
@@ -243,93 +363,89 @@ func (s *Server) Use(ms ...Middleware) *Server {
 
 // GET registers a new GET route for a path with matching handler in the router
 // with optional route-level middleware.
-func (s *Server) GET(path string, handler HandlerFunc, ms ...Middleware) *Server {
-	s.add(GET, path, handler, append(s.mws, ms...)...)
-	return s
+func (s *Server) GET(path string, handler HandlerFunc, ms ...Middleware) *Route {
+	return s.add(GET, path, handler, append(s.mws, ms...)...)
 }
 
 // POST registers a new POST route for a path with matching handler in the
 // router with optional route-level middleware.
-func (s *Server) POST(path string, handler HandlerFunc, ms ...Middleware) *Server {
-	s.add(POST, path, handler, append(s.mws, ms...)...)
-	return s
+func (s *Server) POST(path string, handler HandlerFunc, ms ...Middleware) *Route {
+	return s.add(POST, path, handler, append(s.mws, ms...)...)
 }
 
 // PUT registers a new PUT route for a path with matching handler in the
 // router with optional route-level middleware.
-func (s *Server) PUT(path string, handler HandlerFunc, ms ...Middleware) *Server {
-	s.add(PUT, path, handler, append(s.mws, ms...)...)
-	return s
+func (s *Server) PUT(path string, handler HandlerFunc, ms ...Middleware) *Route {
+	return s.add(PUT, path, handler, append(s.mws, ms...)...)
 }
 
 // HEAD registers a new HEAD route for a path with matching handler in the
 // router with optional route-level middleware.
-func (s *Server) HEAD(path string, handler HandlerFunc, ms ...Middleware) *Server {
-	s.add(HEAD, path, handler, append(s.mws, ms...)...)
-	return s
+func (s *Server) HEAD(path string, handler HandlerFunc, ms ...Middleware) *Route {
+	return s.add(HEAD, path, handler, append(s.mws, ms...)...)
 }
 
 // OPTIONS registers a new OPTIONS route for a path with matching handler in the
 // router with optional route-level middleware.
-func (s *Server) OPTIONS(path string, handler HandlerFunc, ms ...Middleware) *Server {
-	s.add(OPTIONS, path, handler, append(s.mws, ms...)...)
-	return s
+func (s *Server) OPTIONS(path string, handler HandlerFunc, ms ...Middleware) *Route {
+	return s.add(OPTIONS, path, handler, append(s.mws, ms...)...)
 }
 
 // PATCH registers a new PATCH route for a path with matching handler in the
 // router with optional route-level middleware.
-func (s *Server) PATCH(path string, handler HandlerFunc, ms ...Middleware) *Server {
-	s.add(PATCH, path, handler, append(s.mws, ms...)...)
-	return s
+func (s *Server) PATCH(path string, handler HandlerFunc, ms ...Middleware) *Route {
+	return s.add(PATCH, path, handler, append(s.mws, ms...)...)
 }
 
 // DELETE registers a new DELETE route for a path with matching handler in the router
 // with optional route-level middleware.
-func (s *Server) DELETE(path string, handler HandlerFunc, ms ...Middleware) *Server {
-	s.add(DELETE, path, handler, append(s.mws, ms...)...)
-	return s
-}
-
-// Static registers a new route with path prefix to serve static files from the
-// provided root directory.
-func (s *Server) Static(prefix, root string) *Server {
-	s.add(GET, prefix+"/:path", func(c *Context) error {
-		return c.File(path.Join(root, c.Param("path")))
-	}, s.mws...)
-	return s
+func (s *Server) DELETE(path string, handler HandlerFunc, ms ...Middleware) *Route {
+	return s.add(DELETE, path, handler, append(s.mws, ms...)...)
 }
 
 // WS registers a new websocket route for a path with matching handler in the router
 // with optional route-level middleware.
-func (s *Server) WS(path string, handler websocket.Handler, ms ...Middleware) *Server {
+func (s *Server) WS(path string, handler websocket.Handler, ms ...Middleware) *Route {
 	//s.add2(GET, path, handler, append(s.mws, ms...)...)
-	s.add(GET, path, s.wsWrapper(handler), ms...)
-	return s
+	return s.add(GET, path, s.wsWrapper(handler), ms...).setHandler(handler)
 }
 
 // GRPCProxy will appoint a http proxy for gRPC callers with POST method, ex. s.GRPC("sayhello", new(Greeter).SayHello).
-func (s *Server) GRPCProxy(path string, handler interface{}, ms ...Middleware) *Server {
-	s.add(POST, path, s.grpcProxyWrapper(handler), ms...)
-	return s
+func (s *Server) GRPCProxy(path string, handler interface{}, ms ...Middleware) *Route {
+	return s.add(POST, path, s.grpcProxyWrapper(handler), ms...).setHandler(handler)
 }
 
 // GRPCProxyGet will appoint a http proxy for gRPC callers with GET method, ex. s.GRPC("sayhello", new(Greeter).SayHello).
-func (s *Server) GRPCProxyGet(path string, handler interface{}, ms ...Middleware) *Server {
-	s.add(GET, path, s.grpcProxyWrapper(handler), ms...)
-	return s
+func (s *Server) GRPCProxyGet(path string, handler interface{}, ms ...Middleware) *Route {
+	return s.add(GET, path, s.grpcProxyWrapper(handler), ms...).setHandler(handler)
 }
 
 // GRPCProxyPost do same thing with GRPCProxy.
-func (s *Server) GRPCProxyPost(path string, handler interface{}, ms ...Middleware) *Server {
-	s.add(POST, path, s.grpcProxyWrapper(handler), ms...)
-	return s
+func (s *Server) GRPCProxyPost(path string, handler interface{}, ms ...Middleware) *Route {
+	return s.add(POST, path, s.grpcProxyWrapper(handler), ms...).setHandler(handler)
 }
 
-func (s *Server) add(method, path string, handler HandlerFunc, ms ...Middleware) {
+func (s *Server) add(method, path string, handler HandlerFunc, ms ...Middleware) *Route {
 	h := handler
 
 	for i := len(ms) - 1; i >= 0; i-- {
 		h = ms[i].Func()(h)
 	}
-	s.router.add(method, path, h)
+	r := s.router.add(method, path, h).setHandler(handler)
+	r.middlewares = make([]string, 0, len(ms))
+	for _, m := range ms {
+		r.middlewares = append(r.middlewares, middlewareLabel(m))
+	}
+	return r
+}
+
+// middlewareLabel returns label of plugin middlewares, or type name of
+// others.
+func middlewareLabel(m Middleware) string {
+	if l, ok := m.(interface {
+		Label() string
+	}); ok {
+		return l.Label()
+	}
+	return strings.TrimPrefix(fmt.Sprintf("%T", m), "*")
 }
@@ -0,0 +1,351 @@
+package echo
+
+import (
+	"fmt"
+	"hash/fnv"
+	"html/template"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// StaticOption is used to set options of static file serving.
+type StaticOption func(*StaticOptions)
+
+// StaticOptions wraps static file serving options.
+type StaticOptions struct {
+	index         string
+	browse        bool
+	spa           bool
+	precompressed bool
+	cacheControl  func(name string) string
+}
+
+// StaticIndex sets name of index files of directories, defaults to
+// index.html.
+func StaticIndex(index string) StaticOption {
+	return func(o *StaticOptions) {
+		o.index = index
+	}
+}
+
+// StaticBrowse lists directories without index files if browse is true.
+func StaticBrowse(browse bool) StaticOption {
+	return func(o *StaticOptions) {
+		o.browse = browse
+	}
+}
+
+// StaticSPA serves index file of root for missing paths without extension if
+// spa is true, so that single page applications route them in browsers.
+func StaticSPA(spa bool) StaticOption {
+	return func(o *StaticOptions) {
+		o.spa = spa
+	}
+}
+
+// StaticPrecompressed serves name.br and name.gz instead of name to clients
+// accepting br or gzip encoding if they exist, defaults to true.
+func StaticPrecompressed(precompressed bool) StaticOption {
+	return func(o *StaticOptions) {
+		o.precompressed = precompressed
+	}
+}
+
+// StaticCacheControl sets policy returning Cache-Control header of file name,
+// no header is set if it returns empty string.
+func StaticCacheControl(policy func(name string) string) StaticOption {
+	return func(o *StaticOptions) {
+		o.cacheControl = policy
+	}
+}
+
+// StaticMaxAge caches files for maxAge, while html files are revalidated on
+// each request since they refer to others, e.g. versioned assets of SPA.
+func StaticMaxAge(maxAge time.Duration) StaticOption {
+	cc := fmt.Sprintf("public, max-age=%d", int64(maxAge/time.Second))
+	return StaticCacheControl(func(name string) string {
+		if path.Ext(name) == ".html" {
+			return "no-cache"
+		}
+		return cc
+	})
+}
+
+// Static registers routes with path prefix to serve static files from the
+// provided root directory, e.g. s.Static("/assets", "public", StaticMaxAge(time.Hour)).
+func (s *Server) Static(prefix, root string, opts ...StaticOption) *Server {
+	return s.StaticFS(prefix, http.Dir(root), opts...)
+}
+
+// StaticFS registers routes with path prefix to serve static files from fs,
+// e.g. assets embedded in binary.
+func (s *Server) StaticFS(prefix string, fs http.FileSystem, opts ...StaticOption) *Server {
+	h := newStaticHandler(s, fs, opts...)
+	prefix = strings.TrimRight(prefix, "/")
+	s.GET(prefix+"/*", h)
+	if prefix != "" {
+		// redirected to prefix/
+		s.GET(prefix, h)
+	}
+	return s
+}
+
+// Static implements `Server#Static()` for sub-routes within the Group.
+func (g *Group) Static(prefix, root string, opts ...StaticOption) {
+	g.StaticFS(prefix, http.Dir(root), opts...)
+}
+
+// StaticFS implements `Server#StaticFS()` for sub-routes within the Group.
+func (g *Group) StaticFS(prefix string, fs http.FileSystem, opts ...StaticOption) {
+	h := newStaticHandler(g.server, fs, opts...)
+	prefix = strings.TrimRight(prefix, "/")
+	g.server.add(GET, g.path+prefix+"/*", h, append(g.server.mws, g.ms...)...)
+	if g.path+prefix != "" {
+		g.server.add(GET, g.path+prefix, h, append(g.server.mws, g.ms...)...)
+	}
+}
+
+type staticHandler struct {
+	server *Server
+	fs     http.FileSystem
+	opts   StaticOptions
+	etags  sync.Map // name -> etag of files without modification time
+}
+
+func newStaticHandler(s *Server, fs http.FileSystem, opts ...StaticOption) HandlerFunc {
+	h := &staticHandler{
+		server: s,
+		fs:     fs,
+		opts: StaticOptions{
+			index:         "index.html",
+			precompressed: true,
+			cacheControl:  func(string) string { return "" },
+		},
+	}
+	for _, opt := range opts {
+		opt(&h.opts)
+	}
+	return h.serve
+}
+
+func (h *staticHandler) serve(c *Context) error {
+	name, ok := sanitizePath(c.Param("_*"))
+	if !ok {
+		return h.server.notFoundHandler(c)
+	}
+	err := h.serveFile(c, name)
+	if err == ErrNotFound && h.opts.spa && path.Ext(name) == "" {
+		err = h.serveFile(c, "/"+h.opts.index)
+	}
+	if err == ErrNotFound {
+		return h.server.notFoundHandler(c)
+	}
+	return err
+}
+
+// sanitizePath cleans path param of static routes to a rooted path, paths of
+// hidden files, e.g. /.git/config, and with NUL are rejected.
+func sanitizePath(p string) (string, bool) {
+	if strings.ContainsAny(p, "\x00\\") {
+		return "", false
+	}
+	name := path.Clean("/" + p)
+	for _, segment := range strings.Split(name, "/") {
+		if strings.HasPrefix(segment, ".") {
+			return "", false
+		}
+	}
+	return name, true
+}
+
+func (h *staticHandler) serveFile(c *Context, name string) error {
+	f, fi, err := h.open(name)
+	if err != nil {
+		return ErrNotFound
+	}
+	defer f.Close()
+
+	if fi.IsDir() {
+		// relative links of index and listing are resolved against dir/,
+		// location is relative to keep prefixes of proxies
+		if p := c.request.Request.URL.Path; !strings.HasSuffix(p, "/") {
+			location := path.Base(p) + "/"
+			if q := c.request.Request.URL.RawQuery; q != "" {
+				location += "?" + q
+			}
+			return c.Redirect(http.StatusMovedPermanently, location)
+		}
+		index := path.Join(name, h.opts.index)
+		if ff, ffi, err := h.open(index); err == nil {
+			defer ff.Close()
+			if !ffi.IsDir() {
+				return h.serveContent(c, index, ff, ffi)
+			}
+		}
+		if h.opts.browse {
+			return h.list(c, f)
+		}
+		return ErrNotFound
+	}
+	return h.serveContent(c, name, f, fi)
+}
+
+func (h *staticHandler) open(name string) (http.File, os.FileInfo, error) {
+	f, err := h.fs.Open(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, nil, err
+	}
+	return f, fi, nil
+}
+
+// encodings are precompressed variants by preference.
+var encodings = []struct {
+	name string
+	ext  string
+}{
+	{"br", ".br"},
+	{"gzip", ".gz"},
+}
+
+func (h *staticHandler) serveContent(c *Context, name string, f http.File, fi os.FileInfo) error {
+	header := c.response.Header()
+	header.Set(HeaderContentType, ContentTypeByExtension(name))
+	if cc := h.opts.cacheControl(name); cc != "" {
+		header.Set(HeaderCacheControl, cc)
+	}
+
+	var content io.ReadSeeker = f
+	if h.opts.precompressed {
+		varied := false
+		for _, enc := range encodings {
+			ef, efi, err := h.open(name + enc.ext)
+			if err != nil {
+				continue
+			}
+			defer ef.Close()
+			if efi.IsDir() {
+				continue
+			}
+			varied = true
+			if acceptsEncoding(c.request.Header().Get(HeaderAcceptEncoding), enc.name) {
+				// variant is not compressed again, e.g. by gzip middleware
+				c.response.unwrap()
+				header.Set(HeaderContentEncoding, enc.name)
+				name, content, fi = name+enc.ext, ef, efi
+				break
+			}
+		}
+		if varied && !hasValue(header[HeaderVary], HeaderAcceptEncoding) {
+			header.Add(HeaderVary, HeaderAcceptEncoding)
+		}
+	}
+
+	if etag := h.etag(name, content, fi); etag != "" {
+		header.Set(HeaderETag, etag)
+	}
+	// handles conditional, range and HEAD requests
+	c.serveContent(content, name, fi.ModTime())
+	return nil
+}
+
+func hasValue(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// etag returns strong ETag of file by modification time and size, or by
+// content hash if file has no modification time, e.g. embedded assets.
+func (h *staticHandler) etag(name string, content io.ReadSeeker, fi os.FileInfo) string {
+	if !fi.ModTime().IsZero() {
+		return fmt.Sprintf(`"%x-%x"`, fi.ModTime().UnixNano(), fi.Size())
+	}
+	if etag, ok := h.etags.Load(name); ok {
+		return etag.(string)
+	}
+	hash := fnv.New64a()
+	if _, err := io.Copy(hash, content); err != nil {
+		return ""
+	}
+	if _, err := content.Seek(0, io.SeekStart); err != nil {
+		return ""
+	}
+	etag := fmt.Sprintf(`"%x-%x"`, hash.Sum64(), fi.Size())
+	h.etags.Store(name, etag)
+	return etag
+}
+
+// acceptsEncoding reports whether Accept-Encoding header accepts encoding.
+func acceptsEncoding(header, encoding string) bool {
+	for _, part := range strings.Split(header, ",") {
+		params := strings.Split(part, ";")
+		if name := strings.TrimSpace(params[0]); name != encoding && name != "*" {
+			continue
+		}
+		for _, param := range params[1:] {
+			if q := strings.Replace(param, " ", "", -1); q == "q=0" || strings.HasPrefix(q, "q=0.") && strings.Trim(q[4:], "0") == "" {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+var listTemplate = template.Must(template.New("list").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>{{.Path}}</title></head>
+<body>
+<h1>{{.Path}}</h1>
+<pre>
+{{range .Entries}}<a href="{{.URL}}">{{.Name}}</a>
+{{end}}</pre>
+</body>
+</html>
+`))
+
+type listEntry struct {
+	Name string
+	URL  string
+}
+
+// list lists entries of directory, hidden files are left out.
+func (h *staticHandler) list(c *Context, dir http.File) error {
+	fis, err := dir.Readdir(-1)
+	if err != nil {
+		return err
+	}
+	sort.Slice(fis, func(i, j int) bool { return fis[i].Name() < fis[j].Name() })
+
+	entries := make([]listEntry, 0, len(fis))
+	for _, fi := range fis {
+		name := fi.Name()
+		if strings.HasPrefix(name, ".") {
+			continue
+		}
+		if fi.IsDir() {
+			name += "/"
+		}
+		entries = append(entries, listEntry{Name: name, URL: (&url.URL{Path: name}).String()})
+	}
+	c.response.Header().Set(HeaderCacheControl, "no-cache")
+	return c.HTML(http.StatusOK, listTemplate, "list", map[string]interface{}{
+		"Path":    c.request.Request.URL.Path,
+		"Entries": entries,
+	})
+}
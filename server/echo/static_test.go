@@ -0,0 +1,227 @@
+package echo
+
+import (
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func staticDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "static")
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{
+		"index.html":         "<h1>home</h1>",
+		"app.js":             "console.log(1)",
+		"app.js.gz":          "gzipped",
+		"css/site.css":       "body{}",
+		"docs/a.txt":         "0123456789",
+		".secret":            "secret",
+		"docs/sub/index.htm": "sub",
+	}
+	for name, content := range files {
+		p := filepath.Join(dir, name)
+		os.MkdirAll(filepath.Dir(p), 0755)
+		if err := ioutil.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func serveStatic(s *Server, target string, header map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(GET, target, nil)
+	for k, v := range header {
+		req.Header.Set(k, v)
+	}
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestStatic(t *testing.T) {
+	dir := staticDir(t)
+	defer os.RemoveAll(dir)
+	s := NewServer(nil)
+	s.Static("/static", dir, StaticMaxAge(time.Hour))
+
+	// nested directories
+	rec := serveStatic(s, "/static/css/site.css", nil)
+	if rec.Code != http.StatusOK || rec.Body.String() != "body{}" {
+		t.Fatalf("site.css = %d %q", rec.Code, rec.Body.String())
+	}
+	if ct := rec.Header().Get(HeaderContentType); !strings.HasPrefix(ct, "text/css") {
+		t.Errorf("content type = %q", ct)
+	}
+	if cc := rec.Header().Get(HeaderCacheControl); cc != "public, max-age=3600" {
+		t.Errorf("cache control = %q", cc)
+	}
+
+	// conditional requests
+	etag := rec.Header().Get(HeaderETag)
+	if etag == "" || rec.Header().Get(HeaderLastModified) == "" {
+		t.Fatalf("header = %v", rec.Header())
+	}
+	if rec = serveStatic(s, "/static/css/site.css", map[string]string{HeaderIfNoneMatch: etag}); rec.Code != http.StatusNotModified {
+		t.Errorf("if none match = %d", rec.Code)
+	}
+
+	// range requests
+	rec = serveStatic(s, "/static/docs/a.txt", map[string]string{HeaderRange: "bytes=2-4"})
+	if rec.Code != http.StatusPartialContent || rec.Body.String() != "234" {
+		t.Errorf("range = %d %q", rec.Code, rec.Body.String())
+	}
+
+	// index of root, redirect of prefix
+	rec = serveStatic(s, "/static/", nil)
+	if rec.Body.String() != "<h1>home</h1>" || rec.Header().Get(HeaderCacheControl) != "no-cache" {
+		t.Errorf("index = %q, %v", rec.Body.String(), rec.Header())
+	}
+	if rec = serveStatic(s, "/static", nil); rec.Code != http.StatusMovedPermanently || rec.Header().Get(HeaderLocation) != "static/" {
+		t.Errorf("redirect = %d %v", rec.Code, rec.Header())
+	}
+
+	// traversal and hidden files
+	for _, target := range []string{"/static/../static_test.go", "/static/%2e%2e/%2e%2e/etc/passwd", "/static/.secret", "/static/docs/", "/static/missing"} {
+		if rec = serveStatic(s, target, nil); rec.Code != http.StatusNotFound {
+			t.Errorf("%s = %d", target, rec.Code)
+		}
+	}
+}
+
+func TestStaticPrecompressed(t *testing.T) {
+	dir := staticDir(t)
+	defer os.RemoveAll(dir)
+	s := NewServer(nil)
+	s.Static("/", dir)
+
+	rec := serveStatic(s, "/app.js", map[string]string{HeaderAcceptEncoding: "br;q=0, gzip"})
+	if rec.Body.String() != "gzipped" || rec.Header().Get(HeaderContentEncoding) != "gzip" {
+		t.Errorf("gzip = %q, %v", rec.Body.String(), rec.Header())
+	}
+	if ct := rec.Header().Get(HeaderContentType); !strings.Contains(ct, "javascript") {
+		t.Errorf("content type = %q", ct)
+	}
+	rec = serveStatic(s, "/app.js", nil)
+	if rec.Body.String() != "console.log(1)" || rec.Header().Get(HeaderVary) != HeaderAcceptEncoding {
+		t.Errorf("identity = %q, %v", rec.Body.String(), rec.Header())
+	}
+}
+
+func TestStaticBrowseAndSPA(t *testing.T) {
+	dir := staticDir(t)
+	defer os.RemoveAll(dir)
+	s := NewServer(nil)
+	s.Static("/files", dir, StaticBrowse(true))
+	s.Static("/app", dir, StaticSPA(true))
+
+	rec := serveStatic(s, "/files/docs/", nil)
+	if body := rec.Body.String(); !strings.Contains(body, `<a href="a.txt">a.txt</a>`) || !strings.Contains(body, `<a href="sub/">sub/</a>`) {
+		t.Errorf("list = %s", body)
+	}
+	if rec = serveStatic(s, "/files/", nil); rec.Body.String() != "<h1>home</h1>" {
+		t.Errorf("index = %q", rec.Body.String())
+	}
+	if rec = serveStatic(s, "/files/", nil); strings.Contains(rec.Body.String(), ".secret") {
+		t.Errorf("hidden file listed")
+	}
+
+	if rec = serveStatic(s, "/app/orders/42", nil); rec.Body.String() != "<h1>home</h1>" {
+		t.Errorf("spa = %d %q", rec.Code, rec.Body.String())
+	}
+	if rec = serveStatic(s, "/app/missing.js", nil); rec.Code != http.StatusNotFound {
+		t.Errorf("missing asset = %d", rec.Code)
+	}
+}
+
+// memFS is a file system of files without modification time, like embedded
+// assets.
+type memFS map[string]string
+
+type memFile struct {
+	*strings.Reader
+	name string
+}
+
+func (f memFile) Close() error                       { return nil }
+func (f memFile) Readdir(int) ([]os.FileInfo, error) { return nil, nil }
+func (f memFile) Stat() (os.FileInfo, error)         { return f, nil }
+func (f memFile) Name() string                       { return filepath.Base(f.name) }
+func (f memFile) Mode() os.FileMode                  { return 0444 }
+func (f memFile) ModTime() time.Time                 { return time.Time{} }
+func (f memFile) IsDir() bool                        { return false }
+func (f memFile) Sys() interface{}                   { return nil }
+
+func (fs memFS) Open(name string) (http.File, error) {
+	content, ok := fs[name]
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+	return memFile{strings.NewReader(content), name}, nil
+}
+
+func TestStaticFS(t *testing.T) {
+	s := NewServer(nil)
+	s.StaticFS("/assets", memFS{"/a.txt": "aaa", "/b.txt": "bbb"})
+
+	a := serveStatic(s, "/assets/a.txt", nil)
+	b := serveStatic(s, "/assets/b.txt", nil)
+	if a.Body.String() != "aaa" || a.Header().Get(HeaderETag) == "" || a.Header().Get(HeaderETag) == b.Header().Get(HeaderETag) {
+		t.Fatalf("a = %q %v, b = %v", a.Body.String(), a.Header(), b.Header())
+	}
+	if rec := serveStatic(s, "/assets/a.txt", map[string]string{HeaderIfNoneMatch: a.Header().Get(HeaderETag)}); rec.Code != http.StatusNotModified {
+		t.Errorf("if none match = %d", rec.Code)
+	}
+}
+
+// gzipMiddleware compresses response bodies like mw.GZip.
+type gzipMiddleware struct{}
+
+type gzipWriter struct {
+	*gzip.Writer
+}
+
+func (gzipMiddleware) Func() MiddlewareFunc {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(c *Context) error {
+			c.Response().Header().Add(HeaderVary, HeaderAcceptEncoding)
+			c.Response().Header().Add(HeaderContentEncoding, "gzip")
+			c.Response().SetWriter(gzipWriter{gzip.NewWriter(c.Response().ResponseWriter)})
+			return next(c)
+		}
+	}
+}
+
+func TestStaticGZipMiddleware(t *testing.T) {
+	dir := staticDir(t)
+	defer os.RemoveAll(dir)
+	s := NewServer(nil)
+	s.Use(gzipMiddleware{})
+	s.Static("/", dir)
+
+	// compressed by middleware
+	rec := serveStatic(s, "/docs/a.txt", map[string]string{HeaderAcceptEncoding: "gzip", HeaderRange: "bytes=2-4"})
+	if rec.Code != http.StatusOK || rec.Header().Get(HeaderContentEncoding) != "gzip" || !strings.HasPrefix(rec.Header().Get(HeaderETag), "W/") {
+		t.Fatalf("a.txt = %d %v", rec.Code, rec.Header())
+	}
+	zr, err := gzip.NewReader(rec.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if body, err := ioutil.ReadAll(zr); err != nil || string(body) != "0123456789" {
+		t.Errorf("a.txt = %q, %v", body, err)
+	}
+
+	// precompressed variant is not compressed again
+	rec = serveStatic(s, "/app.js", map[string]string{HeaderAcceptEncoding: "gzip"})
+	if rec.Body.String() != "gzipped" || len(rec.Header()[HeaderContentEncoding]) != 1 || len(rec.Header()[HeaderVary]) != 1 {
+		t.Errorf("app.js = %q, %v", rec.Body.String(), rec.Header())
+	}
+}
@@ -0,0 +1,282 @@
+package echo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Stream sends a chunked response of content type, step is called to write
+// the next part until it returns false or the client goes away, and each part
+// is flushed to the client as soon as step returns, e.g.
+//
+//	return c.Stream(echo.MIMETextPlainCharsetUTF8, func(w io.Writer) bool {
+//		line, ok := <-lines
+//		if ok {
+//			fmt.Fprintln(w, line)
+//		}
+//		return ok
+//	})
+//
+// Client disconnection is detected by request context and ends the stream
+// without error.
+func (c *Context) Stream(contentType string, step func(w io.Writer) bool) error {
+	w := c.startStream(contentType)
+	for {
+		select {
+		case <-c.Done():
+			return nil
+		default:
+		}
+		if !step(w) {
+			return nil
+		}
+		c.response.flushStream()
+	}
+}
+
+// NDJSON sends a newline delimited JSON stream, each value returned by next
+// is encoded in a line until next returns false or the client goes away.
+func (c *Context) NDJSON(next func() (v interface{}, ok bool)) error {
+	enc := json.NewEncoder(c.startStream(MIMEApplicationNDJSON))
+	for {
+		select {
+		case <-c.Done():
+			return nil
+		default:
+		}
+		v, ok := next()
+		if !ok {
+			return nil
+		}
+		if err := enc.Encode(v); err != nil {
+			return err
+		}
+		c.response.flushStream()
+	}
+}
+
+// SSE starts a Server-Sent Events response and returns its event writer,
+// which must be closed before handler returns, e.g.
+//
+//	es := c.SSE()
+//	defer es.Close()
+//	es.Heartbeat(15 * time.Second)
+//	for {
+//		select {
+//		case <-es.Done():
+//			return nil
+//		case msg := <-messages:
+//			es.Send(echo.Event{Event: "message", Data: msg})
+//		}
+//	}
+func (c *Context) SSE() *EventStream {
+	header := c.response.Header()
+	header.Set(HeaderCacheControl, "no-cache")
+	header.Set(HeaderConnection, "keep-alive")
+	// disable proxy buffering of nginx
+	header.Set(HeaderXAccelBuffering, "no")
+	es := &EventStream{
+		w:    c.startStream(MIMETextEventStream),
+		rep:  c.response,
+		done: c.Done(),
+		stop: make(chan struct{}),
+	}
+	es.flush()
+	return es
+}
+
+func (c *Context) startStream(contentType string) io.Writer {
+	c.response.Header().Set(HeaderContentType, contentType)
+	// length of stream is unknown
+	c.response.Header().Del(HeaderContentLength)
+	if !c.response.commited {
+		c.response.WriteHeader(c.response.status)
+	}
+	return streamWriter{c.response}
+}
+
+// streamWriter writes to response writer counting response size.
+type streamWriter struct {
+	r *Response
+}
+
+func (w streamWriter) Write(bs []byte) (int, error) {
+	w.r.written = true
+	n, err := w.r.writer.Write(bs)
+	w.r.size += int64(n)
+	return n, err
+}
+
+// Event is a Server-Sent Event. Data of string or []byte is sent as is, and
+// other values are encoded in JSON.
+type Event struct {
+	ID    string
+	Event string
+	Retry time.Duration
+	Data  interface{}
+}
+
+// EventStream writes Server-Sent Events, it's safe for concurrent use.
+type EventStream struct {
+	mu   sync.Mutex
+	w    io.Writer
+	rep  *Response
+	buf  bytes.Buffer
+	err  error
+	done <-chan struct{}
+	stop chan struct{}
+	wg   sync.WaitGroup
+	once sync.Once
+}
+
+// Send sends event e, error is returned if the client has gone away or
+// writing failed. ErrInvalidEvent is returned if ID or Event contains line
+// breaks, which would inject fields into the stream.
+func (es *EventStream) Send(e Event) error {
+	if strings.ContainsAny(e.ID, "\r\n\x00") || strings.ContainsAny(e.Event, "\r\n") {
+		return ErrInvalidEvent
+	}
+	var data []byte
+	switch v := e.Data.(type) {
+	case nil:
+	case string:
+		data = []byte(v)
+	case []byte:
+		data = v
+	default:
+		var err error
+		if data, err = json.Marshal(v); err != nil {
+			return err
+		}
+	}
+
+	es.mu.Lock()
+	defer es.mu.Unlock()
+	es.buf.Reset()
+	if e.ID != "" {
+		writeField(&es.buf, "id", e.ID)
+	}
+	if e.Event != "" {
+		writeField(&es.buf, "event", e.Event)
+	}
+	if e.Retry > 0 {
+		writeField(&es.buf, "retry", strconv.FormatInt(int64(e.Retry/time.Millisecond), 10))
+	}
+	for _, line := range splitLines(string(data)) {
+		writeField(&es.buf, "data", line)
+	}
+	es.buf.WriteByte('\n')
+	return es.write()
+}
+
+// Comment sends a comment line, which is ignored by clients but keeps the
+// connection alive.
+func (es *EventStream) Comment(text string) error {
+	es.mu.Lock()
+	defer es.mu.Unlock()
+	es.buf.Reset()
+	for _, line := range splitLines(text) {
+		fmt.Fprintf(&es.buf, ": %s\n", line)
+	}
+	es.buf.WriteByte('\n')
+	return es.write()
+}
+
+// Heartbeat sends a comment every interval in background until the stream is
+// closed or the client goes away, so that proxies don't time out idle
+// connections.
+func (es *EventStream) Heartbeat(interval time.Duration) {
+	es.wg.Add(1)
+	go func() {
+		defer es.wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-es.stop:
+				return
+			case <-es.done:
+				return
+			case <-ticker.C:
+				if es.Comment("heartbeat") != nil {
+					return
+				}
+			}
+		}
+	}()
+}
+
+// Done returns a channel closed when the client goes away.
+func (es *EventStream) Done() <-chan struct{} {
+	return es.done
+}
+
+// Close stops heartbeat, no event can be sent after closing.
+func (es *EventStream) Close() error {
+	es.once.Do(func() {
+		close(es.stop)
+	})
+	es.wg.Wait()
+	es.mu.Lock()
+	defer es.mu.Unlock()
+	if es.err == nil {
+		es.err = ErrStreamClosed
+	}
+	return nil
+}
+
+// write writes buffered event, es.mu must be held.
+func (es *EventStream) write() error {
+	if es.err != nil {
+		return es.err
+	}
+	select {
+	case <-es.done:
+		es.err = ErrClientGone
+		return es.err
+	default:
+	}
+	if _, err := es.w.Write(es.buf.Bytes()); err != nil {
+		es.err = err
+		return err
+	}
+	es.flush()
+	return nil
+}
+
+func (es *EventStream) flush() {
+	es.rep.flushStream()
+}
+
+// lineBreaks are line breaks of event streams, lone \r breaks lines as well.
+var lineBreaks = strings.NewReplacer("\r\n", "\n", "\r", "\n")
+
+func splitLines(s string) []string {
+	return strings.Split(lineBreaks.Replace(s), "\n")
+}
+
+func writeField(buf *bytes.Buffer, name, value string) {
+	buf.WriteString(name)
+	buf.WriteString(": ")
+	buf.WriteString(value)
+	buf.WriteByte('\n')
+}
+
+// flushStream flushes written data to the client.
+func (r *Response) flushStream() {
+	if f, ok := r.writer.(interface {
+		Flush() error
+	}); ok {
+		f.Flush()
+	}
+	if f, ok := r.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
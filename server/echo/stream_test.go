@@ -0,0 +1,182 @@
+package echo
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestContextStream(t *testing.T) {
+	s := NewServer(nil)
+	var size int64
+	s.GET("/stream", func(c *Context) error {
+		i := 0
+		err := c.Stream(MIMETextPlainCharsetUTF8, func(w io.Writer) bool {
+			if i == 3 {
+				return false
+			}
+			fmt.Fprintf(w, "line %d\n", i)
+			i++
+			return true
+		})
+		size = c.Response().Size()
+		return err
+	})
+
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(GET, "/stream", nil))
+	if body := rec.Body.String(); body != "line 0\nline 1\nline 2\n" {
+		t.Errorf("body = %q", body)
+	}
+	if !rec.Flushed || size != int64(rec.Body.Len()) {
+		t.Errorf("flushed = %v, size = %d", rec.Flushed, size)
+	}
+}
+
+func TestContextNDJSON(t *testing.T) {
+	s := NewServer(nil)
+	s.GET("/ndjson", func(c *Context) error {
+		values := []interface{}{map[string]int{"a": 1}, []string{"b"}}
+		return c.NDJSON(func() (interface{}, bool) {
+			if len(values) == 0 {
+				return nil, false
+			}
+			v := values[0]
+			values = values[1:]
+			return v, true
+		})
+	})
+
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(GET, "/ndjson", nil))
+	if ct := rec.Header().Get(HeaderContentType); ct != MIMEApplicationNDJSON {
+		t.Errorf("content type = %q", ct)
+	}
+	if body := rec.Body.String(); body != "{\"a\":1}\n[\"b\"]\n" {
+		t.Errorf("body = %q", body)
+	}
+}
+
+func TestContextSSE(t *testing.T) {
+	s := NewServer(nil)
+	s.GET("/events", func(c *Context) error {
+		es := c.SSE()
+		defer es.Close()
+		es.Send(Event{ID: "1", Event: "greet", Retry: time.Second, Data: "hello\nworld"})
+		es.Send(Event{Data: map[string]int{"n": 2}})
+		es.Comment("ping")
+		// line breaks can't inject fields
+		for _, e := range []Event{{ID: "2\ndata: x"}, {Event: "greet\rdata: x"}, {ID: "3\x00"}} {
+			if err := es.Send(e); err != ErrInvalidEvent {
+				t.Errorf("send %q = %v", e.ID+e.Event, err)
+			}
+		}
+		es.Send(Event{Data: "a\rb\r\nc"})
+		return nil
+	})
+
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(GET, "/events", nil))
+	if ct := rec.Header().Get(HeaderContentType); ct != MIMETextEventStream {
+		t.Errorf("content type = %q", ct)
+	}
+	want := "id: 1\nevent: greet\nretry: 1000\ndata: hello\ndata: world\n\n" +
+		"data: {\"n\":2}\n\n" +
+		": ping\n\n" +
+		"data: a\ndata: b\ndata: c\n\n"
+	if body := rec.Body.String(); body != want {
+		t.Errorf("body = %q, want %q", body, want)
+	}
+}
+
+func TestContextSSEClientGone(t *testing.T) {
+	s := NewServer(nil)
+	done := make(chan error, 1)
+	s.GET("/events", func(c *Context) error {
+		es := c.SSE()
+		defer es.Close()
+		es.Heartbeat(10 * time.Millisecond)
+		for i := 0; ; i++ {
+			if err := es.Send(Event{Data: fmt.Sprint(i)}); err != nil {
+				done <- err
+				return nil
+			}
+			time.Sleep(time.Millisecond)
+		}
+	})
+	ts := httptest.NewServer(s)
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	req, _ := http.NewRequest(GET, ts.URL+"/events", nil)
+	res, err := http.DefaultClient.Do(req.WithContext(ctx))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// events arrive before handler returns
+	line, err := bufio.NewReader(res.Body).ReadString('\n')
+	if err != nil || !strings.HasPrefix(line, "data: ") {
+		t.Fatalf("first line = %q, %v", line, err)
+	}
+	cancel()
+	res.Body.Close()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("stream did not end after client went away")
+	}
+}
+
+// closingWriter records whether it's closed, like writer of gzip middleware.
+type closingWriter struct {
+	io.Writer
+	closed bool
+}
+
+func (w *closingWriter) Close() error {
+	w.closed = true
+	return nil
+}
+
+type writerMiddleware struct {
+	w *closingWriter
+}
+
+func (m writerMiddleware) Func() MiddlewareFunc {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(c *Context) error {
+			m.w.Writer = c.Response().ResponseWriter
+			c.Response().SetWriter(m.w)
+			return next(c)
+		}
+	}
+}
+
+func TestResponseFlushCloseWriter(t *testing.T) {
+	w := &closingWriter{}
+	s := NewServer(nil)
+	s.Use(writerMiddleware{w})
+	s.GET("/encoded", func(c *Context) error {
+		return c.String(StatusOK, "encoded")
+	})
+	s.GET("/direct", func(c *Context) error {
+		return c.ServeContent(strings.NewReader("direct"), "direct.txt", time.Now())
+	})
+
+	s.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(GET, "/encoded", nil))
+	if !w.closed {
+		t.Error("writer of encoded body is not closed")
+	}
+	w.closed = false
+	s.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(GET, "/direct", nil))
+	if w.closed {
+		t.Error("writer is closed without body written through it")
+	}
+}
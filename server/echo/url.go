@@ -30,18 +30,21 @@ func (u *URL) Query(name string) string {
 }
 
 // QueryS return queries by name.
-// TODO framework-specific
 // "?list_a=1&list_a=2&list_a=3&list_b[]=1&list_b[]=2&list_b[]=3&list_c=1,2,3"
 // would be parsed as:
 // list_a = [1,2,3]
-// list_b, not support
-// list_c = "1,2,3"
+// list_b = [1,2,3]
+// list_c = [1,2,3]
 func (u *URL) QueryS(name string) []string {
 	if u.query == nil {
 		u.query = u.URL.Query()
 	}
 
-	return u.query[name]
+	values := u.query[name]
+	if bracket := u.query[name+"[]"]; len(bracket) > 0 {
+		values = append(append(make([]string, 0, len(values)+len(bracket)), values...), bracket...)
+	}
+	return splitValues(values)
 }
 
 // QueryAll returns all queries.
@@ -0,0 +1,445 @@
+package echo
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/asaskevich/govalidator"
+)
+
+// ValidationFunc reports whether value v satisfies rule with param, e.g.
+// param is "3" of rule min=3.
+type ValidationFunc func(v reflect.Value, param string) bool
+
+// FieldError is a failed validation rule of request field.
+type FieldError struct {
+	// Field is path of field named by json, form or query tags, e.g.
+	// items[0].name.
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Param   string `json:"param,omitempty"`
+	Message string `json:"message"`
+}
+
+// ValidationError is returned by binders if bound request fails validation
+// rules declared by validate tags, e.g.
+//
+//	type CreateUser struct {
+//		Name  string   `json:"name" validate:"required,max=32"`
+//		Age   int      `json:"age" validate:"min=18,max=150"`
+//		Role  string   `json:"role" validate:"oneof=admin member"`
+//		Phone string   `json:"phone" validate:"regexp=^[0-9]+$"`
+//		Tags  []Tag    `json:"tags" validate:"max=5"`
+//	}
+//
+// Rules are separated by comma, so regexp can't contain comma. Struct fields,
+// pointers to struct and elements of slices are validated recursively.
+type ValidationError struct {
+	Fields []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		msgs = append(msgs, f.Field+": "+f.Message)
+	}
+	return strings.Join(msgs, "; ")
+}
+
+var validations = struct {
+	sync.RWMutex
+	funcs    map[string]ValidationFunc
+	messages map[string]map[string]string
+}{
+	funcs: map[string]ValidationFunc{
+		"required": validateRequired,
+		"min":      validateMin,
+		"max":      validateMax,
+		"len":      validateLen,
+		"regexp":   validateRegexp,
+		"oneof":    validateOneOf,
+		"email": func(v reflect.Value, _ string) bool {
+			return v.Kind() != reflect.String || v.Len() == 0 || govalidator.IsEmail(v.String())
+		},
+	},
+	messages: map[string]map[string]string{
+		"en": {
+			"required": "{field} is required",
+			"min":      "{field} must be at least {param}",
+			"max":      "{field} must be at most {param}",
+			"len":      "{field} must have length {param}",
+			"regexp":   "{field} must match {param}",
+			"oneof":    "{field} must be one of {param}",
+			"email":    "{field} must be an email address",
+			"":         "{field} is invalid",
+		},
+		"zh": {
+			"required": "{field}不能为空",
+			"min":      "{field}不能小于{param}",
+			"max":      "{field}不能大于{param}",
+			"len":      "{field}长度必须为{param}",
+			"regexp":   "{field}必须匹配{param}",
+			"oneof":    "{field}必须是{param}之一",
+			"email":    "{field}必须是邮箱地址",
+			"":         "{field}不合法",
+		},
+	},
+}
+
+// RegisterValidation registers validation rule, which can be used in
+// validate tags, e.g. `validate:"mobile"`. Rules must be registered before
+// binding structs using them, validate tags with unknown rules or invalid
+// params panic at the first bind.
+func RegisterValidation(rule string, fn ValidationFunc) {
+	validations.Lock()
+	validations.funcs[rule] = fn
+	validations.Unlock()
+}
+
+// RegisterMessages registers messages of rules in language lang, e.g. "en"
+// or "zh-TW". Messages are templates in which {field} and {param} are
+// replaced, message of rule "" is used for rules without message. Language
+// is chosen by Accept-Language header of request and defaults to "en".
+func RegisterMessages(lang string, messages map[string]string) {
+	lang = strings.ToLower(lang)
+	validations.Lock()
+	defer validations.Unlock()
+	if validations.messages[lang] == nil {
+		validations.messages[lang] = make(map[string]string, len(messages))
+	}
+	for rule, msg := range messages {
+		validations.messages[lang][rule] = msg
+	}
+}
+
+var (
+	jsonNames = []string{"json", "form", "query"}
+	formNames = []string{"form", "query", "json"}
+)
+
+// validate validates obj by validate tags, and by valid tags of govalidator.
+// Fields are named by the first of tags present.
+func validate(req *Request, obj interface{}, tags []string) error {
+	v := reflect.ValueOf(obj)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var fields []FieldError
+	validateStruct(v, "", tags, &fields)
+
+	if _, err := govalidator.ValidateStruct(obj); err != nil {
+		fields = appendGovalidatorErrors(fields, err)
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+
+	lang := ""
+	if req != nil && req.Request != nil {
+		lang = req.Header().Get(HeaderAcceptLanguage)
+	}
+	messages := messagesOf(lang)
+	for i := range fields {
+		if fields[i].Message == "" {
+			fields[i].Message = message(messages, fields[i])
+		}
+	}
+	return &ValidationError{Fields: fields}
+}
+
+// fieldRules are parsed validate tag of struct field.
+type fieldRules struct {
+	index int
+	names map[string]string
+	rules []rule
+	// embedded struct fields are validated as fields of outer struct.
+	embedded bool
+}
+
+type rule struct {
+	name  string
+	param string
+}
+
+var structRules sync.Map // reflect.Type -> []fieldRules
+
+func rulesOf(t reflect.Type) []fieldRules {
+	if rs, ok := structRules.Load(t); ok {
+		return rs.([]fieldRules)
+	}
+
+	var rs []fieldRules
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" && !f.Anonymous {
+			continue
+		}
+		fr := fieldRules{index: i, names: make(map[string]string), embedded: f.Anonymous}
+		for _, tag := range []string{"json", "form", "query"} {
+			if name := strings.Split(f.Tag.Get(tag), ",")[0]; name != "" && name != "-" {
+				fr.names[tag] = name
+			}
+		}
+		fr.names[""] = f.Name
+		if tag := f.Tag.Get("validate"); tag != "" && tag != "-" {
+			for _, r := range strings.Split(tag, ",") {
+				kv := strings.SplitN(r, "=", 2)
+				r := rule{name: strings.TrimSpace(kv[0])}
+				if len(kv) == 2 {
+					r.param = kv[1]
+				}
+				// typos of tags fail at the first bind, instead of requests
+				// of values reaching them
+				if err := checkRule(r); err != nil {
+					panic(fmt.Sprintf("echo: validate tag of %s.%s: %v", t.Name(), f.Name, err))
+				}
+				fr.rules = append(fr.rules, r)
+			}
+		}
+		rs = append(rs, fr)
+	}
+	structRules.Store(t, rs)
+	return rs
+}
+
+// checkRule checks rule is registered and its param is valid.
+func checkRule(r rule) error {
+	validations.RLock()
+	_, ok := validations.funcs[r.name]
+	validations.RUnlock()
+	if !ok {
+		return fmt.Errorf("rule %q is not registered", r.name)
+	}
+	switch r.name {
+	case "min", "max", "len":
+		if _, err := strconv.ParseFloat(r.param, 64); err != nil {
+			return fmt.Errorf("invalid param %q of rule %s", r.param, r.name)
+		}
+	case "regexp":
+		re, err := regexp.Compile(r.param)
+		if err != nil {
+			return fmt.Errorf("invalid param of rule regexp: %v", err)
+		}
+		regexps.Store(r.param, re)
+	}
+	return nil
+}
+
+func (fr fieldRules) name(tags []string) string {
+	for _, tag := range tags {
+		if name, ok := fr.names[tag]; ok {
+			return name
+		}
+	}
+	return fr.names[""]
+}
+
+func validateStruct(v reflect.Value, prefix string, tags []string, fields *[]FieldError) {
+	for _, fr := range rulesOf(v.Type()) {
+		fv := v.Field(fr.index)
+		path := prefix + fr.name(tags)
+
+		valid := true
+		for _, r := range fr.rules {
+			validations.RLock()
+			fn := validations.funcs[r.name]
+			validations.RUnlock()
+			if !fn(fv, r.param) {
+				*fields = append(*fields, FieldError{Field: path, Rule: r.name, Param: r.param})
+				valid = false
+				break
+			}
+		}
+		if valid && fr.embedded && len(fr.names) == 1 {
+			validateEmbedded(fv, prefix, tags, fields)
+		} else if valid {
+			validateNested(fv, path, tags, fields)
+		}
+	}
+}
+
+func validateEmbedded(v reflect.Value, prefix string, tags []string, fields *[]FieldError) {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.Struct {
+		validateStruct(v, prefix, tags, fields)
+	}
+}
+
+func validateNested(v reflect.Value, path string, tags []string, fields *[]FieldError) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Struct:
+		if v.Type().PkgPath() == "time" {
+			return
+		}
+		validateStruct(v, path+".", tags, fields)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			validateNested(v.Index(i), path+"["+strconv.Itoa(i)+"]", tags, fields)
+		}
+	}
+}
+
+func appendGovalidatorErrors(fields []FieldError, err error) []FieldError {
+	switch e := err.(type) {
+	case govalidator.Errors:
+		for _, err := range e {
+			fields = appendGovalidatorErrors(fields, err)
+		}
+	case govalidator.Error:
+		fields = append(fields, FieldError{Field: e.Name, Rule: "valid", Message: e.Err.Error()})
+	default:
+		fields = append(fields, FieldError{Rule: "valid", Message: err.Error()})
+	}
+	return fields
+}
+
+// messagesOf returns messages of the first supported language of
+// Accept-Language header.
+func messagesOf(acceptLanguage string) map[string]string {
+	validations.RLock()
+	defer validations.RUnlock()
+	for _, lang := range strings.Split(acceptLanguage, ",") {
+		lang = strings.ToLower(strings.TrimSpace(strings.SplitN(lang, ";", 2)[0]))
+		if m, ok := validations.messages[lang]; ok {
+			return m
+		}
+		if i := strings.IndexByte(lang, '-'); i > 0 {
+			if m, ok := validations.messages[lang[:i]]; ok {
+				return m
+			}
+		}
+	}
+	return validations.messages["en"]
+}
+
+func message(messages map[string]string, f FieldError) string {
+	validations.RLock()
+	msg, ok := messages[f.Rule]
+	if !ok {
+		msg = messages[""]
+	}
+	validations.RUnlock()
+	return strings.NewReplacer("{field}", f.Field, "{param}", f.Param).Replace(msg)
+}
+
+func validateRequired(v reflect.Value, _ string) bool {
+	switch v.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Array:
+		return v.Len() > 0
+	case reflect.Ptr, reflect.Interface, reflect.Chan, reflect.Func:
+		return !v.IsNil()
+	}
+	// unexported fields can't be compared, e.g. of embedded structs
+	if !v.CanInterface() {
+		return true
+	}
+	return !reflect.DeepEqual(v.Interface(), reflect.Zero(v.Type()).Interface())
+}
+
+// size returns length of strings in runes, slices and maps, and value of
+// numbers.
+func size(v reflect.Value) (float64, bool) {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return 0, false
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return float64(len([]rune(v.String()))), true
+	case reflect.Slice, reflect.Map, reflect.Array:
+		return float64(v.Len()), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	}
+	return 0, false
+}
+
+func compare(v reflect.Value, param string, ok func(size, param float64) bool) bool {
+	// params of tags are checked by checkRule
+	p, _ := strconv.ParseFloat(param, 64)
+	s, valid := size(v)
+	return !valid || ok(s, p)
+}
+
+func validateMin(v reflect.Value, param string) bool {
+	return compare(v, param, func(s, p float64) bool { return s >= p })
+}
+
+func validateMax(v reflect.Value, param string) bool {
+	return compare(v, param, func(s, p float64) bool { return s <= p })
+}
+
+func validateLen(v reflect.Value, param string) bool {
+	return compare(v, param, func(s, p float64) bool { return s == p })
+}
+
+var regexps sync.Map // string -> *regexp.Regexp
+
+func validateRegexp(v reflect.Value, param string) bool {
+	if v.Kind() != reflect.String || v.Len() == 0 {
+		return true
+	}
+	re, ok := regexps.Load(param)
+	if !ok {
+		// param of rules registered by RegisterValidation
+		re, _ = regexps.LoadOrStore(param, regexp.MustCompile(param))
+	}
+	return re.(*regexp.Regexp).MatchString(v.String())
+}
+
+func validateOneOf(v reflect.Value, param string) bool {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return true
+		}
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.String && v.Len() == 0 {
+		return true
+	}
+	var s string
+	switch v.Kind() {
+	case reflect.String:
+		s = v.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		s = strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		s = strconv.FormatUint(v.Uint(), 10)
+	default:
+		return true
+	}
+	for _, option := range strings.Fields(param) {
+		if s == option {
+			return true
+		}
+	}
+	return false
+}
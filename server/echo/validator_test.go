@@ -0,0 +1,155 @@
+package echo
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type address struct {
+	City string `json:"city" validate:"required"`
+}
+
+type item struct {
+	Name string `json:"name" validate:"required,max=4"`
+}
+
+type base struct {
+	ID int `json:"id" validate:"min=1"`
+}
+
+type createUser struct {
+	base
+	Name    string   `json:"name" form:"user_name" validate:"required"`
+	Age     int      `json:"age" validate:"min=18,max=150"`
+	Role    string   `json:"role" validate:"oneof=admin member"`
+	Phone   string   `json:"phone" validate:"regexp=^[0-9]+$"`
+	Code    string   `json:"code" validate:"even"`
+	Address *address `json:"address"`
+	Items   []item   `json:"items" validate:"max=2"`
+}
+
+func TestValidate(t *testing.T) {
+	RegisterValidation("even", func(v reflect.Value, _ string) bool {
+		return len(v.String())%2 == 0
+	})
+
+	obj := &createUser{
+		base:    base{ID: 0},
+		Age:     12,
+		Role:    "root",
+		Phone:   "12a",
+		Code:    "abc",
+		Address: &address{},
+		Items:   []item{{Name: "ok"}, {Name: "too long"}},
+	}
+	err := validate(nil, obj, jsonNames)
+	verr, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("err = %v, want ValidationError", err)
+	}
+	got := make(map[string]string)
+	for _, f := range verr.Fields {
+		got[f.Field] = f.Rule
+	}
+	want := map[string]string{
+		"id":            "min",
+		"name":          "required",
+		"age":           "min",
+		"role":          "oneof",
+		"phone":         "regexp",
+		"code":          "even",
+		"address.city":  "required",
+		"items[1].name": "max",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("failed fields = %v, want %v", got, want)
+	}
+
+	// form binders name fields by form tags
+	err = validate(nil, obj, formNames)
+	if !strings.Contains(err.Error(), "user_name: user_name is required") {
+		t.Errorf("err = %v", err)
+	}
+}
+
+func TestValidateRequired(t *testing.T) {
+	type point struct{ X, Y int }
+	type required struct {
+		OK    bool  `json:"ok" validate:"required"`
+		Point point `json:"point" validate:"required"`
+	}
+	err := validate(nil, &required{}, jsonNames)
+	if err == nil || len(err.(*ValidationError).Fields) != 2 {
+		t.Errorf("err = %v", err)
+	}
+	if err := validate(nil, &required{OK: true, Point: point{Y: 1}}, jsonNames); err != nil {
+		t.Errorf("err = %v", err)
+	}
+}
+
+func TestValidateTags(t *testing.T) {
+	type unknown struct {
+		A string `validate:"requried"`
+	}
+	type badParam struct {
+		A int `validate:"min=one"`
+	}
+	type badRegexp struct {
+		A string `validate:"regexp=^[0-9"`
+	}
+	for name, obj := range map[string]interface{}{
+		`rule "requried" is not registered`: &unknown{},
+		`invalid param "one" of rule min`:   &badParam{},
+		"invalid param of rule regexp":      &badRegexp{},
+	} {
+		func() {
+			defer func() {
+				// tags are checked whatever values are, e.g. empty strings
+				if r := recover(); r == nil || !strings.Contains(r.(string), name) {
+					t.Errorf("panic = %v, want %s", r, name)
+				}
+			}()
+			validate(nil, obj, jsonNames)
+		}()
+	}
+}
+
+func TestProblem(t *testing.T) {
+	RegisterMessages("fr", map[string]string{"required": "{field} est obligatoire"})
+	s := NewServer(nil)
+	s.POST("/users", func(c *Context) error {
+		var req item
+		if err := c.Bind(&req); err != nil {
+			return c.Problem(ProblemOf(err))
+		}
+		return c.NoContent(201)
+	})
+
+	req := httptest.NewRequest(POST, "/users", strings.NewReader(`{}`))
+	req.Header.Set(HeaderContentType, MIMEApplicationJSON)
+	req.Header.Set(HeaderAcceptLanguage, "fr-CA, en;q=0.8")
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, req)
+	if rec.Code != 422 || rec.Header().Get(HeaderContentType) != MIMEApplicationProblemJSON {
+		t.Fatalf("status = %d, content type = %q", rec.Code, rec.Header().Get(HeaderContentType))
+	}
+	var p Problem
+	if err := json.Unmarshal(rec.Body.Bytes(), &p); err != nil {
+		t.Fatal(err)
+	}
+	if p.Status != 422 || p.Instance != "/users" || len(p.Errors) != 1 ||
+		p.Errors[0].Field != "name" || p.Errors[0].Message != "name est obligatoire" {
+		t.Errorf("problem = %+v", p)
+	}
+
+	req = httptest.NewRequest(POST, "/users", strings.NewReader(`{`))
+	req.Header.Set(HeaderContentType, MIMEApplicationJSON)
+	rec = httptest.NewRecorder()
+	s.ServeHTTP(rec, req)
+	if rec.Code != 400 {
+		t.Errorf("malformed body status = %d, want 400", rec.Code)
+	}
+}
@@ -0,0 +1,153 @@
+package server
+
+import (
+	"time"
+
+	"github.com/sevenNt/hera"
+)
+
+// HTTPConfig is the config of HTTP services, which is loaded from
+// app.server.http.<name>, e.g.
+//
+//	[app.server.http.api]
+//	  read_header_timeout = "5s"
+//	  idle_timeout = "2m"
+//	  body_limit = 4194304
+//	  cert_file = "server.crt"
+//	  key_file = "server.key"
+//	  client_ca_file = "ca.crt"
+//
+// Zero values leave defaults of net/http.
+type HTTPConfig struct {
+	ReadTimeout       time.Duration
+	ReadHeaderTimeout time.Duration
+	WriteTimeout      time.Duration
+	IdleTimeout       time.Duration
+	MaxHeaderBytes    int
+	// BodyLimit limits bytes of request bodies, requests over it are replied
+	// with 413.
+	BodyLimit int64
+	// KeepAlive is TCP keepalive period of connections, defaults to 20s, and
+	// negative disables keepalive.
+	KeepAlive time.Duration
+	// CertFile and KeyFile enable TLS, they are reloaded once changed.
+	CertFile string
+	KeyFile  string
+	// ClientCAFile enables verification of client certificates, which are
+	// required unless ClientCAOptional is true.
+	ClientCAFile     string
+	ClientCAOptional bool
+	// DisableHTTP2 disables HTTP/2 of TLS connections.
+	DisableHTTP2 bool
+	// H2C enables HTTP/2 of cleartext connections.
+	H2C bool
+}
+
+// LoadHTTPConfig loads config of HTTP service name from hera.
+func LoadHTTPConfig(name string) HTTPConfig {
+	key := "app.server.http." + name
+	return HTTPConfig{
+		ReadTimeout:       hera.GetDuration(key + ".read_timeout"),
+		ReadHeaderTimeout: hera.GetDuration(key + ".read_header_timeout"),
+		WriteTimeout:      hera.GetDuration(key + ".write_timeout"),
+		IdleTimeout:       hera.GetDuration(key + ".idle_timeout"),
+		MaxHeaderBytes:    hera.GetInt(key + ".max_header_bytes"),
+		BodyLimit:         int64(hera.GetInt(key + ".body_limit")),
+		KeepAlive:         hera.GetDuration(key + ".keepalive"),
+		CertFile:          hera.GetString(key + ".cert_file"),
+		KeyFile:           hera.GetString(key + ".key_file"),
+		ClientCAFile:      hera.GetString(key + ".client_ca_file"),
+		ClientCAOptional:  hera.GetBool(key + ".client_ca_optional"),
+		DisableHTTP2:      hera.GetBool(key + ".disable_http2"),
+		H2C:               hera.GetBool(key + ".h2c"),
+	}
+}
+
+// HTTPFromConfig sets config of HTTP services to the one loaded from
+// app.server.http.<name>, options after it override the config.
+func HTTPFromConfig(name string) Option {
+	return func(o *Options) {
+		o.http = LoadHTTPConfig(name)
+	}
+}
+
+// ReadTimeout sets timeout of reading entire requests.
+func ReadTimeout(timeout time.Duration) Option {
+	return func(o *Options) {
+		o.http.ReadTimeout = timeout
+	}
+}
+
+// ReadHeaderTimeout sets timeout of reading request headers.
+func ReadHeaderTimeout(timeout time.Duration) Option {
+	return func(o *Options) {
+		o.http.ReadHeaderTimeout = timeout
+	}
+}
+
+// WriteTimeout sets timeout of writing responses.
+func WriteTimeout(timeout time.Duration) Option {
+	return func(o *Options) {
+		o.http.WriteTimeout = timeout
+	}
+}
+
+// IdleTimeout sets timeout of idle keep-alive connections.
+func IdleTimeout(timeout time.Duration) Option {
+	return func(o *Options) {
+		o.http.IdleTimeout = timeout
+	}
+}
+
+// MaxHeaderBytes sets max bytes of request headers.
+func MaxHeaderBytes(n int) Option {
+	return func(o *Options) {
+		o.http.MaxHeaderBytes = n
+	}
+}
+
+// BodyLimit sets max bytes of request bodies.
+func BodyLimit(n int64) Option {
+	return func(o *Options) {
+		o.http.BodyLimit = n
+	}
+}
+
+// KeepAlive sets TCP keepalive period, negative disables keepalive.
+func KeepAlive(period time.Duration) Option {
+	return func(o *Options) {
+		o.http.KeepAlive = period
+	}
+}
+
+// TLS enables TLS with certificate and key files.
+func TLS(certFile, keyFile string) Option {
+	return func(o *Options) {
+		o.http.CertFile = certFile
+		o.http.KeyFile = keyFile
+	}
+}
+
+// ClientCA verifies client certificates by CA file, certificates are
+// required unless optional is true.
+func ClientCA(caFile string, optional bool) Option {
+	return func(o *Options) {
+		o.http.ClientCAFile = caFile
+		o.http.ClientCAOptional = optional
+	}
+}
+
+// DisableHTTP2 disables HTTP/2 of TLS connections.
+func DisableHTTP2() Option {
+	return func(o *Options) {
+		o.http.DisableHTTP2 = true
+	}
+}
+
+// H2C enables HTTP/2 of cleartext connections, e.g. behind proxies
+// terminating TLS.
+func H2C() Option {
+	return func(o *Options) {
+		o.http.H2C = true
+	}
+}
@@ -12,6 +12,7 @@ type Options struct {
 	port  int
 	name  string
 	alias []string
+	http  HTTPConfig
 }
 
 // Addr gets service startup address.
@@ -28,6 +29,11 @@ func (opts Options) Alias() []string {
 	return opts.alias
 }
 
+// HTTP gets config of HTTP services.
+func (opts Options) HTTP() HTTPConfig {
+	return opts.http
+}
+
 // Host sets service startup host.
 func Host(host string) Option {
 	return func(o *Options) {
@@ -1,6 +1,10 @@
 package yell
 
-import "google.golang.org/grpc"
+import (
+	"fmt"
+
+	"google.golang.org/grpc"
+)
 
 // UnaryInterceptor wraps groc unary interceptor.
 type UnaryInterceptor interface {
@@ -30,3 +34,37 @@ type ClientInterceptor interface {
 	UnaryClientIntercept() grpc.UnaryClientInterceptor
 	StreamClientIntercept() grpc.StreamClientInterceptor
 }
+
+// interceptorLabel returns the label used to address an interceptor in the chain.
+func interceptorLabel(inte ServerInterceptor) string {
+	if l, ok := inte.(interface {
+		Label() string
+	}); ok {
+		return l.Label()
+	}
+	return fmt.Sprintf("%T", inte)
+}
+
+// insertInterceptors inserts intes into the chain before (offset 0) or
+// after (offset 1) the interceptor labeled with label. An empty label
+// appends intes to the end of the chain.
+func insertInterceptors(chain []ServerInterceptor, label string, offset int, intes ...ServerInterceptor) []ServerInterceptor {
+	pos := len(chain)
+	if label != "" {
+		pos = -1
+		for i, inte := range chain {
+			if interceptorLabel(inte) == label {
+				pos = i + offset
+				break
+			}
+		}
+		if pos < 0 {
+			panic("yell: interceptor " + label + " not found in chain")
+		}
+	}
+
+	ret := make([]ServerInterceptor, 0, len(chain)+len(intes))
+	ret = append(ret, chain[:pos]...)
+	ret = append(ret, intes...)
+	return append(ret, chain[pos:]...)
+}
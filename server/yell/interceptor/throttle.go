@@ -4,8 +4,10 @@ import (
 	"time"
 
 	"github.com/juju/ratelimit"
+	"github.com/sevenNt/ares/server/yell"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
 )
 
 // Throttle ...
@@ -21,7 +23,7 @@ func (t Throttle) UnaryServerIntercept() grpc.UnaryServerInterceptor {
 	rt := ratelimit.NewBucket(t.FillInterval, t.Capacity)
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
 		if _, wait := rt.TakeMaxDuration(1, t.WaitMaxDuration); !wait {
-			return nil, nil
+			return nil, status.Errorf(yell.CodeTooManyRequest, "too many request")
 		}
 		return handler(ctx, req)
 	}
@@ -87,6 +87,8 @@ type Trace struct {
 var twrite *TraceWrite
 
 // NewTraceWrite ..., localAddr 项目地址，eg: 127.0.0.0:50001
+//
+// Deprecated: use plugin/tracing, which exports W3C compatible spans.
 func NewTraceWrite(localAddr string, logPath string) *TraceWrite {
 	twrite = &TraceWrite{
 		msgCh:     make(chan []byte, 1000),
@@ -14,11 +14,13 @@ import (
 type Server struct {
 	*grpc.Server
 	server.Options
-	addr   string
-	name   string
-	alias  []string
-	opts   []grpc.ServerOption
-	inters []ServerInterceptor
+	addr  string
+	name  string
+	alias []string
+	opts  []grpc.ServerOption
+
+	unaryInters  []ServerInterceptor
+	streamInters []ServerInterceptor
 
 	listener net.Listener
 
@@ -33,10 +35,11 @@ type Server struct {
 // NewServer constructs a grpc server.
 func NewServer(lis net.Listener, opts ...server.Option) *Server {
 	s := &Server{
-		inters:    make([]ServerInterceptor, 0),
-		registers: make(map[reflect.Value]interface{}),
-		listener:  lis,
-		running:   make(chan struct{}, 1),
+		unaryInters:  make([]ServerInterceptor, 0),
+		streamInters: make([]ServerInterceptor, 0),
+		registers:    make(map[reflect.Value]interface{}),
+		listener:     lis,
+		running:      make(chan struct{}, 1),
 	}
 
 	return s
@@ -87,6 +90,16 @@ func (s *Server) DumpServiceInfo() {
 	log.Printf("[YELL] \x1b[33m%8s\x1b[0m %s", "Listen On", s.Addr())
 }
 
+// DumpInterceptorInfo dumps interceptor chains in effective order.
+func (s *Server) DumpInterceptorInfo() {
+	for i, label := range s.UnaryInterceptorLabels() {
+		log.Printf("[YELL] \x1b[33m%8s\x1b[0m %d %s", "Unary", i, label)
+	}
+	for i, label := range s.StreamInterceptorLabels() {
+		log.Printf("[YELL] \x1b[33m%8s\x1b[0m %d %s", "Stream", i, label)
+	}
+}
+
 // HookBeforeServe injects hooks executed before server run.
 func (s *Server) HookBeforeServe(f func(*Server)) {
 	s.hookersBeforeServe = append(s.hookersBeforeServe, f)
@@ -98,29 +111,82 @@ func (s *Server) WithGRPCServerOption(opts ...grpc.ServerOption) *Server {
 	return s
 }
 
-// WithUnaryInterceptors adds unary interceptors.
+// WithUnaryInterceptors appends unary interceptors to the end of the chain.
+// Interceptors from every call are composed into one chain at Serve.
 func (s *Server) WithUnaryInterceptors(intes ...ServerInterceptor) *Server {
-	var interceptors = make([]grpc.UnaryServerInterceptor, 0)
-	for _, inte := range intes {
-		interceptors = append(interceptors, inte.UnaryServerIntercept())
-	}
-	opt := grpc.UnaryInterceptor(UnaryInterceptorChain(interceptors...))
-	s.opts = append(s.opts, opt)
-	s.inters = append(s.inters, intes...)
+	s.unaryInters = insertInterceptors(s.unaryInters, "", 0, intes...)
+	return s
+}
+
+// WithUnaryInterceptorsBefore inserts unary interceptors before the one labeled with label.
+func (s *Server) WithUnaryInterceptorsBefore(label string, intes ...ServerInterceptor) *Server {
+	s.unaryInters = insertInterceptors(s.unaryInters, label, 0, intes...)
+	return s
+}
+
+// WithUnaryInterceptorsAfter inserts unary interceptors after the one labeled with label.
+func (s *Server) WithUnaryInterceptorsAfter(label string, intes ...ServerInterceptor) *Server {
+	s.unaryInters = insertInterceptors(s.unaryInters, label, 1, intes...)
 	return s
 }
 
-// WithStreamInterceptors adds stream interceptors.
+// WithStreamInterceptors appends stream interceptors to the end of the chain.
+// Interceptors from every call are composed into one chain at Serve.
 func (s *Server) WithStreamInterceptors(intes ...ServerInterceptor) *Server {
-	var interceptors = make([]grpc.StreamServerInterceptor, 0)
-	for _, inte := range intes {
-		interceptors = append(interceptors, inte.StreamServerIntercept())
-	}
-	s.opts = append(s.opts, grpc.StreamInterceptor(StreamInterceptorChain(interceptors...)))
-	s.inters = append(s.inters, intes...)
+	s.streamInters = insertInterceptors(s.streamInters, "", 0, intes...)
+	return s
+}
+
+// WithStreamInterceptorsBefore inserts stream interceptors before the one labeled with label.
+func (s *Server) WithStreamInterceptorsBefore(label string, intes ...ServerInterceptor) *Server {
+	s.streamInters = insertInterceptors(s.streamInters, label, 0, intes...)
 	return s
 }
 
+// WithStreamInterceptorsAfter inserts stream interceptors after the one labeled with label.
+func (s *Server) WithStreamInterceptorsAfter(label string, intes ...ServerInterceptor) *Server {
+	s.streamInters = insertInterceptors(s.streamInters, label, 1, intes...)
+	return s
+}
+
+// UnaryInterceptorLabels returns labels of unary interceptors in effective chain order.
+func (s *Server) UnaryInterceptorLabels() []string {
+	labels := make([]string, 0, len(s.unaryInters))
+	for _, inte := range s.unaryInters {
+		labels = append(labels, interceptorLabel(inte))
+	}
+	return labels
+}
+
+// StreamInterceptorLabels returns labels of stream interceptors in effective chain order.
+func (s *Server) StreamInterceptorLabels() []string {
+	labels := make([]string, 0, len(s.streamInters))
+	for _, inte := range s.streamInters {
+		labels = append(labels, interceptorLabel(inte))
+	}
+	return labels
+}
+
+// serverOptions returns grpc server options with the composed interceptor chains.
+func (s *Server) serverOptions() []grpc.ServerOption {
+	opts := append([]grpc.ServerOption{}, s.opts...)
+	if len(s.unaryInters) > 0 {
+		interceptors := make([]grpc.UnaryServerInterceptor, 0, len(s.unaryInters))
+		for _, inte := range s.unaryInters {
+			interceptors = append(interceptors, inte.UnaryServerIntercept())
+		}
+		opts = append(opts, grpc.UnaryInterceptor(UnaryInterceptorChain(interceptors...)))
+	}
+	if len(s.streamInters) > 0 {
+		interceptors := make([]grpc.StreamServerInterceptor, 0, len(s.streamInters))
+		for _, inte := range s.streamInters {
+			interceptors = append(interceptors, inte.StreamServerIntercept())
+		}
+		opts = append(opts, grpc.StreamInterceptor(StreamInterceptorChain(interceptors...)))
+	}
+	return opts
+}
+
 // Addr returns stream server address.
 func (s *Server) Addr() string {
 	return s.listener.Addr().String()
@@ -144,11 +210,12 @@ func (s *Server) Scheme() string {
 
 // Serve returns stream server name.
 func (s *Server) Serve() error {
-	s.Server = grpc.NewServer(s.opts...)
+	s.Server = grpc.NewServer(s.serverOptions()...)
 	//s.Func(s)
 	s.register()
 
 	s.DumpServiceInfo()
+	s.DumpInterceptorInfo()
 	for _, hooker := range s.hookersBeforeServe {
 		hooker(s)
 	}
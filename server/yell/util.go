@@ -6,34 +6,41 @@ import (
 )
 
 // UnaryInterceptorChain returns interceptors chain.
+// The chain is composed once, handlers are only bound per request.
 func UnaryInterceptorChain(interceptors ...grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
-	build := func(c grpc.UnaryServerInterceptor, n grpc.UnaryHandler, info *grpc.UnaryServerInfo) grpc.UnaryHandler {
-		return func(ctx context.Context, req interface{}) (interface{}, error) {
-			return c(ctx, req, info, n)
+	switch len(interceptors) {
+	case 0:
+		return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			return handler(ctx, req)
 		}
+	case 1:
+		return interceptors[0]
 	}
 
+	head, tail := interceptors[0], UnaryInterceptorChain(interceptors[1:]...)
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
-		chain := handler
-		for i := len(interceptors) - 1; i >= 0; i-- {
-			chain = build(interceptors[i], chain, info)
-		}
-		return chain(ctx, req)
+		return head(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return tail(ctx, req, info, handler)
+		})
 	}
 }
 
 // StreamInterceptorChain returns stream interceptors chain.
+// The chain is composed once, handlers are only bound per request.
 func StreamInterceptorChain(interceptors ...grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
-	build := func(c grpc.StreamServerInterceptor, n grpc.StreamHandler, info *grpc.StreamServerInfo) grpc.StreamHandler {
-		return func(srv interface{}, stream grpc.ServerStream) error {
-			return c(srv, stream, info, n)
+	switch len(interceptors) {
+	case 0:
+		return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			return handler(srv, stream)
 		}
+	case 1:
+		return interceptors[0]
 	}
+
+	head, tail := interceptors[0], StreamInterceptorChain(interceptors[1:]...)
 	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
-		chain := handler
-		for i := len(interceptors) - 1; i >= 0; i-- {
-			chain = build(interceptors[i], chain, info)
-		}
-		return chain(srv, stream)
+		return head(srv, stream, info, func(srv interface{}, stream grpc.ServerStream) error {
+			return tail(srv, stream, info, handler)
+		})
 	}
 }
@@ -0,0 +1,72 @@
+package yell
+
+import (
+	"reflect"
+	"testing"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+type labeledInterceptor struct {
+	label string
+	trace *[]string
+}
+
+func (i labeledInterceptor) UnaryServerIntercept() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		*i.trace = append(*i.trace, i.label)
+		return handler(ctx, req)
+	}
+}
+
+func (i labeledInterceptor) StreamServerIntercept() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		*i.trace = append(*i.trace, i.label)
+		return handler(srv, stream)
+	}
+}
+
+func (i labeledInterceptor) Label() string { return i.label }
+
+func TestServer_WithUnaryInterceptors(t *testing.T) {
+	var trace []string
+	s := NewServer(nil)
+	s.WithUnaryInterceptors(labeledInterceptor{"recovery", &trace})
+	s.WithUnaryInterceptors(labeledInterceptor{"logger", &trace})
+	s.WithUnaryInterceptorsBefore("logger", labeledInterceptor{"tracer", &trace})
+	s.WithUnaryInterceptorsAfter("logger", labeledInterceptor{"ratelimit", &trace})
+
+	expect := []string{"recovery", "tracer", "logger", "ratelimit"}
+	if labels := s.UnaryInterceptorLabels(); !reflect.DeepEqual(labels, expect) {
+		t.Fatalf("labels: got %v, expect %v", labels, expect)
+	}
+
+	interceptors := make([]grpc.UnaryServerInterceptor, 0)
+	for _, inte := range s.unaryInters {
+		interceptors = append(interceptors, inte.UnaryServerIntercept())
+	}
+	chain := UnaryInterceptorChain(interceptors...)
+	for i := 0; i < 2; i++ {
+		trace = trace[:0]
+		_, err := chain(context.Background(), nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			trace = append(trace, "handler")
+			return nil, nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(trace, append(expect, "handler")) {
+			t.Fatalf("call order: got %v", trace)
+		}
+	}
+}
+
+func TestServer_WithInterceptorsUnknownLabel(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expect panic on unknown label")
+		}
+	}()
+	NewServer(nil).WithStreamInterceptorsAfter("missing", labeledInterceptor{label: "logger"})
+}